@@ -625,13 +625,29 @@ func makeupDecodeColMap(sessCtx sessionctx.Context, dbName model.CIStr, t table.
 	for _, col := range t.WritableCols() {
 		writableColInfos = append(writableColInfos, col.ColumnInfo)
 	}
-	exprCols, _, err := expression.ColumnInfos2ColumnsAndNames(sessCtx, dbName, t.Meta().Name, writableColInfos, t.Meta())
+	exprCols, names, err := expression.ColumnInfos2ColumnsAndNames(sessCtx, dbName, t.Meta().Name, writableColInfos, t.Meta())
 	if err != nil {
 		return nil, err
 	}
 	mockSchema := expression.NewSchema(exprCols...)
 
 	decodeColMap := decoder.BuildFullDecodeColMap(t.WritableCols(), mockSchema)
+	// The stored generated column being added has no value in the existing rows,
+	// so it is evaluated like a virtual generated column.
+	for _, col := range t.WritableCols() {
+		if col.State == model.StatePublic || !col.IsGenerated() || !col.GeneratedStored || col.ChangeStateInfo != nil {
+			continue
+		}
+		genExpr, err := expression.RewriteSimpleExprWithNames(sessCtx, col.GeneratedExpr, mockSchema, names)
+		if err != nil {
+			return nil, err
+		}
+		genExpr, err = genExpr.ResolveIndices(mockSchema)
+		if err != nil {
+			return nil, err
+		}
+		decodeColMap[col.ID] = decoder.Column{Col: col, GenExpr: genExpr}
+	}
 
 	return decodeColMap, nil
 }
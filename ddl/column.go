@@ -44,6 +44,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
@@ -104,7 +105,7 @@ func checkAddColumn(t *meta.Meta, job *model.Job) (*model.TableInfo, *model.Colu
 	return tblInfo, columnInfo, col, pos, false, nil
 }
 
-func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+func (w *worker) onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
 	// Handle the rolling back job.
 	if job.IsRollingback() {
 		ver, err = onDropColumn(d, t, job)
@@ -167,8 +168,21 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 		}
 		// Update the job state when all affairs done.
 		job.SchemaState = model.StateWriteReorganization
-		job.MarkNonRevertible()
+		if isStoredGeneratedColumn(columnInfo) {
+			// Initialize SnapshotVer to 0 for later reorganization check.
+			job.SnapshotVer = 0
+		} else {
+			job.MarkNonRevertible()
+		}
 	case model.StateWriteReorganization:
+		if isStoredGeneratedColumn(columnInfo) {
+			// Backfill the values of the stored generated column for the existing rows.
+			var done bool
+			done, ver, err = w.doReorgWorkForAddStoredGeneratedColumn(d, t, job, tblInfo, columnInfo)
+			if !done {
+				return ver, err
+			}
+		}
 		// reorganization -> public
 		// Adjust table column offset.
 		offset, err := LocateOffsetToMove(columnInfo.Offset, pos, tblInfo)
@@ -192,6 +206,80 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 	return ver, errors.Trace(err)
 }
 
+func isStoredGeneratedColumn(col *model.ColumnInfo) bool {
+	return col.IsGenerated() && col.GeneratedStored
+}
+
+// doReorgWorkForAddStoredGeneratedColumn writes the value of the adding stored generated column
+// for every existing row through the update column backfill workers.
+func (w *worker) doReorgWorkForAddStoredGeneratedColumn(d *ddlCtx, t *meta.Meta, job *model.Job,
+	tblInfo *model.TableInfo, columnInfo *model.ColumnInfo) (done bool, ver int64, err error) {
+	if job.MultiSchemaInfo != nil && !job.MultiSchemaInfo.Revertible {
+		// The reorganization is done, all the others sub-jobs finished.
+		return true, ver, nil
+	}
+	tbl, err := getTable(d.store, job.SchemaID, tblInfo)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	job.ReorgMeta.ReorgTp = model.ReorgTypeTxn
+	sctx, err := w.sessPool.Get()
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	defer w.sessPool.Put(sctx)
+	rh := newReorgHandler(sess.NewSession(sctx))
+	dbInfo, err := t.GetDatabase(job.SchemaID)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	elements := []*meta.Element{{ID: columnInfo.ID, TypeKey: meta.ColumnElementKey}}
+	reorgInfo, err := getReorgInfo(d.jobContext(job.ID), d, rh, job, dbInfo, tbl, elements, false)
+	if err != nil || reorgInfo == nil || reorgInfo.first {
+		// If we run reorg firstly, we should update the job snapshot version
+		// and then run the reorg next time.
+		return false, ver, errors.Trace(err)
+	}
+	err = w.runReorgJob(reorgInfo, tbl.Meta(), d.lease, func() (addColumnErr error) {
+		defer util.Recover(metrics.LabelDDL, "onAddColumn",
+			func() {
+				addColumnErr = dbterror.ErrCancelledDDLJob.GenWithStack("add table `%v` column `%v` panic", tbl.Meta().Name, columnInfo.Name)
+			}, false)
+		return w.updateCurrentElement(tbl, reorgInfo)
+	})
+	if err != nil {
+		if dbterror.ErrPausedDDLJob.Equal(err) || dbterror.ErrWaitReorgTimeout.Equal(err) {
+			// If timeout, we should return, check for the owner and re-wait job done.
+			return false, ver, nil
+		}
+		if kv.IsTxnRetryableError(err) || dbterror.ErrNotOwner.Equal(err) {
+			return false, ver, errors.Trace(err)
+		}
+		if err1 := rh.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+			logutil.BgLogger().Warn("run add column job failed, RemoveDDLReorgHandle failed, can't convert job to rollback", zap.String("category", "ddl"),
+				zap.String("job", job.String()), zap.Error(err1))
+		}
+		logutil.BgLogger().Warn("run add column job failed, convert job to rollback", zap.String("category", "ddl"), zap.String("job", job.String()), zap.Error(err))
+		if job.MultiSchemaInfo != nil {
+			// The sub-jobs are rolled back by the multi-schema change job.
+			job.State = model.JobStateRollingback
+			return false, ver, errors.Trace(err)
+		}
+		var err1 error
+		ver, err1 = rollingbackAddColumn(d, t, job)
+		if err1 != nil && !dbterror.ErrCancelledDDLJob.Equal(err1) {
+			return false, ver, errors.Trace(err1)
+		}
+		return false, ver, errors.Trace(err)
+	}
+	if job.MultiSchemaInfo != nil {
+		// We need another round to wait for all the others sub-jobs to finish.
+		job.MarkNonRevertible()
+		return false, ver, nil
+	}
+	return true, ver, nil
+}
+
 // CheckAfterPositionExists makes sure the column specified in AFTER clause is exists.
 // For example, ALTER TABLE t ADD COLUMN c3 INT AFTER c1.
 func CheckAfterPositionExists(tblInfo *model.TableInfo, pos *ast.ColumnPosition) error {
@@ -363,6 +451,12 @@ func onSetDefaultValue(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ er
 }
 
 func needChangeColumnData(oldCol, newCol *model.ColumnInfo) bool {
+	// Converting a virtual generated column to a stored one has to write the value of every existing row,
+	// while the reverse only changes the meta data.
+	return isVirtualToStoredGeneratedColumn(oldCol, newCol) || needChangeColumnTypeData(oldCol, newCol)
+}
+
+func needChangeColumnTypeData(oldCol, newCol *model.ColumnInfo) bool {
 	toUnsigned := mysql.HasUnsignedFlag(newCol.GetFlag())
 	originUnsigned := mysql.HasUnsignedFlag(oldCol.GetFlag())
 	needTruncationOrToggleSign := func() bool {
@@ -387,6 +481,12 @@ func needChangeColumnData(oldCol, newCol *model.ColumnInfo) bool {
 			return isElemsChangedToModifyColumn(oldCol.GetElems(), newCol.GetElems())
 		case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong:
 			return toUnsigned != originUnsigned
+		case mysql.TypeGeometry:
+			// The existing geometries have to be checked when the column is restricted to another geometry type or SRID.
+			if newCol.FieldType.GetGeometryType() != mysql.GeometryTypeGeometry && newCol.FieldType.GetGeometryType() != oldCol.FieldType.GetGeometryType() {
+				return true
+			}
+			return newCol.SRID != nil && (oldCol.SRID == nil || *oldCol.SRID != *newCol.SRID)
 		case mysql.TypeString:
 			// Due to the behavior of padding \x00 at binary type, always change column data when binary length changed
 			if types.IsBinaryStr(&oldCol.FieldType) {
@@ -555,10 +655,6 @@ func (w *worker) onModifyColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver in
 		job.State = model.JobStateCancelled
 		return ver, errors.Trace(err)
 	}
-	if tblInfo.Partition != nil {
-		job.State = model.JobStateCancelled
-		return ver, errors.Trace(dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("table is partition table"))
-	}
 
 	changingCol := modifyInfo.changingCol
 	if changingCol == nil {
@@ -1069,11 +1165,10 @@ func (w *worker) updatePhysicalTableRow(t table.Table, reorgInfo *reorgInfo) err
 				return dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorgInfo.PhysicalTableID, t.Meta().ID)
 			}
 			workType := typeReorgPartitionWorker
-			if reorgInfo.Job.Type != model.ActionReorganizePartition {
-				// workType = typeUpdateColumnWorker
-				// TODO: Support Modify Column on partitioned table
-				// https://github.com/pingcap/tidb/issues/38297
-				return dbterror.ErrCancelledDDLJob.GenWithStack("Modify Column on partitioned table / typeUpdateColumnWorker not yet supported.")
+			if reorgInfo.Job.Type != model.ActionReorganizePartition &&
+				reorgInfo.Job.Type != model.ActionAlterTablePartitioning &&
+				reorgInfo.Job.Type != model.ActionRemovePartitioning {
+				workType = typeUpdateColumnWorker
 			}
 			err := w.writePhysicalTableRecord(w.sessPool, p, workType, reorgInfo)
 			if err != nil {
@@ -1111,27 +1206,29 @@ func (w *worker) updateCurrentElement(t table.Table, reorgInfo *reorgInfo) error
 			}
 		}
 	})
-	// TODO: Support partition tables.
 	if bytes.Equal(reorgInfo.currElement.TypeKey, meta.ColumnElementKey) {
-		//nolint:forcetypeassert
-		err := w.updatePhysicalTableRow(t.(table.PhysicalTable), reorgInfo)
+		err := w.updatePhysicalTableRow(t, reorgInfo)
 		if err != nil {
 			return errors.Trace(err)
 		}
 	}
 
-	if _, ok := t.(table.PartitionedTable); ok {
-		// TODO: remove when modify column of partitioned table is supported
-		// https://github.com/pingcap/tidb/issues/38297
-		return dbterror.ErrCancelledDDLJob.GenWithStack("Modify Column on partitioned table / typeUpdateColumnWorker not yet supported.")
-	}
 	// Get the original start handle and end handle.
+	// For partitioned tables, each element starts from the first partition.
 	currentVer, err := getValidCurrentVersion(reorgInfo.d.store)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	//nolint:forcetypeassert
-	originalStartHandle, originalEndHandle, err := getTableRange(reorgInfo.d.jobContext(reorgInfo.Job.ID), reorgInfo.d, t.(table.PhysicalTable), currentVer.Ver, reorgInfo.Job.Priority)
+	originalPhysicalTableID := t.Meta().ID
+	var physTbl table.PhysicalTable
+	if tbl, ok := t.(table.PartitionedTable); ok {
+		originalPhysicalTableID = t.Meta().Partition.Definitions[0].ID
+		physTbl = tbl.GetPartition(originalPhysicalTableID)
+	} else {
+		//nolint:forcetypeassert
+		physTbl = t.(table.PhysicalTable)
+	}
+	originalStartHandle, originalEndHandle, err := getTableRange(reorgInfo.d.jobContext(reorgInfo.Job.ID), reorgInfo.d, physTbl, currentVer.Ver, reorgInfo.Job.Priority)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -1152,7 +1249,10 @@ func (w *worker) updateCurrentElement(t table.Table, reorgInfo *reorgInfo) error
 	for i := startElementOffset; i < len(reorgInfo.elements[1:]); i++ {
 		// This backfill job has been exited during processing. At that time, the element is reorgInfo.elements[i+1] and handle range is [reorgInfo.StartHandle, reorgInfo.EndHandle].
 		// Then the handle range of the rest elements' is [originalStartHandle, originalEndHandle].
-		if i == startElementOffsetToResetHandle+1 {
+		// For partitioned tables, the previous element ended at the last partition.
+		if i == startElementOffsetToResetHandle+1 ||
+			(i > startElementOffsetToResetHandle && t.Meta().Partition != nil) {
+			reorgInfo.PhysicalTableID = originalPhysicalTableID
 			reorgInfo.StartKey, reorgInfo.EndKey = originalStartHandle, originalEndHandle
 		}
 
@@ -1187,21 +1287,31 @@ type updateColumnWorker struct {
 
 	rowMap map[int64]types.Datum
 
+	// partitionedTbl is the table with the new column definition, used for checking
+	// that the rows stays in the same partition, when the partitioning column is changed.
+	partitionedTbl table.PartitionedTable
+	physicalID     int64
+	// partitionRow is used to reduce memory allocation when checking the partition.
+	partitionRow []types.Datum
+
 	checksumBuffer rowcodec.RowData
 	checksumNeeded bool
 }
 
-func newUpdateColumnWorker(sessCtx sessionctx.Context, id int, t table.PhysicalTable, decodeColMap map[int64]decoder.Column, reorgInfo *reorgInfo, jc *JobContext) *updateColumnWorker {
+func newUpdateColumnWorker(sessCtx sessionctx.Context, id int, t table.PhysicalTable, decodeColMap map[int64]decoder.Column, reorgInfo *reorgInfo, jc *JobContext) (*updateColumnWorker, error) {
 	if !bytes.Equal(reorgInfo.currElement.TypeKey, meta.ColumnElementKey) {
 		logutil.BgLogger().Error("Element type for updateColumnWorker incorrect", zap.String("jobQuery", reorgInfo.Query),
 			zap.String("reorgInfo", reorgInfo.String()))
-		return nil
+		return nil, dbterror.ErrCancelledDDLJob.GenWithStack("Element type for updateColumnWorker incorrect")
 	}
 	var oldCol, newCol *model.ColumnInfo
 	for _, col := range t.WritableCols() {
 		if col.ID == reorgInfo.currElement.ID {
 			newCol = col.ColumnInfo
-			oldCol = table.FindCol(t.Cols(), getChangingColumnOriginName(newCol)).ColumnInfo
+			// There is no old column when a stored generated column is being added.
+			if newCol.ChangeStateInfo != nil {
+				oldCol = table.FindCol(t.Cols(), getChangingColumnOriginName(newCol)).ColumnInfo
+			}
 			break
 		}
 	}
@@ -1214,7 +1324,7 @@ func newUpdateColumnWorker(sessCtx sessionctx.Context, id int, t table.PhysicalT
 	})
 	// We use global `EnableRowLevelChecksum` to detect whether checksum is enabled in ddl backfill worker because
 	// `SessionVars.IsRowLevelChecksumEnabled` will filter out internal sessions.
-	if variable.EnableRowLevelChecksum.Load() {
+	if variable.EnableRowLevelChecksum.Load() && oldCol != nil {
 		if numNonPubCols := len(t.DeletableCols()) - len(t.Cols()); numNonPubCols > 1 {
 			cols := make([]*model.ColumnInfo, len(t.DeletableCols()))
 			for i, col := range t.DeletableCols() {
@@ -1226,14 +1336,85 @@ func newUpdateColumnWorker(sessCtx sessionctx.Context, id int, t table.PhysicalT
 			checksumNeeded = true
 		}
 	}
+	partitionedTbl, err := getPartitionedTableWithNewColumn(t.Meta(), oldCol, newCol)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return &updateColumnWorker{
 		backfillCtx:    newBackfillCtx(reorgInfo.d, id, sessCtx, reorgInfo.SchemaName, t, jc, "update_col_rate", false),
 		oldColInfo:     oldCol,
 		newColInfo:     newCol,
 		rowDecoder:     rowDecoder,
 		rowMap:         make(map[int64]types.Datum, len(decodeColMap)),
+		partitionedTbl: partitionedTbl,
+		physicalID:     t.GetPhysicalID(),
 		checksumNeeded: checksumNeeded,
+	}, nil
+}
+
+// getPartitionedTableWithNewColumn returns the partitioned table as it will look like
+// when oldCol is replaced by newCol, if oldCol is used for partitioning, otherwise nil.
+func getPartitionedTableWithNewColumn(tblInfo *model.TableInfo, oldCol, newCol *model.ColumnInfo) (table.PartitionedTable, error) {
+	if tblInfo.Partition == nil || oldCol == nil {
+		return nil, nil
+	}
+	newTblInfo := tblInfo.Clone()
+	cols := make([]*model.ColumnInfo, 0, len(newTblInfo.Columns))
+	for _, col := range newTblInfo.Columns {
+		switch col.ID {
+		case newCol.ID:
+			continue
+		case oldCol.ID:
+			col = newCol.Clone()
+			col.Name = oldCol.Name
+			col.Offset = oldCol.Offset
+			col.State = model.StatePublic
+			col.ChangeStateInfo = nil
+		}
+		cols = append(cols, col)
+	}
+	newTblInfo.Columns = cols
+	tbl, err := tables.TableFromMeta(autoid.NewAllocators(false), newTblInfo)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	pt, ok := tbl.(table.PartitionedTable)
+	if !ok {
+		return nil, nil
+	}
+	for _, id := range pt.GetPartitionColumnIDs() {
+		if id == newCol.ID {
+			return pt, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkRowPartition checks that the row would still be in the same partition
+// with the new column value, since the data is not moved between partitions.
+func (w *updateColumnWorker) checkRowPartition() error {
+	if w.partitionedTbl == nil {
+		return nil
+	}
+	cols := w.partitionedTbl.Meta().Columns
+	if cap(w.partitionRow) < len(cols) {
+		w.partitionRow = make([]types.Datum, len(cols))
+	}
+	row := w.partitionRow[:len(cols)]
+	for _, col := range cols {
+		row[col.Offset] = w.rowMap[col.ID]
+	}
+	err := w.partitionedTbl.CheckForExchangePartition(w.sessCtx, nil, row, w.physicalID)
+	if err != nil {
+		if table.ErrRowDoesNotMatchGivenPartitionSet.Equal(err) {
+			d := w.rowMap[w.newColInfo.ID]
+			val, _ := d.ToString()
+			return dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs(
+				fmt.Sprintf("the new value %s of column '%s' does not match its current partition", val, w.oldColInfo.Name.O))
+		}
+		return errors.Trace(err)
 	}
+	return nil
 }
 
 func (w *updateColumnWorker) AddMetricInfo(cnt float64) {
@@ -1317,11 +1498,47 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 
 	if _, ok := w.rowMap[w.newColInfo.ID]; ok {
 		// The column is already added by update or insert statement, skip it.
+		err = w.checkRowPartition()
 		w.cleanRowMap()
-		return nil
+		return errors.Trace(err)
 	}
 
 	var recordWarning *terror.Error
+	// The new column is a stored generated column being added when there is no old column,
+	// its value is evaluated with the other generated columns below.
+	if w.oldColInfo != nil {
+		recordWarning, err = w.castChangingColVal(handle)
+		if err != nil {
+			return err
+		}
+	}
+	if err = w.checkRowPartition(); err != nil {
+		return errors.Trace(err)
+	}
+	_, err = w.rowDecoder.EvalRemainedExprColumnMap(w.sessCtx, w.rowMap)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	newColumnIDs := make([]int64, 0, len(w.rowMap))
+	newRow := make([]types.Datum, 0, len(w.rowMap))
+	for colID, val := range w.rowMap {
+		newColumnIDs = append(newColumnIDs, colID)
+		newRow = append(newRow, val)
+	}
+	checksums := w.calcChecksums()
+	sctx, rd := w.sessCtx.GetSessionVars().StmtCtx, &w.sessCtx.GetSessionVars().RowEncoder
+	newRowVal, err := tablecodec.EncodeRow(sctx, newRow, newColumnIDs, nil, nil, rd, checksums...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	w.rowRecords = append(w.rowRecords, &rowRecord{key: recordKey, vals: newRowVal, warning: recordWarning})
+	w.cleanRowMap()
+	return nil
+}
+
+// castChangingColVal casts the value of the old column to the changing column and puts it in the row map.
+func (w *updateColumnWorker) castChangingColVal(handle kv.Handle) (recordWarning *terror.Error, err error) {
 	// Since every updateColumnWorker handle their own work individually, we can cache warning in statement context when casting datum.
 	oldWarn := w.sessCtx.GetSessionVars().StmtCtx.GetWarnings()
 	if oldWarn == nil {
@@ -1330,6 +1547,13 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 		oldWarn = oldWarn[:0]
 	}
 	w.sessCtx.GetSessionVars().StmtCtx.SetWarnings(oldWarn)
+	if w.oldColInfo.IsGenerated() && !w.oldColInfo.GeneratedStored {
+		// The old column is a virtual generated column which is converted to a stored one,
+		// its value is not in the row, so evaluate it first.
+		if _, err := w.rowDecoder.EvalRemainedExprColumnMap(w.sessCtx, w.rowMap); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 	val := w.rowMap[w.oldColInfo.ID]
 	col := w.newColInfo
 	if val.Kind() == types.KindNull && col.FieldType.GetType() == mysql.TypeTimestamp && mysql.HasNotNullFlag(col.GetFlag()) {
@@ -1340,7 +1564,7 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 	}
 	newColVal, err := table.CastValue(w.sessCtx, w.rowMap[w.oldColInfo.ID], w.newColInfo, false, false)
 	if err != nil {
-		return w.reformatErrors(err)
+		return nil, w.reformatErrors(err)
 	}
 	warn := w.sessCtx.GetSessionVars().StmtCtx.GetWarnings()
 	if len(warn) != 0 {
@@ -1352,32 +1576,13 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 		//nolint:forcetypeassert
 		if val.(bool) {
 			if handle.IntValue() == 3000 && atomic.CompareAndSwapInt32(&TestCheckReorgTimeout, 0, 1) {
-				failpoint.Return(errors.Trace(dbterror.ErrWaitReorgTimeout))
+				failpoint.Return(nil, errors.Trace(dbterror.ErrWaitReorgTimeout))
 			}
 		}
 	})
 
 	w.rowMap[w.newColInfo.ID] = newColVal
-	_, err = w.rowDecoder.EvalRemainedExprColumnMap(w.sessCtx, w.rowMap)
-	if err != nil {
-		return errors.Trace(err)
-	}
-	newColumnIDs := make([]int64, 0, len(w.rowMap))
-	newRow := make([]types.Datum, 0, len(w.rowMap))
-	for colID, val := range w.rowMap {
-		newColumnIDs = append(newColumnIDs, colID)
-		newRow = append(newRow, val)
-	}
-	checksums := w.calcChecksums()
-	sctx, rd := w.sessCtx.GetSessionVars().StmtCtx, &w.sessCtx.GetSessionVars().RowEncoder
-	newRowVal, err := tablecodec.EncodeRow(sctx, newRow, newColumnIDs, nil, nil, rd, checksums...)
-	if err != nil {
-		return errors.Trace(err)
-	}
-
-	w.rowRecords = append(w.rowRecords, &rowRecord{key: recordKey, vals: newRowVal, warning: recordWarning})
-	w.cleanRowMap()
-	return nil
+	return recordWarning, nil
 }
 
 func (w *updateColumnWorker) calcChecksums() []uint32 {
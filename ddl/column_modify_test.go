@@ -559,9 +559,9 @@ func TestGeneratedColumnDDL(t *testing.T) {
 		{`create table test_gv_ddl_bad (a int, b int, c int as (a+b), primary key(c))`, errno.ErrUnsupportedOnGeneratedColumn},
 		{`create table test_gv_ddl_bad (a int, b int, c int as (a+b), primary key(a, c))`, errno.ErrUnsupportedOnGeneratedColumn},
 
-		// Add stored generated column through alter table.
-		{`alter table test_gv_ddl add column d int as (b+2) stored`, errno.ErrUnsupportedOnGeneratedColumn},
-		{`alter table test_gv_ddl modify column b int as (a + 8) stored`, errno.ErrUnsupportedOnGeneratedColumn},
+		// Change the stored status and the expression together.
+		{`alter table test_gv_ddl modify column b int as (a + 9) stored`, errno.ErrUnsupportedOnGeneratedColumn},
+		{`alter table test_gv_ddl modify column c int as (b + 3) virtual`, errno.ErrUnsupportedOnGeneratedColumn},
 
 		// Add generated column with incorrect parameter count.
 		{`alter table test_gv_ddl add column z int as (lower(a, 2))`, errno.ErrWrongParamcountToNativeFct},
@@ -901,6 +901,114 @@ func TestAddGeneratedColumnAndInsert(t *testing.T) {
 	require.NoError(t, checkErr)
 }
 
+func TestAddStoredGeneratedColumn(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomainWithSchemaLease(t, columnModifyLease)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int, unique key(a))")
+	tk.MustExec("insert into t1 values (1, 1), (10, 10)")
+
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test")
+
+	d := dom.DDL()
+	hook := &callback.TestDDLCallback{Do: dom}
+	var checkErr error
+	reorgDMLDone := false
+	onJobUpdatedExportedFunc := func(job *model.Job) {
+		if checkErr != nil || job.Type != model.ActionAddColumn {
+			return
+		}
+		switch job.SchemaState {
+		case model.StateWriteOnly:
+			_, checkErr = tk1.Exec("insert into t1 values (2, 2)")
+			if checkErr == nil {
+				_, checkErr = tk1.Exec("update t1 set b = 100 where a = 1")
+			}
+		case model.StateWriteReorganization:
+			// The job may be updated several times during the reorganization.
+			if reorgDMLDone {
+				return
+			}
+			reorgDMLDone = true
+			_, checkErr = tk1.Exec("insert into t1 values (3, 3) on duplicate key update b = b + 1")
+			if checkErr == nil {
+				_, checkErr = tk1.Exec("replace into t1 values (4, 4)")
+			}
+		}
+	}
+	hook.OnJobUpdatedExported.Store(&onJobUpdatedExportedFunc)
+	d.SetHook(hook)
+
+	tk.MustExec("alter table t1 add column c int as (a + b) stored")
+	require.NoError(t, checkErr)
+	tk.MustQuery("select * from t1 order by a").Check(testkit.Rows("1 100 101", "2 2 4", "3 3 6", "4 4 8", "10 10 20"))
+	tk.MustExec("admin check table t1")
+	tk.MustQuery("show create table t1").Check(testkit.Rows("t1 CREATE TABLE `t1` (\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  `b` int(11) DEFAULT NULL,\n" +
+		"  `c` int(11) GENERATED ALWAYS AS (`a` + `b`) STORED,\n" +
+		"  UNIQUE KEY `a` (`a`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	d.SetHook(&callback.TestDDLCallback{Do: dom})
+
+	// The stored value is read from the rows, so the index on it can be added.
+	tk.MustExec("alter table t1 add index idx_c(c)")
+	tk.MustQuery("select a from t1 use index(idx_c) where c = 8").Check(testkit.Rows("4"))
+	tk.MustExec("admin check table t1")
+
+	// A stored generated column depends on a virtual generated column.
+	tk.MustExec("alter table t1 add column d int as (c * 2) virtual")
+	tk.MustExec("alter table t1 add column e int as (d + 1) stored")
+	tk.MustQuery("select a, e from t1 order by a").Check(testkit.Rows("1 203", "2 9", "3 15", "4 17", "10 41"))
+
+	// The backfill on a partitioned table.
+	tk.MustExec("create table t2 (a int, b varchar(10)) partition by range (a) (partition p0 values less than (10), partition p1 values less than (maxvalue))")
+	tk.MustExec("insert into t2 values (1, 'x'), (5, 'y'), (20, 'z')")
+	tk.MustExec("alter table t2 add column c varchar(20) as (concat(b, a)) stored")
+	tk.MustQuery("select * from t2 order by a").Check(testkit.Rows("1 x x1", "5 y y5", "20 z z20"))
+	tk.MustExec("admin check table t2")
+}
+
+func TestModifyGeneratedColumnStoredStatus(t *testing.T) {
+	store := testkit.CreateMockStoreWithSchemaLease(t, columnModifyLease)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int as (a + 1) virtual, c int as (a * 2), index idx_b(b))")
+	tk.MustExec("insert into t (a) values (1), (2), (3)")
+
+	tk.MustExec("alter table t modify column b int as (a + 1) stored")
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("1 2 2", "2 3 4", "3 4 6"))
+	tk.MustQuery("select a from t use index(idx_b) where b = 3").Check(testkit.Rows("2"))
+	tk.MustExec("admin check table t")
+	tk.MustExec("insert into t (a) values (4)")
+	tk.MustExec("update t set a = 10 where a = 1")
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("2 3 4", "3 4 6", "4 5 8", "10 11 20"))
+
+	tk.MustExec("alter table t change column c c int as (a * 2) stored")
+	tk.MustQuery("desc t").Check(testkit.Rows(
+		"a int(11) YES  <nil> ",
+		"b int(11) YES MUL <nil> STORED GENERATED",
+		"c int(11) YES  <nil> STORED GENERATED"))
+	tk.MustQuery("select a, c from t where c > 7 order by a").Check(testkit.Rows("4 8", "10 20"))
+
+	// Converting back to virtual only changes the meta data.
+	tk.MustExec("alter table t modify column b int as (a + 1) virtual")
+	tk.MustExec("alter table t modify column c int as (a * 2) virtual")
+	tk.MustQuery("desc t").Check(testkit.Rows(
+		"a int(11) YES  <nil> ",
+		"b int(11) YES MUL <nil> VIRTUAL GENERATED",
+		"c int(11) YES  <nil> VIRTUAL GENERATED"))
+	tk.MustExec("update t set a = 5 where a = 4")
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("2 3 4", "3 4 6", "5 6 10", "10 11 20"))
+	tk.MustExec("admin check table t")
+
+	// The normal column can't be converted to a virtual generated column and the reverse.
+	tk.MustGetErrCode("alter table t modify column a int as (1) virtual", errno.ErrUnsupportedOnGeneratedColumn)
+	tk.MustGetErrCode("alter table t modify column c int", errno.ErrUnsupportedOnGeneratedColumn)
+}
+
 func TestColumnTypeChangeGenUniqueChangingName(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomainWithSchemaLease(t, columnModifyLease)
 
@@ -1872,21 +1872,27 @@ func TestAlterTableAddUniqueOnPartionRangeColumn(t *testing.T) {
 	tk.MustGetErrCode("alter table t add unique index idx_b(b)", errno.ErrUniqueKeyNeedAllFieldsInPf)
 }
 
-func TestFulltextIndexIgnore(t *testing.T) {
+func TestFulltextIndex(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
 	tk.MustExec("use test")
-	tk.MustExec("drop table if exists t_ft")
-	defer tk.MustExec("drop table if exists t_ft")
-	// Make sure that creating and altering to add a fulltext key gives the correct warning
-	assertWarningExec(tk, t, "create table t_ft (a text, fulltext key (a))", dbterror.ErrTableCantHandleFt)
-	assertWarningExec(tk, t, "alter table t_ft add fulltext key (a)", dbterror.ErrTableCantHandleFt)
-
-	// Make sure table t_ft still has no indexes even after it was created and altered
-	r := tk.MustQuery("show index from t_ft")
-	require.Len(t, r.Rows(), 0)
-	r = tk.MustQuery("select * from information_schema.statistics where table_schema='test' and table_name='t_ft'")
-	require.Len(t, r.Rows(), 0)
+	tk.MustExec("drop table if exists t_ft, t_ft2")
+	defer tk.MustExec("drop table if exists t_ft, t_ft2")
+	tk.MustExec("create table t_ft (a text, b varchar(10), c int, d blob, e varchar(10) collate utf8mb4_general_ci, fulltext key (a))")
+	tk.MustExec("alter table t_ft add fulltext key fab(a, b) with parser ngram")
+	tk.MustQuery("select index_name, column_name, index_type, collation from information_schema.statistics where table_schema='test' and table_name='t_ft'").
+		Check(testkit.Rows("a a FULLTEXT <nil>", "fab a FULLTEXT <nil>", "fab b FULLTEXT <nil>"))
+
+	tk.MustGetErrCode("alter table t_ft add fulltext key (c)", errno.ErrBadFtColumn)
+	tk.MustGetErrCode("alter table t_ft add fulltext key (d)", errno.ErrBadFtColumn)
+	tk.MustGetErrCode("alter table t_ft add fulltext key (a, e)", errno.ErrBadFtColumn)
+	tk.MustGetErrCode("alter table t_ft add fulltext key (a(10))", errno.ErrWrongSubKey)
+	tk.MustGetErrCode("alter table t_ft add fulltext key ((lower(a)))", errno.ErrFulltextFunctionalIndex)
+	tk.MustGetErrCode("alter table t_ft add fulltext key (a) with parser mecab", errno.ErrFunctionNotDefined)
+	tk.MustGetErrCode("create table t_ft2 (id int, a text, fulltext key (a)) partition by hash(id) partitions 2", errno.ErrFulltextNotSupportedWithPartitioning)
+	tk.MustGetErrCode("create temporary table t_ft2 (a text, fulltext key (a))", errno.ErrInnodbNoFtTempTable)
+	tk.MustExec("create table t_ft2 (id int primary key, a text) partition by hash(id) partitions 2")
+	tk.MustGetErrCode("alter table t_ft2 add fulltext key (a)", errno.ErrFulltextNotSupportedWithPartitioning)
 }
 
 func TestTreatOldVersionUTF8AsUTF8MB4(t *testing.T) {
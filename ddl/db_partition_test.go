@@ -401,7 +401,7 @@ func TestCreateTableWithHashPartition(t *testing.T) {
                                        PARTITION p0 VALUES LESS THAN (100),
                                        PARTITION p1 VALUES LESS THAN (200),
                                        PARTITION p2 VALUES LESS THAN MAXVALUE)`)
-	tk.MustQuery(`show warnings`).Check(testkit.Rows("Warning 8200 Unsupported subpartitioning, only using RANGE partitioning"))
+	tk.MustQuery(`show warnings`).Check(testkit.Rows())
 	tk.MustQuery("select * from t_sub partition (p0)").Check(testkit.Rows())
 	tk.MustQuery("show create table t_sub").Check(testkit.Rows("" +
 		"t_sub CREATE TABLE `t_sub` (\n" +
@@ -409,6 +409,8 @@ func TestCreateTableWithHashPartition(t *testing.T) {
 		"  `b` varchar(128) DEFAULT NULL\n" +
 		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
 		"PARTITION BY RANGE (`a`)\n" +
+		"SUBPARTITION BY HASH (`a`)\n" +
+		"SUBPARTITIONS 2\n" +
 		"(PARTITION `p0` VALUES LESS THAN (100),\n" +
 		" PARTITION `p1` VALUES LESS THAN (200),\n" +
 		" PARTITION `p2` VALUES LESS THAN (MAXVALUE))"))
@@ -425,22 +427,26 @@ func TestSubPartitioning(t *testing.T) {
 	tk := testkit.NewTestKit(t, store)
 	tk.MustExec("use test")
 	tk.MustExec(`create table t (a int) partition by range (a) subpartition by hash (a) subpartitions 2 (partition pMax values less than (maxvalue))`)
-	tk.MustQuery(`show warnings`).Check(testkit.Rows("Warning 8200 Unsupported subpartitioning, only using RANGE partitioning"))
+	tk.MustQuery(`show warnings`).Check(testkit.Rows())
 	tk.MustQuery(`show create table t`).Check(testkit.Rows("" +
 		"t CREATE TABLE `t` (\n" +
 		"  `a` int(11) DEFAULT NULL\n" +
 		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
 		"PARTITION BY RANGE (`a`)\n" +
+		"SUBPARTITION BY HASH (`a`)\n" +
+		"SUBPARTITIONS 2\n" +
 		"(PARTITION `pMax` VALUES LESS THAN (MAXVALUE))"))
 	tk.MustExec(`drop table t`)
 
 	tk.MustExec(`create table t (a int) partition by list (a) subpartition by key (a) subpartitions 2 (partition pMax values in (1,3,4))`)
-	tk.MustQuery(`show warnings`).Check(testkit.Rows("Warning 8200 Unsupported subpartitioning, only using LIST partitioning"))
+	tk.MustQuery(`show warnings`).Check(testkit.Rows())
 	tk.MustQuery(`show create table t`).Check(testkit.Rows("" +
 		"t CREATE TABLE `t` (\n" +
 		"  `a` int(11) DEFAULT NULL\n" +
 		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
 		"PARTITION BY LIST (`a`)\n" +
+		"SUBPARTITION BY KEY (`a`)\n" +
+		"SUBPARTITIONS 2\n" +
 		"(PARTITION `pMax` VALUES IN (1,3,4))"))
 	tk.MustExec(`drop table t`)
 
@@ -449,6 +455,80 @@ func TestSubPartitioning(t *testing.T) {
 
 	tk.MustGetErrMsg(`CREATE TABLE t ( col1 INT NOT NULL, col2 INT NOT NULL, col3 INT NOT NULL, col4 INT NOT NULL, primary KEY (col1,col3) ) PARTITION BY HASH(col1) PARTITIONS 4 SUBPARTITION BY HASH(col3) SUBPARTITIONS 2`, "[ddl:1500]It is only possible to mix RANGE/LIST partitioning with HASH/KEY partitioning for subpartitioning")
 	tk.MustGetErrMsg(`CREATE TABLE t ( col1 INT NOT NULL, col2 INT NOT NULL, col3 INT NOT NULL, col4 INT NOT NULL, primary KEY (col1,col3) ) PARTITION BY KEY(col1) PARTITIONS 4 SUBPARTITION BY KEY(col3) SUBPARTITIONS 2`, "[ddl:1500]It is only possible to mix RANGE/LIST partitioning with HASH/KEY partitioning for subpartitioning")
+
+	// The unique keys must include the subpartitioning columns too.
+	tk.MustGetErrMsg(`create table t (a int, b int, primary key (a)) partition by range (a) subpartition by hash (b) subpartitions 2 (partition p0 values less than (10))`, "[ddl:1503]A CLUSTERED INDEX must include all columns in the table's partitioning function")
+	tk.MustGetErrMsg(`create table t (a int, b int) partition by range (a) subpartition by hash (b) (partition p0 values less than (10) (subpartition s0, subpartition s1), partition p1 values less than (20) (subpartition s0, subpartition s2))`, "[ddl:1517]Duplicate partition name s0")
+	tk.MustGetErrMsg(`create table t (a int, b int) partition by range (a) subpartition by hash (b) (partition p0 values less than (10) (subpartition p1), partition p1 values less than (20) (subpartition s1))`, "[ddl:1517]Duplicate partition name p1")
+
+	tk.MustExec(`create table t (a int, b int, c varchar(255), primary key (a, b)) partition by range (a) subpartition by hash (b) subpartitions 2
+		(partition p0 values less than (10),
+		 partition p1 values less than (20),
+		 partition pMax values less than (maxvalue))`)
+	tk.MustQuery(`select partition_name, subpartition_name, partition_ordinal_position, subpartition_ordinal_position, subpartition_method, subpartition_expression, partition_description from information_schema.partitions where table_schema = 'test' and table_name = 't'`).Check(testkit.Rows(""+
+		"p0 p0sp0 1 1 HASH `b` 10",
+		"p0 p0sp1 1 2 HASH `b` 10",
+		"p1 p1sp0 2 1 HASH `b` 20",
+		"p1 p1sp1 2 2 HASH `b` 20",
+		"pMax pMaxsp0 3 1 HASH `b` MAXVALUE",
+		"pMax pMaxsp1 3 2 HASH `b` MAXVALUE"))
+	tk.MustExec(`insert into t values (1, 1, 'a'), (1, 2, 'b'), (12, 3, 'c'), (12, 4, 'd'), (25, 5, 'e'), (25, 6, 'f')`)
+	tk.MustQuery(`select * from t partition (p1)`).Sort().Check(testkit.Rows("12 3 c", "12 4 d"))
+	tk.MustQuery(`select * from t partition (p1sp0)`).Check(testkit.Rows("12 4 d"))
+	tk.MustQuery(`select * from t partition (p0sp1, pMax)`).Sort().Check(testkit.Rows("1 1 a", "25 5 e", "25 6 f"))
+	tk.MustQuery(`select * from t where a = 12 and b = 3`).Check(testkit.Rows("12 3 c"))
+	tk.MustQuery(`select * from t where a > 10 and b = 6`).Check(testkit.Rows("25 6 f"))
+	tk.MustExec(`update t set b = 8 where a = 12 and b = 3`)
+	tk.MustQuery(`select * from t partition (p1sp0)`).Sort().Check(testkit.Rows("12 4 d", "12 8 c"))
+	tk.MustExec(`admin check table t`)
+
+	tk.MustExec("set @@tidb_partition_prune_mode = 'static'")
+	tk.MustQuery(`explain format = 'brief' select * from t where a = 12 and b = 4`).CheckContain("partition:p1sp0")
+	tk.MustQuery(`select * from t where b = 5`).Check(testkit.Rows("25 5 e"))
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("analyze table t")
+	tk.MustQuery(`explain format = 'brief' select * from t where a = 12 and b = 4`).CheckContain("partition:p1sp0")
+	tk.MustQuery(`explain format = 'brief' select * from t where a = 12`).CheckContain("partition:p1sp0,p1sp1")
+	tk.MustQuery(`explain format = 'brief' select * from t where b = 5`).CheckContain("partition:p0sp1,p1sp1,pMaxsp1")
+	tk.MustQuery(`explain format = 'brief' select * from t partition (pMax) where b = 5`).CheckContain("partition:pMaxsp1")
+	tk.MustQuery(`select * from t where b = 5`).Check(testkit.Rows("25 5 e"))
+
+	tk.MustExec(`alter table t truncate partition p1sp0`)
+	tk.MustQuery(`select * from t partition (p1)`).Check(testkit.Rows())
+	tk.MustExec(`alter table t truncate partition p0`)
+	tk.MustQuery(`select * from t`).Sort().Check(testkit.Rows("25 5 e", "25 6 f"))
+	tk.MustGetErrCode(`alter table t drop partition p0sp0`, errno.ErrDropPartitionNonExistent)
+	tk.MustExec(`alter table t drop partition p0`)
+	tk.MustGetErrMsg(`alter table t reorganize partition p1 into (partition p1 values less than (15))`, "[ddl:8200]Unsupported REORGANIZE PARTITION of a subpartitioned table")
+	tk.MustExec(`create table t2 (a int, b int, c varchar(255), primary key (a, b))`)
+	tk.MustGetErrMsg(`alter table t exchange partition p1sp0 with table t2`, "[ddl:8200]Unsupported EXCHANGE PARTITION of a subpartitioned table")
+	tk.MustGetErrMsg(`alter table t remove partitioning`, "[ddl:8200]Unsupported REMOVE PARTITIONING of a subpartitioned table")
+	tk.MustExec(`drop table t, t2`)
+
+	tk.MustExec(`create table t (a int, b varchar(20)) partition by range columns (a) subpartition by key (b)
+		(partition p0 values less than (10) (subpartition s0 comment 'first', subpartition s1),
+		 partition p1 values less than (20) (subpartition s2, subpartition s3))`)
+	tk.MustQuery(`show create table t`).Check(testkit.Rows("" +
+		"t CREATE TABLE `t` (\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  `b` varchar(20) DEFAULT NULL\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
+		"PARTITION BY RANGE COLUMNS(`a`)\n" +
+		"SUBPARTITION BY KEY (`b`)\n" +
+		"(PARTITION `p0` VALUES LESS THAN (10)\n" +
+		" (SUBPARTITION `s0` COMMENT 'first',\n" +
+		"  SUBPARTITION `s1`),\n" +
+		" PARTITION `p1` VALUES LESS THAN (20)\n" +
+		" (SUBPARTITION `s2`,\n" +
+		"  SUBPARTITION `s3`))"))
+	tk.MustGetErrCode(`alter table t add partition (partition p2 values less than (30) (subpartition s4))`, errno.ErrPartitionWrongNoSubpart)
+	tk.MustExec(`alter table t add partition (partition p2 values less than (30))`)
+	tk.MustExec(`alter table t add partition (partition p3 values less than (40) (subpartition s6, subpartition s7))`)
+	tk.MustQuery(`select subpartition_name from information_schema.partitions where table_schema = 'test' and table_name = 't' and partition_name in ('p2', 'p3')`).Check(testkit.Rows("p2sp0", "p2sp1", "s6", "s7"))
+	tk.MustExec(`insert into t values (5, 'a'), (15, 'b'), (25, 'c'), (35, 'd')`)
+	tk.MustQuery(`select * from t partition (p2)`).Check(testkit.Rows("25 c"))
+	tk.MustQuery(`select count(*) from t partition (s0, s1, s2, s3, p2sp0, p2sp1, s6, s7)`).Check(testkit.Rows("4"))
+	tk.MustExec(`admin check table t`)
 }
 
 func TestCreateTableWithRangeColumnPartition(t *testing.T) {
@@ -4195,11 +4275,12 @@ func TestPartitionErrorCode(t *testing.T) {
 		);`)
 	tk.MustGetDBError("alter table t_part coalesce partition 4;", dbterror.ErrCoalesceOnlyOnHashPartition)
 
-	tk.MustGetErrCode("alter table t_part check partition p0, p1;", errno.ErrUnsupportedDDLOperation)
-	tk.MustGetErrCode("alter table t_part optimize partition p0,p1;", errno.ErrUnsupportedDDLOperation)
-	tk.MustGetErrCode("alter table t_part rebuild partition p0,p1;", errno.ErrUnsupportedDDLOperation)
-	tk.MustGetErrCode("alter table t_part remove partitioning;", errno.ErrUnsupportedDDLOperation)
-	tk.MustGetErrCode("alter table t_part repair partition p1;", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("alter table t_part check partition p0, p1;")
+	tk.MustExec("alter table t_part optimize partition p0,p1;")
+	tk.MustExec("alter table t_part rebuild partition p0,p1;")
+	tk.MustExec("alter table t_part repair partition p1;")
+	tk.MustExec("alter table t_part remove partitioning;")
+	tk.MustGetErrCode("alter table t_part remove partitioning;", errno.ErrPartitionMgmtOnNonpartitioned)
 
 	// Reduce the impact on DML when executing partition DDL
 	tk1 := testkit.NewTestKit(t, store)
@@ -4630,7 +4711,7 @@ func TestConstAndTimezoneDepent2(t *testing.T) {
 	);`)
 }
 
-func TestUnsupportedPartitionManagementDDLs(t *testing.T) {
+func TestAlterTablePartitionByRangeToHash(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
 	tk.MustExec("use test;")
@@ -4644,8 +4725,15 @@ func TestUnsupportedPartitionManagementDDLs(t *testing.T) {
 		);
 	`)
 
-	_, err := tk.Exec("alter table test_1465 partition by hash(a)")
-	require.Regexp(t, ".*alter table partition is unsupported", err.Error())
+	tk.MustExec("insert into test_1465 values (1), (11), (21)")
+	tk.MustExec("alter table test_1465 partition by hash(a)")
+	tk.MustQuery("show create table test_1465").Check(testkit.Rows("" +
+		"test_1465 CREATE TABLE `test_1465` (\n" +
+		"  `a` int(11) DEFAULT NULL\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
+		"PARTITION BY HASH (`a`) PARTITIONS 1"))
+	tk.MustQuery("select * from test_1465").Sort().Check(testkit.Rows("1", "11", "21"))
+	tk.MustExec("admin check table test_1465")
 }
 
 func TestCommitWhenSchemaChange(t *testing.T) {
@@ -5796,12 +5884,11 @@ func TestIssue40135Ver2(t *testing.T) {
 	dom.DDL().SetHook(hook)
 	tk.MustExec("alter table t40135 modify column a bigint NULL DEFAULT '6243108' FIRST")
 	wg.Wait()
-	require.ErrorContains(t, checkErr, "[ddl:8200]Unsupported modify column: table is partition table")
+	require.NoError(t, checkErr)
 	tk.MustExec("admin check table t40135")
 }
 
 func TestAlterModifyPartitionColTruncateWarning(t *testing.T) {
-	t.Skip("waiting for supporting Modify Partition Column again")
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
 	schemaName := "truncWarn"
@@ -5814,10 +5901,11 @@ func TestAlterModifyPartitionColTruncateWarning(t *testing.T) {
 	tk.MustExec(`set sql_mode = ''`)
 	tk.MustExec(`alter table t modify a varchar(5)`)
 	// Fix the duplicate warning, see https://github.com/pingcap/tidb/issues/38699
-	tk.MustQuery(`show warnings`).Check(testkit.Rows(""+
-		"Warning 1265 Data truncated for column 'a', value is ' 654321'",
-		"Warning 1265 Data truncated for column 'a', value is ' 654321'"))
+	tk.MustQuery(`show warnings`).Check(testkit.Rows("" +
+		"Warning 1265 2 warnings with this error code, first warning: Data truncated for column 'a', value is ' 654321'"))
 	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select a from t partition (p1)`).Check(testkit.Rows(" 6543"))
+	tk.MustQuery(`select a from t partition (p2)`).Check(testkit.Rows("12345"))
 }
 
 func TestAlterModifyColumnOnPartitionedTableRename(t *testing.T) {
@@ -5904,3 +5992,51 @@ func TestListDefinitionError(t *testing.T) {
 	tk.MustContainErrMsg(`alter table t add partition (partition p2 values less than (2))`, "[ddl:1480]Only RANGE PARTITIONING can use VALUES LESS THAN in partition definition")
 	tk.MustContainErrMsg(`alter table t add partition (partition p2)`, "[ddl:1479]Syntax : LIST PARTITIONING requires definition of VALUES IN for each partition")
 }
+
+func TestAlterModifyColumnReorgOnPartitionedTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	schemaName := "modColReorgPart"
+	tk.MustExec("create database " + schemaName)
+	tk.MustExec("use " + schemaName)
+	tk.MustExec(`create table t (a int primary key, b varchar(255) charset latin1, c int, key (b), key (c, b)) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20), partition pMax values less than (maxvalue))`)
+	tk.MustExec(`insert into t values (1,"1",1),(11,"11",11),(21,"21",21),(31,"31",31)`)
+	tk.MustExec(`alter table t modify b varchar(200) charset utf8mb4`)
+	tk.MustExec(`alter table t modify c bigint`)
+	tk.MustExec(`alter table t change c d varchar(20)`)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`show create table t`).Check(testkit.Rows("" +
+		"t CREATE TABLE `t` (\n" +
+		"  `a` int(11) NOT NULL,\n" +
+		"  `b` varchar(200) DEFAULT NULL,\n" +
+		"  `d` varchar(20) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`a`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  KEY `b` (`b`),\n" +
+		"  KEY `c` (`d`,`b`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
+		"PARTITION BY RANGE (`a`)\n" +
+		"(PARTITION `p0` VALUES LESS THAN (10),\n" +
+		" PARTITION `p1` VALUES LESS THAN (20),\n" +
+		" PARTITION `pMax` VALUES LESS THAN (MAXVALUE))"))
+	tk.MustQuery(`select a, b, d from t partition (p1)`).Check(testkit.Rows("11 11 11"))
+	tk.MustQuery(`select a from t use index (c) where d = "21"`).Check(testkit.Rows("21"))
+
+	// Changing the partitioning column, each row must stay in its partition.
+	tk.MustExec(`create table t2 (a datetime(6), b int, key (a)) partition by range columns (a) (partition p0 values less than ("2023-01-02"), partition pMax values less than (maxvalue))`)
+	tk.MustExec(`insert into t2 values ("2023-01-01 12:00:00", 1), ("2023-01-01 23:59:59.9", 2), ("2023-01-02 00:00:00.1", 3)`)
+	tk.MustContainErrMsg(`alter table t2 modify a datetime`, "Unsupported modify column: the new value 2023-01-02 00:00:00 of column 'a' does not match its current partition")
+	tk.MustExec(`admin check table t2`)
+	tk.MustQuery(`select b from t2 partition (p0)`).Sort().Check(testkit.Rows("1", "2"))
+	tk.MustExec(`delete from t2 where b = 2`)
+	tk.MustExec(`alter table t2 modify a datetime`)
+	tk.MustExec(`admin check table t2`)
+	tk.MustQuery(`select a from t2 partition (p0)`).Check(testkit.Rows("2023-01-01 12:00:00"))
+	tk.MustQuery(`select a from t2 partition (pMax)`).Check(testkit.Rows("2023-01-02 00:00:00"))
+
+	tk.MustExec(`create table t3 (a int unsigned, b int) partition by hash (a) partitions 3`)
+	tk.MustExec(`insert into t3 values (1, 1), (2, 2), (3, 3), (4, 4)`)
+	tk.MustExec(`alter table t3 modify a bigint`)
+	tk.MustExec(`admin check table t3`)
+	tk.MustQuery(`select a from t3 partition (p1)`).Sort().Check(testkit.Rows("1", "4"))
+	tk.MustContainErrMsg(`alter table t3 modify a varchar(20)`, "Unsupported modify column: can't change the partitioning column, since it would require reorganize all partitions")
+}
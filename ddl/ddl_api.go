@@ -928,6 +928,10 @@ func buildColumnAndConstraint(
 // In NO_ZERO_DATE SQL mode, TIMESTAMP/DATE/DATETIME type can't have zero date like '0000-00-00' or '0000-00-00 00:00:00'.
 func checkColumnDefaultValue(ctx sessionctx.Context, col *table.Column, value interface{}) (bool, interface{}, error) {
 	hasDefaultValue := true
+	// GEOMETRY can't have default values even in non-strict SQL mode.
+	if value != nil && col.GetType() == mysql.TypeGeometry {
+		return hasDefaultValue, value, dbterror.ErrBlobCantHaveDefault.GenWithStackByArgs(col.Name.O)
+	}
 	if value != nil && (col.GetType() == mysql.TypeJSON ||
 		col.GetType() == mysql.TypeTinyBlob || col.GetType() == mysql.TypeMediumBlob ||
 		col.GetType() == mysql.TypeLongBlob || col.GetType() == mysql.TypeBlob) {
@@ -1157,6 +1161,10 @@ func columnDefToCol(ctx sessionctx.Context, offset int, colDef *ast.ColumnDef, o
 				}
 			case ast.ColumnOptionFulltext:
 				ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt.GenWithStackByArgs())
+			case ast.ColumnOptionSRID:
+				if err = setColumnSRID(col, v); err != nil {
+					return nil, nil, errors.Trace(err)
+				}
 			case ast.ColumnOptionCheck:
 				if !variable.EnableCheckConstraint.Load() {
 					ctx.GetSessionVars().StmtCtx.AppendWarning(errors.New("the switch of check constraint is off"))
@@ -1962,7 +1970,17 @@ func BuildTableInfo(
 		}
 
 		if constr.Tp == ast.ConstraintFulltext {
-			ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt.GenWithStackByArgs())
+			idxInfo, err := BuildFullTextIndexInfo(tbInfo.Columns, model.NewCIStr(constr.Name), constr.Keys, constr.Option, model.StatePublic)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			AddIndexColumnFlag(tbInfo, idxInfo)
+			_, err = validateCommentLength(ctx.GetSessionVars(), idxInfo.Name.String(), &idxInfo.Comment, dbterror.ErrTooLongIndexComment)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			idxInfo.ID = AllocateIndexID(tbInfo)
+			tbInfo.Indices = append(tbInfo.Indices, idxInfo)
 			continue
 		}
 
@@ -2222,7 +2240,12 @@ func checkTableInfoValidWithStmt(ctx sessionctx.Context, tbInfo *model.TableInfo
 			if err := checkPartitionFuncType(ctx, s.Partition.Expr, tbInfo); err != nil {
 				return errors.Trace(err)
 			}
-			if err := checkPartitioningKeysConstraints(ctx, s, tbInfo); err != nil {
+			if s.Partition.Sub != nil {
+				if err := checkPartitionFuncType(ctx, s.Partition.Sub.Expr, tbInfo); err != nil {
+					return errors.Trace(err)
+				}
+			}
+			if err := checkPartitioningKeysConstraints(ctx, s.Partition, tbInfo); err != nil {
 				return errors.Trace(err)
 			}
 		}
@@ -2237,6 +2260,9 @@ func checkTableInfoValidWithStmt(ctx sessionctx.Context, tbInfo *model.TableInfo
 }
 
 func checkPartitionDefinitionConstraints(ctx sessionctx.Context, tbInfo *model.TableInfo) error {
+	if tbInfo.Partition.Sub != nil {
+		return checkSubPartitionDefinitionConstraints(ctx, tbInfo)
+	}
 	var err error
 	if err = checkPartitionNameUnique(tbInfo.Partition); err != nil {
 		return errors.Trace(err)
@@ -2262,6 +2288,32 @@ func checkPartitionDefinitionConstraints(ctx sessionctx.Context, tbInfo *model.T
 	return errors.Trace(err)
 }
 
+// checkSubPartitionDefinitionConstraints checks the partitions of a subpartitioned table
+// as if it was only partitioned by its first level, and the names of its subpartitions.
+// Like the checks of the partition values, it may simplify the values of the subpartitions.
+func checkSubPartitionDefinitionConstraints(ctx sessionctx.Context, tbInfo *model.TableInfo) error {
+	pi := tbInfo.Partition
+	if err := checkPartitionNameUnique(pi); err != nil {
+		return errors.Trace(err)
+	}
+	if err := checkAddPartitionTooManyPartitions(uint64(len(pi.Definitions))); err != nil {
+		return err
+	}
+	firstLevelTblInfo := *tbInfo
+	firstLevelTblInfo.Partition = pi.FirstLevel()
+	if err := checkPartitionDefinitionConstraints(ctx, &firstLevelTblInfo); err != nil {
+		return err
+	}
+	firstLevelDefs := firstLevelTblInfo.Partition.Definitions
+	for i := range pi.Definitions {
+		firstLevelDef := firstLevelDefs[uint64(i)/pi.Sub.Num]
+		if !slices.Equal(pi.Definitions[i].LessThan, firstLevelDef.LessThan) {
+			pi.Definitions[i].LessThan = slices.Clone(firstLevelDef.LessThan)
+		}
+	}
+	return nil
+}
+
 // checkTableInfoValid uses to check table info valid. This is used to validate table info.
 func checkTableInfoValid(tblInfo *model.TableInfo) error {
 	_, err := tables.TableFromMeta(autoid.NewAllocators(false), tblInfo)
@@ -2294,6 +2346,7 @@ func BuildTableInfoWithLike(ctx sessionctx.Context, ident ast.Ident, referTblInf
 	tblInfo.Name = ident.Name
 	tblInfo.AutoIncID = 0
 	tblInfo.ForeignKeys = nil
+	tblInfo.Triggers = nil
 	// Ignore TiFlash replicas for temporary tables.
 	if s.TemporaryKeyword != ast.TemporaryNone {
 		tblInfo.TiFlashReplica = nil
@@ -2432,6 +2485,15 @@ func BuildTableInfoWithStmt(ctx sessionctx.Context, s *ast.CreateTableStmt, dbCh
 		return nil, errors.Trace(err)
 	}
 
+	for _, idx := range tbInfo.Indices {
+		if idx.FullText {
+			if err = checkTableSupportFullText(tbInfo); err != nil {
+				return nil, errors.Trace(err)
+			}
+			break
+		}
+	}
+
 	return tbInfo, nil
 }
 
@@ -2954,6 +3016,250 @@ func (d *ddl) CreateView(ctx sessionctx.Context, s *ast.CreateViewStmt) (err err
 	return d.CreateTableWithInfo(ctx, s.ViewName.Schema, tbInfo, onExist)
 }
 
+// CreateMaterializedView creates the table storing the result of the materialized view, then populates it by a refresh.
+func (d *ddl) CreateMaterializedView(ctx sessionctx.Context, s *ast.CreateMaterializedViewStmt) (err error) {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	ident := ast.Ident{Schema: s.ViewName.Schema, Name: s.ViewName.Name}
+	if is.TableExists(ident.Schema, ident.Name) {
+		err = infoschema.ErrTableExists.GenWithStackByArgs(ident)
+		if s.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	tbInfo, err := BuildMaterializedViewTableInfo(ctx, is, s)
+	if err != nil {
+		return err
+	}
+	if err = d.CreateTableWithInfo(ctx, s.ViewName.Schema, tbInfo, OnExistError); err != nil {
+		return err
+	}
+	err = d.RefreshMaterializedView(ctx, &ast.RefreshMaterializedViewStmt{ViewName: s.ViewName, Complete: true})
+	if err != nil {
+		// Don't leave an empty materialized view.
+		if dropErr := d.dropTableObject(ctx, []*ast.TableName{s.ViewName}, true, materializedViewObject); dropErr != nil {
+			logutil.BgLogger().Warn("drop materialized view failed", zap.String("category", "ddl"),
+				zap.Stringer("materialized view", ident), zap.Error(dropErr))
+		}
+	}
+	return err
+}
+
+// RefreshMaterializedView recomputes the result of the materialized view.
+func (d *ddl) RefreshMaterializedView(ctx sessionctx.Context, s *ast.RefreshMaterializedViewStmt) error {
+	ident := ast.Ident{Schema: s.ViewName.Schema, Name: s.ViewName.Name}
+	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !tb.Meta().IsMaterializedView() {
+		return dbterror.ErrWrongObject.GenWithStackByArgs(ident.Schema, ident.Name, "MATERIALIZED VIEW")
+	}
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tb.Meta().ID,
+		SchemaName: schema.Name.L,
+		TableName:  tb.Meta().Name.L,
+		Type:       model.ActionRefreshMaterializedView,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{s.Complete},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// CreateTrigger creates a trigger on the table, the trigger is stored in the TableInfo.
+func (d *ddl) CreateTrigger(ctx sessionctx.Context, s *ast.CreateTriggerStmt) error {
+	ident := ast.Ident{Schema: s.Table.Schema, Name: s.Table.Name}
+	if s.TriggerName.Schema.L != "" && s.TriggerName.Schema.L != ident.Schema.L {
+		return dbterror.ErrTrgInWrongSchema
+	}
+	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := tb.Meta()
+	if tblInfo.IsView() || tblInfo.IsSequence() || tblInfo.IsMaterializedView() || tblInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrTrgOnViewOrTempTable.GenWithStackByArgs(ident.Name.O)
+	}
+	if _, trigger := FindTrigger(d.GetInfoSchemaWithInterceptor(ctx), schema.Name, s.TriggerName.Name); trigger != nil {
+		if s.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(dbterror.ErrTrgAlreadyExists)
+			return nil
+		}
+		return dbterror.ErrTrgAlreadyExists
+	}
+	if err := checkTriggerBody(tblInfo, s); err != nil {
+		return err
+	}
+
+	sessVars := ctx.GetSessionVars()
+	charset, collate := sessVars.GetCharsetInfo()
+	trigger := &model.TriggerInfo{
+		Name:    s.TriggerName.Name,
+		Timing:  s.Timing,
+		Event:   s.Event,
+		Body:    s.Body.Text(),
+		Definer: s.Definer,
+		SQLMode: sessVars.SQLMode,
+		Charset: charset,
+		Collate: collate,
+		Created: time.Now(),
+	}
+	var follows bool
+	var refName model.CIStr
+	if s.Order != nil {
+		follows, refName = s.Order.Follows, s.Order.TriggerName
+	}
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tblInfo.ID,
+		SchemaName: schema.Name.L,
+		TableName:  tblInfo.Name.L,
+		Type:       model.ActionCreateTrigger,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{trigger, follows, refName},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// DropTrigger drops the trigger.
+func (d *ddl) DropTrigger(ctx sessionctx.Context, s *ast.DropTriggerStmt) error {
+	schemaName := s.TriggerName.Schema
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	schema, ok := is.SchemaByName(schemaName)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(schemaName)
+	}
+	tb, trigger := FindTrigger(is, schemaName, s.TriggerName.Name)
+	if trigger == nil {
+		if s.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(dbterror.ErrTrgDoesNotExist)
+			return nil
+		}
+		return dbterror.ErrTrgDoesNotExist
+	}
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tb.Meta().ID,
+		SchemaName: schema.Name.L,
+		TableName:  tb.Meta().Name.L,
+		Type:       model.ActionDropTrigger,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{trigger.Name},
+	}
+	err := d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// CreateRoutine creates a stored procedure or function, the routine is stored in the database meta.
+func (d *ddl) CreateRoutine(ctx sessionctx.Context, s *ast.ProcedureInfo) error {
+	sessVars := ctx.GetSessionVars()
+	if len(sessVars.ActiveRoutines) > 0 {
+		return dbterror.ErrSpNoRecursiveCreate.GenWithStackByArgs(routineType(s.IsFunction))
+	}
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	schema, ok := is.SchemaByName(s.ProcedureName.Schema)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(s.ProcedureName.Schema)
+	}
+	tp := routineType(s.IsFunction)
+	if schema.FindRoutine(s.ProcedureName.Name, tp) != nil {
+		err := dbterror.ErrSpAlreadyExists.GenWithStackByArgs(tp, s.ProcedureName.Name)
+		if s.IfNotExists {
+			sessVars.StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	if err := checkRoutineBody(s); err != nil {
+		return err
+	}
+	params, err := buildRoutineParams(schema, s, sessVars)
+	if err != nil {
+		return err
+	}
+
+	charset, collate := sessVars.GetCharsetInfo()
+	routine := &model.RoutineInfo{
+		Name:          s.ProcedureName.Name,
+		Type:          tp,
+		Params:        params,
+		ParamList:     s.ProcedureParamStr,
+		Body:          s.ProcedureBody.Text(),
+		Deterministic: s.Deterministic,
+		SQLDataAccess: s.SQLDataAccess,
+		Comment:       s.Comment,
+		Definer:       sessVars.User,
+		SQLMode:       sessVars.SQLMode,
+		Charset:       charset,
+		Collate:       collate,
+		Created:       time.Now(),
+	}
+	if s.IsFunction {
+		routine.ReturnType = s.ReturnType.Clone()
+		if err = setRoutineTypeCharsetCollation(routine.ReturnType, s.ProcedureName.Name.O, schema, sessVars); err != nil {
+			return err
+		}
+	}
+	genIDs, err := d.genGlobalIDs(1)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	routine.ID = genIDs[0]
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionCreateRoutine,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{routine},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// DropRoutine drops the stored procedure or function.
+func (d *ddl) DropRoutine(ctx sessionctx.Context, s *ast.DropProcedureStmt) error {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	schema, ok := is.SchemaByName(s.ProcedureName.Schema)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(s.ProcedureName.Schema)
+	}
+	tp := routineType(s.IsFunction)
+	routine := schema.FindRoutine(s.ProcedureName.Name, tp)
+	if routine == nil {
+		err := dbterror.ErrSpDoesNotExist.GenWithStackByArgs(tp, s.ProcedureName.Schema.O+"."+s.ProcedureName.Name.O)
+		if s.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionDropRoutine,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{routine.ID},
+	}
+	err := d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+func routineType(isFunction bool) model.RoutineType {
+	if isFunction {
+		return model.RoutineTypeFunction
+	}
+	return model.RoutineTypeProcedure
+}
+
 // BuildViewInfo builds a ViewInfo structure from an ast.CreateViewStmt.
 func BuildViewInfo(_ sessionctx.Context, s *ast.CreateViewStmt) (*model.ViewInfo, error) {
 	// Always Use `format.RestoreNameBackQuotes` to restore `SELECT` statement despite the `ANSI_QUOTES` SQL Mode is enabled or not.
@@ -2964,7 +3270,8 @@ func BuildViewInfo(_ sessionctx.Context, s *ast.CreateViewStmt) (*model.ViewInfo
 	}
 
 	return &model.ViewInfo{Definer: s.Definer, Algorithm: s.Algorithm,
-		Security: s.Security, SelectStmt: sb.String(), CheckOption: s.CheckOption, Cols: nil}, nil
+		Security: s.Security, SelectStmt: sb.String(), CheckOption: s.CheckOption, Cols: nil,
+		Version: model.CurrLatestViewInfoVersion}, nil
 }
 
 func checkPartitionByHash(ctx sessionctx.Context, tbInfo *model.TableInfo) error {
@@ -3583,14 +3890,12 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			err = dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("SPLIT LAST PARTITION")
 		case ast.AlterTableCheckPartitions:
 			err = errors.Trace(dbterror.ErrUnsupportedCheckPartition)
-		case ast.AlterTableRebuildPartition:
-			err = errors.Trace(dbterror.ErrUnsupportedRebuildPartition)
+		case ast.AlterTableRebuildPartition, ast.AlterTableRepairPartition:
+			err = d.RebuildTablePartitions(sctx, ident, spec)
 		case ast.AlterTableOptimizePartition:
 			err = errors.Trace(dbterror.ErrUnsupportedOptimizePartition)
 		case ast.AlterTableRemovePartitioning:
-			err = errors.Trace(dbterror.ErrUnsupportedRemovePartition)
-		case ast.AlterTableRepairPartition:
-			err = errors.Trace(dbterror.ErrUnsupportedRepairPartition)
+			err = d.RemovePartitioning(sctx, ident, spec)
 		case ast.AlterTableDropColumn:
 			err = d.DropColumn(sctx, ident, spec)
 		case ast.AlterTableDropIndex:
@@ -3639,7 +3944,8 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			case ast.ConstraintPrimaryKey:
 				err = d.CreatePrimaryKey(sctx, ident, model.NewCIStr(constr.Name), spec.Constraint.Keys, constr.Option)
 			case ast.ConstraintFulltext:
-				sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt)
+				err = d.createIndex(sctx, ident, ast.IndexKeyTypeFullText, model.NewCIStr(constr.Name),
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists)
 			case ast.ConstraintCheck:
 				if !variable.EnableCheckConstraint.Load() {
 					sctx.GetSessionVars().StmtCtx.AppendWarning(errors.New("the switch of check constraint is off"))
@@ -3665,8 +3971,7 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			isAlterTable := true
 			err = d.renameTable(sctx, ident, newIdent, isAlterTable)
 		case ast.AlterTablePartition:
-			// Prevent silent succeed if user executes ALTER TABLE x PARTITION BY ...
-			err = errors.New("alter table partition is unsupported")
+			err = d.AlterTablePartitioning(sctx, ident, spec)
 		case ast.AlterTableOption:
 			var placementPolicyRef *model.PolicyRefInfo
 			for i, opt := range spec.Options {
@@ -3970,10 +4275,6 @@ func CreateNewColumn(ctx sessionctx.Context, schema *model.DBInfo, spec *ast.Alt
 				return nil, errors.Trace(err)
 			}
 
-			if option.Stored {
-				return nil, dbterror.ErrUnsupportedOnGeneratedColumn.GenWithStackByArgs("Adding generated stored column through ALTER TABLE")
-			}
-
 			_, dependColNames, err := findDependedColumnNames(schema.Name, t.Meta().Name, specNewColumn)
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -4078,6 +4379,17 @@ func (d *ddl) AddColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTab
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{col, spec.Position, 0, spec.IfNotExists},
 	}
+	// The values of a stored generated column are backfilled for the existing rows.
+	if col.IsGenerated() && col.GeneratedStored {
+		tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+		job.ReorgMeta = &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+		}
+		job.CtxVars = []interface{}{true}
+	}
 
 	err = d.DoDDLJob(ctx, job)
 	err = d.callHookOnChanged(job, err)
@@ -4262,6 +4574,9 @@ func (d *ddl) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident, spec
 	if pi == nil {
 		return dbterror.ErrPartitionMgmtOnNonpartitioned
 	}
+	if pi.Sub != nil {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("REORGANIZE PARTITION of a subpartitioned table")
+	}
 	switch pi.Type {
 	case model.PartitionTypeRange, model.PartitionTypeList:
 	case model.PartitionTypeHash, model.PartitionTypeKey:
@@ -4316,6 +4631,251 @@ func (d *ddl) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident, spec
 	return errors.Trace(err)
 }
 
+// AlterTablePartitioning changes the partitioning scheme of a table, or partitions a
+// non-partitioned table, by reorganizing all its partitions into the new set of partitions.
+func (d *ddl) AlterTablePartitioning(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(infoschema.ErrTableNotExists.FastGenByArgs(ident.Schema, ident.Name))
+	}
+
+	meta := t.Meta()
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	if len(meta.ForeignKeys) > 0 || len(is.GetTableReferredForeignKeys(schema.Name.L, meta.Name.L)) > 0 {
+		return errors.Trace(infoschema.ErrForeignKeyOnPartitioned)
+	}
+	if (meta.Partition != nil && meta.Partition.Sub != nil) || spec.Partition.Sub != nil {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("ALTER TABLE PARTITION BY with subpartitions")
+	}
+	var partNames []model.CIStr
+	if pi := meta.GetPartitionInfo(); pi != nil {
+		partNames = make([]model.CIStr, 0, len(pi.Definitions))
+		for i := range pi.Definitions {
+			partNames = append(partNames, pi.Definitions[i].Name)
+		}
+	} else {
+		// The whole table is handled as a single partition during the reorganization.
+		partNames = []model.CIStr{getPartitionInfoTypeNone().Definitions[0].Name}
+	}
+
+	newMeta := meta.Clone()
+	newMeta.Partition = nil
+	if err = buildTablePartitionInfo(ctx, spec.Partition, newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	if newMeta.Partition == nil {
+		// buildTablePartitionInfo only left a warning, like for unsupported partitioning types.
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(
+			fmt.Sprintf("ALTER TABLE PARTITION BY %s, or with tidb_enable_table_partition disabled", spec.Partition.Tp))
+	}
+	if err = checkPartitionDefinitionConstraints(ctx, newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	if err = checkPartitionFuncType(ctx, spec.Partition.Expr, newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	if err = checkPartitioningKeysConstraints(ctx, spec.Partition, newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	if hasGlobalIndex(newMeta) {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("ALTER TABLE PARTITION BY with global index")
+	}
+	if err = checkTableInfoValid(newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	partInfo := newMeta.Partition
+	if err = handlePartitionPlacement(ctx, partInfo); err != nil {
+		return errors.Trace(err)
+	}
+	if err = d.assignPartitionIDs(partInfo.Definitions); err != nil {
+		return errors.Trace(err)
+	}
+	newIDs, err := d.genGlobalIDs(1)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	partInfo.NewTableID = newIDs[0]
+
+	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    meta.ID,
+		SchemaName: schema.Name.L,
+		TableName:  meta.Name.L,
+		Type:       model.ActionAlterTablePartitioning,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{partNames, partInfo},
+		ReorgMeta: &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+		},
+	}
+
+	// No preSplitAndScatter here, it will be done by the worker in onReorganizePartition instead.
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	if err == nil {
+		ctx.GetSessionVars().StmtCtx.AppendWarning(errors.New("The statistics of new partitions will be outdated after reorganizing partitions. Please use 'ANALYZE TABLE' statement if you want to update it now"))
+	}
+	return errors.Trace(err)
+}
+
+// RemovePartitioning removes the partitioning of a table, by reorganizing all its
+// partitions into a single one, whose ID becomes the table ID.
+func (d *ddl) RemovePartitioning(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(infoschema.ErrTableNotExists.FastGenByArgs(ident.Schema, ident.Name))
+	}
+
+	meta := t.Meta()
+	pi := meta.GetPartitionInfo()
+	if pi == nil {
+		return errors.Trace(dbterror.ErrPartitionMgmtOnNonpartitioned)
+	}
+	if hasGlobalIndex(meta) {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("REMOVE PARTITIONING with global index")
+	}
+	if pi.Sub != nil {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("REMOVE PARTITIONING of a subpartitioned table")
+	}
+	partNames := make([]model.CIStr, 0, len(pi.Definitions))
+	for i := range pi.Definitions {
+		partNames = append(partNames, pi.Definitions[i].Name)
+	}
+	partInfo := getPartitionInfoTypeNone()
+	if err = d.assignPartitionIDs(partInfo.Definitions); err != nil {
+		return errors.Trace(err)
+	}
+	// The table gets the ID of the single partition when the job is done, so the data is
+	// under the table ID, like any non-partitioned table.
+	partInfo.NewTableID = partInfo.Definitions[0].ID
+
+	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    meta.ID,
+		SchemaName: schema.Name.L,
+		TableName:  meta.Name.L,
+		Type:       model.ActionRemovePartitioning,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{partNames, partInfo},
+		ReorgMeta: &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+		},
+	}
+
+	// No preSplitAndScatter here, it will be done by the worker in onReorganizePartition instead.
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// RebuildTablePartitions rebuilds partitions, by reorganizing each of them into an identical
+// partition with a new physical ID. All rows are copied and all indexes are created again from
+// the copied rows, so the old MVCC versions are dropped together with the old physical IDs, and
+// index entries not matching the rows are repaired. Both REBUILD and REPAIR PARTITION do this.
+func (d *ddl) RebuildTablePartitions(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(infoschema.ErrTableNotExists.FastGenByArgs(ident.Schema, ident.Name))
+	}
+
+	meta := t.Meta()
+	pi := meta.GetPartitionInfo()
+	if pi == nil {
+		return errors.Trace(dbterror.ErrPartitionMgmtOnNonpartitioned)
+	}
+	if pi.Sub != nil {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("REBUILD PARTITION of a subpartitioned table")
+	}
+	if hasGlobalIndex(meta) {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("REBUILD PARTITION with global index")
+	}
+	switch pi.Type {
+	case model.PartitionTypeRange, model.PartitionTypeList, model.PartitionTypeHash, model.PartitionTypeKey:
+	default:
+		return errors.Trace(dbterror.ErrUnsupportedRebuildPartition)
+	}
+	rebuilt := make([]bool, len(pi.Definitions))
+	switch {
+	case spec.OnAllPartitions, pi.Type == model.PartitionTypeHash, pi.Type == model.PartitionTypeKey:
+		// Reorganizing HASH and KEY partitions must include all partitions.
+		for i := range rebuilt {
+			rebuilt[i] = true
+		}
+	}
+	for _, name := range spec.PartitionNames {
+		partIdx := pi.FindPartitionDefinitionByName(name.L)
+		if partIdx == -1 {
+			return errors.Trace(table.ErrUnknownPartition.GenWithStackByArgs(name.O, ident.Name.O))
+		}
+		rebuilt[partIdx] = true
+	}
+
+	// Each run of adjacent partitions is rebuilt by a reorganize partition job of its own.
+	for first := 0; first < len(rebuilt); first++ {
+		if !rebuilt[first] {
+			continue
+		}
+		last := first
+		for last+1 < len(rebuilt) && rebuilt[last+1] {
+			last++
+		}
+		if err = d.rebuildAdjacentPartitions(ctx, schema, meta, pi.Definitions[first:last+1]); err != nil {
+			return errors.Trace(err)
+		}
+		first = last
+	}
+	ctx.GetSessionVars().StmtCtx.AppendWarning(errors.New("The statistics of related partitions will be outdated after rebuilding partitions. Please use 'ANALYZE TABLE' statement if you want to update it now"))
+	return nil
+}
+
+func (d *ddl) rebuildAdjacentPartitions(ctx sessionctx.Context, schema *model.DBInfo, meta *model.TableInfo, defs []model.PartitionDefinition) error {
+	partNames := make([]model.CIStr, 0, len(defs))
+	partInfo := &model.PartitionInfo{
+		Type:        meta.Partition.Type,
+		Expr:        meta.Partition.Expr,
+		Columns:     meta.Partition.Columns,
+		Enable:      meta.Partition.Enable,
+		Definitions: make([]model.PartitionDefinition, 0, len(defs)),
+	}
+	for _, def := range defs {
+		partNames = append(partNames, def.Name)
+		partInfo.Definitions = append(partInfo.Definitions, def.Clone())
+	}
+	if err := d.assignPartitionIDs(partInfo.Definitions); err != nil {
+		return errors.Trace(err)
+	}
+
+	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    meta.ID,
+		SchemaName: schema.Name.L,
+		TableName:  meta.Name.L,
+		Type:       model.ActionReorganizePartition,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{partNames, partInfo},
+		ReorgMeta: &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+		},
+	}
+
+	// No preSplitAndScatter here, it will be done by the worker in onReorganizePartition instead.
+	err := d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
 func checkReorgPartitionDefs(ctx sessionctx.Context, tblInfo *model.TableInfo, partInfo *model.PartitionInfo, firstPartIdx, lastPartIdx int, idMap map[int]struct{}) error {
 	// partInfo contains only the new added partition, we have to combine it with the
 	// old partitions to check all partitions is strictly increasing.
@@ -4464,13 +5024,16 @@ func (d *ddl) TruncateTablePartition(ctx sessionctx.Context, ident ast.Ident, sp
 		// so we filter them out through a hash
 		posMap := make(map[int]bool)
 		for _, name := range spec.PartitionNames {
-			pos := pi.FindPartitionDefinitionByName(name.L)
-			if pos < 0 {
+			// The name of a partition of a subpartitioned table truncates all its subpartitions.
+			offsets := pi.FindPartitionDefinitionsByName(name.L)
+			if len(offsets) == 0 {
 				return nil, errors.Trace(table.ErrUnknownPartition.GenWithStackByArgs(name.L, ident.Name.O))
 			}
-			if _, ok := posMap[pos]; !ok {
-				defs = append(defs, pi.Definitions[pos])
-				posMap[pos] = true
+			for _, pos := range offsets {
+				if _, ok := posMap[pos]; !ok {
+					defs = append(defs, pi.Definitions[pos])
+					posMap[pos] = true
+				}
 			}
 		}
 		pi = pi.Clone()
@@ -4577,7 +5140,10 @@ func (d *ddl) DropTablePartition(ctx sessionctx.Context, ident ast.Ident, spec *
 	for i, partCIName := range spec.PartitionNames {
 		partNames[i] = partCIName.L
 	}
-	err = CheckDropTablePartition(meta, partNames)
+	partNames, err = GetDropPartitionNames(meta, partNames)
+	if err == nil {
+		err = CheckDropTablePartition(meta, partNames)
+	}
 	if err != nil {
 		if dbterror.ErrDropPartitionNonExistent.Equal(err) && spec.IfExists {
 			ctx.GetSessionVars().StmtCtx.AppendNote(err)
@@ -4780,7 +5346,9 @@ func (d *ddl) ExchangeTablePartition(ctx sessionctx.Context, ident ast.Ident, sp
 
 	partName := spec.PartitionNames[0].L
 
-	// NOTE: if pt is subPartitioned, it should be checked
+	if ptMeta.Partition.Sub != nil {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("EXCHANGE PARTITION of a subpartitioned table")
+	}
 
 	defID, err := tables.FindPartitionByName(ptMeta, partName)
 	if err != nil {
@@ -5098,6 +5666,10 @@ func processColumnOptions(ctx sessionctx.Context, col *table.Column, options []*
 			}
 		case ast.ColumnOptionCollate:
 			col.SetCollate(opt.StrValue)
+		case ast.ColumnOptionSRID:
+			if err = setColumnSRID(col, opt); err != nil {
+				return errors.Trace(err)
+			}
 		case ast.ColumnOptionReference:
 			return errors.Trace(dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("can't modify with references"))
 		case ast.ColumnOptionFulltext:
@@ -5118,6 +5690,16 @@ func processColumnOptions(ctx sessionctx.Context, col *table.Column, options []*
 	return nil
 }
 
+// setColumnSRID restricts the geometries stored in the column to the SRID.
+func setColumnSRID(col *table.Column, option *ast.ColumnOption) error {
+	if col.GetType() != mysql.TypeGeometry {
+		return dbterror.ErrWrongUsage.GenWithStackByArgs("SRID", "non-geometry column")
+	}
+	srid := option.SRID
+	col.SRID = &srid
+	return nil
+}
+
 func processAndCheckDefaultValueAndColumn(ctx sessionctx.Context, col *table.Column, outPriKeyConstraint *ast.Constraint, hasDefaultValue, setOnUpdateNow, hasNullFlag bool) error {
 	processDefaultValue(col, hasDefaultValue, setOnUpdateNow)
 	processColumnFlags(col)
@@ -5294,9 +5876,6 @@ func GetModifiableColumnJob(
 		if err = isGeneratedRelatedColumn(t.Meta(), newCol.ColumnInfo, col.ColumnInfo); err != nil {
 			return nil, errors.Trace(err)
 		}
-		if t.Meta().Partition != nil {
-			return nil, dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("table is partition table")
-		}
 	}
 
 	// Check that the column change does not affect the partitioning column
@@ -5334,13 +5913,16 @@ func GetModifiableColumnJob(
 					return nil, dbterror.ErrUnsupportedModifyCollation.GenWithStack("Unsupported modify column, decreasing length of int may result in truncation and change of partition")
 				}
 			}
-			// Basically only allow changes of the length/decimals for the column
+			// Do not allow changing the kind of the column, like from string to int.
+			// Other changes, like sign or collation, are only allowed if the data
+			// is reorganized, since then each row is verified to stay in its partition.
 			// Note that enum is not allowed, so elems are not checked
 			// TODO: support partition by ENUM
 			if newCol.FieldType.EvalType() != col.FieldType.EvalType() ||
-				newCol.FieldType.GetFlag() != col.FieldType.GetFlag() ||
-				newCol.FieldType.GetCollate() != col.FieldType.GetCollate() ||
-				newCol.FieldType.GetCharset() != col.FieldType.GetCharset() {
+				(!needChangeColData &&
+					(newCol.FieldType.GetFlag() != col.FieldType.GetFlag() ||
+						newCol.FieldType.GetCollate() != col.FieldType.GetCollate() ||
+						newCol.FieldType.GetCharset() != col.FieldType.GetCharset())) {
 				return nil, dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("can't change the partitioning column, since it would require reorganize all partitions")
 			}
 			// Generate a new PartitionInfo and validate it together with the new column definition
@@ -6345,9 +6927,10 @@ const (
 	tableObject objectType = iota
 	viewObject
 	sequenceObject
+	materializedViewObject
 )
 
-// dropTableObject provides common logic to DROP TABLE/VIEW/SEQUENCE.
+// dropTableObject provides common logic to DROP TABLE/VIEW/SEQUENCE/MATERIALIZED VIEW.
 func (d *ddl) dropTableObject(
 	ctx sessionctx.Context,
 	objects []*ast.TableName,
@@ -6364,7 +6947,7 @@ func (d *ddl) dropTableObject(
 
 	var jobArgs []interface{}
 	switch tableObjectType {
-	case tableObject:
+	case tableObject, materializedViewObject:
 		dropExistErr = infoschema.ErrTableDropExists
 		jobType = model.ActionDropTable
 		objectIdents := make([]ast.Ident, len(objects))
@@ -6433,6 +7016,13 @@ func (d *ddl) dropTableObject(
 			if tableInfo.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 				return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Drop Table")
 			}
+			if tableInfo.Meta().IsMaterializedView() {
+				return dbterror.ErrWrongObject.GenWithStackByArgs(fullti.Schema, fullti.Name, "BASE TABLE")
+			}
+		case materializedViewObject:
+			if !tableInfo.Meta().IsMaterializedView() {
+				return dbterror.ErrWrongObject.GenWithStackByArgs(fullti.Schema, fullti.Name, "MATERIALIZED VIEW")
+			}
 		case viewObject:
 			if !tableInfo.Meta().IsView() {
 				return dbterror.ErrWrongObject.GenWithStackByArgs(fullti.Schema, fullti.Name, "VIEW")
@@ -6501,6 +7091,11 @@ func (d *ddl) DropView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err err
 	return d.dropTableObject(ctx, stmt.Tables, stmt.IfExists, viewObject)
 }
 
+// DropMaterializedView will proceed even if some materialized view in the list does not exists.
+func (d *ddl) DropMaterializedView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error) {
+	return d.dropTableObject(ctx, stmt.Tables, stmt.IfExists, materializedViewObject)
+}
+
 func (d *ddl) TruncateTable(ctx sessionctx.Context, ti ast.Ident) error {
 	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
@@ -6509,6 +7104,9 @@ func (d *ddl) TruncateTable(ctx sessionctx.Context, ti ast.Ident) error {
 	if tb.Meta().IsView() || tb.Meta().IsSequence() {
 		return infoschema.ErrTableNotExists.GenWithStackByArgs(schema.Name.O, tb.Meta().Name.O)
 	}
+	if tb.Meta().IsMaterializedView() {
+		return dbterror.ErrWrongObject.GenWithStackByArgs(schema.Name.O, tb.Meta().Name.O, "BASE TABLE")
+	}
 	if tb.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Truncate Table")
 	}
@@ -6697,6 +7295,12 @@ func ExtractTblInfos(is infoschema.InfoSchema, oldIdent, newIdent ast.Ident, isA
 			return nil, 0, infoschema.ErrForbidSchemaChange.GenWithStackByArgs(oldIdent.Schema, newIdent.Schema)
 		}
 	}
+	// The triggers of the table can't be moved to another schema. Compatible with mysql
+	if oldIdent.Schema.L != newIdent.Schema.L {
+		if tbl, err := is.TableByName(oldIdent.Schema, oldIdent.Name); err == nil && len(tbl.Meta().Triggers) > 0 {
+			return nil, 0, dbterror.ErrTrgInWrongSchema
+		}
+	}
 
 	newSchema, ok := is.SchemaByName(newIdent.Schema)
 	if !ok {
@@ -6996,11 +7600,12 @@ func (*ddl) addHypoIndexIntoCtx(ctx sessionctx.Context, schemaName, tableName mo
 
 func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.IndexKeyType, indexName model.CIStr,
 	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) error {
-	// not support Spatial and FullText index
-	if keyType == ast.IndexKeyTypeFullText || keyType == ast.IndexKeyTypeSpatial {
-		return dbterror.ErrUnsupportedIndexType.GenWithStack("FULLTEXT and SPATIAL index is not supported")
+	// not support Spatial index
+	if keyType == ast.IndexKeyTypeSpatial {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("SPATIAL index is not supported")
 	}
 	unique := keyType == ast.IndexKeyTypeUnique
+	fullText := keyType == ast.IndexKeyTypeFullText
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
 		return errors.Trace(err)
@@ -7040,6 +7645,10 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 
 	tblInfo := t.Meta()
 
+	if fullText {
+		return d.createFullTextIndex(ctx, schema, t, indexName, indexPartSpecifications, indexOption, ifNotExists)
+	}
+
 	// Build hidden columns if necessary.
 	hiddenCols, err := buildHiddenColumnInfoWithCheck(ctx, indexPartSpecifications, indexName, t.Meta(), t.Cols())
 	if err != nil {
@@ -7124,6 +7733,57 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	return errors.Trace(err)
 }
 
+// createFullTextIndex submits the job to create a FULLTEXT index, the arguments are checked by createIndex.
+func (d *ddl) createFullTextIndex(ctx sessionctx.Context, schema *model.DBInfo, t table.Table, indexName model.CIStr,
+	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) error {
+	tblInfo := t.Meta()
+	if err := checkTableSupportFullText(tblInfo); err != nil {
+		return errors.Trace(err)
+	}
+	// Check before the job is put to the queue, see createIndex.
+	if _, err := BuildFullTextIndexInfo(tblInfo.Columns, indexName, indexPartSpecifications, indexOption, model.StatePublic); err != nil {
+		return errors.Trace(err)
+	}
+	if indexOption != nil {
+		if _, err := validateCommentLength(ctx.GetSessionVars(), indexName.String(), &indexOption.Comment, dbterror.ErrTooLongIndexComment); err != nil {
+			return errors.Trace(err)
+		}
+		if indexOption.Tp == model.IndexTypeHypo {
+			return dbterror.ErrUnsupportedIndexType.GenWithStack("FULLTEXT hypothetical index is not supported")
+		}
+	}
+
+	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+	chs, coll := ctx.GetSessionVars().GetCharsetInfo()
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tblInfo.ID,
+		SchemaName: schema.Name.L,
+		TableName:  tblInfo.Name.L,
+		Type:       model.ActionAddIndex,
+		BinlogInfo: &model.HistoryInfo{},
+		ReorgMeta: &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+		},
+		Args:     []interface{}{false, indexName, indexPartSpecifications, indexOption, []*model.ColumnInfo(nil), false, true},
+		Priority: ctx.GetSessionVars().DDLReorgPriority,
+		Charset:  chs,
+		Collate:  coll,
+	}
+
+	err := d.DoDDLJob(ctx, job)
+	// key exists, but if_not_exists flags is true, so we ignore this error.
+	if dbterror.ErrDupKeyName.Equal(err) && ifNotExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
 func buildFKInfo(fkName model.CIStr, keys []*ast.IndexPartSpecification, refer *ast.ReferenceDef, cols []*table.Column) (*model.FKInfo, error) {
 	if len(keys) != len(refer.IndexPartSpecifications) {
 		return nil, infoschema.ErrForeignKeyNotMatch.GenWithStackByArgs(fkName, "Key reference and table reference don't match")
@@ -7533,6 +8193,19 @@ func BuildAddedPartitionInfo(ctx sessionctx.Context, meta *model.TableInfo, spec
 	if err != nil {
 		return nil, err
 	}
+	if meta.Partition.Sub != nil {
+		part.Sub = meta.Partition.Sub.Clone()
+		defs, err = buildSubPartitionDefinitions(part.Sub, spec.PartDefinitions, defs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, def := range spec.PartDefinitions {
+			if len(def.Sub) > 0 {
+				return nil, errors.Trace(ast.ErrPartitionWrongNoSubpart)
+			}
+		}
+	}
 
 	part.Definitions = defs
 	return part, nil
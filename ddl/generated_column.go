@@ -163,6 +163,10 @@ func hasDependentByGeneratedColumn(tblInfo *model.TableInfo, colName model.CIStr
 }
 
 func isGeneratedRelatedColumn(tblInfo *model.TableInfo, newCol, col *model.ColumnInfo) error {
+	if isVirtualToStoredGeneratedColumn(col, newCol) && !needChangeColumnTypeData(col, newCol) {
+		// Only the values of the generated column are written, the column type is not changed.
+		return nil
+	}
 	if newCol.IsGenerated() || col.IsGenerated() {
 		// TODO: Make it compatible with MySQL error.
 		msg := fmt.Sprintf("newCol IsGenerated %v, oldCol IsGenerated %v", newCol.IsGenerated(), col.IsGenerated())
@@ -192,7 +196,8 @@ func (c *generatedColumnChecker) Leave(inNode ast.Node) (node ast.Node, ok bool)
 
 // checkModifyGeneratedColumn checks the modification between
 // old and new is valid or not by such rules:
-//  1. the modification can't change stored status;
+//  1. the modification can't change stored status, except converting a generated column
+//     between VIRTUAL and STORED with the same expression;
 //  2. if the new is generated, check its refer rules.
 //  3. check if the modified expr contains non-deterministic functions
 //  4. check whether new column refers to any auto-increment columns.
@@ -201,7 +206,7 @@ func checkModifyGeneratedColumn(sctx sessionctx.Context, schemaName model.CIStr,
 	// rule 1.
 	oldColIsStored := !oldCol.IsGenerated() || oldCol.GeneratedStored
 	newColIsStored := !newCol.IsGenerated() || newCol.GeneratedStored
-	if oldColIsStored != newColIsStored {
+	if oldColIsStored != newColIsStored && !isGeneratedColumnStoredStatusChange(oldCol, newCol) {
 		return dbterror.ErrUnsupportedOnGeneratedColumn.GenWithStackByArgs("Changing the STORED status")
 	}
 
@@ -275,6 +280,19 @@ func checkModifyGeneratedColumn(sctx sessionctx.Context, schemaName model.CIStr,
 	return nil
 }
 
+// isVirtualToStoredGeneratedColumn returns whether the modification converts a virtual generated column to a stored one.
+func isVirtualToStoredGeneratedColumn(oldCol, newCol *model.ColumnInfo) bool {
+	return oldCol.IsGenerated() && !oldCol.GeneratedStored && newCol.IsGenerated() && newCol.GeneratedStored
+}
+
+// isGeneratedColumnStoredStatusChange returns whether the modification only converts a generated column
+// from VIRTUAL to STORED or the reverse, the former needs to backfill the values of the column.
+func isGeneratedColumnStoredStatusChange(oldCol, newCol *table.Column) bool {
+	return oldCol.IsGenerated() && newCol.IsGenerated() &&
+		oldCol.GeneratedStored != newCol.GeneratedStored &&
+		oldCol.GeneratedExprString == newCol.GeneratedExprString
+}
+
 type illegalFunctionChecker struct {
 	hasIllegalFunc        bool
 	hasAggFunc            bool
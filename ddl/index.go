@@ -54,6 +54,7 @@ import (
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/logutil"
 	decoder "github.com/pingcap/tidb/util/rowDecoder"
 	"github.com/prometheus/client_golang/prometheus"
@@ -177,6 +178,14 @@ func checkIndexColumn(ctx sessionctx.Context, col *model.ColumnInfo, indexColumn
 		return errors.Trace(dbterror.ErrJSONUsedAsKey.GenWithStackByArgs(col.Name.O))
 	}
 
+	// GEOMETRY column cannot index, since SPATIAL index is not supported yet.
+	if col.FieldType.GetType() == mysql.TypeGeometry {
+		if col.Hidden {
+			return dbterror.ErrFunctionalIndexOnJSONOrGeometryFunction
+		}
+		return errors.Trace(dbterror.ErrUnsupportedIndexType.GenWithStack("index on GEOMETRY column is not supported"))
+	}
+
 	// Length must be specified and non-zero for BLOB and TEXT column indexes.
 	if types.IsTypeBlob(col.FieldType.GetType()) {
 		if indexColumnLen == types.UnspecifiedLength {
@@ -314,6 +323,83 @@ func BuildIndexInfo(
 	return idxInfo, nil
 }
 
+// BuildFullTextIndexInfo builds a new IndexInfo of a FULLTEXT index according to the index information.
+func BuildFullTextIndexInfo(
+	allTableColumns []*model.ColumnInfo,
+	indexName model.CIStr,
+	indexPartSpecifications []*ast.IndexPartSpecification,
+	indexOption *ast.IndexOption,
+	state model.SchemaState,
+) (*model.IndexInfo, error) {
+	if err := checkTooLongIndex(indexName); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	idxColumns, err := buildFullTextIndexColumns(allTableColumns, indexPartSpecifications)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	idxInfo := &model.IndexInfo{
+		Name:     indexName,
+		Columns:  idxColumns,
+		State:    state,
+		Tp:       model.IndexTypeBtree,
+		FullText: true,
+	}
+	if indexOption != nil {
+		if !fulltext.IsSupportedParser(indexOption.ParserName.L) {
+			return nil, dbterror.ErrFtParserNotDefined.GenWithStackByArgs(indexOption.ParserName.O)
+		}
+		idxInfo.ParserName = indexOption.ParserName
+		idxInfo.Comment = indexOption.Comment
+		idxInfo.Invisible = indexOption.Visibility == ast.IndexVisibilityInvisible
+	}
+	return idxInfo, nil
+}
+
+// buildFullTextIndexColumns builds the columns of a FULLTEXT index. The columns must be
+// CHAR, VARCHAR or TEXT columns in the same collation, and cannot have a prefix length.
+func buildFullTextIndexColumns(columns []*model.ColumnInfo, indexPartSpecifications []*ast.IndexPartSpecification) ([]*model.IndexColumn, error) {
+	idxParts := make([]*model.IndexColumn, 0, len(indexPartSpecifications))
+	for _, ip := range indexPartSpecifications {
+		if ip.Expr != nil {
+			return nil, dbterror.ErrFulltextFunctionalIndex
+		}
+		col := model.FindColumnInfo(columns, ip.Column.Name.L)
+		if col == nil {
+			return nil, dbterror.ErrKeyColumnDoesNotExits.GenWithStack("column does not exist: %s", ip.Column.Name)
+		}
+		tp := col.GetType()
+		if !(types.IsTypeChar(tp) || types.IsTypeVarchar(tp) || types.IsTypeBlob(tp)) || col.GetCharset() == charset.CharsetBin {
+			return nil, dbterror.ErrBadFtColumn.GenWithStackByArgs(col.Name.O)
+		}
+		if len(idxParts) > 0 && columns[idxParts[0].Offset].GetCollate() != col.GetCollate() {
+			return nil, dbterror.ErrBadFtColumn.GenWithStackByArgs(col.Name.O)
+		}
+		if ip.Length != types.UnspecifiedLength {
+			return nil, errors.Trace(dbterror.ErrIncorrectPrefixKey)
+		}
+		idxParts = append(idxParts, &model.IndexColumn{
+			Name:   col.Name,
+			Offset: col.Offset,
+			Length: types.UnspecifiedLength,
+		})
+	}
+	return idxParts, nil
+}
+
+// checkTableSupportFullText checks whether FULLTEXT indexes can be created on the table.
+func checkTableSupportFullText(tblInfo *model.TableInfo) error {
+	if tblInfo.GetPartitionInfo() != nil {
+		return dbterror.ErrFulltextNotSupportedWithPartitioning
+	}
+	if tblInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrInnodbNoFtTempTable
+	}
+	return nil
+}
+
 // AddIndexColumnFlag aligns the column flags of columns in TableInfo to IndexInfo.
 func AddIndexColumnFlag(tblInfo *model.TableInfo, indexInfo *model.IndexInfo) {
 	if indexInfo.Primary {
@@ -533,6 +619,7 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 	var (
 		unique                  bool
 		global                  bool
+		fullText                bool
 		indexName               model.CIStr
 		indexPartSpecifications []*ast.IndexPartSpecification
 		indexOption             *ast.IndexOption
@@ -544,7 +631,7 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 		// Notice: sqlMode and warnings is used to support non-strict mode.
 		err = job.DecodeArgs(&unique, &indexName, &indexPartSpecifications, &indexOption, &sqlMode, &warnings, &global)
 	} else {
-		err = job.DecodeArgs(&unique, &indexName, &indexPartSpecifications, &indexOption, &hiddenCols, &global)
+		err = job.DecodeArgs(&unique, &indexName, &indexPartSpecifications, &indexOption, &hiddenCols, &global, &fullText)
 	}
 	if err != nil {
 		job.State = model.JobStateCancelled
@@ -583,17 +670,21 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 			job.State = model.JobStateCancelled
 			return ver, errors.Trace(err)
 		}
-		indexInfo, err = BuildIndexInfo(
-			nil,
-			tblInfo.Columns,
-			indexName,
-			isPK,
-			unique,
-			global,
-			indexPartSpecifications,
-			indexOption,
-			model.StateNone,
-		)
+		if fullText {
+			indexInfo, err = BuildFullTextIndexInfo(tblInfo.Columns, indexName, indexPartSpecifications, indexOption, model.StateNone)
+		} else {
+			indexInfo, err = BuildIndexInfo(
+				nil,
+				tblInfo.Columns,
+				indexName,
+				isPK,
+				unique,
+				global,
+				indexPartSpecifications,
+				indexOption,
+				model.StateNone,
+			)
+		}
 		if err != nil {
 			job.State = model.JobStateCancelled
 			return ver, errors.Trace(err)
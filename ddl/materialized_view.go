@@ -0,0 +1,657 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	sess "github.com/pingcap/tidb/ddl/internal/session"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/opcode"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/sessiontxn"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// materializedViewRefreshBatchSize is the number of keys recomputed by a statement in an incremental refresh.
+	materializedViewRefreshBatchSize = 256
+)
+
+// BuildMaterializedViewTableInfo builds the TableInfo of the table storing the result of a materialized view.
+// The columns of the table are decided by the planner, see ast.CreateMaterializedViewStmt.ColTypes.
+func BuildMaterializedViewTableInfo(ctx sessionctx.Context, is infoschema.InfoSchema, s *ast.CreateMaterializedViewStmt) (*model.TableInfo, error) {
+	// Always Use `format.RestoreNameBackQuotes` to restore `SELECT` statement despite the `ANSI_QUOTES` SQL Mode is enabled or not.
+	restoreFlag := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameBackQuotes
+	var sb strings.Builder
+	if err := s.Select.Restore(format.NewRestoreCtx(restoreFlag, &sb)); err != nil {
+		return nil, err
+	}
+	mvInfo := &model.MaterializedViewInfo{SelectStmt: sb.String(), RefreshMode: s.RefreshMode}
+	if s.RefreshMode == model.MaterializedViewRefreshIncremental {
+		var err error
+		mvInfo.KeyColumns, mvInfo.BaseKeyColumns, err = checkIncrementalMaterializedView(is, s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(s.Cols) != len(s.ColTypes) {
+		return nil, dbterror.ErrViewWrongList
+	}
+	cols := make([]*table.Column, len(s.Cols))
+	colInfos := make([]*model.ColumnInfo, len(s.Cols))
+	for i, name := range s.Cols {
+		tp := s.ColTypes[i].Clone()
+		tp.DelFlag(mysql.PriKeyFlag | mysql.UniqueKeyFlag | mysql.MultipleKeyFlag | mysql.AutoIncrementFlag | mysql.OnUpdateNowFlag)
+		if tp.GetType() == mysql.TypeNull {
+			// Like CREATE TABLE ... SELECT NULL in MySQL.
+			tp = types.NewFieldType(mysql.TypeString)
+			tp.SetFlen(0)
+			tp.SetCharset(mysql.DefaultCharset)
+			tp.SetCollate(mysql.DefaultCollationName)
+		}
+		colInfos[i] = &model.ColumnInfo{
+			Name:      name,
+			Offset:    i,
+			State:     model.StatePublic,
+			Version:   model.CurrLatestColumnInfoVersion,
+			FieldType: *tp,
+		}
+		cols[i] = table.ToColumn(colInfos[i])
+	}
+	if err := checkDuplicateColumn(colInfos); err != nil {
+		return nil, err
+	}
+
+	tblCharset := ""
+	tblCollate := ""
+	if v, ok := ctx.GetSessionVars().GetSystemVar(variable.CharacterSetConnection); ok {
+		tblCharset = v
+	}
+	if v, ok := ctx.GetSessionVars().GetSystemVar(variable.CollationConnection); ok {
+		tblCollate = v
+	}
+	tbInfo, err := BuildTableInfo(ctx, s.ViewName.Name, cols, nil, tblCharset, tblCollate)
+	if err != nil {
+		return nil, err
+	}
+	tbInfo.MaterializedView = mvInfo
+	return tbInfo, nil
+}
+
+// checkIncrementalMaterializedView checks whether the materialized view can be refreshed incrementally.
+// It returns the key columns of the view and the corresponding columns of the base table, every change
+// of the base table only affects the rows of the view with the same keys as the changed rows.
+// Only the views reading a single table without joins, subqueries, windows and limit are supported. And
+//   - for the aggregation, all the GROUP BY items must be the columns in the output of the view.
+//   - otherwise, the integer primary key of the table must be in the output of the view.
+func checkIncrementalMaterializedView(is infoschema.InfoSchema, s *ast.CreateMaterializedViewStmt) (keyCols, baseKeyCols []model.CIStr, err error) {
+	unsupported := func(reason string) error {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("incremental refresh of materialized view " + reason)
+	}
+	sel, ok := s.Select.(*ast.SelectStmt)
+	if !ok || sel.With != nil {
+		return nil, nil, unsupported("with set operations or CTE")
+	}
+	if sel.Distinct || sel.Limit != nil || sel.WindowSpecs != nil || sel.LockInfo != nil {
+		return nil, nil, unsupported("with DISTINCT, LIMIT, WINDOW or locking clause")
+	}
+	if sel.From == nil || sel.From.TableRefs.Right != nil {
+		return nil, nil, unsupported("without exactly one table")
+	}
+	ts, ok := sel.From.TableRefs.Left.(*ast.TableSource)
+	if !ok {
+		return nil, nil, unsupported("without exactly one table")
+	}
+	tn, ok := ts.Source.(*ast.TableName)
+	if !ok {
+		return nil, nil, unsupported("reading a derived table")
+	}
+	tbl, err := is.TableByName(tn.Schema, tn.Name)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	baseInfo := tbl.Meta()
+	if !baseInfo.IsBaseTable() || baseInfo.IsMaterializedView() || baseInfo.TempTableType != model.TempTableNone ||
+		baseInfo.Partition != nil || baseInfo.IsCommonHandle {
+		return nil, nil, unsupported("reading a table which is not a non-partitioned table with an integer handle")
+	}
+	checker := &incrementalMaterializedViewChecker{}
+	sel.Accept(checker)
+	if checker.unsupported {
+		return nil, nil, unsupported("with subqueries or window functions")
+	}
+
+	for _, field := range sel.Fields.Fields {
+		if field.WildCard != nil {
+			return nil, nil, unsupported("with wildcard in the select fields")
+		}
+	}
+	// outputColumn returns the offset of the column in the output of the view.
+	outputColumn := func(name *ast.ColumnName) int {
+		for i, field := range sel.Fields.Fields {
+			if c, ok := field.Expr.(*ast.ColumnNameExpr); ok && c.Name.Name.L == name.Name.L {
+				return i
+			}
+		}
+		return -1
+	}
+	if sel.GroupBy != nil {
+		for _, item := range sel.GroupBy.Items {
+			c, ok := item.Expr.(*ast.ColumnNameExpr)
+			if !ok {
+				return nil, nil, unsupported("grouping by expressions")
+			}
+			offset := outputColumn(c.Name)
+			if offset < 0 {
+				return nil, nil, unsupported("grouping by columns which are not in the output")
+			}
+			keyCols = append(keyCols, s.Cols[offset])
+			baseKeyCols = append(baseKeyCols, c.Name.Name)
+		}
+		return keyCols, baseKeyCols, nil
+	}
+	if checker.hasAggregation {
+		return nil, nil, unsupported("with aggregation but without GROUP BY")
+	}
+	if !baseInfo.PKIsHandle {
+		return nil, nil, unsupported("reading a table without an integer primary key")
+	}
+	pkCol := baseInfo.GetPkColInfo()
+	offset := outputColumn(&ast.ColumnName{Name: pkCol.Name})
+	if offset < 0 {
+		return nil, nil, unsupported("without the primary key in the output")
+	}
+	return []model.CIStr{s.Cols[offset]}, []model.CIStr{pkCol.Name}, nil
+}
+
+type incrementalMaterializedViewChecker struct {
+	hasAggregation bool
+	unsupported    bool
+}
+
+// Enter implements ast.Visitor interface.
+func (c *incrementalMaterializedViewChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch in.(type) {
+	case *ast.AggregateFuncExpr:
+		c.hasAggregation = true
+	case *ast.SubqueryExpr, *ast.WindowFuncExpr:
+		c.unsupported = true
+		return in, true
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (*incrementalMaterializedViewChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// onRefreshMaterializedView refreshes a materialized view in two steps.
+// In the first step, the change log is registered on the base table of an incrementally refreshed view.
+// In the second step, the view is recomputed in a transaction which also consumes the change log.
+func (w *worker) onRefreshMaterializedView(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var complete bool
+	if err := job.DecodeArgs(&complete); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	mvInfo := tblInfo.MaterializedView
+	if mvInfo == nil {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrWrongObject.GenWithStackByArgs(job.SchemaName, tblInfo.Name.O, "MATERIALIZED VIEW")
+	}
+
+	switch job.SchemaState {
+	case model.StateNone:
+		// none -> write only
+		var multiInfos []schemaIDAndTableInfo
+		if mvInfo.RefreshMode == model.MaterializedViewRefreshIncremental {
+			base, err := getMaterializedViewBaseTable(d, t, mvInfo)
+			if err != nil {
+				job.State = model.JobStateCancelled
+				return ver, errors.Trace(err)
+			}
+			if base.tblInfo.ID != mvInfo.BaseTableID {
+				// The change log since the last refresh is written to another table, or it's the first refresh.
+				mvInfo.BaseSchemaID, mvInfo.BaseTableID = base.schemaID, base.tblInfo.ID
+				mvInfo.LastRefreshTS = 0
+			}
+			if !slices.Contains(base.tblInfo.MaterializedViewLogs, tblInfo.ID) {
+				base.tblInfo.MaterializedViewLogs = append(base.tblInfo.MaterializedViewLogs, tblInfo.ID)
+				multiInfos = append(multiInfos, base)
+			}
+		}
+		job.SchemaState = model.StateWriteOnly
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true, multiInfos...)
+		return ver, errors.Trace(err)
+	case model.StateWriteOnly:
+		// write only -> public
+		complete = complete || mvInfo.RefreshMode == model.MaterializedViewRefreshComplete || mvInfo.LastRefreshTS == 0
+		refreshTS, err := w.refreshMaterializedView(job.SchemaID, job.SchemaName, tblInfo, complete)
+		if err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+		mvInfo.LastRefreshTS = refreshTS
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+		return ver, nil
+	default:
+		return ver, dbterror.ErrInvalidDDLState.GenWithStackByArgs("materialized view", job.SchemaState)
+	}
+}
+
+// parseMaterializedViewSelect parses the definition of a materialized view,
+// it returns the table read by the view if the view is refreshed incrementally.
+func parseMaterializedViewSelect(mvInfo *model.MaterializedViewInfo) (ast.StmtNode, *ast.TableName, error) {
+	stmt, err := parser.New().ParseOneStmt(mvInfo.SelectStmt, "", "")
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if mvInfo.RefreshMode != model.MaterializedViewRefreshIncremental {
+		return stmt, nil, nil
+	}
+	// The definition has been checked by checkIncrementalMaterializedView.
+	tn := stmt.(*ast.SelectStmt).From.TableRefs.Left.(*ast.TableSource).Source.(*ast.TableName)
+	return stmt, tn, nil
+}
+
+// getMaterializedViewBaseTable gets the table currently read by an incrementally refreshed materialized view.
+func getMaterializedViewBaseTable(d *ddlCtx, t *meta.Meta, mvInfo *model.MaterializedViewInfo) (schemaIDAndTableInfo, error) {
+	_, tn, err := parseMaterializedViewSelect(mvInfo)
+	if err != nil {
+		return schemaIDAndTableInfo{}, err
+	}
+	is := d.infoCache.GetLatest()
+	schema, ok := is.SchemaByName(tn.Schema)
+	if !ok {
+		return schemaIDAndTableInfo{}, infoschema.ErrDatabaseNotExists.GenWithStackByArgs(tn.Schema)
+	}
+	tbl, err := is.TableByName(tn.Schema, tn.Name)
+	if err != nil {
+		return schemaIDAndTableInfo{}, errors.Trace(err)
+	}
+	tblInfo, err := t.GetTable(schema.ID, tbl.Meta().ID)
+	if err != nil {
+		return schemaIDAndTableInfo{}, errors.Trace(err)
+	}
+	if tblInfo == nil {
+		return schemaIDAndTableInfo{}, infoschema.ErrTableNotExists.GenWithStackByArgs(tn.Schema, tn.Name)
+	}
+	return schemaIDAndTableInfo{schemaID: schema.ID, tblInfo: tblInfo}, nil
+}
+
+// unregisterMaterializedViewLog stops writing the change log of a dropped materialized view,
+// it returns the base table to update.
+func unregisterMaterializedViewLog(t *meta.Meta, tblInfo *model.TableInfo) ([]schemaIDAndTableInfo, error) {
+	mvInfo := tblInfo.MaterializedView
+	if mvInfo == nil || mvInfo.BaseTableID == 0 {
+		return nil, nil
+	}
+	base, err := t.GetTable(mvInfo.BaseSchemaID, mvInfo.BaseTableID)
+	if meta.ErrDBNotExists.Equal(err) {
+		return nil, nil
+	}
+	if err != nil || base == nil {
+		return nil, errors.Trace(err)
+	}
+	idx := slices.Index(base.MaterializedViewLogs, tblInfo.ID)
+	if idx < 0 {
+		return nil, nil
+	}
+	base.MaterializedViewLogs = slices.Delete(base.MaterializedViewLogs, idx, idx+1)
+	if len(base.MaterializedViewLogs) == 0 {
+		base.MaterializedViewLogs = nil
+	}
+	return []schemaIDAndTableInfo{{schemaID: mvInfo.BaseSchemaID, tblInfo: base}}, nil
+}
+
+// refreshMaterializedView recomputes the materialized view and consumes its change log.
+// It returns the ts as of which the view is recomputed.
+func (w *worker) refreshMaterializedView(schemaID int64, schemaName string, tblInfo *model.TableInfo, complete bool) (uint64, error) {
+	sctx, err := w.sessPool.Get()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer w.sessPool.Put(sctx)
+	ctx := kv.WithInternalSourceType(w.ctx, kv.InternalTxnDDL)
+	if complete {
+		return w.refreshMaterializedViewCompletely(ctx, sctx, schemaID, schemaName, tblInfo)
+	}
+	mvInfo := tblInfo.MaterializedView
+	stmt, tn, err := parseMaterializedViewSelect(mvInfo)
+	if err != nil {
+		return 0, err
+	}
+
+	var refreshTS uint64
+	err = sess.NewSession(sctx).RunInTxn(func(se *sess.Session) error {
+		txn, err := se.Txn()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		refreshTS = txn.StartTS()
+		logs, err := loadMaterializedViewLogs(txn, tblInfo.ID)
+		if err != nil {
+			return err
+		}
+		if len(logs) > 0 {
+			keys, err := collectMaterializedViewKeys(ctx, se, mvInfo, tn, logs)
+			if err != nil {
+				return err
+			}
+			sel := stmt.(*ast.SelectStmt)
+			where := sel.Where
+			for start := 0; start < len(keys); start += materializedViewRefreshBatchSize {
+				end := start + materializedViewRefreshBatchSize
+				if end > len(keys) {
+					end = len(keys)
+				}
+				batch := keys[start:end]
+				cond, err := buildMaterializedViewKeyCond(mvInfo.KeyColumns, batch)
+				if err != nil {
+					return err
+				}
+				sql := sqlexec.MustEscapeSQL("DELETE FROM %n.%n WHERE ", schemaName, tblInfo.Name.O) + cond
+				if _, err = se.Execute(ctx, sql, "refresh_materialized_view"); err != nil {
+					return err
+				}
+				// Recompute the rows with the keys by adding the condition on the key columns of the base table.
+				if cond, err = buildMaterializedViewKeyCond(mvInfo.BaseKeyColumns, batch); err != nil {
+					return err
+				}
+				condStmt, err := parser.New().ParseOneStmt("SELECT 1 FROM DUAL WHERE "+cond, "", "")
+				if err != nil {
+					return errors.Trace(err)
+				}
+				sel.Where = &ast.ParenthesesExpr{Expr: condStmt.(*ast.SelectStmt).Where}
+				if where != nil {
+					sel.Where = &ast.BinaryOperationExpr{Op: opcode.LogicAnd, L: &ast.ParenthesesExpr{Expr: where}, R: sel.Where}
+				}
+				var sb strings.Builder
+				restoreFlag := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameBackQuotes
+				if err = sel.Restore(format.NewRestoreCtx(restoreFlag, &sb)); err != nil {
+					return errors.Trace(err)
+				}
+				if _, err = se.Execute(ctx, sqlexec.MustEscapeSQL("INSERT INTO %n.%n ", schemaName, tblInfo.Name.O)+sb.String(), "refresh_materialized_view"); err != nil {
+					return err
+				}
+			}
+		}
+		for _, log := range logs {
+			if err = txn.Delete(log.key); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	})
+	return refreshTS, errors.Trace(err)
+}
+
+// refreshMaterializedViewCompletely recomputes the whole materialized view. The rows of the view are
+// deleted and the result of its definition is inserted in the transaction which consumes the change log,
+// so queries reading the view never see it empty or partially refreshed. It returns the start ts of the
+// transaction, as of which the definition is read. The view must fit in a transaction.
+func (w *worker) refreshMaterializedViewCompletely(ctx context.Context, sctx sessionctx.Context, schemaID int64, schemaName string, tblInfo *model.TableInfo) (uint64, error) {
+	tbl, err := getTable(w.store, schemaID, tblInfo)
+	if err != nil {
+		return 0, err
+	}
+
+	var refreshTS uint64
+	err = sess.NewSession(sctx).RunInTxn(func(se *sess.Session) error {
+		txn, err := se.Txn()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		refreshTS = txn.StartTS()
+		logs, err := loadMaterializedViewLogs(txn, tblInfo.ID)
+		if err != nil {
+			return err
+		}
+		if _, err = se.Execute(ctx, sqlexec.MustEscapeSQL("DELETE FROM %n.%n", schemaName, tblInfo.Name.O), "refresh_materialized_view"); err != nil {
+			return err
+		}
+		rs, err := se.Session().(sqlexec.SQLExecutor).ExecuteInternal(ctx, tblInfo.MaterializedView.SelectStmt)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer terror.Call(rs.Close)
+		fields := rs.Fields()
+		fieldTps := make([]*types.FieldType, 0, len(fields))
+		for _, field := range fields {
+			fieldTps = append(fieldTps, &field.Column.FieldType)
+		}
+		cols := tbl.Cols()
+		if len(cols) != len(fieldTps) {
+			return dbterror.ErrViewWrongList
+		}
+		chk := rs.NewChunk(nil)
+		for {
+			if err = rs.Next(ctx, chk); err != nil {
+				return errors.Trace(err)
+			}
+			if chk.NumRows() == 0 {
+				break
+			}
+			for i := 0; i < chk.NumRows(); i++ {
+				row := chk.GetRow(i).GetDatumRow(fieldTps)
+				for j, col := range cols {
+					if row[j], err = table.CastValue(se.Session(), row[j], col.ColumnInfo, false, false); err != nil {
+						return err
+					}
+				}
+				if _, err = tbl.AddRecord(se.Session(), row); err != nil {
+					return err
+				}
+			}
+		}
+		for _, log := range logs {
+			if err = txn.Delete(log.key); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	})
+	return refreshTS, errors.Trace(err)
+}
+
+type materializedViewLog struct {
+	key    kv.Key
+	handle int64
+	oldRow []byte
+}
+
+// loadMaterializedViewLogs loads the change log of the materialized view.
+func loadMaterializedViewLogs(txn kv.Transaction, mvID int64) ([]materializedViewLog, error) {
+	prefix := tablecodec.EncodeMaterializedViewLogPrefix(mvID)
+	iter, err := txn.Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer iter.Close()
+	var logs []materializedViewLog
+	for iter.Valid() && iter.Key().HasPrefix(prefix) {
+		_, _, handle, err := tablecodec.DecodeMaterializedViewLogKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		log := materializedViewLog{key: iter.Key().Clone(), handle: handle}
+		if value := iter.Value(); !slices.Equal(value, tables.MaterializedViewLogNoRow) {
+			log.oldRow = slices.Clone(value)
+		}
+		logs = append(logs, log)
+		if err = iter.Next(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return logs, nil
+}
+
+// collectMaterializedViewKeys collects the keys of the rows of the base table before and after the changes in the log.
+func collectMaterializedViewKeys(ctx context.Context, se *sess.Session, mvInfo *model.MaterializedViewInfo, tn *ast.TableName, logs []materializedViewLog) ([][]types.Datum, error) {
+	tbl, err := sessiontxn.GetTxnManager(se.Session()).GetTxnInfoSchema().TableByName(tn.Schema, tn.Name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	baseInfo := tbl.Meta()
+	keyCols := make([]*model.ColumnInfo, 0, len(mvInfo.BaseKeyColumns))
+	colTps := make(map[int64]*types.FieldType, len(mvInfo.BaseKeyColumns))
+	for _, name := range mvInfo.BaseKeyColumns {
+		col := model.FindColumnInfo(baseInfo.Columns, name.L)
+		if col == nil {
+			return nil, infoschema.ErrColumnNotExists.GenWithStackByArgs(name, baseInfo.Name)
+		}
+		keyCols = append(keyCols, col)
+		colTps[col.ID] = &col.FieldType
+	}
+
+	var keys [][]types.Datum
+	seen := make(map[string]struct{})
+	addKey := func(key []types.Datum) error {
+		cond, err := buildMaterializedViewKeyCond(mvInfo.BaseKeyColumns, [][]types.Datum{key})
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[cond]; !ok {
+			seen[cond] = struct{}{}
+			keys = append(keys, key)
+		}
+		return nil
+	}
+	// The keys of the rows before the changes.
+	handles := make([]int64, 0, len(logs))
+	for _, log := range logs {
+		handles = append(handles, log.handle)
+		if log.oldRow == nil {
+			continue
+		}
+		row, err := tablecodec.DecodeRowToDatumMap(log.oldRow, colTps, se.GetSessionVars().Location())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		key := make([]types.Datum, len(keyCols))
+		for i, col := range keyCols {
+			key[i] = row[col.ID]
+		}
+		if err = addKey(key); err != nil {
+			return nil, err
+		}
+	}
+	// The keys of the rows after the changes.
+	handleCol := model.ExtraHandleName.O
+	if baseInfo.PKIsHandle {
+		handleCol = baseInfo.GetPkColInfo().Name.O
+	}
+	var sb strings.Builder
+	for start := 0; start < len(handles); start += materializedViewRefreshBatchSize {
+		end := start + materializedViewRefreshBatchSize
+		if end > len(handles) {
+			end = len(handles)
+		}
+		sb.Reset()
+		sqlexec.MustFormatSQL(&sb, "SELECT ")
+		for i, col := range keyCols {
+			if i > 0 {
+				sqlexec.MustFormatSQL(&sb, ",")
+			}
+			sqlexec.MustFormatSQL(&sb, "%n", col.Name.O)
+		}
+		sqlexec.MustFormatSQL(&sb, " FROM %n.%n WHERE %n IN (", tn.Schema.O, tn.Name.O, handleCol)
+		for i, handle := range handles[start:end] {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteString(strconv.FormatInt(handle, 10))
+		}
+		sb.WriteString(")")
+		rows, err := se.Execute(ctx, sb.String(), "refresh_materialized_view")
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			key := make([]types.Datum, len(keyCols))
+			for i, col := range keyCols {
+				key[i] = row.GetDatum(i, &col.FieldType)
+			}
+			if err = addKey(key); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return keys, nil
+}
+
+// buildMaterializedViewKeyCond builds the condition to filter the rows with the keys.
+func buildMaterializedViewKeyCond(cols []model.CIStr, keys [][]types.Datum) (string, error) {
+	var sb strings.Builder
+	for i, key := range keys {
+		if i > 0 {
+			sb.WriteString(" OR ")
+		}
+		sb.WriteString("(")
+		for j, col := range cols {
+			if j > 0 {
+				sb.WriteString(" AND ")
+			}
+			var arg interface{}
+			switch key[j].Kind() {
+			case types.KindNull:
+			case types.KindInt64:
+				arg = key[j].GetInt64()
+			case types.KindUint64:
+				arg = key[j].GetUint64()
+			case types.KindFloat32, types.KindFloat64:
+				arg = key[j].GetFloat64()
+			default:
+				s, err := key[j].ToString()
+				if err != nil {
+					return "", errors.Trace(err)
+				}
+				arg = s
+			}
+			sqlexec.MustFormatSQL(&sb, "%n <=> %?", col.O, arg)
+		}
+		sb.WriteString(")")
+	}
+	return sb.String(), nil
+}
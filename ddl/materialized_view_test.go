@@ -0,0 +1,207 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pingcap/tidb/ddl/util/callback"
+	"github.com/pingcap/tidb/domain"
+	mysql "github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaterializedViewCompleteRefresh(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (1, 2), (2, 3)")
+
+	tk.MustExec("create materialized view mv as select a, sum(b) as s from t group by a")
+	tk.MustQuery("select * from mv order by a").Check(testkit.Rows("1 3", "2 3"))
+	tk.MustGetErrCode("create materialized view mv as select a from t", mysql.ErrTableExists)
+	tk.MustExec("create materialized view if not exists mv as select a from t")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1050 Table 'test.mv' already exists"))
+	tk.MustGetErrCode("create materialized view mv2 (x) as select a, b from t", mysql.ErrViewWrongList)
+
+	tk.MustExec("insert into t values (3, 4)")
+	tk.MustQuery("select * from mv order by a").Check(testkit.Rows("1 3", "2 3"))
+	tk.MustExec("refresh materialized view mv")
+	tk.MustQuery("select * from mv order by a").Check(testkit.Rows("1 3", "2 3", "3 4"))
+	tk.MustQuery("show create table mv").Check(testkit.Rows(
+		"mv CREATE MATERIALIZED VIEW `mv` (`a`, `s`) REFRESH COMPLETE AS SELECT `a` AS `a`,SUM(`b`) AS `s` FROM `test`.`t` GROUP BY `a`"))
+
+	// The materialized view can only be changed by the refresh.
+	tk.MustGetErrCode("insert into mv values (4, 4)", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("replace into mv values (4, 4)", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("update mv set s = 0", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("delete from mv", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("truncate table mv", mysql.ErrWrongObject)
+	tk.MustGetErrCode("drop table mv", mysql.ErrWrongObject)
+	tk.MustGetErrCode("drop view mv", mysql.ErrWrongObject)
+	tk.MustGetErrCode("drop materialized view t", mysql.ErrWrongObject)
+	tk.MustGetErrCode("refresh materialized view t", mysql.ErrWrongObject)
+
+	// A complete refresh is done in a transaction, queries never see the view empty or partially refreshed.
+	tk.MustExec("set @@cte_max_recursion_depth = 2500")
+	tk.MustExec("insert into t select a + 10, b from (with recursive c (a, b) as (select 1, 1 union all select a + 1, b from c where a < 2500) select * from c) c")
+	dom := domain.GetDomain(tk.Session())
+	originHook := dom.DDL().GetHook()
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test")
+	var wg sync.WaitGroup
+	var refreshed atomic.Bool
+	var counts []string
+	hook := &callback.TestDDLCallback{Do: dom}
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if job.Type != model.ActionRefreshMaterializedView || job.SchemaState != model.StateWriteOnly {
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !refreshed.Load() {
+				counts = append(counts, tk1.MustQuery("select count(*) from mv").Rows()[0][0].(string))
+			}
+		}()
+	}
+	dom.DDL().SetHook(hook)
+	tk.MustExec("refresh materialized view mv")
+	refreshed.Store(true)
+	wg.Wait()
+	dom.DDL().SetHook(originHook)
+	for _, count := range counts {
+		require.Contains(t, []string{"3", "2503"}, count)
+	}
+	tk.MustQuery("select count(*), sum(s) from mv").Check(testkit.Rows("2503 2510"))
+	tk.MustExec("delete from t where a > 1000")
+	tk.MustExec("refresh materialized view mv")
+	tk.MustQuery("select count(*), sum(s) from mv").Check(testkit.Rows("993 1000"))
+
+	tk.MustExec("drop materialized view mv")
+	tk.MustGetErrCode("select * from mv", mysql.ErrNoSuchTable)
+	tk.MustExec("drop materialized view if exists mv")
+}
+
+func TestMaterializedViewIncrementalRefresh(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int, b int)")
+	tk.MustExec("insert into t values (1, 1, 10), (2, 1, 20), (3, 2, 30)")
+
+	tk.MustExec("create materialized view mv1 refresh incremental as select a, count(*) as c, sum(b) as s from t group by a")
+	tk.MustExec("create materialized view mv2 refresh incremental as select id, a, b from t where b > 15")
+	tk.MustQuery("select * from mv1 order by a").Check(testkit.Rows("1 2 30", "2 1 30"))
+	tk.MustQuery("select * from mv2 order by id").Check(testkit.Rows("2 1 20", "3 2 30"))
+	mv1ID := getMaterializedViewID(t, dom, "mv1")
+	mv2ID := getMaterializedViewID(t, dom, "mv2")
+	require.ElementsMatch(t, []int64{mv1ID, mv2ID}, getMaterializedViewID4Logs(t, dom, "t"))
+
+	checkRefresh := func() {
+		tk.MustExec("refresh materialized view mv1")
+		tk.MustExec("refresh materialized view mv2")
+		tk.MustQuery("select * from mv1 order by a").Check(
+			tk.MustQuery("select a, count(*), sum(b) from t group by a order by a").Rows())
+		tk.MustQuery("select * from mv2 order by id").Check(
+			tk.MustQuery("select id, a, b from t where b > 15 order by id").Rows())
+	}
+	tk.MustExec("insert into t values (4, 3, 5), (5, 2, 16)")
+	checkRefresh()
+	// Move a row to another group and out of the filter.
+	tk.MustExec("update t set a = 3, b = 1 where id = 2")
+	checkRefresh()
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (6, 4, 40)")
+	tk.MustExec("delete from t where id = 6")
+	tk.MustExec("delete from t where a = 2")
+	tk.MustExec("commit")
+	checkRefresh()
+	tk.MustQuery("select * from mv1 order by a").Check(testkit.Rows("1 1 10", "3 2 6"))
+
+	// The refresh of the dropped view isn't needed anymore.
+	tk.MustExec("drop materialized view mv1")
+	require.Equal(t, []int64{mv2ID}, getMaterializedViewID4Logs(t, dom, "t"))
+
+	// The change log is lost after the base table is truncated, so a complete refresh is done.
+	tk.MustExec("truncate table t")
+	tk.MustExec("insert into t values (7, 7, 70)")
+	tk.MustExec("refresh materialized view mv2")
+	tk.MustQuery("select * from mv2").Check(testkit.Rows("7 7 70"))
+	require.Equal(t, []int64{mv2ID}, getMaterializedViewID4Logs(t, dom, "t"))
+	tk.MustExec("insert into t values (8, 8, 80)")
+	tk.MustExec("refresh materialized view mv2")
+	tk.MustQuery("select * from mv2 order by id").Check(testkit.Rows("7 7 70", "8 8 80"))
+
+	tk.MustExec("create table t2 (a int, b int)")
+	tk.MustGetErrCode("create materialized view mv3 refresh incremental as select a, b from t2", mysql.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create materialized view mv3 refresh incremental as select t.a from t join t2 on t.a = t2.a", mysql.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create materialized view mv3 refresh incremental as select count(*) from t", mysql.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create materialized view mv3 refresh incremental as select sum(b) from t group by a", mysql.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create materialized view mv3 refresh incremental as select a, b from t limit 1", mysql.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("select * from mv3", mysql.ErrNoSuchTable)
+}
+
+func TestMaterializedViewRewrite(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (1, 2), (2, 3)")
+	tk.MustExec("create materialized view mv refresh incremental as select a, sum(b) from t group by a")
+	tk.MustExec("create materialized view mv2 as select a, count(*) from t group by a")
+	tk.MustExec("set @@tidb_opt_enable_materialized_view_rewrite = on")
+	tk.MustQuery("explain format = 'brief' select a, sum(b) from t group by a").CheckContain("table:mv")
+	rs, err := tk.Exec("select a, sum(b) from t group by a")
+	require.NoError(t, err)
+	require.Equal(t, "sum(b)", rs.Fields()[1].Column.Name.O)
+	require.NoError(t, rs.Close())
+	// The view refreshed completely may be stale, so it isn't used.
+	tk.MustQuery("explain format = 'brief' select a, count(*) from t group by a").CheckNotContain("table:mv2")
+
+	// The view isn't used until the pending changes are refreshed.
+	tk.MustExec("insert into t values (3, 3)")
+	tk.MustQuery("explain format = 'brief' select a, sum(b) from t group by a").CheckNotContain("table:mv")
+	tk.MustQuery("select a, sum(b) from t group by a").Sort().Check(testkit.Rows("1 3", "2 3", "3 3"))
+	tk.MustExec("refresh materialized view mv")
+	tk.MustQuery("explain format = 'brief' select a, sum(b) from t group by a").CheckContain("table:mv")
+	tk.MustQuery("select a, sum(b) from t group by a").Sort().Check(testkit.Rows("1 3", "2 3", "3 3"))
+	tk.MustQuery("select a, sum(b) from t where a > 1 group by a").Sort().Check(testkit.Rows("2 3", "3 3"))
+
+	// The changes of the transaction itself are pending too.
+	tk.MustExec("begin")
+	tk.MustExec("delete from t where a = 3")
+	tk.MustQuery("select a, sum(b) from t group by a").Sort().Check(testkit.Rows("1 3", "2 3"))
+	tk.MustExec("rollback")
+	tk.MustQuery("explain format = 'brief' select a, sum(b) from t group by a").CheckContain("table:mv")
+}
+
+func getMaterializedViewID(t *testing.T, dom *domain.Domain, name string) int64 {
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr(name))
+	require.NoError(t, err)
+	require.True(t, tbl.Meta().IsMaterializedView())
+	return tbl.Meta().ID
+}
+
+func getMaterializedViewID4Logs(t *testing.T, dom *domain.Domain, name string) []int64 {
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr(name))
+	require.NoError(t, err)
+	return tbl.Meta().MaterializedViewLogs
+}
@@ -65,6 +65,7 @@ import (
 	"github.com/pingcap/tidb/util/stringutil"
 	"github.com/tikv/client-go/v2/tikv"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -362,7 +363,8 @@ func checkAddPartitionValue(meta *model.TableInfo, part *model.PartitionInfo) er
 	switch meta.Partition.Type {
 	case model.PartitionTypeRange:
 		if len(meta.Partition.Columns) == 0 {
-			newDefs, oldDefs := part.Definitions, meta.Partition.Definitions
+			newDefs := meta.Partition.FirstLevelDefinitions(part.Definitions)
+			oldDefs := meta.Partition.FirstLevelDefinitions(meta.Partition.Definitions)
 			rangeValue := oldDefs[len(oldDefs)-1].LessThan[0]
 			if strings.EqualFold(rangeValue, "MAXVALUE") {
 				return errors.Trace(dbterror.ErrPartitionMaxvalue)
@@ -539,8 +541,8 @@ func buildTablePartitionInfo(ctx sessionctx.Context, s *ast.PartitionOptions, tb
 		ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedCreatePartition.GenWithStack(fmt.Sprintf("Unsupported partition type %v, treat as normal table", s.Tp)))
 		return nil
 	}
-	if s.Sub != nil {
-		ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedCreatePartition.GenWithStack(fmt.Sprintf("Unsupported subpartitioning, only using %v partitioning", s.Tp)))
+	if s.Sub != nil && s.Interval != nil {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("INTERVAL partitioning with subpartitions")
 	}
 
 	pi := &model.PartitionInfo{
@@ -581,6 +583,12 @@ func buildTablePartitionInfo(ctx sessionctx.Context, s *ast.PartitionOptions, tb
 
 	tbInfo.Partition.Definitions = defs
 
+	if s.Sub != nil {
+		if err = buildSubPartitionInfo(ctx, s, tbInfo); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	if s.Interval != nil {
 		// Syntactic sugar for INTERVAL partitioning
 		// Generate the resulting CREATE TABLE as the query string
@@ -610,7 +618,115 @@ func buildTablePartitionInfo(ctx sessionctx.Context, s *ast.PartitionOptions, tb
 	return nil
 }
 
+// buildSubPartitionInfo sets the subpartitioning of tbInfo from s.Sub, and replaces
+// the partition definitions by the definitions of their subpartitions.
+func buildSubPartitionInfo(ctx sessionctx.Context, s *ast.PartitionOptions, tbInfo *model.TableInfo) error {
+	sub := s.Sub
+	if sub.Linear {
+		ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedCreatePartition.GenWithStack(fmt.Sprintf("LINEAR %s is not supported, using non-linear %s instead", sub.Tp.String(), sub.Tp.String())))
+	}
+	spi := &model.SubPartitionInfo{
+		Type: sub.Tp,
+		Num:  sub.Num,
+	}
+	if spi.Num == 0 {
+		spi.Num = 1
+	}
+	switch sub.Tp {
+	case model.PartitionTypeHash:
+		if err := checkPartitionFuncValid(ctx, tbInfo, sub.Expr); err != nil {
+			return errors.Trace(err)
+		}
+		buf := new(bytes.Buffer)
+		restoreCtx := format.NewRestoreCtx(format.DefaultRestoreFlags|format.RestoreBracketAroundBinaryOperation, buf)
+		if err := sub.Expr.Restore(restoreCtx); err != nil {
+			return err
+		}
+		spi.Expr = buf.String()
+	case model.PartitionTypeKey:
+		if len(sub.ColumnNames) == 0 {
+			return dbterror.ErrUnsupportedCreatePartition.GenWithStack("KEY subpartitioning without columns is not supported")
+		}
+		spi.Columns = make([]model.CIStr, 0, len(sub.ColumnNames))
+		for _, cn := range sub.ColumnNames {
+			colInfo := tbInfo.FindPublicColumnByName(cn.Name.L)
+			if colInfo == nil {
+				return errors.Trace(dbterror.ErrFieldNotFoundPart)
+			}
+			if !isColTypeAllowedAsPartitioningCol(model.PartitionTypeKey, colInfo.FieldType) {
+				return dbterror.ErrNotAllowedTypeInPartition.GenWithStackByArgs(cn.Name.O)
+			}
+			spi.Columns = append(spi.Columns, cn.Name)
+		}
+	default:
+		return errors.Trace(ast.ErrSubpartition)
+	}
+	if err := checkAddPartitionTooManyPartitions(spi.Num * uint64(len(tbInfo.Partition.Definitions))); err != nil {
+		return err
+	}
+
+	defs, err := buildSubPartitionDefinitions(spi, s.Definitions, tbInfo.Partition.Definitions)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tbInfo.Partition.Sub = spi
+	tbInfo.Partition.Definitions = defs
+	return nil
+}
+
+// buildSubPartitionDefinitions builds the subpartitions of the partitions defs, which
+// are built from astDefs. A subpartition inherits the values and options of its partition.
+func buildSubPartitionDefinitions(spi *model.SubPartitionInfo, astDefs []*ast.PartitionDefinition, defs []model.PartitionDefinition) ([]model.PartitionDefinition, error) {
+	subDefs := make([]model.PartitionDefinition, 0, uint64(len(defs))*spi.Num)
+	for i := range defs {
+		var astSubDefs []*ast.SubPartitionDefinition
+		if i < len(astDefs) {
+			astSubDefs = astDefs[i].Sub
+		}
+		if len(astSubDefs) > 0 && uint64(len(astSubDefs)) != spi.Num {
+			return nil, errors.Trace(ast.ErrPartitionWrongNoSubpart)
+		}
+		for j := uint64(0); j < spi.Num; j++ {
+			subDef := defs[i].Clone()
+			subDef.ParentName = defs[i].Name
+			subDef.ParentComment = defs[i].Comment
+			subDef.ParentPlacementPolicyRef = defs[i].PlacementPolicyRef
+			if len(astSubDefs) == 0 {
+				subDef.Name = model.NewCIStr(fmt.Sprintf("%ssp%d", defs[i].Name.O, j))
+			} else {
+				astSubDef := astSubDefs[j]
+				subDef.Name = astSubDef.Name
+				for _, opt := range astSubDef.Options {
+					if opt.Tp == ast.TableOptionComment {
+						subDef.Comment = opt.StrValue
+					}
+				}
+				if err := setPartitionPlacementFromOptions(&subDef, astSubDef.Options); err != nil {
+					return nil, err
+				}
+			}
+			if err := checkTooLongTable(subDef.Name); err != nil {
+				return nil, err
+			}
+			subDefs = append(subDefs, subDef)
+		}
+	}
+	return subDefs, nil
+}
+
 func getPartitionColSlices(sctx sessionctx.Context, tblInfo *model.TableInfo, s *ast.PartitionOptions) (partCols stringSlice, err error) {
+	partCols, err = getPartitionMethodColSlices(sctx, tblInfo, &s.PartitionMethod)
+	if err != nil || s.Sub == nil {
+		return partCols, err
+	}
+	subCols, err := getPartitionMethodColSlices(sctx, tblInfo, s.Sub)
+	if err != nil {
+		return nil, err
+	}
+	return stringSlices{partCols, subCols}, nil
+}
+
+func getPartitionMethodColSlices(sctx sessionctx.Context, tblInfo *model.TableInfo, s *ast.PartitionMethod) (partCols stringSlice, err error) {
 	if s.Expr != nil {
 		extractCols := newPartitionExprChecker(sctx, tblInfo)
 		s.Expr.Accept(extractCols)
@@ -1423,14 +1539,27 @@ func checkPartitionValuesIsInt(ctx sessionctx.Context, defName interface{}, expr
 	return nil
 }
 
+// partitionDefinitionNames returns the names of defs. For subpartitions, the name of
+// the partition they belong to is included once, before the names of its subpartitions.
+func partitionDefinitionNames(defs []model.PartitionDefinition) []model.CIStr {
+	names := make([]model.CIStr, 0, len(defs))
+	for i := range defs {
+		if defs[i].ParentName.L != "" && (i == 0 || defs[i-1].ParentName.L != defs[i].ParentName.L) {
+			names = append(names, defs[i].ParentName)
+		}
+		names = append(names, defs[i].Name)
+	}
+	return names
+}
+
 func checkPartitionNameUnique(pi *model.PartitionInfo) error {
-	newPars := pi.Definitions
+	newPars := partitionDefinitionNames(pi.Definitions)
 	partNames := make(map[string]struct{}, len(newPars))
 	for _, newPar := range newPars {
-		if _, ok := partNames[newPar.Name.L]; ok {
-			return dbterror.ErrSameNamePartition.GenWithStackByArgs(newPar.Name)
+		if _, ok := partNames[newPar.L]; ok {
+			return dbterror.ErrSameNamePartition.GenWithStackByArgs(newPar)
 		}
-		partNames[newPar.Name.L] = struct{}{}
+		partNames[newPar.L] = struct{}{}
 	}
 	return nil
 }
@@ -1438,17 +1567,17 @@ func checkPartitionNameUnique(pi *model.PartitionInfo) error {
 func checkAddPartitionNameUnique(tbInfo *model.TableInfo, pi *model.PartitionInfo) error {
 	partNames := make(map[string]struct{})
 	if tbInfo.Partition != nil {
-		oldPars := tbInfo.Partition.Definitions
+		oldPars := partitionDefinitionNames(tbInfo.Partition.Definitions)
 		for _, oldPar := range oldPars {
-			partNames[oldPar.Name.L] = struct{}{}
+			partNames[oldPar.L] = struct{}{}
 		}
 	}
-	newPars := pi.Definitions
+	newPars := partitionDefinitionNames(pi.Definitions)
 	for _, newPar := range newPars {
-		if _, ok := partNames[newPar.Name.L]; ok {
-			return dbterror.ErrSameNamePartition.GenWithStackByArgs(newPar.Name)
+		if _, ok := partNames[newPar.L]; ok {
+			return dbterror.ErrSameNamePartition.GenWithStackByArgs(newPar)
 		}
-		partNames[newPar.Name.L] = struct{}{}
+		partNames[newPar.L] = struct{}{}
 	}
 	return nil
 }
@@ -1771,6 +1900,35 @@ func CheckDropTablePartition(meta *model.TableInfo, partLowerNames []string) err
 	return nil
 }
 
+// GetDropPartitionNames returns the names of the partition definitions to drop
+// for DROP PARTITION partLowerNames, which for a subpartitioned table are
+// the subpartitions of the given partitions.
+func GetDropPartitionNames(meta *model.TableInfo, partLowerNames []string) ([]string, error) {
+	pi := meta.Partition
+	if pi.Sub == nil {
+		return partLowerNames, nil
+	}
+	subNames := make([]string, 0, uint64(len(partLowerNames))*pi.Sub.Num)
+	dupCheck := make(map[string]struct{})
+	for _, pn := range partLowerNames {
+		if _, ok := dupCheck[pn]; ok {
+			return nil, errors.Trace(dbterror.ErrDropPartitionNonExistent.GenWithStackByArgs("DROP"))
+		}
+		dupCheck[pn] = struct{}{}
+		found := false
+		for _, def := range pi.Definitions {
+			if def.ParentName.L == pn {
+				subNames = append(subNames, def.Name.L)
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.Trace(dbterror.ErrDropPartitionNonExistent.GenWithStackByArgs("DROP"))
+		}
+	}
+	return subNames, nil
+}
+
 // updateDroppingPartitionInfo move dropping partitions to DroppingDefinitions, and return partitionIDs
 func updateDroppingPartitionInfo(tblInfo *model.TableInfo, partLowerNames []string) []int64 {
 	oldDefs := tblInfo.Partition.Definitions
@@ -1827,6 +1985,105 @@ func hasGlobalIndex(tblInfo *model.TableInfo) bool {
 	return false
 }
 
+// getPartitionInfoTypeNone returns the partition info used for a non-partitioned
+// table during ALTER TABLE ... PARTITION BY and ALTER TABLE ... REMOVE PARTITIONING,
+// where the whole table is a single partition.
+func getPartitionInfoTypeNone() *model.PartitionInfo {
+	return &model.PartitionInfo{
+		Type:   model.PartitionTypeNone,
+		Enable: true,
+		Definitions: []model.PartitionDefinition{{
+			Name:    model.NewCIStr("pFullTable"),
+			Comment: "Intermediate partition during ALTER TABLE ... PARTITION ...",
+		}},
+		Num: 1,
+	}
+}
+
+// initAlterTablePartitioning prepares tblInfo for reorganizing all its partitions
+// into partInfo, which uses a new partitioning scheme, or PartitionTypeNone
+// for REMOVE PARTITIONING.
+func initAlterTablePartitioning(tblInfo *model.TableInfo, partNames []model.CIStr, partInfo *model.PartitionInfo) error {
+	if tblInfo.Partition == nil {
+		// Handle the non-partitioned table as a single partition, using the table ID,
+		// since that is where its data is.
+		tblInfo.Partition = getPartitionInfoTypeNone()
+		tblInfo.Partition.Definitions[0].ID = tblInfo.ID
+		if tblInfo.TiFlashReplica != nil && tblInfo.TiFlashReplica.Available {
+			tblInfo.TiFlashReplica.AvailablePartitionIDs = append(tblInfo.TiFlashReplica.AvailablePartitionIDs, tblInfo.ID)
+		}
+	}
+	if len(partNames) != len(tblInfo.Partition.Definitions) {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("changing the partitioning scheme, partitions changed after the job was queued")
+	}
+	pi := tblInfo.Partition
+	pi.DDLChangeScheme = true
+	pi.DDLType = partInfo.Type
+	pi.DDLExpr = partInfo.Expr
+	pi.DDLColumns = partInfo.Columns
+	return nil
+}
+
+// swapPartitioningScheme swaps the current partitioning scheme with the one
+// stored in DDLType, DDLExpr and DDLColumns.
+func swapPartitioningScheme(pi *model.PartitionInfo) {
+	pi.Type, pi.DDLType = pi.DDLType, pi.Type
+	pi.Expr, pi.DDLExpr = pi.DDLExpr, pi.Expr
+	pi.Columns, pi.DDLColumns = pi.DDLColumns, pi.Columns
+}
+
+// clearPartitioningSchemeChange removes the other partitioning scheme from the table,
+// when the reorganization is either done or rolled back.
+func clearPartitioningSchemeChange(tblInfo *model.TableInfo) {
+	pi := tblInfo.Partition
+	pi.DDLChangeScheme = false
+	pi.DDLType = model.PartitionTypeNone
+	pi.DDLExpr = ""
+	pi.DDLColumns = nil
+	if pi.Type != model.PartitionTypeNone {
+		if tblInfo.TiFlashReplica != nil {
+			tblInfo.TiFlashReplica.AvailablePartitionIDs = removeTableID(tblInfo.TiFlashReplica.AvailablePartitionIDs, tblInfo.ID)
+		}
+		return
+	}
+	// Back to a non-partitioned table, with the data under the table ID.
+	tblInfo.Partition = nil
+	if tblInfo.TiFlashReplica != nil {
+		tblInfo.TiFlashReplica.AvailablePartitionIDs = nil
+	}
+}
+
+// changeTableID gives the table newID, and moves its auto IDs over, so the old table ID
+// is no longer used and its data can be dropped.
+func changeTableID(t *meta.Meta, schemaID int64, tblInfo *model.TableInfo, newID int64) error {
+	autoIDs, err := t.GetAutoIDAccessors(schemaID, tblInfo.ID).Get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err = t.DropTableOrView(schemaID, tblInfo.ID); err != nil {
+		return errors.Trace(err)
+	}
+	if err = t.GetAutoIDAccessors(schemaID, tblInfo.ID).Del(); err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo.ID = newID
+	if err = t.GetAutoIDAccessors(schemaID, tblInfo.ID).Put(autoIDs); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(t.CreateTableOrView(schemaID, tblInfo))
+}
+
+// removeTableID returns ids without tableID.
+func removeTableID(ids []int64, tableID int64) []int64 {
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id != tableID {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
 // getTableInfoWithDroppingPartitions builds oldTableInfo including dropping partitions, only used by onDropTablePartition.
 func getTableInfoWithDroppingPartitions(t *model.TableInfo) *model.TableInfo {
 	p := t.Partition
@@ -1890,11 +2147,16 @@ func (w *worker) onDropTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
-	if job.Type == model.ActionAddTablePartition || job.Type == model.ActionReorganizePartition {
+	if job.Type == model.ActionAddTablePartition || job.Type == model.ActionReorganizePartition ||
+		job.Type == model.ActionAlterTablePartitioning || job.Type == model.ActionRemovePartitioning {
 		// It is rollback from reorganize partition, just remove DroppingDefinitions from tableInfo
 		tblInfo.Partition.DroppingDefinitions = nil
 		// It is rollback from adding table partition, just remove addingDefinitions from tableInfo.
 		physicalTableIDs, pNames, rollbackBundles := rollbackAddingPartitionInfo(tblInfo)
+		if tblInfo.Partition.DDLChangeScheme {
+			// Rollback only happens before the new scheme is used, so just drop it.
+			clearPartitioningSchemeChange(tblInfo)
+		}
 		err = infosync.PutRuleBundlesWithDefaultRetry(context.TODO(), rollbackBundles)
 		if err != nil {
 			job.State = model.JobStateCancelled
@@ -2598,8 +2860,11 @@ func checkReorgPartition(t *meta.Meta, job *model.Job) (*model.TableInfo, []mode
 		job.State = model.JobStateCancelled
 		return nil, nil, nil, nil, nil, errors.Trace(err)
 	}
-	addingDefs := tblInfo.Partition.AddingDefinitions
-	droppingDefs := tblInfo.Partition.DroppingDefinitions
+	var addingDefs, droppingDefs []model.PartitionDefinition
+	if tblInfo.Partition != nil {
+		addingDefs = tblInfo.Partition.AddingDefinitions
+		droppingDefs = tblInfo.Partition.DroppingDefinitions
+	}
 	if len(addingDefs) == 0 {
 		addingDefs = []model.PartitionDefinition{}
 	}
@@ -2640,6 +2905,12 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		// The partInfo may have been checked against an older schema version for example.
 		// If the check is done here, it does not need to be repeated, since no other
 		// DDL on the same table can be run concurrently.
+		if job.Type == model.ActionAlterTablePartitioning || job.Type == model.ActionRemovePartitioning {
+			if err = initAlterTablePartitioning(tblInfo, partNamesCIStr, partInfo); err != nil {
+				job.State = model.JobStateCancelled
+				return ver, errors.Trace(err)
+			}
+		}
 		err = checkAddPartitionTooManyPartitions(uint64(len(tblInfo.Partition.Definitions) +
 			len(partInfo.Definitions) -
 			len(partNames)))
@@ -2661,7 +2932,15 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 			return ver, err
 		}
 		sctx := w.sess.Context
-		if err = checkReorgPartitionDefs(sctx, tblInfo, partInfo, firstPartIdx, lastPartIdx, idMap); err != nil {
+		if tblInfo.Partition.DDLChangeScheme {
+			// All partitions are replaced, so only check the new ones by themselves.
+			clonedMeta := tblInfo.Clone()
+			clonedMeta.Partition = partInfo
+			err = checkPartitionDefinitionConstraints(sctx, clonedMeta)
+		} else {
+			err = checkReorgPartitionDefs(sctx, tblInfo, partInfo, firstPartIdx, lastPartIdx, idMap)
+		}
+		if err != nil {
 			job.State = model.JobStateCancelled
 			return ver, err
 		}
@@ -2835,6 +3114,11 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		// From now on, use the new definitions, but keep the Adding and Dropping for double write
 		tblInfo.Partition.Definitions = newDefs
 		tblInfo.Partition.Num = uint64(len(newDefs))
+		if tblInfo.Partition.DDLChangeScheme {
+			// Also use the new partitioning scheme, and keep the old one for double writing
+			// to the DroppingDefinitions.
+			swapPartitioningScheme(tblInfo.Partition)
+		}
 
 		// Now all the data copying is done, but we cannot simply remove the droppingDefinitions
 		// since they are a part of the normal Definitions that other nodes with
@@ -2861,6 +3145,33 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		definitionsToAdd := tblInfo.Partition.AddingDefinitions
 		tblInfo.Partition.DroppingDefinitions = nil
 		tblInfo.Partition.AddingDefinitions = nil
+		tblInfo.Partition.DDLState = model.StateNone
+		if tblInfo.Partition.DDLChangeScheme {
+			// May also remove the partitioning, i.e. set tblInfo.Partition to nil.
+			clearPartitioningSchemeChange(tblInfo)
+		}
+		if partInfo.NewTableID != 0 {
+			// The data of a non-partitioned table is under the table ID, so the table gets a new ID
+			// and the old one is dropped like the reorganized partitions.
+			oldTblID := tblInfo.ID
+			if err = changeTableID(t, job.SchemaID, tblInfo, partInfo.NewTableID); err != nil {
+				return ver, errors.Trace(err)
+			}
+			if !slices.Contains(physicalTableIDs, oldTblID) {
+				physicalTableIDs = append(physicalTableIDs, oldTblID)
+			}
+			if !slices.Contains(newIDs, tblInfo.ID) {
+				newIDs = append(newIDs, tblInfo.ID)
+			}
+			bundles, err := placement.NewFullTableBundles(t, tblInfo)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			if err = infosync.PutRuleBundlesWithDefaultRetry(context.TODO(), bundles); err != nil {
+				return ver, errors.Wrapf(err, "failed to notify PD the placement rules")
+			}
+			job.CtxVars = []interface{}{physicalTableIDs, newIDs, tblInfo.ID}
+		}
 		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
 		failpoint.Inject("reorgPartWriteReorgSchemaVersionUpdateFail", func(val failpoint.Value) {
 			if val.(bool) {
@@ -2871,12 +3182,11 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 			return ver, errors.Trace(err)
 		}
 		job.SchemaState = model.StateNone
-		tblInfo.Partition.DDLState = model.StateNone
 		job.FinishTableJob(model.JobStateDone, model.StateNone, ver, tblInfo)
 		// How to handle this?
 		// Seems to only trigger asynchronous update of statistics.
 		// Should it actually be synchronous?
-		asyncNotifyEvent(d, &util.Event{Tp: model.ActionReorganizePartition, TableInfo: tblInfo, PartInfo: &model.PartitionInfo{Definitions: definitionsToAdd}})
+		asyncNotifyEvent(d, &util.Event{Tp: job.Type, TableInfo: tblInfo, PartInfo: &model.PartitionInfo{Definitions: definitionsToAdd}})
 		// A background job will be created to delete old partition data.
 		job.Args = []interface{}{physicalTableIDs}
 
@@ -2955,7 +3265,9 @@ func newReorgPartitionWorker(sessCtx sessionctx.Context, i int, t table.Physical
 	if pt == nil {
 		return nil, dbterror.ErrUnsupportedReorganizePartition.GenWithStackByArgs()
 	}
-	partColIDs := pt.GetPartitionColumnIDs()
+	// Use the partitioning columns of the new partitions, which may differ
+	// from the current ones during ALTER TABLE ... PARTITION BY.
+	partColIDs := reorgedTbl.GetPartitionColumnIDs()
 	writeColOffsetMap := make(map[int64]int, len(partColIDs))
 	maxOffset := 0
 	for _, col := range pt.Cols() {
@@ -3480,13 +3792,13 @@ func getPartitionRuleIDs(dbName string, table *model.TableInfo) []string {
 }
 
 // checkPartitioningKeysConstraints checks that the range partitioning key is included in the table constraint.
-func checkPartitioningKeysConstraints(sctx sessionctx.Context, s *ast.CreateTableStmt, tblInfo *model.TableInfo) error {
+func checkPartitioningKeysConstraints(sctx sessionctx.Context, s *ast.PartitionOptions, tblInfo *model.TableInfo) error {
 	// Returns directly if there are no unique keys in the table.
 	if len(tblInfo.Indices) == 0 && !tblInfo.PKIsHandle {
 		return nil
 	}
 
-	partCols, err := getPartitionColSlices(sctx, tblInfo, s.Partition)
+	partCols, err := getPartitionColSlices(sctx, tblInfo, s)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -3546,6 +3858,22 @@ func checkPartitionKeysConstraint(pi *model.PartitionInfo, indexColumns []*model
 			partCols = append(partCols, colInfo)
 		}
 	}
+	if pi.Sub != nil {
+		if pi.Sub.Expr != "" {
+			subCols, err := extractPartitionColumns(pi.Sub.Expr, tblInfo)
+			if err != nil {
+				return false, err
+			}
+			partCols = append(partCols, subCols...)
+		}
+		for _, col := range pi.Sub.Columns {
+			colInfo := tblInfo.FindPublicColumnByName(col.L)
+			if colInfo == nil {
+				return false, infoschema.ErrColumnNotExists.GenWithStackByArgs(col, tblInfo.Name)
+			}
+			partCols = append(partCols, colInfo)
+		}
+	}
 
 	// In MySQL, every unique key on the table must use every column in the table's partitioning expression.(This
 	// also includes the table's primary key.)
@@ -3652,6 +3980,27 @@ func (cns columnNameSlice) At(i int) string {
 	return cns[i].Name.L
 }
 
+// stringSlices implements the stringSlice interface by concatenating its elements.
+type stringSlices []stringSlice
+
+func (ss stringSlices) Len() int {
+	n := 0
+	for _, s := range ss {
+		n += s.Len()
+	}
+	return n
+}
+
+func (ss stringSlices) At(i int) string {
+	for _, s := range ss {
+		if i < s.Len() {
+			return s.At(i)
+		}
+		i -= s.Len()
+	}
+	return ""
+}
+
 func isPartExprUnsigned(tbInfo *model.TableInfo) bool {
 	// We should not rely on any configuration, system or session variables, so use a mock ctx!
 	// Same as in tables.newPartitionExpr
@@ -3917,7 +4266,7 @@ func writeColumnListToBuffer(partitionInfo *model.PartitionInfo, sqlMode mysql.S
 // AppendPartitionInfo is used in SHOW CREATE TABLE as well as generation the SQL syntax
 // for the PartitionInfo during validation of various DDL commands
 func AppendPartitionInfo(partitionInfo *model.PartitionInfo, buf *bytes.Buffer, sqlMode mysql.SQLMode) {
-	if partitionInfo == nil {
+	if partitionInfo == nil || partitionInfo.Type == model.PartitionTypeNone {
 		return
 	}
 	// Since MySQL 5.1/5.5 is very old and TiDB aims for 5.7/8.0 compatibility, we will not
@@ -3961,20 +4310,77 @@ func AppendPartitionInfo(partitionInfo *model.PartitionInfo, buf *bytes.Buffer,
 			fmt.Fprintf(buf, "\nPARTITION BY %s COLUMNS(", partitionInfo.Type.String())
 		}
 		writeColumnListToBuffer(partitionInfo, sqlMode, buf)
-		buf.WriteString(")\n(")
+		buf.WriteString(")")
 	} else {
-		fmt.Fprintf(buf, "\nPARTITION BY %s (%s)\n(", partitionInfo.Type.String(), partitionInfo.Expr)
+		fmt.Fprintf(buf, "\nPARTITION BY %s (%s)", partitionInfo.Type.String(), partitionInfo.Expr)
 	}
+	if partitionInfo.Sub != nil {
+		appendSubPartitionInfo(partitionInfo, buf, sqlMode)
+	}
+	buf.WriteString("\n(")
 
 	AppendPartitionDefs(partitionInfo, buf, sqlMode)
 	buf.WriteString(")")
 }
 
+func appendSubPartitionInfo(partitionInfo *model.PartitionInfo, buf *bytes.Buffer, sqlMode mysql.SQLMode) {
+	sub := partitionInfo.Sub
+	if sub.Type == model.PartitionTypeHash {
+		fmt.Fprintf(buf, "\nSUBPARTITION BY HASH (%s)", sub.Expr)
+	} else {
+		buf.WriteString("\nSUBPARTITION BY KEY (")
+		writeColumnListToBuffer(partitionInfo.SubLevel(), sqlMode, buf)
+		buf.WriteString(")")
+	}
+	if isDefaultSubPartitionDefinitions(partitionInfo) {
+		fmt.Fprintf(buf, "\nSUBPARTITIONS %d", sub.Num)
+	}
+}
+
+// isDefaultSubPartitionDefinitions checks if all the subpartitions have their default
+// names and the options of the partition they belong to, so they don't need to be listed.
+func isDefaultSubPartitionDefinitions(partitionInfo *model.PartitionInfo) bool {
+	defs := partitionInfo.Definitions
+	num := partitionInfo.Sub.Num
+	for i := range defs {
+		j := uint64(i) % num
+		if defs[i].Name.O != fmt.Sprintf("%ssp%d", defs[i].ParentName.O, j) {
+			return false
+		}
+		if defs[i].Comment != defs[i].ParentComment {
+			return false
+		}
+		own, parent := defs[i].PlacementPolicyRef, defs[i].ParentPlacementPolicyRef
+		if (own == nil) != (parent == nil) || (own != nil && own.Name.L != parent.Name.L) {
+			return false
+		}
+	}
+	return true
+}
+
+func appendSubPartitionDefs(defs []model.PartitionDefinition, buf *bytes.Buffer, sqlMode mysql.SQLMode) {
+	buf.WriteString("\n (")
+	for i, def := range defs {
+		if i > 0 {
+			buf.WriteString(",\n  ")
+		}
+		fmt.Fprintf(buf, "SUBPARTITION %s", stringutil.Escape(def.Name.O, sqlMode))
+		if len(def.Comment) > 0 {
+			fmt.Fprintf(buf, " COMMENT '%s'", format.OutputFormat(def.Comment))
+		}
+		if def.PlacementPolicyRef != nil {
+			fmt.Fprintf(buf, " /*T![placement] PLACEMENT POLICY=%s */", stringutil.Escape(def.PlacementPolicyRef.Name.O, sqlMode))
+		}
+	}
+	buf.WriteString(")")
+}
+
 // AppendPartitionDefs generates a list of partition definitions needed for SHOW CREATE TABLE (in executor/show.go)
 // as well as needed for generating the ADD PARTITION query for INTERVAL partitioning of ALTER TABLE t LAST PARTITION
 // and generating the CREATE TABLE query from CREATE TABLE ... INTERVAL
 func AppendPartitionDefs(partitionInfo *model.PartitionInfo, buf *bytes.Buffer, sqlMode mysql.SQLMode) {
-	for i, def := range partitionInfo.Definitions {
+	withSubDefs := partitionInfo.Sub != nil && !isDefaultSubPartitionDefinitions(partitionInfo)
+	for i, def := range partitionInfo.FirstLevelDefinitions(partitionInfo.Definitions) {
 		if i > 0 {
 			fmt.Fprintf(buf, ",\n ")
 		}
@@ -4021,5 +4427,9 @@ func AppendPartitionDefs(partitionInfo *model.PartitionInfo, buf *bytes.Buffer,
 			// add placement ref info here
 			fmt.Fprintf(buf, " /*T![placement] PLACEMENT POLICY=%s */", stringutil.Escape(def.PlacementPolicyRef.Name.O, sqlMode))
 		}
+		if withSubDefs {
+			num := partitionInfo.Sub.Num
+			appendSubPartitionDefs(partitionInfo.Definitions[uint64(i)*num:uint64(i+1)*num], buf, sqlMode)
+		}
 	}
 }
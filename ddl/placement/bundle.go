@@ -482,7 +482,11 @@ func NewTableBundle(getter PolicyGetter, tbInfo *model.TableInfo) (*Bundle, erro
 	// build the default partition rules in the table-level bundle.
 	if tbInfo.Partition != nil {
 		for _, pDef := range tbInfo.Partition.Definitions {
-			ids = append(ids, pDef.ID)
+			// The table ID is reused as partition ID for a non-partitioned
+			// table during ALTER TABLE ... PARTITION BY.
+			if pDef.ID != tbInfo.ID {
+				ids = append(ids, pDef.ID)
+			}
 		}
 	}
 	bundle.Reset(RuleIndexTable, ids)
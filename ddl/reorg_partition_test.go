@@ -19,7 +19,9 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/ddl/util/callback"
@@ -1374,3 +1376,291 @@ func TestReorgPartitionData(t *testing.T) {
 	tk.MustQuery(`select * from t`).Sort().Check(testkit.Rows("0 Zero value! 0 2022-02-30 00:00:00"))
 	tk.MustExec(`admin check table t`)
 }
+
+func TestAlterTablePartitionBy(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	schemaName := "AlterPartBy"
+	tk.MustExec("create database " + schemaName)
+	tk.MustExec("use " + schemaName)
+	tk.MustExec(`create table t (a int unsigned PRIMARY KEY, b varchar(255), c int, key (b), key (c,b))`)
+	tk.MustExec(`insert into t values (1,"1",1), (12,"12",21),(23,"23",32),(34,"34",43),(45,"45",54),(56,"56",65)`)
+	ctx := tk.Session()
+	tbl, err := domain.GetDomain(ctx).InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+	require.NoError(t, err)
+	tableID := tbl.Meta().ID
+
+	tk.MustExec(`alter table t partition by range (a) ` +
+		`(partition p0 values less than (10),` +
+		` partition p1 values less than (20),` +
+		` partition pMax values less than (MAXVALUE))`)
+	tk.MustQuery(`show warnings`).Check(testkit.Rows("Warning 1105 The statistics of new partitions will be outdated after reorganizing partitions. Please use 'ANALYZE TABLE' statement if you want to update it now"))
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`show create table t`).Check(testkit.Rows("" +
+		"t CREATE TABLE `t` (\n" +
+		"  `a` int(10) unsigned NOT NULL,\n" +
+		"  `b` varchar(255) DEFAULT NULL,\n" +
+		"  `c` int(11) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`a`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  KEY `b` (`b`),\n" +
+		"  KEY `c` (`c`,`b`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
+		"PARTITION BY RANGE (`a`)\n" +
+		"(PARTITION `p0` VALUES LESS THAN (10),\n" +
+		" PARTITION `p1` VALUES LESS THAN (20),\n" +
+		" PARTITION `pMax` VALUES LESS THAN (MAXVALUE))"))
+	tk.MustQuery(`select * from t partition (p0)`).Check(testkit.Rows("1 1 1"))
+	tk.MustQuery(`select * from t partition (p1)`).Check(testkit.Rows("12 12 21"))
+	tk.MustQuery(`select a from t partition (pMax)`).Sort().Check(testkit.Rows("23", "34", "45", "56"))
+	tbl, err = domain.GetDomain(ctx).InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+	require.NoError(t, err)
+	require.False(t, tbl.Meta().Partition.DDLChangeScheme)
+	// The data of the non-partitioned table was under the table ID, so the table got a new ID,
+	// and only the old one is deleted.
+	require.NotEqual(t, tableID, tbl.Meta().ID)
+	oldTableKey := hex.EncodeToString(tablecodec.EncodeTablePrefix(tableID))
+	require.Eventually(t, func() bool {
+		rows := tk.MustQuery(`select start_key from mysql.gc_delete_range union all select start_key from mysql.gc_delete_range_done`).Rows()
+		return len(rows) == 1 && strings.HasPrefix(rows[0][0].(string), oldTableKey)
+	}, 5*time.Second, 100*time.Millisecond)
+	noNewTablesAfter(t, tk, ctx, tbl)
+	tableID = tbl.Meta().ID
+
+	tk.MustExec(`alter table t partition by hash (a) partitions 3`)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select a from t partition (p0)`).Sort().Check(testkit.Rows("12", "45"))
+	tk.MustQuery(`select a from t partition (p1)`).Sort().Check(testkit.Rows("1", "34"))
+	tk.MustQuery(`select a from t partition (p2)`).Sort().Check(testkit.Rows("23", "56"))
+
+	tk.MustExec(`alter table t partition by list (a) ` +
+		`(partition p0 values in (1,12,23),` +
+		` partition p1 values in (34,45,56))`)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select a from t partition (p1)`).Sort().Check(testkit.Rows("34", "45", "56"))
+	tk.MustContainErrMsg(`insert into t values (2,"2",2)`, "Table has no partition for value 2")
+
+	tk.MustExec(`alter table t partition by key (a) partitions 2`)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select count(*) from t`).Check(testkit.Rows("6"))
+	tk.MustQuery(`select * from t where a = 23`).Check(testkit.Rows("23 23 32"))
+	tk.MustQuery(`select * from t where b = "45"`).Check(testkit.Rows("45 45 54"))
+	tbl, err = domain.GetDomain(ctx).InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+	require.NoError(t, err)
+	require.NotEqual(t, tableID, tbl.Meta().ID)
+	require.Equal(t, model.PartitionTypeKey, tbl.Meta().Partition.Type)
+
+	tk.MustContainErrMsg(`alter table t partition by range (c) (partition p0 values less than (100))`, "A CLUSTERED INDEX must include all columns in the table's partitioning function")
+	tk.MustExec(`admin check table t`)
+
+	tk.MustExec(`create table parent (id int primary key)`)
+	tk.MustExec(`create table child (id int primary key, pid int, foreign key (pid) references parent (id))`)
+	tk.MustContainErrMsg(`alter table child partition by hash (id) partitions 2`, "Foreign key clause is not yet supported in conjunction with partitioning")
+	tk.MustContainErrMsg(`alter table parent partition by hash (id) partitions 2`, "Foreign key clause is not yet supported in conjunction with partitioning")
+
+	// The auto IDs are kept when the table gets a new ID.
+	tk.MustExec(`create table t2 (a int auto_increment primary key, b int)`)
+	tk.MustExec(`insert into t2 (b) values (1), (2)`)
+	tk.MustExec(`alter table t2 partition by hash (a) partitions 2`)
+	tk.MustExec(`insert into t2 (b) values (3), (4)`)
+	tk.MustQuery(`select count(*) from t2 where a > 2`).Check(testkit.Rows("2"))
+}
+
+func TestAlterTablePartitionByConcurrentDML(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	schemaName := "AlterPartByDML"
+	tk.MustExec("create database " + schemaName)
+	tk.MustExec("use " + schemaName)
+	tk.MustExec(`create table t (a int PRIMARY KEY, b varchar(255), key (b))`)
+	tk.MustExec(`insert into t values (1,"1"),(12,"12"),(23,"23")`)
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use " + schemaName)
+
+	hook := &callback.TestDDLCallback{Do: dom}
+	next := 100
+	var hookErr error
+	hook.OnJobRunAfterExported = func(job *model.Job) {
+		if job.Type != model.ActionAlterTablePartitioning || hookErr != nil {
+			return
+		}
+		next++
+		if _, err := tk2.Exec(fmt.Sprintf(`insert into t values (%d, "%d")`, next, next)); err != nil {
+			hookErr = err
+			return
+		}
+		if _, err := tk2.Exec(fmt.Sprintf(`update t set b = "x%d" where a = 12`, next)); err != nil {
+			hookErr = err
+			return
+		}
+		if _, err := tk2.Exec(`delete from t where a = 23`); err != nil {
+			hookErr = err
+		}
+	}
+	dom.DDL().SetHook(hook)
+	tk.MustExec(`alter table t partition by range (a) (partition p0 values less than (50), partition pMax values less than (MAXVALUE))`)
+	require.NoError(t, hookErr)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select a from t partition (p0)`).Sort().Check(testkit.Rows("1", "12"))
+	tk.MustQuery(`select count(*) from t partition (pMax)`).Check(testkit.Rows(fmt.Sprintf("%d", next-100)))
+
+	tk.MustExec(`alter table t partition by hash (a) partitions 4`)
+	require.NoError(t, hookErr)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select count(*) from t`).Check(testkit.Rows(fmt.Sprintf("%d", next-100+2)))
+
+	hook.OnJobRunAfterExported = func(job *model.Job) {
+		if job.Type != model.ActionRemovePartitioning || hookErr != nil {
+			return
+		}
+		next++
+		if _, err := tk2.Exec(fmt.Sprintf(`insert into t values (%d, "%d")`, next, next)); err != nil {
+			hookErr = err
+			return
+		}
+		if _, err := tk2.Exec(fmt.Sprintf(`update t set b = "x%d" where a = 1`, next)); err != nil {
+			hookErr = err
+		}
+	}
+	tk.MustExec(`alter table t remove partitioning`)
+	require.NoError(t, hookErr)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select count(*) from t`).Check(testkit.Rows(fmt.Sprintf("%d", next-100+2)))
+	tk.MustQuery(`select b from t where a = 1`).Check(testkit.Rows(fmt.Sprintf("x%d", next)))
+}
+
+func TestRemovePartitioning(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	schemaName := "RemovePart"
+	tk.MustExec("create database " + schemaName)
+	tk.MustExec("use " + schemaName)
+	tk.MustExec(`create table t (a int unsigned PRIMARY KEY, b varchar(255), c int, key (b), key (c,b))` +
+		` partition by range (a) ` +
+		`(partition p0 values less than (10),` +
+		` partition p1 values less than (20),` +
+		` partition pMax values less than (MAXVALUE))`)
+	tk.MustExec(`insert into t values (1,"1",1), (12,"12",21),(23,"23",32),(34,"34",43),(45,"45",54),(56,"56",65)`)
+	ctx := tk.Session()
+	tbl, err := domain.GetDomain(ctx).InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+	require.NoError(t, err)
+	tableID := tbl.Meta().ID
+
+	tk.MustExec(`alter table t remove partitioning`)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`show create table t`).Check(testkit.Rows("" +
+		"t CREATE TABLE `t` (\n" +
+		"  `a` int(10) unsigned NOT NULL,\n" +
+		"  `b` varchar(255) DEFAULT NULL,\n" +
+		"  `c` int(11) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`a`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  KEY `b` (`b`),\n" +
+		"  KEY `c` (`c`,`b`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustQuery(`select a from t`).Sort().Check(testkit.Rows("1", "12", "23", "34", "45", "56"))
+	tk.MustQuery(`select * from t where b = "34"`).Check(testkit.Rows("34 34 43"))
+	tk.MustQuery(`select a from t use index (c) where c > 40`).Sort().Check(testkit.Rows("34", "45", "56"))
+	tbl, err = domain.GetDomain(ctx).InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+	require.NoError(t, err)
+	require.NotEqual(t, tableID, tbl.Meta().ID)
+	require.Nil(t, tbl.Meta().Partition)
+	// All data should now be under the new table ID, 6 records and 2 * 6 index entries.
+	require.Equal(t, 18, len(getAllDataForPhysicalTable(t, ctx, tbl.(table.PhysicalTable)).keys))
+
+	tk.MustContainErrMsg(`alter table t remove partitioning`, "Partition management on a not partitioned table is not possible")
+
+	// Back and forth, before the old data is deleted, each job gives the table a new ID.
+	tableIDs := []int64{tableID, tbl.Meta().ID}
+	tk.MustExec(`alter table t partition by hash (a) partitions 3`)
+	tk.MustExec(`insert into t values (67,"67",76)`)
+	tk.MustExec(`alter table t remove partitioning`)
+	tbl, err = domain.GetDomain(ctx).InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+	require.NoError(t, err)
+	require.NotContains(t, tableIDs, tbl.Meta().ID)
+	require.Nil(t, tbl.Meta().Partition)
+	// The data must still be there when the old IDs are deleted.
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery(`select 1 from mysql.gc_delete_range`).Rows()) == 0
+	}, 5*time.Second, 100*time.Millisecond)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select a from t`).Sort().Check(testkit.Rows("1", "12", "23", "34", "45", "56", "67"))
+	require.Equal(t, 21, len(getAllDataForPhysicalTable(t, ctx, tbl.(table.PhysicalTable)).keys))
+}
+
+func TestRebuildPartition(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	schemaName := "RebuildPart"
+	tk.MustExec("create database " + schemaName)
+	tk.MustExec("use " + schemaName)
+	tk.MustExec(`create table t (a int unsigned PRIMARY KEY, b varchar(255), c int, key (b), key (c,b))` +
+		` partition by range (a) ` +
+		`(partition p0 values less than (10),` +
+		` partition p1 values less than (20),` +
+		` partition p2 values less than (30),` +
+		` partition pMax values less than (MAXVALUE))`)
+	tk.MustExec(`insert into t values (1,"1",1), (12,"12",21),(23,"23",32),(34,"34",43),(45,"45",54),(56,"56",65)`)
+	tk.MustExec(`update t set c = c + 1`)
+	ctx := tk.Session()
+	getDefs := func() []model.PartitionDefinition {
+		tbl, err := domain.GetDomain(ctx).InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+		require.NoError(t, err)
+		return tbl.Meta().Partition.Definitions
+	}
+	oldDefs := getDefs()
+	showCreate := tk.MustQuery(`show create table t`).Rows()
+
+	// p0 and pMax are not adjacent, so they are rebuilt by one job each.
+	tk.MustExec(`alter table t rebuild partition p0, pMax, p0`)
+	tk.MustQuery(`show warnings`).Check(testkit.Rows("Warning 1105 The statistics of related partitions will be outdated after rebuilding partitions. Please use 'ANALYZE TABLE' statement if you want to update it now"))
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`show create table t`).Check(showCreate)
+	newDefs := getDefs()
+	require.Len(t, newDefs, len(oldDefs))
+	for i := range oldDefs {
+		require.Equal(t, oldDefs[i].Name, newDefs[i].Name)
+		require.Equal(t, oldDefs[i].LessThan, newDefs[i].LessThan)
+		if i == 0 || i == 3 {
+			require.NotEqual(t, oldDefs[i].ID, newDefs[i].ID)
+		} else {
+			require.Equal(t, oldDefs[i].ID, newDefs[i].ID)
+		}
+	}
+	tk.MustQuery(`select * from t partition (p0, pMax)`).Sort().Check(testkit.Rows("1 1 2", "34 34 44", "45 45 55", "56 56 66"))
+	tk.MustQuery(`select a from t use index (c) where c > 40`).Sort().Check(testkit.Rows("34", "45", "56"))
+
+	tk.MustExec(`alter table t rebuild partition all`)
+	tk.MustExec(`admin check table t`)
+	for i, def := range getDefs() {
+		require.NotEqual(t, newDefs[i].ID, def.ID)
+	}
+	tk.MustQuery(`select a from t`).Sort().Check(testkit.Rows("1", "12", "23", "34", "45", "56"))
+	tk.MustContainErrMsg(`alter table t rebuild partition p0, pNone`, "Unknown partition 'pNone' in table 't'")
+
+	// REPAIR PARTITION rebuilds the partition too.
+	oldDefs = getDefs()
+	tk.MustExec(`alter table t repair partition p1`)
+	require.NotEqual(t, oldDefs[1].ID, getDefs()[1].ID)
+	tk.MustExec(`admin check table t`)
+
+	// HASH and KEY partitions are always rebuilt all together.
+	tk.MustExec(`create table th (a int, b int, key (b)) partition by hash (a) partitions 3`)
+	tk.MustExec(`insert into th values (1,1),(2,2),(3,3),(4,4),(5,5)`)
+	tk.MustExec(`alter table th rebuild partition p1`)
+	tk.MustExec(`admin check table th`)
+	tk.MustQuery(`select a from th partition (p1)`).Sort().Check(testkit.Rows("1", "4"))
+	tk.MustQuery(`select count(*) from th`).Check(testkit.Rows("5"))
+
+	tk.MustExec(`create table tl (a int, b int, key (b)) partition by list (a) ` +
+		`(partition p0 values in (1,3,5), partition p1 values in (2,4,6), partition p2 values in (7,8))`)
+	tk.MustExec(`insert into tl values (1,1),(2,2),(3,3),(4,4),(7,7)`)
+	tk.MustExec(`alter table tl rebuild partition p1, p2`)
+	tk.MustExec(`admin check table tl`)
+	tk.MustQuery(`select a from tl partition (p1)`).Sort().Check(testkit.Rows("2", "4"))
+	tk.MustQuery(`select a from tl`).Sort().Check(testkit.Rows("1", "2", "3", "4", "7"))
+
+	tk.MustExec(`create table tn (a int)`)
+	tk.MustGetErrCode(`alter table tn rebuild partition p0`, errno.ErrPartitionMgmtOnNonpartitioned)
+	tk.MustExec(`create table ts (a int, b int) partition by range (a) subpartition by hash (b) subpartitions 2 ` +
+		`(partition p0 values less than (10), partition p1 values less than (20))`)
+	tk.MustGetErrCode(`alter table ts rebuild partition p0`, errno.ErrUnsupportedDDLOperation)
+}
@@ -0,0 +1,384 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+)
+
+// buildRoutineParams builds the parameters of the stored routine, the charset and collation of the string
+// parameters default to the ones of the database.
+func buildRoutineParams(dbInfo *model.DBInfo, s *ast.ProcedureInfo, sessVars *variable.SessionVars) ([]model.RoutineParam, error) {
+	params := make([]model.RoutineParam, 0, len(s.ProcedureParam))
+	for _, p := range s.ProcedureParam {
+		name := model.NewCIStr(p.ParamName)
+		for _, param := range params {
+			if param.Name.L == name.L {
+				return nil, dbterror.ErrSpDupParam.GenWithStackByArgs(p.ParamName)
+			}
+		}
+		tp := p.ParamType.Clone()
+		if err := setRoutineTypeCharsetCollation(tp, p.ParamName, dbInfo, sessVars); err != nil {
+			return nil, err
+		}
+		mode := model.RoutineParamIn
+		switch p.Paramstatus {
+		case ast.MODE_OUT:
+			mode = model.RoutineParamOut
+		case ast.MODE_INOUT:
+			mode = model.RoutineParamInOut
+		}
+		params = append(params, model.RoutineParam{Name: name, Mode: mode, Type: tp})
+	}
+	return params, nil
+}
+
+func setRoutineTypeCharsetCollation(tp *types.FieldType, name string, dbInfo *model.DBInfo, sessVars *variable.SessionVars) error {
+	chs, coll, err := ResolveCharsetCollation(
+		ast.CharsetOpt{Chs: tp.GetCharset(), Col: tp.GetCollate()},
+		ast.CharsetOpt{Chs: dbInfo.Charset, Col: dbInfo.Collate},
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return setCharsetCollationFlenDecimal(tp, name, chs, coll, sessVars)
+}
+
+// checkRoutineBody checks the statements of the stored procedure or function. The stored functions can only
+// contain the local variables, SET, RETURN and the flow control statements since they are evaluated as
+// expressions of other statements.
+func checkRoutineBody(s *ast.ProcedureInfo) error {
+	checker := &routineBodyChecker{name: s.ProcedureName.Name.O, isFunction: s.IsFunction}
+	params := newRoutineBodyScope()
+	for _, p := range s.ProcedureParam {
+		params.vars[strings.ToLower(p.ParamName)] = struct{}{}
+	}
+	checker.scopes = append(checker.scopes, params)
+	if err := checker.checkStmt(s.ProcedureBody); err != nil {
+		return err
+	}
+	if s.IsFunction && !checker.hasReturn {
+		return dbterror.ErrSpNoreturn.GenWithStackByArgs(s.ProcedureName.Name.O)
+	}
+	return nil
+}
+
+type routineBodyScope struct {
+	vars    map[string]struct{}
+	cursors map[string]struct{}
+}
+
+func newRoutineBodyScope() *routineBodyScope {
+	return &routineBodyScope{vars: make(map[string]struct{}), cursors: make(map[string]struct{})}
+}
+
+type routineLabel struct {
+	name   string
+	isLoop bool
+}
+
+type routineBodyChecker struct {
+	name       string
+	isFunction bool
+	hasReturn  bool
+	scopes     []*routineBodyScope
+	labels     []routineLabel
+}
+
+func (c *routineBodyChecker) hasVar(name string) bool {
+	name = strings.ToLower(name)
+	for _, scope := range c.scopes {
+		if _, ok := scope.vars[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *routineBodyChecker) hasCursor(name string) bool {
+	for _, scope := range c.scopes {
+		if _, ok := scope.cursors[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *routineBodyChecker) checkStmts(stmts []ast.StmtNode) error {
+	for _, stmt := range stmts {
+		if err := c.checkStmt(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *routineBodyChecker) checkStmt(stmt ast.StmtNode) error {
+	switch x := stmt.(type) {
+	case *ast.ProcedureBlock:
+		return c.checkBlock(x)
+	case *ast.ProcedureLabelBlock:
+		if x.LabelError {
+			return dbterror.ErrSpLabelMismatch.GenWithStackByArgs(x.LabelEnd)
+		}
+		c.labels = append(c.labels, routineLabel{name: strings.ToLower(x.LabelName)})
+		defer func() { c.labels = c.labels[:len(c.labels)-1] }()
+		return c.checkBlock(x.Block)
+	case *ast.ProcedureLabelLoop:
+		if x.LabelError {
+			return dbterror.ErrSpLabelMismatch.GenWithStackByArgs(x.LabelEnd)
+		}
+		c.labels = append(c.labels, routineLabel{name: strings.ToLower(x.LabelName), isLoop: true})
+		defer func() { c.labels = c.labels[:len(c.labels)-1] }()
+		return c.checkStmt(x.Block)
+	case *ast.ProcedureJump:
+		return c.checkJump(x)
+	case *ast.ProcedureIfInfo:
+		return c.checkIf(x.IfBody)
+	case *ast.SimpleCaseStmt:
+		for _, when := range x.WhenCases {
+			if err := c.checkStmts(when.ProcedureStmts); err != nil {
+				return err
+			}
+		}
+		return c.checkStmts(x.ElseCases)
+	case *ast.SearchCaseStmt:
+		for _, when := range x.WhenCases {
+			if err := c.checkStmts(when.ProcedureStmts); err != nil {
+				return err
+			}
+		}
+		return c.checkStmts(x.ElseCases)
+	case *ast.ProcedureWhileStmt:
+		return c.checkStmts(x.Body)
+	case *ast.ProcedureRepeatStmt:
+		return c.checkStmts(x.Body)
+	case *ast.ProcedureLoopStmt:
+		return c.checkStmts(x.Body)
+	case *ast.ProcedureOpenCur:
+		return c.checkCursor(x.CurName)
+	case *ast.ProcedureCloseCur:
+		return c.checkCursor(x.CurName)
+	case *ast.ProcedureFetchInto:
+		if err := c.checkCursor(x.CurName); err != nil {
+			return err
+		}
+		for _, v := range x.Variables {
+			if !c.hasVar(v) {
+				return dbterror.ErrSpUndeclaredVar.GenWithStackByArgs(v)
+			}
+		}
+		return nil
+	case *ast.ProcedureReturn:
+		if !c.isFunction {
+			return dbterror.ErrSpBadreturn
+		}
+		c.hasReturn = true
+		return nil
+	case *ast.SetStmt:
+		for _, v := range x.Variables {
+			if !v.IsSystem || (!v.IsGlobal && c.hasVar(v.Name)) {
+				continue
+			}
+			if c.isFunction {
+				return dbterror.ErrNotSupportedYet.GenWithStackByArgs("setting system variables in stored function")
+			}
+			if variable.GetSysVar(v.Name) == nil {
+				return variable.ErrUnknownSystemVar.GenWithStackByArgs(v.Name)
+			}
+		}
+		return nil
+	case *ast.SelectStmt:
+		if x.SelectIntoOpt == nil {
+			return c.checkResultSet()
+		}
+		if c.isFunction {
+			return dbterror.ErrNotSupportedYet.GenWithStackByArgs("SQL statements in stored function")
+		}
+		if x.SelectIntoOpt.Tp == ast.SelectIntoVars {
+			for _, v := range x.SelectIntoOpt.Variables {
+				if col, ok := v.(*ast.ColumnNameExpr); ok && !c.hasVar(col.Name.Name.L) {
+					return dbterror.ErrSpUndeclaredVar.GenWithStackByArgs(col.Name.Name.O)
+				}
+			}
+		}
+		return nil
+	case *ast.SetOprStmt, *ast.ExplainStmt:
+		return c.checkResultSet()
+	case *ast.UseStmt:
+		return dbterror.ErrSpBadstatement.GenWithStackByArgs("USE")
+	default:
+		if c.isFunction {
+			return dbterror.ErrNotSupportedYet.GenWithStackByArgs("SQL statements in stored function")
+		}
+		return nil
+	}
+}
+
+func (c *routineBodyChecker) checkResultSet() error {
+	if c.isFunction {
+		return dbterror.ErrSpNoRetset.GenWithStackByArgs("function")
+	}
+	return dbterror.ErrNotSupportedYet.GenWithStackByArgs("returning result sets from stored procedure")
+}
+
+func (c *routineBodyChecker) checkBlock(block *ast.ProcedureBlock) error {
+	scope := newRoutineBodyScope()
+	c.scopes = append(c.scopes, scope)
+	defer func() { c.scopes = c.scopes[:len(c.scopes)-1] }()
+	var hasCursor, hasHandler bool
+	for _, decl := range block.ProcedureVars {
+		switch x := decl.(type) {
+		case *ast.ProcedureDecl:
+			if hasCursor || hasHandler {
+				return dbterror.ErrSpVarcondAfterCurshndlr
+			}
+			for _, name := range x.DeclNames {
+				if _, ok := scope.vars[name]; ok {
+					return dbterror.ErrSpDupVar.GenWithStackByArgs(name)
+				}
+				scope.vars[name] = struct{}{}
+			}
+		case *ast.ProcedureCursor:
+			if c.isFunction {
+				return dbterror.ErrNotSupportedYet.GenWithStackByArgs("cursors in stored function")
+			}
+			if hasHandler {
+				return dbterror.ErrSpCursorAfterHandler
+			}
+			if _, ok := scope.cursors[x.CurName]; ok {
+				return dbterror.ErrSpDupCurs.GenWithStackByArgs(x.CurName)
+			}
+			scope.cursors[x.CurName] = struct{}{}
+			hasCursor = true
+		case *ast.ProcedureErrorControl:
+			hasHandler = true
+			// The handler statement can't leave or iterate the labels outside.
+			labels := c.labels
+			c.labels = nil
+			err := c.checkStmt(x.Operate)
+			c.labels = labels
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return c.checkStmts(block.ProcedureProcStmts)
+}
+
+func (c *routineBodyChecker) checkIf(ifBlock *ast.ProcedureIfBlock) error {
+	if err := c.checkStmts(ifBlock.ProcedureIfStmts); err != nil {
+		return err
+	}
+	switch x := ifBlock.ProcedureElseStmt.(type) {
+	case *ast.ProcedureElseIfBlock:
+		return c.checkIf(x.ProcedureIfStmt)
+	case *ast.ProcedureElseBlock:
+		return c.checkStmts(x.ProcedureIfStmts)
+	}
+	return nil
+}
+
+func (c *routineBodyChecker) checkJump(jump *ast.ProcedureJump) error {
+	name := strings.ToLower(jump.Name)
+	for i := len(c.labels) - 1; i >= 0; i-- {
+		if c.labels[i].name != name {
+			continue
+		}
+		// ITERATE can only appear within LOOP, REPEAT, and WHILE statements.
+		if jump.IsLeave || c.labels[i].isLoop {
+			return nil
+		}
+		break
+	}
+	if jump.IsLeave {
+		return dbterror.ErrSpLilabelMismatch.GenWithStackByArgs("LEAVE", jump.Name)
+	}
+	return dbterror.ErrSpLilabelMismatch.GenWithStackByArgs("ITERATE", jump.Name)
+}
+
+func (c *routineBodyChecker) checkCursor(name string) error {
+	if c.isFunction {
+		return dbterror.ErrNotSupportedYet.GenWithStackByArgs("cursors in stored function")
+	}
+	if !c.hasCursor(name) {
+		return dbterror.ErrSpCursorMismatch.GenWithStackByArgs(name)
+	}
+	return nil
+}
+
+func onCreateRoutine(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	routine := &model.RoutineInfo{}
+	if err := job.DecodeArgs(routine); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	routines, err := t.ListRoutines(dbInfo.ID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	for _, r := range routines {
+		if r.Type == routine.Type && r.Name.L == routine.Name.L {
+			job.State = model.JobStateCancelled
+			return ver, dbterror.ErrSpAlreadyExists.GenWithStackByArgs(routine.Type, routine.Name)
+		}
+	}
+
+	if err = t.CreateRoutine(dbInfo.ID, routine); err != nil {
+		return ver, errors.Trace(err)
+	}
+	if ver, err = updateSchemaVersion(d, t, job); err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishDBJob(model.JobStateDone, model.StatePublic, ver, dbInfo)
+	return ver, nil
+}
+
+func onDropRoutine(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var routineID int64
+	if err := job.DecodeArgs(&routineID); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if err = t.DropRoutine(dbInfo.ID, routineID); err != nil {
+		if meta.ErrRoutineNotExists.Equal(err) {
+			job.State = model.JobStateCancelled
+		}
+		return ver, errors.Trace(err)
+	}
+	if ver, err = updateSchemaVersion(d, t, job); err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishDBJob(model.JobStateDone, model.StatePublic, ver, dbInfo)
+	return ver, nil
+}
@@ -359,7 +359,13 @@ func getIDs(tables []*model.TableInfo) []int64 {
 	for _, t := range tables {
 		ids = append(ids, t.ID)
 		if t.GetPartitionInfo() != nil {
-			ids = append(ids, getPartitionIDs(t)...)
+			for _, id := range getPartitionIDs(t) {
+				// A non-partitioned table under ALTER TABLE ... PARTITION BY
+				// uses the table ID as its single partition ID.
+				if id != t.ID {
+					ids = append(ids, id)
+				}
+			}
 		}
 	}
 
@@ -280,6 +280,41 @@ func (*Checker) FlashbackCluster(_ sessionctx.Context, _ uint64) (err error) {
 	panic("implement me")
 }
 
+// CreateMaterializedView implements the DDL interface.
+func (d *Checker) CreateMaterializedView(ctx sessionctx.Context, stmt *ast.CreateMaterializedViewStmt) error {
+	return d.realDDL.CreateMaterializedView(ctx, stmt)
+}
+
+// RefreshMaterializedView implements the DDL interface.
+func (d *Checker) RefreshMaterializedView(ctx sessionctx.Context, stmt *ast.RefreshMaterializedViewStmt) error {
+	return d.realDDL.RefreshMaterializedView(ctx, stmt)
+}
+
+// DropMaterializedView implements the DDL interface.
+func (d *Checker) DropMaterializedView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error) {
+	return d.realDDL.DropMaterializedView(ctx, stmt)
+}
+
+// CreateTrigger implements the DDL interface.
+func (d *Checker) CreateTrigger(ctx sessionctx.Context, stmt *ast.CreateTriggerStmt) error {
+	return d.realDDL.CreateTrigger(ctx, stmt)
+}
+
+// DropTrigger implements the DDL interface.
+func (d *Checker) DropTrigger(ctx sessionctx.Context, stmt *ast.DropTriggerStmt) error {
+	return d.realDDL.DropTrigger(ctx, stmt)
+}
+
+// CreateRoutine implements the DDL interface.
+func (d *Checker) CreateRoutine(ctx sessionctx.Context, stmt *ast.ProcedureInfo) error {
+	return d.realDDL.CreateRoutine(ctx, stmt)
+}
+
+// DropRoutine implements the DDL interface.
+func (d *Checker) DropRoutine(ctx sessionctx.Context, stmt *ast.DropProcedureStmt) error {
+	return d.realDDL.DropRoutine(ctx, stmt)
+}
+
 // DropView implements the DDL interface.
 func (d *Checker) DropView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error) {
 	err = d.realDDL.DropView(ctx, stmt)
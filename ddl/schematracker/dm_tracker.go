@@ -362,6 +362,41 @@ func (d SchemaTracker) DropView(_ sessionctx.Context, stmt *ast.DropTableStmt) (
 	return nil
 }
 
+// CreateMaterializedView implements the DDL interface, which is no-op in DM's case.
+func (SchemaTracker) CreateMaterializedView(_ sessionctx.Context, _ *ast.CreateMaterializedViewStmt) error {
+	return nil
+}
+
+// RefreshMaterializedView implements the DDL interface, which is no-op in DM's case.
+func (SchemaTracker) RefreshMaterializedView(_ sessionctx.Context, _ *ast.RefreshMaterializedViewStmt) error {
+	return nil
+}
+
+// DropMaterializedView implements the DDL interface, which is no-op in DM's case.
+func (SchemaTracker) DropMaterializedView(_ sessionctx.Context, _ *ast.DropTableStmt) (err error) {
+	return nil
+}
+
+// CreateTrigger implements the DDL interface, which is no-op in DM's case.
+func (SchemaTracker) CreateTrigger(_ sessionctx.Context, _ *ast.CreateTriggerStmt) error {
+	return nil
+}
+
+// DropTrigger implements the DDL interface, which is no-op in DM's case.
+func (SchemaTracker) DropTrigger(_ sessionctx.Context, _ *ast.DropTriggerStmt) error {
+	return nil
+}
+
+// CreateRoutine implements the DDL interface, which is no-op in DM's case.
+func (SchemaTracker) CreateRoutine(_ sessionctx.Context, _ *ast.ProcedureInfo) error {
+	return nil
+}
+
+// DropRoutine implements the DDL interface, which is no-op in DM's case.
+func (SchemaTracker) DropRoutine(_ sessionctx.Context, _ *ast.DropProcedureStmt) error {
+	return nil
+}
+
 // CreateIndex implements the DDL interface.
 func (d SchemaTracker) CreateIndex(ctx sessionctx.Context, stmt *ast.CreateIndexStmt) error {
 	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
@@ -412,6 +447,17 @@ func (d SchemaTracker) createIndex(
 		return dbterror.ErrDupKeyName.GenWithStack("index already exist %s", indexName)
 	}
 
+	if keyType == ast.IndexKeyTypeFullText {
+		var indexInfo *model.IndexInfo
+		indexInfo, err = ddl.BuildFullTextIndexInfo(tblInfo.Columns, indexName, indexPartSpecifications, indexOption, model.StatePublic)
+		if err != nil {
+			return err
+		}
+		indexInfo.ID = ddl.AllocateIndexID(tblInfo)
+		tblInfo.Indices = append(tblInfo.Indices, indexInfo)
+		return nil
+	}
+
 	hiddenCols, err := ddl.BuildHiddenColumnInfo(ctx, indexPartSpecifications, indexName, t.Meta(), t.Cols())
 	if err != nil {
 		return err
@@ -810,7 +856,10 @@ func (d SchemaTracker) dropTablePartitions(_ sessionctx.Context, ident ast.Ident
 	for i, partCIName := range spec.PartitionNames {
 		partNames[i] = partCIName.L
 	}
-	err = ddl.CheckDropTablePartition(tblInfo, partNames)
+	partNames, err = ddl.GetDropPartitionNames(tblInfo, partNames)
+	if err == nil {
+		err = ddl.CheckDropTablePartition(tblInfo, partNames)
+	}
 	if err != nil {
 		if dbterror.ErrDropPartitionNonExistent.Equal(err) && spec.IfExists {
 			return nil
@@ -945,8 +994,10 @@ func (d SchemaTracker) AlterTable(ctx context.Context, sctx sessionctx.Context,
 					spec.Constraint.Keys, constr.Option, false) // IfNotExists should be not applied
 			case ast.ConstraintPrimaryKey:
 				err = d.createPrimaryKey(sctx, ident, model.NewCIStr(constr.Name), spec.Constraint.Keys, constr.Option)
+			case ast.ConstraintFulltext:
+				err = d.createIndex(sctx, ident, ast.IndexKeyTypeFullText, model.NewCIStr(constr.Name),
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists)
 			case ast.ConstraintForeignKey,
-				ast.ConstraintFulltext,
 				ast.ConstraintCheck:
 			default:
 				// Nothing to do now.
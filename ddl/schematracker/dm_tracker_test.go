@@ -160,11 +160,18 @@ func TestDropColumn(t *testing.T) {
 }
 
 func TestFullTextIndex(t *testing.T) {
-	sql := "create table test.t (a text, fulltext key (a))"
+	sql := "create table test.t (a text, b text, fulltext key (a))"
 
 	tracker := schematracker.NewSchemaTracker(2)
 	tracker.CreateTestDB()
 	execCreate(t, tracker, sql)
+
+	sql = "alter table test.t add fulltext key fb(b) with parser ngram"
+	execAlter(t, tracker, sql)
+	tblInfo := mustTableByName(t, tracker, "test", "t")
+	require.Len(t, tblInfo.Indices, 2)
+	require.True(t, tblInfo.Indices[0].FullText)
+	require.Equal(t, "ngram", tblInfo.Indices[1].ParserName.L)
 }
 
 func checkShowCreateTable(t *testing.T, tblInfo *model.TableInfo, expected string) {
@@ -341,8 +341,13 @@ func onDropTableOrView(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ er
 				return ver, err
 			}
 		}
+		// The dropped materialized view doesn't need the change log anymore.
+		multiInfos, err := unregisterMaterializedViewLog(t, tblInfo)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
 		tblInfo.State = model.StateWriteOnly
-		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != tblInfo.State)
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != tblInfo.State, multiInfos...)
 		if err != nil {
 			return ver, errors.Trace(err)
 		}
@@ -0,0 +1,224 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/util/dbterror"
+)
+
+// FindTrigger finds the trigger by name in the schema, the trigger names are unique in a schema.
+func FindTrigger(is infoschema.InfoSchema, schema, name model.CIStr) (table.Table, *model.TriggerInfo) {
+	for _, tbl := range is.SchemaTables(schema) {
+		for _, trigger := range tbl.Meta().Triggers {
+			if trigger.Name.L == name.L {
+				return tbl, trigger
+			}
+		}
+	}
+	return nil, nil
+}
+
+// checkTriggerBody checks the statements executed by the trigger. The body can be a single
+// INSERT, REPLACE, UPDATE, DELETE or SET statement, or a BEGIN ... END block of them.
+func checkTriggerBody(tblInfo *model.TableInfo, s *ast.CreateTriggerStmt) error {
+	checker := &triggerBodyChecker{tblInfo: tblInfo, timing: s.Timing, event: s.Event}
+	return checker.checkStmt(s.Body)
+}
+
+type triggerBodyChecker struct {
+	tblInfo *model.TableInfo
+	timing  model.TriggerTiming
+	event   model.TriggerEvent
+	err     error
+}
+
+func (c *triggerBodyChecker) checkStmt(stmt ast.StmtNode) error {
+	switch x := stmt.(type) {
+	case *ast.ProcedureBlock:
+		if len(x.ProcedureVars) > 0 {
+			return dbterror.ErrNotSupportedYet.GenWithStackByArgs("DECLARE in trigger")
+		}
+		for _, stmt := range x.ProcedureProcStmts {
+			if err := c.checkStmt(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *ast.SelectStmt, *ast.SetOprStmt:
+		return dbterror.ErrSpNoRetset.GenWithStackByArgs("trigger")
+	case *ast.SetStmt:
+		for _, v := range x.Variables {
+			if err := c.checkAssignment(v); err != nil {
+				return err
+			}
+		}
+	case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+	default:
+		return dbterror.ErrNotSupportedYet.GenWithStackByArgs("this statement in trigger")
+	}
+	stmt.Accept(c)
+	return c.err
+}
+
+// checkAssignment checks `SET NEW.col = expr`, only the NEW row of a BEFORE INSERT or UPDATE trigger can be changed.
+func (c *triggerBodyChecker) checkAssignment(v *ast.VariableAssignment) error {
+	if !v.IsSystem || v.IsGlobal {
+		return nil
+	}
+	row, col, ok := strings.Cut(strings.ToLower(v.Name), ".")
+	if !ok || (row != "new" && row != "old") {
+		return nil
+	}
+	if err := c.checkRow(row, col); err != nil {
+		return err
+	}
+	if row == "old" {
+		return dbterror.ErrTrgCantChangeRow.GenWithStackByArgs("OLD", "")
+	}
+	if c.timing == model.TriggerTimingAfter {
+		return dbterror.ErrTrgCantChangeRow.GenWithStackByArgs("NEW", "after ")
+	}
+	return nil
+}
+
+func (c *triggerBodyChecker) checkRow(row, col string) error {
+	if row == "new" && c.event == model.TriggerEventDelete {
+		return dbterror.ErrTrgNoSuchRowInTrg.GenWithStackByArgs("NEW", "on DELETE")
+	}
+	if row == "old" && c.event == model.TriggerEventInsert {
+		return dbterror.ErrTrgNoSuchRowInTrg.GenWithStackByArgs("OLD", "on INSERT")
+	}
+	if model.FindColumnInfo(c.tblInfo.Columns, col) == nil {
+		return dbterror.ErrBadField.GenWithStackByArgs(col, strings.ToUpper(row))
+	}
+	return nil
+}
+
+// Enter implements ast.Visitor interface.
+func (c *triggerBodyChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.ColumnNameExpr:
+		if x.Name.Schema.L == "" && (x.Name.Table.L == "new" || x.Name.Table.L == "old") && c.err == nil {
+			c.err = c.checkRow(x.Name.Table.L, x.Name.Name.L)
+		}
+	case *ast.SelectStmt:
+		if x.SelectIntoOpt != nil && c.err == nil {
+			c.err = dbterror.ErrNotSupportedYet.GenWithStackByArgs("SELECT INTO in trigger")
+		}
+	}
+	return in, c.err != nil
+}
+
+// Leave implements ast.Visitor interface.
+func (c *triggerBodyChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, c.err == nil
+}
+
+func onCreateTrigger(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	trigger := &model.TriggerInfo{}
+	var follows bool
+	var refName model.CIStr
+	if err := job.DecodeArgs(trigger, &follows, &refName); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	pos := len(tblInfo.Triggers)
+	for i, tr := range tblInfo.Triggers {
+		if tr.Name.L == trigger.Name.L {
+			job.State = model.JobStateCancelled
+			return ver, dbterror.ErrTrgAlreadyExists
+		}
+		if tr.Timing != trigger.Timing || tr.Event != trigger.Event {
+			continue
+		}
+		if refName.L == "" {
+			// Without FOLLOWS or PRECEDES, the new trigger is executed after the existing ones.
+			pos = i + 1
+		} else if tr.Name.L == refName.L {
+			pos = i
+			if follows {
+				pos = i + 1
+			}
+		}
+	}
+	if refName.L != "" && !hasTrigger(tblInfo, refName, trigger.Timing, trigger.Event) {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrTrgDoesNotExist
+	}
+
+	tblInfo.Triggers = append(tblInfo.Triggers, nil)
+	copy(tblInfo.Triggers[pos+1:], tblInfo.Triggers[pos:])
+	tblInfo.Triggers[pos] = trigger
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+func hasTrigger(tblInfo *model.TableInfo, name model.CIStr, timing model.TriggerTiming, event model.TriggerEvent) bool {
+	for _, tr := range tblInfo.Triggers {
+		if tr.Name.L == name.L && tr.Timing == timing && tr.Event == event {
+			return true
+		}
+	}
+	return false
+}
+
+func onDropTrigger(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var name model.CIStr
+	if err := job.DecodeArgs(&name); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	pos := -1
+	for i, tr := range tblInfo.Triggers {
+		if tr.Name.L == name.L {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrTrgDoesNotExist
+	}
+
+	tblInfo.Triggers = append(tblInfo.Triggers[:pos], tblInfo.Triggers[pos+1:]...)
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/domain/resourcegroup"
 	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/event"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/infoschema/perfschema"
 	"github.com/pingcap/tidb/keyspace"
@@ -157,9 +158,11 @@ type Domain struct {
 	planReplayerHandle       *planReplayerHandle
 	extractTaskHandle        *ExtractHandle
 	expiredTimeStamp4PC      types.Time
+	instancePlanCache        atomic.Pointer[sessionctx.InstancePlanCache]
 	logBackupAdvancer        *daemon.OwnerDaemon
 	historicalStatsWorker    *HistoricalStatsWorker
 	ttlJobManager            atomic.Pointer[ttlworker.JobManager]
+	eventManager             atomic.Pointer[event.Manager]
 	runawayManager           *resourcegroup.RunawayManager
 	runawaySyncer            *runawaySyncer
 	resourceGroupsController *rmclient.ResourceGroupsController
@@ -397,6 +400,10 @@ func (do *Domain) fetchSchemasWithTables(schemas []*model.DBInfo, m *meta.Meta,
 			}
 			di.Tables = append(di.Tables, tbl)
 		}
+		if di.Routines, err = m.ListRoutines(di.ID); err != nil {
+			done <- err
+			return
+		}
 	}
 	done <- nil
 }
@@ -493,6 +500,19 @@ func (do *Domain) SetExpiredTimeStamp4PC(time types.Time) {
 	do.expiredTimeStamp4PC = time
 }
 
+// InstancePlanCache returns the instance-level plan cache, it returns nil if the cache isn't set.
+func (do *Domain) InstancePlanCache() sessionctx.InstancePlanCache {
+	if c := do.instancePlanCache.Load(); c != nil {
+		return *c
+	}
+	return nil
+}
+
+// SetInstancePlanCache sets the instance-level plan cache.
+func (do *Domain) SetInstancePlanCache(c sessionctx.InstancePlanCache) {
+	do.instancePlanCache.Store(&c)
+}
+
 // DDL gets DDL from domain.
 func (do *Domain) DDL() ddl.DDL {
 	return do.ddl
@@ -2931,6 +2951,24 @@ func (do *Domain) TTLJobManager() *ttlworker.JobManager {
 	return do.ttlJobManager.Load()
 }
 
+// StartEventManager creates and starts the event manager, the event bodies are executed with the sessions created
+// by sessFactory.
+func (do *Domain) StartEventManager(sessFactory event.SessionFactory) {
+	eventManager := event.NewManager(do.sysSessionPool, sessFactory, do.etcdClient, do.ddl.OwnerManager().IsOwner)
+	do.eventManager.Store(eventManager)
+	do.wg.Run(func() {
+		defer func() {
+			logutil.BgLogger().Info("eventManager exited.")
+		}()
+		eventManager.Run(do.exit)
+	}, "eventManager")
+}
+
+// EventManager returns the event manager on this domain.
+func (do *Domain) EventManager() *event.Manager {
+	return do.eventManager.Load()
+}
+
 // StopAutoAnalyze stops (*Domain).autoAnalyzeWorker to launch new auto analyze jobs.
 func (do *Domain) StopAutoAnalyze() {
 	do.stopAutoAnalyze.Store(true)
@@ -0,0 +1,266 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package event implements the scheduled events. An event is persisted as a timer in `mysql.tidb_timers`, and the
+// timer framework triggers it on the owner when the event scheduler is enabled.
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ngaut/pools"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	timerapi "github.com/pingcap/tidb/timer/api"
+	"github.com/pingcap/tidb/timer/tablestore"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	timerKeyPrefix = "/tidb/event/"
+	timerHookClass = "tidb.event"
+	// oneTimeEventInterval is the interval of the schedule policy of a one-time event. The watermark of a one-time
+	// event is the interval before its execution time, so the timer is triggered at the execution time.
+	oneTimeEventInterval   = time.Second
+	checkSchedulerInterval = time.Second
+)
+
+type sessionPool interface {
+	Get() (pools.Resource, error)
+	Put(pools.Resource)
+}
+
+// Event is a scheduled event with its execution status.
+type Event struct {
+	*model.EventInfo
+	// LastExecuted is the time when the event is executed last time, it's zero if the event is never executed.
+	LastExecuted time.Time
+}
+
+type eventTimerSummary struct {
+	LastExecuted time.Time `json:"last_executed"`
+}
+
+func timerKey(schema, name model.CIStr) string {
+	return fmt.Sprintf("%s%s/%s", timerKeyPrefix, schema.L, name.L)
+}
+
+// Manager manages the scheduled events, and runs the event scheduler when the current node is the owner.
+type Manager struct {
+	store   *timerapi.TimerStore
+	cli     timerapi.TimerClient
+	rt      *eventTimerRuntime
+	isOwner func() bool
+}
+
+// NewManager creates a new event manager. The timers are stored with the sessions in pool, and the event bodies are
+// executed with the sessions created by sessFactory.
+func NewManager(pool sessionPool, sessFactory SessionFactory, etcdCli *clientv3.Client, isOwner func() bool) *Manager {
+	store := tablestore.NewTableTimerStore(1, pool, "mysql", "tidb_timers", etcdCli)
+	return &Manager{
+		store:   store,
+		cli:     timerapi.NewDefaultTimerClient(store),
+		rt:      newEventTimerRuntime(store, sessFactory),
+		isOwner: isOwner,
+	}
+}
+
+// Run runs the event scheduler on the owner until exit is closed.
+func (m *Manager) Run(exit <-chan struct{}) {
+	ticker := time.NewTicker(checkSchedulerInterval)
+	defer func() {
+		ticker.Stop()
+		m.rt.Pause()
+		m.store.Close()
+	}()
+
+	for {
+		select {
+		case <-exit:
+			return
+		case <-ticker.C:
+		}
+
+		if variable.EnableEventScheduler.Load() && m.isOwner != nil && m.isOwner() {
+			m.rt.Resume()
+		} else {
+			m.rt.Pause()
+		}
+	}
+}
+
+// CreateEvent creates a new event.
+func (m *Manager) CreateEvent(ctx context.Context, info *model.EventInfo) error {
+	key := timerKey(info.Schema, info.Name)
+	if _, err := m.cli.GetTimerByKey(ctx, key); err == nil {
+		return exeerrors.ErrEventAlreadyExists.GenWithStackByArgs(info.Name.O)
+	} else if !errors.ErrorEqual(err, timerapi.ErrTimerNotExist) {
+		return err
+	}
+
+	policyExpr, watermark, err := schedulePolicy(info)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = m.cli.CreateTimer(ctx, timerapi.TimerSpec{
+		Key:             key,
+		Data:            data,
+		SchedPolicyType: timerapi.SchedEventInterval,
+		SchedPolicyExpr: policyExpr,
+		HookClass:       timerHookClass,
+		Watermark:       watermark,
+		Enable:          info.Status == model.EventStatusEnabled,
+	})
+	if kv.ErrKeyExists.Equal(err) {
+		return exeerrors.ErrEventAlreadyExists.GenWithStackByArgs(info.Name.O)
+	}
+	return err
+}
+
+// GetEvent gets the event with the name.
+func (m *Manager) GetEvent(ctx context.Context, schema, name model.CIStr) (*Event, error) {
+	timer, err := m.cli.GetTimerByKey(ctx, timerKey(schema, name))
+	if errors.ErrorEqual(err, timerapi.ErrTimerNotExist) {
+		return nil, exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(name.O)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeEvent(timer)
+}
+
+// ListEvents lists all the events.
+func (m *Manager) ListEvents(ctx context.Context) ([]*Event, error) {
+	timers, err := m.cli.GetTimers(ctx, timerapi.WithKeyPrefix(timerKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*Event, 0, len(timers))
+	for _, timer := range timers {
+		event, err := decodeEvent(timer)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// AlterEvent replaces the event with info. The event is renamed if the name of info is different, and it's
+// rescheduled if reschedule is true, otherwise it keeps the progress of the old schedule.
+func (m *Manager) AlterEvent(ctx context.Context, schema, name model.CIStr, info *model.EventInfo, reschedule bool) error {
+	timer, err := m.cli.GetTimerByKey(ctx, timerKey(schema, name))
+	if errors.ErrorEqual(err, timerapi.ErrTimerNotExist) {
+		return exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(name.O)
+	}
+	if err != nil {
+		return err
+	}
+
+	policyExpr, watermark, err := schedulePolicy(info)
+	if err != nil {
+		return err
+	}
+	if !reschedule {
+		watermark = timer.Watermark
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	enable := info.Status == model.EventStatusEnabled
+
+	if newKey := timerKey(info.Schema, info.Name); newKey != timer.Key {
+		if _, err := m.cli.GetTimerByKey(ctx, newKey); err == nil {
+			return exeerrors.ErrEventAlreadyExists.GenWithStackByArgs(info.Name.O)
+		} else if !errors.ErrorEqual(err, timerapi.ErrTimerNotExist) {
+			return err
+		}
+		if _, err = m.cli.CreateTimer(ctx, timerapi.TimerSpec{
+			Key:             newKey,
+			Data:            data,
+			SchedPolicyType: timerapi.SchedEventInterval,
+			SchedPolicyExpr: policyExpr,
+			HookClass:       timerHookClass,
+			Watermark:       watermark,
+			Enable:          enable,
+		}); err != nil {
+			return err
+		}
+		_, err = m.cli.DeleteTimer(ctx, timer.ID)
+		return err
+	}
+
+	return m.cli.UpdateTimer(ctx, timer.ID,
+		timerapi.WithSetData(data),
+		timerapi.WithSetSchedExpr(timerapi.SchedEventInterval, policyExpr),
+		timerapi.WithSetWatermark(watermark),
+		timerapi.WithSetEnable(enable),
+	)
+}
+
+// DropEvent drops the event.
+func (m *Manager) DropEvent(ctx context.Context, schema, name model.CIStr) error {
+	timer, err := m.cli.GetTimerByKey(ctx, timerKey(schema, name))
+	if errors.ErrorEqual(err, timerapi.ErrTimerNotExist) {
+		return exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(name.O)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = m.cli.DeleteTimer(ctx, timer.ID)
+	return err
+}
+
+// DropSchemaEvents drops all the events of the schema.
+func (m *Manager) DropSchemaEvents(ctx context.Context, schema model.CIStr) error {
+	events, err := m.ListEvents(ctx)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		if event.Schema.L != schema.L {
+			continue
+		}
+		if err := m.DropEvent(ctx, event.Schema, event.Name); err != nil && !exeerrors.ErrEventDoesNotExist.Equal(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeEvent(timer *timerapi.TimerRecord) (*Event, error) {
+	event := &Event{EventInfo: &model.EventInfo{}}
+	if err := json.Unmarshal(timer.Data, event.EventInfo); err != nil {
+		return nil, errors.Annotatef(err, "invalid data of the event timer %s", timer.Key)
+	}
+	if len(timer.SummaryData) > 0 {
+		var summary eventTimerSummary
+		if err := json.Unmarshal(timer.SummaryData, &summary); err != nil {
+			return nil, errors.Trace(err)
+		}
+		event.LastExecuted = summary.LastExecuted
+	}
+	return event, nil
+}
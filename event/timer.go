@@ -0,0 +1,285 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	timerapi "github.com/pingcap/tidb/timer/api"
+	timerrt "github.com/pingcap/tidb/timer/runtime"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// maxEventDelay is the max delay to execute a triggered event. An event triggered earlier is not executed any more,
+// it happens when the event is triggered again after the owner is changed.
+const maxEventDelay = 10 * time.Minute
+
+// ExecuteEventBody executes the body of the event with the session authenticated as the definer of the event.
+// It's set by the executor package.
+var ExecuteEventBody func(ctx context.Context, sctx sessionctx.Context, info *model.EventInfo) error
+
+// Session is the session to execute the event body.
+type Session interface {
+	sessionctx.Context
+	// AuthWithoutVerification authenticates the session as the user.
+	AuthWithoutVerification(user *auth.UserIdentity) bool
+	// Close closes the session.
+	Close()
+}
+
+// SessionFactory creates a new session to execute the event body.
+type SessionFactory func() (Session, error)
+
+// Interval returns the interval of a recurring event, such as EVERY '1:30' HOUR_MINUTE.
+func Interval(value, field string) (time.Duration, error) {
+	field = strings.ToUpper(field)
+	if strings.HasSuffix(field, "MICROSECOND") {
+		return 0, dbterror.ErrNotSupportedYet.GenWithStackByArgs(field)
+	}
+	years, months, days, nanos, _, err := types.ParseDurationValue(field, value)
+	if err != nil {
+		return 0, err
+	}
+	if years != 0 || months != 0 {
+		return 0, dbterror.ErrNotSupportedYet.GenWithStackByArgs("the event interval in " + field)
+	}
+	if days < 0 || nanos < 0 || days > math.MaxInt64/int64(24*time.Hour)-1 {
+		return 0, exeerrors.ErrEventIntervalNotPositiveOrTooBig.GenWithStackByArgs()
+	}
+	interval := time.Duration(days)*24*time.Hour + time.Duration(nanos)
+	if interval < time.Second {
+		return 0, exeerrors.ErrEventIntervalNotPositiveOrTooBig.GenWithStackByArgs()
+	}
+	return interval, nil
+}
+
+// schedulePolicy returns the expression of the interval schedule policy of the event, and the watermark which makes
+// the timer triggered at the first execution time of the event.
+func schedulePolicy(info *model.EventInfo) (string, time.Time, error) {
+	if !info.IsRecurring() {
+		return durationExpr(oneTimeEventInterval), info.ExecuteAt.Add(-oneTimeEventInterval), nil
+	}
+	interval, err := Interval(info.IntervalValue, info.IntervalField)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return durationExpr(interval), info.Starts.Add(-interval), nil
+}
+
+func durationExpr(d time.Duration) string {
+	return fmt.Sprintf("%ds", d/time.Second)
+}
+
+// scheduledTime returns the latest execution time of the recurring event which isn't after now, so the executions
+// missed when the scheduler is disabled are skipped.
+func scheduledTime(starts time.Time, interval time.Duration, now time.Time) time.Time {
+	if now.Before(starts) {
+		return starts
+	}
+	return starts.Add(now.Sub(starts) / interval * interval)
+}
+
+type eventTimerHook struct {
+	cli         timerapi.TimerClient
+	sessFactory SessionFactory
+	ctx         context.Context
+	cancel      func()
+	wg          sync.WaitGroup
+	nowFunc     func() time.Time
+}
+
+func newEventTimerHook(cli timerapi.TimerClient, sessFactory SessionFactory) *eventTimerHook {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &eventTimerHook{
+		cli:         cli,
+		sessFactory: sessFactory,
+		ctx:         ctx,
+		cancel:      cancel,
+		nowFunc:     time.Now,
+	}
+}
+
+func (*eventTimerHook) Start() {}
+
+func (h *eventTimerHook) Stop() {
+	h.cancel()
+	h.wg.Wait()
+}
+
+func (*eventTimerHook) OnPreSchedEvent(context.Context, timerapi.TimerShedEvent) (timerapi.PreSchedEventResult, error) {
+	return timerapi.PreSchedEventResult{}, nil
+}
+
+func (h *eventTimerHook) OnSchedEvent(_ context.Context, event timerapi.TimerShedEvent) error {
+	timer := event.Timer()
+	logger := logutil.BgLogger().With(
+		zap.String("key", timer.Key),
+		zap.String("eventID", event.EventID()),
+		zap.Time("eventStart", timer.EventStart),
+	)
+
+	var info model.EventInfo
+	if err := json.Unmarshal(timer.Data, &info); err != nil {
+		logger.Error("invalid event timer data", zap.ByteString("data", timer.Data))
+		return err
+	}
+
+	// execute indicates whether to execute the event body, and completed indicates whether the event is expired
+	// after this execution.
+	var scheduled time.Time
+	var execute, completed bool
+	if info.IsRecurring() {
+		interval, err := Interval(info.IntervalValue, info.IntervalField)
+		if err != nil {
+			return err
+		}
+		scheduled = scheduledTime(info.Starts, interval, timer.EventStart)
+		execute = info.Ends.IsZero() || !scheduled.After(info.Ends)
+		completed = !info.Ends.IsZero() && scheduled.Add(interval).After(info.Ends)
+	} else {
+		// The watermark of a one-time event is its execution time after it's executed.
+		scheduled = info.ExecuteAt
+		execute = timer.Watermark.Before(info.ExecuteAt)
+		completed = true
+	}
+	if !timer.Enable {
+		logger.Info("skip the event because it's disabled")
+		execute, completed = false, false
+	} else if h.nowFunc().Sub(timer.EventStart) > maxEventDelay {
+		logger.Warn("skip the event because it's triggered for a long time")
+		execute = false
+	}
+
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		if execute {
+			logger.Info("execute the event", zap.String("schema", info.Schema.O), zap.String("name", info.Name.O))
+			if err := h.executeEvent(&info); err != nil {
+				logger.Warn("failed to execute the event", zap.Error(err))
+			}
+		}
+		if err := h.closeEvent(timer, event.EventID(), &info, scheduled, execute, completed); err != nil {
+			logger.Warn("failed to close the event", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// executeEvent executes the event body with a new session which is authenticated as the definer.
+func (h *eventTimerHook) executeEvent(info *model.EventInfo) error {
+	se, err := h.sessFactory()
+	if err != nil {
+		return err
+	}
+	// The session is closed rather than put back to a pool because it's authenticated as the definer.
+	defer se.Close()
+
+	if info.Definer == nil {
+		return errors.Errorf("the definer of the event %s.%s is unknown", info.Schema.O, info.Name.O)
+	}
+	user := &auth.UserIdentity{Username: info.Definer.Username, Hostname: info.Definer.Hostname}
+	if info.Definer.AuthUsername != "" || info.Definer.AuthHostname != "" {
+		user = &auth.UserIdentity{Username: info.Definer.AuthUsername, Hostname: info.Definer.AuthHostname}
+	}
+	if !se.AuthWithoutVerification(user) {
+		return errors.Errorf("the definer %s of the event %s.%s doesn't exist", info.Definer.String(), info.Schema.O, info.Name.O)
+	}
+	sessVars := se.GetSessionVars()
+	sessVars.SQLMode = info.SQLMode
+	if info.TimeZone != "" {
+		if err := sessVars.SetSystemVar(variable.TimeZone, info.TimeZone); err != nil {
+			return err
+		}
+	}
+	return ExecuteEventBody(h.ctx, se, info)
+}
+
+// closeEvent closes the timer event, then the expired event is dropped, or disabled if it's preserved.
+func (h *eventTimerHook) closeEvent(timer *timerapi.TimerRecord, eventID string, info *model.EventInfo, scheduled time.Time, executed, completed bool) error {
+	opts := []timerapi.UpdateTimerOption{timerapi.WithSetWatermark(scheduled)}
+	if executed {
+		summary, err := json.Marshal(&eventTimerSummary{LastExecuted: timer.EventStart})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		opts = append(opts, timerapi.WithSetSummaryData(summary))
+	}
+	if err := h.cli.CloseTimerEvent(h.ctx, timer.ID, eventID, opts...); err != nil {
+		return err
+	}
+	if !completed {
+		return nil
+	}
+	if !info.Preserve {
+		_, err := h.cli.DeleteTimer(h.ctx, timer.ID)
+		return err
+	}
+	info.Status = model.EventStatusDisabled
+	data, err := json.Marshal(info)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return h.cli.UpdateTimer(h.ctx, timer.ID, timerapi.WithSetData(data), timerapi.WithSetEnable(false))
+}
+
+type eventTimerRuntime struct {
+	rt          *timerrt.TimerGroupRuntime
+	store       *timerapi.TimerStore
+	sessFactory SessionFactory
+}
+
+func newEventTimerRuntime(store *timerapi.TimerStore, sessFactory SessionFactory) *eventTimerRuntime {
+	return &eventTimerRuntime{
+		store:       store,
+		sessFactory: sessFactory,
+	}
+}
+
+func (r *eventTimerRuntime) Resume() {
+	if r.rt != nil {
+		return
+	}
+
+	r.rt = timerrt.NewTimerRuntimeBuilder("event", r.store).
+		SetCond(&timerapi.TimerCond{Key: timerapi.NewOptionalVal(timerKeyPrefix), KeyPrefix: true}).
+		RegisterHookFactory(timerHookClass, func(hookClass string, cli timerapi.TimerClient) timerapi.Hook {
+			return newEventTimerHook(cli, r.sessFactory)
+		}).
+		Build()
+	r.rt.Start()
+}
+
+func (r *eventTimerRuntime) Pause() {
+	if rt := r.rt; rt != nil {
+		r.rt = nil
+		rt.Stop()
+	}
+}
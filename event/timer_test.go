@@ -0,0 +1,75 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterval(t *testing.T) {
+	cases := []struct {
+		value    string
+		field    string
+		interval time.Duration
+		err      *terror.Error
+	}{
+		{value: "1", field: "SECOND", interval: time.Second},
+		{value: "90", field: "MINUTE", interval: 90 * time.Minute},
+		{value: "1:30", field: "HOUR_MINUTE", interval: 90 * time.Minute},
+		{value: "2", field: "DAY", interval: 48 * time.Hour},
+		{value: "1", field: "WEEK", interval: 7 * 24 * time.Hour},
+		{value: "0", field: "SECOND", err: exeerrors.ErrEventIntervalNotPositiveOrTooBig},
+		{value: "-1", field: "HOUR", err: exeerrors.ErrEventIntervalNotPositiveOrTooBig},
+		{value: "1", field: "MONTH", err: dbterror.ErrNotSupportedYet},
+		{value: "1", field: "MICROSECOND", err: dbterror.ErrNotSupportedYet},
+	}
+	for _, c := range cases {
+		interval, err := Interval(c.value, c.field)
+		if c.err != nil {
+			require.True(t, c.err.Equal(err), "%s %s: %v", c.value, c.field, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, c.interval, interval, "%s %s", c.value, c.field)
+	}
+}
+
+func TestSchedulePolicy(t *testing.T) {
+	at := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	expr, watermark, err := schedulePolicy(&model.EventInfo{ExecuteAt: at})
+	require.NoError(t, err)
+	require.Equal(t, "1s", expr)
+	require.Equal(t, at.Add(-time.Second), watermark)
+
+	expr, watermark, err = schedulePolicy(&model.EventInfo{IntervalValue: "1:30", IntervalField: "HOUR_MINUTE", Starts: at})
+	require.NoError(t, err)
+	require.Equal(t, "5400s", expr)
+	require.Equal(t, at.Add(-90*time.Minute), watermark)
+}
+
+func TestScheduledTime(t *testing.T) {
+	starts := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, starts, scheduledTime(starts, time.Hour, starts.Add(-time.Minute)))
+	require.Equal(t, starts, scheduledTime(starts, time.Hour, starts))
+	require.Equal(t, starts, scheduledTime(starts, time.Hour, starts.Add(59*time.Minute)))
+	require.Equal(t, starts.Add(3*time.Hour), scheduledTime(starts, time.Hour, starts.Add(3*time.Hour+time.Second)))
+}
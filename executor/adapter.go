@@ -52,6 +52,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/sessiontxn"
 	"github.com/pingcap/tidb/sessiontxn/staleread"
+	"github.com/pingcap/tidb/statistics"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/breakpoint"
 	"github.com/pingcap/tidb/util/chunk"
@@ -572,6 +573,7 @@ func (a *ExecStmt) Exec(ctx context.Context) (_ sqlexec.RecordSet, err error) {
 	}
 
 	a.prepareFKCascadeContext(e)
+	a.prepareTriggerContext(e)
 	if handled, result, err := a.handleNoDelay(ctx, e, isPessimistic); handled || err != nil {
 		return result, err
 	}
@@ -789,10 +791,10 @@ func (a *ExecStmt) handleNoDelay(ctx context.Context, e exec.Executor, isPessimi
 		handled = !isExplainAnalyze
 		if isPessimistic {
 			err := a.handlePessimisticDML(ctx, toCheck)
-			return handled, nil, err
+			return handled, nil, a.finishTriggerContext(err)
 		}
 		r, err := a.handleNoDelayExecutor(ctx, toCheck)
-		return handled, r, err
+		return handled, r, a.finishTriggerContext(err)
 	} else if proj, ok := toCheck.(*ProjectionExec); ok && proj.calculateNoDelay {
 		// Currently this is only for the "DO" statement. Take "DO 1, @a=2;" as an example:
 		// the Projection has two expressions and two columns in the schema, but we should
@@ -1140,7 +1142,10 @@ func (a *ExecStmt) handlePessimisticLockError(ctx context.Context, lockErr error
 	if err != nil {
 		return nil, err
 	}
-	// Rollback the statement change before retry it.
+	// Rollback the statement change before retry it, including the changes flushed by the triggers.
+	if err = a.rollbackTriggerChanges(); err != nil {
+		return nil, err
+	}
 	a.Ctx.StmtRollback(ctx, true)
 	a.Ctx.GetSessionVars().StmtCtx.ResetForRetry()
 	a.Ctx.GetSessionVars().RetryInfo.ResetOffset()
@@ -1340,6 +1345,17 @@ func (a *ExecStmt) observePhaseDurations(internal bool, commitDetails *util.Comm
 // 3. record execute duration metric.
 // 4. update the `PrevStmt` in session variable.
 // 5. reset `DurationParse` in session variable.
+// updateCardinalityFeedback compares the actual row counts of the data sources with the estimated ones, and
+// updates the cardinality feedback of the statement digest.
+func (a *ExecStmt) updateCardinalityFeedback(succ bool) {
+	sc := a.Ctx.GetSessionVars().StmtCtx
+	if !succ || len(sc.CardinalityEstimates) == 0 {
+		return
+	}
+	_, digest := sc.SQLDigest()
+	statistics.CardinalityFeedback.Update(digest.String(), plannercore.CollectCardinalityFeedback(a.Ctx, a.Plan))
+}
+
 func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults bool) {
 	a.checkPlanReplayerCapture(txnTS)
 
@@ -1373,6 +1389,7 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 	// `LowSlowQuery` and `SummaryStmt` must be called before recording `PrevStmt`.
 	a.LogSlowQuery(txnTS, succ, hasMoreResults)
 	a.SummaryStmt(succ)
+	a.updateCardinalityFeedback(succ)
 	a.observeStmtFinishedForTopSQL()
 	if sessVars.StmtCtx.IsTiFlash.Load() {
 		if succ {
@@ -76,8 +76,18 @@ func Build(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc, ordinal
 	return nil
 }
 
+// WindowFuncOptions holds the modifiers of a window function which are not
+// described by its aggregate function description.
+type WindowFuncOptions struct {
+	// IgnoreNulls means the null treatment is `IGNORE NULLS`, it's used by
+	// `first_value`, `last_value`, `nth_value`, `lead` and `lag`.
+	IgnoreNulls bool
+	// FromLast means `nth_value` counts the rows from the last row of the frame.
+	FromLast bool
+}
+
 // BuildWindowFunctions builds specific window function according to function description and order by columns.
-func BuildWindowFunctions(ctx sessionctx.Context, windowFuncDesc *aggregation.AggFuncDesc, ordinal int, orderByCols []*expression.Column) AggFunc {
+func BuildWindowFunctions(ctx sessionctx.Context, windowFuncDesc *aggregation.AggFuncDesc, ordinal int, orderByCols []*expression.Column, opts WindowFuncOptions) AggFunc {
 	switch windowFuncDesc.Name {
 	case ast.WindowFuncRank:
 		return buildRank(ordinal, orderByCols, false)
@@ -86,26 +96,32 @@ func BuildWindowFunctions(ctx sessionctx.Context, windowFuncDesc *aggregation.Ag
 	case ast.WindowFuncRowNumber:
 		return buildRowNumber(windowFuncDesc, ordinal)
 	case ast.WindowFuncFirstValue:
-		return buildFirstValue(windowFuncDesc, ordinal)
+		return buildFirstValue(windowFuncDesc, ordinal, opts)
 	case ast.WindowFuncLastValue:
-		return buildLastValue(windowFuncDesc, ordinal)
+		return buildLastValue(windowFuncDesc, ordinal, opts)
 	case ast.WindowFuncCumeDist:
 		return buildCumeDist(ordinal, orderByCols)
 	case ast.WindowFuncNthValue:
-		return buildNthValue(windowFuncDesc, ordinal)
+		return buildNthValue(windowFuncDesc, ordinal, opts)
 	case ast.WindowFuncNtile:
 		return buildNtile(windowFuncDesc, ordinal)
 	case ast.WindowFuncPercentRank:
 		return buildPercentRank(ordinal, orderByCols)
 	case ast.WindowFuncLead:
-		return buildLead(ctx, windowFuncDesc, ordinal)
+		return buildLead(ctx, windowFuncDesc, ordinal, opts)
 	case ast.WindowFuncLag:
-		return buildLag(ctx, windowFuncDesc, ordinal)
+		return buildLag(ctx, windowFuncDesc, ordinal, opts)
 	case ast.AggFuncMax:
 		// The max/min aggFunc using in the window function will using the sliding window algo.
 		return buildMaxMinInWindowFunction(windowFuncDesc, ordinal, true)
 	case ast.AggFuncMin:
 		return buildMaxMinInWindowFunction(windowFuncDesc, ordinal, false)
+	case ast.AggFuncCount:
+		if windowFuncDesc.HasDistinct {
+			// The count distinct aggFunc using in the window function will using the sliding window algo.
+			return buildCountDistinctInWindowFunction(windowFuncDesc, ordinal)
+		}
+		return Build(ctx, windowFuncDesc, ordinal)
 	default:
 		return Build(ctx, windowFuncDesc, ordinal)
 	}
@@ -449,6 +465,20 @@ func buildMaxMinInWindowFunction(aggFuncDesc *aggregation.AggFuncDesc, ordinal i
 	return base
 }
 
+// buildCountDistinctInWindowFunction builds the AggFunc implementation for function "COUNT(DISTINCT)" in window function.
+func buildCountDistinctInWindowFunction(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
+	collators := make([]collate.Collator, 0, len(aggFuncDesc.Args))
+	for _, arg := range aggFuncDesc.Args {
+		collators = append(collators, collate.GetCollator(arg.GetType().GetCollate()))
+	}
+	base := baseAggFunc{
+		args:    aggFuncDesc.Args,
+		ordinal: ordinal,
+		retTp:   aggFuncDesc.RetTp,
+	}
+	return &countOriginalWithDistinctSliding{baseCount: baseCount{base}, collators: collators}
+}
+
 // buildGroupConcat builds the AggFunc implementation for function "GROUP_CONCAT".
 func buildGroupConcat(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
 	switch aggFuncDesc.Mode {
@@ -644,20 +674,20 @@ func buildRank(ordinal int, orderByCols []*expression.Column, isDense bool) AggF
 	return r
 }
 
-func buildFirstValue(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
+func buildFirstValue(aggFuncDesc *aggregation.AggFuncDesc, ordinal int, opts WindowFuncOptions) AggFunc {
 	base := baseAggFunc{
 		args:    aggFuncDesc.Args,
 		ordinal: ordinal,
 	}
-	return &firstValue{baseAggFunc: base, tp: aggFuncDesc.RetTp}
+	return &firstValue{baseAggFunc: base, tp: aggFuncDesc.RetTp, ignoreNulls: opts.IgnoreNulls}
 }
 
-func buildLastValue(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
+func buildLastValue(aggFuncDesc *aggregation.AggFuncDesc, ordinal int, opts WindowFuncOptions) AggFunc {
 	base := baseAggFunc{
 		args:    aggFuncDesc.Args,
 		ordinal: ordinal,
 	}
-	return &lastValue{baseAggFunc: base, tp: aggFuncDesc.RetTp}
+	return &lastValue{baseAggFunc: base, tp: aggFuncDesc.RetTp, ignoreNulls: opts.IgnoreNulls}
 }
 
 func buildCumeDist(ordinal int, orderByCols []*expression.Column) AggFunc {
@@ -668,14 +698,14 @@ func buildCumeDist(ordinal int, orderByCols []*expression.Column) AggFunc {
 	return r
 }
 
-func buildNthValue(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
+func buildNthValue(aggFuncDesc *aggregation.AggFuncDesc, ordinal int, opts WindowFuncOptions) AggFunc {
 	base := baseAggFunc{
 		args:    aggFuncDesc.Args,
 		ordinal: ordinal,
 	}
 	// Already checked when building the function description.
 	nth, _, _ := expression.GetUint64FromConstant(aggFuncDesc.Args[1])
-	return &nthValue{baseAggFunc: base, tp: aggFuncDesc.RetTp, nth: nth}
+	return &nthValue{baseAggFunc: base, tp: aggFuncDesc.RetTp, nth: nth, ignoreNulls: opts.IgnoreNulls, fromLast: opts.FromLast}
 }
 
 func buildNtile(aggFuncDes *aggregation.AggFuncDesc, ordinal int) AggFunc {
@@ -694,7 +724,7 @@ func buildPercentRank(ordinal int, orderByCols []*expression.Column) AggFunc {
 	return &percentRank{baseAggFunc: base, rowComparer: buildRowComparer(orderByCols)}
 }
 
-func buildLeadLag(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc, ordinal int) baseLeadLag {
+func buildLeadLag(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc, ordinal int, opts WindowFuncOptions) baseLeadLag {
 	offset := uint64(1)
 	if len(aggFuncDesc.Args) >= 2 {
 		offset, _, _ = expression.GetUint64FromConstant(aggFuncDesc.Args[1])
@@ -715,13 +745,13 @@ func buildLeadLag(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc,
 		ordinal: ordinal,
 	}
 	ve, _ := buildValueEvaluator(aggFuncDesc.RetTp)
-	return baseLeadLag{baseAggFunc: base, offset: offset, defaultExpr: defaultExpr, valueEvaluator: ve}
+	return baseLeadLag{baseAggFunc: base, offset: offset, defaultExpr: defaultExpr, ignoreNulls: opts.IgnoreNulls, valueEvaluator: ve}
 }
 
-func buildLead(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
-	return &lead{buildLeadLag(ctx, aggFuncDesc, ordinal)}
+func buildLead(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc, ordinal int, opts WindowFuncOptions) AggFunc {
+	return &lead{buildLeadLag(ctx, aggFuncDesc, ordinal, opts)}
 }
 
-func buildLag(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
-	return &lag{buildLeadLag(ctx, aggFuncDesc, ordinal)}
+func buildLag(ctx sessionctx.Context, aggFuncDesc *aggregation.AggFuncDesc, ordinal int, opts WindowFuncOptions) AggFunc {
+	return &lag{buildLeadLag(ctx, aggFuncDesc, ordinal, opts)}
 }
@@ -46,6 +46,8 @@ const (
 	DefPartialResult4CountDistinctStringSize = int64(unsafe.Sizeof(partialResult4CountDistinctString{}))
 	// DefPartialResult4CountWithDistinctSize is the size of partialResult4CountWithDistinct
 	DefPartialResult4CountWithDistinctSize = int64(unsafe.Sizeof(partialResult4CountWithDistinct{}))
+	// DefPartialResult4CountWithDistinctSlidingSize is the size of partialResult4CountWithDistinctSliding
+	DefPartialResult4CountWithDistinctSlidingSize = int64(unsafe.Sizeof(partialResult4CountWithDistinctSliding{}))
 	// DefPartialResult4ApproxCountDistinctSize is the size of partialResult4ApproxCountDistinct
 	DefPartialResult4ApproxCountDistinctSize = int64(unsafe.Sizeof(partialResult4ApproxCountDistinct{}))
 )
@@ -355,6 +357,109 @@ func (e *countOriginalWithDistinct) UpdatePartialResult(sctx sessionctx.Context,
 	return memDelta, nil
 }
 
+// countOriginalWithDistinctSliding is used by `count(distinct)` in window
+// functions. It counts the occurrences of each distinct value, so the rows
+// leaving the window frame can be removed when the frame slides.
+type countOriginalWithDistinctSliding struct {
+	baseCount
+	collators []collate.Collator
+}
+
+type partialResult4CountWithDistinctSliding struct {
+	valCnt map[string]int64
+}
+
+func (*countOriginalWithDistinctSliding) AllocPartialResult() (pr PartialResult, memDelta int64) {
+	return PartialResult(&partialResult4CountWithDistinctSliding{
+		valCnt: make(map[string]int64),
+	}), DefPartialResult4CountWithDistinctSlidingSize
+}
+
+func (*countOriginalWithDistinctSliding) ResetPartialResult(pr PartialResult) {
+	p := (*partialResult4CountWithDistinctSliding)(pr)
+	p.valCnt = make(map[string]int64)
+}
+
+func (e *countOriginalWithDistinctSliding) AppendFinalResult2Chunk(_ sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
+	p := (*partialResult4CountWithDistinctSliding)(pr)
+	chk.AppendInt64(e.ordinal, int64(len(p.valCnt)))
+	return nil
+}
+
+// encodeRow encodes the arguments of the row, hasNull is true if any of them is null.
+func (e *countOriginalWithDistinctSliding) encodeRow(sctx sessionctx.Context, row chunk.Row, buf, encodedBytes []byte) (_ []byte, hasNull bool, err error) {
+	encodedBytes = encodedBytes[:0]
+	for i, arg := range e.args {
+		encodedBytes, hasNull, err = evalAndEncode(sctx, arg, e.collators[i], row, buf, encodedBytes)
+		if err != nil || hasNull {
+			return encodedBytes, hasNull, err
+		}
+	}
+	return encodedBytes, false, nil
+}
+
+func (e *countOriginalWithDistinctSliding) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
+	p := (*partialResult4CountWithDistinctSliding)(pr)
+	var (
+		encodedBytes []byte
+		hasNull      bool
+	)
+	// decimal struct is the biggest type we will use.
+	buf := make([]byte, types.MyDecimalStructSize)
+	for _, row := range rowsInGroup {
+		encodedBytes, hasNull, err = e.encodeRow(sctx, row, buf, encodedBytes)
+		if err != nil {
+			return memDelta, err
+		}
+		if hasNull {
+			continue
+		}
+		cnt, ok := p.valCnt[string(encodedBytes)]
+		if !ok {
+			memDelta += int64(len(encodedBytes)) + 8
+		}
+		p.valCnt[string(encodedBytes)] = cnt + 1
+	}
+	return memDelta, nil
+}
+
+var _ SlidingWindowAggFunc = &countOriginalWithDistinctSliding{}
+
+func (e *countOriginalWithDistinctSliding) Slide(sctx sessionctx.Context, getRow func(uint64) chunk.Row, lastStart, lastEnd uint64, shiftStart, shiftEnd uint64, pr PartialResult) error {
+	p := (*partialResult4CountWithDistinctSliding)(pr)
+	var (
+		encodedBytes []byte
+		hasNull      bool
+		err          error
+	)
+	buf := make([]byte, types.MyDecimalStructSize)
+	for i := uint64(0); i < shiftEnd; i++ {
+		encodedBytes, hasNull, err = e.encodeRow(sctx, getRow(lastEnd+i), buf, encodedBytes)
+		if err != nil {
+			return err
+		}
+		if hasNull {
+			continue
+		}
+		p.valCnt[string(encodedBytes)]++
+	}
+	for i := uint64(0); i < shiftStart; i++ {
+		encodedBytes, hasNull, err = e.encodeRow(sctx, getRow(lastStart+i), buf, encodedBytes)
+		if err != nil {
+			return err
+		}
+		if hasNull {
+			continue
+		}
+		if p.valCnt[string(encodedBytes)] <= 1 {
+			delete(p.valCnt, string(encodedBytes))
+		} else {
+			p.valCnt[string(encodedBytes)]--
+		}
+	}
+	return nil
+}
+
 // evalAndEncode eval one row with an expression and encode value to bytes.
 func evalAndEncode(
 	sctx sessionctx.Context, arg expression.Expression, collator collate.Collator,
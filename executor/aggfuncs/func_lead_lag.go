@@ -33,11 +33,20 @@ type baseLeadLag struct {
 
 	defaultExpr expression.Expression
 	offset      uint64
+	ignoreNulls bool
 }
 
 type partialResult4LeadLag struct {
 	rows   []chunk.Row
 	curIdx uint64
+
+	// The fields below are only used by `IGNORE NULLS`.
+	// nonNullIdx stores the indices of the rows whose argument is not null,
+	// it covers the first scannedRows rows.
+	nonNullIdx  []uint64
+	scannedRows uint64
+	// nonNullCnt is the number of elements in nonNullIdx which are less than curIdx.
+	nonNullCnt uint64
 }
 
 func (*baseLeadLag) AllocPartialResult() (pr PartialResult, memDelta int64) {
@@ -48,6 +57,9 @@ func (*baseLeadLag) ResetPartialResult(pr PartialResult) {
 	p := (*partialResult4LeadLag)(pr)
 	p.rows = p.rows[:0]
 	p.curIdx = 0
+	p.nonNullIdx = p.nonNullIdx[:0]
+	p.scannedRows = 0
+	p.nonNullCnt = 0
 }
 
 func (*baseLeadLag) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
@@ -57,19 +69,58 @@ func (*baseLeadLag) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chun
 	return memDelta, nil
 }
 
+// locateNonNullRows finds the rows whose argument is not null, and moves
+// nonNullCnt to the current row.
+func (v *baseLeadLag) locateNonNullRows(p *partialResult4LeadLag) error {
+	for ; p.scannedRows < uint64(len(p.rows)); p.scannedRows++ {
+		isNull, err := isNullArg(v.args[0], p.rows[p.scannedRows])
+		if err != nil {
+			return err
+		}
+		if !isNull {
+			p.nonNullIdx = append(p.nonNullIdx, p.scannedRows)
+		}
+	}
+	for p.nonNullCnt < uint64(len(p.nonNullIdx)) && p.nonNullIdx[p.nonNullCnt] < p.curIdx {
+		p.nonNullCnt++
+	}
+	return nil
+}
+
+// evaluateTarget evaluates the argument on the row at targetIdx, or the default
+// value if the row doesn't exist.
+func (v *baseLeadLag) evaluateTarget(sctx sessionctx.Context, p *partialResult4LeadLag, targetIdx uint64, exists bool) error {
+	var err error
+	if exists {
+		_, err = v.evaluateRow(sctx, v.args[0], p.rows[targetIdx])
+	} else {
+		_, err = v.evaluateRow(sctx, v.defaultExpr, p.rows[p.curIdx])
+	}
+	return err
+}
+
 type lead struct {
 	baseLeadLag
 }
 
 func (v *lead) AppendFinalResult2Chunk(sctx sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4LeadLag)(pr)
-	var err error
-	if p.curIdx+v.offset < uint64(len(p.rows)) {
-		_, err = v.evaluateRow(sctx, v.args[0], p.rows[p.curIdx+v.offset])
-	} else {
-		_, err = v.evaluateRow(sctx, v.defaultExpr, p.rows[p.curIdx])
+	targetIdx, exists := p.curIdx+v.offset, p.curIdx+v.offset < uint64(len(p.rows))
+	if v.ignoreNulls && v.offset > 0 {
+		if err := v.locateNonNullRows(p); err != nil {
+			return err
+		}
+		// The target is the offset-th non-null row after the current row.
+		i := p.nonNullCnt + v.offset - 1
+		if p.nonNullCnt < uint64(len(p.nonNullIdx)) && p.nonNullIdx[p.nonNullCnt] == p.curIdx {
+			i++
+		}
+		exists = i < uint64(len(p.nonNullIdx))
+		if exists {
+			targetIdx = p.nonNullIdx[i]
+		}
 	}
-	if err != nil {
+	if err := v.evaluateTarget(sctx, p, targetIdx, exists); err != nil {
 		return err
 	}
 	v.appendResult(chk, v.ordinal)
@@ -83,13 +134,18 @@ type lag struct {
 
 func (v *lag) AppendFinalResult2Chunk(sctx sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4LeadLag)(pr)
-	var err error
-	if p.curIdx >= v.offset {
-		_, err = v.evaluateRow(sctx, v.args[0], p.rows[p.curIdx-v.offset])
-	} else {
-		_, err = v.evaluateRow(sctx, v.defaultExpr, p.rows[p.curIdx])
+	targetIdx, exists := p.curIdx-v.offset, p.curIdx >= v.offset
+	if v.ignoreNulls && v.offset > 0 {
+		if err := v.locateNonNullRows(p); err != nil {
+			return err
+		}
+		// The target is the offset-th non-null row before the current row.
+		exists = p.nonNullCnt >= v.offset
+		if exists {
+			targetIdx = p.nonNullIdx[p.nonNullCnt-v.offset]
+		}
 	}
-	if err != nil {
+	if err := v.evaluateTarget(sctx, p, targetIdx, exists); err != nil {
 		return err
 	}
 	v.appendResult(chk, v.ordinal)
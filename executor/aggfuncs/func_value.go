@@ -240,7 +240,8 @@ func buildValueEvaluator(tp *types.FieldType) (ve valueEvaluator, memDelta int64
 type firstValue struct {
 	baseAggFunc
 
-	tp *types.FieldType
+	tp          *types.FieldType
+	ignoreNulls bool
 }
 
 type partialResult4FirstValue struct {
@@ -264,12 +265,22 @@ func (v *firstValue) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []
 	if p.gotFirstValue {
 		return 0, nil
 	}
-	if len(rowsInGroup) > 0 {
+	for _, row := range rowsInGroup {
+		if v.ignoreNulls {
+			isNull, err := isNullArg(v.args[0], row)
+			if err != nil {
+				return 0, err
+			}
+			if isNull {
+				continue
+			}
+		}
 		p.gotFirstValue = true
-		memDelta, err = p.evaluator.evaluateRow(sctx, v.args[0], rowsInGroup[0])
+		memDelta, err = p.evaluator.evaluateRow(sctx, v.args[0], row)
 		if err != nil {
 			return 0, err
 		}
+		break
 	}
 	return memDelta, nil
 }
@@ -287,7 +298,8 @@ func (v *firstValue) AppendFinalResult2Chunk(_ sessionctx.Context, pr PartialRes
 type lastValue struct {
 	baseAggFunc
 
-	tp *types.FieldType
+	tp          *types.FieldType
+	ignoreNulls bool
 }
 
 type partialResult4LastValue struct {
@@ -297,7 +309,7 @@ type partialResult4LastValue struct {
 
 func (v *lastValue) AllocPartialResult() (pr PartialResult, memDelta int64) {
 	ve, veMemDelta := buildValueEvaluator(v.tp)
-	p := &partialResult4FirstValue{evaluator: ve}
+	p := &partialResult4LastValue{evaluator: ve}
 	return PartialResult(p), DefPartialResult4LastValueSize + veMemDelta
 }
 
@@ -308,12 +320,22 @@ func (*lastValue) ResetPartialResult(pr PartialResult) {
 
 func (v *lastValue) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
 	p := (*partialResult4LastValue)(pr)
-	if len(rowsInGroup) > 0 {
+	for i := len(rowsInGroup) - 1; i >= 0; i-- {
+		if v.ignoreNulls {
+			isNull, err := isNullArg(v.args[0], rowsInGroup[i])
+			if err != nil {
+				return 0, err
+			}
+			if isNull {
+				continue
+			}
+		}
 		p.gotLastValue = true
-		memDelta, err = p.evaluator.evaluateRow(sctx, v.args[0], rowsInGroup[len(rowsInGroup)-1])
+		memDelta, err = p.evaluator.evaluateRow(sctx, v.args[0], rowsInGroup[i])
 		if err != nil {
 			return 0, err
 		}
+		break
 	}
 	return memDelta, nil
 }
@@ -331,24 +353,32 @@ func (v *lastValue) AppendFinalResult2Chunk(_ sessionctx.Context, pr PartialResu
 type nthValue struct {
 	baseAggFunc
 
-	tp  *types.FieldType
-	nth uint64
+	tp          *types.FieldType
+	nth         uint64
+	ignoreNulls bool
+	fromLast    bool
 }
 
 type partialResult4NthValue struct {
 	seenRows  uint64
 	evaluator valueEvaluator
+	// rows and evaluated are only used by `FROM LAST`, the nth row can only be
+	// located after all the rows of the frame are seen.
+	rows      []chunk.Row
+	evaluated bool
 }
 
 func (v *nthValue) AllocPartialResult() (pr PartialResult, memDelta int64) {
 	ve, veMemDelta := buildValueEvaluator(v.tp)
-	p := &partialResult4FirstValue{evaluator: ve}
+	p := &partialResult4NthValue{evaluator: ve}
 	return PartialResult(p), DefPartialResult4NthValueSize + veMemDelta
 }
 
 func (*nthValue) ResetPartialResult(pr PartialResult) {
 	p := (*partialResult4NthValue)(pr)
 	p.seenRows = 0
+	p.rows = p.rows[:0]
+	p.evaluated = false
 }
 
 func (v *nthValue) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
@@ -356,19 +386,60 @@ func (v *nthValue) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []ch
 		return 0, nil
 	}
 	p := (*partialResult4NthValue)(pr)
-	numRows := uint64(len(rowsInGroup))
-	if v.nth > p.seenRows && v.nth-p.seenRows <= numRows {
-		memDelta, err = p.evaluator.evaluateRow(sctx, v.args[0], rowsInGroup[v.nth-p.seenRows-1])
+	if v.fromLast {
+		p.rows = append(p.rows, rowsInGroup...)
+		p.evaluated = false
+		return int64(len(rowsInGroup)) * DefRowSize, nil
+	}
+	if !v.ignoreNulls {
+		numRows := uint64(len(rowsInGroup))
+		if v.nth > p.seenRows && v.nth-p.seenRows <= numRows {
+			memDelta, err = p.evaluator.evaluateRow(sctx, v.args[0], rowsInGroup[v.nth-p.seenRows-1])
+			if err != nil {
+				return 0, err
+			}
+		}
+		p.seenRows += numRows
+		return memDelta, nil
+	}
+	for _, row := range rowsInGroup {
+		if p.seenRows >= v.nth {
+			break
+		}
+		memDelta, err = v.seeRow(sctx, row, p)
 		if err != nil {
 			return 0, err
 		}
 	}
-	p.seenRows += numRows
 	return memDelta, nil
 }
 
-func (v *nthValue) AppendFinalResult2Chunk(_ sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
+// seeRow counts the row if it's not ignored, and evaluates it if it's the nth row.
+func (v *nthValue) seeRow(sctx sessionctx.Context, row chunk.Row, p *partialResult4NthValue) (memDelta int64, err error) {
+	if v.ignoreNulls {
+		isNull, err := isNullArg(v.args[0], row)
+		if err != nil || isNull {
+			return 0, err
+		}
+	}
+	p.seenRows++
+	if p.seenRows == v.nth {
+		return p.evaluator.evaluateRow(sctx, v.args[0], row)
+	}
+	return 0, nil
+}
+
+func (v *nthValue) AppendFinalResult2Chunk(sctx sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4NthValue)(pr)
+	if v.fromLast && !p.evaluated {
+		p.evaluated = true
+		p.seenRows = 0
+		for i := len(p.rows) - 1; i >= 0 && p.seenRows < v.nth; i-- {
+			if _, err := v.seeRow(sctx, p.rows[i], p); err != nil {
+				return err
+			}
+		}
+	}
 	if v.nth == 0 || p.seenRows < v.nth {
 		chk.AppendNull(v.ordinal)
 	} else {
@@ -376,3 +447,13 @@ func (v *nthValue) AppendFinalResult2Chunk(_ sessionctx.Context, pr PartialResul
 	}
 	return nil
 }
+
+// isNullArg checks whether the argument of a value function is null on the row,
+// it's used to skip the null values for `IGNORE NULLS`.
+func isNullArg(arg expression.Expression, row chunk.Row) (bool, error) {
+	d, err := arg.Eval(row)
+	if err != nil {
+		return false, err
+	}
+	return d.IsNull(), nil
+}
@@ -61,7 +61,7 @@ func testWindowFunc(t *testing.T, p windowTest) {
 
 	desc, err := aggregation.NewAggFuncDesc(ctx, p.funcName, p.args, false)
 	require.NoError(t, err)
-	finalFunc := aggfuncs.BuildWindowFunctions(ctx, desc, 0, p.orderByCols)
+	finalFunc := aggfuncs.BuildWindowFunctions(ctx, desc, 0, p.orderByCols, aggfuncs.WindowFuncOptions{})
 	finalPr, _ := finalFunc.AllocPartialResult()
 	resultChk := chunk.NewChunkWithCapacity([]*types.FieldType{desc.RetTp}, 1)
 
@@ -90,7 +90,7 @@ func testWindowAggMemFunc(t *testing.T, p windowMemTest) {
 
 	desc, err := aggregation.NewAggFuncDesc(ctx, p.windowTest.funcName, p.windowTest.args, false)
 	require.NoError(t, err)
-	finalFunc := aggfuncs.BuildWindowFunctions(ctx, desc, 0, p.windowTest.orderByCols)
+	finalFunc := aggfuncs.BuildWindowFunctions(ctx, desc, 0, p.windowTest.orderByCols, aggfuncs.WindowFuncOptions{})
 	finalPr, memDelta := finalFunc.AllocPartialResult()
 	require.Equal(t, p.allocMemDelta, memDelta)
 
@@ -207,6 +207,8 @@ func (b *executorBuilder) build(p plannercore.Plan) exec.Executor {
 		return b.buildUnlockStats(v)
 	case *plannercore.IndexAdvise:
 		return b.buildIndexAdvise(v)
+	case *plannercore.AdviseIndex:
+		return b.buildAdviseIndex(v)
 	case *plannercore.PlanReplayer:
 		return b.buildPlanReplayer(v)
 	case *plannercore.PhysicalLimit:
@@ -275,6 +277,8 @@ func (b *executorBuilder) build(p plannercore.Plan) exec.Executor {
 		return b.buildMemTable(v)
 	case *plannercore.PhysicalTableDual:
 		return b.buildTableDual(v)
+	case *plannercore.PhysicalJSONTable:
+		return b.buildJSONTable(v)
 	case *plannercore.PhysicalApply:
 		return b.buildApply(v)
 	case *plannercore.PhysicalMaxOneRow:
@@ -506,6 +510,7 @@ func (b *executorBuilder) buildCheckTable(v *plannercore.CheckTable) exec.Execut
 			dbName:       v.DBName,
 			table:        v.Table,
 			indexInfos:   v.IndexInfos,
+			partitions:   v.Partitions,
 			is:           b.is,
 			err:          &atomic.Pointer[error]{},
 		}
@@ -529,6 +534,7 @@ func (b *executorBuilder) buildCheckTable(v *plannercore.CheckTable) exec.Execut
 		dbName:       v.DBName,
 		table:        v.Table,
 		indexInfos:   v.IndexInfos,
+		partitions:   v.Partitions,
 		is:           b.is,
 		srcs:         readerExecs,
 		exitCh:       make(chan struct{}),
@@ -591,6 +597,10 @@ func (b *executorBuilder) buildRecoverIndex(v *plannercore.RecoverIndex) exec.Ex
 		b.err = errors.Errorf("secondary index `%v` is not found in table `%v`", v.IndexName, v.Table.Name.O)
 		return nil
 	}
+	if index.Meta().FullText {
+		b.err = errors.Errorf("admin recover index is not supported on FULLTEXT index `%v`", v.IndexName)
+		return nil
+	}
 	var hasGenedCol bool
 	for _, iCol := range index.Meta().Columns {
 		if tblInfo.Columns[iCol.Offset].IsGenerated() {
@@ -659,6 +669,10 @@ func (b *executorBuilder) buildCleanupIndex(v *plannercore.CleanupIndex) exec.Ex
 		b.err = errors.Errorf("secondary index `%v` is not found in table `%v`", v.IndexName, v.Table.Name.O)
 		return nil
 	}
+	if index.Meta().FullText {
+		b.err = errors.Errorf("admin cleanup index is not supported on FULLTEXT index `%v`", v.IndexName)
+		return nil
+	}
 	e := &CleanupIndexExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
 		columns:      buildIdxColsConcatHandleCols(tblInfo, index.Meta(), false),
@@ -854,6 +868,7 @@ func (b *executorBuilder) buildShow(v *plannercore.PhysicalShow) exec.Executor {
 		CountWarningsOrErrors: v.CountWarningsOrErrors,
 		DBName:                model.NewCIStr(v.DBName),
 		Table:                 v.Table,
+		Procedure:             v.Procedure,
 		Partition:             v.Partition,
 		Column:                v.Column,
 		IndexName:             v.IndexName,
@@ -932,6 +947,13 @@ func (b *executorBuilder) buildSimple(v *plannercore.Simple) exec.Executor {
 			tp:           s.Tp,
 			jobID:        s.JobID,
 		}
+	case *ast.XAStmt:
+		if s.Tp == ast.XARecover {
+			return &XARecoverExec{
+				BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+				convertXID:   s.ConvertXID,
+			}
+		}
 	}
 	base := exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID())
 	base.SetInitCap(chunk.ZeroCapacity)
@@ -1004,6 +1026,8 @@ func (b *executorBuilder) buildInsert(v *plannercore.Insert) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	ivs.triggers = b.buildTriggerExec(ivs.Table)
+	ivs.viewChecks = v.ViewChecks
 
 	if v.IsReplace {
 		return b.buildReplace(ivs)
@@ -1100,6 +1124,13 @@ func (b *executorBuilder) buildIndexAdvise(v *plannercore.IndexAdvise) exec.Exec
 	return e
 }
 
+func (b *executorBuilder) buildAdviseIndex(v *plannercore.AdviseIndex) exec.Executor {
+	return &AdviseIndexExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		limit:        v.Limit,
+	}
+}
+
 func (b *executorBuilder) buildPlanReplayer(v *plannercore.PlanReplayer) exec.Executor {
 	if v.Load {
 		e := &PlanReplayerLoadExec{
@@ -1902,6 +1933,14 @@ func (b *executorBuilder) buildTableDual(v *plannercore.PhysicalTableDual) exec.
 	return e
 }
 
+func (b *executorBuilder) buildJSONTable(v *plannercore.PhysicalJSONTable) exec.Executor {
+	return &JSONTableExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		expr:         v.Expr,
+		root:         v.Root,
+	}
+}
+
 // `getSnapshotTS` returns for-update-ts if in insert/update/delete/lock statement otherwise the isolation read ts
 // Please notice that in RC isolation, the above two ts are the same
 func (b *executorBuilder) getSnapshotTS() (ts uint64, err error) {
@@ -2074,6 +2113,9 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.TableStatistics),
 			strings.ToLower(infoschema.TableTiDBIndexes),
 			strings.ToLower(infoschema.TableViews),
+			strings.ToLower(infoschema.TableTriggers),
+			strings.ToLower(infoschema.TableRoutines),
+			strings.ToLower(infoschema.TableEvents),
 			strings.ToLower(infoschema.TableTables),
 			strings.ToLower(infoschema.TableReferConst),
 			strings.ToLower(infoschema.TableSequences),
@@ -2111,7 +2153,8 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.ClusterTableMemoryUsage),
 			strings.ToLower(infoschema.ClusterTableMemoryUsageOpsHistory),
 			strings.ToLower(infoschema.TableResourceGroups),
-			strings.ToLower(infoschema.TableRunawayWatches):
+			strings.ToLower(infoschema.TableRunawayWatches),
+			strings.ToLower(infoschema.TableCardinalityFeedback):
 			return &MemTableReaderExec{
 				BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
 				table:        v.Table,
@@ -2499,6 +2542,8 @@ func (b *executorBuilder) buildUpdate(v *plannercore.Update) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	updateExec.triggers = b.buildTblID2TriggerExecs(tblID2table)
+	updateExec.viewChecks = v.ViewChecks
 	return updateExec
 }
 
@@ -2551,6 +2596,7 @@ func (b *executorBuilder) buildDelete(v *plannercore.Delete) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	deleteExec.triggers = b.buildTblID2TriggerExecs(tblID2table)
 	return deleteExec
 }
 
@@ -3272,6 +3318,10 @@ func (b *executorBuilder) buildIndexLookUpJoin(v *plannercore.PhysicalIndexJoin)
 		lastColHelper: v.CompareFilters,
 		finished:      &atomic.Value{},
 	}
+	if threshold := b.ctx.GetSessionVars().IndexJoinAdaptiveThreshold; threshold > 0 && v.FallbackInnerPlan != nil {
+		e.adaptiveThreshold = threshold
+		e.innerCtx.fallbackPlan = v.FallbackInnerPlan
+	}
 	colsFromChildren := v.Schema().Columns
 	if v.JoinType == plannercore.LeftOuterSemiJoin || v.JoinType == plannercore.AntiLeftOuterSemiJoin {
 		colsFromChildren = colsFromChildren[:len(colsFromChildren)-1]
@@ -3733,6 +3783,13 @@ func getPartitionKeyColOffsets(keyColIDs []int64, pt table.PartitionedTable) []i
 			return nil
 		}
 	}
+	if pe.Sub != nil {
+		for _, offset := range pe.Sub.ColumnOffset {
+			if _, ok := offsetMap[offset]; !ok {
+				return nil
+			}
+		}
+	}
 	return keyColOffsets
 }
 
@@ -4354,6 +4411,16 @@ func (builder *dataReaderBuilder) buildExecutorForIndexJoinInternal(ctx context.
 	return nil, errors.New("Wrong plan type for dataReaderBuilder")
 }
 
+// buildExecutorForIndexJoinFallback builds the executor of the full inner scan, which is used when the index
+// lookup join falls back to hash join.
+func (builder *dataReaderBuilder) buildExecutorForIndexJoinFallback(ctx context.Context, plan plannercore.PhysicalPlan) (exec.Executor, error) {
+	e := builder.build(plan)
+	if builder.err != nil {
+		return nil, builder.err
+	}
+	return e, e.Open(ctx)
+}
+
 func (builder *dataReaderBuilder) buildUnionScanForIndexJoin(ctx context.Context, v *plannercore.PhysicalUnionScan,
 	values []*indexJoinLookUpContent, indexRanges []*ranger.Range, keyOff2IdxOff []int,
 	cwc *plannercore.ColWithCmpFuncManager, canReorderHandles bool, memTracker *memory.Tracker, interruptSignal *atomic.Value) (exec.Executor, error) {
@@ -4958,12 +5025,15 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 	partialResults := make([]aggfuncs.PartialResult, 0, len(v.WindowFuncDescs))
 	resultColIdx := v.Schema().Len() - len(v.WindowFuncDescs)
 	for _, desc := range v.WindowFuncDescs {
-		aggDesc, err := aggregation.NewAggFuncDescForWindowFunc(b.ctx, desc, false)
+		aggDesc, err := aggregation.NewAggFuncDescForWindowFunc(b.ctx, desc, desc.HasDistinct)
 		if err != nil {
 			b.err = err
 			return nil
 		}
-		agg := aggfuncs.BuildWindowFunctions(b.ctx, aggDesc, resultColIdx, orderByCols)
+		agg := aggfuncs.BuildWindowFunctions(b.ctx, aggDesc, resultColIdx, orderByCols, aggfuncs.WindowFuncOptions{
+			IgnoreNulls: desc.IgnoreNull,
+			FromLast:    desc.FromLast,
+		})
 		windowFuncs = append(windowFuncs, agg)
 		partialResult, _ := agg.AllocPartialResult()
 		partialResults = append(partialResults, partialResult)
@@ -4999,6 +5069,8 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 				exec.orderByCols = orderByCols
 				exec.expectedCmpResult = cmpResult
 				exec.isRangeFrame = true
+			} else if v.Frame.Type == ast.Groups {
+				exec.peerGroups = newPeerGroups(b.ctx, orderByCols)
 			}
 		}
 		return exec
@@ -5009,13 +5081,17 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 			windowFuncs:    windowFuncs,
 			partialResults: partialResults,
 		}
-	} else if v.Frame.Type == ast.Rows {
-		processor = &rowFrameWindowProcessor{
+	} else if v.Frame.Type == ast.Rows || v.Frame.Type == ast.Groups {
+		rowProcessor := &rowFrameWindowProcessor{
 			windowFuncs:    windowFuncs,
 			partialResults: partialResults,
 			start:          v.Frame.Start,
 			end:            v.Frame.End,
 		}
+		if v.Frame.Type == ast.Groups {
+			rowProcessor.peerGroups = newPeerGroups(b.ctx, orderByCols)
+		}
+		processor = rowProcessor
 	} else {
 		cmpResult := int64(-1)
 		if len(v.OrderBy) > 0 && v.OrderBy[0].Desc {
@@ -5666,18 +5742,22 @@ func (b *executorBuilder) buildCompactTable(v *plannercore.CompactTable) exec.Ex
 			return nil
 		}
 		// use map to avoid FindPartitionDefinitionByName
-		partitionMap := map[string]int64{}
+		// The name of a partition of a subpartitioned table maps to all its subpartitions.
+		partitionMap := map[string][]int64{}
 		for _, partition := range v.TableInfo.Partition.Definitions {
-			partitionMap[partition.Name.L] = partition.ID
+			partitionMap[partition.Name.L] = append(partitionMap[partition.Name.L], partition.ID)
+			if partition.ParentName.L != "" {
+				partitionMap[partition.ParentName.L] = append(partitionMap[partition.ParentName.L], partition.ID)
+			}
 		}
 
 		for _, partitionName := range v.PartitionNames {
-			partitionID, ok := partitionMap[partitionName.L]
+			ids, ok := partitionMap[partitionName.L]
 			if !ok {
 				b.err = table.ErrUnknownPartition.GenWithStackByArgs(partitionName.O, v.TableInfo.Name.O)
 				return nil
 			}
-			partitionIDs = append(partitionIDs, partitionID)
+			partitionIDs = append(partitionIDs, ids...)
 		}
 		if b.Ti.PartitionTelemetry == nil {
 			b.Ti.PartitionTelemetry = &PartitionTelemetryInfo{}
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb/errno"
 	"github.com/pingcap/tidb/store/mockstore"
 	"github.com/pingcap/tidb/store/mockstore/unistore"
 	"github.com/pingcap/tidb/testkit"
@@ -781,6 +782,54 @@ func TestCompactTableWithSpecifiedRangePartition(t *testing.T) {
 	tk.MustQuery(`show warnings;`).Check(testkit.Rows())
 }
 
+// TestOptimizePartition: 1 TiFlash, table has 4 partitions.
+// OPTIMIZE PARTITION p2 compacts Partition 2 like COMPACT PARTITION: 1 Partial.
+func TestOptimizePartition(t *testing.T) {
+	mocker := newCompactRequestMocker(t)
+	defer mocker.RequireAllHandlersHit()
+	store, do := testkit.CreateMockStoreAndDomain(t, withMockTiFlash(1), mocker.AsOpt())
+	tk := testkit.NewTestKit(t, store)
+
+	mocker.MockFrom(`tiflash0/#1`, func(req *kvrpcpb.CompactRequest) (*kvrpcpb.CompactResponse, error) {
+		tableID := do.MustGetTableID(t, "test", "employees")
+		pid := do.MustGetPartitionAt(t, "test", "employees", 2)
+		require.Empty(t, req.StartKey)
+		require.EqualValues(t, req.PhysicalTableId, pid)
+		require.EqualValues(t, req.LogicalTableId, tableID)
+		return &kvrpcpb.CompactResponse{
+			HasRemaining:      false,
+			CompactedStartKey: []byte{},
+			CompactedEndKey:   []byte{0xFF},
+		}, nil
+	})
+
+	tk.MustExec("use test")
+	tk.MustExec(`
+	CREATE TABLE employees  (
+		id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		store_id INT NOT NULL
+	)
+	PARTITION BY RANGE(id)  (
+		PARTITION p0 VALUES LESS THAN (5),
+		PARTITION p1 VALUES LESS THAN (10),
+		PARTITION p2 VALUES LESS THAN (15),
+		PARTITION p3 VALUES LESS THAN MAXVALUE
+	);
+	`)
+	tk.MustExec(`alter table employees optimize partition p2;`)
+	tk.MustQuery(`show warnings;`).Check(testkit.Rows(
+		`Warning 1105 compact skipped: no tiflash replica in the table`,
+	))
+	tk.MustExec(`alter table employees set tiflash replica 1;`)
+	tk.MustExec(`alter table employees optimize partition p2;`)
+	tk.MustQuery(`show warnings;`).Check(testkit.Rows())
+
+	err := tk.ExecToErr(`alter table employees optimize partition p4;`)
+	require.Equal(t, "[table:1735]Unknown partition 'p4' in table 'employees'", err.Error())
+	tk.MustExec("create table t(a int)")
+	tk.MustGetErrCode(`alter table t optimize partition p0;`, errno.ErrPartitionMgmtOnNonpartitioned)
+}
+
 // TestCompactTableWithSpecifiedHashPartition: 1 TiFlash, table has 3 partitions (hash partition).
 // only compact p1, p2
 // During compacting the partition, one partition will return failure PhysicalTableNotExist. The remaining partitions should be still compacted.
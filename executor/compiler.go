@@ -272,6 +272,51 @@ func getStmtDbLabel(stmtNode ast.StmtNode) map[string]struct{} {
 			dbLabel := x.ViewName.Schema.O
 			dbLabelSet[dbLabel] = struct{}{}
 		}
+	case *ast.CreateMaterializedViewStmt:
+		if x.ViewName != nil {
+			dbLabel := x.ViewName.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
+	case *ast.RefreshMaterializedViewStmt:
+		if x.ViewName != nil {
+			dbLabel := x.ViewName.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
+	case *ast.CreateTriggerStmt:
+		if x.Table != nil {
+			dbLabel := x.Table.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
+	case *ast.DropTriggerStmt:
+		if x.TriggerName != nil {
+			dbLabel := x.TriggerName.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
+	case *ast.ProcedureInfo:
+		if x.ProcedureName != nil {
+			dbLabel := x.ProcedureName.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
+	case *ast.DropProcedureStmt:
+		if x.ProcedureName != nil {
+			dbLabel := x.ProcedureName.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
+	case *ast.CreateEventStmt:
+		if x.EventName != nil {
+			dbLabel := x.EventName.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
+	case *ast.AlterEventStmt:
+		if x.EventName != nil {
+			dbLabel := x.EventName.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
+	case *ast.DropEventStmt:
+		if x.EventName != nil {
+			dbLabel := x.EventName.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
 	case *ast.RenameTableStmt:
 		tables := x.TableToTables
 		for _, table := range tables {
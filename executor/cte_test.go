@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/errno"
 	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/types"
@@ -348,6 +349,56 @@ func TestCTEWithLimit(t *testing.T) {
 	rows.Check(testkit.Rows("3", "4", "3", "4"))
 }
 
+func TestCTEWithOrderByLimitDistinctInRecursivePart(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test;")
+	tk.MustExec("drop table if exists edges;")
+	tk.MustExec("create table edges(src int, dst int);")
+	tk.MustExec("insert into edges values(1, 2), (1, 3), (1, 4), (2, 5), (2, 6), (3, 7), (4, 8);")
+
+	// LIMIT in the recursive part bounds the rows of the whole CTE like MySQL 8.0.19+, not the rows of each iteration.
+	tk.MustQuery("with recursive cte(n, d) as (select 1, 0 union all " +
+		"(select e.dst, cte.d + 1 from cte join edges e on cte.n = e.src limit 2)) " +
+		"select count(*), min(d), max(d) from cte;").Check(testkit.Rows("2 0 1"))
+	tk.MustQuery("with recursive cte(n, d) as (select 1, 0 union all " +
+		"(select e.dst, cte.d + 1 from cte join edges e on cte.n = e.src limit 1 offset 1)) " +
+		"select d from cte;").Check(testkit.Rows("1"))
+	tk.MustQuery("with recursive cte(n) as (select 1 union all (select n + 1 from cte limit 0)) select * from cte;").
+		Check(testkit.Rows())
+	tk.MustQuery("with recursive cte(n) as (select 1 union all (select n + 1 from cte limit 5)) select * from cte;").
+		Check(testkit.Rows("1", "2", "3", "4", "5"))
+	tk.MustQuery("with recursive cte(n) as (select 1 union all (select n + 1 from cte limit 5) limit 3 offset 1) select * from cte;").
+		Check(testkit.Rows("2", "3", "4"))
+	tk.MustQuery("with recursive cte(n) as (select 1 union all (select n + 1 from cte limit 5) limit 3 offset 4) select * from cte;").
+		Check(testkit.Rows("5"))
+	tk.MustQuery("with recursive cte(n) as (select 1 union all (select n + 1 from cte limit 5) limit 3 offset 6) select * from cte;").
+		Check(testkit.Rows())
+	tk.MustQuery("with recursive cte(n) as (select 1 union all (select n + 1 from cte limit 5) order by n desc limit 2) select * from cte;").
+		Check(testkit.Rows("5", "4"))
+	tk.MustGetErrCode("with recursive cte(n) as (select 1 union all (select n + 1 from cte limit 5) union all (select n + 2 from cte limit 5)) select * from cte;", errno.ErrNotSupportedYet)
+
+	// DISTINCT in the recursive part deduplicates rows of each iteration.
+	tk.MustQuery("with recursive cte(n) as (select 1 union all select 1 union all select distinct n + 1 from cte where n < 3) select * from cte order by n;").
+		Check(testkit.Rows("1", "1", "2", "3"))
+	tk.MustQuery("with recursive cte(n) as (select 1 union all select 1 union all select n + 1 from cte where n < 3) select * from cte order by n;").
+		Check(testkit.Rows("1", "1", "2", "2", "3", "3"))
+	tk.MustQuery("with recursive cte(n) as (select 1 union all select distinct e.src + 10 from cte join edges e on e.dst > cte.n where cte.n < 2) select * from cte order by n;").
+		Check(testkit.Rows("1", "11", "12", "13", "14"))
+
+	// ORDER BY over UNION sorts the whole CTE, the LIMIT is applied after all iterations are done.
+	tk.MustQuery("with recursive cte(n) as (select 1 union all select n + 1 from cte where n < 10 order by n desc limit 3) select * from cte;").
+		Check(testkit.Rows("10", "9", "8"))
+	tk.MustQuery("with recursive cte(n) as (select 1 union all select n + 1 from cte where n < 10 order by n desc limit 2 offset 1) select * from cte order by n;").
+		Check(testkit.Rows("8", "9"))
+	tk.MustQuery("with recursive cte(m) as (select 1 as n union all select m + 1 from cte where m < 5 order by cte.m desc) select * from cte;").
+		Check(testkit.Rows("5", "4", "3", "2", "1"))
+	tk.MustQuery("with recursive cte(n) as (select 1 union select n + 1 from cte where n < 5 order by n desc limit 2) " +
+		"select * from cte c1 join cte c2 on c1.n = c2.n + 1;").Check(testkit.Rows("5 4"))
+	tk.MustGetErrCode("with recursive cte(n) as (select 1 union all select n + 1 from cte where n < 5 order by (select max(src) from edges where src = n)) select * from cte;", errno.ErrNotSupportedYet)
+}
+
 func TestSpillToDisk(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
@@ -400,6 +451,26 @@ func TestSpillToDisk(t *testing.T) {
 		resRows = append(resRows, fmt.Sprintf("%d", i))
 	}
 	rows.Check(testkit.Rows(resRows...))
+
+	// DISTINCT in the recursive part deduplicates the rows of each iteration.
+	sql = fmt.Sprintf("with recursive cte1 as ( "+
+		"select c1 from t1 "+
+		"union all "+
+		"select distinct c1 + 1 c1 from cte1 where c1 < %d) "+
+		"select count(*), sum(c1) from cte1;", rowNum)
+	cnt, sum := 0, 0
+	for i, v := range vals {
+		cnt++
+		sum += v
+		if i > 0 && vals[i-1] == v {
+			continue
+		}
+		for k := 1; v+k <= rowNum; k++ {
+			cnt++
+			sum += v + k
+		}
+	}
+	tk.MustQuery(sql).Check(testkit.Rows(fmt.Sprintf("%d %d", cnt, sum)))
 }
 
 func TestCTEExecError(t *testing.T) {
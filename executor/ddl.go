@@ -102,7 +102,7 @@ func (e *DDLExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 			return e.createSessionTemporaryTable(s)
 		}
 	case *ast.DropTableStmt:
-		if s.IsView {
+		if s.IsView || s.IsMaterializedView {
 			break
 		}
 
@@ -157,13 +157,33 @@ func (e *DDLExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 		err = e.executeCreateTable(x)
 	case *ast.CreateViewStmt:
 		err = e.executeCreateView(ctx, x)
+	case *ast.CreateMaterializedViewStmt:
+		err = e.executeCreateMaterializedView(ctx, x)
+	case *ast.RefreshMaterializedViewStmt:
+		err = e.executeRefreshMaterializedView(x)
+	case *ast.CreateTriggerStmt:
+		err = e.executeCreateTrigger(x)
+	case *ast.DropTriggerStmt:
+		err = e.executeDropTrigger(x)
+	case *ast.ProcedureInfo:
+		err = e.executeCreateRoutine(x)
+	case *ast.DropProcedureStmt:
+		err = e.executeDropRoutine(x)
+	case *ast.CreateEventStmt:
+		err = e.executeCreateEvent(ctx, x)
+	case *ast.AlterEventStmt:
+		err = e.executeAlterEvent(ctx, x)
+	case *ast.DropEventStmt:
+		err = e.executeDropEvent(ctx, x)
 	case *ast.DropIndexStmt:
 		err = e.executeDropIndex(x)
 	case *ast.DropDatabaseStmt:
-		err = e.executeDropDatabase(x)
+		err = e.executeDropDatabase(ctx, x)
 	case *ast.DropTableStmt:
 		if x.IsView {
 			err = e.executeDropView(x)
+		} else if x.IsMaterializedView {
+			err = e.executeDropMaterializedView(x)
 		} else {
 			err = e.executeDropTable(x)
 			if err == nil {
@@ -309,6 +329,39 @@ func (e *DDLExec) executeCreateView(ctx context.Context, s *ast.CreateViewStmt)
 	return domain.GetDomain(e.Ctx()).DDL().CreateView(e.Ctx(), s)
 }
 
+func (e *DDLExec) executeCreateMaterializedView(ctx context.Context, s *ast.CreateMaterializedViewStmt) error {
+	ret := &core.PreprocessorReturn{}
+	err := core.Preprocess(ctx, e.Ctx(), s.Select, core.WithPreprocessorReturn(ret))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ret.IsStaleness {
+		return exeerrors.ErrViewInvalid.GenWithStackByArgs(s.ViewName.Schema.L, s.ViewName.Name.L)
+	}
+
+	return domain.GetDomain(e.Ctx()).DDL().CreateMaterializedView(e.Ctx(), s)
+}
+
+func (e *DDLExec) executeRefreshMaterializedView(s *ast.RefreshMaterializedViewStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().RefreshMaterializedView(e.Ctx(), s)
+}
+
+func (e *DDLExec) executeCreateTrigger(s *ast.CreateTriggerStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().CreateTrigger(e.Ctx(), s)
+}
+
+func (e *DDLExec) executeDropTrigger(s *ast.DropTriggerStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().DropTrigger(e.Ctx(), s)
+}
+
+func (e *DDLExec) executeCreateRoutine(s *ast.ProcedureInfo) error {
+	return domain.GetDomain(e.Ctx()).DDL().CreateRoutine(e.Ctx(), s)
+}
+
+func (e *DDLExec) executeDropRoutine(s *ast.DropProcedureStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().DropRoutine(e.Ctx(), s)
+}
+
 func (e *DDLExec) executeCreateIndex(s *ast.CreateIndexStmt) error {
 	if _, ok := e.getLocalTemporaryTable(s.Table.Schema, s.Table.Name); ok {
 		return dbterror.ErrUnsupportedLocalTempTableDDL.GenWithStackByArgs("CREATE INDEX")
@@ -317,7 +370,7 @@ func (e *DDLExec) executeCreateIndex(s *ast.CreateIndexStmt) error {
 	return domain.GetDomain(e.Ctx()).DDL().CreateIndex(e.Ctx(), s)
 }
 
-func (e *DDLExec) executeDropDatabase(s *ast.DropDatabaseStmt) error {
+func (e *DDLExec) executeDropDatabase(ctx context.Context, s *ast.DropDatabaseStmt) error {
 	dbName := s.Name
 
 	// Protect important system table from been dropped by a mistake.
@@ -327,6 +380,10 @@ func (e *DDLExec) executeDropDatabase(s *ast.DropDatabaseStmt) error {
 	}
 
 	err := domain.GetDomain(e.Ctx()).DDL().DropSchema(e.Ctx(), s)
+	if m := domain.GetDomain(e.Ctx()).EventManager(); err == nil && m != nil {
+		// The events are stored as timers rather than in the schema, so they're dropped separately.
+		err = m.DropSchemaEvents(ctx, dbName)
+	}
 	sessionVars := e.Ctx().GetSessionVars()
 	if err == nil && strings.ToLower(sessionVars.CurrentDB) == dbName.L {
 		sessionVars.CurrentDB = ""
@@ -350,6 +407,10 @@ func (e *DDLExec) executeDropView(s *ast.DropTableStmt) error {
 	return domain.GetDomain(e.Ctx()).DDL().DropView(e.Ctx(), s)
 }
 
+func (e *DDLExec) executeDropMaterializedView(s *ast.DropTableStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().DropMaterializedView(e.Ctx(), s)
+}
+
 func (e *DDLExec) executeDropSequence(s *ast.DropSequenceStmt) error {
 	return domain.GetDomain(e.Ctx()).DDL().DropSequence(e.Ctx(), s)
 }
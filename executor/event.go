@@ -0,0 +1,261 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/event"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+func init() {
+	event.ExecuteEventBody = executeEventBody
+}
+
+// executeEventBody executes the event body with the routine interpreter, so the body can be a compound statement
+// like the body of a stored procedure.
+func executeEventBody(ctx context.Context, sctx sessionctx.Context, info *model.EventInfo) error {
+	routine := &model.RoutineInfo{
+		Name:    info.Name,
+		Type:    model.RoutineTypeProcedure,
+		Body:    info.Body,
+		Definer: info.Definer,
+		SQLMode: info.SQLMode,
+		Charset: info.Charset,
+		Collate: info.Collate,
+	}
+	_, err := newRoutineInterpreter(sctx, info.Schema, routine).run(ctx, nil)
+	return err
+}
+
+func getEventManager(sctx sessionctx.Context) (*event.Manager, error) {
+	m := domain.GetDomain(sctx).EventManager()
+	if m == nil {
+		return nil, errors.New("the event manager is not started")
+	}
+	return m, nil
+}
+
+// evalEventTime evaluates the time expression of the schedule in the location of the session.
+func evalEventTime(sctx sessionctx.Context, expr ast.ExprNode) (time.Time, error) {
+	d, err := expression.EvalAstExpr(sctx, expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if d.IsNull() {
+		return time.Time{}, types.ErrWrongValue.GenWithStackByArgs(types.DateTimeStr, "NULL")
+	}
+	d, err = d.ConvertTo(sctx.GetSessionVars().StmtCtx, types.NewFieldType(mysql.TypeDatetime))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return d.GetMysqlTime().GoTime(sctx.GetSessionVars().Location())
+}
+
+// setEventSchedule evaluates the schedule and sets it to info.
+func setEventSchedule(sctx sessionctx.Context, schedule *ast.EventSchedule, info *model.EventInfo, now time.Time) (err error) {
+	info.ExecuteAt, info.Starts, info.Ends = time.Time{}, time.Time{}, time.Time{}
+	info.IntervalValue, info.IntervalField = "", ""
+	if schedule.At != nil {
+		info.ExecuteAt, err = evalEventTime(sctx, schedule.At)
+		return err
+	}
+
+	d, err := expression.EvalAstExpr(sctx, schedule.Every)
+	if err != nil {
+		return err
+	}
+	if d.IsNull() {
+		return exeerrors.ErrEventIntervalNotPositiveOrTooBig.GenWithStackByArgs()
+	}
+	if info.IntervalValue, err = d.ToString(); err != nil {
+		return err
+	}
+	info.IntervalField = schedule.Unit.String()
+	if _, err = event.Interval(info.IntervalValue, info.IntervalField); err != nil {
+		return err
+	}
+
+	info.Starts = now.Truncate(time.Second)
+	if schedule.Starts != nil {
+		if info.Starts, err = evalEventTime(sctx, schedule.Starts); err != nil {
+			return err
+		}
+	}
+	if schedule.Ends != nil {
+		if info.Ends, err = evalEventTime(sctx, schedule.Ends); err != nil {
+			return err
+		}
+		if info.Ends.Before(info.Starts) {
+			return exeerrors.ErrEventEndsBeforeStarts.GenWithStackByArgs()
+		}
+	}
+	return nil
+}
+
+// eventTime converts the time of the event to a datetime in the location, the zero time is converted to nil.
+func eventTime(t time.Time, loc *time.Location) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return types.NewTime(types.FromGoTime(t.In(loc)), mysql.TypeDatetime, 0)
+}
+
+// isEventExpired checks whether the event won't be executed any more.
+func isEventExpired(info *model.EventInfo, now time.Time) bool {
+	if !info.IsRecurring() {
+		return info.ExecuteAt.Before(now)
+	}
+	return !info.Ends.IsZero() && info.Ends.Before(now)
+}
+
+func (e *DDLExec) executeCreateEvent(ctx context.Context, s *ast.CreateEventStmt) error {
+	m, err := getEventManager(e.Ctx())
+	if err != nil {
+		return err
+	}
+	if _, ok := e.is.SchemaByName(s.EventName.Schema); !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(s.EventName.Schema.O)
+	}
+
+	_, err = m.GetEvent(ctx, s.EventName.Schema, s.EventName.Name)
+	if err == nil {
+		existsErr := exeerrors.ErrEventAlreadyExists.GenWithStackByArgs(s.EventName.Name.O)
+		if s.IfNotExists {
+			e.Ctx().GetSessionVars().StmtCtx.AppendNote(existsErr)
+			return nil
+		}
+		return existsErr
+	} else if !exeerrors.ErrEventDoesNotExist.Equal(err) {
+		return err
+	}
+
+	sessVars := e.Ctx().GetSessionVars()
+	now := time.Now()
+	charset, collation := sessVars.GetCharsetInfo()
+	timeZone, err := sessVars.GetSessionOrGlobalSystemVar(ctx, variable.TimeZone)
+	if err != nil {
+		return err
+	}
+	info := &model.EventInfo{
+		Schema:      s.EventName.Schema,
+		Name:        s.EventName.Name,
+		Definer:     s.Definer,
+		Preserve:    s.Preserve,
+		Status:      s.Status,
+		Comment:     s.Comment,
+		Body:        s.Body.Text(),
+		SQLMode:     sessVars.SQLMode,
+		Charset:     charset,
+		Collate:     collation,
+		TimeZone:    timeZone,
+		Created:     now,
+		LastAltered: now,
+	}
+	if err = setEventSchedule(e.Ctx(), s.Schedule, info, now); err != nil {
+		return err
+	}
+	// An expired event isn't created if it's not preserved, otherwise it's created but disabled.
+	if isEventExpired(info, now) {
+		if !info.Preserve {
+			sessVars.StmtCtx.AppendNote(exeerrors.ErrEventCannotCreateInThePast.GenWithStackByArgs())
+			return nil
+		}
+		sessVars.StmtCtx.AppendNote(exeerrors.ErrEventExecTimeInThePast.GenWithStackByArgs())
+		info.Status = model.EventStatusDisabled
+	}
+	return m.CreateEvent(ctx, info)
+}
+
+func (e *DDLExec) executeAlterEvent(ctx context.Context, s *ast.AlterEventStmt) error {
+	m, err := getEventManager(e.Ctx())
+	if err != nil {
+		return err
+	}
+	old, err := m.GetEvent(ctx, s.EventName.Schema, s.EventName.Name)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	info := old.EventInfo.Clone()
+	info.LastAltered = now
+	if s.Definer != nil {
+		info.Definer = s.Definer
+	}
+	if s.Preserve != nil {
+		info.Preserve = *s.Preserve
+	}
+	if s.Status != nil {
+		info.Status = *s.Status
+	}
+	if s.Comment != nil {
+		info.Comment = *s.Comment
+	}
+	if s.Body != nil {
+		info.Body = s.Body.Text()
+		sessVars := e.Ctx().GetSessionVars()
+		info.SQLMode = sessVars.SQLMode
+		info.Charset, info.Collate = sessVars.GetCharsetInfo()
+	}
+	if s.NewName != nil {
+		if s.NewName.Schema.L == info.Schema.L && s.NewName.Name.L == info.Name.L {
+			return exeerrors.ErrEventSameName.GenWithStackByArgs()
+		}
+		if _, ok := e.is.SchemaByName(s.NewName.Schema); !ok {
+			return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(s.NewName.Schema.O)
+		}
+		info.Schema, info.Name = s.NewName.Schema, s.NewName.Name
+	}
+	reschedule := s.Schedule != nil
+	if reschedule {
+		if err = setEventSchedule(e.Ctx(), s.Schedule, info, now); err != nil {
+			return err
+		}
+	}
+	if (reschedule || s.Preserve != nil) && isEventExpired(info, now) {
+		if !info.Preserve {
+			return exeerrors.ErrEventCannotAlterInThePast.GenWithStackByArgs()
+		}
+		e.Ctx().GetSessionVars().StmtCtx.AppendNote(exeerrors.ErrEventExecTimeInThePast.GenWithStackByArgs())
+		info.Status = model.EventStatusDisabled
+	}
+	return m.AlterEvent(ctx, old.Schema, old.Name, info, reschedule)
+}
+
+func (e *DDLExec) executeDropEvent(ctx context.Context, s *ast.DropEventStmt) error {
+	m, err := getEventManager(e.Ctx())
+	if err != nil {
+		return err
+	}
+	err = m.DropEvent(ctx, s.EventName.Schema, s.EventName.Name)
+	if s.IfExists && exeerrors.ErrEventDoesNotExist.Equal(err) {
+		e.Ctx().GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	return err
+}
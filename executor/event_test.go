@@ -0,0 +1,153 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAlterAndDropEvent(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("set time_zone = '+00:00'")
+	tk.MustExec("create table t (a int)")
+
+	tk.MustExec("create event e1 on schedule every 1 hour starts '2030-01-01 00:00:00' do insert into t values (1)")
+	tk.MustGetErrCode("create event e1 on schedule every 1 day do insert into t values (2)", errno.ErrEventAlreadyExists)
+	tk.MustExec("create event if not exists e1 on schedule every 1 day do insert into t values (2)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1537 Event 'e1' already exists"))
+	tk.MustGetErrCode("create event e2 on schedule every 0 second do insert into t values (2)", errno.ErrEventIntervalNotPositiveOrTooBig)
+	tk.MustGetErrCode("create event e2 on schedule every -1 hour do insert into t values (2)", errno.ErrEventIntervalNotPositiveOrTooBig)
+	tk.MustGetErrCode("create event e2 on schedule every 1 hour starts '2030-01-02' ends '2030-01-01' do insert into t values (2)", errno.ErrEventEndsBeforeStarts)
+	tk.MustGetErrCode("create event e2 on schedule every 1 hour do create event e3 on schedule every 1 hour do select 1", errno.ErrParse)
+	tk.MustGetErrCode("create event e2 on schedule every 1 month do insert into t values (2)", errno.ErrNotSupportedYet)
+	tk.MustGetErrCode("create event db_not_exists.e2 on schedule every 1 hour do insert into t values (2)", errno.ErrBadDB)
+
+	// An expired one-time event isn't created unless it's preserved.
+	tk.MustExec("create event e2 on schedule at '2000-01-01 00:00:00' do insert into t values (2)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1588 Event execution time is in the past and ON COMPLETION NOT PRESERVE is set. The event was dropped immediately after creation."))
+	tk.MustExec("create event e2 on schedule at '2000-01-01 00:00:00' on completion preserve do insert into t values (2)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1544 Event execution time is in the past. Event has been disabled"))
+	tk.MustExec("create event e3 on schedule at '2030-01-01 00:00:00' + interval 1 hour disable comment 'one time' " +
+		"do begin insert into t values (3); insert into t values (4); end")
+
+	tk.MustQuery("select event_schema, event_name, definer, time_zone, event_definition, event_type, execute_at, interval_value, " +
+		"interval_field, starts, ends, status, on_completion, event_comment from information_schema.events where event_schema = 'test' " +
+		"order by event_name").Check(testkit.RowsWithSep("|",
+		"test|e1|root@%|+00:00|insert into t values (1)|RECURRING|<nil>|1|HOUR|2030-01-01 00:00:00|<nil>|ENABLED|NOT PRESERVE|",
+		"test|e2|root@%|+00:00|insert into t values (2)|ONE TIME|2000-01-01 00:00:00|<nil>|<nil>|<nil>|<nil>|DISABLED|PRESERVE|",
+		"test|e3|root@%|+00:00|begin insert into t values (3); insert into t values (4); end|ONE TIME|2030-01-01 01:00:00|<nil>|<nil>|<nil>|<nil>|DISABLED|NOT PRESERVE|one time",
+	))
+	tk.MustQuery("show events").CheckAt([]int{0, 1, 4, 5, 6, 7, 8, 10}, testkit.RowsWithSep("|",
+		"test|e1|RECURRING|<nil>|1|HOUR|2030-01-01 00:00:00|ENABLED",
+		"test|e2|ONE TIME|2000-01-01 00:00:00|<nil>|<nil>|<nil>|DISABLED",
+		"test|e3|ONE TIME|2030-01-01 01:00:00|<nil>|<nil>|<nil>|DISABLED",
+	))
+	tk.MustQuery("show events like 'e_'").CheckAt([]int{1}, testkit.Rows("e1", "e2", "e3"))
+	tk.MustQuery("show events where name = 'e3'").CheckAt([]int{1}, testkit.Rows("e3"))
+	tk.MustQuery("show events from mysql").Check(testkit.Rows())
+
+	// The times are shown in the time zone of the session.
+	tk.MustExec("set time_zone = '+08:00'")
+	tk.MustQuery("select starts from information_schema.events where event_name = 'e1'").Check(testkit.Rows("2030-01-01 08:00:00"))
+
+	tk.MustExec("alter event e1 on schedule every '1:30' hour_minute ends '2031-01-01 00:00:00' on completion preserve")
+	tk.MustExec("alter event e3 enable comment 'altered' do insert into t values (5)")
+	tk.MustGetErrCode("alter event e3 rename to e3", errno.ErrEventSameName)
+	tk.MustGetErrCode("alter event e3 rename to e1", errno.ErrEventAlreadyExists)
+	tk.MustGetErrCode("alter event e4 enable", errno.ErrEventDoesNotExist)
+	tk.MustGetErrCode("alter event e3 on schedule at '2000-01-01 00:00:00'", errno.ErrEventCannotAlterInThePast)
+	tk.MustExec("alter event e3 rename to e4")
+	tk.MustQuery("select event_name, interval_value, interval_field, ends, status, on_completion, event_comment, event_definition " +
+		"from information_schema.events where event_schema = 'test' order by event_name").Check(testkit.RowsWithSep("|",
+		"e1|1:30|HOUR_MINUTE|2031-01-01 00:00:00|ENABLED|PRESERVE||insert into t values (1)",
+		"e2|<nil>|<nil>|<nil>|DISABLED|PRESERVE||insert into t values (2)",
+		"e4|<nil>|<nil>|<nil>|ENABLED|NOT PRESERVE|altered|insert into t values (5)",
+	))
+
+	tk.MustExec("drop event e1")
+	tk.MustGetErrCode("drop event e1", errno.ErrEventDoesNotExist)
+	tk.MustExec("drop event if exists e1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1539 Unknown event 'e1'"))
+	tk.MustQuery("select event_name from information_schema.events where event_schema = 'test'").Sort().Check(testkit.Rows("e2", "e4"))
+
+	// The events are dropped with the schema.
+	tk.MustExec("create database db1")
+	tk.MustExec("create event db1.e1 on schedule every 1 hour do select 1")
+	tk.MustQuery("select event_name from information_schema.events where event_schema = 'db1'").Check(testkit.Rows("e1"))
+	tk.MustExec("drop database db1")
+	tk.MustExec("create database db1")
+	tk.MustQuery("select event_name from information_schema.events where event_schema = 'db1'").Check(testkit.Rows())
+}
+
+func TestEventPrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create user u1")
+	tk.MustExec("create user u2")
+	tk.MustExec("grant event on test.* to u1")
+	tk.MustExec("create event e1 on schedule every 1 hour do select 1")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustExec("create event e2 on schedule every 1 hour do select 1")
+	tk1.MustQuery("select event_name, definer from information_schema.events order by event_name").Check(testkit.Rows("e1 root@%", "e2 u1@%"))
+	tk1.MustGetErrCode("create definer = 'root'@'%' event e3 on schedule every 1 hour do select 1", errno.ErrSpecificAccessDenied)
+	tk1.MustExec("drop event e1")
+
+	tk2 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk2.Session().Auth(&auth.UserIdentity{Username: "u2", Hostname: "%"}, nil, nil, nil))
+	tk2.MustGetErrCode("create event test.e3 on schedule every 1 hour do select 1", errno.ErrDBaccessDenied)
+	tk2.MustGetErrCode("drop event test.e2", errno.ErrDBaccessDenied)
+	tk2.MustQuery("select event_name from information_schema.events").Check(testkit.Rows())
+}
+
+func TestEventScheduler(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("set global event_scheduler = on")
+	defer tk.MustExec("set global event_scheduler = off")
+
+	tk.MustExec("create event e1 on schedule every 1 second do insert into t values (1)")
+	tk.MustExec("create event e2 on schedule at now() + interval 1 second do begin insert into t values (2); insert into t values (3); end")
+	require.Eventually(t, func() bool {
+		rows := tk.MustQuery("select distinct a from t order by a").Rows()
+		return len(rows) == 3
+	}, 30*time.Second, 100*time.Millisecond)
+	tk.MustQuery("select distinct a from t order by a").Check(testkit.Rows("1", "2", "3"))
+
+	// The one-time event is dropped after it's executed because it's not preserved.
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("select event_name from information_schema.events where event_name = 'e2'").Rows()) == 0
+	}, 30*time.Second, 100*time.Millisecond)
+	tk.MustQuery("select last_executed is not null from information_schema.events where event_name = 'e1'").Check(testkit.Rows("1"))
+
+	tk.MustExec("alter event e1 disable")
+	tk.MustExec("drop event e1")
+}
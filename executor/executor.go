@@ -878,6 +878,8 @@ type CheckTableExec struct {
 	dbName     string
 	table      table.Table
 	indexInfos []*model.IndexInfo
+	// partitions are the partitions to check, all partitions are checked if it is nil.
+	partitions []model.PartitionDefinition
 	srcs       []*IndexLookUpExecutor
 	done       bool
 	is         infoschema.InfoSchema
@@ -967,7 +969,7 @@ func (e *CheckTableExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 		}
 		idxNames = append(idxNames, idx.Name.O)
 	}
-	greater, idxOffset, err := admin.CheckIndicesCount(e.Ctx(), e.dbName, e.table.Meta().Name.O, idxNames)
+	greater, idxOffset, err := e.checkIndicesCount(idxNames)
 	if err != nil {
 		// For admin check index statement, for speed up and compatibility, doesn't do below checks.
 		if e.checkIndex {
@@ -1040,6 +1042,21 @@ func (e *CheckTableExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 	}
 }
 
+// checkIndicesCount compares the count of the indices with the count of the table,
+// in each of the checked partitions if only some partitions are checked.
+func (e *CheckTableExec) checkIndicesCount(idxNames []string) (byte, int, error) {
+	if e.partitions == nil {
+		return admin.CheckIndicesCount(e.Ctx(), e.dbName, e.table.Meta().Name.O, idxNames)
+	}
+	for _, def := range e.partitions {
+		greater, idxOffset, err := admin.CheckPartitionIndicesCount(e.Ctx(), e.dbName, e.table.Meta().Name.O, def.Name.O, idxNames)
+		if err != nil {
+			return greater, idxOffset, err
+		}
+	}
+	return 0, 0, nil
+}
+
 func (e *CheckTableExec) checkTableRecord(ctx context.Context, idxOffset int) error {
 	idxInfo := e.indexInfos[idxOffset]
 	txn, err := e.Ctx().Txn(true)
@@ -1051,8 +1068,11 @@ func (e *CheckTableExec) checkTableRecord(ctx context.Context, idxOffset int) er
 		return admin.CheckRecordAndIndex(ctx, e.Ctx(), txn, e.table, idx)
 	}
 
-	info := e.table.Meta().GetPartitionInfo()
-	for _, def := range info.Definitions {
+	defs := e.partitions
+	if defs == nil {
+		defs = e.table.Meta().GetPartitionInfo().Definitions
+	}
+	for _, def := range defs {
 		pid := def.ID
 		partition := e.table.(table.PartitionedTable).GetPartition(pid)
 		idx := tables.NewIndex(def.ID, e.table.Meta(), idxInfo)
@@ -2290,6 +2310,8 @@ type FastCheckTableExec struct {
 	dbName     string
 	table      table.Table
 	indexInfos []*model.IndexInfo
+	// partitions are the partitions to check, all partitions are checked if it is nil.
+	partitions []model.PartitionDefinition
 	done       bool
 	is         infoschema.InfoSchema
 	err        *atomic.Pointer[error]
@@ -2308,6 +2330,19 @@ func (e *FastCheckTableExec) Open(ctx context.Context) error {
 	return nil
 }
 
+// tableSource returns the table to check in the SQL, with the partitions to check if only some partitions are checked.
+func (e *FastCheckTableExec) tableSource() string {
+	source := TableName(e.dbName, e.table.Meta().Name.String())
+	if e.partitions == nil {
+		return source
+	}
+	names := make([]string, 0, len(e.partitions))
+	for _, def := range e.partitions {
+		names = append(names, ColumnName(def.Name.O))
+	}
+	return source + " partition(" + strings.Join(names, ", ") + ")"
+}
+
 type checkIndexTask struct {
 	indexOffset int
 }
@@ -2464,8 +2499,8 @@ func (w *checkIndexWorker) HandleTask(task checkIndexTask) {
 		}
 		checkOnce = true
 
-		tblQuery := fmt.Sprintf("select /*+ read_from_storage(tikv[%s]) */ bit_xor(%s), %s, count(*) from %s use index() where %s = 0 group by %s", TableName(w.e.dbName, w.e.table.Meta().Name.String()), md5HandleAndIndexCol.String(), groupByKey, TableName(w.e.dbName, w.e.table.Meta().Name.String()), whereKey, groupByKey)
-		idxQuery := fmt.Sprintf("select bit_xor(%s), %s, count(*) from %s use index(`%s`) where %s = 0 group by %s", md5HandleAndIndexCol.String(), groupByKey, TableName(w.e.dbName, w.e.table.Meta().Name.String()), idxInfo.Name, whereKey, groupByKey)
+		tblQuery := fmt.Sprintf("select /*+ read_from_storage(tikv[%s]) */ bit_xor(%s), %s, count(*) from %s use index() where %s = 0 group by %s", TableName(w.e.dbName, w.e.table.Meta().Name.String()), md5HandleAndIndexCol.String(), groupByKey, w.e.tableSource(), whereKey, groupByKey)
+		idxQuery := fmt.Sprintf("select bit_xor(%s), %s, count(*) from %s use index(`%s`) where %s = 0 group by %s", md5HandleAndIndexCol.String(), groupByKey, w.e.tableSource(), idxInfo.Name, whereKey, groupByKey)
 
 		logutil.BgLogger().Info("fast check table by group", zap.String("table name", w.table.Meta().Name.String()), zap.String("index name", idxInfo.Name.String()), zap.Int("times", times), zap.Int("current offset", offset), zap.Int("current mod", mod), zap.String("table sql", tblQuery), zap.String("index sql", idxQuery))
 
@@ -2549,8 +2584,8 @@ func (w *checkIndexWorker) HandleTask(task checkIndexTask) {
 
 	if meetError {
 		groupByKey := fmt.Sprintf("((%s - %d) %% %d)", md5Handle.String(), offset, mod)
-		indexSQL := fmt.Sprintf("select %s, %s, %s from %s use index(`%s`) where %s = 0 order by %s", handleColumnField, indexColumnField.String(), md5HandleAndIndexCol.String(), TableName(w.e.dbName, w.e.table.Meta().Name.String()), idxInfo.Name, groupByKey, handleColumnField)
-		tableSQL := fmt.Sprintf("select /*+ read_from_storage(tikv[%s]) */ %s, %s, %s from %s use index() where %s = 0 order by %s", TableName(w.e.dbName, w.e.table.Meta().Name.String()), handleColumnField, indexColumnField.String(), md5HandleAndIndexCol.String(), TableName(w.e.dbName, w.e.table.Meta().Name.String()), groupByKey, handleColumnField)
+		indexSQL := fmt.Sprintf("select %s, %s, %s from %s use index(`%s`) where %s = 0 order by %s", handleColumnField, indexColumnField.String(), md5HandleAndIndexCol.String(), w.e.tableSource(), idxInfo.Name, groupByKey, handleColumnField)
+		tableSQL := fmt.Sprintf("select /*+ read_from_storage(tikv[%s]) */ %s, %s, %s from %s use index() where %s = 0 order by %s", TableName(w.e.dbName, w.e.table.Meta().Name.String()), handleColumnField, indexColumnField.String(), md5HandleAndIndexCol.String(), w.e.tableSource(), groupByKey, handleColumnField)
 
 		idxRow, err := queryToRow(se, indexSQL)
 		if err != nil {
@@ -0,0 +1,114 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func prepareFullTextTable(tk *testkit.TestKit) {
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (id int primary key, title varchar(100), body text, fulltext key ft(title, body)) collate utf8mb4_general_ci")
+	tk.MustExec(`insert into t values
+		(1, 'MySQL Tutorial', 'DBMS stands for DataBase ...'),
+		(2, 'How To Use MySQL Well', 'After you went through a ...'),
+		(3, 'Optimizing MySQL', 'In this tutorial, we show ...'),
+		(4, '1001 MySQL Tricks', '1. Never run mysqld as root. 2. ...'),
+		(5, 'MySQL vs. YourSQL', 'In the following database comparison ...'),
+		(6, 'MySQL Security', 'When configured properly, MySQL ...')`)
+}
+
+func TestFullTextSearch(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	prepareFullTextTable(tk)
+
+	tk.MustQuery("show index from t where key_name = 'ft'").Check(testkit.Rows(
+		"t 1 ft 1 title <nil> 0 <nil> <nil> YES FULLTEXT   YES <nil> NO",
+		"t 1 ft 2 body <nil> 0 <nil> <nil> YES FULLTEXT   YES <nil> NO"))
+	require.Contains(t, tk.MustQuery("show create table t").Rows()[0][1], "FULLTEXT KEY `ft` (`title`,`body`)")
+
+	// Natural language mode.
+	tk.MustQuery("select id from t where match(title, body) against ('database') order by id").Check(testkit.Rows("1", "5"))
+	tk.MustQuery("select id from t where match(title, body) against ('tutorial database') order by id").Check(testkit.Rows("1", "3", "5"))
+	tk.MustQuery("select id from t where match(title, body) against ('the of')").Check(testkit.Rows())
+	tk.MustQuery("select id from t where match(body, title) against ('database') order by id").Check(testkit.Rows("1", "5"))
+	// The row containing both words is the most relevant.
+	tk.MustQuery("select id from t order by match(title, body) against ('tutorial database') desc, id limit 1").Check(testkit.Rows("1"))
+	tk.MustQuery("select id, (match(title, body) against ('security')) > 0 from t where id in (1, 6) order by id").Check(testkit.Rows("1 0", "6 1"))
+
+	// Boolean mode.
+	tk.MustQuery("select id from t where match(title, body) against ('+mysql -yoursql' in boolean mode) order by id").Check(testkit.Rows("1", "2", "3", "4", "6"))
+	tk.MustQuery("select id from t where match(title, body) against ('tutor*' in boolean mode) order by id").Check(testkit.Rows("1", "3"))
+	tk.MustQuery(`select id from t where match(title, body) against ('"database comparison"' in boolean mode)`).Check(testkit.Rows("5"))
+	tk.MustQuery(`select id from t where match(title, body) against ('"comparison database"' in boolean mode)`).Check(testkit.Rows())
+	tk.MustQuery("select id from t where match(title, body) against ('-mysql' in boolean mode)").Check(testkit.Rows())
+
+	// The index is maintained by the DML in the transaction.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (7, 'Database Tutorial', 'x')")
+	tk.MustExec("update t set body = 'nothing' where id = 1")
+	tk.MustExec("delete from t where id = 5")
+	tk.MustQuery("select id from t where match(title, body) against ('database') order by id").Check(testkit.Rows("7"))
+	tk.MustExec("rollback")
+	tk.MustQuery("select id from t where match(title, body) against ('database') order by id").Check(testkit.Rows("1", "5"))
+	tk.MustExec("update t set title = 'Database Security' where id = 6")
+	tk.MustQuery("select id from t where match(title, body) against ('database') order by id").Check(testkit.Rows("1", "5", "6"))
+	tk.MustExec("admin check table t")
+
+	// The ngram parser.
+	tk.MustExec("create table t2 (id int, a text, fulltext key fa(a) with parser ngram)")
+	tk.MustExec("insert into t2 values (1, '全文索引'), (2, '数据库索引'), (3, 'full text')")
+	tk.MustExec("alter table t2 add fulltext key fb(a)")
+	tk.MustQuery("select id from t2 where match(a) against ('索引') order by id").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select id from t2 where match(a) against ('+索引 -数据' in boolean mode)").Check(testkit.Rows("1"))
+	tk.MustQuery("select id from t2 where match(a) against ('full')").Check(testkit.Rows("3"))
+	tk.MustExec("admin check table t2")
+}
+
+func fullTextPlan(tk *testkit.TestKit, sql string) string {
+	var plan []string
+	for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+		plan = append(plan, fmt.Sprintf("%v", row))
+	}
+	return strings.Join(plan, "\n")
+}
+
+func TestFullTextSearchPlan(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	prepareFullTextTable(tk)
+
+	plan := fullTextPlan(tk, "select id from t where match(title, body) against ('database')")
+	require.Contains(t, plan, `match_against(test.t.title, test.t.body, "database")`)
+	require.Contains(t, plan, "IndexMerge")
+	require.Contains(t, plan, "index:ft(title, body) range:[0x6461746162617365,0x6461746162617365]")
+	// The FULLTEXT index cannot be used for the search without terms to look up.
+	require.NotContains(t, fullTextPlan(tk, "select id from t where match(title, body) against ('-mysql' in boolean mode)"), "IndexMerge")
+	// The FULLTEXT index is not used as an ordinary index.
+	require.NotContains(t, fullTextPlan(tk, "select id from t use index(ft) where title = 'MySQL Security'"), "index:ft")
+
+	tk.MustGetErrCode("select id from t where match(title) against ('database')", errno.ErrFtMatchingKeyNotFound)
+	tk.MustGetErrCode("select id from t where match(title, body) against (title)", errno.ErrWrongArguments)
+	tk.MustGetErrCode("select id from t where match(title, body) against ('database' with query expansion)", errno.ErrNotSupportedYet)
+	tk.MustContainErrMsg("admin check index t ft", "admin check index is not supported on FULLTEXT index ft")
+}
@@ -16,16 +16,32 @@ package executor
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/ddl"
 	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/opcode"
+	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"github.com/pingcap/tidb/util/stmtsummary"
+	stmtsummaryv2 "github.com/pingcap/tidb/util/stmtsummary/v2"
 )
 
 // IndexAdviseExec represents a index advise executor.
@@ -144,3 +160,611 @@ func (IndexAdviseVarKeyType) String() string {
 
 // IndexAdviseVarKey is a variable key for index advise.
 const IndexAdviseVarKey IndexAdviseVarKeyType = 0
+
+const (
+	// adviseIndexMaxStmts is the maximum number of the statements in the workload of ADVISE INDEX.
+	adviseIndexMaxStmts = 100
+	// adviseIndexMaxColumns is the maximum number of the columns in a candidate index.
+	adviseIndexMaxColumns = 3
+)
+
+// AdviseIndexExec recommends indexes for the workload in the statements summary. The candidate indexes are
+// enumerated from the predicates and the ORDER BY items of the top statements, then they are costed by the
+// optimizer as hypothetical indexes in a system session, so no index is built.
+type AdviseIndexExec struct {
+	exec.BaseExecutor
+
+	limit   uint64
+	done    bool
+	advices []*indexCandidate
+}
+
+// Next implements the Executor Next interface.
+func (e *AdviseIndexExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+	if err := e.advise(ctx); err != nil {
+		return err
+	}
+	for _, c := range e.advices {
+		req.AppendString(0, c.dbName.O)
+		req.AppendString(1, c.tblInfo.Name.O)
+		req.AppendString(2, c.idxInfo.Name.O)
+		req.AppendString(3, c.columnNames())
+		req.AppendFloat64(4, c.benefit)
+		req.AppendString(5, strings.Join(c.digests, ","))
+		req.AppendString(6, c.createStatement())
+	}
+	return nil
+}
+
+func (e *AdviseIndexExec) advise(ctx context.Context) error {
+	is := e.Ctx().GetInfoSchema().(infoschema.InfoSchema)
+	workload := adviseIndexWorkload(is)
+	candidates := enumerateIndexCandidates(e.Ctx(), workload)
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sctx, err := e.GetSysSession()
+	if err != nil {
+		return err
+	}
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	sessVars := sctx.GetSessionVars()
+	originDB, originHypoIndexes := sessVars.CurrentDB, sessVars.HypoIndexes
+	defer func() {
+		sessVars.CurrentDB, sessVars.HypoIndexes = originDB, originHypoIndexes
+		e.ReleaseSysSession(ctx, sctx)
+	}()
+
+	// Estimate the cost of the statements without the candidate indexes.
+	costed := workload[:0]
+	for _, stmt := range workload {
+		sessVars.HypoIndexes = nil
+		if stmt.baseCost, _, err = explainWorkloadStmt(ctx, sctx, stmt); err != nil {
+			// The statement can't be optimized in the system session, e.g. it's truncated in the summary.
+			continue
+		}
+		costed = append(costed, stmt)
+	}
+	for _, c := range candidates {
+		sessVars.HypoIndexes = map[string]map[string]map[string]*model.IndexInfo{
+			c.dbName.L: {c.tblInfo.Name.L: {c.idxInfo.Name.L: c.idxInfo}},
+		}
+		for _, stmt := range costed {
+			if _, ok := stmt.tables[c.tblInfo.ID]; !ok {
+				continue
+			}
+			cost, plan, err := explainWorkloadStmt(ctx, sctx, stmt)
+			if err != nil {
+				continue
+			}
+			if cost < stmt.baseCost && strings.Contains(plan, "index:"+c.idxInfo.Name.O+"(") {
+				c.benefit += (stmt.baseCost - cost) * float64(stmt.ExecCount)
+				c.digests = append(c.digests, stmt.Digest)
+			}
+		}
+	}
+	e.advices = pickIndexAdvices(candidates, e.limit)
+	return nil
+}
+
+// workloadStmt is a statement in the workload of ADVISE INDEX.
+type workloadStmt struct {
+	*stmtsummary.AdvisableStmt
+	node ast.StmtNode
+	// tables are the IDs of the tables accessed by the statement.
+	tables   map[int64]struct{}
+	baseCost float64
+}
+
+// adviseIndexWorkload returns the statements with the largest sum latency in the statements summary.
+func adviseIndexWorkload(is infoschema.InfoSchema) []*workloadStmt {
+	// The statements are summarized by the plan digest too, merge them by the SQL digest.
+	merged := make(map[string]*stmtsummary.AdvisableStmt)
+	for _, stmt := range stmtsummaryv2.GetAdvisableStmt() {
+		key := stmt.Schema + "." + stmt.Digest
+		if m, ok := merged[key]; ok {
+			m.ExecCount += stmt.ExecCount
+			m.SumLatency += stmt.SumLatency
+			continue
+		}
+		merged[key] = stmt
+	}
+	stmts := make([]*stmtsummary.AdvisableStmt, 0, len(merged))
+	for _, stmt := range merged {
+		stmts = append(stmts, stmt)
+	}
+	sort.Slice(stmts, func(i, j int) bool {
+		if stmts[i].SumLatency != stmts[j].SumLatency {
+			return stmts[i].SumLatency > stmts[j].SumLatency
+		}
+		return stmts[i].Digest < stmts[j].Digest
+	})
+
+	workload := make([]*workloadStmt, 0, adviseIndexMaxStmts)
+	p := parser.New()
+	for _, stmt := range stmts {
+		if len(workload) >= adviseIndexMaxStmts {
+			break
+		}
+		node, err := p.ParseOneStmt(stmt.Query, "", "")
+		if err != nil {
+			continue
+		}
+		switch node.(type) {
+		case *ast.SelectStmt, *ast.SetOprStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		default:
+			continue
+		}
+		workload = append(workload, &workloadStmt{AdvisableStmt: stmt, node: node, tables: make(map[int64]struct{})})
+	}
+	return workload
+}
+
+// explainWorkloadStmt returns the estimated cost and the access objects of the statement's plan in sctx.
+func explainWorkloadStmt(ctx context.Context, sctx sessionctx.Context, stmt *workloadStmt) (float64, string, error) {
+	var sb strings.Builder
+	explain := &ast.ExplainStmt{Stmt: stmt.node, Format: types.ExplainFormatVerbose}
+	if err := explain.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return 0, "", err
+	}
+	sctx.GetSessionVars().CurrentDB = stmt.Schema
+	rs, err := sctx.(sqlexec.SQLExecutor).ExecuteInternal(ctx, sb.String())
+	if err != nil {
+		return 0, "", err
+	}
+	rows, err := sqlexec.DrainRecordSet(ctx, rs, sctx.GetSessionVars().MaxChunkSize)
+	terror.Log(rs.Close())
+	if err != nil {
+		return 0, "", err
+	}
+	if len(rows) == 0 {
+		return 0, "", errors.New("empty plan")
+	}
+	// The columns are id, estRows, estCost, task, access object and operator info.
+	cost, err := strconv.ParseFloat(rows[0].GetString(2), 64)
+	if err != nil {
+		return 0, "", err
+	}
+	accessObjects := make([]string, 0, len(rows))
+	for _, row := range rows {
+		accessObjects = append(accessObjects, row.GetString(4))
+	}
+	return cost, strings.Join(accessObjects, "\n"), nil
+}
+
+// indexCandidate is a candidate index of ADVISE INDEX.
+type indexCandidate struct {
+	dbName  model.CIStr
+	tblInfo *model.TableInfo
+	idxInfo *model.IndexInfo
+	// benefit is the estimated cost reduction of the workload.
+	benefit float64
+	// digests are the digests of the statements whose plans are improved by the index.
+	digests []string
+}
+
+func (c *indexCandidate) columnNames() string {
+	names := make([]string, 0, len(c.idxInfo.Columns))
+	for _, col := range c.idxInfo.Columns {
+		names = append(names, col.Name.O)
+	}
+	return strings.Join(names, ",")
+}
+
+func (c *indexCandidate) createStatement() string {
+	var sb strings.Builder
+	sqlexec.MustFormatSQL(&sb, "CREATE INDEX %n ON %n.%n (", c.idxInfo.Name.O, c.dbName.O, c.tblInfo.Name.O)
+	for i, col := range c.idxInfo.Columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sqlexec.MustFormatSQL(&sb, "%n", col.Name.O)
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// coveredBy returns whether the index is a prefix of the columns of idx.
+func (c *indexCandidate) coveredBy(idx *model.IndexInfo) bool {
+	if len(c.idxInfo.Columns) > len(idx.Columns) {
+		return false
+	}
+	for i, col := range c.idxInfo.Columns {
+		if idx.Columns[i].Name.L != col.Name.L {
+			return false
+		}
+	}
+	return true
+}
+
+// enumerateIndexCandidates enumerates the candidate indexes from the predicates and the ORDER BY items of the
+// workload, the candidates which are covered by the existing indexes are skipped.
+func enumerateIndexCandidates(sctx sessionctx.Context, workload []*workloadStmt) []*indexCandidate {
+	is := sctx.GetInfoSchema().(infoschema.InfoSchema)
+	candidates := make([]*indexCandidate, 0)
+	exists := make(map[string]struct{})
+	for _, stmt := range workload {
+		extractor := &candidateExtractor{is: is, defaultDB: model.NewCIStr(stmt.Schema), tables: make(map[int64]*candidateTable)}
+		stmt.node.Accept(extractor)
+		for id, tbl := range extractor.tables {
+			stmt.tables[id] = struct{}{}
+			for _, cols := range tbl.candidateColumns() {
+				c := newIndexCandidate(sctx, tbl, cols)
+				if c == nil {
+					continue
+				}
+				key := c.dbName.L + "." + c.tblInfo.Name.L + "(" + strings.ToLower(c.columnNames()) + ")"
+				if _, ok := exists[key]; ok {
+					continue
+				}
+				exists[key] = struct{}{}
+				candidates = append(candidates, c)
+			}
+		}
+	}
+	return candidates
+}
+
+func newIndexCandidate(sctx sessionctx.Context, tbl *candidateTable, cols []*model.ColumnInfo) *indexCandidate {
+	names := make([]string, 0, len(cols))
+	specs := make([]*ast.IndexPartSpecification, 0, len(cols))
+	for _, col := range cols {
+		names = append(names, col.Name.L)
+		specs = append(specs, &ast.IndexPartSpecification{Column: &ast.ColumnName{Name: col.Name}, Length: types.UnspecifiedLength})
+	}
+	name := "idx_" + strings.Join(names, "_")
+	if len(name) > mysql.MaxIndexIdentifierLen {
+		name = name[:mysql.MaxIndexIdentifierLen]
+	}
+	idxName := model.NewCIStr(name)
+	for i := 1; tbl.tblInfo.FindIndexByName(idxName.L) != nil; i++ {
+		suffix := fmt.Sprintf("_%d", i)
+		idxName = model.NewCIStr(name[:mathutil.Min(len(name), mysql.MaxIndexIdentifierLen-len(suffix))] + suffix)
+	}
+	idxInfo, err := ddl.BuildIndexInfo(sctx, tbl.tblInfo.Columns, idxName, false, false, false, specs,
+		&ast.IndexOption{Tp: model.IndexTypeHypo}, model.StatePublic)
+	if err != nil {
+		return nil
+	}
+	idxInfo.Table = tbl.tblInfo.Name
+	c := &indexCandidate{dbName: tbl.dbName, tblInfo: tbl.tblInfo, idxInfo: idxInfo}
+	for _, idx := range tbl.tblInfo.Indices {
+		if idx.State == model.StatePublic && !idx.FullText && c.coveredBy(idx) {
+			return nil
+		}
+	}
+	if pk := tbl.tblInfo.GetPkColInfo(); tbl.tblInfo.PKIsHandle && pk != nil && len(cols) == 1 && cols[0].ID == pk.ID {
+		return nil
+	}
+	return c
+}
+
+// pickIndexAdvices picks the beneficial candidates with the largest benefit. A candidate is skipped if the
+// statements it improves are all improved by the picked indexes on the same table.
+func pickIndexAdvices(candidates []*indexCandidate, limit uint64) []*indexCandidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].benefit != candidates[j].benefit {
+			return candidates[i].benefit > candidates[j].benefit
+		}
+		return len(candidates[i].idxInfo.Columns) < len(candidates[j].idxInfo.Columns)
+	})
+	advices := make([]*indexCandidate, 0)
+	improved := make(map[int64]map[string]struct{})
+	for _, c := range candidates {
+		if c.benefit <= 0 || (limit > 0 && uint64(len(advices)) >= limit) {
+			break
+		}
+		digests := improved[c.tblInfo.ID]
+		if digests == nil {
+			digests = make(map[string]struct{})
+			improved[c.tblInfo.ID] = digests
+		}
+		redundant := true
+		for _, digest := range c.digests {
+			if _, ok := digests[digest]; !ok {
+				redundant = false
+			}
+			digests[digest] = struct{}{}
+		}
+		if !redundant {
+			advices = append(advices, c)
+		}
+	}
+	return advices
+}
+
+// candidateTable collects the columns used by the predicates and the ORDER BY items of a table.
+type candidateTable struct {
+	dbName  model.CIStr
+	tblInfo *model.TableInfo
+
+	eqCols    []*model.ColumnInfo
+	rangeCols []*model.ColumnInfo
+	orderCols []*model.ColumnInfo
+}
+
+// candidateColumns returns the columns of the candidate indexes: the single column indexes of the predicates,
+// the index of the ORDER BY items, and the composite indexes led by the equal conditions.
+func (t *candidateTable) candidateColumns() [][]*model.ColumnInfo {
+	var result [][]*model.ColumnInfo
+	add := func(cols ...[]*model.ColumnInfo) {
+		idxCols := make([]*model.ColumnInfo, 0, adviseIndexMaxColumns)
+		for _, colList := range cols {
+			for _, col := range colList {
+				if len(idxCols) < adviseIndexMaxColumns && !containsColumn(idxCols, col) {
+					idxCols = append(idxCols, col)
+				}
+			}
+		}
+		if len(idxCols) > 0 {
+			result = append(result, idxCols)
+		}
+	}
+	for _, col := range t.eqCols {
+		add([]*model.ColumnInfo{col})
+	}
+	for _, col := range t.rangeCols {
+		add([]*model.ColumnInfo{col})
+	}
+	add(t.orderCols)
+	if len(t.eqCols) == 0 {
+		return result
+	}
+	if len(t.eqCols) > 1 {
+		add(t.eqCols)
+	}
+	for _, col := range t.rangeCols {
+		add(t.eqCols, []*model.ColumnInfo{col})
+	}
+	if len(t.orderCols) > 0 {
+		add(t.eqCols, t.orderCols)
+	}
+	return result
+}
+
+func containsColumn(cols []*model.ColumnInfo, col *model.ColumnInfo) bool {
+	for _, c := range cols {
+		if c.ID == col.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// candidateSource is a table in the FROM clause.
+type candidateSource struct {
+	name  model.CIStr
+	table *candidateTable
+}
+
+// candidateExtractor extracts the columns used by the predicates and the ORDER BY items for each table in a
+// statement. The columns are resolved in the FROM clause of their own query block, the columns which can't be
+// resolved, e.g. the correlated columns, are ignored.
+type candidateExtractor struct {
+	is        infoschema.InfoSchema
+	defaultDB model.CIStr
+	tables    map[int64]*candidateTable
+}
+
+// Enter implements Visitor interface.
+func (e *candidateExtractor) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.SelectStmt:
+		e.extract(x.From, x.Where, x.OrderBy)
+	case *ast.UpdateStmt:
+		e.extract(x.TableRefs, x.Where, x.Order)
+	case *ast.DeleteStmt:
+		e.extract(x.TableRefs, x.Where, x.Order)
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*candidateExtractor) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func (e *candidateExtractor) extract(from *ast.TableRefsClause, where ast.ExprNode, orderBy *ast.OrderByClause) {
+	if from == nil || from.TableRefs == nil {
+		return
+	}
+	sources := make([]*candidateSource, 0, 2)
+	conds := make([]ast.ExprNode, 0, 2)
+	e.collectSources(from.TableRefs, &sources, &conds)
+	if len(sources) == 0 {
+		return
+	}
+	if where != nil {
+		conds = append(conds, where)
+	}
+	for _, cond := range conds {
+		e.extractPredicates(sources, cond)
+	}
+	if orderBy == nil {
+		return
+	}
+	// The ORDER BY items can be provided by an index only if they are all from the same table.
+	var orderTbl *candidateTable
+	orderCols := make([]*model.ColumnInfo, 0, len(orderBy.Items))
+	for _, item := range orderBy.Items {
+		colExpr, ok := item.Expr.(*ast.ColumnNameExpr)
+		if !ok {
+			return
+		}
+		tbl, col := resolveCandidateColumn(sources, colExpr.Name)
+		if tbl == nil || (orderTbl != nil && orderTbl != tbl) {
+			return
+		}
+		orderTbl = tbl
+		orderCols = append(orderCols, col)
+	}
+	if orderTbl != nil {
+		orderTbl.orderCols = orderCols
+	}
+}
+
+func (e *candidateExtractor) collectSources(node ast.ResultSetNode, sources *[]*candidateSource, conds *[]ast.ExprNode) {
+	switch x := node.(type) {
+	case *ast.Join:
+		if x.Left != nil {
+			e.collectSources(x.Left, sources, conds)
+		}
+		if x.Right != nil {
+			e.collectSources(x.Right, sources, conds)
+		}
+		if x.On != nil {
+			*conds = append(*conds, x.On.Expr)
+		}
+	case *ast.TableSource:
+		tn, ok := x.Source.(*ast.TableName)
+		if !ok {
+			return
+		}
+		dbName := tn.Schema
+		if dbName.L == "" {
+			dbName = e.defaultDB
+		}
+		if util.IsMemOrSysDB(dbName.L) {
+			return
+		}
+		tbl, err := e.is.TableByName(dbName, tn.Name)
+		if err != nil {
+			return
+		}
+		tblInfo := tbl.Meta()
+		if tblInfo.IsView() || tblInfo.IsSequence() || tblInfo.TempTableType != model.TempTableNone {
+			return
+		}
+		name := x.AsName
+		if name.L == "" {
+			name = tn.Name
+		}
+		ct, ok := e.tables[tblInfo.ID]
+		if !ok {
+			ct = &candidateTable{dbName: dbName, tblInfo: tblInfo}
+			e.tables[tblInfo.ID] = ct
+		}
+		*sources = append(*sources, &candidateSource{name: name, table: ct})
+	}
+}
+
+func (e *candidateExtractor) extractPredicates(sources []*candidateSource, expr ast.ExprNode) {
+	switch x := expr.(type) {
+	case *ast.ParenthesesExpr:
+		e.extractPredicates(sources, x.Expr)
+	case *ast.BinaryOperationExpr:
+		switch x.Op {
+		case opcode.LogicAnd:
+			e.extractPredicates(sources, x.L)
+			e.extractPredicates(sources, x.R)
+		case opcode.EQ, opcode.NullEQ:
+			lTbl, lCol := resolveCandidateExpr(sources, x.L)
+			rTbl, rCol := resolveCandidateExpr(sources, x.R)
+			// The join keys are the equal conditions for the inner side of the index join.
+			if lTbl != nil && (isCandidateConstant(x.R) || rTbl != nil) {
+				lTbl.eqCols = appendColumn(lTbl.eqCols, lCol)
+			}
+			if rTbl != nil && (isCandidateConstant(x.L) || lTbl != nil) {
+				rTbl.eqCols = appendColumn(rTbl.eqCols, rCol)
+			}
+		case opcode.LT, opcode.LE, opcode.GT, opcode.GE:
+			if tbl, col := resolveCandidateExpr(sources, x.L); tbl != nil && isCandidateConstant(x.R) {
+				tbl.rangeCols = appendColumn(tbl.rangeCols, col)
+			} else if tbl, col := resolveCandidateExpr(sources, x.R); tbl != nil && isCandidateConstant(x.L) {
+				tbl.rangeCols = appendColumn(tbl.rangeCols, col)
+			}
+		}
+	case *ast.PatternInExpr:
+		if x.Not || x.Sel != nil {
+			return
+		}
+		for _, item := range x.List {
+			if !isCandidateConstant(item) {
+				return
+			}
+		}
+		if tbl, col := resolveCandidateExpr(sources, x.Expr); tbl != nil {
+			tbl.eqCols = appendColumn(tbl.eqCols, col)
+		}
+	case *ast.IsNullExpr:
+		if tbl, col := resolveCandidateExpr(sources, x.Expr); tbl != nil && !x.Not {
+			tbl.eqCols = appendColumn(tbl.eqCols, col)
+		}
+	case *ast.BetweenExpr:
+		if x.Not || !isCandidateConstant(x.Left) || !isCandidateConstant(x.Right) {
+			return
+		}
+		if tbl, col := resolveCandidateExpr(sources, x.Expr); tbl != nil {
+			tbl.rangeCols = appendColumn(tbl.rangeCols, col)
+		}
+	case *ast.PatternLikeOrIlikeExpr:
+		if x.Not || !x.IsLike {
+			return
+		}
+		// Only the pattern with a constant prefix can be converted to a range.
+		v, ok := x.Pattern.(ast.ValueExpr)
+		if !ok {
+			return
+		}
+		if pattern := v.GetString(); pattern == "" || pattern[0] == '%' || pattern[0] == '_' || pattern[0] == x.Escape {
+			return
+		}
+		if tbl, col := resolveCandidateExpr(sources, x.Expr); tbl != nil {
+			tbl.rangeCols = appendColumn(tbl.rangeCols, col)
+		}
+	}
+}
+
+func appendColumn(cols []*model.ColumnInfo, col *model.ColumnInfo) []*model.ColumnInfo {
+	if containsColumn(cols, col) {
+		return cols
+	}
+	return append(cols, col)
+}
+
+func isCandidateConstant(expr ast.ExprNode) bool {
+	switch x := expr.(type) {
+	case ast.ValueExpr:
+		return true
+	case *ast.UnaryOperationExpr:
+		return x.Op == opcode.Minus && isCandidateConstant(x.V)
+	}
+	return false
+}
+
+func resolveCandidateExpr(sources []*candidateSource, expr ast.ExprNode) (*candidateTable, *model.ColumnInfo) {
+	colExpr, ok := expr.(*ast.ColumnNameExpr)
+	if !ok {
+		return nil, nil
+	}
+	return resolveCandidateColumn(sources, colExpr.Name)
+}
+
+// resolveCandidateColumn finds the column in the sources, it returns nil if the column can't be resolved or
+// it can't be indexed without a prefix length.
+func resolveCandidateColumn(sources []*candidateSource, name *ast.ColumnName) (tbl *candidateTable, col *model.ColumnInfo) {
+	for _, source := range sources {
+		if name.Table.L != "" && (name.Table.L != source.name.L || (name.Schema.L != "" && name.Schema.L != source.table.dbName.L)) {
+			continue
+		}
+		c := model.FindColumnInfo(source.table.tblInfo.Columns, name.Name.L)
+		if c == nil || c.Hidden || c.State != model.StatePublic {
+			continue
+		}
+		if col != nil {
+			// The column is ambiguous.
+			return nil, nil
+		}
+		tbl, col = source.table, c
+	}
+	if col != nil && (types.IsTypeBlob(col.GetType()) || col.GetType() == mysql.TypeJSON || col.GetType() == mysql.TypeGeometry) {
+		return nil, nil
+	}
+	return tbl, col
+}
@@ -15,10 +15,13 @@
 package executor_test
 
 import (
+	"fmt"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/pingcap/tidb/executor"
+	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/stretchr/testify/require"
@@ -66,6 +69,46 @@ func TestIndexAdvise(t *testing.T) {
 	require.Equal(t, uint64(5), ia.MaxIndexNum.PerDB)
 }
 
+func TestAdviseIndex(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	// clear the statements summary
+	tk.MustExec("set global tidb_enable_stmt_summary = 0")
+	tk.MustExec("set global tidb_enable_stmt_summary = 1")
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int, d varchar(10), key(c))")
+	for i := 0; i < 200; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t values (%v, %v, %v, 'x%v')", i%20, i, i%50, i))
+	}
+	tk.MustExec("analyze table t")
+	tk.MustQuery("advise index").Check(testkit.Rows())
+
+	for i := 0; i < 3; i++ {
+		tk.MustQuery("select * from t where a = 1 and b > 150").Check(testkit.Rows("1 161 11 x161", "1 181 31 x181"))
+		// covered by the existing index
+		tk.MustQuery("select count(*) from t where c = 1").Check(testkit.Rows("4"))
+	}
+	tk.MustQuery("select b from t where d = 'x1'").Check(testkit.Rows("1"))
+	rows := tk.MustQuery("advise index").Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, []interface{}{"test", "t", "idx_a_b", "a,b"}, rows[0][:4])
+	require.Equal(t, "CREATE INDEX `idx_a_b` ON `test`.`t` (`a`, `b`)", rows[0][6])
+	require.Equal(t, []interface{}{"test", "t", "idx_d", "d"}, rows[1][:4])
+	require.Equal(t, "CREATE INDEX `idx_d` ON `test`.`t` (`d`)", rows[1][6])
+	for _, row := range rows {
+		benefit, err := strconv.ParseFloat(row[4].(string), 64)
+		require.NoError(t, err)
+		require.Greater(t, benefit, 0.0)
+		require.NotEmpty(t, row[5])
+	}
+	tk.MustQuery("advise index limit 1").Check(testkit.Rows(rows[0][0].(string) + " " + rows[0][1].(string) + " " +
+		rows[0][2].(string) + " " + rows[0][3].(string) + " " + rows[0][4].(string) + " " + rows[0][5].(string) + " " + rows[0][6].(string)))
+
+	// no index is built
+	tk.MustQuery("show index from t").CheckAt([]int{2}, testkit.Rows("c"))
+}
+
 func TestIndexJoinProjPattern(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -84,6 +84,12 @@ type IndexLookUpJoin struct {
 
 	memTracker *memory.Tracker // track memory usage.
 
+	// adaptiveThreshold is the number of outer rows to buffer before deciding whether to fall back to hash join
+	// against a full scan of the inner side. 0 means the join never falls back.
+	adaptiveThreshold int
+	// fallback is set by the outer worker when the join falls back to hash join.
+	fallback *indexJoinFallback
+
 	stats    *indexLookUpJoinRuntimeStats
 	finished *atomic.Value
 	prepared bool
@@ -108,6 +114,8 @@ type innerCtx struct {
 	hashCollators []collate.Collator
 	colLens       []int
 	hasPrefixCol  bool
+	// fallbackPlan is the full scan of the inner side used when the join falls back to hash join.
+	fallbackPlan plannercore.PhysicalPlan
 }
 
 type lookUpJoinTask struct {
@@ -118,6 +126,8 @@ type lookUpJoinTask struct {
 	encodedLookUpKeys []*chunk.Chunk
 	lookupMap         *mvmap.MVMap
 	matchedInners     []chunk.Row
+	// fallback holds the whole inner side and its hash map after the join falls back to hash join.
+	fallback *indexJoinFallback
 
 	doneCh   chan error
 	cursor   chunk.RowPtr
@@ -144,6 +154,14 @@ type outerWorker struct {
 	parentMemTracker *memory.Tracker
 }
 
+// indexJoinFallback is the whole inner side shared by all the tasks after the join falls back to hash join.
+// It's built once by the first inner worker which handles a task.
+type indexJoinFallback struct {
+	once  sync.Once
+	inner *lookUpJoinTask
+	err   error
+}
+
 type innerWorker struct {
 	innerCtx
 
@@ -171,7 +189,7 @@ func (e *IndexLookUpJoin) Open(ctx context.Context) error {
 	e.innerPtrBytes = make([][]byte, 0, 8)
 	e.finished.Store(false)
 	if e.RuntimeStats() != nil {
-		e.stats = &indexLookUpJoinRuntimeStats{}
+		e.stats = &indexLookUpJoinRuntimeStats{adaptiveThreshold: e.adaptiveThreshold}
 	}
 	e.cancelFunc = nil
 	return nil
@@ -373,18 +391,42 @@ func (ow *outerWorker) run(ctx context.Context, wg *sync.WaitGroup) {
 		close(ow.innerCh)
 		wg.Done()
 	}()
-	for {
-		failpoint.Inject("TestIssue30211", nil)
-		failpoint.Inject("ConsumeRandomPanic", nil)
-		task, err := ow.buildTask(ctx)
+	var (
+		bufferedTasks []*lookUpJoinTask
+		drained       bool
+	)
+	if ow.lookup.adaptiveThreshold > 0 {
+		var err error
+		bufferedTasks, drained, err = ow.bufferTasks(ctx)
 		if err != nil {
+			task := &lookUpJoinTask{doneCh: make(chan error, 1)}
 			task.doneCh <- err
 			ow.pushToChan(ctx, task, ow.resultCh)
 			return
 		}
-		if task == nil {
-			return
+	}
+	for {
+		failpoint.Inject("TestIssue30211", nil)
+		failpoint.Inject("ConsumeRandomPanic", nil)
+		var task *lookUpJoinTask
+		if len(bufferedTasks) > 0 {
+			task, bufferedTasks = bufferedTasks[0], bufferedTasks[1:]
+		} else {
+			if drained {
+				return
+			}
+			var err error
+			task, err = ow.buildTask(ctx)
+			if err != nil {
+				task.doneCh <- err
+				ow.pushToChan(ctx, task, ow.resultCh)
+				return
+			}
+			if task == nil {
+				return
+			}
 		}
+		task.fallback = ow.lookup.fallback
 
 		if finished := ow.pushToChan(ctx, task, ow.innerCh); finished {
 			return
@@ -396,6 +438,47 @@ func (ow *outerWorker) run(ctx context.Context, wg *sync.WaitGroup) {
 	}
 }
 
+// bufferTasks reads outer rows until they exceed the adaptive threshold or the outer side is drained. When the
+// threshold is exceeded, the join falls back to hash join: the whole inner side is read by a full scan and its
+// hash map is shared by all the tasks. Neither the buffered outer rows nor the inner side are spilled, so the join
+// also falls back once the outer rows take half of the memory quota of the statement, and it doesn't fall back if
+// the inner side is estimated to take more than the other half.
+func (ow *outerWorker) bufferTasks(ctx context.Context) (tasks []*lookUpJoinTask, drained bool, err error) {
+	outerRows := 0
+	memQuota := ow.ctx.GetSessionVars().MemQuotaQuery
+	for outerRows <= ow.lookup.adaptiveThreshold {
+		if memQuota > 0 && ow.parentMemTracker.BytesConsumed() > memQuota/2 {
+			break
+		}
+		task, err := ow.buildTask(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if task == nil {
+			return tasks, true, nil
+		}
+		tasks = append(tasks, task)
+		outerRows += task.outerResult.Len()
+	}
+	if memQuota > 0 && ow.lookup.estimateFallbackInnerSize() > float64(memQuota/2) {
+		return tasks, false, nil
+	}
+	if ow.lookup.stats != nil {
+		ow.lookup.stats.fallback = true
+	}
+	ow.lookup.fallback = &indexJoinFallback{}
+	return tasks, false, nil
+}
+
+// estimateFallbackInnerSize estimates the memory usage of the whole inner side read by the fallback.
+func (e *IndexLookUpJoin) estimateFallbackInnerSize() float64 {
+	rowSize := 0
+	for _, tp := range e.innerCtx.rowTypes {
+		rowSize += chunk.EstimateTypeWidth(tp)
+	}
+	return e.innerCtx.fallbackPlan.StatsInfo().RowCount * float64(rowSize)
+}
+
 func (*outerWorker) pushToChan(ctx context.Context, task *lookUpJoinTask, dst chan<- *lookUpJoinTask) bool {
 	select {
 	case <-ctx.Done():
@@ -522,10 +605,26 @@ func (iw *innerWorker) handleTask(ctx context.Context, task *lookUpJoinTask) err
 	defer func() {
 		iw.memTracker.Consume(-iw.memTracker.BytesConsumed())
 	}()
+	if task.fallback != nil {
+		// The join has fallen back to hash join, all the inner rows are fetched only once.
+		task.fallback.once.Do(func() {
+			task.fallback.inner, task.fallback.err = iw.buildFallbackInner(ctx)
+		})
+		if task.fallback.err == errIndexJoinFallbackTooLarge {
+			// The inner side is too large to be held in memory, look up the inner rows of the task instead.
+			task.fallback = nil
+		} else if task.fallback.err != nil {
+			return task.fallback.err
+		}
+	}
 	lookUpContents, err := iw.constructLookupContent(task)
 	if err != nil {
 		return err
 	}
+	if task.fallback != nil {
+		task.innerResult, task.lookupMap = task.fallback.inner.innerResult, task.fallback.inner.lookupMap
+		return nil
+	}
 	err = iw.fetchInnerResults(ctx, task, lookUpContents)
 	if err != nil {
 		return err
@@ -581,6 +680,10 @@ func (iw *innerWorker) constructLookupContent(task *lookUpJoinTask) ([]*indexJoi
 			}
 			// Store the encoded lookup key in chunk, so we can use it to lookup the matched inners directly.
 			task.encodedLookUpKeys[chkIdx].AppendBytes(0, keyBuf)
+			if task.fallback != nil {
+				// Only the encoded lookup keys are needed to probe the hash map of the whole inner side.
+				continue
+			}
 			if iw.hasPrefixCol {
 				for i, outerOffset := range iw.keyOff2IdxOff {
 					// If it's a prefix column. Try to fix it.
@@ -695,7 +798,54 @@ func (iw *innerWorker) fetchInnerResults(ctx context.Context, task *lookUpJoinTa
 	if err != nil {
 		return err
 	}
+	return iw.readInnerResults(ctx, task, innerExec, 0)
+}
 
+// buildFallbackInner reads the whole inner side by a full scan and builds its hash map,
+// which is used by all the tasks after the join falls back to hash join.
+func (iw *innerWorker) buildFallbackInner(ctx context.Context) (_ *lookUpJoinTask, err error) {
+	inner := &lookUpJoinTask{
+		lookupMap:  mvmap.NewMVMap(),
+		memTracker: memory.NewTracker(memory.LabelForBuildSideResult, -1),
+	}
+	inner.memTracker.AttachTo(iw.lookup.memTracker)
+	defer func() {
+		if err != nil {
+			inner.memTracker.Detach()
+		}
+	}()
+	if iw.stats != nil {
+		start := time.Now()
+		defer func() {
+			atomic.AddInt64(&iw.stats.fetch, int64(time.Since(start)))
+		}()
+	}
+	innerExec, err := iw.readerBuilder.buildExecutorForIndexJoinFallback(ctx, iw.fallbackPlan)
+	if innerExec != nil {
+		defer terror.Call(innerExec.Close)
+	}
+	if err != nil {
+		return nil, err
+	}
+	memQuota := iw.ctx.GetSessionVars().MemQuotaQuery
+	if err = iw.readInnerResults(ctx, inner, innerExec, memQuota/2); err != nil {
+		if err == errIndexJoinFallbackTooLarge && iw.lookup.stats != nil {
+			iw.lookup.stats.fallback = false
+		}
+		return nil, err
+	}
+	if err = iw.buildLookUpMap(inner); err != nil {
+		return nil, err
+	}
+	return inner, nil
+}
+
+// errIndexJoinFallbackTooLarge means the whole inner side read by the fallback exceeds its memory limit.
+var errIndexJoinFallbackTooLarge = errors.New("the inner side of the index join fallback is too large")
+
+// readInnerResults reads all the rows of innerExec into the task. If memLimit is positive, it returns
+// errIndexJoinFallbackTooLarge once the rows take more memory than it.
+func (iw *innerWorker) readInnerResults(ctx context.Context, task *lookUpJoinTask, innerExec exec.Executor, memLimit int64) error {
 	innerResult := chunk.NewList(retTypes(innerExec), iw.ctx.GetSessionVars().MaxChunkSize, iw.ctx.GetSessionVars().MaxChunkSize)
 	innerResult.GetMemTracker().SetLabel(memory.LabelForBuildSideResult)
 	innerResult.GetMemTracker().AttachTo(task.memTracker)
@@ -715,6 +865,9 @@ func (iw *innerWorker) fetchInnerResults(ctx context.Context, task *lookUpJoinTa
 		}
 		innerResult.Add(iw.executorChk)
 		iw.executorChk = tryNewCacheChunk(innerExec)
+		if memLimit > 0 && innerResult.GetMemTracker().BytesConsumed() > memLimit {
+			return errIndexJoinFallbackTooLarge
+		}
 	}
 	task.innerResult = innerResult
 	return nil
@@ -772,6 +925,10 @@ func (e *IndexLookUpJoin) Close() error {
 		e.cancelFunc()
 	}
 	e.workerWg.Wait()
+	if e.fallback != nil && e.fallback.inner != nil {
+		e.fallback.inner.memTracker.Detach()
+	}
+	e.fallback = nil
 	e.memTracker = nil
 	e.task = nil
 	e.finished.Store(false)
@@ -783,6 +940,9 @@ type indexLookUpJoinRuntimeStats struct {
 	concurrency int
 	probe       int64
 	innerWorker innerWorkerRuntimeStats
+
+	adaptiveThreshold int
+	fallback          bool
 }
 
 type innerWorkerRuntimeStats struct {
@@ -823,14 +983,30 @@ func (e *indexLookUpJoinRuntimeStats) String() string {
 		buf.WriteString(", probe:")
 		buf.WriteString(execdetails.FormatDuration(time.Duration(e.probe)))
 	}
+	if e.adaptiveThreshold > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("adaptive:{threshold:")
+		buf.WriteString(strconv.Itoa(e.adaptiveThreshold))
+		buf.WriteString(", fallback:")
+		if e.fallback {
+			buf.WriteString("hash_join")
+		} else {
+			buf.WriteString("none")
+		}
+		buf.WriteString("}")
+	}
 	return buf.String()
 }
 
 func (e *indexLookUpJoinRuntimeStats) Clone() execdetails.RuntimeStats {
 	return &indexLookUpJoinRuntimeStats{
-		concurrency: e.concurrency,
-		probe:       e.probe,
-		innerWorker: e.innerWorker,
+		concurrency:       e.concurrency,
+		probe:             e.probe,
+		innerWorker:       e.innerWorker,
+		adaptiveThreshold: e.adaptiveThreshold,
+		fallback:          e.fallback,
 	}
 }
 
@@ -846,6 +1022,7 @@ func (e *indexLookUpJoinRuntimeStats) Merge(rs execdetails.RuntimeStats) {
 	e.innerWorker.fetch += tmp.innerWorker.fetch
 	e.innerWorker.build += tmp.innerWorker.build
 	e.innerWorker.join += tmp.innerWorker.join
+	e.fallback = e.fallback || tmp.fallback
 }
 
 // Tp implements the RuntimeStats interface.
@@ -493,3 +493,78 @@ func TestPartitionTableIndexJoinAndIndexReader(t *testing.T) {
 		tk.MustQuery("select /*+ TIDB_INLJ(t1, t2) */ t1.a from t t1, t t2 where t1.a=t2.b and " + cond).Sort().Check(result)
 	}
 }
+
+func TestIndexLookupJoinAdaptiveFallback(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(id int primary key, a int, b int, key idx_a(a))")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8), (null, 9), (1, 10)")
+	tk.MustExec("insert into t2 values (1, 1, 10), (2, 2, 20), (3, 3, 30), (4, 4, 40), (5, 5, 50), (6, 1, 60), (7, null, 70)")
+	tk.Session().GetSessionVars().IndexJoinBatchSize = 2
+
+	queries := []string{
+		"select /*+ %s(t2) */ * from t1 join t2 on t1.a = t2.a",
+		"select /*+ %s(t2) */ * from t1 join t2 on t1.a = t2.a and t2.b > 20",
+		"select /*+ %s(t2) */ * from t1 left join t2 on t1.a = t2.a and t1.b < t2.b",
+		"select /*+ %s(t2) */ * from t1 left join t2 on t1.a = t2.id where t2.b is null or t2.b < 50",
+		"select /*+ %s(t2) */ t1.a, t2.id from t1 join t2 on t1.a = t2.a and t2.a in (1, 3, 5)",
+	}
+	check := func(runtimeInfo string) {
+		for _, query := range queries {
+			expected := tk.MustQuery(fmt.Sprintf(query, "HASH_JOIN")).Sort().Rows()
+			tk.MustQuery(fmt.Sprintf(query, "INL_JOIN")).Sort().Check(expected)
+			found := false
+			for _, row := range tk.MustQuery("explain analyze " + fmt.Sprintf(query, "INL_JOIN")).Rows() {
+				if strings.Contains(row[0].(string), "IndexJoin") {
+					require.Contains(t, row[5].(string), runtimeInfo, query)
+					found = true
+				}
+			}
+			require.True(t, found, query)
+		}
+	}
+
+	tk.MustExec("set @@tidb_index_join_adaptive_threshold = 3")
+	check("adaptive:{threshold:3, fallback:hash_join}")
+	tk.MustExec("set @@tidb_index_join_adaptive_threshold = 100")
+	check("adaptive:{threshold:100, fallback:none}")
+
+	// The full scan of the inner side should see the uncommitted rows too.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t2 values (8, 8, 80), (9, 1, 90)")
+	tk.MustExec("delete from t2 where id = 2")
+	tk.MustExec("set @@tidb_index_join_adaptive_threshold = 3")
+	check("adaptive:{threshold:3, fallback:hash_join}")
+	tk.MustExec("rollback")
+
+	// The fallback isn't used when the inner side is estimated not to fit in the memory quota.
+	tk.MustExec("set @@tidb_mem_quota_query = 5000")
+	check("adaptive:{threshold:3, fallback:none}")
+	tk.MustExec("analyze table t1, t2")
+	tk.MustExec("set @@tidb_mem_quota_query = 100000")
+	check("adaptive:{threshold:3, fallback:hash_join}")
+	// Or when the inner side turns out not to fit in the memory quota while it's being read.
+	tk.MustExec("set @@tidb_mem_quota_query = 5000")
+	check("adaptive:{threshold:3, fallback:none}")
+	tk.MustExec("set @@tidb_mem_quota_query = default")
+
+	tk.MustExec("set @@tidb_index_join_adaptive_threshold = 0")
+	rows := tk.MustQuery("explain analyze " + fmt.Sprintf(queries[0], "INL_JOIN")).Rows()
+	require.NotContains(t, rows[0][5].(string), "adaptive")
+
+	// The cached plan without the fallback inner plan can't be used after the fallback is enabled.
+	tk.MustExec("prepare stmt from 'select /*+ INL_JOIN(t2) */ * from t1 join t2 on t1.a = t2.a'")
+	tk.MustExec("execute stmt")
+	tk.MustExec("execute stmt")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustExec("set @@tidb_index_join_adaptive_threshold = 3")
+	tk.MustExec("execute stmt")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	// The plan only depends on whether the fallback is enabled.
+	tk.MustExec("set @@tidb_index_join_adaptive_threshold = 5")
+	tk.MustExec("execute stmt")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+}
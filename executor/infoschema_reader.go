@@ -42,6 +42,7 @@ import (
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/meta/autoid"
+	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/charset"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
@@ -131,7 +132,13 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 		case infoschema.TableTiDBIndexes:
 			e.setDataFromIndexes(sctx, dbs)
 		case infoschema.TableViews:
-			e.setDataFromViews(sctx, dbs)
+			e.setDataFromViews(sctx, is, dbs)
+		case infoschema.TableTriggers:
+			e.setDataFromTriggers(sctx, dbs)
+		case infoschema.TableRoutines:
+			e.setDataFromRoutines(sctx, dbs)
+		case infoschema.TableEvents:
+			err = e.setDataFromEvents(ctx, sctx, dbs)
 		case infoschema.TableEngines:
 			e.setDataFromEngines()
 		case infoschema.TableCharacterSets:
@@ -194,6 +201,8 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataFromResourceGroups()
 		case infoschema.TableRunawayWatches:
 			err = e.setDataFromRunawayWatches(sctx)
+		case infoschema.TableCardinalityFeedback:
+			e.setDataForCardinalityFeedback(sctx)
 		}
 		if err != nil {
 			return nil, err
@@ -419,6 +428,12 @@ func (e *memtableRetriever) setDataForStatisticsInTable(schema *model.DBInfo, ta
 				expression = tblCol.GeneratedExprString
 			}
 
+			var collation interface{} = "A"
+			indexType := "BTREE"
+			if index.FullText {
+				collation, indexType = nil, "FULLTEXT"
+			}
+
 			record := types.MakeDatums(
 				infoschema.CatalogVal, // TABLE_CATALOG
 				schema.Name.O,         // TABLE_SCHEMA
@@ -428,12 +443,12 @@ func (e *memtableRetriever) setDataForStatisticsInTable(schema *model.DBInfo, ta
 				index.Name.O,          // INDEX_NAME
 				i+1,                   // SEQ_IN_INDEX
 				colName,               // COLUMN_NAME
-				"A",                   // COLLATION
+				collation,             // COLLATION
 				0,                     // CARDINALITY
 				nil,                   // SUB_PART
 				nil,                   // PACKED
 				nullable,              // NULLABLE
-				"BTREE",               // INDEX_TYPE
+				indexType,             // INDEX_TYPE
 				"",                    // COMMENT
 				index.Comment,         // INDEX_COMMENT
 				visible,               // IS_VISIBLE
@@ -869,6 +884,20 @@ func calcCharOctLength(lenInChar int, cs string) int {
 	return lenInBytes
 }
 
+// partitionColumnsExpr returns the partitioning columns as shown in PARTITION_EXPRESSION.
+func partitionColumnsExpr(cols []model.CIStr) string {
+	buf := bytes.NewBuffer(nil)
+	for i, col := range cols {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("`")
+		buf.WriteString(col.String())
+		buf.WriteString("`")
+	}
+	return buf.String()
+}
+
 func (e *memtableRetriever) setDataFromPartitions(ctx context.Context, sctx sessionctx.Context, schemas []*model.DBInfo) error {
 	cache := cache.TableRowStatsCache
 	err := cache.Update(ctx, sctx)
@@ -968,16 +997,18 @@ func (e *memtableRetriever) setDataFromPartitions(ctx context.Context, sctx sess
 						default:
 							return errors.Errorf("Inconsistent partition type, have type %v, but with COLUMNS > 0 (%d)", table.Partition.Type, len(table.Partition.Columns))
 						}
-						buf := bytes.NewBuffer(nil)
-						for i, col := range table.Partition.Columns {
-							if i > 0 {
-								buf.WriteString(",")
-							}
-							buf.WriteString("`")
-							buf.WriteString(col.String())
-							buf.WriteString("`")
+						partitionExpr = partitionColumnsExpr(table.Partition.Columns)
+					}
+
+					partitionName, partitionOrdinal := pi.Name.O, i+1
+					var subPartitionName, subPartitionOrdinal, subPartitionMethod, subPartitionExpr interface{}
+					if sub := table.Partition.Sub; sub != nil {
+						partitionName, partitionOrdinal = pi.ParentName.O, i/int(sub.Num)+1
+						subPartitionName, subPartitionOrdinal = pi.Name.O, i%int(sub.Num)+1
+						subPartitionMethod, subPartitionExpr = sub.Type.String(), sub.Expr
+						if len(sub.Columns) > 0 {
+							subPartitionExpr = partitionColumnsExpr(sub.Columns)
 						}
-						partitionExpr = buf.String()
 					}
 
 					var policyName interface{}
@@ -988,14 +1019,14 @@ func (e *memtableRetriever) setDataFromPartitions(ctx context.Context, sctx sess
 						infoschema.CatalogVal, // TABLE_CATALOG
 						schema.Name.O,         // TABLE_SCHEMA
 						table.Name.O,          // TABLE_NAME
-						pi.Name.O,             // PARTITION_NAME
-						nil,                   // SUBPARTITION_NAME
-						i+1,                   // PARTITION_ORDINAL_POSITION
-						nil,                   // SUBPARTITION_ORDINAL_POSITION
+						partitionName,         // PARTITION_NAME
+						subPartitionName,      // SUBPARTITION_NAME
+						partitionOrdinal,      // PARTITION_ORDINAL_POSITION
+						subPartitionOrdinal,   // SUBPARTITION_ORDINAL_POSITION
 						partitionMethod,       // PARTITION_METHOD
-						nil,                   // SUBPARTITION_METHOD
+						subPartitionMethod,    // SUBPARTITION_METHOD
 						partitionExpr,         // PARTITION_EXPRESSION
-						nil,                   // SUBPARTITION_EXPRESSION
+						subPartitionExpr,      // SUBPARTITION_EXPRESSION
 						partitionDesc,         // PARTITION_DESCRIPTION
 						rowCount,              // TABLE_ROWS
 						avgRowLength,          // AVG_ROW_LENGTH
@@ -1106,7 +1137,7 @@ func (e *memtableRetriever) setDataFromIndexes(ctx sessionctx.Context, schemas [
 	e.rows = rows
 }
 
-func (e *memtableRetriever) setDataFromViews(ctx sessionctx.Context, schemas []*model.DBInfo) {
+func (e *memtableRetriever) setDataFromViews(ctx sessionctx.Context, is infoschema.InfoSchema, schemas []*model.DBInfo) {
 	checker := privilege.GetPrivilegeManager(ctx)
 	var rows [][]types.Datum
 	for _, schema := range schemas {
@@ -1125,17 +1156,145 @@ func (e *memtableRetriever) setDataFromViews(ctx sessionctx.Context, schemas []*
 			if checker != nil && !checker.RequestVerification(ctx.GetSessionVars().ActiveRoles, schema.Name.L, table.Name.L, "", mysql.AllPrivMask) {
 				continue
 			}
+			updatable := "NO"
+			if plannercore.IsUpdatableView(ctx, is, schema.Name, table) {
+				updatable = "YES"
+			}
+			checkOption := table.View.GetCheckOption()
 			record := types.MakeDatums(
-				infoschema.CatalogVal,           // TABLE_CATALOG
-				schema.Name.O,                   // TABLE_SCHEMA
-				table.Name.O,                    // TABLE_NAME
-				table.View.SelectStmt,           // VIEW_DEFINITION
-				table.View.CheckOption.String(), // CHECK_OPTION
-				"NO",                            // IS_UPDATABLE
-				table.View.Definer.String(),     // DEFINER
-				table.View.Security.String(),    // SECURITY_TYPE
-				charset,                         // CHARACTER_SET_CLIENT
-				collation,                       // COLLATION_CONNECTION
+				infoschema.CatalogVal,        // TABLE_CATALOG
+				schema.Name.O,                // TABLE_SCHEMA
+				table.Name.O,                 // TABLE_NAME
+				table.View.SelectStmt,        // VIEW_DEFINITION
+				checkOption.String(),         // CHECK_OPTION
+				updatable,                    // IS_UPDATABLE
+				table.View.Definer.String(),  // DEFINER
+				table.View.Security.String(), // SECURITY_TYPE
+				charset,                      // CHARACTER_SET_CLIENT
+				collation,                    // COLLATION_CONNECTION
+			)
+			rows = append(rows, record)
+		}
+	}
+	e.rows = rows
+}
+
+func (e *memtableRetriever) setDataFromTriggers(ctx sessionctx.Context, schemas []*model.DBInfo) {
+	checker := privilege.GetPrivilegeManager(ctx)
+	loc := ctx.GetSessionVars().TimeZone
+	if loc == nil {
+		loc = time.Local
+	}
+	var rows [][]types.Datum
+	for _, schema := range schemas {
+		for _, table := range schema.Tables {
+			if len(table.Triggers) == 0 {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(ctx.GetSessionVars().ActiveRoles, schema.Name.L, table.Name.L, "", mysql.TriggerPriv) {
+				continue
+			}
+			// The ACTION_ORDER is the order of the trigger among the triggers with the same timing and event.
+			actionOrders := make(map[[2]int]int)
+			for _, trigger := range table.Triggers {
+				key := [2]int{int(trigger.Timing), int(trigger.Event)}
+				actionOrders[key]++
+				record := types.MakeDatums(
+					infoschema.CatalogVal,   // TRIGGER_CATALOG
+					schema.Name.O,           // TRIGGER_SCHEMA
+					trigger.Name.O,          // TRIGGER_NAME
+					trigger.Event.String(),  // EVENT_MANIPULATION
+					infoschema.CatalogVal,   // EVENT_OBJECT_CATALOG
+					schema.Name.O,           // EVENT_OBJECT_SCHEMA
+					table.Name.O,            // EVENT_OBJECT_TABLE
+					actionOrders[key],       // ACTION_ORDER
+					nil,                     // ACTION_CONDITION
+					trigger.Body,            // ACTION_STATEMENT
+					"ROW",                   // ACTION_ORIENTATION
+					trigger.Timing.String(), // ACTION_TIMING
+					nil,                     // ACTION_REFERENCE_OLD_TABLE
+					nil,                     // ACTION_REFERENCE_NEW_TABLE
+					"OLD",                   // ACTION_REFERENCE_OLD_ROW
+					"NEW",                   // ACTION_REFERENCE_NEW_ROW
+					types.NewTime(types.FromGoTime(trigger.Created.In(loc)), mysql.TypeDatetime, 2), // CREATED
+					sqlModeString(trigger.SQLMode), // SQL_MODE
+					trigger.Definer.String(),       // DEFINER
+					trigger.Charset,                // CHARACTER_SET_CLIENT
+					trigger.Collate,                // COLLATION_CONNECTION
+					schema.Collate,                 // DATABASE_COLLATION
+				)
+				rows = append(rows, record)
+			}
+		}
+	}
+	e.rows = rows
+}
+
+func (e *memtableRetriever) setDataFromRoutines(ctx sessionctx.Context, schemas []*model.DBInfo) {
+	checker := privilege.GetPrivilegeManager(ctx)
+	loc := ctx.GetSessionVars().TimeZone
+	if loc == nil {
+		loc = time.Local
+	}
+	var rows [][]types.Datum
+	for _, schema := range schemas {
+		if checker != nil && !checker.DBIsVisible(ctx.GetSessionVars().ActiveRoles, schema.Name.O) {
+			continue
+		}
+		for _, routine := range schema.Routines {
+			var dataType, dtd, charset, collation, definition interface{}
+			dataType = ""
+			if tp := routine.ReturnType; tp != nil {
+				dataType = types.TypeToStr(tp.GetType(), tp.GetCharset())
+				dtd = tp.CompactStr()
+				if types.IsNonBinaryStr(tp) {
+					charset, collation = tp.GetCharset(), tp.GetCollate()
+				}
+			}
+			if isRoutineBodyVisible(ctx, schema.Name, routine) {
+				definition = routine.Body
+			}
+			isDeterministic := "NO"
+			if routine.Deterministic {
+				isDeterministic = "YES"
+			}
+			sqlDataAccess := routine.SQLDataAccess
+			if sqlDataAccess == "" {
+				sqlDataAccess = ast.ContainsSQL
+			}
+			created := types.NewTime(types.FromGoTime(routine.Created.In(loc)), mysql.TypeDatetime, 0)
+			record := types.MakeDatums(
+				routine.Name.O,                 // SPECIFIC_NAME
+				infoschema.CatalogVal,          // ROUTINE_CATALOG
+				schema.Name.O,                  // ROUTINE_SCHEMA
+				routine.Name.O,                 // ROUTINE_NAME
+				routine.Type.String(),          // ROUTINE_TYPE
+				dataType,                       // DATA_TYPE
+				nil,                            // CHARACTER_MAXIMUM_LENGTH
+				nil,                            // CHARACTER_OCTET_LENGTH
+				nil,                            // NUMERIC_PRECISION
+				nil,                            // NUMERIC_SCALE
+				nil,                            // DATETIME_PRECISION
+				charset,                        // CHARACTER_SET_NAME
+				collation,                      // COLLATION_NAME
+				dtd,                            // DTD_IDENTIFIER
+				"SQL",                          // ROUTINE_BODY
+				definition,                     // ROUTINE_DEFINITION
+				nil,                            // EXTERNAL_NAME
+				"SQL",                          // EXTERNAL_LANGUAGE
+				"SQL",                          // PARAMETER_STYLE
+				isDeterministic,                // IS_DETERMINISTIC
+				sqlDataAccess,                  // SQL_DATA_ACCESS
+				nil,                            // SQL_PATH
+				"DEFINER",                      // SECURITY_TYPE
+				created,                        // CREATED
+				created,                        // LAST_ALTERED
+				sqlModeString(routine.SQLMode), // SQL_MODE
+				routine.Comment,                // ROUTINE_COMMENT
+				routine.Definer.String(),       // DEFINER
+				routine.Charset,                // CHARACTER_SET_CLIENT
+				routine.Collate,                // COLLATION_CONNECTION
+				schema.Collate,                 // DATABASE_COLLATION
 			)
 			rows = append(rows, record)
 		}
@@ -1143,6 +1302,80 @@ func (e *memtableRetriever) setDataFromViews(ctx sessionctx.Context, schemas []*
 	e.rows = rows
 }
 
+func (e *memtableRetriever) setDataFromEvents(ctx context.Context, sctx sessionctx.Context, schemas []*model.DBInfo) error {
+	m := domain.GetDomain(sctx).EventManager()
+	if m == nil {
+		return nil
+	}
+	events, err := m.ListEvents(ctx)
+	if err != nil {
+		return err
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	loc := sctx.GetSessionVars().TimeZone
+	if loc == nil {
+		loc = time.Local
+	}
+	dbs := make(map[string]*model.DBInfo, len(schemas))
+	for _, schema := range schemas {
+		dbs[schema.Name.L] = schema
+	}
+	var rows [][]types.Datum
+	for _, ev := range events {
+		schema, ok := dbs[ev.Schema.L]
+		if !ok {
+			continue
+		}
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schema.Name.L, "", "", mysql.EventPriv) {
+			continue
+		}
+		eventType, onCompletion := "RECURRING", "NOT PRESERVE"
+		if !ev.IsRecurring() {
+			eventType = "ONE TIME"
+		}
+		if ev.Preserve {
+			onCompletion = "PRESERVE"
+		}
+		record := types.MakeDatums(
+			infoschema.CatalogVal,           // EVENT_CATALOG
+			schema.Name.O,                   // EVENT_SCHEMA
+			ev.Name.O,                       // EVENT_NAME
+			ev.Definer.String(),             // DEFINER
+			ev.TimeZone,                     // TIME_ZONE
+			"SQL",                           // EVENT_BODY
+			ev.Body,                         // EVENT_DEFINITION
+			eventType,                       // EVENT_TYPE
+			nil,                             // EXECUTE_AT
+			nil,                             // INTERVAL_VALUE
+			nil,                             // INTERVAL_FIELD
+			sqlModeString(ev.SQLMode),       // SQL_MODE
+			nil,                             // STARTS
+			nil,                             // ENDS
+			ev.Status.String(),              // STATUS
+			onCompletion,                    // ON_COMPLETION
+			eventTime(ev.Created, loc),      // CREATED
+			eventTime(ev.LastAltered, loc),  // LAST_ALTERED
+			eventTime(ev.LastExecuted, loc), // LAST_EXECUTED
+			ev.Comment,                      // EVENT_COMMENT
+			0,                               // ORIGINATOR
+			ev.Charset,                      // CHARACTER_SET_CLIENT
+			ev.Collate,                      // COLLATION_CONNECTION
+			schema.Collate,                  // DATABASE_COLLATION
+		)
+		if ev.IsRecurring() {
+			record[9].SetString(ev.IntervalValue, mysql.DefaultCollationName)
+			record[10].SetString(ev.IntervalField, mysql.DefaultCollationName)
+			record[12] = types.NewDatum(eventTime(ev.Starts, loc))
+			record[13] = types.NewDatum(eventTime(ev.Ends, loc))
+		} else {
+			record[8] = types.NewDatum(eventTime(ev.ExecuteAt, loc))
+		}
+		rows = append(rows, record)
+	}
+	e.rows = rows
+	return nil
+}
+
 func (e *memtableRetriever) dataForTiKVStoreStatus(ctx sessionctx.Context) (err error) {
 	tikvStore, ok := ctx.GetStore().(helper.Storage)
 	if !ok {
@@ -3279,6 +3512,30 @@ const (
 	unlimitedFillRate = "UNLIMITED"
 )
 
+func (e *memtableRetriever) setDataForCardinalityFeedback(sctx sessionctx.Context) {
+	loc := sctx.GetSessionVars().Location()
+	records := statistics.CardinalityFeedback.Records()
+	rows := make([][]types.Datum, 0, len(records))
+	for _, record := range records {
+		if record.Item == nil {
+			rows = append(rows, types.MakeDatums(record.Digest, nil, nil, nil, nil, nil, nil))
+			continue
+		}
+		item := record.Item
+		updateTime := types.NewTime(types.FromGoTime(item.UpdateTime.In(loc)), mysql.TypeDatetime, types.DefaultFsp)
+		rows = append(rows, types.MakeDatums(
+			record.Digest,
+			item.DataSource,
+			item.Operator,
+			item.EstRows,
+			item.ActRows,
+			item.Correction(),
+			updateTime,
+		))
+	}
+	e.rows = rows
+}
+
 func (e *memtableRetriever) setDataFromResourceGroups() error {
 	resourceGroups, err := infosync.ListResourceGroups(context.TODO())
 	if err != nil {
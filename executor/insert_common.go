@@ -96,6 +96,10 @@ type InsertValues struct {
 	// fkChecks contains the foreign key checkers.
 	fkChecks   []*FKCheckExec
 	fkCascades []*FKCascadeExec
+	// triggers contains the triggers of the table, it's nil if the table has no trigger.
+	triggers *TriggerExec
+	// viewChecks contains the check options of the view inserted into.
+	viewChecks []*core.ViewCheck
 }
 
 type defaultVal struct {
@@ -690,6 +694,20 @@ func (e *InsertValues) fillRow(ctx context.Context, row []types.Datum, hasValue
 			}
 		}
 	}
+	if e.triggers.hasTriggers(model.TriggerTimingBefore, model.TriggerEventInsert) {
+		if err := e.triggers.fire(ctx, model.TriggerTimingBefore, model.TriggerEventInsert, nil, row); err != nil {
+			return nil, err
+		}
+		// The triggers may set the NOT NULL columns to NULL by `SET NEW.col = NULL`.
+		for i, c := range tCols {
+			if c.IsGenerated() || (e.lazyFillAutoID && mysql.HasAutoIncrementFlag(c.GetFlag())) {
+				continue
+			}
+			if err := c.HandleBadNull(&row[i], e.Ctx().GetSessionVars().StmtCtx, rowCntInLoadData); err != nil {
+				return nil, err
+			}
+		}
+	}
 	tbl := e.Table.Meta()
 	// Handle exchange partition
 	if tbl.ExchangePartitionInfo != nil && tbl.ExchangePartitionInfo.ExchangePartitionFlag {
@@ -1302,7 +1320,7 @@ CheckAndInsert:
 		err = addRecord(ctx, rows[i])
 		if err != nil {
 			// throw warning when violate check constraint
-			if table.ErrCheckConstraintViolated.Equal(err) {
+			if table.ErrCheckConstraintViolated.Equal(err) || exeerrors.ErrViewCheckFailed.Equal(err) {
 				if !sc.InLoadDataStmt {
 					sc.AppendWarning(err)
 				}
@@ -1359,6 +1377,10 @@ func (e *InsertValues) removeRow(
 		return true, nil
 	}
 
+	err = e.triggers.fire(ctx, model.TriggerTimingBefore, model.TriggerEventDelete, oldRow, nil)
+	if err != nil {
+		return false, err
+	}
 	err = r.t.RemoveRecord(e.Ctx(), handle, oldRow)
 	if err != nil {
 		return false, err
@@ -1367,6 +1389,10 @@ func (e *InsertValues) removeRow(
 	if err != nil {
 		return false, err
 	}
+	err = e.triggers.fire(ctx, model.TriggerTimingAfter, model.TriggerEventDelete, oldRow, nil)
+	if err != nil {
+		return false, err
+	}
 	if inReplace {
 		e.Ctx().GetSessionVars().StmtCtx.AddAffectedRows(1)
 	} else {
@@ -1401,6 +1427,9 @@ func (e *InsertValues) addRecordWithAutoIDHint(
 	ctx context.Context, row []types.Datum, reserveAutoIDCount int,
 ) (err error) {
 	vars := e.Ctx().GetSessionVars()
+	if err = checkViewOptions(e.Ctx(), e.viewChecks, row); err != nil {
+		return err
+	}
 	if !vars.ConstraintCheckInPlace {
 		vars.PresumeKeyNotExists = true
 	}
@@ -1425,7 +1454,7 @@ func (e *InsertValues) addRecordWithAutoIDHint(
 			}
 		}
 	}
-	return nil
+	return e.triggers.fire(ctx, model.TriggerTimingAfter, model.TriggerEventInsert, nil, row)
 }
 
 // CreateSession will be assigned by session package.
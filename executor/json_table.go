@@ -0,0 +1,181 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
+	plannercore "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+// JSONTableExec is the executor of the JSON_TABLE table function.
+// The JSON document is evaluated when the executor is opened, so a
+// correlated document is re-evaluated each time the outer row of an
+// Apply changes.
+type JSONTableExec struct {
+	exec.BaseExecutor
+
+	expr expression.Expression
+	root *plannercore.JSONTablePath
+	// sc is used to convert the extracted values to the column types. Unlike the
+	// statement context, it reports every truncation as an error, so the ON ERROR
+	// clause can handle it.
+	sc *stmtctx.StatementContext
+
+	// row is the row being filled, the columns which are not filled are NULL.
+	row    []types.Datum
+	rows   [][]types.Datum
+	cursor int
+}
+
+// Open implements the Executor Open interface.
+func (e *JSONTableExec) Open(context.Context) error {
+	e.rows = e.rows[:0]
+	e.cursor = 0
+	e.sc = &stmtctx.StatementContext{TimeZone: e.Ctx().GetSessionVars().Location()}
+	doc, isNull, err := e.expr.EvalJSON(e.Ctx(), chunk.Row{})
+	if err != nil || isNull {
+		return err
+	}
+	e.row = make([]types.Datum, e.Schema().Len())
+	_, err = e.appendRows(e.root, doc)
+	return err
+}
+
+// Next implements the Executor Next interface.
+func (e *JSONTableExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	for ; e.cursor < len(e.rows) && !req.IsFull(); e.cursor++ {
+		for i := range e.rows[e.cursor] {
+			req.AppendDatum(i, &e.rows[e.cursor][i])
+		}
+	}
+	return nil
+}
+
+// Close implements the Executor Close interface.
+func (e *JSONTableExec) Close() error {
+	e.rows = nil
+	return e.BaseExecutor.Close()
+}
+
+// appendRows appends a row for each value path matches in doc, joined with
+// the rows of its nested paths. Sibling nested paths are unioned, a value
+// gets a single row with NULL nested columns if none of them matches.
+func (e *JSONTableExec) appendRows(path *plannercore.JSONTablePath, doc types.BinaryJSON) (matched bool, err error) {
+	values := doc.ExtractAll(path.Path)
+	for i, value := range values {
+		for _, col := range path.Columns {
+			if e.row[col.Offset], err = e.evalColumn(col, value, i+1); err != nil {
+				return false, err
+			}
+		}
+		nestedMatched := false
+		for _, nested := range path.Nested {
+			m, err := e.appendRows(nested, value)
+			if err != nil {
+				return false, err
+			}
+			nestedMatched = nestedMatched || m
+		}
+		if !nestedMatched {
+			e.rows = append(e.rows, types.CloneRow(e.row))
+		}
+	}
+	e.resetColumns(path)
+	return len(values) > 0, nil
+}
+
+// resetColumns sets the columns of path and its nested paths to NULL.
+func (e *JSONTableExec) resetColumns(path *plannercore.JSONTablePath) {
+	for _, col := range path.Columns {
+		e.row[col.Offset].SetNull()
+	}
+	for _, nested := range path.Nested {
+		e.resetColumns(nested)
+	}
+}
+
+func (e *JSONTableExec) evalColumn(col *plannercore.JSONTableColumn, value types.BinaryJSON, ordinality int) (types.Datum, error) {
+	tp := e.Schema().Columns[col.Offset].RetType
+	switch col.Tp {
+	case ast.JSONTableColumnOrdinality:
+		return types.NewUintDatum(uint64(ordinality)), nil
+	case ast.JSONTableColumnExistsPath:
+		exists := int64(0)
+		if len(value.ExtractAll(col.Path)) > 0 {
+			exists = 1
+		}
+		d := types.NewIntDatum(exists)
+		return d.ConvertTo(e.sc, tp)
+	}
+	res, found := value.Extract([]types.JSONPathExpression{col.Path})
+	if !found {
+		return e.onResponse(col, col.OnEmpty, exeerrors.ErrMissingJSONTableValue.GenWithStackByArgs(col.Name))
+	}
+	d, err := e.convertJSON(col, res, tp)
+	if err != nil {
+		return e.onResponse(col, col.OnError, err)
+	}
+	return d, nil
+}
+
+// onResponse handles the ON EMPTY or ON ERROR clause, err is returned for ERROR ON EMPTY and ERROR ON ERROR.
+func (e *JSONTableExec) onResponse(col *plannercore.JSONTableColumn, resp *plannercore.JSONTableOnResponse, err error) (types.Datum, error) {
+	if resp == nil || resp.Tp == ast.JSONTableOnResponseNull {
+		return types.Datum{}, nil
+	}
+	if resp.Tp == ast.JSONTableOnResponseError {
+		return types.Datum{}, err
+	}
+	return e.convertJSON(col, resp.Default, e.Schema().Columns[col.Offset].RetType)
+}
+
+func (e *JSONTableExec) convertJSON(col *plannercore.JSONTableColumn, value types.BinaryJSON, tp *types.FieldType) (types.Datum, error) {
+	if tp.GetType() == mysql.TypeJSON {
+		return types.NewJSONDatum(value), nil
+	}
+	var d types.Datum
+	switch value.TypeCode {
+	case types.JSONTypeCodeObject, types.JSONTypeCodeArray:
+		return d, exeerrors.ErrWrongJSONTableValue.GenWithStackByArgs(col.Name)
+	case types.JSONTypeCodeLiteral:
+		if value.Value[0] == types.JSONLiteralNil {
+			return d, nil
+		}
+	}
+	if tp.EvalType() == types.ETString {
+		s, err := value.Unquote()
+		if err != nil {
+			return d, err
+		}
+		d.SetString(s, tp.GetCollate())
+	} else {
+		d.SetMysqlJSON(value)
+	}
+	d, err := d.ConvertTo(e.sc, tp)
+	if types.ErrOverflow.Equal(err) {
+		err = exeerrors.ErrJTValueOutOfRange.GenWithStackByArgs(col.Name)
+	}
+	return d, err
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+)
+
+func TestJSONTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustQuery(`select * from json_table('[{"a":1,"b":"x"},{"a":2},{"a":"3","b":[1]}]', '$[*]' columns (
+		id for ordinality, a int path '$.a', b varchar(10) path '$.b', j json path '$.b', e int exists path '$.b')) as jt`).
+		Check(testkit.Rows("1 1 x \"x\" 1", "2 2 <nil> <nil> 0", "3 3 <nil> [1] 1"))
+	tk.MustQuery(`select * from json_table('{"a":[1,2]}', '$.b[*]' columns (a int path '$')) jt`).Check(testkit.Rows())
+	tk.MustQuery(`select * from json_table(null, '$' columns (a int path '$')) jt`).Check(testkit.Rows())
+	tk.MustQuery(`select * from json_table('[true, false, null, 1.5, "2020-01-01"]', '$[*]' columns (
+		i int path '$', s varchar(20) path '$', d date path '$')) jt`).
+		Check(testkit.Rows("1 true <nil>", "0 false <nil>", "<nil> <nil> <nil>", "2 1.5 <nil>", "<nil> 2020-01-01 2020-01-01"))
+
+	// ON EMPTY and ON ERROR.
+	tk.MustQuery(`select * from json_table('[{"a":"x"},{}]', '$[*]' columns (
+		a int path '$.a' default '7' on empty default '8' on error,
+		b varchar(10) path '$.a' default '"none"' on empty)) jt`).Check(testkit.Rows("8 x", "7 none"))
+	tk.MustQuery(`select * from json_table('[{"a":[1]},{"a":300}]', '$[*]' columns (a tinyint path '$.a' null on error)) jt`).
+		Check(testkit.Rows("<nil>", "<nil>"))
+	tk.MustGetErrCode(`select * from json_table('[{}]', '$[*]' columns (a int path '$.a' error on empty)) jt`, errno.ErrMissingJSONTableValue)
+	tk.MustGetErrCode(`select * from json_table('[{"a":[1]}]', '$[*]' columns (a int path '$.a' error on error)) jt`, errno.ErrWrongJSONTableValue)
+	tk.MustGetErrCode(`select * from json_table('[{"a":300}]', '$[*]' columns (a tinyint path '$.a' error on error)) jt`, errno.ErrJTValueOutOfRange)
+
+	// NESTED PATH, sibling nested paths are unioned.
+	doc := `'[{"a":1,"b":[10,20],"c":[{"d":[1,2]},{"d":[]}]},{"a":2,"b":[],"c":[]}]'`
+	tk.MustQuery(`select * from json_table(` + doc + `, '$[*]' columns (
+		id for ordinality, a int path '$.a',
+		nested path '$.b[*]' columns (bid for ordinality, b int path '$'),
+		nested path '$.c[*]' columns (cid for ordinality, nested path '$.d[*]' columns (d int path '$')))) jt`).
+		Check(testkit.Rows(
+			"1 1 1 10 <nil> <nil>",
+			"1 1 2 20 <nil> <nil>",
+			"1 1 <nil> <nil> 1 1",
+			"1 1 <nil> <nil> 1 2",
+			"1 1 <nil> <nil> 2 <nil>",
+			"2 2 <nil> <nil> <nil> <nil>"))
+
+	// JSON_TABLE can refer to the preceding tables.
+	tk.MustExec("create table t (id int, j json)")
+	tk.MustExec(`insert into t values (1, '[1,2]'), (2, '[3]'), (3, '[]'), (4, null)`)
+	tk.MustQuery(`select t.id, jt.v from t, json_table(t.j, '$[*]' columns (v int path '$')) jt order by t.id, jt.v`).
+		Check(testkit.Rows("1 1", "1 2", "2 3"))
+	tk.MustQuery(`select t.id, jt.v from t left join json_table(t.j, '$[*]' columns (v int path '$')) jt on true order by t.id, jt.v`).
+		Check(testkit.Rows("1 1", "1 2", "2 3", "3 <nil>", "4 <nil>"))
+	tk.MustQuery(`select t.id, sum(jt.v) from t join json_table(t.j, '$[*]' columns (v int path '$')) jt on jt.v > 1 group by t.id order by t.id`).
+		Check(testkit.Rows("1 2", "2 3"))
+	tk.MustExec("set tidb_enable_parallel_apply = 1")
+	tk.MustQuery(`select t.id, jt.v from t left join json_table(t.j, '$[*]' columns (v int path '$')) jt on true order by t.id, jt.v`).
+		Check(testkit.Rows("1 1", "1 2", "2 3", "3 <nil>", "4 <nil>"))
+	tk.MustExec("set tidb_enable_parallel_apply = default")
+	tk.MustQuery(`select t.id, (select count(*) from json_table(t.j, '$[*]' columns (v int path '$')) jt) from t order by t.id`).
+		Check(testkit.Rows("1 2", "2 1", "3 0", "4 0"))
+	tk.MustQuery(`select jt.* from json_table('[{"x":1}]', '$[*]' columns (x int path '$.x')) jt, t where t.id = jt.x`).
+		Check(testkit.Rows("1"))
+
+	tk.MustGetErrCode(`select * from json_table('[]', '$[*]' columns (a int path '$'))`, errno.ErrTableFunctionMustHaveAlias)
+	tk.MustGetErrCode(`select * from json_table('[]', '$[*]' columns (a int path '$', a int path '$')) jt`, errno.ErrDupFieldName)
+	tk.MustGetErrCode(`select * from json_table('[]', '$[' columns (a int path '$')) jt`, errno.ErrInvalidJSONPath)
+	tk.MustGetErrCode(`select * from json_table('[]', '$' columns (a int path '$' default 'x' on empty)) jt`, errno.ErrInvalidJSONText)
+	tk.MustGetErrCode(`select * from json_table('{', '$' columns (a int path '$')) jt`, errno.ErrInvalidJSONText)
+	tk.MustGetErrCode(`select * from json_table(t.j, '$' columns (a int path '$')) jt, t`, errno.ErrBadField)
+}
@@ -635,3 +635,27 @@ func TestIssue24930(t *testing.T) {
     then (select t2.a from t2 where t2.a = t1.a limit 1) else t1.a end a
 	from t1 where t1.a=1 order by a limit 1`).Check(testkit.Rows()) // can return an empty result instead of hanging forever
 }
+
+func TestParallelApplyWithLateral(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int, key(a))")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (3, 3), (null, null)")
+	tk.MustExec("insert into t2 values (1, 10), (1, 11), (1, 12), (1, 13), (2, 20), (2, 21), (null, 0)")
+
+	q1 := "select t1.a, dt.b from t1, lateral (select b from t2 where t2.a = t1.a order by b limit 2) dt"
+	q2 := "select t1.a, dt.b from t1 left join lateral (select b from t2 where t2.a = t1.a order by b desc limit 1) dt on true"
+	q3 := "select t1.a, dt.c from t1 join lateral (select count(*) c from t2 where t2.a <= t1.a) dt on dt.c > 0"
+	for _, parallel := range []int{0, 1} {
+		tk.MustExec(fmt.Sprintf("set tidb_enable_parallel_apply = %v", parallel > 0))
+		checkApplyPlan(t, tk, q1, parallel)
+		tk.MustQuery(q1).Sort().Check(testkit.Rows("1 10", "1 11", "2 20", "2 21"))
+		checkApplyPlan(t, tk, q2, parallel)
+		tk.MustQuery(q2).Sort().Check(testkit.Rows("1 13", "2 21", "3 <nil>", "<nil> <nil>"))
+		checkApplyPlan(t, tk, q3, parallel)
+		tk.MustQuery(q3).Sort().Check(testkit.Rows("1 4", "2 6", "3 6"))
+	}
+}
@@ -68,6 +68,8 @@ type PipelinedWindowExec struct {
 	orderByCols    []*expression.Column
 	// expectedCmpResult is used to decide if one value is included in the frame.
 	expectedCmpResult int64
+	// peerGroups is not nil for the GROUPS frames.
+	peerGroups *peerGroups
 
 	// rows keeps rows starting from curStartRow
 	rows                     []chunk.Row
@@ -281,6 +283,12 @@ func (e *PipelinedWindowExec) getStart(ctx sessionctx.Context) (uint64, error) {
 		e.stagedStartRow = start
 		return start, nil
 	}
+	if e.peerGroups != nil {
+		if err := e.locatePeerGroup(ctx); err != nil {
+			return 0, err
+		}
+		return e.peerGroups.getStartOffset(e.start, e.rowCnt), nil
+	}
 	switch e.start.Type {
 	case ast.Preceding:
 		if e.curRowIdx > e.start.Num {
@@ -321,6 +329,12 @@ func (e *PipelinedWindowExec) getEnd(ctx sessionctx.Context) (uint64, error) {
 		e.stagedEndRow = end
 		return end, nil
 	}
+	if e.peerGroups != nil {
+		if err := e.locatePeerGroup(ctx); err != nil {
+			return 0, err
+		}
+		return e.peerGroups.getEndOffset(e.end, e.rowCnt), nil
+	}
 	switch e.end.Type {
 	case ast.Preceding:
 		if e.curRowIdx >= e.end.Num {
@@ -334,6 +348,16 @@ func (e *PipelinedWindowExec) getEnd(ctx sessionctx.Context) (uint64, error) {
 	}
 }
 
+// locatePeerGroup splits the consumed rows into peer groups and locates the
+// peer group of the current row.
+func (e *PipelinedWindowExec) locatePeerGroup(ctx sessionctx.Context) error {
+	if err := e.peerGroups.update(ctx, e.getRow, e.rowCnt); err != nil {
+		return err
+	}
+	e.peerGroups.locate(e.curRowIdx)
+	return nil
+}
+
 // produce produces rows and append it to chk, return produced means number of rows appended into chunk, available means
 // number of rows processed but not fetched
 func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk, remained uint64) (produced uint64, err error) {
@@ -415,6 +439,11 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 		remained--
 	}
 	extend := mathutil.Min(e.curRowIdx, e.lastEndRow, e.lastStartRow)
+	if e.peerGroups != nil && e.peerGroups.numRows > 0 {
+		// Keep the last row split into peer groups, it's compared with the
+		// following rows to find the next peer group.
+		extend = mathutil.Min(extend, e.peerGroups.numRows-1)
+	}
 	if extend > e.rowStart {
 		numDrop := extend - e.rowStart
 		e.dropped += numDrop
@@ -457,6 +486,9 @@ func (e *PipelinedWindowExec) reset() {
 	e.rowStart = 0
 	e.rowCnt = 0
 	e.initializedSlidingWindow = false
+	if e.peerGroups != nil {
+		e.peerGroups.reset()
+	}
 	for i, windowFunc := range e.windowFuncs {
 		windowFunc.ResetPartialResult(e.partialResults[i])
 	}
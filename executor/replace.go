@@ -229,3 +229,8 @@ func (e *ReplaceExec) GetFKCascades() []*FKCascadeExec {
 func (e *ReplaceExec) HasFKCascades() bool {
 	return len(e.fkCascades) > 0
 }
+
+// HasTriggers implements WithTrigger interface.
+func (e *ReplaceExec) HasTriggers() bool {
+	return e.triggers != nil
+}
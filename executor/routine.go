@@ -0,0 +1,952 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	plannercore "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/privilege"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessiontxn"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+func init() {
+	expression.EvalStoredFunction = evalStoredFunction
+	expression.GetStoredFunction = getStoredFunction
+}
+
+// CallProcedure executes the stored procedure of the CALL statement. The statements of the procedure body are
+// executed one by one through the session, the OUT and INOUT parameters are written back to the user variables.
+func CallProcedure(ctx context.Context, sctx sessionctx.Context, call *ast.CallStmt) error {
+	sessVars := sctx.GetSessionVars()
+	charset, collation := sessVars.GetCharsetInfo()
+	caller := &routineInterpreter{sctx: sctx, sqlMode: sessVars.SQLMode, charset: charset, collate: collation}
+	return caller.execCall(ctx, call)
+}
+
+// evalStoredFunction evaluates the stored function with the arguments, it's called by the stored function
+// expressions when they are evaluated.
+func evalStoredFunction(sctx sessionctx.Context, db model.CIStr, routine *model.RoutineInfo, args []types.Datum) (types.Datum, error) {
+	r := newRoutineInterpreter(sctx, db, routine)
+	_, err := r.run(context.Background(), args)
+	if err != nil {
+		return types.Datum{}, err
+	}
+	return r.ret, nil
+}
+
+// getStoredFunction gets the stored function from the info schema of the transaction.
+func getStoredFunction(sctx sessionctx.Context, dbName, name model.CIStr) (model.CIStr, *model.RoutineInfo) {
+	is := sessiontxn.GetTxnManager(sctx).GetTxnInfoSchema()
+	if is == nil {
+		return dbName, nil
+	}
+	db, ok := is.SchemaByName(dbName)
+	if !ok {
+		return dbName, nil
+	}
+	return db.Name, db.FindRoutine(name, model.RoutineTypeFunction)
+}
+
+// routineVar is a parameter or a local variable of the stored routine.
+type routineVar struct {
+	tp  *types.FieldType
+	val types.Datum
+}
+
+func (v *routineVar) set(sctx sessionctx.Context, d types.Datum) error {
+	casted, err := d.ConvertTo(sctx.GetSessionVars().StmtCtx, v.tp)
+	if err != nil {
+		return err
+	}
+	casted.Copy(&v.val)
+	return nil
+}
+
+// routineCursor is a cursor of the stored procedure, the rows of the query are fetched when it's opened.
+type routineCursor struct {
+	query ast.StmtNode
+	rows  [][]types.Datum
+	pos   int
+	open  bool
+}
+
+// routineScope holds the variables, cursors and handlers declared in a BEGIN ... END block.
+type routineScope struct {
+	vars     map[string]*routineVar
+	cursors  map[string]*routineCursor
+	handlers []*ast.ProcedureErrorControl
+}
+
+func newRoutineScope() *routineScope {
+	return &routineScope{vars: make(map[string]*routineVar), cursors: make(map[string]*routineCursor)}
+}
+
+// routineJump is returned by LEAVE and ITERATE to unwind the statements to the labeled block or loop.
+type routineJump struct {
+	label string
+	leave bool
+}
+
+func (j *routineJump) Error() string {
+	if j.leave {
+		return "LEAVE " + j.label
+	}
+	return "ITERATE " + j.label
+}
+
+// routineReturn is returned by RETURN to unwind the statements of the stored function.
+type routineReturn struct{}
+
+func (*routineReturn) Error() string {
+	return "RETURN"
+}
+
+// routineExit is returned after an EXIT handler is executed to leave the block declaring the handler.
+type routineExit struct {
+	scope *routineScope
+}
+
+func (*routineExit) Error() string {
+	return "EXIT"
+}
+
+func isRoutineControlFlow(err error) bool {
+	switch err.(type) {
+	case *routineJump, *routineReturn, *routineExit:
+		return true
+	}
+	return false
+}
+
+// routineInterpreter executes the statements of a stored routine. The statements are restored and parsed again
+// before they are executed, the local variables in them are replaced with their current values, so the AST
+// of the routine body is never changed and can be executed repeatedly in loops.
+type routineInterpreter struct {
+	sctx    sessionctx.Context
+	db      model.CIStr
+	routine *model.RoutineInfo
+	sqlMode mysql.SQLMode
+	charset string
+	collate string
+
+	parser   *parser.Parser
+	scopes   []*routineScope
+	handling bool
+	ret      types.Datum
+}
+
+func newRoutineInterpreter(sctx sessionctx.Context, db model.CIStr, routine *model.RoutineInfo) *routineInterpreter {
+	return &routineInterpreter{
+		sctx:    sctx,
+		db:      db,
+		routine: routine,
+		sqlMode: routine.SQLMode,
+		charset: routine.Charset,
+		collate: routine.Collate,
+	}
+}
+
+func (r *routineInterpreter) isFunction() bool {
+	return r.routine != nil && r.routine.Type == model.RoutineTypeFunction
+}
+
+// run executes the routine body with the arguments and returns the final values of the parameters.
+func (r *routineInterpreter) run(ctx context.Context, args []types.Datum) ([]types.Datum, error) {
+	sessVars := r.sctx.GetSessionVars()
+	for _, id := range sessVars.ActiveRoutines {
+		if id != r.routine.ID {
+			continue
+		}
+		if r.isFunction() {
+			return nil, exeerrors.ErrSpNoRecursion.GenWithStackByArgs()
+		}
+		return nil, exeerrors.ErrSpRecursionLimit.GenWithStackByArgs(0, r.routine.Name.O)
+	}
+	body, err := r.parseBody()
+	if err != nil {
+		return nil, err
+	}
+	params := newRoutineScope()
+	vars := make([]*routineVar, 0, len(r.routine.Params))
+	for i, param := range r.routine.Params {
+		v := &routineVar{tp: param.Type}
+		if param.Mode != model.RoutineParamOut {
+			if err := v.set(r.sctx, args[i]); err != nil {
+				return nil, err
+			}
+		}
+		params.vars[param.Name.L] = v
+		vars = append(vars, v)
+	}
+	r.scopes = append(r.scopes, params)
+
+	// The unqualified names in the routine body refer to the objects in the schema of the routine.
+	currentDB := sessVars.CurrentDB
+	sessVars.CurrentDB = r.db.O
+	sessVars.ActiveRoutines = append(sessVars.ActiveRoutines, r.routine.ID)
+	defer func() {
+		sessVars.CurrentDB = currentDB
+		sessVars.ActiveRoutines = sessVars.ActiveRoutines[:len(sessVars.ActiveRoutines)-1]
+	}()
+
+	err = r.execStmt(ctx, body)
+	if _, ok := err.(*routineReturn); ok {
+		err = nil
+	} else if err == nil && r.isFunction() {
+		err = exeerrors.ErrSpNoreturnend.GenWithStackByArgs(r.routine.Name.O)
+	}
+	if err != nil {
+		return nil, err
+	}
+	results := make([]types.Datum, 0, len(vars))
+	for _, v := range vars {
+		results = append(results, v.val)
+	}
+	return results, nil
+}
+
+// parseBody parses the routine body with the sql mode, charset and collation when the routine is created.
+func (r *routineInterpreter) parseBody() (ast.StmtNode, error) {
+	// The parameters are bound by the interpreter, so the body is parsed without them.
+	node, err := r.parseStmt("CREATE PROCEDURE `p`() " + r.routine.Body)
+	if err != nil {
+		return nil, err
+	}
+	return node.(*ast.ProcedureInfo).ProcedureBody, nil
+}
+
+func (r *routineInterpreter) parseStmt(sql string) (ast.StmtNode, error) {
+	if r.parser == nil {
+		r.parser = parser.New()
+		r.parser.SetSQLMode(r.sqlMode)
+		r.parser.SetParserConfig(r.sctx.GetSessionVars().BuildParserConfig())
+	}
+	node, err := r.parser.ParseOneStmt(sql, r.charset, r.collate)
+	return node, errors.Trace(err)
+}
+
+// prepareStmt restores the statement and parses it again, then the local variables in the new statement are
+// replaced with their values.
+func (r *routineInterpreter) prepareStmt(node ast.Node) (ast.StmtNode, error) {
+	var sb strings.Builder
+	if err := node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	sql := sb.String()
+	if _, ok := node.(ast.ExprNode); ok {
+		sql = "SELECT " + sql
+	}
+	stmt, err := r.parseStmt(sql)
+	if err != nil {
+		return nil, err
+	}
+	stmt.Accept(&routineVarBinder{r: r})
+	return stmt, nil
+}
+
+func (r *routineInterpreter) lookupVar(name string) *routineVar {
+	name = strings.ToLower(name)
+	for i := len(r.scopes) - 1; i >= 0; i-- {
+		if v, ok := r.scopes[i].vars[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func (r *routineInterpreter) lookupCursor(name string) (*routineCursor, error) {
+	lower := strings.ToLower(name)
+	for i := len(r.scopes) - 1; i >= 0; i-- {
+		if c, ok := r.scopes[i].cursors[lower]; ok {
+			return c, nil
+		}
+	}
+	return nil, dbterror.ErrSpCursorMismatch.GenWithStackByArgs(name)
+}
+
+// execStmts executes the statements in order, it stops at the first error.
+func (r *routineInterpreter) execStmts(ctx context.Context, stmts []ast.StmtNode) error {
+	for _, stmt := range stmts {
+		if err := r.execStmt(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execStmt executes a statement, the error of the statement is handled by the handlers of the active blocks.
+func (r *routineInterpreter) execStmt(ctx context.Context, stmt ast.StmtNode) error {
+	err := r.execStmtInner(ctx, stmt)
+	if err == nil || isRoutineControlFlow(err) {
+		return err
+	}
+	if handler, idx := r.findHandler(err); handler != nil {
+		return r.runHandler(ctx, handler, idx)
+	}
+	return err
+}
+
+func (r *routineInterpreter) execStmtInner(ctx context.Context, stmt ast.StmtNode) error {
+	switch x := stmt.(type) {
+	case *ast.ProcedureBlock:
+		return r.execBlock(ctx, x)
+	case *ast.ProcedureLabelBlock:
+		err := r.execBlock(ctx, x.Block)
+		if jump, ok := err.(*routineJump); ok && jump.leave && jump.label == strings.ToLower(x.LabelName) {
+			return nil
+		}
+		return err
+	case *ast.ProcedureLabelLoop:
+		return r.execLoop(ctx, strings.ToLower(x.LabelName), x.Block)
+	case *ast.ProcedureWhileStmt, *ast.ProcedureRepeatStmt, *ast.ProcedureLoopStmt:
+		return r.execLoop(ctx, "", x)
+	case *ast.ProcedureIfInfo:
+		return r.execIf(ctx, x.IfBody)
+	case *ast.SimpleCaseStmt:
+		return r.execSimpleCase(ctx, x)
+	case *ast.SearchCaseStmt:
+		return r.execSearchCase(ctx, x)
+	case *ast.ProcedureJump:
+		return &routineJump{label: strings.ToLower(x.Name), leave: x.IsLeave}
+	case *ast.ProcedureReturn:
+		d, _, err := r.eval(ctx, x.Expr)
+		if err != nil {
+			return err
+		}
+		if r.ret, err = d.ConvertTo(r.sctx.GetSessionVars().StmtCtx, r.routine.ReturnType); err != nil {
+			return err
+		}
+		return &routineReturn{}
+	case *ast.ProcedureOpenCur:
+		return r.execOpenCursor(ctx, x)
+	case *ast.ProcedureCloseCur:
+		c, err := r.lookupCursor(x.CurName)
+		if err != nil {
+			return err
+		}
+		if !c.open {
+			return exeerrors.ErrSpCursorNotOpen.GenWithStackByArgs()
+		}
+		c.open, c.rows, c.pos = false, nil, 0
+		return nil
+	case *ast.ProcedureFetchInto:
+		return r.execFetch(x)
+	case *ast.SetStmt:
+		return r.execSet(ctx, x)
+	case *ast.SelectStmt:
+		if x.SelectIntoOpt != nil && x.SelectIntoOpt.Tp == ast.SelectIntoVars {
+			return r.execSelectInto(ctx, x)
+		}
+	case *ast.CallStmt:
+		return r.execCall(ctx, x)
+	}
+	_, _, err := r.execSQL(ctx, stmt)
+	return err
+}
+
+// execBlock executes the BEGIN ... END block, the declarations are evaluated in order before the statements.
+func (r *routineInterpreter) execBlock(ctx context.Context, block *ast.ProcedureBlock) error {
+	scope := newRoutineScope()
+	r.scopes = append(r.scopes, scope)
+	defer func() {
+		r.scopes = r.scopes[:len(r.scopes)-1]
+	}()
+	for _, decl := range block.ProcedureVars {
+		switch x := decl.(type) {
+		case *ast.ProcedureDecl:
+			// The default value is evaluated before the declared variables are visible.
+			var d types.Datum
+			if x.DeclDefault != nil {
+				var err error
+				if d, _, err = r.eval(ctx, x.DeclDefault); err != nil {
+					return err
+				}
+			}
+			tp := r.localVarType(x.DeclType)
+			for _, name := range x.DeclNames {
+				v := &routineVar{tp: tp}
+				if err := v.set(r.sctx, d); err != nil {
+					return err
+				}
+				scope.vars[strings.ToLower(name)] = v
+			}
+		case *ast.ProcedureCursor:
+			scope.cursors[strings.ToLower(x.CurName)] = &routineCursor{query: x.Selectstring}
+		case *ast.ProcedureErrorControl:
+			scope.handlers = append(scope.handlers, x)
+		}
+	}
+	err := r.execStmts(ctx, block.ProcedureProcStmts)
+	if exit, ok := err.(*routineExit); ok && exit.scope == scope {
+		return nil
+	}
+	return err
+}
+
+// localVarType fills the default length, charset and collation of the local variable type.
+func (r *routineInterpreter) localVarType(declType *types.FieldType) *types.FieldType {
+	tp := declType.Clone()
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(tp.GetType())
+	if tp.GetFlen() == types.UnspecifiedLength {
+		tp.SetFlen(defaultFlen)
+	}
+	if tp.GetDecimal() == types.UnspecifiedLength {
+		tp.SetDecimal(defaultDecimal)
+	}
+	if types.IsString(tp.GetType()) && tp.GetCharset() == "" {
+		tp.SetCharset(r.charset)
+		tp.SetCollate(r.collate)
+	}
+	return tp
+}
+
+func (r *routineInterpreter) execLoop(ctx context.Context, label string, loop ast.StmtNode) error {
+	sessVars := r.sctx.GetSessionVars()
+	for {
+		if atomic.LoadUint32(&sessVars.Killed) == 1 {
+			return exeerrors.ErrQueryInterrupted
+		}
+		var err error
+		switch x := loop.(type) {
+		case *ast.ProcedureWhileStmt:
+			var ok bool
+			if ok, err = r.evalCondition(ctx, x.Condition); err != nil || !ok {
+				return err
+			}
+			err = r.execStmts(ctx, x.Body)
+		case *ast.ProcedureRepeatStmt:
+			if err = r.execStmts(ctx, x.Body); err == nil {
+				var done bool
+				if done, err = r.evalCondition(ctx, x.Condition); err != nil || done {
+					return err
+				}
+			}
+		case *ast.ProcedureLoopStmt:
+			err = r.execStmts(ctx, x.Body)
+		default:
+			return errors.Errorf("unknown loop statement %T", loop)
+		}
+		if jump, ok := err.(*routineJump); ok && label != "" && jump.label == label {
+			if jump.leave {
+				return nil
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (r *routineInterpreter) execIf(ctx context.Context, block *ast.ProcedureIfBlock) error {
+	ok, err := r.evalCondition(ctx, block.IfExpr)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return r.execStmts(ctx, block.ProcedureIfStmts)
+	}
+	switch x := block.ProcedureElseStmt.(type) {
+	case *ast.ProcedureElseIfBlock:
+		return r.execIf(ctx, x.ProcedureIfStmt)
+	case *ast.ProcedureElseBlock:
+		return r.execStmts(ctx, x.ProcedureIfStmts)
+	}
+	return nil
+}
+
+func (r *routineInterpreter) execSimpleCase(ctx context.Context, stmt *ast.SimpleCaseStmt) error {
+	val, tp, err := r.eval(ctx, stmt.Condition)
+	if err != nil {
+		return err
+	}
+	sc := r.sctx.GetSessionVars().StmtCtx
+	for _, when := range stmt.WhenCases {
+		d, _, err := r.eval(ctx, when.Expr)
+		if err != nil {
+			return err
+		}
+		if val.IsNull() || d.IsNull() {
+			continue
+		}
+		cmp, err := val.Compare(sc, &d, collate.GetCollator(tp.GetCollate()))
+		if err != nil {
+			return err
+		}
+		if cmp == 0 {
+			return r.execStmts(ctx, when.ProcedureStmts)
+		}
+	}
+	if stmt.ElseCases == nil {
+		return exeerrors.ErrSpCaseNotFound.GenWithStackByArgs()
+	}
+	return r.execStmts(ctx, stmt.ElseCases)
+}
+
+func (r *routineInterpreter) execSearchCase(ctx context.Context, stmt *ast.SearchCaseStmt) error {
+	for _, when := range stmt.WhenCases {
+		ok, err := r.evalCondition(ctx, when.Expr)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return r.execStmts(ctx, when.ProcedureStmts)
+		}
+	}
+	if stmt.ElseCases == nil {
+		return exeerrors.ErrSpCaseNotFound.GenWithStackByArgs()
+	}
+	return r.execStmts(ctx, stmt.ElseCases)
+}
+
+// execSet assigns the local variables directly, the other variables are assigned by the SET statement.
+func (r *routineInterpreter) execSet(ctx context.Context, set *ast.SetStmt) error {
+	for _, v := range set.Variables {
+		var local *routineVar
+		if v.IsSystem && !v.IsGlobal {
+			local = r.lookupVar(v.Name)
+		}
+		if local == nil && (v.IsSystem || !r.isFunction()) {
+			if _, _, err := r.execSQL(ctx, &ast.SetStmt{Variables: []*ast.VariableAssignment{v}}); err != nil {
+				return err
+			}
+			continue
+		}
+		var d types.Datum
+		tp := types.NewFieldType(mysql.TypeNull)
+		if v.Value != nil {
+			var err error
+			if d, tp, err = r.eval(ctx, v.Value); err != nil {
+				return err
+			}
+		}
+		if local != nil {
+			if err := local.set(r.sctx, d); err != nil {
+				return err
+			}
+			continue
+		}
+		setUserVar(r.sctx, v.Name, d, tp)
+	}
+	return nil
+}
+
+func (r *routineInterpreter) execSelectInto(ctx context.Context, sel *ast.SelectStmt) error {
+	into := sel.SelectIntoOpt
+	sel.SelectIntoOpt = nil
+	stmt, err := r.prepareStmt(sel)
+	sel.SelectIntoOpt = into
+	if err != nil {
+		return err
+	}
+	rows, fields, err := r.runSQL(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if len(fields) != len(into.Variables) {
+		return plannercore.ErrWrongNumberOfColumnsInSelect.GenWithStackByArgs()
+	}
+	switch len(rows) {
+	case 0:
+		// No rows is a warning unless there is a NOT FOUND handler, the variables are unchanged.
+		noData := exeerrors.ErrSpFetchNoData.GenWithStackByArgs()
+		if handler, idx := r.findHandler(noData); handler != nil {
+			return r.runHandler(ctx, handler, idx)
+		}
+		r.sctx.GetSessionVars().StmtCtx.AppendWarning(noData)
+		return nil
+	case 1:
+	default:
+		return exeerrors.ErrTooManyRows.GenWithStackByArgs()
+	}
+	for i, target := range into.Variables {
+		tp := &fields[i].Column.FieldType
+		if err := r.assign(target, rows[0].GetDatum(i, tp), tp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *routineInterpreter) execOpenCursor(ctx context.Context, open *ast.ProcedureOpenCur) error {
+	c, err := r.lookupCursor(open.CurName)
+	if err != nil {
+		return err
+	}
+	if c.open {
+		return exeerrors.ErrSpCursorAlreadyOpen.GenWithStackByArgs()
+	}
+	rows, fields, err := r.execSQL(ctx, c.query)
+	if err != nil {
+		return err
+	}
+	fieldTypes := make([]*types.FieldType, 0, len(fields))
+	for _, field := range fields {
+		fieldTypes = append(fieldTypes, &field.Column.FieldType)
+	}
+	c.rows = make([][]types.Datum, 0, len(rows))
+	for _, row := range rows {
+		c.rows = append(c.rows, row.GetDatumRow(fieldTypes))
+	}
+	c.open, c.pos = true, 0
+	return nil
+}
+
+func (r *routineInterpreter) execFetch(fetch *ast.ProcedureFetchInto) error {
+	c, err := r.lookupCursor(fetch.CurName)
+	if err != nil {
+		return err
+	}
+	if !c.open {
+		return exeerrors.ErrSpCursorNotOpen.GenWithStackByArgs()
+	}
+	if c.pos >= len(c.rows) {
+		return exeerrors.ErrSpFetchNoData.GenWithStackByArgs()
+	}
+	row := c.rows[c.pos]
+	if len(row) != len(fetch.Variables) {
+		return exeerrors.ErrSpWrongNoOfFetchArgs.GenWithStackByArgs()
+	}
+	c.pos++
+	for i, name := range fetch.Variables {
+		v := r.lookupVar(name)
+		if v == nil {
+			return dbterror.ErrSpUndeclaredVar.GenWithStackByArgs(name)
+		}
+		if err := v.set(r.sctx, row[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execCall calls the stored procedure, the OUT and INOUT arguments must be variables which are assigned with
+// the final values of the parameters.
+func (r *routineInterpreter) execCall(ctx context.Context, call *ast.CallStmt) error {
+	sessVars := r.sctx.GetSessionVars()
+	dbName := call.Procedure.Schema
+	if dbName.L == "" {
+		if sessVars.CurrentDB == "" {
+			return plannercore.ErrNoDB
+		}
+		dbName = model.NewCIStr(sessVars.CurrentDB)
+	}
+	is := sessiontxn.GetTxnManager(r.sctx).GetTxnInfoSchema()
+	var routine *model.RoutineInfo
+	if db, ok := is.SchemaByName(dbName); ok {
+		dbName = db.Name
+		routine = db.FindRoutine(call.Procedure.FnName, model.RoutineTypeProcedure)
+	}
+	name := dbName.O + "." + call.Procedure.FnName.O
+	if routine == nil {
+		return dbterror.ErrSpDoesNotExist.GenWithStackByArgs(model.RoutineTypeProcedure.String(), name)
+	}
+	if err := checkRoutineExecutePriv(r.sctx, dbName, routine); err != nil {
+		return err
+	}
+	if len(call.Procedure.Args) != len(routine.Params) {
+		return exeerrors.ErrSpWrongNoOfArgs.GenWithStackByArgs(model.RoutineTypeProcedure.String(), name, len(routine.Params), len(call.Procedure.Args))
+	}
+	args := make([]types.Datum, len(routine.Params))
+	for i, param := range routine.Params {
+		arg := call.Procedure.Args[i]
+		if param.Mode != model.RoutineParamIn && !r.isAssignable(arg) {
+			return exeerrors.ErrSpNotVarArg.GenWithStackByArgs(i+1, name)
+		}
+		if param.Mode == model.RoutineParamOut {
+			continue
+		}
+		d, _, err := r.eval(ctx, arg)
+		if err != nil {
+			return err
+		}
+		args[i] = d
+	}
+	results, err := newRoutineInterpreter(r.sctx, dbName, routine).run(ctx, args)
+	if err != nil {
+		return err
+	}
+	for i, param := range routine.Params {
+		if param.Mode == model.RoutineParamIn {
+			continue
+		}
+		if err := r.assign(call.Procedure.Args[i], results[i], param.Type); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *routineInterpreter) isAssignable(expr ast.ExprNode) bool {
+	switch x := expr.(type) {
+	case *ast.ColumnNameExpr:
+		return x.Name.Schema.L == "" && x.Name.Table.L == "" && r.lookupVar(x.Name.Name.L) != nil
+	case *ast.VariableExpr:
+		return !x.IsSystem
+	}
+	return false
+}
+
+// assign assigns the value to the local variable or the user variable.
+func (r *routineInterpreter) assign(target ast.ExprNode, d types.Datum, tp *types.FieldType) error {
+	switch x := target.(type) {
+	case *ast.ColumnNameExpr:
+		v := r.lookupVar(x.Name.Name.L)
+		if v == nil || x.Name.Table.L != "" {
+			return dbterror.ErrSpUndeclaredVar.GenWithStackByArgs(x.Name.String())
+		}
+		return v.set(r.sctx, d)
+	case *ast.VariableExpr:
+		if !x.IsSystem {
+			setUserVar(r.sctx, x.Name, d, tp)
+			return nil
+		}
+	}
+	return errors.Errorf("can't assign the value to %T", target)
+}
+
+func setUserVar(sctx sessionctx.Context, name string, d types.Datum, tp *types.FieldType) {
+	sessVars := sctx.GetSessionVars()
+	name = strings.ToLower(name)
+	if d.IsNull() {
+		sessVars.UnsetUserVar(name)
+		return
+	}
+	var val types.Datum
+	d.Copy(&val)
+	sessVars.SetUserVarVal(name, val)
+	sessVars.SetUserVarType(name, tp)
+}
+
+func (r *routineInterpreter) evalCondition(ctx context.Context, expr ast.ExprNode) (bool, error) {
+	d, _, err := r.eval(ctx, expr)
+	if err != nil || d.IsNull() {
+		return false, err
+	}
+	b, err := d.ToBool(r.sctx.GetSessionVars().StmtCtx)
+	return b != 0, err
+}
+
+// eval evaluates the expression. The expressions of stored procedures are evaluated by SELECT statements so that
+// they can contain subqueries, while the expressions of stored functions are evaluated directly since the
+// functions are evaluated during the execution of other statements.
+func (r *routineInterpreter) eval(ctx context.Context, expr ast.ExprNode) (types.Datum, *types.FieldType, error) {
+	stmt, err := r.prepareStmt(expr)
+	if err != nil {
+		return types.Datum{}, nil, err
+	}
+	if r.isFunction() {
+		e, err := expression.RewriteAstExpr(r.sctx, stmt.(*ast.SelectStmt).Fields.Fields[0].Expr, nil, nil, false)
+		if err != nil {
+			return types.Datum{}, nil, err
+		}
+		d, err := e.Eval(chunk.Row{})
+		return d, e.GetType(), err
+	}
+	rows, fields, err := r.runSQL(ctx, stmt)
+	if err != nil {
+		return types.Datum{}, nil, err
+	}
+	tp := &fields[0].Column.FieldType
+	return rows[0].GetDatum(0, tp), tp, nil
+}
+
+// execSQL executes the SQL statement of the routine body and returns the rows of it.
+func (r *routineInterpreter) execSQL(ctx context.Context, node ast.StmtNode) ([]chunk.Row, []*ast.ResultField, error) {
+	stmt, err := r.prepareStmt(node)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.runSQL(ctx, stmt)
+}
+
+func (r *routineInterpreter) runSQL(ctx context.Context, stmt ast.StmtNode) ([]chunk.Row, []*ast.ResultField, error) {
+	exec, ok := r.sctx.(sqlexec.SQLExecutor)
+	if !ok {
+		return nil, nil, errors.Errorf("can't execute %s in the stored routine", stmt.Text())
+	}
+	rs, err := exec.ExecuteStmt(ctx, stmt)
+	if err != nil || rs == nil {
+		return nil, nil, err
+	}
+	rows, err := sqlexec.DrainRecordSet(ctx, rs, r.sctx.GetSessionVars().MaxChunkSize)
+	fields := rs.Fields()
+	if closeErr := rs.Close(); err == nil {
+		err = closeErr
+	}
+	return rows, fields, err
+}
+
+// findHandler finds the handler for the error in the active blocks from the innermost one, a handler for
+// the error code is preferred to a handler for the SQLSTATE, which is preferred to a handler for the class.
+func (r *routineInterpreter) findHandler(err error) (*ast.ProcedureErrorControl, int) {
+	if r.handling {
+		return nil, -1
+	}
+	sqlErr := routineSQLError(err)
+	if sqlErr.Code == mysql.ErrQueryInterrupted {
+		return nil, -1
+	}
+	for i := len(r.scopes) - 1; i >= 0; i-- {
+		var (
+			best     *ast.ProcedureErrorControl
+			bestRank int
+		)
+		for _, handler := range r.scopes[i].handlers {
+			for _, cond := range handler.ErrorCon {
+				if rank := matchRoutineCondition(cond, sqlErr); rank > bestRank {
+					best, bestRank = handler, rank
+				}
+			}
+		}
+		if best != nil {
+			return best, i
+		}
+	}
+	return nil, -1
+}
+
+// runHandler executes the handler declared in the idx-th block, the handler can only see the variables
+// of the blocks enclosing its declaration.
+func (r *routineInterpreter) runHandler(ctx context.Context, handler *ast.ProcedureErrorControl, idx int) error {
+	scopes := r.scopes
+	r.scopes = append([]*routineScope(nil), scopes[:idx+1]...)
+	r.handling = true
+	err := r.execStmt(ctx, handler.Operate)
+	r.handling = false
+	r.scopes = scopes
+	if err != nil {
+		return err
+	}
+	if handler.ControlHandle == ast.PROCEDUR_EXIT {
+		return &routineExit{scope: scopes[idx]}
+	}
+	return nil
+}
+
+func routineSQLError(err error) *mysql.SQLError {
+	if tErr, ok := errors.Cause(err).(*terror.Error); ok {
+		return terror.ToSQLError(tErr)
+	}
+	return mysql.NewErr(mysql.ErrUnknown, err.Error())
+}
+
+func matchRoutineCondition(cond ast.ErrNode, sqlErr *mysql.SQLError) int {
+	switch x := cond.(type) {
+	case *ast.ProcedureErrorVal:
+		if x.ErrorNum == uint64(sqlErr.Code) {
+			return 3
+		}
+	case *ast.ProcedureErrorState:
+		if x.CodeStatus == sqlErr.State {
+			return 2
+		}
+	case *ast.ProcedureErrorCon:
+		var match bool
+		switch x.ErrorCon {
+		case ast.PROCEDUR_SQLWARNING:
+			match = strings.HasPrefix(sqlErr.State, "01")
+		case ast.PROCEDUR_NOT_FOUND:
+			match = strings.HasPrefix(sqlErr.State, "02")
+		case ast.PROCEDUR_SQLEXCEPTION:
+			match = !strings.HasPrefix(sqlErr.State, "00") && !strings.HasPrefix(sqlErr.State, "01") &&
+				!strings.HasPrefix(sqlErr.State, "02")
+		}
+		if match {
+			return 1
+		}
+	}
+	return 0
+}
+
+// checkRoutineExecutePriv checks the EXECUTE privilege of the stored routine.
+func checkRoutineExecutePriv(sctx sessionctx.Context, db model.CIStr, routine *model.RoutineInfo) error {
+	pm := privilege.GetPrivilegeManager(sctx)
+	sessVars := sctx.GetSessionVars()
+	if pm == nil || pm.RequestVerification(sessVars.ActiveRoles, db.L, "", "", mysql.ExecutePriv) {
+		return nil
+	}
+	var user, host string
+	if sessVars.User != nil {
+		user, host = sessVars.User.AuthUsername, sessVars.User.AuthHostname
+	}
+	return exeerrors.ErrProcaccessDenied.GenWithStackByArgs("execute", user, host, db.O+"."+routine.Name.O)
+}
+
+// isRoutineBodyVisible reports whether the current user can see the body of the stored routine, which is
+// visible to the definer and the users with the routine privileges or the global SELECT privilege.
+func isRoutineBodyVisible(sctx sessionctx.Context, db model.CIStr, routine *model.RoutineInfo) bool {
+	pm := privilege.GetPrivilegeManager(sctx)
+	if pm == nil {
+		return true
+	}
+	sessVars := sctx.GetSessionVars()
+	if sessVars.User != nil && routine.Definer != nil &&
+		sessVars.User.AuthUsername == routine.Definer.AuthUsername && sessVars.User.AuthHostname == routine.Definer.AuthHostname {
+		return true
+	}
+	if pm.RequestVerification(sessVars.ActiveRoles, "", "", "", mysql.SelectPriv) {
+		return true
+	}
+	for _, priv := range []mysql.PrivilegeType{mysql.CreateRoutinePriv, mysql.AlterRoutinePriv, mysql.ExecutePriv} {
+		if pm.RequestVerification(sessVars.ActiveRoles, db.L, "", "", priv) {
+			return true
+		}
+	}
+	return false
+}
+
+// routineVarBinder replaces the local variables in the statement with their values.
+type routineVarBinder struct {
+	r *routineInterpreter
+}
+
+// Enter implements ast.Visitor interface.
+func (*routineVarBinder) Enter(in ast.Node) (ast.Node, bool) {
+	// The targets of SELECT ... INTO are assigned by the interpreter.
+	_, skip := in.(*ast.SelectIntoOption)
+	return in, skip
+}
+
+// Leave implements ast.Visitor interface.
+func (v *routineVarBinder) Leave(in ast.Node) (ast.Node, bool) {
+	x, ok := in.(*ast.ColumnNameExpr)
+	if !ok || x.Name.Schema.L != "" || x.Name.Table.L != "" {
+		return in, true
+	}
+	local := v.r.lookupVar(x.Name.Name.L)
+	if local == nil {
+		return in, true
+	}
+	return ast.NewValueExpr(local.val.GetValue(), local.tp.GetCharset(), local.tp.GetCollate()), true
+}
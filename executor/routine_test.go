@@ -0,0 +1,288 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndDropRoutine(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+
+	tk.MustExec("create procedure p(in a int, out b int) begin set b = a + 1; end")
+	tk.MustGetErrCode("create procedure p() begin end", errno.ErrSpAlreadyExists)
+	tk.MustExec("create procedure if not exists p() begin end")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1304 PROCEDURE p already exists"))
+	tk.MustExec("create function p(a int) returns int deterministic return a * 2")
+	tk.MustGetErrCode("create procedure p2(a int, a int) begin end", errno.ErrSpDupParam)
+	tk.MustGetErrCode("create procedure p2() begin return 1; end", errno.ErrSpBadreturn)
+	tk.MustGetErrCode("create function f2() returns int begin end", errno.ErrSpNoreturn)
+	tk.MustGetErrCode("create function f2() returns int begin select 1; return 1; end", errno.ErrSpNoRetset)
+	tk.MustGetErrCode("create procedure p2() begin set x = 1; end", errno.ErrUnknownSystemVariable)
+	tk.MustGetErrCode("create procedure p2() begin select 1 into x; end", errno.ErrSpUndeclaredVar)
+	tk.MustGetErrCode("create procedure p2() begin declare x int; declare x int; end", errno.ErrSpDupVar)
+	tk.MustGetErrCode("create procedure p2() l1: begin leave l2; end", errno.ErrSpLilabelMismatch)
+
+	tk.MustQuery("show procedure status like 'p'").CheckAt([]int{0, 1, 2}, testkit.Rows("test p PROCEDURE"))
+	tk.MustQuery("show function status where name = 'p'").CheckAt([]int{0, 1, 2}, testkit.Rows("test p FUNCTION"))
+	tk.MustQuery("select routine_name, routine_type, data_type, routine_definition, is_deterministic " +
+		"from information_schema.routines where routine_schema = 'test' order by routine_type").Check(testkit.Rows(
+		"p FUNCTION int return a * 2 YES",
+		"p PROCEDURE  begin set b = a + 1; end NO",
+	))
+	tk.MustQuery("show create procedure p").CheckAt([]int{0, 2}, testkit.RowsWithSep("|",
+		"p|CREATE DEFINER=`root`@`%` PROCEDURE `p`(in a int, out b int)\nbegin set b = a + 1; end",
+	))
+	tk.MustQuery("show create function test.p").CheckAt([]int{0, 2}, testkit.RowsWithSep("|",
+		"p|CREATE DEFINER=`root`@`%` FUNCTION `p`(a int) RETURNS int(11)\n    DETERMINISTIC\nreturn a * 2",
+	))
+
+	tk.MustExec("drop procedure p")
+	tk.MustGetErrCode("drop procedure p", errno.ErrSpDoesNotExist)
+	tk.MustExec("drop procedure if exists p")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1305 PROCEDURE test.p does not exist"))
+	require.True(t, dbterror.ErrSpDoesNotExist.Equal(tk.QueryToErr("show create procedure p")))
+	tk.MustQuery("select p(3)").Check(testkit.Rows("6"))
+	tk.MustExec("drop function p")
+	tk.MustGetErrCode("select p(3)", errno.ErrSpDoesNotExist)
+}
+
+func TestCallProcedure(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b varchar(20))")
+
+	tk.MustExec(`create procedure fill(in n int, inout total int)
+begin
+	declare i int default 0;
+	while i < n do
+		set i = i + 1;
+		insert into t values (i, concat('row', i));
+		set total = total + i;
+	end while;
+end`)
+	tk.MustExec("set @total = 10")
+	tk.MustExec("call fill(3, @total)")
+	tk.MustQuery("select @total").Check(testkit.Rows("16"))
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("1 row1", "2 row2", "3 row3"))
+	tk.MustGetErrCode("call fill(1)", errno.ErrSpWrongNoOfArgs)
+	tk.MustGetErrCode("call fill(1, 2)", errno.ErrSpNotVarArg)
+	tk.MustGetErrCode("call missing()", errno.ErrSpDoesNotExist)
+
+	// SELECT INTO, IF, CASE, LOOP with labels and OUT parameters.
+	tk.MustExec(`create procedure describe_row(in id int, out res varchar(50))
+begin
+	declare v varchar(20);
+	declare k int default 0;
+	select b from t where a = id into v;
+	if v is null then
+		set res = 'missing';
+	elseif id = 1 then
+		set res = concat('first ', v);
+	else
+		case id
+			when 2 then set res = concat('second ', v);
+			else set res = concat('other ', v);
+		end case;
+	end if;
+	l: loop
+		set k = k + 1;
+		if k < 3 then
+			iterate l;
+		end if;
+		leave l;
+	end loop l;
+	set res = concat(res, ' ', k);
+end`)
+	tk.MustExec("call describe_row(1, @r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("first row1 3"))
+	tk.MustExec("call describe_row(2, @r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("second row2 3"))
+	tk.MustExec("call describe_row(3, @r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("other row3 3"))
+	tk.MustExec("call describe_row(4, @r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("missing 3"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	tk.MustExec(`create procedure too_many(out res int)
+begin
+	select a from t into res;
+end`)
+	tk.MustGetErrCode("call too_many(@r)", errno.ErrTooManyRows)
+	tk.MustExec(`create procedure no_case(in x int)
+begin
+	case x when 1 then set @c = 1; end case;
+end`)
+	tk.MustGetErrCode("call no_case(2)", errno.ErrSpCaseNotFound)
+
+	// A procedure can call the other procedures but not itself.
+	tk.MustExec(`create procedure outer_call(out res int)
+begin
+	declare x int default 0;
+	call fill(0, x);
+	set x = x + 1;
+	call fill(0, x);
+	set res = x;
+end`)
+	tk.MustExec("call outer_call(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("1"))
+	tk.MustExec("create procedure recursive_call() begin call recursive_call(); end")
+	tk.MustGetErrCode("call recursive_call()", errno.ErrSpRecursionLimit)
+	tk.MustGetErrCode("create procedure use_db() begin use test; end", errno.ErrSpBadstatement)
+}
+
+func TestProcedureCursorAndHandler(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key)")
+	tk.MustExec("insert into t values (1), (2), (3), (4)")
+
+	tk.MustExec(`create procedure sum_cursor(out total int)
+begin
+	declare done int default 0;
+	declare v int;
+	declare c cursor for select a from t order by a;
+	declare continue handler for not found set done = 1;
+	set total = 0;
+	open c;
+	read_loop: loop
+		fetch c into v;
+		if done then
+			leave read_loop;
+		end if;
+		set total = total + v;
+	end loop;
+	close c;
+end`)
+	tk.MustExec("call sum_cursor(@total)")
+	tk.MustQuery("select @total").Check(testkit.Rows("10"))
+
+	tk.MustExec(`create procedure insert_dup(out res varchar(20))
+begin
+	declare exit handler for 1062 set res = 'duplicated';
+	set res = 'inserted';
+	insert into t values (1);
+	set res = 'unreachable';
+end`)
+	tk.MustExec("call insert_dup(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("duplicated"))
+
+	tk.MustExec(`create procedure continue_on_error(out res int)
+begin
+	declare continue handler for sqlexception set res = res + 100;
+	set res = 1;
+	insert into t values (2);
+	set res = res + 1;
+end`)
+	tk.MustExec("call continue_on_error(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("102"))
+
+	tk.MustExec(`create procedure cursor_errors(in k int)
+begin
+	declare v int;
+	declare c cursor for select a from t where a > 10;
+	if k = 1 then
+		fetch c into v;
+	end if;
+	open c;
+	if k = 2 then
+		open c;
+	end if;
+	fetch c into v;
+end`)
+	tk.MustGetErrCode("call cursor_errors(1)", errno.ErrSpCursorNotOpen)
+	tk.MustGetErrCode("call cursor_errors(2)", errno.ErrSpCursorAlreadyOpen)
+	tk.MustGetErrCode("call cursor_errors(3)", errno.ErrSpFetchNoData)
+}
+
+func TestStoredFunction(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create database db1")
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1), (2), (3)")
+
+	tk.MustExec(`create function db1.fact(n int) returns bigint deterministic
+begin
+	declare r bigint default 1;
+	while n > 1 do
+		set r = r * n;
+		set n = n - 1;
+	end while;
+	return r;
+end`)
+	tk.MustExec(`create function grade(x int) returns varchar(10)
+begin
+	if x > 2 then
+		return 'high';
+	end if;
+	return 'low';
+end`)
+	tk.MustQuery("select a, db1.fact(a + 2), grade(a) from t order by a").Check(testkit.Rows(
+		"1 6 low",
+		"2 24 low",
+		"3 120 high",
+	))
+	tk.MustQuery("select a from t where grade(a) = 'low' order by a").Check(testkit.Rows("1", "2"))
+	tk.MustGetErrCode("select fact(3)", errno.ErrSpDoesNotExist)
+	tk.MustGetErrCode("select db1.fact(1, 2)", errno.ErrSpWrongNoOfArgs)
+
+	tk.MustExec("create function no_return(x int) returns int begin if x > 0 then return x; end if; end")
+	tk.MustQuery("select no_return(1)").Check(testkit.Rows("1"))
+	require.True(t, exeerrors.ErrSpNoreturnend.Equal(tk.QueryToErr("select no_return(0)")))
+	tk.MustExec("create function self_call(x int) returns int return self_call(x)")
+	require.True(t, exeerrors.ErrSpNoRecursion.Equal(tk.QueryToErr("select self_call(1)")))
+
+	// The stored functions can be called in the procedures.
+	tk.MustExec("create procedure p(out r bigint) begin set r = db1.fact(5); end")
+	tk.MustExec("call p(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("120"))
+}
+
+func TestRoutinePrivileges(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create procedure p() begin set @x = 1; end")
+	tk.MustExec("create function f() returns int return 1")
+	tk.MustExec("create user u1")
+	tk.MustExec("grant select on test.* to u1")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "localhost"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustGetErrCode("call p()", errno.ErrProcaccessDenied)
+	tk1.MustGetErrCode("select f()", errno.ErrProcaccessDenied)
+	tk1.MustGetErrCode("create procedure p2() begin end", errno.ErrDBaccessDenied)
+	tk1.MustGetErrCode("drop procedure p", errno.ErrDBaccessDenied)
+	tk1.MustQuery("show create procedure p").CheckAt([]int{0, 2}, testkit.Rows("p <nil>"))
+
+	tk.MustExec("grant execute on test.* to u1")
+	tk1.MustExec("call p()")
+	tk1.MustQuery("select @x, f()").Check(testkit.Rows("1 1"))
+}
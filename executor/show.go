@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/disttask/importinto"
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/domain/infosync"
+	"github.com/pingcap/tidb/event"
 	"github.com/pingcap/tidb/executor/importer"
 	"github.com/pingcap/tidb/executor/internal/exec"
 	"github.com/pingcap/tidb/expression"
@@ -68,6 +69,7 @@ import (
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/dbterror"
 	"github.com/pingcap/tidb/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/util/etcd"
 	"github.com/pingcap/tidb/util/filter"
@@ -93,6 +95,7 @@ type ShowExec struct {
 	Tp                ast.ShowStmtType // Databases/Tables/Columns/....
 	DBName            model.CIStr
 	Table             *ast.TableName       // Used for showing columns.
+	Procedure         *ast.TableName       // Used for showing create procedure and function.
 	Partition         model.CIStr          // Used for showing partition
 	Column            *ast.ColumnName      // Used for `desc table column`.
 	IndexName         model.CIStr          // Used for show table regions.
@@ -190,6 +193,10 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 		return e.fetchShowCreateUser(ctx)
 	case ast.ShowCreateView:
 		return e.fetchShowCreateView()
+	case ast.ShowCreateProcedure:
+		return e.fetchShowCreateRoutine(model.RoutineTypeProcedure)
+	case ast.ShowCreateFunction:
+		return e.fetchShowCreateRoutine(model.RoutineTypeFunction)
 	case ast.ShowCreateDatabase:
 		return e.fetchShowCreateDatabase()
 	case ast.ShowCreatePlacementPolicy:
@@ -207,7 +214,9 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 	case ast.ShowIndex:
 		return e.fetchShowIndex()
 	case ast.ShowProcedureStatus:
-		return e.fetchShowProcedureStatus()
+		return e.fetchShowRoutineStatus(model.RoutineTypeProcedure)
+	case ast.ShowFunctionStatus:
+		return e.fetchShowRoutineStatus(model.RoutineTypeFunction)
 	case ast.ShowPumpStatus:
 		return e.fetchShowPumpOrDrainerStatus(node.PumpNode)
 	case ast.ShowStatus:
@@ -229,7 +238,7 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 	case ast.ShowProcessList:
 		return e.fetchShowProcessList()
 	case ast.ShowEvents:
-		// empty result
+		return e.fetchShowEvents(ctx)
 	case ast.ShowStatsExtended:
 		return e.fetchShowStatsExtended()
 	case ast.ShowStatsMeta:
@@ -809,6 +818,12 @@ func (e *ShowExec) fetchShowIndex() error {
 				expression = tblCol.GeneratedExprString
 			}
 
+			var collation interface{} = "A"
+			indexType := idx.Meta().Tp.String()
+			if idx.Meta().FullText {
+				collation, indexType = nil, "FULLTEXT"
+			}
+
 			colStats, ok := statsTbl.Columns[tblCol.ID]
 			var ndv int64
 			if ok {
@@ -816,22 +831,22 @@ func (e *ShowExec) fetchShowIndex() error {
 			}
 
 			e.appendRow([]interface{}{
-				tb.Meta().Name.O,       // Table
-				nonUniq,                // Non_unique
-				idx.Meta().Name.O,      // Key_name
-				i + 1,                  // Seq_in_index
-				colName,                // Column_name
-				"A",                    // Collation
-				ndv,                    // Cardinality
-				subPart,                // Sub_part
-				nil,                    // Packed
-				nullVal,                // Null
-				idx.Meta().Tp.String(), // Index_type
-				"",                     // Comment
-				idx.Meta().Comment,     // Index_comment
-				visible,                // Index_visible
-				expression,             // Expression
-				isClustered,            // Clustered
+				tb.Meta().Name.O,   // Table
+				nonUniq,            // Non_unique
+				idx.Meta().Name.O,  // Key_name
+				i + 1,              // Seq_in_index
+				colName,            // Column_name
+				collation,          // Collation
+				ndv,                // Cardinality
+				subPart,            // Sub_part
+				nil,                // Packed
+				nullVal,            // Null
+				indexType,          // Index_type
+				"",                 // Comment
+				idx.Meta().Comment, // Index_comment
+				visible,            // Index_visible
+				expression,         // Expression
+				isClustered,        // Clustered
 			})
 		}
 	}
@@ -978,6 +993,10 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 		ConstructResultOfShowCreateSequence(ctx, tableInfo, buf)
 		return nil
 	}
+	if tableInfo.IsMaterializedView() {
+		fetchShowCreateTable4MaterializedView(ctx, tableInfo, buf)
+		return nil
+	}
 
 	tblCharset := tableInfo.Charset
 	if len(tblCharset) == 0 {
@@ -1023,6 +1042,9 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 				}
 			}
 		}
+		if col.SRID != nil {
+			fmt.Fprintf(buf, " /*!80003 SRID %d */", *col.SRID)
+		}
 		if col.IsGenerated() {
 			// It's a generated column.
 			fmt.Fprintf(buf, " GENERATED ALWAYS AS (%s)", col.GeneratedExprString)
@@ -1142,6 +1164,8 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 			buf.WriteString("  PRIMARY KEY ")
 		} else if idxInfo.Unique {
 			fmt.Fprintf(buf, "  UNIQUE KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
+		} else if idxInfo.FullText {
+			fmt.Fprintf(buf, "  FULLTEXT KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
 		} else {
 			fmt.Fprintf(buf, "  KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
 		}
@@ -1160,6 +1184,9 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 			cols = append(cols, colInfo)
 		}
 		fmt.Fprintf(buf, "(%s)", strings.Join(cols, ","))
+		if idxInfo.ParserName.L != "" {
+			fmt.Fprintf(buf, ` /*!50100 WITH PARSER %s */`, stringutil.Escape(idxInfo.ParserName.O, sqlMode))
+		}
 		if idxInfo.Invisible {
 			fmt.Fprintf(buf, ` /*!80000 INVISIBLE */`)
 		}
@@ -1488,6 +1515,21 @@ func fetchShowCreateTable4View(ctx sessionctx.Context, tb *model.TableInfo, buf
 		}
 	}
 	fmt.Fprintf(buf, ") AS %s", tb.View.SelectStmt)
+	if checkOption := tb.View.GetCheckOption(); checkOption != model.CheckOptionNone {
+		fmt.Fprintf(buf, " WITH %s CHECK OPTION", checkOption.String())
+	}
+}
+
+func fetchShowCreateTable4MaterializedView(ctx sessionctx.Context, tb *model.TableInfo, buf *bytes.Buffer) {
+	sqlMode := ctx.GetSessionVars().SQLMode
+	fmt.Fprintf(buf, "CREATE MATERIALIZED VIEW %s (", stringutil.Escape(tb.Name.O, sqlMode))
+	for i, col := range tb.Columns {
+		fmt.Fprintf(buf, "%s", stringutil.Escape(col.Name.O, sqlMode))
+		if i < len(tb.Columns)-1 {
+			fmt.Fprintf(buf, ", ")
+		}
+	}
+	fmt.Fprintf(buf, ") REFRESH %s AS %s", tb.MaterializedView.RefreshMode.String(), tb.MaterializedView.SelectStmt)
 }
 
 // ConstructResultOfShowCreateDatabase constructs the result for show create database.
@@ -1832,14 +1874,230 @@ func (e *ShowExec) fetchShowPrivileges() error {
 	return nil
 }
 
-func (*ShowExec) fetchShowTriggers() error {
+func (e *ShowExec) fetchShowTriggers() error {
+	checker := privilege.GetPrivilegeManager(e.Ctx())
+	if checker != nil && e.Ctx().GetSessionVars().User != nil {
+		if !checker.DBIsVisible(e.Ctx().GetSessionVars().ActiveRoles, e.DBName.O) {
+			return e.dbAccessDenied()
+		}
+	}
+	dbInfo, ok := e.is.SchemaByName(e.DBName)
+	if !ok {
+		return exeerrors.ErrBadDB.GenWithStackByArgs(e.DBName)
+	}
+	var (
+		fieldPatternsLike collate.WildcardPattern
+		fieldFilter       string
+	)
+	if e.Extractor != nil {
+		fieldFilter = e.Extractor.Field()
+		fieldPatternsLike = e.Extractor.FieldPatternLike()
+	}
+	activeRoles := e.Ctx().GetSessionVars().ActiveRoles
+	// The LIKE pattern of SHOW TRIGGERS matches the table names.
+	schemaTables := e.is.SchemaTables(e.DBName)
+	slices.SortFunc(schemaTables, func(i, j table.Table) bool {
+		return i.Meta().Name.L < j.Meta().Name.L
+	})
+	for _, tbl := range schemaTables {
+		tblInfo := tbl.Meta()
+		if len(tblInfo.Triggers) == 0 {
+			continue
+		}
+		if checker != nil && !checker.RequestVerification(activeRoles, e.DBName.O, tblInfo.Name.O, "", mysql.TriggerPriv) {
+			continue
+		} else if fieldFilter != "" && tblInfo.Name.L != fieldFilter {
+			continue
+		} else if fieldPatternsLike != nil && !fieldPatternsLike.DoMatch(tblInfo.Name.L) {
+			continue
+		}
+		for _, trigger := range tblInfo.Triggers {
+			created := types.NewTime(types.FromGoTime(trigger.Created.In(e.Ctx().GetSessionVars().Location())), mysql.TypeDatetime, 2)
+			e.appendRow([]interface{}{
+				trigger.Name.O,
+				trigger.Event.String(),
+				tblInfo.Name.O,
+				trigger.Body,
+				trigger.Timing.String(),
+				created,
+				sqlModeString(trigger.SQLMode),
+				trigger.Definer.String(),
+				trigger.Charset,
+				trigger.Collate,
+				dbInfo.Collate,
+			})
+		}
+	}
+	return nil
+}
+
+func (e *ShowExec) fetchShowEvents(ctx context.Context) error {
+	checker := privilege.GetPrivilegeManager(e.Ctx())
+	activeRoles := e.Ctx().GetSessionVars().ActiveRoles
+	if checker != nil && e.Ctx().GetSessionVars().User != nil {
+		if !checker.RequestVerification(activeRoles, e.DBName.O, "", "", mysql.EventPriv) {
+			return e.dbAccessDenied()
+		}
+	}
+	dbInfo, ok := e.is.SchemaByName(e.DBName)
+	if !ok {
+		return exeerrors.ErrBadDB.GenWithStackByArgs(e.DBName)
+	}
+	m := domain.GetDomain(e.Ctx()).EventManager()
+	if m == nil {
+		return nil
+	}
+	events, err := m.ListEvents(ctx)
+	if err != nil {
+		return err
+	}
+	var (
+		fieldPatternsLike collate.WildcardPattern
+		fieldFilter       string
+	)
+	if e.Extractor != nil {
+		fieldFilter = e.Extractor.Field()
+		fieldPatternsLike = e.Extractor.FieldPatternLike()
+	}
+	slices.SortFunc(events, func(i, j *event.Event) bool {
+		return i.Name.L < j.Name.L
+	})
+	loc := e.Ctx().GetSessionVars().Location()
+	for _, ev := range events {
+		if ev.Schema.L != dbInfo.Name.L {
+			continue
+		} else if fieldFilter != "" && ev.Name.L != fieldFilter {
+			continue
+		} else if fieldPatternsLike != nil && !fieldPatternsLike.DoMatch(ev.Name.L) {
+			continue
+		}
+		row := []interface{}{
+			dbInfo.Name.O,
+			ev.Name.O,
+			ev.TimeZone,
+			ev.Definer.String(),
+			"RECURRING",
+			nil,
+			ev.IntervalValue,
+			ev.IntervalField,
+			eventTime(ev.Starts, loc),
+			eventTime(ev.Ends, loc),
+			ev.Status.String(),
+			0,
+			ev.Charset,
+			ev.Collate,
+			dbInfo.Collate,
+		}
+		if !ev.IsRecurring() {
+			row[4], row[5], row[6], row[7] = "ONE TIME", eventTime(ev.ExecuteAt, loc), nil, nil
+		}
+		e.appendRow(row)
+	}
+	return nil
+}
+
+func (e *ShowExec) fetchShowRoutineStatus(tp model.RoutineType) error {
+	var (
+		fieldPatternsLike collate.WildcardPattern
+		fieldFilter       string
+	)
+	if e.Extractor != nil {
+		fieldFilter = e.Extractor.Field()
+		fieldPatternsLike = e.Extractor.FieldPatternLike()
+	}
+	checker := privilege.GetPrivilegeManager(e.Ctx())
+	activeRoles := e.Ctx().GetSessionVars().ActiveRoles
+	dbs := e.is.AllSchemas()
+	slices.SortFunc(dbs, func(i, j *model.DBInfo) bool {
+		return i.Name.L < j.Name.L
+	})
+	for _, db := range dbs {
+		if checker != nil && !checker.DBIsVisible(activeRoles, db.Name.O) {
+			continue
+		}
+		routines := make([]*model.RoutineInfo, 0, len(db.Routines))
+		for _, routine := range db.Routines {
+			if routine.Type != tp {
+				continue
+			} else if fieldFilter != "" && routine.Name.L != fieldFilter {
+				continue
+			} else if fieldPatternsLike != nil && !fieldPatternsLike.DoMatch(routine.Name.L) {
+				continue
+			}
+			routines = append(routines, routine)
+		}
+		slices.SortFunc(routines, func(i, j *model.RoutineInfo) bool {
+			return i.Name.L < j.Name.L
+		})
+		for _, routine := range routines {
+			created := types.NewTime(types.FromGoTime(routine.Created.In(e.Ctx().GetSessionVars().Location())), mysql.TypeDatetime, 0)
+			e.appendRow([]interface{}{
+				db.Name.O,
+				routine.Name.O,
+				routine.Type.String(),
+				routine.Definer.String(),
+				created,
+				created,
+				"DEFINER",
+				routine.Comment,
+				routine.Charset,
+				routine.Collate,
+				db.Collate,
+			})
+		}
+	}
 	return nil
 }
 
-func (*ShowExec) fetchShowProcedureStatus() error {
+func (e *ShowExec) fetchShowCreateRoutine(tp model.RoutineType) error {
+	db, ok := e.is.SchemaByName(e.Procedure.Schema)
+	var routine *model.RoutineInfo
+	if ok {
+		routine = db.FindRoutine(e.Procedure.Name, tp)
+	}
+	if routine == nil {
+		return dbterror.ErrSpDoesNotExist.GenWithStackByArgs(tp.String(), e.Procedure.Schema.O+"."+e.Procedure.Name.O)
+	}
+	var createStmt interface{}
+	if isRoutineBodyVisible(e.Ctx(), db.Name, routine) {
+		var buf bytes.Buffer
+		fetchShowCreateRoutine(e.Ctx(), routine, &buf)
+		createStmt = buf.String()
+	}
+	e.appendRow([]interface{}{routine.Name.O, sqlModeString(routine.SQLMode), createStmt, routine.Charset, routine.Collate, db.Collate})
 	return nil
 }
 
+func fetchShowCreateRoutine(ctx sessionctx.Context, routine *model.RoutineInfo, buf *bytes.Buffer) {
+	sqlMode := ctx.GetSessionVars().SQLMode
+	buf.WriteString("CREATE ")
+	if definer := routine.Definer; definer == nil {
+		// The routines created by the internal sessions have no definer.
+	} else if definer.AuthUsername == "" || definer.AuthHostname == "" {
+		fmt.Fprintf(buf, "DEFINER=%s@%s ", stringutil.Escape(definer.Username, sqlMode), stringutil.Escape(definer.Hostname, sqlMode))
+	} else {
+		fmt.Fprintf(buf, "DEFINER=%s@%s ", stringutil.Escape(definer.AuthUsername, sqlMode), stringutil.Escape(definer.AuthHostname, sqlMode))
+	}
+	fmt.Fprintf(buf, "%s %s(%s)", routine.Type.String(), stringutil.Escape(routine.Name.O, sqlMode), routine.ParamList)
+	if routine.Type == model.RoutineTypeFunction {
+		fmt.Fprintf(buf, " RETURNS %s", routine.ReturnType.CompactStr())
+		if types.IsNonBinaryStr(routine.ReturnType) {
+			fmt.Fprintf(buf, " CHARSET %s", routine.ReturnType.GetCharset())
+		}
+	}
+	buf.WriteString("\n")
+	if routine.SQLDataAccess != "" && routine.SQLDataAccess != ast.ContainsSQL {
+		fmt.Fprintf(buf, "    %s\n", routine.SQLDataAccess)
+	}
+	if routine.Deterministic {
+		buf.WriteString("    DETERMINISTIC\n")
+	}
+	if routine.Comment != "" {
+		fmt.Fprintf(buf, "    COMMENT '%s'\n", format.OutputFormat(routine.Comment))
+	}
+	buf.WriteString(routine.Body)
+}
+
 func (e *ShowExec) fetchShowPlugins() error {
 	tiPlugins := plugin.GetAll()
 	for _, ps := range tiPlugins {
@@ -50,6 +50,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/sessionstates"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/sessiontxn"
+	"github.com/pingcap/tidb/statistics"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
@@ -193,6 +194,8 @@ func (e *SimpleExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 		err = e.executeSetResourceGroupName(x)
 	case *ast.DropQueryWatchStmt:
 		err = e.executeDropQueryWatch(x)
+	case *ast.XAStmt:
+		err = e.executeXA(ctx, x)
 	}
 	e.done = true
 	return err
@@ -811,6 +814,129 @@ func (e *SimpleExec) executeRollback(s *ast.RollbackStmt) error {
 	return nil
 }
 
+func (e *SimpleExec) executeXA(ctx context.Context, s *ast.XAStmt) error {
+	switch s.Tp {
+	case ast.XAStart:
+		return e.executeXAStart(ctx, s)
+	case ast.XAEnd:
+		return e.executeXAEnd(s)
+	case ast.XAPrepare:
+		return e.executeXAPrepare(ctx, s)
+	case ast.XACommit:
+		return e.executeXACommit(ctx, s)
+	case ast.XARollback:
+		return e.executeXARollback(ctx, s)
+	}
+	return errors.Errorf("unexpected XA statement type %d", s.Tp)
+}
+
+func (e *SimpleExec) executeXAStart(ctx context.Context, s *ast.XAStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	if s.Join || s.Resume {
+		return exeerrors.ErrXaerInval
+	}
+	if sessVars.XAState != variable.XANonExisting {
+		return exeerrors.ErrXaerRmfail.GenWithStackByArgs(sessVars.XAState.String())
+	}
+	if sessVars.InTxn() {
+		return exeerrors.ErrXaerOutside
+	}
+	prepared, err := getXATxn(e.Ctx().GetStore(), xaKey(s.XID))
+	if err != nil {
+		return err
+	}
+	if prepared != nil {
+		return exeerrors.ErrXaerDupid
+	}
+	err = sessiontxn.GetTxnManager(e.Ctx()).EnterNewTxn(ctx, &sessiontxn.EnterNewTxnRequest{
+		Type: sessiontxn.EnterNewTxnWithBeginStmt,
+	})
+	if err != nil {
+		return err
+	}
+	sessVars.XAState = variable.XAActive
+	sessVars.XID = s.XID
+	return nil
+}
+
+func (e *SimpleExec) executeXAEnd(s *ast.XAStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	if s.Suspend {
+		return exeerrors.ErrXaerInval
+	}
+	if sessVars.XAState != variable.XAActive {
+		return exeerrors.ErrXaerRmfail.GenWithStackByArgs(sessVars.XAState.String())
+	}
+	if *sessVars.XID != *s.XID {
+		return exeerrors.ErrXaerNota
+	}
+	sessVars.XAState = variable.XAIdle
+	return nil
+}
+
+func (e *SimpleExec) executeXAPrepare(ctx context.Context, s *ast.XAStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	if sessVars.XAState == variable.XANonExisting {
+		return exeerrors.ErrXaerNota
+	}
+	if sessVars.XAState != variable.XAIdle {
+		return exeerrors.ErrXaerRmfail.GenWithStackByArgs(sessVars.XAState.String())
+	}
+	if *sessVars.XID != *s.XID {
+		return exeerrors.ErrXaerNota
+	}
+	// The branch is detached from the session no matter whether it's prepared successfully,
+	// a failed branch is rolled back.
+	defer sessVars.ResetXA()
+	if !sessVars.InTxn() {
+		return exeerrors.ErrXaRbrollback
+	}
+	ws, err := getXAWriteSet(e.Ctx(), s.XID)
+	// The locks of the session are released before they are taken over by the prepared branch.
+	if rollbackErr := e.executeRollback(&ast.RollbackStmt{}); err == nil {
+		err = rollbackErr
+	}
+	if err != nil {
+		return err
+	}
+	return prepareXATxn(ctx, e.Ctx(), s.XID, ws)
+}
+
+func (e *SimpleExec) executeXACommit(ctx context.Context, s *ast.XAStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	if sessVars.XAState == variable.XANonExisting {
+		if s.OnePhase {
+			return exeerrors.ErrXaerNota
+		}
+		if sessVars.InTxn() {
+			return exeerrors.ErrXaerOutside
+		}
+		return commitXATxn(ctx, e.Ctx(), s.XID)
+	}
+	if *sessVars.XID != *s.XID || !s.OnePhase || sessVars.XAState != variable.XAIdle {
+		return exeerrors.ErrXaerRmfail.GenWithStackByArgs(sessVars.XAState.String())
+	}
+	// The transaction is committed by the session after the statement, like COMMIT.
+	sessVars.ResetXA()
+	e.executeCommit()
+	return nil
+}
+
+func (e *SimpleExec) executeXARollback(ctx context.Context, s *ast.XAStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	if sessVars.XAState == variable.XANonExisting {
+		if sessVars.InTxn() {
+			return exeerrors.ErrXaerOutside
+		}
+		return rollbackXATxn(ctx, e.Ctx(), s.XID)
+	}
+	if *sessVars.XID != *s.XID || sessVars.XAState != variable.XAIdle {
+		return exeerrors.ErrXaerRmfail.GenWithStackByArgs(sessVars.XAState.String())
+	}
+	sessVars.ResetXA()
+	return e.executeRollback(&ast.RollbackStmt{})
+}
+
 func whetherSavePasswordHistory(plOptions *passwordOrLockOptionsInfo) bool {
 	var passwdSaveNum, passwdSaveTime int64
 	// If the user specifies a default, read the global variable.
@@ -2773,6 +2899,12 @@ func (e *SimpleExec) executeAdmin(s *ast.AdminStmt) error {
 		return e.executeAdminReloadStatistics(s)
 	case ast.AdminFlushPlanCache:
 		return e.executeAdminFlushPlanCache(s)
+	case ast.AdminEnableCardinalityFeedback:
+		statistics.CardinalityFeedback.Enable(s.SQLDigest)
+	case ast.AdminDisableCardinalityFeedback:
+		statistics.CardinalityFeedback.Disable(s.SQLDigest)
+	case ast.AdminResetCardinalityFeedback:
+		statistics.CardinalityFeedback.Reset(s.SQLDigest)
 	}
 	return nil
 }
@@ -2805,6 +2937,9 @@ func (e *SimpleExec) executeAdminFlushPlanCache(s *ast.AdminStmt) error {
 		// Record the timestamp. When other sessions want to use the plan cache,
 		// it will check the timestamp first to decide whether the plan cache should be flushed.
 		domain.GetDomain(e.Ctx()).SetExpiredTimeStamp4PC(now)
+		if instanceCache := domain.GetDomain(e.Ctx()).InstancePlanCache(); instanceCache != nil {
+			instanceCache.DeleteAll()
+		}
 	}
 	return nil
 }
@@ -1127,6 +1127,51 @@ func TestAdminCheckPartitionTableFailed(t *testing.T) {
 	}
 }
 
+func TestAlterTableCheckPartition(t *testing.T) {
+	store, domain := testkit.CreateMockStoreAndDomain(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table admin_test_p (c1 int key,c2 int,c3 int,index idx(c2)) partition by hash(c1) partitions 4")
+	tk.MustExec("insert admin_test_p (c1, c2, c3) values (0,0,0), (1,1,1),(2,2,2),(3,3,3),(4,4,4),(5,5,5)")
+	tk.MustExec("alter table admin_test_p check partition p0, p1")
+	tk.MustExec("alter table admin_test_p check partition all")
+
+	// Make the index of partition p1 miss the row 1.
+	ctx := mock.NewContext()
+	ctx.Store = store
+	tbl, err := domain.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("admin_test_p"))
+	require.NoError(t, err)
+	tblInfo := tbl.Meta()
+	indexOpr := tables.NewIndex(tblInfo.GetPartitionInfo().Definitions[1].ID, tblInfo, tblInfo.Indices[0])
+	txn, err := store.Begin()
+	require.NoError(t, err)
+	err = indexOpr.Delete(ctx.GetSessionVars().StmtCtx, txn, types.MakeDatums(1), kv.IntHandle(1))
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit(context.Background()))
+
+	for _, fastCheck := range []string{"ON", "OFF"} {
+		tk.MustExec("set tidb_enable_fast_table_check = " + fastCheck)
+		tk.MustExec("alter table admin_test_p check partition p0, p2, p3")
+		err = tk.ExecToErr("alter table admin_test_p check partition p1")
+		require.True(t, consistency.ErrAdminCheckInconsistent.Equal(err), "%v", err)
+		err = tk.ExecToErr("alter table admin_test_p check partition p0, p1")
+		require.True(t, consistency.ErrAdminCheckInconsistent.Equal(err), "%v", err)
+		err = tk.ExecToErr("alter table admin_test_p check partition all")
+		require.True(t, consistency.ErrAdminCheckInconsistent.Equal(err), "%v", err)
+	}
+
+	// Repairing the partition creates its index again from the rows.
+	tk.MustExec("alter table admin_test_p repair partition p1")
+	tk.MustExec("alter table admin_test_p check partition p1")
+	tk.MustExec("admin check table admin_test_p")
+	tk.MustQuery("select c1 from admin_test_p use index(idx) where c2 = 1").Check(testkit.Rows("1"))
+
+	tk.MustGetErrCode("alter table admin_test_p check partition p4", mysql.ErrUnknownPartition)
+	tk.MustExec("create table admin_test (c1 int key, c2 int, index idx(c2))")
+	tk.MustGetErrCode("alter table admin_test check partition p0", mysql.ErrPartitionMgmtOnNonpartitioned)
+}
+
 const dbName, tblName = "test", "admin_test"
 
 type inconsistencyTestKit struct {
@@ -309,6 +309,7 @@ func TestShow(t *testing.T) {
 		"RESTRICTED_CONNECTION_ADMIN Server Admin ",
 		"RESTRICTED_REPLICA_WRITER_ADMIN Server Admin ",
 		"RESOURCE_GROUP_ADMIN Server Admin ",
+		"XA_RECOVER_ADMIN Server Admin ",
 	))
 	require.Len(t, tk.MustQuery("show table status").Rows(), 1)
 }
@@ -303,9 +303,9 @@ func TestInsert(t *testing.T) {
 
 	tk.MustExec("create view v as select * from t")
 	err = tk.ExecToErr("insert into v values(1,2)")
-	require.EqualError(t, err, "insert into view v is not supported now")
+	require.EqualError(t, err, core.ErrViewInvalid.GenWithStackByArgs("test", "v").Error())
 	err = tk.ExecToErr("replace into v values(1,2)")
-	require.EqualError(t, err, "replace into view v is not supported now")
+	require.EqualError(t, err, core.ErrViewInvalid.GenWithStackByArgs("test", "v").Error())
 	tk.MustExec("drop view v")
 
 	tk.MustExec("create sequence seq")
@@ -0,0 +1,600 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/planner"
+	plannercore "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	driver "github.com/pingcap/tidb/types/parser_driver"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"golang.org/x/exp/slices"
+)
+
+// WithTrigger indicates the executor may execute triggers.
+type WithTrigger interface {
+	HasTriggers() bool
+}
+
+// TriggerExec executes the triggers of a table for the rows changed by the statement.
+// The statements of the trigger body are executed in the same transaction as the statement.
+type TriggerExec struct {
+	b      *executorBuilder
+	tbl    table.Table
+	dbName model.CIStr
+
+	// bodies caches the statements of the triggers by the trigger name, the trigger body is
+	// parsed once for all the rows changed by the statement.
+	bodies map[string][]*triggerStmt
+	// genExprs are the expressions of the generated columns, which are evaluated again after
+	// the BEFORE UPDATE triggers, see evalGeneratedColumns.
+	genExprs []expression.Expression
+}
+
+// triggerStmt is a statement of the trigger body. The NEW.col and OLD.col in it are replaced with
+// parameter markers when the body is parsed, only the values of the markers are set for each row.
+type triggerStmt struct {
+	stmt    ast.StmtNode
+	markers []*driver.ParamMarkerExpr
+	refs    []triggerColRef
+	// definer is the definer of the trigger, the privileges needed by stmt are checked against it.
+	definer *auth.UserIdentity
+	// newCol is set for `SET NEW.col = expr`, which changes the NEW row directly by evaluating stmt.
+	newCol *table.Column
+	expr   ast.ExprNode
+	// exprChecked is set when the privileges needed by expr, like the ones of its subqueries, are checked.
+	exprChecked bool
+	// plan is built with the plan cache enabled, so the parameters are evaluated when it's executed.
+	// It's reused for the next rows as long as the types of the parameters are the same, and the tables
+	// read without UnionScan are not changed by the transaction.
+	plan              plannercore.Plan
+	paramTps          []*types.FieldType
+	tblInfo2UnionScan map[*model.TableInfo]bool
+}
+
+// triggerColRef is the column of the NEW or OLD row referred by a parameter marker.
+type triggerColRef struct {
+	old bool
+	col *table.Column
+}
+
+func (b *executorBuilder) buildTriggerExec(tbl table.Table) *TriggerExec {
+	// The rows changed by foreign key cascade don't activate triggers, the same as MySQL.
+	if len(tbl.Meta().Triggers) == 0 || b.ctx.GetSessionVars().StmtCtx.InHandleForeignKeyTrigger {
+		return nil
+	}
+	db, ok := b.is.SchemaByTable(tbl.Meta())
+	if !ok {
+		return nil
+	}
+	return &TriggerExec{b: b, tbl: tbl, dbName: db.Name, bodies: make(map[string][]*triggerStmt)}
+}
+
+func (b *executorBuilder) buildTblID2TriggerExecs(tblID2Table map[int64]table.Table) map[int64]*TriggerExec {
+	var triggers map[int64]*TriggerExec
+	for tid, tbl := range tblID2Table {
+		if t := b.buildTriggerExec(tbl); t != nil {
+			if triggers == nil {
+				triggers = make(map[int64]*TriggerExec)
+			}
+			triggers[tid] = t
+		}
+	}
+	return triggers
+}
+
+// hasTriggers checks whether the table has triggers of the timing and event.
+func (e *TriggerExec) hasTriggers(timing model.TriggerTiming, event model.TriggerEvent) bool {
+	if e == nil {
+		return false
+	}
+	for _, trigger := range e.tbl.Meta().Triggers {
+		if trigger.Timing == timing && trigger.Event == event {
+			return true
+		}
+	}
+	return false
+}
+
+// fire executes the triggers of the timing and event, oldRow is nil for INSERT and newRow is nil for DELETE.
+// The BEFORE triggers may change newRow by `SET NEW.col = expr`.
+func (e *TriggerExec) fire(ctx context.Context, timing model.TriggerTiming, event model.TriggerEvent, oldRow, newRow []types.Datum) error {
+	if e == nil {
+		return nil
+	}
+	for _, trigger := range e.tbl.Meta().Triggers {
+		if trigger.Timing != timing || trigger.Event != event {
+			continue
+		}
+		if err := e.execTrigger(ctx, trigger, oldRow, newRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evalGeneratedColumns evaluates the generated columns of the NEW row again after the BEFORE UPDATE
+// triggers, since the columns they depend on may be changed by the triggers.
+func (e *TriggerExec) evalGeneratedColumns(newRow []types.Datum) error {
+	if !e.hasTriggers(model.TriggerTimingBefore, model.TriggerEventUpdate) {
+		return nil
+	}
+	sctx := e.b.ctx
+	cols := e.tbl.Cols()
+	if e.genExprs == nil {
+		colInfos := make([]*model.ColumnInfo, 0, len(cols))
+		for _, col := range cols {
+			colInfos = append(colInfos, col.ColumnInfo)
+		}
+		columns, names, err := expression.ColumnInfos2ColumnsAndNames(sctx, e.dbName, e.tbl.Meta().Name, colInfos, e.tbl.Meta())
+		if err != nil {
+			return err
+		}
+		schema := expression.NewSchema(columns...)
+		e.genExprs = make([]expression.Expression, len(cols))
+		for i, col := range cols {
+			if !col.IsGenerated() {
+				continue
+			}
+			if e.genExprs[i], err = expression.RewriteSimpleExprWithNames(sctx, col.GeneratedExpr, schema, names); err != nil {
+				return err
+			}
+		}
+	}
+	// The generated columns only refer to the columns before them.
+	row := chunk.MutRowFromDatums(newRow[:len(cols)])
+	for i, expr := range e.genExprs {
+		if expr == nil {
+			continue
+		}
+		val, err := expr.Eval(row.ToRow())
+		if err != nil {
+			return err
+		}
+		if newRow[i], err = table.CastValue(sctx, val, cols[i].ColumnInfo, false, false); err != nil {
+			return err
+		}
+		row.SetDatum(i, newRow[i])
+	}
+	return nil
+}
+
+func (e *TriggerExec) execTrigger(ctx context.Context, trigger *model.TriggerInfo, oldRow, newRow []types.Datum) error {
+	sessVars := e.b.ctx.GetSessionVars()
+	sc := sessVars.StmtCtx
+	// The unqualified table names in the trigger body refer to the tables in the schema of the trigger.
+	currentDB := sessVars.CurrentDB
+	sessVars.CurrentDB = e.dbName.O
+	sc.TriggerCtx.TableIDs = append(sc.TriggerCtx.TableIDs, e.tbl.Meta().ID)
+	defer func() {
+		sessVars.CurrentDB = currentDB
+		sc.TriggerCtx.TableIDs = sc.TriggerCtx.TableIDs[:len(sc.TriggerCtx.TableIDs)-1]
+	}()
+
+	stmts, err := e.getTriggerBody(ctx, trigger)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		if err = e.execStmt(ctx, stmt, oldRow, newRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTriggerBody gets the statements of the trigger body to be executed in order, the body is parsed
+// and preprocessed when the trigger is executed for the first time.
+func (e *TriggerExec) getTriggerBody(ctx context.Context, trigger *model.TriggerInfo) ([]*triggerStmt, error) {
+	if stmts, ok := e.bodies[trigger.Name.L]; ok {
+		return stmts, nil
+	}
+	sql := fmt.Sprintf("CREATE TRIGGER `t` %s %s ON `t` FOR EACH ROW %s", trigger.Timing, trigger.Event, trigger.Body)
+	p := parser.New()
+	p.SetSQLMode(trigger.SQLMode)
+	p.SetParserConfig(e.b.ctx.GetSessionVars().BuildParserConfig())
+	node, err := p.ParseOneStmt(sql, trigger.Charset, trigger.Collate)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var stmts []*triggerStmt
+	for _, stmt := range flattenTriggerBody(node.(*ast.CreateTriggerStmt).Body, nil) {
+		set, ok := stmt.(*ast.SetStmt)
+		if !ok {
+			ts, err := e.newTriggerStmt(ctx, trigger, stmt, nil)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, ts)
+			continue
+		}
+		// The assignments are executed one by one, `SET NEW.col = expr` changes the NEW row directly.
+		for _, v := range set.Variables {
+			var newCol *table.Column
+			if row, name, ok := strings.Cut(strings.ToLower(v.Name), "."); v.IsSystem && !v.IsGlobal && ok && row == "new" {
+				if newCol = table.FindColLowerCase(e.tbl.Cols(), name); newCol == nil {
+					return nil, dbterror.ErrBadField.GenWithStackByArgs(name, "NEW")
+				}
+			}
+			ts, err := e.newTriggerStmt(ctx, trigger, &ast.SetStmt{Variables: []*ast.VariableAssignment{v}}, newCol)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, ts)
+		}
+	}
+	e.bodies[trigger.Name.L] = stmts
+	return stmts, nil
+}
+
+func flattenTriggerBody(stmt ast.StmtNode, stmts []ast.StmtNode) []ast.StmtNode {
+	block, ok := stmt.(*ast.ProcedureBlock)
+	if !ok {
+		return append(stmts, stmt)
+	}
+	for _, s := range block.ProcedureProcStmts {
+		stmts = flattenTriggerBody(s, stmts)
+	}
+	return stmts
+}
+
+// newTriggerStmt replaces the NEW.col and OLD.col in the statement with parameter markers and preprocesses it.
+func (e *TriggerExec) newTriggerStmt(ctx context.Context, trigger *model.TriggerInfo, stmt ast.StmtNode, newCol *table.Column) (*triggerStmt, error) {
+	binder := &triggerParamBinder{cols: e.tbl.Cols()}
+	stmt.Accept(binder)
+	if binder.err != nil {
+		return nil, binder.err
+	}
+	ts := &triggerStmt{stmt: stmt, markers: binder.markers, refs: binder.refs, definer: trigger.Definer, newCol: newCol}
+	if newCol != nil {
+		ts.expr = stmt.(*ast.SetStmt).Variables[0].Value
+		return ts, nil
+	}
+	if err := plannercore.Preprocess(ctx, e.b.ctx, stmt, plannercore.InPrepare); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// execStmt executes a statement of the trigger body with the values of the row. The changes of the statement are
+// flushed into the txn mem-buffer first, since `UnionScanExec` reads the txn mem-buffer by snapshot, the trigger
+// statement can't see the changes otherwise.
+func (e *TriggerExec) execStmt(ctx context.Context, ts *triggerStmt, oldRow, newRow []types.Datum) error {
+	sctx := e.b.ctx
+	sessVars := sctx.GetSessionVars()
+	params := variable.NewPlanCacheParamList()
+	for i, ref := range ts.refs {
+		row, rowName := newRow, "NEW"
+		if ref.old {
+			row, rowName = oldRow, "OLD"
+		}
+		if row == nil {
+			return dbterror.ErrBadField.GenWithStackByArgs(ref.col.Name.O, rowName)
+		}
+		ts.markers[i].Datum = row[ref.col.Offset]
+		ts.markers[i].InExecute = true
+		params.Append(row[ref.col.Offset])
+	}
+	// The parameters are read from the session when the plan is executed, the parameters of the statement
+	// activating the trigger are restored after the trigger statement is executed.
+	stmtParams := sessVars.PlanCacheParams
+	sessVars.PlanCacheParams = params
+	defer func() {
+		sessVars.PlanCacheParams = stmtParams
+	}()
+
+	if ts.newCol != nil {
+		if newRow == nil {
+			return dbterror.ErrBadField.GenWithStackByArgs(ts.newCol.Name.O, "NEW")
+		}
+		if !ts.exprChecked {
+			sel := &ast.SelectStmt{
+				SelectStmtOpts: &ast.SelectStmtOpts{},
+				Fields:         &ast.FieldList{Fields: []*ast.SelectField{{Expr: ts.expr}}},
+			}
+			if _, err := planner.OptimizeWithDefiner(ctx, sctx, sel, e.b.is, ts.definer); err != nil {
+				return err
+			}
+			ts.exprChecked = true
+		}
+		val, err := expression.EvalAstExpr(sctx, ts.expr)
+		if err != nil {
+			return err
+		}
+		casted, err := table.CastValue(sctx, val, ts.newCol.ColumnInfo, false, false)
+		if err != nil {
+			return err
+		}
+		newRow[ts.newCol.Offset] = casted
+		return nil
+	}
+
+	p, err := e.planStmt(ctx, ts)
+	if err != nil {
+		return err
+	}
+	if err = e.checkUpdatedTables(p); err != nil {
+		return err
+	}
+	if err = flushStmtChangesForTrigger(ctx, sctx); err != nil {
+		return err
+	}
+	ex := e.b.build(p)
+	if e.b.err != nil {
+		return e.b.err
+	}
+	fkExec, hasFK := ex.(WithForeignKeyTrigger)
+	if hasFK && fkExec.HasFKCascades() {
+		return dbterror.ErrNotSupportedYet.GenWithStackByArgs("foreign key cascade in trigger")
+	}
+	if err = ex.Open(ctx); err != nil {
+		terror.Call(ex.Close)
+		return err
+	}
+	if err = Next(ctx, ex, newFirstChunk(ex)); err != nil {
+		terror.Call(ex.Close)
+		return err
+	}
+	if err = ex.Close(); err != nil {
+		return err
+	}
+	if hasFK {
+		for _, fkCheck := range fkExec.GetFKChecks() {
+			if err = fkCheck.doCheck(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// planStmt returns the plan of the trigger statement. The cached plan is reused if the types of the parameters
+// are the same as the ones it's built with, only its ranges are rebuilt with the new parameters, like the plan
+// cache does. Otherwise the plan is built again from the statement, and the privileges it needs are checked
+// against the definer of the trigger.
+func (e *TriggerExec) planStmt(ctx context.Context, ts *triggerStmt) (plannercore.Plan, error) {
+	sctx := e.b.ctx
+	sc := sctx.GetSessionVars().StmtCtx
+	useCache, cacheType, tblInfo2UnionScan := sc.UseCache, sc.CacheType, sc.TblInfo2UnionScan
+	defer func() {
+		sc.UseCache, sc.CacheType, sc.TblInfo2UnionScan = useCache, cacheType, tblInfo2UnionScan
+	}()
+	sc.UseCache, sc.CacheType = true, stmtctx.SessionNonPrepared
+
+	tps := make([]*types.FieldType, 0, len(ts.markers))
+	for _, marker := range ts.markers {
+		tp := types.NewFieldType(mysql.TypeUnspecified)
+		types.InferParamTypeFromDatum(&marker.Datum, tp)
+		tps = append(tps, tp)
+	}
+	if ts.plan != nil && slices.EqualFunc(ts.paramTps, tps, func(a, b *types.FieldType) bool { return a.Equal(b) }) &&
+		!hasNewDirtyTables(sctx, ts.tblInfo2UnionScan) && plannercore.RebuildPlan4CachedPlan(ts.plan) {
+		return ts.plan, nil
+	}
+	sc.UseCache = true
+	sc.TblInfo2UnionScan = make(map[*model.TableInfo]bool)
+	p, err := planner.OptimizeWithDefiner(ctx, sctx, ts.stmt, e.b.is, ts.definer)
+	if err != nil {
+		return nil, err
+	}
+	ts.plan, ts.paramTps, ts.tblInfo2UnionScan = nil, nil, nil
+	// The plan can't be reused if some optimizations depending on the parameters are applied.
+	if sc.UseCache {
+		ts.plan, ts.paramTps, ts.tblInfo2UnionScan = p, tps, sc.TblInfo2UnionScan
+	}
+	return p, nil
+}
+
+// hasNewDirtyTables checks whether the tables read by a plan without UnionScan are changed by the transaction.
+func hasNewDirtyTables(sctx sessionctx.Context, tblInfo2UnionScan map[*model.TableInfo]bool) bool {
+	for tblInfo, unionScan := range tblInfo2UnionScan {
+		if unionScan {
+			continue
+		}
+		if sctx.HasDirtyContent(tblInfo.ID) {
+			return true
+		}
+		if pi := tblInfo.GetPartitionInfo(); pi != nil {
+			for _, def := range pi.Definitions {
+				if sctx.HasDirtyContent(def.ID) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// checkUpdatedTables checks the trigger statement doesn't change the tables whose triggers are executing.
+func (e *TriggerExec) checkUpdatedTables(p plannercore.Plan) error {
+	var tblIDs []int64
+	switch x := p.(type) {
+	case *plannercore.Insert:
+		tblIDs = append(tblIDs, x.Table.Meta().ID)
+	case *plannercore.Update:
+		for _, info := range x.TblColPosInfos {
+			tblIDs = append(tblIDs, info.TblID)
+		}
+	case *plannercore.Delete:
+		for _, info := range x.TblColPosInfos {
+			tblIDs = append(tblIDs, info.TblID)
+		}
+	}
+	for _, id := range tblIDs {
+		for _, usedID := range e.b.ctx.GetSessionVars().StmtCtx.TriggerCtx.TableIDs {
+			if id != usedID {
+				continue
+			}
+			name := e.tbl.Meta().Name.O
+			if tbl, ok := e.b.is.TableByID(id); ok {
+				name = tbl.Meta().Name.O
+			}
+			return exeerrors.ErrCantUpdateUsedTableInSfOrTrg.GenWithStackByArgs(name)
+		}
+	}
+	return nil
+}
+
+// flushStmtChangesForTrigger flushes the changes of the statement into the txn mem-buffer. In pessimistic
+// transaction, the keys need to be locked are locked before flushing, since the statement only locks the keys
+// of the current staging buffer.
+func flushStmtChangesForTrigger(ctx context.Context, sctx sessionctx.Context) error {
+	txn, err := sctx.Txn(false)
+	if err != nil {
+		return err
+	}
+	sessVars := sctx.GetSessionVars()
+	if ptxn, ok := txn.(pessimisticTxn); ok && txn.Valid() && sessVars.TxnCtx.IsPessimistic {
+		keys, err := ptxn.KeysNeedToLock()
+		if err != nil {
+			return err
+		}
+		keys = sessVars.TxnCtx.CollectUnchangedKeysForLock(keys)
+		keys = filterTemporaryTableKeys(sessVars, keys)
+		keys = filterLockTableKeys(sessVars.StmtCtx, keys)
+		if len(keys) > 0 {
+			lockCtx, err := newLockCtx(sctx, sessVars.LockWaitTimeout, len(keys))
+			if err != nil {
+				return err
+			}
+			if err = txn.LockKeys(ctx, lockCtx, keys...); err != nil {
+				return err
+			}
+		}
+	}
+	sctx.StmtCommit(ctx)
+	return nil
+}
+
+// triggerParamBinder replaces the NEW.col and OLD.col in the trigger statement with parameter markers.
+type triggerParamBinder struct {
+	cols    []*table.Column
+	markers []*driver.ParamMarkerExpr
+	refs    []triggerColRef
+	err     error
+}
+
+// Enter implements ast.Visitor interface.
+func (*triggerParamBinder) Enter(in ast.Node) (ast.Node, bool) {
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (v *triggerParamBinder) Leave(in ast.Node) (ast.Node, bool) {
+	x, ok := in.(*ast.ColumnNameExpr)
+	if !ok || x.Name.Schema.L != "" || (x.Name.Table.L != "new" && x.Name.Table.L != "old") {
+		return in, true
+	}
+	col := table.FindColLowerCase(v.cols, x.Name.Name.L)
+	if col == nil {
+		v.err = dbterror.ErrBadField.GenWithStackByArgs(x.Name.Name.O, x.Name.Table.O)
+		return in, false
+	}
+	marker := ast.NewParamMarkerExpr(len(v.markers)).(*driver.ParamMarkerExpr)
+	marker.SetOrder(len(v.markers))
+	v.markers = append(v.markers, marker)
+	v.refs = append(v.refs, triggerColRef{old: x.Name.Table.L == "old", col: col})
+	return marker, true
+}
+
+// sqlModeString formats the sql mode as the value of sql_mode variable, the modes are ordered by the mode value.
+func sqlModeString(mode mysql.SQLMode) string {
+	names := make([]string, 0, 8)
+	for name, m := range mysql.Str2SQLMode {
+		if mode&m != 0 {
+			names = append(names, name)
+		}
+	}
+	slices.SortFunc(names, func(i, j string) bool {
+		return mysql.Str2SQLMode[i] < mysql.Str2SQLMode[j]
+	})
+	return strings.Join(names, ",")
+}
+
+// prepareTriggerContext records a transaction savepoint when the ExecStmt may execute triggers, the savepoint is
+// used to rollback the changes flushed by the triggers when the statement failed.
+func (a *ExecStmt) prepareTriggerContext(e exec.Executor) {
+	exec, ok := e.(WithTrigger)
+	if !ok || !exec.HasTriggers() {
+		return
+	}
+	sessVars := a.Ctx.GetSessionVars()
+	sessVars.StmtCtx.TriggerCtx.HasTriggers = true
+	txn, err := a.Ctx.Txn(false)
+	if err != nil || !txn.Valid() {
+		return
+	}
+	savepointName := fmt.Sprintf("trigger_sp_%d", txn.StartTS())
+	sessVars.TxnCtx.AddSavepoint(savepointName, txn.GetMemDBCheckpoint())
+	sessVars.StmtCtx.TriggerCtx.SavepointName = savepointName
+}
+
+// rollbackTriggerChanges rollbacks the changes of the statement to the savepoint recorded by prepareTriggerContext.
+func (a *ExecStmt) rollbackTriggerChanges() error {
+	sc := a.Ctx.GetSessionVars().StmtCtx
+	if sc.TriggerCtx.SavepointName == "" {
+		return nil
+	}
+	txn, err := a.Ctx.Txn(false)
+	if err != nil || !txn.Valid() {
+		return err
+	}
+	savepointRecord := a.Ctx.GetSessionVars().TxnCtx.RollbackToSavepoint(sc.TriggerCtx.SavepointName)
+	if savepointRecord == nil {
+		// Normally should never run into here, but just in case, rollback the transaction.
+		if err = txn.Rollback(); err != nil {
+			return err
+		}
+		return errors.Errorf("trigger savepoint '%s' not found, transaction is rollback, should never happen", sc.TriggerCtx.SavepointName)
+	}
+	txn.RollbackMemDBToCheckpoint(savepointRecord.MemDBCheckpoint)
+	return nil
+}
+
+// finishTriggerContext releases the savepoint recorded by prepareTriggerContext, the changes of the statement are
+// rollback to the savepoint if the statement failed.
+func (a *ExecStmt) finishTriggerContext(err error) error {
+	sc := a.Ctx.GetSessionVars().StmtCtx
+	if sc.TriggerCtx.SavepointName == "" {
+		return err
+	}
+	if err != nil {
+		if err1 := a.rollbackTriggerChanges(); err1 != nil {
+			return errors.Errorf("rollback trigger changes failed, err: %v, original_err: %v", err1, err)
+		}
+	}
+	a.Ctx.GetSessionVars().TxnCtx.ReleaseSavepoint(sc.TriggerCtx.SavepointName)
+	sc.TriggerCtx.SavepointName = ""
+	return err
+}
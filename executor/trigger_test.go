@@ -0,0 +1,225 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndDropTrigger(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("create table log (a int)")
+	tk.MustExec("create view v as select * from t")
+
+	tk.MustExec("create trigger tr1 before insert on t for each row set new.b = new.a * 2")
+	tk.MustGetErrCode("create trigger tr1 after insert on t for each row insert into log values (new.a)", errno.ErrTrgAlreadyExists)
+	tk.MustExec("create trigger if not exists tr1 after insert on t for each row insert into log values (new.a)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1359 Trigger already exists"))
+	tk.MustGetErrCode("create trigger tr2 before insert on v for each row set new.b = 1", errno.ErrTrgOnViewOrTempTable)
+	tk.MustGetErrCode("create trigger tr2 after insert on t for each row set new.b = 1", errno.ErrTrgCantChangeRow)
+	tk.MustGetErrCode("create trigger tr2 before update on t for each row set old.b = 1", errno.ErrTrgCantChangeRow)
+	tk.MustGetErrCode("create trigger tr2 before delete on t for each row insert into log values (new.a)", errno.ErrTrgNoSuchRowInTrg)
+	tk.MustGetErrCode("create trigger tr2 before insert on t for each row insert into log values (old.a)", errno.ErrTrgNoSuchRowInTrg)
+	tk.MustGetErrCode("create trigger tr2 before insert on t for each row set new.c = 1", errno.ErrBadField)
+	tk.MustGetErrCode("create trigger tr2 before insert on t for each row select 1", errno.ErrSpNoRetset)
+	tk.MustGetErrCode("create trigger tr2 before insert on t for each row precedes tr3 set new.b = 1", errno.ErrTrgDoesNotExist)
+	tk.MustGetErrCode("create trigger mysql.tr2 before insert on test.t for each row set new.b = 1", errno.ErrTrgInWrongSchema)
+
+	tk.MustExec("create trigger tr2 after insert on t for each row insert into log values (new.a)")
+	tk.MustExec("create trigger tr3 before insert on t for each row precedes tr1 set new.b = 100")
+	tk.MustQuery("select trigger_name, event_manipulation, event_object_table, action_order, action_statement, action_timing " +
+		"from information_schema.triggers where trigger_schema = 'test' order by action_timing, action_order").Check(testkit.Rows(
+		"tr2 INSERT t 1 insert into log values (new.a) AFTER",
+		"tr3 INSERT t 1 set new.b = 100 BEFORE",
+		"tr1 INSERT t 2 set new.b = new.a * 2 BEFORE",
+	))
+	tk.MustQuery("show triggers like 't'").CheckAt([]int{0, 1, 2, 3, 4}, testkit.RowsWithSep("|",
+		"tr3|INSERT|t|set new.b = 100|BEFORE",
+		"tr1|INSERT|t|set new.b = new.a * 2|BEFORE",
+		"tr2|INSERT|t|insert into log values (new.a)|AFTER",
+	))
+	tk.MustQuery("show triggers like 'log'").Check(testkit.Rows())
+
+	tk.MustExec("drop trigger tr3")
+	tk.MustGetErrCode("drop trigger tr3", errno.ErrTrgDoesNotExist)
+	tk.MustExec("drop trigger if exists tr3")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1360 Trigger does not exist"))
+	tk.MustExec("drop trigger test.tr1")
+	tk.MustQuery("select trigger_name from information_schema.triggers where trigger_schema = 'test'").Check(testkit.Rows("tr2"))
+	tk.MustExec("drop table t")
+	tk.MustQuery("select trigger_name from information_schema.triggers where trigger_schema = 'test'").Check(testkit.Rows())
+}
+
+func TestTriggerOnInsertUpdateDelete(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int, b int)")
+	tk.MustExec("create table log (id int auto_increment primary key, msg varchar(100))")
+	tk.MustExec("create table total (n int)")
+	tk.MustExec("insert into total values (0)")
+
+	tk.MustExec("create trigger t_bi before insert on t for each row set new.b = new.a * 10")
+	tk.MustExec(`create trigger t_ai after insert on t for each row begin
+		insert into log(msg) values (concat('insert ', new.id, ' ', new.b));
+		update total set n = n + new.a;
+	end`)
+	tk.MustExec("create trigger t_bu before update on t for each row set new.b = old.b + new.a")
+	tk.MustExec("create trigger t_au after update on t for each row insert into log(msg) values (concat('update ', old.a, '->', new.a))")
+	tk.MustExec("create trigger t_bd before delete on t for each row update total set n = n - old.a")
+	tk.MustExec("create trigger t_ad after delete on t for each row insert into log(msg) values (concat('delete ', old.id))")
+
+	tk.MustExec("insert into t(id, a) values (1, 1), (2, 2)")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 1 10", "2 2 20"))
+	tk.MustQuery("select n from total").Check(testkit.Rows("3"))
+
+	tk.MustExec("update t set a = a + 1 where id = 1")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 2 12", "2 2 20"))
+	tk.MustExec("insert into t(id, a) values (2, 5) on duplicate key update a = 3")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 2 12", "2 3 23"))
+
+	tk.MustExec("delete from t where id = 1")
+	tk.MustQuery("select n from total").Check(testkit.Rows("1"))
+	tk.MustExec("replace into t(id, a) values (2, 4)")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("2 4 40"))
+	tk.MustQuery("select n from total").Check(testkit.Rows("2"))
+	tk.MustQuery("select msg from log order by id").Check(testkit.Rows(
+		"insert 1 10",
+		"insert 2 20",
+		"update 1->2",
+		"update 2->3",
+		"delete 1",
+		"delete 2",
+		"insert 2 40",
+	))
+
+	// The rows changed by the triggers are not counted in the affected rows.
+	tk.MustExec("insert into t(id, a) values (3, 1)")
+	require.Equal(t, uint64(1), tk.Session().AffectedRows())
+}
+
+func TestTriggerInTransaction(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int)")
+	tk.MustExec("create table log (id int primary key)")
+	tk.MustExec("create trigger t_ai after insert on t for each row insert into log values (new.id)")
+
+	for _, mode := range []string{"pessimistic", "optimistic"} {
+		tk.MustExec("delete from t")
+		tk.MustExec("delete from log")
+		tk.MustExec("begin " + mode)
+		tk.MustExec("insert into t values (1, 1)")
+		tk.MustQuery("select * from log").Check(testkit.Rows("1"))
+		// The statement fails after the trigger of the first row is executed, all the changes of the statement are
+		// rollback, including the changes of the trigger.
+		tk.MustGetErrCode("insert into t values (2, 2), (1, 1)", errno.ErrDupEntry)
+		tk.MustQuery("select * from t").Check(testkit.Rows("1 1"))
+		tk.MustQuery("select * from log").Check(testkit.Rows("1"))
+		tk.MustExec("insert into t values (3, 3)")
+		tk.MustExec("rollback")
+		tk.MustQuery("select * from t").Check(testkit.Rows())
+		tk.MustQuery("select * from log").Check(testkit.Rows())
+
+		tk.MustExec("begin " + mode)
+		tk.MustExec("insert into t values (1, 1), (2, 2)")
+		tk.MustExec("commit")
+		tk.MustQuery("select * from log order by id").Check(testkit.Rows("1", "2"))
+	}
+
+	// The trigger can't change the table used by the statement which activates it.
+	tk.MustExec("create trigger log_ai after insert on log for each row update t set a = a + 1")
+	tk.MustGetErrCode("insert into t values (4, 4)", errno.ErrCantUpdateUsedTableInSfOrTrg)
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 1", "2 2"))
+	tk.MustQuery("select * from log order by id").Check(testkit.Rows("1", "2"))
+}
+
+func TestTriggerWithGeneratedAndNotNullColumns(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int not null, b int as (a * 2) stored, c int as (b + 1) virtual, index ib (b))")
+	tk.MustExec("create trigger t_bi before insert on t for each row set new.a = if(new.a < 0, null, new.a)")
+	tk.MustExec("create trigger t_bu before update on t for each row set new.a = new.a + 100")
+
+	tk.MustExec("insert into t(id, a) values (1, 1), (2, 2)")
+	tk.MustGetErrCode("insert into t(id, a) values (3, -1)", errno.ErrBadNull)
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 1 2 3", "2 2 4 5"))
+
+	// The generated columns and their indexes are computed with the NEW row set by the trigger.
+	tk.MustExec("update t set a = a + 1")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 102 204 205", "2 103 206 207"))
+	tk.MustQuery("select id from t use index (ib) where b = 206").Check(testkit.Rows("2"))
+	tk.MustExec("admin check table t")
+
+	// The trigger statements are planned once and executed with the values of every row.
+	tk.MustExec("create table s (id int primary key, a int)")
+	tk.MustExec("create table log (id int primary key, n int)")
+	tk.MustExec("insert into s values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("insert into log values (1, 0), (2, 0), (3, 0)")
+	tk.MustExec("create trigger s_au after update on s for each row update log set n = n + new.a - old.a where id = new.id")
+	tk.MustExec("update s set a = a + id * 10")
+	tk.MustQuery("select * from log order by id").Check(testkit.Rows("1 10", "2 20", "3 30"))
+	tk.MustExec("update s set a = if(id = 2, null, a + 1)")
+	tk.MustQuery("select * from log order by id").Check(testkit.Rows("1 11", "2 <nil>", "3 31"))
+}
+
+func TestTriggerDefinerPrivileges(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create table secret (a int)")
+	tk.MustExec("create table log (a int)")
+	tk.MustExec("insert into secret values (42)")
+	tk.MustExec("create user 'u1'@'%', 'u2'@'%'")
+	tk.MustExec("grant insert on test.log to 'u1'@'%'")
+	tk.MustExec("grant insert on test.t to 'u2'@'%'")
+
+	// The trigger body is checked against the privileges of the definer, not of the user activating it.
+	tk.MustExec("create definer = 'u1'@'%' trigger tr after insert on t for each row insert into log select a from secret")
+	tk.MustGetErrCode("insert into t values (1)", errno.ErrTableaccessDenied)
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*) from log").Check(testkit.Rows("0"))
+	tk.MustExec("grant select on test.secret to 'u1'@'%'")
+	tk.MustExec("insert into t values (1)")
+	tk.MustQuery("select a from log").Check(testkit.Rows("42"))
+
+	tk2 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk2.Session().Auth(&auth.UserIdentity{Username: "u2", Hostname: "%"}, nil, nil, nil))
+	tk2.MustExec("insert into test.t values (2)")
+	tk.MustQuery("select a from log").Check(testkit.Rows("42", "42"))
+	tk.MustExec("revoke insert on test.log from 'u1'@'%'")
+	tk2.MustGetErrCode("insert into test.t values (3)", errno.ErrTableaccessDenied)
+	tk.MustQuery("select a from t").Sort().Check(testkit.Rows("1", "2"))
+
+	// So are the subqueries of `SET NEW.col = expr`.
+	tk.MustExec("create table t2 (a int, b int)")
+	tk.MustExec("create definer = 'u2'@'%' trigger tr2 before insert on t2 for each row set new.b = (select a from secret)")
+	tk.MustGetErrCode("insert into t2 values (1, 0)", errno.ErrTableaccessDenied)
+	tk.MustExec("grant select on test.secret to 'u2'@'%'")
+	tk.MustExec("insert into t2 values (1, 0)")
+	tk.MustQuery("select * from t2").Check(testkit.Rows("1 42"))
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDMLOnUpdatableView(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int, b varchar(10) default 'x')")
+	tk.MustExec("create view v (k, x, y) as select id, a, b from t where a > 0")
+	tk.MustExec("create view v2 as select k, x * 10 as x10, y from v")
+
+	tk.MustExec("insert into v values (1, 1, 'a'), (2, 2, 'b')")
+	tk.MustExec("insert into v (x, k) values (3, 3)")
+	tk.MustExec("insert into v set k = 4, x = -4")
+	tk.MustExec("insert into v (k, x) values (1, 10) on duplicate key update y = concat(values(y), y), x = values(x)")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 10 xa", "2 2 b", "3 3 x", "4 -4 x"))
+	tk.MustQuery("select * from v order by k").Check(testkit.Rows("1 10 xa", "2 2 b", "3 3 x"))
+
+	// The rows invisible through the view aren't updated or deleted.
+	tk.MustExec("update v set y = 'u' where k > 2")
+	tk.CheckExecResult(1, 0)
+	tk.MustExec("update v2 as w set y = concat(w.y, x10) order by k desc limit 1")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 10 xa", "2 2 b", "3 3 u30", "4 -4 x"))
+	tk.MustExec("delete from v2 where x10 in (select 20 union select 40)")
+	tk.CheckExecResult(1, 0)
+	tk.MustExec("delete from v")
+	tk.CheckExecResult(2, 0)
+	tk.MustQuery("select * from t").Check(testkit.Rows("4 -4 x"))
+
+	// The expression columns can't be written.
+	tk.MustGetErrCode("insert into v2 values (5, 50, 'e')", errno.ErrNonInsertableTable)
+	tk.MustGetErrCode("update v2 set x10 = 1", errno.ErrNonupdateableColumn)
+	tk.MustGetErrCode("update v set z = 1", errno.ErrBadField)
+
+	// The views with aggregation, DISTINCT, UNION, join or subquery aren't updatable.
+	tk.MustExec("create table t1 (id int)")
+	for _, def := range []string{
+		"select count(*) as c from t",
+		"select distinct a from t",
+		"select a from t union select id from t1",
+		"select t.a from t join t1 on t.id = t1.id",
+		"select a from t where id in (select id from t1)",
+		"select a from (select a from t) s",
+	} {
+		tk.MustExec("create or replace view nv as " + def)
+		tk.MustGetErrCode("insert into nv values (1)", errno.ErrNonInsertableTable)
+		tk.MustGetErrCode("update nv set a = 1", errno.ErrNonUpdatableTable)
+		tk.MustGetErrCode("delete from nv", errno.ErrNonUpdatableTable)
+	}
+	tk.MustExec("create algorithm = temptable view tv as select * from t")
+	tk.MustGetErrCode("delete from tv", errno.ErrNonUpdatableTable)
+	tk.MustQuery("select table_name, is_updatable from information_schema.views where table_schema = 'test' order by table_name").
+		Check(testkit.Rows("nv NO", "tv NO", "v YES", "v2 YES"))
+
+	// The prepared statements are rebuilt on the base table at each execution.
+	tk.MustExec("prepare stmt from 'insert into v (k, x) values (?, ?)'")
+	tk.MustExec("set @k = 5, @x = 5")
+	tk.MustExec("execute stmt using @k, @x")
+	tk.MustExec("set @k = 6, @x = 6")
+	tk.MustExec("execute stmt using @k, @x")
+	tk.MustExec("prepare stmt from 'update v set x = x + ? where k = ?'")
+	tk.MustExec("execute stmt using @x, @k")
+	tk.MustQuery("select id, a from t order by id").Check(testkit.Rows("4 -4", "5 5", "6 12"))
+}
+
+func TestUpdatableViewCheckOption(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("create view v1 as select * from t where a < 10 with check option")
+	tk.MustExec("create view v2 as select * from v1 where a > 0 with local check option")
+	tk.MustExec("create view v3 as select * from t where a < 10")
+	tk.MustExec("create view v4 as select * from v3 where a > 0 with local check option")
+	tk.MustExec("create view v5 as select * from v3 where a > 0 with cascaded check option")
+
+	tk.MustGetErrCode("insert into v1 values (10, 1)", errno.ErrViewCheckFailed)
+	tk.MustGetErrMsg("insert into v2 values (10, 1)", "[executor:1369]CHECK OPTION failed 'test.v2'")
+	tk.MustGetErrCode("insert into v2 values (0, 1)", errno.ErrViewCheckFailed)
+	tk.MustExec("insert into v4 values (10, 1)")
+	tk.MustGetErrCode("insert into v4 values (0, 1)", errno.ErrViewCheckFailed)
+	tk.MustGetErrCode("insert into v5 values (11, 1)", errno.ErrViewCheckFailed)
+	tk.MustExec("insert into v5 values (1, 1), (2, 2)")
+
+	tk.MustGetErrCode("update v2 set a = a + 10 where a = 1", errno.ErrViewCheckFailed)
+	tk.MustExec("update v2 set b = b + 10 where a = 1")
+	tk.MustExec("update ignore v5 set a = a * 10")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1369 CHECK OPTION failed 'test.v5'", "Warning 1369 CHECK OPTION failed 'test.v5'"))
+	tk.MustExec("insert ignore into v1 values (3, 3), (30, 30)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1369 CHECK OPTION failed 'test.v1'"))
+	tk.MustGetErrCode("insert into v1 values (1, 1) on duplicate key update a = 20", errno.ErrViewCheckFailed)
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("1 11", "2 2", "3 3", "10 1"))
+
+	tk.MustGetErrCode("create view v6 as select distinct a from t with check option", errno.ErrViewNonupdCheck)
+	tk.MustGetErrCode("create algorithm = temptable view v6 as select * from t with check option", errno.ErrViewNonupdCheck)
+	tk.MustQuery("show create view v2").CheckAt([]int{1}, testkit.RowsWithSep("|",
+		"CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`%` SQL SECURITY DEFINER VIEW `v2` (`a`, `b`) AS SELECT `test`.`v1`.`a` AS `a`,`test`.`v1`.`b` AS `b` FROM `test`.`v1` WHERE `a`>0 WITH LOCAL CHECK OPTION"))
+	tk.MustQuery("select table_name, check_option from information_schema.views where table_schema = 'test' order by table_name").
+		Check(testkit.Rows("v1 CASCADED", "v2 LOCAL", "v3 NONE", "v4 LOCAL", "v5 CASCADED"))
+}
+
+func TestUpdatableViewPrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("create view v as select * from t")
+	tk.MustExec("create sql security invoker view iv as select * from t")
+	tk.MustExec("create user u1")
+	tk.MustExec("grant select, insert on test.v to u1")
+	tk.MustExec("grant select, insert on test.iv to u1")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	// The base table of a definer view is written with the privileges of the definer.
+	tk1.MustExec("insert into v values (1, 1)")
+	tk1.MustGetErrCode("update v set b = 2", errno.ErrTableaccessDenied)
+	tk1.MustGetErrCode("insert into iv values (2, 2)", errno.ErrTableaccessDenied)
+	tk1.MustGetErrCode("insert into v select * from t", errno.ErrTableaccessDenied)
+	tk.MustExec("grant update on test.v to u1")
+	tk1.MustExec("update v set b = 2")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 2"))
+}
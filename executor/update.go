@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/memory"
 	"github.com/tikv/client-go/v2/txnkv/txnsnapshot"
@@ -71,6 +72,10 @@ type UpdateExec struct {
 	fkChecks map[int64][]*FKCheckExec
 	// fkCascades contains the foreign key cascade. the map is tableID -> []*FKCascadeExec
 	fkCascades map[int64][]*FKCascadeExec
+	// triggers contains the triggers. the map is tableID -> *TriggerExec
+	triggers map[int64]*TriggerExec
+	// viewChecks contains the check options of the view updated through. the map is tableID -> []*ViewCheck
+	viewChecks map[int64][]*plannercore.ViewCheck
 }
 
 // prepare `handles`, `tableUpdatable`, `changed` to avoid re-computations.
@@ -198,7 +203,9 @@ func (e *UpdateExec) exec(ctx context.Context, _ *expression.Schema, row, newDat
 		// Update row
 		fkChecks := e.fkChecks[content.TblID]
 		fkCascades := e.fkCascades[content.TblID]
-		changed, err1 := updateRecord(ctx, e.Ctx(), handle, oldData, newTableData, flags, tbl, false, e.memTracker, fkChecks, fkCascades)
+		triggers := e.triggers[content.TblID]
+		viewChecks := e.viewChecks[content.TblID]
+		changed, err1 := updateRecord(ctx, e.Ctx(), handle, oldData, newTableData, flags, tbl, false, e.memTracker, fkChecks, fkCascades, triggers, viewChecks)
 		if err1 == nil {
 			_, exist := e.updatedRowKeys[content.Start].Get(handle)
 			memDelta := e.updatedRowKeys[content.Start].Set(handle, changed)
@@ -210,7 +217,8 @@ func (e *UpdateExec) exec(ctx context.Context, _ *expression.Schema, row, newDat
 		}
 
 		sc := e.Ctx().GetSessionVars().StmtCtx
-		if (kv.ErrKeyExists.Equal(err1) || table.ErrCheckConstraintViolated.Equal(err1)) && sc.DupKeyAsWarning {
+		if (kv.ErrKeyExists.Equal(err1) || table.ErrCheckConstraintViolated.Equal(err1) || exeerrors.ErrViewCheckFailed.Equal(err1)) &&
+			sc.DupKeyAsWarning {
 			sc.AppendWarning(err1)
 			continue
 		}
@@ -561,3 +569,8 @@ func (e *UpdateExec) GetFKCascades() []*FKCascadeExec {
 func (e *UpdateExec) HasFKCascades() bool {
 	return len(e.fkCascades) > 0
 }
+
+// HasTriggers implements WithTrigger interface.
+func (e *UpdateExec) HasTriggers() bool {
+	return len(e.triggers) > 0
+}
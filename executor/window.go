@@ -241,12 +241,18 @@ type rowFrameWindowProcessor struct {
 	start          *core.FrameBound
 	end            *core.FrameBound
 	curRowIdx      uint64
+	// peerGroups is not nil for the GROUPS frames, whose offsets are counted
+	// in peer groups instead of rows.
+	peerGroups *peerGroups
 }
 
 func (p *rowFrameWindowProcessor) getStartOffset(numRows uint64) uint64 {
 	if p.start.UnBounded {
 		return 0
 	}
+	if p.peerGroups != nil {
+		return p.peerGroups.getStartOffset(p.start, numRows)
+	}
 	switch p.start.Type {
 	case ast.Preceding:
 		if p.curRowIdx >= p.start.Num {
@@ -270,6 +276,9 @@ func (p *rowFrameWindowProcessor) getEndOffset(numRows uint64) uint64 {
 	if p.end.UnBounded {
 		return numRows
 	}
+	if p.peerGroups != nil {
+		return p.peerGroups.getEndOffset(p.end, numRows)
+	}
 	switch p.end.Type {
 	case ast.Preceding:
 		if p.curRowIdx >= p.end.Num {
@@ -311,7 +320,18 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 			slidingWindowAggFuncs[i] = slidingWindowAggFunc
 		}
 	}
+	if p.peerGroups != nil {
+		err = p.peerGroups.update(ctx, func(u uint64) chunk.Row {
+			return rows[u]
+		}, numRows)
+		if err != nil {
+			return nil, err
+		}
+	}
 	for ; remained > 0; lastStart, lastEnd = start, end {
+		if p.peerGroups != nil {
+			p.peerGroups.locate(p.curRowIdx)
+		}
 		start = p.getStartOffset(numRows)
 		end = p.getEndOffset(numRows)
 		p.curRowIdx++
@@ -375,6 +395,116 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 
 func (p *rowFrameWindowProcessor) resetPartialResult() {
 	p.curRowIdx = 0
+	if p.peerGroups != nil {
+		p.peerGroups.reset()
+	}
+}
+
+// peerGroups splits the rows of a partition into peer groups for the GROUPS
+// frames. The rows in a peer group have the same values of ORDER BY columns,
+// all the rows of a partition are peers if there is no ORDER BY clause.
+type peerGroups struct {
+	orderByCols []*expression.Column
+	cmpFuncs    []expression.CompareFunc
+	// starts stores the offset of the first row of each peer group found so far.
+	starts []uint64
+	// numRows is the number of rows which have been split into peer groups.
+	numRows uint64
+	// curGroup is the index of the peer group which the current row belongs to.
+	curGroup uint64
+}
+
+func newPeerGroups(ctx sessionctx.Context, orderByCols []*expression.Column) *peerGroups {
+	cmpFuncs := make([]expression.CompareFunc, 0, len(orderByCols))
+	for _, col := range orderByCols {
+		cmpFuncs = append(cmpFuncs, expression.GetCmpFunction(ctx, col, col))
+	}
+	return &peerGroups{orderByCols: orderByCols, cmpFuncs: cmpFuncs}
+}
+
+// update splits the rows from g.numRows to numRows into peer groups. Note that
+// the last row which has been split is needed to find out whether a new peer
+// group starts.
+func (g *peerGroups) update(ctx sessionctx.Context, getRow func(uint64) chunk.Row, numRows uint64) error {
+	for ; g.numRows < numRows; g.numRows++ {
+		if g.numRows == 0 {
+			g.starts = append(g.starts, 0)
+			continue
+		}
+		prevRow, row := getRow(g.numRows-1), getRow(g.numRows)
+		for i, col := range g.orderByCols {
+			res, _, err := g.cmpFuncs[i](ctx, col, col, prevRow, row)
+			if err != nil {
+				return err
+			}
+			if res != 0 {
+				g.starts = append(g.starts, g.numRows)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// locate moves curGroup to the peer group of the row at curRowIdx, the row
+// must have been split into peer groups.
+func (g *peerGroups) locate(curRowIdx uint64) {
+	for g.curGroup+1 < uint64(len(g.starts)) && g.starts[g.curGroup+1] <= curRowIdx {
+		g.curGroup++
+	}
+}
+
+// groupStart returns the offset of the first row of the peer group which is
+// `delta` groups after the current one, numRows is returned if the group is
+// not found.
+func (g *peerGroups) groupStart(delta uint64, numRows uint64) uint64 {
+	if delta < uint64(len(g.starts))-g.curGroup {
+		return g.starts[g.curGroup+delta]
+	}
+	return numRows
+}
+
+// getStartOffset returns the start offset of the frame of the current row.
+func (g *peerGroups) getStartOffset(bound *core.FrameBound, numRows uint64) uint64 {
+	switch bound.Type {
+	case ast.Preceding:
+		if g.curGroup >= bound.Num {
+			return g.starts[g.curGroup-bound.Num]
+		}
+		return 0
+	case ast.Following:
+		return g.groupStart(bound.Num, numRows)
+	default: // ast.CurrentRow
+		return g.groupStart(0, numRows)
+	}
+}
+
+// getEndOffset returns the end offset of the frame of the current row, which is
+// exclusive.
+func (g *peerGroups) getEndOffset(bound *core.FrameBound, numRows uint64) uint64 {
+	switch bound.Type {
+	case ast.Preceding:
+		if bound.Num == 0 {
+			return g.groupStart(1, numRows)
+		}
+		if g.curGroup >= bound.Num {
+			return g.starts[g.curGroup-bound.Num+1]
+		}
+		return 0
+	case ast.Following:
+		if bound.Num >= uint64(len(g.starts))-g.curGroup {
+			return numRows
+		}
+		return g.groupStart(bound.Num+1, numRows)
+	default: // ast.CurrentRow
+		return g.groupStart(1, numRows)
+	}
+}
+
+func (g *peerGroups) reset() {
+	g.starts = g.starts[:0]
+	g.numRows = 0
+	g.curGroup = 0
 }
 
 type rangeFrameWindowProcessor struct {
@@ -217,9 +217,32 @@ func doTestWindowFunctions(tk *testkit.TestKit) {
 	tk.MustQuery("select row_number() over w, sum(b) over w from t window w as (rows between 1 preceding and 1 following)").
 		Check(testkit.Rows("1 3", "2 4", "3 5", "4 3"))
 
+	tk.MustExec("drop table if exists tn")
+	tk.MustExec("create table tn (id int, g int, v int)")
+	tk.MustExec("insert into tn values (1, 1, null), (2, 1, 10), (3, 2, null), (4, 2, 20), (5, 2, 20), (6, 3, null), (7, 4, 30)")
+	tk.MustQuery("select id, first_value(v) ignore nulls over w, last_value(v) ignore nulls over w from tn window w as (order by id rows between 1 preceding and 1 following)").
+		Check(testkit.Rows("1 10 10", "2 10 10", "3 10 20", "4 20 20", "5 20 20", "6 20 30", "7 30 30"))
+	tk.MustQuery("select id, nth_value(v, 2) ignore nulls over w, nth_value(v, 1) from last over w, nth_value(v, 2) from last ignore nulls over w from tn window w as (order by id rows between unbounded preceding and current row)").
+		Check(testkit.Rows("1 <nil> <nil> <nil>", "2 <nil> 10 <nil>", "3 <nil> <nil> <nil>", "4 20 20 10", "5 20 20 20", "6 20 <nil> 20", "7 20 30 20"))
+	tk.MustQuery("select id, lead(v) ignore nulls over w, lag(v, 2) ignore nulls over w, lag(v, 1, -1) ignore nulls over w from tn window w as (order by id)").
+		Check(testkit.Rows("1 10 <nil> -1", "2 20 <nil> -1", "3 20 <nil> 10", "4 20 <nil> 10", "5 30 10 20", "6 30 20 20", "7 <nil> 20 20"))
+	tk.MustQuery("select id, count(distinct v) over w, sum(distinct v) over w, group_concat(distinct v) over w from tn window w as (order by id rows between 2 preceding and current row)").
+		Check(testkit.Rows("1 0 <nil> <nil>", "2 1 10 10", "3 1 10 10", "4 2 30 10,20", "5 1 20 20", "6 1 20 20", "7 2 50 20,30"))
+	tk.MustQuery("select id, count(distinct g) over () from tn").Sort().
+		Check(testkit.Rows("1 4", "2 4", "3 4", "4 4", "5 4", "6 4", "7 4"))
+	groupsResult := testkit.Rows("1 3 5 <nil> 18", "2 3 5 <nil> 18", "3 15 4 3 13", "4 15 4 3 13", "5 15 4 3 13", "6 18 2 15 7", "7 13 1 18 <nil>")
+	groupsQuery := "select id, sum(id) over (order by g groups between 1 preceding and current row), " +
+		"count(*) over (order by g groups between current row and 1 following), " +
+		"sum(id) over (order by g groups between 2 preceding and 1 preceding), " +
+		"sum(id) over (order by g groups between 1 following and 2 following) from tn order by id"
+	tk.MustQuery(groupsQuery).Check(groupsResult)
+	tk.MustQuery("select id, count(*) over (groups between current row and current row) from tn").Sort().
+		Check(testkit.Rows("1 7", "2 7", "3 7", "4 7", "5 7", "6 7", "7 7"))
+
 	tk.Session().GetSessionVars().MaxChunkSize = 1
 	tk.MustQuery("select a, row_number() over (partition by a) from t").Sort().
 		Check(testkit.Rows("1 1", "1 2", "2 1", "2 2"))
+	tk.MustQuery(groupsQuery).Check(groupsResult)
 }
 
 func TestWindowFunctionsDataReference(t *testing.T) {
@@ -26,13 +26,17 @@ import (
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/meta/autoid"
 	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/terror"
+	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tidb/util/tracing"
 )
@@ -54,11 +58,18 @@ var (
 func updateRecord(
 	ctx context.Context, sctx sessionctx.Context, h kv.Handle, oldData, newData []types.Datum, modified []bool,
 	t table.Table,
-	onDup bool, _ *memory.Tracker, fkChecks []*FKCheckExec, fkCascades []*FKCascadeExec,
+	onDup bool, _ *memory.Tracker, fkChecks []*FKCheckExec, fkCascades []*FKCascadeExec, triggers *TriggerExec,
+	viewChecks []*plannercore.ViewCheck,
 ) (bool, error) {
 	r, ctx := tracing.StartRegionEx(ctx, "executor.updateRecord")
 	defer r.End()
 
+	if err := triggers.fire(ctx, model.TriggerTimingBefore, model.TriggerEventUpdate, oldData, newData); err != nil {
+		return false, err
+	}
+	if err := triggers.evalGeneratedColumns(newData); err != nil {
+		return false, err
+	}
 	sc := sctx.GetSessionVars().StmtCtx
 	changed, handleChanged := false, false
 	// onUpdateSpecified is for "UPDATE SET ts_field = old_value", the
@@ -76,6 +87,9 @@ func updateRecord(
 			return false, err
 		}
 	}
+	if err := checkViewOptions(sctx, viewChecks, newData); err != nil {
+		return false, err
+	}
 
 	// Handle exchange partition
 	tbl := t.Meta()
@@ -150,8 +164,10 @@ func updateRecord(
 		if sctx.GetSessionVars().LockUnchangedKeys {
 			keySet |= lockUniqueKeys
 		}
-		_, err := addUnchangedKeysForLockByRow(sctx, t, h, oldData, keySet)
-		return false, err
+		if _, err := addUnchangedKeysForLockByRow(sctx, t, h, oldData, keySet); err != nil {
+			return false, err
+		}
+		return false, triggers.fire(ctx, model.TriggerTimingAfter, model.TriggerEventUpdate, oldData, newData)
 	}
 
 	// Fill values into on-update-now fields, only if they are really changed.
@@ -239,7 +255,7 @@ func updateRecord(
 	sc.AddUpdatedRows(1)
 	sc.AddCopiedRows(1)
 
-	return true, nil
+	return true, triggers.fire(ctx, model.TriggerTimingAfter, model.TriggerEventUpdate, oldData, newData)
 }
 
 const (
@@ -326,3 +342,21 @@ func resetErrDataTooLong(colName string, rowIdx int, _ error) error {
 	newErr := types.ErrDataTooLong.GenWithStack("Data too long for column '%v' at row %v", colName, rowIdx)
 	return newErr
 }
+
+// checkViewOptions checks the row against the WITH CHECK OPTION of the view which the row is written through.
+func checkViewOptions(sctx sessionctx.Context, checks []*plannercore.ViewCheck, row []types.Datum) error {
+	if len(checks) == 0 {
+		return nil
+	}
+	r := chunk.MutRowFromDatums(row).ToRow()
+	for _, check := range checks {
+		ok, _, err := expression.EvalBool(sctx, []expression.Expression{check.Expr}, r)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return exeerrors.ErrViewCheckFailed.GenWithStackByArgs(check.DBName.O, check.ViewName.O)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,651 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/tikv/client-go/v2/oracle"
+	"github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/tikvrpc"
+	"github.com/tikv/client-go/v2/txnkv/txnlock"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+)
+
+// An XA transaction branch is detached from the session once it is prepared, so that it can be committed or
+// rolled back by any session of any instance, even after the client which prepared it crashed. XA PREPARE takes
+// the write set of the branch from the session, and prewrites it in a new transaction after checking that none
+// of the written keys were changed since the branch read them. The locks are never expired, so the prepared
+// branch can't be rolled back by others, and the transaction is decided by committing or rolling back its primary
+// key, which is persisted into the meta data together with all the written keys.
+//
+// The meta data is persisted before the keys are prewritten, so the locks of a branch which failed to be prepared,
+// e.g. the instance crashed during XA PREPARE, can always be cleaned up by XA ROLLBACK.
+
+const (
+	// xaKeyChunkSize is the max size of a written key chunk, the written keys of a large transaction are split
+	// into chunks to keep every persisted value below the entry size limit.
+	xaKeyChunkSize = 1 << 20
+	// xaLockTTL is the TTL of the locks of XA transactions in milliseconds, which is about 35 years, so the
+	// locks of a prepared branch are never regarded as expired. Its lower 32 bits are all set, so it's
+	// still long enough for the storages keeping 32-bit TTLs.
+	xaLockTTL = 1<<40 - 1
+	// xaMaxBackoff is the max sleep time in milliseconds to retry a request to prewrite, commit or roll back
+	// the keys of an XA transaction.
+	xaMaxBackoff = 20000
+)
+
+func xaKey(xid *ast.XID) string {
+	return meta.XIDKey(xid.FormatID, []byte(xid.Gtrid), []byte(xid.Bqual))
+}
+
+// encodeXAKeys encodes the keys into chunks, every key is encoded with its uvarint length.
+func encodeXAKeys(keys [][]byte) (chunks [][]byte) {
+	var (
+		buf    []byte
+		lenBuf [binary.MaxVarintLen64]byte
+	)
+	for _, k := range keys {
+		if len(buf) > 0 && len(buf)+len(k) > xaKeyChunkSize {
+			chunks = append(chunks, buf)
+			buf = nil
+		}
+		buf = append(buf, lenBuf[:binary.PutUvarint(lenBuf[:], uint64(len(k)))]...)
+		buf = append(buf, k...)
+	}
+	if len(buf) > 0 {
+		chunks = append(chunks, buf)
+	}
+	return chunks
+}
+
+// decodeXAKeys decodes a chunk encoded by encodeXAKeys.
+func decodeXAKeys(chunk []byte) (keys [][]byte, err error) {
+	for len(chunk) > 0 {
+		l, n := binary.Uvarint(chunk)
+		if n <= 0 || uint64(len(chunk)-n) < l {
+			return nil, errors.New("invalid XA key chunk")
+		}
+		keys = append(keys, chunk[n:n+int(l)])
+		chunk = chunk[n+int(l):]
+	}
+	return keys, nil
+}
+
+func getXATxn(store kv.Storage, xid string) (*meta.XAPreparedTxn, error) {
+	ver, err := store.CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		return nil, err
+	}
+	return meta.NewSnapshotMeta(store.GetSnapshot(ver)).GetXATxn(xid)
+}
+
+// getXATxnKeys gets the prepared XA transaction with all its written keys.
+func getXATxnKeys(store kv.Storage, xid string) (*meta.XAPreparedTxn, [][]byte, error) {
+	ver, err := store.CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		return nil, nil, err
+	}
+	m := meta.NewSnapshotMeta(store.GetSnapshot(ver))
+	prepared, err := m.GetXATxn(xid)
+	if err != nil || prepared == nil {
+		return nil, nil, err
+	}
+	var keys [][]byte
+	for i := 0; i < prepared.Chunks; i++ {
+		chunk, err := m.GetXATxnChunk(xid, i)
+		if err != nil {
+			return nil, nil, err
+		}
+		chunkKeys, err := decodeXAKeys(chunk)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, chunkKeys...)
+	}
+	return prepared, keys, nil
+}
+
+// xaWriteSet is the write set of an XA transaction branch taken from the session.
+type xaWriteSet struct {
+	prepared *meta.XAPreparedTxn
+	// mutations are sorted by their keys.
+	mutations []*kvrpcpb.Mutation
+	// checkTS is the ts from which the written keys must not be changed by other transactions.
+	checkTS uint64
+	// tableVersions is the UpdateTS of the written tables when the branch is executed.
+	tableVersions map[int64]uint64
+}
+
+func (ws *xaWriteSet) keys() [][]byte {
+	keys := make([][]byte, 0, len(ws.mutations))
+	for _, m := range ws.mutations {
+		keys = append(keys, m.Key)
+	}
+	return keys
+}
+
+// getXAWriteSet takes the write set of the XA transaction branch in the session.
+func getXAWriteSet(sctx sessionctx.Context, xid *ast.XID) (*xaWriteSet, error) {
+	sessVars := sctx.GetSessionVars()
+	txnCtx := sessVars.TxnCtx
+	if len(txnCtx.TemporaryTables) > 0 {
+		return nil, dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("XA PREPARE")
+	}
+	if len(txnCtx.CachedTables) > 0 {
+		return nil, dbterror.ErrOptOnCacheTable.GenWithStackByArgs("XA PREPARE")
+	}
+	txn, err := sctx.Txn(false)
+	if err != nil {
+		return nil, err
+	}
+	ws := &xaWriteSet{
+		prepared: &meta.XAPreparedTxn{
+			FormatID:    xid.FormatID,
+			Gtrid:       []byte(xid.Gtrid),
+			Bqual:       []byte(xid.Bqual),
+			PrepareTime: time.Now(),
+		},
+		checkTS:       txnCtx.GetForUpdateTS(),
+		tableVersions: make(map[int64]uint64, len(txnCtx.TableDeltaMap)),
+	}
+	if txn.Valid() {
+		if ws.mutations, err = getXAMutations(txn.GetMemBuffer()); err != nil {
+			return nil, err
+		}
+	}
+	is, _ := txnCtx.InfoSchema.(infoschema.InfoSchema)
+	for id, delta := range txnCtx.TableDeltaMap {
+		ws.prepared.Deltas = append(ws.prepared.Deltas, meta.XATableDelta{TableID: id, Delta: delta.Delta, Count: delta.Count})
+		if is == nil {
+			continue
+		}
+		tbl, ok := is.TableByID(id)
+		if !ok {
+			tbl, _, _ = is.FindTableByPartitionID(id)
+		}
+		if tbl != nil {
+			ws.tableVersions[id] = tbl.Meta().UpdateTS
+		}
+	}
+	return ws, nil
+}
+
+// getXAMutations gets the mutations in the memory buffer. The inserted keys whose existence hasn't been
+// checked yet are checked by the prewrite.
+func getXAMutations(memBuffer kv.MemBuffer) ([]*kvrpcpb.Mutation, error) {
+	it, err := memBuffer.Iter(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var mutations []*kvrpcpb.Mutation
+	for ; it.Valid(); err = it.Next() {
+		if err != nil {
+			return nil, err
+		}
+		k, v := it.Key(), it.Value()
+		if tablecodec.IsUntouchedIndexKValue(k, v) {
+			continue
+		}
+		flags, err := memBuffer.GetFlags(k)
+		if err != nil {
+			return nil, err
+		}
+		m := &kvrpcpb.Mutation{Op: kvrpcpb.Op_Put, Key: k.Clone(), Value: slices.Clone(v)}
+		if len(v) == 0 {
+			m.Op = kvrpcpb.Op_Del
+		} else if flags.HasPresumeKeyNotExists() || flags.HasNeedConstraintCheckInPrewrite() {
+			m.Op = kvrpcpb.Op_Insert
+		}
+		mutations = append(mutations, m)
+	}
+	return mutations, err
+}
+
+// prepareXATxn prepares the XA transaction branch with its write set, after the session released its locks.
+// The write set is prewritten by a new transaction whose locks never expire, it's rolled back if it fails
+// to be prepared.
+func prepareXATxn(ctx context.Context, sctx sessionctx.Context, xid *ast.XID, ws *xaWriteSet) error {
+	store := sctx.GetStore()
+	tikvStore, ok := store.(tikv.Storage)
+	if !ok {
+		return errors.New("XA PREPARE is only supported by TiKV")
+	}
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	startTS, err := tikvStore.GetOracle().GetTimestamp(ctx, &oracle.Option{TxnScope: oracle.GlobalTxnScope})
+	if err != nil {
+		return err
+	}
+	ws.prepared.StartTS = startTS
+	if len(ws.mutations) > 0 {
+		ws.prepared.Primary = ws.mutations[0].Key
+	}
+	key := xaKey(xid)
+	keys := ws.keys()
+	err = kv.RunInNewTxn(ctx, store, true, func(_ context.Context, txn kv.Transaction) error {
+		m := meta.NewMeta(txn)
+		old, err := m.GetXATxn(key)
+		if err != nil {
+			return err
+		}
+		if old != nil {
+			return exeerrors.ErrXaerDupid
+		}
+		return m.PrepareXATxn(ws.prepared, encodeXAKeys(keys))
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = prewriteXATxn(ctx, sctx, tikvStore, ws); err == nil {
+		err = kv.RunInNewTxn(ctx, store, true, func(_ context.Context, txn kv.Transaction) error {
+			m := meta.NewMeta(txn)
+			prepared, err := m.GetXATxn(key)
+			if err != nil {
+				return err
+			}
+			// The branch is rolled back by others during XA PREPARE.
+			if prepared == nil || prepared.StartTS != startTS {
+				return exeerrors.ErrXaRbrollback
+			}
+			prepared.Prepared = true
+			return m.UpdateXATxn(prepared)
+		})
+		if err == nil {
+			return nil
+		}
+	}
+	if rollbackErr := rollbackXAKeys(ctx, tikvStore, startTS, keys); rollbackErr != nil {
+		// The branch is left to XA ROLLBACK.
+		logutil.Logger(ctx).Warn("failed to roll back the XA transaction which failed to be prepared",
+			zap.String("xid", xid.String()), zap.Error(rollbackErr))
+		return err
+	}
+	if dropErr := dropXATxn(ctx, store, key, startTS); dropErr != nil && !exeerrors.ErrXaerNota.Equal(dropErr) {
+		logutil.Logger(ctx).Warn("failed to drop the XA transaction which failed to be prepared",
+			zap.String("xid", xid.String()), zap.Error(dropErr))
+	}
+	return err
+}
+
+// prewriteXATxn prewrites the write set of the XA transaction branch. Its written keys must not be changed since
+// the branch read them, which is checked as of the start ts of the prewrite. The changes committed after that are
+// conflicts detected by the prewrite.
+func prewriteXATxn(ctx context.Context, sctx sessionctx.Context, store tikv.Storage, ws *xaWriteSet) error {
+	if !xaTableVersionsMatch(domain.GetDomain(sctx).InfoSchema(), ws.tableVersions) {
+		return exeerrors.ErrXaRbrollback
+	}
+	if len(ws.mutations) == 0 {
+		return nil
+	}
+	keys := ws.keys()
+	kvKeys := make([]kv.Key, 0, len(keys))
+	for _, k := range keys {
+		kvKeys = append(kvKeys, k)
+	}
+	kvStore := sctx.GetStore()
+	before, err := kvStore.GetSnapshot(kv.NewVersion(ws.checkTS)).BatchGet(ctx, kvKeys)
+	if err != nil {
+		return err
+	}
+	current, err := kvStore.GetSnapshot(kv.NewVersion(ws.prepared.StartTS)).BatchGet(ctx, kvKeys)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if !bytes.Equal(before[string(k)], current[string(k)]) {
+			return exeerrors.ErrXaRbrollback
+		}
+	}
+
+	mutations := make(map[string]*kvrpcpb.Mutation, len(ws.mutations))
+	for _, m := range ws.mutations {
+		mutations[string(m.Key)] = m
+	}
+	startTS := ws.prepared.StartTS
+	return sendXARequests(ctx, store, startTS, keys, tikvrpc.CmdPrewrite, func(batch [][]byte) interface{} {
+		req := &kvrpcpb.PrewriteRequest{
+			PrimaryLock:  ws.prepared.Primary,
+			StartVersion: startTS,
+			LockTtl:      xaLockTTL,
+			TxnSize:      uint64(len(keys)),
+			// Readers can push the commit ts of the transaction forward, so they aren't blocked by its locks.
+			MinCommitTs: startTS + 1,
+		}
+		for _, k := range batch {
+			req.Mutations = append(req.Mutations, mutations[string(k)])
+		}
+		return req
+	}, func(keyErr *kvrpcpb.KeyError) error {
+		if keyErr.Conflict != nil || keyErr.AlreadyExist != nil {
+			return exeerrors.ErrXaRbrollback
+		}
+		return errors.New(keyErr.String())
+	})
+}
+
+// errXARetry means the request should be retried.
+var errXARetry = errors.New("retry the XA request")
+
+// sendXARequests sends the requests built by build for the keys grouped by regions. The locks of other
+// transactions are resolved, the other key errors are handled by onKeyErr, which returns errXARetry to
+// resend the request.
+func sendXARequests(ctx context.Context, store tikv.Storage, startTS uint64, keys [][]byte, cmd tikvrpc.CmdType,
+	build func(batch [][]byte) interface{}, onKeyErr func(keyErr *kvrpcpb.KeyError) error) error {
+	bo := tikv.NewBackofferWithVars(ctx, xaMaxBackoff, nil)
+	groups, _, err := store.GetRegionCache().GroupKeysByRegion(bo, keys, nil)
+	if err != nil {
+		return err
+	}
+	for region, batch := range groups {
+		for {
+			resp, err := store.SendReq(bo, tikvrpc.NewRequest(cmd, build(batch)), region, tikv.ReadTimeoutShort)
+			if err != nil {
+				return err
+			}
+			regionErr, err := resp.GetRegionError()
+			if err != nil {
+				return err
+			}
+			if regionErr != nil {
+				if err = bo.Backoff(tikv.BoRegionMiss(), errors.New(regionErr.String())); err != nil {
+					return err
+				}
+				if err = sendXARequests(ctx, store, startTS, batch, cmd, build, onKeyErr); err != nil {
+					return err
+				}
+				break
+			}
+			var keyErrs []*kvrpcpb.KeyError
+			switch r := resp.Resp.(type) {
+			case *kvrpcpb.PrewriteResponse:
+				keyErrs = r.GetErrors()
+			case *kvrpcpb.CommitResponse:
+				if r.GetError() != nil {
+					keyErrs = append(keyErrs, r.GetError())
+				}
+			case *kvrpcpb.BatchRollbackResponse:
+				if r.GetError() != nil {
+					keyErrs = append(keyErrs, r.GetError())
+				}
+			default:
+				if err = bo.Backoff(tikv.BoTiKVRPC(), errors.Errorf("unexpected response %T", resp.Resp)); err != nil {
+					return err
+				}
+				continue
+			}
+			if len(keyErrs) == 0 {
+				break
+			}
+			var locks []*txnlock.Lock
+			retry := false
+			for _, keyErr := range keyErrs {
+				if keyErr.Locked != nil {
+					locks = append(locks, txnlock.NewLock(keyErr.Locked))
+					continue
+				}
+				if err = onKeyErr(keyErr); err == errXARetry {
+					retry = true
+				} else if err != nil {
+					return err
+				}
+			}
+			if len(locks) > 0 {
+				retry = true
+				msBeforeExpired, err := store.GetLockResolver().ResolveLocks(bo, startTS, locks)
+				if err != nil {
+					return err
+				}
+				if msBeforeExpired > 0 {
+					if err = bo.Backoff(tikv.BoTxnLock(), errors.Errorf("%d locks of others", len(locks))); err != nil {
+						return err
+					}
+				}
+			}
+			if !retry {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// xaTableVersionsMatch checks whether the written tables are not changed since the XA transaction branch is executed.
+func xaTableVersionsMatch(is infoschema.InfoSchema, versions map[int64]uint64) bool {
+	for id, ver := range versions {
+		tbl, ok := is.TableByID(id)
+		if !ok {
+			tbl, _, _ = is.FindTableByPartitionID(id)
+		}
+		if tbl == nil || tbl.Meta().UpdateTS != ver {
+			return false
+		}
+	}
+	return true
+}
+
+// commitXATxn commits the prepared XA transaction by committing its primary key, then its secondary keys.
+// The secondary keys failed to be committed are committed by the readers which find their locks.
+func commitXATxn(ctx context.Context, sctx sessionctx.Context, xid *ast.XID) error {
+	store := sctx.GetStore()
+	key := xaKey(xid)
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	prepared, keys, err := getXATxnKeys(store, key)
+	if err != nil {
+		return err
+	}
+	if prepared == nil || !prepared.Prepared {
+		return exeerrors.ErrXaerNota
+	}
+	if len(prepared.Primary) > 0 {
+		tikvStore, ok := store.(tikv.Storage)
+		if !ok {
+			return errors.New("XA COMMIT is only supported by TiKV")
+		}
+		startTS := prepared.StartTS
+		commitTS, err := tikvStore.GetOracle().GetTimestamp(ctx, &oracle.Option{TxnScope: oracle.GlobalTxnScope})
+		if err != nil {
+			return err
+		}
+		build := func(batch [][]byte) interface{} {
+			return &kvrpcpb.CommitRequest{StartVersion: startTS, Keys: batch, CommitVersion: commitTS}
+		}
+		err = sendXARequests(ctx, tikvStore, startTS, [][]byte{prepared.Primary}, tikvrpc.CmdCommit, build, func(keyErr *kvrpcpb.KeyError) error {
+			if keyErr.CommitTsExpired != nil {
+				commitTS, err = tikvStore.GetOracle().GetTimestamp(ctx, &oracle.Option{TxnScope: oracle.GlobalTxnScope})
+				if err != nil {
+					return err
+				}
+				return errXARetry
+			}
+			// The transaction may have been committed by a previous XA COMMIT which failed after that.
+			status, err := tikvStore.GetLockResolver().GetTxnStatus(startTS, 0, prepared.Primary)
+			if err != nil {
+				return err
+			}
+			if status.IsCommitted() {
+				commitTS = status.CommitTS()
+				return nil
+			}
+			if status.IsRolledBack() {
+				return exeerrors.ErrXaerNota
+			}
+			return errors.New(keyErr.String())
+		})
+		if err != nil {
+			return err
+		}
+		secondaries := make([][]byte, 0, len(keys))
+		for _, k := range keys {
+			if !bytes.Equal(k, prepared.Primary) {
+				secondaries = append(secondaries, k)
+			}
+		}
+		err = sendXARequests(ctx, tikvStore, startTS, secondaries, tikvrpc.CmdCommit, build, func(keyErr *kvrpcpb.KeyError) error {
+			return errors.New(keyErr.String())
+		})
+		if err != nil {
+			logutil.Logger(ctx).Warn("failed to commit the secondary keys of the XA transaction",
+				zap.String("xid", xid.String()), zap.Error(err))
+		}
+	}
+	if err = dropXATxn(ctx, store, key, prepared.StartTS); err != nil {
+		return err
+	}
+	reportXATableDeltas(sctx, prepared)
+	return nil
+}
+
+// reportXATableDeltas reports the row count changes of the committed XA transaction.
+func reportXATableDeltas(sctx sessionctx.Context, prepared *meta.XAPreparedTxn) {
+	if h := domain.GetDomain(sctx).StatsHandle(); h != nil && len(prepared.Deltas) > 0 {
+		// The session which prepared the transaction may be gone, so report the deltas through a new collector
+		// which is swept on the next stats dump.
+		collector := h.NewSessionStatsCollector()
+		for _, d := range prepared.Deltas {
+			collector.Update(d.TableID, d.Delta, d.Count, nil)
+		}
+		collector.Delete()
+	}
+}
+
+// rollbackXATxn rolls back the XA transaction, which is prepared or failed to be prepared.
+func rollbackXATxn(ctx context.Context, sctx sessionctx.Context, xid *ast.XID) error {
+	store := sctx.GetStore()
+	key := xaKey(xid)
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	prepared, keys, err := getXATxnKeys(store, key)
+	if err != nil {
+		return err
+	}
+	if prepared == nil {
+		return exeerrors.ErrXaerNota
+	}
+	if len(keys) > 0 {
+		tikvStore, ok := store.(tikv.Storage)
+		if !ok {
+			return errors.New("XA ROLLBACK is only supported by TiKV")
+		}
+		if err = rollbackXAKeys(ctx, tikvStore, prepared.StartTS, keys); err != nil {
+			return err
+		}
+	}
+	return dropXATxn(ctx, store, key, prepared.StartTS)
+}
+
+// rollbackXAKeys rolls back the keys of the XA transaction, starting from its primary key. It fails if the
+// transaction is committed.
+func rollbackXAKeys(ctx context.Context, store tikv.Storage, startTS uint64, keys [][]byte) error {
+	build := func(batch [][]byte) interface{} {
+		return &kvrpcpb.BatchRollbackRequest{StartVersion: startTS, Keys: batch}
+	}
+	onKeyErr := func(keyErr *kvrpcpb.KeyError) error {
+		if keyErr.Abort != "" || keyErr.Retryable != "" {
+			status, err := store.GetLockResolver().GetTxnStatus(startTS, 0, keys[0])
+			if err == nil && status.IsCommitted() {
+				return exeerrors.ErrXaerRmfail.GenWithStackByArgs("COMMITTED")
+			}
+		}
+		return errors.New(keyErr.String())
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := sendXARequests(ctx, store, startTS, keys[:1], tikvrpc.CmdBatchRollback, build, onKeyErr); err != nil {
+		return err
+	}
+	return sendXARequests(ctx, store, startTS, keys[1:], tikvrpc.CmdBatchRollback, build, onKeyErr)
+}
+
+// dropXATxn drops the XA transaction, if it's still the one started at startTS.
+func dropXATxn(ctx context.Context, store kv.Storage, key string, startTS uint64) error {
+	return kv.RunInNewTxn(ctx, store, true, func(_ context.Context, txn kv.Transaction) error {
+		m := meta.NewMeta(txn)
+		prepared, err := m.GetXATxn(key)
+		if err != nil {
+			return err
+		}
+		if prepared == nil || prepared.StartTS != startTS {
+			return exeerrors.ErrXaerNota
+		}
+		return m.DropXATxn(key)
+	})
+}
+
+// XARecoverExec represents an XA RECOVER executor, it lists the prepared XA transactions.
+type XARecoverExec struct {
+	exec.BaseExecutor
+
+	convertXID bool
+	done       bool
+}
+
+// Next implements the Executor Next interface.
+func (e *XARecoverExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+
+	store := e.Ctx().GetStore()
+	ver, err := store.CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		return err
+	}
+	txns, err := meta.NewSnapshotMeta(store.GetSnapshot(ver)).ListXATxns()
+	if err != nil {
+		return err
+	}
+	sort.Slice(txns, func(i, j int) bool {
+		return txns[i].PrepareTime.Before(txns[j].PrepareTime)
+	})
+	for _, txn := range txns {
+		if !txn.Prepared {
+			continue
+		}
+		data := append(append([]byte{}, txn.Gtrid...), txn.Bqual...)
+		req.AppendInt64(0, int64(txn.FormatID))
+		req.AppendInt64(1, int64(len(txn.Gtrid)))
+		req.AppendInt64(2, int64(len(txn.Bqual)))
+		if e.convertXID {
+			req.AppendString(3, "0x"+hex.EncodeToString(data))
+		} else {
+			req.AppendBytes(3, data)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXAStateTransition(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int)")
+
+	tk.MustGetErrCode("xa end 'x'", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("xa prepare 'x'", errno.ErrXaerNota)
+	tk.MustGetErrCode("xa commit 'x' one phase", errno.ErrXaerNota)
+	tk.MustGetErrCode("xa commit 'x'", errno.ErrXaerNota)
+	tk.MustGetErrCode("xa rollback 'x'", errno.ErrXaerNota)
+	tk.MustGetErrCode("xa start 'x' join", errno.ErrXaerInval)
+
+	tk.MustExec("begin")
+	tk.MustGetErrCode("xa start 'x'", errno.ErrXaerOutside)
+	tk.MustExec("rollback")
+
+	tk.MustExec("xa start 'x'")
+	tk.MustGetErrCode("xa start 'y'", errno.ErrXaerRmfail)
+	tk.MustExec("insert into t values (1, 1)")
+	tk.MustGetErrCode("commit", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("begin", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("rollback", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("create table t1 (a int)", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("xa commit 'x' one phase", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("xa rollback 'x'", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("xa prepare 'x'", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("xa end 'x' suspend", errno.ErrXaerInval)
+	tk.MustGetErrCode("xa end 'y'", errno.ErrXaerNota)
+	tk.MustExec("xa end 'x'")
+	tk.MustGetErrCode("select * from t", errno.ErrXaerRmfail)
+	tk.MustQuery("show variables like 'autocommit'").Check(testkit.Rows("autocommit ON"))
+	tk.MustGetErrCode("xa commit 'x'", errno.ErrXaerRmfail)
+	tk.MustGetErrCode("xa commit 'y' one phase", errno.ErrXaerRmfail)
+	tk.MustExec("xa commit 'x' one phase")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1"))
+
+	tk.MustExec("xa start 'x'")
+	tk.MustExec("insert into t values (2, 2)")
+	tk.MustExec("xa end 'x'")
+	tk.MustExec("xa rollback 'x'")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1"))
+	tk.MustQuery("xa recover").Check(testkit.Rows())
+}
+
+func TestXAPrepareAndCommitFromAnotherSession(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int, unique key(b))")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+
+	tk.MustExec("xa start 'g1', 'b1'")
+	tk.MustExec("insert into t values (3, 3)")
+	tk.MustExec("update t set b = 10 where a = 1")
+	tk.MustExec("delete from t where a = 2")
+	tk.MustExec("xa end 'g1', 'b1'")
+	tk.MustExec("xa prepare 'g1', 'b1'")
+	// The prepared branch is detached from the session.
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2"))
+	tk.MustGetErrCode("xa start 'g1', 'b1'", errno.ErrXaerDupid)
+
+	tk.MustExec("xa start 'g2', 'b2', 2")
+	tk.MustExec("insert into t values (4, 4)")
+	tk.MustExec("xa end 'g2', 'b2', 2")
+	tk.MustExec("xa prepare 'g2', 'b2', 2")
+	tk.MustQuery("xa recover").Check(testkit.Rows("1 2 2 g1b1", "2 2 2 g2b2"))
+	tk.MustQuery("xa recover convert xid").Check(testkit.Rows("1 2 2 0x67316231", "2 2 2 0x67326232"))
+
+	// Another session, like the one of a recovered client, finishes the branches.
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use test")
+	tk2.MustGetErrCode("xa commit 'g1'", errno.ErrXaerNota)
+	tk2.MustExec("xa commit 'g1', 'b1'")
+	tk2.MustExec("xa rollback 'g2', 'b2', 2")
+	tk2.MustQuery("xa recover").Check(testkit.Rows())
+	tk2.MustQuery("select * from t").Check(testkit.Rows("1 10", "3 3"))
+	tk2.MustExec("admin check table t")
+	tk2.MustGetErrCode("xa commit 'g1', 'b1'", errno.ErrXaerNota)
+
+	// An XA transaction can't be finished inside another transaction.
+	tk.MustExec("xa start 'g3'")
+	tk.MustExec("xa end 'g3'")
+	tk.MustExec("xa prepare 'g3'")
+	tk.MustExec("begin")
+	tk.MustGetErrCode("xa commit 'g3'", errno.ErrXaerOutside)
+	tk.MustExec("rollback")
+	tk.MustExec("xa commit 'g3'")
+}
+
+func TestXACommitConflict(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 1)")
+
+	tk.MustExec("xa start 'x'")
+	tk.MustExec("update t set b = 2 where a = 1")
+	tk.MustExec("xa end 'x'")
+	tk.MustExec("xa prepare 'x'")
+
+	// The prepared branch keeps its locks, so it can't be committed with conflicts.
+	// They don't block the readers.
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use test")
+	tk2.MustQuery("select * from t").Check(testkit.Rows("1 1"))
+	tk2.MustExec("set @@innodb_lock_wait_timeout = 1")
+	tk2.MustExec("begin pessimistic")
+	tk2.MustGetErrCode("update t set b = 3 where a = 1", errno.ErrLockWaitTimeout)
+	tk2.MustExec("rollback")
+	tk2.MustExec("xa commit 'x'")
+	tk2.MustQuery("xa recover").Check(testkit.Rows())
+	tk2.MustExec("update t set b = 3 where a = 1")
+	tk2.MustQuery("select * from t").Check(testkit.Rows("1 3"))
+
+	// The rolled back branch releases its locks.
+	tk.MustExec("xa start 'x'")
+	tk.MustExec("insert into t values (2, 2)")
+	tk.MustExec("xa end 'x'")
+	tk.MustExec("xa prepare 'x'")
+	tk2.MustExec("begin pessimistic")
+	tk2.MustGetErrCode("insert into t values (2, 3)", errno.ErrLockWaitTimeout)
+	tk2.MustExec("rollback")
+	tk2.MustExec("xa rollback 'x'")
+	tk2.MustExec("insert into t values (2, 3)")
+	tk2.MustExec("delete from t where a = 2")
+
+	// The prepared branch is never rolled back by itself, even if the table is changed after it's prepared.
+	tk.MustExec("xa start 'y'")
+	tk.MustExec("insert into t values (2, 2)")
+	tk.MustExec("xa end 'y'")
+	tk.MustExec("xa prepare 'y'")
+	tk2.MustExec("alter table t add column c int")
+	tk2.MustExec("xa commit 'y'")
+	tk2.MustQuery("select * from t").Check(testkit.Rows("1 3 <nil>", "2 2 <nil>"))
+	tk2.MustExec("delete from t where a = 2")
+
+	// Duplicated keys inserted by an optimistic branch are detected by XA PREPARE.
+	tk.MustExec("set @@tidb_txn_mode = 'optimistic'")
+	tk.MustExec("set @@tidb_constraint_check_in_place = 0")
+	tk.MustExec("xa start 'z'")
+	tk.MustExec("insert into t values (1, 1, 1)")
+	tk.MustExec("xa end 'z'")
+	tk.MustGetErrCode("xa prepare 'z'", errno.ErrXaRbrollback)
+	tk.MustQuery("xa recover").Check(testkit.Rows())
+	// So are the ones inserted by others after the branch started.
+	tk.MustExec("xa start 'z'")
+	tk.MustExec("insert into t values (5, 5, 5)")
+	tk.MustExec("xa end 'z'")
+	tk2.MustExec("insert into t values (5, 6, 6)")
+	tk.MustGetErrCode("xa prepare 'z'", errno.ErrXaRbrollback)
+	tk.MustQuery("select * from t where a = 5").Check(testkit.Rows("5 6 6"))
+	tk.MustExec("xa start 'z'")
+	tk.MustExec("xa end 'z'")
+	tk.MustExec("xa prepare 'z'")
+	tk.MustExec("xa commit 'z'")
+}
+
+func TestXARecoverPrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create user xa_user")
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "xa_user", Hostname: "localhost"}, nil, nil, nil))
+	tk1.MustGetErrCode("xa recover", errno.ErrSpecificAccessDenied)
+	tk.MustExec("grant XA_RECOVER_ADMIN on *.* to xa_user")
+	tk1.MustQuery("xa recover").Check(testkit.Rows())
+}
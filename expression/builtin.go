@@ -33,6 +33,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/opcode"
 	"github.com/pingcap/tidb/sessionctx"
@@ -341,10 +342,13 @@ func (b *baseBuiltinFunc) isChildrenVectorized() bool {
 func (b *baseBuiltinFunc) getRetTp() *types.FieldType {
 	switch b.tp.EvalType() {
 	case types.ETString:
-		if b.tp.GetFlen() >= mysql.MaxBlobWidth {
-			b.tp.SetType(mysql.TypeLongBlob)
-		} else if b.tp.GetFlen() >= 65536 {
-			b.tp.SetType(mysql.TypeMediumBlob)
+		// geometry values are binary strings with their own column type, keep it as is.
+		if b.tp.GetType() != mysql.TypeGeometry {
+			if b.tp.GetFlen() >= mysql.MaxBlobWidth {
+				b.tp.SetType(mysql.TypeLongBlob)
+			} else if b.tp.GetFlen() >= 65536 {
+				b.tp.SetType(mysql.TypeMediumBlob)
+			}
 		}
 		if len(b.tp.GetCharset()) <= 0 {
 			charset, collate := charset.GetDefaultCharsetAndCollate()
@@ -372,6 +376,10 @@ func (b *baseBuiltinFunc) getCtx() sessionctx.Context {
 	return b.ctx
 }
 
+func (b *baseBuiltinFunc) setCtx(ctx sessionctx.Context) {
+	b.ctx = ctx
+}
+
 func (b *baseBuiltinFunc) cloneFrom(from *baseBuiltinFunc) {
 	b.args = make([]Expression, 0, len(b.args))
 	for _, arg := range from.args {
@@ -485,6 +493,8 @@ type builtinFunc interface {
 	equal(builtinFunc) bool
 	// getCtx returns this function's context.
 	getCtx() sessionctx.Context
+	// setCtx sets this function's context.
+	setCtx(ctx sessionctx.Context)
 	// getRetTp returns the return type of the built-in function.
 	getRetTp() *types.FieldType
 	// setPbCode sets pbCode for signature.
@@ -802,6 +812,9 @@ var funcs = map[string]functionClass{
 	ast.TiDBRowChecksum: &tidbRowChecksumFunctionClass{baseFunctionClass{ast.TiDBRowChecksum, 0, 0}},
 	ast.Grouping:        &groupingImplFunctionClass{baseFunctionClass{ast.Grouping, 1, 1}},
 
+	// full-text search functions
+	ast.MatchAgainstFunc: &matchAgainstFunctionClass{baseFunctionClass{ast.MatchAgainstFunc, 7, -1}},
+
 	ast.GetLock:     &lockFunctionClass{baseFunctionClass{ast.GetLock, 2, 2}},
 	ast.ReleaseLock: &releaseLockFunctionClass{baseFunctionClass{ast.ReleaseLock, 1, 1}},
 
@@ -896,6 +909,49 @@ var funcs = map[string]functionClass{
 	ast.JSONKeys:          &jsonKeysFunctionClass{baseFunctionClass{ast.JSONKeys, 1, 2}},
 	ast.JSONLength:        &jsonLengthFunctionClass{baseFunctionClass{ast.JSONLength, 1, 2}},
 
+	// spatial functions
+	ast.Point:                &pointFunctionClass{baseFunctionClass{ast.Point, 2, 2}},
+	ast.LineString:           &geomCollectionFunctionClass{baseFunctionClass{ast.LineString, 1, -1}, mysql.GeometryTypeLineString},
+	ast.Polygon:              &geomCollectionFunctionClass{baseFunctionClass{ast.Polygon, 1, -1}, mysql.GeometryTypePolygon},
+	ast.MultiPoint:           &geomCollectionFunctionClass{baseFunctionClass{ast.MultiPoint, 1, -1}, mysql.GeometryTypeMultiPoint},
+	ast.MultiLineString:      &geomCollectionFunctionClass{baseFunctionClass{ast.MultiLineString, 1, -1}, mysql.GeometryTypeMultiLineString},
+	ast.MultiPolygon:         &geomCollectionFunctionClass{baseFunctionClass{ast.MultiPolygon, 1, -1}, mysql.GeometryTypeMultiPolygon},
+	ast.GeometryCollection:   &geomCollectionFunctionClass{baseFunctionClass{ast.GeometryCollection, 0, -1}, mysql.GeometryTypeGeometryCollection},
+	ast.GeomCollection:       &geomCollectionFunctionClass{baseFunctionClass{ast.GeomCollection, 0, -1}, mysql.GeometryTypeGeometryCollection},
+	ast.STGeomFromText:       &geomFromTextFunctionClass{baseFunctionClass{ast.STGeomFromText, 1, 2}, mysql.GeometryTypeGeometry},
+	ast.STGeometryFromText:   &geomFromTextFunctionClass{baseFunctionClass{ast.STGeometryFromText, 1, 2}, mysql.GeometryTypeGeometry},
+	ast.STPointFromText:      &geomFromTextFunctionClass{baseFunctionClass{ast.STPointFromText, 1, 2}, mysql.GeometryTypePoint},
+	ast.STLineFromText:       &geomFromTextFunctionClass{baseFunctionClass{ast.STLineFromText, 1, 2}, mysql.GeometryTypeLineString},
+	ast.STLineStringFromText: &geomFromTextFunctionClass{baseFunctionClass{ast.STLineStringFromText, 1, 2}, mysql.GeometryTypeLineString},
+	ast.STPolyFromText:       &geomFromTextFunctionClass{baseFunctionClass{ast.STPolyFromText, 1, 2}, mysql.GeometryTypePolygon},
+	ast.STPolygonFromText:    &geomFromTextFunctionClass{baseFunctionClass{ast.STPolygonFromText, 1, 2}, mysql.GeometryTypePolygon},
+	ast.STGeomFromWKB:        &geomFromWKBFunctionClass{baseFunctionClass{ast.STGeomFromWKB, 1, 2}},
+	ast.STGeometryFromWKB:    &geomFromWKBFunctionClass{baseFunctionClass{ast.STGeometryFromWKB, 1, 2}},
+	ast.STGeomFromGeoJSON:    &geomFromGeoJSONFunctionClass{baseFunctionClass{ast.STGeomFromGeoJSON, 1, 3}},
+	ast.STAsText:             &geomAsTextFunctionClass{baseFunctionClass{ast.STAsText, 1, 1}},
+	ast.STAsWKT:              &geomAsTextFunctionClass{baseFunctionClass{ast.STAsWKT, 1, 1}},
+	ast.STAsBinary:           &geomAsBinaryFunctionClass{baseFunctionClass{ast.STAsBinary, 1, 1}},
+	ast.STAsWKB:              &geomAsBinaryFunctionClass{baseFunctionClass{ast.STAsWKB, 1, 1}},
+	ast.STAsGeoJSON:          &geomAsGeoJSONFunctionClass{baseFunctionClass{ast.STAsGeoJSON, 1, 3}},
+	ast.STSRID:               &geomSRIDFunctionClass{baseFunctionClass{ast.STSRID, 1, 2}},
+	ast.STX:                  &pointCoordFunctionClass{baseFunctionClass{ast.STX, 1, 1}, false},
+	ast.STY:                  &pointCoordFunctionClass{baseFunctionClass{ast.STY, 1, 1}, true},
+	ast.STGeometryType:       &geometryTypeFunctionClass{baseFunctionClass{ast.STGeometryType, 1, 1}},
+	ast.STIsEmpty:            &geomIsEmptyFunctionClass{baseFunctionClass{ast.STIsEmpty, 1, 1}},
+	ast.STArea:               &geomAreaFunctionClass{baseFunctionClass{ast.STArea, 1, 1}},
+	ast.STLength:             &geomLengthFunctionClass{baseFunctionClass{ast.STLength, 1, 1}},
+	ast.STContains:           &geomRelationFunctionClass{baseFunctionClass{ast.STContains, 2, 2}, (*types.Geometry).Contains},
+	ast.STWithin:             &geomRelationFunctionClass{baseFunctionClass{ast.STWithin, 2, 2}, geomWithin},
+	ast.STIntersects:         &geomRelationFunctionClass{baseFunctionClass{ast.STIntersects, 2, 2}, (*types.Geometry).Intersects},
+	ast.STDisjoint:           &geomRelationFunctionClass{baseFunctionClass{ast.STDisjoint, 2, 2}, geomDisjoint},
+	ast.STEquals:             &geomRelationFunctionClass{baseFunctionClass{ast.STEquals, 2, 2}, (*types.Geometry).GeomEquals},
+	ast.STDistance:           &geomDistanceFunctionClass{baseFunctionClass{ast.STDistance, 2, 2}},
+	ast.STDistanceSphere:     &geomDistanceSphereFunctionClass{baseFunctionClass{ast.STDistanceSphere, 2, 3}},
+	ast.STGeoHash:            &geoHashFunctionClass{baseFunctionClass{ast.STGeoHash, 2, 3}},
+	ast.STLatFromGeoHash:     &geoHashCoordFunctionClass{baseFunctionClass{ast.STLatFromGeoHash, 1, 1}, true},
+	ast.STLongFromGeoHash:    &geoHashCoordFunctionClass{baseFunctionClass{ast.STLongFromGeoHash, 1, 1}, false},
+	ast.STPointFromGeoHash:   &pointFromGeoHashFunctionClass{baseFunctionClass{ast.STPointFromGeoHash, 2, 2}},
+
 	// TiDB internal function.
 	ast.TiDBDecodeKey: &tidbDecodeKeyFunctionClass{baseFunctionClass{ast.TiDBDecodeKey, 1, 1}},
 	// This function is used to show tidb-server version info.
@@ -912,6 +968,14 @@ var funcs = map[string]functionClass{
 	ast.SetVal:  &setValFunctionClass{baseFunctionClass{ast.SetVal, 2, 2}},
 }
 
+// EvalStoredFunction evaluates the stored function with the arguments, it's set by the executor package which
+// interprets the body of the stored routines.
+var EvalStoredFunction func(ctx sessionctx.Context, db model.CIStr, routine *model.RoutineInfo, args []types.Datum) (types.Datum, error)
+
+// GetStoredFunction gets the stored function by the schema name and the function name, the functions which are
+// not builtin functions are resolved to the stored functions by it.
+var GetStoredFunction func(ctx sessionctx.Context, db, name model.CIStr) (model.CIStr, *model.RoutineInfo)
+
 // IsFunctionSupported check if given function name is a builtin sql function.
 func IsFunctionSupported(name string) bool {
 	_, ok := funcs[name]
@@ -0,0 +1,277 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"bytes"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/fulltext"
+)
+
+var (
+	_ functionClass = &matchAgainstFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinMatchAgainstSig{}
+)
+
+// The search modes of MATCH ... AGAINST.
+const (
+	// FullTextNaturalLanguageMode is the natural language search mode.
+	FullTextNaturalLanguageMode int64 = iota
+	// FullTextBooleanMode is the boolean search mode.
+	FullTextBooleanMode
+)
+
+// The offsets of the arguments of match_against. The function is rewritten from
+// `MATCH (col1, col2, ...) AGAINST (search [mode])` as
+// `match_against(search, mode, parser, table_id, index_id, total_docs, col1, col2, ...)`,
+// where table_id and index_id locate the FULLTEXT index on (col1, col2, ...), and total_docs
+// is the estimated number of rows of the table which is used to calculate the relevance.
+const (
+	MatchAgainstSearchArg = iota
+	MatchAgainstModeArg
+	MatchAgainstParserArg
+	MatchAgainstTableIDArg
+	MatchAgainstIndexIDArg
+	MatchAgainstTotalDocsArg
+	MatchAgainstColumnArgs
+)
+
+type matchAgainstFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *matchAgainstFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	meta := make([]types.Datum, MatchAgainstColumnArgs)
+	for i := MatchAgainstModeArg; i < MatchAgainstColumnArgs; i++ {
+		con, ok := args[i].(*Constant)
+		if !ok {
+			return nil, errIncorrectArgs.GenWithStackByArgs("MATCH")
+		}
+		meta[i] = con.Value
+	}
+	argTps := make([]types.EvalType, 0, len(args))
+	argTps = append(argTps, types.ETString, types.ETInt, types.ETString, types.ETInt, types.ETInt, types.ETInt)
+	for i := MatchAgainstColumnArgs; i < len(args); i++ {
+		argTps = append(argTps, types.ETString)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	collation := args[MatchAgainstColumnArgs].GetType().GetCollate()
+	sig := &builtinMatchAgainstSig{
+		baseBuiltinFunc: bf,
+		mode:            meta[MatchAgainstModeArg].GetInt64(),
+		parser:          meta[MatchAgainstParserArg].GetString(),
+		caseSensitive:   fulltext.IsCaseSensitive(collation),
+		tableID:         meta[MatchAgainstTableIDArg].GetInt64(),
+		indexID:         meta[MatchAgainstIndexIDArg].GetInt64(),
+		totalDocs:       meta[MatchAgainstTotalDocsArg].GetInt64(),
+	}
+	return sig, nil
+}
+
+// builtinMatchAgainstSig evaluates the relevance of a row to a full-text search. The relevance is 0
+// if the row does not match the search. The document frequencies of the search terms are read from
+// the FULLTEXT index in the current transaction once per search string.
+type builtinMatchAgainstSig struct {
+	baseBuiltinFunc
+
+	mode          int64
+	parser        string
+	caseSensitive bool
+	tableID       int64
+	indexID       int64
+	totalDocs     int64
+
+	tokenizer *fulltext.Tokenizer
+	search    string
+	query     *fulltext.Query
+	idf       map[fulltext.Term]float64
+}
+
+func (b *builtinMatchAgainstSig) Clone() builtinFunc {
+	newSig := &builtinMatchAgainstSig{
+		mode:          b.mode,
+		parser:        b.parser,
+		caseSensitive: b.caseSensitive,
+		tableID:       b.tableID,
+		indexID:       b.indexID,
+		totalDocs:     b.totalDocs,
+	}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinMatchAgainstSig) evalReal(row chunk.Row) (float64, bool, error) {
+	search, isNull, err := b.args[MatchAgainstSearchArg].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if err = b.prepareQuery(search); err != nil {
+		return 0, true, err
+	}
+	if b.query.IsEmpty() {
+		return 0, false, nil
+	}
+	doc := make(fulltext.Document, 0, len(b.args)-MatchAgainstColumnArgs)
+	for _, arg := range b.args[MatchAgainstColumnArgs:] {
+		text, isNull, err := arg.EvalString(b.ctx, row)
+		if err != nil {
+			return 0, true, err
+		}
+		if !isNull {
+			doc = append(doc, b.tokenizer.Tokenize(text))
+		}
+	}
+	return b.query.Relevance(doc, b.termIDF), false, nil
+}
+
+func (b *builtinMatchAgainstSig) termIDF(t fulltext.Term) float64 {
+	return b.idf[t]
+}
+
+// prepareQuery parses the search string and reads the document frequencies of its terms.
+func (b *builtinMatchAgainstSig) prepareQuery(search string) error {
+	if b.query != nil && b.search == search {
+		return nil
+	}
+	query := b.parseQuery(search)
+	idf := make(map[fulltext.Term]float64)
+	for _, t := range query.Terms() {
+		df, err := b.docFreq(t)
+		if err != nil {
+			return err
+		}
+		idf[t] = fulltext.IDF(b.totalDocs, df)
+	}
+	b.search, b.query, b.idf = search, query, idf
+	return nil
+}
+
+func (b *builtinMatchAgainstSig) parseQuery(search string) *fulltext.Query {
+	if b.tokenizer == nil {
+		b.tokenizer = fulltext.NewTokenizer(b.parser, b.caseSensitive)
+	}
+	if b.mode == FullTextBooleanMode {
+		return fulltext.ParseBooleanQuery(b.tokenizer, search)
+	}
+	return fulltext.ParseNaturalLanguageQuery(b.tokenizer, search)
+}
+
+// docFreq counts the rows containing the term by scanning its entries in the FULLTEXT index.
+func (b *builtinMatchAgainstSig) docFreq(t fulltext.Term) (int64, error) {
+	txn, err := b.ctx.Txn(true)
+	if err != nil {
+		return 0, err
+	}
+	sc := b.ctx.GetSessionVars().StmtCtx
+	prefix := tablecodec.EncodeTableIndexPrefix(b.tableID, b.indexID)
+	low, err := codec.EncodeKey(sc, prefix.Clone(), types.NewBytesDatum([]byte(t.Text)))
+	if err != nil {
+		return 0, err
+	}
+	high := kv.Key(low).PrefixNext()
+	if t.Prefix {
+		if next := kv.Key(t.Text).PrefixNext(); len(next) == len(t.Text) {
+			high, err = codec.EncodeKey(sc, prefix.Clone(), types.NewBytesDatum(next))
+			if err != nil {
+				return 0, err
+			}
+		} else {
+			high = prefix.PrefixNext()
+		}
+	}
+	it, err := txn.Iter(low, high)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	var df int64
+	handles := make(map[string]struct{})
+	for it.Valid() {
+		if !t.Prefix {
+			df++
+		} else {
+			_, handle, err := codec.CutOne(it.Key()[len(prefix):])
+			if err != nil {
+				return 0, errors.Trace(err)
+			}
+			if _, ok := handles[string(handle)]; !ok {
+				handles[string(handle)] = struct{}{}
+				df++
+			}
+		}
+		if err = it.Next(); err != nil {
+			return 0, err
+		}
+	}
+	return df, nil
+}
+
+// MatchAgainstIndex returns the table ID and the index ID of the FULLTEXT index used by match_against.
+func MatchAgainstIndex(sf *ScalarFunction) (tableID, indexID int64) {
+	args := sf.GetArgs()
+	return args[MatchAgainstTableIDArg].(*Constant).Value.GetInt64(), args[MatchAgainstIndexIDArg].(*Constant).Value.GetInt64()
+}
+
+// MatchAgainstAccessTerms returns the terms which can be looked up in the FULLTEXT index to find all the rows
+// matching match_against. It returns false if the search string is not a constant or there are no such terms.
+func MatchAgainstAccessTerms(sf *ScalarFunction) ([]fulltext.Term, bool) {
+	sig, ok := sf.Function.(*builtinMatchAgainstSig)
+	if !ok {
+		return nil, false
+	}
+	con, ok := sf.GetArgs()[MatchAgainstSearchArg].(*Constant)
+	if !ok || con.ParamMarker != nil || con.DeferredExpr != nil || con.Value.IsNull() {
+		return nil, false
+	}
+	search, err := con.Value.ToString()
+	if err != nil {
+		return nil, false
+	}
+	query := sig.parseQuery(search)
+	if sig.mode == FullTextBooleanMode {
+		return query.AccessTerms()
+	}
+	terms := query.Terms()
+	return terms, len(terms) > 0
+}
+
+// writeMatchAgainstArgs writes the arguments of match_against as `col1, col2, ..., search[, boolean mode]`,
+// the other arguments are the metadata of the FULLTEXT index and are omitted.
+func writeMatchAgainstArgs(buffer *bytes.Buffer, args []Expression, argString func(Expression) string) {
+	for _, arg := range args[MatchAgainstColumnArgs:] {
+		buffer.WriteString(argString(arg))
+		buffer.WriteString(", ")
+	}
+	buffer.WriteString(argString(args[MatchAgainstSearchArg]))
+	if mode, ok := args[MatchAgainstModeArg].(*Constant); ok && mode.Value.GetInt64() == FullTextBooleanMode {
+		buffer.WriteString(", boolean mode")
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+// newStoredFunction resolves the function which isn't a builtin function to the stored function, the name is
+// either `db.func` or `func` of the current database. It returns nil if the stored function doesn't exist.
+func newStoredFunction(ctx sessionctx.Context, funcName string, args []Expression) (Expression, error) {
+	if GetStoredFunction == nil {
+		return nil, nil
+	}
+	dbName, name, ok := strings.Cut(funcName, ".")
+	if !ok {
+		dbName, name = ctx.GetSessionVars().CurrentDB, funcName
+	}
+	if dbName == "" {
+		return nil, nil
+	}
+	db, routine := GetStoredFunction(ctx, model.NewCIStr(dbName), model.NewCIStr(name))
+	if routine == nil {
+		return nil, nil
+	}
+	fullName := db.O + "." + routine.Name.O
+	if len(args) != len(routine.Params) {
+		return nil, errSpWrongNoOfArgs.GenWithStackByArgs(model.RoutineTypeFunction.String(), fullName, len(routine.Params), len(args))
+	}
+	funcArgs := make([]Expression, len(args))
+	copy(funcArgs, args)
+	retType := routine.ReturnType.Clone()
+	bf, err := newBaseBuiltinFuncWithFieldType(ctx, retType, funcArgs)
+	if err != nil {
+		return nil, err
+	}
+	// The result of the stored function has the same coercibility as the columns.
+	bf.SetCoercibility(CoercibilityImplicit)
+	bf.SetRepertoire(UNICODE)
+	return &ScalarFunction{
+		FuncName: model.NewCIStr(fullName),
+		RetType:  retType,
+		Function: &builtinStoredFunctionSig{baseBuiltinFunc: bf, db: db, routine: routine},
+	}, nil
+}
+
+// GetStoredFunctionInfo returns the schema and the meta of the stored function if the expression calls a stored
+// function.
+func GetStoredFunctionInfo(expr Expression) (model.CIStr, *model.RoutineInfo, bool) {
+	sf, ok := expr.(*ScalarFunction)
+	if !ok {
+		return model.CIStr{}, nil, false
+	}
+	sig, ok := sf.Function.(*builtinStoredFunctionSig)
+	if !ok {
+		return model.CIStr{}, nil, false
+	}
+	return sig.db, sig.routine, true
+}
+
+// builtinStoredFunctionSig evaluates the stored function through EvalStoredFunction.
+type builtinStoredFunctionSig struct {
+	baseBuiltinFunc
+	db      model.CIStr
+	routine *model.RoutineInfo
+}
+
+func (b *builtinStoredFunctionSig) Clone() builtinFunc {
+	newSig := &builtinStoredFunctionSig{db: b.db, routine: b.routine}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinStoredFunctionSig) eval(row chunk.Row) (types.Datum, bool, error) {
+	if EvalStoredFunction == nil {
+		return types.Datum{}, true, errors.New("stored function is not supported")
+	}
+	args := make([]types.Datum, 0, len(b.args))
+	for _, arg := range b.args {
+		d, err := arg.Eval(row)
+		if err != nil {
+			return types.Datum{}, true, err
+		}
+		args = append(args, d)
+	}
+	d, err := EvalStoredFunction(b.ctx, b.db, b.routine, args)
+	if err != nil {
+		return types.Datum{}, true, err
+	}
+	return d, d.IsNull(), nil
+}
+
+func (b *builtinStoredFunctionSig) evalInt(row chunk.Row) (int64, bool, error) {
+	d, isNull, err := b.eval(row)
+	if isNull || err != nil {
+		return 0, true, err
+	}
+	switch d.Kind() {
+	case types.KindInt64, types.KindUint64:
+		return d.GetInt64(), false, nil
+	}
+	val, err := d.ToInt64(b.ctx.GetSessionVars().StmtCtx)
+	return val, false, err
+}
+
+func (b *builtinStoredFunctionSig) evalReal(row chunk.Row) (float64, bool, error) {
+	d, isNull, err := b.eval(row)
+	if isNull || err != nil {
+		return 0, true, err
+	}
+	val, err := d.ToFloat64(b.ctx.GetSessionVars().StmtCtx)
+	return val, false, err
+}
+
+func (b *builtinStoredFunctionSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	d, isNull, err := b.eval(row)
+	if isNull || err != nil {
+		return nil, true, err
+	}
+	val, err := d.ToDecimal(b.ctx.GetSessionVars().StmtCtx)
+	return val, false, err
+}
+
+func (b *builtinStoredFunctionSig) evalString(row chunk.Row) (string, bool, error) {
+	d, isNull, err := b.eval(row)
+	if isNull || err != nil {
+		return "", true, err
+	}
+	val, err := d.ToString()
+	return val, false, err
+}
+
+func (b *builtinStoredFunctionSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	d, isNull, err := b.eval(row)
+	if isNull || err != nil {
+		return types.ZeroTime, true, err
+	}
+	return d.GetMysqlTime(), false, nil
+}
+
+func (b *builtinStoredFunctionSig) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	d, isNull, err := b.eval(row)
+	if isNull || err != nil {
+		return types.Duration{}, true, err
+	}
+	return d.GetMysqlDuration(), false, nil
+}
+
+func (b *builtinStoredFunctionSig) evalJSON(row chunk.Row) (types.BinaryJSON, bool, error) {
+	d, isNull, err := b.eval(row)
+	if isNull || err != nil {
+		return types.BinaryJSON{}, true, err
+	}
+	return d.GetMysqlJSON(), false, nil
+}
@@ -0,0 +1,1175 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"math"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/hack"
+)
+
+// The geometry values are binary strings in the MySQL internal format, which is a 4-byte little-endian SRID followed by
+// the WKB. All the computations are done in a Cartesian plane regardless of the SRID, except ST_Distance_Sphere and the
+// geohash functions which take the X and Y as the longitude and latitude in degrees.
+
+var (
+	_ functionClass = &pointFunctionClass{}
+	_ functionClass = &geomCollectionFunctionClass{}
+	_ functionClass = &geomFromTextFunctionClass{}
+	_ functionClass = &geomFromWKBFunctionClass{}
+	_ functionClass = &geomFromGeoJSONFunctionClass{}
+	_ functionClass = &geomAsTextFunctionClass{}
+	_ functionClass = &geomAsBinaryFunctionClass{}
+	_ functionClass = &geomAsGeoJSONFunctionClass{}
+	_ functionClass = &geomSRIDFunctionClass{}
+	_ functionClass = &pointCoordFunctionClass{}
+	_ functionClass = &geometryTypeFunctionClass{}
+	_ functionClass = &geomIsEmptyFunctionClass{}
+	_ functionClass = &geomAreaFunctionClass{}
+	_ functionClass = &geomLengthFunctionClass{}
+	_ functionClass = &geomRelationFunctionClass{}
+	_ functionClass = &geomDistanceFunctionClass{}
+	_ functionClass = &geomDistanceSphereFunctionClass{}
+	_ functionClass = &geoHashFunctionClass{}
+	_ functionClass = &geoHashCoordFunctionClass{}
+	_ functionClass = &pointFromGeoHashFunctionClass{}
+
+	_ builtinFunc = &builtinPointSig{}
+	_ builtinFunc = &builtinGeomCollectionSig{}
+	_ builtinFunc = &builtinGeomFromTextSig{}
+	_ builtinFunc = &builtinGeomFromWKBSig{}
+	_ builtinFunc = &builtinGeomFromGeoJSONSig{}
+	_ builtinFunc = &builtinGeomAsTextSig{}
+	_ builtinFunc = &builtinGeomAsBinarySig{}
+	_ builtinFunc = &builtinGeomAsGeoJSONSig{}
+	_ builtinFunc = &builtinGeomSRIDSig{}
+	_ builtinFunc = &builtinGeomSetSRIDSig{}
+	_ builtinFunc = &builtinPointCoordSig{}
+	_ builtinFunc = &builtinGeometryTypeSig{}
+	_ builtinFunc = &builtinGeomIsEmptySig{}
+	_ builtinFunc = &builtinGeomAreaSig{}
+	_ builtinFunc = &builtinGeomLengthSig{}
+	_ builtinFunc = &builtinGeomRelationSig{}
+	_ builtinFunc = &builtinGeomDistanceSig{}
+	_ builtinFunc = &builtinGeomDistanceSphereSig{}
+	_ builtinFunc = &builtinGeoHashSig{}
+	_ builtinFunc = &builtinGeoHashCoordSig{}
+	_ builtinFunc = &builtinPointFromGeoHashSig{}
+)
+
+// setGeometryRetType sets the return type of a function returning geometry values.
+func setGeometryRetType(bf *baseBuiltinFunc) {
+	bf.tp.SetType(mysql.TypeGeometry)
+	bf.tp.SetFlen(mysql.MaxBlobWidth)
+	types.SetBinChsClnFlag(bf.tp)
+}
+
+// evalGeometry evaluates the argument and parses it as a geometry value.
+func evalGeometry(ctx sessionctx.Context, funcName string, arg Expression, row chunk.Row) (*types.Geometry, bool, error) {
+	s, isNull, err := arg.EvalString(ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	g, err := types.ParseGeometry(hack.Slice(s))
+	if err != nil {
+		return nil, false, ErrGISInvalidData.GenWithStackByArgs(funcName)
+	}
+	return g, false, nil
+}
+
+// evalGeometryPair evaluates the two geometry arguments, which must have the same SRID.
+func evalGeometryPair(ctx sessionctx.Context, funcName string, args []Expression, row chunk.Row) (g1, g2 *types.Geometry, isNull bool, err error) {
+	if g1, isNull, err = evalGeometry(ctx, funcName, args[0], row); isNull || err != nil {
+		return nil, nil, isNull, err
+	}
+	if g2, isNull, err = evalGeometry(ctx, funcName, args[1], row); isNull || err != nil {
+		return nil, nil, isNull, err
+	}
+	if g1.SRID != g2.SRID {
+		return nil, nil, false, ErrGISDifferentSRIDs.GenWithStackByArgs(funcName, g1.SRID, g2.SRID)
+	}
+	return g1, g2, false, nil
+}
+
+// evalSRID evaluates the SRID argument, which must fit in 32 bits.
+func evalSRID(ctx sessionctx.Context, funcName string, arg Expression, row chunk.Row) (uint32, bool, error) {
+	srid, isNull, err := arg.EvalInt(ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if (srid < 0 && !mysql.HasUnsignedFlag(arg.GetType().GetFlag())) || uint64(srid) > math.MaxUint32 {
+		return 0, false, types.ErrOverflow.GenWithStackByArgs("SRID", funcName)
+	}
+	return uint32(srid), false, nil
+}
+
+// checkLongitudeLatitude checks whether the coordinates are in the range of the geographic coordinates.
+func checkLongitudeLatitude(funcName string, lon, lat float64) error {
+	if lon <= -180 || lon > 180 {
+		return ErrLongitudeOutOfRange.GenWithStackByArgs(lon, funcName, -180.0, 180.0)
+	}
+	if lat < -90 || lat > 90 {
+		return ErrLatitudeOutOfRange.GenWithStackByArgs(lat, funcName, -90.0, 90.0)
+	}
+	return nil
+}
+
+type pointFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *pointFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETReal, types.ETReal)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf)
+	sig := &builtinPointSig{bf}
+	return sig, nil
+}
+
+type builtinPointSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinPointSig) Clone() builtinFunc {
+	newSig := &builtinPointSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals POINT(x, y).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-mysql-specific-functions.html#function_point
+func (b *builtinPointSig) evalString(row chunk.Row) (string, bool, error) {
+	x, isNull, err := b.args[0].EvalReal(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	y, isNull, err := b.args[1].EvalReal(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return string(types.NewGeomPoint(x, y, 0).Encode()), false, nil
+}
+
+// geomCollectionFunctionClass builds LineString, Polygon, the Multi* types and GeometryCollection from their members.
+type geomCollectionFunctionClass struct {
+	baseFunctionClass
+
+	geomType byte
+}
+
+func (c *geomCollectionFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := make([]types.EvalType, len(args))
+	for i := range args {
+		argTps[i] = types.ETString
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf)
+	sig := &builtinGeomCollectionSig{bf, c.funcName, c.geomType}
+	return sig, nil
+}
+
+type builtinGeomCollectionSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	geomType byte
+}
+
+func (b *builtinGeomCollectionSig) Clone() builtinFunc {
+	newSig := &builtinGeomCollectionSig{funcName: b.funcName, geomType: b.geomType}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// memberType returns the required type of the members, GeometryTypeGeometry means any type.
+func (b *builtinGeomCollectionSig) memberType() byte {
+	switch b.geomType {
+	case mysql.GeometryTypeLineString:
+		return mysql.GeometryTypePoint
+	case mysql.GeometryTypePolygon:
+		return mysql.GeometryTypeLineString
+	case mysql.GeometryTypeGeometryCollection:
+		return mysql.GeometryTypeGeometry
+	}
+	return b.geomType - 3
+}
+
+// evalString evals LINESTRING(pt [, pt] ...), POLYGON(ls [, ls] ...), MULTIPOINT(pt [, pt2] ...),
+// MULTILINESTRING(ls [, ls] ...), MULTIPOLYGON(poly [, poly] ...) and GEOMETRYCOLLECTION([g [, g] ...]).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-mysql-specific-functions.html
+func (b *builtinGeomCollectionSig) evalString(row chunk.Row) (string, bool, error) {
+	g := &types.Geometry{Tp: b.geomType}
+	memberType := b.memberType()
+	for i, arg := range b.args {
+		m, isNull, err := evalGeometry(b.ctx, b.funcName, arg, row)
+		if isNull || err != nil {
+			return "", isNull, err
+		}
+		if memberType != mysql.GeometryTypeGeometry && m.Tp != memberType {
+			return "", false, ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+		}
+		if i == 0 {
+			g.SRID = m.SRID
+		} else if m.SRID != g.SRID {
+			return "", false, ErrGISDifferentSRIDs.GenWithStackByArgs(b.funcName, g.SRID, m.SRID)
+		}
+		switch b.geomType {
+		case mysql.GeometryTypeLineString:
+			g.Points = append(g.Points, m.Points[0])
+		case mysql.GeometryTypePolygon:
+			g.Rings = append(g.Rings, m.Points)
+		default:
+			g.Geoms = append(g.Geoms, m)
+		}
+	}
+	if err := g.Validate(); err != nil {
+		return "", false, ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+	}
+	return string(g.Encode()), false, nil
+}
+
+// geomFromTextFunctionClass is for ST_GeomFromText and the functions which accept only one type of geometry.
+type geomFromTextFunctionClass struct {
+	baseFunctionClass
+
+	geomType byte
+}
+
+func (c *geomFromTextFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString}
+	if len(args) == 2 {
+		argTps = append(argTps, types.ETInt)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf)
+	sig := &builtinGeomFromTextSig{bf, c.funcName, c.geomType}
+	return sig, nil
+}
+
+type builtinGeomFromTextSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	geomType byte
+}
+
+func (b *builtinGeomFromTextSig) Clone() builtinFunc {
+	newSig := &builtinGeomFromTextSig{funcName: b.funcName, geomType: b.geomType}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_GeomFromText(wkt [, srid]).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-wkt-functions.html#function_st-geomfromtext
+func (b *builtinGeomFromTextSig) evalString(row chunk.Row) (string, bool, error) {
+	wkt, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	var srid uint32
+	if len(b.args) == 2 {
+		if srid, isNull, err = evalSRID(b.ctx, b.funcName, b.args[1], row); isNull || err != nil {
+			return "", isNull, err
+		}
+	}
+	g, err := types.ParseWKT(wkt, srid)
+	if err != nil || (b.geomType != mysql.GeometryTypeGeometry && g.Tp != b.geomType) {
+		return "", false, ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+	}
+	return string(g.Encode()), false, nil
+}
+
+type geomFromWKBFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomFromWKBFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString}
+	if len(args) == 2 {
+		argTps = append(argTps, types.ETInt)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf)
+	sig := &builtinGeomFromWKBSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeomFromWKBSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinGeomFromWKBSig) Clone() builtinFunc {
+	newSig := &builtinGeomFromWKBSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_GeomFromWKB(wkb [, srid]).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-wkb-functions.html#function_st-geomfromwkb
+func (b *builtinGeomFromWKBSig) evalString(row chunk.Row) (string, bool, error) {
+	wkb, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	var srid uint32
+	if len(b.args) == 2 {
+		if srid, isNull, err = evalSRID(b.ctx, b.funcName, b.args[1], row); isNull || err != nil {
+			return "", isNull, err
+		}
+	}
+	g, err := types.ParseWKB(hack.Slice(wkb), srid)
+	if err != nil {
+		return "", false, ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+	}
+	return string(g.Encode()), false, nil
+}
+
+type geomFromGeoJSONFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomFromGeoJSONFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETJson}
+	for range args[1:] {
+		argTps = append(argTps, types.ETInt)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf)
+	sig := &builtinGeomFromGeoJSONSig{bf}
+	return sig, nil
+}
+
+type builtinGeomFromGeoJSONSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomFromGeoJSONSig) Clone() builtinFunc {
+	newSig := &builtinGeomFromGeoJSONSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_GeomFromGeoJSON(str [, options [, srid]]).
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-geojson-functions.html#function_st-geomfromgeojson
+func (b *builtinGeomFromGeoJSONSig) evalString(row chunk.Row) (string, bool, error) {
+	const funcName = "st_geomfromgeojson"
+	doc, isNull, err := b.args[0].EvalJSON(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	options, srid := int64(1), uint32(4326)
+	if len(b.args) > 1 {
+		if options, isNull, err = b.args[1].EvalInt(b.ctx, row); isNull || err != nil {
+			return "", isNull, err
+		}
+		if options < 1 || options > 4 {
+			return "", false, types.ErrOverflow.GenWithStackByArgs("options", funcName)
+		}
+	}
+	if len(b.args) > 2 {
+		if srid, isNull, err = evalSRID(b.ctx, funcName, b.args[2], row); isNull || err != nil {
+			return "", isNull, err
+		}
+	}
+	g, err := types.ParseGeoJSON(doc, srid, options > 1)
+	if err != nil {
+		return "", false, ErrGISInvalidData.GenWithStackByArgs(funcName)
+	}
+	return string(g.Encode()), false, nil
+}
+
+type geomAsTextFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomAsTextFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	charset, collate := ctx.GetSessionVars().GetCharsetInfo()
+	bf.tp.SetCharset(charset)
+	bf.tp.SetCollate(collate)
+	bf.tp.SetFlen(mysql.MaxBlobWidth)
+	sig := &builtinGeomAsTextSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeomAsTextSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinGeomAsTextSig) Clone() builtinFunc {
+	newSig := &builtinGeomAsTextSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_AsText(g).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-format-conversion-functions.html#function_st-astext
+func (b *builtinGeomAsTextSig) evalString(row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.funcName, b.args[0], row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return g.WKT(), false, nil
+}
+
+type geomAsBinaryFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomAsBinaryFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(mysql.MaxBlobWidth)
+	types.SetBinChsClnFlag(bf.tp)
+	sig := &builtinGeomAsBinarySig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeomAsBinarySig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinGeomAsBinarySig) Clone() builtinFunc {
+	newSig := &builtinGeomAsBinarySig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_AsBinary(g).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-format-conversion-functions.html#function_st-asbinary
+func (b *builtinGeomAsBinarySig) evalString(row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.funcName, b.args[0], row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return string(g.WKB()), false, nil
+}
+
+type geomAsGeoJSONFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomAsGeoJSONFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString}
+	for range args[1:] {
+		argTps = append(argTps, types.ETInt)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETJson, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeomAsGeoJSONSig{bf}
+	return sig, nil
+}
+
+type builtinGeomAsGeoJSONSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomAsGeoJSONSig) Clone() builtinFunc {
+	newSig := &builtinGeomAsGeoJSONSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalJSON evals ST_AsGeoJSON(g [, max_dec_digits [, options]]).
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-geojson-functions.html#function_st-asgeojson
+func (b *builtinGeomAsGeoJSONSig) evalJSON(row chunk.Row) (types.BinaryJSON, bool, error) {
+	const funcName = "st_asgeojson"
+	g, isNull, err := evalGeometry(b.ctx, funcName, b.args[0], row)
+	if isNull || err != nil {
+		return types.BinaryJSON{}, isNull, err
+	}
+	maxDecimals, options := int64(math.MaxInt32), int64(0)
+	if len(b.args) > 1 {
+		if maxDecimals, isNull, err = b.args[1].EvalInt(b.ctx, row); isNull || err != nil {
+			return types.BinaryJSON{}, isNull, err
+		}
+		if maxDecimals < 0 || maxDecimals > math.MaxInt32 {
+			return types.BinaryJSON{}, false, types.ErrOverflow.GenWithStackByArgs("max_dec_digits", funcName)
+		}
+	}
+	if len(b.args) > 2 {
+		if options, isNull, err = b.args[2].EvalInt(b.ctx, row); isNull || err != nil {
+			return types.BinaryJSON{}, isNull, err
+		}
+		if options < 0 || options > 7 {
+			return types.BinaryJSON{}, false, types.ErrOverflow.GenWithStackByArgs("options", funcName)
+		}
+	}
+	return g.GeoJSON(int(maxDecimals), int(options)), false, nil
+}
+
+type geomSRIDFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomSRIDFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	if len(args) == 2 {
+		bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString, types.ETInt)
+		if err != nil {
+			return nil, err
+		}
+		setGeometryRetType(&bf)
+		sig := &builtinGeomSetSRIDSig{bf}
+		return sig, nil
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.AddFlag(mysql.UnsignedFlag)
+	sig := &builtinGeomSRIDSig{bf}
+	return sig, nil
+}
+
+type builtinGeomSRIDSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomSRIDSig) Clone() builtinFunc {
+	newSig := &builtinGeomSRIDSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals ST_SRID(g).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-srid
+func (b *builtinGeomSRIDSig) evalInt(row chunk.Row) (int64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, "st_srid", b.args[0], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	return int64(g.SRID), false, nil
+}
+
+type builtinGeomSetSRIDSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomSetSRIDSig) Clone() builtinFunc {
+	newSig := &builtinGeomSetSRIDSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_SRID(g, srid).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-srid
+func (b *builtinGeomSetSRIDSig) evalString(row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, "st_srid", b.args[0], row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	srid, isNull, err := evalSRID(b.ctx, "st_srid", b.args[1], row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	g.SetSRID(srid)
+	return string(g.Encode()), false, nil
+}
+
+type pointCoordFunctionClass struct {
+	baseFunctionClass
+
+	isY bool
+}
+
+func (c *pointCoordFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinPointCoordSig{bf, c.funcName, c.isY}
+	return sig, nil
+}
+
+type builtinPointCoordSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	isY      bool
+}
+
+func (b *builtinPointCoordSig) Clone() builtinFunc {
+	newSig := &builtinPointCoordSig{funcName: b.funcName, isY: b.isY}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals ST_X(p) and ST_Y(p).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-point-property-functions.html
+func (b *builtinPointCoordSig) evalReal(row chunk.Row) (float64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.funcName, b.args[0], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if g.Tp != mysql.GeometryTypePoint {
+		return 0, false, ErrGISUnsupportedArgument.GenWithStackByArgs(b.funcName)
+	}
+	if b.isY {
+		return g.Points[0].Y, false, nil
+	}
+	return g.Points[0].X, false, nil
+}
+
+type geometryTypeFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometryTypeFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	charset, collate := ctx.GetSessionVars().GetCharsetInfo()
+	bf.tp.SetCharset(charset)
+	bf.tp.SetCollate(collate)
+	bf.tp.SetFlen(len("GEOMETRYCOLLECTION"))
+	sig := &builtinGeometryTypeSig{bf}
+	return sig, nil
+}
+
+type builtinGeometryTypeSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeometryTypeSig) Clone() builtinFunc {
+	newSig := &builtinGeometryTypeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_GeometryType(g).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-geometrytype
+func (b *builtinGeometryTypeSig) evalString(row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, "st_geometrytype", b.args[0], row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return types.GeometryTypeName(g.Tp), false, nil
+}
+
+type geomIsEmptyFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomIsEmptyFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(1)
+	sig := &builtinGeomIsEmptySig{bf}
+	return sig, nil
+}
+
+type builtinGeomIsEmptySig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomIsEmptySig) Clone() builtinFunc {
+	newSig := &builtinGeomIsEmptySig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals ST_IsEmpty(g).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-isempty
+func (b *builtinGeomIsEmptySig) evalInt(row chunk.Row) (int64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, "st_isempty", b.args[0], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if g.IsEmpty() {
+		return 1, false, nil
+	}
+	return 0, false, nil
+}
+
+type geomAreaFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomAreaFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeomAreaSig{bf}
+	return sig, nil
+}
+
+type builtinGeomAreaSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomAreaSig) Clone() builtinFunc {
+	newSig := &builtinGeomAreaSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals ST_Area(poly).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-polygon-property-functions.html#function_st-area
+func (b *builtinGeomAreaSig) evalReal(row chunk.Row) (float64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, "st_area", b.args[0], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	area, ok := g.Area()
+	if !ok {
+		return 0, false, ErrGISUnsupportedArgument.GenWithStackByArgs("st_area")
+	}
+	return area, false, nil
+}
+
+type geomLengthFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomLengthFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeomLengthSig{bf}
+	return sig, nil
+}
+
+type builtinGeomLengthSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomLengthSig) Clone() builtinFunc {
+	newSig := &builtinGeomLengthSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals ST_Length(ls).
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-linestring-property-functions.html#function_st-length
+func (b *builtinGeomLengthSig) evalReal(row chunk.Row) (float64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, "st_length", b.args[0], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	length, ok := g.Length()
+	if !ok {
+		return 0, false, ErrGISUnsupportedArgument.GenWithStackByArgs("st_length")
+	}
+	return length, false, nil
+}
+
+// geomRelationFunctionClass is for the functions which test the spatial relation between two geometries.
+type geomRelationFunctionClass struct {
+	baseFunctionClass
+
+	relate func(g1, g2 *types.Geometry) bool
+}
+
+func (c *geomRelationFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(1)
+	sig := &builtinGeomRelationSig{bf, c.funcName, c.relate}
+	return sig, nil
+}
+
+type builtinGeomRelationSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	relate   func(g1, g2 *types.Geometry) bool
+}
+
+func (b *builtinGeomRelationSig) Clone() builtinFunc {
+	newSig := &builtinGeomRelationSig{funcName: b.funcName, relate: b.relate}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals ST_Contains, ST_Within, ST_Intersects, ST_Disjoint and ST_Equals.
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-relation-functions-object-shapes.html
+func (b *builtinGeomRelationSig) evalInt(row chunk.Row) (int64, bool, error) {
+	g1, g2, isNull, err := evalGeometryPair(b.ctx, b.funcName, b.args, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if b.relate(g1, g2) {
+		return 1, false, nil
+	}
+	return 0, false, nil
+}
+
+func geomWithin(g1, g2 *types.Geometry) bool {
+	return g2.Contains(g1)
+}
+
+func geomDisjoint(g1, g2 *types.Geometry) bool {
+	return !g1.Intersects(g2)
+}
+
+type geomDistanceFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomDistanceFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeomDistanceSig{bf}
+	return sig, nil
+}
+
+type builtinGeomDistanceSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomDistanceSig) Clone() builtinFunc {
+	newSig := &builtinGeomDistanceSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals ST_Distance(g1, g2), which is NULL if any of the geometries is empty.
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-relation-functions-object-shapes.html#function_st-distance
+func (b *builtinGeomDistanceSig) evalReal(row chunk.Row) (float64, bool, error) {
+	g1, g2, isNull, err := evalGeometryPair(b.ctx, "st_distance", b.args, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	dist, ok := g1.Distance(g2)
+	return dist, !ok, nil
+}
+
+type geomDistanceSphereFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomDistanceSphereFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString, types.ETString}
+	if len(args) == 3 {
+		argTps = append(argTps, types.ETReal)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeomDistanceSphereSig{bf}
+	return sig, nil
+}
+
+type builtinGeomDistanceSphereSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeomDistanceSphereSig) Clone() builtinFunc {
+	newSig := &builtinGeomDistanceSphereSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals ST_Distance_Sphere(g1, g2 [, radius]).
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-convenience-functions.html#function_st-distance-sphere
+func (b *builtinGeomDistanceSphereSig) evalReal(row chunk.Row) (float64, bool, error) {
+	const funcName = "st_distance_sphere"
+	g1, g2, isNull, err := evalGeometryPair(b.ctx, funcName, b.args, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	radius := float64(types.DefaultEarthRadius)
+	if len(b.args) == 3 {
+		if radius, isNull, err = b.args[2].EvalReal(b.ctx, row); isNull || err != nil {
+			return 0, isNull, err
+		}
+		if radius <= 0 {
+			return 0, false, errIncorrectArgs.GenWithStackByArgs(funcName)
+		}
+	}
+	for _, g := range []*types.Geometry{g1, g2} {
+		points := g.Points
+		switch g.Tp {
+		case mysql.GeometryTypePoint:
+		case mysql.GeometryTypeMultiPoint:
+			points = nil
+			for _, m := range g.Geoms {
+				points = append(points, m.Points...)
+			}
+		default:
+			return 0, false, ErrGISUnsupportedArgument.GenWithStackByArgs(funcName)
+		}
+		for _, p := range points {
+			if err := checkLongitudeLatitude(funcName, p.X, p.Y); err != nil {
+				return 0, false, err
+			}
+		}
+	}
+	dist, ok := g1.DistanceSphere(g2, radius)
+	if !ok {
+		return 0, false, ErrGISUnsupportedArgument.GenWithStackByArgs(funcName)
+	}
+	return dist, false, nil
+}
+
+type geoHashFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geoHashFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString, types.ETInt}
+	if len(args) == 3 {
+		argTps = []types.EvalType{types.ETReal, types.ETReal, types.ETInt}
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	charset, collate := ctx.GetSessionVars().GetCharsetInfo()
+	bf.tp.SetCharset(charset)
+	bf.tp.SetCollate(collate)
+	bf.tp.SetFlen(types.MaxGeohashLength)
+	sig := &builtinGeoHashSig{bf}
+	return sig, nil
+}
+
+type builtinGeoHashSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGeoHashSig) Clone() builtinFunc {
+	newSig := &builtinGeoHashSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_GeoHash(longitude, latitude, max_length) and ST_GeoHash(point, max_length).
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-geohash-functions.html#function_st-geohash
+func (b *builtinGeoHashSig) evalString(row chunk.Row) (string, bool, error) {
+	const funcName = "st_geohash"
+	var lon, lat float64
+	if len(b.args) == 3 {
+		var isNull bool
+		var err error
+		if lon, isNull, err = b.args[0].EvalReal(b.ctx, row); isNull || err != nil {
+			return "", isNull, err
+		}
+		if lat, isNull, err = b.args[1].EvalReal(b.ctx, row); isNull || err != nil {
+			return "", isNull, err
+		}
+	} else {
+		g, isNull, err := evalGeometry(b.ctx, funcName, b.args[0], row)
+		if isNull || err != nil {
+			return "", isNull, err
+		}
+		if g.Tp != mysql.GeometryTypePoint {
+			return "", false, ErrGISUnsupportedArgument.GenWithStackByArgs(funcName)
+		}
+		lon, lat = g.Points[0].X, g.Points[0].Y
+	}
+	length, isNull, err := b.args[len(b.args)-1].EvalInt(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	if length <= 0 || length > types.MaxGeohashLength {
+		return "", false, errIncorrectArgs.GenWithStackByArgs(funcName)
+	}
+	if lon < -180 || lon > 180 {
+		return "", false, ErrLongitudeOutOfRange.GenWithStackByArgs(lon, funcName, -180.0, 180.0)
+	}
+	if lat < -90 || lat > 90 {
+		return "", false, ErrLatitudeOutOfRange.GenWithStackByArgs(lat, funcName, -90.0, 90.0)
+	}
+	return types.EncodeGeohash(lon, lat, int(length)), false, nil
+}
+
+type geoHashCoordFunctionClass struct {
+	baseFunctionClass
+
+	isLat bool
+}
+
+func (c *geoHashCoordFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeoHashCoordSig{bf, c.funcName, c.isLat}
+	return sig, nil
+}
+
+type builtinGeoHashCoordSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	isLat    bool
+}
+
+func (b *builtinGeoHashCoordSig) Clone() builtinFunc {
+	newSig := &builtinGeoHashCoordSig{funcName: b.funcName, isLat: b.isLat}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals ST_LatFromGeoHash(geohash) and ST_LongFromGeoHash(geohash).
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-geohash-functions.html
+func (b *builtinGeoHashCoordSig) evalReal(row chunk.Row) (float64, bool, error) {
+	hash, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	lon, lat, ok := types.DecodeGeohash(hash)
+	if !ok {
+		return 0, false, ErrIncorrectType.GenWithStackByArgs("geohash", b.funcName)
+	}
+	if b.isLat {
+		return lat, false, nil
+	}
+	return lon, false, nil
+}
+
+type pointFromGeoHashFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *pointFromGeoHashFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString, types.ETInt)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf)
+	sig := &builtinPointFromGeoHashSig{bf}
+	return sig, nil
+}
+
+type builtinPointFromGeoHashSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinPointFromGeoHashSig) Clone() builtinFunc {
+	newSig := &builtinPointFromGeoHashSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ST_PointFromGeoHash(geohash, srid).
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-geohash-functions.html#function_st-pointfromgeohash
+func (b *builtinPointFromGeoHashSig) evalString(row chunk.Row) (string, bool, error) {
+	const funcName = "st_pointfromgeohash"
+	hash, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	srid, isNull, err := evalSRID(b.ctx, funcName, b.args[1], row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	lon, lat, ok := types.DecodeGeohash(hash)
+	if !ok {
+		return "", false, ErrIncorrectType.GenWithStackByArgs("geohash", funcName)
+	}
+	return string(types.NewGeomPoint(lon, lat, srid).Encode()), false, nil
+}
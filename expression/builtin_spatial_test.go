@@ -0,0 +1,98 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeomFromText(t *testing.T) {
+	ctx := createContext(t)
+	tbl := []struct {
+		funcName string
+		args     []interface{}
+		wkt      interface{}
+		srid     uint32
+		err      bool
+	}{
+		{ast.STGeomFromText, []interface{}{nil}, nil, 0, false},
+		{ast.STGeomFromText, []interface{}{"POINT(1 2)"}, "POINT(1 2)", 0, false},
+		{ast.STGeomFromText, []interface{}{"LINESTRING(0 0, 1 1)", 4326}, "LINESTRING(0 0,1 1)", 4326, false},
+		{ast.STGeomFromText, []interface{}{"POINT(1 2)", nil}, nil, 0, false},
+		{ast.STGeomFromText, []interface{}{"POINT(1 2)", -1}, nil, 0, true},
+		{ast.STGeomFromText, []interface{}{"POINT(1)"}, nil, 0, true},
+		{ast.STPointFromText, []interface{}{"POINT(1 2)"}, "POINT(1 2)", 0, false},
+		{ast.STPointFromText, []interface{}{"LINESTRING(0 0,1 1)"}, nil, 0, true},
+		{ast.STPolyFromText, []interface{}{"POLYGON((0 0,1 0,1 1,0 0))", 3857}, "POLYGON((0 0,1 0,1 1,0 0))", 3857, false},
+	}
+	for _, tt := range tbl {
+		f, err := funcs[tt.funcName].getFunction(ctx, datumsToConstants(types.MakeDatums(tt.args...)))
+		require.NoError(t, err)
+		require.Equal(t, mysql.TypeGeometry, f.getRetTp().GetType())
+		require.Equal(t, charset.CharsetBin, f.getRetTp().GetCharset())
+		d, err := evalBuiltinFunc(f, chunk.Row{})
+		if tt.err {
+			require.Error(t, err, tt.args)
+			continue
+		}
+		require.NoError(t, err, tt.args)
+		if tt.wkt == nil {
+			require.True(t, d.IsNull())
+			continue
+		}
+		g, err := types.ParseGeometry(d.GetBytes())
+		require.NoError(t, err)
+		require.Equal(t, tt.wkt, g.WKT())
+		require.Equal(t, tt.srid, g.SRID)
+	}
+}
+
+func TestGeomRelation(t *testing.T) {
+	ctx := createContext(t)
+	square, err := types.ParseWKT("POLYGON((0 0,10 0,10 10,0 10,0 0))", 0)
+	require.NoError(t, err)
+	point := types.NewGeomPoint(0, 5, 0)
+	tbl := []struct {
+		funcName string
+		expected int64
+	}{
+		{ast.STContains, 0},
+		{ast.STWithin, 0},
+		{ast.STIntersects, 1},
+		{ast.STDisjoint, 0},
+		{ast.STEquals, 0},
+	}
+	for _, tt := range tbl {
+		args := types.MakeDatums(square.Encode(), point.Encode())
+		f, err := funcs[tt.funcName].getFunction(ctx, datumsToConstants(args))
+		require.NoError(t, err)
+		d, err := evalBuiltinFunc(f, chunk.Row{})
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, d.GetInt64(), tt.funcName)
+	}
+
+	other := types.NewGeomPoint(0, 5, 4326)
+	f, err := funcs[ast.STContains].getFunction(ctx, datumsToConstants(types.MakeDatums(square.Encode(), other.Encode())))
+	require.NoError(t, err)
+	_, err = evalBuiltinFunc(f, chunk.Row{})
+	require.True(t, ErrGISDifferentSRIDs.Equal(err))
+}
@@ -133,6 +133,9 @@ var (
 		charset.CollationUTF8:    3,
 		charset.CollationUTF8MB4: 3,
 		charset.CollationBin:     4,
+		"utf8mb4_0900_bin":       4,
+		"utf8mb4_0900_ai_ci":     5,
+		"utf8mb4_0900_as_cs":     6,
 	}
 
 	// CollationStrictness indicates the strictness of comparison of the collation. The unequal order in a weak collation also holds in a strict collation.
@@ -143,6 +146,8 @@ var (
 		2: {3, 4},
 		3: {4},
 		4: {},
+		5: {4, 6},
+		6: {4},
 	}
 )
 
@@ -810,6 +810,9 @@ func TestPBToExprWithNewCollation(t *testing.T) {
 		{"some_error_collation", "utf8mb4_bin", 46, 46},
 		{"utf8_unicode_ci", "utf8_unicode_ci", 192, 192},
 		{"utf8mb4_unicode_ci", "utf8mb4_unicode_ci", 224, 224},
+		{"utf8mb4_0900_ai_ci", "utf8mb4_0900_ai_ci", 255, 255},
+		{"utf8mb4_0900_as_cs", "utf8mb4_0900_as_cs", 278, 278},
+		{"utf8mb4_0900_bin", "utf8mb4_0900_bin", 309, 309},
 		{"utf8mb4_zh_pinyin_tidb_as_cs", "utf8mb4_zh_pinyin_tidb_as_cs", 2048, 2048},
 	}
 
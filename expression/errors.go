@@ -41,6 +41,11 @@ var (
 	ErrInvalidJSONForFuncIndex     = dbterror.ClassExpression.NewStd(mysql.ErrInvalidJSONValueForFuncIndex)
 	ErrDataOutOfRangeFuncIndex     = dbterror.ClassExpression.NewStd(mysql.ErrDataOutOfRangeFunctionalIndex)
 	ErrFuncIndexDataIsTooLong      = dbterror.ClassExpression.NewStd(mysql.ErrFunctionalIndexDataIsTooLong)
+	ErrGISDifferentSRIDs           = dbterror.ClassExpression.NewStd(mysql.ErrGISDifferentSRIDs)
+	ErrGISInvalidData              = dbterror.ClassExpression.NewStd(mysql.ErrGISInvalidData)
+	ErrGISUnsupportedArgument      = dbterror.ClassExpression.NewStd(mysql.ErrGISUnsupportedArgument)
+	ErrLongitudeOutOfRange         = dbterror.ClassExpression.NewStd(mysql.ErrLongitudeOutOfRange)
+	ErrLatitudeOutOfRange          = dbterror.ClassExpression.NewStd(mysql.ErrLatitudeOutOfRange)
 
 	// All the un-exported errors are defined here:
 	errFunctionNotExists             = dbterror.ClassExpression.NewStd(mysql.ErrSpDoesNotExist)
@@ -61,6 +66,7 @@ var (
 	errUserLockDeadlock              = dbterror.ClassExpression.NewStd(mysql.ErrUserLockDeadlock)
 	errUserLockWrongName             = dbterror.ClassExpression.NewStd(mysql.ErrUserLockWrongName)
 	errJSONInBooleanContext          = dbterror.ClassExpression.NewStd(mysql.ErrJSONInBooleanContext)
+	errSpWrongNoOfArgs               = dbterror.ClassExpression.NewStd(mysql.ErrSpWrongNoOfArgs)
 
 	// Sequence usage privilege check.
 	errSequenceAccessDenied      = dbterror.ClassExpression.NewStd(mysql.ErrTableaccessDenied)
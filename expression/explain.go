@@ -44,6 +44,13 @@ func (expr *ScalarFunction) explainInfo(normalized bool) string {
 			buffer.WriteString(", ")
 			buffer.WriteString(expr.RetType.String())
 		}
+	case ast.MatchAgainstFunc:
+		writeMatchAgainstArgs(&buffer, expr.GetArgs(), func(arg Expression) string {
+			if normalized {
+				return arg.ExplainNormalizedInfo()
+			}
+			return arg.ExplainInfo()
+		})
 	default:
 		for i, arg := range expr.GetArgs() {
 			if normalized {
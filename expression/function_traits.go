@@ -63,6 +63,18 @@ var unFoldableFunctions = map[string]struct{}{
 	ast.LastVal:   {},
 	ast.SetVal:    {},
 	ast.AnyValue:  {},
+
+	ast.MatchAgainstFunc: {},
+}
+
+// isUnFoldableFunc checks whether the function can not be folded, the stored functions are never folded since
+// they may read or change the state of the session.
+func isUnFoldableFunc(sf *ScalarFunction) bool {
+	if _, ok := unFoldableFunctions[sf.FuncName.L]; ok {
+		return true
+	}
+	_, ok := sf.Function.(*builtinStoredFunctionSig)
+	return ok
 }
 
 // DisableFoldFunctions stores functions which prevent child scope functions from being constant folded.
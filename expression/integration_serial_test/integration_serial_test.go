@@ -1178,6 +1178,36 @@ func TestClusteredIndexAndNewCollation(t *testing.T) {
 	tk.MustQuery("select * from t").Check(testkit.Rows("&"))
 }
 
+func TestUnicode0900Collations(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustQuery("select 'a' = 'A' collate utf8mb4_0900_ai_ci, 'a' = 'A' collate utf8mb4_0900_as_cs, 'a' = 'A' collate utf8mb4_0900_bin").Check(testkit.Rows("1 0 0"))
+	tk.MustQuery("select 'À' = 'a' collate utf8mb4_0900_ai_ci, 'À' = 'A' collate utf8mb4_0900_as_cs, 'ß' = 'ss' collate utf8mb4_0900_ai_ci").Check(testkit.Rows("1 0 1"))
+	// The 0900 collations are NO PAD.
+	tk.MustQuery("select 'a' = 'a ' collate utf8mb4_0900_ai_ci, 'a' < 'a ' collate utf8mb4_0900_as_cs, 'a' = 'a ' collate utf8mb4_0900_bin").Check(testkit.Rows("0 1 0"))
+	tk.MustQuery("select '😜' = '😃' collate utf8mb4_0900_ai_ci, '😜' = '😃' collate utf8mb4_unicode_ci").Check(testkit.Rows("0 1"))
+	tk.MustQuery("select 'Ábc' like 'a%' collate utf8mb4_0900_ai_ci, 'Ábc' like 'a%' collate utf8mb4_0900_as_cs, 'Ábc' like 'Á_C' collate utf8mb4_0900_as_cs").Check(testkit.Rows("1 0 0"))
+
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(id int, a varchar(10) collate utf8mb4_0900_ai_ci, b varchar(10) collate utf8mb4_0900_as_cs, c varchar(10) collate utf8mb4_0900_bin, " +
+		"primary key(a, id) clustered, key b(b), unique key c(c))")
+	tk.MustExec("insert into t values (1, 'a', 'a', 'a'), (2, 'A', 'A', 'A'), (3, 'á ', 'á ', 'á '), (4, 'b', 'b', 'b')")
+	tk.MustQuery("select id from t where a = 'a' order by id").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select id from t use index(b) where b = 'a'").Check(testkit.Rows("1"))
+	tk.MustQuery("select id from t use index(c) where c = 'A'").Check(testkit.Rows("2"))
+	tk.MustQuery("select a, count(*) from t group by a order by a").Check(testkit.Rows("a 2", "á  1", "b 1"))
+	tk.MustQuery("select b from t order by b").Check(testkit.Rows("a", "A", "á ", "b"))
+	tk.MustQuery("select c from t use index(c) order by c").Check(testkit.Rows("A", "a", "b", "á "))
+	tk.MustQuery("select b from t use index(b) where b like 'á%'").Check(testkit.Rows("á "))
+	tk.MustQuery("select count(*) from t t1 join t t2 on t1.a = t2.a").Check(testkit.Rows("6"))
+	tk.MustExec("admin check table t")
+	tk.MustGetErrCode("insert into t values (5, 'x', 'x', 'a')", mysql.ErrDupEntry)
+	tk.MustExec("insert into t values (5, 'x', 'x', 'a ')")
+	tk.MustExec("admin check table t")
+}
+
 func TestIssue20608(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
@@ -7987,3 +7987,88 @@ func TestIssue41733(t *testing.T) {
 	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1264 Out of range value for column 'c0' at row 1"))
 	tk.MustQuery("select * from t_big;").Check(testkit.Rows("18446744073709551615"))
 }
+
+func TestSpatialBuiltin(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	// conversion functions
+	tk.MustQuery("select st_astext(st_geomfromtext('multipoint(0 0, 1 1)')), st_astext(st_geomfromtext('POINT(1 2)', 4326))").
+		Check(testkit.Rows("MULTIPOINT((0 0),(1 1)) POINT(1 2)"))
+	tk.MustQuery("select hex(st_asbinary(point(1, -1))), st_srid(st_geomfromtext('POINT(1 2)', 4326)), st_srid(point(1, 2))").
+		Check(testkit.Rows("0101000000000000000000F03F000000000000F0BF 4326 0"))
+	tk.MustQuery("select st_astext(st_geomfromwkb(st_asbinary(linestring(point(0, 0), point(1, 1)))))").
+		Check(testkit.Rows("LINESTRING(0 0,1 1)"))
+	tk.MustQuery(`select st_astext(st_geomfromgeojson('{"type": "Point", "coordinates": [1, 2]}')), st_srid(st_geomfromgeojson('{"type": "Point", "coordinates": [1, 2]}'))`).
+		Check(testkit.Rows("POINT(1 2) 4326"))
+	tk.MustQuery("select st_asgeojson(st_geomfromtext('POINT(1.2345 2.3456)', 4326), 2, 2)").
+		Check(testkit.Rows(`{"coordinates": [1.23, 2.35], "crs": {"properties": {"name": "EPSG:4326"}, "type": "name"}, "type": "Point"}`))
+	tk.MustQuery("select st_astext(polygon(linestring(point(0, 0), point(1, 0), point(1, 1), point(0, 0)))), st_astext(geomcollection())").
+		Check(testkit.Rows("POLYGON((0 0,1 0,1 1,0 0)) GEOMETRYCOLLECTION EMPTY"))
+	tk.MustQuery("select st_astext(st_srid(point(1, 2), 3857)), st_srid(st_srid(point(1, 2), 3857))").
+		Check(testkit.Rows("POINT(1 2) 3857"))
+	require.True(t, expression.ErrGISInvalidData.Equal(tk.QueryToErr("select st_geomfromtext('POINT(1)')")))
+	require.True(t, expression.ErrGISInvalidData.Equal(tk.QueryToErr("select st_pointfromtext('LINESTRING(0 0,1 1)')")))
+	require.True(t, expression.ErrGISInvalidData.Equal(tk.QueryToErr("select st_astext('abc')")))
+	require.True(t, expression.ErrGISInvalidData.Equal(tk.QueryToErr("select linestring(point(0, 0), linestring(point(0, 0), point(1, 1)))")))
+	tk.MustQuery("select st_astext(null), st_geomfromtext(null) is null").Check(testkit.Rows("<nil> 1"))
+
+	// property and measurement functions
+	tk.MustQuery("select st_x(point(1, 2)), st_y(point(1, 2)), st_geometrytype(point(1, 2)), st_isempty(geometrycollection())").
+		Check(testkit.Rows("1 2 POINT 1"))
+	tk.MustQuery("select st_area(st_geomfromtext('POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 2,1 1))')), st_length(st_geomfromtext('LINESTRING(0 0,3 4)'))").
+		Check(testkit.Rows("15 5"))
+	require.True(t, expression.ErrGISUnsupportedArgument.Equal(tk.QueryToErr("select st_x(st_geomfromtext('LINESTRING(0 0,1 1)'))")))
+
+	// relation functions
+	tk.MustExec("set @square = st_geomfromtext('POLYGON((0 0,10 0,10 10,0 10,0 0))')")
+	tk.MustQuery("select st_contains(@square, point(5, 5)), st_within(point(5, 5), @square), st_contains(@square, point(0, 5)), st_intersects(@square, point(0, 5)), st_disjoint(@square, point(11, 5))").
+		Check(testkit.Rows("1 1 0 1 1"))
+	tk.MustQuery("select st_equals(@square, st_geomfromtext('POLYGON((0 0,0 10,10 10,10 0,0 0))')), st_distance(@square, point(13, 14))").
+		Check(testkit.Rows("1 5"))
+	tk.MustQuery("select round(st_distance_sphere(point(2.3522, 48.8566), point(-0.1276, 51.5072))), st_distance(point(0, 0), geomcollection())").
+		Check(testkit.Rows("343529 <nil>"))
+	require.True(t, expression.ErrGISDifferentSRIDs.Equal(tk.QueryToErr("select st_contains(@square, st_geomfromtext('POINT(1 1)', 4326))")))
+	require.True(t, expression.ErrLongitudeOutOfRange.Equal(tk.QueryToErr("select st_distance_sphere(point(200, 0), point(0, 0))")))
+
+	// geohash functions
+	tk.MustQuery("select st_geohash(10.40744, 57.64911, 11), st_geohash(point(10.40744, 57.64911), 5)").
+		Check(testkit.Rows("u4pruydqqvj u4pru"))
+	tk.MustQuery("select st_latfromgeohash('u4pru'), st_longfromgeohash('u4pru'), st_astext(st_pointfromgeohash('s000', 0))").
+		Check(testkit.Rows("57.63 10.4 POINT(0 0)"))
+	require.True(t, expression.ErrIncorrectType.Equal(tk.QueryToErr("select st_latfromgeohash('u4pa')")))
+}
+
+func TestSpatialColumn(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, g geometry, p point srid 4326, pg polygon)")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `id` int(11) NOT NULL,\n" +
+		"  `g` geometry DEFAULT NULL,\n" +
+		"  `p` point /*!80003 SRID 4326 */ DEFAULT NULL,\n" +
+		"  `pg` polygon DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustExec("insert into t values (1, st_geomfromtext('LINESTRING(0 0,1 1)'), st_geomfromtext('POINT(1 2)', 4326), null)")
+	tk.MustExec("insert into t values (2, point(0, 0), st_pointfromgeohash('u4pru', 4326), polygon(linestring(point(0, 0), point(1, 0), point(1, 1), point(0, 0))))")
+	tk.MustQuery("select id, st_astext(g), st_astext(p), st_srid(p), st_astext(pg) from t order by id").Check(testkit.Rows(
+		"1 LINESTRING(0 0,1 1) POINT(1 2) 4326 <nil>",
+		"2 POINT(0 0) POINT(10.4 57.63) 4326 POLYGON((0 0,1 0,1 1,0 0))",
+	))
+	tk.MustQuery("select id from t where st_intersects(g, point(0.5, 0.5)) order by id").Check(testkit.Rows("1"))
+	tk.MustExec("update t set g = st_geomfromtext('POINT(3 3)') where id = 1")
+	tk.MustQuery("select st_astext(g) from t where id = 1").Check(testkit.Rows("POINT(3 3)"))
+
+	tk.MustGetErrCode("insert into t values (3, 'abc', null, null)", errno.ErrCantCreateGeometryObject)
+	tk.MustGetErrCode("insert into t values (3, 1, null, null)", errno.ErrCantCreateGeometryObject)
+	tk.MustGetErrCode("insert into t values (3, null, point(1, 2), null)", errno.ErrWrongSRIDForColumn)
+	tk.MustGetErrCode("insert into t values (3, null, null, point(1, 2))", errno.ErrCantCreateGeometryObject)
+
+	tk.MustGetErrCode("create table t1 (a int srid 4326)", errno.ErrWrongUsage)
+	tk.MustGetErrCode("create table t1 (g geometry default 'abc')", errno.ErrBlobCantHaveDefault)
+	tk.MustGetErrCode("create table t1 (g geometry, index(g))", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create spatial index idx on t(g)", errno.ErrUnsupportedDDLOperation)
+}
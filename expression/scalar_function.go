@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strings"
 	"unsafe"
 
 	"github.com/pingcap/errors"
@@ -121,6 +122,8 @@ func (sf *ScalarFunction) String() string {
 			buffer.WriteString(", ")
 			buffer.WriteString(sf.RetType.String())
 		}
+	case ast.MatchAgainstFunc:
+		writeMatchAgainstArgs(&buffer, sf.GetArgs(), Expression.String)
 	default:
 		for i, arg := range sf.GetArgs() {
 			buffer.WriteString(arg.String())
@@ -203,6 +206,12 @@ func newFunctionImpl(ctx sessionctx.Context, fold int, funcName string, retType
 	}
 
 	if !ok {
+		if sf, err := newStoredFunction(ctx, funcName, args); sf != nil || err != nil {
+			return sf, err
+		}
+		if strings.Contains(funcName, ".") {
+			return nil, errFunctionNotExists.GenWithStackByArgs("FUNCTION", funcName)
+		}
 		db := ctx.GetSessionVars().CurrentDB
 		if db == "" {
 			return nil, errors.Trace(ErrNoDB)
@@ -333,7 +342,7 @@ func (sf *ScalarFunction) IsCorrelated() bool {
 // ConstItem implements Expression interface.
 func (sf *ScalarFunction) ConstItem(sc *stmtctx.StatementContext) bool {
 	// Note: some unfoldable functions are deterministic, we use unFoldableFunctions here for simplification.
-	if _, ok := unFoldableFunctions[sf.FuncName.L]; ok {
+	if isUnFoldableFunc(sf) {
 		return false
 	}
 	for _, arg := range sf.GetArgs() {
@@ -407,6 +407,28 @@ func SetExprColumnInOperand(expr Expression) Expression {
 	return expr
 }
 
+// SetExprCtx binds the expression to ctx. It's used to reuse the clone of an expression built by
+// another session, so expr must not be shared with the other expressions.
+func SetExprCtx(ctx sessionctx.Context, expr Expression) {
+	switch v := expr.(type) {
+	case *ScalarFunction:
+		v.Function.setCtx(ctx)
+		for _, arg := range v.GetArgs() {
+			SetExprCtx(ctx, arg)
+		}
+	case *Constant:
+		// Clone only makes a shallow copy of the constant, so the param marker and the deferred
+		// expression are still shared.
+		if v.ParamMarker != nil {
+			v.ParamMarker = &ParamMarker{ctx: ctx, order: v.ParamMarker.order}
+		}
+		if v.DeferredExpr != nil {
+			v.DeferredExpr = v.DeferredExpr.Clone()
+			SetExprCtx(ctx, v.DeferredExpr)
+		}
+	}
+}
+
 // ColumnSubstitute substitutes the columns in filter to expressions in select fields.
 // e.g. select * from (select b as a from t) k where a < 10 => select * from (select b as a from t where b < 10) k.
 func ColumnSubstitute(expr Expression, schema *Schema, newExprs []Expression) Expression {
@@ -1107,7 +1129,7 @@ func BuildNotNullExpr(ctx sessionctx.Context, expr Expression) Expression {
 func IsRuntimeConstExpr(expr Expression) bool {
 	switch x := expr.(type) {
 	case *ScalarFunction:
-		if _, ok := unFoldableFunctions[x.FuncName.L]; ok {
+		if isUnFoldableFunc(x) {
 			return false
 		}
 		for _, arg := range x.GetArgs() {
@@ -1130,7 +1152,7 @@ func CheckNonDeterministic(e Expression) bool {
 	case *Constant, *Column, *CorrelatedColumn:
 		return false
 	case *ScalarFunction:
-		if _, ok := unFoldableFunctions[x.FuncName.L]; ok {
+		if isUnFoldableFunc(x) {
 			return true
 		}
 		for _, arg := range x.GetArgs() {
@@ -1186,7 +1208,7 @@ func IsMutableEffectsExpr(expr Expression) bool {
 func IsInmutableExpr(expr Expression) bool {
 	switch x := expr.(type) {
 	case *ScalarFunction:
-		if _, ok := unFoldableFunctions[x.FuncName.L]; ok {
+		if isUnFoldableFunc(x) {
 			return false
 		}
 		if _, ok := mutableEffectsFunctions[x.FuncName.L]; ok {
@@ -52,9 +52,17 @@ var (
 //	DB:1 -> {
 //		Table:1 -> table meta data []byte
 //		Table:2 -> table meta data []byte
+//		Routine:3 -> routine meta data []byte
 //		TID:1 -> int64
 //		TID:2 -> int64
 //	}
+//	XAPrepared -> {
+//		XID:1:6731:6231 -> prepared XA transaction meta data []byte
+//	}
+//	XAData:1:6731:6231 -> {
+//		0 -> the 1st chunk of the written keys []byte
+//		1 -> the 2nd chunk of the written keys []byte
+//	}
 //
 
 var (
@@ -64,6 +72,7 @@ var (
 	mDBs                 = []byte("DBs")
 	mDBPrefix            = "DB"
 	mTablePrefix         = "Table"
+	mRoutinePrefix       = "Routine"
 	mSequencePrefix      = "SID"
 	mSeqCyclePrefix      = "SequenceCycle"
 	mTableIDPrefix       = "TID"
@@ -79,6 +88,9 @@ var (
 	mPolicyMagicByte     = CurrentMagicByteVer
 	mDDLTableVersion     = []byte("DDLTableVersion")
 	mMetaDataLock        = []byte("metadataLock")
+	mXAPrepared          = []byte("XAPrepared")
+	mXIDPrefix           = "XID"
+	mXADataPrefix        = "XAData"
 	// the id for 'default' group, the internal ddl can ensure
 	// user created resource group won't duplicate with this id.
 	defaultGroupID = int64(1)
@@ -132,6 +144,10 @@ var (
 	ErrTableExists = dbterror.ClassMeta.NewStd(mysql.ErrTableExists)
 	// ErrTableNotExists is the error for table not exists.
 	ErrTableNotExists = dbterror.ClassMeta.NewStd(mysql.ErrNoSuchTable)
+	// ErrRoutineExists is the error for routine exists.
+	ErrRoutineExists = dbterror.ClassMeta.NewStd(mysql.ErrSpAlreadyExists)
+	// ErrRoutineNotExists is the error for routine not exists.
+	ErrRoutineNotExists = dbterror.ClassMeta.NewStd(mysql.ErrSpDoesNotExist)
 	// ErrDDLReorgElementNotExist is the error for reorg element not exists.
 	ErrDDLReorgElementNotExist = dbterror.ClassMeta.NewStd(errno.ErrDDLReorgElementNotExist)
 	// ErrInvalidString is the error for invalid string to parse
@@ -366,6 +382,10 @@ func ParseTableKey(tableKey []byte) (int64, error) {
 	return int64(id), errors.Trace(err)
 }
 
+func (*Meta) routineKey(routineID int64) []byte {
+	return []byte(fmt.Sprintf("%s:%d", mRoutinePrefix, routineID))
+}
+
 func (*Meta) sequenceKey(sequenceID int64) []byte {
 	return SequenceKey(sequenceID)
 }
@@ -874,6 +894,74 @@ func (m *Meta) UpdateTable(dbID int64, tableInfo *model.TableInfo) error {
 	return errors.Trace(err)
 }
 
+// CreateRoutine creates a stored procedure or function with routineInfo in database.
+func (m *Meta) CreateRoutine(dbID int64, routineInfo *model.RoutineInfo) error {
+	// Check if db exists.
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return errors.Trace(err)
+	}
+
+	// Check if routine exists.
+	routineKey := m.routineKey(routineInfo.ID)
+	v, err := m.txn.HGet(dbKey, routineKey)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if v != nil {
+		return ErrRoutineExists.GenWithStackByArgs(routineInfo.Type, routineInfo.Name)
+	}
+
+	data, err := json.Marshal(routineInfo)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return m.txn.HSet(dbKey, routineKey, data)
+}
+
+// DropRoutine drops the stored procedure or function in database.
+func (m *Meta) DropRoutine(dbID int64, routineID int64) error {
+	// Check if db exists.
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return errors.Trace(err)
+	}
+
+	// Check if routine exists.
+	routineKey := m.routineKey(routineID)
+	v, err := m.txn.HGet(dbKey, routineKey)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if v == nil {
+		return ErrRoutineNotExists.GenWithStackByArgs("ROUTINE", routineID)
+	}
+	return errors.Trace(m.txn.HDel(dbKey, routineKey))
+}
+
+// ListRoutines shows all stored procedures and functions in database.
+func (m *Meta) ListRoutines(dbID int64) ([]*model.RoutineInfo, error) {
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var routines []*model.RoutineInfo
+	err := m.txn.HGetIter(dbKey, func(r structure.HashPair) error {
+		// only handle routine meta
+		if !strings.HasPrefix(string(r.Field), mRoutinePrefix+":") {
+			return nil
+		}
+		routineInfo := &model.RoutineInfo{}
+		if err := json.Unmarshal(r.Value, routineInfo); err != nil {
+			return errors.Trace(err)
+		}
+		routines = append(routines, routineInfo)
+		return nil
+	})
+	return routines, errors.Trace(err)
+}
+
 // IterTables iterates all the table at once, in order to avoid oom.
 func (m *Meta) IterTables(dbID int64, fn func(info *model.TableInfo) error) error {
 	dbKey := m.dbKey(dbID)
@@ -1066,6 +1154,120 @@ func (m *Meta) GetResourceGroup(groupID int64) (*model.ResourceGroupInfo, error)
 	return group, errors.Trace(err)
 }
 
+// XAPreparedTxn is the meta data of a prepared XA transaction.
+type XAPreparedTxn struct {
+	FormatID uint64 `json:"format_id"`
+	Gtrid    []byte `json:"gtrid"`
+	Bqual    []byte `json:"bqual"`
+	// StartTS is the start ts of the prewritten transaction.
+	StartTS uint64 `json:"start_ts"`
+	// Primary is the primary key of the prewritten transaction, whose status decides the transaction.
+	// It's empty if the transaction writes nothing.
+	Primary []byte `json:"primary,omitempty"`
+	// Prepared means all the written keys are prewritten. Otherwise, the transaction is being prepared
+	// or failed to be prepared, it can only be rolled back.
+	Prepared bool `json:"prepared"`
+	// Deltas is the row count changes of the written physical tables.
+	Deltas []XATableDelta `json:"deltas"`
+	// Chunks is the count of the written key chunks.
+	Chunks      int       `json:"chunks"`
+	PrepareTime time.Time `json:"prepare_time"`
+}
+
+// XATableDelta is the row count changes of a table in a prepared XA transaction.
+type XATableDelta struct {
+	TableID int64 `json:"table_id"`
+	Delta   int64 `json:"delta"`
+	Count   int64 `json:"count"`
+}
+
+// XIDKey returns the key to identify the XA transaction.
+func XIDKey(formatID uint64, gtrid, bqual []byte) string {
+	return fmt.Sprintf("%d:%x:%x", formatID, gtrid, bqual)
+}
+
+func (*Meta) xidKey(xid string) []byte {
+	return []byte(fmt.Sprintf("%s:%s", mXIDPrefix, xid))
+}
+
+func (*Meta) xaDataKey(xid string) []byte {
+	return []byte(fmt.Sprintf("%s:%s", mXADataPrefix, xid))
+}
+
+// PrepareXATxn persists the prepared XA transaction with its written key chunks.
+func (m *Meta) PrepareXATxn(txn *XAPreparedTxn, chunks [][]byte) error {
+	xid := XIDKey(txn.FormatID, txn.Gtrid, txn.Bqual)
+	txn.Chunks = len(chunks)
+	data, err := json.Marshal(txn)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	dataKey := m.xaDataKey(xid)
+	for i, chunk := range chunks {
+		if err := m.txn.HSet(dataKey, []byte(strconv.Itoa(i)), chunk); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return m.txn.HSet(mXAPrepared, m.xidKey(xid), data)
+}
+
+// UpdateXATxn updates the meta data of the prepared XA transaction, its written key chunks are kept.
+func (m *Meta) UpdateXATxn(txn *XAPreparedTxn) error {
+	data, err := json.Marshal(txn)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return m.txn.HSet(mXAPrepared, m.xidKey(XIDKey(txn.FormatID, txn.Gtrid, txn.Bqual)), data)
+}
+
+// GetXATxn gets the prepared XA transaction, it returns nil if the transaction doesn't exist.
+func (m *Meta) GetXATxn(xid string) (*XAPreparedTxn, error) {
+	value, err := m.txn.HGet(mXAPrepared, m.xidKey(xid))
+	if err != nil || value == nil {
+		return nil, errors.Trace(err)
+	}
+	txn := &XAPreparedTxn{}
+	err = json.Unmarshal(value, txn)
+	return txn, errors.Trace(err)
+}
+
+// GetXATxnChunk gets the idx-th written key chunk of the prepared XA transaction.
+func (m *Meta) GetXATxnChunk(xid string, idx int) ([]byte, error) {
+	value, err := m.txn.HGet(m.xaDataKey(xid), []byte(strconv.Itoa(idx)))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if value == nil {
+		return nil, errors.Errorf("key chunk %d of XA transaction %s doesn't exist", idx, xid)
+	}
+	return value, nil
+}
+
+// DropXATxn drops the prepared XA transaction with its written keys.
+func (m *Meta) DropXATxn(xid string) error {
+	if err := m.txn.HClear(m.xaDataKey(xid)); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(m.txn.HDel(mXAPrepared, m.xidKey(xid)))
+}
+
+// ListXATxns shows all prepared XA transactions.
+func (m *Meta) ListXATxns() ([]*XAPreparedTxn, error) {
+	res, err := m.txn.HGetAll(mXAPrepared)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	txns := make([]*XAPreparedTxn, 0, len(res))
+	for _, r := range res {
+		txn := &XAPreparedTxn{}
+		if err := json.Unmarshal(r.Value, txn); err != nil {
+			return nil, errors.Trace(err)
+		}
+		txns = append(txns, txn)
+	}
+	return txns, nil
+}
+
 func attachMagicByte(data []byte) []byte {
 	data = append(data, 0)
 	copy(data[1:], data)
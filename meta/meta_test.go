@@ -149,6 +149,113 @@ func TestResourceGroup(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestRoutine(t *testing.T) {
+	store, err := mockstore.NewMockStore()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	txn, err := store.Begin()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, txn.Rollback())
+	}()
+
+	m := meta.NewMeta(txn)
+	dbInfo := &model.DBInfo{ID: 1, Name: model.NewCIStr("a")}
+	require.NoError(t, m.CreateDatabase(dbInfo))
+	tbInfo := &model.TableInfo{ID: 2, Name: model.NewCIStr("t")}
+	require.NoError(t, m.CreateTableOrView(dbInfo.ID, tbInfo))
+
+	proc := &model.RoutineInfo{ID: 3, Name: model.NewCIStr("p"), Type: model.RoutineTypeProcedure, Body: "select 1"}
+	require.NoError(t, m.CreateRoutine(dbInfo.ID, proc))
+	require.True(t, meta.ErrRoutineExists.Equal(m.CreateRoutine(dbInfo.ID, proc)))
+	fn := &model.RoutineInfo{ID: 4, Name: model.NewCIStr("p"), Type: model.RoutineTypeFunction, Body: "return 1"}
+	require.NoError(t, m.CreateRoutine(dbInfo.ID, fn))
+
+	routines, err := m.ListRoutines(dbInfo.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*model.RoutineInfo{proc, fn}, routines)
+	// The routines are not listed as tables.
+	tables, err := m.ListTables(dbInfo.ID)
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+
+	require.NoError(t, m.DropRoutine(dbInfo.ID, proc.ID))
+	require.True(t, meta.ErrRoutineNotExists.Equal(m.DropRoutine(dbInfo.ID, proc.ID)))
+	routines, err = m.ListRoutines(dbInfo.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*model.RoutineInfo{fn}, routines)
+
+	require.NoError(t, m.DropDatabase(dbInfo.ID))
+	_, err = m.ListRoutines(dbInfo.ID)
+	require.True(t, meta.ErrDBNotExists.Equal(err))
+}
+
+func TestXATxn(t *testing.T) {
+	store, err := mockstore.NewMockStore()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	txn, err := store.Begin()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, txn.Rollback())
+	}()
+
+	m := meta.NewMeta(txn)
+	xa := &meta.XAPreparedTxn{
+		FormatID:    1,
+		Gtrid:       []byte("g"),
+		Bqual:       []byte("b"),
+		StartTS:     10,
+		Primary:     []byte("k"),
+		Deltas:      []meta.XATableDelta{{TableID: 100, Delta: 1, Count: 1}},
+		PrepareTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	xid := meta.XIDKey(xa.FormatID, xa.Gtrid, xa.Bqual)
+	require.Equal(t, "1:67:62", xid)
+	got, err := m.GetXATxn(xid)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	require.NoError(t, m.PrepareXATxn(xa, [][]byte{[]byte("c0"), []byte("c1")}))
+	got, err = m.GetXATxn(xid)
+	require.NoError(t, err)
+	require.Equal(t, xa, got)
+	require.Equal(t, 2, got.Chunks)
+	chunk, err := m.GetXATxnChunk(xid, 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("c1"), chunk)
+	_, err = m.GetXATxnChunk(xid, 2)
+	require.Error(t, err)
+	txns, err := m.ListXATxns()
+	require.NoError(t, err)
+	require.Equal(t, []*meta.XAPreparedTxn{xa}, txns)
+
+	xa.Prepared = true
+	require.NoError(t, m.UpdateXATxn(xa))
+	got, err = m.GetXATxn(xid)
+	require.NoError(t, err)
+	require.True(t, got.Prepared)
+	require.Equal(t, 2, got.Chunks)
+	_, err = m.GetXATxnChunk(xid, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, m.DropXATxn(xid))
+	got, err = m.GetXATxn(xid)
+	require.NoError(t, err)
+	require.Nil(t, got)
+	_, err = m.GetXATxnChunk(xid, 0)
+	require.Error(t, err)
+	txns, err = m.ListXATxns()
+	require.NoError(t, err)
+	require.Len(t, txns, 0)
+}
+
 func TestBackupAndRestoreAutoIDs(t *testing.T) {
 	store, err := mockstore.NewMockStore()
 	require.NoError(t, err)
@@ -18,7 +18,10 @@ import (
 	"github.com/pingcap/tidb/parser/format"
 )
 
-var _ StmtNode = &IndexAdviseStmt{}
+var (
+	_ StmtNode = &IndexAdviseStmt{}
+	_ StmtNode = &AdviseIndexStmt{}
+)
 
 // IndexAdviseStmt is used to advise indexes
 type IndexAdviseStmt struct {
@@ -83,3 +86,31 @@ func (n *MaxIndexNumClause) Restore(ctx *format.RestoreCtx) error {
 	}
 	return nil
 }
+
+// AdviseIndexStmt is used to advise indexes for the workload in the statements summary.
+type AdviseIndexStmt struct {
+	stmtNode
+
+	// Limit is the maximum number of the recommended indexes, 0 means unspecified.
+	Limit uint64
+}
+
+// Restore implements Node interface.
+func (n *AdviseIndexStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ADVISE INDEX")
+	if n.Limit > 0 {
+		ctx.WriteKeyWord(" LIMIT ")
+		ctx.WritePlainf("%d", n.Limit)
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AdviseIndexStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AdviseIndexStmt)
+	return v.Leave(n)
+}
@@ -245,6 +245,8 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		return "CreateBinding"
 	case *IndexAdviseStmt:
 		return "IndexAdvise"
+	case *AdviseIndexStmt:
+		return "AdviseIndex"
 	case *DropBindingStmt:
 		return "DropBinding"
 	case *TraceStmt:
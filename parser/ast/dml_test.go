@@ -189,6 +189,9 @@ func TestTableSourceRestore(t *testing.T) {
 		{"tbl as t", "`tbl` AS `t`"},
 		{"(select * from tbl) as t", "(SELECT * FROM `tbl`) AS `t`"},
 		{"(select * from a union select * from b) as t", "(SELECT * FROM `a` UNION SELECT * FROM `b`) AS `t`"},
+		{"lateral (select * from tbl) as t", "LATERAL (SELECT * FROM `tbl`) AS `t`"},
+		{"json_table(j, '$[*]' columns (id for ordinality, a int path '$.a' null on empty default '0' on error, e int exists path '$.e')) as t", "JSON_TABLE(`j`, '$[*]' COLUMNS (`id` FOR ORDINALITY, `a` INT PATH '$.a' NULL ON EMPTY DEFAULT '0' ON ERROR, `e` INT EXISTS PATH '$.e')) AS `t`"},
+		{"json_table('[]', '$' columns (nested '$.b[*]' columns (b json path '$' error on error))) t", "JSON_TABLE(_UTF8MB4'[]', '$' COLUMNS (NESTED PATH '$.b[*]' COLUMNS (`b` JSON PATH '$' ERROR ON ERROR))) AS `t`"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*SelectStmt).From.TableRefs.Left
@@ -228,6 +231,8 @@ func TestJoinRestore(t *testing.T) {
 		{"(select * from t) t1 natural join t2", "(SELECT * FROM `t`) AS `t1` NATURAL JOIN `t2`"},
 		{"(select * from t) t1 cross join t2 on t1.a>t2.a", "(SELECT * FROM `t`) AS `t1` JOIN `t2` ON `t1`.`a`>`t2`.`a`"},
 		{"(select * from t union select * from t1) tb1, t2;", "(SELECT * FROM `t` UNION SELECT * FROM `t1`) AS `tb1`, `t2`"},
+		{"t1, lateral (select * from t2 where t2.a = t1.a) t", "(`t1`) JOIN LATERAL (SELECT * FROM `t2` WHERE `t2`.`a`=`t1`.`a`) AS `t`"},
+		{"t1 left join lateral (select a from t2 where t2.a > t1.a limit 3) t on true", "`t1` LEFT JOIN LATERAL (SELECT `a` FROM `t2` WHERE `t2`.`a`>`t1`.`a` LIMIT 3) AS `t` ON TRUE"},
 		//todo: uncomment this after https://github.com/pingcap/parser/issues/1127 fixed
 		//{"(select a from t) t1 join t t2, t3;", "((SELECT `a` FROM `t`) AS `t1` JOIN `t` AS `t2`) JOIN `t3`"},
 	}
@@ -367,6 +372,7 @@ func TestFrameClauseRestore(t *testing.T) {
 		{"ROWS CURRENT ROW", "ROWS BETWEEN CURRENT ROW AND CURRENT ROW"},
 		{"ROWS UNBOUNDED PRECEDING", "ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW"},
 		{"ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING", "ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING"},
+		{"GROUPS BETWEEN 1 PRECEDING AND CURRENT ROW", "GROUPS BETWEEN 1 PRECEDING AND CURRENT ROW"},
 		{"RANGE BETWEEN ? PRECEDING AND ? FOLLOWING", "RANGE BETWEEN ? PRECEDING AND ? FOLLOWING"},
 		{"RANGE BETWEEN INTERVAL 5 DAY PRECEDING AND INTERVAL '2:30' MINUTE_SECOND FOLLOWING", "RANGE BETWEEN INTERVAL 5 DAY PRECEDING AND INTERVAL _UTF8MB4'2:30' MINUTE_SECOND FOLLOWING"},
 	}
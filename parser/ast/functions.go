@@ -303,6 +303,8 @@ const (
 	GetLock         = "get_lock"
 	ReleaseLock     = "release_lock"
 	Grouping        = "grouping"
+	// MatchAgainstFunc is the internal function which MATCH ... AGAINST is rewritten to.
+	MatchAgainstFunc = "match_against"
 
 	// encryption and compression functions
 	AesDecrypt               = "aes_decrypt"
@@ -354,6 +356,49 @@ const (
 	JSONKeys          = "json_keys"
 	JSONLength        = "json_length"
 
+	// spatial functions
+	Point                = "point"
+	LineString           = "linestring"
+	Polygon              = "polygon"
+	MultiPoint           = "multipoint"
+	MultiLineString      = "multilinestring"
+	MultiPolygon         = "multipolygon"
+	GeometryCollection   = "geometrycollection"
+	GeomCollection       = "geomcollection"
+	STGeomFromText       = "st_geomfromtext"
+	STGeometryFromText   = "st_geometryfromtext"
+	STPointFromText      = "st_pointfromtext"
+	STLineFromText       = "st_linefromtext"
+	STLineStringFromText = "st_linestringfromtext"
+	STPolyFromText       = "st_polyfromtext"
+	STPolygonFromText    = "st_polygonfromtext"
+	STGeomFromWKB        = "st_geomfromwkb"
+	STGeometryFromWKB    = "st_geometryfromwkb"
+	STGeomFromGeoJSON    = "st_geomfromgeojson"
+	STAsText             = "st_astext"
+	STAsWKT              = "st_aswkt"
+	STAsBinary           = "st_asbinary"
+	STAsWKB              = "st_aswkb"
+	STAsGeoJSON          = "st_asgeojson"
+	STSRID               = "st_srid"
+	STX                  = "st_x"
+	STY                  = "st_y"
+	STGeometryType       = "st_geometrytype"
+	STIsEmpty            = "st_isempty"
+	STArea               = "st_area"
+	STLength             = "st_length"
+	STContains           = "st_contains"
+	STWithin             = "st_within"
+	STIntersects         = "st_intersects"
+	STDisjoint           = "st_disjoint"
+	STEquals             = "st_equals"
+	STDistance           = "st_distance"
+	STDistanceSphere     = "st_distance_sphere"
+	STGeoHash            = "st_geohash"
+	STLatFromGeoHash     = "st_latfromgeohash"
+	STLongFromGeoHash    = "st_longfromgeohash"
+	STPointFromGeoHash   = "st_pointfromgeohash"
+
 	// TiDB internal function.
 	TiDBDecodeKey       = "tidb_decode_key"
 	TiDBDecodeBase64Key = "tidb_decode_base64_key"
@@ -916,7 +961,13 @@ type WindowFuncExpr struct {
 func (n *WindowFuncExpr) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord(n.F)
 	ctx.WritePlain("(")
-	for i, v := range n.Args {
+	args := n.Args
+	// The last argument of group_concat is the separator.
+	isGroupConcat := strings.ToLower(n.F) == AggFuncGroupConcat && len(args) > 0
+	if isGroupConcat {
+		args = args[:len(args)-1]
+	}
+	for i, v := range args {
 		if i != 0 {
 			ctx.WritePlain(", ")
 		} else if n.Distinct {
@@ -926,6 +977,12 @@ func (n *WindowFuncExpr) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore WindowFuncExpr.Args[%d]", i)
 		}
 	}
+	if isGroupConcat {
+		ctx.WriteKeyWord(" SEPARATOR ")
+		if err := n.Args[len(n.Args)-1].Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore WindowFuncExpr.Args SEPARATOR")
+		}
+	}
 	ctx.WritePlain(")")
 	if n.FromLast {
 		ctx.WriteKeyWord(" FROM LAST")
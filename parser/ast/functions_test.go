@@ -222,6 +222,7 @@ func TestWindowFuncExprRestore(t *testing.T) {
 		{"FIRST_VALUE(val) RESPECT NULLS OVER w", "FIRST_VALUE(`val`) OVER `w`"},
 		{"NTH_VALUE(val, 233) FROM LAST IGNORE NULLS OVER w", "NTH_VALUE(`val`, 233) FROM LAST IGNORE NULLS OVER `w`"},
 		{"NTH_VALUE(val, 233) FROM FIRST IGNORE NULLS OVER (w)", "NTH_VALUE(`val`, 233) IGNORE NULLS OVER (`w`)"},
+		{"GROUP_CONCAT(DISTINCT a, b SEPARATOR ';') OVER (PARTITION BY a)", "GROUP_CONCAT(DISTINCT `a`, `b` SEPARATOR ';') OVER (PARTITION BY `a`)"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*SelectStmt).Fields.Fields[0].Expr
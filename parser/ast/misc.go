@@ -19,6 +19,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -60,6 +61,7 @@ var (
 	_ StmtNode = &PlanReplayerStmt{}
 	_ StmtNode = &CompactTableStmt{}
 	_ StmtNode = &SetResourceGroupStmt{}
+	_ StmtNode = &XAStmt{}
 
 	_ Node = &PrivElem{}
 	_ Node = &VariableAssignment{}
@@ -768,6 +770,127 @@ func (n *RollbackStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// XAStmtType is the type of an XA statement.
+type XAStmtType int
+
+// XA statement types.
+const (
+	XAStart XAStmtType = iota
+	XAEnd
+	XAPrepare
+	XACommit
+	XARollback
+	XARecover
+)
+
+// XID is the identifier of an XA transaction, which consists of a global transaction
+// identifier, a branch qualifier and a format identifier.
+// See https://dev.mysql.com/doc/refman/8.0/en/xa-statements.html
+type XID struct {
+	Gtrid    string
+	Bqual    string
+	FormatID uint64
+}
+
+// String implements fmt.Stringer interface.
+func (x *XID) String() string {
+	return fmt.Sprintf("%q,%q,%d", x.Gtrid, x.Bqual, x.FormatID)
+}
+
+// Restore writes the XID in the form of gtrid[,bqual[,formatID]].
+func (x *XID) Restore(ctx *format.RestoreCtx) {
+	restoreXIDPart(ctx, x.Gtrid)
+	if x.Bqual != "" || x.FormatID != 1 {
+		ctx.WritePlain(",")
+		restoreXIDPart(ctx, x.Bqual)
+	}
+	if x.FormatID != 1 {
+		ctx.WritePlainf(",%d", x.FormatID)
+	}
+}
+
+func restoreXIDPart(ctx *format.RestoreCtx, part string) {
+	for _, r := range part {
+		if r == utf8.RuneError || !strconv.IsPrint(r) {
+			ctx.WritePlainf("0x%x", part)
+			return
+		}
+	}
+	ctx.WriteString(part)
+}
+
+// XAStmt is a statement to control an XA transaction.
+// See https://dev.mysql.com/doc/refman/8.0/en/xa-statements.html
+type XAStmt struct {
+	stmtNode
+
+	Tp  XAStmtType
+	XID *XID
+	// Join and Resume are the options of XA START.
+	Join   bool
+	Resume bool
+	// Suspend and ForMigrate are the options of XA END.
+	Suspend    bool
+	ForMigrate bool
+	// OnePhase is the option of XA COMMIT.
+	OnePhase bool
+	// ConvertXID is the option of XA RECOVER.
+	ConvertXID bool
+}
+
+// Restore implements Node interface.
+func (n *XAStmt) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case XAStart:
+		ctx.WriteKeyWord("XA START ")
+		n.XID.Restore(ctx)
+		if n.Join {
+			ctx.WriteKeyWord(" JOIN")
+		} else if n.Resume {
+			ctx.WriteKeyWord(" RESUME")
+		}
+	case XAEnd:
+		ctx.WriteKeyWord("XA END ")
+		n.XID.Restore(ctx)
+		if n.Suspend {
+			ctx.WriteKeyWord(" SUSPEND")
+			if n.ForMigrate {
+				ctx.WriteKeyWord(" FOR MIGRATE")
+			}
+		}
+	case XAPrepare:
+		ctx.WriteKeyWord("XA PREPARE ")
+		n.XID.Restore(ctx)
+	case XACommit:
+		ctx.WriteKeyWord("XA COMMIT ")
+		n.XID.Restore(ctx)
+		if n.OnePhase {
+			ctx.WriteKeyWord(" ONE PHASE")
+		}
+	case XARollback:
+		ctx.WriteKeyWord("XA ROLLBACK ")
+		n.XID.Restore(ctx)
+	case XARecover:
+		ctx.WriteKeyWord("XA RECOVER")
+		if n.ConvertXID {
+			ctx.WriteKeyWord(" CONVERT XID")
+		}
+	default:
+		return errors.Errorf("invalid XAStmt type: %d", n.Tp)
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *XAStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*XAStmt)
+	return v.Leave(n)
+}
+
 // UseStmt is a statement to use the DBName database as the current database.
 // See https://dev.mysql.com/doc/refman/5.7/en/use.html
 type UseStmt struct {
@@ -2289,6 +2412,9 @@ const (
 	AdminResetTelemetryID
 	AdminReloadStatistics
 	AdminFlushPlanCache
+	AdminEnableCardinalityFeedback
+	AdminDisableCardinalityFeedback
+	AdminResetCardinalityFeedback
 )
 
 // HandleRange represents a range where handle value >= Begin and < End.
@@ -2384,6 +2510,7 @@ type AdminStmt struct {
 	Where          ExprNode
 	StatementScope StatementScope
 	LimitSimple    LimitSimple
+	SQLDigest      string
 }
 
 // Restore implements Node interface.
@@ -2538,6 +2665,18 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 		} else if n.StatementScope == StatementScopeGlobal {
 			ctx.WriteKeyWord("FLUSH GLOBAL PLAN_CACHE")
 		}
+	case AdminEnableCardinalityFeedback:
+		ctx.WriteKeyWord("ENABLE CARDINALITY FEEDBACK FOR DIGEST ")
+		ctx.WriteString(n.SQLDigest)
+	case AdminDisableCardinalityFeedback:
+		ctx.WriteKeyWord("DISABLE CARDINALITY FEEDBACK FOR DIGEST ")
+		ctx.WriteString(n.SQLDigest)
+	case AdminResetCardinalityFeedback:
+		ctx.WriteKeyWord("RESET CARDINALITY FEEDBACK")
+		if n.SQLDigest != "" {
+			ctx.WriteKeyWord(" FOR DIGEST ")
+			ctx.WriteString(n.SQLDigest)
+		}
 	default:
 		return errors.New("Unsupported AdminStmt type")
 	}
@@ -26,8 +26,8 @@ var (
 	_ Node = &ProcedureDecl{}
 
 	_ StmtNode = &ProcedureBlock{}
-	_ StmtNode = &ProcedureInfo{}
-	_ StmtNode = &DropProcedureStmt{}
+	_ DDLNode  = &ProcedureInfo{}
+	_ DDLNode  = &DropProcedureStmt{}
 	_ StmtNode = &ProcedureElseIfBlock{}
 	_ StmtNode = &ProcedureElseBlock{}
 	_ StmtNode = &ProcedureIfBlock{}
@@ -36,6 +36,8 @@ var (
 	_ StmtNode = &ProcedureLabelBlock{}
 	_ StmtNode = &ProcedureLabelLoop{}
 	_ StmtNode = &ProcedureJump{}
+	_ StmtNode = &ProcedureLoopStmt{}
+	_ StmtNode = &ProcedureReturn{}
 
 	_ DeclNode = &ProcedureErrorControl{}
 	_ DeclNode = &ProcedureCursor{}
@@ -229,19 +231,72 @@ func (n *ProcedureBlock) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// SQL data access characteristics of stored routines.
+const (
+	ContainsSQL     = "CONTAINS SQL"
+	NoSQL           = "NO SQL"
+	ReadsSQLData    = "READS SQL DATA"
+	ModifiesSQLData = "MODIFIES SQL DATA"
+)
+
+// RoutineCharacteristicType is the type of RoutineCharacteristic.
+type RoutineCharacteristicType int
+
+// Types of stored routine characteristics.
+const (
+	RoutineCharacteristicComment RoutineCharacteristicType = iota
+	RoutineCharacteristicLanguage
+	RoutineCharacteristicDeterministic
+	RoutineCharacteristicSQLDataAccess
+)
+
+// RoutineCharacteristic is a characteristic of CREATE PROCEDURE and CREATE FUNCTION.
+type RoutineCharacteristic struct {
+	Tp        RoutineCharacteristicType
+	StrValue  string
+	BoolValue bool
+}
+
 // ProcedureInfo stores all procedure information.
+// It also stores the stored function information when IsFunction is true.
 type ProcedureInfo struct {
-	stmtNode
+	ddlNode
 	IfNotExists       bool
 	ProcedureName     *TableName
 	ProcedureParam    []*StoreParameter //procedure param
 	ProcedureBody     StmtNode          //procedure body statement
 	ProcedureParamStr string            //procedure parameter string
+
+	IsFunction bool
+	// ReturnType is the type in the RETURNS clause of a stored function.
+	ReturnType *types.FieldType
+	// Deterministic, SQLDataAccess and Comment are the characteristics of the routine.
+	Deterministic bool
+	SQLDataAccess string
+	Comment       string
+}
+
+// SetCharacteristics sets the characteristics of the routine, the latter ones override the former ones.
+func (n *ProcedureInfo) SetCharacteristics(characteristics []*RoutineCharacteristic) {
+	for _, c := range characteristics {
+		switch c.Tp {
+		case RoutineCharacteristicComment:
+			n.Comment = c.StrValue
+		case RoutineCharacteristicDeterministic:
+			n.Deterministic = c.BoolValue
+		case RoutineCharacteristicSQLDataAccess:
+			n.SQLDataAccess = c.StrValue
+		}
+	}
 }
 
 // Restore implements Node interface.
 func (n *ProcedureInfo) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("CREATE PROCEDURE ")
+	if n.IsFunction {
+		ctx.WriteKeyWord("CREATE FUNCTION ")
+	} else {
+		ctx.WriteKeyWord("CREATE PROCEDURE ")
+	}
 	if n.IfNotExists {
 		ctx.WriteKeyWord("IF NOT EXISTS ")
 	}
@@ -254,12 +309,36 @@ func (n *ProcedureInfo) Restore(ctx *format.RestoreCtx) error {
 		if i > 0 {
 			ctx.WritePlain(",")
 		}
+		if n.IsFunction {
+			// The parameters of stored functions don't have the IN, OUT and INOUT modes.
+			ctx.WriteName(ProcedureParam.ParamName)
+			ctx.WritePlain(" ")
+			ctx.WriteKeyWord(ProcedureParam.ParamType.CompactStr())
+			continue
+		}
 		err := ProcedureParam.Restore(ctx)
 		if err != nil {
 			return err
 		}
 	}
 	ctx.WritePlain(") ")
+	if n.IsFunction {
+		ctx.WriteKeyWord("RETURNS ")
+		ctx.WriteKeyWord(n.ReturnType.CompactStr())
+		ctx.WritePlain(" ")
+	}
+	if n.Comment != "" {
+		ctx.WriteKeyWord("COMMENT ")
+		ctx.WriteString(n.Comment)
+		ctx.WritePlain(" ")
+	}
+	if n.Deterministic {
+		ctx.WriteKeyWord("DETERMINISTIC ")
+	}
+	if n.SQLDataAccess != "" {
+		ctx.WriteKeyWord(n.SQLDataAccess)
+		ctx.WritePlain(" ")
+	}
 	err = (n.ProcedureBody).Restore(ctx)
 	if err != nil {
 		return err
@@ -289,17 +368,22 @@ func (n *ProcedureInfo) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
-// DropProcedureStmt represents the ast of `drop procedure`
+// DropProcedureStmt represents the ast of `drop procedure` and `drop function`.
 type DropProcedureStmt struct {
-	stmtNode
+	ddlNode
 
 	IfExists      bool
 	ProcedureName *TableName
+	IsFunction    bool
 }
 
 // Restore implements DropProcedureStmt interface.
 func (n *DropProcedureStmt) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("DROP PROCEDURE ")
+	if n.IsFunction {
+		ctx.WriteKeyWord("DROP FUNCTION ")
+	} else {
+		ctx.WriteKeyWord("DROP PROCEDURE ")
+	}
 	if n.IfExists {
 		ctx.WriteKeyWord("IF EXISTS ")
 	}
@@ -1174,3 +1258,69 @@ func (n *ProcedureJump) Accept(v Visitor) (Node, bool) {
 	n = newNode.(*ProcedureJump)
 	return v.Leave(n)
 }
+
+// ProcedureLoopStmt stores the `loop ... end loop` statement.
+type ProcedureLoopStmt struct {
+	stmtNode
+
+	Body []StmtNode
+}
+
+// Restore implements ProcedureLoopStmt interface.
+func (n *ProcedureLoopStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("LOOP ")
+	for _, stmt := range n.Body {
+		err := stmt.Restore(ctx)
+		if err != nil {
+			return err
+		}
+		ctx.WriteKeyWord(";")
+	}
+	ctx.WriteKeyWord("END LOOP")
+	return nil
+}
+
+// Accept implements ProcedureLoopStmt Accept interface.
+func (n *ProcedureLoopStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureLoopStmt)
+	for i, stmt := range n.Body {
+		node, ok := stmt.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Body[i] = node.(StmtNode)
+	}
+	return v.Leave(n)
+}
+
+// ProcedureReturn stores the `return expr` statement in stored function.
+type ProcedureReturn struct {
+	stmtNode
+
+	Expr ExprNode
+}
+
+// Restore implements ProcedureReturn interface.
+func (n *ProcedureReturn) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RETURN ")
+	return n.Expr.Restore(ctx)
+}
+
+// Accept implements ProcedureReturn Accept interface.
+func (n *ProcedureReturn) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureReturn)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	return v.Leave(n)
+}
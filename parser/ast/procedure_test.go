@@ -99,6 +99,8 @@ func TestProcedure(t *testing.T) {
 		`create procedure proc_2() begin labelname: while id < 10 do set id = id + 1; select 1; end while; end`,
 		`create procedure proc_2() begin labelname: while id < 10 do set id = id + 1; select 1; end while labelname; end`,
 		`create procedure proc_2(id int) begin labelname: REPEAT set id = id + 1; select 1; UNTIL id < 10 end REPEAT labelname; end`,
+		`create procedure proc_2(id int) begin labelname: loop set id = id + 1; if id > 10 then leave labelname; end if; end loop labelname; end`,
+		`create procedure proc_2(out id int) comment 'test' deterministic reads sql data begin call proc_3(id); end`,
 	}
 	for _, testcase := range testcases {
 		stmt, _, err := p.Parse(testcase, "", "")
@@ -123,6 +125,45 @@ func TestShowCreateProcedure(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestFunction(t *testing.T) {
+	p := parser.New()
+	testcases := []string{
+		`create function f() returns int return 1`,
+		`create function if not exists test.f(a int, b varchar(10)) returns varchar(20) deterministic return concat(a, b)`,
+		`create function f(a int) returns int(11) no sql begin declare b int default 0; while b < a do set b = b + 1; end while; return b; end`,
+		`create function f(a int) returns int begin loop set a = a - 1; if a < 0 then return a; end if; end loop; end`,
+	}
+	for _, testcase := range testcases {
+		stmt, _, err := p.Parse(testcase, "", "")
+		require.NoError(t, err, testcase)
+		info, ok := stmt[0].(*ast.ProcedureInfo)
+		require.True(t, ok, testcase)
+		require.True(t, info.IsFunction, testcase)
+	}
+
+	stmt, _, err := p.Parse(`create function f(in a int) returns int return 1`, "", "")
+	require.Error(t, err)
+	require.Nil(t, stmt)
+
+	stmt, _, err = p.Parse("create function f(a int) returns int comment 'c' not deterministic contains sql return a", "", "")
+	require.NoError(t, err)
+	info := stmt[0].(*ast.ProcedureInfo)
+	require.Equal(t, "c", info.Comment)
+	require.False(t, info.Deterministic)
+	require.Equal(t, ast.ContainsSQL, info.SQLDataAccess)
+	require.Equal(t, "a int", info.ProcedureParamStr)
+	require.Equal(t, "return a", info.ProcedureBody.Text())
+
+	stmt, _, err = p.Parse("show create function f", "", "")
+	require.NoError(t, err)
+	require.Equal(t, ast.ShowStmtType(ast.ShowCreateFunction), stmt[0].(*ast.ShowStmt).Tp)
+	stmt, _, err = p.Parse("drop function if exists test.f", "", "")
+	require.NoError(t, err)
+	drop := stmt[0].(*ast.DropProcedureStmt)
+	require.True(t, drop.IsFunction)
+	require.True(t, drop.IfExists)
+}
+
 func TestProcedureVisitor(t *testing.T) {
 	sqls := []string{
 		"create procedure proc_2(in id bigint,in id2 varchar(100),in id3 decimal(30,2)) begin declare s varchar(100) DEFAULT FROM_UNIXTIME(1447430881);select s;SELECT * FROM `t1`;SELECT * FROM `t2`;INSERT INTO `t1` VALUES (111);END;",
@@ -217,6 +258,14 @@ func TestProcedureRestore(t *testing.T) {
 			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN `labelname`: REPEAT SET @@SESSION.`id`=`id`+1;SELECT 1;UNTIL `id`<10 END REPEAT `labelname`; END",
 			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN `labelname`: REPEAT SET @@SESSION.`id`=`id`+1;SELECT 1;UNTIL `id`<10 END REPEAT `labelname`; END",
 		},
+		{
+			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) COMMENT 'c' DETERMINISTIC MODIFIES SQL DATA LOOP SET @@SESSION.`id`=`id`+1;END LOOP",
+			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) COMMENT 'c' DETERMINISTIC MODIFIES SQL DATA LOOP SET @@SESSION.`id`=`id`+1;END LOOP",
+		},
+		{
+			"CREATE FUNCTION `f`(`a` INT(11),`b` VARCHAR(10)) RETURNS VARCHAR(20) BEGIN IF `a`>1 THEN RETURN `b`;END IF;RETURN CONCAT(`a`, `b`); END",
+			"CREATE FUNCTION `f`(`a` INT(11),`b` VARCHAR(10)) RETURNS VARCHAR(20) BEGIN IF `a`>1 THEN RETURN `b`;END IF;RETURN CONCAT(`a`, `b`); END",
+		},
 	}
 	extractNodeFunc := func(node ast.Node) ast.Node {
 		return node.(*ast.ProcedureInfo)
@@ -0,0 +1,277 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cron provides the parser of cron expressions, which supports the standard 5 fields
+// "minute hour day-of-month month day-of-week", an optional leading second field, the descriptors
+// like "@daily", and a time zone prefix like "CRON_TZ=Asia/Shanghai".
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+// maxSearchYears is the max years to search the next time, a schedule like "0 0 30 2 *" never matches.
+const maxSearchYears = 5
+
+type bounds struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	secondBounds = bounds{min: 0, max: 59}
+	minuteBounds = bounds{min: 0, max: 59}
+	hourBounds   = bounds{min: 0, max: 23}
+	domBounds    = bounds{min: 1, max: 31}
+	monthBounds  = bounds{min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is also Sunday in the day-of-week field.
+	dowBounds = bounds{min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	second, minute, hour, dom, month, dow uint64
+	// domAny and dowAny indicate the day-of-month or day-of-week field is '*' or '?'. A day matches if both of the
+	// fields match when any of them is '*', otherwise it matches if any of the fields matches.
+	domAny, dowAny bool
+	loc            *time.Location
+}
+
+// IsCronExpr returns whether the expression looks like a cron expression rather than a duration, it doesn't check
+// whether the expression is valid.
+func IsCronExpr(expr string) bool {
+	expr = strings.TrimSpace(expr)
+	return strings.HasPrefix(expr, "@") || len(strings.Fields(expr)) > 1
+}
+
+// Parse parses the cron expression. The time of the schedule is in the local time zone unless a time zone prefix
+// is specified.
+func Parse(expr string) (*Schedule, error) {
+	s := &Schedule{loc: time.Local}
+	fields := strings.Fields(expr)
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		name := fields[0][strings.IndexByte(fields[0], '=')+1:]
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, errors.Annotatef(err, "invalid time zone '%s' in cron expression", name)
+		}
+		s.loc = loc
+		fields = fields[1:]
+	}
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+		descriptor, ok := descriptors[strings.ToLower(fields[0])]
+		if !ok {
+			return nil, errors.Errorf("unknown cron descriptor '%s'", fields[0])
+		}
+		fields = strings.Fields(descriptor)
+	}
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, errors.Errorf("cron expression '%s' should have 5 or 6 fields", expr)
+	}
+
+	var err error
+	if s.second, _, err = parseField(fields[0], secondBounds); err != nil {
+		return nil, err
+	}
+	if s.minute, _, err = parseField(fields[1], minuteBounds); err != nil {
+		return nil, err
+	}
+	if s.hour, _, err = parseField(fields[2], hourBounds); err != nil {
+		return nil, err
+	}
+	if s.dom, s.domAny, err = parseField(fields[3], domBounds); err != nil {
+		return nil, err
+	}
+	if s.month, _, err = parseField(fields[4], monthBounds); err != nil {
+		return nil, err
+	}
+	if s.dow, s.dowAny, err = parseField(fields[5], dowBounds); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+// parseField parses a field like "*", "1,3,5", "1-5", "*/10" or "MON-FRI", it returns the bit set of the
+// matched values and whether the field matches any value.
+func parseField(field string, b bounds) (bitSet uint64, matchAny bool, err error) {
+	if field == "*" || field == "?" {
+		return rangeBits(b.min, b.max, 1), true, nil
+	}
+	for _, part := range strings.Split(field, ",") {
+		rangeAndStep := strings.SplitN(part, "/", 2)
+		low, high := b.min, b.max
+		if rangeAndStep[0] != "*" && rangeAndStep[0] != "?" {
+			lowAndHigh := strings.SplitN(rangeAndStep[0], "-", 2)
+			if low, err = parseValue(lowAndHigh[0], b); err != nil {
+				return 0, false, err
+			}
+			high = low
+			if len(lowAndHigh) == 2 {
+				if high, err = parseValue(lowAndHigh[1], b); err != nil {
+					return 0, false, err
+				}
+			} else if len(rangeAndStep) == 2 {
+				// "N/step" means from N to the max value.
+				high = b.max
+			}
+		}
+		step := uint64(1)
+		if len(rangeAndStep) == 2 {
+			if step, err = strconv.ParseUint(rangeAndStep[1], 10, 32); err != nil || step == 0 {
+				return 0, false, errors.Errorf("invalid step in cron field '%s'", field)
+			}
+		}
+		if low > high {
+			return 0, false, errors.Errorf("invalid range in cron field '%s'", field)
+		}
+		bitSet |= rangeBits(low, high, uint(step))
+	}
+	return bitSet, false, nil
+}
+
+func parseValue(s string, b bounds) (uint, error) {
+	if v, ok := b.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, errors.Errorf("invalid value '%s' in cron expression", s)
+	}
+	if uint(v) < b.min || uint(v) > b.max {
+		return 0, errors.Errorf("value %d in cron expression is out of range [%d, %d]", v, b.min, b.max)
+	}
+	return uint(v), nil
+}
+
+func rangeBits(low, high, step uint) uint64 {
+	var bitSet uint64
+	for i := low; i <= high; i += step {
+		bitSet |= 1 << i
+	}
+	return bitSet
+}
+
+func has(bitSet uint64, v int) bool {
+	return bitSet&(1<<uint(v)) != 0
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatches := has(s.dom, t.Day())
+	dowMatches := has(s.dow, int(t.Weekday()))
+	if s.domAny || s.dowAny {
+		return domMatches && dowMatches
+	}
+	return domMatches || dowMatches
+}
+
+// Location returns the time zone of the schedule.
+func (s *Schedule) Location() *time.Location {
+	return s.loc
+}
+
+// Next returns the first time matching the schedule after t. The second return value is false if there is no such
+// time in the next few years.
+func (s *Schedule) Next(t time.Time) (time.Time, bool) {
+	t = t.In(s.loc).Add(time.Second - time.Duration(t.Nanosecond()))
+	yearLimit := t.Year() + maxSearchYears
+
+	// When a field is changed, the smaller fields are reset to their min values.
+	reset := false
+wrap:
+	if t.Year() > yearLimit {
+		return time.Time{}, false
+	}
+
+	for !has(s.month, int(t.Month())) {
+		if !reset {
+			reset = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, s.loc)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		if !reset {
+			reset = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+		}
+		t = t.AddDate(0, 0, 1)
+		// The time may not be the midnight after the daylight saving time changes.
+		if t.Hour() != 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+		}
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for !has(s.hour, t.Hour()) {
+		if !reset {
+			reset = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, s.loc)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for !has(s.minute, t.Minute()) {
+		if !reset {
+			reset = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, s.loc)
+		}
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	for !has(s.second, t.Second()) {
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto wrap
+		}
+	}
+	return t, true
+}
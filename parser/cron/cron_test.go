@@ -0,0 +1,118 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseError(t *testing.T) {
+	cases := []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@every",
+		"CRON_TZ=Unknown/Zone 0 2 * * *",
+	}
+	for _, c := range cases {
+		_, err := Parse(c)
+		require.Error(t, err, c)
+	}
+}
+
+func TestIsCronExpr(t *testing.T) {
+	require.True(t, IsCronExpr("0 2 * * *"))
+	require.True(t, IsCronExpr("@daily"))
+	require.True(t, IsCronExpr(" CRON_TZ=UTC 0 2 * * * "))
+	require.False(t, IsCronExpr("1h"))
+	require.False(t, IsCronExpr("30m"))
+	require.False(t, IsCronExpr(""))
+}
+
+func TestNext(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	cases := []struct {
+		expr string
+		from string
+		next string
+	}{
+		{"* * * * *", "2023-06-01T10:20:30Z", "2023-06-01T10:21:00Z"},
+		{"*/15 * * * *", "2023-06-01T10:20:30Z", "2023-06-01T10:30:00Z"},
+		{"30 */2 * * *", "2023-06-01T10:30:00Z", "2023-06-01T12:30:00Z"},
+		{"0 2 * * *", "2023-06-01T10:20:30Z", "2023-06-02T02:00:00Z"},
+		{"0 2 * * *", "2023-06-01T01:59:59.5Z", "2023-06-01T02:00:00Z"},
+		{"0 2 * * *", "2023-12-31T03:00:00Z", "2024-01-01T02:00:00Z"},
+		{"*/10 * * * * *", "2023-06-01T10:20:30Z", "2023-06-01T10:20:40Z"},
+		{"0 0 29 2 *", "2023-03-01T00:00:00Z", "2024-02-29T00:00:00Z"},
+		{"0 9 * * MON-FRI", "2023-06-02T10:00:00Z", "2023-06-05T09:00:00Z"},
+		{"0 9 * * 7", "2023-06-02T10:00:00Z", "2023-06-04T09:00:00Z"},
+		{"0 0 1,15 * *", "2023-06-02T10:00:00Z", "2023-06-15T00:00:00Z"},
+		{"0 0 13 * 5", "2023-06-02T10:00:00Z", "2023-06-09T00:00:00Z"},
+		{"0 0 1 jan-mar *", "2023-06-02T10:00:00Z", "2024-01-01T00:00:00Z"},
+		{"@hourly", "2023-06-01T10:20:30Z", "2023-06-01T11:00:00Z"},
+		{"@weekly", "2023-06-01T10:20:30Z", "2023-06-04T00:00:00Z"},
+		{"@monthly", "2023-06-01T10:20:30Z", "2023-07-01T00:00:00Z"},
+		{"CRON_TZ=Asia/Shanghai 0 2 * * *", "2023-06-01T10:20:30Z", "2023-06-01T18:00:00Z"},
+		{"TZ=Asia/Shanghai @daily", "2023-06-01T10:20:30Z", "2023-06-01T16:00:00Z"},
+	}
+
+	for _, c := range cases {
+		expr := c.expr
+		if !strings.Contains(expr, "TZ=") {
+			expr = "CRON_TZ=UTC " + expr
+		}
+		s, err := Parse(expr)
+		require.NoError(t, err, c.expr)
+		from, err := time.Parse(time.RFC3339Nano, c.from)
+		require.NoError(t, err)
+		expected, err := time.Parse(time.RFC3339, c.next)
+		require.NoError(t, err)
+		next, ok := s.Next(from)
+		require.True(t, ok, c.expr)
+		require.True(t, expected.Equal(next), "%s: expected %s, got %s", c.expr, expected, next)
+	}
+
+	s, err := Parse("CRON_TZ=Asia/Shanghai 0 2 * * *")
+	require.NoError(t, err)
+	require.Equal(t, shanghai, s.Location())
+
+	// The daylight saving time starts at 2023-03-12 02:00 in New York, so 02:30 is skipped in that day.
+	s, err = Parse("CRON_TZ=America/New_York 30 2 * * *")
+	require.NoError(t, err)
+	next, ok := s.Next(time.Date(2023, 3, 11, 3, 0, 0, 0, newYork))
+	require.True(t, ok)
+	require.Equal(t, time.Date(2023, 3, 13, 2, 30, 0, 0, newYork), next)
+
+	s, err = Parse("0 0 30 2 *")
+	require.NoError(t, err)
+	_, ok = s.Next(time.Now())
+	require.False(t, ok)
+}
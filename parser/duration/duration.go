@@ -11,7 +11,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package duration provides a customized duration, which supports unit 'd', 'h' and 'm'
+// Package duration provides a customized duration, which supports unit 'd', 'h', 'm' and 's'
 package duration
 
 import (
@@ -40,7 +40,7 @@ func readFloat(s string) (float64, string, error) {
 	return 0, s, errors.New("fail to read an integer")
 }
 
-// ParseDuration parses the duration which contains 'd', 'h' and 'm'
+// ParseDuration parses the duration which contains 'd', 'h', 'm' and 's'
 func ParseDuration(s string) (time.Duration, error) {
 	duration := time.Duration(0)
 
@@ -62,6 +62,8 @@ func ParseDuration(s string) (time.Duration, error) {
 			duration += time.Duration(i * float64(time.Hour))
 		case 'm':
 			duration += time.Duration(i * float64(time.Minute))
+		case 's':
+			duration += time.Duration(i * float64(time.Second))
 		default:
 			return 0, errors.Errorf("unknown unit %c", s[0])
 		}
@@ -53,6 +53,14 @@ func TestParseDuration(t *testing.T) {
 			"1d3.555h",
 			24*time.Hour + time.Duration(3.555*float64(time.Hour)),
 		},
+		{
+			"30s",
+			30 * time.Second,
+		},
+		{
+			"1h1m1s",
+			time.Hour + time.Minute + time.Second,
+		},
 	}
 
 	for _, c := range cases {
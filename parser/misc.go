@@ -167,6 +167,7 @@ var tokenMap = map[string]int{
 	"AS":                       as,
 	"ASC":                      asc,
 	"ASCII":                    ascii,
+	"AT":                       at,
 	"ATTRIBUTE":                attribute,
 	"ATTRIBUTES":               attributes,
 	"BATCH":                    batch,
@@ -184,6 +185,7 @@ var tokenMap = map[string]int{
 	"BACKEND":                  backend,
 	"BACKUP":                   backup,
 	"BACKUPS":                  backups,
+	"BEFORE":                   before,
 	"BEGIN":                    begin,
 	"BETWEEN":                  between,
 	"BERNOULLI":                bernoulli,
@@ -249,6 +251,8 @@ var tokenMap = map[string]int{
 	"COMMIT":                   commit,
 	"COMMITTED":                committed,
 	"COMPACT":                  compact,
+	"COMPLETE":                 complete,
+	"COMPLETION":               completion,
 	"COMPRESSED":               compressed,
 	"COMPRESSION":              compression,
 	"CONCURRENCY":              concurrency,
@@ -256,6 +260,7 @@ var tokenMap = map[string]int{
 	"CONNECTION":               connection,
 	"CONSISTENCY":              consistency,
 	"CONSISTENT":               consistent,
+	"CONTAINS":                 contains,
 	"CONSTRAINT":               constraint,
 	"CONSTRAINTS":              constraints,
 	"CONTEXT":                  context,
@@ -311,6 +316,7 @@ var tokenMap = map[string]int{
 	"DEPTH":                    depth,
 	"DESC":                     desc,
 	"DESCRIBE":                 describe,
+	"DETERMINISTIC":            deterministic,
 	"DIGEST":                   digest,
 	"DIRECTORY":                directory,
 	"DISABLE":                  disable,
@@ -332,13 +338,16 @@ var tokenMap = map[string]int{
 	"DUPLICATE":                duplicate,
 	"DURATION":                 timeDuration,
 	"DYNAMIC":                  dynamic,
+	"EACH":                     each,
 	"ELSE":                     elseKwd,
 	"ELSEIF":                   elseIfKwd,
+	"EMPTY":                    empty,
 	"ENABLE":                   enable,
 	"ENABLED":                  enabled,
 	"ENCLOSED":                 enclosed,
 	"ENCRYPTION":               encryption,
 	"END":                      end,
+	"ENDS":                     ends,
 	"END_TIME":                 endTime,
 	"ENFORCED":                 enforced,
 	"ENGINE":                   engine,
@@ -350,6 +359,7 @@ var tokenMap = map[string]int{
 	"ESCAPED":                  escaped,
 	"EVENT":                    event,
 	"EVENTS":                   events,
+	"EVERY":                    every,
 	"EVOLVE":                   evolve,
 	"EXACT":                    exact,
 	"EXEC_ELAPSED":             execElapsed,
@@ -368,6 +378,7 @@ var tokenMap = map[string]int{
 	"FALSE":                    falseKwd,
 	"FAULTS":                   faultsSym,
 	"FETCH":                    fetch,
+	"FEEDBACK":                 feedback,
 	"FIELDS":                   fields,
 	"FILE":                     file,
 	"FIRST":                    first,
@@ -379,6 +390,7 @@ var tokenMap = map[string]int{
 	"FOLLOWERS":                followers,
 	"FOLLOWER_CONSTRAINTS":     followerConstraints,
 	"FOLLOWING":                following,
+	"FOLLOWS":                  follows,
 	"FOR":                      forKwd,
 	"FORCE":                    force,
 	"FOREIGN":                  foreign,
@@ -391,6 +403,9 @@ var tokenMap = map[string]int{
 	"FUNCTION":                 function,
 	"GC_TTL":                   gcTTL,
 	"GENERAL":                  general,
+	"GEOMCOLLECTION":           geomCollection,
+	"GEOMETRY":                 geometry,
+	"GEOMETRYCOLLECTION":       geometryCollection,
 	"GENERATED":                generated,
 	"GET_FORMAT":               getFormat,
 	"GLOBAL":                   global,
@@ -460,6 +475,7 @@ var tokenMap = map[string]int{
 	"JOIN":                     join,
 	"JSON_ARRAYAGG":            jsonArrayagg,
 	"JSON_OBJECTAGG":           jsonObjectAgg,
+	"JSON_TABLE":               jsonTable,
 	"JSON":                     jsonType,
 	"KEY_BLOCK_SIZE":           keyBlockSize,
 	"KEY":                      key,
@@ -470,6 +486,7 @@ var tokenMap = map[string]int{
 	"LAST_BACKUP":              lastBackup,
 	"LAST":                     last,
 	"LASTVAL":                  lastval,
+	"LATERAL":                  lateral,
 	"LEADER":                   leader,
 	"LEADER_CONSTRAINTS":       leaderConstraints,
 	"LEADING":                  leading,
@@ -484,6 +501,7 @@ var tokenMap = map[string]int{
 	"LIMIT":                    limit,
 	"LINEAR":                   linear,
 	"LINES":                    lines,
+	"LINESTRING":               lineString,
 	"LIST":                     list,
 	"LOAD":                     load,
 	"LOCAL":                    local,
@@ -496,8 +514,10 @@ var tokenMap = map[string]int{
 	"LONG":                     long,
 	"LONGBLOB":                 longblobType,
 	"LONGTEXT":                 longtextType,
+	"LOOP":                     loop,
 	"LOW_PRIORITY":             lowPriority,
 	"MASTER":                   master,
+	"MATERIALIZED":             materialized,
 	"MATCH":                    match,
 	"MAX_CONNECTIONS_PER_HOUR": maxConnectionsPerHour,
 	"MAX_IDXNUM":               max_idxnum,
@@ -517,6 +537,7 @@ var tokenMap = map[string]int{
 	"MERGE":                    merge,
 	"METADATA":                 metadata,
 	"MICROSECOND":              microsecond,
+	"MIGRATE":                  migrate,
 	"MIN_ROWS":                 minRows,
 	"MIN":                      min,
 	"MINUTE_MICROSECOND":       minuteMicrosecond,
@@ -526,11 +547,16 @@ var tokenMap = map[string]int{
 	"MOD":                      mod,
 	"MODE":                     mode,
 	"MODIFY":                   modify,
+	"MODIFIES":                 modifies,
 	"MONTH":                    month,
+	"MULTILINESTRING":          multiLineString,
+	"MULTIPOINT":               multiPoint,
+	"MULTIPOLYGON":             multiPolygon,
 	"NAMES":                    names,
 	"NATIONAL":                 national,
 	"NATURAL":                  natural,
 	"NCHAR":                    ncharType,
+	"NESTED":                   nested,
 	"NEVER":                    never,
 	"NEXT_ROW_ID":              next_row_id,
 	"NEXT":                     next,
@@ -561,6 +587,7 @@ var tokenMap = map[string]int{
 	"OLTP_WRITE_ONLY":          oltpWriteOnly,
 	"ON_DUPLICATE":             onDuplicate,
 	"ON":                       on,
+	"ONE":                      one,
 	"ONLINE":                   online,
 	"ONLY":                     only,
 	"OPEN":                     open,
@@ -572,6 +599,7 @@ var tokenMap = map[string]int{
 	"OPTIONALLY":               optionally,
 	"OR":                       or,
 	"ORDER":                    order,
+	"ORDINALITY":               ordinality,
 	"OUT":                      out,
 	"OUTER":                    outer,
 	"OUTFILE":                  outfile,
@@ -583,10 +611,12 @@ var tokenMap = map[string]int{
 	"PARTITIONING":             partitioning,
 	"PARTITIONS":               partitions,
 	"PASSWORD":                 password,
+	"PATH":                     path,
 	"PAUSE":                    pause,
 	"PERCENT":                  percent,
 	"PER_DB":                   per_db,
 	"PER_TABLE":                per_table,
+	"PHASE":                    phase,
 	"PESSIMISTIC":              pessimistic,
 	"PLACEMENT":                placement,
 	"PLAN":                     plan,
@@ -594,9 +624,11 @@ var tokenMap = map[string]int{
 	"PLUGINS":                  plugins,
 	"POINT":                    point,
 	"POLICY":                   policy,
+	"POLYGON":                  polygon,
 	"POSITION":                 position,
 	"PRE_SPLIT_REGIONS":        preSplitRegions,
 	"PRECEDING":                preceding,
+	"PRECEDES":                 precedes,
 	"PREDICATE":                predicate,
 	"PRECISION":                precisionType,
 	"PREPARE":                  prepare,
@@ -622,10 +654,12 @@ var tokenMap = map[string]int{
 	"READ":                     read,
 	"REAL":                     realType,
 	"REBUILD":                  rebuild,
+	"READS":                    reads,
 	"RECENT":                   recent,
 	"RECOVER":                  recover,
 	"RECURSIVE":                recursive,
 	"REDUNDANT":                redundant,
+	"REFRESH":                  refresh,
 	"REFERENCES":               references,
 	"REGEXP":                   regexpKwd,
 	"REGION":                   region,
@@ -653,6 +687,7 @@ var tokenMap = map[string]int{
 	"RESTORES":                 restores,
 	"RESTORED_TS":              restoredTS,
 	"RESTRICT":                 restrict,
+	"RETURN":                   returnKwd,
 	"REVERSE":                  reverse,
 	"REVOKE":                   revoke,
 	"RIGHT":                    right,
@@ -726,6 +761,7 @@ var tokenMap = map[string]int{
 	"SQL_TSI_SECOND":           sqlTsiSecond,
 	"SQL_TSI_WEEK":             sqlTsiWeek,
 	"SQL_TSI_YEAR":             sqlTsiYear,
+	"SRID":                     srid,
 	"SQL":                      sql,
 	"SQLEXCEPTION":             sqlexception,
 	"SQLSTATE":                 sqlstate,
@@ -733,6 +769,7 @@ var tokenMap = map[string]int{
 	"SSL":                      ssl,
 	"STALENESS":                staleness,
 	"START":                    start,
+	"STARTS":                   starts,
 	"START_TIME":               startTime,
 	"START_TS":                 startTS,
 	"STARTING":                 starting,
@@ -770,6 +807,7 @@ var tokenMap = map[string]int{
 	"SUM":                      sum,
 	"SUPER":                    super,
 	"SURVIVAL_PREFERENCES":     survivalPreferences,
+	"SUSPEND":                  suspend,
 	"SWAPS":                    swaps,
 	"SWITCHES":                 switchesSym,
 	"SYSTEM":                   system,
@@ -881,6 +919,8 @@ var tokenMap = map[string]int{
 	"WRITE":                    write,
 	"WORKLOAD":                 workload,
 	"X509":                     x509,
+	"XA":                       xa,
+	"XID":                      xid,
 	"XOR":                      xor,
 	"YEAR_MONTH":               yearMonth,
 	"YEAR":                     yearType,
@@ -889,6 +929,7 @@ var tokenMap = map[string]int{
 	"FAILED_LOGIN_ATTEMPTS":    failedLoginAttempts,
 	"PASSWORD_LOCK_TIME":       passwordLockTime,
 	"REUSE":                    reuse,
+	"RETURNS":                  returns,
 }
 
 // See https://dev.mysql.com/doc/refman/5.7/en/function-resolution.html for details.
@@ -102,6 +102,13 @@ const (
 	ActionCreateResourceGroup           ActionType = 68
 	ActionAlterResourceGroup            ActionType = 69
 	ActionDropResourceGroup             ActionType = 70
+	ActionAlterTablePartitioning        ActionType = 71
+	ActionRemovePartitioning            ActionType = 72
+	ActionRefreshMaterializedView       ActionType = 73
+	ActionCreateTrigger                 ActionType = 74
+	ActionDropTrigger                   ActionType = 75
+	ActionCreateRoutine                 ActionType = 76
+	ActionDropRoutine                   ActionType = 77
 )
 
 var actionMap = map[ActionType]string{
@@ -170,6 +177,13 @@ var actionMap = map[ActionType]string{
 	ActionCreateResourceGroup:           "create resource group",
 	ActionAlterResourceGroup:            "alter resource group",
 	ActionDropResourceGroup:             "drop resource group",
+	ActionAlterTablePartitioning:        "alter table partition by",
+	ActionRemovePartitioning:            "alter table remove partitioning",
+	ActionRefreshMaterializedView:       "refresh materialized view",
+	ActionCreateTrigger:                 "create trigger",
+	ActionDropTrigger:                   "drop trigger",
+	ActionCreateRoutine:                 "create routine",
+	ActionDropRoutine:                   "drop routine",
 
 	// `ActionAlterTableAlterPartition` is removed and will never be used.
 	// Just left a tombstone here for compatibility.
@@ -797,9 +811,10 @@ func (job *Job) NotStarted() bool {
 // MayNeedReorg indicates that this job may need to reorganize the data.
 func (job *Job) MayNeedReorg() bool {
 	switch job.Type {
-	case ActionAddIndex, ActionAddPrimaryKey, ActionReorganizePartition:
+	case ActionAddIndex, ActionAddPrimaryKey, ActionReorganizePartition,
+		ActionAlterTablePartitioning, ActionRemovePartitioning:
 		return true
-	case ActionModifyColumn:
+	case ActionModifyColumn, ActionAddColumn:
 		if len(job.CtxVars) > 0 {
 			needReorg, ok := job.CtxVars[0].(bool)
 			return ok && needReorg
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/cron"
 	"github.com/pingcap/tidb/parser/duration"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/types"
@@ -126,6 +127,9 @@ type ColumnInfo struct {
 	// Version = 1: For OriginDefaultValue and DefaultValue of timestamp column will stores the default time in UTC time zone.
 	//              This will fix bug in version 0. For compatibility with version 0, we add version field in column info struct.
 	Version uint64 `json:"version"`
+	// SRID is the spatial reference system identifier of a spatial column.
+	// The column accepts the geometries of any SRID if it's nil.
+	SRID *uint32 `json:"srid,omitempty"`
 }
 
 // Clone clones ColumnInfo.
@@ -358,7 +362,8 @@ func FindIndexByColumns(tbInfo *TableInfo, indices []*IndexInfo, cols ...CIStr)
 
 // IsIndexPrefixCovered checks the index's columns beginning with the cols.
 func IsIndexPrefixCovered(tbInfo *TableInfo, index *IndexInfo, cols ...CIStr) bool {
-	if len(index.Columns) < len(cols) {
+	// A FULLTEXT index stores the tokens of the values instead of the values.
+	if index.FullText || len(index.Columns) < len(cols) {
 		return false
 	}
 	for i := range cols {
@@ -521,6 +526,14 @@ type TableInfo struct {
 	ExchangePartitionInfo *ExchangePartitionInfo `json:"exchange_partition_info"`
 
 	TTLInfo *TTLInfo `json:"ttl_info"`
+
+	// MaterializedView is set when the table stores the result of a materialized view.
+	MaterializedView *MaterializedViewInfo `json:"materialized_view,omitempty"`
+	// MaterializedViewLogs are the IDs of the incrementally refreshed materialized views
+	// which read this table. The DML on the table writes change logs for them.
+	MaterializedViewLogs []int64 `json:"materialized_view_logs,omitempty"`
+	// Triggers are the triggers of the table, in the order of execution.
+	Triggers []*TriggerInfo `json:"triggers,omitempty"`
 }
 
 // SepAutoInc decides whether _rowid and auto_increment id use separate allocator.
@@ -732,6 +745,18 @@ func (t *TableInfo) Clone() *TableInfo {
 	if t.TTLInfo != nil {
 		nt.TTLInfo = t.TTLInfo.Clone()
 	}
+	if t.MaterializedView != nil {
+		nt.MaterializedView = t.MaterializedView.Clone()
+	}
+	if t.MaterializedViewLogs != nil {
+		nt.MaterializedViewLogs = append([]int64(nil), t.MaterializedViewLogs...)
+	}
+	if t.Triggers != nil {
+		nt.Triggers = make([]*TriggerInfo, len(t.Triggers))
+		for i := range t.Triggers {
+			nt.Triggers[i] = t.Triggers[i].Clone()
+		}
+	}
 
 	return &nt
 }
@@ -989,6 +1014,11 @@ func (t *TableInfo) IsView() bool {
 	return t.View != nil
 }
 
+// IsMaterializedView checks if TableInfo is a materialized view.
+func (t *TableInfo) IsMaterializedView() bool {
+	return t.MaterializedView != nil
+}
+
 // IsSequence checks if TableInfo is a sequence.
 func (t *TableInfo) IsSequence() bool {
 	return t.Sequence != nil
@@ -1056,6 +1086,9 @@ type ViewCheckOption int
 const (
 	CheckOptionLocal ViewCheckOption = iota
 	CheckOptionCascaded
+	// CheckOptionNone means the view has no WITH CHECK OPTION clause. The views created before
+	// ViewInfoVersion1 are stored with CheckOptionCascaded instead, see ViewInfo.GetCheckOption.
+	CheckOptionNone
 )
 
 //revive:enable:exported
@@ -1066,11 +1099,25 @@ func (v *ViewCheckOption) String() string {
 		return "LOCAL"
 	case CheckOptionCascaded:
 		return "CASCADED"
+	case CheckOptionNone:
+		return "NONE"
 	default:
 		return "CASCADED"
 	}
 }
 
+const (
+	// ViewInfoVersion0 means the view info version is 0.
+	// The WITH CHECK OPTION clause isn't enforced, and CheckOptionCascaded is stored for every view.
+	ViewInfoVersion0 = uint16(0)
+	// ViewInfoVersion1 means the view info version is 1.
+	// The WITH CHECK OPTION clause is enforced, and CheckOptionNone is stored for the views without it.
+	ViewInfoVersion1 = uint16(1)
+
+	// CurrLatestViewInfoVersion means the latest view info in the current TiDB.
+	CurrLatestViewInfoVersion = ViewInfoVersion1
+)
+
 // ViewInfo provides meta data describing a DB view.
 //
 //revive:disable:exported
@@ -1081,8 +1128,296 @@ type ViewInfo struct {
 	SelectStmt  string             `json:"view_select"`
 	CheckOption ViewCheckOption    `json:"view_checkoption"`
 	Cols        []CIStr            `json:"view_cols"`
+	Version     uint16             `json:"view_version"`
+}
+
+//revive:enable:exported
+
+// GetCheckOption returns the WITH CHECK OPTION of the view. The check option of the views
+// created before ViewInfoVersion1 is unknown, so they are regarded as having none.
+func (v *ViewInfo) GetCheckOption() ViewCheckOption {
+	if v.Version < ViewInfoVersion1 {
+		return CheckOptionNone
+	}
+	return v.CheckOption
+}
+
+// MaterializedViewRefreshMode is the REFRESH mode of a materialized view.
+type MaterializedViewRefreshMode byte
+
+// Refresh modes of materialized views.
+const (
+	// MaterializedViewRefreshComplete recomputes the whole view on refresh.
+	MaterializedViewRefreshComplete MaterializedViewRefreshMode = iota
+	// MaterializedViewRefreshIncremental recomputes the rows affected by the
+	// changes recorded in the change log of the base table since the last refresh.
+	MaterializedViewRefreshIncremental
+)
+
+// String implements fmt.Stringer interface.
+func (m MaterializedViewRefreshMode) String() string {
+	switch m {
+	case MaterializedViewRefreshIncremental:
+		return "INCREMENTAL"
+	default:
+		return "COMPLETE"
+	}
+}
+
+// MaterializedViewInfo provides meta data describing a materialized view.
+type MaterializedViewInfo struct {
+	// SelectStmt is the definition of the view with the table names qualified.
+	SelectStmt  string                      `json:"select"`
+	RefreshMode MaterializedViewRefreshMode `json:"refresh_mode"`
+	// LastRefreshTS is the start ts of the transaction of the last refresh.
+	// It's 0 if the next refresh must recompute the whole view.
+	LastRefreshTS uint64 `json:"last_refresh_ts"`
+
+	// The fields below are only used by the INCREMENTAL refresh mode.
+
+	// BaseSchemaID and BaseTableID are the IDs of the table which writes the
+	// change log. The change log is incomplete if the table read by the view
+	// has a different ID, e.g. after it is truncated, and the next refresh
+	// recomputes the whole view.
+	BaseSchemaID int64 `json:"base_schema_id"`
+	BaseTableID  int64 `json:"base_table_id"`
+	// KeyColumns are the columns of the view which decide the rows affected by
+	// a change of the base table, and BaseKeyColumns are the corresponding
+	// columns of the base table.
+	KeyColumns     []CIStr `json:"key_columns"`
+	BaseKeyColumns []CIStr `json:"base_key_columns"`
+}
+
+// Clone clones MaterializedViewInfo.
+func (m *MaterializedViewInfo) Clone() *MaterializedViewInfo {
+	nm := *m
+	nm.KeyColumns = append([]CIStr(nil), m.KeyColumns...)
+	nm.BaseKeyColumns = append([]CIStr(nil), m.BaseKeyColumns...)
+	return &nm
 }
 
+// TriggerTiming is the action time of a trigger.
+type TriggerTiming byte
+
+// Action times of triggers.
+const (
+	TriggerTimingBefore TriggerTiming = iota
+	TriggerTimingAfter
+)
+
+// String implements fmt.Stringer interface.
+func (t TriggerTiming) String() string {
+	if t == TriggerTimingAfter {
+		return "AFTER"
+	}
+	return "BEFORE"
+}
+
+// TriggerEvent is the kind of the statement which activates a trigger.
+type TriggerEvent byte
+
+// Events of triggers.
+const (
+	TriggerEventInsert TriggerEvent = iota
+	TriggerEventUpdate
+	TriggerEventDelete
+)
+
+// String implements fmt.Stringer interface.
+func (e TriggerEvent) String() string {
+	switch e {
+	case TriggerEventUpdate:
+		return "UPDATE"
+	case TriggerEventDelete:
+		return "DELETE"
+	default:
+		return "INSERT"
+	}
+}
+
+// TriggerInfo provides meta data describing a trigger.
+type TriggerInfo struct {
+	Name   CIStr         `json:"name"`
+	Timing TriggerTiming `json:"timing"`
+	Event  TriggerEvent  `json:"event"`
+	// Body is the original text of the statement executed by the trigger.
+	Body    string             `json:"body"`
+	Definer *auth.UserIdentity `json:"definer"`
+	// SQLMode, Charset and Collate are the session settings when the trigger is created,
+	// the trigger body is parsed and executed with them.
+	SQLMode mysql.SQLMode `json:"sql_mode"`
+	Charset string        `json:"charset"`
+	Collate string        `json:"collate"`
+	// Created is the time when the trigger is created.
+	Created time.Time `json:"created"`
+}
+
+// Clone clones TriggerInfo.
+func (t *TriggerInfo) Clone() *TriggerInfo {
+	nt := *t
+	if t.Definer != nil {
+		definer := *t.Definer
+		nt.Definer = &definer
+	}
+	return &nt
+}
+
+// RoutineType is the type of a stored routine.
+type RoutineType byte
+
+// Types of stored routines.
+const (
+	RoutineTypeProcedure RoutineType = iota
+	RoutineTypeFunction
+)
+
+// String implements fmt.Stringer interface.
+func (t RoutineType) String() string {
+	if t == RoutineTypeFunction {
+		return "FUNCTION"
+	}
+	return "PROCEDURE"
+}
+
+// RoutineParamMode is the mode of a stored routine parameter.
+type RoutineParamMode byte
+
+// Parameter modes of stored routines.
+const (
+	RoutineParamIn RoutineParamMode = iota
+	RoutineParamOut
+	RoutineParamInOut
+)
+
+// String implements fmt.Stringer interface.
+func (m RoutineParamMode) String() string {
+	switch m {
+	case RoutineParamOut:
+		return "OUT"
+	case RoutineParamInOut:
+		return "INOUT"
+	default:
+		return "IN"
+	}
+}
+
+// RoutineParam is a parameter of a stored routine.
+type RoutineParam struct {
+	Name CIStr            `json:"name"`
+	Mode RoutineParamMode `json:"mode"`
+	Type *types.FieldType `json:"type"`
+}
+
+// RoutineInfo provides meta data describing a stored procedure or function.
+type RoutineInfo struct {
+	ID     int64          `json:"id"`
+	Name   CIStr          `json:"name"`
+	Type   RoutineType    `json:"type"`
+	Params []RoutineParam `json:"params"`
+	// ParamList is the original text of the parameter list.
+	ParamList string `json:"param_list"`
+	// ReturnType is the type of the value returned by a stored function.
+	ReturnType *types.FieldType `json:"return_type"`
+	// Body is the original text of the routine body.
+	Body          string             `json:"body"`
+	Deterministic bool               `json:"deterministic"`
+	SQLDataAccess string             `json:"sql_data_access"`
+	Comment       string             `json:"comment"`
+	Definer       *auth.UserIdentity `json:"definer"`
+	// SQLMode, Charset and Collate are the session settings when the routine is created,
+	// the routine body is parsed and executed with them.
+	SQLMode mysql.SQLMode `json:"sql_mode"`
+	Charset string        `json:"charset"`
+	Collate string        `json:"collate"`
+	Created time.Time     `json:"created"`
+}
+
+// Clone clones RoutineInfo.
+func (r *RoutineInfo) Clone() *RoutineInfo {
+	nr := *r
+	nr.Params = make([]RoutineParam, len(r.Params))
+	for i, param := range r.Params {
+		nr.Params[i] = param
+		nr.Params[i].Type = param.Type.Clone()
+	}
+	if r.ReturnType != nil {
+		nr.ReturnType = r.ReturnType.Clone()
+	}
+	if r.Definer != nil {
+		definer := *r.Definer
+		nr.Definer = &definer
+	}
+	return &nr
+}
+
+// EventStatus is the status of a scheduled event.
+type EventStatus byte
+
+// Statuses of scheduled events.
+const (
+	EventStatusEnabled EventStatus = iota
+	EventStatusDisabled
+	EventStatusSlavesideDisabled
+)
+
+// String implements fmt.Stringer interface.
+func (s EventStatus) String() string {
+	switch s {
+	case EventStatusDisabled:
+		return "DISABLED"
+	case EventStatusSlavesideDisabled:
+		return "SLAVESIDE_DISABLED"
+	default:
+		return "ENABLED"
+	}
+}
+
+// EventInfo provides meta data describing a scheduled event.
+// Events are not a part of the schema, they are persisted as timers of the timer framework.
+type EventInfo struct {
+	Schema  CIStr              `json:"schema"`
+	Name    CIStr              `json:"name"`
+	Definer *auth.UserIdentity `json:"definer"`
+	// ExecuteAt is the execution time of a one-time event, it's zero for a recurring event.
+	ExecuteAt time.Time `json:"execute_at"`
+	// IntervalValue and IntervalField are the interval of a recurring event, such as '1:30' and 'HOUR_MINUTE'.
+	IntervalValue string    `json:"interval_value"`
+	IntervalField string    `json:"interval_field"`
+	Starts        time.Time `json:"starts"`
+	Ends          time.Time `json:"ends"`
+	// Preserve indicates whether the event is kept after it's expired.
+	Preserve bool        `json:"preserve"`
+	Status   EventStatus `json:"status"`
+	Comment  string      `json:"comment"`
+	// Body is the original text of the statement executed by the event.
+	Body string `json:"body"`
+	// SQLMode, Charset and Collate are the session settings when the event is created,
+	// the event body is parsed and executed with them.
+	SQLMode     mysql.SQLMode `json:"sql_mode"`
+	Charset     string        `json:"charset"`
+	Collate     string        `json:"collate"`
+	TimeZone    string        `json:"time_zone"`
+	Created     time.Time     `json:"created"`
+	LastAltered time.Time     `json:"last_altered"`
+}
+
+// IsRecurring returns whether the event is scheduled by EVERY.
+func (e *EventInfo) IsRecurring() bool {
+	return e.ExecuteAt.IsZero()
+}
+
+// Clone clones EventInfo.
+func (e *EventInfo) Clone() *EventInfo {
+	ne := *e
+	if e.Definer != nil {
+		definer := *e.Definer
+		ne.Definer = &definer
+	}
+	return &ne
+}
+
+//revive:disable:exported
+
 const (
 	DefaultSequenceCacheBool          = true
 	DefaultSequenceCycleBool          = false
@@ -1116,6 +1451,9 @@ type PartitionType int
 
 // Partition types.
 const (
+	// PartitionTypeNone is only used during DDL, for a non-partitioned table
+	// that is handled as a single partition.
+	PartitionTypeNone       PartitionType = 0
 	PartitionTypeRange      PartitionType = 1
 	PartitionTypeHash       PartitionType = 2
 	PartitionTypeList       PartitionType = 3
@@ -1135,6 +1473,8 @@ func (p PartitionType) String() string {
 		return "KEY"
 	case PartitionTypeSystemTime:
 		return "SYSTEM_TIME"
+	case PartitionTypeNone:
+		return "NONE"
 	default:
 		return ""
 	}
@@ -1158,6 +1498,11 @@ type PartitionInfo struct {
 	// rather than pid.
 	Enable bool `json:"enable"`
 
+	// Sub is set when the table is subpartitioned. Definitions then holds the
+	// subpartitions, Sub.Num of them for each partition in partition order,
+	// each carrying the values and the name (as ParentName) of its partition.
+	Sub *SubPartitionInfo `json:"sub,omitempty"`
+
 	Definitions []PartitionDefinition `json:"definitions"`
 	// AddingDefinitions is filled when adding partitions that is in the mid state.
 	AddingDefinitions []PartitionDefinition `json:"adding_definitions"`
@@ -1170,6 +1515,18 @@ type PartitionInfo struct {
 	Num    uint64           `json:"num"`
 	// Only used during ReorganizePartition so far
 	DDLState SchemaState `json:"ddl_state"`
+	// DDLChangeScheme is set during ALTER TABLE ... PARTITION BY and
+	// REMOVE PARTITIONING, when the partitioning scheme itself changes.
+	// DDLType, DDLExpr and DDLColumns then hold the new scheme, which is
+	// swapped with the old one when entering StateDeleteReorganization.
+	DDLChangeScheme bool          `json:"ddl_change_scheme"`
+	DDLType         PartitionType `json:"ddl_type"`
+	DDLExpr         string        `json:"ddl_expr"`
+	DDLColumns      []CIStr       `json:"ddl_columns"`
+	// NewTableID is only set in the job arguments of ALTER TABLE ... PARTITION BY
+	// and REMOVE PARTITIONING. The table gets this ID when the job is done, so its
+	// old ID can be dropped with the old partitions.
+	NewTableID int64 `json:"new_table_id,omitempty"`
 }
 
 // Clone clones itself.
@@ -1177,6 +1534,13 @@ func (pi *PartitionInfo) Clone() *PartitionInfo {
 	newPi := *pi
 	newPi.Columns = make([]CIStr, len(pi.Columns))
 	copy(newPi.Columns, pi.Columns)
+	if pi.DDLColumns != nil {
+		newPi.DDLColumns = make([]CIStr, len(pi.DDLColumns))
+		copy(newPi.DDLColumns, pi.DDLColumns)
+	}
+	if pi.Sub != nil {
+		newPi.Sub = pi.Sub.Clone()
+	}
 
 	newPi.Definitions = make([]PartitionDefinition, len(pi.Definitions))
 	for i := range pi.Definitions {
@@ -1196,6 +1560,77 @@ func (pi *PartitionInfo) Clone() *PartitionInfo {
 	return &newPi
 }
 
+// SubPartitionInfo provides the subpartition info of a subpartitioned table,
+// which is always HASH or KEY subpartitioned.
+type SubPartitionInfo struct {
+	Type    PartitionType `json:"type"`
+	Expr    string        `json:"expr"`
+	Columns []CIStr       `json:"columns"`
+	// Num is the number of subpartitions of each partition.
+	Num uint64 `json:"num"`
+}
+
+// Clone clones itself.
+func (spi *SubPartitionInfo) Clone() *SubPartitionInfo {
+	newSpi := *spi
+	newSpi.Columns = make([]CIStr, len(spi.Columns))
+	copy(newSpi.Columns, spi.Columns)
+	return &newSpi
+}
+
+// FirstLevelDefinitions returns, for a subpartitioned table, one definition for
+// each partition the subpartitions in defs belong to, with the partition name, values
+// and options. Otherwise defs is returned as is.
+func (pi *PartitionInfo) FirstLevelDefinitions(defs []PartitionDefinition) []PartitionDefinition {
+	if pi.Sub == nil {
+		return defs
+	}
+	var firstLevelDefs []PartitionDefinition
+	for i := range defs {
+		if i > 0 && defs[i].ParentName.L == defs[i-1].ParentName.L {
+			continue
+		}
+		def := defs[i].Clone()
+		def.ID = 0
+		def.Name = defs[i].ParentName
+		def.Comment = defs[i].ParentComment
+		def.PlacementPolicyRef = defs[i].ParentPlacementPolicyRef
+		def.ParentName = CIStr{}
+		def.ParentComment = ""
+		def.ParentPlacementPolicyRef = nil
+		firstLevelDefs = append(firstLevelDefs, def)
+	}
+	return firstLevelDefs
+}
+
+// FirstLevel returns the partition info of a subpartitioned table as if
+// it was only partitioned by its first level.
+func (pi *PartitionInfo) FirstLevel() *PartitionInfo {
+	newPi := *pi
+	newPi.Sub = nil
+	newPi.Definitions = pi.FirstLevelDefinitions(pi.Definitions)
+	newPi.AddingDefinitions = pi.FirstLevelDefinitions(pi.AddingDefinitions)
+	newPi.DroppingDefinitions = pi.FirstLevelDefinitions(pi.DroppingDefinitions)
+	newPi.Num = uint64(len(newPi.Definitions))
+	return &newPi
+}
+
+// SubLevel returns the partition info of the subpartitions of a subpartitioned table,
+// with the subpartitions of its first partition as definitions.
+func (pi *PartitionInfo) SubLevel() *PartitionInfo {
+	newPi := &PartitionInfo{
+		Type:    pi.Sub.Type,
+		Expr:    pi.Sub.Expr,
+		Columns: pi.Sub.Columns,
+		Enable:  pi.Enable,
+		Num:     pi.Sub.Num,
+	}
+	if uint64(len(pi.Definitions)) >= pi.Sub.Num {
+		newPi.Definitions = pi.Definitions[:pi.Sub.Num]
+	}
+	return newPi
+}
+
 // GetNameByID gets the partition name by ID.
 func (pi *PartitionInfo) GetNameByID(id int64) string {
 	definitions := pi.Definitions
@@ -1279,6 +1714,12 @@ type PartitionDefinition struct {
 	InValues           [][]string     `json:"in_values"`
 	PlacementPolicyRef *PolicyRefInfo `json:"policy_ref_info"`
 	Comment            string         `json:"comment,omitempty"`
+	// ParentName is the name of the partition a subpartition belongs to.
+	ParentName CIStr `json:"parent_name"`
+	// ParentComment and ParentPlacementPolicyRef are the options of the
+	// partition a subpartition belongs to, which may differ from its own.
+	ParentComment            string         `json:"parent_comment,omitempty"`
+	ParentPlacementPolicyRef *PolicyRefInfo `json:"parent_policy_ref_info,omitempty"`
 }
 
 // Clone clones ConstraintInfo.
@@ -1325,6 +1766,19 @@ func (pi *PartitionInfo) FindPartitionDefinitionByName(partitionDefinitionName s
 	return -1
 }
 
+// FindPartitionDefinitionsByName finds the offsets of the PartitionDefinitions by name.
+// For a subpartitioned table, the name of a partition matches all its subpartitions.
+func (pi *PartitionInfo) FindPartitionDefinitionsByName(partitionDefinitionName string) []int {
+	lowConstrName := strings.ToLower(partitionDefinitionName)
+	var offsets []int
+	for i := range pi.Definitions {
+		if pi.Definitions[i].Name.L == lowConstrName || pi.Definitions[i].ParentName.L == lowConstrName {
+			offsets = append(offsets, i)
+		}
+	}
+	return offsets
+}
+
 // GetPartitionIDByName gets the partition ID by name.
 func (pi *PartitionInfo) GetPartitionIDByName(partitionDefinitionName string) int64 {
 	lowConstrName := strings.ToLower(partitionDefinitionName)
@@ -1421,6 +1875,8 @@ type IndexInfo struct {
 	Invisible     bool           `json:"is_invisible"` // Whether the index is invisible.
 	Global        bool           `json:"is_global"`    // Whether the index is global.
 	MVIndex       bool           `json:"mv_index"`     // Whether the index is multivalued index.
+	FullText      bool           `json:"is_fulltext"`  // Whether the index is a FULLTEXT index.
+	ParserName    CIStr          `json:"parser_name"`  // The full-text parser of a FULLTEXT index, empty for the built-in one.
 }
 
 // Clone clones IndexInfo.
@@ -1643,6 +2099,7 @@ type DBInfo struct {
 	Charset            string         `json:"charset"`
 	Collate            string         `json:"collate"`
 	Tables             []*TableInfo   `json:"-"` // Tables in the DB.
+	Routines           []*RoutineInfo `json:"-"` // Stored procedures and functions in the DB.
 	State              SchemaState    `json:"state"`
 	PlacementPolicyRef *PolicyRefInfo `json:"policy_ref_info"`
 }
@@ -1654,9 +2111,26 @@ func (db *DBInfo) Clone() *DBInfo {
 	for i := range db.Tables {
 		newInfo.Tables[i] = db.Tables[i].Clone()
 	}
+	if db.Routines != nil {
+		newInfo.Routines = make([]*RoutineInfo, len(db.Routines))
+		for i := range db.Routines {
+			newInfo.Routines[i] = db.Routines[i].Clone()
+		}
+	}
 	return &newInfo
 }
 
+// FindRoutine finds the stored procedure or function by name, the procedures and functions have
+// separate namespaces.
+func (db *DBInfo) FindRoutine(name CIStr, tp RoutineType) *RoutineInfo {
+	for _, routine := range db.Routines {
+		if routine.Type == tp && routine.Name.L == name.L {
+			return routine
+		}
+	}
+	return nil
+}
+
 // Copy shallow copies DBInfo.
 func (db *DBInfo) Copy() *DBInfo {
 	newInfo := *db
@@ -1770,8 +2244,9 @@ type TTLInfo struct {
 	// `IntervalTimeUnit` is actually ast.TimeUnitType. Use `int` to avoid cycle dependency
 	IntervalTimeUnit int  `json:"interval_time_unit"`
 	Enable           bool `json:"enable"`
-	// JobInterval is the interval between two TTL scan jobs.
-	// It's suggested to get a duration with `(*TTLInfo).GetJobInterval`
+	// JobInterval is the interval between two TTL scan jobs, or a cron expression like "0 2 * * *" to run the jobs
+	// at the specified times.
+	// It's suggested to get the next job time with `(*TTLInfo).NextJobTime`
 	JobInterval string `json:"job_interval"`
 }
 
@@ -1791,9 +2266,38 @@ func (t *TTLInfo) GetJobInterval() (time.Duration, error) {
 		return DefaultJobInterval, nil
 	}
 
+	if t.IsJobIntervalCron() {
+		return 0, errors.Errorf("job interval '%s' is a cron expression rather than a duration", t.JobInterval)
+	}
 	return duration.ParseDuration(t.JobInterval)
 }
 
+// IsJobIntervalCron returns whether the job interval is a cron expression.
+func (t *TTLInfo) IsJobIntervalCron() bool {
+	return cron.IsCronExpr(t.JobInterval)
+}
+
+// NextJobTime returns the time to schedule the next job after the last job started at `last`.
+func (t *TTLInfo) NextJobTime(last time.Time) (time.Time, error) {
+	if !t.IsJobIntervalCron() {
+		interval, err := t.GetJobInterval()
+		if err != nil {
+			return time.Time{}, err
+		}
+		return last.Add(interval), nil
+	}
+
+	schedule, err := cron.Parse(t.JobInterval)
+	if err != nil {
+		return time.Time{}, err
+	}
+	next, ok := schedule.Next(last)
+	if !ok {
+		return time.Time{}, errors.Errorf("job interval '%s' doesn't have a next time after %s", t.JobInterval, last)
+	}
+	return next, nil
+}
+
 func writeSettingItemToBuilder(sb *strings.Builder, item string, separatorFns ...func()) {
 	if sb.Len() != 0 {
 		for _, fn := range separatorFns {
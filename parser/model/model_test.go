@@ -731,6 +731,30 @@ func TestPlacementPolicyClone(t *testing.T) {
 	require.Equal(t, PlacementSettings{}, *(policy.PlacementSettings))
 }
 
+func TestPartitionInfoCloneDDLColumns(t *testing.T) {
+	pi := &PartitionInfo{Columns: []CIStr{NewCIStr("a")}}
+	require.Nil(t, pi.Clone().DDLColumns)
+
+	pi.DDLColumns = []CIStr{NewCIStr("b")}
+	cloned := pi.Clone()
+	cloned.DDLColumns[0] = NewCIStr("c")
+	require.Equal(t, NewCIStr("b"), pi.DDLColumns[0])
+}
+
+func TestViewInfoCheckOption(t *testing.T) {
+	// The views created before ViewInfoVersion1 are stored with CheckOptionCascaded.
+	var view ViewInfo
+	require.NoError(t, json.Unmarshal([]byte(`{"view_select":"SELECT 1","view_checkoption":1}`), &view))
+	require.Equal(t, ViewInfoVersion0, view.Version)
+	require.Equal(t, CheckOptionCascaded, view.CheckOption)
+	require.Equal(t, CheckOptionNone, view.GetCheckOption())
+
+	view.Version = CurrLatestViewInfoVersion
+	require.Equal(t, CheckOptionCascaded, view.GetCheckOption())
+	view.CheckOption = CheckOptionLocal
+	require.Equal(t, CheckOptionLocal, view.GetCheckOption())
+}
+
 func TestLocation(t *testing.T) {
 	// test offset = 0
 	loc := &TimeZoneLocation{}
@@ -817,4 +841,22 @@ func TestTTLJobInterval(t *testing.T) {
 	interval, err = ttlInfo.GetJobInterval()
 	require.NoError(t, err)
 	require.Equal(t, time.Hour*200, interval)
+	require.False(t, ttlInfo.IsJobIntervalCron())
+
+	last := time.Date(2023, 5, 31, 23, 30, 0, 0, time.UTC)
+	next, err := ttlInfo.NextJobTime(last)
+	require.NoError(t, err)
+	require.Equal(t, last.Add(200*time.Hour), next)
+
+	ttlInfo = &TTLInfo{JobInterval: "CRON_TZ=UTC 0 2 * * *"}
+	require.True(t, ttlInfo.IsJobIntervalCron())
+	_, err = ttlInfo.GetJobInterval()
+	require.Error(t, err)
+	next, err = ttlInfo.NextJobTime(last)
+	require.NoError(t, err)
+	require.True(t, time.Date(2023, 6, 1, 2, 0, 0, 0, time.UTC).Equal(next))
+
+	ttlInfo = &TTLInfo{JobInterval: "CRON_TZ=UTC 0 0 30 2 *"}
+	_, err = ttlInfo.NextJobTime(last)
+	require.Error(t, err)
 }
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/parser/charset"
 	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/parser/cron"
 	"github.com/pingcap/tidb/parser/duration"
 )
 
@@ -178,11 +179,13 @@ import (
 	int8Type          "INT8"
 	iterate           "ITERATE"
 	join              "JOIN"
+	jsonTable         "JSON_TABLE"
 	key               "KEY"
 	keys              "KEYS"
 	kill              "KILL"
 	lag               "LAG"
 	lastValue         "LAST_VALUE"
+	lateral           "LATERAL"
 	lead              "LEAD"
 	leading           "LEADING"
 	leave             "LEAVE"
@@ -198,6 +201,7 @@ import (
 	lock              "LOCK"
 	longblobType      "LONGBLOB"
 	longtextType      "LONGTEXT"
+	loop              "LOOP"
 	lowPriority       "LOW_PRIORITY"
 	match             "MATCH"
 	maxValue          "MAXVALUE"
@@ -241,6 +245,7 @@ import (
 	replace           "REPLACE"
 	require           "REQUIRE"
 	restrict          "RESTRICT"
+	returnKwd         "RETURN"
 	revoke            "REVOKE"
 	right             "RIGHT"
 	rlike             "RLIKE"
@@ -318,6 +323,7 @@ import (
 	always                "ALWAYS"
 	any                   "ANY"
 	ascii                 "ASCII"
+	at                    "AT"
 	attribute             "ATTRIBUTE"
 	attributes            "ATTRIBUTES"
 	statsOptions          "STATS_OPTIONS"
@@ -333,6 +339,7 @@ import (
 	backend               "BACKEND"
 	backup                "BACKUP"
 	backups               "BACKUPS"
+	before                "BEFORE"
 	begin                 "BEGIN"
 	bernoulli             "BERNOULLI"
 	binding               "BINDING"
@@ -367,12 +374,15 @@ import (
 	commit                "COMMIT"
 	committed             "COMMITTED"
 	compact               "COMPACT"
+	complete              "COMPLETE"
+	completion            "COMPLETION"
 	compressed            "COMPRESSED"
 	compression           "COMPRESSION"
 	concurrency           "CONCURRENCY"
 	connection            "CONNECTION"
 	consistency           "CONSISTENCY"
 	consistent            "CONSISTENT"
+	contains              "CONTAINS"
 	context               "CONTEXT"
 	cpu                   "CPU"
 	csvBackslashEscape    "CSV_BACKSLASH_ESCAPE"
@@ -395,6 +405,7 @@ import (
 	declare               "DECLARE"
 	definer               "DEFINER"
 	delayKeyWrite         "DELAY_KEY_WRITE"
+	deterministic         "DETERMINISTIC"
 	digest                "DIGEST"
 	directory             "DIRECTORY"
 	disable               "DISABLE"
@@ -404,10 +415,13 @@ import (
 	do                    "DO"
 	duplicate             "DUPLICATE"
 	dynamic               "DYNAMIC"
+	each                  "EACH"
+	empty                 "EMPTY"
 	enable                "ENABLE"
 	enabled               "ENABLED"
 	encryption            "ENCRYPTION"
 	end                   "END"
+	ends                  "ENDS"
 	enforced              "ENFORCED"
 	engine                "ENGINE"
 	engines               "ENGINES"
@@ -416,6 +430,7 @@ import (
 	escape                "ESCAPE"
 	event                 "EVENT"
 	events                "EVENTS"
+	every                 "EVERY"
 	evolve                "EVOLVE"
 	exchange              "EXCHANGE"
 	exclusive             "EXCLUSIVE"
@@ -431,10 +446,14 @@ import (
 	flush                 "FLUSH"
 	found                 "FOUND"
 	following             "FOLLOWING"
+	follows               "FOLLOWS"
 	format                "FORMAT"
 	full                  "FULL"
 	function              "FUNCTION"
 	general               "GENERAL"
+	geomCollection        "GEOMCOLLECTION"
+	geometry              "GEOMETRY"
+	geometryCollection    "GEOMETRYCOLLECTION"
 	global                "GLOBAL"
 	grants                "GRANTS"
 	handler               "HANDLER"
@@ -468,12 +487,14 @@ import (
 	lastval               "LASTVAL"
 	less                  "LESS"
 	level                 "LEVEL"
+	lineString            "LINESTRING"
 	list                  "LIST"
 	local                 "LOCAL"
 	locked                "LOCKED"
 	location              "LOCATION"
 	logs                  "LOGS"
 	master                "MASTER"
+	materialized          "MATERIALIZED"
 	max_idxnum            "MAX_IDXNUM"
 	max_minutes           "MAX_MINUTES"
 	maxConnectionsPerHour "MAX_CONNECTIONS_PER_HOUR"
@@ -486,15 +507,21 @@ import (
 	memory                "MEMORY"
 	merge                 "MERGE"
 	microsecond           "MICROSECOND"
+	migrate               "MIGRATE"
 	minRows               "MIN_ROWS"
 	minute                "MINUTE"
 	minValue              "MINVALUE"
 	mode                  "MODE"
+	modifies              "MODIFIES"
 	modify                "MODIFY"
 	month                 "MONTH"
+	multiLineString       "MULTILINESTRING"
+	multiPoint            "MULTIPOINT"
+	multiPolygon          "MULTIPOLYGON"
 	names                 "NAMES"
 	national              "NATIONAL"
 	ncharType             "NCHAR"
+	nested                "NESTED"
 	never                 "NEVER"
 	next                  "NEXT"
 	nextval               "NEXTVAL"
@@ -515,10 +542,12 @@ import (
 	oltpReadWrite         "OLTP_READ_WRITE"
 	oltpWriteOnly         "OLTP_WRITE_ONLY"
 	onDuplicate           "ON_DUPLICATE"
+	one                   "ONE"
 	online                "ONLINE"
 	only                  "ONLY"
 	open                  "OPEN"
 	optional              "OPTIONAL"
+	ordinality            "ORDINALITY"
 	packKeys              "PACK_KEYS"
 	pageSym               "PAGE"
 	parser                "PARSER"
@@ -526,16 +555,20 @@ import (
 	partitioning          "PARTITIONING"
 	partitions            "PARTITIONS"
 	password              "PASSWORD"
+	path                  "PATH"
 	pause                 "PAUSE"
 	percent               "PERCENT"
 	per_db                "PER_DB"
 	per_table             "PER_TABLE"
+	phase                 "PHASE"
 	pipesAsOr
 	plugins               "PLUGINS"
 	point                 "POINT"
 	policy                "POLICY"
+	polygon               "POLYGON"
 	preSplitRegions       "PRE_SPLIT_REGIONS"
 	preceding             "PRECEDING"
+	precedes              "PRECEDES"
 	prepare               "PREPARE"
 	preserve              "PRESERVE"
 	privileges            "PRIVILEGES"
@@ -550,9 +583,11 @@ import (
 	query                 "QUERY"
 	quick                 "QUICK"
 	rateLimit             "RATE_LIMIT"
+	reads                 "READS"
 	rebuild               "REBUILD"
 	recover               "RECOVER"
 	redundant             "REDUNDANT"
+	refresh               "REFRESH"
 	reload                "RELOAD"
 	remove                "REMOVE"
 	reorganize            "REORGANIZE"
@@ -568,6 +603,7 @@ import (
 	restore               "RESTORE"
 	restores              "RESTORES"
 	resume                "RESUME"
+	returns               "RETURNS"
 	reuse                 "REUSE"
 	reverse               "REVERSE"
 	role                  "ROLE"
@@ -616,7 +652,9 @@ import (
 	sqlTsiSecond          "SQL_TSI_SECOND"
 	sqlTsiWeek            "SQL_TSI_WEEK"
 	sqlTsiYear            "SQL_TSI_YEAR"
+	srid                  "SRID"
 	start                 "START"
+	starts                "STARTS"
 	statsAutoRecalc       "STATS_AUTO_RECALC"
 	statsPersistent       "STATS_PERSISTENT"
 	statsSamplePages      "STATS_SAMPLE_PAGES"
@@ -627,6 +665,7 @@ import (
 	subpartition          "SUBPARTITION"
 	subpartitions         "SUBPARTITIONS"
 	super                 "SUPER"
+	suspend               "SUSPEND"
 	swaps                 "SWAPS"
 	switchesSym           "SWITCHES"
 	system                "SYSTEM"
@@ -669,6 +708,8 @@ import (
 	without               "WITHOUT"
 	workload              "WORKLOAD"
 	x509                  "X509"
+	xa                    "XA"
+	xid                   "XID"
 	yearType              "YEAR"
 	wait                  "WAIT"
 	failedLoginAttempts   "FAILED_LOGIN_ATTEMPTS"
@@ -807,6 +848,7 @@ import (
 	depth                      "DEPTH"
 	drainer                    "DRAINER"
 	dry                        "DRY"
+	feedback                   "FEEDBACK"
 	jobs                       "JOBS"
 	job                        "JOB"
 	nodeID                     "NODE_ID"
@@ -913,6 +955,7 @@ import (
 	FunctionCallNonKeyword          "Function call with nonkeyword as function name"
 	Literal                         "literal value"
 	Variable                        "User or system variable"
+	SelectIntoVar                   "SELECT INTO variable"
 	SystemVariable                  "System defined variable name"
 	UserVariable                    "User defined variable name"
 	SubSelect                       "Sub Select"
@@ -931,139 +974,151 @@ import (
 	ProcedureCall                   "Procedure call with Identifier or identifier"
 
 %type	<statement>
-	AdminStmt                  "Check table statement or show ddl statement"
-	AlterDatabaseStmt          "Alter database statement"
-	AlterTableStmt             "Alter table statement"
-	AlterUserStmt              "Alter user statement"
-	AlterInstanceStmt          "Alter instance statement"
-	AlterPolicyStmt            "Alter Placement Policy statement"
-	AlterResourceGroupStmt     "Alter Resource Group statement"
-	AlterSequenceStmt          "Alter sequence statement"
-	AnalyzeTableStmt           "Analyze table statement"
-	BeginTransactionStmt       "BEGIN TRANSACTION statement"
-	BinlogStmt                 "Binlog base64 statement"
-	BRIEStmt                   "BACKUP or RESTORE statement"
-	CalibrateResourceStmt      "CALIBRATE RESOURCE statement"
-	CommitStmt                 "COMMIT statement"
-	CreateTableStmt            "CREATE TABLE statement"
-	CreateViewStmt             "CREATE VIEW  statement"
-	CreateUserStmt             "CREATE User statement"
-	CreateRoleStmt             "CREATE Role statement"
-	CreateDatabaseStmt         "Create Database Statement"
-	CreateIndexStmt            "CREATE INDEX statement"
-	CreateBindingStmt          "CREATE BINDING statement"
-	CreatePolicyStmt           "CREATE PLACEMENT POLICY statement"
-	CreateProcedureStmt        "CREATE PROCEDURE statement"
-	AddQueryWatchStmt          "ADD QUERY WATCH statement"
-	CreateResourceGroupStmt    "CREATE RESOURCE GROUP statement"
-	CreateSequenceStmt         "CREATE SEQUENCE statement"
-	CreateStatisticsStmt       "CREATE STATISTICS statement"
-	DoStmt                     "Do statement"
-	DropDatabaseStmt           "DROP DATABASE statement"
-	DropIndexStmt              "DROP INDEX statement"
-	DropProcedureStmt          "DROP PROCEDURE statement"
-	DropQueryWatchStmt         "DROP QUERY WATCH statement"
-	DropResourceGroupStmt      "DROP RESOURCE GROUP statement"
-	DropStatisticsStmt         "DROP STATISTICS statement"
-	DropStatsStmt              "DROP STATS statement"
-	DropTableStmt              "DROP TABLE statement"
-	DropSequenceStmt           "DROP SEQUENCE statement"
-	DropUserStmt               "DROP USER"
-	DropRoleStmt               "DROP ROLE"
-	DropViewStmt               "DROP VIEW statement"
-	DropBindingStmt            "DROP BINDING  statement"
-	DropPolicyStmt             "DROP PLACEMENT POLICY statement"
-	DeallocateStmt             "Deallocate prepared statement"
-	DeleteFromStmt             "DELETE FROM statement"
-	DeleteWithoutUsingStmt     "Normal DELETE statement"
-	DeleteWithUsingStmt        "DELETE USING statement"
-	EmptyStmt                  "empty statement"
-	ExecuteStmt                "Execute statement"
-	ExplainStmt                "EXPLAIN statement"
-	ExplainableStmt            "explainable statement"
-	FlushStmt                  "Flush statement"
-	FlashbackTableStmt         "Flashback table statement"
-	FlashbackToTimestampStmt   "Flashback cluster statement"
-	FlashbackDatabaseStmt      "Flashback Database statement"
-	GrantStmt                  "Grant statement"
-	GrantProxyStmt             "Grant proxy statement"
-	GrantRoleStmt              "Grant role statement"
-	InsertIntoStmt             "INSERT INTO statement"
-	CallStmt                   "CALL statement"
-	IndexAdviseStmt            "INDEX ADVISE statement"
-	ImportIntoStmt             "IMPORT INTO statement"
-	KillStmt                   "Kill statement"
-	LoadDataStmt               "Load data statement"
-	LoadStatsStmt              "Load statistic statement"
-	LockStatsStmt              "Lock statistic statement"
-	UnlockStatsStmt            "Unlock statistic statement"
-	LockTablesStmt             "Lock tables statement"
-	NonTransactionalDMLStmt    "Non-transactional DML statement"
-	PlanReplayerStmt           "Plan replayer statement"
-	PreparedStmt               "PreparedStmt"
-	ProcedureProcStmt          "The entrance of procedure statements which contains all kinds of statements in procedure"
-	ProcedureStatementStmt     "The normal statements in procedure, such as dml, select, set ..."
-	SelectStmt                 "SELECT statement"
-	SelectStmtWithClause       "common table expression SELECT statement"
-	RenameTableStmt            "rename table statement"
-	RenameUserStmt             "rename user statement"
-	ReplaceIntoStmt            "REPLACE INTO statement"
-	RecoverTableStmt           "recover table statement"
-	RevokeStmt                 "Revoke statement"
-	RevokeRoleStmt             "Revoke role statement"
-	RollbackStmt               "ROLLBACK statement"
-	ReleaseSavepointStmt       "RELEASE SAVEPOINT statement"
-	SavepointStmt              "SAVEPOINT statement"
-	SplitRegionStmt            "Split index region statement"
-	SetStmt                    "Set variable statement"
-	ChangeStmt                 "Change statement"
-	SetBindingStmt             "Set binding statement"
-	SetRoleStmt                "Set active role statement"
-	SetDefaultRoleStmt         "Set default statement for some user"
-	ShowStmt                   "Show engines/databases/tables/user/columns/warnings/status statement"
-	Statement                  "statement"
-	TraceStmt                  "TRACE statement"
-	TraceableStmt              "traceable statement"
-	TruncateTableStmt          "TRUNCATE TABLE statement"
-	UnlockTablesStmt           "Unlock tables statement"
-	UpdateStmt                 "UPDATE statement"
-	SetOprStmt                 "Union/Except/Intersect select statement"
-	SetOprStmtWithLimitOrderBy "Union/Except/Intersect select statement with limit and order by"
-	SetOprStmtWoutLimitOrderBy "Union/Except/Intersect select statement without limit and order by"
-	UseStmt                    "USE statement"
-	ShutdownStmt               "SHUTDOWN statement"
-	RestartStmt                "RESTART statement"
-	CreateViewSelectOpt        "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
-	BindableStmt               "Statement that can be created binding on"
-	UpdateStmtNoWith           "Update statement without CTE clause"
-	HelpStmt                   "HELP statement"
-	ShardableStmt              "Shardable statement that can be used in non-transactional DMLs"
-	PauseLoadDataStmt          "PAUSE LOAD DATA JOB statement"
-	ResumeLoadDataStmt         "RESUME LOAD DATA JOB statement"
-	CancelImportStmt           "CANCEL IMPORT JOB statement"
-	DropLoadDataStmt           "DROP LOAD DATA JOB statement"
-	ProcedureUnlabeledBlock    "The statement block without label in procedure"
-	ProcedureBlockContent      "The statement block in procedure expressed with 'Begin ... End'"
-	SimpleWhenThen             "Procedure case when then"
-	SearchWhenThen             "Procedure search when then"
-	ProcedureIfstmt            "The if statement in procedure, expressed by if ... elseif .. else ... end if"
-	procedurceElseIfs          "The else block in procedure, expressed by elseif or else or nil"
-	ProcedureIf                "The if block in procedure, expressed by expr then statement procedurceElseIfs"
-	ProcedureUnlabelLoopBlock  "The loop block without label in procedure "
-	ProcedureUnlabelLoopStmt   "The loop statement in procedure, expressed by repeat/do while/loop"
-	ProcedureCaseStmt          "Case statement in procedure, expressed by `case ... when.. then ..`"
-	ProcedureSimpleCase        "The simpe case statement in procedure, expressed by `case expr when expr then statement ... end case`"
-	ProcedureSearchedCase      "The searched case statement in procedure, expressed by `case when expr then statement ... end case`"
-	ProcedureCursorSelectStmt  "The select stmt can used in procedure cursor."
-	ProcedureOpenCur           "The open cursor statement in procedure, expressed by `open ...`"
-	ProcedureCloseCur          "The close cursor statement in procedure, expressed by `close ...`"
-	ProcedureFetchInto         "The fetch into statement in procedure, expressed by `fetch ... into ...`"
-	ProcedureHcond             "The handler value statement in procedure, expressed by condition_value"
-	ProcedurceCond             "The handler code statement in procedure, expressed by code error num or `sqlstate ...`"
-	ProcedureLabeledBlock      "The statement block with label in procedure"
-	ProcedurelabeledLoopStmt   "The loop block with label in procedure"
-	ProcedureIterate           "The iterate statement in procedure, expressed by `iterate ...`"
-	ProcedureLeave             "The leave statement in procedure, expressed by `leave ...`"
+	AdminStmt                   "Check table statement or show ddl statement"
+	AlterDatabaseStmt           "Alter database statement"
+	AlterTableStmt              "Alter table statement"
+	AlterUserStmt               "Alter user statement"
+	AlterInstanceStmt           "Alter instance statement"
+	AlterPolicyStmt             "Alter Placement Policy statement"
+	AlterResourceGroupStmt      "Alter Resource Group statement"
+	AlterSequenceStmt           "Alter sequence statement"
+	AnalyzeTableStmt            "Analyze table statement"
+	BeginTransactionStmt        "BEGIN TRANSACTION statement"
+	BinlogStmt                  "Binlog base64 statement"
+	BRIEStmt                    "BACKUP or RESTORE statement"
+	CalibrateResourceStmt       "CALIBRATE RESOURCE statement"
+	CommitStmt                  "COMMIT statement"
+	CreateTableStmt             "CREATE TABLE statement"
+	CreateViewStmt              "CREATE VIEW  statement"
+	CreateMaterializedViewStmt  "CREATE MATERIALIZED VIEW statement"
+	CreateUserStmt              "CREATE User statement"
+	CreateRoleStmt              "CREATE Role statement"
+	CreateDatabaseStmt          "Create Database Statement"
+	CreateIndexStmt             "CREATE INDEX statement"
+	CreateBindingStmt           "CREATE BINDING statement"
+	CreatePolicyStmt            "CREATE PLACEMENT POLICY statement"
+	CreateProcedureStmt         "CREATE PROCEDURE statement"
+	CreateTriggerStmt           "CREATE TRIGGER statement"
+	CreateEventStmt             "CREATE EVENT statement"
+	AlterEventStmt              "ALTER EVENT statement"
+	AddQueryWatchStmt           "ADD QUERY WATCH statement"
+	CreateResourceGroupStmt     "CREATE RESOURCE GROUP statement"
+	CreateSequenceStmt          "CREATE SEQUENCE statement"
+	CreateStatisticsStmt        "CREATE STATISTICS statement"
+	DoStmt                      "Do statement"
+	DropDatabaseStmt            "DROP DATABASE statement"
+	DropIndexStmt               "DROP INDEX statement"
+	DropProcedureStmt           "DROP PROCEDURE statement"
+	DropTriggerStmt             "DROP TRIGGER statement"
+	DropEventStmt               "DROP EVENT statement"
+	DropQueryWatchStmt          "DROP QUERY WATCH statement"
+	DropResourceGroupStmt       "DROP RESOURCE GROUP statement"
+	DropStatisticsStmt          "DROP STATISTICS statement"
+	DropStatsStmt               "DROP STATS statement"
+	DropTableStmt               "DROP TABLE statement"
+	DropSequenceStmt            "DROP SEQUENCE statement"
+	DropUserStmt                "DROP USER"
+	DropRoleStmt                "DROP ROLE"
+	DropViewStmt                "DROP VIEW statement"
+	DropBindingStmt             "DROP BINDING  statement"
+	DropPolicyStmt              "DROP PLACEMENT POLICY statement"
+	DeallocateStmt              "Deallocate prepared statement"
+	DeleteFromStmt              "DELETE FROM statement"
+	DeleteWithoutUsingStmt      "Normal DELETE statement"
+	DeleteWithUsingStmt         "DELETE USING statement"
+	EmptyStmt                   "empty statement"
+	ExecuteStmt                 "Execute statement"
+	ExplainStmt                 "EXPLAIN statement"
+	ExplainableStmt             "explainable statement"
+	FlushStmt                   "Flush statement"
+	FlashbackTableStmt          "Flashback table statement"
+	FlashbackToTimestampStmt    "Flashback cluster statement"
+	FlashbackDatabaseStmt       "Flashback Database statement"
+	GrantStmt                   "Grant statement"
+	GrantProxyStmt              "Grant proxy statement"
+	GrantRoleStmt               "Grant role statement"
+	InsertIntoStmt              "INSERT INTO statement"
+	CallStmt                    "CALL statement"
+	IndexAdviseStmt             "INDEX ADVISE statement"
+	AdviseIndexStmt             "ADVISE INDEX statement"
+	ImportIntoStmt              "IMPORT INTO statement"
+	KillStmt                    "Kill statement"
+	LoadDataStmt                "Load data statement"
+	LoadStatsStmt               "Load statistic statement"
+	LockStatsStmt               "Lock statistic statement"
+	UnlockStatsStmt             "Unlock statistic statement"
+	LockTablesStmt              "Lock tables statement"
+	NonTransactionalDMLStmt     "Non-transactional DML statement"
+	PlanReplayerStmt            "Plan replayer statement"
+	PreparedStmt                "PreparedStmt"
+	ProcedureProcStmt           "The entrance of procedure statements which contains all kinds of statements in procedure"
+	ProcedureStatementStmt      "The normal statements in procedure, such as dml, select, set ..."
+	SelectStmt                  "SELECT statement"
+	SelectStmtWithClause        "common table expression SELECT statement"
+	RenameTableStmt             "rename table statement"
+	RenameUserStmt              "rename user statement"
+	ReplaceIntoStmt             "REPLACE INTO statement"
+	RecoverTableStmt            "recover table statement"
+	RefreshMaterializedViewStmt "REFRESH MATERIALIZED VIEW statement"
+	RevokeStmt                  "Revoke statement"
+	RevokeRoleStmt              "Revoke role statement"
+	RollbackStmt                "ROLLBACK statement"
+	ReleaseSavepointStmt        "RELEASE SAVEPOINT statement"
+	SavepointStmt               "SAVEPOINT statement"
+	SplitRegionStmt             "Split index region statement"
+	SetStmt                     "Set variable statement"
+	ChangeStmt                  "Change statement"
+	SetBindingStmt              "Set binding statement"
+	SetRoleStmt                 "Set active role statement"
+	SetDefaultRoleStmt          "Set default statement for some user"
+	ShowStmt                    "Show engines/databases/tables/user/columns/warnings/status statement"
+	Statement                   "statement"
+	TraceStmt                   "TRACE statement"
+	TraceableStmt               "traceable statement"
+	TruncateTableStmt           "TRUNCATE TABLE statement"
+	UnlockTablesStmt            "Unlock tables statement"
+	UpdateStmt                  "UPDATE statement"
+	SetOprStmt                  "Union/Except/Intersect select statement"
+	SetOprStmtWithLimitOrderBy  "Union/Except/Intersect select statement with limit and order by"
+	SetOprStmtWoutLimitOrderBy  "Union/Except/Intersect select statement without limit and order by"
+	UseStmt                     "USE statement"
+	XAStmt                      "XA statement"
+	ShutdownStmt                "SHUTDOWN statement"
+	RestartStmt                 "RESTART statement"
+	CreateViewSelectOpt         "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
+	BindableStmt                "Statement that can be created binding on"
+	UpdateStmtNoWith            "Update statement without CTE clause"
+	HelpStmt                    "HELP statement"
+	ShardableStmt               "Shardable statement that can be used in non-transactional DMLs"
+	PauseLoadDataStmt           "PAUSE LOAD DATA JOB statement"
+	ResumeLoadDataStmt          "RESUME LOAD DATA JOB statement"
+	CancelImportStmt            "CANCEL IMPORT JOB statement"
+	DropLoadDataStmt            "DROP LOAD DATA JOB statement"
+	ProcedureUnlabeledBlock     "The statement block without label in procedure"
+	ProcedureBlockContent       "The statement block in procedure expressed with 'Begin ... End'"
+	SimpleWhenThen              "Procedure case when then"
+	SearchWhenThen              "Procedure search when then"
+	ProcedureIfstmt             "The if statement in procedure, expressed by if ... elseif .. else ... end if"
+	procedurceElseIfs           "The else block in procedure, expressed by elseif or else or nil"
+	ProcedureIf                 "The if block in procedure, expressed by expr then statement procedurceElseIfs"
+	ProcedureUnlabelLoopBlock   "The loop block without label in procedure "
+	ProcedureUnlabelLoopStmt    "The loop statement in procedure, expressed by repeat/do while/loop"
+	ProcedureCaseStmt           "Case statement in procedure, expressed by `case ... when.. then ..`"
+	ProcedureSimpleCase         "The simpe case statement in procedure, expressed by `case expr when expr then statement ... end case`"
+	ProcedureSearchedCase       "The searched case statement in procedure, expressed by `case when expr then statement ... end case`"
+	ProcedureCursorSelectStmt   "The select stmt can used in procedure cursor."
+	ProcedureOpenCur            "The open cursor statement in procedure, expressed by `open ...`"
+	ProcedureCloseCur           "The close cursor statement in procedure, expressed by `close ...`"
+	ProcedureFetchInto          "The fetch into statement in procedure, expressed by `fetch ... into ...`"
+	ProcedureHcond              "The handler value statement in procedure, expressed by condition_value"
+	ProcedurceCond              "The handler code statement in procedure, expressed by code error num or `sqlstate ...`"
+	ProcedureLabeledBlock       "The statement block with label in procedure"
+	ProcedurelabeledLoopStmt    "The loop block with label in procedure"
+	ProcedureIterate            "The iterate statement in procedure, expressed by `iterate ...`"
+	ProcedureLeave              "The leave statement in procedure, expressed by `leave ...`"
+	ProcedureReturn             "The return statement in stored function, expressed by `return ...`"
+	CreateFunctionStmt          "CREATE FUNCTION statement"
+	DropFunctionStmt            "DROP FUNCTION statement"
 
 %type	<item>
 	AdminShowSlow                          "Admin Show Slow statement"
@@ -1184,6 +1239,11 @@ import (
 	IndexPartSpecificationListOpt          "Optional list of index column name or expression"
 	InsertValues                           "Rest part of INSERT/REPLACE INTO statement"
 	IntervalExpr                           "Interval expression"
+	JSONTableColumn                        "JSON_TABLE column definition"
+	JSONTableColumnList                    "JSON_TABLE column definition list"
+	JSONTableColumnsClause                 "JSON_TABLE COLUMNS clause"
+	JSONTableOnEmptyOnError                "JSON_TABLE ON EMPTY and ON ERROR clauses"
+	JSONTableOnResponse                    "JSON_TABLE ON EMPTY or ON ERROR response"
 	JoinTable                              "join table"
 	JoinType                               "join type"
 	KillOrKillTiDB                         "Kill or Kill TiDB"
@@ -1284,6 +1344,7 @@ import (
 	SelectStmtFromTable                    "SELECT statement from table"
 	SelectStmtGroup                        "SELECT statement optional GROUP BY clause"
 	SelectStmtIntoOption                   "SELECT statement into clause"
+	SelectIntoVarList                      "SELECT INTO variable list"
 	SequenceOption                         "Create sequence option"
 	SequenceOptionList                     "Create sequence option list"
 	SetRoleOpt                             "Set role options"
@@ -1359,6 +1420,22 @@ import (
 	ValuesStmtList                         "VALUES statement field list"
 	VariableAssignment                     "set variable value"
 	VariableAssignmentList                 "set variable value list"
+	MaterializedViewRefreshOpt             "Materialized view refresh mode option"
+	TriggerActionTime                      "Trigger action time"
+	TriggerEvent                           "Trigger event"
+	TriggerOrderOpt                        "Trigger order option"
+	EventSchedule                          "Event schedule"
+	EventScheduleStartsOpt                 "Event schedule STARTS option"
+	EventScheduleEndsOpt                   "Event schedule ENDS option"
+	EventCompletion                        "Event ON COMPLETION clause"
+	EventCompletionOpt                     "Event ON COMPLETION option"
+	EventStatus                            "Event status"
+	EventStatusOpt                         "Event status option"
+	AlterEventOnOpt                        "ALTER EVENT ON SCHEDULE and ON COMPLETION option"
+	AlterEventRenameOpt                    "ALTER EVENT RENAME option"
+	AlterEventStatusOpt                    "ALTER EVENT status option"
+	AlterEventCommentOpt                   "ALTER EVENT COMMENT option"
+	AlterEventBodyOpt                      "ALTER EVENT DO option"
 	ViewAlgorithm                          "view algorithm"
 	ViewCheckOption                        "view check option"
 	ViewDefiner                            "view definer"
@@ -1376,6 +1453,9 @@ import (
 	WithGrantOptionOpt                     "With Grant Option opt"
 	WithValidation                         "with validation"
 	WithValidationOpt                      "optional with validation"
+	XAEndOpt                               "XA END option"
+	XAStartOpt                             "XA START option"
+	XID                                    "XA transaction identifier"
 	Writeable                              "Table writeable status"
 	ElseOpt                                "Optional else clause"
 	Type                                   "Types"
@@ -1416,6 +1496,8 @@ import (
 	FloatingPointType                      "Approximate value types"
 	BitValueType                           "bit value types"
 	StringType                             "String types"
+	SpatialType                            "Spatial types"
+	GeometryType                           "Geometry types"
 	BlobType                               "Blob types"
 	TextType                               "Text types"
 	DateAndTimeType                        "Date and Time types"
@@ -1495,9 +1577,15 @@ import (
 	ProcedureFetchList                     "Procedure fetch into variables"
 	ProcedureHandlerType                   "Procedure handler operation type"
 	ProcedureHcondList                     "Procedure handler condition value list"
+	OptSpFuncParams                        "Optional stored function param list"
+	SpFuncParams                           "Stored function params"
+	SpFuncParam                            "Stored function param"
+	RoutineCharacteristicListOpt           "Optional stored routine characteristic list"
+	RoutineCharacteristic                  "Stored routine characteristic"
 
 %type	<ident>
 	AsOpt             "AS or EmptyString"
+	EventCommentOpt   "Event COMMENT option"
 	KeyOrIndex        "{KEY|INDEX}"
 	ColumnKeywordOpt  "Column keyword or empty"
 	PrimaryOpt        "Optional primary keyword"
@@ -1563,6 +1651,8 @@ import (
 	FieldTerminator                 "Field terminator"
 	FlashbackToNewName              "Flashback to new name"
 	HashString                      "Hashed string"
+	XIDPart                         "gtrid or bqual of XA transaction identifier"
+	XABeginOrStart                  "BEGIN or START keyword of XA START"
 	LikeOrIlikeEscapeOpt            "like or ilike escape option"
 	OptCharset                      "Optional Character setting"
 	OptCollate                      "Optional Collate setting"
@@ -1817,7 +1907,7 @@ DirectResourceGroupRunawayOption:
 		dur := strings.ToLower($4.(string))
 		if dur == "unlimited" {
 			dur = ""
-		} 
+		}
 		if len(dur) > 0 {
 			_, err := time.ParseDuration(dur)
 			if err != nil {
@@ -3605,6 +3695,10 @@ ColumnOption:
 	{
 		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionAutoRandom, AutoRandOpt: $2.(ast.AutoRandomOption)}
 	}
+|	"SRID" LengthNum
+	{
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionSRID, SRID: uint32($2.(uint64))}
+	}
 
 AutoRandomOpt:
 	{
@@ -4871,11 +4965,68 @@ CreateViewStmt:
 			endOffset := parser.startOffset(&yyS[yypt])
 			selStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:endOffset]))
 		} else {
-			x.CheckOption = model.CheckOptionCascaded
+			x.CheckOption = model.CheckOptionNone
 		}
 		$$ = x
 	}
 
+/*******************************************************************
+ *
+ *  Create Materialized View Statement
+ *
+ *  Example:
+ *      CREATE MATERIALIZED VIEW IF NOT EXISTS mv (c1, c2) REFRESH INCREMENTAL
+ *          AS SELECT a, COUNT(*) FROM t GROUP BY a
+ *******************************************************************/
+CreateMaterializedViewStmt:
+	"CREATE" "MATERIALIZED" "VIEW" IfNotExists ViewName ViewFieldList MaterializedViewRefreshOpt "AS" CreateViewSelectOpt
+	{
+		startOffset := parser.startOffset(&yyS[yypt])
+		selStmt := $9.(ast.StmtNode)
+		selStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		x := &ast.CreateMaterializedViewStmt{
+			IfNotExists: $4.(bool),
+			ViewName:    $5.(*ast.TableName),
+			RefreshMode: $7.(model.MaterializedViewRefreshMode),
+			Select:      selStmt,
+		}
+		if $6 != nil {
+			x.Cols = $6.([]model.CIStr)
+		}
+		$$ = x
+	}
+
+MaterializedViewRefreshOpt:
+	/* EMPTY */
+	{
+		$$ = model.MaterializedViewRefreshComplete
+	}
+|	"REFRESH" "COMPLETE"
+	{
+		$$ = model.MaterializedViewRefreshComplete
+	}
+|	"REFRESH" "INCREMENTAL"
+	{
+		$$ = model.MaterializedViewRefreshIncremental
+	}
+
+/*******************************************************************
+ *
+ *  Refresh Materialized View Statement
+ *
+ *  Example:
+ *      REFRESH MATERIALIZED VIEW mv COMPLETE
+ *******************************************************************/
+RefreshMaterializedViewStmt:
+	"REFRESH" "MATERIALIZED" "VIEW" TableName
+	{
+		$$ = &ast.RefreshMaterializedViewStmt{ViewName: $4.(*ast.TableName)}
+	}
+|	"REFRESH" "MATERIALIZED" "VIEW" TableName "COMPLETE"
+	{
+		$$ = &ast.RefreshMaterializedViewStmt{ViewName: $4.(*ast.TableName), Complete: true}
+	}
+
 OrReplace:
 	/* EMPTY */
 	{
@@ -4956,6 +5107,10 @@ ViewCheckOption:
 	{
 		$$ = nil
 	}
+|	"WITH" "CHECK" "OPTION"
+	{
+		$$ = model.CheckOptionCascaded
+	}
 |	"WITH" "CASCADED" "CHECK" "OPTION"
 	{
 		$$ = model.CheckOptionCascaded
@@ -5137,6 +5292,10 @@ DropViewStmt:
 	{
 		$$ = &ast.DropTableStmt{IfExists: true, Tables: $5.([]*ast.TableName), IsView: true}
 	}
+|	"DROP" "MATERIALIZED" "VIEW" IfExists TableNameList RestrictOrCascadeOpt
+	{
+		$$ = &ast.DropTableStmt{IfExists: $4.(bool), Tables: $5.([]*ast.TableName), IsMaterializedView: true}
+	}
 
 DropUserStmt:
 	"DROP" "USER" UsernameList
@@ -6508,6 +6667,7 @@ UnReservedKeyword:
 	"ACTION"
 |	"ADVISE"
 |	"ASCII"
+|	"AT"
 |	"ATTRIBUTE"
 |	"ATTRIBUTES"
 |	"BINDING_CACHE"
@@ -6540,6 +6700,7 @@ UnReservedKeyword:
 |	"COMPRESSED"
 |	"CONSISTENCY"
 |	"CONSISTENT"
+|	"CONTAINS"
 |	"CURRENT"
 |	"DATA"
 |	"DATE" %prec lowerThanStringLitToken
@@ -6570,6 +6731,9 @@ UnReservedKeyword:
 |	"FORMAT"
 |	"FULL"
 |	"GENERAL"
+|	"GEOMCOLLECTION"
+|	"GEOMETRY"
+|	"GEOMETRYCOLLECTION"
 |	"GLOBAL"
 |	"HASH"
 |	"HELP"
@@ -6589,6 +6753,7 @@ UnReservedKeyword:
 |	"PROXY"
 |	"QUICK"
 |	"REBUILD"
+|	"READS"
 |	"REDUNDANT"
 |	"REORGANIZE"
 |	"RESOURCE"
@@ -6605,6 +6770,7 @@ UnReservedKeyword:
 |	"STATUS"
 |	"OPEN"
 |	"POINT"
+|	"POLYGON"
 |	"SUBPARTITIONS"
 |	"SUBPARTITION"
 |	"TABLES"
@@ -6673,6 +6839,7 @@ UnReservedKeyword:
 |	"BINDING"
 |	"BINDINGS"
 |	"MODIFY"
+|	"MODIFIES"
 |	"EVENTS"
 |	"PARTITIONS"
 |	"NONE"
@@ -6684,6 +6851,9 @@ UnReservedKeyword:
 |	"ROW_COUNT"
 |	"COALESCE"
 |	"MONTH"
+|	"MULTILINESTRING"
+|	"MULTIPOINT"
+|	"MULTIPOLYGON"
 |	"PROCESS"
 |	"PROFILE"
 |	"PROFILES"
@@ -6712,7 +6882,12 @@ UnReservedKeyword:
 |	"EVENT"
 |	"ALGORITHM"
 |	"DEFINER"
+|	"DETERMINISTIC"
 |	"INVOKER"
+|	"EVERY"
+|	"STARTS"
+|	"ENDS"
+|	"COMPLETION"
 |	"MERGE"
 |	"TEMPTABLE"
 |	"UNDEFINED"
@@ -6727,6 +6902,13 @@ UnReservedKeyword:
 |	"EXPIRE"
 |	"ACCOUNT"
 |	"INCREMENTAL"
+|	"COMPLETE"
+|	"MATERIALIZED"
+|	"REFRESH"
+|	"BEFORE"
+|	"EACH"
+|	"FOLLOWS"
+|	"PRECEDES"
 |	"CPU"
 |	"MEMBER"
 |	"MEMORY"
@@ -6744,6 +6926,7 @@ UnReservedKeyword:
 |	"DIRECTORY"
 |	"HISTOGRAM"
 |	"HISTORY"
+|	"LINESTRING"
 |	"LIST"
 |	"NODEGROUP"
 |	"SYSTEM_TIME"
@@ -6780,6 +6963,7 @@ UnReservedKeyword:
 |	"LANGUAGE"
 |	"SQL_TSI_WEEK"
 |	"SQL_TSI_YEAR"
+|	"SRID"
 |	"INVISIBLE"
 |	"VISIBLE"
 |	"TYPE"
@@ -6860,6 +7044,7 @@ UnReservedKeyword:
 |	"PASSWORD_LOCK_TIME"
 |	"DIGEST"
 |	"REUSE" %prec lowerThanEq
+|	"RETURNS"
 |	"DECLARE"
 |	"HANDLER"
 |	"FOUND"
@@ -6869,6 +7054,16 @@ UnReservedKeyword:
 |	"OLTP_READ_WRITE"
 |	"OLTP_READ_ONLY"
 |	"OLTP_WRITE_ONLY"
+|	"XA"
+|	"XID"
+|	"ONE"
+|	"PHASE"
+|	"SUSPEND"
+|	"MIGRATE"
+|	"EMPTY"
+|	"NESTED"
+|	"ORDINALITY"
+|	"PATH"
 
 TiDBKeyword:
 	"ADMIN"
@@ -6884,6 +7079,7 @@ TiDBKeyword:
 |	"DEPENDENCY"
 |	"DEPTH"
 |	"DRAINER"
+|	"FEEDBACK"
 |	"JOBS"
 |	"JOB"
 |	"NODE_ID"
@@ -7813,12 +8009,19 @@ FunctionNameConflict:
 |	"IF"
 |	"INTERVAL"
 |	"FORMAT"
+|	"GEOMCOLLECTION"
+|	"GEOMETRYCOLLECTION"
 |	"LEFT"
+|	"LINESTRING"
 |	"MICROSECOND"
 |	"MINUTE"
 |	"MONTH"
+|	"MULTILINESTRING"
+|	"MULTIPOINT"
+|	"MULTIPOLYGON"
 |	builtinNow
 |	"POINT"
+|	"POLYGON"
 |	"QUARTER"
 |	"REPEAT"
 |	"REPLACE"
@@ -8220,9 +8423,13 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
 		}
 	}
-|	builtinCount '(' DistinctKwd ExpressionList ')'
+|	builtinCount '(' DistinctKwd ExpressionList ')' OptWindowingClause
 	{
-		$$ = &ast.AggregateFuncExpr{F: $1, Args: $4.([]ast.ExprNode), Distinct: true}
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: $4.([]ast.ExprNode), Distinct: true, Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: $4.([]ast.ExprNode), Distinct: true}
+		}
 	}
 |	builtinCount '(' "ALL" Expression ')' OptWindowingClause
 	{
@@ -9595,6 +9802,20 @@ TableFactor:
 		resultNode := $1.(*ast.SubqueryExpr).Query
 		$$ = &ast.TableSource{Source: resultNode, AsName: $2.(model.CIStr)}
 	}
+|	"LATERAL" SubSelect TableAsNameOpt
+	{
+		resultNode := $2.(*ast.SubqueryExpr).Query
+		$$ = &ast.TableSource{Source: resultNode, AsName: $3.(model.CIStr), Lateral: true}
+	}
+|	"JSON_TABLE" '(' Expression ',' stringLit JSONTableColumnsClause ')' TableAsNameOpt
+	{
+		jt := &ast.JSONTable{
+			Expr:    $3,
+			Path:    $5,
+			Columns: $6.([]*ast.JSONTableColumn),
+		}
+		$$ = &ast.TableSource{Source: jt, AsName: $8.(model.CIStr)}
+	}
 |	'(' TableRefs ')'
 	{
 		j := $2.(*ast.Join)
@@ -9602,6 +9823,97 @@ TableFactor:
 		$$ = $2
 	}
 
+JSONTableColumnsClause:
+	"COLUMNS" '(' JSONTableColumnList ')'
+	{
+		$$ = $3
+	}
+
+JSONTableColumnList:
+	JSONTableColumn
+	{
+		$$ = []*ast.JSONTableColumn{$1.(*ast.JSONTableColumn)}
+	}
+|	JSONTableColumnList ',' JSONTableColumn
+	{
+		$$ = append($1.([]*ast.JSONTableColumn), $3.(*ast.JSONTableColumn))
+	}
+
+JSONTableColumn:
+	Identifier "FOR" "ORDINALITY"
+	{
+		$$ = &ast.JSONTableColumn{Tp: ast.JSONTableColumnOrdinality, Name: model.NewCIStr($1)}
+	}
+|	Identifier Type "PATH" stringLit JSONTableOnEmptyOnError
+	{
+		responses := $5.([]*ast.JSONTableOnResponse)
+		$$ = &ast.JSONTableColumn{
+			Tp:      ast.JSONTableColumnPath,
+			Name:    model.NewCIStr($1),
+			Type:    $2.(*types.FieldType),
+			Path:    $4,
+			OnEmpty: responses[0],
+			OnError: responses[1],
+		}
+	}
+|	Identifier Type "EXISTS" "PATH" stringLit
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:   ast.JSONTableColumnExistsPath,
+			Name: model.NewCIStr($1),
+			Type: $2.(*types.FieldType),
+			Path: $5,
+		}
+	}
+|	"NESTED" stringLit JSONTableColumnsClause
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:            ast.JSONTableColumnNested,
+			Path:          $2,
+			NestedColumns: $3.([]*ast.JSONTableColumn),
+		}
+	}
+|	"NESTED" "PATH" stringLit JSONTableColumnsClause
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:            ast.JSONTableColumnNested,
+			Path:          $3,
+			NestedColumns: $4.([]*ast.JSONTableColumn),
+		}
+	}
+
+JSONTableOnEmptyOnError:
+	/* empty */
+	{
+		$$ = []*ast.JSONTableOnResponse{nil, nil}
+	}
+|	JSONTableOnResponse "ON" "EMPTY"
+	{
+		$$ = []*ast.JSONTableOnResponse{$1.(*ast.JSONTableOnResponse), nil}
+	}
+|	JSONTableOnResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableOnResponse{nil, $1.(*ast.JSONTableOnResponse)}
+	}
+|	JSONTableOnResponse "ON" "EMPTY" JSONTableOnResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableOnResponse{$1.(*ast.JSONTableOnResponse), $4.(*ast.JSONTableOnResponse)}
+	}
+
+JSONTableOnResponse:
+	"NULL"
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseNull}
+	}
+|	"ERROR"
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseError}
+	}
+|	"DEFAULT" stringLit
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseDefault, Default: $2}
+	}
+
 PartitionNameListOpt:
 	/* empty */
 	{
@@ -10012,6 +10324,31 @@ SelectStmtIntoOption:
 
 		$$ = x
 	}
+|	"INTO" SelectIntoVarList
+	{
+		$$ = &ast.SelectIntoOption{
+			Tp:        ast.SelectIntoVars,
+			Variables: $2.([]ast.ExprNode),
+		}
+	}
+
+SelectIntoVarList:
+	SelectIntoVar
+	{
+		$$ = []ast.ExprNode{$1}
+	}
+|	SelectIntoVarList ',' SelectIntoVar
+	{
+		$$ = append($1.([]ast.ExprNode), $3)
+	}
+
+/* The variable is a user variable or a local variable of the stored routine. */
+SelectIntoVar:
+	Identifier
+	{
+		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: model.NewCIStr($1)}}
+	}
+|	UserVariable
 
 // See https://dev.mysql.com/doc/refman/5.7/en/subqueries.html
 SubSelect:
@@ -11078,6 +11415,33 @@ AdminStmt:
 			StatementScope: $3.(ast.StatementScope),
 		}
 	}
+|	"ADMIN" "ENABLE" "CARDINALITY" "FEEDBACK" "FOR" "DIGEST" stringLit
+	{
+		$$ = &ast.AdminStmt{
+			Tp:        ast.AdminEnableCardinalityFeedback,
+			SQLDigest: $7,
+		}
+	}
+|	"ADMIN" "DISABLE" "CARDINALITY" "FEEDBACK" "FOR" "DIGEST" stringLit
+	{
+		$$ = &ast.AdminStmt{
+			Tp:        ast.AdminDisableCardinalityFeedback,
+			SQLDigest: $7,
+		}
+	}
+|	"ADMIN" "RESET" "CARDINALITY" "FEEDBACK"
+	{
+		$$ = &ast.AdminStmt{
+			Tp: ast.AdminResetCardinalityFeedback,
+		}
+	}
+|	"ADMIN" "RESET" "CARDINALITY" "FEEDBACK" "FOR" "DIGEST" stringLit
+	{
+		$$ = &ast.AdminStmt{
+			Tp:        ast.AdminResetCardinalityFeedback,
+			SQLDigest: $7,
+		}
+	}
 
 AdminShowSlow:
 	"RECENT" NUM
@@ -11323,6 +11687,13 @@ ShowStmt:
 			Procedure: $4.(*ast.TableName),
 		}
 	}
+|	"SHOW" "CREATE" "FUNCTION" TableName
+	{
+		$$ = &ast.ShowStmt{
+			Tp:        ast.ShowCreateFunction,
+			Procedure: $4.(*ast.TableName),
+		}
+	}
 
 ShowPlacementTarget:
 	DatabaseSym DBName
@@ -11583,8 +11954,6 @@ ShowTargetFilterable:
 	{
 		// This statement is similar to SHOW PROCEDURE STATUS but for stored functions.
 		// See http://dev.mysql.com/doc/refman/5.7/en/show-function-status.html
-		// We do not support neither stored functions nor stored procedures.
-		// So we reuse show procedure status process logic.
 		$$ = &ast.ShowStmt{
 			Tp: ast.ShowFunctionStatus,
 		}
@@ -11880,6 +12249,7 @@ Statement:
 |	AlterSequenceStmt
 |	AlterPolicyStmt
 |	AlterResourceGroupStmt
+|	AlterEventStmt
 |	AnalyzeTableStmt
 |	BeginTransactionStmt
 |	BinlogStmt
@@ -11895,11 +12265,15 @@ Statement:
 |	CreateIndexStmt
 |	CreateTableStmt
 |	CreateViewStmt
+|	CreateMaterializedViewStmt
 |	CreateUserStmt
 |	CreateRoleStmt
 |	CreateBindingStmt
 |	CreatePolicyStmt
 |	CreateProcedureStmt
+|	CreateFunctionStmt
+|	CreateTriggerStmt
+|	CreateEventStmt
 |	CreateResourceGroupStmt
 |	AddQueryWatchStmt
 |	CreateSequenceStmt
@@ -11909,6 +12283,9 @@ Statement:
 |	DropIndexStmt
 |	DropTableStmt
 |	DropProcedureStmt
+|	DropFunctionStmt
+|	DropTriggerStmt
+|	DropEventStmt
 |	DropPolicyStmt
 |	DropSequenceStmt
 |	DropViewStmt
@@ -11930,6 +12307,7 @@ Statement:
 |	ImportIntoStmt
 |	InsertIntoStmt
 |	IndexAdviseStmt
+|	AdviseIndexStmt
 |	KillStmt
 |	LoadDataStmt
 |	LoadStatsStmt
@@ -11942,6 +12320,7 @@ Statement:
 |	RenameUserStmt
 |	ReplaceIntoStmt
 |	RecoverTableStmt
+|	RefreshMaterializedViewStmt
 |	ReleaseSavepointStmt
 |	RevokeStmt
 |	RevokeRoleStmt
@@ -11982,6 +12361,7 @@ Statement:
 |	ResumeLoadDataStmt
 |	CancelImportStmt
 |	DropLoadDataStmt
+|	XAStmt
 
 TraceableStmt:
 	DeleteFromStmt
@@ -12323,9 +12703,14 @@ TableOption:
 	}
 |	"TTL_JOB_INTERVAL" EqOpt stringLit
 	{
-		_, err := duration.ParseDuration($3)
+		var err error
+		if cron.IsCronExpr($3) {
+			_, err = cron.Parse($3)
+		} else {
+			_, err = duration.ParseDuration($3)
+		}
 		if err != nil {
-			yylex.AppendError(yylex.Errorf("The TTL_JOB_INTERVAL option is not a valid duration: %s", err.Error()))
+			yylex.AppendError(yylex.Errorf("The TTL_JOB_INTERVAL option is not a valid duration or cron expression: %s", err.Error()))
 			return 1
 		}
 		$$ = &ast.TableOption{Tp: ast.TableOptionTTLJobInterval, StrValue: $3}
@@ -12445,6 +12830,7 @@ Type:
 	NumericType
 |	StringType
 |	DateAndTimeType
+|	SpatialType
 
 NumericType:
 	IntegerType OptFieldLen FieldOpts
@@ -12841,6 +13227,54 @@ Year:
 	"YEAR"
 |	"SQL_TSI_YEAR"
 
+SpatialType:
+	GeometryType
+	{
+		tp := types.NewFieldType(mysql.TypeGeometry)
+		tp.SetGeometryType($1.(byte))
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		$$ = tp
+	}
+
+GeometryType:
+	"GEOMETRY"
+	{
+		$$ = mysql.GeometryTypeGeometry
+	}
+|	"POINT"
+	{
+		$$ = mysql.GeometryTypePoint
+	}
+|	"LINESTRING"
+	{
+		$$ = mysql.GeometryTypeLineString
+	}
+|	"POLYGON"
+	{
+		$$ = mysql.GeometryTypePolygon
+	}
+|	"MULTIPOINT"
+	{
+		$$ = mysql.GeometryTypeMultiPoint
+	}
+|	"MULTILINESTRING"
+	{
+		$$ = mysql.GeometryTypeMultiLineString
+	}
+|	"MULTIPOLYGON"
+	{
+		$$ = mysql.GeometryTypeMultiPolygon
+	}
+|	"GEOMETRYCOLLECTION"
+	{
+		$$ = mysql.GeometryTypeGeometryCollection
+	}
+|	"GEOMCOLLECTION"
+	{
+		$$ = mysql.GeometryTypeGeometryCollection
+	}
+
 BlobType:
 	"TINYBLOB"
 	{
@@ -12975,6 +13409,7 @@ FieldLen:
 	}
 
 OptFieldLen:
+	%prec lowerThanParenthese
 	{
 		$$ = types.UnspecifiedLength
 	}
@@ -13004,6 +13439,7 @@ FieldOpts:
 	}
 
 FloatOpt:
+	%prec lowerThanParenthese
 	{
 		$$ = &ast.FloatOpt{Flen: types.UnspecifiedLength, Decimal: types.UnspecifiedLength}
 	}
@@ -13029,6 +13465,7 @@ OptBinMod:
 	}
 
 OptBinary:
+	%prec lowerThanParenthese
 	{
 		$$ = &ast.OptBinary{
 			IsBinary: false,
@@ -13181,6 +13618,106 @@ UseStmt:
 		$$ = &ast.UseStmt{DBName: $2}
 	}
 
+XAStmt:
+	"XA" XABeginOrStart XID XAStartOpt
+	{
+		stmt := &ast.XAStmt{Tp: ast.XAStart, XID: $3.(*ast.XID)}
+		switch $4.(string) {
+		case "JOIN":
+			stmt.Join = true
+		case "RESUME":
+			stmt.Resume = true
+		}
+		$$ = stmt
+	}
+|	"XA" "END" XID XAEndOpt
+	{
+		stmt := &ast.XAStmt{Tp: ast.XAEnd, XID: $3.(*ast.XID)}
+		switch $4.(string) {
+		case "SUSPEND":
+			stmt.Suspend = true
+		case "SUSPEND FOR MIGRATE":
+			stmt.Suspend = true
+			stmt.ForMigrate = true
+		}
+		$$ = stmt
+	}
+|	"XA" "PREPARE" XID
+	{
+		$$ = &ast.XAStmt{Tp: ast.XAPrepare, XID: $3.(*ast.XID)}
+	}
+|	"XA" "COMMIT" XID
+	{
+		$$ = &ast.XAStmt{Tp: ast.XACommit, XID: $3.(*ast.XID)}
+	}
+|	"XA" "COMMIT" XID "ONE" "PHASE"
+	{
+		$$ = &ast.XAStmt{Tp: ast.XACommit, XID: $3.(*ast.XID), OnePhase: true}
+	}
+|	"XA" "ROLLBACK" XID
+	{
+		$$ = &ast.XAStmt{Tp: ast.XARollback, XID: $3.(*ast.XID)}
+	}
+|	"XA" "RECOVER"
+	{
+		$$ = &ast.XAStmt{Tp: ast.XARecover}
+	}
+|	"XA" "RECOVER" "CONVERT" "XID"
+	{
+		$$ = &ast.XAStmt{Tp: ast.XARecover, ConvertXID: true}
+	}
+
+XABeginOrStart:
+	"BEGIN"
+|	"START"
+
+XAStartOpt:
+	{
+		$$ = ""
+	}
+|	"JOIN"
+	{
+		$$ = "JOIN"
+	}
+|	"RESUME"
+	{
+		$$ = "RESUME"
+	}
+
+XAEndOpt:
+	{
+		$$ = ""
+	}
+|	"SUSPEND"
+	{
+		$$ = "SUSPEND"
+	}
+|	"SUSPEND" "FOR" "MIGRATE"
+	{
+		$$ = "SUSPEND FOR MIGRATE"
+	}
+
+XID:
+	XIDPart
+	{
+		$$ = &ast.XID{Gtrid: $1, FormatID: 1}
+	}
+|	XIDPart ',' XIDPart
+	{
+		$$ = &ast.XID{Gtrid: $1, Bqual: $3, FormatID: 1}
+	}
+|	XIDPart ',' XIDPart ',' LengthNum
+	{
+		$$ = &ast.XID{Gtrid: $1, Bqual: $3, FormatID: $5.(uint64)}
+	}
+
+XIDPart:
+	stringLit
+|	hexLit
+	{
+		$$ = $1.(ast.BinaryLiteral).ToString()
+	}
+
 WhereClause:
 	"WHERE" Expression
 	{
@@ -14940,6 +15477,19 @@ IndexAdviseStmt:
 		$$ = x
 	}
 
+/********************************************************************
+ * ADVISE INDEX [LIMIT number]
+ *******************************************************************/
+AdviseIndexStmt:
+	"ADVISE" "INDEX"
+	{
+		$$ = &ast.AdviseIndexStmt{}
+	}
+|	"ADVISE" "INDEX" "LIMIT" LengthNum
+	{
+		$$ = &ast.AdviseIndexStmt{Limit: $4.(uint64)}
+	}
+
 MaxMinutesOpt:
 	{
 		$$ = uint64(ast.UnspecifiedSize)
@@ -15245,6 +15795,81 @@ SpOptInout:
 		$$ = ast.MODE_INOUT
 	}
 
+/* Stored FUNCTION parameter declaration list */
+OptSpFuncParams:
+	/* Empty */
+	{
+		$$ = []*ast.StoreParameter{}
+	}
+|	SpFuncParams
+	{
+		$$ = $1
+	}
+
+SpFuncParams:
+	SpFuncParams ',' SpFuncParam
+	{
+		$$ = append($1.([]*ast.StoreParameter), $3.(*ast.StoreParameter))
+	}
+|	SpFuncParam
+	{
+		$$ = []*ast.StoreParameter{$1.(*ast.StoreParameter)}
+	}
+
+SpFuncParam:
+	Identifier Type
+	{
+		$$ = &ast.StoreParameter{
+			Paramstatus: ast.MODE_IN,
+			ParamType:   $2.(*types.FieldType),
+			ParamName:   $1,
+		}
+	}
+
+RoutineCharacteristicListOpt:
+	/* Empty */
+	{
+		$$ = []*ast.RoutineCharacteristic{}
+	}
+|	RoutineCharacteristicListOpt RoutineCharacteristic
+	{
+		$$ = append($1.([]*ast.RoutineCharacteristic), $2.(*ast.RoutineCharacteristic))
+	}
+
+RoutineCharacteristic:
+	"COMMENT" stringLit
+	{
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicComment, StrValue: $2}
+	}
+|	"LANGUAGE" "SQL"
+	{
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicLanguage}
+	}
+|	"DETERMINISTIC"
+	{
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicDeterministic, BoolValue: true}
+	}
+|	"NOT" "DETERMINISTIC"
+	{
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicDeterministic}
+	}
+|	"CONTAINS" "SQL"
+	{
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicSQLDataAccess, StrValue: ast.ContainsSQL}
+	}
+|	"NO" "SQL"
+	{
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicSQLDataAccess, StrValue: ast.NoSQL}
+	}
+|	"READS" "SQL" "DATA"
+	{
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicSQLDataAccess, StrValue: ast.ReadsSQLData}
+	}
+|	"MODIFIES" "SQL" "DATA"
+	{
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicSQLDataAccess, StrValue: ast.ModifiesSQLData}
+	}
+
 ProcedureStatementStmt:
 	SelectStmt
 |	SelectStmtWithClause
@@ -15273,6 +15898,7 @@ ProcedureStatementStmt:
 |	DeleteFromStmt
 |	AnalyzeTableStmt
 |	TruncateTableStmt
+|	CallStmt
 
 ProcedureCursorSelectStmt:
 	SelectStmt
@@ -15663,6 +16289,12 @@ ProcedureUnlabelLoopStmt:
 			Condition: $4.(ast.ExprNode),
 		}
 	}
+|	"LOOP" ProcedureProcStmt1s "END" "LOOP"
+	{
+		$$ = &ast.ProcedureLoopStmt{
+			Body: $2.([]ast.StmtNode),
+		}
+	}
 
 ProcedureLabeledBlock:
 	identifier ':' ProcedureBlockContent ProcedurceLabelOpt
@@ -15720,6 +16352,14 @@ ProcedureLeave:
 		}
 	}
 
+ProcedureReturn:
+	"RETURN" Expression
+	{
+		$$ = &ast.ProcedureReturn{
+			Expr: $2,
+		}
+	}
+
 ProcedureProcStmt:
 	ProcedureStatementStmt
 |	ProcedureUnlabeledBlock
@@ -15733,6 +16373,7 @@ ProcedureProcStmt:
 |	ProcedurelabeledLoopStmt
 |	ProcedureIterate
 |	ProcedureLeave
+|	ProcedureReturn
 
 /********************************************************************************************
  *
@@ -15753,22 +16394,64 @@ ProcedureProcStmt:
  *  Valid SQL routine statement
  ********************************************************************************************/
 CreateProcedureStmt:
-	"CREATE" "PROCEDURE" IfNotExists TableName '(' OptSpPdparams ')' ProcedureProcStmt
+	"CREATE" "PROCEDURE" IfNotExists TableName '(' OptSpPdparams ')' RoutineCharacteristicListOpt ProcedureProcStmt
 	{
 		x := &ast.ProcedureInfo{
 			IfNotExists:    $3.(bool),
 			ProcedureName:  $4.(*ast.TableName),
 			ProcedureParam: $6.([]*ast.StoreParameter),
-			ProcedureBody:  $8,
+			ProcedureBody:  $9,
 		}
+		x.SetCharacteristics($8.([]*ast.RoutineCharacteristic))
 		startOffset := parser.startOffset(&yyS[yypt])
-		originStmt := $8
+		originStmt := $9
 		originStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
-		startOffset = parser.startOffset(&yyS[yypt-3])
+		startOffset = parser.startOffset(&yyS[yypt-4])
 		if parser.src[startOffset] == '(' {
 			startOffset++
 		}
-		endOffset := parser.startOffset(&yyS[yypt-1])
+		endOffset := parser.startOffset(&yyS[yypt-2])
+		x.ProcedureParamStr = strings.TrimSpace(parser.src[startOffset:endOffset])
+		$$ = x
+	}
+
+/********************************************************************************************
+ *
+ *  Create Function Statement
+ *
+ *  Example:
+ *  CREATE FUNCTION [IF NOT EXISTS] sp_name ([func_parameter[,...]])
+ *  RETURNS type
+ *  [characteristic ...] routine_body
+ *  func_parameter:
+ *  param_name type
+ *  characteristic: {
+ *  COMMENT 'string'
+ *  | LANGUAGE SQL
+ *  | [NOT] DETERMINISTIC
+ *  | { CONTAINS SQL | NO SQL | READS SQL DATA | MODIFIES SQL DATA }
+ *  }
+ ********************************************************************************************/
+CreateFunctionStmt:
+	"CREATE" "FUNCTION" IfNotExists TableName '(' OptSpFuncParams ')' "RETURNS" Type RoutineCharacteristicListOpt ProcedureProcStmt
+	{
+		x := &ast.ProcedureInfo{
+			IfNotExists:    $3.(bool),
+			ProcedureName:  $4.(*ast.TableName),
+			ProcedureParam: $6.([]*ast.StoreParameter),
+			ProcedureBody:  $11,
+			IsFunction:     true,
+			ReturnType:     $9.(*types.FieldType),
+		}
+		x.SetCharacteristics($10.([]*ast.RoutineCharacteristic))
+		startOffset := parser.startOffset(&yyS[yypt])
+		originStmt := $11
+		originStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		startOffset = parser.startOffset(&yyS[yypt-6])
+		if parser.src[startOffset] == '(' {
+			startOffset++
+		}
+		endOffset := parser.startOffset(&yyS[yypt-4])
 		x.ProcedureParamStr = strings.TrimSpace(parser.src[startOffset:endOffset])
 		$$ = x
 	}
@@ -15785,6 +16468,357 @@ DropProcedureStmt:
 		}
 	}
 
+/********************************************************************************************
+*  DROP FUNCTION  [IF EXISTS] sp_name
+********************************************************************************************/
+DropFunctionStmt:
+	"DROP" "FUNCTION" IfExists TableName
+	{
+		$$ = &ast.DropProcedureStmt{
+			IfExists:      $3.(bool),
+			ProcedureName: $4.(*ast.TableName),
+			IsFunction:    true,
+		}
+	}
+
+/********************************************************************************************
+ *
+ *  Create Trigger Statement
+ *
+ *  Example:
+ *  CREATE
+ *  [DEFINER = user]
+ *  TRIGGER [IF NOT EXISTS] trigger_name
+ *  trigger_time trigger_event
+ *  ON tbl_name FOR EACH ROW
+ *  [trigger_order]
+ *  trigger_body
+ *  trigger_time: { BEFORE | AFTER }
+ *  trigger_event: { INSERT | UPDATE | DELETE }
+ *  trigger_order: { FOLLOWS | PRECEDES } other_trigger_name
+ ********************************************************************************************/
+CreateTriggerStmt:
+	"CREATE" OrReplace ViewAlgorithm ViewDefiner "TRIGGER" IfNotExists TableName TriggerActionTime TriggerEvent "ON" TableName "FOR" "EACH" "ROW" TriggerOrderOpt ProcedureProcStmt
+	{
+		// OrReplace and ViewAlgorithm share the prefix with CREATE VIEW, but they aren't allowed here.
+		if $2.(bool) || $3.(model.ViewAlgorithm) != model.AlgorithmUndefined {
+			yylex.AppendError(yylex.Errorf("OR REPLACE and ALGORITHM are not supported by CREATE TRIGGER"))
+			return 1
+		}
+		x := &ast.CreateTriggerStmt{
+			Definer:     $4.(*auth.UserIdentity),
+			IfNotExists: $6.(bool),
+			TriggerName: $7.(*ast.TableName),
+			Timing:      $8.(model.TriggerTiming),
+			Event:       $9.(model.TriggerEvent),
+			Table:       $11.(*ast.TableName),
+			Body:        $16,
+		}
+		if $15 != nil {
+			x.Order = $15.(*ast.TriggerOrder)
+		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		x.Body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = x
+	}
+
+TriggerActionTime:
+	"BEFORE"
+	{
+		$$ = model.TriggerTimingBefore
+	}
+|	"AFTER"
+	{
+		$$ = model.TriggerTimingAfter
+	}
+
+TriggerEvent:
+	"INSERT"
+	{
+		$$ = model.TriggerEventInsert
+	}
+|	"UPDATE"
+	{
+		$$ = model.TriggerEventUpdate
+	}
+|	"DELETE"
+	{
+		$$ = model.TriggerEventDelete
+	}
+
+TriggerOrderOpt:
+	{
+		$$ = nil
+	}
+|	"FOLLOWS" Identifier
+	{
+		$$ = &ast.TriggerOrder{Follows: true, TriggerName: model.NewCIStr($2)}
+	}
+|	"PRECEDES" Identifier
+	{
+		$$ = &ast.TriggerOrder{TriggerName: model.NewCIStr($2)}
+	}
+
+/********************************************************************************************
+*  DROP TRIGGER  [IF EXISTS] [schema_name.]trigger_name
+********************************************************************************************/
+DropTriggerStmt:
+	"DROP" "TRIGGER" IfExists TableName
+	{
+		$$ = &ast.DropTriggerStmt{
+			IfExists:    $3.(bool),
+			TriggerName: $4.(*ast.TableName),
+		}
+	}
+
+/********************************************************************************************
+ *
+ *  Create Event Statement
+ *
+ *  Example:
+ *  CREATE
+ *  [DEFINER = user]
+ *  EVENT [IF NOT EXISTS] event_name
+ *  ON SCHEDULE schedule
+ *  [ON COMPLETION [NOT] PRESERVE]
+ *  [ENABLE | DISABLE | DISABLE ON SLAVE]
+ *  [COMMENT 'string']
+ *  DO event_body
+ *  schedule: { AT timestamp | EVERY interval [STARTS timestamp] [ENDS timestamp] }
+ ********************************************************************************************/
+CreateEventStmt:
+	"CREATE" OrReplace ViewAlgorithm ViewDefiner "EVENT" IfNotExists TableName "ON" "SCHEDULE" EventSchedule EventCompletionOpt EventStatusOpt EventCommentOpt "DO" ProcedureProcStmt
+	{
+		// OrReplace and ViewAlgorithm share the prefix with CREATE VIEW, but they aren't allowed here.
+		if $2.(bool) || $3.(model.ViewAlgorithm) != model.AlgorithmUndefined {
+			yylex.AppendError(yylex.Errorf("OR REPLACE and ALGORITHM are not supported by CREATE EVENT"))
+			return 1
+		}
+		x := &ast.CreateEventStmt{
+			Definer:     $4.(*auth.UserIdentity),
+			IfNotExists: $6.(bool),
+			EventName:   $7.(*ast.TableName),
+			Schedule:    $10.(*ast.EventSchedule),
+			Preserve:    $11.(bool),
+			Status:      $12.(model.EventStatus),
+			Comment:     $13,
+			Body:        $15,
+		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		x.Body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = x
+	}
+
+EventSchedule:
+	"AT" Expression
+	{
+		$$ = &ast.EventSchedule{At: $2}
+	}
+|	"EVERY" Expression TimeUnit EventScheduleStartsOpt EventScheduleEndsOpt
+	{
+		x := &ast.EventSchedule{
+			Every: $2,
+			Unit:  $3.(ast.TimeUnitType),
+		}
+		if $4 != nil {
+			x.Starts = $4.(ast.ExprNode)
+		}
+		if $5 != nil {
+			x.Ends = $5.(ast.ExprNode)
+		}
+		$$ = x
+	}
+
+EventScheduleStartsOpt:
+	{
+		$$ = nil
+	}
+|	"STARTS" Expression
+	{
+		$$ = $2
+	}
+
+EventScheduleEndsOpt:
+	{
+		$$ = nil
+	}
+|	"ENDS" Expression
+	{
+		$$ = $2
+	}
+
+EventCompletion:
+	"ON" "COMPLETION" "PRESERVE"
+	{
+		$$ = true
+	}
+|	"ON" "COMPLETION" "NOT" "PRESERVE"
+	{
+		$$ = false
+	}
+
+EventCompletionOpt:
+	{
+		$$ = false
+	}
+|	EventCompletion
+
+EventStatus:
+	"ENABLE"
+	{
+		$$ = model.EventStatusEnabled
+	}
+|	"DISABLE"
+	{
+		$$ = model.EventStatusDisabled
+	}
+|	"DISABLE" "ON" "SLAVE"
+	{
+		$$ = model.EventStatusSlavesideDisabled
+	}
+
+EventStatusOpt:
+	{
+		$$ = model.EventStatusEnabled
+	}
+|	EventStatus
+
+EventCommentOpt:
+	{
+		$$ = ""
+	}
+|	"COMMENT" stringLit
+	{
+		$$ = $2
+	}
+
+/********************************************************************************************
+ *
+ *  Alter Event Statement
+ *
+ *  Example:
+ *  ALTER
+ *  [DEFINER = user]
+ *  EVENT event_name
+ *  [ON SCHEDULE schedule]
+ *  [ON COMPLETION [NOT] PRESERVE]
+ *  [RENAME TO new_event_name]
+ *  [ENABLE | DISABLE | DISABLE ON SLAVE]
+ *  [COMMENT 'string']
+ *  [DO event_body]
+ ********************************************************************************************/
+AlterEventStmt:
+	"ALTER" "EVENT" TableName AlterEventOnOpt AlterEventRenameOpt AlterEventStatusOpt AlterEventCommentOpt AlterEventBodyOpt
+	{
+		x := $4.(*ast.AlterEventStmt)
+		x.EventName = $3.(*ast.TableName)
+		if $5 != nil {
+			x.NewName = $5.(*ast.TableName)
+		}
+		if $6 != nil {
+			x.Status = $6.(*model.EventStatus)
+		}
+		if $7 != nil {
+			x.Comment = $7.(*string)
+		}
+		if $8 != nil {
+			x.Body = $8.(ast.StmtNode)
+		}
+		$$ = x
+	}
+|	"ALTER" "DEFINER" "=" Username "EVENT" TableName AlterEventOnOpt AlterEventRenameOpt AlterEventStatusOpt AlterEventCommentOpt AlterEventBodyOpt
+	{
+		x := $7.(*ast.AlterEventStmt)
+		x.Definer = $4.(*auth.UserIdentity)
+		x.EventName = $6.(*ast.TableName)
+		if $8 != nil {
+			x.NewName = $8.(*ast.TableName)
+		}
+		if $9 != nil {
+			x.Status = $9.(*model.EventStatus)
+		}
+		if $10 != nil {
+			x.Comment = $10.(*string)
+		}
+		if $11 != nil {
+			x.Body = $11.(ast.StmtNode)
+		}
+		$$ = x
+	}
+
+// AlterEventOnOpt returns an AlterEventStmt with the ON SCHEDULE and ON COMPLETION clauses, they're parsed together
+// since both of them start with ON.
+AlterEventOnOpt:
+	{
+		$$ = &ast.AlterEventStmt{}
+	}
+|	"ON" "SCHEDULE" EventSchedule
+	{
+		$$ = &ast.AlterEventStmt{Schedule: $3.(*ast.EventSchedule)}
+	}
+|	EventCompletion
+	{
+		preserve := $1.(bool)
+		$$ = &ast.AlterEventStmt{Preserve: &preserve}
+	}
+|	"ON" "SCHEDULE" EventSchedule EventCompletion
+	{
+		preserve := $4.(bool)
+		$$ = &ast.AlterEventStmt{Schedule: $3.(*ast.EventSchedule), Preserve: &preserve}
+	}
+
+AlterEventRenameOpt:
+	{
+		$$ = nil
+	}
+|	"RENAME" "TO" TableName
+	{
+		$$ = $3
+	}
+
+AlterEventStatusOpt:
+	{
+		$$ = nil
+	}
+|	EventStatus
+	{
+		status := $1.(model.EventStatus)
+		$$ = &status
+	}
+
+AlterEventCommentOpt:
+	{
+		$$ = nil
+	}
+|	"COMMENT" stringLit
+	{
+		comment := $2
+		$$ = &comment
+	}
+
+AlterEventBodyOpt:
+	{
+		$$ = nil
+	}
+|	"DO" ProcedureProcStmt
+	{
+		startOffset := parser.startOffset(&yyS[yypt])
+		$2.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = $2
+	}
+
+/********************************************************************************************
+*  DROP EVENT [IF EXISTS] [schema_name.]event_name
+********************************************************************************************/
+DropEventStmt:
+	"DROP" "EVENT" IfExists TableName
+	{
+		$$ = &ast.DropEventStmt{
+			IfExists:  $3.(bool),
+			EventName: $4.(*ast.TableName),
+		}
+	}
+
 /********************************************************************
  *
  * Calibrate Resource Statement
@@ -15954,5 +16988,4 @@ DropQueryWatchStmt:
 			IntValue: $4.(int64),
 		}
 	}
-
 %%
@@ -555,6 +555,26 @@ func TestDMLStmt(t *testing.T) {
 		{"ROLLBACK TO X", true, "ROLLBACK TO X"},
 		{"ROLLBACK TO SAVEPOINT x", true, "ROLLBACK TO x"},
 
+		// XA statements
+		{"XA START 'xid1'", true, "XA START 'xid1'"},
+		{"XA BEGIN 'xid1', 'b1'", true, "XA START 'xid1','b1'"},
+		{"XA START 'xid1', 'b1', 3", true, "XA START 'xid1','b1',3"},
+		{"XA START 'xid1', '', 3 JOIN", true, "XA START 'xid1','',3 JOIN"},
+		{"XA START X'0102' RESUME", true, "XA START 0x0102 RESUME"},
+		{"XA START 0x7869643161", true, "XA START 'xid1a'"},
+		{"XA START 'xid1', 3", false, ""},
+		{"XA START xid1", false, ""},
+		{"XA END 'xid1'", true, "XA END 'xid1'"},
+		{"XA END 'xid1' SUSPEND", true, "XA END 'xid1' SUSPEND"},
+		{"XA END 'xid1' SUSPEND FOR MIGRATE", true, "XA END 'xid1' SUSPEND FOR MIGRATE"},
+		{"XA PREPARE 'xid1', 'b1', 1", true, "XA PREPARE 'xid1','b1'"},
+		{"XA COMMIT 'xid1'", true, "XA COMMIT 'xid1'"},
+		{"XA COMMIT 'xid1' ONE PHASE", true, "XA COMMIT 'xid1' ONE PHASE"},
+		{"XA ROLLBACK 'xid1'", true, "XA ROLLBACK 'xid1'"},
+		{"XA RECOVER", true, "XA RECOVER"},
+		{"XA RECOVER CONVERT XID", true, "XA RECOVER CONVERT XID"},
+		{"create table xa (xid int, one int, phase int, suspend int, migrate int)", true, "CREATE TABLE `xa` (`xid` INT,`one` INT,`phase` INT,`suspend` INT,`migrate` INT)"},
+
 		// table statement
 		{"TABLE t", true, "TABLE `t`"},
 		{"(TABLE t)", true, "(TABLE `t`)"},
@@ -724,6 +744,10 @@ func TestDMLStmt(t *testing.T) {
 		{"select a from t order by a into outfile '/tmp/abc'", true, "SELECT `a` FROM `t` ORDER BY `a` INTO OUTFILE '/tmp/abc'"},
 		{"select 1 into outfile '/tmp/1.csv'", true, "SELECT 1 INTO OUTFILE '/tmp/1.csv'"},
 		{"select 1 for update into outfile '/tmp/1.csv'", true, "SELECT 1 FOR UPDATE INTO OUTFILE '/tmp/1.csv'"},
+
+		// select into variables
+		{"select a, b from t into x, @y", true, "SELECT `a`,`b` FROM `t` INTO `x`,@`y`"},
+		{"select count(*) from t where a > 1 limit 1 into @cnt", true, "SELECT COUNT(1) FROM `t` WHERE `a`>1 LIMIT 1 INTO @`cnt`"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ','", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ','"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' enclosed BY '\"'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' ENCLOSED BY '\"'"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' optionally enclosed BY '\"'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"'"},
@@ -867,6 +891,13 @@ func TestDMLStmt(t *testing.T) {
 		{"admin flush session plan_cache", true, "ADMIN FLUSH SESSION PLAN_CACHE"},
 		// We do not support the global level. We will check it in the later.
 		{"admin flush global plan_cache", true, "ADMIN FLUSH GLOBAL PLAN_CACHE"},
+		// Test for 'admin enable/disable/reset cardinality feedback'
+		{"admin enable cardinality feedback for digest 'abc'", true, "ADMIN ENABLE CARDINALITY FEEDBACK FOR DIGEST 'abc'"},
+		{"admin disable cardinality feedback for digest 'abc'", true, "ADMIN DISABLE CARDINALITY FEEDBACK FOR DIGEST 'abc'"},
+		{"admin reset cardinality feedback", true, "ADMIN RESET CARDINALITY FEEDBACK"},
+		{"admin reset cardinality feedback for digest 'abc'", true, "ADMIN RESET CARDINALITY FEEDBACK FOR DIGEST 'abc'"},
+		{"admin enable cardinality feedback", false, ""},
+		{"create table feedback (feedback int)", true, "CREATE TABLE `feedback` (`feedback` INT)"},
 
 		// for on duplicate key update
 		{"INSERT INTO t (a,b,c) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE c=VALUES(a)+VALUES(b);", true, "INSERT INTO `t` (`a`,`b`,`c`) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE `c`=VALUES(`a`)+VALUES(`b`)"},
@@ -931,6 +962,20 @@ func TestDMLStmt(t *testing.T) {
 		// for https://github.com/pingcap/parser/issues/963
 		{"select min(b) b from (select min(t.b) b from t where t.a = '');", true, "SELECT MIN(`b`) AS `b` FROM (SELECT MIN(`t`.`b`) AS `b` FROM `t` WHERE `t`.`a`=_UTF8MB4'')"},
 		{"select min(b) b from (select min(t.b) b from t where t.a = '') as t1;", true, "SELECT MIN(`b`) AS `b` FROM (SELECT MIN(`t`.`b`) AS `b` FROM `t` WHERE `t`.`a`=_UTF8MB4'') AS `t1`"},
+		{"select * from t, lateral (select * from t1 where t1.a = t.a limit 3) as dt", true, "SELECT * FROM (`t`) JOIN LATERAL (SELECT * FROM `t1` WHERE `t1`.`a`=`t`.`a` LIMIT 3) AS `dt`"},
+		{"select * from t join lateral (select a from t1 union select b from t2) dt on t.a = dt.a", true, "SELECT * FROM `t` JOIN LATERAL (SELECT `a` FROM `t1` UNION SELECT `b` FROM `t2`) AS `dt` ON `t`.`a`=`dt`.`a`"},
+		{"select * from lateral t", false, ""},
+		{"select lateral from t", false, ""},
+
+		// for JSON_TABLE
+		{`select * from json_table('[{"a":1},{"a":2}]', '$[*]' columns (id for ordinality, a int path '$.a')) as jt`, true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[{\"a\":1},{\"a\":2}]', '$[*]' COLUMNS (`id` FOR ORDINALITY, `a` INT PATH '$.a')) AS `jt`"},
+		{`select * from t, json_table(t.j, '$' columns (a varchar(10) path '$.a' default '"x"' on empty error on error, b int exists path '$.b', nested path '$.c[*]' columns (c json path '$', nested '$.d' columns (d int path '$' null on error)))) jt`, true, "SELECT * FROM (`t`) JOIN JSON_TABLE(`t`.`j`, '$' COLUMNS (`a` VARCHAR(10) PATH '$.a' DEFAULT '\"x\"' ON EMPTY ERROR ON ERROR, `b` INT EXISTS PATH '$.b', NESTED PATH '$.c[*]' COLUMNS (`c` JSON PATH '$', NESTED PATH '$.d' COLUMNS (`d` INT PATH '$' NULL ON ERROR)))) AS `jt`"},
+		{`select * from json_table('[]', '$' columns (a int path '$' null on empty)) jt`, true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[]', '$' COLUMNS (`a` INT PATH '$' NULL ON EMPTY)) AS `jt`"},
+		{`select * from json_table('[]', '$' columns (nested int path '$', path int path '$', ordinality int path '$')) jt`, true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[]', '$' COLUMNS (`nested` INT PATH '$', `path` INT PATH '$', `ordinality` INT PATH '$')) AS `jt`"},
+		{`select * from json_table('[]', '$' columns (a int path '$' on empty)) jt`, false, ""},
+		{`select * from json_table('[]', '$' columns (a int path '$' error on error null on empty)) jt`, false, ""},
+		{`select * from json_table('[]', '$' columns ()) jt`, false, ""},
+		{`select * from json_table('[]', '$') jt`, false, ""},
 
 		// for https://github.com/pingcap/tidb/issues/1050
 		{`SELECT /*!40001 SQL_NO_CACHE */ * FROM test WHERE 1 limit 0, 2000;`, true, "SELECT SQL_NO_CACHE * FROM `test` WHERE 1 LIMIT 0,2000"},
@@ -2225,6 +2270,11 @@ func TestBuiltin(t *testing.T) {
 		{`SELECT JSON_TYPE('[123]');`, true, "SELECT JSON_TYPE(_UTF8MB4'[123]')"},
 		{`SELECT JSON_TYPE();`, true, "SELECT JSON_TYPE()"},
 
+		// For spatial functions.
+		{`SELECT POINT(1, 2), LINESTRING(POINT(0, 0), POINT(1, 1));`, true, "SELECT POINT(1, 2),LINESTRING(POINT(0, 0), POINT(1, 1))"},
+		{`SELECT POLYGON(a), MULTIPOINT(a), MULTILINESTRING(a), MULTIPOLYGON(a), GEOMETRYCOLLECTION(a), GEOMCOLLECTION();`, true, "SELECT POLYGON(`a`),MULTIPOINT(`a`),MULTILINESTRING(`a`),MULTIPOLYGON(`a`),GEOMETRYCOLLECTION(`a`),GEOMCOLLECTION()"},
+		{`SELECT ST_ASTEXT(ST_GEOMFROMTEXT('POINT(1 2)', 4326));`, true, "SELECT ST_ASTEXT(ST_GEOMFROMTEXT(_UTF8MB4'POINT(1 2)', 4326))"},
+
 		// For two json grammar sugar.
 		{`SELECT a->'$.a' FROM t`, true, "SELECT JSON_EXTRACT(`a`, _UTF8MB4'$.a') FROM `t`"},
 		{`SELECT a->>'$.a' FROM t`, true, "SELECT JSON_UNQUOTE(JSON_EXTRACT(`a`, _UTF8MB4'$.a')) FROM `t`"},
@@ -2460,6 +2510,9 @@ func TestDDL(t *testing.T) {
 		{"CREATE TABLE foo (a SMALLINT UNSIGNED, b INT UNSIGNED) /* foo */", true, "CREATE TABLE `foo` (`a` SMALLINT UNSIGNED,`b` INT UNSIGNED)"},
 		{"CREATE TABLE foo /* foo */ (a SMALLINT UNSIGNED, b INT UNSIGNED) /* foo */", true, "CREATE TABLE `foo` (`a` SMALLINT UNSIGNED,`b` INT UNSIGNED)"},
 		{"CREATE TABLE foo (name CHAR(50) BINARY);", true, "CREATE TABLE `foo` (`name` CHAR(50) BINARY)"},
+		{"CREATE TABLE foo (g GEOMETRY, p POINT SRID 4326 NOT NULL, l LINESTRING, pg POLYGON, mp MULTIPOINT, ml MULTILINESTRING, mpg MULTIPOLYGON, gc GEOMETRYCOLLECTION, gc2 GEOMCOLLECTION)", true, "CREATE TABLE `foo` (`g` GEOMETRY,`p` POINT SRID 4326 NOT NULL,`l` LINESTRING,`pg` POLYGON,`mp` MULTIPOINT,`ml` MULTILINESTRING,`mpg` MULTIPOLYGON,`gc` GEOMCOLLECTION,`gc2` GEOMCOLLECTION)"},
+		{"CREATE TABLE foo (p POINT SRID)", false, ""},
+		{"CREATE TABLE point (point point, polygon int, srid int)", true, "CREATE TABLE `point` (`point` POINT,`polygon` INT,`srid` INT)"},
 		{"CREATE TABLE foo (name CHAR(50) COLLATE utf8_bin)", true, "CREATE TABLE `foo` (`name` CHAR(50) COLLATE utf8_bin)"},
 		{"CREATE TABLE foo (id varchar(50) collate utf8_bin);", true, "CREATE TABLE `foo` (`id` VARCHAR(50) COLLATE utf8_bin)"},
 		{"CREATE TABLE foo (name CHAR(50) CHARACTER SET UTF8)", true, "CREATE TABLE `foo` (`name` CHAR(50) CHARACTER SET UTF8)"},
@@ -5499,7 +5552,8 @@ func TestView(t *testing.T) {
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v as select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` AS SELECT * FROM `t`"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t`"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t with local check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` WITH LOCAL CHECK OPTION"},
-		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t`"},
+		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` WITH CASCADED CHECK OPTION"},
+		{"create view v as select * from t with check option", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t` WITH CASCADED CHECK OPTION"},
 		{"create or replace algorithm = merge definer = current_user view v as select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t`"},
 
 		// create view with `(` select statement `)`
@@ -5513,7 +5567,7 @@ func TestView(t *testing.T) {
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v as (select * from t)", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` AS (SELECT * FROM `t`)"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t)", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t`)"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t) with local check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t`) WITH LOCAL CHECK OPTION"},
-		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t) with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t`)"},
+		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t) with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t`) WITH CASCADED CHECK OPTION"},
 		{"create or replace algorithm = merge definer = current_user view v as (select * from t)", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS (SELECT * FROM `t`)"},
 
 		// create view with union statement
@@ -5527,7 +5581,7 @@ func TestView(t *testing.T) {
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v as select * from t union select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` AS SELECT * FROM `t` UNION SELECT * FROM `t`"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t union select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` UNION SELECT * FROM `t`"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t union select * from t with local check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` UNION SELECT * FROM `t` WITH LOCAL CHECK OPTION"},
-		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t union select * from t with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` UNION SELECT * FROM `t`"},
+		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t union select * from t with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` UNION SELECT * FROM `t` WITH CASCADED CHECK OPTION"},
 		{"create or replace algorithm = merge definer = current_user view v as select * from t union select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t` UNION SELECT * FROM `t`"},
 
 		// create view with union all statement
@@ -5541,7 +5595,7 @@ func TestView(t *testing.T) {
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v as select * from t union all select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` AS SELECT * FROM `t` UNION ALL SELECT * FROM `t`"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t union all select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` UNION ALL SELECT * FROM `t`"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t union all select * from t with local check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` UNION ALL SELECT * FROM `t` WITH LOCAL CHECK OPTION"},
-		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t union all select * from t with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` UNION ALL SELECT * FROM `t`"},
+		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as select * from t union all select * from t with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS SELECT * FROM `t` UNION ALL SELECT * FROM `t` WITH CASCADED CHECK OPTION"},
 		{"create or replace algorithm = merge definer = current_user view v as select * from t union all select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t` UNION ALL SELECT * FROM `t`"},
 
 		// create view with `(` union statement `)`
@@ -5555,7 +5609,7 @@ func TestView(t *testing.T) {
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v as (select * from t union all select * from t)", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`)"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t union all select * from t)", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`)"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t union all select * from t) with local check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`) WITH LOCAL CHECK OPTION"},
-		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t union all select * from t) with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`)"},
+		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t union all select * from t) with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`) WITH CASCADED CHECK OPTION"},
 		{"create or replace algorithm = merge definer = current_user view v as select * from t union all select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t` UNION ALL SELECT * FROM `t`"},
 	}
 	RunTest(t, table, false)
@@ -5569,7 +5623,7 @@ func TestView(t *testing.T) {
 	require.Equal(t, model.AlgorithmUndefined, v.Algorithm)
 	require.Equal(t, "select * from t", v.Select.Text())
 	require.Equal(t, model.SecurityDefiner, v.Security)
-	require.Equal(t, model.CheckOptionCascaded, v.CheckOption)
+	require.Equal(t, model.CheckOptionNone, v.CheckOption)
 
 	src := `CREATE OR REPLACE ALGORITHM = UNDEFINED DEFINER = root@localhost
                   SQL SECURITY DEFINER
@@ -5593,6 +5647,102 @@ func TestView(t *testing.T) {
 	require.Equal(t, model.CheckOptionCascaded, v.CheckOption)
 }
 
+func TestMaterializedView(t *testing.T) {
+	table := []testCase{
+		{"create materialized view mv as select * from t", true, "CREATE MATERIALIZED VIEW `mv` REFRESH COMPLETE AS SELECT * FROM `t`"},
+		{"create materialized view if not exists mv (a, b) refresh complete as select a, count(*) from t group by a", true, "CREATE MATERIALIZED VIEW IF NOT EXISTS `mv` (`a`,`b`) REFRESH COMPLETE AS SELECT `a`,COUNT(1) FROM `t` GROUP BY `a`"},
+		{"create materialized view test.mv refresh incremental as select a, sum(b) from t where c > 1 group by a", true, "CREATE MATERIALIZED VIEW `test`.`mv` REFRESH INCREMENTAL AS SELECT `a`,SUM(`b`) FROM `t` WHERE `c`>1 GROUP BY `a`"},
+		{"create materialized view mv refresh incremental as (select a from t)", true, "CREATE MATERIALIZED VIEW `mv` REFRESH INCREMENTAL AS (SELECT `a` FROM `t`)"},
+		{"create materialized view mv refresh as select * from t", false, ""},
+		{"create or replace materialized view mv as select * from t", false, ""},
+		{"refresh materialized view mv", true, "REFRESH MATERIALIZED VIEW `mv`"},
+		{"refresh materialized view test.mv complete", true, "REFRESH MATERIALIZED VIEW `test`.`mv` COMPLETE"},
+		{"refresh materialized view mv incremental", false, ""},
+		{"drop materialized view mv", true, "DROP MATERIALIZED VIEW `mv`"},
+		{"drop materialized view if exists mv, test.mv2", true, "DROP MATERIALIZED VIEW IF EXISTS `mv`, `test`.`mv2`"},
+		// The new keywords are not reserved.
+		{"create table materialized (refresh int, complete int)", true, "CREATE TABLE `materialized` (`refresh` INT,`complete` INT)"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	st, err := p.ParseOneStmt("create materialized view mv refresh incremental as select a, count(*) from t group by a", "", "")
+	require.NoError(t, err)
+	v, ok := st.(*ast.CreateMaterializedViewStmt)
+	require.True(t, ok)
+	require.Equal(t, model.MaterializedViewRefreshIncremental, v.RefreshMode)
+	require.Equal(t, "select a, count(*) from t group by a", v.Select.Text())
+}
+
+func TestTrigger(t *testing.T) {
+	table := []testCase{
+		{"create trigger tr before insert on t for each row set new.a = new.a + 1", true, "CREATE TRIGGER `tr` BEFORE INSERT ON `t` FOR EACH ROW SET @@SESSION.`new.a`=`new`.`a`+1"},
+		{"create trigger if not exists test.tr after update on test.t for each row insert into log values (old.a, new.a)", true, "CREATE TRIGGER IF NOT EXISTS `test`.`tr` AFTER UPDATE ON `test`.`t` FOR EACH ROW INSERT INTO `log` VALUES (`old`.`a`,`new`.`a`)"},
+		{"create definer = 'root'@'%' trigger tr after delete on t for each row follows tr2 begin delete from t2 where a = old.a; update t3 set c = c - 1; end", true, "CREATE DEFINER = `root`@`%` TRIGGER `tr` AFTER DELETE ON `t` FOR EACH ROW FOLLOWS `tr2` BEGIN DELETE FROM `t2` WHERE `a`=`old`.`a`;UPDATE `t3` SET `c`=`c`-1; END"},
+		{"create trigger tr before update on t for each row precedes tr2 begin end", true, "CREATE TRIGGER `tr` BEFORE UPDATE ON `t` FOR EACH ROW PRECEDES `tr2` BEGIN  END"},
+		{"create trigger tr before select on t for each row set new.a = 1", false, ""},
+		{"create trigger tr insert on t for each row set new.a = 1", false, ""},
+		{"create or replace trigger tr before insert on t for each row set new.a = 1", false, ""},
+		{"drop trigger tr", true, "DROP TRIGGER `tr`"},
+		{"drop trigger if exists test.tr", true, "DROP TRIGGER IF EXISTS `test`.`tr`"},
+		// The new keywords are not reserved.
+		{"create table before (each int, follows int, precedes int)", true, "CREATE TABLE `before` (`each` INT,`follows` INT,`precedes` INT)"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	st, err := p.ParseOneStmt("create trigger tr before insert on t for each row begin set new.a = 1; end", "", "")
+	require.NoError(t, err)
+	tr, ok := st.(*ast.CreateTriggerStmt)
+	require.True(t, ok)
+	require.Equal(t, model.TriggerTimingBefore, tr.Timing)
+	require.Equal(t, model.TriggerEventInsert, tr.Event)
+	require.True(t, tr.Definer.CurrentUser)
+	require.Equal(t, "begin set new.a = 1; end", tr.Body.Text())
+}
+
+func TestEvent(t *testing.T) {
+	table := []testCase{
+		{"create event e on schedule every 1 hour do insert into t values (1)", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 HOUR ON COMPLETION NOT PRESERVE ENABLE DO INSERT INTO `t` VALUES (1)"},
+		{"create event if not exists test.e on schedule at now() + interval 1 day on completion preserve disable comment 'daily' do delete from t", true, "CREATE EVENT IF NOT EXISTS `test`.`e` ON SCHEDULE AT DATE_ADD(NOW(), INTERVAL 1 DAY) ON COMPLETION PRESERVE DISABLE COMMENT 'daily' DO DELETE FROM `t`"},
+		{"create definer = 'root'@'%' event e on schedule every '1:30' hour_minute starts '2023-01-01 00:00:00' ends '2024-01-01 00:00:00' disable on slave do begin delete from t; insert into t values (1); end", true, "CREATE DEFINER = `root`@`%` EVENT `e` ON SCHEDULE EVERY _UTF8MB4'1:30' HOUR_MINUTE STARTS _UTF8MB4'2023-01-01 00:00:00' ENDS _UTF8MB4'2024-01-01 00:00:00' ON COMPLETION NOT PRESERVE DISABLE ON SLAVE DO BEGIN DELETE FROM `t`;INSERT INTO `t` VALUES (1); END"},
+		{"create event e do insert into t values (1)", false, ""},
+		{"create event e on schedule every 1 hour", false, ""},
+		{"create or replace event e on schedule every 1 hour do insert into t values (1)", false, ""},
+		{"alter event e enable", true, "ALTER EVENT `e` ENABLE"},
+		{"alter event test.e on schedule every 2 minute on completion preserve rename to test.e2 disable comment '' do delete from t", true, "ALTER EVENT `test`.`e` ON SCHEDULE EVERY 2 MINUTE ON COMPLETION PRESERVE RENAME TO `test`.`e2` DISABLE COMMENT '' DO DELETE FROM `t`"},
+		{"alter definer = 'u'@'%' event e on completion not preserve", true, "ALTER DEFINER = `u`@`%` EVENT `e` ON COMPLETION NOT PRESERVE"},
+		{"alter event e on schedule at '2023-01-01 00:00:00'", true, "ALTER EVENT `e` ON SCHEDULE AT _UTF8MB4'2023-01-01 00:00:00'"},
+		{"drop event e", true, "DROP EVENT `e`"},
+		{"drop event if exists test.e", true, "DROP EVENT IF EXISTS `test`.`e`"},
+		// The new keywords are not reserved.
+		{"create table every (at int, starts int, ends int, completion int)", true, "CREATE TABLE `every` (`at` INT,`starts` INT,`ends` INT,`completion` INT)"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	st, err := p.ParseOneStmt("create event e on schedule every 5 second starts now() do begin insert into t values (1); end", "", "")
+	require.NoError(t, err)
+	ev, ok := st.(*ast.CreateEventStmt)
+	require.True(t, ok)
+	require.Nil(t, ev.Schedule.At)
+	require.Equal(t, ast.TimeUnitSecond, ev.Schedule.Unit)
+	require.NotNil(t, ev.Schedule.Starts)
+	require.Nil(t, ev.Schedule.Ends)
+	require.Equal(t, model.EventStatusEnabled, ev.Status)
+	require.True(t, ev.Definer.CurrentUser)
+	require.Equal(t, "begin insert into t values (1); end", ev.Body.Text())
+
+	st, err = p.ParseOneStmt("alter event e do insert into t values (2)", "", "")
+	require.NoError(t, err)
+	alter, ok := st.(*ast.AlterEventStmt)
+	require.True(t, ok)
+	require.Nil(t, alter.Schedule)
+	require.Nil(t, alter.Preserve)
+	require.Nil(t, alter.Status)
+	require.Equal(t, "insert into t values (2)", alter.Body.Text())
+}
+
 func TestTimestampDiffUnit(t *testing.T) {
 	// Test case for timestampdiff unit.
 	// TimeUnit should be unified to upper case.
@@ -6263,6 +6413,8 @@ func TestWindowFunctions(t *testing.T) {
 		{`SELECT COUNT(profit) OVER() AS country_profit FROM sales;`, true, "SELECT COUNT(`profit`) OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT COUNT(ALL profit) OVER() AS country_profit FROM sales;`, true, "SELECT COUNT(`profit`) OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT COUNT(*) OVER() AS country_profit FROM sales;`, true, "SELECT COUNT(1) OVER () AS `country_profit` FROM `sales`"},
+		{`SELECT COUNT(DISTINCT profit, year) OVER() AS country_profit FROM sales;`, true, "SELECT COUNT(DISTINCT `profit`, `year`) OVER () AS `country_profit` FROM `sales`"},
+		{`SELECT GROUP_CONCAT(profit) OVER() AS country_profit FROM sales;`, true, "SELECT GROUP_CONCAT(`profit` SEPARATOR ',') OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT MAX(profit) OVER() AS country_profit FROM sales;`, true, "SELECT MAX(`profit`) OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT MIN(profit) OVER() AS country_profit FROM sales;`, true, "SELECT MIN(`profit`) OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT SUM(profit) OVER() AS country_profit FROM sales;`, true, "SELECT SUM(`profit`) OVER () AS `country_profit` FROM `sales`"},
@@ -6649,6 +6801,21 @@ func (checker *nodeTextCleaner) Enter(in ast.Node) (out ast.Node, skipChildren b
 				}
 			}
 		}
+	case *ast.CreateTriggerStmt:
+		// CreateTriggerStmt doesn't traverse the body.
+		node.Body.Accept(checker)
+	case *ast.CreateEventStmt:
+		// CreateEventStmt doesn't traverse the body.
+		node.Body.Accept(checker)
+	case *ast.AlterEventStmt:
+		if node.Body != nil {
+			node.Body.Accept(checker)
+		}
+	case *ast.ProcedureBlock:
+		// ProcedureBlock doesn't traverse the statements.
+		for _, stmt := range node.ProcedureProcStmts {
+			stmt.Accept(checker)
+		}
 	case *ast.DeleteStmt:
 		for _, tableHint := range node.TableHints {
 			tableHint.HintName.O = ""
@@ -6765,6 +6932,16 @@ func TestIndexAdviseStmt(t *testing.T) {
 	RunTest(t, table, false)
 }
 
+func TestAdviseIndexStmt(t *testing.T) {
+	table := []testCase{
+		{"ADVISE INDEX", true, "ADVISE INDEX"},
+		{"advise index limit 3", true, "ADVISE INDEX LIMIT 3"},
+		{"ADVISE INDEX LIMIT -1", false, ""},
+		{"ADVISE INDEX 3", false, ""},
+	}
+	RunTest(t, table, false)
+}
+
 // For BRIE
 func TestBRIE(t *testing.T) {
 	table := []testCase{
@@ -7380,8 +7557,13 @@ func TestTTLTableOption(t *testing.T) {
 		{"create table t (created_at datetime) /*T![ttl] TTL_ENABLE = 'test_case' */", false, ""},
 		{"alter table t /*T![ttl] TTL_ENABLE = 'test_case' */", false, ""},
 
+		// TTL_JOB_INTERVAL can be a cron expression
+		{"create table t (created_at datetime) TTL_JOB_INTERVAL = '@monthly'", true, "CREATE TABLE `t` (`created_at` DATETIME) TTL_JOB_INTERVAL = '@monthly'"},
+		{"alter table t TTL_JOB_INTERVAL = 'CRON_TZ=Asia/Shanghai 0 2 * * *'", true, "ALTER TABLE `t` TTL_JOB_INTERVAL = 'CRON_TZ=Asia/Shanghai 0 2 * * *'"},
+
 		// validate invalid TTL_JOB_INTERVAL settings
-		{"create table t (created_at datetime) TTL_JOB_INTERVAL = '@monthly'", false, ""},
+		{"create table t (created_at datetime) TTL_JOB_INTERVAL = '@never'", false, ""},
+		{"create table t (created_at datetime) TTL_JOB_INTERVAL = '0 25 * * *'", false, ""},
 		{"create table t (created_at datetime) TTL_JOB_INTERVAL = '10hourxx'", false, ""},
 		{"create table t (created_at datetime) TTL_JOB_INTERVAL = '10.10.255h'", false, ""},
 	}
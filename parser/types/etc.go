@@ -100,6 +100,22 @@ var str2Type = map[string]byte{
 	"year":        mysql.TypeYear,
 }
 
+var geometryType2Str = map[byte]string{
+	mysql.GeometryTypeGeometry:           "geometry",
+	mysql.GeometryTypePoint:              "point",
+	mysql.GeometryTypeLineString:         "linestring",
+	mysql.GeometryTypePolygon:            "polygon",
+	mysql.GeometryTypeMultiPoint:         "multipoint",
+	mysql.GeometryTypeMultiLineString:    "multilinestring",
+	mysql.GeometryTypeMultiPolygon:       "multipolygon",
+	mysql.GeometryTypeGeometryCollection: "geomcollection",
+}
+
+// GeometryTypeStr converts a geometry type to a string.
+func GeometryTypeStr(gt byte) string {
+	return geometryType2Str[gt]
+}
+
 // TypeStr converts tp to a string.
 func TypeStr(tp byte) (r string) {
 	return type2Str[tp]
@@ -57,6 +57,8 @@ type FieldType struct {
 	elems            []string
 	elemsIsBinaryLit []bool
 	array            bool
+	// geometryType is the geometry type of a spatial column.
+	geometryType byte
 	// Please keep in mind that jsonFieldType should be updated if you add a new field here.
 }
 
@@ -116,6 +118,11 @@ func (ft *FieldType) GetCharset() string {
 	return ft.charset
 }
 
+// GetGeometryType returns the geometry type of a spatial field.
+func (ft *FieldType) GetGeometryType() byte {
+	return ft.geometryType
+}
+
 // GetCollate returns the collation of the field.
 func (ft *FieldType) GetCollate() string {
 	return ft.collate
@@ -213,6 +220,11 @@ func (ft *FieldType) SetCollate(collate string) {
 	ft.collate = collate
 }
 
+// SetGeometryType sets the geometry type of a spatial field.
+func (ft *FieldType) SetGeometryType(gt byte) {
+	ft.geometryType = gt
+}
+
 // SetElems sets the elements of the FieldType.
 func (ft *FieldType) SetElems(elems []string) {
 	ft.elems = elems
@@ -296,6 +308,7 @@ func (ft *FieldType) Equal(other *FieldType) bool {
 		ft.charset == other.charset &&
 		ft.collate == other.collate &&
 		flenEqual &&
+		ft.geometryType == other.geometryType &&
 		mysql.HasUnsignedFlag(ft.flag) == mysql.HasUnsignedFlag(other.flag)
 	if !partialEqual || len(ft.elems) != len(other.elems) {
 		return false
@@ -369,7 +382,7 @@ func (ft *FieldType) Init(tp byte) {
 // CompactStr only considers tp/CharsetBin/flen/Deimal.
 // This is used for showing column type in infoschema.
 func (ft *FieldType) CompactStr() string {
-	ts := TypeToStr(ft.GetType(), ft.charset)
+	ts := ft.typeStr()
 	suffix := ""
 
 	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(ft.GetType())
@@ -423,6 +436,14 @@ func (ft *FieldType) CompactStr() string {
 	return ts + suffix
 }
 
+// typeStr returns the type name of the FieldType.
+func (ft *FieldType) typeStr() string {
+	if ft.GetType() == mysql.TypeGeometry {
+		return GeometryTypeStr(ft.geometryType)
+	}
+	return TypeToStr(ft.GetType(), ft.charset)
+}
+
 // InfoSchemaStr joins the CompactStr with unsigned flag and
 // returns a string.
 func (ft *FieldType) InfoSchemaStr() string {
@@ -463,7 +484,7 @@ func (ft *FieldType) String() string {
 
 // Restore implements Node interface.
 func (ft *FieldType) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord(TypeToStr(ft.GetType(), ft.charset))
+	ctx.WriteKeyWord(ft.typeStr())
 
 	precision := UnspecifiedLength
 	scale := UnspecifiedLength
@@ -634,6 +655,7 @@ type jsonFieldType struct {
 	Elems            []string
 	ElemsIsBinaryLit []bool
 	Array            bool
+	GeometryType     byte `json:",omitempty"`
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -650,6 +672,7 @@ func (ft *FieldType) UnmarshalJSON(data []byte) error {
 		ft.elems = r.Elems
 		ft.elemsIsBinaryLit = r.ElemsIsBinaryLit
 		ft.array = r.Array
+		ft.geometryType = r.GeometryType
 	}
 	return err
 }
@@ -666,6 +689,7 @@ func (ft *FieldType) MarshalJSON() ([]byte, error) {
 	r.Elems = ft.elems
 	r.ElemsIsBinaryLit = ft.elemsIsBinaryLit
 	r.Array = ft.array
+	r.GeometryType = ft.geometryType
 	return json.Marshal(r)
 }
 
@@ -0,0 +1,167 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/statistics"
+)
+
+// cardinalityFeedbackKey identifies a data source in a statement by its alias and query block.
+func cardinalityFeedbackKey(tblInfo *model.TableInfo, asName *model.CIStr, blockOffset int) string {
+	alias := tblInfo.Name.L
+	if asName != nil && asName.L != "" {
+		alias = asName.L
+	}
+	return fmt.Sprintf("%s@sel_%d", alias, blockOffset)
+}
+
+// applyCardinalityFeedback records the estimated row count of the data source and corrects it by the
+// cardinality feedback of the statement digest, if the feedback is enabled for the digest.
+func (ds *DataSource) applyCardinalityFeedback() {
+	if !statistics.CardinalityFeedback.AnyEnabled() || ds.isPartition {
+		return
+	}
+	sessVars := ds.SCtx().GetSessionVars()
+	if sessVars.InRestrictedSQL {
+		return
+	}
+	sc := sessVars.StmtCtx
+	_, digest := sc.SQLDigest()
+	if digest == nil || !statistics.CardinalityFeedback.IsEnabled(digest.String()) {
+		return
+	}
+	key := cardinalityFeedbackKey(ds.tableInfo, ds.TableAsName, ds.SelectBlockOffset())
+	if sc.CardinalityEstimates == nil {
+		sc.CardinalityEstimates = make(map[string]float64)
+	}
+	sc.CardinalityEstimates[key] = ds.StatsInfo().RowCount
+	if correction, ok := statistics.CardinalityFeedback.Correction(digest.String(), key); ok {
+		ds.SetStats(ds.StatsInfo().Scale(correction))
+	}
+}
+
+// CollectCardinalityFeedback collects the actual row counts of the data sources in the executed plan, and
+// pairs them with the row counts estimated from statistics during the optimization.
+func CollectCardinalityFeedback(sctx sessionctx.Context, p Plan) []*statistics.CardinalityFeedbackItem {
+	sc := sctx.GetSessionVars().StmtCtx
+	if len(sc.CardinalityEstimates) == 0 || sc.RuntimeStatsColl == nil {
+		return nil
+	}
+	if explain, ok := p.(*Explain); ok {
+		if !explain.Analyze {
+			return nil
+		}
+		p = explain.TargetPlan
+	}
+	physicalPlan, ok := p.(PhysicalPlan)
+	if !ok {
+		return nil
+	}
+	items := make(map[string]*statistics.CardinalityFeedbackItem)
+	// duplicated records the data sources which appear more than once in the plan, e.g. the partitions of a
+	// table, they can't be corrected as a whole.
+	duplicated := make(map[string]struct{})
+	now := time.Now()
+	var collect func(p PhysicalPlan)
+	collect = func(p PhysicalPlan) {
+		if key, ok := dataSourceKeyOfOperator(p); ok {
+			if _, ok := items[key]; ok {
+				duplicated[key] = struct{}{}
+				return
+			}
+			est, ok := sc.CardinalityEstimates[key]
+			if !ok || !sc.RuntimeStatsColl.ExistsRootStats(p.ID()) {
+				return
+			}
+			items[key] = &statistics.CardinalityFeedbackItem{
+				DataSource: key,
+				Operator:   p.ExplainID().String(),
+				EstRows:    est,
+				ActRows:    float64(sc.RuntimeStatsColl.GetRootStats(p.ID()).GetActRows()),
+				UpdateTime: now,
+			}
+			return
+		}
+		switch x := p.(type) {
+		case *PhysicalLimit:
+			// The children may stop early, so their actual row counts are not the cardinalities.
+			return
+		case *PhysicalIndexJoin:
+			collect(x.children[x.InnerChildIdx^1])
+			return
+		case *PhysicalIndexHashJoin:
+			collect(x.children[x.InnerChildIdx^1])
+			return
+		case *PhysicalIndexMergeJoin:
+			collect(x.children[x.InnerChildIdx^1])
+			return
+		case *PhysicalApply:
+			collect(x.children[x.InnerChildIdx^1])
+			return
+		}
+		for _, child := range p.Children() {
+			collect(child)
+		}
+	}
+	collect(physicalPlan)
+	result := make([]*statistics.CardinalityFeedbackItem, 0, len(items))
+	for key, item := range items {
+		if _, ok := duplicated[key]; !ok {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// dataSourceKeyOfOperator returns the data source key if the operator outputs all the rows of a data source,
+// i.e. it's a reader which only scans and filters the table, or a selection or union scan on such an operator.
+func dataSourceKeyOfOperator(p PhysicalPlan) (string, bool) {
+	var plans []PhysicalPlan
+	switch x := p.(type) {
+	case *PhysicalSelection:
+		return dataSourceKeyOfOperator(x.children[0])
+	case *PhysicalUnionScan:
+		return dataSourceKeyOfOperator(x.children[0])
+	case *PhysicalTableReader:
+		plans = x.TablePlans
+	case *PhysicalIndexReader:
+		plans = x.IndexPlans
+	case *PhysicalIndexLookUpReader:
+		if x.PushedLimit != nil {
+			return "", false
+		}
+		plans = append(append(plans, x.IndexPlans...), x.TablePlans...)
+	default:
+		return "", false
+	}
+	key := ""
+	for _, plan := range plans {
+		switch x := plan.(type) {
+		case *PhysicalTableScan:
+			key = cardinalityFeedbackKey(x.Table, x.TableAsName, x.SelectBlockOffset())
+		case *PhysicalIndexScan:
+			key = cardinalityFeedbackKey(x.Table, x.TableAsName, x.SelectBlockOffset())
+		case *PhysicalSelection:
+		default:
+			return "", false
+		}
+	}
+	return key, key != ""
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinalityFeedback(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, key(a))")
+	values := make([]string, 0, 2000)
+	for i := 0; i < 2000; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("analyze table t")
+
+	// The columns are correlated, so the estimation is far less than the actual row count.
+	query := "select * from t where a < 20 and b < 20"
+	_, digest := parser.NormalizeDigest(query)
+	feedbackSQL := "select data_source, act_rows from information_schema.cardinality_feedback where digest = ?"
+	tk.MustQuery(query)
+	tk.MustQuery(feedbackSQL, digest.String()).Check(testkit.Rows())
+
+	tk.MustExec(fmt.Sprintf("admin enable cardinality feedback for digest '%s'", digest.String()))
+	tk.MustQuery(feedbackSQL, digest.String()).Check(testkit.Rows("<nil> <nil>"))
+	require.Len(t, tk.MustQuery(query).Rows(), 20)
+	tk.MustQuery(feedbackSQL, digest.String()).Check(testkit.Rows("t@sel_1 20"))
+
+	// The feedback corrects the estimation of the next compilation.
+	explain := "explain analyze " + query
+	_, explainDigest := parser.NormalizeDigest(explain)
+	tk.MustExec(fmt.Sprintf("admin enable cardinality feedback for digest '%s'", explainDigest.String()))
+	estRows := func() string {
+		rows := tk.MustQuery(explain).Rows()
+		return rows[0][1].(string)
+	}
+	require.NotEqual(t, "20.00", estRows())
+	require.Equal(t, "20.00", estRows())
+
+	tk.MustExec(fmt.Sprintf("admin reset cardinality feedback for digest '%s'", explainDigest.String()))
+	tk.MustQuery(feedbackSQL, explainDigest.String()).Check(testkit.Rows("<nil> <nil>"))
+	tk.MustQuery(feedbackSQL, digest.String()).Check(testkit.Rows("t@sel_1 20"))
+	tk.MustExec("admin reset cardinality feedback")
+	tk.MustQuery(feedbackSQL, digest.String()).Check(testkit.Rows("<nil> <nil>"))
+
+	tk.MustExec(fmt.Sprintf("admin disable cardinality feedback for digest '%s'", digest.String()))
+	tk.MustExec(fmt.Sprintf("admin disable cardinality feedback for digest '%s'", explainDigest.String()))
+	tk.MustQuery("select count(*) from information_schema.cardinality_feedback").Check(testkit.Rows("0"))
+}
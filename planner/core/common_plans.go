@@ -85,6 +85,8 @@ type CheckTable struct {
 	IndexInfos         []*model.IndexInfo
 	IndexLookUpReaders []*PhysicalIndexLookUpReader
 	CheckIndex         bool
+	// Partitions are the partitions to check, all partitions are checked if it is nil.
+	Partitions []model.PartitionDefinition
 }
 
 // RecoverIndex is used for backfilling corrupted index data.
@@ -380,6 +382,9 @@ type Insert struct {
 
 	FKChecks   []*FKCheck
 	FKCascades []*FKCascade
+
+	// ViewChecks are the check options of the view inserted into.
+	ViewChecks []*ViewCheck
 }
 
 // MemoryUsage return the memory usage of Insert
@@ -445,6 +450,9 @@ type Update struct {
 
 	FKChecks   map[int64][]*FKCheck
 	FKCascades map[int64][]*FKCascade
+
+	// ViewChecks are the check options of the view updated through, the map is tableID -> []*ViewCheck.
+	ViewChecks map[int64][]*ViewCheck
 }
 
 // MemoryUsage return the memory usage of Update
@@ -643,6 +651,14 @@ type IndexAdvise struct {
 	LineFieldsInfo
 }
 
+// AdviseIndex represents an advise index plan, it recommends indexes for the workload in the statements summary.
+type AdviseIndex struct {
+	baseSchemaProducer
+
+	// Limit is the maximum number of the recommended indexes, 0 means unspecified.
+	Limit uint64
+}
+
 // SplitRegion represents a split regions plan.
 type SplitRegion struct {
 	baseSchemaProducer
@@ -50,6 +50,9 @@ var (
 	ErrWrongGroupField                       = dbterror.ClassOptimizer.NewStd(mysql.ErrWrongGroupField)
 	ErrDupFieldName                          = dbterror.ClassOptimizer.NewStd(mysql.ErrDupFieldName)
 	ErrNonUpdatableTable                     = dbterror.ClassOptimizer.NewStd(mysql.ErrNonUpdatableTable)
+	ErrNonInsertableTable                    = dbterror.ClassOptimizer.NewStd(mysql.ErrNonInsertableTable)
+	ErrNonUpdatableColumn                    = dbterror.ClassOptimizer.NewStd(mysql.ErrNonupdateableColumn)
+	ErrViewNonUpdatableCheck                 = dbterror.ClassOptimizer.NewStd(mysql.ErrViewNonupdCheck)
 	ErrMultiUpdateKeyConflict                = dbterror.ClassOptimizer.NewStd(mysql.ErrMultiUpdateKeyConflict)
 	ErrInternal                              = dbterror.ClassOptimizer.NewStd(mysql.ErrInternal)
 	ErrNonUniqTable                          = dbterror.ClassOptimizer.NewStd(mysql.ErrNonuniqTable)
@@ -80,6 +83,7 @@ var (
 	errTooBigPrecision                       = dbterror.ClassExpression.NewStd(mysql.ErrTooBigPrecision)
 	ErrDBaccessDenied                        = dbterror.ClassOptimizer.NewStd(mysql.ErrDBaccessDenied)
 	ErrTableaccessDenied                     = dbterror.ClassOptimizer.NewStd(mysql.ErrTableaccessDenied)
+	ErrProcaccessDenied                      = dbterror.ClassOptimizer.NewStd(mysql.ErrProcaccessDenied)
 	ErrSpecificAccessDenied                  = dbterror.ClassOptimizer.NewStd(mysql.ErrSpecificAccessDenied)
 	ErrViewNoExplain                         = dbterror.ClassOptimizer.NewStd(mysql.ErrViewNoExplain)
 	ErrWrongValueCountOnRow                  = dbterror.ClassOptimizer.NewStd(mysql.ErrWrongValueCountOnRow)
@@ -112,6 +116,9 @@ var (
 	ErrSubqueryMoreThan1Row     = dbterror.ClassOptimizer.NewStd(mysql.ErrSubqueryNo1Row)
 	ErrKeyPart0                 = dbterror.ClassOptimizer.NewStd(mysql.ErrKeyPart0)
 	ErrGettingNoopVariable      = dbterror.ClassOptimizer.NewStd(mysql.ErrGettingNoopVariable)
+	ErrFtMatchingKeyNotFound    = dbterror.ClassOptimizer.NewStd(mysql.ErrFtMatchingKeyNotFound)
+	// ErrTableFunctionMustHaveAlias returns when a table function like JSON_TABLE does not have an alias.
+	ErrTableFunctionMustHaveAlias = dbterror.ClassOptimizer.NewStd(mysql.ErrTableFunctionMustHaveAlias)
 
 	ErrPrepareMulti     = dbterror.ClassExecutor.NewStd(mysql.ErrPrepareMulti)
 	ErrUnsupportedPs    = dbterror.ClassExecutor.NewStd(mysql.ErrUnsupportedPs)
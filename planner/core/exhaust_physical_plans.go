@@ -939,7 +939,11 @@ func (p *LogicalJoin) buildIndexJoinInner2TableScan(
 			failpoint.Return(p.constructIndexHashJoin(prop, outerIdx, innerTask, nil, keyOff2IdxOff, path, lastColMng))
 		}
 	})
-	joins = append(joins, p.constructIndexJoin(prop, outerIdx, innerTask, ranges, keyOff2IdxOff, path, lastColMng, true)...)
+	indexJoins := p.constructIndexJoin(prop, outerIdx, innerTask, ranges, keyOff2IdxOff, path, lastColMng, true)
+	if len(indexJoins) > 0 {
+		indexJoins[0].(*PhysicalIndexJoin).FallbackInnerPlan = p.constructIndexJoinFallbackInner(wrapper, innerTask)
+	}
+	joins = append(joins, indexJoins...)
 	// We can reuse the `innerTask` here since index nested loop hash join
 	// do not need the inner child to promise the order.
 	joins = append(joins, p.constructIndexHashJoin(prop, outerIdx, innerTask, ranges, keyOff2IdxOff, path, lastColMng)...)
@@ -976,7 +980,11 @@ func (p *LogicalJoin) buildIndexJoinInner2IndexScan(
 			failpoint.Return(p.constructIndexHashJoin(prop, outerIdx, innerTask, helper.chosenRanges, keyOff2IdxOff, helper.chosenPath, helper.lastColManager))
 		}
 	})
-	joins = append(joins, p.constructIndexJoin(prop, outerIdx, innerTask, helper.chosenRanges, keyOff2IdxOff, helper.chosenPath, helper.lastColManager, true)...)
+	indexJoins := p.constructIndexJoin(prop, outerIdx, innerTask, helper.chosenRanges, keyOff2IdxOff, helper.chosenPath, helper.lastColManager, true)
+	if len(indexJoins) > 0 {
+		indexJoins[0].(*PhysicalIndexJoin).FallbackInnerPlan = p.constructIndexJoinFallbackInner(wrapper, innerTask)
+	}
+	joins = append(joins, indexJoins...)
 	// We can reuse the `innerTask` here since index nested loop hash join
 	// do not need the inner child to promise the order.
 	joins = append(joins, p.constructIndexHashJoin(prop, outerIdx, innerTask, helper.chosenRanges, keyOff2IdxOff, helper.chosenPath, helper.lastColManager)...)
@@ -1118,6 +1126,60 @@ func (p *LogicalJoin) constructInnerTableScanTask(
 	return t
 }
 
+// constructIndexJoinFallbackInner builds a full scan of the inner side of the index join. IndexLookUpJoin switches
+// to hash join against it when the outer side has more rows than `tidb_index_join_adaptive_threshold`.
+// Nil is returned when the fallback is disabled or the full scan can't produce the same rows as the inner plan.
+func (p *LogicalJoin) constructIndexJoinFallbackInner(wrapper *indexJoinInnerChildWrapper, innerTask task) PhysicalPlan {
+	if p.SCtx().GetSessionVars().IndexJoinAdaptiveThreshold <= 0 || innerTask == nil || innerTask.invalid() {
+		return nil
+	}
+	ds := wrapper.ds
+	if ds.tableInfo.GetPartitionInfo() != nil {
+		return nil
+	}
+	var ranges ranger.Ranges
+	if ds.tableInfo.IsCommonHandle {
+		ranges = ranger.FullRange()
+	} else {
+		isUnsigned := false
+		if pkColInfo := ds.tableInfo.GetPkColInfo(); ds.tableInfo.PKIsHandle && pkColInfo != nil {
+			isUnsigned = mysql.HasUnsignedFlag(pkColInfo.GetFlag())
+		}
+		ranges = ranger.FullIntRange(isUnsigned)
+	}
+	ts := PhysicalTableScan{
+		Table:           ds.tableInfo,
+		Columns:         ds.Columns,
+		TableAsName:     ds.TableAsName,
+		DBName:          ds.DBName,
+		filterCondition: ds.pushedDownConds,
+		Ranges:          ranges,
+		physicalTableID: ds.physicalTableID,
+		tblCols:         ds.TblCols,
+		tblColHists:     ds.TblColHists,
+	}.Init(ds.SCtx(), ds.SelectBlockOffset())
+	ts.SetSchema(ds.schema.Clone())
+	ts.SetStats(ds.tableStats)
+	copTask := &copTask{
+		tablePlan:         ts,
+		indexPlanFinished: true,
+		tblColHists:       ds.TblColHists,
+	}
+	ts.addPushedDownSelection(copTask, ds.StatsInfo())
+	fallback := p.constructInnerByWrapper(wrapper, copTask.convertToRootTask(ds.SCtx()).p)
+	// The hash join reuses the row layout of the inner plan, e.g. the positions of the join keys.
+	innerCols, fallbackCols := innerTask.plan().Schema().Columns, fallback.Schema().Columns
+	if len(innerCols) != len(fallbackCols) {
+		return nil
+	}
+	for i := range innerCols {
+		if !innerCols[i].Equal(nil, fallbackCols[i]) {
+			return nil
+		}
+	}
+	return fallback
+}
+
 func (p *LogicalJoin) constructInnerByWrapper(wrapper *indexJoinInnerChildWrapper, child PhysicalPlan) PhysicalPlan {
 	if !p.SCtx().GetSessionVars().EnableINLJoinInnerMultiPattern {
 		if wrapper.us != nil {
@@ -2847,6 +2909,11 @@ func (lw *LogicalWindow) tryToGetMppWindows(prop *property.PhysicalProperty) []P
 		if !allSupported {
 			return nil
 		}
+		if lw.Frame != nil && lw.Frame.Type == ast.Groups {
+			lw.SCtx().GetSessionVars().RaiseWarningWhenMPPEnforced(
+				"MPP mode may be blocked because window function frame can't be pushed down, because TiFlash does not support groups frame type yet.")
+			return nil
+		}
 		if lw.Frame != nil && lw.Frame.Type == ast.Ranges {
 			if _, err := expression.ExpressionsToPBList(lw.SCtx().GetSessionVars().StmtCtx, lw.Frame.Start.CalcFuncs, lw.SCtx().GetClient()); err != nil {
 				lw.SCtx().GetSessionVars().RaiseWarningWhenMPPEnforced(
@@ -413,6 +413,34 @@ func (p *PhysicalTableDual) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalJSONTable) ExplainInfo() string {
+	return explainJSONTable(p.Expr.ExplainInfo(), p.Root)
+}
+
+// ExplainNormalizedInfo implements Plan interface.
+func (p *PhysicalJSONTable) ExplainNormalizedInfo() string {
+	return explainJSONTable(p.Expr.ExplainNormalizedInfo(), p.Root)
+}
+
+func explainJSONTable(expr string, root *JSONTablePath) string {
+	var str strings.Builder
+	str.WriteString("json:")
+	str.WriteString(expr)
+	str.WriteString(", path:")
+	str.WriteString(root.Path.String())
+	var explainNested func(path *JSONTablePath)
+	explainNested = func(path *JSONTablePath) {
+		for _, nested := range path.Nested {
+			str.WriteString(", nested path:")
+			str.WriteString(nested.Path.String())
+			explainNested(nested)
+		}
+	}
+	explainNested(root)
+	return str.String()
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalSort) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
@@ -817,9 +845,12 @@ func (p *PhysicalWindow) ExplainInfo() string {
 		if !isFirst {
 			buffer.WriteString(" ")
 		}
-		if p.Frame.Type == ast.Rows {
+		switch p.Frame.Type {
+		case ast.Rows:
 			buffer.WriteString("rows")
-		} else {
+		case ast.Groups:
+			buffer.WriteString("groups")
+		default:
 			buffer.WriteString("range")
 		}
 		buffer.WriteString(" between ")
@@ -920,6 +951,11 @@ func (p *LogicalTableDual) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *LogicalJSONTable) ExplainInfo() string {
+	return explainJSONTable(p.Expr.ExplainInfo(), p.Root)
+}
+
 // ExplainInfo implements Plan interface.
 func (ds *DataSource) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
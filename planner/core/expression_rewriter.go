@@ -1250,6 +1250,8 @@ func (er *expressionRewriter) Leave(originInNode ast.Node) (retNode ast.Node, ok
 		er.toTable(v)
 	case *ast.ColumnName:
 		er.toColumn(v)
+	case *ast.MatchAgainst:
+		er.matchAgainstToExpression(v)
 	case *ast.UnaryOperationExpr:
 		er.unaryOpToExpression(v)
 	case *ast.BinaryOperationExpr:
@@ -2058,6 +2060,16 @@ func (er *expressionRewriter) funcCallToExpression(v *ast.FuncCallExpr) {
 	if er.err != nil {
 		return
 	}
+	defer er.checkStoredFunction()
+
+	if v.Schema.L != "" {
+		// The functions qualified by schema are always the stored functions.
+		er.ctxStackPop(len(v.Args))
+		function, err := er.newFunction(v.Schema.L+"."+v.FnName.L, &v.Type, args...)
+		er.err = err
+		er.ctxStackAppend(function, types.EmptyName)
+		return
+	}
 
 	if er.rewriteFuncCall(v) {
 		return
@@ -2126,6 +2138,27 @@ func (er *expressionRewriter) funcCallToExpression(v *ast.FuncCallExpr) {
 	}
 }
 
+// checkStoredFunction checks the EXECUTE privilege if the rewritten function is a stored function. The query
+// calling stored functions can't be cached since the functions may be changed.
+func (er *expressionRewriter) checkStoredFunction() {
+	if er.err != nil || len(er.ctxStack) == 0 {
+		return
+	}
+	db, routine, ok := expression.GetStoredFunctionInfo(er.ctxStack[len(er.ctxStack)-1])
+	if !ok {
+		return
+	}
+	er.sctx.GetSessionVars().StmtCtx.SetSkipPlanCache(errors.New("query has stored functions is un-cacheable"))
+	if er.b == nil {
+		return
+	}
+	var authErr error
+	if user := er.sctx.GetSessionVars().User; user != nil {
+		authErr = ErrProcaccessDenied.GenWithStackByArgs("execute", user.AuthUsername, user.AuthHostname, db.O+"."+routine.Name.O)
+	}
+	er.b.visitInfo = appendVisitInfo(er.b.visitInfo, mysql.ExecutePriv, db.L, "", "", authErr)
+}
+
 // Now TableName in expression only used by sequence function like nextval(seq).
 // The function arg should be evaluated as a table name rather than normal column name like mysql does.
 func (er *expressionRewriter) toTable(v *ast.TableName) {
@@ -2204,6 +2237,97 @@ func findFieldNameFromNaturalUsingJoin(p LogicalPlan, v *ast.ColumnName) (col *e
 	return nil, nil, nil
 }
 
+// matchAgainstToExpression rewrites `MATCH (col1, col2, ...) AGAINST (search [modifier])` to the
+// match_against function, which carries the FULLTEXT index on exactly the matched columns.
+func (er *expressionRewriter) matchAgainstToExpression(v *ast.MatchAgainst) {
+	stkLen := len(er.ctxStack)
+	colCnt := len(v.ColumnNames)
+	cols, names := er.ctxStack[stkLen-colCnt-1:stkLen-1], er.ctxNameStk[stkLen-colCnt-1:stkLen-1]
+	against := er.ctxStack[stkLen-1]
+	if _, ok := against.(*expression.Constant); !ok {
+		er.err = ErrWrongArguments.GenWithStackByArgs("AGAINST")
+		return
+	}
+	if v.Modifier.WithQueryExpansion() {
+		er.err = ErrNotSupportedYet.GenWithStackByArgs("WITH QUERY EXPANSION")
+		return
+	}
+	tblInfo, idxInfo := er.findFullTextIndex(names)
+	if er.err != nil {
+		return
+	}
+	if idxInfo == nil {
+		er.err = ErrFtMatchingKeyNotFound.GenWithStackByArgs()
+		return
+	}
+	mode := expression.FullTextNaturalLanguageMode
+	if v.Modifier.IsBooleanMode() {
+		mode = expression.FullTextBooleanMode
+	}
+	// The relevance depends on the row count of the table, which is read when building the plan.
+	er.sctx.GetSessionVars().StmtCtx.SetSkipPlanCache(errors.New("query has 'MATCH ... AGAINST' is un-cacheable"))
+	totalDocs := getStatsTable(er.sctx, tblInfo, tblInfo.ID).RealtimeCount
+	args := make([]expression.Expression, 0, expression.MatchAgainstColumnArgs+colCnt)
+	args = append(args,
+		against,
+		expression.NewInt64Const(mode),
+		expression.DatumToConstant(types.NewStringDatum(idxInfo.ParserName.L), mysql.TypeVarchar, 0),
+		expression.NewInt64Const(tblInfo.ID),
+		expression.NewInt64Const(idxInfo.ID),
+		expression.NewInt64Const(totalDocs),
+	)
+	args = append(args, cols...)
+	function, err := er.newFunction(ast.MatchAgainstFunc, types.NewFieldType(mysql.TypeDouble), args...)
+	if err != nil {
+		er.err = err
+		return
+	}
+	er.ctxStackPop(colCnt + 1)
+	er.ctxStackAppend(function, types.EmptyName)
+}
+
+// findFullTextIndex finds the public and visible FULLTEXT index whose columns are exactly the named columns.
+// It returns a nil index if the columns are not from the same base table or there is no such index.
+func (er *expressionRewriter) findFullTextIndex(names []*types.FieldName) (*model.TableInfo, *model.IndexInfo) {
+	dbName, tblName := names[0].DBName, names[0].OrigTblName
+	colNames := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if name.OrigTblName.L == "" || name.OrigTblName.L != tblName.L || name.DBName.L != dbName.L {
+			return nil, nil
+		}
+		colName := name.OrigColName
+		if colName.L == "" {
+			colName = name.ColName
+		}
+		colNames[colName.L] = struct{}{}
+	}
+	if dbName.O == "" {
+		dbName = model.NewCIStr(er.sctx.GetSessionVars().CurrentDB)
+	}
+	tbl, err := er.b.is.TableByName(dbName, tblName)
+	if err != nil {
+		er.err = err
+		return nil, nil
+	}
+	tblInfo := tbl.Meta()
+	for _, idx := range tblInfo.Indices {
+		if !idx.FullText || idx.State != model.StatePublic || idx.Invisible || len(idx.Columns) != len(colNames) {
+			continue
+		}
+		matched := true
+		for _, col := range idx.Columns {
+			if _, ok := colNames[col.Name.L]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return tblInfo, idx
+		}
+	}
+	return tblInfo, nil
+}
+
 func (er *expressionRewriter) evalDefaultExpr(v *ast.DefaultExpr) {
 	var name *types.FieldName
 	// Here we will find the corresponding column for default function. At the same time, we need to consider the issue
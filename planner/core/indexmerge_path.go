@@ -28,8 +28,11 @@ import (
 	"github.com/pingcap/tidb/planner/util"
 	"github.com/pingcap/tidb/planner/util/debugtrace"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/ranger"
 	"go.uber.org/zap"
@@ -79,6 +82,9 @@ func (ds *DataSource) generateIndexMergePath() error {
 	if err := ds.generateIndexMerge4MVIndex(regularPathCount, indexMergeConds); err != nil {
 		return err
 	}
+	if err := ds.generateIndexMerge4FullTextIndex(indexMergeConds); err != nil {
+		return err
+	}
 
 	// If without hints, it means that `enableIndexMerge` is true
 	if len(ds.indexMergeHints) == 0 {
@@ -935,3 +941,149 @@ func unwrapJSONCast(expr expression.Expression) (expression.Expression, bool) {
 func isMVIndexPath(path *util.AccessPath) bool {
 	return !path.IsTablePath() && path.Index != nil && path.Index.MVIndex
 }
+
+// generateIndexMerge4FullTextIndex generates IndexMerge paths for FULLTEXT indexes upon MATCH ... AGAINST filters.
+/*
+	A FULLTEXT index stores an index row for every distinct token of a row, so the rows matching a search can
+	be found by scanning the tokens which every matched row contains at least one of, and the duplicated handles
+	are removed by the union. The MATCH filter itself is still evaluated upon the rows read from the table.
+	select * from t where match(a) against('apple banana')
+		IndexMerge(OR)
+			IndexRangeScan(ft, ["apple","apple"], ["banana","banana"])
+			Selection(match(a) against('apple banana'))
+				TableRowIdScan(t)
+*/
+func (ds *DataSource) generateIndexMerge4FullTextIndex(filters []expression.Expression) error {
+	for _, filter := range filters {
+		sf := fullTextSearchFilter(ds.SCtx().GetSessionVars().StmtCtx, filter)
+		if sf == nil {
+			continue
+		}
+		tableID, indexID := expression.MatchAgainstIndex(sf)
+		if tableID != ds.tableInfo.ID {
+			continue
+		}
+		var idx *model.IndexInfo
+		for _, index := range ds.tableInfo.Indices {
+			if index.ID == indexID {
+				idx = index
+				break
+			}
+		}
+		if idx == nil || !idx.FullText || idx.State != model.StatePublic || idx.Invisible || !ds.isInIndexMergeHints(idx.Name.L) {
+			continue
+		}
+		terms, ok := expression.MatchAgainstAccessTerms(sf)
+		if !ok {
+			continue
+		}
+		partialPath, err := ds.buildPartialPath4FullTextIndex(idx, terms)
+		if err != nil {
+			return err
+		}
+		indexMergePath := &util.AccessPath{PartialIndexPaths: []*util.AccessPath{partialPath}}
+		indexMergePath.TableFilters = filters
+		indexMergePath.CountAfterAccess = partialPath.CountAfterAccess
+		ds.possibleAccessPaths = append(ds.possibleAccessPaths, indexMergePath)
+	}
+	return nil
+}
+
+// buildPartialPath4FullTextIndex builds a partial path scanning the tokens of the terms in the FULLTEXT index.
+func (ds *DataSource) buildPartialPath4FullTextIndex(idx *model.IndexInfo, terms []fulltext.Term) (*util.AccessPath, error) {
+	binCollator := collate.GetBinaryCollator()
+	ranges := make([]*ranger.Range, 0, len(terms))
+	for _, t := range terms {
+		ran := &ranger.Range{
+			LowVal:    []types.Datum{types.NewBytesDatum([]byte(t.Text))},
+			HighVal:   []types.Datum{types.NewBytesDatum([]byte(t.Text))},
+			Collators: []collate.Collator{binCollator},
+		}
+		if t.Prefix {
+			// All the tokens starting with the text are in [text, PrefixNext(text)).
+			next := kv.Key(t.Text).PrefixNext()
+			if len(next) == len(t.Text) {
+				ran.HighVal = []types.Datum{types.NewBytesDatum(next)}
+				ran.HighExclude = true
+			} else {
+				ran.HighVal = []types.Datum{types.MaxValueDatum()}
+			}
+		}
+		ranges = append(ranges, ran)
+	}
+	ranges, err := ranger.UnionRanges(ds.SCtx(), ranges, false)
+	if err != nil {
+		return nil, err
+	}
+	idxCols := ds.fullTextIndexColumns(idx)
+	idxColLens := make([]int, len(idxCols))
+	for i := range idxColLens {
+		idxColLens[i] = types.UnspecifiedLength
+	}
+	// TODO: estimate the row count by the document frequencies of the terms.
+	rowCount := math.Min(ds.tableStats.RowCount, float64(len(terms))*ds.tableStats.RowCount*selectionFactor4FullTextTerm)
+	return &util.AccessPath{
+		Index:            idx,
+		IdxCols:          idxCols[:1],
+		IdxColLens:       idxColLens[:1],
+		FullIdxCols:      idxCols,
+		FullIdxColLens:   idxColLens,
+		Ranges:           ranges,
+		CountAfterAccess: rowCount,
+		CountAfterIndex:  rowCount,
+	}, nil
+}
+
+// selectionFactor4FullTextTerm is the estimated fraction of the rows containing a search term.
+const selectionFactor4FullTextTerm = 0.01
+
+// fullTextIndexColumns returns the columns stored in the FULLTEXT index. The first column is the token and
+// the others are always null, see tables.getFullTextIndexedValue. They are all typed as binary strings.
+func (ds *DataSource) fullTextIndexColumns(idx *model.IndexInfo) []*expression.Column {
+	cols := make([]*expression.Column, 0, len(idx.Columns))
+	for _, idxCol := range idx.Columns {
+		cols = append(cols, &expression.Column{
+			ID:       ds.tableInfo.Columns[idxCol.Offset].ID,
+			RetType:  fullTextTokenFieldType(),
+			UniqueID: ds.SCtx().GetSessionVars().AllocPlanColumnID(),
+		})
+	}
+	return cols
+}
+
+func fullTextTokenFieldType() *types.FieldType {
+	tp := types.NewFieldType(mysql.TypeVarString)
+	tp.SetFlag(mysql.BinaryFlag)
+	tp.SetCharset(charset.CharsetBin)
+	tp.SetCollate(charset.CollationBin)
+	return tp
+}
+
+// fullTextSearchFilter returns the match_against function if the filter only keeps the rows it matches,
+// which are `match_against(...)`, `match_against(...) > c` where c >= 0 and `match_against(...) >= c` where c > 0.
+func fullTextSearchFilter(sc *stmtctx.StatementContext, filter expression.Expression) *expression.ScalarFunction {
+	sf, ok := filter.(*expression.ScalarFunction)
+	if !ok {
+		return nil
+	}
+	if sf.FuncName.L == ast.MatchAgainstFunc {
+		return sf
+	}
+	if sf.FuncName.L != ast.GT && sf.FuncName.L != ast.GE {
+		return nil
+	}
+	args := sf.GetArgs()
+	match, ok := args[0].(*expression.ScalarFunction)
+	if !ok || match.FuncName.L != ast.MatchAgainstFunc {
+		return nil
+	}
+	con, ok := args[1].(*expression.Constant)
+	if !ok || con.ParamMarker != nil || con.DeferredExpr != nil || con.Value.IsNull() {
+		return nil
+	}
+	v, err := con.Value.ToFloat64(sc)
+	if err != nil || v < 0 || (v == 0 && sf.FuncName.L == ast.GE) {
+		return nil
+	}
+	return match
+}
@@ -5279,3 +5279,84 @@ func TestIssue45033(t *testing.T) {
 				                        from   t3 alias3) alias4
 				                where  alias4.c2 = alias2.alias_col1);`).Check(testkit.Rows("0"))
 }
+
+func TestLateralDerivedTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int, key(a))")
+
+	// The LATERAL derived table is decorrelated into a join if possible.
+	tk.MustQuery("explain format = 'brief' select * from t1, lateral (select b from t2 where t2.a = t1.a) dt").Check(testkit.Rows(
+		"HashJoin 12487.50 root  inner join, equal:[eq(test.t1.a, test.t2.a)]",
+		"├─TableReader(Build) 9990.00 root  data:Selection",
+		"│ └─Selection 9990.00 cop[tikv]  not(isnull(test.t2.a))",
+		"│   └─TableFullScan 10000.00 cop[tikv] table:t2 keep order:false, stats:pseudo",
+		"└─TableReader(Probe) 9990.00 root  data:Selection",
+		"  └─Selection 9990.00 cop[tikv]  not(isnull(test.t1.a))",
+		"    └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+	// Otherwise it's executed by an apply.
+	tk.MustQuery("explain format = 'brief' select * from t1 left join lateral (select b from t2 where t2.a = t1.a order by b limit 2) dt on true").Check(testkit.Rows(
+		"Projection 10000.00 root  test.t1.a, test.t1.b, test.t2.b",
+		"└─Apply 10000.00 root  CARTESIAN left outer join",
+		"  ├─TableReader(Build) 10000.00 root  data:TableFullScan",
+		"  │ └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo",
+		"  └─TopN(Probe) 20000.00 root  test.t2.b, offset:0, count:2",
+		"    └─IndexLookUp 20000.00 root  ",
+		"      ├─IndexRangeScan(Build) 100000.00 cop[tikv] table:t2, index:a(a) range: decided by [eq(test.t2.a, test.t1.a)], keep order:false, stats:pseudo",
+		"      └─TopN(Probe) 20000.00 cop[tikv]  test.t2.b, offset:0, count:2",
+		"        └─TableRowIDScan 100000.00 cop[tikv] table:t2 keep order:false, stats:pseudo"))
+	// A LATERAL derived table without outer references is a normal derived table.
+	tk.MustQuery("explain format = 'brief' select * from t1, lateral (select 1) dt").Check(testkit.Rows(
+		"Projection 10000.00 root  test.t1.a, test.t1.b, Column#4",
+		"└─HashJoin 10000.00 root  CARTESIAN inner join",
+		"  ├─Projection(Build) 1.00 root  1->Column#4",
+		"  │ └─TableDual 1.00 root  rows:1",
+		"  └─TableReader(Probe) 10000.00 root  data:TableFullScan",
+		"    └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+
+	// Only the tables on the left side are visible to the LATERAL derived table.
+	tk.MustGetErrCode("select * from lateral (select b from t2 where t2.a = t1.a) dt, t1", errno.ErrBadField)
+	tk.MustGetErrCode("select * from t1 right join lateral (select b from t2 where t2.a = t1.a) dt on true", errno.ErrBadField)
+	tk.MustGetErrCode("select * from t1, (select b from t2 where t2.a = t1.a) dt", errno.ErrBadField)
+}
+
+func TestJSONTablePlan(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(id int, j json)")
+
+	tk.MustQuery(`explain format = 'brief' select * from json_table('[{"a":1}]', '$[*]' columns (a int path '$.a', nested path '$.b[*]' columns (b int path '$'))) jt where a > 1`).Check(testkit.Rows(
+		"Selection 8.00 root  gt(jt.a, 1)",
+		`└─JSONTable 10.00 root  json:cast("[{"a":1}]", json BINARY), path:$[*], nested path:$.b[*]`))
+	tk.MustQuery(`explain format = 'brief' select t.id, jt.a from t, json_table('[1]', '$[*]' columns (a int path '$')) jt where jt.a = t.id`).Check(testkit.Rows(
+		"Projection 10.00 root  test.t.id, jt.a",
+		"└─HashJoin 10.00 root  inner join, equal:[eq(jt.a, test.t.id)]",
+		"  ├─Selection(Build) 8.00 root  not(isnull(jt.a))",
+		`  │ └─JSONTable 10.00 root  json:cast("[1]", json BINARY), path:$[*]`,
+		"  └─TableReader(Probe) 9990.00 root  data:Selection",
+		"    └─Selection 9990.00 cop[tikv]  not(isnull(test.t.id))",
+		"      └─TableFullScan 10000.00 cop[tikv] table:t keep order:false, stats:pseudo"))
+	// JSON_TABLE referring to the preceding tables is executed by an apply.
+	tk.MustQuery(`explain format = 'brief' select t.id, jt.a from t, json_table(t.j, '$[*]' columns (a int path '$')) jt`).Check(testkit.Rows(
+		"Projection 10000.00 root  test.t.id, jt.a",
+		"└─Apply 10000.00 root  CARTESIAN inner join",
+		"  ├─TableReader(Build) 10000.00 root  data:TableFullScan",
+		"  │ └─TableFullScan 10000.00 cop[tikv] table:t keep order:false, stats:pseudo",
+		"  └─JSONTable(Probe) 100000.00 root  json:test.t.j, path:$[*]"))
+	tk.MustQuery(`explain format = 'brief' select t.id, jt.a from t left join json_table(t.j, '$[*]' columns (a int path '$')) jt on jt.a = t.id`).Check(testkit.Rows(
+		"Projection 10000.00 root  test.t.id, jt.a",
+		"└─Apply 10000.00 root  left outer join, equal:[eq(test.t.id, jt.a)]",
+		"  ├─TableReader(Build) 10000.00 root  data:TableFullScan",
+		"  │ └─TableFullScan 10000.00 cop[tikv] table:t keep order:false, stats:pseudo",
+		"  └─Selection(Probe) 80000.00 root  not(isnull(jt.a))",
+		"    └─JSONTable 100000.00 root  json:test.t.j, path:$[*]"))
+
+	// JSON_TABLE can't refer to the tables on its right side.
+	tk.MustGetErrCode(`select * from json_table(t.j, '$[*]' columns (a int path '$')) jt, t`, errno.ErrBadField)
+	tk.MustGetErrCode(`select * from json_table('[]', '$[*]' columns (a int path '$'))`, errno.ErrTableFunctionMustHaveAlias)
+}
@@ -508,6 +508,10 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 	case *ast.TableSource:
 		var isTableName bool
 		switch v := x.Source.(type) {
+		case *ast.JSONTable:
+			p, err = b.buildJSONTable(ctx, v, x.AsName)
+			// `JSON_TABLE` is not a select block either.
+			isTableName = true
 		case *ast.SelectStmt:
 			ci := b.prepareCTECheckForSubQuery()
 			defer resetCTECheckForSubQuery(ci)
@@ -1013,7 +1017,7 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		return nil, err
 	}
 
-	rightPlan, err := b.buildResultSetNode(ctx, joinNode.Right, false)
+	rightPlan, isLateral, err := b.buildJoinRightSide(ctx, joinNode, leftPlan)
 	if err != nil {
 		return nil, err
 	}
@@ -1027,7 +1031,21 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 	handleMap2 := b.handleHelper.popMap()
 	b.handleHelper.mergeAndPush(handleMap1, handleMap2)
 
-	joinPlan := LogicalJoin{StraightJoin: joinNode.StraightJoin || b.inStraightJoin}.Init(b.ctx, b.getSelectOffset())
+	// If the LATERAL derived table refers to the columns of the left side, it's built as an apply,
+	// which will be decorrelated into a join if possible. joinPlan is the embedded LogicalJoin of the
+	// apply in this case, and resultPlan is the apply itself.
+	var (
+		joinPlan   *LogicalJoin
+		resultPlan LogicalPlan
+	)
+	if isLateral && len(extractCorColumnsBySchema4LogicalPlan(rightPlan, leftPlan.Schema())) > 0 {
+		b.optFlag = b.optFlag | flagBuildKeyInfo | flagDecorrelate
+		ap := LogicalApply{}.Init(b.ctx, b.getSelectOffset())
+		joinPlan, resultPlan = &ap.LogicalJoin, ap
+	} else {
+		joinPlan = LogicalJoin{StraightJoin: joinNode.StraightJoin || b.inStraightJoin}.Init(b.ctx, b.getSelectOffset())
+		resultPlan = joinPlan
+	}
 	joinPlan.SetChildren(leftPlan, rightPlan)
 	joinPlan.SetSchema(expression.MergeSchema(leftPlan.Schema(), rightPlan.Schema()))
 	joinPlan.names = make([]*types.FieldName, leftPlan.Schema().Len()+rightPlan.Schema().Len())
@@ -1115,11 +1133,11 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		}
 	} else if joinNode.On != nil {
 		b.curClause = onClause
-		onExpr, newPlan, err := b.rewrite(ctx, joinNode.On.Expr, joinPlan, nil, false)
+		onExpr, newPlan, err := b.rewrite(ctx, joinNode.On.Expr, resultPlan, nil, false)
 		if err != nil {
 			return nil, err
 		}
-		if newPlan != joinPlan {
+		if newPlan != resultPlan {
 			return nil, errors.New("ON condition doesn't support subqueries yet")
 		}
 		onCondition := expression.SplitCNFItems(onExpr)
@@ -1127,7 +1145,7 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		// possible decorrelate optimizations. The ON clause is actually treated as a WHERE clause now.
 		if joinPlan.JoinType == InnerJoin {
 			sel := LogicalSelection{Conditions: onCondition}.Init(b.ctx, b.getSelectOffset())
-			sel.SetChildren(joinPlan)
+			sel.SetChildren(resultPlan)
 			return sel, nil
 		}
 		joinPlan.AttachOnConds(onCondition)
@@ -1136,8 +1154,35 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		// product over the join tables.
 		joinPlan.cartesianJoin = true
 	}
+	if ap, ok := resultPlan.(*LogicalApply); ok {
+		setIsInApplyForCTE(rightPlan, ap.Schema())
+	}
 
-	return joinPlan, nil
+	return resultPlan, nil
+}
+
+// buildJoinRightSide builds the right side of the join. If it's a LATERAL derived table, the columns of the
+// left side are visible to it as the outer columns, unless it's on the right side of a RIGHT JOIN.
+func (b *PlanBuilder) buildJoinRightSide(ctx context.Context, joinNode *ast.Join, leftPlan LogicalPlan) (LogicalPlan, bool, error) {
+	ts, ok := joinNode.Right.(*ast.TableSource)
+	lateral := ok && ts.Lateral
+	if ok {
+		// JSON_TABLE is implicitly lateral, its document can refer to the preceding tables.
+		_, isJSONTable := ts.Source.(*ast.JSONTable)
+		lateral = lateral || isJSONTable
+	}
+	if !lateral || joinNode.Tp == ast.RightJoin {
+		p, err := b.buildResultSetNode(ctx, joinNode.Right, false)
+		return p, false, err
+	}
+	b.outerSchemas = append(b.outerSchemas, leftPlan.Schema().Clone())
+	b.outerNames = append(b.outerNames, leftPlan.OutputNames())
+	defer func() {
+		b.outerSchemas = b.outerSchemas[:len(b.outerSchemas)-1]
+		b.outerNames = b.outerNames[:len(b.outerNames)-1]
+	}()
+	p, err := b.buildResultSetNode(ctx, joinNode.Right, false)
+	return p, true, err
 }
 
 // buildUsingClause eliminate the redundant columns and ordering columns based
@@ -2692,6 +2737,16 @@ func (a *havingWindowAndOrderbyExprResolver) Leave(n ast.Node) (node ast.Node, o
 		}
 	case *ast.WindowSpec:
 		a.inWindowSpec = false
+	case *ast.MatchAgainst:
+		if a.curClause == orderByClause && !a.inAggFunc && !a.inWindowFunc {
+			// The columns of MATCH are not ColumnNameExpr, append them to the select fields
+			// so that they can be found when rewriting the order by items.
+			for _, name := range v.ColumnNames {
+				if _, a.err = a.resolveFromPlan(&ast.ColumnNameExpr{Name: name}, a.p); a.err != nil {
+					return node, false
+				}
+			}
+		}
 	case *ast.PartitionByClause:
 		a.popCurClause()
 	case *ast.OrderByClause:
@@ -4271,6 +4326,13 @@ func (b *PlanBuilder) TableHints() *tableHintInfo {
 }
 
 func (b *PlanBuilder) buildSelect(ctx context.Context, sel *ast.SelectStmt) (p LogicalPlan, err error) {
+	mvSel, err := b.tryRewriteToMaterializedView(ctx, sel)
+	if err != nil {
+		return nil, err
+	}
+	if mvSel != nil {
+		sel = mvSel
+	}
 	b.pushSelectOffset(sel.QueryBlockOffset)
 	b.pushTableHints(sel.TableHints, sel.QueryBlockOffset)
 	defer func() {
@@ -4278,13 +4340,10 @@ func (b *PlanBuilder) buildSelect(ctx context.Context, sel *ast.SelectStmt) (p L
 		// table hints are only visible in the current SELECT statement.
 		b.popTableHints()
 	}()
-	if b.buildingRecursivePartForCTE {
-		if sel.Distinct || sel.OrderBy != nil || sel.Limit != nil {
-			return nil, ErrNotSupportedYet.GenWithStackByArgs("ORDER BY / LIMIT / SELECT DISTINCT in recursive query block of Common Table Expression")
-		}
-		if sel.GroupBy != nil {
-			return nil, ErrCTERecursiveForbidsAggregation.FastGenByArgs(b.genCTETableNameForError())
-		}
+	// ORDER BY and SELECT DISTINCT are allowed in the recursive query block, they take effect in each iteration.
+	// LIMIT in the recursive query block bounds the rows of the whole CTE instead, see buildRecursiveCTE.
+	if b.buildingRecursivePartForCTE && sel.GroupBy != nil {
+		return nil, ErrCTERecursiveForbidsAggregation.FastGenByArgs(b.genCTETableNameForError())
 	}
 	noopFuncsMode := b.ctx.GetSessionVars().NoopFuncsMode
 	if sel.SelectStmtOpts != nil {
@@ -4621,6 +4680,123 @@ func (b *PlanBuilder) buildTableDual() *LogicalTableDual {
 	return LogicalTableDual{RowCount: 1}.Init(b.ctx, b.getSelectOffset())
 }
 
+// buildJSONTable builds the JSON_TABLE table function. The JSON document may
+// refer to the preceding tables in the FROM clause, they are in b.outerSchemas
+// and the references are built as correlated columns.
+func (b *PlanBuilder) buildJSONTable(ctx context.Context, jt *ast.JSONTable, asName model.CIStr) (LogicalPlan, error) {
+	mockTablePlan := LogicalTableDual{}.Init(b.ctx, b.getSelectOffset())
+	expr, np, err := b.rewrite(ctx, jt.Expr, mockTablePlan, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	if np != mockTablePlan {
+		return nil, ErrNotSupportedYet.GenWithStackByArgs("subquery in the JSON_TABLE document")
+	}
+	p := LogicalJSONTable{Expr: expression.WrapWithCastAsJSON(b.ctx, expr)}.Init(b.ctx, b.getSelectOffset())
+	schema := expression.NewSchema()
+	names := make(types.NameSlice, 0, len(jt.Columns))
+	p.Root, err = b.buildJSONTablePath(jt.Path, jt.Columns, asName, schema, &names)
+	if err != nil {
+		return nil, err
+	}
+	p.SetSchema(schema)
+	p.SetOutputNames(names)
+	b.handleHelper.pushMap(nil)
+	return p, nil
+}
+
+func (b *PlanBuilder) buildJSONTablePath(path string, cols []*ast.JSONTableColumn, asName model.CIStr, schema *expression.Schema, names *types.NameSlice) (*JSONTablePath, error) {
+	pathExpr, err := types.ParseJSONPathExpr(path)
+	if err != nil {
+		return nil, err
+	}
+	tablePath := &JSONTablePath{Path: pathExpr}
+	for _, col := range cols {
+		if col.Tp == ast.JSONTableColumnNested {
+			nested, err := b.buildJSONTablePath(col.Path, col.NestedColumns, asName, schema, names)
+			if err != nil {
+				return nil, err
+			}
+			tablePath.Nested = append(tablePath.Nested, nested)
+			continue
+		}
+		tableCol := &JSONTableColumn{Tp: col.Tp, Name: col.Name.O, Offset: schema.Len()}
+		var tp *types.FieldType
+		if col.Tp == ast.JSONTableColumnOrdinality {
+			tp = types.NewFieldType(mysql.TypeLong)
+			tp.AddFlag(mysql.UnsignedFlag)
+			tp = jsonTableColumnType(tp)
+		} else {
+			tp = jsonTableColumnType(col.Type)
+			if tableCol.Path, err = types.ParseJSONPathExpr(col.Path); err != nil {
+				return nil, err
+			}
+			if tableCol.OnEmpty, err = buildJSONTableOnResponse(col.OnEmpty); err != nil {
+				return nil, err
+			}
+			if tableCol.OnError, err = buildJSONTableOnResponse(col.OnError); err != nil {
+				return nil, err
+			}
+		}
+		tablePath.Columns = append(tablePath.Columns, tableCol)
+		schema.Append(&expression.Column{
+			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+			RetType:  tp,
+			OrigName: fmt.Sprintf("%s.%s", asName.L, col.Name.L),
+		})
+		*names = append(*names, &types.FieldName{
+			TblName:     asName,
+			OrigTblName: asName,
+			ColName:     col.Name,
+			OrigColName: col.Name,
+		})
+	}
+	return tablePath, nil
+}
+
+// jsonTableColumnType fills the unspecified length, decimal and charset of the column type of JSON_TABLE.
+func jsonTableColumnType(tp *types.FieldType) *types.FieldType {
+	tp = tp.Clone()
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(tp.GetType())
+	if tp.GetFlen() == types.UnspecifiedLength {
+		tp.SetFlen(defaultFlen)
+	}
+	if tp.GetDecimal() == types.UnspecifiedLength {
+		tp.SetDecimal(defaultDecimal)
+	}
+	if tp.EvalType() != types.ETString || tp.GetType() == mysql.TypeJSON || tp.GetCharset() == charset.CharsetBin {
+		types.SetBinChsClnFlag(tp)
+		return tp
+	}
+	if tp.GetCharset() == "" {
+		chs, coll := charset.GetDefaultCharsetAndCollate()
+		tp.SetCharset(chs)
+		if tp.GetCollate() == "" {
+			tp.SetCollate(coll)
+		}
+	} else if tp.GetCollate() == "" {
+		coll, err := charset.GetDefaultCollation(tp.GetCharset())
+		if err == nil {
+			tp.SetCollate(coll)
+		}
+	}
+	return tp
+}
+
+func buildJSONTableOnResponse(resp *ast.JSONTableOnResponse) (*JSONTableOnResponse, error) {
+	if resp == nil {
+		return nil, nil
+	}
+	ret := &JSONTableOnResponse{Tp: resp.Tp}
+	if resp.Tp == ast.JSONTableOnResponseDefault {
+		var err error
+		if ret.Default, err = types.ParseBinaryJSONFromString(resp.Default); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
 func (ds *DataSource) newExtraHandleSchemaCol() *expression.Column {
 	tp := types.NewFieldType(mysql.TypeLonglong)
 	tp.SetFlag(mysql.NotNullFlag | mysql.PriKeyFlag)
@@ -4814,6 +4990,9 @@ func (b *PlanBuilder) tryBuildCTE(ctx context.Context, tn *ast.TableName, asName
 				}
 				p.SetOutputNames(on)
 			}
+			if cte.orderByItems != nil {
+				return b.buildCTEOrderByAndLimit(p, cte)
+			}
 			return p, nil
 		}
 	}
@@ -4821,6 +5000,50 @@ func (b *PlanBuilder) tryBuildCTE(ctx context.Context, tn *ast.TableName, asName
 	return nil, nil
 }
 
+// buildCTEOrderByAndLimit applies the ORDER BY and LIMIT over UNION of a recursive CTE on a reference of it.
+// For example: with recursive cte(n) as (select 1 union all select n + 1 from cte where n < 10 order by n desc limit 3) select * from cte;
+func (b *PlanBuilder) buildCTEOrderByAndLimit(p LogicalPlan, cte *cteInfo) (LogicalPlan, error) {
+	cols := make([]expression.Expression, 0, p.Schema().Len())
+	for _, col := range p.Schema().Columns {
+		cols = append(cols, col)
+	}
+	sort := LogicalSort{}.Init(b.ctx, b.getSelectOffset())
+	sort.ByItems = make([]*util.ByItems, 0, len(cte.orderByItems))
+	for _, item := range cte.orderByItems {
+		expr := expression.ColumnSubstitute(item.Expr, cte.seedLP.Schema(), cols)
+		sort.ByItems = append(sort.ByItems, &util.ByItems{Expr: expr, Desc: item.Desc})
+	}
+	sort.SetChildren(p)
+	if cte.sortedLimit == nil {
+		return sort, nil
+	}
+	return b.buildLimit(sort, cte.sortedLimit)
+}
+
+// mergeCTELimit merges the limit over UNION of a recursive CTE into the limit in its recursive query block,
+// which is applied first. Both of them are either a LogicalLimit or a LogicalTableDual returning no rows.
+func mergeCTELimit(inner, outer LogicalPlan) LogicalPlan {
+	innerLimit, ok := inner.(*LogicalLimit)
+	if !ok {
+		if inner == nil {
+			return outer
+		}
+		return inner
+	}
+	outerLimit, ok := outer.(*LogicalLimit)
+	if !ok {
+		return outer
+	}
+	if outerLimit.Offset >= innerLimit.Count {
+		innerLimit.Offset += innerLimit.Count
+		innerLimit.Count = 0
+		return innerLimit
+	}
+	innerLimit.Offset += outerLimit.Offset
+	innerLimit.Count = mathutil.Min(innerLimit.Count-outerLimit.Offset, outerLimit.Count)
+	return innerLimit
+}
+
 func (b *PlanBuilder) buildDataSourceFromCTEMerge(ctx context.Context, cte *ast.CommonTableExpression) (LogicalPlan, error) {
 	p, err := b.buildResultSetNode(ctx, cte.Query.Query, true)
 	if err != nil {
@@ -4941,11 +5164,13 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 		if len(tn.PartitionNames) > 0 {
 			pids := make(map[int64]struct{}, len(tn.PartitionNames))
 			for _, name := range tn.PartitionNames {
-				pid, err := tables.FindPartitionByName(tableInfo, name.L)
+				partIDs, err := tables.FindPartitionIDsByName(tableInfo, name.L)
 				if err != nil {
 					return nil, err
 				}
-				pids[pid] = struct{}{}
+				for _, pid := range partIDs {
+					pids[pid] = struct{}{}
+				}
 			}
 			pt = tables.NewPartitionTableWithGivenSets(pt, pids)
 		}
@@ -5859,6 +6084,9 @@ func buildColumns2Handle(
 }
 
 func (b *PlanBuilder) buildUpdate(ctx context.Context, update *ast.UpdateStmt) (Plan, error) {
+	if tn := viewTarget(update.TableRefs); tn != nil && !update.MultipleTable {
+		return b.buildUpdateOnView(ctx, update, tn)
+	}
 	b.pushSelectOffset(0)
 	b.pushTableHints(update.TableHints, 0)
 	defer func() {
@@ -6086,7 +6314,7 @@ func (b *PlanBuilder) buildUpdateLists(ctx context.Context, tableList []*ast.Tab
 		foundListItem := false
 		for _, tl := range tableList {
 			if (tl.Schema.L == "" || tl.Schema.L == name.DBName.L) && (tl.Name.L == name.TblName.L) {
-				if isCTE(tl) || tl.TableInfo.IsView() || tl.TableInfo.IsSequence() {
+				if isCTE(tl) || tl.TableInfo.IsView() || tl.TableInfo.IsSequence() || b.isNonUpdatableMaterializedView(tl.TableInfo) {
 					return nil, nil, false, ErrNonUpdatableTable.GenWithStackByArgs(name.TblName.O, "UPDATE")
 				}
 				foundListItem = true
@@ -6266,6 +6494,9 @@ func IsDefaultExprSameColumn(names types.NameSlice, node ast.ExprNode) bool {
 }
 
 func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (Plan, error) {
+	if tn := viewTarget(ds.TableRefs); tn != nil && !ds.IsMultiTable {
+		return b.buildDeleteOnView(ctx, ds, tn)
+	}
 	b.pushSelectOffset(0)
 	b.pushTableHints(ds.TableHints, 0)
 	defer func() {
@@ -6408,6 +6639,9 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (Plan
 			if tn.TableInfo.IsSequence() {
 				return nil, errors.Errorf("delete sequence %s is not supported now", tn.Name.O)
 			}
+			if b.isNonUpdatableMaterializedView(tn.TableInfo) {
+				return nil, ErrNonUpdatableTable.GenWithStackByArgs(tn.Name.O, "DELETE")
+			}
 			if sessionVars.User != nil {
 				authErr = ErrTableaccessDenied.FastGenByArgs("DELETE", sessionVars.User.AuthUsername, sessionVars.User.AuthHostname, tb.Name.L)
 			}
@@ -6427,6 +6661,9 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (Plan
 			if v.TableInfo.IsSequence() {
 				return nil, errors.Errorf("delete sequence %s is not supported now", v.Name.O)
 			}
+			if b.isNonUpdatableMaterializedView(v.TableInfo) {
+				return nil, ErrNonUpdatableTable.GenWithStackByArgs(v.Name.O, "DELETE")
+			}
 			dbName := v.Schema.L
 			if dbName == "" {
 				dbName = b.ctx.GetSessionVars().CurrentDB
@@ -6650,7 +6887,7 @@ func (b *PlanBuilder) buildByItemsForWindow(
 }
 
 // buildWindowFunctionFrameBound builds the bounds of window function frames.
-// For type `Rows`, the bound expr must be an unsigned integer.
+// For type `Rows` and `Groups`, the bound expr must be an unsigned integer.
 // For type `Range`, the bound expr must be temporal or numeric types.
 func (b *PlanBuilder) buildWindowFunctionFrameBound(_ context.Context, spec *ast.WindowSpec, orderByItems []property.SortItem, boundClause *ast.FrameBound) (*FrameBound, error) {
 	frameType := spec.Frame.Type
@@ -6659,7 +6896,7 @@ func (b *PlanBuilder) buildWindowFunctionFrameBound(_ context.Context, spec *ast
 		return bound, nil
 	}
 
-	if frameType == ast.Rows {
+	if frameType == ast.Rows || frameType == ast.Groups {
 		if bound.Type == ast.CurrentRow {
 			return bound, nil
 		}
@@ -6760,9 +6997,6 @@ func (b *PlanBuilder) buildWindowFunctionFrame(ctx context.Context, spec *ast.Wi
 func (b *PlanBuilder) checkWindowFuncArgs(ctx context.Context, p LogicalPlan, windowFuncExprs []*ast.WindowFuncExpr, windowAggMap map[*ast.AggregateFuncExpr]int) error {
 	checker := &expression.ParamMarkerInPrepareChecker{}
 	for _, windowFuncExpr := range windowFuncExprs {
-		if strings.ToLower(windowFuncExpr.F) == ast.AggFuncGroupConcat {
-			return ErrNotSupportedYet.GenWithStackByArgs("group_concat as window function")
-		}
 		args, err := b.buildArgs4WindowFunc(ctx, p, windowFuncExpr.Args, windowAggMap)
 		if err != nil {
 			return err
@@ -6895,6 +7129,9 @@ func (b *PlanBuilder) buildWindowFunctions(ctx context.Context, p LogicalPlan, g
 				return nil, nil, ErrWrongArguments.GenWithStackByArgs(strings.ToLower(windowFunc.F))
 			}
 			preArgs += len(windowFunc.Args)
+			desc.HasDistinct = windowFunc.Distinct
+			desc.IgnoreNull = windowFunc.IgnoreNull
+			desc.FromLast = windowFunc.FromLast
 			desc.WrapCastForAggArgs(b.ctx)
 			descs = append(descs, desc)
 			windowMap[windowFunc] = schema.Len()
@@ -6916,15 +7153,6 @@ func (b *PlanBuilder) buildWindowFunctions(ctx context.Context, p LogicalPlan, g
 // Because the grouped specification is different from them, we should especially check them before build window frame.
 func (b *PlanBuilder) checkOriginWindowFuncs(funcs []*ast.WindowFuncExpr, orderByItems []property.SortItem) error {
 	for _, f := range funcs {
-		if f.IgnoreNull {
-			return ErrNotSupportedYet.GenWithStackByArgs("IGNORE NULLS")
-		}
-		if f.Distinct {
-			return ErrNotSupportedYet.GenWithStackByArgs("<window function>(DISTINCT ..)")
-		}
-		if f.FromLast {
-			return ErrNotSupportedYet.GenWithStackByArgs("FROM LAST")
-		}
 		spec := &f.Spec
 		if f.Spec.Name.L != "" {
 			spec = b.windowSpecs[f.Spec.Name.L]
@@ -6941,9 +7169,6 @@ func (b *PlanBuilder) checkOriginWindowSpec(spec *ast.WindowSpec, orderByItems [
 	if spec.Frame == nil {
 		return nil
 	}
-	if spec.Frame.Type == ast.Groups {
-		return ErrNotSupportedYet.GenWithStackByArgs("GROUPS")
-	}
 	start, end := spec.Frame.Extent.Start, spec.Frame.Extent.End
 	if start.Type == ast.Following && start.UnBounded {
 		return ErrWindowFrameStartIllegal.GenWithStackByArgs(getWindowName(spec.Name.O))
@@ -6975,7 +7200,7 @@ func (b *PlanBuilder) checkOriginWindowFrameBound(bound *ast.FrameBound, spec *a
 	}
 
 	frameType := spec.Frame.Type
-	if frameType == ast.Rows {
+	if frameType == ast.Rows || frameType == ast.Groups {
 		if bound.Unit != ast.TimeUnitInvalid {
 			return ErrWindowRowsIntervalUse.GenWithStackByArgs(getWindowName(spec.Name.O))
 		}
@@ -7536,10 +7761,30 @@ func (b *PlanBuilder) buildRecursiveCTE(ctx context.Context, cte ast.ResultSetNo
 		tmpAfterSetOptsForRecur := []*ast.SetOprType{nil}
 
 		expectSeed := true
+		// recurLimit is the LIMIT in the recursive query block. Like MySQL 8.0.19+, it bounds the rows of the
+		// whole CTE instead of the rows generated by each iteration, so it's enforced by the CTE executor.
+		var recurLimit *ast.Limit
 		for i := 0; i < len(x.SelectList.Selects); i++ {
 			var p LogicalPlan
 			var err error
 
+			// A query block in parentheses is a SetOprSelectList of it.
+			block := x.SelectList.Selects[i]
+			for list, ok := block.(*ast.SetOprSelectList); ok && len(list.Selects) == 1; list, ok = block.(*ast.SetOprSelectList) {
+				block = list.Selects[0]
+			}
+			if sel, ok := block.(*ast.SelectStmt); ok && !expectSeed && sel.Limit != nil {
+				if recurLimit != nil {
+					return ErrNotSupportedYet.GenWithStackByArgs("LIMIT in more than one recursive query block of Common Table Expression")
+				}
+				recurLimit = sel.Limit
+				sel.Limit = nil
+				// Reset it in defer so that the AST doesn't change after this function.
+				defer func() {
+					sel.Limit = recurLimit
+				}()
+			}
+
 			var afterOpr *ast.SetOprType
 			switch y := x.SelectList.Selects[i].(type) {
 			case *ast.SelectStmt:
@@ -7558,13 +7803,9 @@ func (b *PlanBuilder) buildRecursiveCTE(ctx context.Context, cte ast.ResultSetNo
 					}
 
 					// It's the recursive part. Build the seed part, and build this recursive part again.
-					// Before we build the seed part, do some checks.
-					if x.OrderBy != nil {
-						return ErrNotSupportedYet.GenWithStackByArgs("ORDER BY over UNION in recursive Common Table Expression")
-					}
-					// Limit clause is for the whole CTE instead of only for the seed part.
-					oriLimit := x.Limit
-					x.Limit = nil
+					// Order by and limit clauses are for the whole CTE instead of only for the seed part.
+					oriOrderBy, oriLimit := x.OrderBy, x.Limit
+					x.OrderBy, x.Limit = nil, nil
 
 					// Check union type.
 					if afterOpr != nil {
@@ -7590,11 +7831,23 @@ func (b *PlanBuilder) buildRecursiveCTE(ctx context.Context, cte ast.ResultSetNo
 						return err
 					}
 					cInfo.seedLP = p
+					if oriOrderBy != nil {
+						// The order by items are resolved by the CTE's output names like the recursive part,
+						// and they will be applied on each reference of this CTE.
+						sort, err := b.buildSort(ctx, p, oriOrderBy.Items, nil, nil)
+						if err != nil {
+							return err
+						}
+						if sort.children[0] != p {
+							return ErrNotSupportedYet.GenWithStackByArgs("subquery in ORDER BY over UNION in recursive Common Table Expression")
+						}
+						cInfo.orderByItems = sort.ByItems
+					}
 
 					// Rebuild the plan.
 					i--
 					b.buildingRecursivePartForCTE = true
-					x.Limit = oriLimit
+					x.OrderBy, x.Limit = oriOrderBy, oriLimit
 					continue
 				}
 				if err != nil {
@@ -7643,14 +7896,25 @@ func (b *PlanBuilder) buildRecursiveCTE(ctx context.Context, cte ast.ResultSetNo
 		}
 		// 4. Finally, we get the seed part plan and recursive part plan.
 		cInfo.recurLP = recurPart
+		if recurLimit != nil {
+			limit, err := b.buildLimit(cInfo.seedLP, recurLimit)
+			if err != nil {
+				return err
+			}
+			limit.SetChildren(limit.Children()[:0]...)
+			cInfo.limitLP = limit
+		}
 		// Only need to handle limit if x is SetOprStmt.
-		if x.Limit != nil {
+		if x.Limit != nil && cInfo.orderByItems != nil {
+			// The whole CTE must be computed before sorting, so the limit can't be used to stop the iterations early.
+			cInfo.sortedLimit = x.Limit
+		} else if x.Limit != nil {
 			limit, err := b.buildLimit(cInfo.seedLP, x.Limit)
 			if err != nil {
 				return err
 			}
 			limit.SetChildren(limit.Children()[:0]...)
-			cInfo.limitLP = limit
+			cInfo.limitLP = mergeCTELimit(cInfo.limitLP, limit)
 		}
 		return nil
 	default:
@@ -50,6 +50,7 @@ var (
 	_ LogicalPlan = &LogicalApply{}
 	_ LogicalPlan = &LogicalMaxOneRow{}
 	_ LogicalPlan = &LogicalTableDual{}
+	_ LogicalPlan = &LogicalJSONTable{}
 	_ LogicalPlan = &DataSource{}
 	_ LogicalPlan = &TiKVSingleGather{}
 	_ LogicalPlan = &LogicalTableScan{}
@@ -1411,6 +1412,50 @@ type LogicalTableDual struct {
 	RowCount int
 }
 
+// JSONTableOnResponse is the ON EMPTY or ON ERROR clause of a JSON_TABLE column.
+type JSONTableOnResponse struct {
+	Tp ast.JSONTableOnResponseType
+	// Default is the parsed value of `DEFAULT json_string`.
+	Default types.BinaryJSON
+}
+
+// JSONTableColumn is a column of JSON_TABLE other than a nested path.
+type JSONTableColumn struct {
+	Tp ast.JSONTableColumnType
+	// Name is the column name, used in the error messages.
+	Name string
+	// Offset is the offset of the column in the schema of JSON_TABLE.
+	Offset int
+	// Path is the JSON path of path and exists path columns.
+	Path types.JSONPathExpression
+	// OnEmpty and OnError are nil if the clauses are omitted, which means NULL ON EMPTY and NULL ON ERROR.
+	OnEmpty *JSONTableOnResponse
+	OnError *JSONTableOnResponse
+}
+
+// JSONTablePath is the row path or a nested path of JSON_TABLE, with the
+// columns filled by each value it matches.
+type JSONTablePath struct {
+	Path    types.JSONPathExpression
+	Columns []*JSONTableColumn
+	Nested  []*JSONTablePath
+}
+
+// LogicalJSONTable represents the JSON_TABLE table function. It's a leaf
+// operator; when Expr refers to the preceding tables in the FROM clause,
+// it's built as the inner side of a LogicalApply.
+type LogicalJSONTable struct {
+	logicalSchemaProducer
+
+	Expr expression.Expression
+	Root *JSONTablePath
+}
+
+// ExtractCorrelatedCols implements LogicalPlan interface.
+func (p *LogicalJSONTable) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
+	return expression.ExtractCorColumns(p.Expr)
+}
+
 // LogicalMemTable represents a memory table or virtual table
 // Some memory tables wants to take the ownership of some predications
 // e.g
@@ -1665,10 +1710,28 @@ func (ds *DataSource) detachCondAndBuildRangeForPath(path *util.AccessPath, cond
 			path.ConstCols[i] = res.ColumnValues[i] != nil
 		}
 	}
+	if path.Index.Tp == model.IndexTypeHypo {
+		path.CountAfterAccess = ds.deriveHypoIndexCountAfterAccess(path)
+		return nil
+	}
 	path.CountAfterAccess, err = ds.tableStats.HistColl.GetRowCountByIndexRanges(ds.SCtx(), path.Index.ID, path.Ranges)
 	return err
 }
 
+// deriveHypoIndexCountAfterAccess estimates the row count after accessing the hypothetical index. The index has
+// no statistics of its own, so the count is derived from the statistics of the columns in the access conditions.
+func (ds *DataSource) deriveHypoIndexCountAfterAccess(path *util.AccessPath) float64 {
+	if len(path.AccessConds) == 0 {
+		return float64(ds.statisticTable.RealtimeCount)
+	}
+	selectivity, _, err := ds.tableStats.HistColl.Selectivity(ds.SCtx(), path.AccessConds, nil)
+	if err != nil {
+		logutil.BgLogger().Debug("calculate selectivity failed, use selection factor", zap.Error(err))
+		selectivity = SelectionFactor
+	}
+	return float64(ds.statisticTable.RealtimeCount) * selectivity
+}
+
 func (ds *DataSource) deriveCommonHandleTablePathStats(path *util.AccessPath, conds []expression.Expression, isIm bool) error {
 	path.CountAfterAccess = float64(ds.statisticTable.RealtimeCount)
 	path.Ranges = ranger.FullNotNullRange()
@@ -2201,6 +2264,7 @@ type ShowContents struct {
 	Tp                ast.ShowStmtType // Databases/Tables/Columns/....
 	DBName            string
 	Table             *ast.TableName  // Used for showing columns.
+	Procedure         *ast.TableName  // Used for showing create procedure and function.
 	Partition         model.CIStr     // Use for showing partition
 	Column            *ast.ColumnName // Used for `desc table column`.
 	IndexName         model.CIStr
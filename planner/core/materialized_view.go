@@ -0,0 +1,165 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/privilege"
+	"github.com/pingcap/tidb/tablecodec"
+)
+
+// isNonUpdatableMaterializedView checks whether the table is a materialized view which can't be modified by DML.
+// Only the internal refresh of the view is allowed to write it.
+func (b *PlanBuilder) isNonUpdatableMaterializedView(tblInfo *model.TableInfo) bool {
+	return tblInfo != nil && tblInfo.IsMaterializedView() && !b.ctx.GetSessionVars().InRestrictedSQL
+}
+
+// tryRewriteToMaterializedView returns a SELECT reading from an up-to-date materialized view whose
+// definition is the same as sel, or nil if no materialized view can answer it.
+func (b *PlanBuilder) tryRewriteToMaterializedView(ctx context.Context, sel *ast.SelectStmt) (*ast.SelectStmt, error) {
+	sessVars := b.ctx.GetSessionVars()
+	if !sessVars.EnableMaterializedViewRewrite || sessVars.InRestrictedSQL || b.isCreateView ||
+		len(b.buildingViewStack) > 0 || b.buildingRecursivePartForCTE {
+		return nil, nil
+	}
+	if sel.Kind != ast.SelectStmtKindSelect || sel.From == nil || sel.With != nil || sel.OrderBy != nil ||
+		sel.SelectIntoOpt != nil || (sel.LockInfo != nil && sel.LockInfo.LockType != ast.SelectLockNone) {
+		return nil, nil
+	}
+	for _, field := range sel.Fields.Fields {
+		if field.WildCard != nil {
+			return nil, nil
+		}
+	}
+
+	// The definition of a materialized view has an alias for each field, see addAliasName.
+	// Restore the query in the same way to compare with it.
+	namedFields := make([]*ast.SelectField, 0, len(sel.Fields.Fields))
+	for _, field := range sel.Fields.Fields {
+		namedField := *field
+		if namedField.AsName.L == "" {
+			if col, ok := field.Expr.(*ast.ColumnNameExpr); ok {
+				namedField.AsName = col.Name.Name
+			} else {
+				var err error
+				if namedField.AsName, err = b.buildProjectionFieldNameFromExpressions(ctx, field); err != nil {
+					return nil, err
+				}
+			}
+		}
+		namedFields = append(namedFields, &namedField)
+	}
+	namedSel := *sel
+	namedSel.Fields = &ast.FieldList{Fields: namedFields}
+	var sb strings.Builder
+	restoreFlag := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameBackQuotes
+	if err := namedSel.Restore(format.NewRestoreCtx(restoreFlag, &sb)); err != nil {
+		return nil, nil
+	}
+	def := sb.String()
+
+	var mvSchema model.CIStr
+	var mvInfo *model.TableInfo
+	pm := privilege.GetPrivilegeManager(b.ctx)
+	checked := make(map[string]struct{})
+	tableNames := extractTableList(sel.From.TableRefs, nil, false)
+	for _, tn := range tableNames {
+		if tn.Schema.L == "" {
+			return nil, nil
+		}
+		if _, ok := checked[tn.Schema.L]; ok {
+			continue
+		}
+		checked[tn.Schema.L] = struct{}{}
+		for _, tbl := range b.is.SchemaTables(tn.Schema) {
+			tblInfo := tbl.Meta()
+			if !tblInfo.IsMaterializedView() || tblInfo.MaterializedView.SelectStmt != def ||
+				len(tblInfo.Columns) != len(sel.Fields.Fields) {
+				continue
+			}
+			if pm != nil && !pm.RequestVerification(sessVars.ActiveRoles, tn.Schema.L, tblInfo.Name.L, "", mysql.SelectPriv) {
+				continue
+			}
+			fresh, err := b.isMaterializedViewFresh(tableNames, tblInfo)
+			if err != nil {
+				return nil, err
+			}
+			if !fresh {
+				continue
+			}
+			mvSchema, mvInfo = tn.Schema, tblInfo
+			break
+		}
+		if mvInfo != nil {
+			break
+		}
+	}
+	if mvInfo == nil {
+		return nil, nil
+	}
+
+	// Keep the output names of the original query.
+	fields := make([]*ast.SelectField, 0, len(namedFields))
+	for i, field := range namedFields {
+		fields = append(fields, &ast.SelectField{
+			Expr:   &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: mvInfo.Columns[i].Name}},
+			AsName: field.AsName,
+		})
+	}
+	sessVars.StmtCtx.SetSkipPlanCache(errors.New("query reading from a materialized view is un-cacheable"))
+	return &ast.SelectStmt{
+		Kind:   ast.SelectStmtKindSelect,
+		Fields: &ast.FieldList{Fields: fields},
+		From: &ast.TableRefsClause{TableRefs: &ast.Join{
+			Left: &ast.TableSource{Source: &ast.TableName{Schema: mvSchema, Name: mvInfo.Name}},
+		}},
+		QueryBlockOffset: sel.QueryBlockOffset,
+	}, nil
+}
+
+// isMaterializedViewFresh checks whether the materialized view reflects the current data of the tables
+// read by the query. Only an incrementally refreshed view tracks the changes of its base table, so it's
+// fresh if it has been refreshed and no change is pending in its change log. A view refreshed completely
+// may be stale at any time, so it's never used to answer a query.
+func (b *PlanBuilder) isMaterializedViewFresh(tableNames []*ast.TableName, tblInfo *model.TableInfo) (bool, error) {
+	mvInfo := tblInfo.MaterializedView
+	if mvInfo.RefreshMode != model.MaterializedViewRefreshIncremental || mvInfo.LastRefreshTS == 0 || len(tableNames) != 1 {
+		return false, nil
+	}
+	// The change log is lost if the base table is truncated or recreated after the last refresh.
+	base, err := b.is.TableByName(tableNames[0].Schema, tableNames[0].Name)
+	if err != nil || base.Meta().ID != mvInfo.BaseTableID {
+		return false, nil
+	}
+	// Read the change log in the transaction to also see the changes made by the transaction itself.
+	txn, err := b.ctx.Txn(true)
+	if err != nil {
+		return false, err
+	}
+	prefix := tablecodec.EncodeMaterializedViewLogPrefix(tblInfo.ID)
+	iter, err := txn.Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	defer iter.Close()
+	return !iter.Valid() || !iter.Key().HasPrefix(prefix), nil
+}
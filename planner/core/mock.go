@@ -386,7 +386,7 @@ func MockView() *model.TableInfo {
 		Name:   model.NewCIStr("d"),
 		ID:     3,
 	}
-	view := &model.ViewInfo{SelectStmt: selectStmt, Security: model.SecurityDefiner, Definer: &auth.UserIdentity{Username: "root", Hostname: ""}, Cols: []model.CIStr{col0.Name, col1.Name, col2.Name}}
+	view := &model.ViewInfo{SelectStmt: selectStmt, Security: model.SecurityDefiner, CheckOption: model.CheckOptionNone, Definer: &auth.UserIdentity{Username: "root", Hostname: ""}, Cols: []model.CIStr{col0.Name, col1.Name, col2.Name}}
 	table := &model.TableInfo{
 		Name:    model.NewCIStr("v"),
 		Columns: []*model.ColumnInfo{col0, col1, col2},
@@ -20,6 +20,7 @@ import (
 	"math"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -187,6 +188,24 @@ func CheckPrivilege(activeRoles []*auth.RoleIdentity, pm privilege.Manager, vs [
 	return nil
 }
 
+// CheckPrivilegeWithUser checks the privilege for the given user instead of the current one,
+// like the definer of a trigger.
+func CheckPrivilegeWithUser(pm privilege.Manager, vs []visitInfo, user *auth.UserIdentity) error {
+	for _, v := range vs {
+		if v.privilege == mysql.ExtendedPriv {
+			if !pm.RequestDynamicVerificationWithUser(v.dynamicPriv, v.dynamicWithGrant, user) {
+				return ErrPrivilegeCheckFail.GenWithStackByArgs(v.dynamicPriv)
+			}
+		} else if !pm.RequestVerificationWithUser(v.db, v.table, v.column, v.privilege, user) {
+			if v.table == "" {
+				return ErrPrivilegeCheckFail.GenWithStackByArgs(v.privilege.String())
+			}
+			return ErrTableaccessDenied.GenWithStackByArgs(strings.ToUpper(v.privilege.String()), user.Username, user.Hostname, v.table)
+		}
+	}
+	return nil
+}
+
 // VisitInfo4PrivCheck generates privilege check infos because privilege check of local temporary tables is different
 // with normal tables. `CREATE` statement needs `CREATE TEMPORARY TABLE` privilege from the database, and subsequent
 // statements do not need any privileges.
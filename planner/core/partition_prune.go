@@ -29,6 +29,9 @@ func PartitionPruning(ctx sessionctx.Context, tbl table.PartitionedTable, conds
 	columns []*expression.Column, names types.NameSlice) ([]int, error) {
 	s := partitionProcessor{}
 	pi := tbl.Meta().Partition
+	if pi.Sub != nil {
+		return s.pruneSubPartition(ctx, tbl, partitionNames, conds, columns, names)
+	}
 	switch pi.Type {
 	case model.PartitionTypeHash, model.PartitionTypeKey:
 		return s.pruneHashOrKeyPartition(ctx, tbl, partitionNames, conds, columns, names)
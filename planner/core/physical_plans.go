@@ -49,6 +49,7 @@ var (
 	_ PhysicalPlan = &PhysicalTopN{}
 	_ PhysicalPlan = &PhysicalMaxOneRow{}
 	_ PhysicalPlan = &PhysicalTableDual{}
+	_ PhysicalPlan = &PhysicalJSONTable{}
 	_ PhysicalPlan = &PhysicalUnionAll{}
 	_ PhysicalPlan = &PhysicalSort{}
 	_ PhysicalPlan = &NominalSort{}
@@ -328,9 +329,8 @@ func (p *PhysicalIndexReader) Clone() (PhysicalPlan, error) {
 	if cloned.indexPlan, err = p.indexPlan.Clone(); err != nil {
 		return nil, err
 	}
-	if cloned.IndexPlans, err = clonePhysicalPlan(p.IndexPlans); err != nil {
-		return nil, err
-	}
+	// IndexPlans are actually the flattened plans in indexPlan, so can't copy them, just need to extract from indexPlan
+	cloned.IndexPlans = flattenPushDownPlan(cloned.indexPlan)
 	cloned.OutputColumns = util.CloneCols(p.OutputColumns)
 	return cloned, err
 }
@@ -453,23 +453,21 @@ type PhysicalIndexLookUpReader struct {
 // Clone implements PhysicalPlan interface.
 func (p *PhysicalIndexLookUpReader) Clone() (PhysicalPlan, error) {
 	cloned := new(PhysicalIndexLookUpReader)
+	*cloned = *p
 	base, err := p.physicalSchemaProducer.cloneWithSelf(cloned)
 	if err != nil {
 		return nil, err
 	}
 	cloned.physicalSchemaProducer = *base
-	if cloned.IndexPlans, err = clonePhysicalPlan(p.IndexPlans); err != nil {
-		return nil, err
-	}
-	if cloned.TablePlans, err = clonePhysicalPlan(p.TablePlans); err != nil {
-		return nil, err
-	}
 	if cloned.indexPlan, err = p.indexPlan.Clone(); err != nil {
 		return nil, err
 	}
 	if cloned.tablePlan, err = p.tablePlan.Clone(); err != nil {
 		return nil, err
 	}
+	cloned.IndexPlans = flattenPushDownPlan(cloned.indexPlan)
+	cloned.TablePlans = flattenPushDownPlan(cloned.tablePlan)
+	cloned.CommonHandleCols = util.CloneCols(p.CommonHandleCols)
 	if p.ExtraHandleCol != nil {
 		cloned.ExtraHandleCol = p.ExtraHandleCol.Clone().(*expression.Column)
 	}
@@ -727,6 +725,13 @@ func (p *PhysicalIndexScan) Clone() (PhysicalPlan, error) {
 		cloned.Index = p.Index.Clone()
 	}
 	cloned.IdxCols = util.CloneCols(p.IdxCols)
+	if p.GenExprs != nil {
+		cloned.GenExprs = make(map[model.TableItemID]expression.Expression, len(p.GenExprs))
+		for id, expr := range p.GenExprs {
+			cloned.GenExprs[id] = expr.Clone()
+		}
+	}
+	cloned.ByItems = cloneByItems(p.ByItems)
 	cloned.IdxColLens = make([]int, len(p.IdxColLens))
 	copy(cloned.IdxColLens, p.IdxColLens)
 	cloned.Ranges = util.CloneRanges(p.Ranges)
@@ -909,6 +914,8 @@ func (ts *PhysicalTableScan) Clone() (PhysicalPlan, error) {
 	clonedScan.physicalSchemaProducer = *prod
 	clonedScan.AccessCondition = util.CloneExprs(ts.AccessCondition)
 	clonedScan.filterCondition = util.CloneExprs(ts.filterCondition)
+	clonedScan.lateMaterializationFilterCondition = util.CloneExprs(ts.lateMaterializationFilterCondition)
+	clonedScan.ByItems = cloneByItems(ts.ByItems)
 	if ts.Table != nil {
 		clonedScan.Table = ts.Table.Clone()
 	}
@@ -1253,6 +1260,7 @@ func (p *basePhysicalJoin) cloneWithSelf(newSelf PhysicalPlan) (*basePhysicalJoi
 	cloned.RightJoinKeys = util.CloneCols(p.RightJoinKeys)
 	cloned.LeftNAJoinKeys = util.CloneCols(p.LeftNAJoinKeys)
 	cloned.RightNAJoinKeys = util.CloneCols(p.RightNAJoinKeys)
+	cloned.IsNullEQ = append(cloned.IsNullEQ, p.IsNullEQ...)
 	for _, d := range p.DefaultValues {
 		cloned.DefaultValues = append(cloned.DefaultValues, *d.Clone())
 	}
@@ -1351,6 +1359,8 @@ func (p *PhysicalHashJoin) Clone() (PhysicalPlan, error) {
 	cloned.basePhysicalJoin = *base
 	cloned.Concurrency = p.Concurrency
 	cloned.UseOuterToBuild = p.UseOuterToBuild
+	cloned.storeTp = p.storeTp
+	cloned.mppShuffleJoin = p.mppShuffleJoin
 	for _, c := range p.EqualConditions {
 		cloned.EqualConditions = append(cloned.EqualConditions, c.Clone().(*expression.ScalarFunction))
 	}
@@ -1462,6 +1472,9 @@ type PhysicalIndexJoin struct {
 	// InnerHashKeys indicates the inner keys used to build hash table during
 	// execution. InnerJoinKeys is the prefix of InnerHashKeys.
 	InnerHashKeys []*expression.Column
+	// FallbackInnerPlan is a full scan of the inner side. The executor falls back to hash join against it if the
+	// outer side has more rows than expected. It's nil if the fallback is not available.
+	FallbackInnerPlan PhysicalPlan
 }
 
 // MemoryUsage return the memory usage of PhysicalIndexJoin
@@ -1478,6 +1491,9 @@ func (p *PhysicalIndexJoin) MemoryUsage() (sum int64) {
 	if p.CompareFilters != nil {
 		sum += p.CompareFilters.MemoryUsage()
 	}
+	if p.FallbackInnerPlan != nil {
+		sum += p.FallbackInnerPlan.MemoryUsage()
+	}
 
 	for _, col := range p.OuterHashKeys {
 		sum += col.MemoryUsage()
@@ -2254,6 +2270,46 @@ func (p *PhysicalTableDual) MemoryUsage() (sum int64) {
 	return
 }
 
+// PhysicalJSONTable is the physical operator of the JSON_TABLE table function.
+type PhysicalJSONTable struct {
+	physicalSchemaProducer
+
+	Expr expression.Expression
+	Root *JSONTablePath
+}
+
+// Clone implements PhysicalPlan interface.
+func (p *PhysicalJSONTable) Clone() (PhysicalPlan, error) {
+	cloned := new(PhysicalJSONTable)
+	base, err := p.physicalSchemaProducer.cloneWithSelf(cloned)
+	if err != nil {
+		return nil, err
+	}
+	cloned.physicalSchemaProducer = *base
+	cloned.Expr = p.Expr.Clone()
+	// Root is read only after the plan is built, so it can be shared.
+	cloned.Root = p.Root
+	return cloned, nil
+}
+
+// ExtractCorrelatedCols implements PhysicalPlan interface.
+func (p *PhysicalJSONTable) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
+	return expression.ExtractCorColumns(p.Expr)
+}
+
+// MemoryUsage return the memory usage of PhysicalJSONTable
+func (p *PhysicalJSONTable) MemoryUsage() (sum int64) {
+	if p == nil {
+		return
+	}
+
+	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfInterface + size.SizeOfPointer
+	if p.Expr != nil {
+		sum += p.Expr.MemoryUsage()
+	}
+	return
+}
+
 // PhysicalWindow is the physical operator of window function.
 type PhysicalWindow struct {
 	physicalSchemaProducer
@@ -33,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/sessiontxn/staleread"
+	"github.com/pingcap/tidb/statistics"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/types"
 	driver "github.com/pingcap/tidb/types/parser_driver"
@@ -168,6 +169,12 @@ func GetPlanFromSessionPlanCache(ctx context.Context, sctx sessionctx.Context,
 			stmtCtx.SetSkipPlanCache(errors.Errorf("ignore plan cache by binding"))
 		}
 	}
+	if stmtCtx.UseCache && statistics.CardinalityFeedback.AnyEnabled() {
+		// The plan depends on the cardinality feedback, which may change after every execution.
+		if _, digest := stmtCtx.SQLDigest(); statistics.CardinalityFeedback.IsEnabled(digest.String()) {
+			stmtCtx.SetSkipPlanCache(errors.Errorf("ignore plan cache by cardinality feedback"))
+		}
+	}
 
 	// In rc or for update read, we need the latest schema version to decide whether we need to
 	// rebuild the plan. So we set this value in rc or for update read. In other cases, let it be 0.
@@ -255,6 +262,13 @@ func getCachedPlan(sctx sessionctx.Context, isNonPrepared bool, cacheKey kvcache
 	stmtCtx := sessVars.StmtCtx
 
 	candidate, exist := sctx.GetSessionPlanCache().Get(cacheKey, matchOpts)
+	fromInstance := false
+	if !exist {
+		if instanceCache := getInstancePlanCache(sctx); instanceCache != nil {
+			candidate, exist = instanceCache.Get(sctx, cacheKey, matchOpts)
+			fromInstance = true
+		}
+	}
 	if !exist {
 		return nil, nil, false, nil
 	}
@@ -266,7 +280,10 @@ func getCachedPlan(sctx sessionctx.Context, isNonPrepared bool, cacheKey kvcache
 		if !unionScan && tableHasDirtyContent(sctx, tblInfo) {
 			// TODO we can inject UnionScan into cached plan to avoid invalidating it, though
 			// rebuilding the filters in UnionScan is pretty trivial.
-			sctx.GetSessionPlanCache().Delete(cacheKey)
+			// The plans in the instance plan cache are shared, so only the session plan cache is cleaned.
+			if !fromInstance {
+				sctx.GetSessionPlanCache().Delete(cacheKey)
+			}
 			return nil, nil, false, nil
 		}
 	}
@@ -331,12 +348,26 @@ func generateNewPlan(ctx context.Context, sctx sessionctx.Context, isNonPrepared
 		stmt.NormalizedPlan, stmt.PlanDigest = NormalizePlan(p)
 		stmtCtx.SetPlan(p)
 		stmtCtx.SetPlanDigest(stmt.NormalizedPlan, stmt.PlanDigest)
-		sctx.GetSessionPlanCache().Put(cacheKey, cached, matchOpts)
+		// The plan is put into the session plan cache if it can't be shared by the sessions.
+		if instanceCache := getInstancePlanCache(sctx); instanceCache == nil || !instanceCache.Put(sctx, cacheKey, cached, matchOpts) {
+			sctx.GetSessionPlanCache().Put(cacheKey, cached, matchOpts)
+		}
 	}
 	sessVars.FoundInPlanCache = false
 	return p, names, err
 }
 
+// getInstancePlanCache returns the instance plan cache, it returns nil if the cache is disabled.
+func getInstancePlanCache(sctx sessionctx.Context) sessionctx.InstancePlanCache {
+	if !variable.EnableInstancePlanCache.Load() {
+		return nil
+	}
+	if do := domain.GetDomain(sctx); do != nil {
+		return do.InstancePlanCache()
+	}
+	return nil
+}
+
 // RebuildPlan4CachedPlan will rebuild this plan under current user parameters.
 func RebuildPlan4CachedPlan(p Plan) (ok bool) {
 	sc := p.SCtx().GetSessionVars().StmtCtx
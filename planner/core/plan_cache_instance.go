@@ -0,0 +1,332 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"container/list"
+
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	core_metrics "github.com/pingcap/tidb/planner/core/metrics"
+	"github.com/pingcap/tidb/planner/util"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/util/kvcache"
+	utilpc "github.com/pingcap/tidb/util/plancache"
+	"github.com/pingcap/tidb/util/syncutil"
+)
+
+// InstancePlanCache is the plan cache shared by all sessions of the instance.
+// The cached plans are detached from the sessions which built them and never modified after they are put
+// into the cache. Get clones the cached plan and binds the clone to the session, so the sessions can use
+// the plan concurrently. The memory usage of the cache is limited by tidb_instance_plan_cache_max_mem_size,
+// the least recently used plans are evicted if the limit is exceeded.
+type InstancePlanCache struct {
+	// buckets replace the map in general LRU
+	buckets map[string]map[*list.Element]struct{}
+	lruList *list.List
+	lock    syncutil.Mutex
+
+	memoryUsageTotal int64
+}
+
+// NewInstancePlanCache creates an InstancePlanCache.
+func NewInstancePlanCache() *InstancePlanCache {
+	return &InstancePlanCache{
+		buckets: make(map[string]map[*list.Element]struct{}),
+		lruList: list.New(),
+	}
+}
+
+// Get tries to find the corresponding value according to the given key, the returned value is a copy of
+// the cached one which is bound to sctx.
+func (c *InstancePlanCache) Get(sctx sessionctx.Context, key kvcache.Key, opts *utilpc.PlanCacheMatchOpts) (value kvcache.Value, ok bool) {
+	key = instancePlanCacheKey(key)
+	c.lock.Lock()
+	bucket, bucketExist := c.buckets[strHashKey(key, false)]
+	if !bucketExist {
+		c.lock.Unlock()
+		return nil, false
+	}
+	element, exist := pickPlanFromBucket(sctx.GetSessionVars(), bucket, opts)
+	if !exist {
+		c.lock.Unlock()
+		return nil, false
+	}
+	c.lruList.MoveToFront(element)
+	cached := element.Value.(*planCacheEntry).PlanValue.(*PlanCacheValue)
+	c.lock.Unlock()
+
+	// The cached value is never modified, so it's safe to clone it without holding the lock.
+	return cached.cloneForCtx(sctx)
+}
+
+// Put puts a copy of the (key, value) pair into the cache, it returns false if the plan can't be shared by
+// the sessions or it's too large to be cached.
+func (c *InstancePlanCache) Put(sctx sessionctx.Context, key kvcache.Key, value kvcache.Value, opts *utilpc.PlanCacheMatchOpts) bool {
+	v := value.(*PlanCacheValue)
+	if p, ok := v.Plan.(PhysicalPlan); !ok || !instancePlanCacheable(p) {
+		return false
+	}
+	// Detach the cached plan from the session, otherwise the session can't be released until the plan is evicted.
+	cached, ok := v.cloneForCtx(nil)
+	if !ok {
+		return false
+	}
+	key = instancePlanCacheKey(key)
+	entry := &planCacheEntry{PlanKey: key, PlanValue: cached}
+	maxMemory := variable.InstancePlanCacheMaxMemSize.Load()
+	if entry.MemoryUsage() > maxMemory {
+		return false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	hash := strHashKey(key, true)
+	bucket, bucketExist := c.buckets[hash]
+	if bucketExist {
+		if element, exist := pickPlanFromBucket(sctx.GetSessionVars(), bucket, opts); exist {
+			c.updateMetric(entry, element.Value.(*planCacheEntry))
+			element.Value.(*planCacheEntry).PlanValue = cached
+			c.lruList.MoveToFront(element)
+			c.evict(maxMemory)
+			return true
+		}
+	} else {
+		bucket = make(map[*list.Element]struct{}, 1)
+		c.buckets[hash] = bucket
+	}
+	element := c.lruList.PushFront(entry)
+	bucket[element] = struct{}{}
+	c.updateMetric(entry, nil)
+	c.evict(maxMemory)
+	return true
+}
+
+// Delete deletes the multi-values from the cache.
+func (c *InstancePlanCache) Delete(key kvcache.Key) {
+	key = instancePlanCacheKey(key)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	hash := strHashKey(key, false)
+	for element := range c.buckets[hash] {
+		c.updateMetric(nil, element.Value.(*planCacheEntry))
+		c.lruList.Remove(element)
+	}
+	delete(c.buckets, hash)
+}
+
+// DeleteAll deletes all elements from the cache.
+func (c *InstancePlanCache) DeleteAll() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	core_metrics.GetInstancePlanCacheNumCounter().Sub(float64(c.lruList.Len()))
+	core_metrics.GetInstancePlanCacheMemoryUsage().Sub(float64(c.memoryUsageTotal))
+	c.buckets = make(map[string]map[*list.Element]struct{})
+	c.lruList = list.New()
+	c.memoryUsageTotal = 0
+}
+
+// Size gets the current cache size.
+func (c *InstancePlanCache) Size() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.lruList.Len()
+}
+
+// MemoryUsage returns the memory usage of the cache.
+func (c *InstancePlanCache) MemoryUsage() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.memoryUsageTotal
+}
+
+// evict removes the least recently used elements until the memory usage doesn't exceed maxMemory.
+func (c *InstancePlanCache) evict(maxMemory int64) {
+	for c.memoryUsageTotal > maxMemory {
+		lru := c.lruList.Back()
+		if lru == nil {
+			return
+		}
+		c.updateMetric(nil, lru.Value.(*planCacheEntry))
+		c.lruList.Remove(lru)
+		hash := strHashKey(lru.Value.(*planCacheEntry).PlanKey, false)
+		bucket := c.buckets[hash]
+		delete(bucket, lru)
+		if len(bucket) == 0 {
+			delete(c.buckets, hash)
+		}
+	}
+}
+
+// updateMetric updates the memory usage and the plan number of the cache.
+func (c *InstancePlanCache) updateMetric(in, out *planCacheEntry) {
+	if in != nil {
+		c.memoryUsageTotal += in.MemoryUsage()
+		core_metrics.GetInstancePlanCacheMemoryUsage().Add(float64(in.MemoryUsage()))
+	}
+	if out != nil {
+		c.memoryUsageTotal -= out.MemoryUsage()
+		core_metrics.GetInstancePlanCacheMemoryUsage().Sub(float64(out.MemoryUsage()))
+	}
+	if in != nil && out == nil {
+		core_metrics.GetInstancePlanCacheNumCounter().Add(1)
+	} else if in == nil && out != nil {
+		core_metrics.GetInstancePlanCacheNumCounter().Sub(1)
+	}
+}
+
+// instancePlanCacheKey returns the key used by the instance plan cache, which ignores the connection.
+func instancePlanCacheKey(key kvcache.Key) kvcache.Key {
+	k := *key.(*planCacheKey)
+	k.connID = 0
+	k.hash = nil
+	k.memoryUsage = 0
+	return &k
+}
+
+// cloneForCtx clones the cached value and binds the cloned plan to sctx.
+func (v *PlanCacheValue) cloneForCtx(sctx sessionctx.Context) (*PlanCacheValue, bool) {
+	p, err := v.Plan.(PhysicalPlan).Clone()
+	if err != nil {
+		return nil, false
+	}
+	setPlanCtx(sctx, p)
+	return &PlanCacheValue{
+		Plan:              p,
+		OutPutNames:       v.OutPutNames,
+		TblInfo2UnionScan: v.TblInfo2UnionScan,
+		memoryUsage:       v.memoryUsage,
+		matchOpts:         v.matchOpts,
+	}, true
+}
+
+// instancePlanCacheable returns whether the plan can be shared by the sessions. Only the plans made up of the
+// operators which can be cloned and bound to another session are supported.
+func instancePlanCacheable(p PhysicalPlan) bool {
+	switch x := p.(type) {
+	case *PhysicalTableReader:
+		return x.StoreType == kv.TiKV && instancePlanCacheable(x.tablePlan)
+	case *PhysicalIndexReader:
+		return instancePlanCacheable(x.indexPlan)
+	case *PhysicalIndexLookUpReader:
+		return instancePlanCacheable(x.indexPlan) && instancePlanCacheable(x.tablePlan)
+	case *PhysicalTableScan:
+		if x.SampleInfo != nil || !instanceCacheableTable(x.Table) {
+			return false
+		}
+	case *PhysicalIndexScan:
+		if !instanceCacheableTable(x.Table) {
+			return false
+		}
+	case *PhysicalSelection, *PhysicalProjection, *PhysicalLimit, *PhysicalTopN, *PhysicalSort,
+		*PhysicalHashAgg, *PhysicalStreamAgg, *PhysicalHashJoin, *PhysicalMergeJoin, *PhysicalUnionAll:
+	default:
+		return false
+	}
+	for _, child := range p.Children() {
+		if !instancePlanCacheable(child) {
+			return false
+		}
+	}
+	return true
+}
+
+// instanceCacheableTable returns false for the partitioned tables and the tables with virtual generated
+// columns, whose expressions are shared by the cloned plans.
+func instanceCacheableTable(tblInfo *model.TableInfo) bool {
+	if tblInfo.GetPartitionInfo() != nil {
+		return false
+	}
+	for _, col := range tblInfo.Columns {
+		if col.IsGenerated() && !col.GeneratedStored {
+			return false
+		}
+	}
+	return true
+}
+
+// setPlanCtx binds the cloned plan and its expressions to sctx.
+func setPlanCtx(sctx sessionctx.Context, p PhysicalPlan) {
+	switch x := p.(type) {
+	case *PhysicalTableReader:
+		setPlanCtx(sctx, x.tablePlan)
+	case *PhysicalIndexReader:
+		setPlanCtx(sctx, x.indexPlan)
+	case *PhysicalIndexLookUpReader:
+		setPlanCtx(sctx, x.indexPlan)
+		setPlanCtx(sctx, x.tablePlan)
+	case *PhysicalTableScan:
+		setExprsCtx(sctx, x.AccessCondition, x.filterCondition, x.lateMaterializationFilterCondition)
+		setByItemsCtx(sctx, x.ByItems)
+	case *PhysicalIndexScan:
+		setExprsCtx(sctx, x.AccessCondition)
+		setByItemsCtx(sctx, x.ByItems)
+		for _, expr := range x.GenExprs {
+			expression.SetExprCtx(sctx, expr)
+		}
+	case *PhysicalSelection:
+		setExprsCtx(sctx, x.Conditions)
+	case *PhysicalProjection:
+		setExprsCtx(sctx, x.Exprs)
+	case *PhysicalTopN:
+		setByItemsCtx(sctx, x.ByItems)
+	case *PhysicalSort:
+		setByItemsCtx(sctx, x.ByItems)
+	case *PhysicalHashAgg:
+		setAggCtx(sctx, &x.basePhysicalAgg)
+	case *PhysicalStreamAgg:
+		setAggCtx(sctx, &x.basePhysicalAgg)
+	case *PhysicalHashJoin:
+		setExprsCtx(sctx, x.LeftConditions, x.RightConditions, x.OtherConditions)
+		for _, cond := range x.EqualConditions {
+			expression.SetExprCtx(sctx, cond)
+		}
+		for _, cond := range x.NAEqualConditions {
+			expression.SetExprCtx(sctx, cond)
+		}
+	case *PhysicalMergeJoin:
+		setExprsCtx(sctx, x.LeftConditions, x.RightConditions, x.OtherConditions)
+	}
+	p.(interface{ SetSCtx(sessionctx.Context) }).SetSCtx(sctx)
+	for _, child := range p.Children() {
+		setPlanCtx(sctx, child)
+	}
+}
+
+func setExprsCtx(sctx sessionctx.Context, exprsList ...[]expression.Expression) {
+	for _, exprs := range exprsList {
+		for _, expr := range exprs {
+			expression.SetExprCtx(sctx, expr)
+		}
+	}
+}
+
+func setByItemsCtx(sctx sessionctx.Context, byItems []*util.ByItems) {
+	for _, item := range byItems {
+		expression.SetExprCtx(sctx, item.Expr)
+	}
+}
+
+func setAggCtx(sctx sessionctx.Context, agg *basePhysicalAgg) {
+	setExprsCtx(sctx, agg.GroupByItems)
+	for _, aggFunc := range agg.AggFuncs {
+		setExprsCtx(sctx, aggFunc.Args)
+		setByItemsCtx(sctx, aggFunc.OrderByItems)
+	}
+}
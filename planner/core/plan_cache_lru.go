@@ -19,6 +19,7 @@ import (
 	"github.com/pingcap/errors"
 	core_metrics "github.com/pingcap/tidb/planner/core/metrics"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/util/hack"
 	"github.com/pingcap/tidb/util/kvcache"
 	"github.com/pingcap/tidb/util/logutil"
@@ -252,6 +253,11 @@ func (l *LRUPlanCache) memoryControl() {
 
 // PickPlanFromBucket pick one plan from bucket
 func (l *LRUPlanCache) pickFromBucket(bucket map[*list.Element]struct{}, matchOpts *utilpc.PlanCacheMatchOpts) (*list.Element, bool) {
+	return pickPlanFromBucket(l.sctx.GetSessionVars(), bucket, matchOpts)
+}
+
+// pickPlanFromBucket picks one plan which matches matchOpts under the session variables from bucket.
+func pickPlanFromBucket(vars *variable.SessionVars, bucket map[*list.Element]struct{}, matchOpts *utilpc.PlanCacheMatchOpts) (*list.Element, bool) {
 	for k := range bucket {
 		plan := k.Value.(*planCacheEntry).PlanValue.(*PlanCacheValue)
 		// check param types' compatibility
@@ -265,17 +271,17 @@ func (l *LRUPlanCache) pickFromBucket(bucket map[*list.Element]struct{}, matchOp
 		if !ok2 {
 			continue
 		}
-		if len(plan.matchOpts.LimitOffsetAndCount) > 0 && !l.sctx.GetSessionVars().EnablePlanCacheForParamLimit {
+		if len(plan.matchOpts.LimitOffsetAndCount) > 0 && !vars.EnablePlanCacheForParamLimit {
 			// offset and key slice matched, but it is a plan with param limit and the switch is disabled
 			continue
 		}
 		// check subquery switch state
-		if plan.matchOpts.HasSubQuery && !l.sctx.GetSessionVars().EnablePlanCacheForSubquery {
+		if plan.matchOpts.HasSubQuery && !vars.EnablePlanCacheForSubquery {
 			continue
 		}
 		// table stats has changed
 		// this check can be disabled by turning off system variable tidb_plan_cache_invalidation_on_fresh_stats
-		if l.sctx.GetSessionVars().PlanCacheInvalidationOnFreshStats &&
+		if vars.PlanCacheInvalidationOnFreshStats &&
 			plan.matchOpts.StatsVersionHash != matchOpts.StatsVersionHash {
 			continue
 		}
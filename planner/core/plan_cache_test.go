@@ -2535,3 +2535,132 @@ func BenchmarkNonPreparedPlanCacheDML(b *testing.B) {
 		tk.MustExec("delete from t where a = 2")
 	}
 }
+
+func TestInstancePlanCache(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk1 := testkit.NewTestKit(t, store)
+	tk2 := testkit.NewTestKit(t, store)
+	tk1.MustExec("set global tidb_enable_instance_plan_cache = 1")
+	defer tk1.MustExec("set global tidb_enable_instance_plan_cache = default")
+	tk1.MustExec("use test")
+	tk2.MustExec("use test")
+	tk1.MustExec("create table t (a int, b int, c int, key(b))")
+	tk1.MustExec("create table s (a int, b int)")
+	tk1.MustExec("insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3), (4, 4, 4)")
+	tk1.MustExec("insert into s values (1, 10), (2, 20), (3, 30)")
+	instanceCache := dom.InstancePlanCache()
+
+	// the plan built by tk1 is reused by tk2
+	for _, stmt := range []string{
+		"select * from t where a > ? order by a",
+		"select b from t where b < ? order by b",
+		"select a, c from t use index(b) where b > ? order by a",
+		"select b, count(*), sum(a) from t where a > ? group by b order by b",
+		"select t.a, s.b from t join s on t.a = s.a where t.b > ? order by t.a",
+		"select a + ? from t order by a limit 2",
+	} {
+		instanceCache.DeleteAll()
+		tk1.MustExec(fmt.Sprintf("prepare st from '%s'", stmt))
+		tk2.MustExec(fmt.Sprintf("prepare st from '%s'", stmt))
+		tk1.MustExec("set @a = 1")
+		tk2.MustExec("set @a = 2")
+		res1 := tk1.MustQuery("execute st using @a").Rows()
+		tk1.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+		require.Equal(t, 1, instanceCache.Size(), stmt)
+		res2 := tk2.MustQuery("execute st using @a").Rows()
+		tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+		tk2.MustExec("set @a = 1")
+		tk2.MustQuery("execute st using @a").Check(res1)
+		tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+		tk1.MustExec("set @a = 2")
+		tk1.MustQuery("execute st using @a").Check(res2)
+		tk1.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+		require.Equal(t, 1, instanceCache.Size(), stmt)
+	}
+
+	// the non-prepared plan cache
+	instanceCache.DeleteAll()
+	tk1.MustExec("set tidb_enable_non_prepared_plan_cache = 1")
+	tk2.MustExec("set tidb_enable_non_prepared_plan_cache = 1")
+	tk1.MustQuery("select * from t where b < 3 order by a").Check(testkit.Rows("1 1 1", "2 2 2"))
+	tk2.MustQuery("select * from t where b < 4 order by a").Check(testkit.Rows("1 1 1", "2 2 2", "3 3 3"))
+	tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	// the plans which can't be cloned are only cached by the sessions
+	instanceCache.DeleteAll()
+	tk1.MustExec("create table p (a int, b int, key(a))")
+	tk1.MustExec("insert into p values (1, 10), (2, 20)")
+	tk1.MustExec("prepare st from 'select /*+ inl_join(p) */ t.a, p.b from t join p on t.a = p.a where t.b > ? order by t.a'")
+	tk2.MustExec("prepare st from 'select /*+ inl_join(p) */ t.a, p.b from t join p on t.a = p.a where t.b > ? order by t.a'")
+	tk1.MustExec("set @a = 1")
+	tk1.MustQuery("execute st using @a").Check(testkit.Rows("2 20"))
+	tk1.MustQuery("execute st using @a").Check(testkit.Rows("2 20"))
+	tk1.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk2.MustQuery("execute st using @a").Check(testkit.Rows("2 20"))
+	tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	require.Equal(t, 0, instanceCache.Size())
+
+	// the plan is not used if the table is modified in the transaction
+	tk1.MustExec("prepare st from 'select a from t where a < ? order by a'")
+	tk2.MustExec("prepare st from 'select a from t where a < ? order by a'")
+	tk1.MustExec("set @a = 3")
+	tk2.MustExec("set @a = 3")
+	tk1.MustQuery("execute st using @a").Check(testkit.Rows("1", "2"))
+	tk2.MustExec("begin")
+	tk2.MustExec("delete from t where a = 1")
+	tk2.MustQuery("execute st using @a").Check(testkit.Rows("2"))
+	tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk2.MustExec("rollback")
+	tk1.MustQuery("execute st using @a").Check(testkit.Rows("1", "2"))
+	tk1.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	// memory limit and flush
+	require.Greater(t, instanceCache.MemoryUsage(), int64(0))
+	tk1.MustExec("admin flush instance plan_cache")
+	require.Equal(t, 0, instanceCache.Size())
+	require.Equal(t, int64(0), instanceCache.MemoryUsage())
+	tk1.MustExec("set global tidb_instance_plan_cache_max_mem_size = 1")
+	defer tk1.MustExec("set global tidb_instance_plan_cache_max_mem_size = default")
+	tk1.MustQuery("execute st using @a").Check(testkit.Rows("1", "2"))
+	require.Equal(t, 0, instanceCache.Size())
+	tk1.MustQuery("execute st using @a").Check(testkit.Rows("1", "2"))
+	tk1.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+}
+
+func TestInstancePlanCacheConcurrency(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set global tidb_enable_instance_plan_cache = 1")
+	defer tk.MustExec("set global tidb_enable_instance_plan_cache = default")
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, key(b))")
+	for i := 0; i < 50; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t values (%v, %v)", i, i))
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tk := testkit.NewTestKit(t, store)
+			tk.MustExec("use test")
+			tk.MustExec("prepare st from 'select count(*), sum(a) from t where b >= ? and b < ? and a + 1 > ?'")
+			for j := 0; j < 50; j++ {
+				l, r := rand.Intn(50), rand.Intn(50)
+				tk.MustExec(fmt.Sprintf("set @l = %v, @r = %v, @z = 0", l, r))
+				cnt, sum := 0, 0
+				for k := l; k < r; k++ {
+					cnt++
+					sum += k
+				}
+				if cnt == 0 {
+					tk.MustQuery("execute st using @l, @r, @z").Check(testkit.Rows("0 <nil>"))
+				} else {
+					tk.MustQuery("execute st using @l, @r, @z").Check(testkit.Rows(fmt.Sprintf("%v %v", cnt, sum)))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
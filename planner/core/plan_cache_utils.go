@@ -218,6 +218,10 @@ type planCacheKey struct {
 	restrictedReadOnly       bool
 	TiDBSuperReadOnly        bool
 	ExprBlacklistTS          int64 // expr-pushdown-blacklist can affect query optimization, so we need to consider it in plan cache.
+	// materializedViewRewrite decides whether the query can be rewritten to read from a materialized view.
+	materializedViewRewrite bool
+	// indexJoinAdaptive decides whether index joins are planned with a fallback to hash join.
+	indexJoinAdaptive bool
 
 	memoryUsage int64 // Do not include in hash
 	hash        []byte
@@ -251,6 +255,8 @@ func (key *planCacheKey) Hash() []byte {
 		key.hash = append(key.hash, hack.Slice(strconv.FormatBool(key.restrictedReadOnly))...)
 		key.hash = append(key.hash, hack.Slice(strconv.FormatBool(key.TiDBSuperReadOnly))...)
 		key.hash = codec.EncodeInt(key.hash, key.ExprBlacklistTS)
+		key.hash = append(key.hash, hack.Slice(strconv.FormatBool(key.materializedViewRewrite))...)
+		key.hash = append(key.hash, hack.Slice(strconv.FormatBool(key.indexJoinAdaptive))...)
 	}
 	return key.hash
 }
@@ -321,6 +327,8 @@ func NewPlanCacheKey(sessionVars *variable.SessionVars, stmtText, stmtDB string,
 		restrictedReadOnly:       variable.RestrictedReadOnly.Load(),
 		TiDBSuperReadOnly:        variable.VarTiDBSuperReadOnly.Load(),
 		ExprBlacklistTS:          exprBlacklistTS,
+		materializedViewRewrite:  sessionVars.EnableMaterializedViewRewrite,
+		indexJoinAdaptive:        sessionVars.IndexJoinAdaptiveThreshold > 0,
 	}
 	for k, v := range sessionVars.IsolationReadEngines {
 		key.isolationReadEngines[k] = v
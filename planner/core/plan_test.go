@@ -1012,6 +1012,38 @@ func TestHypoIndexPlan(t *testing.T) {
 		`Point_Get_5 1.00 root table:t, index:hypo_a(a) `))
 }
 
+func TestHypoIndexStats(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec(`create table t (a int, b int, c int)`)
+	for i := 0; i < 100; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t values (%v, %v, %v)", i%10, i, i))
+	}
+	tk.MustExec(`analyze table t`)
+
+	tk.MustExec(`create index hypo_a type hypo on t (a)`)
+	tk.MustExec(`create index hypo_ab type hypo on t (a, b)`)
+
+	// the estimation of the hypo-indexes is derived from the column stats
+	tk.MustQuery(`explain format='brief' select /*+ use_index(t, hypo_a) */ a from t where a = 1`).Check(testkit.Rows(
+		`IndexReader 10.00 root  index:IndexRangeScan`,
+		`└─IndexRangeScan 10.00 cop[tikv] table:t, index:hypo_a(a) range:[1,1], keep order:false`))
+	tk.MustQuery(`explain format='brief' select a, b from t where a = 1 and b < 30`).Check(testkit.Rows(
+		`IndexReader 3.00 root  index:IndexRangeScan`,
+		`└─IndexRangeScan 3.00 cop[tikv] table:t, index:hypo_ab(a, b) range:[1 -inf,1 30), keep order:false`))
+
+	// the hypo-indexes are not used if they can't help
+	tk.MustQuery(`explain format='brief' select * from t where a > 0`).Check(testkit.Rows(
+		`TableReader 90.00 root  data:Selection`,
+		`└─Selection 90.00 cop[tikv]  gt(test.t.a, 0)`,
+		`  └─TableFullScan 100.00 cop[tikv] table:t keep order:false`))
+
+	// the hypo-indexes are not considered in EXPLAIN ANALYZE, they are never built
+	tk.MustQuery(`explain analyze format='brief' select a from t where a = 1`).CheckAt([]int{0, 2}, testkit.RowsWithSep("|",
+		`TableReader|10`, `└─Selection|10`, `  └─TableFullScan|100`))
+}
+
 func TestHypoTiFlashReplica(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
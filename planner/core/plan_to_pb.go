@@ -475,13 +475,18 @@ func (e *PhysicalExchangeReceiver) ToPB(ctx sessionctx.Context, _ kv.StoreType)
 func (p *PhysicalIndexScan) ToPB(_ sessionctx.Context, _ kv.StoreType) (*tipb.Executor, error) {
 	columns := make([]*model.ColumnInfo, 0, p.schema.Len())
 	tableColumns := p.Table.Cols()
-	for _, col := range p.schema.Columns {
+	for i, col := range p.schema.Columns {
 		if col.ID == model.ExtraHandleID {
 			columns = append(columns, model.NewExtraHandleColInfo())
 		} else if col.ID == model.ExtraPhysTblID {
 			columns = append(columns, model.NewExtraPhysTblIDColInfo())
 		} else if col.ID == model.ExtraPidColID {
 			columns = append(columns, model.NewExtraPartitionIDColInfo())
+		} else if p.Index.FullText && i < len(p.Index.Columns) {
+			// The FULLTEXT index stores the tokens as binary strings instead of the column values.
+			colInfo := FindColumnInfoByID(tableColumns, col.ID).Clone()
+			colInfo.FieldType = *fullTextTokenFieldType()
+			columns = append(columns, colInfo)
 		} else {
 			columns = append(columns, FindColumnInfoByID(tableColumns, col.ID))
 		}
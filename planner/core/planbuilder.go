@@ -486,6 +486,10 @@ type cteInfo struct {
 	enterSubquery bool
 	recursiveRef  bool
 	limitLP       LogicalPlan
+	// orderByItems is the ORDER BY over UNION of a recursive CTE, it's resolved by seedLP's schema.
+	// It's applied on each reference of the CTE together with sortedLimit, instead of being pushed into the CTE like limitLP.
+	orderByItems []*util.ByItems
+	sortedLimit  *ast.Limit
 	// seedStat is shared between logicalCTE and logicalCTETable.
 	seedStat *property.StatsInfo
 	// The LogicalCTEs that reference the same table should share the same CteClass.
@@ -842,6 +846,8 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (Plan, error) {
 		return b.buildUnlockStats(x), nil
 	case *ast.IndexAdviseStmt:
 		return b.buildIndexAdvise(x), nil
+	case *ast.AdviseIndexStmt:
+		return b.buildAdviseIndex(x), nil
 	case *ast.PlanReplayerStmt:
 		return b.buildPlanReplayer(x), nil
 	case *ast.PrepareStmt:
@@ -870,8 +876,18 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (Plan, error) {
 		*ast.GrantStmt, *ast.DropUserStmt, *ast.AlterUserStmt, *ast.RevokeStmt, *ast.KillStmt, *ast.DropStatsStmt,
 		*ast.GrantRoleStmt, *ast.RevokeRoleStmt, *ast.SetRoleStmt, *ast.SetDefaultRoleStmt, *ast.ShutdownStmt,
 		*ast.RenameUserStmt, *ast.NonTransactionalDMLStmt, *ast.SetSessionStatesStmt, *ast.SetResourceGroupStmt,
-		*ast.LoadDataActionStmt, *ast.ImportIntoActionStmt, *ast.CalibrateResourceStmt, *ast.AddQueryWatchStmt, *ast.DropQueryWatchStmt:
+		*ast.LoadDataActionStmt, *ast.ImportIntoActionStmt, *ast.CalibrateResourceStmt, *ast.AddQueryWatchStmt, *ast.DropQueryWatchStmt, *ast.XAStmt:
 		return b.buildSimple(ctx, node.(ast.StmtNode))
+	case *ast.AlterTableStmt:
+		if len(x.Specs) == 1 {
+			switch x.Specs[0].Tp {
+			case ast.AlterTableCheckPartitions:
+				return b.buildCheckPartitions(ctx, x)
+			case ast.AlterTableOptimizePartition:
+				return b.buildOptimizePartitions(x)
+			}
+		}
+		return b.buildDDL(ctx, x)
 	case ast.DDLNode:
 		return b.buildDDL(ctx, x)
 	case *ast.CreateBindingStmt:
@@ -1406,6 +1422,10 @@ func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, i
 			if tblInfo.IsCommonHandle && index.Primary {
 				continue
 			}
+			// FULLTEXT indexes are only accessed by the IndexMerge paths of MATCH ... AGAINST.
+			if index.FullText {
+				continue
+			}
 			if check && latestIndexes == nil {
 				latestIndexes, check, err = getLatestIndexInfo(ctx, tblInfo.ID, 0)
 				if err != nil {
@@ -1421,9 +1441,9 @@ func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, i
 		}
 	}
 
-	// consider hypo-indexes
+	// consider hypo-indexes, they can't be used by EXPLAIN ANALYZE since they are never built
 	hypoIndexes := ctx.GetSessionVars().HypoIndexes
-	if ctx.GetSessionVars().StmtCtx.InExplainStmt && hypoIndexes != nil {
+	if ctx.GetSessionVars().StmtCtx.InExplainStmt && !ctx.GetSessionVars().StmtCtx.InExplainAnalyzeStmt && hypoIndexes != nil {
 		originalTableName := tblInfo.Name.L
 		if hypoIndexes[dbName.L] != nil && hypoIndexes[dbName.L][originalTableName] != nil {
 			for _, index := range hypoIndexes[dbName.L][originalTableName] {
@@ -1479,6 +1499,9 @@ func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, i
 		for _, idxName := range hint.IndexNames {
 			path := getPathByIndexName(publicPaths, idxName, tblInfo)
 			if path == nil {
+				if idx := tblInfo.FindIndexByName(idxName.L); idx != nil && idx.FullText {
+					continue
+				}
 				err := ErrKeyDoesNotExist.GenWithStackByArgs(idxName, tblInfo.Name)
 				// if hint is from comment-style sql hints, we should throw a warning instead of error.
 				if i < indexHintsLen {
@@ -1772,6 +1795,8 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (Plan,
 		return &Simple{Statement: as}, nil
 	case ast.AdminFlushPlanCache:
 		return &Simple{Statement: as}, nil
+	case ast.AdminEnableCardinalityFeedback, ast.AdminDisableCardinalityFeedback, ast.AdminResetCardinalityFeedback:
+		ret = &Simple{Statement: as}
 	default:
 		return nil, ErrUnsupportedType.GenWithStack("Unsupported ast.AdminStmt(%T) for buildAdmin", as)
 	}
@@ -1938,7 +1963,7 @@ func tryGetPkHandleCol(tblInfo *model.TableInfo, allColSchema *expression.Schema
 	return nil, nil, false
 }
 
-func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbName model.CIStr, tbl table.Table, indices []table.Index) ([]Plan, []*model.IndexInfo, error) {
+func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbName model.CIStr, tbl table.Table, indices []table.Index, partitions []model.PartitionDefinition) ([]Plan, []*model.IndexInfo, error) {
 	tblInfo := tbl.Meta()
 	// get index information
 	indexInfos := make([]*model.IndexInfo, 0, len(tblInfo.Indices))
@@ -1955,6 +1980,10 @@ func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbNam
 			// Skip checking clustered index.
 			continue
 		}
+		if idxInfo.FullText {
+			// Skip checking FULLTEXT index, whose entries are the tokens rather than the column values.
+			continue
+		}
 		if idxInfo.State != model.StatePublic {
 			logutil.Logger(ctx).Info("build physical index lookup reader, the index isn't public",
 				zap.String("index", idxInfo.Name.O),
@@ -1985,7 +2014,10 @@ func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbNam
 		indexInfos = append(indexInfos, idxInfo)
 		// For partition tables.
 		if pi := tbl.Meta().GetPartitionInfo(); pi != nil {
-			for _, def := range pi.Definitions {
+			if partitions == nil {
+				partitions = pi.Definitions
+			}
+			for _, def := range partitions {
 				t := tbl.(table.PartitionedTable).GetPartition(def.ID)
 				reader, err := b.buildPhysicalIndexLookUpReader(ctx, dbName, t, idxInfo)
 				if err != nil {
@@ -2019,8 +2051,6 @@ func (b *PlanBuilder) buildAdminCheckTable(ctx context.Context, as *ast.AdminStm
 		DBName: tblName.Schema.O,
 		Table:  tbl,
 	}
-	var readerPlans []Plan
-	var indexInfos []*model.IndexInfo
 	var err error
 	if as.Tp == ast.AdminCheckIndex {
 		// get index information
@@ -2038,21 +2068,80 @@ func (b *PlanBuilder) buildAdminCheckTable(ctx context.Context, as *ast.AdminStm
 		if idx.Meta().State != model.StatePublic {
 			return nil, errors.Errorf("index %s state %s isn't public", as.Index, idx.Meta().State)
 		}
+		if idx.Meta().FullText {
+			return nil, errors.Errorf("admin check index is not supported on FULLTEXT index %s", as.Index)
+		}
 		p.CheckIndex = true
-		readerPlans, indexInfos, err = b.buildPhysicalIndexLookUpReaders(ctx, tblName.Schema, tbl, []table.Index{idx})
+		err = b.buildCheckTableReaders(ctx, p, tblName.Schema, []table.Index{idx})
 	} else {
-		readerPlans, indexInfos, err = b.buildPhysicalIndexLookUpReaders(ctx, tblName.Schema, tbl, tbl.Indices())
+		err = b.buildCheckTableReaders(ctx, p, tblName.Schema, tbl.Indices())
 	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	return p, nil
+}
+
+// buildCheckPartitions builds a plan for the "ALTER TABLE t CHECK PARTITION ..." statement,
+// which runs the checks of "ADMIN CHECK TABLE" on the given partitions only.
+func (b *PlanBuilder) buildCheckPartitions(ctx context.Context, node *ast.AlterTableStmt) (Plan, error) {
+	var authErr error
+	if b.ctx.GetSessionVars().User != nil {
+		authErr = ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+			b.ctx.GetSessionVars().User.AuthHostname, node.Table.Name.L)
+	}
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, node.Table.Schema.L,
+		node.Table.Name.L, "", authErr)
+
+	tableInfo := node.Table.TableInfo
+	pi := tableInfo.GetPartitionInfo()
+	if pi == nil {
+		return nil, errors.Trace(dbterror.ErrPartitionMgmtOnNonpartitioned)
+	}
+	tbl, ok := b.is.TableByID(tableInfo.ID)
+	if !ok {
+		return nil, infoschema.ErrTableNotExists.GenWithStackByArgs(node.Table.Schema.O, tableInfo.Name.O)
+	}
+	p := &CheckTable{
+		DBName: node.Table.Schema.O,
+		Table:  tbl,
+	}
+	if spec := node.Specs[0]; !spec.OnAllPartitions {
+		p.Partitions = make([]model.PartitionDefinition, 0, len(spec.PartitionNames))
+		checked := make(map[int]struct{}, len(spec.PartitionNames))
+		for _, name := range spec.PartitionNames {
+			// The name of a partition of a subpartitioned table selects all its subpartitions.
+			offsets := pi.FindPartitionDefinitionsByName(name.L)
+			if len(offsets) == 0 {
+				return nil, errors.Trace(table.ErrUnknownPartition.GenWithStackByArgs(name.O, tableInfo.Name.O))
+			}
+			for _, offset := range offsets {
+				if _, ok := checked[offset]; !ok {
+					checked[offset] = struct{}{}
+					p.Partitions = append(p.Partitions, pi.Definitions[offset])
+				}
+			}
+		}
+	}
+	if err := b.buildCheckTableReaders(ctx, p, node.Table.Schema, tbl.Indices()); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return p, nil
+}
+
+// buildCheckTableReaders builds the index lookup readers of the check table plan for the given indices.
+func (b *PlanBuilder) buildCheckTableReaders(ctx context.Context, p *CheckTable, dbName model.CIStr, indices []table.Index) error {
+	readerPlans, indexInfos, err := b.buildPhysicalIndexLookUpReaders(ctx, dbName, p.Table, indices, p.Partitions)
+	if err != nil {
+		return err
+	}
 	readers := make([]*PhysicalIndexLookUpReader, 0, len(readerPlans))
 	for _, plan := range readerPlans {
 		readers = append(readers, plan.(*PhysicalIndexLookUpReader))
 	}
 	p.IndexInfos = indexInfos
 	p.IndexLookUpReaders = readers
-	return p, nil
+	return nil
 }
 
 func (b *PlanBuilder) buildCheckIndexSchema(tn *ast.TableName, indexName string) (*expression.Schema, types.NameSlice, error) {
@@ -2420,6 +2509,10 @@ func getModifiedIndexesInfoForAnalyze(sctx sessionctx.Context, tblInfo *model.Ta
 			sctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", originIdx.Name.L))
 			continue
 		}
+		if originIdx.FullText {
+			sctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing FULLTEXT indexes is not supported, skip %s", originIdx.Name.L))
+			continue
+		}
 		if allColumns {
 			// If all the columns need to be analyzed, we don't need to modify IndexColumn.Offset.
 			idxsInfo = append(idxsInfo, originIdx)
@@ -2760,6 +2853,10 @@ func (b *PlanBuilder) buildAnalyzeTable(as *ast.AnalyzeTableStmt, opts map[ast.A
 				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", idx.Name.L))
 				continue
 			}
+			if idx.FullText {
+				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing FULLTEXT indexes is not supported, skip %s", idx.Name.L))
+				continue
+			}
 			for i, id := range physicalIDs {
 				if id == tbl.TableInfo.ID {
 					id = -1
@@ -2857,6 +2954,10 @@ func (b *PlanBuilder) buildAnalyzeIndex(as *ast.AnalyzeTableStmt, opts map[ast.A
 			b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", idx.Name.L))
 			continue
 		}
+		if idx.FullText {
+			b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing FULLTEXT indexes is not supported, skip %s", idx.Name.L))
+			continue
+		}
 		for i, id := range physicalIDs {
 			if id == tblInfo.ID {
 				id = -1
@@ -2903,6 +3004,10 @@ func (b *PlanBuilder) buildAnalyzeAllIndex(as *ast.AnalyzeTableStmt, opts map[as
 				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", idx.Name.L))
 				continue
 			}
+			if idx.FullText {
+				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing FULLTEXT indexes is not supported, skip %s", idx.Name.L))
+				continue
+			}
 
 			for i, id := range physicalIDs {
 				if id == tblInfo.ID {
@@ -3133,6 +3238,18 @@ func buildShowDDLFields() (*expression.Schema, types.NameSlice) {
 	return schema.col2Schema(), schema.names
 }
 
+func buildAdviseIndexFields() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(7)
+	schema.Append(buildColumnWithName("", "DB_NAME", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "TABLE_NAME", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "INDEX_NAME", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "INDEX_COLUMNS", mysql.TypeVarchar, 256))
+	schema.Append(buildColumnWithName("", "EST_BENEFIT", mysql.TypeDouble, 22))
+	schema.Append(buildColumnWithName("", "AFFECTED_DIGESTS", mysql.TypeVarchar, 1024))
+	schema.Append(buildColumnWithName("", "CREATE_STATEMENT", mysql.TypeVarchar, 1024))
+	return schema.col2Schema(), schema.names
+}
+
 func buildRecoverIndexFields() (*expression.Schema, types.NameSlice) {
 	schema := newColumnsWithNames(2)
 	schema.Append(buildColumnWithName("", "ADDED_COUNT", mysql.TypeLonglong, 4))
@@ -3303,6 +3420,16 @@ func buildCalibrateResourceSchema() (*expression.Schema, types.NameSlice) {
 	return schema.col2Schema(), schema.names
 }
 
+func buildXARecoverSchema() (*expression.Schema, types.NameSlice) {
+	longlongSize, _ := mysql.GetDefaultFieldLengthAndDecimal(mysql.TypeLonglong)
+	schema := newColumnsWithNames(4)
+	schema.Append(buildColumnWithName("", "formatID", mysql.TypeLonglong, longlongSize))
+	schema.Append(buildColumnWithName("", "gtrid_length", mysql.TypeLonglong, longlongSize))
+	schema.Append(buildColumnWithName("", "bqual_length", mysql.TypeLonglong, longlongSize))
+	schema.Append(buildColumnWithName("", "data", mysql.TypeVarchar, 2*128+2))
+	return schema.col2Schema(), schema.names
+}
+
 func buildShowTelemetrySchema() (*expression.Schema, types.NameSlice) {
 	schema := newColumnsWithNames(1)
 	schema.Append(buildColumnWithName("", "TRACKING_ID", mysql.TypeVarchar, 64))
@@ -3379,6 +3506,7 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (Plan,
 			CountWarningsOrErrors: show.CountWarningsOrErrors,
 			DBName:                show.DBName,
 			Table:                 show.Table,
+			Procedure:             show.Procedure,
 			Partition:             show.Partition,
 			Column:                show.Column,
 			IndexName:             show.IndexName,
@@ -3400,10 +3528,25 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (Plan,
 	buildPattern := true
 
 	switch show.Tp {
-	case ast.ShowDatabases, ast.ShowVariables, ast.ShowTables, ast.ShowColumns, ast.ShowTableStatus, ast.ShowCollation:
-		if (show.Tp == ast.ShowTables || show.Tp == ast.ShowTableStatus) && p.DBName == "" {
+	case ast.ShowDatabases, ast.ShowVariables, ast.ShowTables, ast.ShowColumns, ast.ShowTableStatus, ast.ShowCollation, ast.ShowTriggers:
+		if (show.Tp == ast.ShowTables || show.Tp == ast.ShowTableStatus || show.Tp == ast.ShowTriggers) && p.DBName == "" {
+			return nil, ErrNoDB
+		}
+		if extractor := newShowBaseExtractor(*show); extractor.Extract() {
+			p.Extractor = extractor
+			buildPattern = false
+		}
+	case ast.ShowProcedureStatus, ast.ShowFunctionStatus:
+		// The LIKE pattern matches the routine names rather than the first column.
+		if extractor := newShowBaseExtractor(*show); extractor.Extract() {
+			p.Extractor = extractor
+			buildPattern = false
+		}
+	case ast.ShowEvents:
+		if p.DBName == "" {
 			return nil, ErrNoDB
 		}
+		// The LIKE pattern matches the event names rather than the first column.
 		if extractor := newShowBaseExtractor(*show); extractor.Extract() {
 			p.Extractor = extractor
 			buildPattern = false
@@ -3573,6 +3716,12 @@ func (b *PlanBuilder) buildSimple(ctx context.Context, node ast.StmtNode) (Plan,
 	case *ast.AddQueryWatchStmt:
 		err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or RESOURCE_GROUP_ADMIN")
 		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "RESOURCE_GROUP_ADMIN", false, err)
+	case *ast.XAStmt:
+		if raw.Tp == ast.XARecover {
+			err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or XA_RECOVER_ADMIN")
+			b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "XA_RECOVER_ADMIN", false, err)
+			p.setSchemaAndNames(buildXARecoverSchema())
+		}
 	case *ast.DropQueryWatchStmt:
 		err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or RESOURCE_GROUP_ADMIN")
 		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "RESOURCE_GROUP_ADMIN", false, err)
@@ -3864,11 +4013,7 @@ func (b *PlanBuilder) buildInsert(ctx context.Context, insert *ast.InsertStmt) (
 	}
 	tableInfo := tn.TableInfo
 	if tableInfo.IsView() {
-		err := errors.Errorf("insert into view %s is not supported now", tableInfo.Name.O)
-		if insert.IsReplace {
-			err = errors.Errorf("replace into view %s is not supported now", tableInfo.Name.O)
-		}
-		return nil, err
+		return b.buildInsertOnView(ctx, insert, tn)
 	}
 	if tableInfo.IsSequence() {
 		err := errors.Errorf("insert into sequence %s is not supported now", tableInfo.Name.O)
@@ -3877,6 +4022,12 @@ func (b *PlanBuilder) buildInsert(ctx context.Context, insert *ast.InsertStmt) (
 		}
 		return nil, err
 	}
+	if b.isNonUpdatableMaterializedView(tableInfo) {
+		if insert.IsReplace {
+			return nil, ErrNonUpdatableTable.GenWithStackByArgs(tableInfo.Name.O, "REPLACE")
+		}
+		return nil, ErrNonUpdatableTable.GenWithStackByArgs(tableInfo.Name.O, "INSERT")
+	}
 	// Build Schema with DBName otherwise ColumnRef with DBName cannot match any Column in Schema.
 	schema, names, err := expression.TableInfo2SchemaAndNames(b.ctx, tn.Schema, tableInfo)
 	if err != nil {
@@ -3899,11 +4050,13 @@ func (b *PlanBuilder) buildInsert(ctx context.Context, insert *ast.InsertStmt) (
 		givenPartitionSets := make(map[int64]struct{}, len(insert.PartitionNames))
 		// check partition by name.
 		for _, name := range insert.PartitionNames {
-			id, err := tables.FindPartitionByName(tableInfo, name.L)
+			ids, err := tables.FindPartitionIDsByName(tableInfo, name.L)
 			if err != nil {
 				return nil, err
 			}
-			givenPartitionSets[id] = struct{}{}
+			for _, id := range ids {
+				givenPartitionSets[id] = struct{}{}
+			}
 		}
 		pt := tableInPlan.(table.PartitionedTable)
 		insertPlan.Table = tables.NewPartitionTableWithGivenSets(pt, givenPartitionSets)
@@ -4494,6 +4647,15 @@ func (*PlanBuilder) buildIndexAdvise(node *ast.IndexAdviseStmt) Plan {
 	return p
 }
 
+func (b *PlanBuilder) buildAdviseIndex(node *ast.AdviseIndexStmt) Plan {
+	p := &AdviseIndex{Limit: node.Limit}
+	// The workload is read from the statements summary of all users.
+	err := ErrSpecificAccessDenied.GenWithStackByArgs("PROCESS")
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.ProcessPriv, "", "", "", err)
+	p.setSchemaAndNames(buildAdviseIndexFields())
+	return p
+}
+
 func (b *PlanBuilder) buildSplitRegion(node *ast.SplitRegionStmt) (Plan, error) {
 	if node.Table.TableInfo.TempTableType != model.TempTableNone {
 		return nil, ErrOptOnTemporaryTable.GenWithStackByArgs("split table")
@@ -4726,6 +4888,32 @@ func convertValueListToData(valueList []ast.ExprNode, handleColInfos []*model.Co
 	return data, nil
 }
 
+// appendEventVisitInfo requires the EVENT privilege on the schema of the event.
+func (b *PlanBuilder) appendEventVisitInfo(schema model.CIStr) {
+	var authErr error
+	if user := b.ctx.GetSessionVars().User; user != nil {
+		authErr = ErrDBaccessDenied.GenWithStackByArgs(user.AuthUsername, user.AuthHostname, schema.L)
+	}
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.EventPriv, schema.L, "", "", authErr)
+}
+
+// resolveEventDefiner resolves the CURRENT_USER definer of the event, and requires the SUPER privilege
+// if the definer isn't the current user.
+func (b *PlanBuilder) resolveEventDefiner(definer *auth.UserIdentity) *auth.UserIdentity {
+	user := b.ctx.GetSessionVars().User
+	if user == nil {
+		return definer
+	}
+	if definer.CurrentUser {
+		definer = user
+	}
+	if definer.String() != user.String() {
+		err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER")
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", err)
+	}
+	return definer
+}
+
 func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, error) {
 	var authErr error
 	switch v := node.(type) {
@@ -4902,6 +5090,15 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 		if len(v.Cols) != schema.Len() {
 			return nil, dbterror.ErrViewWrongList
 		}
+		if v.CheckOption != model.CheckOptionNone {
+			updatable, err := newViewMerger(b.ctx, b.is).isUpdatableSelect(v.ViewName.Schema, v.Select)
+			if err != nil {
+				return nil, err
+			}
+			if !updatable || v.Algorithm == model.AlgorithmTemptable {
+				return nil, ErrViewNonUpdatableCheck.GenWithStackByArgs(v.ViewName.Schema.O, v.ViewName.Name.O)
+			}
+		}
 		if user := b.ctx.GetSessionVars().User; user != nil {
 			authErr = ErrTableaccessDenied.GenWithStackByArgs("CREATE VIEW", user.AuthUsername,
 				user.AuthHostname, v.ViewName.Name.L)
@@ -4916,6 +5113,107 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "",
 				"", "", err)
 		}
+	case *ast.CreateMaterializedViewStmt:
+		b.isCreateView = true
+		b.capFlag |= canExpandAST
+		defer func() {
+			b.capFlag &= ^canExpandAST
+			b.isCreateView = false
+		}()
+
+		if stmt := findStmtAsViewSchema(v.Select); stmt != nil {
+			stmt.AsViewSchema = true
+		}
+
+		plan, err := b.Build(ctx, v.Select)
+		if err != nil {
+			return nil, err
+		}
+		schema := plan.Schema()
+		names := plan.OutputNames()
+		if v.Cols == nil {
+			adjustOverlongViewColname(plan.(LogicalPlan))
+			v.Cols = make([]model.CIStr, len(schema.Columns))
+			for i, name := range names {
+				v.Cols[i] = name.ColName
+			}
+		}
+		if len(v.Cols) != schema.Len() {
+			return nil, dbterror.ErrViewWrongList
+		}
+		v.ColTypes = make([]*types.FieldType, 0, schema.Len())
+		for _, col := range schema.Columns {
+			v.ColTypes = append(v.ColTypes, col.RetType.Clone())
+		}
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("CREATE", user.AuthUsername,
+				user.AuthHostname, v.ViewName.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreatePriv, v.ViewName.Schema.L,
+			v.ViewName.Name.L, "", authErr)
+	case *ast.RefreshMaterializedViewStmt:
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("ALTER", user.AuthUsername,
+				user.AuthHostname, v.ViewName.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.ViewName.Schema.L,
+			v.ViewName.Name.L, "", authErr)
+	case *ast.CreateTriggerStmt:
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("TRIGGER", user.AuthUsername,
+				user.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.TriggerPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+		if v.Definer.CurrentUser && b.ctx.GetSessionVars().User != nil {
+			v.Definer = b.ctx.GetSessionVars().User
+		}
+		if b.ctx.GetSessionVars().User != nil && v.Definer.String() != b.ctx.GetSessionVars().User.String() {
+			err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER")
+			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "",
+				"", "", err)
+		}
+	case *ast.DropTriggerStmt:
+		// The privilege is checked on the table of the trigger.
+		tblName := ""
+		for _, tbl := range b.is.SchemaTables(v.TriggerName.Schema) {
+			for _, trigger := range tbl.Meta().Triggers {
+				if trigger.Name.L == v.TriggerName.Name.L {
+					tblName = tbl.Meta().Name.L
+				}
+			}
+		}
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("TRIGGER", user.AuthUsername,
+				user.AuthHostname, tblName)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.TriggerPriv, v.TriggerName.Schema.L,
+			tblName, "", authErr)
+	case *ast.ProcedureInfo:
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrDBaccessDenied.GenWithStackByArgs(user.AuthUsername, user.AuthHostname, v.ProcedureName.Schema.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreateRoutinePriv, v.ProcedureName.Schema.L,
+			"", "", authErr)
+	case *ast.DropProcedureStmt:
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrDBaccessDenied.GenWithStackByArgs(user.AuthUsername, user.AuthHostname, v.ProcedureName.Schema.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterRoutinePriv, v.ProcedureName.Schema.L,
+			"", "", authErr)
+	case *ast.CreateEventStmt:
+		b.appendEventVisitInfo(v.EventName.Schema)
+		v.Definer = b.resolveEventDefiner(v.Definer)
+	case *ast.AlterEventStmt:
+		b.appendEventVisitInfo(v.EventName.Schema)
+		if v.NewName != nil && v.NewName.Schema.L != v.EventName.Schema.L {
+			b.appendEventVisitInfo(v.NewName.Schema)
+		}
+		if v.Definer != nil {
+			v.Definer = b.resolveEventDefiner(v.Definer)
+		}
+	case *ast.DropEventStmt:
+		b.appendEventVisitInfo(v.EventName.Schema)
 	case *ast.CreateSequenceStmt:
 		if b.ctx.GetSessionVars().User != nil {
 			authErr = ErrTableaccessDenied.GenWithStackByArgs("CREATE", b.ctx.GetSessionVars().User.AuthUsername,
@@ -5345,6 +5643,10 @@ func buildShowSchema(s *ast.ShowStmt, isView bool, isSequence bool) (schema *exp
 		}
 	case ast.ShowCreateView:
 		names = []string{"View", "Create View", "character_set_client", "collation_connection"}
+	case ast.ShowCreateProcedure:
+		names = []string{"Procedure", "sql_mode", "Create Procedure", "character_set_client", "collation_connection", "Database Collation"}
+	case ast.ShowCreateFunction:
+		names = []string{"Function", "sql_mode", "Create Function", "character_set_client", "collation_connection", "Database Collation"}
 	case ast.ShowCreateDatabase:
 		names = []string{"Database", "Create Database"}
 	case ast.ShowDrainerStatus:
@@ -5587,6 +5889,23 @@ func (b *PlanBuilder) buildCompactTable(node *ast.CompactTableStmt) (Plan, error
 	return p, nil
 }
 
+// buildOptimizePartitions builds a plan for the "ALTER TABLE t OPTIMIZE PARTITION ..." statement,
+// which compacts the TiFlash replicas of the given partitions like "ALTER TABLE t COMPACT PARTITION ...".
+func (b *PlanBuilder) buildOptimizePartitions(node *ast.AlterTableStmt) (Plan, error) {
+	tblInfo := node.Table.TableInfo
+	if tblInfo.GetPartitionInfo() == nil {
+		return nil, errors.Trace(dbterror.ErrPartitionMgmtOnNonpartitioned)
+	}
+	compact := &ast.CompactTableStmt{
+		Table:       node.Table,
+		ReplicaKind: ast.CompactReplicaKindTiFlash,
+	}
+	if spec := node.Specs[0]; !spec.OnAllPartitions {
+		compact.PartitionNames = spec.PartitionNames
+	}
+	return b.buildCompactTable(compact)
+}
+
 func extractPatternLikeOrIlikeName(patternLike *ast.PatternLikeOrIlikeExpr) string {
 	if patternLike == nil {
 		return ""
@@ -628,7 +628,10 @@ func newBatchPointGetPlan(
 	stmtCtx := ctx.GetSessionVars().StmtCtx
 	statsInfo := &property.StatsInfo{RowCount: float64(len(patternInExpr.List))}
 	var partitionExpr *tables.PartitionExpr
-	if tbl.GetPartitionInfo() != nil {
+	if pi := tbl.GetPartitionInfo(); pi != nil {
+		if pi.Sub != nil {
+			return nil
+		}
 		partitionExpr = getPartitionExpr(ctx, tbl)
 		if partitionExpr == nil {
 			return nil
@@ -1619,11 +1622,13 @@ func buildPointUpdatePlan(ctx sessionctx.Context, pointPlan PhysicalPlan, dbName
 			if len(updateTable.PartitionNames) > 0 {
 				pids := make(map[int64]struct{}, len(updateTable.PartitionNames))
 				for _, name := range updateTable.PartitionNames {
-					pid, err := tables.FindPartitionByName(tbl, name.L)
+					partIDs, err := tables.FindPartitionIDsByName(tbl, name.L)
 					if err != nil {
 						return updatePlan
 					}
-					pids[pid] = struct{}{}
+					for _, pid := range partIDs {
+						pids[pid] = struct{}{}
+					}
 				}
 				pt = tables.NewPartitionTableWithGivenSets(pt, pids)
 			}
@@ -1785,7 +1790,7 @@ func getPartitionInfo(ctx sessionctx.Context, tbl *model.TableInfo, pairs []name
 	}
 
 	pi := tbl.GetPartitionInfo()
-	if pi == nil {
+	if pi == nil || pi.Sub != nil {
 		return nil, 0, 0, false
 	}
 
@@ -1901,6 +1906,9 @@ func getPartitionColumnName(partitionExpr *tables.PartitionExpr, tbl *model.Tabl
 	}
 
 	pi := tbl.GetPartitionInfo()
+	if pi.Sub != nil {
+		return nil
+	}
 	var partitionColName model.CIStr
 	switch pi.Type {
 	case model.PartitionTypeHash:
@@ -263,10 +263,40 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 		p.flag |= inCreateOrDropTable
 		p.checkCreateViewGrammar(node)
 		p.checkCreateViewWithSelectGrammar(node)
+	case *ast.CreateMaterializedViewStmt:
+		p.stmtTp = TypeCreate
+		p.flag |= inCreateOrDropTable
+		p.checkCreateMaterializedViewGrammar(node)
 	case *ast.DropTableStmt:
 		p.flag |= inCreateOrDropTable
 		p.stmtTp = TypeDrop
 		p.checkDropTableGrammar(node)
+	case *ast.CreateTriggerStmt:
+		p.stmtTp = TypeCreate
+		p.resolveNameSchema(node.TriggerName)
+	case *ast.DropTriggerStmt:
+		p.stmtTp = TypeDrop
+		p.resolveNameSchema(node.TriggerName)
+	case *ast.ProcedureInfo:
+		p.stmtTp = TypeCreate
+		p.resolveNameSchema(node.ProcedureName)
+		// The statements of the routine body are checked when they are executed.
+		return in, true
+	case *ast.DropProcedureStmt:
+		p.stmtTp = TypeDrop
+		p.resolveNameSchema(node.ProcedureName)
+	case *ast.CreateEventStmt:
+		p.stmtTp = TypeCreate
+		p.resolveNameSchema(node.EventName)
+	case *ast.AlterEventStmt:
+		p.stmtTp = TypeAlter
+		p.resolveNameSchema(node.EventName)
+		if node.NewName != nil {
+			p.resolveNameSchema(node.NewName)
+		}
+	case *ast.DropEventStmt:
+		p.stmtTp = TypeDrop
+		p.resolveNameSchema(node.EventName)
 	case *ast.RenameTableStmt:
 		p.stmtTp = TypeRename
 		p.flag |= inCreateOrDropTable
@@ -364,6 +394,9 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 		if _, ok := node.Source.(*ast.SelectStmt); ok && !isModeOracle && len(node.AsName.L) == 0 {
 			p.err = dbterror.ErrDerivedMustHaveAlias.GenWithStackByArgs()
 		}
+		if _, ok := node.Source.(*ast.JSONTable); ok && len(node.AsName.L) == 0 {
+			p.err = ErrTableFunctionMustHaveAlias.GenWithStackByArgs()
+		}
 		if v, ok := node.Source.(*ast.TableName); ok && v.TableSample != nil {
 			switch v.TableSample.SampleMethod {
 			case ast.SampleMethodTypeTiDBRegion:
@@ -557,7 +590,7 @@ func (p *preprocessor) Leave(in ast.Node) (out ast.Node, ok bool) {
 		p.flag &= ^inCreateOrDropTable
 		p.checkAutoIncrement(x)
 		p.checkContainDotColumn(x)
-	case *ast.CreateViewStmt:
+	case *ast.CreateViewStmt, *ast.CreateMaterializedViewStmt:
 		p.flag &= ^inCreateOrDropTable
 	case *ast.DropTableStmt, *ast.AlterTableStmt, *ast.RenameTableStmt:
 		p.flag &= ^inCreateOrDropTable
@@ -968,6 +1001,31 @@ func (p *preprocessor) checkCreateViewGrammar(stmt *ast.CreateViewStmt) {
 	}
 }
 
+func (p *preprocessor) checkCreateMaterializedViewGrammar(stmt *ast.CreateMaterializedViewStmt) {
+	vName := stmt.ViewName.Name.String()
+	if isIncorrectName(vName) {
+		p.err = dbterror.ErrWrongTableName.GenWithStackByArgs(vName)
+		return
+	}
+	for _, col := range stmt.Cols {
+		if isIncorrectName(col.String()) {
+			p.err = dbterror.ErrWrongColumnName.GenWithStackByArgs(col)
+			return
+		}
+	}
+	switch sel := stmt.Select.(type) {
+	case *ast.SelectStmt:
+		p.checkCreateViewWithSelect(sel)
+	case *ast.SetOprStmt:
+		for _, selectStmt := range sel.SelectList.Selects {
+			p.checkCreateViewWithSelect(selectStmt)
+			if p.err != nil {
+				return
+			}
+		}
+	}
+}
+
 func (p *preprocessor) checkCreateViewWithSelect(stmt ast.Node) {
 	switch s := stmt.(type) {
 	case *ast.SelectStmt:
@@ -1515,6 +1573,20 @@ func (p *preprocessor) stmtType() string {
 	}
 }
 
+// resolveNameSchema fills the schema of the trigger, stored routine or event name, they aren't tables so
+// they're not handled by handleTableName.
+func (p *preprocessor) resolveNameSchema(tn *ast.TableName) {
+	if tn.Schema.L != "" {
+		return
+	}
+	currentDB := p.sctx.GetSessionVars().CurrentDB
+	if currentDB == "" {
+		p.err = errors.Trace(ErrNoDB)
+		return
+	}
+	tn.Schema = model.NewCIStr(currentDB)
+}
+
 func (p *preprocessor) handleTableName(tn *ast.TableName) {
 	if tn.Schema.L == "" {
 		for _, cte := range p.preprocessWith.cteCanUsed {
@@ -1619,6 +1691,10 @@ func (p *preprocessor) handleRepairName(tn *ast.TableName) {
 }
 
 func (p *preprocessor) resolveShowStmt(node *ast.ShowStmt) {
+	if node.Procedure != nil {
+		p.resolveNameSchema(node.Procedure)
+		node.DBName = node.Procedure.Schema.O
+	}
 	if node.DBName == "" {
 		if node.Table != nil && node.Table.Schema.L != "" {
 			node.DBName = node.Table.Schema.O
@@ -303,7 +303,9 @@ func (p *PhysicalIndexJoin) ResolveIndices() (err error) {
 		}
 		p.schema.Columns[i] = newCol.(*expression.Column)
 	}
-
+	if p.FallbackInnerPlan != nil {
+		err = p.FallbackInnerPlan.ResolveIndices()
+	}
 	return
 }
 
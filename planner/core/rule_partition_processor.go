@@ -136,9 +136,8 @@ func getPartColumnsForHashPartition(hashExpr expression.Expression) ([]*expressi
 }
 
 func (s *partitionProcessor) getUsedHashPartitions(ctx sessionctx.Context,
-	tbl table.Table, partitionNames []model.CIStr, columns []*expression.Column,
+	pi *model.PartitionInfo, partitionNames []model.CIStr, columns []*expression.Column,
 	conds []expression.Expression, names types.NameSlice) ([]int, []expression.Expression, error) {
-	pi := tbl.Meta().Partition
 	hashExpr, err := generateHashPartitionExpr(ctx, pi, columns, names)
 	if err != nil {
 		return nil, nil, err
@@ -242,10 +241,8 @@ func (s *partitionProcessor) getUsedHashPartitions(ctx sessionctx.Context,
 }
 
 func (s *partitionProcessor) getUsedKeyPartitions(ctx sessionctx.Context,
-	tbl table.Table, partitionNames []model.CIStr, columns []*expression.Column,
+	pi *model.PartitionInfo, partExpr *tables.PartitionExpr, partitionNames []model.CIStr, columns []*expression.Column,
 	conds []expression.Expression, _ types.NameSlice) ([]int, []expression.Expression, error) {
-	pi := tbl.Meta().Partition
-	partExpr := tbl.(partitionTable).PartitionExpr()
 	partCols, colLen := partExpr.GetPartColumnsForKeyPartition(columns)
 	pe := &tables.ForKeyPruning{KeyPartCols: partCols}
 	detachedResult, err := ranger.DetachCondAndBuildRangeForPartition(ctx, conds, partCols, colLen, ctx.GetSessionVars().RangeMaxSize)
@@ -326,13 +323,13 @@ func (s *partitionProcessor) getUsedKeyPartitions(ctx sessionctx.Context,
 }
 
 // getUsedPartitions is used to get used partitions for hash or key partition tables
-func (s *partitionProcessor) getUsedPartitions(ctx sessionctx.Context, tbl table.Table,
+func (s *partitionProcessor) getUsedPartitions(ctx sessionctx.Context, pi *model.PartitionInfo, partExpr *tables.PartitionExpr,
 	partitionNames []model.CIStr, columns []*expression.Column, conds []expression.Expression,
-	names types.NameSlice, partType model.PartitionType) ([]int, []expression.Expression, error) {
-	if partType == model.PartitionTypeHash {
-		return s.getUsedHashPartitions(ctx, tbl, partitionNames, columns, conds, names)
+	names types.NameSlice) ([]int, []expression.Expression, error) {
+	if pi.Type == model.PartitionTypeHash {
+		return s.getUsedHashPartitions(ctx, pi, partitionNames, columns, conds, names)
 	}
-	return s.getUsedKeyPartitions(ctx, tbl, partitionNames, columns, conds, names)
+	return s.getUsedKeyPartitions(ctx, pi, partExpr, partitionNames, columns, conds, names)
 }
 
 // findUsedPartitions is used to get used partitions for hash or key partition tables.
@@ -342,7 +339,7 @@ func (s *partitionProcessor) findUsedPartitions(ctx sessionctx.Context,
 	tbl table.Table, partitionNames []model.CIStr, conds []expression.Expression,
 	columns []*expression.Column, names types.NameSlice) ([]int, []expression.Expression, error) {
 	pi := tbl.Meta().Partition
-	used, remainedConds, err := s.getUsedPartitions(ctx, tbl, partitionNames, columns, conds, names, pi.Type)
+	used, remainedConds, err := s.getUsedPartitions(ctx, pi, tbl.(partitionTable).PartitionExpr(), partitionNames, columns, conds, names)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -370,7 +367,7 @@ func (s *partitionProcessor) convertToIntSlice(or partitionRangeOR, pi *model.Pa
 	ret := make([]int, 0, len(or))
 	for i := 0; i < len(or); i++ {
 		for pos := or[i].start; pos < or[i].end; pos++ {
-			if len(partitionNames) > 0 && !s.findByName(partitionNames, pi.Definitions[pos].Name.L) {
+			if len(partitionNames) > 0 && !s.findDefinitionByName(partitionNames, &pi.Definitions[pos]) {
 				continue
 			}
 			ret = append(ret, pos)
@@ -483,7 +480,7 @@ type listPartitionPruner struct {
 	listPrune      *tables.ForListPruning
 }
 
-func newListPartitionPruner(ctx sessionctx.Context, tbl table.Table, partitionNames []model.CIStr, s *partitionProcessor, pruneList *tables.ForListPruning, columns []*expression.Column) *listPartitionPruner {
+func newListPartitionPruner(ctx sessionctx.Context, pi *model.PartitionInfo, partitionNames []model.CIStr, s *partitionProcessor, pruneList *tables.ForListPruning, columns []*expression.Column) *listPartitionPruner {
 	pruneList = pruneList.Clone()
 	for i := range pruneList.PruneExprCols {
 		for j := range columns {
@@ -506,7 +503,7 @@ func newListPartitionPruner(ctx sessionctx.Context, tbl table.Table, partitionNa
 	return &listPartitionPruner{
 		partitionProcessor: s,
 		ctx:                ctx,
-		pi:                 tbl.Meta().Partition,
+		pi:                 pi,
 		partitionNames:     partitionNames,
 		fullRange:          fullRange,
 		listPrune:          pruneList,
@@ -736,12 +733,9 @@ func (l *listPartitionPruner) findUsedListPartitions(conds []expression.Expressi
 	return used, nil
 }
 
-func (s *partitionProcessor) findUsedListPartitions(ctx sessionctx.Context, tbl table.Table, partitionNames []model.CIStr,
-	conds []expression.Expression, columns []*expression.Column) ([]int, error) {
-	pi := tbl.Meta().Partition
-	partExpr := tbl.(partitionTable).PartitionExpr()
-
-	listPruner := newListPartitionPruner(ctx, tbl, partitionNames, s, partExpr.ForListPruning, columns)
+func (s *partitionProcessor) findUsedListPartitions(ctx sessionctx.Context, pi *model.PartitionInfo, partExpr *tables.PartitionExpr,
+	partitionNames []model.CIStr, conds []expression.Expression, columns []*expression.Column) ([]int, error) {
+	listPruner := newListPartitionPruner(ctx, pi, partitionNames, s, partExpr.ForListPruning, columns)
 	var used map[int]struct{}
 	var err error
 	if partExpr.ForListPruning.ColPrunes == nil {
@@ -766,7 +760,7 @@ func (s *partitionProcessor) findUsedListPartitions(ctx sessionctx.Context, tbl
 
 func (s *partitionProcessor) pruneListPartition(ctx sessionctx.Context, tbl table.Table, partitionNames []model.CIStr,
 	conds []expression.Expression, columns []*expression.Column) ([]int, error) {
-	used, err := s.findUsedListPartitions(ctx, tbl, partitionNames, conds, columns)
+	used, err := s.findUsedListPartitions(ctx, tbl.Meta().Partition, tbl.(partitionTable).PartitionExpr(), partitionNames, conds, columns)
 	if err != nil {
 		return nil, err
 	}
@@ -784,6 +778,9 @@ func (s *partitionProcessor) prune(ds *DataSource, opt *logicalOptimizeOp) (Logi
 	for i, cond := range ds.allConds {
 		ds.allConds[i] = expression.PushDownNot(ds.SCtx(), cond)
 	}
+	if pi.Sub != nil {
+		return s.processSubPartition(ds, pi, opt)
+	}
 	// Try to locate partition directly for hash partition.
 	switch pi.Type {
 	case model.PartitionTypeRange:
@@ -808,6 +805,86 @@ func (*partitionProcessor) findByName(partitionNames []model.CIStr, partitionNam
 	return false
 }
 
+// findDefinitionByName checks whether the partition, or the partition the subpartition
+// belongs to, exists in list.
+func (s *partitionProcessor) findDefinitionByName(partitionNames []model.CIStr, def *model.PartitionDefinition) bool {
+	return s.findByName(partitionNames, def.Name.L) || (def.ParentName.L != "" && s.findByName(partitionNames, def.ParentName.L))
+}
+
+func (s *partitionProcessor) processSubPartition(ds *DataSource, pi *model.PartitionInfo, opt *logicalOptimizeOp) (LogicalPlan, error) {
+	names, err := s.reconstructTableColNames(ds)
+	if err != nil {
+		return nil, err
+	}
+	used, err := s.pruneSubPartition(ds.SCtx(), ds.table.(table.PartitionedTable), ds.partitionNames, ds.allConds, ds.TblCols, names)
+	if err != nil {
+		return nil, err
+	}
+	return s.makeUnionAllChildren(ds, pi, convertToRangeOr(used, pi), opt)
+}
+
+// pruneSubPartition is used to prune subpartitioned tables, first on the RANGE or LIST
+// partitions and then on the HASH or KEY subpartitions within each of them.
+// It returns the offsets of the used subpartitions in the partition definitions.
+func (s *partitionProcessor) pruneSubPartition(ctx sessionctx.Context, tbl table.PartitionedTable, partitionNames []model.CIStr,
+	conds []expression.Expression, columns []*expression.Column, names types.NameSlice) ([]int, error) {
+	pi := tbl.Meta().Partition
+	partExpr := tbl.(partitionTable).PartitionExpr()
+	firstLevelPi := pi.FirstLevel()
+	var used []int
+	switch pi.Type {
+	case model.PartitionTypeRange:
+		rangeOr, err := s.pruneRangePartition(ctx, firstLevelPi, tbl, conds, columns, names)
+		if err != nil {
+			return nil, err
+		}
+		used = s.convertToIntSlice(rangeOr, firstLevelPi, nil)
+	case model.PartitionTypeList:
+		var err error
+		used, err = s.findUsedListPartitions(ctx, firstLevelPi, partExpr, nil, conds, columns)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return []int{FullRange}, nil
+	}
+	subUsed, _, err := s.getUsedPartitions(ctx, pi.SubLevel(), partExpr.Sub, nil, columns, conds, names)
+	if err != nil {
+		return nil, err
+	}
+	used = expandFullRange(used, len(firstLevelPi.Definitions))
+	subUsed = expandFullRange(subUsed, int(pi.Sub.Num))
+	slices.Sort(subUsed)
+	subUsed = slices.Compact(subUsed)
+
+	ret := make([]int, 0, len(used)*len(subUsed))
+	for _, partIdx := range used {
+		for _, subIdx := range subUsed {
+			idx := partIdx*int(pi.Sub.Num) + subIdx
+			if len(partitionNames) > 0 && !s.findDefinitionByName(partitionNames, &pi.Definitions[idx]) {
+				continue
+			}
+			ret = append(ret, idx)
+		}
+	}
+	if len(partitionNames) == 0 && len(ret) == len(pi.Definitions) {
+		return []int{FullRange}, nil
+	}
+	return ret, nil
+}
+
+// expandFullRange replaces FullRange in used by all the offsets up to num.
+func expandFullRange(used []int, num int) []int {
+	if len(used) != 1 || used[0] != FullRange {
+		return used
+	}
+	ret := make([]int, 0, num)
+	for i := 0; i < num; i++ {
+		ret = append(ret, i)
+	}
+	return ret
+}
+
 func (*partitionProcessor) name() string {
 	return "partition_processor"
 }
@@ -1734,7 +1811,7 @@ func (s *partitionProcessor) makeUnionAllChildren(ds *DataSource, pi *model.Part
 		for i := r.start; i < r.end; i++ {
 			// This is for `table partition (p0,p1)` syntax, only union the specified partition if has specified partitions.
 			if len(ds.partitionNames) != 0 {
-				if !s.findByName(ds.partitionNames, pi.Definitions[i].Name.L) {
+				if !s.findDefinitionByName(ds.partitionNames, &pi.Definitions[i]) {
 					continue
 				}
 			}
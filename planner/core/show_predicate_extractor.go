@@ -32,6 +32,8 @@ const (
 	databaseKey     = "database"
 	collationKey    = "collation"
 	databaseNameKey = "db_name"
+	routineKey      = "routine"
+	eventKey        = "event"
 )
 
 var (
@@ -102,7 +104,7 @@ func (e *ShowBaseExtractor) explainInfo() string {
 	switch e.ShowStmt.Tp {
 	case ast.ShowVariables, ast.ShowColumns:
 		key = fieldKey
-	case ast.ShowTables, ast.ShowTableStatus:
+	case ast.ShowTables, ast.ShowTableStatus, ast.ShowTriggers:
 		key = tableKey
 	case ast.ShowDatabases:
 		key = databaseKey
@@ -110,6 +112,10 @@ func (e *ShowBaseExtractor) explainInfo() string {
 		key = collationKey
 	case ast.ShowStatsHealthy:
 		key = databaseNameKey
+	case ast.ShowProcedureStatus, ast.ShowFunctionStatus:
+		key = routineKey
+	case ast.ShowEvents:
+		key = eventKey
 	}
 
 	r := new(bytes.Buffer)
@@ -0,0 +1,813 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/opcode"
+	"github.com/pingcap/tidb/privilege"
+	"github.com/pingcap/tidb/sessionctx"
+	driver "github.com/pingcap/tidb/types/parser_driver"
+	"golang.org/x/exp/slices"
+)
+
+// maxUpdatableViewDepth is the max depth of the nested views that can be merged into the base table.
+const maxUpdatableViewDepth = 64
+
+// ViewCheck is the WITH CHECK OPTION of the view which the rows are written through.
+type ViewCheck struct {
+	DBName   model.CIStr
+	ViewName model.CIStr
+	// Expr is the condition on the row of the base table, the row fails the check if it's not true.
+	Expr expression.Expression
+}
+
+// updatableView is a view merged into its base table.
+// See https://dev.mysql.com/doc/refman/8.0/en/view-updatability.html
+type updatableView struct {
+	dbName  model.CIStr
+	tblInfo *model.TableInfo
+	baseDB  model.CIStr
+	base    *model.TableInfo
+	// cols are the expressions on the base table of the columns of the view.
+	cols []ast.ExprNode
+	// where is the condition of the view and its underlying views, it's nil if there is no condition.
+	where ast.ExprNode
+	// checks are the conditions that the written rows must satisfy.
+	checks []ast.ExprNode
+}
+
+// baseColumn returns the name of the base column if the i-th column of the view is a simple column reference.
+func (v *updatableView) baseColumn(i int) (model.CIStr, bool) {
+	expr := v.cols[i]
+	for {
+		p, ok := expr.(*ast.ParenthesesExpr)
+		if !ok {
+			break
+		}
+		expr = p.Expr
+	}
+	if col, ok := expr.(*ast.ColumnNameExpr); ok {
+		return col.Name.Name, true
+	}
+	return model.CIStr{}, false
+}
+
+// insertable checks whether all the columns of the view are distinct columns of the base table.
+func (v *updatableView) insertable() bool {
+	cols := make(map[string]struct{}, len(v.cols))
+	for i := range v.cols {
+		col, ok := v.baseColumn(i)
+		if !ok {
+			return false
+		}
+		if _, ok := cols[col.L]; ok {
+			return false
+		}
+		cols[col.L] = struct{}{}
+	}
+	return true
+}
+
+// IsUpdatableView checks whether the view can be the target of INSERT, UPDATE and DELETE.
+func IsUpdatableView(sctx sessionctx.Context, is infoschema.InfoSchema, dbName model.CIStr, tblInfo *model.TableInfo) bool {
+	view, err := newViewMerger(sctx, is).merge(dbName, tblInfo, 0)
+	return err == nil && view != nil
+}
+
+// viewMerger merges the updatable views into their base tables.
+type viewMerger struct {
+	sctx   sessionctx.Context
+	is     infoschema.InfoSchema
+	parser *parser.Parser
+}
+
+func newViewMerger(sctx sessionctx.Context, is infoschema.InfoSchema) *viewMerger {
+	p := parser.New()
+	p.SetParserConfig(sctx.GetSessionVars().BuildParserConfig())
+	return &viewMerger{sctx: sctx, is: is, parser: p}
+}
+
+func (m *viewMerger) parse(sql string) (ast.StmtNode, error) {
+	charset, collation := m.sctx.GetSessionVars().GetCharsetInfo()
+	return m.parser.ParseOneStmt(sql, charset, collation)
+}
+
+func restoreViewNode(node ast.Node) (string, error) {
+	var sb strings.Builder
+	restoreFlag := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameBackQuotes
+	if err := node.Restore(format.NewRestoreCtx(restoreFlag, &sb)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// cloneExpr clones the expression and sets the qualifier of its columns.
+func (m *viewMerger) cloneExpr(expr ast.ExprNode, qualifier columnQualifier) (ast.ExprNode, error) {
+	sql, err := restoreViewNode(expr)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := m.parse("SELECT " + sql)
+	if err != nil {
+		return nil, err
+	}
+	newExpr := stmt.(*ast.SelectStmt).Fields.Fields[0].Expr
+	newExpr.Accept(&qualifier)
+	return newExpr, nil
+}
+
+// cloneStmt clones the statement, so it can be rewritten without changing the original one, which may be
+// cached by a prepared statement.
+func (m *viewMerger) cloneStmt(stmt ast.StmtNode) (ast.StmtNode, error) {
+	sql, err := restoreViewNode(stmt)
+	if err != nil {
+		return nil, err
+	}
+	newStmt, err := m.parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	// Use the original parameter markers, so the parameters of the prepared statement can be resolved.
+	var oldMarkers, newMarkers paramMarkerExtractor
+	stmt.Accept(&oldMarkers)
+	newStmt.Accept(&newMarkers)
+	if len(oldMarkers.markers) != len(newMarkers.markers) {
+		return nil, errors.Errorf("the parameters of the statement on view are mismatched")
+	}
+	if len(oldMarkers.markers) > 0 {
+		byOffset := func(i, j ast.ParamMarkerExpr) bool {
+			return i.(*driver.ParamMarkerExpr).Offset < j.(*driver.ParamMarkerExpr).Offset
+		}
+		slices.SortFunc(oldMarkers.markers, byOffset)
+		slices.SortFunc(newMarkers.markers, byOffset)
+		newStmt.Accept(&paramMarkerReplacer{from: newMarkers.markers, to: oldMarkers.markers})
+	}
+	return newStmt, nil
+}
+
+// updatableViewSource returns the only table of the SELECT, or nil if the SELECT isn't updatable.
+func updatableViewSource(node ast.Node) *ast.TableSource {
+	sel, ok := node.(*ast.SelectStmt)
+	if !ok || sel.Kind != ast.SelectStmtKindSelect || sel.Distinct || sel.GroupBy != nil || sel.Having != nil ||
+		sel.Limit != nil || sel.WindowSpecs != nil || sel.With != nil || sel.From == nil || sel.From.TableRefs.Right != nil {
+		return nil
+	}
+	ts, ok := sel.From.TableRefs.Left.(*ast.TableSource)
+	if !ok {
+		return nil
+	}
+	if _, ok := ts.Source.(*ast.TableName); !ok {
+		return nil
+	}
+	checker := &nonUpdatableExprChecker{}
+	sel.Fields.Accept(checker)
+	if sel.Where != nil {
+		sel.Where.Accept(checker)
+	}
+	if checker.found {
+		return nil
+	}
+	return ts
+}
+
+// sourceTable returns the table of the source, and the table is nil if it can't be updated.
+func (m *viewMerger) sourceTable(dbName model.CIStr, ts *ast.TableSource) (model.CIStr, *model.TableInfo, error) {
+	tn := ts.Source.(*ast.TableName)
+	if tn.Schema.L != "" {
+		dbName = tn.Schema
+	}
+	tbl, err := m.is.TableByName(dbName, tn.Name)
+	if err != nil {
+		return dbName, nil, err
+	}
+	tblInfo := tbl.Meta()
+	if !tbl.Type().IsNormalTable() || tblInfo.IsSequence() || tblInfo.IsMaterializedView() {
+		return dbName, nil, nil
+	}
+	return dbName, tblInfo, nil
+}
+
+// isUpdatableSelect checks whether the SELECT of a view being created can be updated.
+func (m *viewMerger) isUpdatableSelect(dbName model.CIStr, node ast.Node) (bool, error) {
+	ts := updatableViewSource(node)
+	if ts == nil {
+		return false, nil
+	}
+	baseDB, base, err := m.sourceTable(dbName, ts)
+	if err != nil || base == nil {
+		return false, err
+	}
+	if base.IsView() {
+		inner, err := m.merge(baseDB, base, 1)
+		return inner != nil, err
+	}
+	return true, nil
+}
+
+// merge merges the view into its base table, it returns nil if the view isn't updatable.
+func (m *viewMerger) merge(dbName model.CIStr, tblInfo *model.TableInfo, depth int) (*updatableView, error) {
+	if depth > maxUpdatableViewDepth {
+		return nil, ErrViewRecursive.GenWithStackByArgs(dbName.O, tblInfo.Name.O)
+	}
+	if tblInfo.View.Algorithm == model.AlgorithmTemptable {
+		return nil, nil
+	}
+	stmt, err := m.parse(tblInfo.View.SelectStmt)
+	if err != nil {
+		return nil, err
+	}
+	ts := updatableViewSource(stmt)
+	if ts == nil {
+		return nil, nil
+	}
+	sel := stmt.(*ast.SelectStmt)
+	baseDB, base, err := m.sourceTable(dbName, ts)
+	if err != nil {
+		return nil, ErrViewInvalid.GenWithStackByArgs(dbName.O, tblInfo.Name.O)
+	}
+	if base == nil {
+		return nil, nil
+	}
+
+	// The output names of the fields are used to find the columns of the views created by the old version.
+	var exprs []ast.ExprNode
+	var names []model.CIStr
+	for _, field := range sel.Fields.Fields {
+		if field.WildCard != nil {
+			for _, col := range base.Cols() {
+				if col.Hidden {
+					continue
+				}
+				exprs = append(exprs, &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: col.Name}})
+				names = append(names, col.Name)
+			}
+			continue
+		}
+		name := field.AsName
+		if name.L == "" {
+			if col, ok := field.Expr.(*ast.ColumnNameExpr); ok {
+				name = col.Name.Name
+			} else {
+				name = model.NewCIStr(field.Text())
+			}
+		}
+		exprs = append(exprs, field.Expr)
+		names = append(names, name)
+	}
+	if tblInfo.View.Cols != nil {
+		cols := make([]ast.ExprNode, 0, len(tblInfo.Columns))
+		for _, col := range tblInfo.Columns {
+			idx := slices.IndexFunc(names, func(name model.CIStr) bool { return name.L == col.Name.L })
+			if idx < 0 {
+				return nil, nil
+			}
+			cols = append(cols, exprs[idx])
+		}
+		exprs = cols
+	}
+	if len(exprs) != len(tblInfo.Columns) {
+		return nil, ErrViewInvalid.GenWithStackByArgs(dbName.O, tblInfo.Name.O)
+	}
+
+	view := &updatableView{
+		dbName:  dbName,
+		tblInfo: tblInfo,
+		baseDB:  baseDB,
+		base:    base,
+		cols:    exprs,
+		where:   sel.Where,
+	}
+	var inner *updatableView
+	if base.IsView() {
+		if inner, err = m.merge(baseDB, base, depth+1); err != nil || inner == nil {
+			return nil, err
+		}
+		view.baseDB, view.base = inner.baseDB, inner.base
+		alias := ts.AsName
+		if alias.L == "" {
+			alias = base.Name
+		}
+		s := m.newColumnSubstitutor(inner, baseDB, alias, columnQualifier{}, "field list")
+		for i := range view.cols {
+			if view.cols[i], err = s.substitute(view.cols[i]); err != nil {
+				return nil, ErrViewInvalid.GenWithStackByArgs(dbName.O, tblInfo.Name.O)
+			}
+		}
+		if view.where, err = s.substitute(view.where); err != nil {
+			return nil, ErrViewInvalid.GenWithStackByArgs(dbName.O, tblInfo.Name.O)
+		}
+	}
+
+	// LOCAL checks the condition of the view itself and the views underlying with check options,
+	// CASCADED checks the conditions of the view and all the views underlying.
+	ownWhere := view.where
+	if inner != nil {
+		view.where = andViewConditions(view.where, inner.where)
+		view.checks = append(view.checks, inner.checks...)
+	}
+	switch tblInfo.View.GetCheckOption() {
+	case model.CheckOptionLocal:
+		if ownWhere != nil {
+			view.checks = append(view.checks, ownWhere)
+		}
+	case model.CheckOptionCascaded:
+		view.checks = view.checks[:0]
+		if view.where != nil {
+			view.checks = append(view.checks, view.where)
+		}
+	}
+	return view, nil
+}
+
+func andViewConditions(l, r ast.ExprNode) ast.ExprNode {
+	if l == nil {
+		return r
+	}
+	if r == nil {
+		return l
+	}
+	return &ast.BinaryOperationExpr{
+		Op: opcode.LogicAnd,
+		L:  &ast.ParenthesesExpr{Expr: l},
+		R:  &ast.ParenthesesExpr{Expr: r},
+	}
+}
+
+// nonUpdatableExprChecker checks whether there is an expression making the view not updatable.
+type nonUpdatableExprChecker struct {
+	found bool
+}
+
+func (c *nonUpdatableExprChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch in.(type) {
+	case *ast.AggregateFuncExpr, *ast.WindowFuncExpr, *ast.SubqueryExpr, *ast.ExistsSubqueryExpr:
+		c.found = true
+	}
+	return in, c.found
+}
+
+func (*nonUpdatableExprChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// columnQualifier sets the schema and table of the column names.
+type columnQualifier struct {
+	schema model.CIStr
+	table  model.CIStr
+}
+
+func (q *columnQualifier) Enter(in ast.Node) (ast.Node, bool) {
+	if col, ok := in.(*ast.ColumnName); ok {
+		col.Schema, col.Table = q.schema, q.table
+	}
+	return in, false
+}
+
+func (*columnQualifier) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// paramMarkerReplacer replaces the parameter markers.
+type paramMarkerReplacer struct {
+	from []ast.ParamMarkerExpr
+	to   []ast.ParamMarkerExpr
+}
+
+func (*paramMarkerReplacer) Enter(in ast.Node) (ast.Node, bool) {
+	return in, false
+}
+
+func (r *paramMarkerReplacer) Leave(in ast.Node) (ast.Node, bool) {
+	if x, ok := in.(ast.ParamMarkerExpr); ok {
+		if idx := slices.Index(r.from, x); idx >= 0 {
+			return r.to[idx], true
+		}
+	}
+	return in, true
+}
+
+// tableRefCounter counts the references of a table.
+type tableRefCounter struct {
+	schema model.CIStr
+	name   model.CIStr
+	count  int
+}
+
+func (c *tableRefCounter) Enter(in ast.Node) (ast.Node, bool) {
+	if tn, ok := in.(*ast.TableName); ok && tn.Schema.L == c.schema.L && tn.Name.L == c.name.L {
+		c.count++
+	}
+	return in, false
+}
+
+func (*tableRefCounter) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// viewColumnSubstitutor substitutes the columns of a view with their expressions on the base table.
+type viewColumnSubstitutor struct {
+	m    *viewMerger
+	view *updatableView
+	// schema and table are the name of the view in the statement.
+	schema model.CIStr
+	table  model.CIStr
+	// qualifier is set to the substituted columns.
+	qualifier columnQualifier
+	clause    string
+
+	subqueryDepth int
+	err           error
+}
+
+func (m *viewMerger) newColumnSubstitutor(view *updatableView, schema, table model.CIStr, qualifier columnQualifier, clause string) *viewColumnSubstitutor {
+	return &viewColumnSubstitutor{m: m, view: view, schema: schema, table: table, qualifier: qualifier, clause: clause}
+}
+
+// match returns whether the name references the view, and the offset of the column in the view.
+func (s *viewColumnSubstitutor) match(name *ast.ColumnName) (int, bool) {
+	if name.Table.L != "" {
+		if name.Table.L != s.table.L || (name.Schema.L != "" && name.Schema.L != s.schema.L) {
+			return -1, false
+		}
+	} else if s.subqueryDepth > 0 {
+		return -1, false
+	}
+	for i, col := range s.view.tblInfo.Columns {
+		if col.Name.L == name.Name.L {
+			return i, true
+		}
+	}
+	return -1, true
+}
+
+// baseColumn returns the base column of the view column, which must be a simple column reference.
+func (s *viewColumnSubstitutor) baseColumn(name *ast.ColumnName) (model.CIStr, error) {
+	idx, ok := s.match(name)
+	if !ok || idx < 0 {
+		return model.CIStr{}, ErrUnknownColumn.GenWithStackByArgs(name.OrigColName(), s.clause)
+	}
+	col, ok := s.view.baseColumn(idx)
+	if !ok {
+		return model.CIStr{}, ErrNonUpdatableColumn.GenWithStackByArgs(name.Name.O)
+	}
+	return col, nil
+}
+
+func (s *viewColumnSubstitutor) substitute(expr ast.ExprNode) (ast.ExprNode, error) {
+	if expr == nil {
+		return nil, nil
+	}
+	s.subqueryDepth, s.err = 0, nil
+	node, _ := expr.Accept(s)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return node.(ast.ExprNode), nil
+}
+
+func (s *viewColumnSubstitutor) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt:
+		s.subqueryDepth++
+	case *ast.ValuesExpr:
+		// VALUES(col) references the column of the INSERT, which must be a base column.
+		if _, ok := s.match(x.Column.Name); ok {
+			col, err := s.baseColumn(x.Column.Name)
+			if err != nil {
+				s.err = err
+				return in, true
+			}
+			x.Column = &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: col}}
+		}
+		return in, true
+	}
+	return in, s.err != nil
+}
+
+func (s *viewColumnSubstitutor) Leave(in ast.Node) (ast.Node, bool) {
+	if s.err != nil {
+		return in, false
+	}
+	switch x := in.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt:
+		s.subqueryDepth--
+	case *ast.ColumnNameExpr:
+		idx, ok := s.match(x.Name)
+		if !ok {
+			return in, true
+		}
+		if idx < 0 {
+			s.err = ErrUnknownColumn.GenWithStackByArgs(x.Name.OrigColName(), s.clause)
+			return in, false
+		}
+		expr, err := s.m.cloneExpr(s.view.cols[idx], s.qualifier)
+		if err != nil {
+			s.err = err
+			return in, false
+		}
+		return &ast.ParenthesesExpr{Expr: expr}, true
+	case *ast.DefaultExpr:
+		if x.Name == nil {
+			return in, true
+		}
+		if _, ok := s.match(x.Name); !ok {
+			return in, true
+		}
+		col, err := s.baseColumn(x.Name)
+		if err != nil {
+			s.err = err
+			return in, false
+		}
+		x.Name = &ast.ColumnName{Schema: s.qualifier.schema, Table: s.qualifier.table, Name: col}
+	}
+	return in, true
+}
+
+// buildInsertOnView builds the INSERT into an updatable view as the INSERT into its base table.
+func (b *PlanBuilder) buildInsertOnView(ctx context.Context, insert *ast.InsertStmt, tn *ast.TableName) (Plan, error) {
+	m := newViewMerger(b.ctx, b.is)
+	view, err := m.merge(tn.Schema, tn.TableInfo, 0)
+	if err != nil {
+		return nil, err
+	}
+	if view == nil || !view.insertable() {
+		if insert.IsReplace {
+			return nil, ErrNonInsertableTable.GenWithStackByArgs(tn.Name.O, "REPLACE")
+		}
+		return nil, ErrNonInsertableTable.GenWithStackByArgs(tn.Name.O, "INSERT")
+	}
+	stmt, err := m.cloneStmt(insert)
+	if err != nil {
+		return nil, err
+	}
+	newInsert := stmt.(*ast.InsertStmt)
+	s := m.newColumnSubstitutor(view, tn.Schema, tn.Name, columnQualifier{schema: view.baseDB, table: view.base.Name}, "field list")
+
+	cols := newInsert.Columns
+	if len(cols) == 0 {
+		for _, col := range view.tblInfo.Columns {
+			cols = append(cols, &ast.ColumnName{Name: col.Name})
+		}
+	}
+	newInsert.Columns = make([]*ast.ColumnName, 0, len(cols))
+	for _, col := range cols {
+		name, err := s.baseColumn(col)
+		if err != nil {
+			return nil, err
+		}
+		newInsert.Columns = append(newInsert.Columns, &ast.ColumnName{Name: name})
+	}
+	for _, list := range newInsert.Lists {
+		for i := range list {
+			if list[i], err = s.substitute(list[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, assign := range newInsert.OnDuplicate {
+		name, err := s.baseColumn(assign.Column)
+		if err != nil {
+			return nil, err
+		}
+		assign.Column = &ast.ColumnName{Name: name}
+		if assign.Expr, err = s.substitute(assign.Expr); err != nil {
+			return nil, err
+		}
+	}
+	newInsert.Table = &ast.TableRefsClause{TableRefs: &ast.Join{
+		Left: &ast.TableSource{Source: &ast.TableName{Schema: view.baseDB, Name: view.base.Name}},
+	}}
+
+	p, err := b.buildDMLOnView(ctx, view, newInsert)
+	if err != nil {
+		return nil, err
+	}
+	insertPlan := p.(*Insert)
+	insertPlan.ViewChecks, err = b.buildViewChecks(m, view)
+	return insertPlan, err
+}
+
+// viewTarget returns the view if it's the only target table of the UPDATE or DELETE.
+func viewTarget(refs *ast.TableRefsClause) *ast.TableName {
+	if refs == nil || refs.TableRefs == nil || refs.TableRefs.Right != nil {
+		return nil
+	}
+	ts, ok := refs.TableRefs.Left.(*ast.TableSource)
+	if !ok {
+		return nil
+	}
+	tn, ok := ts.Source.(*ast.TableName)
+	if !ok || tn.TableInfo == nil || !tn.TableInfo.IsView() {
+		return nil
+	}
+	return tn
+}
+
+// rewriteFilterOnView substitutes the columns of the view in the WHERE and ORDER BY of the UPDATE or DELETE,
+// and makes the base table the target of the statement.
+func (b *PlanBuilder) rewriteFilterOnView(m *viewMerger, view *updatableView, tn *ast.TableName,
+	refs *ast.TableRefsClause, where *ast.ExprNode, order *ast.OrderByClause) (*viewColumnSubstitutor, error) {
+	ts := refs.TableRefs.Left.(*ast.TableSource)
+	alias, schema := ts.AsName, model.CIStr{}
+	if alias.L == "" {
+		alias, schema = tn.Name, tn.Schema
+	}
+	qualifier := columnQualifier{table: alias}
+	s := m.newColumnSubstitutor(view, schema, alias, qualifier, "where clause")
+	newWhere, err := s.substitute(*where)
+	if err != nil {
+		return nil, err
+	}
+	var viewWhere ast.ExprNode
+	if view.where != nil {
+		if viewWhere, err = m.cloneExpr(view.where, qualifier); err != nil {
+			return nil, err
+		}
+	}
+	*where = andViewConditions(newWhere, viewWhere)
+	if order != nil {
+		s.clause = "order clause"
+		for _, item := range order.Items {
+			if item.Expr, err = s.substitute(item.Expr); err != nil {
+				return nil, err
+			}
+		}
+	}
+	ts.Source = &ast.TableName{Schema: view.baseDB, Name: view.base.Name}
+	ts.AsName = alias
+	return s, nil
+}
+
+// buildUpdateOnView builds the UPDATE of an updatable view as the UPDATE of its base table.
+func (b *PlanBuilder) buildUpdateOnView(ctx context.Context, update *ast.UpdateStmt, tn *ast.TableName) (Plan, error) {
+	m := newViewMerger(b.ctx, b.is)
+	view, err := m.merge(tn.Schema, tn.TableInfo, 0)
+	if err != nil {
+		return nil, err
+	}
+	if view == nil {
+		return nil, ErrNonUpdatableTable.GenWithStackByArgs(tn.Name.O, "UPDATE")
+	}
+	stmt, err := m.cloneStmt(update)
+	if err != nil {
+		return nil, err
+	}
+	newUpdate := stmt.(*ast.UpdateStmt)
+	s, err := b.rewriteFilterOnView(m, view, tn, newUpdate.TableRefs, &newUpdate.Where, newUpdate.Order)
+	if err != nil {
+		return nil, err
+	}
+	s.clause = "field list"
+	for _, assign := range newUpdate.List {
+		name, err := s.baseColumn(assign.Column)
+		if err != nil {
+			return nil, err
+		}
+		assign.Column = &ast.ColumnName{Table: s.qualifier.table, Name: name}
+		if assign.Expr, err = s.substitute(assign.Expr); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := b.buildDMLOnView(ctx, view, newUpdate)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := b.buildViewChecks(m, view)
+	if err != nil {
+		return nil, err
+	}
+	updt := p.(*Update)
+	if len(checks) > 0 {
+		updt.ViewChecks = map[int64][]*ViewCheck{view.base.ID: checks}
+	}
+	return updt, nil
+}
+
+// buildDeleteOnView builds the DELETE of an updatable view as the DELETE of its base table.
+func (b *PlanBuilder) buildDeleteOnView(ctx context.Context, ds *ast.DeleteStmt, tn *ast.TableName) (Plan, error) {
+	m := newViewMerger(b.ctx, b.is)
+	view, err := m.merge(tn.Schema, tn.TableInfo, 0)
+	if err != nil {
+		return nil, err
+	}
+	if view == nil {
+		return nil, ErrNonUpdatableTable.GenWithStackByArgs(tn.Name.O, "DELETE")
+	}
+	stmt, err := m.cloneStmt(ds)
+	if err != nil {
+		return nil, err
+	}
+	newDelete := stmt.(*ast.DeleteStmt)
+	if _, err = b.rewriteFilterOnView(m, view, tn, newDelete.TableRefs, &newDelete.Where, newDelete.Order); err != nil {
+		return nil, err
+	}
+	return b.buildDMLOnView(ctx, view, newDelete)
+}
+
+// buildDMLOnView builds the DML statement rewritten on the base table of the view. The privileges needed on the
+// base table are also needed on the view, and the base table is accessed with the privileges of the definer if
+// the view is SQL SECURITY DEFINER.
+func (b *PlanBuilder) buildDMLOnView(ctx context.Context, view *updatableView, stmt ast.StmtNode) (Plan, error) {
+	// The parameter markers are from the original statement, which has been checked.
+	if err := Preprocess(ctx, b.ctx, stmt, InPrepare, WithPreprocessorReturn(&PreprocessorReturn{InfoSchema: b.is})); err != nil {
+		return nil, err
+	}
+	sessVars := b.ctx.GetSessionVars()
+	sessVars.StmtCtx.SetSkipPlanCache(errors.New("DML on a view is un-cacheable"))
+
+	originalVisitInfo := b.visitInfo
+	b.visitInfo = make([]visitInfo, 0)
+	var p Plan
+	var err error
+	switch x := stmt.(type) {
+	case *ast.InsertStmt:
+		p, err = b.buildInsert(ctx, x)
+	case *ast.UpdateStmt:
+		p, err = b.buildUpdate(ctx, x)
+	case *ast.DeleteStmt:
+		p, err = b.buildDelete(ctx, x)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The base table may also be referenced by the statement itself besides the target.
+	counter := &tableRefCounter{schema: view.baseDB, name: view.base.Name}
+	stmt.Accept(counter)
+	isDefiner := view.tblInfo.View.Security == model.SecurityDefiner
+	pm := privilege.GetPrivilegeManager(b.ctx)
+	visitInfos := originalVisitInfo
+	baseVisitInfos := make([]visitInfo, 0, len(b.visitInfo))
+	for _, v := range b.visitInfo {
+		if v.db != view.baseDB.L || v.table != view.base.Name.L {
+			baseVisitInfos = append(baseVisitInfos, v)
+			continue
+		}
+		var authErr error
+		if sessVars.User != nil {
+			authErr = ErrTableaccessDenied.FastGenByArgs(strings.ToUpper(mysql.Priv2Str[v.privilege]),
+				sessVars.User.AuthUsername, sessVars.User.AuthHostname, view.tblInfo.Name.L)
+		}
+		visitInfos = appendVisitInfo(visitInfos, v.privilege, view.dbName.L, view.tblInfo.Name.L, "", authErr)
+		if isDefiner {
+			if pm != nil && !pm.RequestVerificationWithUser(v.db, v.table, v.column, v.privilege, view.tblInfo.View.Definer) {
+				return nil, ErrViewInvalid.GenWithStackByArgs(view.dbName.O, view.tblInfo.Name.O)
+			}
+			if counter.count <= 1 {
+				continue
+			}
+		}
+		baseVisitInfos = append(baseVisitInfos, v)
+	}
+	b.visitInfo = append(visitInfos, baseVisitInfos...)
+	return p, nil
+}
+
+// buildViewChecks builds the conditions of the WITH CHECK OPTION on the rows of the base table.
+func (b *PlanBuilder) buildViewChecks(m *viewMerger, view *updatableView) ([]*ViewCheck, error) {
+	if len(view.checks) == 0 {
+		return nil, nil
+	}
+	cols, names, err := expression.ColumnInfos2ColumnsAndNames(b.ctx, view.baseDB, view.base.Name, view.base.Cols(), view.base)
+	if err != nil {
+		return nil, err
+	}
+	schema := expression.NewSchema(cols...)
+	checks := make([]*ViewCheck, 0, len(view.checks))
+	for _, check := range view.checks {
+		node, err := m.cloneExpr(check, columnQualifier{})
+		if err != nil {
+			return nil, err
+		}
+		expr, err := rewriteAstExpr(b.ctx, node, schema, names, false)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, &ViewCheck{DBName: view.dbName, ViewName: view.tblInfo.Name, Expr: expr})
+	}
+	return checks, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/planner/core/internal/base"
+	"github.com/pingcap/tidb/planner/util"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/types"
@@ -401,16 +402,15 @@ func tableHasDirtyContent(ctx sessionctx.Context, tableInfo *model.TableInfo) bo
 	return false
 }
 
-func clonePhysicalPlan(plans []PhysicalPlan) ([]PhysicalPlan, error) {
-	cloned := make([]PhysicalPlan, 0, len(plans))
-	for _, p := range plans {
-		c, err := p.Clone()
-		if err != nil {
-			return nil, err
-		}
-		cloned = append(cloned, c)
+func cloneByItems(byItems []*util.ByItems) []*util.ByItems {
+	if byItems == nil {
+		return nil
+	}
+	cloned := make([]*util.ByItems, 0, len(byItems))
+	for _, item := range byItems {
+		cloned = append(cloned, item.Clone())
 	}
-	return cloned, nil
+	return cloned
 }
 
 // GetPhysID returns the physical table ID.
@@ -425,6 +425,9 @@ func GetPhysID(tblInfo *model.TableInfo, partitionExpr *tables.PartitionExpr, d
 	}
 
 	switch pi.Type {
+	case model.PartitionTypeNone:
+		// Non-partitioned table during ALTER TABLE ... PARTITION BY, with a single partition.
+		return pi.Definitions[0].ID, nil
 	case model.PartitionTypeHash:
 		intVal := d.GetInt64()
 		partIdx := mathutil.Abs(intVal % int64(pi.Num))
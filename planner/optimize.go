@@ -32,6 +32,7 @@ import (
 	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/planner/cascades"
 	"github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/planner/util/debugtrace"
@@ -400,6 +401,31 @@ func OptimizeForForeignKeyCascade(ctx context.Context, sctx sessionctx.Context,
 	return p, nil
 }
 
+// OptimizeWithDefiner does the same as OptimizeForForeignKeyCascade, and checks the privileges needed by the node
+// against definer, for the statements executed on behalf of the definer of a trigger.
+func OptimizeWithDefiner(ctx context.Context, sctx sessionctx.Context, node ast.StmtNode, is infoschema.InfoSchema, definer *auth.UserIdentity) (core.Plan, error) {
+	builder := planBuilderPool.Get().(*core.PlanBuilder)
+	defer planBuilderPool.Put(builder.ResetForReuse())
+	hintProcessor := &hint.BlockHintProcessor{Ctx: sctx}
+	builder.Init(sctx, is, hintProcessor)
+	p, err := builder.Build(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+	// The definer isn't resolved if the trigger is created by a session without a user, like the internal ones,
+	// which skip the privilege check too.
+	if pm := privilege.GetPrivilegeManager(sctx); pm != nil && !definer.CurrentUser {
+		visitInfo := core.VisitInfo4PrivCheck(is, node, builder.GetVisitInfo())
+		if err := core.CheckPrivilegeWithUser(pm, visitInfo, definer); err != nil {
+			return nil, err
+		}
+	}
+	if err := core.CheckTableLock(sctx, is, builder.GetVisitInfo()); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 func allowInReadOnlyMode(sctx sessionctx.Context, node ast.Node) (bool, error) {
 	pm := privilege.GetPrivilegeManager(sctx)
 	if pm == nil {
@@ -46,6 +46,7 @@ import (
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/errno"
+	eventsched "github.com/pingcap/tidb/event"
 	"github.com/pingcap/tidb/executor"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/extension"
@@ -2196,6 +2197,12 @@ func (s *session) ExecuteStmt(ctx context.Context, stmtNode ast.StmtNode) (sqlex
 		return nil, err
 	}
 
+	// The statements of the stored procedure are executed by the session one by one.
+	if call, ok := stmtNode.(*ast.CallStmt); ok {
+		s.SetProcessInfo(stmtNode.Text(), time.Now(), cmdByte, 0)
+		return nil, executor.CallProcedure(ctx, s, call)
+	}
+
 	// Uncorrelated subqueries will execute once when building plan, so we reset process info before building plan.
 	s.currentPlan = nil // reset current plan
 	s.SetProcessInfo(stmtNode.Text(), time.Now(), cmdByte, 0)
@@ -2431,6 +2438,9 @@ func runStmt(ctx context.Context, se *session, s sqlexec.Statement) (rs sqlexec.
 	if err != nil {
 		return nil, err
 	}
+	if err = se.checkXAState(s.(*executor.ExecStmt).StmtNode); err != nil {
+		return nil, err
+	}
 
 	rs, err = s.Exec(ctx)
 	se.updateTelemetryMetric(s.(*executor.ExecStmt))
@@ -3372,6 +3382,7 @@ func bootstrapSessionImpl(store kv.Storage, createSessionsImpl func(store kv.Sto
 	rebuildAllPartitionValueMapAndSorted(ses[0])
 
 	dom := domain.GetDomain(ses[0])
+	dom.SetInstancePlanCache(plannercore.NewInstancePlanCache())
 
 	// We should make the load bind-info loop before other loops which has internal SQL.
 	// Because the internal SQL may access the global bind-info handler. As the result, the data race occurs here as the
@@ -3510,6 +3521,13 @@ func bootstrapSessionImpl(store kv.Storage, createSessionsImpl func(store kv.Sto
 		return s
 	}
 	dom.StartTTLJobManager()
+	dom.StartEventManager(func() (eventsched.Session, error) {
+		se, err := CreateSession(store)
+		if err != nil {
+			return nil, err
+		}
+		return se, nil
+	})
 
 	analyzeCtxs, err := createSessions(store, analyzeConcurrencyQuota)
 	if err != nil {
@@ -27,13 +27,16 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/session/txninfo"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/binloginfo"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/sessiontxn"
 	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/sli"
 	"github.com/pingcap/tidb/util/syncutil"
@@ -747,3 +750,39 @@ func (s *session) StmtGetMutation(tableID int64) *binlog.TableMutation {
 	}
 	return st.mutations[tableID]
 }
+
+// checkXAState checks whether the statement can be executed in the state of the XA transaction associated with
+// the session. The XA transaction can only be finished by the XA statements, so the statements which commit or
+// roll back the transaction implicitly are forbidden, and only SET and SHOW are allowed once it's ended.
+func (s *session) checkXAState(stmt ast.StmtNode) error {
+	sessVars := s.GetSessionVars()
+	if sessVars.XAState == variable.XANonExisting {
+		return nil
+	}
+	if _, ok := stmt.(*ast.XAStmt); ok {
+		return nil
+	}
+	if !sessVars.InTxn() {
+		// The transaction has been rolled back because of errors like deadlock.
+		return exeerrors.ErrXaerRmfail.GenWithStackByArgs("ROLLBACK ONLY")
+	}
+	if sessVars.XAState == variable.XAIdle {
+		switch stmt.(type) {
+		case *ast.SetStmt, *ast.ShowStmt:
+			return nil
+		}
+		return exeerrors.ErrXaerRmfail.GenWithStackByArgs(sessVars.XAState.String())
+	}
+	switch x := stmt.(type) {
+	case *ast.RollbackStmt:
+		if x.SavepointName != "" {
+			return nil
+		}
+	case *ast.BeginStmt, *ast.CommitStmt, ast.DDLNode, *ast.CreateUserStmt, *ast.AlterUserStmt, *ast.DropUserStmt,
+		*ast.RenameUserStmt, *ast.GrantRoleStmt, *ast.RevokeRoleStmt, *ast.FlushStmt, *ast.LockTablesStmt,
+		*ast.UnlockTablesStmt:
+	default:
+		return nil
+	}
+	return exeerrors.ErrXaerRmfail.GenWithStackByArgs(sessVars.XAState.String())
+}
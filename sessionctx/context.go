@@ -63,6 +63,18 @@ type PlanCache interface {
 	Close()
 }
 
+// InstancePlanCache is an interface for the instance-level plan cache, which is shared by all sessions.
+// The cached plans are never used directly, Get returns a copy of the cached plan bound to sctx, and Put
+// stores a copy of the plan, it returns false if the plan can't be shared.
+type InstancePlanCache interface {
+	Get(sctx Context, key kvcache.Key, opts *utilpc.PlanCacheMatchOpts) (value kvcache.Value, ok bool)
+	Put(sctx Context, key kvcache.Key, value kvcache.Value, opts *utilpc.PlanCacheMatchOpts) bool
+	Delete(key kvcache.Key)
+	DeleteAll()
+	Size() int
+	MemoryUsage() int64
+}
+
 // Context is an interface for transaction and executive args environment.
 type Context interface {
 	SessionStatesHandler
@@ -371,6 +371,9 @@ type StatementContext struct {
 	usedStatsInfo map[int64]*UsedStatsInfoForTable
 	// IsSyncStatsFailed indicates whether any failure happened during sync stats
 	IsSyncStatsFailed bool
+	// CardinalityEstimates records the row counts of the data sources estimated from statistics, keyed by
+	// `alias@sel_N`. It's only recorded when the cardinality feedback is enabled for the statement digest.
+	CardinalityEstimates map[string]float64
 	// UseDynamicPruneMode indicates whether use UseDynamicPruneMode in query stmt
 	UseDynamicPruneMode bool
 	// ColRefFromPlan mark the column ref used by assignment in update statement.
@@ -393,6 +396,15 @@ type StatementContext struct {
 		HasFKCascades bool
 	}
 
+	// TriggerCtx contains the information for trigger execution.
+	TriggerCtx struct {
+		// The SavepointName is use to do rollback when the statement with triggers failed.
+		SavepointName string
+		HasTriggers   bool
+		// TableIDs are the tables whose triggers are executing, from the outermost to the innermost.
+		TableIDs []int64
+	}
+
 	// MPPQueryInfo stores some id and timestamp of current MPP query statement.
 	MPPQueryInfo struct {
 		QueryID              atomic2.Uint64
@@ -674,8 +686,8 @@ type TableEntry struct {
 
 // AddAffectedRows adds affected rows.
 func (sc *StatementContext) AddAffectedRows(rows uint64) {
-	if sc.InHandleForeignKeyTrigger {
-		// For compatibility with MySQL, not add the affected row cause by the foreign key trigger.
+	if sc.InHandleForeignKeyTrigger || len(sc.TriggerCtx.TableIDs) > 0 {
+		// For compatibility with MySQL, not add the affected row cause by the foreign key trigger or the trigger.
 		return
 	}
 	sc.mu.Lock()
@@ -662,6 +662,37 @@ func (s *SessionVars) GetUserVarType(name string) (*types.FieldType, bool) {
 	return ft, ok
 }
 
+// XAState is the state of an XA transaction branch associated with a session.
+// A branch is detached from the session once it is prepared, so the prepared state is not listed here.
+type XAState int
+
+const (
+	// XANonExisting means there is no XA transaction associated with the session.
+	XANonExisting XAState = iota
+	// XAActive means the XA transaction is started by XA START.
+	XAActive
+	// XAIdle means the XA transaction is ended by XA END.
+	XAIdle
+)
+
+// String implements fmt.Stringer interface, the names are the same as MySQL's.
+func (s XAState) String() string {
+	switch s {
+	case XAActive:
+		return "ACTIVE"
+	case XAIdle:
+		return "IDLE"
+	default:
+		return "NON-EXISTING"
+	}
+}
+
+// ResetXA disassociates the XA transaction from the session.
+func (s *SessionVars) ResetXA() {
+	s.XAState = XANonExisting
+	s.XID = nil
+}
+
 // HookContext contains the necessary variables for executing set/get hook
 type HookContext interface {
 	GetStore() kv.Storage
@@ -717,6 +748,11 @@ type SessionVars struct {
 	// TxnManager is used to manage txn context in session
 	TxnManager interface{}
 
+	// XAState is the state of the XA transaction branch associated with the session.
+	XAState XAState
+	// XID is the identifier of the XA transaction associated with the session, it is nil if XAState is XANonExisting.
+	XID *ast.XID
+
 	// KVVars is the variables for KV storage.
 	KVVars *tikvstore.Variables
 
@@ -799,6 +835,10 @@ type SessionVars struct {
 	// Note: this variable should be accessed and updated by atomic operations.
 	RefCountOfStmtCtx stmtctx.ReferenceCount
 
+	// ActiveRoutines holds the IDs of the stored procedures and functions being executed, the innermost one is
+	// the last. It's used to detect the recursive calls.
+	ActiveRoutines []int64
+
 	// AllowAggPushDown can be set to false to forbid aggregation push down.
 	AllowAggPushDown bool
 
@@ -1472,9 +1512,16 @@ type SessionVars struct {
 	// For now it is not public to user
 	EnableINLJoinInnerMultiPattern bool
 
+	// IndexJoinAdaptiveThreshold is the number of outer rows an index lookup join buffers before it falls back
+	// to a hash join against a full scan of the inner table. 0 disables the fallback.
+	IndexJoinAdaptiveThreshold int
+
 	// Enable late materialization: push down some selection condition to tablescan.
 	EnableLateMaterialization bool
 
+	// EnableMaterializedViewRewrite indicates whether a query can be answered by a materialized view with the same definition.
+	EnableMaterializedViewRewrite bool
+
 	// EnableRowLevelChecksum indicates whether row level checksum is enabled.
 	EnableRowLevelChecksum bool
 
@@ -342,6 +342,11 @@ const (
 	// Large value may reduce the latency but consumes more system resource.
 	TiDBIndexJoinBatchSize = "tidb_index_join_batch_size"
 
+	// TiDBIndexJoinAdaptiveThreshold is the number of outer rows an index lookup join buffers before it decides
+	// the join strategy. If the outer side turns out to have more rows than this value, the index lookup join
+	// falls back to a hash join against a full scan of the inner table. 0 disables the fallback.
+	TiDBIndexJoinAdaptiveThreshold = "tidb_index_join_adaptive_threshold"
+
 	// TiDBIndexLookupSize is used for index lookup executor.
 	// The index lookup executor first scan a batch of handles from a index, then use those handles to lookup the table
 	// rows, this value controls how much of handles in a batch to do a lookup task.
@@ -815,6 +820,10 @@ const (
 	TiDBPlanCacheInvalidationOnFreshStats = "tidb_plan_cache_invalidation_on_fresh_stats"
 	// TiDBSessionPlanCacheSize controls the size of session plan cache.
 	TiDBSessionPlanCacheSize = "tidb_session_plan_cache_size"
+	// TiDBEnableInstancePlanCache indicates whether to enable the instance plan cache, which is shared by all sessions.
+	TiDBEnableInstancePlanCache = "tidb_enable_instance_plan_cache"
+	// TiDBInstancePlanCacheMaxMemSize is the max memory usage of the instance plan cache.
+	TiDBInstancePlanCacheMaxMemSize = "tidb_instance_plan_cache_max_mem_size"
 
 	// TiDBConstraintCheckInPlacePessimistic controls whether to skip certain kinds of pessimistic locks.
 	TiDBConstraintCheckInPlacePessimistic = "tidb_constraint_check_in_place_pessimistic"
@@ -878,6 +887,10 @@ const (
 
 	// TiDBOptEnableLateMaterialization indicates whether to enable late materialization
 	TiDBOptEnableLateMaterialization = "tidb_opt_enable_late_materialization"
+	// TiDBOptEnableMaterializedViewRewrite indicates whether the optimizer can rewrite a query to read from
+	// a materialized view having the same definition. Only the incrementally refreshed views without pending
+	// changes of their base tables are used, so the result is never stale.
+	TiDBOptEnableMaterializedViewRewrite = "tidb_opt_enable_materialized_view_rewrite"
 	// TiDBLoadBasedReplicaReadThreshold is the wait duration threshold to enable replica read automatically.
 	TiDBLoadBasedReplicaReadThreshold = "tidb_load_based_replica_read_threshold"
 
@@ -1101,6 +1114,7 @@ const (
 	DefIndexLookupJoinConcurrency                  = ConcurrencyUnset
 	DefIndexSerialScanConcurrency                  = 1
 	DefIndexJoinBatchSize                          = 25000
+	DefIndexJoinAdaptiveThreshold                  = 0
 	DefIndexLookupSize                             = 20000
 	DefDistSQLScanConcurrency                      = 15
 	DefBuildStatsConcurrency                       = 4
@@ -1210,6 +1224,7 @@ const (
 	DefTiDBEvolvePlanTaskStartTime                 = "00:00 +0000"
 	DefTiDBEvolvePlanTaskEndTime                   = "23:59 +0000"
 	DefInnodbLockWaitTimeout                       = 50 // 50s
+	DefEventScheduler                              = false
 	DefTiDBStoreLimit                              = 0
 	DefTiDBMetricSchemaStep                        = 60 // 60s
 	DefTiDBMetricSchemaRangeDuration               = 60 // 60s
@@ -1287,6 +1302,8 @@ const (
 	DefTiDBEnablePrepPlanCache                     = true
 	DefTiDBPrepPlanCacheSize                       = 100
 	DefTiDBSessionPlanCacheSize                    = 100
+	DefTiDBEnableInstancePlanCache                 = false
+	DefTiDBInstancePlanCacheMaxMemSize             = 100 << 20 // 100MB.
 	DefTiDBEnablePrepPlanCacheMemoryMonitor        = true
 	DefTiDBPrepPlanCacheMemoryGuardRatio           = 0.1
 	DefTiDBEnableDistTask                          = disttask.TiDBEnableDistTask
@@ -1364,6 +1381,7 @@ const (
 	DefTiDBEnablePlanCacheForSubquery                 = true
 	DefTiDBLoadBasedReplicaReadThreshold              = time.Second
 	DefTiDBOptEnableLateMaterialization               = true
+	DefTiDBOptEnableMaterializedViewRewrite           = false
 	DefTiDBOptOrderingIdxSelThresh                    = 0.0
 	DefTiDBOptEnableMPPSharedCTEExecution             = false
 	DefTiDBPlanCacheInvalidationOnFreshStats          = true
@@ -1429,6 +1447,8 @@ var (
 	MaxAutoAnalyzeTime                   = atomic.NewInt64(DefTiDBMaxAutoAnalyzeTime)
 	// variables for plan cache
 	PreparedPlanCacheMemoryGuardRatio = atomic.NewFloat64(DefTiDBPrepPlanCacheMemoryGuardRatio)
+	EnableInstancePlanCache           = atomic.NewBool(DefTiDBEnableInstancePlanCache)
+	InstancePlanCacheMaxMemSize       = atomic.NewInt64(DefTiDBInstancePlanCacheMaxMemSize)
 	EnableDistTask                    = atomic.NewBool(DefTiDBEnableDistTask)
 	DDLForce2Queue                    = atomic.NewBool(false)
 	EnableNoopVariables               = atomic.NewBool(DefTiDBEnableNoopVariables)
@@ -1453,6 +1473,7 @@ var (
 	PasswordValidtaionNumberCount      = atomic.NewInt32(1)
 	PasswordValidationSpecialCharCount = atomic.NewInt32(1)
 	EnableTTLJob                       = atomic.NewBool(DefTiDBTTLJobEnable)
+	EnableEventScheduler               = atomic.NewBool(DefEventScheduler)
 	TTLScanBatchSize                   = atomic.NewInt64(DefTiDBTTLScanBatchSize)
 	TTLDeleteBatchSize                 = atomic.NewInt64(DefTiDBTTLDeleteBatchSize)
 	TTLDeleteRateLimit                 = atomic.NewInt64(DefTiDBTTLDeleteRateLimit)
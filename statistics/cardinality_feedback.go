@@ -0,0 +1,178 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// CardinalityFeedbackQErrorThreshold is the minimal q-error, i.e. max(est/act, act/est), of a data source
+// to be corrected by the cardinality feedback.
+const CardinalityFeedbackQErrorThreshold = 10.0
+
+// CardinalityFeedback is the instance level store of the cardinality feedback. After a statement whose digest
+// is enabled for the feedback finishes, the actual row counts of its data sources are compared with the row
+// counts estimated from statistics, and the ones with large errors are stored. The next time the statement is
+// compiled, the planner corrects the estimations of these data sources by the stored feedback.
+var CardinalityFeedback = NewCardinalityFeedbackStore()
+
+// CardinalityFeedbackItem is the feedback of a data source in a statement.
+type CardinalityFeedbackItem struct {
+	// DataSource identifies the data source in the statement, in the `alias@sel_N` format.
+	DataSource string
+	// Operator is the executed operator which outputs the rows of the data source.
+	Operator string
+	// EstRows is the row count estimated from statistics, without any correction.
+	EstRows float64
+	// ActRows is the actual row count of the last execution.
+	ActRows    float64
+	UpdateTime time.Time
+}
+
+// Correction returns the factor to correct the estimated row count of the data source.
+func (i *CardinalityFeedbackItem) Correction() float64 {
+	if i.EstRows <= 0 {
+		// A zero estimation can't be corrected by scaling.
+		return 1
+	}
+	return math.Max(i.ActRows, 1) / i.EstRows
+}
+
+// CardinalityFeedbackRecord is an enabled digest and one of its feedback items, the item is nil if no large
+// error has been found for the digest.
+type CardinalityFeedbackRecord struct {
+	Digest string
+	Item   *CardinalityFeedbackItem
+}
+
+// CardinalityFeedbackStore stores the cardinality feedback of the enabled digests.
+type CardinalityFeedbackStore struct {
+	sync.RWMutex
+	// digests maps an enabled digest to its feedback items, which are keyed by the data source.
+	digests map[string]map[string]*CardinalityFeedbackItem
+	// enabledCount is used to skip the digest computation and lookup when no digest is enabled.
+	enabledCount atomic.Int64
+}
+
+// NewCardinalityFeedbackStore creates a new CardinalityFeedbackStore.
+func NewCardinalityFeedbackStore() *CardinalityFeedbackStore {
+	return &CardinalityFeedbackStore{
+		digests: make(map[string]map[string]*CardinalityFeedbackItem),
+	}
+}
+
+// Enable enables the cardinality feedback for the digest.
+func (s *CardinalityFeedbackStore) Enable(digest string) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.digests[digest]; !ok {
+		s.digests[digest] = make(map[string]*CardinalityFeedbackItem)
+		s.enabledCount.Store(int64(len(s.digests)))
+	}
+}
+
+// Disable disables the cardinality feedback for the digest and drops its feedback.
+func (s *CardinalityFeedbackStore) Disable(digest string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.digests, digest)
+	s.enabledCount.Store(int64(len(s.digests)))
+}
+
+// Reset drops the feedback of the digest, or of all the digests if the digest is empty.
+// The digests are still enabled.
+func (s *CardinalityFeedbackStore) Reset(digest string) {
+	s.Lock()
+	defer s.Unlock()
+	for d := range s.digests {
+		if digest == "" || d == digest {
+			s.digests[d] = make(map[string]*CardinalityFeedbackItem)
+		}
+	}
+}
+
+// AnyEnabled returns whether the cardinality feedback is enabled for any digest.
+func (s *CardinalityFeedbackStore) AnyEnabled() bool {
+	return s.enabledCount.Load() > 0
+}
+
+// IsEnabled returns whether the cardinality feedback is enabled for the digest.
+func (s *CardinalityFeedbackStore) IsEnabled(digest string) bool {
+	if !s.AnyEnabled() {
+		return false
+	}
+	s.RLock()
+	defer s.RUnlock()
+	_, ok := s.digests[digest]
+	return ok
+}
+
+// Correction returns the factor to correct the estimated row count of the data source in the digest.
+func (s *CardinalityFeedbackStore) Correction(digest, dataSource string) (float64, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	item, ok := s.digests[digest][dataSource]
+	if !ok {
+		return 1, false
+	}
+	return item.Correction(), true
+}
+
+// Update updates the feedback of the digest by the row counts of its last execution. The items with large
+// errors are stored and the others are dropped, since the estimations are accurate enough without correction.
+func (s *CardinalityFeedbackStore) Update(digest string, items []*CardinalityFeedbackItem) {
+	s.Lock()
+	defer s.Unlock()
+	feedback, ok := s.digests[digest]
+	if !ok {
+		return
+	}
+	for _, item := range items {
+		est, act := math.Max(item.EstRows, 1), math.Max(item.ActRows, 1)
+		if math.Max(est/act, act/est) < CardinalityFeedbackQErrorThreshold {
+			delete(feedback, item.DataSource)
+			continue
+		}
+		feedback[item.DataSource] = item
+	}
+}
+
+// Records returns all the enabled digests and their feedback, ordered by digest and data source.
+func (s *CardinalityFeedbackStore) Records() []CardinalityFeedbackRecord {
+	s.RLock()
+	defer s.RUnlock()
+	records := make([]CardinalityFeedbackRecord, 0, len(s.digests))
+	for digest, feedback := range s.digests {
+		if len(feedback) == 0 {
+			records = append(records, CardinalityFeedbackRecord{Digest: digest})
+			continue
+		}
+		for _, item := range feedback {
+			records = append(records, CardinalityFeedbackRecord{Digest: digest, Item: item})
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Digest != records[j].Digest {
+			return records[i].Digest < records[j].Digest
+		}
+		return records[i].Item != nil && records[j].Item != nil && records[i].Item.DataSource < records[j].Item.DataSource
+	})
+	return records
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinalityFeedbackStore(t *testing.T) {
+	s := NewCardinalityFeedbackStore()
+	require.False(t, s.IsEnabled("d1"))
+	items := []*CardinalityFeedbackItem{
+		{DataSource: "t1@sel_1", EstRows: 10, ActRows: 1000},
+		{DataSource: "t2@sel_1", EstRows: 10, ActRows: 20},
+		{DataSource: "t3@sel_1", EstRows: 1000, ActRows: 0},
+	}
+	// The feedback of the digests which are not enabled is ignored.
+	s.Update("d1", items)
+	require.Len(t, s.Records(), 0)
+
+	s.Enable("d1")
+	s.Enable("d2")
+	require.True(t, s.IsEnabled("d1"))
+	records := s.Records()
+	require.Len(t, records, 2)
+	require.Nil(t, records[0].Item)
+	require.Nil(t, records[1].Item)
+
+	s.Update("d1", items)
+	records = s.Records()
+	require.Len(t, records, 3)
+	require.Equal(t, "t1@sel_1", records[0].Item.DataSource)
+	require.Equal(t, "t3@sel_1", records[1].Item.DataSource)
+	require.Equal(t, "d2", records[2].Digest)
+	correction, ok := s.Correction("d1", "t1@sel_1")
+	require.True(t, ok)
+	require.Equal(t, 100.0, correction)
+	correction, ok = s.Correction("d1", "t3@sel_1")
+	require.True(t, ok)
+	require.Equal(t, 0.001, correction)
+	_, ok = s.Correction("d1", "t2@sel_1")
+	require.False(t, ok)
+
+	// The feedback is dropped once the estimation becomes accurate.
+	s.Update("d1", []*CardinalityFeedbackItem{{DataSource: "t1@sel_1", EstRows: 900, ActRows: 1000}})
+	_, ok = s.Correction("d1", "t1@sel_1")
+	require.False(t, ok)
+
+	s.Reset("")
+	require.True(t, s.IsEnabled("d1"))
+	_, ok = s.Correction("d1", "t3@sel_1")
+	require.False(t, ok)
+
+	s.Disable("d1")
+	require.False(t, s.IsEnabled("d1"))
+	require.Len(t, s.Records(), 1)
+}
@@ -19,6 +19,7 @@
 package table
 
 import (
+	"encoding/binary"
 	"fmt"
 	"strconv"
 	"strings"
@@ -296,6 +297,12 @@ func handleZeroDatetime(ctx sessionctx.Context, col *model.ColumnInfo, casted ty
 func CastValue(ctx sessionctx.Context, val types.Datum, col *model.ColumnInfo, returnErr, forceIgnoreTruncate bool) (casted types.Datum, err error) {
 	sc := ctx.GetSessionVars().StmtCtx
 	casted, err = val.ConvertTo(sc, &col.FieldType)
+	if err == nil && col.SRID != nil && !casted.IsNull() {
+		// The converted geometry is in the MySQL internal format, which starts with the little-endian SRID.
+		if srid := binary.LittleEndian.Uint32(casted.GetBytes()); srid != *col.SRID {
+			err = ErrWrongSRIDForColumn.GenWithStackByArgs(col.Name.O, srid, *col.SRID)
+		}
+	}
 	// TODO: make sure all truncate errors are handled by ConvertTo.
 	if returnErr && err != nil {
 		return casted, err
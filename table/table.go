@@ -109,6 +109,8 @@ var (
 	ErrOptOnCacheTable = dbterror.ClassDDL.NewStd(mysql.ErrOptOnCacheTable)
 	// ErrCheckConstraintViolated return when check constraint is violated.
 	ErrCheckConstraintViolated = dbterror.ClassTable.NewStd(mysql.ErrCheckConstraintViolated)
+	// ErrWrongSRIDForColumn returns when the SRID of a geometry doesn't match the SRID of the column.
+	ErrWrongSRIDForColumn = dbterror.ClassTable.NewStd(mysql.ErrWrongSRIDForColumn)
 )
 
 // RecordIterFunc is used for low-level record iteration.
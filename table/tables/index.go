@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tidb/util/tracing"
 )
@@ -43,6 +44,8 @@ type index struct {
 	// the collation global variable is initialized *after* `NewIndex()`.
 	initNeedRestoreData sync.Once
 	needRestoredData    bool
+	// tokenizer splits the indexed values of a FULLTEXT index into tokens.
+	tokenizer *fulltext.Tokenizer
 }
 
 // NeedRestoredData checks whether the index columns needs restored data.
@@ -73,6 +76,10 @@ func NewIndex(physicalID int64, tblInfo *model.TableInfo, indexInfo *model.Index
 		prefix:   prefix,
 		phyTblID: physicalID,
 	}
+	if indexInfo.FullText {
+		col := tblInfo.Columns[indexInfo.Columns[0].Offset]
+		index.tokenizer = fulltext.NewTokenizer(indexInfo.ParserName.L, fulltext.IsCaseSensitive(col.GetCollate()))
+	}
 	return index
 }
 
@@ -99,7 +106,7 @@ func (c *index) GenIndexKey(sc *stmtctx.StatementContext, indexedValues []types.
 // GenIndexValue generates the index value.
 func (c *index) GenIndexValue(sc *stmtctx.StatementContext, distinct bool, indexedValues []types.Datum, h kv.Handle, restoredData []types.Datum) ([]byte, error) {
 	c.initNeedRestoreData.Do(func() {
-		c.needRestoredData = NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
+		c.needRestoredData = !c.idxInfo.FullText && NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
 	})
 	return tablecodec.GenIndexValuePortal(sc, c.tblInfo, c.idxInfo, c.needRestoredData, distinct, false, indexedValues, h, c.phyTblID, restoredData)
 }
@@ -109,7 +116,11 @@ func (c *index) GenIndexValue(sc *stmtctx.StatementContext, distinct bool, index
 // 2. (i1, [m1,m2], i2, ...) ==> [(i1, m1, i2, ...), (i1, m2, i2, ...)]
 // 3. (i1, null, i2, ...) ==> [(i1, null, i2, ...)]
 // 4. (i1, [], i2, ...) ==> nothing.
+// 5. If FULLTEXT index, (t1, t2, ...) ==> [(w1, null, ...), (w2, null, ...), ...], where w1, w2, ... are the distinct tokens of t1, t2, ...
 func (c *index) getIndexedValue(indexedValues []types.Datum) [][]types.Datum {
+	if c.idxInfo.FullText {
+		return c.getFullTextIndexedValue(indexedValues)
+	}
 	if !c.idxInfo.MVIndex {
 		return [][]types.Datum{indexedValues}
 	}
@@ -156,6 +167,28 @@ out:
 	return vals
 }
 
+// getFullTextIndexedValue splits the text values into tokens, each distinct token is stored as a binary string
+// in an index entry of its own. The other columns of the entry are null, so that the entry is decoded as other indexes.
+func (c *index) getFullTextIndexedValue(indexedValues []types.Datum) [][]types.Datum {
+	vals := make([][]types.Datum, 0, 16)
+	existsVals := make(map[string]struct{})
+	for _, v := range indexedValues {
+		if v.IsNull() {
+			continue
+		}
+		for _, token := range c.tokenizer.Tokenize(v.GetString()) {
+			if _, exists := existsVals[token]; exists {
+				continue
+			}
+			existsVals[token] = struct{}{}
+			val := make([]types.Datum, len(indexedValues))
+			val[0].SetBytes([]byte(token))
+			vals = append(vals, val)
+		}
+	}
+	return vals
+}
+
 // Create creates a new entry in the kvIndex data.
 // If the index is unique and there is an existing entry with the same key,
 // Create will return the existing entry's handle as the first return value, ErrKeyExists as the second return value.
@@ -230,7 +263,7 @@ func (c *index) Create(sctx sessionctx.Context, txn kv.Transaction, indexedValue
 		// save the key buffer to reuse.
 		writeBufs.IndexKeyBuf = key
 		c.initNeedRestoreData.Do(func() {
-			c.needRestoredData = NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
+			c.needRestoredData = !c.idxInfo.FullText && NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
 		})
 		idxVal, err := tablecodec.GenIndexValuePortal(sctx.GetSessionVars().StmtCtx, c.tblInfo, c.idxInfo, c.needRestoredData, distinct, opt.Untouched, value, h, c.phyTblID, handleRestoreData)
 		if err != nil {
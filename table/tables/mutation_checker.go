@@ -219,6 +219,10 @@ func checkIndexKeys(
 		if !ok {
 			return errors.New("index not found")
 		}
+		if indexInfo.FullText {
+			// The keys of a FULLTEXT index are the tokens of the values, which cannot be compared with the row.
+			continue
+		}
 
 		var isTmpIdxValAndDeleted bool
 		// If this is temp index data, need remove last byte of index data.
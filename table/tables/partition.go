@@ -48,6 +48,7 @@ import (
 	"github.com/pingcap/tidb/util/ranger"
 	"github.com/pingcap/tidb/util/stringutil"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -147,7 +148,7 @@ func newPartitionedTable(tbl *TableCommon, tblInfo *model.TableInfo) (table.Part
 	if pi.DDLState == model.StateDeleteReorganization {
 		origIdx := setIndexesState(ret, pi.DDLState)
 		defer unsetIndexesState(ret, origIdx)
-		ret.reorgPartitionExpr, err = newPartitionExpr(tblInfo, pi.DroppingDefinitions)
+		ret.reorgPartitionExpr, err = newPartitionExpr(getReorgTableInfo(tblInfo), pi.DroppingDefinitions)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -168,7 +169,7 @@ func newPartitionedTable(tbl *TableCommon, tblInfo *model.TableInfo) (table.Part
 		if len(pi.AddingDefinitions) > 0 {
 			origIdx := setIndexesState(ret, pi.DDLState)
 			defer unsetIndexesState(ret, origIdx)
-			ret.reorgPartitionExpr, err = newPartitionExpr(tblInfo, pi.AddingDefinitions)
+			ret.reorgPartitionExpr, err = newPartitionExpr(getReorgTableInfo(tblInfo), pi.AddingDefinitions)
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
@@ -227,6 +228,22 @@ func initPartition(t *partitionedTable, def model.PartitionDefinition) (*partiti
 	return &newPart, nil
 }
 
+// getReorgTableInfo returns the table info with the partitioning scheme of the partitions
+// being reorganized, which only differs from the current one during ALTER TABLE ... PARTITION BY
+// and REMOVE PARTITIONING.
+func getReorgTableInfo(tblInfo *model.TableInfo) *model.TableInfo {
+	pi := tblInfo.Partition
+	if !pi.DDLChangeScheme {
+		return tblInfo
+	}
+	reorgTblInfo := *tblInfo
+	reorgPi := *pi
+	reorgPi.Type, reorgPi.Expr, reorgPi.Columns = pi.DDLType, pi.DDLExpr, pi.DDLColumns
+	reorgPi.DDLType, reorgPi.DDLExpr, reorgPi.DDLColumns = pi.Type, pi.Expr, pi.Columns
+	reorgTblInfo.Partition = &reorgPi
+	return &reorgTblInfo
+}
+
 func newPartitionExpr(tblInfo *model.TableInfo, defs []model.PartitionDefinition) (*PartitionExpr, error) {
 	// a partitioned table cannot rely on session context/sql modes, so use a default one!
 	ctx := mock.NewContext()
@@ -236,6 +253,9 @@ func newPartitionExpr(tblInfo *model.TableInfo, defs []model.PartitionDefinition
 		return nil, err
 	}
 	pi := tblInfo.GetPartitionInfo()
+	if pi.Sub != nil {
+		return generateSubPartitionExpr(ctx, tblInfo, defs, columns, names)
+	}
 	switch pi.Type {
 	case model.PartitionTypeRange:
 		return generateRangePartitionExpr(ctx, pi, defs, columns, names)
@@ -245,10 +265,53 @@ func newPartitionExpr(tblInfo *model.TableInfo, defs []model.PartitionDefinition
 		return generateKeyPartitionExpr(ctx, pi, columns, names)
 	case model.PartitionTypeList:
 		return generateListPartitionExpr(ctx, tblInfo, defs, columns, names)
+	case model.PartitionTypeNone:
+		// Non-partitioned table handled as a single partition during DDL,
+		// no expression needed since all rows are in the same partition.
+		return &PartitionExpr{}, nil
 	}
 	panic("cannot reach here")
 }
 
+// generateSubPartitionExpr generates the partition expression of a subpartitioned table,
+// where the first level expression is built on the partitions the subpartitions in defs
+// belong to, and Sub is the expression for the subpartitions within a partition.
+func generateSubPartitionExpr(ctx sessionctx.Context, tblInfo *model.TableInfo,
+	defs []model.PartitionDefinition, columns []*expression.Column, names types.NameSlice) (*PartitionExpr, error) {
+	pi := tblInfo.GetPartitionInfo()
+	firstLevelTblInfo := *tblInfo
+	firstLevelTblInfo.Partition = pi.FirstLevel()
+	firstLevelDefs := pi.FirstLevelDefinitions(defs)
+	var (
+		ret *PartitionExpr
+		err error
+	)
+	switch pi.Type {
+	case model.PartitionTypeRange:
+		ret, err = generateRangePartitionExpr(ctx, firstLevelTblInfo.Partition, firstLevelDefs, columns, names)
+	case model.PartitionTypeList:
+		ret, err = generateListPartitionExpr(ctx, &firstLevelTblInfo, firstLevelDefs, columns, names)
+	default:
+		return nil, errors.Errorf("subpartitioning is not supported for %s partitioning", pi.Type)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	subPi := pi.SubLevel()
+	switch subPi.Type {
+	case model.PartitionTypeHash:
+		ret.Sub, err = generateHashPartitionExpr(ctx, subPi, columns, names)
+	case model.PartitionTypeKey:
+		ret.Sub, err = generateKeyPartitionExpr(ctx, subPi, columns, names)
+	default:
+		return nil, errors.Errorf("subpartitioning by %s is not supported", subPi.Type)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return ret, nil
+}
+
 // PartitionExpr is the partition definition expressions.
 type PartitionExpr struct {
 	// UpperBounds: (x < y1); (x < y2); (x < y3), used by locatePartition.
@@ -266,6 +329,9 @@ type PartitionExpr struct {
 	// ColOffset is the offsets of partition columns.
 	ColumnOffset []int
 	*ForListPruning
+	// Sub is the subpartition expression of a subpartitioned table,
+	// used to locate the subpartition within a partition.
+	Sub *PartitionExpr
 }
 
 // GetPartColumnsForKeyPartition is used to get partition columns for key partition table
@@ -1220,11 +1286,23 @@ func (t *partitionedTable) PartitionExpr() *PartitionExpr {
 }
 
 func (t *partitionedTable) GetPartitionColumnIDs() []int64 {
-	// PARTITION BY {LIST|RANGE} COLUMNS uses columns directly without expressions
 	pi := t.Meta().Partition
-	if len(pi.Columns) > 0 {
-		colIDs := make([]int64, 0, len(pi.Columns))
-		for _, name := range pi.Columns {
+	colIDs := t.partitionColumnIDs(pi.Columns, t.partitionExpr)
+	if pi.Sub != nil {
+		for _, id := range t.partitionColumnIDs(pi.Sub.Columns, t.partitionExpr.Sub) {
+			if !slices.Contains(colIDs, id) {
+				colIDs = append(colIDs, id)
+			}
+		}
+	}
+	return colIDs
+}
+
+func (t *partitionedTable) partitionColumnIDs(columns []model.CIStr, partitionExpr *PartitionExpr) []int64 {
+	// PARTITION BY {LIST|RANGE} COLUMNS uses columns directly without expressions
+	if len(columns) > 0 {
+		colIDs := make([]int64, 0, len(columns))
+		for _, name := range columns {
 			col := table.FindColLowerCase(t.Cols(), name.L)
 			if col == nil {
 				// For safety, should not happen
@@ -1235,7 +1313,10 @@ func (t *partitionedTable) GetPartitionColumnIDs() []int64 {
 		return colIDs
 	}
 
-	partitionCols := expression.ExtractColumns(t.partitionExpr.Expr)
+	if partitionExpr.Expr == nil {
+		return nil
+	}
+	partitionCols := expression.ExtractColumns(partitionExpr.Expr)
 	colIDs := make([]int64, 0, len(partitionCols))
 	for _, col := range partitionCols {
 		colIDs = append(colIDs, col.ID)
@@ -1245,7 +1326,7 @@ func (t *partitionedTable) GetPartitionColumnIDs() []int64 {
 
 func (t *partitionedTable) GetPartitionColumnNames() []model.CIStr {
 	pi := t.Meta().Partition
-	if len(pi.Columns) > 0 {
+	if len(pi.Columns) > 0 && pi.Sub == nil {
 		return pi.Columns
 	}
 	colIDs := t.GetPartitionColumnIDs()
@@ -1281,7 +1362,9 @@ func (t *partitionedTable) CheckForExchangePartition(ctx sessionctx.Context, pi
 func (t *partitionedTable) locatePartitionCommon(ctx sessionctx.Context, pi *model.PartitionInfo, partitionExpr *PartitionExpr, num uint64, r []types.Datum) (int, error) {
 	var err error
 	var idx int
-	switch t.meta.Partition.Type {
+	switch pi.Type {
+	case model.PartitionTypeNone:
+		idx = 0
 	case model.PartitionTypeRange:
 		if len(pi.Columns) == 0 {
 			idx, err = t.locateRangePartition(ctx, partitionExpr, r)
@@ -1299,9 +1382,24 @@ func (t *partitionedTable) locatePartitionCommon(ctx sessionctx.Context, pi *mod
 	if err != nil {
 		return 0, errors.Trace(err)
 	}
+	if pi.Sub != nil {
+		subIdx, err := t.locateSubPartition(ctx, pi, partitionExpr.Sub, r)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		idx = idx*int(pi.Sub.Num) + subIdx
+	}
 	return idx, nil
 }
 
+// locateSubPartition returns the subpartition idx of the input record, within its partition.
+func (t *partitionedTable) locateSubPartition(ctx sessionctx.Context, pi *model.PartitionInfo, subExpr *PartitionExpr, r []types.Datum) (int, error) {
+	if pi.Sub.Type == model.PartitionTypeKey {
+		return subExpr.LocateKeyPartition(pi.Sub.Num, r)
+	}
+	return t.locateHashPartition(ctx, subExpr, pi.Sub.Num, r)
+}
+
 func (t *partitionedTable) locatePartition(ctx sessionctx.Context, r []types.Datum) (int64, error) {
 	pi := t.Meta().GetPartitionInfo()
 	idx, err := t.locatePartitionCommon(ctx, pi, t.partitionExpr, pi.Num, r)
@@ -1323,7 +1421,7 @@ func (t *partitionedTable) locateReorgPartition(ctx sessionctx.Context, r []type
 	} else {
 		numParts = uint64(len(pi.AddingDefinitions))
 	}
-	idx, err := t.locatePartitionCommon(ctx, pi, t.reorgPartitionExpr, numParts, r)
+	idx, err := t.locatePartitionCommon(ctx, getReorgTableInfo(t.Meta()).Partition, t.reorgPartitionExpr, numParts, r)
 	if err != nil {
 		return 0, errors.Trace(err)
 	}
@@ -1390,7 +1488,7 @@ func (t *partitionedTable) locateRangePartition(ctx sessionctx.Context, partitio
 		isNull bool
 		err    error
 	)
-	if col, ok := t.partitionExpr.Expr.(*expression.Column); ok {
+	if col, ok := partitionExpr.Expr.(*expression.Column); ok {
 		if r[col.Index].IsNull() {
 			isNull = true
 		}
@@ -1399,13 +1497,13 @@ func (t *partitionedTable) locateRangePartition(ctx sessionctx.Context, partitio
 		evalBuffer := t.evalBufferPool.Get().(*chunk.MutRow)
 		defer t.evalBufferPool.Put(evalBuffer)
 		evalBuffer.SetDatums(r...)
-		val, isNull, err = t.partitionExpr.Expr.EvalInt(ctx, evalBuffer.ToRow())
+		val, isNull, err = partitionExpr.Expr.EvalInt(ctx, evalBuffer.ToRow())
 		if err != nil {
 			return 0, err
 		}
 		ret = val
 	}
-	unsigned := mysql.HasUnsignedFlag(t.partitionExpr.Expr.GetType().GetFlag())
+	unsigned := mysql.HasUnsignedFlag(partitionExpr.Expr.GetType().GetFlag())
 	ranges := partitionExpr.ForRangePruning
 	length := len(ranges.LessThan)
 	pos := sort.Search(length, func(i int) bool {
@@ -1502,6 +1600,13 @@ func GetReorganizedPartitionedTable(t table.Table) (table.PartitionedTable, erro
 		return nil, dbterror.ErrUnsupportedReorganizePartition.GenWithStackByArgs()
 	}
 	tblInfo := t.Meta().Clone()
+	pi := tblInfo.Partition
+	if pi.DDLChangeScheme {
+		// Use the new partitioning scheme of the AddingDefinitions.
+		pi.Type, pi.Expr, pi.Columns = pi.DDLType, pi.DDLExpr, pi.DDLColumns
+		pi.DDLChangeScheme = false
+		pi.DDLType, pi.DDLExpr, pi.DDLColumns = model.PartitionTypeNone, "", nil
+	}
 	tblInfo.Partition.Definitions = tblInfo.Partition.AddingDefinitions
 	tblInfo.Partition.AddingDefinitions = nil
 	tblInfo.Partition.DroppingDefinitions = nil
@@ -1795,6 +1900,20 @@ func FindPartitionByName(meta *model.TableInfo, parName string) (int64, error) {
 	return -1, errors.Trace(table.ErrUnknownPartition.GenWithStackByArgs(parName, meta.Name.O))
 }
 
+// FindPartitionIDsByName finds the physical partitions in table meta by name, which are
+// all the subpartitions of a partition when the table is subpartitioned.
+func FindPartitionIDsByName(meta *model.TableInfo, parName string) ([]int64, error) {
+	offsets := meta.Partition.FindPartitionDefinitionsByName(parName)
+	if len(offsets) == 0 {
+		return nil, errors.Trace(table.ErrUnknownPartition.GenWithStackByArgs(strings.ToLower(parName), meta.Name.O))
+	}
+	pids := make([]int64, 0, len(offsets))
+	for _, offset := range offsets {
+		pids = append(pids, meta.Partition.Definitions[offset].ID)
+	}
+	return pids, nil
+}
+
 func parseExpr(p *parser.Parser, exprStr string) (ast.ExprNode, error) {
 	exprStr = "select " + exprStr
 	stmts, _, err := p.ParseSQL(exprStr)
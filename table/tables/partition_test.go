@@ -2169,7 +2169,7 @@ func TestKeyPartitionTableMixed(t *testing.T) {
 		"PARTITION p2 VALUES LESS THAN MAXVALUE\n" +
 		")")
 	result = tk.MustQuery("show warnings")
-	result.CheckContain("Unsupported subpartitioning, only using RANGE partitioning")
+	result.Check(testkit.Rows())
 
 	// It ignores /*!50100 */ format
 	tk.MustExec("CREATE TABLE tkey10 (`col1` int, `col2` char(5),`col3` date)" +
@@ -2383,23 +2383,22 @@ func TestKeyPartitionTableDDL(t *testing.T) {
 		"PARTITION BY KEY(col3) PARTITIONS 4")
 	tk.MustExec("INSERT INTO tkey16 values(1,1,1,1),(1,1,2,2),(3,3,3,3),(3,3,4,3),(4,4,4,4),(5,5,5,5),(6,6,6,6),(7,7,7,7),(8,8,8,8),(9,9,9,9),(10,10,10,5),(11,11,11,6),(12,12,12,12),(13,13,13,13),(14,14,14,14)")
 
-	err := tk.ExecToErr("ALTER TABLE tkey15 PARTITION BY KEY(col3) PARTITIONS 4")
-	require.Regexp(t, "alter table partition is unsupported", err)
 	tk.MustExec("ALTER TABLE tkey14 ADD PARTITION PARTITIONS 1")
-	err = tk.ExecToErr("ALTER TABLE tkey14 DROP PARTITION p4")
+	err := tk.ExecToErr("ALTER TABLE tkey14 DROP PARTITION p4")
 	require.Regexp(t, "DROP PARTITION can only be used on RANGE/LIST partitions", err)
 	tk.MustExec("ALTER TABLE tkey14 TRUNCATE PARTITION p3")
 	tk.MustQuery("SELECT COUNT(*) FROM tkey14 partition(p3)").Check(testkit.Rows("0"))
 	tk.MustExec("ALTER TABLE tkey16 COALESCE PARTITION 2")
 	tk.MustExec("ALTER TABLE tkey14 ANALYZE PARTITION p3")
-	err = tk.ExecToErr("ALTER TABLE tkey14 CHECK PARTITION p2")
-	require.Regexp(t, "Unsupported check partition", err)
-	err = tk.ExecToErr("ALTER TABLE tkey14 OPTIMIZE PARTITION p2")
-	require.Regexp(t, "Unsupported optimize partition", err)
-	err = tk.ExecToErr("ALTER TABLE tkey14 REBUILD PARTITION p2")
-	require.Regexp(t, "Unsupported rebuild partition", err)
+	tk.MustExec("ALTER TABLE tkey14 CHECK PARTITION p2")
+	tk.MustExec("ALTER TABLE tkey14 OPTIMIZE PARTITION p2")
+	tk.MustExec("ALTER TABLE tkey14 REBUILD PARTITION p2")
+	tk.MustExec("ADMIN CHECK TABLE tkey14")
 	err = tk.ExecToErr("ALTER TABLE tkey14 EXCHANGE PARTITION p3 WITH TABLE tkey15")
 	require.Regexp(t, "Unsupported partition type of table tkey14 when exchanging partition", err)
+	tk.MustExec("ALTER TABLE tkey15 PARTITION BY KEY(col3) PARTITIONS 4")
+	tk.MustQuery("SELECT COUNT(*) FROM tkey15 partition(p0, p1, p2, p3)").Check(testkit.Rows("1"))
+	tk.MustExec("ADMIN CHECK TABLE tkey15")
 
 	err = tk.ExecToErr("ALTER TABLE tkey16 REORGANIZE PARTITION")
 	require.Regexp(t, "Unsupported reorganize partition", err)
@@ -2409,8 +2408,9 @@ func TestKeyPartitionTableDDL(t *testing.T) {
 	require.Regexp(t, "Unsupported reorganize partition", err)
 	err = tk.ExecToErr("ALTER TABLE tkey16 REORGANIZE PARTITION p0 INTO (PARTITION p4)")
 	require.Regexp(t, "Unsupported reorganize partition", err)
-	err = tk.ExecToErr("ALTER TABLE tkey16 REMOVE PARTITIONING")
-	require.Regexp(t, "Unsupported remove partitioning", err)
+	tk.MustExec("ALTER TABLE tkey16 REMOVE PARTITIONING")
+	tk.MustQuery("SELECT COUNT(*) FROM tkey16").Check(testkit.Rows("15"))
+	tk.MustExec("ADMIN CHECK TABLE tkey16")
 
 	tk.MustExec("CREATE TABLE tkey17 (" +
 		"id INT NOT NULL PRIMARY KEY," +
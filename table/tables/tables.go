@@ -30,6 +30,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/meta"
 	"github.com/pingcap/tidb/meta/autoid"
@@ -488,8 +489,19 @@ func (t *TableCommon) UpdateRecord(ctx context.Context, sctx sessionctx.Context,
 				newData[col.Offset] = value
 				touched[col.Offset] = touched[col.DependencyColumnOffset]
 				checksumData = t.appendInChangeColForChecksum(sctx, h, checksumData, col.ToInfo(), &newData[col.DependencyColumnOffset], &value)
-			} else if needChecksum {
-				checksumData = t.appendNonPublicColForChecksum(sctx, h, checksumData, col.ToInfo(), &value)
+			} else {
+				if col.IsGenerated() && col.GeneratedStored {
+					// The stored generated column is being added, its value must follow the new row.
+					value, err = t.evalNonPublicStoredGeneratedCol(sctx, col, newData)
+					if err != nil {
+						return err
+					}
+					newData[col.Offset] = value
+					touched[col.Offset] = true
+				}
+				if needChecksum {
+					checksumData = t.appendNonPublicColForChecksum(sctx, h, checksumData, col.ToInfo(), &value)
+				}
 			}
 		} else {
 			value = newData[col.Offset]
@@ -577,6 +589,9 @@ func (t *TableCommon) UpdateRecord(ctx context.Context, sctx sessionctx.Context,
 			return errors.Trace(err)
 		}
 	}
+	if err = t.writeMaterializedViewLogs(sctx, txn, h, oldData); err != nil {
+		return err
+	}
 
 	memBuffer.Release(sh)
 	if shouldWriteBinlog(sctx, t.meta) {
@@ -646,9 +661,11 @@ func (t *TableCommon) rebuildIndices(ctx sessionctx.Context, txn kv.Transaction,
 		// If InHandleForeignKeyTrigger or ForeignKeyTriggerCtx.HasFKCascades is true indicate we may have
 		// foreign key cascade need to handle later, then we still need to write index value,
 		// otherwise, the later foreign cascade executor may see data-index inconsistency in txn-mem-buffer.
+		// The same goes for the statements executed by triggers.
 		sessVars := ctx.GetSessionVars()
 		if untouched && !sessVars.InTxn() &&
-			!sessVars.StmtCtx.InHandleForeignKeyTrigger && !sessVars.StmtCtx.ForeignKeyTriggerCtx.HasFKCascades {
+			!sessVars.StmtCtx.InHandleForeignKeyTrigger && !sessVars.StmtCtx.ForeignKeyTriggerCtx.HasFKCascades &&
+			!sessVars.StmtCtx.TriggerCtx.HasTriggers {
 			continue
 		}
 		newVs, err := idx.FetchValues(newData, nil)
@@ -941,7 +958,19 @@ func (t *TableCommon) AddRecord(sctx sessionctx.Context, r []types.Datum, opts .
 			// because `col.State != model.StatePublic` is true here, if col.ChangeStateInfo is not nil, the col should
 			// be handle by the previous if-block.
 
-			if opt.IsUpdate {
+			if col.IsGenerated() && col.GeneratedStored {
+				// The stored generated column is being added, so compute its value from the row
+				// instead of using the default value, the backfill skips the rows written here.
+				value, err = t.evalNonPublicStoredGeneratedCol(sctx, col, r)
+				if err != nil {
+					return nil, err
+				}
+				if col.Offset < len(r) {
+					r[col.Offset] = value
+				} else {
+					r = append(r, value)
+				}
+			} else if opt.IsUpdate {
 				// If `AddRecord` is called by an update, the default value should be handled the update.
 				value = r[col.Offset]
 			} else {
@@ -1072,6 +1101,9 @@ func (t *TableCommon) AddRecord(sctx sessionctx.Context, r []types.Datum, opts .
 			return nil, errors.Trace(err)
 		}
 	}
+	if err = t.writeMaterializedViewLogs(sctx, txn, recordID, nil); err != nil {
+		return nil, err
+	}
 
 	memBuffer.Release(sh)
 
@@ -1342,6 +1374,9 @@ func (t *TableCommon) RemoveRecord(ctx sessionctx.Context, h kv.Handle, r []type
 			return errors.Trace(err)
 		}
 	}
+	if err = t.writeMaterializedViewLogs(ctx, txn, h, r); err != nil {
+		return err
+	}
 	memBuffer.Release(sh)
 
 	if shouldWriteBinlog(ctx, t.meta) {
@@ -1380,6 +1415,49 @@ func (t *TableCommon) RemoveRecord(ctx sessionctx.Context, h kv.Handle, r []type
 	return err
 }
 
+// MaterializedViewLogNoRow is the value of a change log entry of a materialized view
+// when the row doesn't exist before the transaction.
+var MaterializedViewLogNoRow = []byte{0}
+
+// writeMaterializedViewLogs records the change of the row in the change logs of the incrementally
+// refreshed materialized views which read the table. oldRow is nil if the row is inserted.
+// Only the first change of a row in a transaction is recorded, so the entry keeps the row before the transaction.
+func (t *TableCommon) writeMaterializedViewLogs(ctx sessionctx.Context, txn kv.Transaction, h kv.Handle, oldRow []types.Datum) error {
+	if len(t.meta.MaterializedViewLogs) == 0 || !h.IsInt() {
+		return nil
+	}
+	memBuffer := txn.GetMemBuffer()
+	var value []byte
+	for _, mvID := range t.meta.MaterializedViewLogs {
+		key := tablecodec.EncodeMaterializedViewLogKey(mvID, txn.StartTS(), h.IntValue())
+		_, err := memBuffer.Get(context.Background(), key)
+		if err == nil {
+			continue
+		}
+		if !kv.ErrNotExist.Equal(err) {
+			return err
+		}
+		if value == nil {
+			value = MaterializedViewLogNoRow
+			if oldRow != nil {
+				cols := t.Cols()
+				colIDs := make([]int64, 0, len(cols))
+				for _, col := range cols {
+					colIDs = append(colIDs, col.ID)
+				}
+				value, err = tablecodec.EncodeRow(ctx.GetSessionVars().StmtCtx, oldRow[:len(cols)], colIDs, nil, nil, &rowcodec.Encoder{})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if err = memBuffer.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *TableCommon) addInsertBinlog(ctx sessionctx.Context, h kv.Handle, row []types.Datum, colIDs []int64) error {
 	mutation := t.getMutation(ctx)
 	handleData, err := h.Data()
@@ -1919,6 +1997,30 @@ func logWithContext(sctx sessionctx.Context, log func(msg string, fields ...zap.
 	log(msg, ctxFields...)
 }
 
+// evalNonPublicStoredGeneratedCol evaluates the value of a stored generated column which is being added
+// by a DDL job, so it is not handled by the executor. The row is indexed by the column offsets.
+func (t *TableCommon) evalNonPublicStoredGeneratedCol(sctx sessionctx.Context, col *table.Column, r []types.Datum) (types.Datum, error) {
+	colInfos := make([]*model.ColumnInfo, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		colInfos = append(colInfos, c.ColumnInfo)
+	}
+	columns, names, err := expression.ColumnInfos2ColumnsAndNames(sctx, model.CIStr{}, t.meta.Name, colInfos, t.meta)
+	if err != nil {
+		return types.Datum{}, err
+	}
+	expr, err := expression.RewriteSimpleExprWithNames(sctx, col.GeneratedExpr, expression.NewSchema(columns...), names)
+	if err != nil {
+		return types.Datum{}, err
+	}
+	row := make([]types.Datum, len(t.Columns))
+	copy(row, r)
+	val, err := expr.Eval(chunk.MutRowFromDatums(row).ToRow())
+	if err != nil {
+		return types.Datum{}, err
+	}
+	return table.CastValue(sctx, val, col.ColumnInfo, false, false)
+}
+
 func (t *TableCommon) canSkip(col *table.Column, value *types.Datum) bool {
 	return CanSkip(t.Meta(), col, value)
 }
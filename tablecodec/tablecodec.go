@@ -51,6 +51,7 @@ var (
 	tablePrefix     = []byte{'t'}
 	recordPrefixSep = []byte("_r")
 	indexPrefixSep  = []byte("_i")
+	mvLogPrefixSep  = []byte("_l")
 	metaPrefix      = []byte{'m'}
 )
 
@@ -627,7 +628,7 @@ func Unflatten(datum types.Datum, ft *types.FieldType, loc *time.Location) (type
 		datum.SetFloat32(float32(datum.GetFloat64()))
 		return datum, nil
 	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeTinyBlob,
-		mysql.TypeMediumBlob, mysql.TypeBlob, mysql.TypeLongBlob:
+		mysql.TypeMediumBlob, mysql.TypeBlob, mysql.TypeLongBlob, mysql.TypeGeometry:
 		datum.SetString(datum.GetString(), ft.GetCollate())
 	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeYear, mysql.TypeInt24,
 		mysql.TypeLong, mysql.TypeLonglong, mysql.TypeDouble:
@@ -992,6 +993,47 @@ func EncodeTablePrefix(tableID int64) kv.Key {
 	return key
 }
 
+// EncodeMaterializedViewLogPrefix encodes the prefix "t[mvID]_l" of the change log of a materialized view.
+func EncodeMaterializedViewLogPrefix(mvID int64) kv.Key {
+	key := make([]byte, 0, prefixLen)
+	key = append(key, tablePrefix...)
+	key = codec.EncodeInt(key, mvID)
+	key = append(key, mvLogPrefixSep...)
+	return key
+}
+
+// EncodeMaterializedViewLogKey encodes the key of a change log entry of a materialized view,
+// which records that the row of the base table with the handle is changed by the transaction with startTS.
+func EncodeMaterializedViewLogKey(mvID int64, startTS uint64, handle int64) kv.Key {
+	key := make([]byte, 0, prefixLen+2*idLen)
+	key = append(key, EncodeMaterializedViewLogPrefix(mvID)...)
+	key = codec.EncodeUint(key, startTS)
+	key = codec.EncodeInt(key, handle)
+	return key
+}
+
+// DecodeMaterializedViewLogKey decodes the key encoded by EncodeMaterializedViewLogKey.
+func DecodeMaterializedViewLogKey(key kv.Key) (mvID int64, startTS uint64, handle int64, err error) {
+	if len(key) != prefixLen+2*idLen || !hasTablePrefix(key) {
+		return 0, 0, 0, errInvalidKey.GenWithStack("invalid materialized view log key - %q", key)
+	}
+	key = key[tablePrefixLength:]
+	key, mvID, err = codec.DecodeInt(key)
+	if err != nil {
+		return 0, 0, 0, errors.Trace(err)
+	}
+	if !kv.Key(key).HasPrefix(mvLogPrefixSep) {
+		return 0, 0, 0, errInvalidKey.GenWithStack("invalid materialized view log key - %q", key)
+	}
+	key = key[len(mvLogPrefixSep):]
+	key, startTS, err = codec.DecodeUint(key)
+	if err != nil {
+		return 0, 0, 0, errors.Trace(err)
+	}
+	_, handle, err = codec.DecodeInt(key)
+	return mvID, startTS, handle, errors.Trace(err)
+}
+
 // appendTableRecordPrefix appends table record prefix  "t[tableID]_r".
 func appendTableRecordPrefix(buf []byte, tableID int64) []byte {
 	buf = append(buf, tablePrefix...)
@@ -1458,6 +1500,10 @@ func GenIndexValueForClusteredIndexVersion1(sc *stmtctx.StatementContext, tblInf
 		colIds := make([]int64, 0, len(idxInfo.Columns))
 		allRestoredData := make([]types.Datum, 0, len(handleRestoredData)+len(idxInfo.Columns))
 		for i, idxCol := range idxInfo.Columns {
+			// The entries of a FULLTEXT index store tokens rather than the column values.
+			if idxInfo.FullText {
+				break
+			}
 			col := tblInfo.Columns[idxCol.Offset]
 			// If  the column is the primary key's column,
 			// the restored data will be written later. Skip writing it here to avoid redundancy.
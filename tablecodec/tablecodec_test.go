@@ -446,6 +446,26 @@ func TestRecordKey(t *testing.T) {
 	require.Equal(t, int64(0), DecodeTableID(nil))
 }
 
+func TestMaterializedViewLogKey(t *testing.T) {
+	const mvID int64 = 77
+	key := EncodeMaterializedViewLogKey(mvID, math.MaxUint64, -1)
+	require.True(t, key.HasPrefix(EncodeMaterializedViewLogPrefix(mvID)))
+	require.Equal(t, mvID, DecodeTableID(key))
+	tMVID, startTS, handle, err := DecodeMaterializedViewLogKey(key)
+	require.NoError(t, err)
+	require.Equal(t, mvID, tMVID)
+	require.Equal(t, uint64(math.MaxUint64), startTS)
+	require.Equal(t, int64(-1), handle)
+
+	// The log entries are ordered by the start ts of the transactions.
+	require.Less(t, EncodeMaterializedViewLogKey(mvID, 1, 100).Cmp(EncodeMaterializedViewLogKey(mvID, 2, 1)), 0)
+
+	_, _, _, err = DecodeMaterializedViewLogKey(EncodeRowKeyWithHandle(mvID, kv.IntHandle(1)))
+	require.Error(t, err)
+	_, _, _, err = DecodeMaterializedViewLogKey(append(EncodeRowKeyWithHandle(mvID, kv.IntHandle(1)), 'x', 'x', 'x', 'x', 'x', 'x', 'x', 'x'))
+	require.Error(t, err)
+}
+
 func TestPrefix(t *testing.T) {
 	const tableID int64 = 66
 	key := EncodeTablePrefix(tableID)
@@ -76,6 +76,13 @@ func WithSetEnable(enable bool) UpdateTimerOption {
 	}
 }
 
+// WithSetData indicates to set the timer's `Data` field.
+func WithSetData(data []byte) UpdateTimerOption {
+	return func(update *TimerUpdate) {
+		update.Data.Set(data)
+	}
+}
+
 // WithSetSchedExpr indicates to set the timer's schedule policy.
 func WithSetSchedExpr(tp SchedPolicyType, expr string) UpdateTimerOption {
 	return func(update *TimerUpdate) {
@@ -65,3 +65,79 @@ func TestIntervalPolicy(t *testing.T) {
 		require.Equal(t, watermark2.Add(c.interval), tm)
 	}
 }
+
+func TestCronPolicy(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	watermark := time.Date(2023, 5, 31, 23, 30, 15, 123, time.UTC)
+
+	cases := []struct {
+		expr string
+		err  bool
+		next time.Time
+	}{
+		{
+			expr: "CRON_TZ=UTC */10 * * * *",
+			next: time.Date(2023, 5, 31, 23, 40, 0, 0, time.UTC),
+		},
+		{
+			expr: "CRON_TZ=UTC 30 0 2 * * *",
+			next: time.Date(2023, 6, 1, 2, 0, 30, 0, time.UTC),
+		},
+		{
+			expr: "CRON_TZ=Asia/Shanghai 0 2 * * *",
+			next: time.Date(2023, 6, 2, 2, 0, 0, 0, shanghai),
+		},
+		{
+			expr: "TZ=UTC @monthly",
+			next: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "CRON_TZ=UTC 0 0 * * SAT,SUN",
+			next: time.Date(2023, 6, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 0 * *",
+			err:  true,
+		},
+		{
+			expr: "CRON_TZ=Invalid/Zone 0 0 * * *",
+			err:  true,
+		},
+		{
+			expr: "0 25 * * *",
+			err:  true,
+		},
+	}
+
+	for _, c := range cases {
+		p, err := NewSchedCronPolicy(c.expr)
+		if c.err {
+			require.ErrorContains(t, err, fmt.Sprintf("invalid schedule event expr '%s'", c.expr))
+			continue
+		}
+		require.NoError(t, err)
+		tm, ok := p.NextEventTime(watermark)
+		require.True(t, ok, c.expr)
+		require.True(t, c.next.Equal(tm), "%s: %s", c.expr, tm)
+	}
+
+	// The next event is scheduled after now if the watermark is zero.
+	p, err := NewSchedCronPolicy("* * * * *")
+	require.NoError(t, err)
+	now := time.Now()
+	tm, ok := p.NextEventTime(time.Time{})
+	require.True(t, ok)
+	require.True(t, tm.After(now))
+	require.True(t, tm.Before(now.Add(time.Minute+time.Second)))
+
+	// An expression never matches has no next event.
+	p, err = NewSchedCronPolicy("0 0 30 2 *")
+	require.NoError(t, err)
+	_, ok = p.NextEventTime(watermark)
+	require.False(t, ok)
+
+	policy, err := CreateSchedEventPolicy(SchedEventCron, "0 2 * * *")
+	require.NoError(t, err)
+	require.IsType(t, &SchedCronPolicy{}, policy)
+}
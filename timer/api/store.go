@@ -166,6 +166,8 @@ func (c *TimerCond) Clear() {
 type TimerUpdate struct {
 	// Tags indicates to set all tags for a timer.
 	Tags OptionalVal[[]string]
+	// Data indicates to set the timer's `Data` field.
+	Data OptionalVal[[]byte]
 	// Enable indicates to set the timer's `Enable` field.
 	Enable OptionalVal[bool]
 	// SchedPolicyType indicates to set the timer's `SchedPolicyType` field.
@@ -212,6 +214,10 @@ func (u *TimerUpdate) Apply(record *TimerRecord) (*TimerRecord, error) {
 		record.Tags = v
 	}
 
+	if v, ok := u.Data.Get(); ok {
+		record.Data = v
+	}
+
 	if v, ok := u.Enable.Get(); ok {
 		record.Enable = v
 	}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/cron"
 	"github.com/pingcap/tidb/parser/duration"
 )
 
@@ -27,6 +28,9 @@ type SchedPolicyType string
 const (
 	// SchedEventInterval indicates to schedule events every fixed interval.
 	SchedEventInterval SchedPolicyType = "INTERVAL"
+	// SchedEventCron indicates to schedule events by a cron expression like "0 2 * * *". The expression can have an
+	// optional leading second field and a time zone prefix like "CRON_TZ=Asia/Shanghai 0 2 * * *".
+	SchedEventCron SchedPolicyType = "CRON"
 )
 
 // SchedEventPolicy is an interface to tell the runtime how to schedule a timer's events.
@@ -64,6 +68,35 @@ func (p *SchedIntervalPolicy) NextEventTime(watermark time.Time) (time.Time, boo
 	return watermark.Add(p.interval), true
 }
 
+// SchedCronPolicy implements SchedEventPolicy, it is the policy of type `SchedEventCron`.
+type SchedCronPolicy struct {
+	expr     string
+	schedule *cron.Schedule
+}
+
+// NewSchedCronPolicy creates a new SchedCronPolicy.
+func NewSchedCronPolicy(expr string) (*SchedCronPolicy, error) {
+	schedule, err := cron.Parse(expr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid schedule event expr '%s'", expr)
+	}
+
+	return &SchedCronPolicy{
+		expr:     expr,
+		schedule: schedule,
+	}, nil
+}
+
+// NextEventTime returns the next time of the timer event.
+// A next event should be triggered at the first time matching the cron expression after watermark.
+// If the watermark is zero, the first event is triggered at the next matching time from now.
+func (p *SchedCronPolicy) NextEventTime(watermark time.Time) (time.Time, bool) {
+	if watermark.IsZero() {
+		watermark = time.Now()
+	}
+	return p.schedule.Next(watermark)
+}
+
 // ManualRequest is the request info to trigger timer manually.
 type ManualRequest struct {
 	// ManualRequestID is the id of manual request.
@@ -161,6 +194,8 @@ func CreateSchedEventPolicy(tp SchedPolicyType, expr string) (SchedEventPolicy,
 	switch tp {
 	case SchedEventInterval:
 		return NewSchedIntervalPolicy(expr)
+	case SchedEventCron:
+		return NewSchedCronPolicy(expr)
 	default:
 		return nil, errors.Errorf("invalid schedule event type: '%s'", tp)
 	}
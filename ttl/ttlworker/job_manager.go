@@ -631,12 +631,12 @@ func (m *JobManager) couldLockJob(tableStatus *cache.TableStatus, table *cache.P
 
 		startTime := tableStatus.LastJobStartTime
 
-		interval, err := table.TTLInfo.GetJobInterval()
+		nextJobTime, err := table.TTLInfo.NextJobTime(startTime)
 		if err != nil {
 			logutil.Logger(m.ctx).Warn("illegal job interval", zap.Error(err))
 			return false
 		}
-		return startTime.Add(interval).Before(now)
+		return nextJobTime.Before(now)
 	}
 
 	// if isCreate is false, it means to take over an exist job
@@ -1006,24 +1006,24 @@ GROUP BY
 				continue
 			}
 
-			interval, err := tblInfo.TTLInfo.GetJobInterval()
+			record, ok := records[tblInfo.ID]
+			if !ok {
+				noRecordTables = append(noRecordTables, strconv.FormatInt(tblInfo.ID, 10))
+				continue
+			}
+
+			nextJobTime, err := tblInfo.TTLInfo.NextJobTime(record.LastJobTime)
 			if err != nil {
 				logutil.Logger(ctx).Error("failed to get table's job interval",
 					zap.Error(err),
 					zap.String("db", db.Name.String()),
 					zap.String("table", tblInfo.Name.String()),
 				)
-				interval = time.Hour
-			}
-
-			record, ok := records[tblInfo.ID]
-			if !ok {
-				noRecordTables = append(noRecordTables, strconv.FormatInt(tblInfo.ID, 10))
-				continue
+				nextJobTime = record.LastJobTime.Add(time.Hour)
 			}
 
-			if record.AbsoluteDelay > interval {
-				record.ScheduleRelativeDelay = record.AbsoluteDelay - interval
+			if now.After(nextJobTime) {
+				record.ScheduleRelativeDelay = now.Sub(nextJobTime)
 			}
 		}
 	}
@@ -263,6 +263,20 @@ func TestReadyForLockHBTimeoutJobTables(t *testing.T) {
 	}
 }
 
+func TestCouldLockJobWithCronInterval(t *testing.T) {
+	m := NewJobManager("test-id", nil, nil, nil, nil)
+	tbl := newMockTTLTbl(t, "t1")
+	tbl.TTLInfo.JobInterval = "CRON_TZ=UTC 0 2 * * *"
+
+	lastJobStartTime := time.Date(2023, 6, 1, 2, 0, 0, 0, time.UTC)
+	status := &cache.TableStatus{TableID: tbl.ID, ParentTableID: tbl.ID, LastJobStartTime: lastJobStartTime}
+	require.False(t, m.couldLockJob(status, tbl, lastJobStartTime.Add(23*time.Hour), true, true))
+	require.True(t, m.couldLockJob(status, tbl, lastJobStartTime.Add(24*time.Hour+time.Second), true, true))
+
+	tbl.TTLInfo.JobInterval = "CRON_TZ=UTC 0 0 30 2 *"
+	require.False(t, m.couldLockJob(status, tbl, lastJobStartTime.Add(24*time.Hour+time.Second), true, true))
+}
+
 func TestOnTimerTick(t *testing.T) {
 	var leader atomic.Bool
 	m := NewJobManager("test-id", newMockSessionPool(t), nil, nil, func() bool {
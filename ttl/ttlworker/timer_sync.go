@@ -253,9 +253,19 @@ func (g *TTLTimersSyncer) shouldSyncTimer(timer *timerapi.TimerRecord, schema mo
 
 	tags := getTimerTags(schema, tblInfo, partition)
 	ttlInfo := tblInfo.TTLInfo
+	policyType, policyExpr := getTimerSchedPolicy(ttlInfo)
 	return !slices.Equal(timer.Tags, tags) ||
 		timer.Enable != ttlInfo.Enable ||
-		timer.SchedPolicyExpr != ttlInfo.JobInterval
+		timer.SchedPolicyType != policyType ||
+		timer.SchedPolicyExpr != policyExpr
+}
+
+// getTimerSchedPolicy returns the schedule policy of the timer according to the job interval of the TTL table.
+func getTimerSchedPolicy(ttlInfo *model.TTLInfo) (timerapi.SchedPolicyType, string) {
+	if ttlInfo.IsJobIntervalCron() {
+		return timerapi.SchedEventCron, ttlInfo.JobInterval
+	}
+	return timerapi.SchedEventInterval, ttlInfo.JobInterval
 }
 
 func (g *TTLTimersSyncer) syncOneTimer(ctx context.Context, se session.Session, schema model.CIStr, tblInfo *model.TableInfo, partition *model.PartitionDefinition, skipCache bool) (*timerapi.TimerRecord, error) {
@@ -306,12 +316,13 @@ func (g *TTLTimersSyncer) syncOneTimer(ctx context.Context, se session.Session,
 			return nil, err
 		}
 
+		policyType, policyExpr := getTimerSchedPolicy(ttlInfo)
 		timer, err = g.cli.CreateTimer(ctx, timerapi.TimerSpec{
 			Key:             key,
 			Tags:            tags,
 			Data:            data,
-			SchedPolicyType: timerapi.SchedEventInterval,
-			SchedPolicyExpr: ttlInfo.JobInterval,
+			SchedPolicyType: policyType,
+			SchedPolicyExpr: policyExpr,
 			HookClass:       timerHookClass,
 			Watermark:       watermark,
 			Enable:          ttlInfo.Enable,
@@ -330,7 +341,7 @@ func (g *TTLTimersSyncer) syncOneTimer(ctx context.Context, se session.Session,
 
 	err = g.cli.UpdateTimer(ctx, timer.ID,
 		timerapi.WithSetTags(tags),
-		timerapi.WithSetSchedExpr(timerapi.SchedEventInterval, tblInfo.TTLInfo.JobInterval),
+		timerapi.WithSetSchedExpr(getTimerSchedPolicy(tblInfo.TTLInfo)),
 		timerapi.WithSetEnable(tblInfo.TTLInfo.Enable),
 	)
 
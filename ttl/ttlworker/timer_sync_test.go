@@ -379,6 +379,21 @@ func TestTTLTimerSync(t *testing.T) {
 	require.GreaterOrEqual(t, lastSyncTime.Unix(), now.Unix())
 	checkTimerCnt(t, cli, 7)
 	checkTimersNotChange(t, cli, timer2, timer3, timer4, timer5, timerP10, timerP11, timerP12)
+
+	// use cron expression as job interval
+	tk.MustExec("alter table t3 ttl_job_interval='CRON_TZ=Asia/Shanghai 0 2 * * *'")
+	sync.SyncTimers(context.TODO(), do.InfoSchema())
+	require.Equal(t, syncCnt+1, syncTimerCounter.Val())
+	syncCnt = syncTimerCounter.Val()
+	timer3 = checkTimerWithTableMeta(t, do, cli, "test", "t3", "", zeroTime)
+	require.Equal(t, timerapi.SchedEventCron, timer3.SchedPolicyType)
+	checkTimersNotChange(t, cli, timer2, timer4, timer5, timerP10, timerP11, timerP12)
+
+	tk.MustExec("alter table t3 ttl_job_interval='1h'")
+	sync.SyncTimers(context.TODO(), do.InfoSchema())
+	require.Equal(t, syncCnt+1, syncTimerCounter.Val())
+	timer3 = checkTimerWithTableMeta(t, do, cli, "test", "t3", "", zeroTime)
+	require.Equal(t, timerapi.SchedEventInterval, timer3.SchedPolicyType)
 }
 
 func insertTTLTableStatusWatermark(t *testing.T, do *domain.Domain, tk *testkit.TestKit, db, table, partition string, watermark time.Time, jobRunning bool) {
@@ -454,7 +469,11 @@ func checkTimerWithTableMeta(t *testing.T, do *domain.Domain, cli timerapi.Timer
 	require.NoError(t, err)
 
 	require.Equal(t, physical.TTLInfo.Enable, timer.Enable)
-	require.Equal(t, timerapi.SchedEventInterval, timer.SchedPolicyType)
+	if physical.TTLInfo.IsJobIntervalCron() {
+		require.Equal(t, timerapi.SchedEventCron, timer.SchedPolicyType)
+	} else {
+		require.Equal(t, timerapi.SchedEventInterval, timer.SchedPolicyType)
+	}
 	require.Equal(t, physical.TTLInfo.JobInterval, timer.SchedPolicyExpr)
 	if partition == "" {
 		require.Equal(t, []string{
@@ -939,6 +939,8 @@ func (d *Datum) ConvertTo(sc *stmtctx.StatementContext, target *FieldType) (Datu
 		return d.convertToMysqlSet(sc, target)
 	case mysql.TypeJSON:
 		return d.convertToMysqlJSON(sc, target)
+	case mysql.TypeGeometry:
+		return d.convertToMysqlGeometry(sc, target)
 	case mysql.TypeNull:
 		return Datum{}, nil
 	default:
@@ -1686,6 +1688,23 @@ func (d *Datum) convertToMysqlSet(sc *stmtctx.StatementContext, target *FieldTyp
 	return ret, err
 }
 
+func (d *Datum) convertToMysqlGeometry(_ *stmtctx.StatementContext, target *FieldType) (ret Datum, err error) {
+	switch d.k {
+	case KindString, KindBytes, KindBinaryLiteral:
+		var g *Geometry
+		if g, err = ParseGeometry(d.GetBytes()); err != nil {
+			return ret, err
+		}
+		if tp := target.GetGeometryType(); tp != mysql.GeometryTypeGeometry && tp != g.Tp {
+			return ret, ErrCantCreateGeometryObject.GenWithStackByArgs()
+		}
+		ret.SetBytes(g.Encode())
+	default:
+		err = ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	return ret, err
+}
+
 func (d *Datum) convertToMysqlJSON(_ *stmtctx.StatementContext, _ *FieldType) (ret Datum, err error) {
 	switch d.k {
 	case KindString, KindBytes:
@@ -163,6 +163,9 @@ var kind2Str = map[byte]string{
 // TypeStr converts tp to a string.
 var TypeStr = ast.TypeStr
 
+// GeometryTypeStr converts a geometry type to a string.
+var GeometryTypeStr = ast.GeometryTypeStr
+
 // KindStr converts kind to a string.
 func KindStr(kind byte) (r string) {
 	return kind2Str[kind]
@@ -0,0 +1,634 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/parser/mysql"
+)
+
+const (
+	wkbBigEndian    = 0
+	wkbLittleEndian = 1
+
+	// maxGeometryDepth is the max nesting depth of the geometry collections.
+	maxGeometryDepth = 64
+	// sridLen is the length of the SRID prefix of the stored geometry.
+	sridLen = 4
+)
+
+// GeomPoint is a point of the plane.
+type GeomPoint struct {
+	X float64
+	Y float64
+}
+
+// Geometry is a spatial value of the OpenGIS geometry model.
+// It is stored as the 4 bytes little-endian SRID followed by the WKB of the geometry, which is the same as MySQL.
+type Geometry struct {
+	// Tp is the geometry type, which is one of the mysql.GeometryType constants.
+	Tp byte
+	// SRID is the spatial reference system identifier.
+	SRID uint32
+	// Points are the points of a Point or a LineString.
+	Points []GeomPoint
+	// Rings are the rings of a Polygon, the first one is the exterior ring.
+	Rings [][]GeomPoint
+	// Geoms are the members of a MultiPoint, MultiLineString, MultiPolygon or GeometryCollection.
+	Geoms []*Geometry
+}
+
+// NewGeomPoint creates a Point geometry.
+func NewGeomPoint(x, y float64, srid uint32) *Geometry {
+	return &Geometry{Tp: mysql.GeometryTypePoint, SRID: srid, Points: []GeomPoint{{X: x, Y: y}}}
+}
+
+// GeometryTypeName returns the name of the geometry type in upper case.
+func GeometryTypeName(tp byte) string {
+	if tp == mysql.GeometryTypeGeometryCollection {
+		return "GEOMETRYCOLLECTION"
+	}
+	return strings.ToUpper(GeometryTypeStr(tp))
+}
+
+// IsEmpty returns whether the geometry is an empty geometry collection.
+func (g *Geometry) IsEmpty() bool {
+	if g.Tp != mysql.GeometryTypeGeometryCollection {
+		return false
+	}
+	for _, m := range g.Geoms {
+		if !m.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// SetSRID sets the SRID of the geometry and its members.
+func (g *Geometry) SetSRID(srid uint32) {
+	g.SRID = srid
+	for _, m := range g.Geoms {
+		m.SetSRID(srid)
+	}
+}
+
+// Validate checks whether the geometry is well-formed.
+func (g *Geometry) Validate() error {
+	return g.validate(0)
+}
+
+func (g *Geometry) validate(depth int) error {
+	if depth > maxGeometryDepth {
+		return ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	for _, p := range g.Points {
+		if math.IsNaN(p.X) || math.IsInf(p.X, 0) || math.IsNaN(p.Y) || math.IsInf(p.Y, 0) {
+			return ErrCantCreateGeometryObject.GenWithStackByArgs()
+		}
+	}
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		if len(g.Points) != 1 {
+			return ErrCantCreateGeometryObject.GenWithStackByArgs()
+		}
+	case mysql.GeometryTypeLineString:
+		if len(g.Points) < 2 {
+			return ErrCantCreateGeometryObject.GenWithStackByArgs()
+		}
+	case mysql.GeometryTypePolygon:
+		if len(g.Rings) == 0 {
+			return ErrCantCreateGeometryObject.GenWithStackByArgs()
+		}
+		for _, ring := range g.Rings {
+			if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+				return ErrCantCreateGeometryObject.GenWithStackByArgs()
+			}
+			for _, p := range ring {
+				if math.IsNaN(p.X) || math.IsInf(p.X, 0) || math.IsNaN(p.Y) || math.IsInf(p.Y, 0) {
+					return ErrCantCreateGeometryObject.GenWithStackByArgs()
+				}
+			}
+		}
+	case mysql.GeometryTypeMultiPoint, mysql.GeometryTypeMultiLineString, mysql.GeometryTypeMultiPolygon:
+		if len(g.Geoms) == 0 {
+			return ErrCantCreateGeometryObject.GenWithStackByArgs()
+		}
+		for _, m := range g.Geoms {
+			if m.Tp != g.Tp-3 {
+				return ErrCantCreateGeometryObject.GenWithStackByArgs()
+			}
+			if err := m.validate(depth + 1); err != nil {
+				return err
+			}
+		}
+	case mysql.GeometryTypeGeometryCollection:
+		for _, m := range g.Geoms {
+			if err := m.validate(depth + 1); err != nil {
+				return err
+			}
+		}
+	default:
+		return ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	return nil
+}
+
+// ParseGeometry parses a geometry from the stored format, which is the SRID followed by the WKB.
+func ParseGeometry(data []byte) (*Geometry, error) {
+	if len(data) < sridLen {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	return ParseWKB(data[sridLen:], binary.LittleEndian.Uint32(data))
+}
+
+// Encode encodes the geometry into the stored format.
+func (g *Geometry) Encode() []byte {
+	buf := binary.LittleEndian.AppendUint32(make([]byte, 0, 64), g.SRID)
+	return g.appendWKB(buf)
+}
+
+// WKB returns the well-known binary representation of the geometry.
+func (g *Geometry) WKB() []byte {
+	return g.appendWKB(make([]byte, 0, 64))
+}
+
+func (g *Geometry) appendWKB(buf []byte) []byte {
+	buf = append(buf, wkbLittleEndian)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(g.Tp))
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		buf = appendWKBPoint(buf, g.Points[0])
+	case mysql.GeometryTypeLineString:
+		buf = appendWKBPoints(buf, g.Points)
+	case mysql.GeometryTypePolygon:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Rings)))
+		for _, ring := range g.Rings {
+			buf = appendWKBPoints(buf, ring)
+		}
+	default:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Geoms)))
+		for _, m := range g.Geoms {
+			buf = m.appendWKB(buf)
+		}
+	}
+	return buf
+}
+
+func appendWKBPoint(buf []byte, p GeomPoint) []byte {
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(p.X))
+	return binary.LittleEndian.AppendUint64(buf, math.Float64bits(p.Y))
+}
+
+func appendWKBPoints(buf []byte, points []GeomPoint) []byte {
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(points)))
+	for _, p := range points {
+		buf = appendWKBPoint(buf, p)
+	}
+	return buf
+}
+
+// ParseWKB parses a geometry from the well-known binary representation.
+func ParseWKB(data []byte, srid uint32) (*Geometry, error) {
+	r := wkbReader{data: data}
+	g, err := r.readGeometry(srid, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.data) != 0 {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	if err = g.Validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+type wkbReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func (r *wkbReader) readUint32() (uint32, bool) {
+	if len(r.data) < 4 {
+		return 0, false
+	}
+	v := r.order.Uint32(r.data)
+	r.data = r.data[4:]
+	return v, true
+}
+
+func (r *wkbReader) readPoint() (GeomPoint, bool) {
+	if len(r.data) < 16 {
+		return GeomPoint{}, false
+	}
+	p := GeomPoint{
+		X: math.Float64frombits(r.order.Uint64(r.data)),
+		Y: math.Float64frombits(r.order.Uint64(r.data[8:])),
+	}
+	r.data = r.data[16:]
+	return p, true
+}
+
+func (r *wkbReader) readPoints() ([]GeomPoint, bool) {
+	n, ok := r.readUint32()
+	// Check the count against the remaining data to avoid allocating for a malformed count.
+	if !ok || uint64(n)*16 > uint64(len(r.data)) {
+		return nil, false
+	}
+	points := make([]GeomPoint, 0, n)
+	for i := uint32(0); i < n; i++ {
+		p, _ := r.readPoint()
+		points = append(points, p)
+	}
+	return points, true
+}
+
+func (r *wkbReader) readGeometry(srid uint32, depth int) (*Geometry, error) {
+	if len(r.data) < 1 || depth > maxGeometryDepth {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	switch r.data[0] {
+	case wkbBigEndian:
+		r.order = binary.BigEndian
+	case wkbLittleEndian:
+		r.order = binary.LittleEndian
+	default:
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	r.data = r.data[1:]
+	tp, ok := r.readUint32()
+	if !ok || tp < uint32(mysql.GeometryTypePoint) || tp > uint32(mysql.GeometryTypeGeometryCollection) {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	g := &Geometry{Tp: byte(tp), SRID: srid}
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		var p GeomPoint
+		if p, ok = r.readPoint(); ok {
+			g.Points = []GeomPoint{p}
+		}
+	case mysql.GeometryTypeLineString:
+		g.Points, ok = r.readPoints()
+	case mysql.GeometryTypePolygon:
+		var n uint32
+		n, ok = r.readUint32()
+		if ok && uint64(n)*4 > uint64(len(r.data)) {
+			ok = false
+		}
+		for i := uint32(0); ok && i < n; i++ {
+			var ring []GeomPoint
+			if ring, ok = r.readPoints(); ok {
+				g.Rings = append(g.Rings, ring)
+			}
+		}
+	default:
+		var n uint32
+		n, ok = r.readUint32()
+		// Each member takes at least 9 bytes.
+		if ok && uint64(n)*9 > uint64(len(r.data)) {
+			ok = false
+		}
+		for i := uint32(0); ok && i < n; i++ {
+			m, err := r.readGeometry(srid, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			g.Geoms = append(g.Geoms, m)
+		}
+	}
+	if !ok {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	return g, nil
+}
+
+// WKT returns the well-known text representation of the geometry.
+func (g *Geometry) WKT() string {
+	var sb strings.Builder
+	g.writeWKT(&sb)
+	return sb.String()
+}
+
+func (g *Geometry) writeWKT(sb *strings.Builder) {
+	sb.WriteString(GeometryTypeName(g.Tp))
+	switch g.Tp {
+	case mysql.GeometryTypePoint, mysql.GeometryTypeLineString:
+		writeWKTPoints(sb, g.Points)
+	case mysql.GeometryTypePolygon:
+		writeWKTRings(sb, g.Rings)
+	case mysql.GeometryTypeMultiPoint, mysql.GeometryTypeMultiLineString:
+		sb.WriteByte('(')
+		for i, m := range g.Geoms {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			writeWKTPoints(sb, m.Points)
+		}
+		sb.WriteByte(')')
+	case mysql.GeometryTypeMultiPolygon:
+		sb.WriteByte('(')
+		for i, m := range g.Geoms {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			writeWKTRings(sb, m.Rings)
+		}
+		sb.WriteByte(')')
+	case mysql.GeometryTypeGeometryCollection:
+		if len(g.Geoms) == 0 {
+			sb.WriteString(" EMPTY")
+			return
+		}
+		sb.WriteByte('(')
+		for i, m := range g.Geoms {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			m.writeWKT(sb)
+		}
+		sb.WriteByte(')')
+	}
+}
+
+func writeWKTPoints(sb *strings.Builder, points []GeomPoint) {
+	sb.WriteByte('(')
+	for i, p := range points {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(formatGeomCoord(p.X))
+		sb.WriteByte(' ')
+		sb.WriteString(formatGeomCoord(p.Y))
+	}
+	sb.WriteByte(')')
+}
+
+func writeWKTRings(sb *strings.Builder, rings [][]GeomPoint) {
+	sb.WriteByte('(')
+	for i, ring := range rings {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		writeWKTPoints(sb, ring)
+	}
+	sb.WriteByte(')')
+}
+
+// formatGeomCoord formats a coordinate in the shortest form.
+func formatGeomCoord(f float64) string {
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-5 || abs >= 1e15) {
+		return strings.Replace(strconv.FormatFloat(f, 'g', -1, 64), "e+", "e", 1)
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// ParseWKT parses a geometry from the well-known text representation.
+func ParseWKT(s string, srid uint32) (*Geometry, error) {
+	p := wktParser{s: s}
+	g, err := p.parseGeometry(srid, 0)
+	if err != nil {
+		return nil, err
+	}
+	if p.next() != "" {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	if err = g.Validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+type wktParser struct {
+	s   string
+	pos int
+}
+
+// next returns the next token, which is a word, a number or a punctuation.
+func (p *wktParser) next() string {
+	for p.pos < len(p.s) && isWKTSpace(p.s[p.pos]) {
+		p.pos++
+	}
+	if p.pos >= len(p.s) {
+		return ""
+	}
+	start := p.pos
+	switch c := p.s[p.pos]; {
+	case c == '(' || c == ')' || c == ',':
+		p.pos++
+	case isWKTWordChar(c):
+		for p.pos < len(p.s) && isWKTWordChar(p.s[p.pos]) {
+			p.pos++
+		}
+	case isWKTNumberChar(c):
+		for p.pos < len(p.s) && isWKTNumberChar(p.s[p.pos]) {
+			p.pos++
+		}
+	default:
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *wktParser) peek() string {
+	pos := p.pos
+	tok := p.next()
+	p.pos = pos
+	return tok
+}
+
+func (p *wktParser) expect(tok string) bool {
+	return p.next() == tok
+}
+
+func isWKTSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isWKTWordChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_'
+}
+
+func isWKTNumberChar(c byte) bool {
+	return (c >= '0' && c <= '9') || c == '.' || c == '-' || c == '+' || c == 'e' || c == 'E'
+}
+
+var wktGeometryTypes = map[string]byte{
+	"POINT":              mysql.GeometryTypePoint,
+	"LINESTRING":         mysql.GeometryTypeLineString,
+	"POLYGON":            mysql.GeometryTypePolygon,
+	"MULTIPOINT":         mysql.GeometryTypeMultiPoint,
+	"MULTILINESTRING":    mysql.GeometryTypeMultiLineString,
+	"MULTIPOLYGON":       mysql.GeometryTypeMultiPolygon,
+	"GEOMETRYCOLLECTION": mysql.GeometryTypeGeometryCollection,
+	"GEOMCOLLECTION":     mysql.GeometryTypeGeometryCollection,
+}
+
+func (p *wktParser) parseGeometry(srid uint32, depth int) (*Geometry, error) {
+	tp, ok := wktGeometryTypes[strings.ToUpper(p.next())]
+	if !ok || depth > maxGeometryDepth {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	g := &Geometry{Tp: tp, SRID: srid}
+	if tp == mysql.GeometryTypeGeometryCollection && strings.EqualFold(p.peek(), "EMPTY") {
+		p.next()
+		return g, nil
+	}
+	if !p.expect("(") {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	// The point lists consume the closing parenthesis.
+	closed := false
+	switch tp {
+	case mysql.GeometryTypePoint:
+		var pt GeomPoint
+		if pt, ok = p.parsePoint(); ok {
+			g.Points = []GeomPoint{pt}
+		}
+	case mysql.GeometryTypeLineString:
+		g.Points, ok = p.parsePointList()
+		closed = true
+	case mysql.GeometryTypePolygon:
+		g.Rings, ok = p.parseRingList()
+		closed = true
+	case mysql.GeometryTypeMultiPoint:
+		// Both MULTIPOINT(0 0,1 1) and MULTIPOINT((0 0),(1 1)) are accepted.
+		for ok = true; ok; {
+			var pt GeomPoint
+			if p.peek() == "(" {
+				p.next()
+				pt, ok = p.parsePoint()
+				ok = ok && p.expect(")")
+			} else {
+				pt, ok = p.parsePoint()
+			}
+			if !ok {
+				break
+			}
+			g.Geoms = append(g.Geoms, &Geometry{Tp: mysql.GeometryTypePoint, SRID: srid, Points: []GeomPoint{pt}})
+			if p.peek() != "," {
+				break
+			}
+			p.next()
+		}
+	case mysql.GeometryTypeMultiLineString:
+		for ok = true; ok; {
+			var points []GeomPoint
+			if ok = p.expect("("); !ok {
+				break
+			}
+			if points, ok = p.parsePointList(); !ok {
+				break
+			}
+			g.Geoms = append(g.Geoms, &Geometry{Tp: mysql.GeometryTypeLineString, SRID: srid, Points: points})
+			if p.peek() != "," {
+				break
+			}
+			p.next()
+		}
+	case mysql.GeometryTypeMultiPolygon:
+		for ok = true; ok; {
+			var rings [][]GeomPoint
+			if ok = p.expect("("); !ok {
+				break
+			}
+			if rings, ok = p.parseRingList(); !ok {
+				break
+			}
+			g.Geoms = append(g.Geoms, &Geometry{Tp: mysql.GeometryTypePolygon, SRID: srid, Rings: rings})
+			if p.peek() != "," {
+				break
+			}
+			p.next()
+		}
+	case mysql.GeometryTypeGeometryCollection:
+		if p.peek() == ")" {
+			break
+		}
+		for {
+			m, err := p.parseGeometry(srid, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			g.Geoms = append(g.Geoms, m)
+			if p.peek() != "," {
+				break
+			}
+			p.next()
+		}
+	}
+	if !ok || (!closed && !p.expect(")")) {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	return g, nil
+}
+
+func (p *wktParser) parseNumber() (float64, bool) {
+	f, err := strconv.ParseFloat(p.next(), 64)
+	return f, err == nil
+}
+
+func (p *wktParser) parsePoint() (GeomPoint, bool) {
+	x, ok := p.parseNumber()
+	if !ok {
+		return GeomPoint{}, false
+	}
+	y, ok := p.parseNumber()
+	return GeomPoint{X: x, Y: y}, ok
+}
+
+// parsePointList parses the points separated by commas until the closing parenthesis, which is consumed.
+func (p *wktParser) parsePointList() ([]GeomPoint, bool) {
+	var points []GeomPoint
+	for {
+		pt, ok := p.parsePoint()
+		if !ok {
+			return nil, false
+		}
+		points = append(points, pt)
+		switch p.next() {
+		case ",":
+		case ")":
+			return points, true
+		default:
+			return nil, false
+		}
+	}
+}
+
+// parseRingList parses the parenthesized point lists until the closing parenthesis, which is consumed.
+func (p *wktParser) parseRingList() ([][]GeomPoint, bool) {
+	var rings [][]GeomPoint
+	for {
+		if !p.expect("(") {
+			return nil, false
+		}
+		ring, ok := p.parsePointList()
+		if !ok {
+			return nil, false
+		}
+		rings = append(rings, ring)
+		switch p.next() {
+		case ",":
+		case ")":
+			return rings, true
+		default:
+			return nil, false
+		}
+	}
+}
@@ -0,0 +1,600 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb/parser/mysql"
+)
+
+// The relations and measures below are computed on the Cartesian plane,
+// the SRID is only checked for consistency.
+
+// geomParts are the geometry decomposed into the points, the line strings and the polygons.
+type geomParts struct {
+	points   []GeomPoint
+	lines    [][]GeomPoint
+	polygons [][][]GeomPoint
+}
+
+func (g *Geometry) parts() *geomParts {
+	parts := &geomParts{}
+	g.collectParts(parts)
+	return parts
+}
+
+func (g *Geometry) collectParts(parts *geomParts) {
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		parts.points = append(parts.points, g.Points[0])
+	case mysql.GeometryTypeLineString:
+		parts.lines = append(parts.lines, g.Points)
+	case mysql.GeometryTypePolygon:
+		parts.polygons = append(parts.polygons, g.Rings)
+	default:
+		for _, m := range g.Geoms {
+			m.collectParts(parts)
+		}
+	}
+}
+
+// segments returns the segments of the line strings and the rings.
+func (p *geomParts) segments(withRings bool) [][2]GeomPoint {
+	var segs [][2]GeomPoint
+	for _, line := range p.lines {
+		for i := 1; i < len(line); i++ {
+			segs = append(segs, [2]GeomPoint{line[i-1], line[i]})
+		}
+	}
+	if withRings {
+		segs = append(segs, p.ringSegments()...)
+	}
+	return segs
+}
+
+func (p *geomParts) ringSegments() [][2]GeomPoint {
+	var segs [][2]GeomPoint
+	for _, rings := range p.polygons {
+		for _, ring := range rings {
+			for i := 1; i < len(ring); i++ {
+				segs = append(segs, [2]GeomPoint{ring[i-1], ring[i]})
+			}
+		}
+	}
+	return segs
+}
+
+// vertices returns all the points of the geometry parts.
+func (p *geomParts) vertices() []GeomPoint {
+	vertices := append([]GeomPoint(nil), p.points...)
+	for _, line := range p.lines {
+		vertices = append(vertices, line...)
+	}
+	for _, rings := range p.polygons {
+		for _, ring := range rings {
+			vertices = append(vertices, ring...)
+		}
+	}
+	return vertices
+}
+
+func (p *geomParts) isEmpty() bool {
+	return len(p.points) == 0 && len(p.lines) == 0 && len(p.polygons) == 0
+}
+
+// Envelope returns the minimum and maximum corners of the bounding box of a non-empty geometry.
+func (g *Geometry) Envelope() (minP, maxP GeomPoint) {
+	minP = GeomPoint{X: math.Inf(1), Y: math.Inf(1)}
+	maxP = GeomPoint{X: math.Inf(-1), Y: math.Inf(-1)}
+	for _, v := range g.parts().vertices() {
+		minP.X, minP.Y = math.Min(minP.X, v.X), math.Min(minP.Y, v.Y)
+		maxP.X, maxP.Y = math.Max(maxP.X, v.X), math.Max(maxP.Y, v.Y)
+	}
+	return minP, maxP
+}
+
+// Area returns the area of a Polygon or a MultiPolygon.
+func (g *Geometry) Area() (float64, bool) {
+	if g.Tp != mysql.GeometryTypePolygon && g.Tp != mysql.GeometryTypeMultiPolygon {
+		return 0, false
+	}
+	area := 0.0
+	for _, rings := range g.parts().polygons {
+		for i, ring := range rings {
+			a := math.Abs(ringSignedArea(ring))
+			if i > 0 {
+				a = -a
+			}
+			area += a
+		}
+	}
+	return area, true
+}
+
+func ringSignedArea(ring []GeomPoint) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1].X*ring[i].Y - ring[i].X*ring[i-1].Y
+	}
+	return area / 2
+}
+
+// Length returns the length of a LineString or a MultiLineString.
+func (g *Geometry) Length() (float64, bool) {
+	if g.Tp != mysql.GeometryTypeLineString && g.Tp != mysql.GeometryTypeMultiLineString {
+		return 0, false
+	}
+	length := 0.0
+	for _, seg := range g.parts().segments(false) {
+		length += math.Hypot(seg[1].X-seg[0].X, seg[1].Y-seg[0].Y)
+	}
+	return length, true
+}
+
+// cross returns the cross product of (b - a) and (c - a).
+func cross(a, b, c GeomPoint) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+func onSegment(p GeomPoint, seg [2]GeomPoint) bool {
+	a, b := seg[0], seg[1]
+	return cross(a, b, p) == 0 &&
+		math.Min(a.X, b.X) <= p.X && p.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= p.Y && p.Y <= math.Max(a.Y, b.Y)
+}
+
+func sign(f float64) int {
+	if f > 0 {
+		return 1
+	} else if f < 0 {
+		return -1
+	}
+	return 0
+}
+
+// segmentsIntersect returns whether two closed segments share a point.
+func segmentsIntersect(s1, s2 [2]GeomPoint) bool {
+	d1 := sign(cross(s2[0], s2[1], s1[0]))
+	d2 := sign(cross(s2[0], s2[1], s1[1]))
+	d3 := sign(cross(s1[0], s1[1], s2[0]))
+	d4 := sign(cross(s1[0], s1[1], s2[1]))
+	if d1*d2 < 0 && d3*d4 < 0 {
+		return true
+	}
+	return onSegment(s1[0], s2) || onSegment(s1[1], s2) || onSegment(s2[0], s1) || onSegment(s2[1], s1)
+}
+
+// properCrossing returns the position on s1 where the two segments cross at a single interior point.
+func properCrossing(s1, s2 [2]GeomPoint) (float64, bool) {
+	d1 := cross(s2[0], s2[1], s1[0])
+	d2 := cross(s2[0], s2[1], s1[1])
+	d3 := cross(s1[0], s1[1], s2[0])
+	d4 := cross(s1[0], s1[1], s2[1])
+	if sign(d1)*sign(d2) >= 0 || sign(d3)*sign(d4) >= 0 {
+		return 0, false
+	}
+	return d1 / (d1 - d2), true
+}
+
+// The locations of a point relative to a polygon.
+const (
+	outsidePolygon = iota
+	onPolygonBoundary
+	insidePolygon
+)
+
+func locatePointInPolygon(p GeomPoint, rings [][]GeomPoint) int {
+	inside := false
+	for _, ring := range rings {
+		for i := 1; i < len(ring); i++ {
+			a, b := ring[i-1], ring[i]
+			if onSegment(p, [2]GeomPoint{a, b}) {
+				return onPolygonBoundary
+			}
+			if (a.Y > p.Y) != (b.Y > p.Y) && p.X < a.X+(p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+				inside = !inside
+			}
+		}
+	}
+	if inside {
+		return insidePolygon
+	}
+	return outsidePolygon
+}
+
+// pointCovered returns whether the point is in the closure of the geometry parts.
+func (p *geomParts) pointCovered(pt GeomPoint, arealOnly bool) bool {
+	for _, rings := range p.polygons {
+		if locatePointInPolygon(pt, rings) != outsidePolygon {
+			return true
+		}
+	}
+	if arealOnly {
+		return false
+	}
+	for _, q := range p.points {
+		if q == pt {
+			return true
+		}
+	}
+	for _, seg := range p.segments(false) {
+		if onSegment(pt, seg) {
+			return true
+		}
+	}
+	return false
+}
+
+// pointInInterior returns whether the point is in the interior of the geometry parts.
+func (p *geomParts) pointInInterior(pt GeomPoint) bool {
+	for _, rings := range p.polygons {
+		if locatePointInPolygon(pt, rings) == insidePolygon {
+			return true
+		}
+	}
+	for _, q := range p.points {
+		if q == pt {
+			return true
+		}
+	}
+	for _, line := range p.lines {
+		closed := line[0] == line[len(line)-1]
+		if !closed && (pt == line[0] || pt == line[len(line)-1]) {
+			continue
+		}
+		for i := 1; i < len(line); i++ {
+			if onSegment(pt, [2]GeomPoint{line[i-1], line[i]}) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func interpolate(seg [2]GeomPoint, t float64) GeomPoint {
+	return GeomPoint{X: seg[0].X + (seg[1].X-seg[0].X)*t, Y: seg[0].Y + (seg[1].Y-seg[0].Y)*t}
+}
+
+// splitSegment returns the sample points of the pieces of the segment split by the geometry parts,
+// each piece is either entirely in or entirely out of the closure of the parts.
+func (p *geomParts) splitSegment(seg [2]GeomPoint) []GeomPoint {
+	ts := []float64{0, 1}
+	dx, dy := seg[1].X-seg[0].X, seg[1].Y-seg[0].Y
+	lenSq := dx*dx + dy*dy
+	if lenSq == 0 {
+		return []GeomPoint{seg[0]}
+	}
+	for _, v := range p.vertices() {
+		if onSegment(v, seg) {
+			ts = append(ts, ((v.X-seg[0].X)*dx+(v.Y-seg[0].Y)*dy)/lenSq)
+		}
+	}
+	for _, s := range p.segments(true) {
+		if t, ok := properCrossing(seg, s); ok {
+			ts = append(ts, t)
+		}
+	}
+	sort.Float64s(ts)
+	samples := []GeomPoint{seg[0], seg[1]}
+	for i := 1; i < len(ts); i++ {
+		if ts[i] > ts[i-1] {
+			samples = append(samples, interpolate(seg, (ts[i-1]+ts[i])/2))
+		}
+	}
+	return samples
+}
+
+// polygonInteriorPoint returns a point in the interior of the polygon.
+func polygonInteriorPoint(rings [][]GeomPoint) (GeomPoint, bool) {
+	ys := make([]float64, 0, len(rings[0]))
+	for _, v := range rings[0] {
+		ys = append(ys, v.Y)
+	}
+	sort.Float64s(ys)
+	for i := 1; i < len(ys); i++ {
+		if ys[i] == ys[i-1] {
+			continue
+		}
+		// The scan line doesn't pass through any vertex of the exterior ring,
+		// use the middle of the first interval inside the polygon.
+		y := (ys[i-1] + ys[i]) / 2
+		var xs []float64
+		for _, ring := range rings {
+			for j := 1; j < len(ring); j++ {
+				a, b := ring[j-1], ring[j]
+				if (a.Y > y) != (b.Y > y) {
+					xs = append(xs, a.X+(y-a.Y)*(b.X-a.X)/(b.Y-a.Y))
+				}
+			}
+		}
+		sort.Float64s(xs)
+		for j := 1; j < len(xs); j += 2 {
+			pt := GeomPoint{X: (xs[j-1] + xs[j]) / 2, Y: y}
+			if xs[j] > xs[j-1] && locatePointInPolygon(pt, rings) == insidePolygon {
+				return pt, true
+			}
+		}
+	}
+	return GeomPoint{}, false
+}
+
+// covers returns whether every point of other is in the closure of p.
+func (p *geomParts) covers(other *geomParts) bool {
+	for _, pt := range other.points {
+		if !p.pointCovered(pt, false) {
+			return false
+		}
+	}
+	for _, seg := range other.segments(false) {
+		for _, pt := range p.splitSegment(seg) {
+			if !p.pointCovered(pt, false) {
+				return false
+			}
+		}
+	}
+	if len(other.polygons) == 0 {
+		return true
+	}
+	// A polygon can only be covered by the polygons.
+	areal := &geomParts{polygons: p.polygons}
+	for _, rings := range other.polygons {
+		polygon := &geomParts{polygons: [][][]GeomPoint{rings}}
+		for _, seg := range polygon.ringSegments() {
+			for _, pt := range areal.splitSegment(seg) {
+				if !areal.pointCovered(pt, true) {
+					return false
+				}
+			}
+		}
+		// No boundary of the covering polygons goes through the interior of the polygon.
+		for _, seg := range areal.ringSegments() {
+			for _, pt := range polygon.splitSegment(seg) {
+				if locatePointInPolygon(pt, rings) == insidePolygon {
+					return false
+				}
+			}
+		}
+		pt, ok := polygonInteriorPoint(rings)
+		if ok && !areal.pointCovered(pt, true) {
+			return false
+		}
+	}
+	return true
+}
+
+// interiorsIntersect returns whether the interior of other intersects the interior of p,
+// it's only called when p covers other.
+func (p *geomParts) interiorsIntersect(other *geomParts) bool {
+	if len(other.polygons) > 0 {
+		return true
+	}
+	for _, pt := range other.points {
+		if p.pointInInterior(pt) {
+			return true
+		}
+	}
+	for _, seg := range other.segments(false) {
+		samples := p.splitSegment(seg)
+		// Skip the end points of the segment, which may be on the boundary of the line string.
+		for _, pt := range samples[2:] {
+			if p.pointInInterior(pt) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Intersects returns whether the two geometries share any point.
+func (g *Geometry) Intersects(other *Geometry) bool {
+	p1, p2 := g.parts(), other.parts()
+	if p1.isEmpty() || p2.isEmpty() {
+		return false
+	}
+	for _, pt := range p2.points {
+		if p1.pointCovered(pt, false) {
+			return true
+		}
+	}
+	for _, pt := range p1.points {
+		if p2.pointCovered(pt, false) {
+			return true
+		}
+	}
+	segs2 := p2.segments(true)
+	for _, s1 := range p1.segments(true) {
+		for _, s2 := range segs2 {
+			if segmentsIntersect(s1, s2) {
+				return true
+			}
+		}
+	}
+	// One of them may be inside a polygon of the other without crossing.
+	for _, v := range p2.vertices() {
+		if p1.pointCovered(v, true) {
+			return true
+		}
+	}
+	for _, v := range p1.vertices() {
+		if p2.pointCovered(v, true) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns whether other lies in g and their interiors intersect.
+func (g *Geometry) Contains(other *Geometry) bool {
+	p1, p2 := g.parts(), other.parts()
+	if p1.isEmpty() || p2.isEmpty() {
+		return false
+	}
+	return p1.covers(p2) && p1.interiorsIntersect(p2)
+}
+
+// GeomEquals returns whether the two geometries are spatially equal.
+func (g *Geometry) GeomEquals(other *Geometry) bool {
+	p1, p2 := g.parts(), other.parts()
+	if p1.isEmpty() || p2.isEmpty() {
+		return p1.isEmpty() && p2.isEmpty()
+	}
+	return p1.covers(p2) && p2.covers(p1)
+}
+
+func pointSegmentDistance(p GeomPoint, seg [2]GeomPoint) float64 {
+	dx, dy := seg[1].X-seg[0].X, seg[1].Y-seg[0].Y
+	lenSq := dx*dx + dy*dy
+	t := 0.0
+	if lenSq > 0 {
+		t = math.Max(0, math.Min(1, ((p.X-seg[0].X)*dx+(p.Y-seg[0].Y)*dy)/lenSq))
+	}
+	q := interpolate(seg, t)
+	return math.Hypot(p.X-q.X, p.Y-q.Y)
+}
+
+// Distance returns the minimum distance between the two geometries, which is false if any of them is empty.
+func (g *Geometry) Distance(other *Geometry) (float64, bool) {
+	p1, p2 := g.parts(), other.parts()
+	if p1.isEmpty() || p2.isEmpty() {
+		return 0, false
+	}
+	if g.Intersects(other) {
+		return 0, true
+	}
+	// The geometries are disjoint, so the minimum distance is between their points and segments.
+	segs1, segs2 := p1.segments(true), p2.segments(true)
+	for _, pt := range p1.points {
+		segs1 = append(segs1, [2]GeomPoint{pt, pt})
+	}
+	for _, pt := range p2.points {
+		segs2 = append(segs2, [2]GeomPoint{pt, pt})
+	}
+	dist := math.Inf(1)
+	for _, s1 := range segs1 {
+		for _, s2 := range segs2 {
+			dist = math.Min(dist, pointSegmentDistance(s1[0], s2))
+			dist = math.Min(dist, pointSegmentDistance(s1[1], s2))
+			dist = math.Min(dist, pointSegmentDistance(s2[0], s1))
+			dist = math.Min(dist, pointSegmentDistance(s2[1], s1))
+		}
+	}
+	return dist, true
+}
+
+// DefaultEarthRadius is the default radius of the sphere used by ST_Distance_Sphere, in meters.
+const DefaultEarthRadius = 6370986
+
+// DistanceSphere returns the minimum spherical distance between the points of two Point or MultiPoint geometries,
+// whose X and Y are the longitude and latitude in degrees.
+func (g *Geometry) DistanceSphere(other *Geometry, radius float64) (float64, bool) {
+	p1, p2 := g.parts(), other.parts()
+	if len(p1.lines) > 0 || len(p1.polygons) > 0 || len(p2.lines) > 0 || len(p2.polygons) > 0 ||
+		len(p1.points) == 0 || len(p2.points) == 0 {
+		return 0, false
+	}
+	dist := math.Inf(1)
+	for _, a := range p1.points {
+		for _, b := range p2.points {
+			dist = math.Min(dist, haversine(a, b, radius))
+		}
+	}
+	return dist, true
+}
+
+func haversine(a, b GeomPoint, radius float64) float64 {
+	lat1, lat2 := a.Y*math.Pi/180, b.Y*math.Pi/180
+	dLat, dLon := lat2-lat1, (b.X-a.X)*math.Pi/180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * radius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxGeohashLength is the max length of a geohash.
+const MaxGeohashLength = 100
+
+// EncodeGeohash encodes the longitude and latitude into a geohash of the given length.
+func EncodeGeohash(lon, lat float64, length int) string {
+	lonRange, latRange := [2]float64{-180, 180}, [2]float64{-90, 90}
+	var sb strings.Builder
+	even := true
+	for sb.Len() < length {
+		idx := 0
+		for bit := 4; bit >= 0; bit-- {
+			r, v := &latRange, lat
+			if even {
+				r, v = &lonRange, lon
+			}
+			mid := (r[0] + r[1]) / 2
+			if v >= mid {
+				idx |= 1 << bit
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+		sb.WriteByte(geohashAlphabet[idx])
+	}
+	return sb.String()
+}
+
+// DecodeGeohash decodes a geohash into the longitude and latitude, which are the shortest decimals in the cell.
+func DecodeGeohash(hash string) (lon, lat float64, ok bool) {
+	if len(hash) == 0 {
+		return 0, 0, false
+	}
+	lonRange, latRange := [2]float64{-180, 180}, [2]float64{-90, 90}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		c := hash[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		idx := strings.IndexByte(geohashAlphabet, c)
+		if idx < 0 {
+			return 0, 0, false
+		}
+		for bit := 4; bit >= 0; bit-- {
+			r := &latRange
+			if even {
+				r = &lonRange
+			}
+			mid := (r[0] + r[1]) / 2
+			if idx&(1<<bit) != 0 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+	return shortestInRange(lonRange), shortestInRange(latRange), true
+}
+
+// shortestInRange returns the value with the fewest decimals in the range.
+func shortestInRange(r [2]float64) float64 {
+	mid := (r[0] + r[1]) / 2
+	for digits := 0; digits < 17; digits++ {
+		shift := math.Pow10(digits)
+		if v := math.Round(mid*shift) / shift; v >= r[0] && v <= r[1] {
+			return v
+		}
+	}
+	return mid
+}
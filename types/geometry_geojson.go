@@ -0,0 +1,275 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pingcap/tidb/parser/mysql"
+)
+
+// The options of the GeoJSON output.
+const (
+	// GeoJSONOptionBBox adds the bounding box to the output.
+	GeoJSONOptionBBox = 1
+	// GeoJSONOptionShortCRS adds the short format CRS URN to the output.
+	GeoJSONOptionShortCRS = 2
+	// GeoJSONOptionLongCRS adds the long format CRS URN to the output.
+	GeoJSONOptionLongCRS = 4
+)
+
+var geoJSONTypeNames = map[byte]string{
+	mysql.GeometryTypePoint:              "Point",
+	mysql.GeometryTypeLineString:         "LineString",
+	mysql.GeometryTypePolygon:            "Polygon",
+	mysql.GeometryTypeMultiPoint:         "MultiPoint",
+	mysql.GeometryTypeMultiLineString:    "MultiLineString",
+	mysql.GeometryTypeMultiPolygon:       "MultiPolygon",
+	mysql.GeometryTypeGeometryCollection: "GeometryCollection",
+}
+
+// GeoJSON returns the GeoJSON representation of the geometry.
+// The coordinates are rounded to maxDecimals digits, and options is the bit set of the GeoJSONOption constants.
+func (g *Geometry) GeoJSON(maxDecimals int, options int) BinaryJSON {
+	obj := g.geoJSONObject(maxDecimals)
+	if options&GeoJSONOptionBBox != 0 && !g.IsEmpty() {
+		minP, maxP := g.Envelope()
+		obj["bbox"] = []interface{}{
+			roundGeomCoord(minP.X, maxDecimals), roundGeomCoord(minP.Y, maxDecimals),
+			roundGeomCoord(maxP.X, maxDecimals), roundGeomCoord(maxP.Y, maxDecimals),
+		}
+	}
+	if options&(GeoJSONOptionShortCRS|GeoJSONOptionLongCRS) != 0 && g.SRID != 0 {
+		name := fmt.Sprintf("EPSG:%d", g.SRID)
+		if options&GeoJSONOptionLongCRS != 0 {
+			name = fmt.Sprintf("urn:ogc:def:crs:EPSG::%d", g.SRID)
+		}
+		obj["crs"] = map[string]interface{}{
+			"type":       "name",
+			"properties": map[string]interface{}{"name": name},
+		}
+	}
+	return CreateBinaryJSON(obj)
+}
+
+func (g *Geometry) geoJSONObject(maxDecimals int) map[string]interface{} {
+	obj := map[string]interface{}{"type": geoJSONTypeNames[g.Tp]}
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		obj["coordinates"] = geoJSONPosition(g.Points[0], maxDecimals)
+	case mysql.GeometryTypeLineString:
+		obj["coordinates"] = geoJSONPositions(g.Points, maxDecimals)
+	case mysql.GeometryTypePolygon:
+		obj["coordinates"] = geoJSONRings(g.Rings, maxDecimals)
+	case mysql.GeometryTypeGeometryCollection:
+		geoms := make([]interface{}, 0, len(g.Geoms))
+		for _, m := range g.Geoms {
+			geoms = append(geoms, m.geoJSONObject(maxDecimals))
+		}
+		obj["geometries"] = geoms
+	default:
+		coords := make([]interface{}, 0, len(g.Geoms))
+		for _, m := range g.Geoms {
+			coords = append(coords, m.geoJSONObject(maxDecimals)["coordinates"])
+		}
+		obj["coordinates"] = coords
+	}
+	return obj
+}
+
+func roundGeomCoord(f float64, maxDecimals int) float64 {
+	if maxDecimals >= 17 {
+		return f
+	}
+	shift := math.Pow10(maxDecimals)
+	if rounded := math.Round(f*shift) / shift; !math.IsInf(rounded, 0) && !math.IsNaN(rounded) {
+		return rounded
+	}
+	return f
+}
+
+func geoJSONPosition(p GeomPoint, maxDecimals int) []interface{} {
+	return []interface{}{roundGeomCoord(p.X, maxDecimals), roundGeomCoord(p.Y, maxDecimals)}
+}
+
+func geoJSONPositions(points []GeomPoint, maxDecimals int) []interface{} {
+	positions := make([]interface{}, 0, len(points))
+	for _, p := range points {
+		positions = append(positions, geoJSONPosition(p, maxDecimals))
+	}
+	return positions
+}
+
+func geoJSONRings(rings [][]GeomPoint, maxDecimals int) []interface{} {
+	ret := make([]interface{}, 0, len(rings))
+	for _, ring := range rings {
+		ret = append(ret, geoJSONPositions(ring, maxDecimals))
+	}
+	return ret
+}
+
+// ParseGeoJSON parses a geometry from a GeoJSON document.
+// The extra dimensions of the positions are stripped if stripDims is true, otherwise they are rejected.
+func ParseGeoJSON(bj BinaryJSON, srid uint32, stripDims bool) (*Geometry, error) {
+	p := geoJSONParser{srid: srid, stripDims: stripDims}
+	g, ok := p.parseObject(bj, 0)
+	if !ok {
+		return nil, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+type geoJSONParser struct {
+	srid      uint32
+	stripDims bool
+}
+
+func geoJSONMember(bj BinaryJSON, key string, typeCode JSONTypeCode) (BinaryJSON, bool) {
+	if bj.TypeCode != JSONTypeCodeObject {
+		return BinaryJSON{}, false
+	}
+	v, found := bj.objectSearchKey([]byte(key))
+	if !found || v.TypeCode != typeCode {
+		return BinaryJSON{}, false
+	}
+	return v, true
+}
+
+func (p *geoJSONParser) parseObject(bj BinaryJSON, depth int) (*Geometry, bool) {
+	tpName, ok := geoJSONMember(bj, "type", JSONTypeCodeString)
+	if !ok || depth > maxGeometryDepth {
+		return nil, false
+	}
+	switch string(tpName.GetString()) {
+	case "Feature":
+		geom, ok := geoJSONMember(bj, "geometry", JSONTypeCodeObject)
+		if !ok {
+			return nil, false
+		}
+		return p.parseObject(geom, depth+1)
+	case "FeatureCollection":
+		features, ok := geoJSONMember(bj, "features", JSONTypeCodeArray)
+		if !ok {
+			return nil, false
+		}
+		g := &Geometry{Tp: mysql.GeometryTypeGeometryCollection, SRID: p.srid}
+		for i := 0; i < features.GetElemCount(); i++ {
+			m, ok := p.parseObject(features.ArrayGetElem(i), depth+1)
+			if !ok {
+				return nil, false
+			}
+			g.Geoms = append(g.Geoms, m)
+		}
+		return g, true
+	case "GeometryCollection":
+		geoms, ok := geoJSONMember(bj, "geometries", JSONTypeCodeArray)
+		if !ok {
+			return nil, false
+		}
+		g := &Geometry{Tp: mysql.GeometryTypeGeometryCollection, SRID: p.srid}
+		for i := 0; i < geoms.GetElemCount(); i++ {
+			m, ok := p.parseObject(geoms.ArrayGetElem(i), depth+1)
+			if !ok {
+				return nil, false
+			}
+			g.Geoms = append(g.Geoms, m)
+		}
+		return g, true
+	}
+	for tp, name := range geoJSONTypeNames {
+		if name == string(tpName.GetString()) && tp != mysql.GeometryTypeGeometryCollection {
+			coords, ok := geoJSONMember(bj, "coordinates", JSONTypeCodeArray)
+			if !ok {
+				return nil, false
+			}
+			return p.parseCoordinates(tp, coords)
+		}
+	}
+	return nil, false
+}
+
+func (p *geoJSONParser) parseCoordinates(tp byte, coords BinaryJSON) (*Geometry, bool) {
+	g := &Geometry{Tp: tp, SRID: p.srid}
+	var ok bool
+	switch tp {
+	case mysql.GeometryTypePoint:
+		var pt GeomPoint
+		if pt, ok = p.parsePosition(coords); ok {
+			g.Points = []GeomPoint{pt}
+		}
+	case mysql.GeometryTypeLineString:
+		g.Points, ok = p.parsePositions(coords)
+	case mysql.GeometryTypePolygon:
+		ok = coords.TypeCode == JSONTypeCodeArray
+		for i := 0; ok && i < coords.GetElemCount(); i++ {
+			var ring []GeomPoint
+			if ring, ok = p.parsePositions(coords.ArrayGetElem(i)); ok {
+				g.Rings = append(g.Rings, ring)
+			}
+		}
+	default:
+		ok = coords.TypeCode == JSONTypeCodeArray
+		for i := 0; ok && i < coords.GetElemCount(); i++ {
+			var m *Geometry
+			if m, ok = p.parseCoordinates(tp-3, coords.ArrayGetElem(i)); ok {
+				g.Geoms = append(g.Geoms, m)
+			}
+		}
+	}
+	return g, ok
+}
+
+func (p *geoJSONParser) parsePositions(bj BinaryJSON) ([]GeomPoint, bool) {
+	if bj.TypeCode != JSONTypeCodeArray {
+		return nil, false
+	}
+	points := make([]GeomPoint, 0, bj.GetElemCount())
+	for i := 0; i < bj.GetElemCount(); i++ {
+		pt, ok := p.parsePosition(bj.ArrayGetElem(i))
+		if !ok {
+			return nil, false
+		}
+		points = append(points, pt)
+	}
+	return points, true
+}
+
+func (p *geoJSONParser) parsePosition(bj BinaryJSON) (GeomPoint, bool) {
+	if bj.TypeCode != JSONTypeCodeArray || bj.GetElemCount() < 2 || (bj.GetElemCount() > 2 && !p.stripDims) {
+		return GeomPoint{}, false
+	}
+	x, ok := geoJSONNumber(bj.ArrayGetElem(0))
+	if !ok {
+		return GeomPoint{}, false
+	}
+	y, ok := geoJSONNumber(bj.ArrayGetElem(1))
+	return GeomPoint{X: x, Y: y}, ok
+}
+
+func geoJSONNumber(bj BinaryJSON) (float64, bool) {
+	switch bj.TypeCode {
+	case JSONTypeCodeInt64:
+		return float64(bj.GetInt64()), true
+	case JSONTypeCodeUint64:
+		return float64(bj.GetUint64()), true
+	case JSONTypeCodeFloat64:
+		return bj.GetFloat64(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,291 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseWKT(t *testing.T, wkt string) *Geometry {
+	g, err := ParseWKT(wkt, 0)
+	require.NoError(t, err, wkt)
+	return g
+}
+
+func TestGeometryWKT(t *testing.T) {
+	tests := []struct {
+		input  string
+		output string
+		tp     byte
+	}{
+		{"POINT(1 2)", "POINT(1 2)", mysql.GeometryTypePoint},
+		{" point ( -1.5  2e3 ) ", "POINT(-1.5 2000)", mysql.GeometryTypePoint},
+		{"POINT(0.000001 1e20)", "POINT(1e-06 1e20)", mysql.GeometryTypePoint},
+		{"LINESTRING(0 0,1 1,2 0)", "LINESTRING(0 0,1 1,2 0)", mysql.GeometryTypeLineString},
+		{"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))", "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))", mysql.GeometryTypePolygon},
+		{"MULTIPOINT(0 0,1 1)", "MULTIPOINT((0 0),(1 1))", mysql.GeometryTypeMultiPoint},
+		{"MULTIPOINT((0 0),(1 1))", "MULTIPOINT((0 0),(1 1))", mysql.GeometryTypeMultiPoint},
+		{"MULTILINESTRING((0 0,1 1),(2 2,3 3))", "MULTILINESTRING((0 0,1 1),(2 2,3 3))", mysql.GeometryTypeMultiLineString},
+		{"MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))", "MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))", mysql.GeometryTypeMultiPolygon},
+		{"GEOMETRYCOLLECTION(POINT(1 1),LINESTRING(0 0,1 1))", "GEOMETRYCOLLECTION(POINT(1 1),LINESTRING(0 0,1 1))", mysql.GeometryTypeGeometryCollection},
+		{"GEOMCOLLECTION(GEOMETRYCOLLECTION EMPTY)", "GEOMETRYCOLLECTION(GEOMETRYCOLLECTION EMPTY)", mysql.GeometryTypeGeometryCollection},
+		{"GEOMETRYCOLLECTION EMPTY", "GEOMETRYCOLLECTION EMPTY", mysql.GeometryTypeGeometryCollection},
+	}
+	for _, tt := range tests {
+		g := mustParseWKT(t, tt.input)
+		require.Equal(t, tt.tp, g.Tp, tt.input)
+		require.Equal(t, tt.output, g.WKT(), tt.input)
+	}
+
+	for _, input := range []string{
+		"",
+		"POINT",
+		"POINT(1)",
+		"POINT(1 2 3)",
+		"POINT(1 2",
+		"POINT(1 2))",
+		"POINT(a b)",
+		"LINESTRING(0 0)",
+		"POLYGON((0 0,1 0,1 1,0 1))",
+		"POLYGON((0 0,1 1,0 0))",
+		"MULTIPOINT()",
+		"CIRCLE(0 0)",
+		"POINT EMPTY",
+	} {
+		_, err := ParseWKT(input, 0)
+		require.Error(t, err, input)
+		require.True(t, ErrCantCreateGeometryObject.Equal(err), input)
+	}
+}
+
+func TestGeometryWKB(t *testing.T) {
+	g, err := ParseWKT("POINT(1 -1)", 4326)
+	require.NoError(t, err)
+	require.Equal(t, "0101000000000000000000f03f000000000000f0bf", hex.EncodeToString(g.WKB()))
+	require.Equal(t, "e6100000"+"0101000000000000000000f03f000000000000f0bf", hex.EncodeToString(g.Encode()))
+
+	parsed, err := ParseGeometry(g.Encode())
+	require.NoError(t, err)
+	require.Equal(t, uint32(4326), parsed.SRID)
+	require.Equal(t, "POINT(1 -1)", parsed.WKT())
+
+	// The big-endian WKB is accepted, and it's stored in little-endian.
+	bigEndian, err := hex.DecodeString("00000000013ff0000000000000bff0000000000000")
+	require.NoError(t, err)
+	parsed, err = ParseWKB(bigEndian, 0)
+	require.NoError(t, err)
+	require.Equal(t, "POINT(1 -1)", parsed.WKT())
+	require.Equal(t, g.WKB(), parsed.WKB())
+
+	for _, wkt := range []string{
+		"LINESTRING(0 0,1 1,2 0)",
+		"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))",
+		"MULTIPOINT((0 0),(1 1))",
+		"MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))",
+		"GEOMETRYCOLLECTION(POINT(1 1),GEOMETRYCOLLECTION EMPTY)",
+	} {
+		g := mustParseWKT(t, wkt)
+		parsed, err := ParseWKB(g.WKB(), 3857)
+		require.NoError(t, err, wkt)
+		require.Equal(t, wkt, parsed.WKT())
+		require.Equal(t, uint32(3857), parsed.SRID)
+	}
+
+	for _, data := range []string{
+		"",
+		"01",
+		"0101000000000000000000f03f",
+		"0101000000000000000000f03f000000000000f0bf00",
+		"0108000000",
+		"0102000000ffffffff",
+		"0104000000010000000102000000000000000000",
+		"0101000000000000000000f87f000000000000f0bf",
+	} {
+		b, err := hex.DecodeString(data)
+		require.NoError(t, err)
+		_, err = ParseWKB(b, 0)
+		require.Error(t, err, data)
+	}
+	_, err = ParseGeometry([]byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestGeometryGeoJSON(t *testing.T) {
+	g, err := ParseWKT("POLYGON((0 0,10 0,10 10,0 10,0 0))", 4326)
+	require.NoError(t, err)
+	require.Equal(t, `{"coordinates": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]]], "type": "Polygon"}`, g.GeoJSON(math.MaxInt32, 0).String())
+	require.Equal(t, `{"bbox": [0, 0, 10, 10], "coordinates": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]]], "crs": {"properties": {"name": "EPSG:4326"}, "type": "name"}, "type": "Polygon"}`,
+		g.GeoJSON(math.MaxInt32, GeoJSONOptionBBox|GeoJSONOptionShortCRS).String())
+	require.Equal(t, `{"coordinates": [1.23, 4.57], "crs": {"properties": {"name": "urn:ogc:def:crs:EPSG::4326"}, "type": "name"}, "type": "Point"}`,
+		NewGeomPoint(1.23456, 4.56789, 4326).GeoJSON(2, GeoJSONOptionLongCRS).String())
+
+	for _, wkt := range []string{
+		"POINT(1 2)",
+		"LINESTRING(0 0,1 1)",
+		"MULTIPOINT((0 0),(1 1))",
+		"MULTILINESTRING((0 0,1 1),(2 2,3 3))",
+		"MULTIPOLYGON(((0 0,1 0,1 1,0 0)))",
+		"GEOMETRYCOLLECTION(POINT(1 1),LINESTRING(0 0,1 1))",
+	} {
+		g := mustParseWKT(t, wkt)
+		parsed, err := ParseGeoJSON(g.GeoJSON(math.MaxInt32, 0), 4326, false)
+		require.NoError(t, err, wkt)
+		require.Equal(t, wkt, parsed.WKT())
+		require.Equal(t, uint32(4326), parsed.SRID)
+	}
+
+	doc, err := ParseBinaryJSONFromString(`{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1, 2, 3]}, "properties": {}}`)
+	require.NoError(t, err)
+	_, err = ParseGeoJSON(doc, 4326, false)
+	require.Error(t, err)
+	parsed, err := ParseGeoJSON(doc, 4326, true)
+	require.NoError(t, err)
+	require.Equal(t, "POINT(1 2)", parsed.WKT())
+
+	doc, err = ParseBinaryJSONFromString(`{"type": "FeatureCollection", "features": [{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1, 2]}}]}`)
+	require.NoError(t, err)
+	parsed, err = ParseGeoJSON(doc, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, "GEOMETRYCOLLECTION(POINT(1 2))", parsed.WKT())
+
+	for _, s := range []string{
+		`{"type": "Point"}`,
+		`{"type": "Point", "coordinates": [1]}`,
+		`{"type": "Point", "coordinates": ["1", 2]}`,
+		`{"type": "LineString", "coordinates": [[1, 2]]}`,
+		`{"type": "Circle", "coordinates": [1, 2]}`,
+		`[1, 2]`,
+	} {
+		doc, err := ParseBinaryJSONFromString(s)
+		require.NoError(t, err)
+		_, err = ParseGeoJSON(doc, 0, true)
+		require.Error(t, err, s)
+	}
+}
+
+func TestGeometryMeasures(t *testing.T) {
+	area, ok := mustParseWKT(t, "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 2,1 1))").Area()
+	require.True(t, ok)
+	require.Equal(t, 15.0, area)
+	area, ok = mustParseWKT(t, "MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,4 2,4 4,2 4,2 2)))").Area()
+	require.True(t, ok)
+	require.Equal(t, 4.5, area)
+	_, ok = mustParseWKT(t, "POINT(0 0)").Area()
+	require.False(t, ok)
+
+	length, ok := mustParseWKT(t, "LINESTRING(0 0,3 4,3 5)").Length()
+	require.True(t, ok)
+	require.Equal(t, 6.0, length)
+	length, ok = mustParseWKT(t, "MULTILINESTRING((0 0,3 4),(0 0,0 1))").Length()
+	require.True(t, ok)
+	require.Equal(t, 6.0, length)
+	_, ok = mustParseWKT(t, "POLYGON((0 0,1 0,1 1,0 0))").Length()
+	require.False(t, ok)
+
+	minP, maxP := mustParseWKT(t, "GEOMETRYCOLLECTION(POINT(-1 5),LINESTRING(0 0,3 2))").Envelope()
+	require.Equal(t, GeomPoint{X: -1, Y: 0}, minP)
+	require.Equal(t, GeomPoint{X: 3, Y: 5}, maxP)
+}
+
+func TestGeometryRelations(t *testing.T) {
+	const square = "POLYGON((0 0,10 0,10 10,0 10,0 0))"
+	tests := []struct {
+		g1, g2     string
+		intersects bool
+		contains   bool
+		equals     bool
+	}{
+		{square, "POINT(5 5)", true, true, false},
+		{square, "POINT(0 5)", true, false, false},
+		{square, "POINT(11 5)", false, false, false},
+		{square, "LINESTRING(1 1,9 9)", true, true, false},
+		{square, "LINESTRING(0 0,10 0)", true, false, false},
+		{square, "LINESTRING(5 5,15 5)", true, false, false},
+		{square, "POLYGON((1 1,2 1,2 2,1 1))", true, true, false},
+		{square, "POLYGON((5 5,15 5,15 15,5 5))", true, false, false},
+		{square, "POLYGON((10 0,20 0,20 10,10 0))", true, false, false},
+		{square, "POLYGON((0 0,0 10,10 10,10 0,0 0))", true, true, true},
+		{"POLYGON((0 0,10 0,10 10,0 10,0 0),(2 2,8 2,8 8,2 8,2 2))", "POINT(5 5)", false, false, false},
+		{"POLYGON((0 0,10 0,10 10,0 10,0 0),(2 2,8 2,8 8,2 8,2 2))", "POINT(1 1)", true, true, false},
+		{"LINESTRING(0 0,10 10)", "LINESTRING(0 10,10 0)", true, false, false},
+		{"LINESTRING(0 0,10 10)", "POINT(5 5)", true, true, false},
+		{"LINESTRING(0 0,10 10)", "POINT(0 0)", true, false, false},
+		{"LINESTRING(0 0,10 10)", "LINESTRING(10 10,5 5,0 0)", true, true, true},
+		{"MULTIPOINT((0 0),(1 1))", "POINT(1 1)", true, true, false},
+		{"MULTIPOINT((0 0),(1 1))", "MULTIPOINT((1 1),(0 0),(1 1))", true, true, true},
+		{"POINT(1 1)", "POINT(1 1)", true, true, true},
+		{"POINT(1 1)", "POINT(1 2)", false, false, false},
+		{square, "GEOMETRYCOLLECTION EMPTY", false, false, false},
+	}
+	for _, tt := range tests {
+		g1, g2 := mustParseWKT(t, tt.g1), mustParseWKT(t, tt.g2)
+		require.Equal(t, tt.intersects, g1.Intersects(g2), "%s intersects %s", tt.g1, tt.g2)
+		require.Equal(t, tt.intersects, g2.Intersects(g1), "%s intersects %s", tt.g2, tt.g1)
+		require.Equal(t, tt.contains, g1.Contains(g2), "%s contains %s", tt.g1, tt.g2)
+		require.Equal(t, tt.equals, g1.GeomEquals(g2), "%s equals %s", tt.g1, tt.g2)
+	}
+}
+
+func TestGeometryDistance(t *testing.T) {
+	tests := []struct {
+		g1, g2 string
+		dist   float64
+	}{
+		{"POINT(0 0)", "POINT(3 4)", 5},
+		{"POINT(0 5)", "LINESTRING(-1 0,1 0)", 5},
+		{"LINESTRING(0 0,10 0)", "LINESTRING(0 2,10 3)", 2},
+		{"POLYGON((0 0,10 0,10 10,0 10,0 0))", "POINT(5 5)", 0},
+		{"POLYGON((0 0,10 0,10 10,0 10,0 0))", "POINT(13 14)", 5},
+	}
+	for _, tt := range tests {
+		dist, ok := mustParseWKT(t, tt.g1).Distance(mustParseWKT(t, tt.g2))
+		require.True(t, ok)
+		require.InDelta(t, tt.dist, dist, 1e-9, "%s %s", tt.g1, tt.g2)
+	}
+	_, ok := mustParseWKT(t, "POINT(0 0)").Distance(mustParseWKT(t, "GEOMETRYCOLLECTION EMPTY"))
+	require.False(t, ok)
+
+	// The distance between Paris and London.
+	dist, ok := NewGeomPoint(2.3522, 48.8566, 0).DistanceSphere(NewGeomPoint(-0.1276, 51.5072, 0), DefaultEarthRadius)
+	require.True(t, ok)
+	require.InDelta(t, 343.5e3, dist, 1e3)
+	_, ok = NewGeomPoint(0, 0, 0).DistanceSphere(mustParseWKT(t, "LINESTRING(0 0,1 1)"), DefaultEarthRadius)
+	require.False(t, ok)
+}
+
+func TestGeohash(t *testing.T) {
+	require.Equal(t, "u4pruydqqvj", EncodeGeohash(10.40744, 57.64911, 11))
+	require.Equal(t, "s000", EncodeGeohash(0, 0, 4))
+	require.Equal(t, "zzzzzzzzzz", EncodeGeohash(180, 90, 10))
+
+	lon, lat, ok := DecodeGeohash("u4pruydqqvj")
+	require.True(t, ok)
+	require.InDelta(t, 10.40744, lon, 1e-5)
+	require.InDelta(t, 57.64911, lat, 1e-5)
+	lon2, lat2, ok := DecodeGeohash("U4PRUYDQQVJ")
+	require.True(t, ok)
+	require.Equal(t, lon, lon2)
+	require.Equal(t, lat, lat2)
+
+	for _, hash := range []string{"", "u4pa", "u4pi", "u4p ", "u4p\x05"} {
+		_, _, ok := DecodeGeohash(hash)
+		require.False(t, ok, hash)
+	}
+}
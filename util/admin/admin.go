@@ -69,6 +69,17 @@ const (
 // It returns nil if the count from the index is equal to the count from the table columns,
 // otherwise it returns an error and the corresponding index's offset.
 func CheckIndicesCount(ctx sessionctx.Context, dbName, tableName string, indices []string) (byte, int, error) {
+	return checkIndicesCount(ctx, "table", "SELECT COUNT(*) FROM %n.%n", []interface{}{dbName, tableName}, tableName, indices)
+}
+
+// CheckPartitionIndicesCount is like CheckIndicesCount, but it only compares the counts in the given partition.
+func CheckPartitionIndicesCount(ctx sessionctx.Context, dbName, tableName, partitionName string, indices []string) (byte, int, error) {
+	return checkIndicesCount(ctx, "partition("+partitionName+")", "SELECT COUNT(*) FROM %n.%n PARTITION(%n)",
+		[]interface{}{dbName, tableName, partitionName}, tableName, indices)
+}
+
+// checkIndicesCount compares the count of the rows from the `from` query with the count through each index.
+func checkIndicesCount(ctx sessionctx.Context, source, from string, args []interface{}, tableName string, indices []string) (byte, int, error) {
 	// Here we need check all indexes, includes invisible index
 	ctx.GetSessionVars().OptimizerUseInvisibleIndexes = true
 	defer func() {
@@ -89,17 +100,17 @@ func CheckIndicesCount(ctx sessionctx.Context, dbName, tableName string, indices
 
 	// Add `` for some names like `table name`.
 	exec := ctx.(sqlexec.RestrictedSQLExecutor)
-	tblCnt, err := getCount(exec, snapshot, "SELECT COUNT(*) FROM %n.%n USE INDEX()", dbName, tableName)
+	tblCnt, err := getCount(exec, snapshot, from+" USE INDEX()", args...)
 	if err != nil {
 		return 0, 0, errors.Trace(err)
 	}
 	for i, idx := range indices {
-		idxCnt, err := getCount(exec, snapshot, "SELECT COUNT(*) FROM %n.%n USE INDEX(%n)", dbName, tableName, idx)
+		idxCnt, err := getCount(exec, snapshot, from+" USE INDEX(%n)", append(args[:len(args):len(args)], idx)...)
 		if err != nil {
 			return 0, i, errors.Trace(err)
 		}
 		logutil.Logger(context.Background()).Info("check indices count",
-			zap.String("table", tableName), zap.Int64("tblCnt", tblCnt), zap.Reflect("index", idx), zap.Int64("idxCnt", idxCnt))
+			zap.String("table", tableName), zap.String("source", source), zap.Int64("tblCnt", tblCnt), zap.Reflect("index", idx), zap.Int64("idxCnt", idxCnt))
 		if tblCnt == idxCnt {
 			continue
 		}
@@ -110,7 +121,7 @@ func CheckIndicesCount(ctx sessionctx.Context, dbName, tableName string, indices
 		} else if idxCnt > tblCnt {
 			ret = IdxCntGreater
 		}
-		return ret, i, ErrAdminCheckTable.GenWithStack("table count %d != index(%s) count %d", tblCnt, idx, idxCnt)
+		return ret, i, ErrAdminCheckTable.GenWithStack("%s count %d != index(%s) count %d", source, tblCnt, idx, idxCnt)
 	}
 	return 0, 0, nil
 }
@@ -65,6 +65,10 @@ func BenchmarkUtf8mb4UnicodeCI_CompareShort(b *testing.B) {
 	compare(b, &unicodeCICollator{}, short)
 }
 
+func BenchmarkUtf8mb40900AICI_CompareShort(b *testing.B) {
+	compare(b, &unicode0900AICICollator{}, short)
+}
+
 func BenchmarkUtf8mb4Bin_CompareMid(b *testing.B) {
 	compare(b, &binCollator{}, middle)
 }
@@ -77,6 +81,10 @@ func BenchmarkUtf8mb4UnicodeCI_CompareMid(b *testing.B) {
 	compare(b, &unicodeCICollator{}, middle)
 }
 
+func BenchmarkUtf8mb40900AICI_CompareMid(b *testing.B) {
+	compare(b, &unicode0900AICICollator{}, middle)
+}
+
 func BenchmarkUtf8mb4Bin_CompareLong(b *testing.B) {
 	compare(b, &binCollator{}, long)
 }
@@ -89,6 +97,10 @@ func BenchmarkUtf8mb4UnicodeCI_CompareLong(b *testing.B) {
 	compare(b, &unicodeCICollator{}, long)
 }
 
+func BenchmarkUtf8mb40900AICI_CompareLong(b *testing.B) {
+	compare(b, &unicode0900AICICollator{}, long)
+}
+
 func BenchmarkUtf8mb4Bin_KeyShort(b *testing.B) {
 	key(b, &binCollator{}, short)
 }
@@ -101,6 +113,10 @@ func BenchmarkUtf8mb4UnicodeCI_KeyShort(b *testing.B) {
 	key(b, &unicodeCICollator{}, short)
 }
 
+func BenchmarkUtf8mb40900AICI_KeyShort(b *testing.B) {
+	key(b, &unicode0900AICICollator{}, short)
+}
+
 func BenchmarkUtf8mb4Bin_KeyMid(b *testing.B) {
 	key(b, &binCollator{}, middle)
 }
@@ -113,6 +129,10 @@ func BenchmarkUtf8mb4UnicodeCI_KeyMid(b *testing.B) {
 	key(b, &unicodeCICollator{}, middle)
 }
 
+func BenchmarkUtf8mb40900AICI_KeyMid(b *testing.B) {
+	key(b, &unicode0900AICICollator{}, middle)
+}
+
 func BenchmarkUtf8mb4Bin_KeyLong(b *testing.B) {
 	key(b, &binCollator{}, long)
 }
@@ -124,3 +144,7 @@ func BenchmarkUtf8mb4GeneralCI_KeyLong(b *testing.B) {
 func BenchmarkUtf8mb4UnicodeCI_KeyLong(b *testing.B) {
 	key(b, &unicodeCICollator{}, long)
 }
+
+func BenchmarkUtf8mb40900AICI_KeyLong(b *testing.B) {
+	key(b, &unicode0900AICICollator{}, long)
+}
@@ -55,21 +55,22 @@ func testKeyTable(t *testing.T, collations []string, tests []keyTable) {
 func TestUTF8CollatorCompare(t *testing.T) {
 	SetNewCollationEnabledForTest(true)
 	defer SetNewCollationEnabledForTest(false)
-	collations := []string{"binary", "utf8mb4_bin", "utf8mb4_general_ci", "utf8mb4_unicode_ci", "gbk_bin", "gbk_chinese_ci"}
+	collations := []string{"binary", "utf8mb4_bin", "utf8mb4_general_ci", "utf8mb4_unicode_ci", "gbk_bin", "gbk_chinese_ci", "utf8mb4_0900_ai_ci", "utf8mb4_0900_as_cs", "utf8mb4_0900_bin"}
 	tests := []compareTable{
-		{"a", "b", []int{-1, -1, -1, -1, -1, -1}},
-		{"a", "A", []int{1, 1, 0, 0, 1, 0}},
-		{"À", "A", []int{1, 1, 0, 0, -1, -1}},
-		{"abc", "abc", []int{0, 0, 0, 0, 0, 0}},
-		{"abc", "ab", []int{1, 1, 1, 1, 1, 1}},
-		{"😜", "😃", []int{1, 1, 0, 0, 0, 0}},
-		{"a", "a ", []int{-1, 0, 0, 0, 0, 0}},
-		{"a ", "a  ", []int{-1, 0, 0, 0, 0, 0}},
-		{"a\t", "a", []int{1, 1, 1, 1, 1, 1}},
-		{"ß", "s", []int{1, 1, 0, 1, -1, -1}},
-		{"ß", "ss", []int{1, 1, -1, 0, -1, -1}},
-		{"啊", "吧", []int{1, 1, 1, 1, -1, -1}},
-		{"中文", "汉字", []int{-1, -1, -1, -1, 1, 1}},
+		{"a", "b", []int{-1, -1, -1, -1, -1, -1, -1, -1, -1}},
+		{"a", "A", []int{1, 1, 0, 0, 1, 0, 0, -1, 1}},
+		{"À", "A", []int{1, 1, 0, 0, -1, -1, 0, 1, 1}},
+		{"abc", "abc", []int{0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{"abc", "ab", []int{1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{"😜", "😃", []int{1, 1, 0, 0, 0, 0, 1, 1, 1}},
+		{"a", "a ", []int{-1, 0, 0, 0, 0, 0, -1, -1, -1}},
+		{"a ", "a  ", []int{-1, 0, 0, 0, 0, 0, -1, -1, -1}},
+		{"a\t", "a", []int{1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{"ß", "s", []int{1, 1, 0, 1, -1, -1, 1, 1, 1}},
+		{"ß", "ss", []int{1, 1, -1, 0, -1, -1, 0, 1, 1}},
+		{"啊", "吧", []int{1, 1, 1, 1, -1, -1, 1, 1, 1}},
+		{"中文", "汉字", []int{-1, -1, -1, -1, 1, 1, -1, -1, -1}},
+		{"𠀀", "中", []int{1, 1, 1, 1, -1, -1, 1, 1, 1}},
 	}
 	testCompareTable(t, collations, tests)
 }
@@ -77,10 +78,10 @@ func TestUTF8CollatorCompare(t *testing.T) {
 func TestUTF8CollatorKey(t *testing.T) {
 	SetNewCollationEnabledForTest(true)
 	defer SetNewCollationEnabledForTest(false)
-	collations := []string{"binary", "utf8mb4_bin", "utf8mb4_general_ci", "utf8mb4_unicode_ci", "gbk_bin", "gbk_chinese_ci"}
+	collations := []string{"binary", "utf8mb4_bin", "utf8mb4_general_ci", "utf8mb4_unicode_ci", "gbk_bin", "gbk_chinese_ci", "utf8mb4_0900_ai_ci", "utf8mb4_0900_bin"}
 	tests := []keyTable{
-		{"a", [][]byte{{0x61}, {0x61}, {0x0, 0x41}, {0x0E, 0x33}, {0x61}, {0x41}}},
-		{"A", [][]byte{{0x41}, {0x41}, {0x0, 0x41}, {0x0E, 0x33}, {0x41}, {0x41}}},
+		{"a", [][]byte{{0x61}, {0x61}, {0x0, 0x41}, {0x0E, 0x33}, {0x61}, {0x41}, {0x1F, 0xA2}, {0x61}}},
+		{"A", [][]byte{{0x41}, {0x41}, {0x0, 0x41}, {0x0E, 0x33}, {0x41}, {0x41}, {0x1F, 0xA2}, {0x41}}},
 		{"Foo © bar 𝌆 baz ☃ qux", [][]byte{
 			{0x46, 0x6f, 0x6f, 0x20, 0xc2, 0xa9, 0x20, 0x62, 0x61, 0x72, 0x20, 0xf0, 0x9d, 0x8c, 0x86, 0x20, 0x62, 0x61, 0x7a, 0x20, 0xe2, 0x98, 0x83, 0x20, 0x71, 0x75, 0x78},
 			{0x46, 0x6f, 0x6f, 0x20, 0xc2, 0xa9, 0x20, 0x62, 0x61, 0x72, 0x20, 0xf0, 0x9d, 0x8c, 0x86, 0x20, 0x62, 0x61, 0x7a, 0x20, 0xe2, 0x98, 0x83, 0x20, 0x71, 0x75, 0x78},
@@ -88,8 +89,10 @@ func TestUTF8CollatorKey(t *testing.T) {
 			{0x0E, 0xB9, 0x0F, 0x82, 0x0F, 0x82, 0x02, 0x09, 0x02, 0xC5, 0x02, 0x09, 0x0E, 0x4A, 0x0E, 0x33, 0x0F, 0xC0, 0x02, 0x09, 0xFF, 0xFD, 0x02, 0x09, 0x0E, 0x4A, 0x0E, 0x33, 0x10, 0x6A, 0x02, 0x09, 0x06, 0xFF, 0x02, 0x09, 0x0F, 0xB4, 0x10, 0x1F, 0x10, 0x5A},
 			{0x46, 0x6f, 0x6f, 0x20, 0x3f, 0x20, 0x62, 0x61, 0x72, 0x20, 0x3f, 0x20, 0x62, 0x61, 0x7a, 0x20, 0x3f, 0x20, 0x71, 0x75, 0x78},
 			{0x46, 0x4f, 0x4f, 0x20, 0x3f, 0x20, 0x42, 0x41, 0x52, 0x20, 0x3f, 0x20, 0x42, 0x41, 0x5a, 0x20, 0x3f, 0x20, 0x51, 0x55, 0x58},
+			{0x20, 0x42, 0x21, 0x3C, 0x21, 0x3C, 0x02, 0x09, 0x05, 0xD2, 0x02, 0x09, 0x1F, 0xBC, 0x1F, 0xA2, 0x21, 0x93, 0x02, 0x09, 0x10, 0x3C, 0x02, 0x09, 0x1F, 0xBC, 0x1F, 0xA2, 0x22, 0x86, 0x02, 0x09, 0x0A, 0x36, 0x02, 0x09, 0x21, 0x80, 0x22, 0x17, 0x22, 0x64},
+			{0x46, 0x6f, 0x6f, 0x20, 0xc2, 0xa9, 0x20, 0x62, 0x61, 0x72, 0x20, 0xf0, 0x9d, 0x8c, 0x86, 0x20, 0x62, 0x61, 0x7a, 0x20, 0xe2, 0x98, 0x83, 0x20, 0x71, 0x75, 0x78},
 		}},
-		{"a ", [][]byte{{0x61, 0x20}, {0x61}, {0x0, 0x41}, {0x0E, 0x33}, {0x61}, {0x41}}},
+		{"a ", [][]byte{{0x61, 0x20}, {0x61}, {0x0, 0x41}, {0x0E, 0x33}, {0x61}, {0x41}, {0x1F, 0xA2, 0x02, 0x09}, {0x61, 0x20}}},
 		{"ﷻ", [][]byte{
 			{0xEF, 0xB7, 0xBB},
 			{0xEF, 0xB7, 0xBB},
@@ -97,6 +100,8 @@ func TestUTF8CollatorKey(t *testing.T) {
 			{0x13, 0x5E, 0x13, 0xAB, 0x02, 0x09, 0x13, 0x5E, 0x13, 0xAB, 0x13, 0x50, 0x13, 0xAB, 0x13, 0xB7},
 			{0x3f},
 			{0x3F},
+			{0x26, 0x8F, 0x27, 0x0C, 0x02, 0x09, 0x26, 0x8F, 0x27, 0x0C, 0x26, 0x72, 0x27, 0x0C, 0x27, 0x22},
+			{0xEF, 0xB7, 0xBB},
 		}},
 		{"中文", [][]byte{
 			{0xE4, 0xB8, 0xAD, 0xE6, 0x96, 0x87},
@@ -105,9 +110,21 @@ func TestUTF8CollatorKey(t *testing.T) {
 			{0xFB, 0x40, 0xCE, 0x2D, 0xFB, 0x40, 0xE5, 0x87},
 			{0xD6, 0xD0, 0xCE, 0xC4},
 			{0xD3, 0x21, 0xC1, 0xAD},
+			{0xFB, 0x40, 0xCE, 0x2D, 0xFB, 0x40, 0xE5, 0x87},
+			{0xE4, 0xB8, 0xAD, 0xE6, 0x96, 0x87},
 		}},
 	}
 	testKeyTable(t, collations, tests)
+
+	// The weights of the levels are separated by 0x0000 in utf8mb4_0900_as_cs.
+	collations = []string{"utf8mb4_0900_as_cs"}
+	tests = []keyTable{
+		{"a", [][]byte{{0x1F, 0xA2, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x02}}},
+		{"A", [][]byte{{0x1F, 0xA2, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x08}}},
+		{"a ", [][]byte{{0x1F, 0xA2, 0x02, 0x09, 0x00, 0x00, 0x00, 0x20, 0x00, 0x20, 0x00, 0x00, 0x00, 0x02, 0x00, 0x02}}},
+		{"中", [][]byte{{0xFB, 0x40, 0xCE, 0x2D, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x02}}},
+	}
+	testKeyTable(t, collations, tests)
 }
 
 func TestSetNewCollateEnabled(t *testing.T) {
@@ -141,6 +158,9 @@ func TestGetCollator(t *testing.T) {
 	require.IsType(t, &generalCICollator{}, GetCollator("utf8_general_ci"))
 	require.IsType(t, &unicodeCICollator{}, GetCollator("utf8mb4_unicode_ci"))
 	require.IsType(t, &unicodeCICollator{}, GetCollator("utf8_unicode_ci"))
+	require.IsType(t, &unicode0900AICICollator{}, GetCollator("utf8mb4_0900_ai_ci"))
+	require.IsType(t, &unicode0900ASCSCollator{}, GetCollator("utf8mb4_0900_as_cs"))
+	require.IsType(t, &binCollator{}, GetCollator("utf8mb4_0900_bin"))
 	require.IsType(t, &zhPinyinTiDBASCSCollator{}, GetCollator("utf8mb4_zh_pinyin_tidb_as_cs"))
 	require.IsType(t, &binPaddingCollator{}, GetCollator("default_test"))
 	require.IsType(t, &binCollator{}, GetCollatorByID(63))
@@ -150,6 +170,9 @@ func TestGetCollator(t *testing.T) {
 	require.IsType(t, &generalCICollator{}, GetCollatorByID(33))
 	require.IsType(t, &unicodeCICollator{}, GetCollatorByID(224))
 	require.IsType(t, &unicodeCICollator{}, GetCollatorByID(192))
+	require.IsType(t, &unicode0900AICICollator{}, GetCollatorByID(255))
+	require.IsType(t, &unicode0900ASCSCollator{}, GetCollatorByID(278))
+	require.IsType(t, &binCollator{}, GetCollatorByID(309))
 	require.IsType(t, &zhPinyinTiDBASCSCollator{}, GetCollatorByID(2048))
 	require.IsType(t, &binPaddingCollator{}, GetCollatorByID(9999))
 
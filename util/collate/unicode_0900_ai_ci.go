@@ -0,0 +1,213 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+import (
+	"github.com/pingcap/tidb/util/stringutil"
+)
+
+const (
+	// the levels of the collation elements of UCA 9.0.0
+	uca0900Primary = iota
+	uca0900Secondary
+	uca0900Tertiary
+)
+
+// unicode0900AICICollator implements UCA 9.0.0 with the primary weights, it is accent-insensitive and
+// case-insensitive. Like MySQL, the 0900 collations are NO PAD, so the trailing spaces are significant.
+// see http://unicode.org/reports/tr10/
+type unicode0900AICICollator struct {
+}
+
+// Compare implements Collator interface.
+func (*unicode0900AICICollator) Compare(a, b string) int {
+	return uca0900CompareLevel(a, b, uca0900Primary)
+}
+
+// Key implements Collator interface.
+func (uc *unicode0900AICICollator) Key(str string) []byte {
+	return uc.KeyWithoutTrimRightSpace(str)
+}
+
+// KeyWithoutTrimRightSpace implements Collator interface.
+func (*unicode0900AICICollator) KeyWithoutTrimRightSpace(str string) []byte {
+	return uca0900AppendKey(make([]byte, 0, len(str)*2), str, uca0900Primary)
+}
+
+// Pattern implements Collator interface.
+func (*unicode0900AICICollator) Pattern() WildcardPattern {
+	return &unicode0900Pattern{level: uca0900Primary}
+}
+
+// uca0900Iter iterates the non-zero weights of a level of str.
+type uca0900Iter struct {
+	str   string
+	si    int
+	level int
+	// ces is the collation elements of the rune being iterated.
+	ces      []uint32
+	implicit [2]uint32
+}
+
+// next returns the next non-zero weight, 0 if there is no more weight.
+func (it *uca0900Iter) next() uint32 {
+	for {
+		for len(it.ces) > 0 {
+			w := uca0900Weight(it.ces[0], it.level)
+			it.ces = it.ces[1:]
+			if w != 0 {
+				return w
+			}
+		}
+		if it.si >= len(it.str) {
+			return 0
+		}
+		var r rune
+		r, it.si = decodeRune(it.str, it.si)
+		it.ces = convertRuneUnicode0900(r, &it.implicit)
+	}
+}
+
+// uca0900CompareLevel compares a and b by the weights of level.
+func uca0900CompareLevel(a, b string, level int) int {
+	ai := uca0900Iter{str: a, level: level}
+	bi := uca0900Iter{str: b, level: level}
+	for {
+		aw, bw := ai.next(), bi.next()
+		if aw != bw {
+			return sign(int(aw) - int(bw))
+		}
+		if aw == 0 {
+			return 0
+		}
+	}
+}
+
+// uca0900AppendKey appends the weights of level of str to buf.
+func uca0900AppendKey(buf []byte, str string, level int) []byte {
+	it := uca0900Iter{str: str, level: level}
+	for w := it.next(); w != 0; w = it.next() {
+		buf = append(buf, byte(w>>8), byte(w))
+	}
+	return buf
+}
+
+func uca0900Weight(ce uint32, level int) uint32 {
+	switch level {
+	case uca0900Primary:
+		return ce >> 16
+	case uca0900Secondary:
+		return (ce >> 5) & 0x1FF
+	default:
+		return ce & 0x1F
+	}
+}
+
+// convertRuneUnicode0900 returns the collation elements of r, implicit is used to hold the implicit
+// weights if r has no explicit weights.
+func convertRuneUnicode0900(r rune, implicit *[2]uint32) []uint32 {
+	if r >= 0 && r <= 0x10FFFF {
+		if page := uca0900Page[r>>8]; page != 0 {
+			if idx := uca0900Index[int(page)<<8|int(r&0xFF)]; idx != 0 {
+				offset, count := idx>>5, idx&0x1F
+				return uca0900CE[offset : offset+count]
+			}
+		}
+	}
+	// see https://www.unicode.org/reports/tr10/tr10-34.html#Implicit_Weights
+	var base, low uint32
+	switch {
+	case r >= 0x17000 && r <= 0x18AFF:
+		// Tangut and Tangut Components
+		base, low = 0xFB00, uint32(r-0x17000)
+	case isCoreHan0900(r):
+		base, low = 0xFB40+uint32(r>>15), uint32(r&0x7FFF)
+	case isUnifiedIdeograph0900(r):
+		base, low = 0xFB80+uint32(r>>15), uint32(r&0x7FFF)
+	default:
+		base, low = 0xFBC0+uint32(r>>15), uint32(r&0x7FFF)
+	}
+	implicit[0] = base<<16 | 0x20<<5 | 0x2
+	implicit[1] = (low | 0x8000) << 16
+	return implicit[:]
+}
+
+// isCoreHan0900 returns whether r is a unified ideograph in the CJK Unified Ideographs or
+// CJK Compatibility Ideographs block of Unicode 9.0.0.
+func isCoreHan0900(r rune) bool {
+	if r >= 0x4E00 && r <= 0x9FD5 {
+		return true
+	}
+	switch r {
+	case 0xFA0E, 0xFA0F, 0xFA11, 0xFA13, 0xFA14, 0xFA1F, 0xFA21, 0xFA23, 0xFA24, 0xFA27, 0xFA28, 0xFA29:
+		return true
+	}
+	return false
+}
+
+// isUnifiedIdeograph0900 returns whether r is a unified ideograph of the CJK extensions of Unicode 9.0.0.
+func isUnifiedIdeograph0900(r rune) bool {
+	return (r >= 0x3400 && r <= 0x4DB5) || (r >= 0x20000 && r <= 0x2A6D6) || (r >= 0x2A700 && r <= 0x2B734) ||
+		(r >= 0x2B740 && r <= 0x2B81D) || (r >= 0x2B820 && r <= 0x2CEA1)
+}
+
+type unicode0900Pattern struct {
+	patChars []rune
+	patTypes []byte
+	// level is the highest level of the weights compared, the weights of the lower levels are compared too.
+	level int
+}
+
+// Compile implements WildcardPattern interface.
+func (p *unicode0900Pattern) Compile(patternStr string, escape byte) {
+	p.patChars, p.patTypes = stringutil.CompilePatternInner(patternStr, escape)
+}
+
+// DoMatch implements WildcardPattern interface.
+func (p *unicode0900Pattern) DoMatch(str string) bool {
+	var ai, bi [2]uint32
+	return stringutil.DoMatchInner(str, p.patChars, p.patTypes, func(a, b rune) bool {
+		if a == b {
+			return true
+		}
+		aces, bces := convertRuneUnicode0900(a, &ai), convertRuneUnicode0900(b, &bi)
+		for level := uca0900Primary; level <= p.level; level++ {
+			if !uca0900EqualWeights(aces, bces, level) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// uca0900EqualWeights returns whether the non-zero weights of level of a and b are the same.
+func uca0900EqualWeights(a, b []uint32, level int) bool {
+	ai, bi := 0, 0
+	for {
+		aw, bw := uint32(0), uint32(0)
+		for ; ai < len(a) && aw == 0; ai++ {
+			aw = uca0900Weight(a[ai], level)
+		}
+		for ; bi < len(b) && bw == 0; bi++ {
+			bw = uca0900Weight(b[bi], level)
+		}
+		if aw != bw {
+			return false
+		}
+		if aw == 0 {
+			return true
+		}
+	}
+}
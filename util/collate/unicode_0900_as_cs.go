@@ -0,0 +1,51 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+// unicode0900ASCSCollator implements UCA 9.0.0 with the primary, secondary and tertiary weights,
+// it is accent-sensitive and case-sensitive. It's NO PAD like the other 0900 collations.
+type unicode0900ASCSCollator struct {
+}
+
+// Compare implements Collator interface.
+func (*unicode0900ASCSCollator) Compare(a, b string) int {
+	for level := uca0900Primary; level <= uca0900Tertiary; level++ {
+		if cmp := uca0900CompareLevel(a, b, level); cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+// Key implements Collator interface.
+func (uc *unicode0900ASCSCollator) Key(str string) []byte {
+	return uc.KeyWithoutTrimRightSpace(str)
+}
+
+// KeyWithoutTrimRightSpace implements Collator interface.
+// The weights of the levels are separated by 0x0000, which is less than any weight.
+func (*unicode0900ASCSCollator) KeyWithoutTrimRightSpace(str string) []byte {
+	buf := make([]byte, 0, len(str)*6+4)
+	buf = uca0900AppendKey(buf, str, uca0900Primary)
+	buf = append(buf, 0, 0)
+	buf = uca0900AppendKey(buf, str, uca0900Secondary)
+	buf = append(buf, 0, 0)
+	return uca0900AppendKey(buf, str, uca0900Tertiary)
+}
+
+// Pattern implements Collator interface.
+func (*unicode0900ASCSCollator) Pattern() WildcardPattern {
+	return &unicode0900Pattern{level: uca0900Tertiary}
+}
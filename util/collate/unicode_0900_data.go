@@ -0,0 +1,4884 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+/* Data from allkeys.txt(http://www.unicode.org/Public/UCA/13.0.0/allkeys.txt), only the characters assigned in Unicode '9.0.0' are kept, the others get the implicit weights as in UCA 9.0.0. Do not EDIT */
+var (
+	// uca0900Page maps a rune to its page in uca0900Index by r >> 8, the runes of page 0 have no explicit weights.
+	uca0900Page = [0x1100]uint16{
+		0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xA, 0xB, 0xC, 0xD, 0xE, 0xF, 0x10,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F, 0x20,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F, 0x30,
+		0x31, 0x32, 0x33, 0x34, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x35, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F, 0x40, 0x41, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x42, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49,
+		0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F, 0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0, 0x57, 0,
+		0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F, 0x60, 0, 0x61, 0, 0x62, 0, 0, 0,
+		0x63, 0x64, 0x65, 0x66, 0x67, 0x68, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x69, 0x6A, 0x6B, 0x6C, 0x6D, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0x6E, 0x6F, 0x70, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0x71, 0x72, 0x73, 0x74, 0, 0, 0, 0x75,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x76, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x77, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x78, 0x79, 0x7A, 0x7B, 0x7C, 0x7D, 0x7E, 0x7F, 0x80, 0x81, 0x82, 0, 0, 0, 0, 0,
+		0x83, 0, 0, 0, 0, 0, 0, 0, 0x84, 0x85, 0, 0, 0, 0, 0x86, 0,
+		0x87, 0x88, 0x89, 0x8A, 0x8B, 0x8C, 0x8D, 0x8E, 0x8F, 0x90, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0x91, 0x92, 0x93, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x94, 0x95, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	}
+	// uca0900Index is offset << 5 | count of the collation elements of a rune in uca0900CE.
+	uca0900Index = []uint32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x1, 0x21, 0x41, 0x61, 0x81, 0xA1, 0xC1, 0xE1, 0x101, 0x38E1, 0x3901, 0x3921, 0x3941, 0x3961, 0x121, 0x141,
+		0x161, 0x181, 0x1A1, 0x1C1, 0x1E1, 0x201, 0x221, 0x241, 0x261, 0x281, 0x2A1, 0x2C1, 0x2E1, 0x301, 0x321, 0x341,
+		0x3981, 0x39A1, 0x39C1, 0x39E1, 0x41CA1, 0x3A01, 0x3A21, 0x3A41, 0x3A61, 0x3A81, 0x3AA1, 0x3AC1, 0x3AE1, 0x3B01, 0x3B21, 0x3B41,
+		0x422A1, 0x42C81, 0x45A41, 0x476E1, 0x48AE1, 0x49CE1, 0x4AD21, 0x4BAA1, 0x4C881, 0x4D5C1, 0x3B61, 0x3B81, 0x3BA1, 0x3BC1, 0x3BE1, 0x3C01,
+		0x3C21, 0x4E541, 0x50AA1, 0x51461, 0x52441, 0x53621, 0x54F41, 0x55A21, 0x56841, 0x57821, 0x58C01, 0x593C1, 0x5A341, 0x5B661, 0x5C661, 0x5D701,
+		0x5FB81, 0x60861, 0x60F01, 0x62141, 0x634C1, 0x64381, 0x660E1, 0x66D21, 0x67781, 0x68061, 0x68BC1, 0x3C41, 0x3C61, 0x3C81, 0x3CA1, 0x3CC1,
+		0x3CE1, 0x4E2C1, 0x50821, 0x511C1, 0x52181, 0x53381, 0x54CC1, 0x557A1, 0x565C1, 0x57541, 0x58981, 0x59141, 0x5A081, 0x5B3C1, 0x5C3E1, 0x5D481,
+		0x5F901, 0x60601, 0x60C61, 0x61EC1, 0x63241, 0x64101, 0x65E41, 0x66AA1, 0x674E1, 0x67E01, 0x68941, 0x3D01, 0x3D21, 0x3D41, 0x3D61, 0x361,
+		0x381, 0x3A1, 0x3C1, 0x3E1, 0x401, 0x3D81, 0x421, 0x441, 0x461, 0x481, 0x4A1, 0x4C1, 0x4E1, 0x501, 0x521, 0x541,
+		0x561, 0x581, 0x5A1, 0x5C1, 0x5E1, 0x601, 0x621, 0x641, 0x661, 0x681, 0x6A1, 0x6C1, 0x6E1, 0x701, 0x721, 0x741,
+		0x3DA1, 0x3DC1, 0x41C61, 0x41D01, 0x41C41, 0x41D41, 0x3DE1, 0x3E01, 0x3E21, 0x3E41, 0x4E7C1, 0x3E61, 0x3E81, 0x761, 0x3EA1, 0x3EC1,
+		0x3EE1, 0x3F01, 0x467C1, 0x48461, 0x3F21, 0x6E741, 0x3F41, 0x3F61, 0x3F81, 0x43A61, 0x5D981, 0x3FA1, 0x44A03, 0x44383, 0x48723, 0x3FC1,
+		0x4E942, 0x4E8C2, 0x4ED42, 0x4F4C2, 0x4F342, 0x4F142, 0x4FD63, 0x51A42, 0x53A02, 0x53982, 0x53B02, 0x53F02, 0x57C22, 0x57BA2, 0x57D22, 0x57E22,
+		0x52BA2, 0x5CB42, 0x5DB02, 0x5DA82, 0x5DC02, 0x5E282, 0x5E082, 0x3FE1, 0x5E682, 0x64762, 0x646E2, 0x64862, 0x64A62, 0x68382, 0x695C1, 0x62E43,
+		0x4E902, 0x4E882, 0x4ED02, 0x4F482, 0x4F282, 0x4F102, 0x4FCA3, 0x519C2, 0x539C2, 0x53942, 0x53AC2, 0x53EC2, 0x57BE2, 0x57B62, 0x57CE2, 0x57DE2,
+		0x52B22, 0x5CB02, 0x5DAC2, 0x5DA42, 0x5DBC2, 0x5E242, 0x5DFC2, 0x4001, 0x5E642, 0x64722, 0x646A2, 0x64822, 0x649A2, 0x68342, 0x695A1, 0x68502,
+		0x4F702, 0x4F6C2, 0x4E9C2, 0x4E982, 0x4F682, 0x4F642, 0x51802, 0x517C2, 0x51882, 0x51842, 0x51982, 0x51942, 0x51902, 0x518C2, 0x527E2, 0x527A2,
+		0x52962, 0x52922, 0x54242, 0x54202, 0x53A82, 0x53A42, 0x54002, 0x53FC2, 0x541C2, 0x54182, 0x53E82, 0x53E42, 0x55E62, 0x55E22, 0x55DE2, 0x55DA2,
+		0x55F62, 0x55F22, 0x55FE2, 0x55FA2, 0x56BA2, 0x56B62, 0x56E62, 0x56DE2, 0x57F62, 0x57F22, 0x580A2, 0x58062, 0x57CA2, 0x57C62, 0x58022, 0x57FE2,
+		0x57FA2, 0x58721, 0x58562, 0x58522, 0x58F62, 0x58F22, 0x59842, 0x59802, 0x60C41, 0x5A6C2, 0x5A682, 0x5A7C2, 0x5A782, 0x5A742, 0x5A702, 0x5AB02,
+		0x5AAC2, 0x5A842, 0x5A802, 0x5C9C2, 0x5C982, 0x5CC42, 0x5CC02, 0x5CAC2, 0x5CA82, 0x69AA2, 0x5D421, 0x5D401, 0x5E902, 0x5E8C2, 0x5DB82, 0x5DB42,
+		0x5E202, 0x5E1C2, 0x5F263, 0x5F203, 0x61282, 0x61242, 0x61402, 0x613C2, 0x61302, 0x612C2, 0x624E2, 0x624A2, 0x62622, 0x625E2, 0x62862, 0x62822,
+		0x626A2, 0x62662, 0x63962, 0x63922, 0x63822, 0x637E2, 0x63F41, 0x63F21, 0x64EA2, 0x64E62, 0x65062, 0x65022, 0x647E2, 0x647A2, 0x64962, 0x64922,
+		0x64E22, 0x64DE2, 0x64FE2, 0x64FA2, 0x67162, 0x67122, 0x68482, 0x68442, 0x68542, 0x68EE2, 0x68EA2, 0x69062, 0x69022, 0x68FE2, 0x68FA2, 0x62AE2,
+		0x50FE1, 0x51101, 0x51141, 0x51121, 0x699E1, 0x699C1, 0x5F5C1, 0x520A1, 0x52081, 0x53261, 0x532A1, 0x53301, 0x532E1, 0x691A2, 0x54981, 0x54A21,
+		0x54AC1, 0x55721, 0x55701, 0x56481, 0x56541, 0x57341, 0x58921, 0x58861, 0x59F21, 0x59F01, 0x5B081, 0x5B381, 0x65D01, 0x5D281, 0x5D2C1, 0x5F7A1,
+		0x5ECC2, 0x5EC82, 0x565A1, 0x56581, 0x604E1, 0x604C1, 0x61A61, 0x69961, 0x69941, 0x63141, 0x631C1, 0x63FC1, 0x64021, 0x64001, 0x64061, 0x65362,
+		0x65322, 0x65DE1, 0x66981, 0x68881, 0x68861, 0x69221, 0x69201, 0x69421, 0x69521, 0x69501, 0x69561, 0x69861, 0x699A1, 0x69981, 0x63DC2, 0x69661,
+		0x69D41, 0x69D61, 0x69D81, 0x69DA1, 0x530E3, 0x53083, 0x53023, 0x5ABC2, 0x5AB82, 0x5AB42, 0x5D022, 0x5CFE2, 0x5CFA2, 0x4F0C2, 0x4F082, 0x57DA2,
+		0x57D62, 0x5DF82, 0x5DF42, 0x648E2, 0x648A2, 0x64D83, 0x64D23, 0x64B43, 0x64AE3, 0x64CC3, 0x64C63, 0x64C03, 0x64BA3, 0x54961, 0x4F423, 0x4F3C3,
+		0x4F5E3, 0x4F583, 0x4FFA4, 0x4FF24, 0x56421, 0x56401, 0x55EE2, 0x55EA2, 0x597C2, 0x59782, 0x5E7C2, 0x5E782, 0x5E863, 0x5E803, 0x694A2, 0x69462,
+		0x58FA2, 0x52FE2, 0x52FA2, 0x52F22, 0x55D62, 0x55D22, 0x57361, 0x69681, 0x5CA42, 0x5CA02, 0x4F223, 0x4F1C3, 0x4FEA4, 0x4FE24, 0x5E723, 0x5E6C3,
+		0x4F802, 0x4F7C2, 0x4F882, 0x4F842, 0x544C2, 0x54482, 0x54542, 0x54502, 0x581A2, 0x58162, 0x58222, 0x581E2, 0x5EBC2, 0x5EB82, 0x5EC42, 0x5EC02,
+		0x61502, 0x614C2, 0x61582, 0x61542, 0x65262, 0x65222, 0x652E2, 0x652A2, 0x62AA2, 0x62A62, 0x63A62, 0x63A22, 0x68921, 0x68901, 0x56C22, 0x56BE2,
+		0x5D2E1, 0x53321, 0x5F8A1, 0x5F881, 0x692A1, 0x69281, 0x4F542, 0x4F502, 0x54082, 0x54042, 0x5E163, 0x5E103, 0x5E4A3, 0x5E443, 0x5E542, 0x5E502,
+		0x5E5E3, 0x5E583, 0x686C2, 0x68682, 0x5B2E1, 0x5D3A1, 0x64081, 0x58FE1, 0x52D22, 0x60B22, 0x505C1, 0x52001, 0x51FE1, 0x5B0A1, 0x63F81, 0x63081,
+		0x69341, 0x69A41, 0x69A21, 0x51001, 0x65B61, 0x66A61, 0x548E1, 0x548C1, 0x59061, 0x59041, 0x60C21, 0x60C01, 0x61B41, 0x61B21, 0x68841, 0x68821,
+		0x50681, 0x506E1, 0x507A1, 0x510E1, 0x5F5A1, 0x520C1, 0x53241, 0x53281, 0x54B41, 0x549E1, 0x54B61, 0x54AA1, 0x54B81, 0x54C41, 0x54C61, 0x590E1,
+		0x56461, 0x56341, 0x563C1, 0x56521, 0x54CA1, 0x65C41, 0x573A1, 0x574E1, 0x58841, 0x58901, 0x58761, 0x5B101, 0x5B1C1, 0x5B281, 0x5B321, 0x65CE1,
+		0x65D81, 0x5C301, 0x5D261, 0x5D361, 0x5D1A1, 0x5F781, 0x5F481, 0x5F821, 0x605A1, 0x61B81, 0x61BE1, 0x61C21, 0x61C81, 0x61CA1, 0x61D01, 0x61D41,
+		0x61A21, 0x61E01, 0x63041, 0x63101, 0x59121, 0x631E1, 0x63221, 0x640C1, 0x64041, 0x65B41, 0x65DC1, 0x66961, 0x66A41, 0x674C1, 0x5B3A1, 0x68801,
+		0x692C1, 0x69301, 0x69401, 0x69581, 0x69A01, 0x69BC1, 0x69D21, 0x69DC1, 0x69DE1, 0x50FC1, 0x54C81, 0x564A1, 0x57321, 0x59081, 0x5A041, 0x5AF81,
+		0x60BE1, 0x69CE1, 0x69D01, 0x52F62, 0x53182, 0x53142, 0x63E02, 0x63E42, 0x63C22, 0x55582, 0x5AE62, 0x5AF42, 0x69E01, 0x69E21, 0x65CA1, 0x65CC1,
+		0x56AC1, 0x573E1, 0x58E81, 0x611A1, 0x61BA1, 0x61C41, 0x61E21, 0x66FA1, 0x682E1, 0x4021, 0x4041, 0x57501, 0x69A81, 0x57521, 0x69B01, 0x69C01,
+		0x69A61, 0x69C21, 0x4061, 0x4081, 0x40A1, 0x40C1, 0x40E1, 0x4101, 0x4121, 0x4141, 0x4161, 0x4181, 0x41A1, 0x41C1, 0x41E1, 0x4201,
+		0x41821, 0x41841, 0x4221, 0x4241, 0x4261, 0x4281, 0x42A1, 0x42C1, 0x42E1, 0x4301, 0x4321, 0x4341, 0x4361, 0x4381, 0x43A1, 0x43C1,
+		0x56561, 0x5A5E1, 0x62421, 0x67A21, 0x69BE1, 0x43E1, 0x4401, 0x4421, 0x4441, 0x4461, 0x4481, 0x44A1, 0x44C1, 0x44E1, 0x69AE1, 0x4501,
+		0x4521, 0x4541, 0x4561, 0x4581, 0x45A1, 0x45C1, 0x45E1, 0x4601, 0x4621, 0x4641, 0x4661, 0x4681, 0x46A1, 0x46C1, 0x46E1, 0x4701,
+		0x3D5A1, 0x3D541, 0x3D621, 0x3D721, 0x3D7C1, 0x3E961, 0x3D601, 0x3D741, 0x3D6A1, 0x3E981, 0x3D661, 0x3D701, 0x3D641, 0x3D7E1, 0x3D801, 0x3E9A1,
+		0x3E9C1, 0x3E9E1, 0x3D821, 0x3D461, 0x3D4E1, 0x3D841, 0x3DF81, 0x3DFA1, 0x3DFC1, 0x3DFE1, 0x3D861, 0x3EA01, 0x3E001, 0x3E021, 0x3E041, 0x3E061,
+		0x3E081, 0x3EA21, 0x3EA41, 0x3EA61, 0x3EA81, 0x3EAA1, 0x3EAC1, 0x3D781, 0x3D7A1, 0x3E0A1, 0x3E0C1, 0x3E0E1, 0x3E101, 0x3EAE1, 0x3EB01, 0x3E121,
+		0x3EB21, 0x3EB41, 0x3D441, 0x3E141, 0x3EB61, 0x3E941, 0x3E6A1, 0x3E6C1, 0x3D761, 0x3EB81, 0x3E161, 0x3E181, 0x3E1A1, 0x3D881, 0x3D8A1, 0x3D8C1,
+		0x3D5C1, 0x3D561, 0x3D681, 0x3D481, 0x3D6C2, 0x3EBA1, 0x3D8E1, 0x3E1C1, 0x3E1E1, 0x3E201, 0x3D901, 0x3D921, 0x3D941, 0x3E221, 0x3E241, 0x4721,
+		0x3D961, 0x3D981, 0x3D9A1, 0x3E261, 0x3E281, 0x3E2A1, 0x3E2C1, 0x3D9C1, 0x3EBC1, 0x3E2E1, 0x3E301, 0x3D9E1, 0x3E321, 0x3DA01, 0x3DA21, 0x3E341,
+		0x3EBE1, 0x3EC41, 0x3E361, 0x4E301, 0x533C1, 0x57581, 0x5D4C1, 0x64141, 0x51201, 0x521C1, 0x56601, 0x5B401, 0x60CA1, 0x63281, 0x65E81, 0x67521,
+		0x6C121, 0x6C101, 0x723A1, 0x72381, 0x4741, 0x4761, 0x6BF21, 0x6BF01, 0, 0, 0x6D681, 0x6FE41, 0x6FE01, 0x6FE81, 0x4781, 0x6E261,
+		0, 0, 0, 0, 0x47A1, 0x47C2, 0x6AD42, 0x4801, 0x6BD82, 0x6D042, 0x6DE02, 0, 0x6F3C2, 0, 0x70682, 0x72042,
+		0x6E043, 0x69F01, 0x6B201, 0x6B3E1, 0x6B5E1, 0x6B841, 0x6C041, 0x6C201, 0x6D4A1, 0x6D741, 0x6E401, 0x6E641, 0x6E801, 0x6EB81, 0x6ED01, 0x6EE81,
+		0x6F661, 0x6F9A1, 0, 0x6FD01, 0x6FF81, 0x70101, 0x70CC1, 0x70E81, 0x71041, 0x711E1, 0x6E002, 0x708C2, 0x6ACC2, 0x6BD02, 0x6CFC2, 0x6DD82,
+		0x70943, 0x69E41, 0x6B121, 0x6B301, 0x6B521, 0x6B6C1, 0x6BF81, 0x6C141, 0x6D321, 0x6D641, 0x6E281, 0x6E581, 0x6E721, 0x6EAC1, 0x6EC41, 0x6EDC1,
+		0x6F4C1, 0x6F821, 0x6FDE1, 0x6FB81, 0x6FEC1, 0x70041, 0x70B41, 0x70DC1, 0x70F81, 0x71121, 0x6DFC2, 0x70882, 0x6F342, 0x70602, 0x71FC2, 0x6E523,
+		0x6B141, 0x6D341, 0x70121, 0x70702, 0x70902, 0x70B61, 0x6F4E1, 0x6E4C3, 0x6F801, 0x6F7E1, 0x6BF61, 0x6BF41, 0x6BEC1, 0x6BE81, 0x6F7C1, 0x6F7A1,
+		0x72361, 0x72341, 0x72C41, 0x72C21, 0x72D81, 0x72D61, 0x72DC1, 0x72DA1, 0x72E81, 0x72E61, 0x73041, 0x73021, 0x73101, 0x730E1, 0x73201, 0x731E1,
+		0x6E2A1, 0x6F841, 0x6FBA1, 0x6E241, 0x6D4C1, 0x6B6E1, 0x4821, 0x723E1, 0x723C1, 0x6FD21, 0x6F781, 0x6F761, 0x6FB61, 0x6FE61, 0x6FE21, 0x6FEA1,
+		0x73BA2, 0x73CA2, 0x73A21, 0x73742, 0x73D21, 0x74161, 0x74501, 0x745A2, 0x74681, 0x74B41, 0x74F21, 0x757A1, 0x747A2, 0x74362, 0x75862, 0x76361,
+		0x733A1, 0x73601, 0x73681, 0x736E1, 0x73961, 0x73B41, 0x73D81, 0x73FA1, 0x74301, 0x74641, 0x74741, 0x749E1, 0x74C61, 0x74D41, 0x750A1, 0x752E1,
+		0x75401, 0x75501, 0x75661, 0x75801, 0x75BA1, 0x75C01, 0x75F41, 0x760A1, 0x763C1, 0x76461, 0x76561, 0x76621, 0x76701, 0x76861, 0x76941, 0x76A21,
+		0x73361, 0x735C1, 0x73621, 0x736A1, 0x73901, 0x73B01, 0x73D41, 0x73F61, 0x742C1, 0x74621, 0x74701, 0x749A1, 0x74C21, 0x74D01, 0x74F81, 0x752A1,
+		0x753C1, 0x754A1, 0x755E1, 0x757C1, 0x75B61, 0x75BC1, 0x75F01, 0x76061, 0x76381, 0x76421, 0x76501, 0x765E1, 0x766C1, 0x76841, 0x76901, 0x76A01,
+		0x73B62, 0x73C62, 0x73A01, 0x73702, 0x73CE1, 0x74141, 0x744E1, 0x74522, 0x74661, 0x74B21, 0x74F01, 0x75781, 0x74762, 0x74322, 0x75822, 0x76341,
+		0x75DE1, 0x75DA1, 0x767E1, 0x76781, 0x76AC1, 0x76A81, 0x76B21, 0x76AE1, 0x76C41, 0x76C21, 0x76BC1, 0x76B81, 0x76CE1, 0x76CA1, 0x76D21, 0x76D01,
+		0x76D61, 0x76D41, 0x76DC1, 0x76D81, 0x76E01, 0x76DE1, 0x76E62, 0x76E22, 0x75B41, 0x75B21, 0x75EE1, 0x75EC1, 0x75EA1, 0x75E81, 0x75E21, 0x75E01,
+		0x753A1, 0x75381, 0x4841, 0x3EC81, 0x3DA41, 0x3D501, 0x3D4A1, 0x3DA61, 0x4861, 0x4881, 0x744C1, 0x744A1, 0x76761, 0x76741, 0x75441, 0x75421,
+		0x737C2, 0x73782, 0x73821, 0x73801, 0x738A1, 0x73881, 0x73F41, 0x73F21, 0x73AE1, 0x73AC1, 0x74801, 0x747E1, 0x74901, 0x748E1, 0x748C1, 0x748A1,
+		0x74881, 0x74861, 0x74E21, 0x74E01, 0x74EE1, 0x74EC1, 0x75361, 0x75341, 0x76F01, 0x76EE1, 0x755C1, 0x755A1, 0x75721, 0x75701, 0x75A41, 0x75A21,
+		0x75A81, 0x75A61, 0x75CC1, 0x75CA1, 0x76001, 0x75FE1, 0x761E1, 0x761C1, 0x76261, 0x76241, 0x75D01, 0x75CE1, 0x762E1, 0x762C1, 0x76321, 0x76301,
+		0x76F81, 0x73DE2, 0x73DA2, 0x74841, 0x74821, 0x74A41, 0x74A21, 0x74E61, 0x74E41, 0x74DE1, 0x74DC1, 0x76221, 0x76201, 0x74CA1, 0x74C81, 0x76F61,
+		0x73402, 0x733C2, 0x73482, 0x73442, 0x735A1, 0x73581, 0x73C22, 0x73BE2, 0x734E1, 0x734C1, 0x73542, 0x73502, 0x73E62, 0x73E22, 0x74002, 0x73FC2,
+		0x741E1, 0x741C1, 0x74462, 0x74422, 0x743E2, 0x743A2, 0x751A2, 0x75162, 0x75201, 0x751E1, 0x75262, 0x75222, 0x768C2, 0x76882, 0x759E2, 0x759A2,
+		0x758E2, 0x758A2, 0x75962, 0x75922, 0x76102, 0x760C2, 0x738E1, 0x738C1, 0x76682, 0x76642, 0x73861, 0x73841, 0x75C41, 0x75C21, 0x75C81, 0x75C61,
+		0x739A1, 0x73981, 0x73AA1, 0x73A81, 0x740A1, 0x74081, 0x74261, 0x74241, 0x74BC1, 0x74BA1, 0x74F61, 0x74F41, 0x75581, 0x75561, 0x756E1, 0x756C1,
+		0x740E1, 0x740C1, 0x74AC1, 0x74AA1, 0x74C01, 0x74BE1, 0x75481, 0x75461, 0x76A61, 0x76A41, 0x74981, 0x74961, 0x76F41, 0x76F21, 0x74941, 0x74921,
+		0x74B01, 0x74AE1, 0x74EA1, 0x74E81, 0x75321, 0x75301, 0x75D41, 0x75D21, 0x74DA1, 0x74D81, 0x73EC1, 0x73EA1, 0x76161, 0x76141, 0x74A81, 0x74A61,
+		0, 0x79581, 0x795C1, 0x79601, 0x79641, 0x79681, 0x79701, 0x79741, 0x79781, 0x797C1, 0x79801, 0x79841, 0x79881, 0x798C1, 0x79901, 0x79941,
+		0x79981, 0x799C1, 0x79A01, 0x79A41, 0x79A81, 0x79BC1, 0x79C01, 0x79C41, 0x79C81, 0x79CC1, 0x79D01, 0x79D41, 0x79D81, 0x79DC1, 0x79E01, 0x79E81,
+		0x79EC1, 0x79F01, 0x79F41, 0x79F81, 0x79FC1, 0x7A001, 0x7A041, 0, 0, 0x7A061, 0x48A1, 0x48C1, 0x48E1, 0x4901, 0x4921, 0x4941,
+		0, 0x79561, 0x795A1, 0x795E1, 0x79621, 0x79661, 0x796E1, 0x79721, 0x79761, 0x797A1, 0x797E1, 0x79821, 0x79861, 0x798A1, 0x798E1, 0x79921,
+		0x79961, 0x799A1, 0x799E1, 0x79A21, 0x79A61, 0x79BA1, 0x79BE1, 0x79C21, 0x79C61, 0x79CA1, 0x79CE1, 0x79D21, 0x79D61, 0x79DA1, 0x79DE1, 0x79E61,
+		0x79EA1, 0x79EE1, 0x79F21, 0x79F61, 0x79FA1, 0x79FE1, 0x7A021, 0x796A2, 0, 0x4961, 0x4981, 0, 0, 0x49A1, 0x49C1, 0x41D81,
+		0, 0x49E1, 0x4A01, 0x4A21, 0x4A41, 0x4A61, 0x4A81, 0x4AA1, 0x4AC1, 0x4AE1, 0x4B01, 0x4B21, 0x4B41, 0x4B61, 0x4B81, 0x4BA1,
+		0x4BC1, 0x4BE1, 0x4C01, 0x4C21, 0x4C41, 0x4C61, 0x4C81, 0x4CA1, 0x4CC1, 0x4CE1, 0x4D01, 0x4D21, 0x4D41, 0x4D61, 0x4D81, 0x4DA1,
+		0x3ECE1, 0x3ED01, 0x3ED21, 0x3ED41, 0x3ED61, 0x3ED81, 0x3EDA1, 0x3EDC1, 0x3EDE1, 0x3EE21, 0x3EE41, 0x3EE61, 0x3EEC1, 0x4DC1, 0x4DE1, 0x3EEE1,
+		0x4E01, 0x3EEA1, 0x3EE81, 0x4E21, 0x4E41, 0x4E61, 0x4E81, 0x3EE01, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x7A081, 0x7A1E1, 0x7A2A1, 0x7A321, 0x7A3C1, 0x7A441, 0x7A561, 0x7A5C1, 0x7A5E1, 0x7A641, 0x7A7C1, 0x7A781, 0x7A8A1, 0x7A961, 0x7A921, 0x7A9E1,
+		0x7A9C1, 0x7AA41, 0x7AAA1, 0x7AB01, 0x7AAE1, 0x7AC01, 0x7ABE1, 0x7AC61, 0x7ACC1, 0x7AD41, 0x7AEE1, 0, 0, 0, 0, 0,
+		0x7A4E2, 0x7A522, 0x7A6E2, 0x4EA1, 0x4EC1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x4EE1, 0x4F01, 0x4F21, 0x4F41, 0x4F61, 0x4F81, 0x4FA1, 0x4FC1, 0x4FE1, 0x5001, 0x5021, 0x41DA1, 0x5041, 0x5061, 0x5081, 0x50A1,
+		0x50C1, 0x50E1, 0x5101, 0x5121, 0x5141, 0x5161, 0x5181, 0x51A1, 0x51C1, 0x51E1, 0x5201, 0x5221, 0x781, 0, 0x5241, 0x5261,
+		0x88A41, 0x7B561, 0x7B601, 0x7B661, 0x7B741, 0x7B7A1, 0x7B861, 0x7C261, 0x7C541, 0x7CF41, 0x7CFA1, 0x7DAE1, 0x7E1A1, 0x7EB01, 0x7EF81, 0x7F441,
+		0x7F501, 0x7F8A1, 0x7FAA1, 0x7FE01, 0x80821, 0x813A1, 0x81D21, 0x82401, 0x828E1, 0x82B01, 0x83121, 0x85001, 0x85021, 0x889E1, 0x88A01, 0x88A21,
+		0x5281, 0x83661, 0x83EA1, 0x84461, 0x85081, 0x85D81, 0x86701, 0x87361, 0x87981, 0x87EC1, 0x87FE1, 0x3F1A1, 0x3F241, 0x3F301, 0x3F3C1, 0x3F501,
+		0x3F621, 0x3F741, 0x3F801, 0x3F881, 0x3F8A1, 0x3F8C1, 0x3F901, 0x3F921, 0x3F941, 0x3F981, 0x3F9A1, 0x3F9C1, 0x3F9E1, 0x3FA01, 0x3FA21, 0x3F8E1,
+		0x422C1, 0x42CA1, 0x45A61, 0x47701, 0x48B01, 0x49D01, 0x4AD41, 0x4BAC1, 0x4C8A1, 0x4D5E1, 0x52A1, 0x52C1, 0x52E1, 0x5301, 0x7C4E1, 0x83E41,
+		0x3FB41, 0x7B6E1, 0x7B6C1, 0x7B801, 0x7B581, 0x7C3A2, 0x87A62, 0x87C62, 0x88162, 0x7DF61, 0x7E001, 0x7CC01, 0x7E0A1, 0x7E0C1, 0x7CCA1, 0x7E0E1,
+		0x7CD41, 0x7F321, 0x7F341, 0x7E841, 0x7E8E1, 0x7F361, 0x7E981, 0x7EA41, 0x7F601, 0x7F661, 0x7F681, 0x7F6A1, 0x7F6C1, 0x7F721, 0x7F7A1, 0x7F801,
+		0x7F821, 0x7FB61, 0x7FBC1, 0x7FBE1, 0x7FC01, 0x7FC21, 0x7FC41, 0x7FC61, 0x7FC81, 0x7FCE1, 0x81281, 0x812A1, 0x812C1, 0x82381, 0x823C1, 0x82AC1,
+		0x835A1, 0x83BC1, 0x83C21, 0x83C61, 0x83C81, 0x83D41, 0x83D61, 0x843E1, 0x84421, 0x84B81, 0x84C21, 0x84C41, 0x84C61, 0x84CA1, 0x84D41, 0x84D81,
+		0x84E41, 0x84E61, 0x84F01, 0x84F21, 0x84FC1, 0x85CC1, 0x85CE1, 0x85D01, 0x85D21, 0x872E1, 0x87141, 0x87201, 0x872A1, 0x872C1, 0x876E1, 0x7EA21,
+		0x878C2, 0x87781, 0x87822, 0x87861, 0x87B21, 0x87B41, 0x87BA1, 0x87C01, 0x87CE1, 0x87D41, 0x87DA1, 0x87DC1, 0x88841, 0x888E1, 0x88901, 0x87E41,
+		0x88921, 0x889C1, 0x88AE1, 0x88B42, 0x5321, 0x878A1, 0x5341, 0x5361, 0x5381, 0x53A1, 0x53C1, 0x53E1, 0x5401, 0x5421, 0x5441, 0x5461,
+		0x5481, 0x54A1, 0x54C1, 0x54E1, 0x5501, 0x879A1, 0x88001, 0x5521, 0x5541, 0x5561, 0x5581, 0x55A1, 0x55C1, 0x55E1, 0x7F841, 0x7FD01,
+		0x422E1, 0x42CC1, 0x45A81, 0x47721, 0x48B21, 0x49D21, 0x4AD61, 0x4BAE1, 0x4C8C1, 0x4D601, 0x812E1, 0x823E1, 0x835C1, 0x7B5C2, 0x85EC2, 0x87881,
+		0x5601, 0x5621, 0x5641, 0x5661, 0x5681, 0x56A1, 0x56C1, 0x56E1, 0x5701, 0x5721, 0x5741, 0x5761, 0x5781, 0x57A1, 0, 0x57C1,
+		0x88C41, 0x3FB61, 0x88C61, 0x88CC1, 0x88CE2, 0x88D81, 0x88D61, 0x88DE1, 0x88E01, 0x88E21, 0x88E61, 0x88E81, 0x88EA2, 0x88EE1, 0x88F01, 0x88F21,
+		0x88F61, 0x88F81, 0x88FA1, 0x88FC1, 0x88FE1, 0x89001, 0x89021, 0x89042, 0x890A1, 0x890C1, 0x890E1, 0x89101, 0x89121, 0x88C82, 0x88D22, 0x88DA2,
+		0x3FB81, 0x3FBA1, 0x3FBC1, 0x3FBE1, 0x3FC01, 0x3FC21, 0x3FC41, 0x3FC61, 0x3FC81, 0x3FCA1, 0x3FCC1, 0x3FCE1, 0x3FD01, 0x3FD21, 0x3FD41, 0x3FD61,
+		0x57E1, 0x3DA81, 0x3E381, 0x5801, 0x5821, 0x3DAA1, 0x3E3A1, 0x5841, 0x5861, 0x5881, 0x58A1, 0, 0, 0x88E41, 0x88F41, 0x89081,
+		0x7CDE1, 0x7CE01, 0x7CE21, 0x7CE41, 0x7CE61, 0x7CE81, 0x7CEC1, 0x7F381, 0x7F3A1, 0x7F861, 0x7F881, 0x7FD21, 0x81301, 0x835E1, 0x83601, 0x83621,
+		0x83E01, 0x83E21, 0x84FE1, 0x85041, 0x85061, 0x866A1, 0x866C1, 0x87301, 0x87321, 0x87341, 0x85D41, 0x7FD41, 0x7FD61, 0x81321, 0x7F3C1, 0x7F3E1,
+		0x81341, 0x7FD81, 0x7F401, 0x7B821, 0x7B841, 0x88A61, 0x88A81, 0x88AA1, 0x87E61, 0x87E81, 0x88C01, 0x88C21, 0x7F421, 0x81361, 0x81381, 0x84C81,
+		0x89461, 0x894C1, 0x894E1, 0x89501, 0x89541, 0x89561, 0x89581, 0x895A1, 0x89601, 0x89641, 0x89661, 0x89681, 0x896C1, 0x89741, 0x89761, 0x897A1,
+		0x897C1, 0x89841, 0x89861, 0x89881, 0x898A1, 0x898C1, 0x898E1, 0x89901, 0x896E1, 0x89481, 0x894A1, 0x896A1, 0x89521, 0x897E1, 0x89801, 0x89821,
+		0x89701, 0x89721, 0x895C1, 0x895E1, 0x89781, 0x89621, 0x89941, 0x89961, 0x89981, 0x899A1, 0x899C1, 0x899E1, 0x89A01, 0x89A21, 0x89A41, 0x89A61,
+		0x89A81, 0x89921, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x42301, 0x42CE1, 0x45AA1, 0x47741, 0x48B41, 0x49D41, 0x4AD81, 0x4BB01, 0x4C8E1, 0x4D621, 0x89AA1, 0x89AC1, 0x89AE1, 0x89B01, 0x89B21, 0x89B41,
+		0x89B61, 0x89B81, 0x89BA1, 0x89BC1, 0x89BE1, 0x89C01, 0x89C21, 0x89C81, 0x89CE1, 0x89D01, 0x89D61, 0x89D81, 0x89DA1, 0x89DC1, 0x89DE1, 0x89E01,
+		0x89E21, 0x89E41, 0x89E61, 0x89E81, 0x89EA1, 0x89EC1, 0x89EE1, 0x89F01, 0x89C42, 0x89CA2, 0x89D22, 0x3FD81, 0x3FDA1, 0x3FDC1, 0x3FDE1, 0x3FE01,
+		0x3FE21, 0x3FE41, 0x3FE61, 0x3FE81, 0x89F21, 0x89F41, 0x58C1, 0x58E1, 0x5901, 0x5921, 0x5941, 0, 0, 0, 0, 0,
+		0x7B221, 0x7B241, 0x7B261, 0x7B281, 0x7B2A1, 0x7B2C1, 0x7B2E1, 0x7B301, 0x7B321, 0x7B341, 0x7B361, 0x7B381, 0x7B3A1, 0x7B3C1, 0x7B3E1, 0x7B401,
+		0x7B421, 0x7B441, 0x7B461, 0x7B481, 0x7B4A1, 0x7B4C1, 0x7B4E1, 0x7B501, 0x3F141, 0x3F161, 0x7B521, 0x7B541, 0x3EF21, 0x3EF41, 0x3EF61, 0x3EF81,
+		0x3EFA1, 0x3EFC1, 0x3EFE1, 0x3F001, 0x3F021, 0x3F041, 0x3F061, 0x3F081, 0x3F0A1, 0x3F0C1, 0x3F0E1, 0x3F101, 0x3F121, 0x3F181, 0, 0,
+		0x5961, 0x5981, 0x59A1, 0x59C1, 0x59E1, 0x5A01, 0x5A21, 0x5A41, 0x5A61, 0x5A81, 0x5AA1, 0x5AC1, 0x5AE1, 0x5B01, 0x5B21, 0,
+		0x89141, 0x89161, 0x89181, 0x891A1, 0x891C1, 0x891E1, 0x89201, 0x89221, 0x89241, 0x89261, 0x89281, 0x892A1, 0x892C1, 0x892E1, 0x89301, 0x89321,
+		0x89341, 0x89361, 0x89381, 0x893A1, 0x893C1, 0x893E1, 0x89401, 0x89421, 0x89441, 0x3E3C1, 0x3E3E1, 0x3E401, 0, 0, 0x5B41, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x7CEA1, 0x7CEE1, 0x7EAE1, 0x82AE1, 0x83D21, 0x84441, 0x85D61, 0x866E1, 0x7C201, 0x7C221, 0x7FDA1, 0x87EA1, 0x7C241, 0x7C281, 0x7F781, 0x823A1,
+		0x84E21, 0x87E21, 0x7FDC1, 0x83641, 0x84D61, 0, 0x7CF01, 0x7CF21, 0x7E181, 0x7FDE1, 0x88AC1, 0x83C41, 0x84401, 0x871E1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0x5B61, 0x5B81, 0x5BA1, 0x5BC1, 0x5BE1, 0x5C01, 0x5C21, 0x5C41, 0x5C61, 0x5C81, 0x5CA1, 0x5CC1,
+		0x5CE1, 0x5D01, 0x5D21, 0x3FA41, 0x3F4A1, 0x3F5E1, 0x3F701, 0x3F221, 0x3F2E1, 0x3F3A1, 0x5D41, 0x5D61, 0x5D81, 0x5DA1, 0x5DC1, 0x5DE1,
+		0x3F201, 0x3F2C1, 0x3F381, 0x5E01, 0x3F4C1, 0x3F4E1, 0x3F721, 0x3FA61, 0x3FA81, 0x3FB01, 0x3FB21, 0x3FAC1, 0x3FAE1, 0x3FAA1, 0x3F601, 0x3F961,
+		0x40341, 0x40361, 0x40661, 0x40AE1, 0x8DF81, 0x8DFA1, 0x8DFC1, 0x8E081, 0x8E0A1, 0x8E0C1, 0x8E0E1, 0x8E101, 0x8E141, 0x8E181, 0x8E1A1, 0x8E1C1,
+		0x8E1E1, 0x8E201, 0x8E221, 0x8E241, 0x8E261, 0x8E281, 0x8E2E1, 0x8E341, 0x8E3C1, 0x8E3E1, 0x8E401, 0x8E421, 0x8E441, 0x8E4E1, 0x8E501, 0x8E521,
+		0x8E541, 0x8E581, 0x8E601, 0x8E661, 0x8E681, 0x8E6A1, 0x8E6C1, 0x8E6E1, 0x8E701, 0x8E722, 0x8E761, 0x8E781, 0x8E7E1, 0x8E821, 0x8E841, 0x8E861,
+		0x8E8E1, 0x8E902, 0x8E941, 0x8E961, 0x8E982, 0x8E9C1, 0x8E9E1, 0x8EA01, 0x8EA21, 0x8EA41, 0x8ECE1, 0x8ED01, 0x400C1, 0x8EA61, 0x8ECC1, 0x8ED81,
+		0x8EDA1, 0x8EDC1, 0x8EDE1, 0x8EE01, 0x8EE21, 0x8EE81, 0x8EEC1, 0x8EEE1, 0x8EF21, 0x8EF41, 0x8EF61, 0x8EF81, 0x8EFA1, 0x8EFC1, 0x8EF01, 0x8ED21,
+		0x8DF21, 0x5E21, 0x5E41, 0x3D5E1, 0x3D581, 0x8EEA1, 0x8ED41, 0x8ED61, 0x8E2A2, 0x8E302, 0x8E362, 0x8E462, 0x8E5A2, 0x8E622, 0x8E7A2, 0x8E882,
+		0x8E121, 0x8E161, 0x8EE41, 0x8EE61, 0x5E61, 0x5E81, 0x42321, 0x42D01, 0x45AC1, 0x47761, 0x48B61, 0x49D61, 0x4ADA1, 0x4BB21, 0x4C901, 0x4D641,
+		0x5EA1, 0x41861, 0x8DF61, 0x8DFE1, 0x8E001, 0x8E021, 0x8E041, 0x8E061, 0x8E561, 0x8E4A1, 0x8E8C1, 0x8E3A1, 0x8E4C1, 0x8EA81, 0x8E5E1, 0x8E801,
+		0x8EFE1, 0x40381, 0x40681, 0x40B01, 0, 0x8F001, 0x8F021, 0x8F041, 0x8F061, 0x8F081, 0x8F0A1, 0x8F0C1, 0x8F101, 0, 0, 0x8F141,
+		0x8F161, 0, 0, 0x8F181, 0x8F1A1, 0x8F1C1, 0x8F1E1, 0x8F201, 0x8F221, 0x8F241, 0x8F261, 0x8F281, 0x8F2A1, 0x8F2C1, 0x8F2E1, 0x8F301,
+		0x8F321, 0x8F341, 0x8F3A1, 0x8F401, 0x8F421, 0x8F481, 0x8F4A1, 0x8F4C1, 0x8F4E1, 0, 0x8F501, 0x8F521, 0x8F541, 0x8F561, 0x8F581, 0x8F5A1,
+		0x8F601, 0, 0x8F641, 0, 0, 0, 0x8F681, 0x8F6A1, 0x8F6C1, 0x8F6E1, 0, 0, 0x400E1, 0x8F701, 0x8F721, 0x8F741,
+		0x8F761, 0x8F781, 0x8F7A1, 0x8F7C1, 0x8F7E1, 0, 0, 0x8F841, 0x8F861, 0, 0, 0x8F881, 0x8F8A1, 0x8F8C1, 0x8F442, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x8F8E1, 0, 0, 0, 0, 0x8F362, 0x8F3C2, 0, 0x8F5C2,
+		0x8F0E1, 0x8F121, 0x8F801, 0x8F821, 0, 0, 0x42341, 0x42D21, 0x45AE1, 0x47781, 0x48B81, 0x49D81, 0x4ADC1, 0x4BB41, 0x4C921, 0x4D661,
+		0x8F621, 0x8F661, 0x41DC1, 0x41DE1, 0x5EC1, 0x5EE1, 0x5F01, 0x5F21, 0x5F41, 0x5F61, 0x5F81, 0x41E01, 0, 0, 0, 0,
+		0, 0x403A1, 0x406A1, 0x40B21, 0, 0x8F9A1, 0x8F9C1, 0x8FA41, 0x8FA61, 0x8F941, 0x8F961, 0, 0, 0, 0, 0x8FA81,
+		0x8F9E1, 0, 0, 0x8F981, 0x8FA01, 0x8FB41, 0x8FB61, 0x8FBC1, 0x8FC21, 0x8FC41, 0x8FC61, 0x8FC81, 0x8FCA1, 0x8FD01, 0x8FD21, 0x8FD41,
+		0x8FD61, 0x8FD81, 0x8FDA1, 0x8FDC1, 0x8FDE1, 0x8FE01, 0x8FE21, 0x8FE41, 0x8FE61, 0, 0x8FE81, 0x8FEA1, 0x8FF01, 0x8FF21, 0x8FF41, 0x8FF61,
+		0x8FFA1, 0, 0x8FFC1, 0x8FFE2, 0, 0x90021, 0x8FAC2, 0, 0x8FAA1, 0x8FB01, 0, 0, 0x40101, 0, 0x90061, 0x90081,
+		0x900A1, 0x900C1, 0x900E1, 0, 0, 0, 0, 0x90101, 0x90121, 0, 0, 0x90141, 0x90161, 0x90181, 0, 0,
+		0, 0x8FB21, 0, 0, 0, 0, 0, 0, 0, 0x8FB82, 0x8FBE2, 0x8FCC2, 0x90041, 0, 0x8FEC2, 0,
+		0, 0, 0, 0, 0, 0, 0x42361, 0x42D41, 0x45B01, 0x477A1, 0x48BA1, 0x49DA1, 0x4ADE1, 0x4BB61, 0x4C941, 0x4D681,
+		0x40EC1, 0x40EE1, 0x8FA21, 0x8F921, 0x8F901, 0x8FF81, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x403C1, 0x406C1, 0x40B41, 0, 0x901C1, 0x901E1, 0x90201, 0x90221, 0x90241, 0x90261, 0x90281, 0x902C1, 0x90301, 0, 0x90321,
+		0x90341, 0x90361, 0, 0x90381, 0x903A1, 0x903C1, 0x903E1, 0x90401, 0x90421, 0x90441, 0x90461, 0x90481, 0x904A1, 0x904E1, 0x90501, 0x90521,
+		0x90541, 0x90561, 0x90581, 0x905A1, 0x905C1, 0x905E1, 0x90601, 0x90621, 0x90641, 0, 0x90661, 0x90681, 0x906A1, 0x906C1, 0x906E1, 0x90701,
+		0x90721, 0, 0x90741, 0x90801, 0, 0x90761, 0x90781, 0x907A1, 0x907C1, 0x907E1, 0, 0, 0x40121, 0x90821, 0x90841, 0x90861,
+		0x90881, 0x908A1, 0x908C1, 0x908E1, 0x90901, 0x90961, 0, 0x90981, 0x909A1, 0x909C1, 0, 0x909E1, 0x90A01, 0x90A21, 0, 0,
+		0x901A1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x902A1, 0x902E1, 0x90921, 0x90941, 0, 0, 0x42381, 0x42D61, 0x45B21, 0x477C1, 0x48BC1, 0x49DC1, 0x4AE01, 0x4BB81, 0x4C961, 0x4D6A1,
+		0x5FA1, 0x41E21, 0, 0, 0, 0, 0, 0, 0, 0x904C1, 0, 0, 0, 0, 0, 0,
+		0, 0x403E1, 0x406E1, 0x40B61, 0, 0x90A41, 0x90A61, 0x90A81, 0x90AA1, 0x90AC1, 0x90AE1, 0x90B01, 0x90B41, 0, 0, 0x90B81,
+		0x90BA1, 0, 0, 0x90BC1, 0x90BE1, 0x90C01, 0x90C21, 0x90C41, 0x90C61, 0x90C81, 0x90CA1, 0x90CC1, 0x90CE1, 0x90D01, 0x90D21, 0x90D41,
+		0x90D61, 0x90D81, 0x90DE1, 0x90E41, 0x90E61, 0x90E81, 0x90EA1, 0x90EC1, 0x90EE1, 0, 0x90F01, 0x90F21, 0x90F41, 0x90F61, 0x90F81, 0x90FA1,
+		0x90FE1, 0, 0x91001, 0x91021, 0, 0x91041, 0x91081, 0x910A1, 0x910C1, 0x910E1, 0, 0, 0x40141, 0x91101, 0x91121, 0x91141,
+		0x91161, 0x91181, 0x911A1, 0x911C1, 0x911E1, 0, 0, 0x91241, 0x91261, 0, 0, 0x91281, 0x912A1, 0x912C1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x912E1, 0x91301, 0, 0, 0, 0, 0x90DA2, 0x90E02, 0, 0x90FC1,
+		0x90B21, 0x90B61, 0x91201, 0x91221, 0, 0, 0x423A1, 0x42D81, 0x45B41, 0x477E1, 0x48BE1, 0x49DE1, 0x4AE21, 0x4BBA1, 0x4C981, 0x4D6C1,
+		0x5FC1, 0x91061, 0x5FE1, 0x6001, 0x6021, 0x6041, 0x6061, 0x6081, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0x40701, 0x914C1, 0, 0x91341, 0x91361, 0x91381, 0x913A1, 0x913C1, 0x913E1, 0, 0, 0, 0x91401, 0x91421,
+		0x91441, 0, 0x91461, 0x91481, 0x914A1, 0x914E1, 0, 0, 0, 0x91501, 0x91521, 0, 0x91721, 0, 0x91541, 0x91561,
+		0, 0, 0, 0x91581, 0x915A1, 0, 0, 0, 0x915C1, 0x91701, 0x915E1, 0, 0, 0, 0x91601, 0x91621,
+		0x91641, 0x916E1, 0x91661, 0x916C1, 0x916A1, 0x91681, 0x91741, 0x91761, 0x91781, 0x917A1, 0, 0, 0, 0, 0x917C1, 0x917E1,
+		0x91801, 0x91821, 0x91841, 0, 0, 0, 0x91861, 0x91881, 0x918A1, 0, 0x918C1, 0x918E1, 0x91901, 0x91921, 0, 0,
+		0x91321, 0, 0, 0, 0, 0, 0, 0x91941, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x423C1, 0x42DA1, 0x45B61, 0x47801, 0x48C01, 0x49E01, 0x4AE41, 0x4BBC1, 0x4C9A1, 0x4D6E1,
+		0x60A1, 0x60C1, 0x60E1, 0x6101, 0x6121, 0x6141, 0x6161, 0x6181, 0x61A1, 0x41E61, 0x61C1, 0, 0, 0, 0, 0,
+		0x40401, 0x40421, 0x40721, 0x40B81, 0, 0x91961, 0x91981, 0x919A1, 0x919C1, 0x919E1, 0x91A01, 0x91A21, 0x91A61, 0, 0x91AA1, 0x91AC1,
+		0x91AE1, 0, 0x91B01, 0x91B21, 0x91B41, 0x91B61, 0x91B81, 0x91BA1, 0x91BC1, 0x91BE1, 0x91C01, 0x91C41, 0x91C61, 0x91CA1, 0x91CC1, 0x91CE1,
+		0x91D01, 0x91D21, 0x91D41, 0x91D61, 0x91D81, 0x91DA1, 0x91DC1, 0x91DE1, 0x91E01, 0, 0x91E21, 0x91E41, 0x91E61, 0x91E81, 0x91EA1, 0x91EC1,
+		0x91EE1, 0x91F01, 0x91F21, 0x91FE1, 0x92001, 0x91F41, 0x91F61, 0x91F81, 0x91FA1, 0x91FC1, 0, 0, 0, 0x92041, 0x92061, 0x92081,
+		0x920A1, 0x920C1, 0x920E1, 0x92101, 0x92121, 0, 0x92181, 0x921A1, 0x921C1, 0, 0x921E1, 0x92201, 0x92221, 0x92241, 0, 0,
+		0, 0, 0, 0, 0, 0x92261, 0x92281, 0, 0x91C21, 0x91C81, 0x92021, 0, 0, 0, 0, 0,
+		0x91A41, 0x91A81, 0x92141, 0x92161, 0, 0, 0x423E1, 0x42DC1, 0x45B81, 0x47821, 0x48C21, 0x49E21, 0x4AE61, 0x4BBE1, 0x4C9C1, 0x4D701,
+		0, 0, 0, 0, 0, 0, 0, 0, 0x42401, 0x42DE1, 0x45BA1, 0x47841, 0x42E01, 0x45BC1, 0x47861, 0x61E1,
+		0x92981, 0x40441, 0x40741, 0x40BA1, 0, 0x922A1, 0x922C1, 0x922E1, 0x92301, 0x92321, 0x92341, 0x92361, 0x923A1, 0, 0x923E1, 0x92401,
+		0x92421, 0, 0x92441, 0x92461, 0x92481, 0x924A1, 0x924C1, 0x924E1, 0x92501, 0x92521, 0x92541, 0x92561, 0x92581, 0x925A1, 0x925C1, 0x925E1,
+		0x92601, 0x92621, 0x92641, 0x92661, 0x92681, 0x926A1, 0x926C1, 0x926E1, 0x92701, 0, 0x92721, 0x92741, 0x92761, 0x92781, 0x927A1, 0x927C1,
+		0x927E1, 0x92801, 0x92821, 0x928E1, 0, 0x92841, 0x92861, 0x92881, 0x928A1, 0x928C1, 0, 0, 0x40161, 0x92921, 0x929A1, 0x929C1,
+		0x929E1, 0x92A01, 0x92A21, 0x92A41, 0x92A61, 0, 0x92AC1, 0x92AE1, 0x92B01, 0, 0x92B21, 0x92B41, 0x92B61, 0x92B81, 0, 0,
+		0, 0, 0, 0, 0, 0x92BA1, 0x92BC1, 0, 0, 0, 0, 0, 0, 0, 0x92901, 0,
+		0x92381, 0x923C1, 0x92A81, 0x92AA1, 0, 0, 0x42421, 0x42E21, 0x45BE1, 0x47881, 0x48C41, 0x49E41, 0x4AE81, 0x4BC01, 0x4C9E1, 0x4D721,
+		0, 0x92941, 0x92961, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x40461, 0x40761, 0x40BC1, 0, 0x92BE1, 0x92C01, 0x92C21, 0x92C41, 0x92C81, 0x92CA1, 0x92CC1, 0x92D01, 0, 0x92D41, 0x92D61,
+		0x92D81, 0, 0x92DA1, 0x92DC1, 0x92DE1, 0x92E01, 0x92E61, 0x92E81, 0x92EA1, 0x92EC1, 0x92EE1, 0x92F01, 0x92F21, 0x92F41, 0x92F61, 0x92F81,
+		0x92FA1, 0x92FC1, 0x92FE1, 0x93001, 0x93061, 0x93081, 0x930A1, 0x930C1, 0x930E1, 0x93141, 0x93161, 0x93181, 0x931A1, 0x931C1, 0x931E1, 0x93241,
+		0x932A1, 0x93501, 0x93341, 0x93441, 0x934A1, 0x933A1, 0x933C1, 0x933E1, 0x93401, 0x93421, 0x93521, 0, 0, 0x93541, 0x93561, 0x93581,
+		0x935A1, 0x935C1, 0x935E1, 0x93601, 0x93621, 0, 0x93681, 0x936A1, 0x936C1, 0, 0x936E1, 0x93701, 0x93721, 0x93761, 0x932C2, 0x6201,
+		0, 0, 0, 0, 0x93202, 0x93262, 0x934C2, 0x93741, 0x6221, 0x6241, 0x6261, 0x6281, 0x62A1, 0x62C1, 0x62E1, 0x92C61,
+		0x92CE1, 0x92D21, 0x93641, 0x93661, 0, 0, 0x42441, 0x42E41, 0x45C01, 0x478A1, 0x48C61, 0x49E61, 0x4AEA1, 0x4BC21, 0x4CA01, 0x4D741,
+		0x6301, 0x6321, 0x6341, 0x6361, 0x6381, 0x63A1, 0x63C1, 0x63E1, 0x6401, 0x6421, 0x93022, 0x93102, 0x93302, 0x93362, 0x93462, 0x92E22,
+		0, 0, 0x40781, 0x40BE1, 0, 0x93781, 0x937A1, 0x937C1, 0x937E1, 0x93801, 0x93821, 0x93841, 0x93861, 0x93881, 0x938A1, 0x938C1,
+		0x938E1, 0x93901, 0x93921, 0x93941, 0x93961, 0x93981, 0x939A1, 0, 0, 0, 0x939C1, 0x939E1, 0x93A01, 0x93A21, 0x93A41, 0x93A61,
+		0x93A81, 0x93AA1, 0x93AC1, 0x93AE1, 0x93B01, 0x93B21, 0x93B41, 0x93B61, 0x93B81, 0x93BA1, 0x93BC1, 0x93BE1, 0x93C01, 0x93C21, 0x93C41, 0x93C61,
+		0x93C81, 0x93CA1, 0, 0x93CC1, 0x93CE1, 0x93D01, 0x93D21, 0x93D41, 0x93D61, 0x93D81, 0x93DA1, 0x93DC1, 0, 0x93DE1, 0, 0,
+		0x93E01, 0x93E21, 0x93E41, 0x93E61, 0x93E81, 0x93EA1, 0x93EC1, 0, 0, 0, 0x94101, 0, 0, 0, 0, 0x93EE1,
+		0x93F01, 0x93F21, 0x93F41, 0x93F61, 0x93F81, 0, 0x93FA1, 0, 0x93FC1, 0x94041, 0x94061, 0x94081, 0x940A1, 0x940C1, 0x940E1, 0x94001,
+		0, 0, 0, 0, 0, 0, 0x42461, 0x42E61, 0x45C21, 0x478C1, 0x48C81, 0x49E81, 0x4AEC1, 0x4BC41, 0x4CA21, 0x4D761,
+		0, 0, 0x93FE1, 0x94021, 0x6441, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x9CF01, 0x9CF21, 0x9CF41, 0x9CF61, 0x9CF81, 0x9CFA1, 0x9CFC1, 0x9CFE1, 0x9D001, 0x9D021, 0x9D041, 0x9D061, 0x9D081, 0x9D0A1, 0x9D0C1,
+		0x9D0E1, 0x9D101, 0x9D121, 0x9D141, 0x9D161, 0x9D181, 0x9D1A1, 0x9D1C1, 0x9D1E1, 0x9D201, 0x9D221, 0x9D241, 0x9D261, 0x9D281, 0x9D2A1, 0x9D2C1,
+		0x9D2E1, 0x9D301, 0x9D321, 0x9D341, 0x9D361, 0x9D381, 0x9D3A1, 0x9D3C1, 0x9D3E1, 0x9D401, 0x9D421, 0x9D441, 0x9D461, 0x9D481, 0x9D4A1, 0x9D4C1,
+		0x9D4E1, 0x9D501, 0x9D521, 0x9D541, 0x9D561, 0x9D581, 0x9D5A1, 0x9D5C1, 0x9D5E1, 0x9D601, 0x9D621, 0, 0, 0, 0, 0x41E81,
+		0x9D641, 0x9D661, 0x9D681, 0x9D6A1, 0x9D6C1, 0x9D6E1, 0x41881, 0x41041, 0x41061, 0x41081, 0x410A1, 0x410C1, 0x410E1, 0x41101, 0x41021, 0x6461,
+		0x42481, 0x42E81, 0x45C41, 0x478E1, 0x48CA1, 0x49EA1, 0x4AEE1, 0x4BC61, 0x4CA41, 0x4D781, 0x6481, 0x64A1, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x9D721, 0x9D741, 0, 0x9D761, 0, 0, 0x9D781, 0x9D7A1, 0, 0x9D7E1, 0, 0, 0x9D821, 0, 0,
+		0, 0, 0, 0, 0x9D841, 0x9D861, 0x9D881, 0x9D8A1, 0, 0x9D8C1, 0x9D8E1, 0x9D901, 0x9D921, 0x9D941, 0x9D961, 0x9D981,
+		0, 0x9D9A1, 0x9D9C1, 0x9D9E1, 0, 0x9DA01, 0, 0x9DA21, 0, 0, 0x9D7C1, 0x9DA41, 0, 0x9DAE1, 0x9DB01, 0x9DB21,
+		0x9DB41, 0x9DB61, 0x9DB81, 0x9DBA1, 0x9DBC1, 0x9DBE1, 0x9DC01, 0x9DC21, 0x9DC41, 0x9DC61, 0, 0x9DC81, 0x9DCA1, 0x9DCC1, 0, 0,
+		0x9DCE1, 0x9DD01, 0x9DD21, 0x9DD41, 0x9DD61, 0, 0x418A1, 0, 0x41121, 0x41141, 0x41161, 0x41181, 0x411A1, 0x411C1, 0, 0,
+		0x424A1, 0x42EA1, 0x45C61, 0x47901, 0x48CC1, 0x49EC1, 0x4AF01, 0x4BC81, 0x4CA61, 0x4D7A1, 0, 0, 0x9DA62, 0x9DAA2, 0x9D701, 0x9D801,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x9F2C3, 0x64C1, 0x64E1, 0x6501, 0x6521, 0x6541, 0x6561, 0x6581, 0x65A1, 0x65C1, 0x65E1, 0x6601, 0x6621, 0x6641, 0x6661, 0x6681,
+		0x66A1, 0x66C1, 0x66E1, 0x6701, 0x6721, 0x6741, 0x6761, 0x6781, 0x67A1, 0x67C1, 0x67E1, 0x6801, 0x6821, 0x6841, 0x6861, 0x6881,
+		0x424C1, 0x42EC1, 0x45C81, 0x47921, 0x48CE1, 0x49EE1, 0x4AF21, 0x4BCA1, 0x4CA81, 0x4D7C1, 0x43821, 0x46581, 0x48221, 0x495E1, 0x4A7E1, 0x4B661,
+		0x4C3E1, 0x4D1A1, 0x4DF21, 0x429C1, 0x68A1, 0x68C1, 0x68E1, 0x6901, 0x6921, 0x41221, 0x6941, 0x6961, 0x6981, 0x69A1, 0x69C1, 0x69E1,
+		0x9E5E1, 0x9E6C1, 0x9E701, 0x9E722, 0x9E7C1, 0x9E801, 0x9E841, 0x9E881, 0, 0x9E8C1, 0x9E901, 0x9E941, 0x9E981, 0x9E9A2, 0x9EA41, 0x9EA81,
+		0x9EAC1, 0x9EB01, 0x9EB22, 0x9EBC1, 0x9EC01, 0x9EC41, 0x9EC81, 0x9ECA2, 0x9ED41, 0x9ED81, 0x9EDC1, 0x9EE01, 0x9EE22, 0x9EEC1, 0x9EF41, 0x9EF81,
+		0x9EFC1, 0x9F001, 0x9F081, 0x9F161, 0x9F1A1, 0x9F1E1, 0x9F221, 0x9F261, 0x9F2A1, 0x9E602, 0x9F0A2, 0x9E6A1, 0x9F141, 0, 0, 0,
+		0, 0x9F441, 0x9F461, 0x9F481, 0x9F4E1, 0x9F501, 0x9F521, 0x9F541, 0x9F561, 0x9F581, 0x9F5A1, 0x9F5C1, 0x9F5E1, 0x9F601, 0x407A1, 0x40C01,
+		0x9F4A1, 0x9F4C1, 0x6A01, 0x6A21, 0x9F621, 0x6A41, 0x6A61, 0x6A81, 0x9F341, 0x9F381, 0x9F401, 0x9F421, 0x9F3C1, 0x9F361, 0x9F3A1, 0x9F3E1,
+		0x9E641, 0x9E6E1, 0x9E761, 0x9E782, 0x9E7E1, 0x9E821, 0x9E861, 0x9E8A1, 0, 0x9E8E1, 0x9E921, 0x9E961, 0x9E9E1, 0x9EA02, 0x9EA61, 0x9EAA1,
+		0x9EAE1, 0x9EB61, 0x9EB82, 0x9EBE1, 0x9EC21, 0x9EC61, 0x9ECE1, 0x9ED02, 0x9ED61, 0x9EDA1, 0x9EDE1, 0x9EE61, 0x9EE82, 0x9EEE1, 0x9EF61, 0x9EFA1,
+		0x9EFE1, 0x9F021, 0x9F0E1, 0x9F181, 0x9F1C1, 0x9F201, 0x9F241, 0x9F281, 0x9F321, 0x9E662, 0x9EF02, 0x9F042, 0x9F102, 0, 0x6AA1, 0x6AC1,
+		0x6AE1, 0x6B01, 0x6B21, 0x6B41, 0x6B61, 0x6B81, 0x6BA1, 0x6BC1, 0x6BE1, 0x6C01, 0x6C21, 0x6C41, 0x6C61, 0, 0x6C81, 0x6CA1,
+		0x6CC1, 0x6CE1, 0x6D01, 0x6D21, 0x6D41, 0x6D61, 0x6D81, 0x6DA1, 0x6DC1, 0x6DE1, 0x6E01, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA2F21, 0xA2F61, 0xA2FA1, 0xA3021, 0xA3081, 0xA30C1, 0xA3121, 0xA31A1, 0xA3241, 0xA3321, 0xA33A1, 0xA33C1, 0xA3401, 0xA3441, 0xA34A1, 0xA3501,
+		0xA3581, 0xA35A1, 0xA35C1, 0xA3621, 0xA3681, 0xA3701, 0xA3721, 0xA37E1, 0xA3841, 0xA38A1, 0xA38E1, 0xA3921, 0xA39A1, 0xA39E1, 0xA3AC1, 0xA3B61,
+		0xA3C21, 0xA3D01, 0xA3D21, 0xA3D41, 0xA3D61, 0xA3D81, 0xA3DA1, 0xA3E41, 0xA3E61, 0xA3E81, 0xA3EA1, 0xA3EE1, 0xA3EC1, 0xA3F61, 0xA3FA1, 0xA3FE1,
+		0xA4041, 0xA40E1, 0xA4161, 0xA3FC1, 0xA41A1, 0xA4121, 0x407C1, 0x412A1, 0x40C21, 0xA4261, 0xA4281, 0xA3901, 0xA3981, 0xA3A01, 0xA3BC1, 0xA3AE3,
+		0x424E1, 0x42EE1, 0x45CA1, 0x47941, 0x48D01, 0x49F01, 0x4AF41, 0x4BCC1, 0x4CAA1, 0x4D7E1, 0x6E21, 0x6E41, 0x6E61, 0x6E81, 0x6EA1, 0x6EC1,
+		0xA3A61, 0xA3A81, 0xA3DC1, 0xA3DE1, 0xA3E01, 0xA3E21, 0xA4061, 0xA4081, 0xA40A1, 0xA40C1, 0xA30A1, 0xA3261, 0xA3C61, 0xA3C81, 0xA36E1, 0xA38C1,
+		0xA39C1, 0xA32E1, 0xA41C1, 0xA42A1, 0xA42C1, 0xA3AA1, 0xA3CE1, 0xA41E1, 0xA4201, 0xA42E1, 0xA4301, 0xA4321, 0xA4341, 0xA4361, 0xA3521, 0xA3CA1,
+		0xA3CC1, 0xA3F81, 0xA3F21, 0xA4001, 0xA4021, 0xA2F41, 0xA2F81, 0xA2FC1, 0xA30E1, 0xA3201, 0xA3341, 0xA35E1, 0xA36A1, 0xA3741, 0xA3761, 0xA3801,
+		0xA3A41, 0xA3B81, 0xA3A21, 0xA3F01, 0xA4101, 0xA4141, 0xA4241, 0xA4381, 0xA43C1, 0xA4421, 0xA4441, 0xA43A1, 0xA43E1, 0xA4401, 0xA37A1, 0xA4461,
+		0x42501, 0x42F01, 0x45CC1, 0x47961, 0x48D21, 0x49F21, 0x4AF61, 0x4BCE1, 0x4CAC1, 0x4D801, 0xA4481, 0xA44A1, 0xA3F41, 0xA4181, 0x6EE1, 0x6F01,
+		0x785C1, 0x78621, 0x78681, 0x786E1, 0x78741, 0x787A1, 0x78801, 0x788C1, 0x78921, 0x78981, 0x789E1, 0x78A41, 0x78AC1, 0x78B81, 0x78BE1, 0x78C41,
+		0x78CA1, 0x78D01, 0x78D61, 0x78E21, 0x78E81, 0x78EE1, 0x78F41, 0x78FA1, 0x79001, 0x79061, 0x790C1, 0x79121, 0x79181, 0x791E1, 0x79241, 0x79301,
+		0x79361, 0x78861, 0x78B21, 0x78DC1, 0x792A1, 0x793C1, 0, 0x79441, 0, 0, 0, 0, 0, 0x79501, 0, 0,
+		0x78581, 0x785E1, 0x78641, 0x786A1, 0x78701, 0x78761, 0x787C1, 0x78881, 0x788E1, 0x78941, 0x789A1, 0x78A01, 0x78A61, 0x78B41, 0x78BA1, 0x78C01,
+		0x78C61, 0x78CC1, 0x78D21, 0x78DE1, 0x78E41, 0x78EA1, 0x78F01, 0x78F61, 0x78FC1, 0x79021, 0x79081, 0x790E1, 0x79141, 0x791A1, 0x79201, 0x792C1,
+		0x79321, 0x78821, 0x78AE1, 0x78D81, 0x79261, 0x79381, 0x793E1, 0x79401, 0x79461, 0x79481, 0x794A1, 0x6F21, 0x78A81, 0x794C1, 0x79521, 0x79541,
+		0xBDC21, 0xBDDC1, 0xBDE21, 0xBDFC1, 0xBE161, 0xBE1C1, 0xBE361, 0xBE501, 0xBE6A1, 0xBE701, 0xBE8A1, 0xBE901, 0xBEC81, 0xBEF21, 0xBEF81, 0xBF1C1,
+		0xBF361, 0xBF501, 0xBF6A1, 0xBF841, 0xBF861, 0xBF8A1, 0xBF8E1, 0xBF901, 0xBF921, 0xBF941, 0xBF961, 0xBF9C1, 0xBF9E1, 0xBFA21, 0xBFA61, 0xBFAA1,
+		0xBFAC1, 0xBFB01, 0xBFB61, 0xBFBA1, 0xBFBE1, 0xBFC01, 0xBFC21, 0xBFC41, 0xBFC81, 0xBFCA1, 0xBFCE1, 0xBFD01, 0xBFD41, 0xBFD81, 0xBFDC1, 0xBFE01,
+		0xBFE41, 0xBFE61, 0xBFE81, 0xBFEC1, 0xBFEE1, 0xBFF01, 0xBFF21, 0xBFF61, 0xBFF81, 0xBFFA1, 0xBFFC1, 0xBFFE1, 0xC0001, 0xC0021, 0xC0041, 0xC0061,
+		0xC0081, 0xC00C1, 0xC00E1, 0xC0101, 0xC0121, 0xC0141, 0xC0161, 0xC0181, 0xC01C1, 0xC01E1, 0xC0201, 0xC0221, 0xC0241, 0xC0281, 0xC02A1, 0xC02C1,
+		0xC02E1, 0xC0301, 0xC0321, 0xC0341, 0xC0361, 0xC0381, 0xC03A1, 0xC03C1, 0xC0401, 0xC0441, 0xC0481, 0xC04A1, 0xC04C1, 0xC04E1, 0xC0501, 0xC08C1,
+		0xC08E1, 0xC0941, 0xC09A1, 0xC0A01, 0xC0A61, 0xC0AC1, 0xC0B21, 0xC0B81, 0xC0BE1, 0xC0C41, 0xC0CA1, 0xC0D01, 0xC0D61, 0xC0DC1, 0xC0E21, 0xC0E81,
+		0xC0EE1, 0xC0F41, 0xC0FA1, 0xC1001, 0xC1061, 0xC10C1, 0xC1121, 0xC1141, 0xC1161, 0xC1181, 0xC11A1, 0xC11C1, 0xC11E1, 0xC1201, 0xC1221, 0xC1241,
+		0xC1261, 0xC1281, 0xC12A1, 0xC12C1, 0xC12E1, 0xC1321, 0xC1361, 0xC1381, 0xC13A1, 0xC13E1, 0xC1401, 0xC1421, 0xC1441, 0xC1461, 0xC1481, 0xC14A1,
+		0xC14C1, 0xC14E1, 0xC1521, 0xC1561, 0xC1581, 0xC15C1, 0xC15E1, 0xC1601, 0xC1621, 0xC1641, 0xC1661, 0xC1681, 0xC16A1, 0xC16C1, 0xC16E1, 0xC1721,
+		0xC1741, 0xC1761, 0xC17A1, 0xC17C1, 0xC17E1, 0xC1801, 0xC1821, 0xC1841, 0xC1B41, 0xC1B61, 0xC1B81, 0xC1BE1, 0xC1C01, 0xC1C61, 0xC1CC1, 0xC1CE1,
+		0xC1D01, 0xC1D61, 0xC1DC1, 0xC1E21, 0xC1E81, 0xC1EE1, 0xC1F41, 0xC1F61, 0xC1F81, 0xC1FA1, 0xC1FC1, 0xC1FE1, 0xC2001, 0xC2021, 0xC2041, 0xC2061,
+		0xC2081, 0xC20A1, 0xC20C1, 0xC20E1, 0xC2101, 0xC2121, 0xC2141, 0xC2161, 0xC21A1, 0xC21E1, 0xC2201, 0xC2221, 0xC2241, 0xC2281, 0xC22A1, 0xC22E1,
+		0xC2301, 0xC2321, 0xC2341, 0xC2361, 0xC23A1, 0xC23C1, 0xC23E1, 0xC2401, 0xC2441, 0xC2461, 0xC24A1, 0xC24C1, 0xC24E1, 0xC2501, 0xC2541, 0xC2561,
+		0xC25A1, 0xC25C1, 0xC25E1, 0xC2601, 0xC2621, 0xC2641, 0xC2661, 0xC2681, 0xC26A1, 0xC26C1, 0xC26E1, 0xC2701, 0xC2721, 0xC2741, 0xC2761, 0xC2781,
+		0xC27A1, 0xC27C1, 0xC2801, 0xC2841, 0xC2861, 0xC2881, 0xC28A1, 0xC28C1, 0xC28E1, 0xC2901, 0xC2921, 0xC2941, 0xC2961, 0xC2981, 0xC29A1, 0xC29C1,
+		0x8A681, 0x8A6A1, 0x8A6C1, 0x8A6E1, 0x8A701, 0x8A721, 0x8A741, 0x8A761, 0x8A781, 0x8A7A1, 0x8A7C1, 0x8A7E1, 0x8A801, 0x8A821, 0x8A841, 0x8A861,
+		0x8A8A1, 0x8A8C1, 0x8A8E1, 0x8A901, 0x8A921, 0x8A941, 0x8A961, 0x8A981, 0x8A9A1, 0x8A9C1, 0x8A9E1, 0x8AA01, 0x8AA21, 0x8AA41, 0x8AA61, 0x8AA81,
+		0x8AB41, 0x8AB61, 0x8AB81, 0x8ABA1, 0x8ABC1, 0x8ABE1, 0x8AC01, 0x8AC21, 0x8AC41, 0x8AC61, 0x8AC81, 0x8ACA1, 0x8ACC1, 0x8ACE1, 0x8AD01, 0x8AD21,
+		0x8AD61, 0x8AD81, 0x8ADA1, 0x8ADC1, 0x8ADE1, 0x8AE01, 0x8AE21, 0x8AE41, 0x8AF41, 0x8AF61, 0x8AF81, 0x8AFA1, 0x8AFC1, 0x8AFE1, 0x8B001, 0x8B021,
+		0x8B061, 0x8B081, 0x8B0A1, 0x8B0C1, 0x8B0E1, 0x8B101, 0x8B121, 0x8B141, 0x8B161, 0, 0x8B181, 0x8B1A1, 0x8B1C1, 0x8B1E1, 0, 0,
+		0x8B201, 0x8B221, 0x8B241, 0x8B261, 0x8B281, 0x8B2A1, 0x8B2C1, 0, 0x8B2E1, 0, 0x8B301, 0x8B321, 0x8B341, 0x8B361, 0, 0,
+		0x8B381, 0x8B3A1, 0x8B3C1, 0x8B3E1, 0x8B401, 0x8B421, 0x8B441, 0x8B461, 0x8B521, 0x8B541, 0x8B561, 0x8B581, 0x8B5A1, 0x8B5C1, 0x8B5E1, 0x8B601,
+		0x8B621, 0x8B641, 0x8B661, 0x8B681, 0x8B6A1, 0x8B6C1, 0x8B6E1, 0x8B701, 0x8B741, 0x8B761, 0x8B781, 0x8B7A1, 0x8B7C1, 0x8B7E1, 0x8B801, 0x8B821,
+		0x8B861, 0x8B881, 0x8B8A1, 0x8B8C1, 0x8B8E1, 0x8B901, 0x8B921, 0x8B941, 0x8B961, 0, 0x8B981, 0x8B9A1, 0x8B9C1, 0x8B9E1, 0, 0,
+		0x8BA01, 0x8BA21, 0x8BA41, 0x8BA61, 0x8BA81, 0x8BAA1, 0x8BAC1, 0x8BAE1, 0x8BB21, 0x8BB41, 0x8BB61, 0x8BB81, 0x8BBA1, 0x8BBC1, 0x8BBE1, 0x8BC01,
+		0x8BC41, 0x8BC61, 0x8BC81, 0x8BCA1, 0x8BCC1, 0x8BCE1, 0x8BD01, 0x8BD21, 0x8BD61, 0x8BD81, 0x8BDA1, 0x8BDC1, 0x8BDE1, 0x8BE01, 0x8BE21, 0x8BE41,
+		0x8BE61, 0, 0x8BE81, 0x8BEA1, 0x8BEC1, 0x8BEE1, 0, 0, 0x8BF01, 0x8BF21, 0x8BF41, 0x8BF61, 0x8BF81, 0x8BFA1, 0x8BFC1, 0,
+		0x8BFE1, 0, 0x8C001, 0x8C021, 0x8C041, 0x8C061, 0, 0, 0x8C081, 0x8C0A1, 0x8C0C1, 0x8C0E1, 0x8C101, 0x8C121, 0x8C141, 0x8C161,
+		0x8C181, 0x8C1A1, 0x8C1C1, 0x8C1E1, 0x8C201, 0x8C221, 0x8C241, 0, 0x8C261, 0x8C281, 0x8C2A1, 0x8C2C1, 0x8C2E1, 0x8C301, 0x8C321, 0x8C341,
+		0x8C441, 0x8C461, 0x8C481, 0x8C4A1, 0x8C4C1, 0x8C4E1, 0x8C501, 0x8C521, 0x8C541, 0x8C561, 0x8C581, 0x8C5A1, 0x8C5C1, 0x8C5E1, 0x8C601, 0x8C621,
+		0x8C641, 0x8C661, 0x8C681, 0x8C6A1, 0x8C6C1, 0x8C6E1, 0x8C701, 0x8C721, 0x8C821, 0x8C841, 0x8C861, 0x8C881, 0x8C8A1, 0x8C8C1, 0x8C8E1, 0x8C901,
+		0x8C941, 0x8C961, 0x8C981, 0x8C9A1, 0x8C9C1, 0x8C9E1, 0x8CA01, 0x8CA21, 0x8CA61, 0x8CA81, 0x8CAA1, 0x8CAC1, 0x8CAE1, 0x8CB01, 0x8CB21, 0x8CB41,
+		0x8CB61, 0, 0x8CB81, 0x8CBA1, 0x8CBC1, 0x8CBE1, 0, 0, 0x8CC01, 0x8CC21, 0x8CC41, 0x8CC61, 0x8CC81, 0x8CCA1, 0x8CCC1, 0x8CCE1,
+		0x8CD81, 0x8CDA1, 0x8CDC1, 0x8CDE1, 0x8CE01, 0x8CE21, 0x8CE41, 0x8CE61, 0x8CEA1, 0x8CEC1, 0x8CEE1, 0x8CF01, 0x8CF21, 0x8CF41, 0x8CF61, 0x8CF81,
+		0x8D0A1, 0x8D0C1, 0x8D0E1, 0x8D101, 0x8D121, 0x8D141, 0x8D161, 0x8D181, 0x8D1C1, 0x8D1E1, 0x8D201, 0x8D221, 0x8D241, 0x8D261, 0x8D281, 0x8D2A1,
+		0x8D3A1, 0x8D3C1, 0x8D3E1, 0x8D401, 0x8D421, 0x8D441, 0x8D461, 0x8D481, 0x8D4A1, 0x8D4C1, 0x8D4E1, 0x8D501, 0x8D521, 0x8D541, 0x8D561, 0x8D581,
+		0x8D621, 0x8D641, 0x8D661, 0x8D681, 0x8D6A1, 0x8D6C1, 0x8D6E1, 0x8D701, 0x8D7C1, 0x8D7E1, 0x8D801, 0, 0, 0x3FEE1, 0x3FEC1, 0x3FEA1,
+		0x6F41, 0x6F61, 0x6F81, 0x6FA1, 0x6FC1, 0x6FE1, 0x7001, 0x7021, 0x7041, 0x42F21, 0x45CE1, 0x47981, 0x48D41, 0x49F41, 0x4AF81, 0x4BD01,
+		0x4CAE1, 0x4D821, 0x7061, 0x7081, 0x70A1, 0x70C1, 0x70E1, 0x7101, 0x7121, 0x7141, 0x7161, 0x7181, 0x71A1, 0, 0, 0,
+		0x8AAA1, 0x8AAC1, 0x8AAE1, 0x8AB01, 0x8B481, 0x8B4A1, 0x8B4C1, 0x8B4E1, 0x8D5A1, 0x8D5C1, 0x8D5E1, 0x8D601, 0x8D721, 0x8D741, 0x8D761, 0x8D781,
+		0x71C1, 0x71E1, 0x7201, 0x7221, 0x7241, 0x7261, 0x7281, 0x72A1, 0x72C1, 0x72E1, 0, 0, 0, 0, 0, 0,
+		0xA9AC1, 0xA9B01, 0xA9B41, 0xA9B81, 0xA9BC1, 0xA9C01, 0xA9C41, 0xA9C81, 0xA9CC1, 0xA9D01, 0xA9D41, 0xA9D81, 0xA9DC1, 0xA9E01, 0xA9E41, 0xA9E81,
+		0xA9EC1, 0xA9F01, 0xA9F41, 0xA9F81, 0xA9FC1, 0xAA001, 0xAA041, 0xAA081, 0xAA0C1, 0xAA101, 0xAA141, 0xAA181, 0xAA1C1, 0xAA201, 0xAA241, 0xAA281,
+		0xAA2C1, 0xAA301, 0xAA341, 0xAA381, 0xAA3C1, 0xAA401, 0xAA441, 0xAA481, 0xAA4C1, 0xAA501, 0xAA541, 0xAA581, 0xAA5C1, 0xAA601, 0xAA641, 0xAA681,
+		0xAA6C1, 0xAA701, 0xAA741, 0xAA781, 0xAA7C1, 0xAA801, 0xAA841, 0xAA881, 0xAA8C1, 0xAA901, 0xAA941, 0xAA981, 0xAA9C1, 0xAAA01, 0xAAA41, 0xAAA81,
+		0xAAAC1, 0xAAB01, 0xAAB41, 0xAAB81, 0xAABC1, 0xAAC01, 0xAAC41, 0xAAC81, 0xAACC1, 0xAAD01, 0xAAD41, 0xAAD81, 0xAADC1, 0xAAE01, 0xAAE41, 0xAAE81,
+		0xAAEC1, 0xAAF01, 0xAAF41, 0xAAF81, 0xAAFC1, 0xAB001, 0, 0, 0xAAEA1, 0xAAEE1, 0xAAF21, 0xAAF61, 0xAAFA1, 0xAAFE1, 0, 0,
+		0x7301, 0xAB921, 0xAB941, 0xAB961, 0xAB981, 0xAB9A1, 0xAB9C1, 0xAB9E1, 0xABA01, 0xABA21, 0xABA41, 0xABA61, 0xABA81, 0xABAA1, 0xABAC1, 0xABAE1,
+		0xABB01, 0xABB21, 0xABB41, 0xABB61, 0xABB81, 0xABBA1, 0xABBC1, 0xABBE1, 0xABC01, 0xABC21, 0xABC41, 0xABC61, 0xABC81, 0xABCA1, 0xABCC1, 0xABCE1,
+		0xABD01, 0xABD21, 0xABD41, 0xABD61, 0xABD81, 0xABDA1, 0xABDC1, 0xABDE1, 0xABE01, 0xABE21, 0xABE41, 0xABE61, 0xABE81, 0xABEA1, 0xABEC1, 0xABEE1,
+		0xABF01, 0xABF21, 0xABF41, 0xABF61, 0xABF81, 0xABFA1, 0xABFC1, 0xABFE1, 0xAC001, 0xAC021, 0xAC041, 0xAC061, 0xAC081, 0xAC0A1, 0xAC0C1, 0xAC0E1,
+		0xAC101, 0xAC121, 0xAC141, 0xAC161, 0xAC181, 0xAC1A1, 0xAC1C1, 0xAC1E1, 0xAC201, 0xAC221, 0xAC241, 0xAC261, 0xAC281, 0xAC2A1, 0xAC2C1, 0xAC2E1,
+		0xAC301, 0xAC321, 0xAC341, 0xAC361, 0xAC381, 0xAC3A1, 0xAC3C1, 0xAC3E1, 0xAC401, 0xAC421, 0xAC441, 0xAC461, 0xAC481, 0xAC4A1, 0xAC4C1, 0xAC4E1,
+		0xAC501, 0xAC521, 0xAC541, 0xAC561, 0xAC581, 0xAC5A1, 0xAC5C1, 0xAC5E1, 0xAC601, 0xAC621, 0xAC641, 0xAC661, 0xAC681, 0xAC6A1, 0xAC6C1, 0xAC6E1,
+		0xAC701, 0xAC721, 0xAC741, 0xAC761, 0xAC781, 0xAC7A1, 0xAC7C1, 0xAC7E1, 0xAC801, 0xAC821, 0xAC841, 0xAC861, 0xAC881, 0xAC8A1, 0xAC8C1, 0xAC8E1,
+		0xAC901, 0xAC921, 0xAC941, 0xAC961, 0xAC981, 0xAC9A1, 0xAC9C1, 0xAC9E1, 0xACA01, 0xACA21, 0xACA41, 0xACA61, 0xACA81, 0xACAA1, 0xACAC1, 0xACAE1,
+		0xACB01, 0xACB21, 0xACB41, 0xACB61, 0xACB81, 0xACBA1, 0xACBC1, 0xACBE1, 0xACC01, 0xACC21, 0xACC41, 0xACC61, 0xACC81, 0xACCA1, 0xACCC1, 0xACCE1,
+		0xACD01, 0xACD21, 0xACD41, 0xACD61, 0xACD81, 0xACDA1, 0xACDC1, 0xACDE1, 0xACE01, 0xACE21, 0xACE41, 0xACE61, 0xACE81, 0xACEA1, 0xACEC1, 0xACEE1,
+		0xACF01, 0xACF21, 0xACF41, 0xACF61, 0xACF81, 0xACFA1, 0xACFC1, 0xACFE1, 0xAD001, 0xAD021, 0xAD041, 0xAD061, 0xAD081, 0xAD0A1, 0xAD0C1, 0xAD0E1,
+		0xAD101, 0xAD121, 0xAD141, 0xAD161, 0xAD181, 0xAD1A1, 0xAD1C1, 0xAD1E1, 0xAD201, 0xAD221, 0xAD241, 0xAD261, 0xAD281, 0xAD2A1, 0xAD2C1, 0xAD2E1,
+		0xAD301, 0xAD321, 0xAD341, 0xAD361, 0xAD381, 0xAD3A1, 0xAD3C1, 0xAD3E1, 0xAD401, 0xAD421, 0xAD441, 0xAD461, 0xAD481, 0xAD4A1, 0xAD4C1, 0xAD4E1,
+		0xAD501, 0xAD521, 0xAD541, 0xAD561, 0xAD581, 0xAD5A1, 0xAD5C1, 0xAD5E1, 0xAD601, 0xAD621, 0xAD641, 0xAD661, 0xAD681, 0xAD6A1, 0xAD6C1, 0xAD6E1,
+		0xAD701, 0xAD721, 0xAD741, 0xAD761, 0xAD781, 0xAD7A1, 0xAD7C1, 0xAD7E1, 0xAD801, 0xAD821, 0xAD841, 0xAD861, 0xAD881, 0xAD8A1, 0xAD8C1, 0xAD8E1,
+		0xAD901, 0xAD921, 0xAD941, 0xAD961, 0xAD981, 0xAD9A1, 0xAD9C1, 0xAD9E1, 0xADA01, 0xADA21, 0xADA41, 0xADA61, 0xADA81, 0xADAA1, 0xADAC1, 0xADAE1,
+		0xADB01, 0xADB21, 0xADB41, 0xADB61, 0xADB81, 0xADBA1, 0xADBC1, 0xADBE1, 0xADC01, 0xADC21, 0xADC41, 0xADC61, 0xADC81, 0xADCA1, 0xADCC1, 0xADCE1,
+		0xADD01, 0xADD21, 0xADD41, 0xADD61, 0xADD81, 0xADDA1, 0xADDC1, 0xADDE1, 0xADE01, 0xADE21, 0xADE41, 0xADE61, 0xADE81, 0xADEA1, 0xADEC1, 0xADEE1,
+		0xADF01, 0xADF21, 0xADF41, 0xADF61, 0xADF81, 0xADFA1, 0xADFC1, 0xADFE1, 0xAE001, 0xAE021, 0xAE041, 0xAE061, 0xAE081, 0xAE0A1, 0xAE0C1, 0xAE0E1,
+		0xAE101, 0xAE121, 0xAE141, 0xAE161, 0xAE181, 0xAE1A1, 0xAE1C1, 0xAE1E1, 0xAE201, 0xAE221, 0xAE241, 0xAE261, 0xAE281, 0xAE2A1, 0xAE2C1, 0xAE2E1,
+		0xAE301, 0xAE321, 0xAE341, 0xAE361, 0xAE381, 0xAE3A1, 0xAE3C1, 0xAE3E1, 0xAE401, 0xAE421, 0xAE441, 0xAE461, 0xAE481, 0xAE4A1, 0xAE4C1, 0xAE4E1,
+		0xAE501, 0xAE521, 0xAE541, 0xAE561, 0xAE581, 0xAE5A1, 0xAE5C1, 0xAE5E1, 0xAE601, 0xAE621, 0xAE641, 0xAE661, 0xAE681, 0xAE6A1, 0xAE6C1, 0xAE6E1,
+		0xAE701, 0xAE721, 0xAE741, 0xAE761, 0xAE781, 0xAE7A1, 0xAE7C1, 0xAE7E1, 0xAE801, 0xAE821, 0xAE841, 0xAE861, 0xAEEC1, 0xAE881, 0xAE8C1, 0xAE8E1,
+		0xAE901, 0xAE921, 0xAE941, 0xAE961, 0xAE981, 0xAE9A1, 0xAE9C1, 0xAE9E1, 0xAEA01, 0xAEA21, 0xAEA41, 0xAEA61, 0xAEA81, 0xAEAA1, 0xAEAE1, 0xAEB01,
+		0xAEB21, 0xAEB41, 0xAEB61, 0xAEB81, 0xAEBA1, 0xAEBC1, 0xAECA1, 0xAECC1, 0xAECE1, 0xAED01, 0xAED21, 0xAED41, 0xAED61, 0xAED81, 0xAEDA1, 0xAEDC1,
+		0xAEDE1, 0xAEE01, 0xAEE21, 0xAEE41, 0xAEE61, 0xAEE81, 0xAEEA1, 0xAEEE1, 0xAEF01, 0xAEF21, 0xAEF41, 0xAEF61, 0xAEF81, 0xAEFA1, 0xAEFC1, 0xAEFE1,
+		0xAF001, 0xAF021, 0xAF041, 0xAF061, 0xAF081, 0xAF0A1, 0xAF0C1, 0xAF0E1, 0xAF101, 0xAF121, 0xAF141, 0xAF161, 0xAF181, 0xAF1A1, 0xAF1C1, 0xAF1E1,
+		0xAF201, 0xAF221, 0xAF241, 0xAF261, 0xAF281, 0xAF2A1, 0xAF2C1, 0xAF2E1, 0xAF301, 0xAF321, 0xAF341, 0xAF361, 0xAF381, 0xAF3A1, 0xAF3C1, 0xAF3E1,
+		0xAF401, 0xAF421, 0xAF441, 0xAF461, 0xAF481, 0xAF4A1, 0xAF4C1, 0xAF4E1, 0xAF501, 0xAF521, 0xAF541, 0xAF561, 0xAF581, 0xAF5A1, 0xAF5C1, 0xAF5E1,
+		0xAF601, 0xAF621, 0xAF641, 0xAF661, 0xAF681, 0xAF6A1, 0xAF6C1, 0xAF6E1, 0xAF701, 0xAF721, 0xAF741, 0xAF761, 0xAF781, 0xAF7A1, 0xAF7C1, 0xAF7E1,
+		0xAF801, 0xAF821, 0xAF841, 0xAF861, 0xAF881, 0xAF8A1, 0xAF8C1, 0xAF8E1, 0xAF901, 0xAF921, 0xAF941, 0xAF961, 0xAF981, 0xAF9A1, 0xAF9C1, 0xAF9E1,
+		0xAFA01, 0xAFA21, 0xAFA41, 0xAFA61, 0xAFA81, 0xAFAA1, 0xAFAC1, 0xAFAE1, 0xAFB01, 0xAFB21, 0xAFB41, 0xAFB61, 0xAFB81, 0xAFBA1, 0xAFBC1, 0xAFBE1,
+		0xAFC01, 0xAFC21, 0xAFC41, 0xAFC61, 0xAFC81, 0xAFCA1, 0xAFCC1, 0xAFCE1, 0xAFD01, 0xAFD21, 0xAFD41, 0xAFD61, 0xAFD81, 0xAFDA1, 0xAFDC1, 0xAFDE1,
+		0xAFE01, 0xAFE21, 0xAFE41, 0xAFE61, 0xAFE81, 0xAFEA1, 0xAFEC1, 0xAFEE1, 0xAFF01, 0xAFF21, 0xAFF41, 0xAFF61, 0xAFF81, 0xAFFA1, 0xAFFC1, 0xAFFE1,
+		0xB0001, 0xB0021, 0xB0041, 0xB0061, 0xB0081, 0xB00A1, 0xB00C1, 0xB00E1, 0xB0101, 0xB0121, 0xB0141, 0xB0161, 0xB0181, 0xB01A1, 0xB01C1, 0xB01E1,
+		0xB0201, 0xB0221, 0xB0241, 0xB0261, 0xB0281, 0xB02A1, 0xB02C1, 0xB02E1, 0xB0301, 0xB0321, 0xB0341, 0xB0361, 0xB0381, 0xB03A1, 0xB03C1, 0xB03E1,
+		0xB0401, 0xB0421, 0xB0441, 0xB0461, 0xB0481, 0xB04A1, 0xB04C1, 0xB04E1, 0xB0501, 0xB0521, 0xB0541, 0xB0561, 0xB0581, 0xB05A1, 0xB05C1, 0xB05E1,
+		0xB0601, 0xB0621, 0xB0641, 0xB0661, 0xB0681, 0xB06A1, 0xB06C1, 0xB06E1, 0xB0701, 0xB0721, 0xB0741, 0xB0761, 0xB0781, 0x7321, 0x7341, 0xAE8A1,
+		0xAEAC1, 0xAEBE1, 0xAEC01, 0xAEC21, 0xAEC41, 0xAEC61, 0xAEC81, 0xB07A1, 0xB07C1, 0xB07E1, 0xB0801, 0xB0821, 0xB0841, 0xB0861, 0xB0881, 0xB08A1,
+		0x7361, 0xB1181, 0xB11A1, 0xB11C1, 0xB11E1, 0xB1201, 0xB1221, 0xB1241, 0xB1261, 0xB1281, 0xB12A1, 0xB12C1, 0xB12E1, 0xB1301, 0xB1321, 0xB1341,
+		0xB1361, 0xB1381, 0xB13A1, 0xB13C1, 0xB13E1, 0xB1401, 0xB1421, 0xB1441, 0xB1461, 0xB1481, 0xB14A1, 0x7381, 0x73A1, 0, 0, 0,
+		0xB14C1, 0xB14E2, 0xB1521, 0xB2321, 0xB1542, 0xB1582, 0xB15C1, 0xB15E2, 0xB1661, 0xB1682, 0xB22A1, 0xB22E1, 0xB16C2, 0xB1702, 0xB1742, 0xB17A1,
+		0xB17C1, 0xB17E1, 0xB1801, 0xB1822, 0xB1862, 0xB18A2, 0xB18E2, 0xB1941, 0xB2381, 0xB1961, 0xB19C1, 0xB19E2, 0xB1A22, 0xB1A62, 0xB1AA1, 0xB1AC2,
+		0xB1B02, 0xB1B41, 0xB1B62, 0xB1BC1, 0xB1BE2, 0xB1C21, 0xB1C42, 0xB1CC1, 0xB1CE1, 0xB1D41, 0xB1D61, 0xB1D82, 0xB1E02, 0xB1E42, 0xB1E82, 0xB1EE1,
+		0xB1F02, 0xB1F42, 0xB1F81, 0xB1FA2, 0xB1FE2, 0xB1D02, 0xB2021, 0xB2061, 0xB2082, 0xB20C2, 0xB2181, 0xB21A2, 0xB21E1, 0xB2202, 0xB2241, 0xB2261,
+		0xB2341, 0xB23C1, 0xB23E1, 0xB2361, 0xB23A1, 0xB2401, 0xB2421, 0xB2442, 0xB2482, 0xB1982, 0xB1DC2, 0x73C1, 0x73E1, 0x7401, 0xB1C82, 0xB2104,
+		0xB1622, 0xB1921, 0xB1EC1, 0xB2281, 0xB1781, 0xB1BA1, 0xB2041, 0xB22C1, 0xB2301, 0, 0, 0, 0, 0, 0, 0,
+		0xA12C1, 0xA12E1, 0xA1301, 0xA1321, 0xA1341, 0xA1361, 0xA1381, 0xA13A1, 0xA13C1, 0xA13E1, 0xA1401, 0xA1421, 0xA1441, 0, 0xA1461, 0xA1481,
+		0xA14A1, 0xA14C1, 0xA14E1, 0xA1501, 0xA1521, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA1541, 0xA1561, 0xA1581, 0xA15A1, 0xA15C1, 0xA15E1, 0xA1601, 0xA1621, 0xA1641, 0xA1661, 0xA1681, 0xA16A1, 0xA16C1, 0xA16E1, 0xA1701, 0xA1721,
+		0xA1741, 0xA1761, 0xA1781, 0xA17A1, 0xA17C1, 0x7421, 0x7441, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA17E1, 0xA1801, 0xA1821, 0xA1841, 0xA1861, 0xA1881, 0xA18A1, 0xA18C1, 0xA18E1, 0xA1901, 0xA1921, 0xA1941, 0xA1961, 0xA1981, 0xA19A1, 0xA19C1,
+		0xA19E1, 0xA1A01, 0xA1A21, 0xA1A41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA1A61, 0xA1A81, 0xA1AA1, 0xA1AC1, 0xA1AE1, 0xA1B01, 0xA1B21, 0xA1B41, 0xA1B61, 0xA1B81, 0xA1BA1, 0xA1BC1, 0xA1BE1, 0, 0xA1C01, 0xA1C21,
+		0xA1C41, 0, 0xA1C61, 0xA1C81, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA4BC1, 0xA4BE1, 0xA4C01, 0xA4C21, 0xA4C41, 0xA4C61, 0xA4C81, 0xA4CA1, 0xA4CC1, 0xA4CE1, 0xA4D01, 0xA4D21, 0xA4D41, 0xA4D61, 0xA4D81, 0xA4DA1,
+		0xA4DC1, 0xA4DE1, 0xA4E01, 0xA4E21, 0xA4E41, 0xA4E61, 0xA4E81, 0xA4EA1, 0xA4EC1, 0xA4EE1, 0xA4F01, 0xA4F21, 0xA4F41, 0xA4F61, 0xA4F81, 0xA4FA1,
+		0xA4FC1, 0xA4FE1, 0xA5001, 0xA5041, 0xA5061, 0xA5081, 0xA50A1, 0xA50C1, 0xA50E1, 0xA5101, 0xA5121, 0xA5141, 0xA5161, 0xA5181, 0xA51A1, 0xA51C1,
+		0xA51E1, 0xA5201, 0xA5221, 0xA5241, 0x7461, 0x7481, 0xA5261, 0xA5281, 0xA52A1, 0xA52C1, 0xA52E1, 0xA5301, 0xA5321, 0xA5341, 0xA5361, 0xA5381,
+		0xA53A1, 0xA53C1, 0xA53E1, 0xA5401, 0xA5421, 0xA5441, 0x407E1, 0x40C41, 0x412C1, 0x412E1, 0x41301, 0x3DAC1, 0x3DAE1, 0x3DB01, 0x3DB21, 0x3DB41,
+		0x3DB61, 0x3DB81, 0xA5461, 0x74A1, 0x74C1, 0x74E1, 0x7501, 0x418C1, 0x7521, 0x7541, 0x7561, 0x41EA1, 0xA5021, 0x3DBA1, 0, 0,
+		0x42521, 0x42F41, 0x45D01, 0x479A1, 0x48D61, 0x49F61, 0x4AFA1, 0x4BD21, 0x4CB01, 0x4D841, 0, 0, 0, 0, 0, 0,
+		0x42541, 0x42F61, 0x45D21, 0x479C1, 0x48D81, 0x49F81, 0x4AFC1, 0x4BD41, 0x4CB21, 0x4D861, 0, 0, 0, 0, 0, 0,
+		0x7581, 0x75A1, 0x75C1, 0x75E1, 0x7601, 0x7621, 0x7641, 0x7661, 0x7681, 0x76A1, 0x76C1, 0x7A1, 0x7C1, 0x7E1, 0x801, 0,
+		0x42561, 0x42F81, 0x45D41, 0x479E1, 0x48DA1, 0x49FA1, 0x4AFE1, 0x4BD61, 0x4CB41, 0x4D881, 0, 0, 0, 0, 0, 0,
+		0xA86C1, 0xA8701, 0xA8761, 0xA8821, 0xA8861, 0xA88C1, 0xA8901, 0xA8961, 0xA8981, 0xA89A1, 0xA8A41, 0xA8A81, 0xA8AE1, 0xA8B21, 0xA8BC1, 0xA8C01,
+		0xA8C21, 0xA8C41, 0xA8D21, 0xA8D81, 0xA8DE1, 0xA8E81, 0xA8F01, 0xA8F61, 0xA8FA1, 0xA8FE1, 0xA9041, 0xA90E1, 0xA9101, 0xA9161, 0xA91E1, 0xA9241,
+		0xA9261, 0xA9281, 0xA92A1, 0xA86A1, 0xA8721, 0xA8781, 0xA8841, 0xA8881, 0xA88E1, 0xA8921, 0xA89C1, 0xA8A61, 0xA8AA1, 0xA8B01, 0xA8B41, 0xA8BE1,
+		0xA8D41, 0xA8DA1, 0xA8E01, 0xA8EA1, 0xA9121, 0xA8F21, 0xA8FC1, 0xA9061, 0xA91A1, 0xA9201, 0xA92C1, 0xA92E1, 0xA8E41, 0xA8741, 0xA87A1, 0xA8801,
+		0xA8941, 0xA88A1, 0xA89E1, 0xA9081, 0xA8B61, 0xA8BA1, 0xA8AC1, 0xA8C61, 0xA8D61, 0xA8DC1, 0xA8EC1, 0xA9001, 0xA91C1, 0xA9221, 0xA9141, 0xA9181,
+		0xA9301, 0xA8E21, 0xA8F41, 0xA87C1, 0xA90A1, 0xA8F81, 0xA9021, 0xA8EE1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA85C1, 0xA85E1, 0xA8601, 0xA8621, 0xA8641, 0xA8661, 0xA8681, 0xA86E1, 0xA87E1, 0xA90C1, 0xA8A01, 0xA8E61, 0xA9321, 0xA9361, 0xA9381, 0xA93C1,
+		0xA93E1, 0xA9441, 0xA9481, 0xA94A1, 0xA94E1, 0xA9521, 0xA9561, 0xA9581, 0xA9401, 0xA9541, 0xA8B81, 0xA8A21, 0xA8C81, 0xA8CA1, 0xA9341, 0xA93A1,
+		0xA9421, 0xA9461, 0xA8CC1, 0xA9501, 0xA8CE1, 0xA8D01, 0xA95A1, 0xA95C1, 0xA94C1, 0xA9601, 0xA95E1, 0, 0, 0, 0, 0,
+		0xB08C1, 0xB08E1, 0xB0901, 0xB0921, 0xB0941, 0xB0961, 0xB0981, 0xB09A1, 0xB09C1, 0xB09E1, 0xB0A01, 0xB0A21, 0xB0A41, 0xB0A61, 0xB0A81, 0xB0AA1,
+		0xB0AC1, 0xB0AE1, 0xB0B01, 0xB0B21, 0xB0B41, 0xB0B61, 0xB0B81, 0xB0BA1, 0xB0BC1, 0xB0BE1, 0xB0C01, 0xB0C21, 0xB0C41, 0xB0C61, 0xB0C81, 0xB0CA1,
+		0xB0CC1, 0xB0CE1, 0xB0D01, 0xB0D21, 0xB0D41, 0xB0D61, 0xB0D81, 0xB0DA1, 0xB0DC1, 0xB0DE1, 0xB0E01, 0xB0E21, 0xB0E41, 0xB0E61, 0xB0E81, 0xB0EA1,
+		0xB0EC1, 0xB0EE1, 0xB0F01, 0xB0F21, 0xB0F41, 0xB0F61, 0xB0F81, 0xB0FA1, 0xB0FC1, 0xB0FE1, 0xB1001, 0xB1021, 0xB1041, 0xB1061, 0xB1081, 0xB10A1,
+		0xB10C1, 0xB10E1, 0xB1101, 0xB1121, 0xB1141, 0xB1161, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA0C01, 0xA0C21, 0xA0C41, 0xA0C61, 0xA0C81, 0xA0CA1, 0xA0CC1, 0xA0CE1, 0xA0D01, 0xA0D61, 0xA0D81, 0xA0DA1, 0xA0E01, 0xA0E21, 0xA0E41, 0xA0E61,
+		0xA0E81, 0xA0EA1, 0xA0EC1, 0xA0EE1, 0xA0F01, 0xA0F21, 0xA0F41, 0xA0F61, 0xA0F81, 0xA0FA1, 0xA0FC1, 0xA0FE1, 0xA1001, 0xA0D22, 0xA0DC2, 0,
+		0xA1021, 0xA1041, 0xA1061, 0xA1081, 0xA10A1, 0xA10C1, 0xA10E1, 0xA1101, 0xA1121, 0xA1141, 0xA1161, 0xA1181, 0, 0, 0, 0,
+		0xA11A1, 0xA11C1, 0xA11E1, 0xA1201, 0xA1221, 0xA1241, 0xA1261, 0xA1281, 0xA12A1, 0x41421, 0x41441, 0x41461, 0, 0, 0, 0,
+		0x76E1, 0, 0, 0, 0x7701, 0x7721, 0x42581, 0x42FA1, 0x45D61, 0x47A01, 0x48DC1, 0x49FC1, 0x4B001, 0x4BD81, 0x4CB61, 0x4D8A1,
+		0xA5481, 0xA54A1, 0xA54C1, 0xA54E1, 0xA5501, 0xA5521, 0xA5541, 0xA5561, 0xA5581, 0xA55A1, 0xA55C1, 0xA55E1, 0xA5601, 0xA5621, 0xA5641, 0xA5661,
+		0xA5681, 0xA56A1, 0xA56C1, 0xA56E1, 0xA5701, 0xA5721, 0xA5741, 0xA5761, 0xA5781, 0xA57A1, 0xA57C1, 0xA57E1, 0xA5801, 0xA5821, 0, 0,
+		0xA5841, 0xA5861, 0xA5881, 0xA58A1, 0xA58C1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA58E1, 0xA5901, 0xA5921, 0xA5941, 0xA5961, 0xA5981, 0xA59A1, 0xA59C1, 0xA59E1, 0xA5A01, 0xA5A21, 0xA5A41, 0xA5A61, 0xA5A81, 0xA5AA1, 0xA5AC1,
+		0xA5AE1, 0xA5B01, 0xA5B21, 0xA5B41, 0xA5B61, 0xA5B81, 0xA5BA1, 0xA5BC1, 0xA5BE1, 0xA5C01, 0xA5C21, 0xA5C41, 0xA5C61, 0xA5D21, 0xA5D41, 0xA5D61,
+		0xA5D81, 0xA5DA1, 0xA5DC1, 0xA5DE1, 0xA5E01, 0xA5E21, 0xA5E41, 0xA5E61, 0xA5E81, 0xA5EA1, 0xA5EC1, 0xA5EE1, 0, 0, 0, 0,
+		0xA5F01, 0xA5F21, 0xA5F41, 0xA5F61, 0xA5F81, 0xA5FA1, 0xA5FC1, 0xA5FE1, 0xA6001, 0xA6021, 0xA6041, 0xA6061, 0xA6081, 0xA60A1, 0xA60C1, 0xA60E1,
+		0xA6101, 0xA6121, 0xA6141, 0xA6161, 0xA6181, 0xA61A1, 0xA61C1, 0xA61E1, 0xA6201, 0xA6221, 0, 0, 0, 0, 0, 0,
+		0x425A1, 0x42FC1, 0x45D81, 0x47A21, 0x48DE1, 0x49FE1, 0x4B021, 0x4BDA1, 0x4CB81, 0x4D8C1, 0x42FE1, 0, 0, 0, 0xA5C82, 0xA5CC3,
+		0x7741, 0x7761, 0x7781, 0x77A1, 0x77C1, 0x77E1, 0x7801, 0x7821, 0x7841, 0x7861, 0x7881, 0x78A1, 0x78C1, 0x78E1, 0x7901, 0x7921,
+		0x7941, 0x7961, 0x7981, 0x79A1, 0x79C1, 0x79E1, 0x7A01, 0x7A21, 0x7A41, 0x7A61, 0x7A81, 0x7AA1, 0x7AC1, 0x7AE1, 0x7B01, 0x7B21,
+		0xA1CA1, 0xA1CC1, 0xA1CE1, 0xA1D01, 0xA1D21, 0xA1D41, 0xA1D61, 0xA1D81, 0xA1DA1, 0xA1DC1, 0xA1DE1, 0xA1E01, 0xA1E21, 0xA1E41, 0xA1E61, 0xA1E81,
+		0xA1EA1, 0xA1EC1, 0xA1EE1, 0xA1F01, 0xA1F21, 0xA1F41, 0xA1F61, 0xA1F81, 0xA1FA1, 0xA1FC1, 0xA1FE1, 0xA2001, 0, 0, 0x7B41, 0x7B61,
+		0xA6241, 0xA6261, 0xA6281, 0xA62A1, 0xA62C1, 0xA62E1, 0xA6301, 0xA6361, 0xA6381, 0xA63A1, 0xA63C1, 0xA63E1, 0xA6401, 0xA6421, 0xA6441, 0xA6461,
+		0xA6481, 0xA64A1, 0xA64C1, 0xA64E1, 0xA6501, 0xA6521, 0xA6541, 0xA6561, 0xA6581, 0xA65A1, 0xA65C1, 0xA65E1, 0xA6641, 0xA6661, 0xA6681, 0xA66A1,
+		0xA66C1, 0xA66E1, 0xA6701, 0xA6721, 0xA6741, 0xA6761, 0xA6781, 0xA6801, 0xA6821, 0xA6841, 0xA6861, 0xA6881, 0xA68A1, 0xA69C1, 0xA69E1, 0xA6A01,
+		0xA6A21, 0xA6A41, 0xA6A61, 0xA68C1, 0xA67A3, 0xA6901, 0xA6921, 0xA6941, 0xA6321, 0xA6341, 0xA6601, 0xA6621, 0xA6961, 0xA6981, 0xA69A1, 0,
+		0xA6CC1, 0xA6A81, 0xA6AC1, 0xA6AE1, 0xA6B01, 0xA6B21, 0xA6B41, 0xA6B61, 0xA6B81, 0xA6BA1, 0xA6BC1, 0xA68E1, 0xA6AA1, 0xA6CA1, 0xA6BE1, 0xA6C01,
+		0xA6C41, 0xA6C61, 0xA6C81, 0xA6C21, 0x40801, 0x41321, 0x41341, 0x41361, 0x41381, 0x413A1, 0x413C1, 0x413E1, 0x41401, 0, 0, 0x7B81,
+		0x425C1, 0x43001, 0x45DA1, 0x47A41, 0x48E01, 0x4A001, 0x4B041, 0x4BDC1, 0x4CBA1, 0x4D8E1, 0, 0, 0, 0, 0, 0,
+		0x425E1, 0x43021, 0x45DC1, 0x47A61, 0x48E21, 0x4A021, 0x4B061, 0x4BDE1, 0x4CBC1, 0x4D901, 0, 0, 0, 0, 0, 0,
+		0x7BA1, 0x7BC1, 0x7BE1, 0x7C01, 0x7C21, 0x7C41, 0x7C61, 0x418E1, 0x7C81, 0x7CA1, 0x7CC1, 0x7CE1, 0x7D01, 0x7D21, 0, 0,
+		0x3DBC1, 0x3DBE1, 0x3DC01, 0x3DC21, 0x3DC41, 0x3E421, 0x3E441, 0x3E461, 0x3E481, 0x3E4A1, 0x3E4C1, 0x3DC61, 0x3DC81, 0x3E4E1, 0x3E7C1, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x40481, 0x404A1, 0x40821, 0x40F01, 0x40C61, 0xA7581, 0xA75A1, 0xA75C1, 0xA75E1, 0xA7601, 0xA7621, 0xA7641, 0xA7661, 0xA7681, 0xA76A1, 0xA76C1,
+		0xA76E1, 0xA7701, 0xA7721, 0xA7741, 0xA77A1, 0xA77C1, 0xA77E1, 0xA7801, 0xA7821, 0xA7841, 0xA7861, 0xA7881, 0xA78A1, 0xA78C1, 0xA78E1, 0xA7901,
+		0xA7921, 0xA7941, 0xA7961, 0xA79A1, 0xA79C1, 0xA79E1, 0xA7A01, 0xA7A21, 0xA7A61, 0xA7A81, 0xA7AA1, 0xA7AC1, 0xA7AE1, 0xA7B01, 0xA7B21, 0xA7B41,
+		0xA7B81, 0xA7BA1, 0xA7BC1, 0xA7C21, 0x40181, 0xA7C41, 0xA7C61, 0xA7C81, 0xA7CA1, 0xA7CC1, 0xA7CE1, 0xA7D01, 0xA7D21, 0xA7D41, 0xA7D61, 0xA7D81,
+		0xA7DA1, 0xA7DC1, 0xA7DE1, 0xA7E01, 0xA7E21, 0xA7761, 0xA7781, 0xA7981, 0xA7A41, 0xA7B61, 0xA7BE1, 0xA7C01, 0, 0, 0, 0,
+		0x42601, 0x43041, 0x45DE1, 0x47A81, 0x48E41, 0x4A041, 0x4B081, 0x4BE01, 0x4CBE1, 0x4D921, 0x7D41, 0x7D61, 0x7D81, 0x7DA1, 0x7DC1, 0x7DE1,
+		0x7E01, 0x7E21, 0x7E41, 0x7E61, 0x7E81, 0x7EA1, 0x7EC1, 0x7EE1, 0x7F01, 0x7F21, 0x7F41, 0x7F61, 0x7F81, 0x7FA1, 0x7FC1, 0x7FE1,
+		0x8001, 0x8021, 0x8041, 0x8061, 0x8081, 0x80A1, 0x80C1, 0x80E1, 0x8101, 0x8121, 0x8141, 0x8161, 0x8181, 0, 0, 0,
+		0x40841, 0x40F41, 0x40C81, 0x9B341, 0x9B381, 0x9B3A1, 0x9B3C1, 0x9B3E1, 0x9B401, 0x9B421, 0x9B441, 0x9B4A1, 0x9B4C1, 0x9B4E1, 0x9B501, 0x9B521,
+		0x9B541, 0x9B561, 0x9B581, 0x9B5A1, 0x9B5C1, 0x9B5E1, 0x9B601, 0x9B621, 0x9B641, 0x9B681, 0x9B6E1, 0x9B721, 0x9B781, 0x9B7E1, 0x9B821, 0x9B841,
+		0x9B881, 0x9B701, 0x9B741, 0x9B7A1, 0x9B8A1, 0x9B8C1, 0x9B8E1, 0x9B901, 0x9B921, 0x9B941, 0x9B961, 0x9B981, 0x9B6C1, 0x9B801, 0x9B481, 0x9B861,
+		0x42621, 0x43061, 0x45E01, 0x47AA1, 0x48E61, 0x4A061, 0x4B0A1, 0x4BE21, 0x4CC01, 0x4D941, 0x9B361, 0x9B761, 0x9B7C1, 0x9B661, 0x9B461, 0x9B6A1,
+		0xA2021, 0xA2041, 0xA2061, 0xA2081, 0xA20A1, 0xA20C1, 0xA20E1, 0xA2101, 0xA2121, 0xA2141, 0xA2161, 0xA2181, 0xA21A1, 0xA21C1, 0xA21E1, 0xA2201,
+		0xA2221, 0xA2241, 0xA2261, 0xA2281, 0xA22A1, 0xA22C1, 0xA22E1, 0xA2301, 0xA2321, 0xA2341, 0xA2361, 0xA2381, 0xA23A1, 0xA23C1, 0xA23E1, 0xA2401,
+		0xA2421, 0xA2441, 0xA2461, 0xA2481, 0xA24A1, 0xA24C1, 0x401A1, 0xA24E1, 0xA2501, 0xA2521, 0xA2541, 0xA2561, 0xA2581, 0xA25A1, 0xA25C1, 0xA25E1,
+		0xA2601, 0xA2621, 0xA2641, 0xA2661, 0, 0, 0, 0, 0, 0, 0, 0, 0x81A1, 0x81C1, 0x81E1, 0x8201,
+		0x9FE41, 0x9FE61, 0x9FE81, 0x9FEA1, 0x9FEC1, 0x9FEE1, 0x9FF01, 0x9FF21, 0x9FF41, 0x9FF61, 0x9FFE1, 0xA0001, 0xA0021, 0xA0041, 0xA0061, 0xA0081,
+		0xA00A1, 0xA00C1, 0xA00E1, 0xA0101, 0xA0121, 0xA0141, 0xA0161, 0xA0181, 0xA01A1, 0xA01C1, 0xA01E1, 0xA0221, 0xA0261, 0xA0281, 0xA02A1, 0xA02C1,
+		0xA02E1, 0xA0301, 0xA0321, 0xA0341, 0xA0201, 0xA0241, 0xA0381, 0xA03A1, 0xA03C1, 0xA03E1, 0xA0401, 0xA0421, 0xA0441, 0xA0461, 0xA0481, 0xA04A1,
+		0xA04C1, 0xA04E1, 0xA0501, 0xA0521, 0xA0541, 0xA0561, 0xA0361, 0x401C1, 0, 0, 0, 0x8221, 0x8241, 0x8261, 0x8281, 0x82A1,
+		0x42641, 0x43081, 0x45E21, 0x47AC1, 0x48E81, 0x4A081, 0x4B0C1, 0x4BE41, 0x4CC21, 0x4D961, 0, 0, 0, 0x9FF81, 0x9FFA1, 0x9FFC1,
+		0x42661, 0x430A1, 0x45E41, 0x47AE1, 0x48EA1, 0x4A0A1, 0x4B0E1, 0x4BE61, 0x4CC41, 0x4D981, 0xA9621, 0xA9641, 0xA9661, 0xA9681, 0xA96A1, 0xA96C1,
+		0xA96E1, 0xA9701, 0xA9721, 0xA9741, 0xA9761, 0xA9781, 0xA97A1, 0xA97C1, 0xA97E1, 0xA9801, 0xA9821, 0xA9841, 0xA9861, 0xA9881, 0xA98A1, 0xA98C1,
+		0xA98E1, 0xA9901, 0xA9921, 0xA9941, 0xA9961, 0xA9981, 0xA99A1, 0xA99C1, 0xA99E1, 0xA9A01, 0xA9A21, 0xA9A41, 0xA9A61, 0xA9A81, 0x82C1, 0x82E1,
+		0x73641, 0x73921, 0x74FA1, 0x754C1, 0x75601, 0x75621, 0x76521, 0x767A1, 0x75AC1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x8301, 0x8321, 0x8341, 0x8361, 0x8381, 0x83A1, 0x83C1, 0x83E1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x8401, 0x8421, 0x8441, 0x8461, 0x8481, 0x84A1, 0x84C1, 0x84E1, 0x8501, 0x8521, 0x8541, 0x8561, 0x8581, 0x85A1, 0x85C1, 0x85E1,
+		0x8601, 0x8621, 0x8641, 0x8661, 0x8681, 0x86A1, 0x86C1, 0x86E1, 0x8701, 0x8EAA1, 0x8EAC1, 0x8EAE1, 0x8EB01, 0x40861, 0x8EB21, 0x8EB41,
+		0x8EB61, 0x8EB81, 0x40CA1, 0x40CC1, 0x8721, 0x8EBA1, 0x8EBC1, 0, 0x8741, 0x8761, 0, 0, 0, 0, 0, 0,
+		0x50581, 0x50601, 0x50621, 0x51041, 0x51FC1, 0x531C1, 0x531E1, 0x54861, 0x54C01, 0x58801, 0x59021, 0x59EC1, 0x5B021, 0x5C281, 0x5D221, 0x5F421,
+		0x5F601, 0x5F441, 0x5F621, 0x5F561, 0x5F4A1, 0x5F8E1, 0x5F6E1, 0x5F721, 0x603E1, 0x61B01, 0x61BC1, 0x63F01, 0x65A21, 0x65A81, 0x65AC1, 0x65D61,
+		0x668E1, 0x67461, 0x691E1, 0x694E1, 0x69C41, 0x69C61, 0x6B501, 0x6E701, 0x6F741, 0x6FB41, 0x71101, 0x74A01, 0x4E821, 0x4FDC3, 0x50D41, 0x51021,
+		0x52741, 0x538E1, 0x549A1, 0x55CC1, 0x56B01, 0x57B01, 0x58EC1, 0x596A1, 0x5A621, 0x5B941, 0x5C921, 0x5D201, 0x5D9E1, 0x5F8C1, 0x5FE41, 0x611E1,
+		0x63781, 0x64641, 0x66FC1, 0x4E7E1, 0x506C1, 0x50741, 0x50641, 0x50D21, 0x52701, 0x538A1, 0x54A41, 0x54B01, 0x54C21, 0x55CA1, 0x58821, 0x59661,
+		0x5B901, 0x5D441, 0x5D9A1, 0x5F5E1, 0x5F701, 0x5F741, 0x5FE01, 0x63741, 0x64601, 0x65AA1, 0x65D21, 0x66381, 0x69C81, 0x6B2C1, 0x6B4C1, 0x6B6A1,
+		0x70D81, 0x70F41, 0x57AE1, 0x611C1, 0x64621, 0x663A1, 0x6B2E1, 0x6B4E1, 0x6FA61, 0x70DA1, 0x70F61, 0x65AE1, 0x51061, 0x53201, 0x556C1, 0x5C2C1,
+		0x5D241, 0x60481, 0x61B61, 0x61D21, 0x63001, 0x63FA1, 0x69241, 0x564C1, 0x74D61, 0x56122, 0x63CC3, 0x588A1, 0x58961, 0x60401, 0x65BC1, 0x65E21,
+		0x510C1, 0x53221, 0x556E1, 0x56441, 0x59EE1, 0x5B241, 0x5C2E1, 0x5D341, 0x604A1, 0x61C01, 0x63021, 0x631A1, 0x66941, 0x67CE1, 0x69261, 0x505E1,
+		0x50781, 0x532C1, 0x54901, 0x54B21, 0x54BE1, 0x54A81, 0x588E1, 0x5F661, 0x63201, 0x65BE1, 0x69541, 0x507E1, 0x51761, 0x520E1, 0x52BE2, 0x54BC1,
+		0x55201, 0x59101, 0x56381, 0x65C81, 0x58881, 0x58941, 0x587A1, 0x588C1, 0x590C1, 0x5B2A1, 0x5B261, 0x5AFC1, 0x5C341, 0x65DA1, 0x5D2A1, 0x5D381,
+		0x5D1E1, 0x5F7C1, 0x605C1, 0x63061, 0x63161, 0x63FE1, 0x65B81, 0x65E01, 0x65A41, 0x669A1, 0x66A81, 0x68E41, 0x692E1, 0x69321, 0x69441, 0x6D621,
+		0x3DCA1, 0x3DCC1, 0x3E501, 0x3DCE1, 0x3DD01, 0x3DD21, 0x3DD41, 0x3DD61, 0x3DD81, 0x3DDA1, 0x60CC1, 0x3DDC1, 0x3DDE1, 0x3DE01, 0x3DE21, 0x3E521,
+		0x3E541, 0x3DE41, 0x697E1, 0x4FB42, 0x4FD03, 0x50102, 0x50342, 0x51A02, 0x52C22, 0x52B62, 0x557E1, 0x563E1, 0x59181, 0x5A0C1, 0x5AFA1, 0x5C2A1,
+		0x5C421, 0x5D1C1, 0x61A41, 0x61AC1, 0x61F01, 0x62B22, 0x68981, 0x50701, 0x50861, 0x51181, 0x54A01, 0x54D01, 0x5B181, 0x5EAC2, 0x5F941, 0x63121,
+		0x65162, 0x66AE1, 0x4F2C2, 0x5E002, 0x649E2, 0x3DE61, 0, 0, 0, 0, 0, 0x3DE81, 0x3E561, 0x3E581, 0x3DEA1, 0x3E5A1,
+		0x4FB02, 0x4FAC2, 0x50DE2, 0x50DA2, 0x50E62, 0x50E22, 0x50EE2, 0x50EA2, 0x51AE3, 0x51A83, 0x52862, 0x52822, 0x529E2, 0x529A2, 0x52AE2, 0x52AA2,
+		0x528E2, 0x528A2, 0x52A62, 0x52A22, 0x543A3, 0x54343, 0x542E3, 0x54283, 0x54702, 0x546C2, 0x54782, 0x54742, 0x54123, 0x540C3, 0x552A2, 0x55262,
+		0x56062, 0x56022, 0x56D22, 0x56CE2, 0x56F22, 0x56EE2, 0x56CA2, 0x56C62, 0x56DA2, 0x56D62, 0x56FA2, 0x56F62, 0x58322, 0x582E2, 0x57EC3, 0x57E63,
+		0x59742, 0x59702, 0x59942, 0x59902, 0x599C2, 0x59982, 0x5A8C2, 0x5A882, 0x5A963, 0x5A903, 0x5AA82, 0x5AA42, 0x5AA02, 0x5A9C2, 0x5B9E2, 0x5B9A2,
+		0x5BA62, 0x5BA22, 0x5BAE2, 0x5BAA2, 0x5CBC2, 0x5CB82, 0x5CD42, 0x5CD02, 0x5CE42, 0x5CE02, 0x5CDC2, 0x5CD82, 0x5E323, 0x5E2C3, 0x5E3E3, 0x5E383,
+		0x5EA63, 0x5EA03, 0x5E9A3, 0x5E943, 0x5FF02, 0x5FEC2, 0x5FF82, 0x5FF42, 0x61382, 0x61342, 0x61602, 0x615C2, 0x616A3, 0x61643, 0x61742, 0x61702,
+		0x627E2, 0x627A2, 0x62962, 0x62922, 0x62583, 0x62523, 0x62743, 0x626E3, 0x62A03, 0x629A3, 0x638E2, 0x638A2, 0x639E2, 0x639A2, 0x63B62, 0x63B22,
+		0x63AE2, 0x63AA2, 0x65822, 0x657E2, 0x65922, 0x658E2, 0x658A2, 0x65862, 0x64F43, 0x64EE3, 0x65103, 0x650A3, 0x66462, 0x66422, 0x664E2, 0x664A2,
+		0x670E2, 0x670A2, 0x67062, 0x67022, 0x67222, 0x671E2, 0x672A2, 0x67262, 0x67322, 0x672E2, 0x67B62, 0x67B22, 0x67AE2, 0x67AA2, 0x68642, 0x68602,
+		0x68F62, 0x68F22, 0x690E2, 0x690A2, 0x69162, 0x69122, 0x56FE2, 0x63862, 0x671A2, 0x684C2, 0x50542, 0x62BE3, 0x630C1, 0x630E1, 0x62EA3, 0x53361,
+		0x4F902, 0x4F8C2, 0x4F782, 0x4F742, 0x4EDE3, 0x4ED83, 0x4EEA3, 0x4EE43, 0x4F023, 0x4EFC3, 0x4EF63, 0x4EF03, 0x4FA63, 0x4FA03, 0x4EA63, 0x4EA03,
+		0x4EB23, 0x4EAC3, 0x4ECA3, 0x4EC43, 0x4EBE3, 0x4EB83, 0x4F9A3, 0x4F943, 0x545C2, 0x54582, 0x54442, 0x54402, 0x53F82, 0x53F42, 0x53BA3, 0x53B43,
+		0x53C63, 0x53C03, 0x53DE3, 0x53D83, 0x53D23, 0x53CC3, 0x54663, 0x54603, 0x58122, 0x580E2, 0x582A2, 0x58262, 0x5F102, 0x5F0C2, 0x5EB42, 0x5EB02,
+		0x5DCA3, 0x5DC43, 0x5DD63, 0x5DD03, 0x5DEE3, 0x5DE83, 0x5DE23, 0x5DDC3, 0x5F1A3, 0x5F143, 0x5ED63, 0x5ED03, 0x5EE23, 0x5EDC3, 0x5EFA3, 0x5EF43,
+		0x5EEE3, 0x5EE83, 0x5F063, 0x5F003, 0x657A2, 0x65762, 0x651E2, 0x651A2, 0x65403, 0x653A3, 0x654C3, 0x65463, 0x65643, 0x655E3, 0x65583, 0x65523,
+		0x65703, 0x656A3, 0x68402, 0x683C2, 0x687C2, 0x68782, 0x68742, 0x68702, 0x685C2, 0x68582, 0x5AC42, 0x5AC02, 0x66A21, 0x66A01, 0x688C1, 0x688A1,
+		0x69FC2, 0x6A642, 0x6A203, 0x6A883, 0x6A043, 0x6A6C3, 0x6A3C3, 0x6AA43, 0x6A002, 0x6A682, 0x6A263, 0x6A8E3, 0x6A0A3, 0x6A723, 0x6A423, 0x6AAA3,
+		0x6B902, 0x6BB02, 0x6BA43, 0x6BC43, 0x6B983, 0x6BB83, 0, 0, 0x6B942, 0x6BB42, 0x6BAA3, 0x6BCA3, 0x6B9E3, 0x6BBE3, 0, 0,
+		0x6C2C2, 0x6C942, 0x6C503, 0x6CB83, 0x6C343, 0x6C9C3, 0x6C6C3, 0x6CD43, 0x6C302, 0x6C982, 0x6C563, 0x6CBE3, 0x6C3A3, 0x6CA23, 0x6C723, 0x6CDA3,
+		0x6D802, 0x6DAC2, 0x6D943, 0x6DC03, 0x6D883, 0x6DB43, 0x6DA03, 0x6DCC3, 0x6D842, 0x6DB02, 0x6D9A3, 0x6DC63, 0x6D8E3, 0x6DBA3, 0x6DA63, 0x6DD23,
+		0x6EF42, 0x6F142, 0x6F083, 0x6F283, 0x6EFC3, 0x6F1C3, 0, 0, 0x6EF82, 0x6F182, 0x6F0E3, 0x6F2E3, 0x6F023, 0x6F223, 0, 0,
+		0x701E2, 0x70342, 0x70283, 0x70483, 0x70223, 0x703C3, 0x702E3, 0x70543, 0, 0x70382, 0, 0x704E3, 0, 0x70423, 0, 0x705A3,
+		0x712C2, 0x71942, 0x71503, 0x71B83, 0x71343, 0x719C3, 0x716C3, 0x71D43, 0x71302, 0x71982, 0x71563, 0x71BE3, 0x713A3, 0x71A23, 0x71723, 0x71DA3,
+		0x6AE22, 0x6AD02, 0x6BE02, 0x6BD42, 0x6D122, 0x6D002, 0x6DE82, 0x6DDC2, 0x6F442, 0x6F382, 0x70742, 0x70642, 0x72122, 0x72002, 0, 0,
+		0x6A583, 0x6AC03, 0x6A2C4, 0x6A944, 0x6A104, 0x6A784, 0x6A484, 0x6AB04, 0x6A5E3, 0x6AC63, 0x6A344, 0x6A9C4, 0x6A184, 0x6A804, 0x6A504, 0x6AB84,
+		0x6C883, 0x6CF03, 0x6C5C4, 0x6CC44, 0x6C404, 0x6CA84, 0x6C784, 0x6CE04, 0x6C8E3, 0x6CF63, 0x6C644, 0x6CCC4, 0x6C484, 0x6CB04, 0x6C804, 0x6CE84,
+		0x71883, 0x71F03, 0x715C4, 0x71C44, 0x71404, 0x71A84, 0x71784, 0x71E04, 0x718E3, 0x71F63, 0x71644, 0x71CC4, 0x71484, 0x71B04, 0x71804, 0x71E84,
+		0x6AF02, 0x6B022, 0x6AEA3, 0x6B0A2, 0x6ADC3, 0, 0x6AF82, 0x6AFC3, 0x6AF42, 0x6B062, 0x6AE62, 0x6AD82, 0x6B0E2, 0x8781, 0x6D661, 0x87A1,
+		0x87C1, 0x87E2, 0x6D1A3, 0x6D2A2, 0x6D0C3, 0, 0x6D202, 0x6D243, 0x6BE42, 0x6BDC2, 0x6D162, 0x6D082, 0x6D2E2, 0x8822, 0x8862, 0x88A2,
+		0x6DF02, 0x6E1C2, 0x6E103, 0x6E0A3, 0, 0, 0x6DF82, 0x6E163, 0x6DF42, 0x6E202, 0x6DEC2, 0x6DE42, 0, 0x88E2, 0x8922, 0x8962,
+		0x707C2, 0x70AC2, 0x70A03, 0x709A3, 0x6FA82, 0x6FAC2, 0x70842, 0x70A63, 0x70802, 0x70B02, 0x70782, 0x706C2, 0x6FB02, 0x89A2, 0x89E2, 0x8A21,
+		0, 0, 0x721A3, 0x722A2, 0x720C3, 0, 0x72202, 0x72243, 0x6F482, 0x6F402, 0x72162, 0x72082, 0x722E2, 0x8A41, 0x8A61, 0,
+		0x8A81, 0x8AA1, 0x8AC1, 0x8AE1, 0x8B01, 0x8B21, 0x8B41, 0x8B61, 0x8B81, 0x8BA1, 0x8BC1, 0x821, 0x841, 0x861, 0x881, 0x8A1,
+		0x8BE1, 0x8C01, 0x8C21, 0x8C41, 0x8C61, 0x8C81, 0x8CA1, 0x8CC1, 0x8CE1, 0x8D01, 0x8D21, 0x8D41, 0x8D61, 0x8D81, 0x8DA1, 0x8DC1,
+		0x8DE1, 0x8E01, 0x8E21, 0x8E41, 0x8E61, 0x8E82, 0x8EC3, 0x8F21, 0x8F41, 0x8F61, 0x8C1, 0x8E1, 0x901, 0x921, 0x941, 0x8F81,
+		0x8FA1, 0x8FC1, 0x8FE1, 0x9002, 0x9043, 0x90A1, 0x90C2, 0x9103, 0x9161, 0x9181, 0x91A1, 0x91C1, 0x91E2, 0x9221, 0x9241, 0x9261,
+		0x9281, 0x92A1, 0x92C1, 0x92E1, 0x9301, 0x9321, 0x9341, 0x9362, 0x93A2, 0x93E2, 0x9421, 0x9441, 0x9461, 0x9481, 0x94A1, 0x94C1,
+		0x94E1, 0x9501, 0x9521, 0x9541, 0x9561, 0x9581, 0x95A1, 0x95C4, 0x9641, 0x9661, 0x9681, 0x96A1, 0x96C1, 0x96E1, 0x9701, 0x9721,
+		0x961, 0x9741, 0x9761, 0x9781, 0x97A1, 0, 0x981, 0x9A1, 0x9C1, 0x9E1, 0xA01, 0xA21, 0xA41, 0xA61, 0xA81, 0xAA1,
+		0x42B81, 0x57AC1, 0, 0, 0x49821, 0x4AA21, 0x4B8A1, 0x4C621, 0x4D3E1, 0x4E161, 0x97C1, 0x97E1, 0x9801, 0x9821, 0x9841, 0x5C8E1,
+		0x42BA1, 0x43A81, 0x467E1, 0x48481, 0x49841, 0x4AA41, 0x4B8C1, 0x4C641, 0x4D401, 0x4E181, 0x9861, 0x9881, 0x98A1, 0x98C1, 0x98E1, 0,
+		0x4E801, 0x538C1, 0x5D9C1, 0x67A41, 0x54A61, 0x56AE1, 0x59681, 0x5A601, 0x5B921, 0x5C901, 0x5FE21, 0x62441, 0x63761, 0, 0, 0,
+		0x41EC1, 0x41EE1, 0x41F01, 0x41F21, 0x41F41, 0x41F61, 0x41F81, 0x41FA1, 0x619C2, 0x41FC1, 0x42001, 0x42021, 0x42041, 0x42061, 0x42081, 0x420A1,
+		0x420C1, 0x420E1, 0x42101, 0x42121, 0x42141, 0x42161, 0x42181, 0x421A1, 0x421C1, 0x421E1, 0x42201, 0x42221, 0x42241, 0x42261, 0x42281, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x41621, 0x41641, 0x41661, 0x41681, 0x416A1, 0x416C1, 0x416E1, 0x41701, 0x3E6E1, 0x3E701, 0x3E721, 0x41721, 0x41741, 0x3E7E1, 0x3E801, 0x3E821,
+		0x3E841, 0x41761, 0x3E861, 0x3E881, 0x3E8A1, 0x3E741, 0x41781, 0x417A1, 0x417C1, 0x417E1, 0x3E761, 0x3E781, 0x3E5C1, 0x3E5E1, 0x3E601, 0x3E621,
+		0x3DEC1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x4FC43, 0x501C3, 0x51561, 0x514A2, 0x9901, 0x51E23, 0x51F63, 0x54AE1, 0x9921, 0x54F82, 0x55861, 0x568E1, 0x56901, 0x56921, 0x56681, 0x56E22,
+		0x578E1, 0x57901, 0x5A401, 0x5A161, 0x9941, 0x5C701, 0x5D0A2, 0x9961, 0x9981, 0x5FC21, 0x60901, 0x60FA1, 0x60FC1, 0x60FE1, 0x99A1, 0x99C1,
+		0x62D22, 0x63C63, 0x63D82, 0x99E1, 0x68C61, 0x9A01, 0x71201, 0x9A21, 0x68C81, 0x9A41, 0x593E1, 0x4F182, 0x50B41, 0x51581, 0x9A61, 0x53441,
+		0x536C1, 0x55021, 0x55761, 0x5B721, 0x5D541, 0x7A0A1, 0x7A201, 0x7A2C1, 0x7A341, 0x57621, 0x9A81, 0x55363, 0x6F501, 0x6B321, 0x6B401, 0x6F681,
+		0x9AA1, 0x9AC1, 0x9AE1, 0x9B01, 0x9B21, 0x52501, 0x52261, 0x53461, 0x57641, 0x58A21, 0x9B41, 0x9B61, 0x9B81, 0x50223, 0x55741, 0x9BA1,
+		0x45243, 0x457C3, 0x44024, 0x44743, 0x46DE3, 0x44CC3, 0x470C3, 0x487C3, 0x49A23, 0x44F83, 0x4AB43, 0x45503, 0x488E3, 0x4ABA3, 0x4C703, 0x43AA2,
+		0x57861, 0x58422, 0x584C3, 0x58662, 0x66121, 0x66562, 0x66603, 0x666E4, 0x586E2, 0x677C1, 0x67BE2, 0x67C83, 0x5A381, 0x514E1, 0x52481, 0x5B6A1,
+		0x575A1, 0x583E2, 0x58463, 0x58622, 0x65EA1, 0x66522, 0x665A3, 0x66664, 0x586A2, 0x67541, 0x67BA2, 0x67C23, 0x5A0E1, 0x51221, 0x521E1, 0x5B421,
+		0x9BC1, 0x9BE1, 0x9C01, 0x52121, 0x52101, 0x4B101, 0x9C21, 0x9C41, 0x9C61, 0x42BC3, 0x9C81, 0x9CA1, 0, 0, 0, 0,
+		0x9CC1, 0x9CE1, 0x9D01, 0x9D21, 0x9D41, 0x9D61, 0x9D81, 0x9DA1, 0x9DC1, 0x9DE1, 0x9E02, 0x9E42, 0x9E81, 0x9EA1, 0x9EC1, 0x9EE1,
+		0x9F01, 0x9F21, 0x9F41, 0x9F61, 0x9F81, 0x9FA1, 0x9FC1, 0x9FE1, 0xA001, 0xA021, 0xA041, 0xA061, 0xA081, 0xA0A1, 0xA0C2, 0xA101,
+		0xA121, 0xA141, 0xA161, 0xA181, 0xA1A1, 0xA1C1, 0xA1E1, 0xA201, 0xA221, 0xA241, 0xA261, 0xA281, 0xA2A1, 0xA2C1, 0xA2E1, 0xA301,
+		0xA321, 0xA341, 0xA361, 0xA381, 0xA3A1, 0xA3C1, 0xA3E1, 0xA401, 0xA421, 0xA441, 0xA461, 0xA481, 0xA4A1, 0xA4C2, 0xA502, 0xA542,
+		0xA581, 0xA5A1, 0xA5C1, 0xA5E1, 0xA601, 0xA621, 0xA641, 0xA661, 0xA681, 0xA6A1, 0xA6C1, 0xA6E1, 0xA701, 0xA721, 0xA741, 0xA761,
+		0xA781, 0xA7A1, 0xA7C1, 0xA7E1, 0xA801, 0xA821, 0xA841, 0xA861, 0xA881, 0xA8A1, 0xA8C1, 0xA8E1, 0xA901, 0xA921, 0xA941, 0xA961,
+		0xA981, 0xA9A1, 0xA9C1, 0xA9E1, 0xAA01, 0xAA21, 0xAA41, 0xAA61, 0xAA81, 0xAAA1, 0xAAC1, 0xAAE1, 0xAB01, 0xAB21, 0xAB41, 0xAB61,
+		0xAB81, 0xABA1, 0xABC1, 0xABE1, 0xAC02, 0xAC41, 0xAC61, 0xAC81, 0xACA1, 0xACC2, 0xAD01, 0xAD21, 0xAD42, 0xAD81, 0xADA1, 0xADC1,
+		0xADE1, 0xAE01, 0xAE21, 0xAE41, 0xAE61, 0xAE81, 0xAEA1, 0xAEC1, 0xAEE1, 0xAF01, 0xAF21, 0xAF41, 0xAF61, 0xAF81, 0xAFA1, 0xAFC1,
+		0xAFE1, 0xB001, 0xB021, 0xB041, 0xB062, 0xB0A1, 0xB0C2, 0xB101, 0xB121, 0xB141, 0xB161, 0xB181, 0xB1A2, 0xB1E3, 0xB241, 0xB262,
+		0xB2A3, 0xB301, 0xB321, 0xB341, 0xB361, 0xB381, 0xB3A1, 0xB3C1, 0xB3E1, 0xB401, 0xB421, 0xB441, 0xB461, 0xB481, 0xB4A1, 0xB4C1,
+		0xB4E1, 0xB502, 0xB541, 0xB561, 0xB582, 0xB5C1, 0xB5E1, 0xB602, 0xB641, 0xB662, 0xB6A1, 0xB6C1, 0xB6E1, 0xB701, 0xB721, 0xB741,
+		0xB761, 0xB781, 0xB7A1, 0xB7C1, 0xB7E1, 0xB801, 0xB821, 0xB841, 0xB861, 0xB881, 0xB8A1, 0xB8C1, 0xB8E1, 0xB901, 0xB921, 0xB941,
+		0xB962, 0xB9A1, 0xB9C2, 0xBA01, 0xBA21, 0xBA41, 0xBA61, 0xBA81, 0xBAA1, 0xBAC1, 0xBAE1, 0xBB01, 0xBB21, 0xBB42, 0xBB82, 0xBBC2,
+		0xBC02, 0xBC42, 0xBC81, 0xBCA1, 0xBCC2, 0xBD02, 0xBD41, 0xBD61, 0xBD82, 0xBDC2, 0xBE01, 0xBE21, 0xBE41, 0xBE61, 0xBE81, 0xBEA1,
+		0xBEC2, 0xBF02, 0xBF41, 0xBF61, 0xBF82, 0xBFC2, 0xC001, 0xC021, 0xC042, 0xC082, 0xC0C1, 0xC0E1, 0xC101, 0xC121, 0xC141, 0xC161,
+		0xC181, 0xC1A1, 0xC1C1, 0xC1E1, 0xC201, 0xC221, 0xC241, 0xC261, 0xC281, 0xC2A1, 0xC2C1, 0xC2E1, 0xC301, 0xC321, 0xC341, 0xC361,
+		0xC381, 0xC3A1, 0xC3C1, 0xC3E1, 0xC401, 0xC421, 0xC441, 0xC461, 0xC481, 0xC4A1, 0xC4C1, 0xC4E1, 0xC502, 0xC542, 0xC582, 0xC5C2,
+		0xC601, 0xC621, 0xC641, 0xC661, 0xC681, 0xC6A1, 0xC6C1, 0xC6E1, 0xC701, 0xC721, 0xC741, 0xC761, 0xC781, 0xC7A1, 0xC7C1, 0xC7E1,
+		0xC801, 0xC821, 0xC841, 0xC861, 0xC881, 0xC8A1, 0xC8C1, 0xC8E1, 0xC901, 0xC921, 0xC941, 0xC961, 0xC981, 0xC9A1, 0xC9C1, 0xC9E1,
+		0xCA01, 0xCA21, 0xCA41, 0xCA61, 0xCA81, 0xCAA1, 0xCAC1, 0xCAE1, 0xCB01, 0xCB21, 0xCB41, 0xCB61, 0xCB81, 0xCBA1, 0xCBC1, 0xCBE1,
+		0xCC02, 0xCC42, 0xCC82, 0xCCC2, 0xCD01, 0xCD21, 0xCD41, 0xCD61, 0xCD81, 0xCDA1, 0xCDC2, 0xCE02, 0xCE42, 0xCE82, 0xCEC1, 0xCEE1,
+		0xCF01, 0xCF21, 0xCF41, 0xCF61, 0xCF81, 0xCFA1, 0xCFC1, 0xCFE1, 0xD001, 0xD021, 0xD041, 0xD061, 0xD081, 0xD0A1, 0xD0C1, 0xD0E1,
+		0xD101, 0xD121, 0xD141, 0xD161, 0xD181, 0xD1A1, 0xD1C1, 0xD1E1, 0xD201, 0xD221, 0xD241, 0xD261, 0xD281, 0xD2A1, 0xD2C1, 0xD2E1,
+		0xD301, 0xD321, 0xD341, 0xD361, 0xD381, 0xD3A1, 0xD3C1, 0xD3E1, 0xD401, 0xD421, 0xD441, 0xD461, 0xD481, 0xD4A1, 0xD4C1, 0xD4E1,
+		0xD501, 0xD521, 0xD541, 0xD561, 0xD581, 0xD5A1, 0xD5C1, 0xD5E1, 0xD601, 0xD621, 0xD641, 0xD661, 0xD681, 0xD6A1, 0xD6C1, 0xD6E1,
+		0xD701, 0xD721, 0xD741, 0xD761, 0xD781, 0xD7A1, 0xD7C1, 0xD7E1, 0xD801, 0xD821, 0xD841, 0xD861, 0xD881, 0xD8A1, 0xD8C1, 0xD8E1,
+		0xD901, 0xD921, 0xD941, 0xD961, 0xD981, 0xD9A1, 0xD9C1, 0xD9E1, 0xDA01, 0xDA21, 0xDA41, 0xDA61, 0xDA81, 0xDAA1, 0xDAC1, 0xDAE1,
+		0xDB01, 0xDB21, 0xDB41, 0xDB61, 0xDB81, 0xDBA1, 0xDBC1, 0xDBE1, 0xDC01, 0xDC21, 0xDC41, 0xDC61, 0xDC81, 0xDCA1, 0xDCC1, 0xDCE1,
+		0xDD01, 0xDD21, 0xDD41, 0xDD61, 0xDD81, 0xDDA1, 0xDDC1, 0xDDE1, 0xDE01, 0xDE21, 0xDE41, 0xDE61, 0xDE81, 0xDEA1, 0xDEC1, 0xDEE1,
+		0xDF01, 0xDF21, 0xDF41, 0xDF61, 0xDF81, 0xDFA1, 0xDFC1, 0xDFE1, 0xE001, 0xE021, 0xE041, 0xE061, 0xE081, 0xE0A1, 0xE0C1, 0xE0E1,
+		0xE101, 0xE121, 0xE141, 0xE161, 0xE181, 0xE1A1, 0xE1C1, 0xE1E1, 0xE201, 0xE221, 0xE241, 0xE261, 0xE281, 0xE2A1, 0xE2C1, 0xE2E1,
+		0xE301, 0xE321, 0xE341, 0xE361, 0xE381, 0xE3A1, 0xE3C1, 0xE3E1, 0xE401, 0xE421, 0xE441, 0xE461, 0xE481, 0xE4A1, 0xE4C1, 0xE4E1,
+		0xE501, 0xE521, 0xE541, 0xE561, 0xE581, 0xE5A1, 0xE5C1, 0xE5E1, 0xE601, 0xE621, 0xE641, 0xE661, 0xE681, 0xE6A1, 0xE6C1, 0xE6E1,
+		0xE701, 0xE721, 0xE741, 0xE761, 0xE781, 0xE7A1, 0xE7C1, 0xE7E1, 0xE801, 0xE821, 0xE841, 0xE861, 0xE881, 0xE8A1, 0xE8C1, 0xE8E1,
+		0xE901, 0xE921, 0xE941, 0xE961, 0xE981, 0xE9A1, 0xE9C1, 0xE9E1, 0xEA01, 0xEA21, 0xEA41, 0xEA61, 0xEA81, 0xEAA1, 0xEAC1, 0xEAE1,
+		0xEB01, 0xEB21, 0xEB41, 0xEB61, 0xEB81, 0xEBA1, 0xEBC1, 0xEBE1, 0xEC01, 0xEC21, 0xEC41, 0xEC61, 0xEC81, 0xECA1, 0xECC1, 0xECE1,
+		0xED01, 0xED21, 0xED41, 0xED61, 0xED81, 0xEDA1, 0xEDC1, 0xEDE1, 0xEE01, 0xEE21, 0xEE41, 0xEE61, 0xEE81, 0xEEA1, 0xEEC1, 0xEEE1,
+		0xEF01, 0xEF21, 0xEF41, 0xEF61, 0xEF81, 0xEFA1, 0xEFC1, 0xEFE1, 0xF001, 0xF021, 0xF041, 0xF061, 0xF081, 0xF0A1, 0xF0C1, 0,
+		0xF0E1, 0xF101, 0xF121, 0xF141, 0xF161, 0xF181, 0xF1A1, 0xF1C1, 0xF1E1, 0xF201, 0xF221, 0xF241, 0xF261, 0xF281, 0xF2A1, 0xF2C1,
+		0xF2E1, 0xF301, 0xF321, 0xF341, 0xF361, 0xF381, 0xF3A1, 0xF3C1, 0xF3E1, 0xF401, 0xF421, 0xF441, 0xF461, 0xF481, 0xF4A1, 0xF4C1,
+		0xF4E1, 0xF501, 0xF521, 0xF541, 0xF561, 0xF581, 0xF5A1, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xF5C1, 0xF5E1, 0xF601, 0xF621, 0xF641, 0xF661, 0xF681, 0xF6A1, 0xF6C1, 0xF6E1, 0xF701, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x439C1, 0x46721, 0x483C1, 0x49781, 0x4A981, 0x4B801, 0x4C581, 0x4D341, 0x4E0C1, 0x43BC2, 0x43FA2, 0x44302, 0x446C2, 0x44982, 0x44C42, 0x44F02,
+		0x451C2, 0x45482, 0x45742, 0x468E2, 0x43843, 0x465A3, 0x48243, 0x49603, 0x4A803, 0x4B683, 0x4C403, 0x4D1C3, 0x4DF43, 0x43AE4, 0x43EC4, 0x44224,
+		0x445E4, 0x448A4, 0x44B64, 0x44E24, 0x450E4, 0x453A4, 0x45664, 0x46804, 0x438A2, 0x46602, 0x482A2, 0x49662, 0x4A862, 0x4B6E2, 0x4C462, 0x4D222,
+		0x4DFA2, 0x43B63, 0x43F43, 0x442A3, 0x44663, 0x44923, 0x44BE3, 0x44EA3, 0x45163, 0x45423, 0x456E3, 0x46883, 0x4E323, 0x50883, 0x51243, 0x52203,
+		0x533E3, 0x54D23, 0x55803, 0x56623, 0x575C3, 0x589C3, 0x591A3, 0x5A103, 0x5B443, 0x5C443, 0x5D4E3, 0x5F963, 0x60643, 0x60CE3, 0x61F23, 0x632A3,
+		0x64163, 0x65EC3, 0x66B03, 0x67563, 0x67E43, 0x689A3, 0x4E781, 0x50CE1, 0x51701, 0x526C1, 0x53861, 0x551C1, 0x55C61, 0x56A81, 0x57A81, 0x58E41,
+		0x59621, 0x5A5A1, 0x5B8C1, 0x5C8A1, 0x5D941, 0x5FDC1, 0x60AA1, 0x61141, 0x623E1, 0x63701, 0x645C1, 0x66341, 0x66F61, 0x679E1, 0x682A1, 0x68E01,
+		0x4E521, 0x50A81, 0x51441, 0x52421, 0x53601, 0x54F21, 0x55A01, 0x56821, 0x57801, 0x58BE1, 0x593A1, 0x5A321, 0x5B641, 0x5C641, 0x5D6E1, 0x5FB61,
+		0x60841, 0x60EE1, 0x62121, 0x634A1, 0x64361, 0x660C1, 0x66D01, 0x67761, 0x68041, 0x68BA1, 0x42B01, 0x43FE2, 0x44342, 0x44702, 0x449C2, 0x44C82,
+		0x44F42, 0x45202, 0x454C2, 0x45782, 0x46922, 0x439E1, 0x46741, 0x483E1, 0x497A1, 0x4A9A1, 0x4B821, 0x4C5A1, 0x4D361, 0x4E0E1, 0x43C02, 0x42B21,
+		0xF721, 0xF741, 0xF761, 0xF781, 0xF7A1, 0xF7C1, 0xF7E1, 0xF801, 0xF821, 0xF841, 0xF861, 0xF881, 0xF8A1, 0xF8C1, 0xF8E1, 0xF901,
+		0xF921, 0xF941, 0xF961, 0xF981, 0xF9A1, 0xF9C1, 0xF9E1, 0xFA01, 0xFA21, 0xFA41, 0xFA61, 0xFA81, 0xFAA1, 0xFAC1, 0xFAE1, 0xFB01,
+		0xFB21, 0xFB41, 0xFB61, 0xFB81, 0xFBA1, 0xFBC1, 0xFBE1, 0xFC01, 0xFC21, 0xFC41, 0xFC61, 0xFC81, 0xFCA1, 0xFCC1, 0xFCE1, 0xFD01,
+		0xFD21, 0xFD41, 0xFD61, 0xFD81, 0xFDA1, 0xFDC1, 0xFDE1, 0xFE01, 0xFE21, 0xFE41, 0xFE61, 0xFE81, 0xFEA1, 0xFEC1, 0xFEE1, 0xFF01,
+		0xFF21, 0xFF41, 0xFF61, 0xFF81, 0xFFA1, 0xFFC1, 0xFFE1, 0x10001, 0x10021, 0x10041, 0x10061, 0x10081, 0x100A1, 0x100C1, 0x100E1, 0x10101,
+		0x10121, 0x10141, 0x10161, 0x10181, 0x101A1, 0x101C1, 0x101E1, 0x10201, 0x10221, 0x10241, 0x10261, 0x10281, 0x102A1, 0x102C1, 0x102E1, 0x10301,
+		0x10321, 0x10341, 0x10361, 0x10381, 0x103A1, 0x103C1, 0x103E1, 0x10401, 0x10421, 0x10441, 0x10461, 0x10481, 0x104A1, 0x104C1, 0x104E1, 0x10501,
+		0x10521, 0x10541, 0x10561, 0x10581, 0x105A1, 0x105C1, 0x105E1, 0x10601, 0x10621, 0x10641, 0x10661, 0x10681, 0x106A1, 0x106C1, 0x106E1, 0x10701,
+		0x10721, 0x10741, 0x10761, 0x10781, 0x107A1, 0x107C1, 0x107E1, 0x10801, 0x10821, 0x10841, 0x10861, 0x10881, 0x108A1, 0x108C1, 0x108E1, 0x10901,
+		0x10921, 0x10941, 0x10961, 0x10981, 0x109A1, 0x109C1, 0x109E1, 0x10A01, 0x10A21, 0x10A41, 0x10A61, 0x10A81, 0x10AA1, 0x10AC1, 0x10AE1, 0x10B01,
+		0x10B21, 0x10B41, 0x10B61, 0x10B81, 0x10BA1, 0x10BC1, 0x10BE1, 0x10C01, 0x10C21, 0x10C41, 0x10C61, 0x10C81, 0x10CA1, 0x10CC1, 0x10CE1, 0x10D01,
+		0x10D21, 0x10D41, 0x10D61, 0x10D81, 0x10DA1, 0x10DC1, 0x10DE1, 0x10E01, 0x10E21, 0x10E41, 0x10E61, 0x10E81, 0x10EA1, 0x10EC1, 0x10EE1, 0x10F01,
+		0x10F21, 0x10F41, 0x10F61, 0x10F81, 0x10FA1, 0x10FC1, 0x10FE1, 0x11001, 0x11021, 0x11041, 0x11061, 0x11081, 0x110A1, 0x110C1, 0x110E1, 0x11101,
+		0x11121, 0x11141, 0x11161, 0x11181, 0x111A1, 0x111C1, 0x111E1, 0x11201, 0x11221, 0x11241, 0x11261, 0x11281, 0x112A1, 0x112C1, 0x112E1, 0x11301,
+		0x11321, 0x11341, 0x11361, 0x11381, 0x113A1, 0x113C1, 0x113E1, 0x11401, 0x11421, 0x11441, 0x11461, 0x11481, 0x114A1, 0x114C1, 0x114E1, 0x11501,
+		0x11521, 0x11541, 0x11561, 0x11581, 0x115A1, 0x115C1, 0x115E1, 0x11601, 0x11621, 0x11641, 0x11661, 0x11681, 0x116A1, 0x116C1, 0x116E1, 0x11701,
+		0x11721, 0x11741, 0x11761, 0x11781, 0x117A1, 0x117C1, 0x117E1, 0x11801, 0x11821, 0x11841, 0x11861, 0x11881, 0x118A1, 0x118C1, 0x118E1, 0x11901,
+		0x11921, 0x11941, 0x11961, 0x11981, 0x119A1, 0x119C1, 0x119E1, 0x11A01, 0x11A21, 0x11A41, 0x11A61, 0x11A81, 0x11AA1, 0x11AC1, 0x11AE1, 0x11B01,
+		0x11B21, 0x11B41, 0x11B61, 0x11B81, 0x11BA1, 0x11BC1, 0x11BE1, 0x11C01, 0x11C21, 0x11C41, 0x11C61, 0x11C81, 0x11CA1, 0x11CC1, 0x11CE1, 0x11D01,
+		0x11D21, 0x11D41, 0x11D61, 0x11D81, 0x11DA1, 0x11DC1, 0x11DE1, 0x11E01, 0x11E21, 0x11E41, 0x11E61, 0x11E81, 0x11EA1, 0x11EC1, 0x11EE1, 0x11F01,
+		0x11F21, 0x11F41, 0x11F61, 0x11F81, 0x11FA1, 0x11FC1, 0x11FE1, 0x12001, 0x12021, 0x12041, 0x12061, 0x12081, 0x120A1, 0x120C1, 0x120E1, 0x12101,
+		0x12121, 0x12141, 0x12161, 0x12181, 0x121A1, 0x121C1, 0x121E1, 0x12201, 0x12221, 0x12241, 0x12261, 0x12281, 0x122A1, 0x122C1, 0x122E1, 0x12301,
+		0x12321, 0x12341, 0x12361, 0x12381, 0x123A1, 0x123C1, 0x123E1, 0x12401, 0x12421, 0x12441, 0x12461, 0x12481, 0x124A1, 0x124C1, 0x124E1, 0x12501,
+		0x12521, 0x12541, 0x12561, 0x12581, 0x125A1, 0x125C1, 0x125E1, 0x12601, 0x12621, 0x12641, 0x12661, 0x12681, 0x126A1, 0x126C1, 0x126E1, 0x12701,
+		0x12721, 0x12741, 0x12761, 0x12781, 0x127A1, 0x127C1, 0x127E1, 0x12801, 0x12821, 0x12841, 0x12861, 0x12881, 0x128A1, 0x128C1, 0x128E1, 0x12901,
+		0x12921, 0x12941, 0x12961, 0x12981, 0x129A1, 0x129C1, 0x129E1, 0x12A01, 0x12A21, 0x12A41, 0x12A61, 0x12A81, 0x12AA1, 0x12AC1, 0x12AE1, 0x12B01,
+		0x12B21, 0x12B41, 0x12B61, 0x12B81, 0x12BA1, 0x12BC1, 0x12BE1, 0x12C01, 0x12C21, 0x12C41, 0x12C61, 0x12C81, 0x12CA1, 0x12CC1, 0x12CE1, 0x12D01,
+		0x12D21, 0x12D41, 0x12D61, 0x12D81, 0x12DA1, 0x12DC1, 0x12DE1, 0x12E01, 0x12E21, 0x12E41, 0x12E61, 0x12E81, 0x12EA1, 0x12EC1, 0x12EE1, 0x12F01,
+		0x12F21, 0x12F41, 0x12F61, 0x12F81, 0x12FA1, 0x12FC1, 0x12FE1, 0x13001, 0x13021, 0x13041, 0x13061, 0x13081, 0x130A1, 0x130C1, 0x130E1, 0x13101,
+		0x13121, 0x13141, 0x13161, 0x13181, 0x131A1, 0x131C1, 0x131E1, 0x13201, 0x13221, 0x13241, 0x13261, 0x13281, 0x132A1, 0x132C1, 0x132E1, 0x13301,
+		0x13321, 0x13341, 0x13361, 0x13381, 0x133A1, 0x133C1, 0x133E1, 0x13401, 0x13421, 0x13441, 0x13461, 0x13481, 0x134A1, 0x134C1, 0x134E1, 0x13501,
+		0x13521, 0x13541, 0x13561, 0x13581, 0x135A1, 0x135C1, 0x135E1, 0x13601, 0x13621, 0x13641, 0x13661, 0x13681, 0x136A1, 0x136C1, 0x136E1, 0x13701,
+		0x13721, 0x13741, 0x13761, 0x13781, 0x137A1, 0x137C1, 0x137E1, 0x13801, 0x13821, 0x13841, 0x13861, 0x13881, 0x138A1, 0x138C1, 0x138E1, 0x13901,
+		0x13921, 0x13941, 0x13961, 0x13981, 0x139A1, 0x139C1, 0x139E1, 0x13A01, 0x13A21, 0x13A41, 0x13A61, 0x13A81, 0x13AA1, 0x13AC1, 0x13AE1, 0x13B01,
+		0x13B21, 0x13B41, 0x13B61, 0x13B81, 0x13BA1, 0x13BC1, 0x13BE1, 0x13C01, 0x13C21, 0x13C41, 0x13C61, 0x13C81, 0x13CA1, 0x13CC1, 0x13CE1, 0x13D01,
+		0x13D21, 0x13D41, 0x13D61, 0x13D81, 0x13DA1, 0x13DC1, 0x13DE1, 0x13E01, 0x13E21, 0x13E41, 0x13E61, 0x13E81, 0x13EA1, 0x13EC1, 0x13EE1, 0x13F01,
+		0x13F21, 0x13F41, 0x13F61, 0x13F81, 0x13FA1, 0x13FC1, 0x13FE1, 0x14001, 0x14021, 0x14041, 0x14061, 0x14081, 0x140A1, 0x140C1, 0x140E1, 0x14101,
+		0x14121, 0x14141, 0x14161, 0x14181, 0x141A1, 0x141C1, 0x141E1, 0x14201, 0x14221, 0x14241, 0x14261, 0x14281, 0x142A1, 0x142C1, 0x142E1, 0x14301,
+		0x14321, 0x14341, 0x14361, 0x14381, 0x143A1, 0x143C1, 0x143E1, 0x14401, 0x14421, 0x14441, 0x14461, 0x14481, 0x144A1, 0x144C1, 0x144E1, 0x14501,
+		0x14521, 0x14541, 0x14561, 0x14581, 0x145A1, 0x145C1, 0x43A01, 0x46761, 0x48401, 0x497C1, 0x4A9C1, 0x4B841, 0x4C5C1, 0x4D381, 0x4E101, 0x43C42,
+		0x43A21, 0x46781, 0x48421, 0x497E1, 0x4A9E1, 0x4B861, 0x4C5E1, 0x4D3A1, 0x4E121, 0x43C82, 0x43A41, 0x467A1, 0x48441, 0x49801, 0x4AA01, 0x4B881,
+		0x4C601, 0x4D3C1, 0x4E141, 0x43CC2, 0x145E1, 0x14601, 0x14621, 0x14641, 0x14661, 0x14681, 0x146A1, 0x146C1, 0x146E1, 0x14701, 0x14721, 0x14741,
+		0x14761, 0x14781, 0x147A1, 0x147C1, 0x147E1, 0x14801, 0x14821, 0x14841, 0x14861, 0x14881, 0x148A1, 0x148C1, 0x148E1, 0x14901, 0x14921, 0x14941,
+		0x14961, 0x14981, 0x149A1, 0x149C1, 0x149E1, 0x14A01, 0x14A21, 0x14A41, 0x14A61, 0x14A81, 0x14AA1, 0x14AC1, 0x14AE1, 0x14B01, 0x14B21, 0x14B41,
+		0x14B61, 0x14B81, 0x14BA1, 0x14BC1, 0x14BE1, 0x14C01, 0x14C21, 0x14C41, 0x14C61, 0x14C81, 0x14CA1, 0x14CC1, 0x14CE1, 0x14D01, 0x14D21, 0x14D41,
+		0x14D61, 0x14D81, 0x14DA1, 0x14DC1, 0x14DE1, 0x14E01, 0x14E21, 0x14E41, 0x14E61, 0x14E81, 0x14EA1, 0x14EC1, 0x14EE1, 0x14F01, 0x14F21, 0x14F41,
+		0x14F61, 0x14F81, 0x14FA1, 0x14FC1, 0x14FE1, 0x15001, 0x15021, 0x15041, 0x15061, 0x15081, 0x150A1, 0x150C1, 0x150E1, 0x15101, 0x15121, 0x15141,
+		0x15161, 0x15181, 0x151A1, 0x151C1, 0x151E1, 0x15201, 0x15221, 0x15241, 0x15261, 0x15281, 0x152A1, 0x152C1, 0x152E1, 0x15301, 0x15321, 0x15341,
+		0x15361, 0x15381, 0x153A1, 0x153C1, 0x153E1, 0x15401, 0x15421, 0x15441, 0x15461, 0x15481, 0x154A1, 0x154C1, 0x154E1, 0x15501, 0x15521, 0x15541,
+		0x15561, 0x15581, 0x155A1, 0x155C1, 0x155E1, 0x15601, 0x15621, 0x15641, 0x15661, 0x15681, 0x156A1, 0x156C1, 0x156E1, 0x15701, 0x15721, 0x15741,
+		0x15761, 0x15781, 0x157A1, 0x157C1, 0x157E1, 0x15801, 0x15821, 0x15841, 0x15861, 0x15881, 0x158A1, 0x158C1, 0x158E1, 0x15901, 0x15921, 0x15941,
+		0x15961, 0x15981, 0x159A1, 0x159C1, 0x159E1, 0x15A01, 0x15A21, 0x15A41, 0x15A61, 0x15A81, 0x15AA1, 0x15AC1, 0x15AE1, 0x15B01, 0x15B21, 0x15B41,
+		0x15B61, 0x15B81, 0x15BA1, 0x15BC1, 0x15BE1, 0x15C01, 0x15C21, 0x15C41, 0x15C61, 0x15C81, 0x15CA1, 0x15CC1, 0x15CE1, 0x15D01, 0x15D21, 0x15D41,
+		0x15D61, 0x15D81, 0x15DA1, 0x15DC1, 0x15DE1, 0x15E01, 0x15E21, 0x15E41, 0x15E61, 0x15E81, 0x15EA1, 0x15EC1, 0x15EE1, 0x15F01, 0x15F21, 0x15F41,
+		0x15F61, 0x15F81, 0x15FA1, 0x15FC1, 0x15FE1, 0x16001, 0x16021, 0x16041, 0x16061, 0x16081, 0x160A1, 0x160C1, 0x160E1, 0x16101, 0x16121, 0x16141,
+		0x16161, 0x16181, 0x161A1, 0x161C1, 0x161E1, 0x16201, 0x16221, 0x16241, 0x16261, 0x16281, 0x162A1, 0x162C1, 0x162E1, 0x16301, 0x16321, 0x16341,
+		0x16361, 0x16381, 0x163A1, 0x163C1, 0x163E1, 0x16401, 0x16421, 0x16441, 0x16461, 0x16481, 0x164A1, 0x164C1, 0x164E1, 0x16501, 0x16521, 0x16541,
+		0x16561, 0x16581, 0x165A1, 0x165C1, 0x165E1, 0x16601, 0x16621, 0x16641, 0x16661, 0x16681, 0x166A1, 0x166C1, 0x166E1, 0x16701, 0x16721, 0x16741,
+		0x16761, 0x16781, 0x167A1, 0x167C1, 0x167E1, 0x16801, 0x16821, 0x16841, 0x16861, 0x16881, 0x168A1, 0x168C1, 0x168E1, 0x16901, 0x16921, 0x16941,
+		0x16961, 0x16981, 0x169A1, 0x169C1, 0x169E1, 0x16A01, 0x16A21, 0x16A41, 0x16A61, 0x16A81, 0x16AA1, 0x16AC1, 0x16AE1, 0x16B01, 0x16B21, 0x16B41,
+		0x16B61, 0x16B81, 0x16BA1, 0x16BC1, 0x16BE1, 0x16C01, 0x16C21, 0x16C41, 0x16C61, 0x16C81, 0x16CA1, 0x16CC1, 0x16CE1, 0x16D01, 0x16D21, 0x16D41,
+		0x16D61, 0x16D81, 0x16DA1, 0x16DC1, 0x16DE1, 0x16E01, 0x16E21, 0x16E41, 0x16E61, 0x16E81, 0x16EA1, 0x16EC1, 0x16EE1, 0x16F01, 0x16F21, 0x16F41,
+		0x16F61, 0x16F81, 0x16FA1, 0x16FC1, 0x16FE1, 0x17001, 0x17021, 0x17041, 0x17061, 0x17081, 0x170A1, 0x170C1, 0x170E1, 0x17101, 0x17121, 0x17141,
+		0x17161, 0x17181, 0x171A1, 0x171C1, 0x171E1, 0x17201, 0x17221, 0x17241, 0x17261, 0x17281, 0x172A1, 0x172C1, 0x172E1, 0x17301, 0x17321, 0x17341,
+		0x17361, 0x17381, 0x173A1, 0x173C1, 0x173E1, 0x17401, 0x17421, 0x17441, 0x17461, 0x17481, 0x174A1, 0x174C1, 0x174E1, 0x17501, 0x17521, 0x17541,
+		0x17561, 0x17581, 0x175A1, 0x175C1, 0x175E1, 0x17601, 0x17621, 0x17641, 0x17661, 0x17681, 0x176A1, 0x176C1, 0x176E1, 0x17701, 0x17721, 0x17741,
+		0x17761, 0x17781, 0x177A1, 0x177C1, 0x177E1, 0x17801, 0x17821, 0x17841, 0x17861, 0x17881, 0x178A1, 0x178C1, 0x178E1, 0x17901, 0x17921, 0x17941,
+		0x17961, 0x17981, 0x179A1, 0x179C1, 0x179E1, 0x17A01, 0x17A21, 0x17A41, 0x17A61, 0x17A81, 0x17AA1, 0x17AC1, 0x17AE1, 0x17B01, 0x17B21, 0x17B41,
+		0x17B61, 0x17B81, 0x17BA1, 0x17BC1, 0x17BE1, 0x17C01, 0x17C21, 0x17C41, 0x17C61, 0x17C81, 0x17CA1, 0x17CC1, 0x17CE1, 0x17D01, 0x17D21, 0x17D41,
+		0x17D61, 0x17D81, 0x17DA1, 0x17DC1, 0x17DE1, 0x17E01, 0x17E21, 0x17E41, 0x17E61, 0x17E81, 0x17EA1, 0x17EC1, 0x17EE1, 0x17F01, 0x17F21, 0x17F41,
+		0x17F61, 0x17F81, 0x17FA1, 0x17FC1, 0x17FE1, 0x18001, 0x18021, 0x18041, 0x18061, 0x18081, 0x180A1, 0x180C1, 0x180E1, 0x18101, 0x18121, 0x18141,
+		0x18161, 0x18181, 0x181A1, 0x181C1, 0x181E1, 0x18201, 0x18221, 0x18241, 0x18261, 0x18281, 0x182A1, 0x182C1, 0x182E1, 0x18301, 0x18321, 0x18341,
+		0x18361, 0x18381, 0x183A1, 0x183C1, 0x183E1, 0x18401, 0x18421, 0x18441, 0x18461, 0x18481, 0x184A1, 0x184C1, 0x184E1, 0x18501, 0x18521, 0x18541,
+		0x18561, 0x18581, 0x185A1, 0x185C1, 0x185E1, 0x18601, 0x18621, 0x18641, 0x18661, 0x18681, 0x186A1, 0x186C1, 0x186E1, 0x18701, 0x18721, 0x18741,
+		0x18761, 0x18781, 0x187A1, 0x187C1, 0x187E1, 0x18801, 0x18821, 0x18841, 0x18861, 0x18881, 0x188A1, 0x188C1, 0x188E1, 0x18901, 0x18921, 0x18941,
+		0x18961, 0x18981, 0x189A1, 0x189C1, 0x189E1, 0x18A01, 0x18A21, 0x18A41, 0x18A61, 0x18A81, 0x18AA1, 0x18AC1, 0x18AE1, 0x18B01, 0x18B21, 0x18B41,
+		0x18B61, 0x18B81, 0x18BA1, 0x18BC1, 0x18BE1, 0x18C01, 0x18C21, 0x18C41, 0x18C61, 0x18C81, 0x18CA1, 0x18CC1, 0x18CE1, 0x18D01, 0x18D21, 0x18D41,
+		0x18D61, 0x18D81, 0x18DA1, 0x18DC1, 0x18DE1, 0x18E01, 0x18E21, 0x18E41, 0x18E61, 0x18E81, 0x18EA1, 0x18EC1, 0x18EE1, 0x18F01, 0x18F21, 0x18F41,
+		0x18F61, 0x18F81, 0x18FA1, 0x18FC1, 0x18FE1, 0x19001, 0x19021, 0x19041, 0x19061, 0x19081, 0x190A1, 0x190C1, 0x190E1, 0x19101, 0x19121, 0x19141,
+		0x19161, 0x19181, 0x191A1, 0x191C1, 0x191E1, 0x19201, 0x19221, 0x19241, 0x19261, 0x19281, 0x192A1, 0x192C1, 0x192E1, 0x19301, 0x19321, 0x19341,
+		0x19361, 0x19381, 0x193A1, 0x193C1, 0x193E1, 0x19401, 0x19421, 0x19441, 0x19461, 0x19481, 0x194A1, 0x194C1, 0x194E4, 0x19561, 0x19581, 0x195A1,
+		0x195C1, 0x195E1, 0x19601, 0x19621, 0x19641, 0x19661, 0x19681, 0x196A1, 0x196C1, 0x196E1, 0x19701, 0x19721, 0x19741, 0x19761, 0x19781, 0x197A1,
+		0x197C1, 0x197E1, 0x19801, 0x19821, 0x19841, 0x19861, 0x19881, 0x198A1, 0x198C1, 0x198E1, 0x19901, 0x19921, 0x19941, 0x19961, 0x19981, 0x199A1,
+		0x199C1, 0x199E1, 0x19A01, 0x19A21, 0x19A41, 0x19A61, 0x19A81, 0x19AA1, 0x19AC1, 0x19AE1, 0x19B01, 0x19B21, 0x19B41, 0x19B61, 0x19B81, 0x19BA1,
+		0x19BC1, 0x19BE1, 0x19C01, 0x19C21, 0x19C41, 0x19C61, 0x19C81, 0x19CA1, 0x19CC1, 0x19CE1, 0x19D01, 0x19D21, 0x19D41, 0x19D61, 0x19D81, 0x19DA1,
+		0x19DC1, 0x19DE1, 0x19E01, 0x19E21, 0x19E41, 0x19E61, 0x19E81, 0x19EA1, 0x19EC1, 0x19EE1, 0x19F01, 0x19F21, 0x19F41, 0x19F61, 0x19F81, 0x19FA1,
+		0x19FC1, 0x19FE1, 0x1A001, 0x1A021, 0x1A041, 0x1A061, 0x1A081, 0x1A0A1, 0x1A0C1, 0x1A0E1, 0x1A101, 0x1A121, 0x1A141, 0x1A161, 0x1A181, 0x1A1A1,
+		0x1A1C1, 0x1A1E1, 0x1A201, 0x1A221, 0x1A243, 0x1A2A2, 0x1A2E3, 0x1A341, 0x1A361, 0x1A381, 0x1A3A1, 0x1A3C1, 0x1A3E1, 0x1A401, 0x1A421, 0x1A441,
+		0x1A461, 0x1A481, 0x1A4A1, 0x1A4C1, 0x1A4E1, 0x1A501, 0x1A521, 0x1A541, 0x1A561, 0x1A581, 0x1A5A1, 0x1A5C1, 0x1A5E1, 0x1A601, 0x1A621, 0x1A641,
+		0x1A661, 0x1A681, 0x1A6A1, 0x1A6C1, 0x1A6E1, 0x1A701, 0x1A721, 0x1A741, 0x1A761, 0x1A781, 0x1A7A1, 0x1A7C1, 0x1A7E1, 0x1A801, 0x1A821, 0x1A841,
+		0x1A861, 0x1A881, 0x1A8A1, 0x1A8C1, 0x1A8E1, 0x1A901, 0x1A921, 0x1A941, 0x1A961, 0x1A981, 0x1A9A1, 0x1A9C1, 0x1A9E1, 0x1AA01, 0x1AA21, 0x1AA41,
+		0x1AA61, 0x1AA81, 0x1AAA1, 0x1AAC1, 0x1AAE1, 0x1AB01, 0x1AB21, 0x1AB41, 0x1AB61, 0x1AB81, 0x1ABA1, 0x1ABC1, 0x1ABE1, 0x1AC01, 0x1AC21, 0x1AC41,
+		0x1AC61, 0x1AC81, 0x1ACA1, 0x1ACC1, 0x1ACE1, 0x1AD01, 0x1AD21, 0x1AD41, 0x1AD61, 0x1AD81, 0x1ADA1, 0x1ADC1, 0x1ADE1, 0x1AE01, 0x1AE21, 0x1AE41,
+		0x1AE61, 0x1AE81, 0x1AEA1, 0x1AEC1, 0x1AEE1, 0x1AF01, 0x1AF21, 0x1AF41, 0x1AF61, 0x1AF81, 0x1AFA1, 0x1AFC1, 0x1AFE2, 0x1B021, 0x1B041, 0x1B061,
+		0x1B081, 0x1B0A1, 0x1B0C1, 0x1B0E1, 0x1B101, 0x1B121, 0x1B141, 0x1B161, 0x1B181, 0x1B1A1, 0x1B1C1, 0x1B1E1, 0x1B201, 0x1B221, 0x1B241, 0x1B261,
+		0x1B281, 0x1B2A1, 0x1B2C1, 0x1B2E1, 0x1B301, 0x1B321, 0x1B341, 0x1B361, 0x1B381, 0x1B3A1, 0x1B3C1, 0x1B3E1, 0x1B401, 0x1B421, 0x1B441, 0x1B461,
+		0x1B481, 0x1B4A1, 0x1B4C1, 0x1B4E1, 0x1B501, 0x1B521, 0x1B541, 0x1B561, 0x1B581, 0x1B5A1, 0x1B5C1, 0x1B5E1, 0x1B601, 0x1B621, 0x1B641, 0x1B661,
+		0x1B681, 0x1B6A1, 0x1B6C1, 0x1B6E1, 0x1B701, 0x1B721, 0x1B741, 0x1B761, 0x1B781, 0x1B7A1, 0x1B7C1, 0x1B7E1, 0x1B801, 0x1B821, 0x1B841, 0x1B861,
+		0x1B881, 0x1B8A1, 0x1B8C1, 0x1B8E1, 0x1B901, 0x1B921, 0x1B941, 0x1B961, 0x1B981, 0x1B9A1, 0x1B9C1, 0x1B9E1, 0x1BA01, 0x1BA21, 0x1BA41, 0x1BA61,
+		0x1BA81, 0x1BAA1, 0x1BAC1, 0x1BAE1, 0x1BB01, 0x1BB21, 0x1BB41, 0x1BB61, 0x1BB81, 0x1BBA1, 0x1BBC1, 0x1BBE1, 0x1BC01, 0x1BC21, 0x1BC41, 0x1BC61,
+		0x1BC81, 0x1BCA1, 0x1BCC1, 0x1BCE1, 0x1BD01, 0x1BD21, 0x1BD41, 0x1BD61, 0x1BD81, 0x1BDA1, 0x1BDC1, 0x1BDE1, 0x1BE01, 0x1BE21, 0x1BE41, 0x1BE61,
+		0x1BE81, 0x1BEA1, 0x1BEC1, 0x1BEE1, 0x1BF01, 0x1BF21, 0x1BF41, 0x1BF61, 0x1BF81, 0x1BFA1, 0x1BFC1, 0x1BFE1, 0x1C001, 0x1C021, 0x1C041, 0x1C061,
+		0x1C081, 0x1C0A1, 0x1C0C1, 0x1C0E1, 0x1C101, 0x1C121, 0x1C141, 0x1C161, 0x1C181, 0x1C1A1, 0x1C1C1, 0x1C1E1, 0x1C201, 0x1C221, 0x1C241, 0x1C261,
+		0x1C281, 0x1C2A1, 0x1C2C1, 0x1C2E1, 0, 0, 0x1C301, 0x1C321, 0x1C341, 0x1C361, 0x1C381, 0x1C3A1, 0x1C3C1, 0x1C3E1, 0x1C401, 0x1C421,
+		0x1C441, 0x1C461, 0x1C481, 0x1C4A1, 0x1C4C1, 0x1C4E1, 0x1C501, 0x1C521, 0x1C541, 0x1C561, 0x1C581, 0x1C5A1, 0x1C5C1, 0x1C5E1, 0x1C601, 0x1C621,
+		0x1C641, 0x1C661, 0x1C681, 0x1C6A1, 0x1C6C1, 0x1C6E1, 0, 0, 0x1C701, 0x1C721, 0x1C741, 0x1C761, 0x1C781, 0x1C7A1, 0x1C7C1, 0x1C7E1,
+		0x1C801, 0x1C821, 0x1C841, 0x1C861, 0x1C881, 0x1C8A1, 0x1C8C1, 0x1C8E1, 0x1C901, 0x1C921, 0x1C941, 0x1C961, 0x1C981, 0x1C9A1, 0x1C9C1, 0x1C9E1,
+		0x1CA01, 0x1CA21, 0x1CA41, 0x1CA61, 0x1CA81, 0x1CAA1, 0x1CAC1, 0x1CAE1, 0x1CB01, 0x1CB21, 0, 0, 0, 0x1CB41, 0x1CB61, 0x1CB81,
+		0x1CBA1, 0x1CBC1, 0x1CBE1, 0x1CC01, 0x1CC21, 0x1CC41, 0x1CC61, 0x1CC81, 0x1CCA1, 0, 0x1CCC1, 0x1CCE1, 0x1CD01, 0x1CD21, 0x1CD41, 0x1CD61,
+		0x1CD81, 0x1CDA1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1CDC1, 0x1CDE1, 0x1CE01, 0x1CE21,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x76FE1, 0x77041, 0x770A1, 0x77101, 0x77161, 0x771C1, 0x77221, 0x77261, 0x772C1, 0x77321, 0x77381, 0x773E1, 0x77441, 0x774A1, 0x77501, 0x77561,
+		0x775C1, 0x77621, 0x77681, 0x776E1, 0x77741, 0x777A1, 0x77801, 0x77861, 0x778C1, 0x77901, 0x77941, 0x779A1, 0x77A01, 0x77A61, 0x77AC1, 0x77B21,
+		0x77B81, 0x77BE1, 0x77C21, 0x77C81, 0x77CE1, 0x77D21, 0x77D81, 0x77DE1, 0x77E41, 0x77EA1, 0x77F01, 0x77F41, 0x77F81, 0x77FC1, 0x78001, 0,
+		0x76FA1, 0x77001, 0x77061, 0x770C1, 0x77121, 0x77181, 0x771E1, 0x77241, 0x77281, 0x772E1, 0x77341, 0x773A1, 0x77401, 0x77461, 0x774C1, 0x77521,
+		0x77581, 0x775E1, 0x77641, 0x776A1, 0x77701, 0x77761, 0x777C1, 0x77821, 0x77881, 0x778E1, 0x77921, 0x77961, 0x779C1, 0x77A21, 0x77A81, 0x77AE1,
+		0x77B41, 0x77BA1, 0x77C01, 0x77C41, 0x77CA1, 0x77D01, 0x77D41, 0x77DA1, 0x77E01, 0x77E61, 0x77EC1, 0x77F21, 0x77F61, 0x77FA1, 0x77FE1, 0,
+		0x5B0E1, 0x5B0C1, 0x5B121, 0x60421, 0x61CC1, 0x505A1, 0x63F61, 0x57421, 0x57401, 0x59F61, 0x59F41, 0x693A1, 0x69381, 0x50721, 0x5C321, 0x506A1,
+		0x507C1, 0x669C1, 0x674A1, 0x67481, 0x669E1, 0x57461, 0x57441, 0x605E1, 0x54941, 0x61C61, 0x5F761, 0x549C1, 0x58EA1, 0x663C1, 0x630A1, 0x69361,
+		0x72421, 0x72401, 0x72461, 0x72441, 0x724A1, 0x72481, 0x724E1, 0x724C1, 0x72521, 0x72501, 0x725A1, 0x72581, 0x725E1, 0x725C1, 0x72621, 0x72601,
+		0x72661, 0x72641, 0x726A1, 0x72681, 0x726E1, 0x726C1, 0x727C1, 0x727A1, 0x72801, 0x727E1, 0x72841, 0x72821, 0x72901, 0x728E1, 0x72941, 0x72921,
+		0x72981, 0x72961, 0x729C1, 0x729A1, 0x72A01, 0x729E1, 0x72A41, 0x72A21, 0x72A81, 0x72A61, 0x72AC1, 0x72AA1, 0x72B01, 0x72AE1, 0x72B41, 0x72B21,
+		0x72B81, 0x72B61, 0x73241, 0x73221, 0x73281, 0x73261, 0x72561, 0x72541, 0x72781, 0x72761, 0x72881, 0x72861, 0x728C1, 0x728A1, 0x72BC1, 0x72BA1,
+		0x72C01, 0x72BE1, 0x72CC1, 0x72CA1, 0x72D01, 0x72CE1, 0x72D41, 0x72D21, 0x72E41, 0x72E21, 0x72EC1, 0x72EA1, 0x72F01, 0x72EE1, 0x72F41, 0x72F21,
+		0x72F81, 0x72F61, 0x72FC1, 0x72FA1, 0x73001, 0x72FE1, 0x730C1, 0x730A1, 0x73141, 0x73121, 0x73181, 0x73161, 0x731C1, 0x731A1, 0x732C1, 0x732A1,
+		0x73301, 0x732E1, 0x73341, 0x73321, 0x72703, 0x1CE41, 0x1CE61, 0x1CE81, 0x1CEA1, 0x1CEC1, 0x1CEE1, 0x72C81, 0x72C61, 0x73081, 0x73061, 0x3DEE1,
+		0x3D521, 0x3D4C1, 0x72E01, 0x72DE1, 0, 0, 0, 0, 0, 0x1CF01, 0x1CF21, 0x1CF41, 0x1CF61, 0x1CF81, 0x1CFA1, 0x1CFC1,
+		0x785A1, 0x78601, 0x78661, 0x786C1, 0x78721, 0x78781, 0x787E1, 0x788A1, 0x78901, 0x78961, 0x789C1, 0x78A21, 0x78AA1, 0x78B61, 0x78BC1, 0x78C21,
+		0x78C81, 0x78CE1, 0x78D41, 0x78E01, 0x78E61, 0x78EC1, 0x78F21, 0x78F81, 0x78FE1, 0x79041, 0x790A1, 0x79101, 0x79161, 0x791C1, 0x79221, 0x792E1,
+		0x79341, 0x78841, 0x78B01, 0x78DA1, 0x79281, 0x793A1, 0, 0x79421, 0, 0, 0, 0, 0, 0x794E1, 0, 0,
+		0x89F61, 0x89F81, 0x89FA1, 0x89FC1, 0x89FE1, 0x8A001, 0x8A021, 0x8A041, 0x8A061, 0x8A081, 0x8A0A1, 0x8A0C1, 0x8A101, 0x8A121, 0x8A141, 0x8A161,
+		0x8A181, 0x8A1A1, 0x8A1C1, 0x8A1E1, 0x8A201, 0x8A221, 0x8A241, 0x8A261, 0x8A281, 0x8A2A1, 0x8A2C1, 0x8A2E1, 0x8A301, 0x8A321, 0x8A341, 0x8A361,
+		0x8A381, 0x8A3A1, 0x8A3C1, 0x8A3E1, 0x8A421, 0x8A441, 0x8A461, 0x8A481, 0x8A4A1, 0x8A4C1, 0x8A4E1, 0x8A501, 0x8A521, 0x8A541, 0x8A561, 0x8A581,
+		0x8A5A1, 0x8A5C1, 0x8A5E1, 0x8A601, 0x8A621, 0x8A641, 0x8A0E1, 0x8A401, 0, 0, 0, 0, 0, 0, 0, 0x8A661,
+		0x1CFE1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1D001,
+		0x8A881, 0x8AB21, 0x8AD41, 0x8AE61, 0x8B041, 0x8B501, 0x8B721, 0x8B841, 0x8BB01, 0x8BC21, 0x8BD41, 0x8C361, 0x8C741, 0x8C921, 0x8CA41, 0x8CE81,
+		0x8CFA1, 0x8D1A1, 0x8D7A1, 0x8CD01, 0x8CD21, 0x8CD41, 0x8CD61, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x8D821, 0x8D841, 0x8D861, 0x8D881, 0x8D8A1, 0x8D8C1, 0x8D8E1, 0, 0x8D901, 0x8D921, 0x8D941, 0x8D961, 0x8D981, 0x8D9A1, 0x8D9C1, 0,
+		0x8D9E1, 0x8DA01, 0x8DA21, 0x8DA41, 0x8DA61, 0x8DA81, 0x8DAA1, 0, 0x8DAC1, 0x8DAE1, 0x8DB01, 0x8DB21, 0x8DB41, 0x8DB61, 0x8DB81, 0,
+		0x8DBA1, 0x8DBC1, 0x8DBE1, 0x8DC01, 0x8DC21, 0x8DC41, 0x8DC61, 0, 0x8DC81, 0x8DCA1, 0x8DCC1, 0x8DCE1, 0x8DD01, 0x8DD21, 0x8DD41, 0,
+		0x8DD61, 0x8DD81, 0x8DDA1, 0x8DDC1, 0x8DDE1, 0x8DE01, 0x8DE21, 0, 0x8DE41, 0x8DE61, 0x8DE81, 0x8DEA1, 0x8DEC1, 0x8DEE1, 0x8DF01, 0,
+		0x735E1, 0x73661, 0x736C1, 0x73941, 0x73D61, 0x73F81, 0x74721, 0x749C1, 0x74C41, 0x74D21, 0x74FC1, 0x752C1, 0x753E1, 0x754E1, 0x75641, 0x75BE1,
+		0x75F21, 0x76081, 0x763A1, 0x76441, 0x76DA1, 0x75522, 0x73381, 0x73B21, 0x746C1, 0x75AE1, 0x767C1, 0x76921, 0x769C1, 0x76B01, 0x76BA1, 0x76CC1,
+		0x1D021, 0x1D041, 0x1D061, 0x1D081, 0x1D0A1, 0x1D0C1, 0x1D0E1, 0x1D101, 0x1D121, 0x1D141, 0x1D161, 0x1D181, 0x1D1A1, 0x1D1C1, 0x1D1E1, 0x1D201,
+		0x1D221, 0x1D241, 0x1D261, 0x1D281, 0x1D2A1, 0x1D2C1, 0x1D2E1, 0x1D301, 0x1D321, 0x1D341, 0x1D361, 0x1D381, 0x1D3A1, 0x1D3C1, 0x1D3E1, 0x1D401,
+		0x1D421, 0x1D441, 0x1D461, 0x1D481, 0x1D4A1, 0x1D4C1, 0x1D4E1, 0x1D501, 0x1D521, 0x1D541, 0x1D561, 0x1D581, 0x1D5A1, 0x1D5C1, 0x1D5E1, 0x764C1,
+		0x1D601, 0x1D621, 0x1D641, 0x1D661, 0x1D681, 0x1D6A1, 0x1D6C1, 0x1D6E1, 0x1D701, 0x1D721, 0x1D741, 0x1D761, 0x1D781, 0x1D7A1, 0x1D7C1, 0x1D7E1,
+		0x1D801, 0x1D821, 0x1D841, 0x1D861, 0x1D881, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xF8AE3, 0xFB883, 0xF8DA2, 0xF8D62, 0xF8D03, 0xF9362, 0xFA023, 0xFA503, 0xFA623, 0xFA682, 0xFB583, 0xFB623, 0xFDBE3, 0xFDC43, 0xFDD63, 0xFDDC2,
+		0xFDCE2, 0xFDE03, 0xFE522, 0xFE7A2, 0xFEE02, 0xFEDA3, 0xFF1E2, 0xFF183, 0xFFD62, 0x100662, 0, 0x100AE2, 0x100CA3, 0x101303, 0x1022A3, 0x102502,
+		0x102602, 0x102782, 0x1027C2, 0x1035C2, 0x103B82, 0x103BC3, 0xF8962, 0x103E63, 0x104082, 0x1043C3, 0x104CA3, 0x105243, 0x1058E3, 0x105942, 0x106443, 0x106922,
+		0x106DE2, 0x106F82, 0x106EE2, 0x106FC3, 0x107023, 0x106F23, 0x1071C3, 0x107223, 0x107282, 0x107482, 0x107822, 0x1077C3, 0x1078A3, 0x107D03, 0x1080A2, 0x1080E3,
+		0x108143, 0x108BE2, 0x109262, 0x1095A2, 0x109562, 0x109722, 0x109762, 0x1097E3, 0x109E02, 0x10A3C2, 0x10A543, 0x10A922, 0x10AAE2, 0x10AB23, 0x10AB83, 0x10AE23,
+		0x10B642, 0x10B682, 0x10B702, 0x10B742, 0x10B882, 0x10B903, 0x10B962, 0x10BDE3, 0x10BFC2, 0x10C202, 0x10C5C2, 0x10C642, 0x10C6C2, 0x10C702, 0x10C7C3, 0x10C782,
+		0x10C962, 0x10C9E3, 0x10CC02, 0x10CC83, 0x10CEE3, 0x10D042, 0x10D202, 0x10D283, 0x10D422, 0x10D522, 0x10D722, 0x10D9E3, 0x10DA42, 0x10DAC3, 0x10DB22, 0x10DBE3,
+		0x10DC82, 0x10DDC3, 0x10DE23, 0x10DE82, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xF8322, 0xF8922, 0xF8AA2, 0xF8C02, 0xF8C82, 0xF8EE2, 0xF8F62, 0xF9222, 0xF92E2, 0xF9AA2, 0xF9C62, 0xF9D62, 0xF9FE2, 0xFA182, 0xFA282, 0xFA4C2,
+		0xFA5A2, 0xFA5E2, 0xFAA82, 0xFAEC2, 0xFB002, 0xFB0C2, 0xFB102, 0xFB1C2, 0xFB542, 0xFB5E2, 0xFB842, 0xFB8E2, 0xFB962, 0xFBA62, 0xFC662, 0xFC862,
+		0xFCD62, 0xFCEA2, 0xFCF22, 0xFCF62, 0xFD0A2, 0xFD362, 0xFD6A2, 0xFD7E2, 0xFDAE2, 0xFDBA2, 0xFDD22, 0xFDE62, 0xFE062, 0xFE0A2, 0xFE362, 0xFE422,
+		0xFE4E2, 0xFE5A2, 0xFE6A2, 0xFE7E2, 0xFE862, 0xFEB62, 0xFEBE2, 0xFEC62, 0xFECA2, 0xFED62, 0xFEE82, 0xFEF82, 0xFF142, 0xFFB62, 0xFFCA2, 0xFFCE2,
+		0x1005E2, 0x100622, 0x100862, 0x1008E2, 0x1009A2, 0x100A22, 0x100AA2, 0x100BA2, 0x1010C2, 0x1011C2, 0x1015E2, 0x102062, 0x102122, 0x102262, 0x102382, 0x1024C2,
+		0x102542, 0x102582, 0x1025C2, 0x102642, 0x102682, 0x1034C2, 0x103B02, 0x103CA2, 0x103CE2, 0x103D22, 0x103D62, 0x103DE2, 0x103E22, 0x104042, 0x104282, 0x104342,
+		0x1047E2, 0x104822, 0x1048A2, 0x1048E2, 0x1049A2, 0x1049E2, 0x104C62, 0x104D02, 0x104EC2, 0x104F02, 0x104F42, 0x104F82, 0x105202, 0x105562, 0x1055A2, 0x1055E2,
+		0x1058A2, 0x105FC2, 0x106002, 0x106242, 0x106382, 0x106402, 0x1066A2, 0x1068E2, 0x106E22, 0x106EA2, 0x107182, 0x107382, 0x107442, 0x107582, 0x1075C2, 0x107602,
+		0x107782, 0x107862, 0x107A82, 0x107B02, 0x107C02, 0x107CC2, 0x107E22, 0x107EA2, 0x107EE2, 0x107F22, 0x107FA2, 0x107FE2, 0x108BA2, 0x108D62, 0x1090E2, 0x109162,
+		0x109222, 0x109522, 0x109662, 0x1097A2, 0x109882, 0x109E42, 0x109E82, 0x109F42, 0x109F82, 0x109FC2, 0x10A402, 0x10A442, 0x10A502, 0x10A6A2, 0x10A722, 0x10A962,
+		0x10AA22, 0x10AA62, 0x10ADE2, 0x10B042, 0x10B182, 0x10B202, 0x10B2C2, 0x10B6C2, 0x10B782, 0x10B8C2, 0x10BBA2, 0x10BC62, 0x10BDA2, 0x10BF82, 0x10C082, 0x10C0C2,
+		0x10C102, 0x10C142, 0x10C242, 0x10C282, 0x10C342, 0x10C602, 0x10C682, 0x10C742, 0x10C9A2, 0x10CA42, 0x10CAC2, 0x10CC42, 0x10CCE2, 0x10CD22, 0x10CDE2, 0x10CE22,
+		0x10CE62, 0x10CEA2, 0x10CF42, 0x10D082, 0x10D242, 0x10D322, 0x10D3E2, 0x10D4A2, 0x10D4E2, 0x10D562, 0x10D5E2, 0x10D662, 0x10D6A2, 0x10D7A2, 0x10D822, 0x10D8A2,
+		0x10D922, 0x10D9A2, 0x10DA82, 0x10DBA2, 0x10DD82, 0x10DEC2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x1D8A1, 0x1D8C1, 0x1D8E1, 0x1D901, 0x1D921, 0x1D941, 0x1D961, 0x1D981, 0x1D9A1, 0x1D9C1, 0x1D9E1, 0x1DA01, 0, 0, 0, 0,
+		0x1DA21, 0x1DA41, 0x1DA61, 0x1DA81, 0x1DAA1, 0x41A01, 0xC4EC2, 0x42681, 0x1DAC1, 0x1DAE1, 0x1DB01, 0x1DB21, 0x1DB41, 0x1DB61, 0x1DB81, 0x1DBA1,
+		0x1DBC1, 0x1DBE1, 0x1DC01, 0x1DC21, 0x1DC41, 0x1DC61, 0x1DC81, 0x1DCA1, 0x1DCC1, 0x1DCE1, 0x1DD01, 0x1DD21, 0x1DD41, 0x1DD61, 0x1DD81, 0x1DDA1,
+		0x1DDC1, 0x430C1, 0x45E61, 0x47B01, 0x48EC1, 0x4A0C1, 0x4B121, 0x4BE81, 0x4CC61, 0x4D9A1, 0x41561, 0x41581, 0x415A1, 0x415C1, 0x415E1, 0x41601,
+		0x1DDE1, 0x41A61, 0x41A82, 0x41AC1, 0x41AE2, 0x41B21, 0x1DE01, 0x1DE21, 0xFB202, 0xFB302, 0xFB342, 0x41A21, 0xC74A2, 0x1DE41, 0x1DE61, 0x1DE81,
+		0, 0xC3001, 0xC3021, 0xC3301, 0xC3321, 0xC34E1, 0xC3501, 0xC36C1, 0xC36E1, 0xC38E1, 0xC3901, 0xC3AA1, 0xC3B42, 0xC3E21, 0xC3EA2, 0xC43C1,
+		0xC4462, 0xC4781, 0xC4822, 0xC4901, 0xC4982, 0xC4B61, 0xC4C02, 0xC4DA1, 0xC4E42, 0xC4FA1, 0xC5042, 0xC50C1, 0xC5142, 0xC5281, 0xC5302, 0xC5381,
+		0xC5402, 0xC5501, 0xC5582, 0xC5601, 0xC5621, 0xC56E2, 0xC5761, 0xC57E2, 0xC5901, 0xC59A2, 0xC5AC1, 0xC5B81, 0xC5C01, 0xC5CA1, 0xC5D21, 0xC5E01,
+		0xC5EA2, 0xC5F22, 0xC61E1, 0xC6282, 0xC6302, 0xC6581, 0xC6622, 0xC66A2, 0xC6981, 0xC6A22, 0xC6AA2, 0xC6EA1, 0xC6F42, 0xC6FC2, 0xC7341, 0xC7641,
+		0xC7841, 0xC78E1, 0xC7AE1, 0xC7B61, 0xC7B81, 0xC7D21, 0xC7D41, 0xC7E61, 0xC7E81, 0xC7F81, 0xC8021, 0xC8181, 0xC8341, 0xC84E1, 0xC8581, 0xC85A1,
+		0xC86E1, 0xC8781, 0xC8821, 0xC88E1, 0xC35C2, 0xC3A81, 0xC4761, 0, 0, 0x3E8C1, 0x3E901, 0x1DEA1, 0x1DEC1, 0x41B41, 0x41B62, 0xC7F42,
+		0x1DEE1, 0xC3041, 0xC3081, 0xC3341, 0xC3381, 0xC3521, 0xC3561, 0xC3701, 0xC3741, 0xC3921, 0xC3961, 0xC3AE1, 0xC3B82, 0xC3E41, 0xC3EE2, 0xC4401,
+		0xC44A2, 0xC47C1, 0xC4862, 0xC4921, 0xC49C2, 0xC4B81, 0xC4C42, 0xC4DE1, 0xC4E82, 0xC4FE1, 0xC5082, 0xC50E1, 0xC5182, 0xC52A1, 0xC5342, 0xC53A1,
+		0xC5442, 0xC5521, 0xC55C2, 0xC5641, 0xC5681, 0xC5722, 0xC5781, 0xC5822, 0xC5941, 0xC59E2, 0xC5AE1, 0xC5BA1, 0xC5C41, 0xC5CC1, 0xC5D41, 0xC5E41,
+		0xC5EE2, 0xC5F62, 0xC6221, 0xC62C2, 0xC6342, 0xC65C1, 0xC6662, 0xC66E2, 0xC69C1, 0xC6A62, 0xC6AE2, 0xC6EE1, 0xC6F82, 0xC7002, 0xC7361, 0xC7661,
+		0xC7881, 0xC7901, 0xC7B01, 0xC7BA1, 0xC7BE1, 0xC7D61, 0xC7DA1, 0xC7EA1, 0xC7EE1, 0xC7FC1, 0xC8061, 0xC81C1, 0xC8381, 0xC8521, 0xC85C1, 0xC85E1,
+		0xC8701, 0xC87A1, 0xC8841, 0xC8901, 0xC3602, 0xC3AC1, 0xC47A1, 0xC8642, 0xC8742, 0xC87E2, 0xC88A2, 0x1DF01, 0x41BA1, 0x41BE1, 0x41C02, 0xC4AC2,
+		0, 0, 0, 0, 0, 0xC8961, 0xC89C1, 0xC8A01, 0xC8A41, 0xC8A81, 0xC8AA1, 0xC8AE1, 0xC8B01, 0xC8B21, 0xC8B81, 0xC8C01,
+		0xC8C41, 0xC8CA1, 0xC8CC1, 0xC8D01, 0xC8D21, 0xC8D41, 0xC8D61, 0xC8D81, 0xC8DE1, 0xC8E01, 0xC8E81, 0xC8EE1, 0xC8F61, 0xC8F81, 0xC9001, 0xC9061,
+		0xC9081, 0xC90E1, 0xC9101, 0xC9121, 0xC9141, 0xC9181, 0xC91E1, 0xC9201, 0xC92A1, 0xC9341, 0xC8A61, 0xC8BC1, 0xC8CE1, 0xC9361, 0, 0,
+		0, 0xBDC41, 0xBDDE1, 0xC1BA1, 0xBDE41, 0xC1C21, 0xC1C81, 0xBDFE1, 0xBE181, 0xBE1E1, 0xC1D21, 0xC1D81, 0xC1DE1, 0xC1E41, 0xC1EA1, 0xC1F01,
+		0xBF981, 0xBE381, 0xBE521, 0xBE6C1, 0xBFB21, 0xBE721, 0xBE8C1, 0xBE921, 0xBECA1, 0xBEF41, 0xBEFA1, 0xBF1E1, 0xBF381, 0xBF521, 0xBF6C1, 0xC0961,
+		0xC09C1, 0xC0A21, 0xC0A81, 0xC0AE1, 0xC0B41, 0xC0BA1, 0xC0C01, 0xC0C61, 0xC0CC1, 0xC0D21, 0xC0D81, 0xC0DE1, 0xC0E41, 0xC0EA1, 0xC0F01, 0xC0F61,
+		0xC0FC1, 0xC1021, 0xC1081, 0xC10E1, 0xC0901, 0xBF881, 0xBF8C1, 0xC2181, 0xC21C1, 0xC2261, 0xC22C1, 0xC2381, 0xC2421, 0xC2481, 0xBFA01, 0xC2521,
+		0xC2581, 0xBFA41, 0xBFA81, 0xBFAE1, 0xBFB81, 0xBFBC1, 0xBFC61, 0xBFCC1, 0xBFD21, 0xBFD61, 0xBFDA1, 0xBFDE1, 0xBFE21, 0xBFEA1, 0xBFF41, 0xC00A1,
+		0xC01A1, 0xC0261, 0xC27E1, 0xC2821, 0xC03E1, 0xC0421, 0xC0461, 0xC1301, 0xC1341, 0xC13C1, 0xC1501, 0xC1541, 0xC15A1, 0xC1701, 0xC1781, 0,
+		0x1DF21, 0x1DF41, 0xF8422, 0xF90E2, 0xF86E2, 0xFC762, 0xF87A2, 0xF89E2, 0xF8822, 0x104A22, 0xF8CC2, 0xF88A2, 0xF84A2, 0xFD162, 0xFC962, 0xF9322,
+		0xC8982, 0xC8DA2, 0xC8C62, 0xC8B42, 0xC8FA1, 0xC8FC2, 0xC8F41, 0xC8F02, 0xC9302, 0xC8EA2, 0xC9222, 0xC92C2, 0xC8A21, 0xC8BE1, 0xC9022, 0xC90A2,
+		0xC91A1, 0xC91C1, 0xC9161, 0xC9262, 0xC89E1, 0xC8AC1, 0xC8BA1, 0xC8C21, 0xC8E21, 0xC8E41, 0xC8E61, 0, 0, 0, 0, 0,
+		0x1DF61, 0x1DF81, 0x1DFA1, 0x1DFC1, 0x1DFE1, 0x1E001, 0x1E021, 0x1E041, 0x1E061, 0x1E081, 0x1E0A1, 0x1E0C1, 0x1E0E1, 0x1E101, 0x1E121, 0x1E141,
+		0x1E161, 0x1E181, 0x1E1A1, 0x1E1C1, 0x1E1E1, 0x1E201, 0x1E221, 0x1E241, 0x1E261, 0x1E281, 0x1E2A1, 0x1E2C1, 0x1E2E1, 0x1E301, 0x1E321, 0x1E341,
+		0x1E361, 0x1E381, 0x1E3A1, 0x1E3C1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xC43E1, 0xC4DC1, 0xC4FC1, 0xC5921, 0xC5C21, 0xC5E21, 0xC6201, 0xC65A1, 0xC69A1, 0xC6EC1, 0xC7861, 0xC7FA1, 0xC8041, 0xC81A1, 0xC8361, 0xC8501,
+		0xBDC63, 0xBDE63, 0xBE003, 0xBE203, 0xBE3A3, 0xBE543, 0xBE743, 0xBE943, 0xBECC3, 0xBEFC3, 0xBF203, 0xBF3A3, 0xBF543, 0xBF6E3, 0xBDD04, 0xBDF04,
+		0xBE0A4, 0xBE2A4, 0xBE444, 0xBE5E4, 0xBE7E4, 0xBE9E4, 0xBED64, 0xBF064, 0xBF2A4, 0xBF444, 0xBF5E4, 0xBF784, 0xBEE24, 0xBEAA7, 0xBEB86, 0,
+		0xF8364, 0xF8FA4, 0xF85A4, 0xFC6A4, 0xF9164, 0xF9EA4, 0xF84E4, 0xF9DA4, 0xF8DE4, 0xFB244, 0x101204, 0x103504, 0x1026C4, 0x101624, 0x10B304, 0xFC8A4,
+		0x100BE4, 0x101A24, 0x101364, 0x105A04, 0xFBCA4, 0x103F04, 0x10A004, 0x105C44, 0xFAB44, 0xF9424, 0xFBF24, 0xFD724, 0x105104, 0xF94E4, 0x10A1C4, 0xFB444,
+		0x105D84, 0xF95A4, 0x107B44, 0x107C44, 0xFC0E2, 0xFE822, 0x1008A2, 0x1064E2, 0x43D02, 0x46962, 0x484A2, 0x49862, 0x4AA62, 0x4B8E2, 0x4C662, 0x4D422,
+		0x60303, 0x46AA2, 0x46BE2, 0x46DA2, 0x46F42, 0x47082, 0x471A2, 0x47262, 0x47322, 0x473E2, 0x484E2, 0x485A2, 0x48662, 0x486A2, 0x486E2, 0x48782,
+		0xBDCC1, 0xBDEC1, 0xBE061, 0xBE261, 0xBE401, 0xBE5A1, 0xBE7A1, 0xBE9A1, 0xBED21, 0xBF021, 0xBF261, 0xBF401, 0xBF5A1, 0xBF741, 0xBDD82, 0xBDF82,
+		0xBE122, 0xBE322, 0xBE4C2, 0xBE662, 0xBE862, 0xBEA62, 0xBEDE2, 0xBF0E2, 0xBF322, 0xBF4C2, 0xBF662, 0xBF802, 0xBF125, 0xBEEA4, 0xBEC42, 0x1E3E1,
+		0xF83E2, 0xF90A2, 0xF86A2, 0xFC722, 0xF91E2, 0xF9F22, 0xF8562, 0xF9E22, 0xF8E62, 0xFB2C2, 0x101282, 0x103582, 0x102742, 0x1016A2, 0x10B382, 0xFC922,
+		0x100C62, 0x101AA2, 0x1013E2, 0x105A82, 0xFBD22, 0x103F82, 0x10A082, 0x105CC2, 0xFABC2, 0x106082, 0x104AA2, 0xFD3A2, 0x10ACE2, 0xF9A62, 0xFB682, 0x1029C2,
+		0x10C382, 0xF9622, 0xFA202, 0x102162, 0xF8762, 0xF89A2, 0xF87E2, 0xFE462, 0xFBBA2, 0xFB142, 0xFD8E2, 0xFD7A2, 0x105182, 0xF9562, 0x10A242, 0xFB4C2,
+		0xFD022, 0x48822, 0x48862, 0x488A2, 0x48942, 0x498A2, 0x498E2, 0x49922, 0x49962, 0x499A2, 0x499E2, 0x49A82, 0x49AC2, 0x49B02, 0x49B42, 0x4AAA2,
+		0x45983, 0x47623, 0x48A23, 0x49C23, 0x4AC63, 0x4B9E3, 0x4C7C3, 0x4D503, 0x4E203, 0x43DC4, 0x44124, 0x444E4, 0x57102, 0x547C3, 0x54822, 0x5AEA3,
+		0xC30C1, 0xC33C1, 0xC35A1, 0xC3781, 0xC39A1, 0xC3B21, 0xC3E81, 0xC4441, 0xC4801, 0xC4961, 0xC4BC1, 0xC4E21, 0xC5021, 0xC5121, 0xC52E1, 0xC53E1,
+		0xC5561, 0xC56C1, 0xC57C1, 0xC5981, 0xC5B21, 0xC5BE1, 0xC5C81, 0xC5D01, 0xC5D81, 0xC5E81, 0xC6261, 0xC6601, 0xC6A01, 0xC6F21, 0xC73A1, 0xC76A1,
+		0xC78C1, 0xC7941, 0xC7B41, 0xC7C21, 0xC7DE1, 0xC7F21, 0xC8001, 0xC80A1, 0xC8201, 0xC83C1, 0xC8561, 0xC8621, 0xC8721, 0xC87C1, 0xC8881, 0,
+		0xC3145, 0xC31E4, 0xC3265, 0xC30E3, 0xC33E5, 0xC3483, 0xC3643, 0xC3826, 0xC37A4, 0xC3A23, 0xC39C3, 0xC3BC3, 0xC3C24, 0xC3CA4, 0xC3D24, 0xC3DA4,
+		0xC3F24, 0xC3FA4, 0xC4024, 0xC40A6, 0xC4162, 0xC41A6, 0xC4266, 0xC4325, 0xC44E4, 0xC4566, 0xC4626, 0xC46E4, 0xC48A3, 0xC4B03, 0xC4A04, 0xC4C84,
+		0xC4D05, 0xC4F05, 0xC51C3, 0xC5223, 0xC5484, 0xC58A3, 0xC5A23, 0xC5A82, 0xC5B42, 0xC5DA3, 0xC6183, 0xC5FA6, 0xC6064, 0xC60E5, 0xC6386, 0xC6444,
+		0xC64C3, 0xC6523, 0xC6726, 0xC67E4, 0xC6866, 0xC6923, 0xC6C45, 0xC6CE3, 0xC6D44, 0xC6DC3, 0xC6E24, 0xC6B25, 0xC6BC4, 0xC7105, 0xC71E4, 0xC7262,
+		0xC72A5, 0xC7043, 0xC70A3, 0xC73C4, 0xC7443, 0xC74E3, 0xC7543, 0xC75A5, 0xC76C4, 0xC7742, 0xC7786, 0xC79E3, 0xC7A45, 0xC7964, 0xC7C44, 0xC7CC3,
+		0xC7E03, 0xC80C4, 0xC8142, 0xC82C4, 0xC8225, 0xC83E2, 0xC8426, 0xC8683, 0x42C23, 0x459E3, 0x47683, 0x48A83, 0x49C83, 0x4ACC3, 0x4BA43, 0x4C823,
+		0x4D563, 0x4E263, 0x43E44, 0x441A4, 0x44564, 0x44824, 0x44AE4, 0x44DA4, 0x45064, 0x45324, 0x455E4, 0x458A4, 0x46A24, 0x46B64, 0x46D24, 0x46EC4,
+		0x47004, 0x57243, 0x52CE2, 0x50302, 0x50F23, 0x5F3E2, 0x60082, 0x52E22, 0x52E63, 0x52EC3, 0x585E2, 0xFE6E4, 0x100E04, 0xFD0E4, 0x100D04, 0x101AE8,
+		0x5FFC2, 0x5CE82, 0x6E8C2, 0x5BBA2, 0x59A02, 0x59A42, 0x5BC22, 0x56202, 0x51B43, 0x59A84, 0x600C2, 0x5CF22, 0x6E902, 0x6E942, 0x5BCE2, 0x59B02,
+		0x572E2, 0x59B43, 0x5BD23, 0x56243, 0x63D23, 0x6E982, 0x5BDE2, 0x52DE2, 0x59BE2, 0x55542, 0x5D062, 0x6E9C2, 0x5BE22, 0x51D22, 0x59C22, 0x5BE63,
+		0x51D63, 0x5BB22, 0x59CA3, 0x5BEC3, 0x51DC3, 0x5BB62, 0x59D03, 0x5BFE3, 0x5C084, 0x60002, 0x59D63, 0x5BF83, 0x562A3, 0x61803, 0x61865, 0x61906,
+		0x602C2, 0x5D0E2, 0x6EA02, 0x5C042, 0x60362, 0x5D122, 0x6EA42, 0x5C102, 0x59E02, 0x5C142, 0x603A2, 0x5D162, 0x6EA82, 0x5C1C2, 0x59E42, 0x5C202,
+		0x59E82, 0x5C242, 0x50024, 0x50F82, 0x51BA2, 0x51C22, 0x51C64, 0x51E83, 0x52D62, 0x56302, 0x57022, 0x57202, 0x585A2, 0x59BA2, 0x59C62, 0x59DC2,
+		0x5AC82, 0x5ACC2, 0x5AD03, 0x5AF02, 0x5BBE2, 0x5BD83, 0x5BF23, 0x60102, 0x60144, 0x601C3, 0x60282, 0x62DC2, 0x62FA2, 0x67362, 0x66763, 0x500A3,
+		0x45923, 0x475C3, 0x489C3, 0x49BC3, 0x4AC03, 0x4B983, 0x4C763, 0x4D4A3, 0x4E1A3, 0x43D44, 0x440A4, 0x44464, 0x447A4, 0x44A64, 0x44D24, 0x44FE4,
+		0x452A4, 0x45564, 0x45824, 0x469A4, 0x46AE4, 0x46CA4, 0x46E44, 0x46F84, 0x47124, 0x471E4, 0x472A4, 0x47364, 0x47424, 0x48524, 0x485E4, 0x561A3,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x1E401, 0x1E421, 0x1E441, 0x1E461, 0x1E481, 0x1E4A1, 0x1E4C1, 0x1E4E1, 0x1E501, 0x1E521, 0x1E541, 0x1E561, 0x1E581, 0x1E5A1, 0x1E5C1, 0x1E5E1,
+		0x1E601, 0x1E621, 0x1E641, 0x1E661, 0x1E681, 0x1E6A1, 0x1E6C1, 0x1E6E1, 0x1E701, 0x1E721, 0x1E741, 0x1E761, 0x1E781, 0x1E7A1, 0x1E7C1, 0x1E7E1,
+		0x1E801, 0x1E821, 0x1E841, 0x1E861, 0x1E881, 0x1E8A1, 0x1E8C1, 0x1E8E1, 0x1E901, 0x1E921, 0x1E941, 0x1E961, 0x1E981, 0x1E9A1, 0x1E9C1, 0x1E9E1,
+		0x1EA01, 0x1EA21, 0x1EA41, 0x1EA61, 0x1EA81, 0x1EAA1, 0x1EAC1, 0x1EAE1, 0x1EB01, 0x1EB21, 0x1EB41, 0x1EB61, 0x1EB81, 0x1EBA1, 0x1EBC1, 0x1EBE1,
+		0xC9381, 0xC93A1, 0xC93C1, 0xC93E1, 0xC9401, 0xC9421, 0xC9441, 0xC9461, 0xC9481, 0xC94A1, 0xC94C1, 0xC94E1, 0xC9501, 0xC9521, 0xC9541, 0xC9561,
+		0xC9581, 0xC95A1, 0xC95C1, 0xC95E1, 0xC9601, 0xC9621, 0xC9641, 0xC9661, 0xC9681, 0xC96A1, 0xC96C1, 0xC96E1, 0xC9701, 0xC9721, 0xC9741, 0xC9761,
+		0xC9781, 0xC97A1, 0xC97C1, 0xC97E1, 0xC9801, 0xC9821, 0xC9841, 0xC9861, 0xC9881, 0xC98A1, 0xC98C1, 0xC98E1, 0xC9901, 0xC9921, 0xC9941, 0xC9961,
+		0xC9981, 0xC99A1, 0xC99C1, 0xC99E1, 0xC9A01, 0xC9A21, 0xC9A41, 0xC9A61, 0xC9A81, 0xC9AA1, 0xC9AC1, 0xC9AE1, 0xC9B01, 0xC9B21, 0xC9B41, 0xC9B61,
+		0xC9B81, 0xC9BA1, 0xC9BC1, 0xC9BE1, 0xC9C01, 0xC9C21, 0xC9C41, 0xC9C61, 0xC9C81, 0xC9CA1, 0xC9CC1, 0xC9CE1, 0xC9D01, 0xC9D21, 0xC9D41, 0xC9D61,
+		0xC9D81, 0xC9DA1, 0xC9DC1, 0xC9DE1, 0xC9E01, 0xC9E21, 0xC9E41, 0xC9E61, 0xC9E81, 0xC9EA1, 0xC9EC1, 0xC9EE1, 0xC9F01, 0xC9F21, 0xC9F41, 0xC9F61,
+		0xC9F81, 0xC9FA1, 0xC9FC1, 0xC9FE1, 0xCA001, 0xCA021, 0xCA041, 0xCA061, 0xCA081, 0xCA0A1, 0xCA0C1, 0xCA0E1, 0xCA101, 0xCA121, 0xCA141, 0xCA161,
+		0xCA181, 0xCA1A1, 0xCA1C1, 0xCA1E1, 0xCA201, 0xCA221, 0xCA241, 0xCA261, 0xCA281, 0xCA2A1, 0xCA2C1, 0xCA2E1, 0xCA301, 0xCA321, 0xCA341, 0xCA361,
+		0xCA381, 0xCA3A1, 0xCA3C1, 0xCA3E1, 0xCA401, 0xCA421, 0xCA441, 0xCA461, 0xCA481, 0xCA4A1, 0xCA4C1, 0xCA4E1, 0xCA501, 0xCA521, 0xCA541, 0xCA561,
+		0xCA581, 0xCA5A1, 0xCA5C1, 0xCA5E1, 0xCA601, 0xCA621, 0xCA641, 0xCA661, 0xCA681, 0xCA6A1, 0xCA6C1, 0xCA6E1, 0xCA701, 0xCA721, 0xCA741, 0xCA761,
+		0xCA781, 0xCA7A1, 0xCA7C1, 0xCA7E1, 0xCA801, 0xCA821, 0xCA841, 0xCA861, 0xCA881, 0xCA8A1, 0xCA8C1, 0xCA8E1, 0xCA901, 0xCA921, 0xCA941, 0xCA961,
+		0xCA981, 0xCA9A1, 0xCA9C1, 0xCA9E1, 0xCAA01, 0xCAA21, 0xCAA41, 0xCAA61, 0xCAA81, 0xCAAA1, 0xCAAC1, 0xCAAE1, 0xCAB01, 0xCAB21, 0xCAB41, 0xCAB61,
+		0xCAB81, 0xCABA1, 0xCABC1, 0xCABE1, 0xCAC01, 0xCAC21, 0xCAC41, 0xCAC61, 0xCAC81, 0xCACA1, 0xCACC1, 0xCACE1, 0xCAD01, 0xCAD21, 0xCAD41, 0xCAD61,
+		0xCAD81, 0xCADA1, 0xCADC1, 0xCADE1, 0xCAE01, 0xCAE21, 0xCAE41, 0xCAE61, 0xCAE81, 0xCAEA1, 0xCAEC1, 0xCAEE1, 0xCAF01, 0xCAF21, 0xCAF41, 0xCAF61,
+		0xCAF81, 0xCAFA1, 0xCAFC1, 0xCAFE1, 0xCB001, 0xCB021, 0xCB041, 0xCB061, 0xCB081, 0xCB0A1, 0xCB0C1, 0xCB0E1, 0xCB101, 0xCB121, 0xCB141, 0xCB161,
+		0xCB181, 0xCB1A1, 0xCB1C1, 0xCB1E1, 0xCB201, 0xCB221, 0xCB241, 0xCB261, 0xCB281, 0xCB2A1, 0xCB2C1, 0xCB2E1, 0xCB301, 0xCB321, 0xCB341, 0xCB361,
+		0xCB381, 0xCB3A1, 0xCB3C1, 0xCB3E1, 0xCB401, 0xCB421, 0xCB441, 0xCB461, 0xCB481, 0xCB4A1, 0xCB4C1, 0xCB4E1, 0xCB501, 0xCB521, 0xCB541, 0xCB561,
+		0xCB581, 0xCB5A1, 0xCB5C1, 0xCB5E1, 0xCB601, 0xCB621, 0xCB641, 0xCB661, 0xCB681, 0xCB6A1, 0xCB6C1, 0xCB6E1, 0xCB701, 0xCB721, 0xCB741, 0xCB761,
+		0xCB781, 0xCB7A1, 0xCB7C1, 0xCB7E1, 0xCB801, 0xCB821, 0xCB841, 0xCB861, 0xCB881, 0xCB8A1, 0xCB8C1, 0xCB8E1, 0xCB901, 0xCB921, 0xCB941, 0xCB961,
+		0xCB981, 0xCB9A1, 0xCB9C1, 0xCB9E1, 0xCBA01, 0xCBA21, 0xCBA41, 0xCBA61, 0xCBA81, 0xCBAA1, 0xCBAC1, 0xCBAE1, 0xCBB01, 0xCBB21, 0xCBB41, 0xCBB61,
+		0xCBB81, 0xCBBA1, 0xCBBC1, 0xCBBE1, 0xCBC01, 0xCBC21, 0xCBC41, 0xCBC61, 0xCBC81, 0xCBCA1, 0xCBCC1, 0xCBCE1, 0xCBD01, 0xCBD21, 0xCBD41, 0xCBD61,
+		0xCBD81, 0xCBDA1, 0xCBDC1, 0xCBDE1, 0xCBE01, 0xCBE21, 0xCBE41, 0xCBE61, 0xCBE81, 0xCBEA1, 0xCBEC1, 0xCBEE1, 0xCBF01, 0xCBF21, 0xCBF41, 0xCBF61,
+		0xCBF81, 0xCBFA1, 0xCBFC1, 0xCBFE1, 0xCC001, 0xCC021, 0xCC041, 0xCC061, 0xCC081, 0xCC0A1, 0xCC0C1, 0xCC0E1, 0xCC101, 0xCC121, 0xCC141, 0xCC161,
+		0xCC181, 0xCC1A1, 0xCC1C1, 0xCC1E1, 0xCC201, 0xCC221, 0xCC241, 0xCC261, 0xCC281, 0xCC2A1, 0xCC2C1, 0xCC2E1, 0xCC301, 0xCC321, 0xCC341, 0xCC361,
+		0xCC381, 0xCC3A1, 0xCC3C1, 0xCC3E1, 0xCC401, 0xCC421, 0xCC441, 0xCC461, 0xCC481, 0xCC4A1, 0xCC4C1, 0xCC4E1, 0xCC501, 0xCC521, 0xCC541, 0xCC561,
+		0xCC581, 0xCC5A1, 0xCC5C1, 0xCC5E1, 0xCC601, 0xCC621, 0xCC641, 0xCC661, 0xCC681, 0xCC6A1, 0xCC6C1, 0xCC6E1, 0xCC701, 0xCC721, 0xCC741, 0xCC761,
+		0xCC781, 0xCC7A1, 0xCC7C1, 0xCC7E1, 0xCC801, 0xCC821, 0xCC841, 0xCC861, 0xCC881, 0xCC8A1, 0xCC8C1, 0xCC8E1, 0xCC901, 0xCC921, 0xCC941, 0xCC961,
+		0xCC981, 0xCC9A1, 0xCC9C1, 0xCC9E1, 0xCCA01, 0xCCA21, 0xCCA41, 0xCCA61, 0xCCA81, 0xCCAA1, 0xCCAC1, 0xCCAE1, 0xCCB01, 0xCCB21, 0xCCB41, 0xCCB61,
+		0xCCB81, 0xCCBA1, 0xCCBC1, 0xCCBE1, 0xCCC01, 0xCCC21, 0xCCC41, 0xCCC61, 0xCCC81, 0xCCCA1, 0xCCCC1, 0xCCCE1, 0xCCD01, 0xCCD21, 0xCCD41, 0xCCD61,
+		0xCCD81, 0xCCDA1, 0xCCDC1, 0xCCDE1, 0xCCE01, 0xCCE21, 0xCCE41, 0xCCE61, 0xCCE81, 0xCCEA1, 0xCCEC1, 0xCCEE1, 0xCCF01, 0xCCF21, 0xCCF41, 0xCCF61,
+		0xCCF81, 0xCCFA1, 0xCCFC1, 0xCCFE1, 0xCD001, 0xCD021, 0xCD041, 0xCD061, 0xCD081, 0xCD0A1, 0xCD0C1, 0xCD0E1, 0xCD101, 0xCD121, 0xCD141, 0xCD161,
+		0xCD181, 0xCD1A1, 0xCD1C1, 0xCD1E1, 0xCD201, 0xCD221, 0xCD241, 0xCD261, 0xCD281, 0xCD2A1, 0xCD2C1, 0xCD2E1, 0xCD301, 0xCD321, 0xCD341, 0xCD361,
+		0xCD381, 0xCD3A1, 0xCD3C1, 0xCD3E1, 0xCD401, 0xCD421, 0xCD441, 0xCD461, 0xCD481, 0xCD4A1, 0xCD4C1, 0xCD4E1, 0xCD501, 0xCD521, 0xCD541, 0xCD561,
+		0xCD581, 0xCD5A1, 0xCD5C1, 0xCD5E1, 0xCD601, 0xCD621, 0xCD641, 0xCD661, 0xCD681, 0xCD6A1, 0xCD6C1, 0xCD6E1, 0xCD701, 0xCD721, 0xCD741, 0xCD761,
+		0xCD781, 0xCD7A1, 0xCD7C1, 0xCD7E1, 0xCD801, 0xCD821, 0xCD841, 0xCD861, 0xCD881, 0xCD8A1, 0xCD8C1, 0xCD8E1, 0xCD901, 0xCD921, 0xCD941, 0xCD961,
+		0xCD981, 0xCD9A1, 0xCD9C1, 0xCD9E1, 0xCDA01, 0xCDA21, 0xCDA41, 0xCDA61, 0xCDA81, 0xCDAA1, 0xCDAC1, 0xCDAE1, 0xCDB01, 0xCDB21, 0xCDB41, 0xCDB61,
+		0xCDB81, 0xCDBA1, 0xCDBC1, 0xCDBE1, 0xCDC01, 0xCDC21, 0xCDC41, 0xCDC61, 0xCDC81, 0xCDCA1, 0xCDCC1, 0xCDCE1, 0xCDD01, 0xCDD21, 0xCDD41, 0xCDD61,
+		0xCDD81, 0xCDDA1, 0xCDDC1, 0xCDDE1, 0xCDE01, 0xCDE21, 0xCDE41, 0xCDE61, 0xCDE81, 0xCDEA1, 0xCDEC1, 0xCDEE1, 0xCDF01, 0xCDF21, 0xCDF41, 0xCDF61,
+		0xCDF81, 0xCDFA1, 0xCDFC1, 0xCDFE1, 0xCE001, 0xCE021, 0xCE041, 0xCE061, 0xCE081, 0xCE0A1, 0xCE0C1, 0xCE0E1, 0xCE101, 0xCE121, 0xCE141, 0xCE161,
+		0xCE181, 0xCE1A1, 0xCE1C1, 0xCE1E1, 0xCE201, 0xCE221, 0xCE241, 0xCE261, 0xCE281, 0xCE2A1, 0xCE2C1, 0xCE2E1, 0xCE301, 0xCE321, 0xCE341, 0xCE361,
+		0xCE381, 0xCE3A1, 0xCE3C1, 0xCE3E1, 0xCE401, 0xCE421, 0xCE441, 0xCE461, 0xCE481, 0xCE4A1, 0xCE4C1, 0xCE4E1, 0xCE501, 0xCE521, 0xCE541, 0xCE561,
+		0xCE581, 0xCE5A1, 0xCE5C1, 0xCE5E1, 0xCE601, 0xCE621, 0xCE641, 0xCE661, 0xCE681, 0xCE6A1, 0xCE6C1, 0xCE6E1, 0xCE701, 0xCE721, 0xCE741, 0xCE761,
+		0xCE781, 0xCE7A1, 0xCE7C1, 0xCE7E1, 0xCE801, 0xCE821, 0xCE841, 0xCE861, 0xCE881, 0xCE8A1, 0xCE8C1, 0xCE8E1, 0xCE901, 0xCE921, 0xCE941, 0xCE961,
+		0xCE981, 0xCE9A1, 0xCE9C1, 0xCE9E1, 0xCEA01, 0xCEA21, 0xCEA41, 0xCEA61, 0xCEA81, 0xCEAA1, 0xCEAC1, 0xCEAE1, 0xCEB01, 0xCEB21, 0xCEB41, 0xCEB61,
+		0xCEB81, 0xCEBA1, 0xCEBC1, 0xCEBE1, 0xCEC01, 0xCEC21, 0xCEC41, 0xCEC61, 0xCEC81, 0xCECA1, 0xCECC1, 0xCECE1, 0xCED01, 0xCED21, 0xCED41, 0xCED61,
+		0xCED81, 0xCEDA1, 0xCEDC1, 0xCEDE1, 0xCEE01, 0xCEE21, 0xCEE41, 0xCEE61, 0xCEE81, 0xCEEA1, 0xCEEC1, 0xCEEE1, 0xCEF01, 0xCEF21, 0xCEF41, 0xCEF61,
+		0xCEF81, 0xCEFA1, 0xCEFC1, 0xCEFE1, 0xCF001, 0xCF021, 0xCF041, 0xCF061, 0xCF081, 0xCF0A1, 0xCF0C1, 0xCF0E1, 0xCF101, 0xCF121, 0xCF141, 0xCF161,
+		0xCF181, 0xCF1A1, 0xCF1C1, 0xCF1E1, 0xCF201, 0xCF221, 0xCF241, 0xCF261, 0xCF281, 0xCF2A1, 0xCF2C1, 0xCF2E1, 0xCF301, 0xCF321, 0xCF341, 0xCF361,
+		0xCF381, 0xCF3A1, 0xCF3C1, 0xCF3E1, 0xCF401, 0xCF421, 0xCF441, 0xCF461, 0xCF481, 0xCF4A1, 0xCF4C1, 0xCF4E1, 0xCF501, 0xCF521, 0xCF541, 0xCF561,
+		0xCF581, 0xCF5A1, 0xCF5C1, 0xCF5E1, 0xCF601, 0xCF621, 0xCF641, 0xCF661, 0xCF681, 0xCF6A1, 0xCF6C1, 0xCF6E1, 0xCF701, 0xCF721, 0xCF741, 0xCF761,
+		0xCF781, 0xCF7A1, 0xCF7C1, 0xCF7E1, 0xCF801, 0xCF821, 0xCF841, 0xCF861, 0xCF881, 0xCF8A1, 0xCF8C1, 0xCF8E1, 0xCF901, 0xCF921, 0xCF941, 0xCF961,
+		0xCF981, 0xCF9A1, 0xCF9C1, 0xCF9E1, 0xCFA01, 0xCFA21, 0xCFA41, 0xCFA61, 0xCFA81, 0xCFAA1, 0xCFAC1, 0xCFAE1, 0xCFB01, 0xCFB21, 0xCFB41, 0xCFB61,
+		0xCFB81, 0xCFBA1, 0xCFBC1, 0xCFBE1, 0xCFC01, 0xCFC21, 0xCFC41, 0xCFC61, 0xCFC81, 0xCFCA1, 0xCFCC1, 0xCFCE1, 0xCFD01, 0xCFD21, 0xCFD41, 0xCFD61,
+		0xCFD81, 0xCFDA1, 0xCFDC1, 0xCFDE1, 0xCFE01, 0xCFE21, 0xCFE41, 0xCFE61, 0xCFE81, 0xCFEA1, 0xCFEC1, 0xCFEE1, 0xCFF01, 0xCFF21, 0xCFF41, 0xCFF61,
+		0xCFF81, 0xCFFA1, 0xCFFC1, 0xCFFE1, 0xD0001, 0xD0021, 0xD0041, 0xD0061, 0xD0081, 0xD00A1, 0xD00C1, 0xD00E1, 0xD0101, 0xD0121, 0xD0141, 0xD0161,
+		0xD0181, 0xD01A1, 0xD01C1, 0xD01E1, 0xD0201, 0xD0221, 0xD0241, 0xD0261, 0xD0281, 0xD02A1, 0xD02C1, 0xD02E1, 0xD0301, 0xD0321, 0xD0341, 0xD0361,
+		0xD0381, 0xD03A1, 0xD03C1, 0xD03E1, 0xD0401, 0xD0421, 0xD0441, 0xD0461, 0xD0481, 0xD04A1, 0xD04C1, 0xD04E1, 0xD0501, 0xD0521, 0xD0541, 0xD0561,
+		0xD0581, 0xD05A1, 0xD05C1, 0xD05E1, 0xD0601, 0xD0621, 0xD0641, 0xD0661, 0xD0681, 0xD06A1, 0xD06C1, 0xD06E1, 0xD0701, 0xD0721, 0xD0741, 0xD0761,
+		0xD0781, 0xD07A1, 0xD07C1, 0xD07E1, 0xD0801, 0xD0821, 0xD0841, 0xD0861, 0xD0881, 0xD08A1, 0xD08C1, 0xD08E1, 0xD0901, 0xD0921, 0xD0941, 0xD0961,
+		0xD0981, 0xD09A1, 0xD09C1, 0xD09E1, 0xD0A01, 0xD0A21, 0xD0A41, 0xD0A61, 0xD0A81, 0xD0AA1, 0xD0AC1, 0xD0AE1, 0xD0B01, 0xD0B21, 0xD0B41, 0xD0B61,
+		0xD0B81, 0xD0BA1, 0xD0BC1, 0xD0BE1, 0xD0C01, 0xD0C21, 0xD0C41, 0xD0C61, 0xD0C81, 0xD0CA1, 0xD0CC1, 0xD0CE1, 0xD0D01, 0xD0D21, 0xD0D41, 0xD0D61,
+		0xD0D81, 0xD0DA1, 0xD0DC1, 0xD0DE1, 0xD0E01, 0xD0E21, 0xD0E41, 0xD0E61, 0xD0E81, 0xD0EA1, 0xD0EC1, 0xD0EE1, 0xD0F01, 0xD0F21, 0xD0F41, 0xD0F61,
+		0xD0F81, 0xD0FA1, 0xD0FC1, 0xD0FE1, 0xD1001, 0xD1021, 0xD1041, 0xD1061, 0xD1081, 0xD10A1, 0xD10C1, 0xD10E1, 0xD1101, 0xD1121, 0xD1141, 0xD1161,
+		0xD1181, 0xD11A1, 0xD11C1, 0xD11E1, 0xD1201, 0xD1221, 0xD1241, 0xD1261, 0xD1281, 0xD12A1, 0xD12C1, 0xD12E1, 0xD1301, 0xD1321, 0xD1341, 0xD1361,
+		0xD1381, 0xD13A1, 0xD13C1, 0xD13E1, 0xD1401, 0xD1421, 0xD1441, 0xD1461, 0xD1481, 0xD14A1, 0xD14C1, 0xD14E1, 0xD1501, 0xD1521, 0xD1541, 0xD1561,
+		0xD1581, 0xD15A1, 0xD15C1, 0xD15E1, 0xD1601, 0xD1621, 0xD1641, 0xD1661, 0xD1681, 0xD16A1, 0xD16C1, 0xD16E1, 0xD1701, 0xD1721, 0xD1741, 0xD1761,
+		0xD1781, 0xD17A1, 0xD17C1, 0xD17E1, 0xD1801, 0xD1821, 0xD1841, 0xD1861, 0xD1881, 0xD18A1, 0xD18C1, 0xD18E1, 0xD1901, 0xD1921, 0xD1941, 0xD1961,
+		0xD1981, 0xD19A1, 0xD19C1, 0xD19E1, 0xD1A01, 0xD1A21, 0xD1A41, 0xD1A61, 0xD1A81, 0xD1AA1, 0xD1AC1, 0xD1AE1, 0xD1B01, 0xD1B21, 0xD1B41, 0xD1B61,
+		0xD1B81, 0xD1BA1, 0xD1BC1, 0xD1BE1, 0xD1C01, 0xD1C21, 0xD1C41, 0xD1C61, 0xD1C81, 0xD1CA1, 0xD1CC1, 0xD1CE1, 0xD1D01, 0xD1D21, 0xD1D41, 0xD1D61,
+		0xD1D81, 0xD1DA1, 0xD1DC1, 0xD1DE1, 0xD1E01, 0xD1E21, 0xD1E41, 0xD1E61, 0xD1E81, 0xD1EA1, 0xD1EC1, 0xD1EE1, 0xD1F01, 0xD1F21, 0xD1F41, 0xD1F61,
+		0xD1F81, 0xD1FA1, 0xD1FC1, 0xD1FE1, 0xD2001, 0xD2021, 0xD2041, 0xD2061, 0xD2081, 0xD20A1, 0xD20C1, 0xD20E1, 0xD2101, 0xD2121, 0xD2141, 0xD2161,
+		0xD2181, 0xD21A1, 0xD21C1, 0xD21E1, 0xD2201, 0xD2221, 0xD2241, 0xD2261, 0xD2281, 0xD22A1, 0xD22C1, 0xD22E1, 0xD2301, 0xD2321, 0xD2341, 0xD2361,
+		0xD2381, 0xD23A1, 0xD23C1, 0xD23E1, 0xD2401, 0xD2421, 0xD2441, 0xD2461, 0xD2481, 0xD24A1, 0xD24C1, 0xD24E1, 0xD2501, 0, 0, 0,
+		0x1EC01, 0x1EC21, 0x1EC41, 0x1EC61, 0x1EC81, 0x1ECA1, 0x1ECC1, 0x1ECE1, 0x1ED01, 0x1ED21, 0x1ED41, 0x1ED61, 0x1ED81, 0x1EDA1, 0x1EDC1, 0x1EDE1,
+		0x1EE01, 0x1EE21, 0x1EE41, 0x1EE61, 0x1EE81, 0x1EEA1, 0x1EEC1, 0x1EEE1, 0x1EF01, 0x1EF21, 0x1EF41, 0x1EF61, 0x1EF81, 0x1EFA1, 0x1EFC1, 0x1EFE1,
+		0x1F001, 0x1F021, 0x1F041, 0x1F061, 0x1F081, 0x1F0A1, 0x1F0C1, 0x1F0E1, 0x1F101, 0x1F121, 0x1F141, 0x1F161, 0x1F181, 0x1F1A1, 0x1F1C1, 0x1F1E1,
+		0x1F201, 0x1F221, 0x1F241, 0x1F261, 0x1F281, 0x1F2A1, 0x1F2C1, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD25E1, 0xD2601, 0xD2621, 0xD2641, 0xD2661, 0xD2681, 0xD26A1, 0xD26C1, 0xD26E1, 0xD2701, 0xD2721, 0xD2741, 0xD2761, 0xD2781, 0xD27A1, 0xD27C1,
+		0xD27E1, 0xD2801, 0xD2821, 0xD2841, 0xD2861, 0xD2881, 0xD28A1, 0xD28C1, 0xD28E1, 0xD2901, 0xD2961, 0xD2921, 0xD2981, 0xD2941, 0xD29A1, 0xD29C1,
+		0xD29E1, 0xD2A01, 0xD2A21, 0xD2A41, 0xD2A61, 0xD2A81, 0xD2AA1, 0xD2AC1, 0xD2521, 0xD2541, 0xD2561, 0xD2581, 0xD25C1, 0xD25A1, 0x1F2E1, 0x1F301,
+		0xB40A1, 0xB40C1, 0xB40E1, 0xB4101, 0xB4121, 0xB4141, 0xB4161, 0xB4181, 0xB41A1, 0xB41C1, 0xB41E1, 0xB4201, 0xB4221, 0xB4281, 0xB42A1, 0xB42C1,
+		0xB42E1, 0xB4301, 0xB4321, 0xB4341, 0xB4361, 0xB4381, 0xB43A1, 0xB43C1, 0xB43E1, 0xB4401, 0xB4421, 0xB4441, 0xB4461, 0xB4481, 0xB44A1, 0xB4501,
+		0xB4521, 0xB4541, 0xB4561, 0xB4581, 0xB45A1, 0xB45C1, 0xB45E1, 0xB4601, 0xB4621, 0xB4641, 0xB4661, 0xB4681, 0xB46A1, 0xB46C1, 0xB46E1, 0xB4701,
+		0xB4721, 0xB4741, 0xB4761, 0xB4781, 0xB47E1, 0xB4801, 0xB4821, 0xB4841, 0xB4861, 0xB4881, 0xB48A1, 0xB48C1, 0xB48E1, 0xB4901, 0xB4921, 0xB4941,
+		0xB4961, 0xB4981, 0xB49A1, 0xB49C1, 0xB49E1, 0xB4A01, 0xB4A21, 0xB4A41, 0xB4AA1, 0xB4AC1, 0xB4AE1, 0xB4B01, 0xB4B21, 0xB4B41, 0xB4B61, 0xB4B81,
+		0xB4BA1, 0xB4BC1, 0xB4BE1, 0xB4C41, 0xB4C61, 0xB4C81, 0xB4CA1, 0xB4CC1, 0xB4CE1, 0xB4D61, 0xB4D81, 0xB4DE1, 0xB4E01, 0xB4E21, 0xB4E41, 0xB4E61,
+		0xB4E81, 0xB4EE1, 0xB4F01, 0xB4F21, 0xB4F41, 0xB4F61, 0xB4F81, 0xB4FA1, 0xB4FC1, 0xB4FE1, 0xB5001, 0xB5041, 0xB5061, 0xB5081, 0xB50A1, 0xB50E1,
+		0xB5101, 0xB5121, 0xB5141, 0xB5161, 0xB5181, 0xB51A1, 0xB51C1, 0xB51E1, 0xB5201, 0xB5221, 0xB5241, 0xB5261, 0xB5281, 0xB52A1, 0xB52C1, 0xB52E1,
+		0xB5301, 0xB5321, 0xB5341, 0xB5361, 0xB5381, 0xB53A1, 0xB5401, 0xB5421, 0xB5461, 0xB5481, 0xB54A1, 0xB54C1, 0xB54E1, 0xB5501, 0xB5521, 0xB5541,
+		0xB5561, 0xB5581, 0xB55A1, 0xB55C1, 0xB55E1, 0xB5601, 0xB5621, 0xB5641, 0xB5661, 0xB5681, 0xB56A1, 0xB56C1, 0xB56E1, 0xB5701, 0xB5721, 0xB5741,
+		0xB5761, 0xB5781, 0xB57A1, 0xB57C1, 0xB57E1, 0xB5801, 0xB5821, 0xB5841, 0xB5861, 0xB5881, 0xB58A1, 0xB58C1, 0xB58E1, 0xB5901, 0xB5921, 0xB5941,
+		0xB5961, 0xB5981, 0xB59A1, 0xB59C1, 0xB59E1, 0xB5A41, 0xB5A61, 0xB5A81, 0xB5AA1, 0xB5AC1, 0xB5AE1, 0xB5B01, 0xB5B21, 0xB5B41, 0xB5B61, 0xB5B81,
+		0xB5BA1, 0xB5BC1, 0xB5BE1, 0xB5C01, 0xB5C21, 0xB5C41, 0xB5C61, 0xB5C81, 0xB5CA1, 0xB5CC1, 0xB5CE1, 0xB5D01, 0xB5D61, 0xB5D81, 0xB5DA1, 0xB5DC1,
+		0xB5DE1, 0xB5E01, 0xB5E81, 0xB5EA1, 0xB5EC1, 0xB5EE1, 0xB5F01, 0xB5F21, 0xB5F41, 0xB5FA1, 0xB5FC1, 0xB5FE1, 0xB6001, 0xB6021, 0xB6041, 0xB6061,
+		0xB6081, 0xB60A1, 0xB60C1, 0xB60E1, 0xB6101, 0xB6121, 0xB6141, 0xB6161, 0xB6181, 0xB61A1, 0xB61C1, 0xB61E1, 0xB6201, 0xB6221, 0xB6241, 0xB6261,
+		0xB6281, 0xB62A1, 0xB62C1, 0xB62E1, 0xB6301, 0xB6321, 0xB6341, 0xB6361, 0xB6381, 0xB63A1, 0xB63C1, 0xB63E1, 0xB6401, 0xB6421, 0xB6441, 0xB6461,
+		0xB6481, 0xB64A1, 0xB64C1, 0xB64E1, 0xB6501, 0xB6521, 0xB6541, 0xB6561, 0xB6581, 0xB65A1, 0xB65C1, 0xB65E1, 0xB6601, 0x1F321, 0x1F341, 0x1F361,
+		0xB4D01, 0xB5021, 0xB5441, 0xB4242, 0xB44C2, 0xB47A2, 0xB4A62, 0xB4C02, 0xB4D22, 0xB4DA2, 0xB4EA2, 0xB53C2, 0xB5A02, 0xB5D22, 0xB5E42, 0xB5F62,
+		0x426A1, 0x430E1, 0x45E81, 0x47B21, 0x48EE1, 0x4A0E1, 0x4B141, 0x4BEA1, 0x4CC81, 0x4D9C1, 0xB50C1, 0xB5E21, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x74061, 0x74041, 0x74121, 0x74101, 0x741A1, 0x74181, 0x74601, 0x745E1, 0x746E1, 0x746A1, 0x75B01, 0x75AA1, 0x75E61, 0x75E41, 0x764A1, 0x76481,
+		0x765C1, 0x765A1, 0x76821, 0x76801, 0x76981, 0x76961, 0x769E1, 0x769A1, 0x76B61, 0x76B41, 0x76C01, 0x76BE1, 0x76C81, 0x76C61, 0x76EC1, 0x76EA1,
+		0x75F81, 0x75F61, 0x73A61, 0x73A41, 0x74B81, 0x74B61, 0x74CE1, 0x74CC1, 0x750C1, 0x74FE1, 0x750E1, 0x75001, 0x75101, 0x75021, 0x75041, 0x3ECC1,
+		0x1F381, 0x1F3A1, 0x1F3C1, 0x1F3E1, 0x73D01, 0x742E1, 0x74562, 0x757E1, 0x76541, 0x76601, 0x766E1, 0x75DC1, 0x3DF01, 0x3DF21, 0x1F401, 0x764E1,
+		0x739E1, 0x739C1, 0x742A1, 0x74281, 0x73F01, 0x73EE1, 0x762A1, 0x76281, 0x74221, 0x74201, 0x75761, 0x75741, 0x756A1, 0x75681, 0x75FC1, 0x75FA1,
+		0x76041, 0x76021, 0x761A1, 0x76181, 0x75D81, 0x75D61, 0x76401, 0x763E1, 0x75121, 0x75061, 0x75141, 0x75081, 0x76581, 0x76721, 0x75B81, 0x76AA1,
+		0xB6621, 0xB6641, 0xB6661, 0xB6681, 0xB66A1, 0xB66C1, 0xB66E1, 0xB6701, 0xB6721, 0xB6741, 0xB6761, 0xB6781, 0xB67A1, 0xB67C1, 0xB67E1, 0xB6801,
+		0xB6821, 0xB6841, 0xB6861, 0xB6881, 0xB68A1, 0xB68C1, 0xB68E1, 0xB6901, 0xB6921, 0xB6941, 0xB6961, 0xB6981, 0xB69A1, 0xB69C1, 0xB69E1, 0xB6A01,
+		0xB6A21, 0xB6A41, 0xB6A61, 0xB6A81, 0xB6AA1, 0xB6AC1, 0xB6AE1, 0xB6B01, 0xB6B21, 0xB6B41, 0xB6B61, 0xB6B81, 0xB6BA1, 0xB6BC1, 0xB6BE1, 0xB6C01,
+		0xB6C21, 0xB6C41, 0xB6C61, 0xB6C81, 0xB6CA1, 0xB6CC1, 0xB6CE1, 0xB6D01, 0xB6D21, 0xB6D41, 0xB6D61, 0xB6D81, 0xB6DA1, 0xB6DC1, 0xB6DE1, 0xB6E01,
+		0xB6E21, 0xB6E41, 0xB6E61, 0xB6E81, 0xB6EA1, 0xB6EC1, 0xB6EE1, 0xB6F01, 0xB6F21, 0xB6F41, 0xB6F61, 0xB6F81, 0xB6FA1, 0xB6FC1, 0xB6FE1, 0xB7001,
+		0x3FF01, 0x3FF21, 0x1F421, 0x1F441, 0x1F461, 0x1F481, 0x1F4A1, 0x1F4C1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x1F4E1, 0x1F501, 0x1F521, 0x1F541, 0x1F561, 0x1F581, 0x1F5A1, 0x1F5C1, 0x1F5E1, 0x1F601, 0x1F621, 0x1F641, 0x1F661, 0x1F681, 0x1F6A1, 0x1F6C1,
+		0x1F6E1, 0x1F701, 0x1F721, 0x1F741, 0x1F761, 0x1F781, 0x1F7A1, 0x1F7C1, 0x1F7E1, 0x1F801, 0x1F821, 0x1F841, 0x1F861, 0x1F881, 0x1F8A1, 0x1F8C1,
+		0x1F8E1, 0x1F901, 0x69B41, 0x69B21, 0x69CC1, 0x69CA1, 0x574A1, 0x57481, 0x63EC2, 0x63E82, 0x698A1, 0x69881, 0x698E1, 0x698C1, 0x69921, 0x69901,
+		0x55641, 0x62FE1, 0x4FBC2, 0x4FB82, 0x50182, 0x50142, 0x502C2, 0x50282, 0x503C2, 0x50382, 0x50463, 0x50403, 0x50502, 0x504C2, 0x52161, 0x52141,
+		0x59FA1, 0x59F81, 0x59FE1, 0x59FC1, 0x5A021, 0x5A001, 0x5B001, 0x5AFE1, 0x5B061, 0x5B041, 0x5F801, 0x5F7E1, 0x5F6C1, 0x5F6A1, 0x5F3A2, 0x5F362,
+		0x60461, 0x60441, 0x60521, 0x60501, 0x60561, 0x60541, 0x60B81, 0x60B61, 0x60BC1, 0x60BA1, 0x61AE1, 0x61AA1, 0x61EA1, 0x61E81, 0x66921, 0x66901,
+		0x668A2, 0x66862, 0x693E1, 0x693C1, 0x69601, 0x695E1, 0x69641, 0x69621, 0x696C1, 0x696A1, 0x69761, 0x69741, 0x697A1, 0x69781, 0x69801, 0x697C1,
+		0x69821, 0x53341, 0x5B301, 0x5C3C1, 0x5D3E1, 0x61E41, 0x61E61, 0x640A1, 0x69841, 0x52CA2, 0x52C62, 0x55322, 0x552E2, 0x56162, 0x56501, 0x564E1,
+		0x5B361, 0x5B341, 0x617C2, 0x61782, 0x62BA2, 0x62B62, 0x63BE2, 0x63BA2, 0x1F921, 0x1F941, 0x1F961, 0x69B81, 0x69B61, 0x65C61, 0x5B2C1, 0x69BA1,
+		0x5D321, 0x5D301, 0x52041, 0x52021, 0x52061, 0x57381, 0x510A1, 0x51081, 0x556A1, 0x55681, 0x4F382, 0x4F302, 0x5E0C2, 0x5E042, 0x64AA2, 0x64A22,
+		0x560E2, 0x560A2, 0x598C2, 0x59882, 0x5CCC2, 0x5CC82, 0x61482, 0x61442, 0x628E2, 0x628A2, 0x573C1, 0x54BA1, 0x56361, 0x5B1E1, 0x58781, 0,
+		0x5A061, 0x640E1, 0x590A1, 0x67DA1, 0x511A1, 0x51161, 0x5F861, 0x5F841, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x587E1, 0x56EA2, 0x5F2C3, 0x65D41, 0x55781, 0x60581, 0x5C381, 0x587C1, 0x5C3A1,
+		0x94901, 0x94921, 0x94941, 0x94961, 0x94981, 0x949A1, 0x949C1, 0x949E1, 0x94A01, 0x94A21, 0x94A41, 0x40881, 0x94A61, 0x94A81, 0x94AA1, 0x94AC1,
+		0x94AE1, 0x94B01, 0x94B21, 0x94B41, 0x94B61, 0x94B81, 0x94BA1, 0x94BC1, 0x94BE1, 0x94C01, 0x94C21, 0x94C41, 0x94C61, 0x94C81, 0x94CA1, 0x94CC1,
+		0x94CE1, 0x94D01, 0x94D21, 0x94D41, 0x94D61, 0x94D81, 0x94DA1, 0x94DC1, 0x1F981, 0x1F9A1, 0x1F9C1, 0x1F9E1, 0, 0, 0, 0,
+		0x1FA01, 0x1FA21, 0x1FA41, 0x1FA61, 0x1FA81, 0x1FAA1, 0x1FAC1, 0x1FAE1, 0x41E41, 0x1FB01, 0, 0, 0, 0, 0, 0,
+		0xA0581, 0xA05A1, 0xA05C1, 0xA05E1, 0xA0601, 0xA0621, 0xA0641, 0xA0661, 0xA0701, 0xA0721, 0xA0741, 0xA0761, 0xA0781, 0xA07A1, 0xA07C1, 0xA07E1,
+		0xA0801, 0xA0821, 0xA0841, 0xA0861, 0xA08A1, 0xA08C1, 0xA08E1, 0xA0901, 0xA0961, 0xA09C1, 0xA09E1, 0xA0A21, 0xA0A41, 0xA0AA1, 0xA0B41, 0xA0B61,
+		0xA0B81, 0xA0BA1, 0xA0AC1, 0xA0AE1, 0xA0B01, 0xA0B21, 0xA0BC1, 0xA0881, 0xA0921, 0xA0681, 0xA06A1, 0xA06C1, 0xA06E1, 0xA0941, 0xA0A01, 0xA0A61,
+		0xA0A81, 0xA0981, 0xA09A1, 0xA0BE1, 0x1FB21, 0x1FB41, 0x1FB61, 0x1FB81, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x408A1, 0x40CE1, 0x94DE1, 0x94E01, 0x94E21, 0x94E41, 0x94E61, 0x94E81, 0x94EA1, 0x94EC1, 0x94EE1, 0x94F01, 0x94F21, 0x94F41, 0x94F61, 0x94F81,
+		0x94FA1, 0x94FC1, 0x94FE1, 0x95001, 0x95021, 0x95041, 0x95061, 0x95081, 0x950A1, 0x950C1, 0x950E1, 0x95101, 0x95121, 0x95141, 0x95161, 0x95181,
+		0x951A1, 0x951C1, 0x951E1, 0x95201, 0x95221, 0x95241, 0x95261, 0x95281, 0x952A1, 0x952C1, 0x952E1, 0x95301, 0x95321, 0x95341, 0x95361, 0x95381,
+		0x953A1, 0x953C1, 0x953E1, 0x95401, 0x95421, 0x95441, 0x95461, 0x95481, 0x954A1, 0x954C1, 0x954E1, 0x95501, 0x95521, 0x95541, 0x95561, 0x95581,
+		0x955A1, 0x955C1, 0x955E1, 0x95601, 0x95621, 0x404C1, 0, 0, 0, 0, 0, 0, 0, 0, 0x1FBA1, 0x1FBC1,
+		0x426C1, 0x43101, 0x45EA1, 0x47B41, 0x48F01, 0x4A101, 0x4B161, 0x4BEC1, 0x4CCA1, 0x4D9E1, 0, 0, 0, 0, 0, 0,
+		0x1FBE1, 0x1FC01, 0x1FC21, 0x1FC41, 0x1FC61, 0x1FC81, 0x1FCA1, 0x1FCC1, 0x1FCE1, 0x1FD01, 0x1FD21, 0x1FD41, 0x1FD61, 0x1FD81, 0x1FDA1, 0x1FDC1,
+		0x1FDE1, 0x1FE01, 0x8EBE1, 0x8EC01, 0x8EC21, 0x8EC41, 0x8EC61, 0x8EC81, 0x1FE21, 0x1FE41, 0x1FE61, 0x8ECA1, 0x1FE81, 0x8DF41, 0, 0,
+		0x426E1, 0x43121, 0x45EC1, 0x47B61, 0x48F21, 0x4A121, 0x4B181, 0x4BEE1, 0x4CCC1, 0x4DA01, 0xA2B01, 0xA2B21, 0xA2B41, 0xA2B61, 0xA2B81, 0xA2BA1,
+		0xA2BC1, 0xA2BE1, 0xA2C01, 0xA2C21, 0xA2C41, 0xA2C61, 0xA2C81, 0xA2CA1, 0xA2CC1, 0xA2CE1, 0xA2D01, 0xA2D21, 0xA2D41, 0xA2D61, 0xA2D81, 0xA2DA1,
+		0xA2DC1, 0xA2DE1, 0xA2E01, 0xA2E21, 0xA2E41, 0xA2E61, 0xA2E81, 0xA2EA1, 0xA2EC1, 0xA2EE1, 0xA2F01, 0x41241, 0x41261, 0x41281, 0x1FEA1, 0x1FEC1,
+		0xA2681, 0xA26A1, 0xA26C1, 0xA26E1, 0xA2701, 0xA2721, 0xA2741, 0xA2761, 0xA2781, 0xA27A1, 0xA27C1, 0xA27E1, 0xA2801, 0xA2821, 0xA2841, 0xA2861,
+		0xA2881, 0xA28A1, 0xA28C1, 0xA28E1, 0xA2901, 0xA2921, 0xA2941, 0xA2961, 0xA2981, 0xA29A1, 0xA29C1, 0xA29E1, 0xA2A01, 0xA2A21, 0xA2A41, 0xA2A61,
+		0xA2A81, 0xA2AA1, 0xA2AC1, 0xA2AE1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1FEE1,
+		0xC0521, 0xC0541, 0xC0561, 0xC0581, 0xC05A1, 0xC05C1, 0xC05E1, 0xC0601, 0xC0621, 0xC0641, 0xC0661, 0xC0681, 0xC06A1, 0xC06C1, 0xC06E1, 0xC0701,
+		0xC0721, 0xC0741, 0xC0761, 0xC0781, 0xC07A1, 0xC07C1, 0xC07E1, 0xC0801, 0xC0821, 0xC0841, 0xC0861, 0xC0881, 0xC08A1, 0, 0, 0,
+		0x404E1, 0x408C1, 0x40F21, 0x40D01, 0xA7E41, 0xA7E61, 0xA7E81, 0xA7EA1, 0xA7EC1, 0xA7EE1, 0xA7F01, 0xA7F21, 0xA7F41, 0xA7F61, 0xA7F81, 0xA7FA1,
+		0xA7FC1, 0xA7FE1, 0xA8001, 0xA8021, 0xA8041, 0xA8061, 0xA8081, 0xA80A1, 0xA80C1, 0xA80E1, 0xA8101, 0xA8121, 0xA8141, 0xA8161, 0xA8181, 0xA81A1,
+		0xA81C1, 0xA81E1, 0xA8201, 0xA8221, 0xA8241, 0xA8261, 0xA8281, 0xA82A1, 0xA82C1, 0xA82E1, 0xA8301, 0xA8341, 0xA8361, 0xA83A1, 0xA83C1, 0xA83E1,
+		0xA8401, 0xA8421, 0xA8441, 0x401E1, 0xA8461, 0xA8481, 0xA84C1, 0xA84E1, 0xA8501, 0xA8521, 0xA8561, 0xA8581, 0xA84A1, 0xA8541, 0xA8321, 0xA8381,
+		0xA85A1, 0x1FF01, 0x1FF21, 0x1FF41, 0x1FF61, 0x1FF81, 0x1FFA1, 0x1FFC1, 0x1FFE1, 0x20001, 0x20021, 0x20041, 0x20061, 0x20081, 0, 0x41901,
+		0x42701, 0x43141, 0x45EE1, 0x47B81, 0x48F41, 0x4A141, 0x4B1A1, 0x4BF01, 0x4CCE1, 0x4DA21, 0, 0, 0, 0, 0x200A1, 0x200C1,
+		0xA3041, 0xA3141, 0xA3281, 0xA3541, 0xA3861, 0xA4221, 0x41921, 0xA3381, 0xA37C1, 0xA3001, 0xA3061, 0xA31E1, 0xA32C1, 0xA3481, 0xA34E1, 0xA3561,
+		0x42721, 0x43161, 0x45F01, 0x47BA1, 0x48F61, 0x4A161, 0x4B1C1, 0x4BF21, 0x4CD01, 0x4DA41, 0xA3C41, 0xA3601, 0xA3661, 0xA3821, 0xA3881, 0,
+		0xA6CE1, 0xA6D01, 0xA6D21, 0xA6D41, 0xA6D61, 0xA6D81, 0xA6DA1, 0xA6DC1, 0xA6DE1, 0xA6E01, 0xA6E21, 0xA6E41, 0xA6E61, 0xA6E81, 0xA6EA1, 0xA6EC1,
+		0xA6EE1, 0xA6F01, 0xA6F21, 0xA6F41, 0xA6F61, 0xA6F81, 0xA6FA1, 0xA6FC1, 0xA6FE1, 0xA7001, 0xA7021, 0xA7041, 0xA7061, 0xA7081, 0xA70A1, 0xA70C1,
+		0xA70E1, 0xA7101, 0xA7121, 0xA7141, 0xA7161, 0xA7181, 0xA71A1, 0xA71C1, 0xA71E1, 0xA7281, 0xA72A1, 0xA72C1, 0xA72E1, 0xA7301, 0xA7321, 0xA7341,
+		0xA7361, 0xA7381, 0xA73A1, 0xA7201, 0xA7221, 0xA7241, 0xA7261, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA73C1, 0xA73E1, 0xA7401, 0xA7421, 0xA7441, 0xA7461, 0xA7481, 0xA74A1, 0xA74C1, 0xA74E1, 0xA7501, 0xA7521, 0xA7541, 0xA7561, 0, 0,
+		0x42741, 0x43181, 0x45F21, 0x47BC1, 0x48F81, 0x4A181, 0x4B1E1, 0x4BF41, 0x4CD21, 0x4DA61, 0, 0, 0x200E1, 0x20101, 0x20121, 0x20141,
+		0xA2FE1, 0xA3101, 0xA3161, 0xA31C1, 0xA32A1, 0xA3361, 0xA33E1, 0xA3421, 0xA3461, 0xA34C1, 0xA3641, 0xA36C1, 0xA3B41, 0xA3BA1, 0xA3BE1, 0xA3781,
+		0x41941, 0xA3C01, 0xA3221, 0xA3941, 0xA4521, 0xA4541, 0xA4561, 0x20161, 0x20181, 0x201A1, 0xA3961, 0xA44C1, 0xA44E1, 0xA4501, 0xA3181, 0xA3301,
+		0x9DD81, 0x9DDA1, 0x9DDC1, 0x9DDE1, 0x9DE01, 0x9DE21, 0x9DE41, 0x9DE61, 0x9DE81, 0x9DEA1, 0x9DEC1, 0x9DEE1, 0x9DF01, 0x9DF21, 0x9DF41, 0x9DF61,
+		0x9DF81, 0x9DFA1, 0x9DFC1, 0x9DFE1, 0x9E001, 0x9E021, 0x9E041, 0x9E061, 0x9E081, 0x9E0A1, 0x9E0C1, 0x9E0E1, 0x9E101, 0x9E121, 0x9E141, 0x9E161,
+		0x9E181, 0x9E1A1, 0x9E1C1, 0x9E1E1, 0x9E201, 0x9E221, 0x9E241, 0x9E261, 0x9E281, 0x9E2A1, 0x9E2C1, 0x9E2E1, 0x9E301, 0x9E321, 0x9E341, 0x9E361,
+		0x9E381, 0x9E3A1, 0x9E3C1, 0x9E3E1, 0x9E401, 0x9E421, 0x9E441, 0x9E461, 0x9E481, 0x9E4A1, 0x9E4C1, 0x9E4E1, 0x9E501, 0x9E521, 0x9E541, 0x411E1,
+		0x9E561, 0x41201, 0x9E581, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x9E5A1, 0x9E5C1, 0x41961, 0x201C1, 0x201E1,
+		0x944A1, 0x944C1, 0x944E1, 0x94501, 0x94521, 0x94541, 0x94561, 0x94581, 0x945A1, 0x945C1, 0x945E1, 0x94701, 0x94721, 0x94741, 0x94761, 0x94781,
+		0x20201, 0x20221, 0x94121, 0x41981, 0x419A1, 0x947A1, 0x948E1, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x8AE81, 0x8AEA1, 0x8AEC1, 0x8AEE1, 0x8AF01, 0x8AF21, 0, 0, 0x8C761, 0x8C781, 0x8C7A1, 0x8C7C1, 0x8C7E1, 0x8C801, 0,
+		0, 0x8C381, 0x8C3A1, 0x8C3C1, 0x8C3E1, 0x8C401, 0x8C421, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x8CFC1, 0x8CFE1, 0x8D001, 0x8D021, 0x8D041, 0x8D061, 0x8D081, 0, 0x8D2C1, 0x8D2E1, 0x8D301, 0x8D321, 0x8D341, 0x8D361, 0x8D381, 0,
+		0x50761, 0x50661, 0x54881, 0x548A1, 0x54921, 0x55661, 0x563A1, 0x5B201, 0x5B161, 0x5B1A1, 0x5C361, 0x5D3C1, 0x5D461, 0x5F461, 0x5F581, 0x5F641,
+		0x5F501, 0x5F4C1, 0x5F4E1, 0x5F521, 0x5F541, 0x61A01, 0x61A81, 0x61D61, 0x61D81, 0x61CE1, 0x61DA1, 0x61DC1, 0x61DE1, 0x63181, 0x65A61, 0x65BA1,
+		0x65B01, 0x65B21, 0x65C01, 0x67D81, 0x67DC1, 0x67DE1, 0x67D01, 0x67D21, 0x67D41, 0x67D61, 0x688E1, 0x20241, 0x574C1, 0x5B221, 0x5B141, 0x65C21,
+		0x696E1, 0x69701, 0x5F681, 0x69721, 0x50801, 0x72321, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xA9AA1, 0xA9AE1, 0xA9B21, 0xA9B61, 0xA9BA1, 0xA9BE1, 0xA9C21, 0xA9C61, 0xA9CA1, 0xA9CE1, 0xA9D21, 0xA9D61, 0xA9DA1, 0xA9DE1, 0xA9E21, 0xA9E61,
+		0xA9EA1, 0xA9EE1, 0xA9F21, 0xA9F61, 0xA9FA1, 0xA9FE1, 0xAA021, 0xAA061, 0xAA0A1, 0xAA0E1, 0xAA121, 0xAA161, 0xAA1A1, 0xAA1E1, 0xAA221, 0xAA261,
+		0xAA2A1, 0xAA2E1, 0xAA321, 0xAA361, 0xAA3A1, 0xAA3E1, 0xAA421, 0xAA461, 0xAA4A1, 0xAA4E1, 0xAA521, 0xAA561, 0xAA5A1, 0xAA5E1, 0xAA621, 0xAA661,
+		0xAA6A1, 0xAA6E1, 0xAA721, 0xAA761, 0xAA7A1, 0xAA7E1, 0xAA821, 0xAA861, 0xAA8A1, 0xAA8E1, 0xAA921, 0xAA961, 0xAA9A1, 0xAA9E1, 0xAAA21, 0xAAA61,
+		0xAAAA1, 0xAAAE1, 0xAAB21, 0xAAB61, 0xAABA1, 0xAABE1, 0xAAC21, 0xAAC61, 0xAACA1, 0xAACE1, 0xAAD21, 0xAAD61, 0xAADA1, 0xAADE1, 0xAAE21, 0xAAE61,
+		0x94141, 0x94161, 0x94181, 0x941A1, 0x941C1, 0x941E1, 0x94201, 0x94221, 0x94241, 0x94261, 0x94281, 0x942A1, 0x942C1, 0x942E1, 0x94301, 0x94321,
+		0x94341, 0x94361, 0x94381, 0x943A1, 0x943C1, 0x943E1, 0x94401, 0x94421, 0x94441, 0x94461, 0x94481, 0x947C1, 0x947E1, 0x94801, 0x94821, 0x94841,
+		0x94861, 0x94881, 0x948A1, 0x94601, 0x94621, 0x94641, 0x94661, 0x94681, 0x946A1, 0x946C1, 0x946E1, 0x20261, 0x40F61, 0x948C1, 0, 0,
+		0x42761, 0x431A1, 0x45F41, 0x47BE1, 0x48FA1, 0x4A1A1, 0x4B201, 0x4BF61, 0x4CD41, 0x4DA81, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xC1861, 0xC1881, 0xC18A1, 0xC18C1, 0xC18E1, 0xC1901, 0xC1921, 0xC1941, 0xC1961, 0xC1981, 0xC19A1, 0xC19C1, 0xC19E1, 0xC1A01, 0xC1A21, 0xC1A41,
+		0xC1A61, 0xC1A81, 0xC1AA1, 0xC1AC1, 0xC1AE1, 0xC1B01, 0xC1B21, 0, 0, 0, 0, 0xC29E1, 0xC2A01, 0xC2A21, 0xC2A41, 0xC2A61,
+		0xC2A81, 0xC2AA1, 0xC2AC1, 0xC2AE1, 0xC2B01, 0xC2B21, 0xC2B41, 0xC2B61, 0xC2B81, 0xC2BA1, 0xC2BC1, 0xC2BE1, 0xC2C01, 0xC2C21, 0xC2C41, 0xC2C61,
+		0xC2C81, 0xC2CA1, 0xC2CC1, 0xC2CE1, 0xC2D01, 0xC2D21, 0xC2D41, 0xC2D61, 0xC2D81, 0xC2DA1, 0xC2DC1, 0xC2DE1, 0xC2E01, 0xC2E21, 0xC2E41, 0xC2E61,
+		0xC2E81, 0xC2EA1, 0xC2EC1, 0xC2EE1, 0xC2F01, 0xC2F21, 0xC2F41, 0xC2F61, 0xC2F81, 0xC2FA1, 0xC2FC1, 0xC2FE1, 0, 0, 0, 0,
+		0x109EC2, 0x101102, 0x10A6E2, 0x10A282, 0x1030C2, 0xF8A62, 0xFBAA2, 0x10DCC2, 0x10DD02, 0xFD262, 0x10B282, 0xFC262, 0xFD222, 0xFFAA2, 0x104E82, 0x107142,
+		0x108B62, 0x108FE2, 0x1093E2, 0x10ADA2, 0x101E62, 0x102A42, 0x103802, 0x1044A2, 0x1087A2, 0x10B0C2, 0x10CB42, 0xF8EA2, 0xFB702, 0x102022, 0x103A82, 0x108B22,
+		0x10D1C2, 0xFE1E2, 0x103302, 0x108A22, 0x1094E2, 0xFFF22, 0x107A42, 0x1090A2, 0xFE9E2, 0x101462, 0x102C42, 0x104142, 0x10AEC2, 0xF96E2, 0xFA382, 0xFADC2,
+		0x1005A2, 0x101FA2, 0x103A42, 0x1051C2, 0x107402, 0x108AA2, 0x108C62, 0x10A622, 0x10BF02, 0x10CF82, 0x10D182, 0x1056A2, 0x105E02, 0x106B22, 0x1085E2, 0x10B542,
+		0x10D2E2, 0x109A42, 0xFCD22, 0xFEC22, 0x106662, 0x107742, 0x103EC2, 0x105762, 0x10A182, 0x10BE82, 0xFCCE2, 0xFDF22, 0x101F22, 0x102DC2, 0x103142, 0x106A22,
+		0x106D22, 0x10B9E2, 0xFAD02, 0x107902, 0xFA442, 0xFA402, 0x106102, 0x106B62, 0x108762, 0x10BA62, 0x109D42, 0xFFF62, 0x101EA2, 0x109B82, 0xF8B82, 0xFD9A2,
+		0xFF322, 0x1042C2, 0x104BE2, 0xFB042, 0x105822, 0xF9822, 0xFF0C2, 0xF8862, 0x102902, 0x100822, 0x1069E2, 0xFB922, 0xFCBE2, 0x105322, 0x1087E2, 0x109902,
+		0x1023C2, 0x10A9E2, 0x102882, 0x100062, 0x108322, 0x100262, 0x104BA2, 0xF92A2, 0xF9D22, 0xFA3C2, 0x101C22, 0x106862, 0x107F62, 0x109A02, 0x10B242, 0xFAE82,
+		0xFBE62, 0xFD322, 0xFEB22, 0x100A62, 0x103342, 0x105862, 0x10B802, 0x10CBC2, 0x10D362, 0x10D5A2, 0xFAA42, 0x101082, 0x1021E2, 0x10A8E2, 0xFE762, 0xFF8A2,
+		0xFFB22, 0x100522, 0x103242, 0x1038C2, 0x104722, 0x106042, 0x106BE2, 0x1076C2, 0x10A7A2, 0x1088A2, 0x10ABE2, 0x10B582, 0xFA782, 0xFAAC2, 0xFC022, 0x1037C2,
+		0x1092A2, 0x109942, 0xFE9A2, 0xFF2A2, 0x1001E2, 0x102342, 0x106622, 0x104202, 0xF94A2, 0xFC7A2, 0xFD9E2, 0xFE322, 0xFF362, 0x104462, 0x104622, 0x107302,
+		0x107642, 0x10B3C2, 0x10BE42, 0x10BF42, 0x10C482, 0xF9722, 0x105F82, 0x10B142, 0x10BC22, 0xFF522, 0xF8F22, 0xF99A2, 0xFDA62, 0xFDEA2, 0x100922, 0x101EE2,
+		0x1039C2, 0x104E42, 0x108962, 0x10AD62, 0x10DB62, 0x100F42, 0x10B9A2, 0xFAA02, 0x101862, 0x101922, 0x102B42, 0x102F82, 0x104522, 0x104B62, 0x105662, 0x1069A2,
+		0x10C582, 0xF9E62, 0xFFC22, 0x10BAA2, 0xF98A2, 0xFE162, 0x102E02, 0x10A7E2, 0xFEFC2, 0xFF5A2, 0x1019A2, 0x104302, 0x10BB22, 0xFA802, 0xFBD62, 0xFDFA2,
+		0x100D82, 0x101762, 0x101D22, 0x102982, 0x1044E2, 0x104D42, 0x1070C2, 0x1092E2, 0x1093A2, 0x10B1C2, 0x10BCE2, 0xFB182, 0x102FC2, 0xFBDA2, 0x103A02, 0x104762,
+		0x108A62, 0x10BB62, 0x10D002, 0x10D3A2, 0x1018E2, 0x102D82, 0x107AC2, 0x106342, 0x1064A2, 0x106722, 0x104102, 0x1036C2, 0x109D02, 0xF93A2, 0x108462, 0xFA842,
+		0xFA702, 0xFE8A2, 0xFFFA2, 0x1067E2, 0xFD822, 0x102A82, 0x101042, 0x10A8A2, 0x109122, 0x10BA22, 0x109622, 0xFEAA2, 0xF9AE2, 0xFC462, 0x10DF02, 0x10DF42,
+		0xFCB62, 0x10DF82, 0x100EC2, 0x10DFC2, 0x10E002, 0xFA482, 0x104182, 0x104FC2, 0x105982, 0x105D02, 0x105D42, 0x105F02, 0x10C002, 0x106762, 0x107342, 0x10E042,
+		0x108AE2, 0x10E082, 0x109B02, 0x10E0C2, 0x10E102, 0x10AC22, 0x10AF82, 0x10E142, 0x10E182, 0x10E1C2, 0x10C862, 0x10C8A2, 0x10C8E2, 0x10D142, 0x10AF02, 0x10BBE2,
+		0xF9762, 0xF99E2, 0xF9B62, 0xFAC82, 0xFAE02, 0xFB3C2, 0xFC322, 0xFC522, 0xFC5E2, 0xFCB22, 0xFCC22, 0xFDF62, 0xFDFE2, 0xFF422, 0xFF762, 0xFF7E2,
+		0xFF9E2, 0x1006A2, 0x100B22, 0x100F82, 0x101C62, 0x102C82, 0x102E82, 0x1031C2, 0x103902, 0x103B42, 0x104562, 0x105722, 0x1059C2, 0x105B02, 0x105AC2, 0x105B42,
+		0x105B82, 0x105C02, 0x105E82, 0x105EC2, 0x106142, 0x1062C2, 0x106522, 0x106C22, 0x106CE2, 0x106D62, 0x107082, 0x1074C2, 0x107BC2, 0x108022, 0x108062, 0x108822,
+		0x109462, 0x1096A2, 0x109C02, 0x109C82, 0x10A2C2, 0x10A302, 0x10AAA2, 0x10AC62, 0x10BD22, 0x10C2C2, 0x10C502, 0xFF3A2, 0x10FE82, 0x107E62, 0, 0,
+		0xF88E2, 0xFA302, 0xF9CE2, 0xF96A2, 0xF9B22, 0xF9FA2, 0xFAC02, 0xFAF02, 0xFC362, 0xFC162, 0xFC2A2, 0xFC4E2, 0xFCBA2, 0xFCCA2, 0xFD1E2, 0xFD2A2,
+		0xFD4E2, 0xFD5E2, 0xFEA62, 0xFEAE2, 0xFEF02, 0xFF102, 0xFF4E2, 0xFF6A2, 0xFF562, 0xFF822, 0xFF722, 0xFFA22, 0xFFC62, 0x1002E2, 0x1003A2, 0x100422,
+		0x100722, 0x100F02, 0x1014A2, 0x101522, 0x1017E2, 0x102222, 0x102402, 0x102B82, 0x103102, 0x103042, 0x103202, 0x1033C2, 0x103942, 0x105522, 0x103C22, 0x1040C2,
+		0x1041C2, 0x104662, 0x104862, 0x104AE2, 0x104DC2, 0x104E02, 0x105002, 0x1050C2, 0x1052A2, 0x105462, 0x105422, 0x1057A2, 0x106302, 0x106562, 0x1066E2, 0x106AA2,
+		0x106C62, 0x106E62, 0x107502, 0x1084A2, 0x108722, 0x108F22, 0x1094A2, 0x1095E2, 0x1096E2, 0x109982, 0x109B42, 0x1099C2, 0x109C42, 0x109BC2, 0x109A82, 0x109CC2,
+		0x109D82, 0x10A342, 0x10A822, 0x10AD22, 0x10B102, 0x10B442, 0x10BAE2, 0x10BD62, 0x10C042, 0x10C182, 0x10C302, 0x10C3C2, 0x10C542, 0x10CD62, 0x10DD42, 0x10F942,
+		0x10F902, 0x10FB82, 0x10E742, 0x10EA42, 0x10EA82, 0x110202, 0x110482, 0x110A82, 0x10D962, 0x10DC42, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x553C2, 0x554C2, 0x55502, 0x55403, 0x55463, 0x62F43, 0x62F02, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x79B62, 0x79AA2, 0x79AE2, 0x79E22, 0x79B22, 0, 0, 0, 0, 0, 0x7A662, 0x3EF01, 0x7A723,
+		0x7AAC1, 0x7A0C1, 0x7A361, 0x7A3E1, 0x7A7A1, 0x7A8C1, 0x7A941, 0x7ACE1, 0x7AF01, 0x20281, 0x7ADA2, 0x7AD62, 0x7AE83, 0x7AE23, 0x7A0E2, 0x7A122,
+		0x7A162, 0x7A222, 0x7A2E2, 0x7A382, 0x7A402, 0x7A4A2, 0x7A582, 0, 0x7A602, 0x7A6A2, 0x7A822, 0x7A7E2, 0x7A8E2, 0, 0x7A982, 0,
+		0x7AA02, 0x7AA62, 0, 0x7AB62, 0x7AB22, 0, 0x7AC22, 0x7AC82, 0x7AD02, 0x7ADE2, 0x7AF22, 0x7A462, 0x7A262, 0x7A862, 0x7ABA2, 0x7A1A2,
+		0x7B721, 0x7B701, 0x7CC81, 0x7CC61, 0x7CC21, 0x7CC41, 0x7CD21, 0x7CD01, 0x7CCC1, 0x7CCE1, 0x7CDC1, 0x7CDA1, 0x7CD61, 0x7CD81, 0x7E081, 0x7E061,
+		0x7E021, 0x7E041, 0x7E161, 0x7E141, 0x7E101, 0x7E121, 0x7DFE1, 0x7DFC1, 0x7DF81, 0x7DFA1, 0x83D01, 0x83CE1, 0x83CA1, 0x83CC1, 0x83DE1, 0x83DC1,
+		0x83D81, 0x83DA1, 0x7E961, 0x7E941, 0x7E901, 0x7E921, 0x7E8C1, 0x7E8A1, 0x7E861, 0x7E881, 0x7EA01, 0x7E9E1, 0x7E9A1, 0x7E9C1, 0x7EAC1, 0x7EAA1,
+		0x7EA61, 0x7EA81, 0x7F761, 0x7F741, 0x7F701, 0x7F6E1, 0x7F7E1, 0x7F7C1, 0x7F641, 0x7F621, 0x7FCC1, 0x7FCA1, 0x7FBA1, 0x7FB81, 0x84C01, 0x84BE1,
+		0x84BA1, 0x84BC1, 0x84E01, 0x84DE1, 0x84DA1, 0x84DC1, 0x84FA1, 0x84F81, 0x84F41, 0x84F61, 0x84EE1, 0x84EC1, 0x84E81, 0x84EA1, 0x871C1, 0x871A1,
+		0x87281, 0x87261, 0x87221, 0x87241, 0x87942, 0x87902, 0x87801, 0x877E1, 0x877A1, 0x877C1, 0x87761, 0x87741, 0x87701, 0x87721, 0x88B21, 0x88B01,
+		0x88BC2, 0x88B82, 0x202A1, 0x202C1, 0x202E1, 0x20301, 0x20321, 0x20341, 0x20361, 0x20381, 0x203A1, 0x203C1, 0x203E1, 0x20401, 0x20421, 0x20441,
+		0x20461, 0x20481, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x84D21, 0x84D01, 0x84CC1, 0x84CE1, 0x87C41, 0x87C21, 0x87BE1, 0x87BC1, 0x87D21, 0x87D01, 0x87CA2, 0x87E01, 0x87DE1,
+		0x87B81, 0x87B61, 0x87D81, 0x87D61, 0x889A1, 0x88981, 0x88941, 0x88961, 0x87EE1, 0x87F01, 0x7B942, 0x7B902, 0x7BD42, 0x7BD02, 0x7BDC2, 0x7BD82,
+		0x7BEC2, 0x7BE82, 0x7BE42, 0x7BE02, 0x7BF42, 0x7BF02, 0x7C1C2, 0x7C182, 0x7C142, 0x7C042, 0x7BFC2, 0x7BF82, 0x888C1, 0x888A1, 0x88861, 0x88881,
+		0x7B9C2, 0x7BA42, 0x7BC02, 0x7C082, 0x7C102, 0x7C6C2, 0x7C742, 0x7C822, 0x7CA02, 0x7CB42, 0x7CBC2, 0x7D122, 0x7D2C2, 0x7D462, 0x7D702, 0x7DA22,
+		0x7DAA2, 0x7DC22, 0x7DDA2, 0x7DEA2, 0x7DF22, 0x7E342, 0x7E582, 0x7ECA2, 0x7ED82, 0x7F122, 0x7F162, 0x7F1E2, 0x7FFE2, 0x80162, 0x80282, 0x80482,
+		0x81542, 0x81B22, 0x81EC2, 0x81F42, 0x82082, 0x82242, 0x82562, 0x82622, 0x82A82, 0x82CA2, 0x82E62, 0x832C2, 0x83342, 0x837E2, 0x83862, 0x838E2,
+		0x83A22, 0x83B02, 0x83B82, 0x84042, 0x84122, 0x84322, 0x843A2, 0x845A2, 0x84622, 0x846A2, 0x84722, 0x84822, 0x84922, 0x84AC2, 0x84B42, 0x85402,
+		0x85662, 0x85862, 0x85A22, 0x85C02, 0x85C82, 0x85F82, 0x86182, 0x863A2, 0x86582, 0x86622, 0x86662, 0x868A2, 0x86B62, 0x86D02, 0x86E82, 0x87082,
+		0x87102, 0x874E2, 0x87562, 0x87662, 0x876A2, 0x881E2, 0x882C2, 0x883A2, 0x88522, 0x88782, 0x88802, 0x7F5C2, 0x7F962, 0x87FA2, 0x3F282, 0x3F342,
+		0x3F462, 0x3F5A2, 0x3F6C2, 0x3F7C2, 0x7BAC2, 0x7BB02, 0x7BBC2, 0x7BC42, 0x7C002, 0x7C0C2, 0x7C8C2, 0x7C902, 0x7C9C2, 0x7CA42, 0x7CB02, 0x7CB82,
+		0x7D5C2, 0x7D602, 0x7D6C2, 0x7D922, 0x7D9E2, 0x7DA62, 0x7DC62, 0x7DCA2, 0x7DD62, 0x7DDE2, 0x7DE62, 0x7DEE2, 0x83AC2, 0x83B42, 0x842E2, 0x84362,
+		0x84562, 0x847E2, 0x848E2, 0x84A82, 0x84B02, 0x859E2, 0x85BC2, 0x85C42, 0x85F02, 0x86542, 0x86D42, 0x86D82, 0x86E42, 0x86F82, 0x87042, 0x870C2,
+		0x87F62, 0x883E2, 0x88422, 0x884E2, 0x88682, 0x88742, 0x887C2, 0x7B982, 0x7BA02, 0x7BA82, 0x7BB42, 0x7BC82, 0x7C682, 0x7C702, 0x7C7E2, 0x7C942,
+		0x7CA82, 0x7D0E2, 0x7D282, 0x7D422, 0x7D642, 0x7D962, 0x7DCE2, 0x7E302, 0x7E542, 0x7EC62, 0x7ED42, 0x7F0E2, 0x7F1A2, 0x7FF62, 0x800E2, 0x80202,
+		0x80402, 0x81502, 0x816A2, 0x81AE2, 0x81E82, 0x81F02, 0x82042, 0x82202, 0x82522, 0x82A02, 0x82C62, 0x82E22, 0x83282, 0x83302, 0x837A2, 0x83822,
+		0x838A2, 0x839E2, 0x84002, 0x840E2, 0x845E2, 0x84662, 0x846E2, 0x84762, 0x84862, 0x853C2, 0x85622, 0x85822, 0x85962, 0x85B82, 0x85F42, 0x86142,
+		0x86362, 0x86502, 0x86862, 0x86B22, 0x86CC2, 0x86DC2, 0x86FC2, 0x874A2, 0x87522, 0x87462, 0x881A2, 0x88282, 0x88362, 0x88462, 0x886C2, 0x7BB82,
+		0x7BCC2, 0x7C982, 0x7CAC2, 0x7D682, 0x7D9A2, 0x7DD22, 0x7DE22, 0x80442, 0x806E2, 0x80EC2, 0x81142, 0x847A2, 0x848A2, 0x859A2, 0x86E02, 0x87002,
+		0x884A2, 0x88702, 0x3F422, 0x3F562, 0x3F682, 0x82822, 0x828A2, 0x83062, 0x830E2, 0x834E2, 0x83562, 0x80762, 0x807E2, 0x811C2, 0x81242, 0x7EEC2,
+		0x7EF42, 0x7E782, 0x7E802, 0x7F262, 0x7F2E2, 0x81C62, 0x81CE2, 0x822C2, 0x82342, 0x80A42, 0x80BA2, 0x80DC2, 0x80F42, 0x80E42, 0x803C2, 0x81722,
+		0x821C2, 0x827E2, 0x82862, 0x83022, 0x830A2, 0x834A2, 0x83522, 0x80722, 0x807A2, 0x81182, 0x81202, 0x7EE82, 0x7EF02, 0x7E742, 0x7E7C2, 0x7F222,
+		0x7F2A2, 0x81C22, 0x81CA2, 0x82282, 0x82302, 0x80A02, 0x80B62, 0x80D82, 0x80F02, 0x80E02, 0x80382, 0x816E2, 0x82182, 0x80982, 0x80AE2, 0x80D02,
+		0x80E82, 0x806A2, 0x81102, 0x825A2, 0x7FFA2, 0x80122, 0x80242, 0x809C2, 0x80B22, 0x80D42, 0x825E2, 0x82A42, 0x7C322, 0x7C362, 0x204A1, 0x204C1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x7D163, 0x7D363, 0x7D303, 0x7D3C3, 0x7D4A3, 0x7D743, 0x7D7A3, 0x7D803, 0x7E623, 0x7E5C3, 0x7EE23, 0x7EDC3, 0x801A3, 0x80023, 0x80083, 0x80583,
+		0x80523, 0x804C3, 0x80643, 0x805E3, 0x815E3, 0x81583, 0x81BC3, 0x80C43, 0x80BE3, 0x80A83, 0x80FE3, 0x80F83, 0x810A3, 0x81043, 0x81F83, 0x82123,
+		0x820C3, 0x826C3, 0x82663, 0x82723, 0x82783, 0x82D43, 0x82F03, 0x82EA3, 0x82F63, 0x83383, 0x83443, 0x833E3, 0x83983, 0x83923, 0x841C3, 0x84223,
+		0x85703, 0x857C3, 0x85763, 0x85443, 0x854A3, 0x85903, 0x858A3, 0x85AC3, 0x85A63, 0x861C3, 0x86223, 0x86303, 0x85FC3, 0x86083, 0x863E3, 0x86443,
+		0, 0, 0x86023, 0x875A3, 0x87603, 0x86BA3, 0x86C03, 0x86A03, 0x869A3, 0x86A63, 0x86F23, 0x86EC3, 0x885C3, 0x88563, 0x7C863, 0x7D223,
+		0x7D1C3, 0x7D563, 0x7D503, 0x7D8C3, 0x7D863, 0x7E6E3, 0x7E383, 0x7E683, 0x802C3, 0x81643, 0x80CA3, 0x81FE3, 0x855C3, 0x85B23, 0x88303, 0x88223,
+		0x88623, 0x865C3, 0x84283, 0x86C63, 0x84163, 0x856A3, 0x82FC3, 0x84A23, 0x868E3, 0x864A3, 0x85503, 0x849C3, 0x85563, 0x86943, 0x7E3E3, 0x7ECE3,
+		0x860E3, 0x83A63, 0x7C783, 0x84963, 0x82CE3, 0x81B63, 0x80323, 0x86AC3, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x81A83, 0x84083, 0x7C464, 0x7C3E4, 0x86284, 0x81764, 0x7F9A4, 0x82DA4, 0x87AA4, 0x817E3, 0x81852, 0x7E448, 0x7FA24, 0x204E1, 0, 0,
+		0xAC1, 0xAE1, 0xB01, 0xB21, 0xB41, 0xB61, 0xB81, 0xBA1, 0xBC1, 0xBE1, 0xC01, 0xC21, 0xC41, 0xC61, 0xC81, 0xCA1,
+		0x20501, 0x20521, 0x20541, 0x20561, 0x20581, 0x205A1, 0x205C1, 0x205E1, 0x20601, 0x20623, 0, 0, 0, 0, 0, 0,
+		0x3EC61, 0x20681, 0x3EC01, 0x206A1, 0x206C1, 0x206E1, 0x20701, 0x3E641, 0x20721, 0x3EC21, 0x20741, 0x20761, 0x20781, 0x207A1, 0x3ECA1, 0x207C1,
+		0x207E2, 0x20821, 0x20841, 0x20861, 0x20881, 0x208A1, 0x208C1, 0x208E1, 0x20901, 0x20921, 0x20941, 0x20961, 0x20981, 0x209A1, 0x209C1, 0x209E1,
+		0x20A01, 0x20A21, 0x20A41, 0x20A61, 0x20A81, 0x20AA1, 0x20AC1, 0x20AE1, 0x20B01, 0x20B21, 0x20B41, 0x20B61, 0x20B81, 0x20BA1, 0x20BC1, 0x20BE1,
+		0x20C01, 0x20C21, 0x20C41, 0, 0x20C61, 0x20C81, 0x20CA1, 0x20CC1, 0x20CE1, 0x20D01, 0x20D21, 0x20D41, 0x20D61, 0x20D81, 0x20DA1, 0x20DC1,
+		0x20DE1, 0x20E01, 0x20E21, 0x20E41, 0x20E61, 0x20E81, 0x20EA1, 0, 0x20EC1, 0x41CE1, 0x20EE1, 0x20F01, 0, 0, 0, 0,
+		0x3F1E1, 0x3F1C1, 0x3F261, 0x20F21, 0x3F321, 0, 0x3F401, 0x3F3E1, 0x3F541, 0x3F521, 0x3F661, 0x3F641, 0x3F7A1, 0x3F781, 0x3F861, 0x3F841,
+		0x7B5A1, 0x7B641, 0x7B621, 0x7B6A1, 0x7B681, 0x7B781, 0x7B761, 0x7B7E1, 0x7B7C1, 0x7B8E1, 0x7B8C1, 0x7B881, 0x7B8A1, 0x7C301, 0x7C2E1, 0x7C661,
+		0x7C641, 0x7C601, 0x7C621, 0x7CF81, 0x7CF61, 0x7D0C1, 0x7D0A1, 0x7D061, 0x7D081, 0x7DC01, 0x7DBE1, 0x7DBA1, 0x7DBC1, 0x7E2E1, 0x7E2C1, 0x7E281,
+		0x7E2A1, 0x7EC41, 0x7EC21, 0x7EBE1, 0x7EC01, 0x7F0C1, 0x7F0A1, 0x7F061, 0x7F081, 0x7F4E1, 0x7F4C1, 0x7F5A1, 0x7F581, 0x7F941, 0x7F921, 0x7FB41,
+		0x7FB21, 0x7FF41, 0x7FF21, 0x7FEE1, 0x7FF01, 0x80961, 0x80941, 0x80901, 0x80921, 0x814E1, 0x814C1, 0x81481, 0x814A1, 0x81E61, 0x81E41, 0x81E01,
+		0x81E21, 0x82501, 0x824E1, 0x824A1, 0x824C1, 0x829E1, 0x829C1, 0x82981, 0x829A1, 0x82C41, 0x82C21, 0x82BE1, 0x82C01, 0x83261, 0x83241, 0x83201,
+		0x83221, 0x83781, 0x83761, 0x83721, 0x83741, 0x83FE1, 0x83FC1, 0x83F81, 0x83FA1, 0x84541, 0x84521, 0x844E1, 0x84501, 0x851A1, 0x85181, 0x85141,
+		0x85161, 0x85EA1, 0x85E81, 0x85E41, 0x85E61, 0x86841, 0x86821, 0x867E1, 0x86801, 0x87441, 0x87421, 0x873E1, 0x87401, 0x87A41, 0x87A21, 0x87F41,
+		0x87F21, 0x88141, 0x88121, 0x880E1, 0x88101, 0x85202, 0x851C2, 0x85282, 0x85242, 0x85302, 0x852C2, 0x85382, 0x85342, 0, 0, 0xCC1,
+		0, 0x20F41, 0x20F61, 0x20F81, 0x41CC1, 0x20FA1, 0x20FC1, 0x20FE1, 0x21001, 0x21021, 0x21041, 0x21061, 0x21081, 0x210A1, 0x210C1, 0x210E1,
+		0x429A1, 0x43801, 0x46561, 0x48201, 0x495C1, 0x4A7C1, 0x4B641, 0x4C3C1, 0x4D181, 0x4DF01, 0x21101, 0x21121, 0x21141, 0x21161, 0x21181, 0x211A1,
+		0x211C1, 0x4E561, 0x50AC1, 0x51481, 0x52461, 0x53641, 0x54F61, 0x55A41, 0x56861, 0x57841, 0x58C21, 0x59401, 0x5A361, 0x5B681, 0x5C681, 0x5D721,
+		0x5FBA1, 0x60881, 0x60F21, 0x62161, 0x634E1, 0x643A1, 0x66101, 0x66D41, 0x677A1, 0x68081, 0x68BE1, 0x211E1, 0x21201, 0x21221, 0x21241, 0x21261,
+		0x21281, 0x4E2E1, 0x50841, 0x511E1, 0x521A1, 0x533A1, 0x54CE1, 0x557C1, 0x565E1, 0x57561, 0x589A1, 0x59161, 0x5A0A1, 0x5B3E1, 0x5C401, 0x5D4A1,
+		0x5F921, 0x60621, 0x60C81, 0x61EE1, 0x63261, 0x64121, 0x65E61, 0x66AC1, 0x67501, 0x67E21, 0x68961, 0x212A1, 0x212C1, 0x212E1, 0x21301, 0x21321,
+		0x21341, 0x21361, 0x21381, 0x213A1, 0x213C1, 0x213E1, 0xC8861, 0xC3061, 0xC3361, 0xC3541, 0xC3721, 0xC3941, 0xC7BC1, 0xC7D81, 0xC7EC1, 0xC5661,
+		0x41BC1, 0xC30A1, 0xC33A1, 0xC3581, 0xC3761, 0xC3981, 0xC3B01, 0xC3E61, 0xC4421, 0xC47E1, 0xC4941, 0xC4BA1, 0xC4E01, 0xC5001, 0xC5101, 0xC52C1,
+		0xC53C1, 0xC5541, 0xC56A1, 0xC57A1, 0xC5961, 0xC5B01, 0xC5BC1, 0xC5C61, 0xC5CE1, 0xC5D61, 0xC5E61, 0xC6241, 0xC65E1, 0xC69E1, 0xC6F01, 0xC7381,
+		0xC7681, 0xC78A1, 0xC7921, 0xC7B21, 0xC7C01, 0xC7DC1, 0xC7F01, 0xC7FE1, 0xC8081, 0xC81E1, 0xC83A1, 0xC8541, 0xC8601, 0xC8921, 0x3E8E1, 0x3E921,
+		0xC0921, 0xBDCE1, 0xBDE01, 0xC1BC1, 0xBDEE1, 0xC1C41, 0xC1CA1, 0xBE081, 0xBE1A1, 0xBE281, 0xC1D41, 0xC1DA1, 0xC1E01, 0xC1E61, 0xC1EC1, 0xC1F21,
+		0xBF9A1, 0xBE421, 0xBE5C1, 0xBE6E1, 0xBFB41, 0xBE7C1, 0xBE8E1, 0xBE9C1, 0xBED41, 0xBEF61, 0xBF041, 0xBF281, 0xBF421, 0xBF5C1, 0xBF761, 0,
+		0, 0, 0xC0981, 0xC09E1, 0xC0A41, 0xC0AA1, 0xC0B01, 0xC0B61, 0, 0, 0xC0BC1, 0xC0C21, 0xC0C81, 0xC0CE1, 0xC0D41, 0xC0DA1,
+		0, 0, 0xC0E01, 0xC0E61, 0xC0EC1, 0xC0F21, 0xC0F81, 0xC0FE1, 0, 0, 0xC1041, 0xC10A1, 0xC1101, 0, 0, 0,
+		0x41C81, 0x41D21, 0x21401, 0x21421, 0x21441, 0x41D61, 0x41FE1, 0, 0x21461, 0x21481, 0x214A1, 0x214C1, 0x214E1, 0x21501, 0x21521, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0xCE1, 0xD01, 0xD21, 0x21541, 0x110F81, 0, 0,
+		0xDA201, 0xDA221, 0xDA241, 0xDA261, 0xDA281, 0xDA2A1, 0xDA2C1, 0xDA2E1, 0xDA301, 0xDA321, 0xDA341, 0xDA361, 0, 0xDA381, 0xDA3A1, 0xDA3C1,
+		0xDA3E1, 0xDA401, 0xDA421, 0xDA441, 0xDA461, 0xDA481, 0xDA4A1, 0xDA4C1, 0xDA4E1, 0xDA501, 0xDA521, 0xDA541, 0xDA561, 0xDA581, 0xDA5A1, 0xDA5C1,
+		0xDA5E1, 0xDA601, 0xDA621, 0xDA641, 0xDA661, 0xDA681, 0xDA6A1, 0, 0xDA6C1, 0xDA6E1, 0xDA701, 0xDA721, 0xDA741, 0xDA761, 0xDA781, 0xDA7A1,
+		0xDA7C1, 0xDA7E1, 0xDA801, 0xDA821, 0xDA841, 0xDA861, 0xDA881, 0xDA8A1, 0xDA8C1, 0xDA8E1, 0xDA901, 0, 0xDA921, 0xDA941, 0, 0xDA961,
+		0xDA981, 0xDA9A1, 0xDA9C1, 0xDA9E1, 0xDAA01, 0xDAA21, 0xDAA41, 0xDAA61, 0xDAA81, 0xDAAA1, 0xDAAC1, 0xDAAE1, 0xDAB01, 0xDAB21, 0, 0,
+		0xDAB41, 0xDAB61, 0xDAB81, 0xDABA1, 0xDABC1, 0xDABE1, 0xDAC01, 0xDAC21, 0xDAC41, 0xDAC61, 0xDAC81, 0xDACA1, 0xDACC1, 0xDACE1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xDAD01, 0xDAD21, 0xDAD41, 0xDAD61, 0xDAD81, 0xDADA1, 0xDADC1, 0xDADE1, 0xDAE01, 0xDAE21, 0xDAE41, 0xDAE61, 0xDAE81, 0xDAEA1, 0xDAEC1, 0xDAEE1,
+		0xDAF01, 0xDAF21, 0xDAF41, 0xDAF61, 0xDAF81, 0xDAFA1, 0xDAFC1, 0xDAFE1, 0xDB001, 0xDB021, 0xDB041, 0xDB061, 0xDB081, 0xDB0A1, 0xDB0C1, 0xDB0E1,
+		0xDB101, 0xDB121, 0xDB141, 0xDB161, 0xDB181, 0xDB1A1, 0xDB1C1, 0xDB1E1, 0xDB201, 0xDB221, 0xDB241, 0xDB261, 0xDB281, 0xDB2A1, 0xDB2C1, 0xDB2E1,
+		0xDB301, 0xDB321, 0xDB341, 0xDB361, 0xDB381, 0xDB3A1, 0xDB3C1, 0xDB3E1, 0xDB401, 0xDB421, 0xDB441, 0xDB461, 0xDB481, 0xDB4A1, 0xDB4C1, 0xDB4E1,
+		0xDB501, 0xDB521, 0xDB541, 0xDB561, 0xDB581, 0xDB5A1, 0xDB5C1, 0xDB5E1, 0xDB601, 0xDB621, 0xDB641, 0xDB661, 0xDB681, 0xDB6A1, 0xDB6C1, 0xDB6E1,
+		0xDB701, 0xDB721, 0xDB741, 0xDB761, 0xDB781, 0xDB7A1, 0xDB7C1, 0xDB7E1, 0xDB801, 0xDB821, 0xDB841, 0xDB861, 0xDB881, 0xDB8A1, 0xDB8C1, 0xDB8E1,
+		0xDB901, 0xDB921, 0xDB941, 0xDB961, 0xDB981, 0xDB9A1, 0xDB9C1, 0xDB9E1, 0xDBA01, 0xDBA21, 0xDBA41, 0xDBA61, 0xDBA81, 0xDBAA1, 0xDBAC1, 0xDBAE1,
+		0xDBB01, 0xDBB21, 0xDBB41, 0xDBB61, 0xDBB81, 0xDBBA1, 0xDBBC1, 0xDBBE1, 0xDBC01, 0xDBC21, 0xDBC41, 0, 0, 0, 0, 0,
+		0x21561, 0x21581, 0x215A1, 0, 0, 0, 0, 0x431C1, 0x45F61, 0x47C01, 0x48FC1, 0x4A1C1, 0x4B221, 0x4BF81, 0x4CD61, 0x4DAA1,
+		0x215C1, 0x215E1, 0x21601, 0x21621, 0x21641, 0x21661, 0x21681, 0x216A1, 0x216C1, 0x216E1, 0x21701, 0x21721, 0x21741, 0x21761, 0x21781, 0x217A1,
+		0x217C1, 0x217E1, 0x21801, 0x21821, 0x21841, 0x21861, 0x21881, 0x218A1, 0x218C1, 0x218E1, 0x21901, 0x21921, 0x21941, 0x21961, 0x21981, 0x219A1,
+		0x219C1, 0x219E1, 0x21A01, 0x21A21, 0, 0, 0, 0x21A41, 0x21A61, 0x21A81, 0x21AA1, 0x21AC1, 0x21AE1, 0x21B01, 0x21B21, 0x21B41,
+		0x21B61, 0x21B81, 0x431E1, 0x4A1E1, 0x21BA1, 0x21BC1, 0x21BE1, 0x21C01, 0x4A201, 0x21C21, 0x21C41, 0x21C61, 0x21C81, 0x21CA1, 0x21CC1, 0x4A221,
+		0x21CE1, 0x21D01, 0x21D21, 0x21D41, 0x21D61, 0x21D81, 0x21DA1, 0x21DC1, 0x43201, 0x43221, 0x43241, 0x45F81, 0x45FA1, 0x45FC1, 0x45FE1, 0x4A241,
+		0x21DE1, 0x21E01, 0x21E21, 0x21E41, 0x21E61, 0x21E81, 0x21EA1, 0x21EC1, 0x21EE1, 0x21F01, 0x21F21, 0x21F41, 0x21F61, 0x21F81, 0x21FA1, 0x21FC1,
+		0x21FE1, 0x22001, 0x22021, 0x4A261, 0x22041, 0x22061, 0x22081, 0x220A1, 0x220C1, 0x220E1, 0x22101, 0x22121, 0x22141, 0x22161, 0x22181, 0x221A1,
+		0x221C1, 0x221E1, 0x22201, 0x22221, 0x22241, 0x22261, 0x22281, 0x222A1, 0x222C1, 0x222E1, 0x42781, 0x22301, 0x22321, 0x22341, 0x22361, 0,
+		0x22381, 0x223A1, 0x223C1, 0x223E1, 0x22401, 0x22421, 0x22441, 0x22461, 0x22481, 0x224A1, 0x224C1, 0x224E1, 0, 0, 0, 0,
+		0x22501, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x22521, 0x22541, 0x22561, 0x22581, 0x225A1, 0x225C1, 0x225E1, 0x22601, 0x22621, 0x22641, 0x22661, 0x22681, 0x226A1, 0x226C1, 0x226E1, 0x22701,
+		0x22721, 0x22741, 0x22761, 0x22781, 0x227A1, 0x227C1, 0x227E1, 0x22801, 0x22821, 0x22841, 0x22861, 0x22881, 0x228A1, 0x228C1, 0x228E1, 0x22901,
+		0x22921, 0x22941, 0x22961, 0x22981, 0x229A1, 0x229C1, 0x229E1, 0x22A01, 0x22A21, 0x22A41, 0x22A61, 0x22A81, 0x22AA1, 0x41801, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD5601, 0xD5621, 0xD5641, 0xD5661, 0xD5681, 0xD56A1, 0xD56C1, 0xD56E1, 0xD5701, 0xD5721, 0xD5741, 0xD5761, 0xD5781, 0xD57A1, 0xD57C1, 0xD57E1,
+		0xD5801, 0xD5821, 0xD5841, 0xD5861, 0xD5881, 0xD58A1, 0xD58C1, 0xD58E1, 0xD5901, 0xD5921, 0xD5941, 0xD5961, 0xD5981, 0, 0, 0,
+		0xD59A1, 0xD59C1, 0xD59E1, 0xD5A01, 0xD5A21, 0xD5A41, 0xD5A61, 0xD5A81, 0xD5AA1, 0xD5AC1, 0xD5AE1, 0xD5B01, 0xD5B21, 0xD5B41, 0xD5B61, 0xD5B81,
+		0xD5BA1, 0xD5BC1, 0xD5BE1, 0xD5C01, 0xD5C21, 0xD5C41, 0xD5C61, 0xD5C81, 0xD5CA1, 0xD5CC1, 0xD5CE1, 0xD5D01, 0xD5D21, 0xD5D41, 0xD5D61, 0xD5D81,
+		0xD5DA1, 0xD5DC1, 0xD5DE1, 0xD5E01, 0xD5E21, 0xD5E41, 0xD5E61, 0xD5E81, 0xD5EA1, 0xD5EC1, 0xD5EE1, 0xD5F01, 0xD5F21, 0xD5F41, 0xD5F61, 0xD5F81,
+		0xD5FA1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x22AC1, 0x43261, 0x46001, 0x47C21, 0x48FE1, 0x4A281, 0x4B241, 0x4BFA1, 0x4CD81, 0x4DAC1, 0x22AE1, 0x22B01, 0x22B21, 0x22B41, 0x22B61, 0x22B81,
+		0x22BA1, 0x22BC1, 0x22BE1, 0x22C01, 0x22C21, 0x22C41, 0x22C61, 0x22C81, 0x22CA1, 0x22CC1, 0x22CE1, 0x22D01, 0, 0, 0, 0,
+		0xD6301, 0xD6321, 0xD6341, 0xD6361, 0xD6381, 0xD63A1, 0xD63C1, 0xD63E1, 0xD6401, 0xD6421, 0xD6441, 0xD6461, 0xD6481, 0xD64A1, 0xD64C1, 0xD6501,
+		0xD6521, 0xD6541, 0xD6561, 0xD6581, 0xD65A1, 0xD65C1, 0xD65E1, 0xD6601, 0xD6621, 0xD6641, 0xD6661, 0xD6681, 0xD66A1, 0xD66C1, 0xD66E1, 0xD64E1,
+		0x43281, 0x4A2A1, 0x22D21, 0x22D41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD6701, 0xD6721, 0xD6741, 0xD6761, 0xD6781, 0xD67A1, 0xD67C1, 0xD67E1, 0xD6801, 0xD6821, 0xD6841, 0xD6861, 0xD6881, 0xD68A1, 0xD68C1, 0xD68E1,
+		0xD6901, 0xD6921, 0xD6941, 0xD6961, 0xD6981, 0xD69A1, 0xD69C1, 0xD69E1, 0xD6A01, 0xD6A21, 0xD6A41, 0, 0, 0, 0, 0,
+		0x78021, 0x78061, 0x78081, 0x780A1, 0x780E1, 0x78101, 0x78121, 0x78141, 0x78181, 0x781A1, 0x781C1, 0x781E1, 0x78201, 0x78221, 0x78261, 0x78281,
+		0x782A1, 0x782C1, 0x78301, 0x78321, 0x78341, 0x78361, 0x78381, 0x783A1, 0x783C1, 0x783E1, 0x78401, 0x78421, 0x78441, 0x78461, 0x78481, 0x784A1,
+		0x784C1, 0x784E1, 0x78501, 0x78521, 0x78541, 0x78561, 0x78041, 0x780C1, 0x78161, 0x78241, 0x782E1, 0, 0, 0, 0, 0,
+		0xE1441, 0xE1461, 0xE1481, 0xE14A1, 0xE14C1, 0xE14E1, 0xE1501, 0xE1521, 0xE1541, 0xE1561, 0xE1581, 0xE15A1, 0xE15C1, 0xE15E1, 0xE1601, 0xE1621,
+		0xE1641, 0xE1661, 0xE1681, 0xE16A1, 0xE16C1, 0xE16E1, 0xE1701, 0xE1721, 0xE1741, 0xE1761, 0xE1781, 0xE17A1, 0xE17C1, 0xE17E1, 0, 0x22D61,
+		0xE1801, 0xE1821, 0xE1841, 0xE1861, 0xE1881, 0xE18A1, 0xE18C1, 0xE18E1, 0xE1901, 0xE1921, 0xE1941, 0xE1961, 0xE1981, 0xE19A1, 0xE19C1, 0xE19E1,
+		0xE1A01, 0xE1A21, 0xE1A41, 0xE1A61, 0xE1A81, 0xE1AA1, 0xE1AC1, 0xE1AE1, 0xE1B01, 0xE1B21, 0xE1B41, 0xE1B61, 0xE1B81, 0xE1BA1, 0xE1BC1, 0xE1BE1,
+		0xE1C01, 0xE1C21, 0xE1C41, 0xE1C61, 0, 0, 0, 0, 0xE1C81, 0xE1CA1, 0xE1CC1, 0xE1CE1, 0xE1D01, 0xE1D21, 0xE1D41, 0xE1D61,
+		0x22D81, 0x432A1, 0x46021, 0x22DA1, 0x22DC1, 0x22DE1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD6A81, 0xD6AC1, 0xD6B01, 0xD6B41, 0xD6B81, 0xD6BC1, 0xD6C01, 0xD6C41, 0xD6C81, 0xD6CC1, 0xD6D01, 0xD6D41, 0xD6D81, 0xD6DC1, 0xD6E01, 0xD6E41,
+		0xD6E81, 0xD6EC1, 0xD6F01, 0xD6F41, 0xD6F81, 0xD6FC1, 0xD7001, 0xD7041, 0xD7081, 0xD70C1, 0xD7101, 0xD7141, 0xD7181, 0xD71C1, 0xD7201, 0xD7241,
+		0xD7281, 0xD72C1, 0xD7301, 0xD7341, 0xD7381, 0xD73C1, 0xD7401, 0xD7441, 0xD6A61, 0xD6AA1, 0xD6AE1, 0xD6B21, 0xD6B61, 0xD6BA1, 0xD6BE1, 0xD6C21,
+		0xD6C61, 0xD6CA1, 0xD6CE1, 0xD6D21, 0xD6D61, 0xD6DA1, 0xD6DE1, 0xD6E21, 0xD6E61, 0xD6EA1, 0xD6EE1, 0xD6F21, 0xD6F61, 0xD6FA1, 0xD6FE1, 0xD7021,
+		0xD7061, 0xD70A1, 0xD70E1, 0xD7121, 0xD7161, 0xD71A1, 0xD71E1, 0xD7221, 0xD7261, 0xD72A1, 0xD72E1, 0xD7321, 0xD7361, 0xD73A1, 0xD73E1, 0xD7421,
+		0xD7461, 0xD7481, 0xD74A1, 0xD74C1, 0xD74E1, 0xD7501, 0xD7521, 0xD7541, 0xD7561, 0xD7581, 0xD75A1, 0xD75C1, 0xD75E1, 0xD7601, 0xD7621, 0xD7641,
+		0xD7661, 0xD7681, 0xD76A1, 0xD76C1, 0xD76E1, 0xD7701, 0xD7721, 0xD7741, 0xD7761, 0xD7781, 0xD77A1, 0xD77C1, 0xD77E1, 0xD7801, 0xD7821, 0xD7841,
+		0xD7861, 0xD7881, 0xD78A1, 0xD78C1, 0xD78E1, 0xD7901, 0xD7921, 0xD7941, 0xD7961, 0xD7981, 0xD79A1, 0xD79C1, 0xD79E1, 0xD7A01, 0xD7A21, 0xD7A41,
+		0xD8BC1, 0xD8BE1, 0xD8C01, 0xD8C21, 0xD8C41, 0xD8C61, 0xD8C81, 0xD8CA1, 0xD8CC1, 0xD8CE1, 0xD8D01, 0xD8D21, 0xD8D41, 0xD8D61, 0xD8D81, 0xD8DA1,
+		0xD8DC1, 0xD8DE1, 0xD8E01, 0xD8E21, 0xD8E41, 0xD8E61, 0xD8E81, 0xD8EA1, 0xD8EC1, 0xD8EE1, 0xD8F01, 0xD8F21, 0xD8F41, 0xD8F61, 0, 0,
+		0x427A1, 0x432C1, 0x46041, 0x47C41, 0x49001, 0x4A2C1, 0x4B261, 0x4BFC1, 0x4CDA1, 0x4DAE1, 0, 0, 0, 0, 0, 0,
+		0xAB041, 0xAB081, 0xAB0C1, 0xAB101, 0xAB141, 0xAB181, 0xAB1C1, 0xAB201, 0xAB241, 0xAB281, 0xAB2C1, 0xAB301, 0xAB341, 0xAB381, 0xAB3C1, 0xAB401,
+		0xAB441, 0xAB481, 0xAB4C1, 0xAB501, 0xAB541, 0xAB581, 0xAB5C1, 0xAB601, 0xAB641, 0xAB681, 0xAB6C1, 0xAB701, 0xAB741, 0xAB781, 0xAB7C1, 0xAB801,
+		0xAB841, 0xAB881, 0xAB8C1, 0xAB901, 0, 0, 0, 0, 0xAB021, 0xAB061, 0xAB0A1, 0xAB0E1, 0xAB121, 0xAB161, 0xAB1A1, 0xAB1E1,
+		0xAB221, 0xAB261, 0xAB2A1, 0xAB2E1, 0xAB321, 0xAB361, 0xAB3A1, 0xAB3E1, 0xAB421, 0xAB461, 0xAB4A1, 0xAB4E1, 0xAB521, 0xAB561, 0xAB5A1, 0xAB5E1,
+		0xAB621, 0xAB661, 0xAB6A1, 0xAB6E1, 0xAB721, 0xAB761, 0xAB7A1, 0xAB7E1, 0xAB821, 0xAB861, 0xAB8A1, 0xAB8E1, 0, 0, 0, 0,
+		0xD8F81, 0xD8FA1, 0xD8FC1, 0xD8FE1, 0xD9001, 0xD9021, 0xD9041, 0xD9061, 0xD9081, 0xD90A1, 0xD90C1, 0xD90E1, 0xD9101, 0xD9121, 0xD9141, 0xD9161,
+		0xD9181, 0xD91A1, 0xD91C1, 0xD91E1, 0xD9201, 0xD9221, 0xD9241, 0xD9261, 0xD9281, 0xD92A1, 0xD92C1, 0xD92E1, 0xD9301, 0xD9321, 0xD9341, 0xD9361,
+		0xD9381, 0xD93A1, 0xD93C1, 0xD93E1, 0xD9401, 0xD9421, 0xD9441, 0xD9461, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD9481, 0xD94A1, 0xD94C1, 0xD94E1, 0xD9501, 0xD9521, 0xD9541, 0xD9561, 0xD9581, 0xD95A1, 0xD95C1, 0xD95E1, 0xD9601, 0xD9621, 0xD9641, 0xD9661,
+		0xD9681, 0xD96A1, 0xD96C1, 0xD96E1, 0xD9701, 0xD9721, 0xD9741, 0xD9761, 0xD9781, 0xD97A1, 0xD97C1, 0xD97E1, 0xD9801, 0xD9821, 0xD9841, 0xD9861,
+		0xD9881, 0xD98A1, 0xD98C1, 0xD98E1, 0xD9901, 0xD9921, 0xD9941, 0xD9961, 0xD9981, 0xD99A1, 0xD99C1, 0xD99E1, 0xD9A01, 0xD9A21, 0xD9A41, 0xD9A61,
+		0xD9A81, 0xD9AA1, 0xD9AC1, 0xD9AE1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x22E01,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xDBC61, 0xDBC81, 0xDBCA1, 0xDBCC1, 0xDBCE1, 0xDBD01, 0xDBD21, 0xDBD41, 0xDBD61, 0xDBD81, 0xDBDA1, 0xDBDC1, 0xDBDE1, 0xDBE01, 0xDBE21, 0xDBE41,
+		0xDBE61, 0xDBE81, 0xDBEA1, 0xDBEC1, 0xDBEE1, 0xDBF01, 0xDBF21, 0xDBF41, 0xDBF61, 0xDBF81, 0xDBFA1, 0xDBFC1, 0xDBFE1, 0xDC001, 0xDC021, 0xDC041,
+		0xDC061, 0xDC081, 0xDC0A1, 0xDC0C1, 0xDC0E1, 0xDC101, 0xDC121, 0xDC141, 0xDC161, 0xDC181, 0xDC1A1, 0xDC1C1, 0xDC1E1, 0xDC201, 0xDC221, 0xDC241,
+		0xDC261, 0xDC281, 0xDC2A1, 0xDC2C1, 0xDC2E1, 0xDC301, 0xDC321, 0xDC341, 0xDC361, 0xDC381, 0xDC3A1, 0xDC3C1, 0xDC3E1, 0xDC401, 0xDC421, 0xDC441,
+		0xDC461, 0xDC481, 0xDC4A1, 0xDC4C1, 0xDC4E1, 0xDC501, 0xDC521, 0xDC541, 0xDC561, 0xDC581, 0xDC5A1, 0xDC5C1, 0xDC5E1, 0xDC601, 0xDC621, 0xDC641,
+		0xDC661, 0xDC681, 0xDC6A1, 0xDC6C1, 0xDC6E1, 0xDC701, 0xDC721, 0xDC741, 0xDC761, 0xDC781, 0xDC7A1, 0xDC7C1, 0xDC7E1, 0xDC801, 0xDC821, 0xDC841,
+		0xDC861, 0xDC881, 0xDC8A1, 0xDC8C1, 0xDC8E1, 0xDC901, 0xDC921, 0xDC941, 0xDC961, 0xDC981, 0xDC9A1, 0xDC9C1, 0xDC9E1, 0xDCA01, 0xDCA21, 0xDCA41,
+		0xDCA61, 0xDCA81, 0xDCAA1, 0xDCAC1, 0xDCAE1, 0xDCB01, 0xDCB21, 0xDCB41, 0xDCB61, 0xDCB81, 0xDCBA1, 0xDCBC1, 0xDCBE1, 0xDCC01, 0xDCC21, 0xDCC41,
+		0xDCC61, 0xDCC81, 0xDCCA1, 0xDCCC1, 0xDCCE1, 0xDCD01, 0xDCD21, 0xDCD41, 0xDCD61, 0xDCD81, 0xDCDA1, 0xDCDC1, 0xDCDE1, 0xDCE01, 0xDCE21, 0xDCE41,
+		0xDCE61, 0xDCE81, 0xDCEA1, 0xDCEC1, 0xDCEE1, 0xDCF01, 0xDCF21, 0xDCF41, 0xDCF61, 0xDCF81, 0xDCFA1, 0xDCFC1, 0xDCFE1, 0xDD001, 0xDD021, 0xDD041,
+		0xDD061, 0xDD081, 0xDD0A1, 0xDD0C1, 0xDD0E1, 0xDD101, 0xDD121, 0xDD141, 0xDD161, 0xDD181, 0xDD1A1, 0xDD1C1, 0xDD1E1, 0xDD201, 0xDD221, 0xDD241,
+		0xDD261, 0xDD281, 0xDD2A1, 0xDD2C1, 0xDD2E1, 0xDD301, 0xDD321, 0xDD341, 0xDD361, 0xDD381, 0xDD3A1, 0xDD3C1, 0xDD3E1, 0xDD401, 0xDD421, 0xDD441,
+		0xDD461, 0xDD481, 0xDD4A1, 0xDD4C1, 0xDD4E1, 0xDD501, 0xDD521, 0xDD541, 0xDD561, 0xDD581, 0xDD5A1, 0xDD5C1, 0xDD5E1, 0xDD601, 0xDD621, 0xDD641,
+		0xDD661, 0xDD681, 0xDD6A1, 0xDD6C1, 0xDD6E1, 0xDD701, 0xDD721, 0xDD741, 0xDD761, 0xDD781, 0xDD7A1, 0xDD7C1, 0xDD7E1, 0xDD801, 0xDD821, 0xDD841,
+		0xDD861, 0xDD881, 0xDD8A1, 0xDD8C1, 0xDD8E1, 0xDD901, 0xDD921, 0xDD941, 0xDD961, 0xDD981, 0xDD9A1, 0xDD9C1, 0xDD9E1, 0xDDA01, 0xDDA21, 0xDDA41,
+		0xDDA61, 0xDDA81, 0xDDAA1, 0xDDAC1, 0xDDAE1, 0xDDB01, 0xDDB21, 0xDDB41, 0xDDB61, 0xDDB81, 0xDDBA1, 0xDDBC1, 0xDDBE1, 0xDDC01, 0xDDC21, 0xDDC41,
+		0xDDC61, 0xDDC81, 0xDDCA1, 0xDDCC1, 0xDDCE1, 0xDDD01, 0xDDD21, 0xDDD41, 0xDDD61, 0xDDD81, 0xDDDA1, 0xDDDC1, 0xDDDE1, 0xDDE01, 0xDDE21, 0xDDE41,
+		0xDDE61, 0xDDE81, 0xDDEA1, 0xDDEC1, 0xDDEE1, 0xDDF01, 0xDDF21, 0xDDF41, 0xDDF61, 0xDDF81, 0xDDFA1, 0xDDFC1, 0xDDFE1, 0xDE001, 0xDE021, 0xDE041,
+		0xDE061, 0xDE081, 0xDE0A1, 0xDE0C1, 0xDE0E1, 0xDE101, 0xDE121, 0xDE141, 0xDE161, 0xDE181, 0xDE1A1, 0xDE1C1, 0xDE1E1, 0xDE201, 0xDE221, 0xDE241,
+		0xDE261, 0xDE281, 0xDE2A1, 0xDE2C1, 0xDE2E1, 0xDE301, 0xDE321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xDE341, 0xDE361, 0xDE381, 0xDE3A1, 0xDE3C1, 0xDE3E1, 0xDE401, 0xDE421, 0xDE441, 0xDE461, 0xDE481, 0xDE4A1, 0xDE4C1, 0xDE4E1, 0xDE501, 0xDE521,
+		0xDE541, 0xDE561, 0xDE581, 0xDE5A1, 0xDE5C1, 0xDE5E1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xDE601, 0xDE621, 0xDE641, 0xDE661, 0xDE681, 0xDE6A1, 0xDE6C1, 0xDE6E1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xDE701, 0xDE721, 0xDE741, 0xDE761, 0xDE781, 0xDE7A1, 0, 0, 0xDE7C1, 0, 0xDE7E1, 0xDE801, 0xDE821, 0xDE841, 0xDE861, 0xDE881,
+		0xDE8A1, 0xDE8C1, 0xDE8E1, 0xDE901, 0xDE921, 0xDE941, 0xDE961, 0xDE981, 0xDE9A1, 0xDE9C1, 0xDE9E1, 0xDEA01, 0xDEA21, 0xDEA41, 0xDEA61, 0xDEA81,
+		0xDEAA1, 0xDEAC1, 0xDEAE1, 0xDEB01, 0xDEB21, 0xDEB41, 0xDEB61, 0xDEB81, 0xDEBA1, 0xDEBC1, 0xDEBE1, 0xDEC01, 0xDEC21, 0xDEC41, 0xDEC61, 0xDEC81,
+		0xDECA1, 0xDECC1, 0xDECE1, 0xDED01, 0xDED21, 0xDED41, 0, 0xDED61, 0xDED81, 0, 0, 0, 0xDEDA1, 0, 0, 0xDEDC1,
+		0xE0561, 0xE0581, 0xE05A1, 0xE05C1, 0xE05E1, 0xE0601, 0xE0621, 0xE0641, 0xE0661, 0xE0681, 0xE06A1, 0xE06C1, 0xE06E1, 0xE0701, 0xE0721, 0xE0741,
+		0xE0761, 0xE0781, 0xE07A1, 0xE07C1, 0xE07E1, 0xE0801, 0, 0x22E21, 0x432E1, 0x46061, 0x47C61, 0x22E41, 0x22E61, 0x22E81, 0x22EA1, 0x22EC1,
+		0xDFC01, 0xDFC21, 0xDFC41, 0xDFC61, 0xDFC81, 0xDFCA1, 0xDFCC1, 0xDFCE1, 0xDFD01, 0xDFD21, 0xDFD41, 0xDFD61, 0xDFD81, 0xDFDC1, 0xDFDA1, 0xDFDE1,
+		0xDFE01, 0xDFE21, 0xDFE41, 0xDFE61, 0xDFE81, 0xDFEA1, 0xDFEC1, 0x22EE1, 0x22F01, 0x43301, 0x46081, 0x47C81, 0x49021, 0x4A2E1, 0x22F21, 0x22F41,
+		0xDFF01, 0xDFEE1, 0xDFF41, 0xDFF21, 0xDFF61, 0xDFF81, 0xDFFC1, 0xDFFA1, 0xDFFE1, 0xE0001, 0xE0021, 0xE0041, 0xE0081, 0xE0061, 0xE00C1, 0xE00A1,
+		0xE0101, 0xE00E1, 0xE0141, 0xE0121, 0xE0181, 0xE0161, 0xE01A1, 0xE01C1, 0xE01E1, 0xE0201, 0xE0221, 0xE0241, 0xE0281, 0xE0261, 0xE02A1, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x43321, 0x460A1, 0x47CA1, 0x49041, 0x49061, 0x4A301, 0x22F61, 0x22F81, 0x22FA1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xE02C1, 0xE02E1, 0xE0301, 0xE0321, 0xE0341, 0xE0361, 0xE0381, 0xE03A1, 0xE03C1, 0xE03E1, 0xE0401, 0xE0421, 0xE0441, 0xE0461, 0xE0481, 0xE04A1,
+		0xE04C1, 0xE04E1, 0xE0501, 0, 0xE0521, 0xE0541, 0, 0, 0, 0, 0, 0x43341, 0x4A321, 0x22FC1, 0x22FE1, 0x23001,
+		0x7AF61, 0x7AF81, 0x7AFA1, 0x7AFC1, 0x7AFE1, 0x7B001, 0x7B021, 0x7B041, 0x7B061, 0x7B081, 0x7B0A1, 0x7B0C1, 0x7B0E1, 0x7B101, 0x7B121, 0x7B141,
+		0x7B161, 0x7B181, 0x7B1A1, 0x7B1C1, 0x7B1E1, 0x7B201, 0x43361, 0x23021, 0x23041, 0x23061, 0x460C1, 0x47CC1, 0, 0, 0, 0x23081,
+		0xD5FC1, 0xD5FE1, 0xD6001, 0xD6021, 0xD6041, 0xD6061, 0xD6081, 0xD60A1, 0xD60C1, 0xD60E1, 0xD6101, 0xD6121, 0xD6141, 0xD6161, 0xD6181, 0xD61A1,
+		0xD61C1, 0xD61E1, 0xD6201, 0xD6221, 0xD6241, 0xD6261, 0xD6281, 0xD62A1, 0xD62C1, 0xD62E1, 0, 0, 0, 0, 0, 0x230A1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xF2F42, 0xF2FA2, 0xF3002, 0xF3062, 0xF30C2, 0xF3122, 0xF3182, 0xF31C2, 0xF3222, 0xF3282, 0xF32E2, 0xF3322, 0xF3382, 0xF33C2, 0xF3422, 0xF3462,
+		0xF34C2, 0xF3522, 0xF3582, 0xF3622, 0xF3662, 0xF36C2, 0xF3722, 0xF3782, 0xF37E2, 0xF3822, 0xF3882, 0xF38C2, 0xF3922, 0xF3982, 0xF3A01, 0xF3A21,
+		0xF2F21, 0xF2F81, 0xF2FE1, 0xF3041, 0xF30A1, 0xF3101, 0xF3161, 0xF3201, 0xF3261, 0xF32C1, 0xF3361, 0xF3401, 0xF34A1, 0xF3501, 0xF3561, 0xF35C1,
+		0xF35E2, 0xF36A1, 0xF3701, 0xF3761, 0xF37C1, 0xF3861, 0xF3901, 0xF3961, 0, 0, 0, 0, 0x230C1, 0x230E1, 0xF39C1, 0xF39E1,
+		0x43381, 0x460E1, 0x47CE1, 0x49081, 0x4A341, 0x4B281, 0x4BFE1, 0x4CDC1, 0x4DB01, 0x23101, 0x23121, 0x23141, 0x23161, 0x23181, 0x231A1, 0x231C1,
+		0, 0, 0x231E1, 0x23201, 0x23221, 0x23241, 0x23261, 0x23281, 0x232A1, 0x232C1, 0x232E1, 0x23301, 0x23321, 0x23341, 0x23361, 0x23381,
+		0x233A1, 0x233C1, 0x233E1, 0x23401, 0x23421, 0x23441, 0x23461, 0x23481, 0x234A1, 0x234C1, 0x234E1, 0x23501, 0x23521, 0x23541, 0x23561, 0x23581,
+		0x235A1, 0x235C1, 0x235E1, 0x23601, 0x23621, 0x23641, 0x23661, 0x23681, 0x236A1, 0x236C1, 0x236E1, 0x23701, 0x23721, 0x23741, 0x23761, 0x23781,
+		0x9C241, 0x9C261, 0x9C281, 0x9C2A1, 0, 0x9C2C1, 0x9C2E1, 0, 0, 0, 0, 0, 0x9C301, 0x3E661, 0x408E1, 0x40D21,
+		0x9C321, 0x9C361, 0x9C381, 0x9C3A1, 0, 0x9C3C1, 0x9C3E1, 0x9C401, 0, 0x9C421, 0x9C441, 0x9C461, 0x9C4A1, 0x9C4C1, 0x9C4E1, 0x9C501,
+		0x9C521, 0x9C541, 0x9C561, 0x9C581, 0x9C5A1, 0x9C5C1, 0x9C5E1, 0x9C601, 0x9C621, 0x9C641, 0x9C661, 0x9C681, 0x9C6A1, 0x9C6C1, 0x9C6E1, 0x9C701,
+		0x9C721, 0x9C741, 0x9C341, 0x9C481, 0, 0, 0, 0, 0x40F81, 0x40FA1, 0x40FC1, 0, 0, 0, 0, 0x9C761,
+		0x433A1, 0x46101, 0x47D01, 0x490A1, 0x237A1, 0x237C1, 0x237E1, 0x23801, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x23821, 0x23841, 0x23861, 0x23881, 0x238A1, 0x238C1, 0x238E1, 0x23901, 0x23921, 0, 0, 0, 0, 0, 0, 0,
+		0xDEDE1, 0xDEE01, 0xDEE21, 0xDEE41, 0xDEE61, 0xDEE81, 0xDEEA1, 0xDEEC1, 0xDEEE1, 0xDEF01, 0xDEF21, 0xDEF41, 0xDEF61, 0xDEF81, 0xDEFA1, 0xDEFC1,
+		0xDEFE1, 0xDF001, 0xDF021, 0xDF041, 0xDF061, 0xDF081, 0xDF0A1, 0xDF0C1, 0xDF0E1, 0xDF101, 0xDF121, 0xDF141, 0xDF161, 0x433C1, 0x23941, 0x23961,
+		0xDF181, 0xDF1A1, 0xDF1C1, 0xDF1E1, 0xDF201, 0xDF221, 0xDF241, 0xDF261, 0xDF281, 0xDF2A1, 0xDF2C1, 0xDF2E1, 0xDF301, 0xDF321, 0xDF341, 0xDF361,
+		0xDF381, 0xDF3A1, 0xDF3C1, 0xDF3E1, 0xDF401, 0xDF421, 0xDF441, 0xDF461, 0xDF481, 0xDF4A1, 0xDF4C1, 0xDF4E1, 0xDF501, 0x433E1, 0x23981, 0x239A1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xE0F81, 0xE0FA1, 0xE0FC1, 0xE0FE1, 0xE1001, 0xE1021, 0xE1041, 0xE1061, 0xE1082, 0xE10C1, 0xE10E1, 0xE1101, 0xE1121, 0xE1141, 0xE1161, 0xE1181,
+		0xE11A1, 0xE11C1, 0xE11E1, 0xE1201, 0xE1221, 0xE1241, 0xE1261, 0xE1281, 0xE12A1, 0xE12C1, 0xE12E1, 0xE1301, 0xE1321, 0xE1341, 0xE1361, 0xE1381,
+		0xE13A1, 0xE13C1, 0xE13E1, 0xE1401, 0xE1421, 0x3DF41, 0x3E681, 0, 0, 0, 0, 0x43401, 0x4A361, 0x239C1, 0x239E1, 0x23A01,
+		0x23A21, 0x23A41, 0x23A61, 0x23A81, 0x23AA1, 0x23AC1, 0x23AE1, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xDF521, 0xDF541, 0xDF561, 0xDF581, 0xDF5A1, 0xDF5C1, 0xDF5E1, 0xDF601, 0xDF621, 0xDF641, 0xDF661, 0xDF681, 0xDF6A1, 0xDF6C1, 0xDF6E1, 0xDF701,
+		0xDF721, 0xDF741, 0xDF761, 0xDF781, 0xDF7A1, 0xDF7C1, 0xDF7E1, 0xDF801, 0xDF821, 0xDF841, 0xDF861, 0xDF881, 0xDF8A1, 0xDF8C1, 0xDF8E1, 0xDF901,
+		0xDF921, 0xDF941, 0xDF961, 0xDF981, 0xDF9A1, 0xDF9C1, 0xDF9E1, 0xDFA01, 0xDFA21, 0xDFA41, 0xDFA61, 0xDFA81, 0xDFAA1, 0xDFAC1, 0xDFAE2, 0xDFB21,
+		0xDFB41, 0xDFB61, 0xDFB81, 0xDFBA1, 0xDFBC1, 0xDFBE1, 0, 0, 0, 0x23B01, 0x23B21, 0x23B41, 0x23B61, 0x23B81, 0x23BA1, 0x23BC1,
+		0xE0821, 0xE0841, 0xE0861, 0xE0881, 0xE08A1, 0xE08C1, 0xE08E1, 0xE0901, 0xE0921, 0xE0941, 0xE0961, 0xE0981, 0xE09A1, 0xE09C1, 0xE09E1, 0xE0A01,
+		0xE0A21, 0xE0A41, 0xE0A61, 0xE0A81, 0xE0AA1, 0xE0AC1, 0, 0, 0x43421, 0x46121, 0x47D21, 0x490C1, 0x23BE1, 0x23C01, 0x23C21, 0x23C41,
+		0xE0AE1, 0xE0B01, 0xE0B21, 0xE0B41, 0xE0B61, 0xE0B81, 0xE0BA1, 0xE0BC1, 0xE0BE1, 0xE0C01, 0xE0C21, 0xE0C41, 0xE0C61, 0xE0C81, 0xE0CA1, 0xE0CC1,
+		0xE0CE1, 0xE0D01, 0xE0D21, 0, 0, 0, 0, 0, 0x43441, 0x46141, 0x47D41, 0x490E1, 0x23C61, 0x23C81, 0x23CA1, 0x23CC1,
+		0xE0D41, 0xE0D61, 0xE0D81, 0xE0DA1, 0xE0DC1, 0xE0DE1, 0xE0E01, 0xE0E21, 0xE0E41, 0xE0E61, 0xE0E81, 0xE0EA1, 0xE0EC1, 0xE0EE1, 0xE0F01, 0xE0F21,
+		0xE0F41, 0xE0F61, 0, 0, 0, 0, 0, 0, 0, 0x23CE1, 0x23D01, 0x23D21, 0x23D41, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0x43461, 0x46161, 0x47D61, 0x49101, 0x23D61, 0x23D81, 0x23DA1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xB3401, 0xB3422, 0xB3461, 0xB3481, 0xB34A2, 0xB34E1, 0xB3501, 0xB3521, 0xB3542, 0xB3581, 0xB35A2, 0xB35E1, 0xB3602, 0xB3641, 0xB3662, 0xB36A1,
+		0xB36C2, 0xB3701, 0xB3722, 0xB3761, 0xB3781, 0xB37A2, 0xB37E1, 0xB3802, 0xB3841, 0xB3862, 0xB38A1, 0xB38C2, 0xB3901, 0xB3922, 0xB3961, 0xB3982,
+		0xB39C1, 0xB39E1, 0xB3A01, 0xB3A21, 0xB3A41, 0xB3A62, 0xB3AA1, 0xB3AC2, 0xB3B01, 0xB3B22, 0xB3B61, 0xB3B82, 0xB3BC1, 0xB3BE1, 0xB3C02, 0xB3C41,
+		0xB3C61, 0xB3C81, 0xB3CA1, 0xB3CC2, 0xB3D01, 0xB3D22, 0xB3D61, 0xB3D82, 0xB3DC1, 0xB3DE2, 0xB3E21, 0xB3E42, 0xB3E81, 0xB3EA1, 0xB3EC1, 0xB3EE1,
+		0xB3F02, 0xB3F41, 0xB3F62, 0xB3FA1, 0xB3FC2, 0xB4001, 0xB4022, 0xB4061, 0xB4081, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xB24E1, 0xB2542, 0xB25A1, 0xB25E1, 0xB2621, 0xB2661, 0xB26A1, 0xB26E1, 0xB2721, 0xB2761, 0xB27C2, 0xB2842, 0xB28A1, 0xB28E1, 0xB2921, 0xB2961,
+		0xB29A1, 0xB2A02, 0xB2A61, 0xB2AA1, 0xB2AE1, 0xB2B21, 0xB2B61, 0xB2BA1, 0xB2BE1, 0xB2C21, 0xB2C61, 0xB2CA1, 0xB2D02, 0xB2D61, 0xB2DC2, 0xB2E42,
+		0xB2EA1, 0xB2EE1, 0xB2F21, 0xB2F82, 0xB2FE1, 0xB3021, 0xB3061, 0xB30A1, 0xB30E1, 0xB3121, 0xB3161, 0xB31C2, 0xB3221, 0xB3282, 0xB32E1, 0xB3321,
+		0xB3361, 0xB33A1, 0xB33E1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xB24C1, 0xB2502, 0xB2581, 0xB25C1, 0xB2601, 0xB2641, 0xB2681, 0xB26C1, 0xB2701, 0xB2741, 0xB2782, 0xB2802, 0xB2881, 0xB28C1, 0xB2901, 0xB2941,
+		0xB2981, 0xB29C2, 0xB2A41, 0xB2A81, 0xB2AC1, 0xB2B01, 0xB2B41, 0xB2B81, 0xB2BC1, 0xB2C01, 0xB2C41, 0xB2C81, 0xB2CC2, 0xB2D41, 0xB2D82, 0xB2E02,
+		0xB2E81, 0xB2EC1, 0xB2F01, 0xB2F42, 0xB2FC1, 0xB3001, 0xB3041, 0xB3081, 0xB30C1, 0xB3101, 0xB3141, 0xB3182, 0xB3201, 0xB3242, 0xB32C1, 0xB3301,
+		0xB3341, 0xB3381, 0xB33C1, 0, 0, 0, 0, 0, 0, 0, 0x43481, 0x4A381, 0x23DC1, 0x23DE1, 0x23E01, 0x23E21,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x434A1, 0x46181, 0x47D81, 0x49121, 0x4A3A1, 0x4B2A1, 0x4C001, 0x4CDE1, 0x4DB21, 0x23E41, 0x23E61, 0x23E81, 0x23EA1, 0x23EC1, 0x23EE1, 0x23F01,
+		0x23F21, 0x23F41, 0x23F61, 0x23F81, 0x23FA1, 0x23FC1, 0x23FE1, 0x24001, 0x24021, 0x24041, 0x24061, 0x24081, 0x240A1, 0x240C1, 0x240E1, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x40501, 0x40901, 0x40D41, 0x9BF81, 0x9BFA1, 0x9B9A1, 0x9B9C1, 0x9B9E1, 0x9BA01, 0x9BA21, 0x9BA41, 0x9BA61, 0x9BA81, 0x9BAA1, 0x9BAC1, 0x9BAE1,
+		0x9BB01, 0x9BB21, 0x9BB41, 0x9BB61, 0x9BB81, 0x9BBA1, 0x9BBC1, 0x9BBE1, 0x9BC01, 0x9BC21, 0x9BC41, 0x9BC61, 0x9BC81, 0x9BCA1, 0x9BCC1, 0x9BCE1,
+		0x9BD01, 0x9BD21, 0x9BD41, 0x9BD61, 0x9BD81, 0x9BDA1, 0x9BDC1, 0x9BDE1, 0x9BE01, 0x9BE21, 0x9BE41, 0x9BE61, 0x9BE81, 0x9BEA1, 0x9BEC1, 0x9BEE1,
+		0x9BF01, 0x9BF21, 0x9BF41, 0x9BF61, 0x9BFC1, 0x9BFE1, 0x9C001, 0x9C021, 0x9C041, 0x9C061, 0x9C081, 0x9C0A1, 0x9C0C1, 0x9C0E1, 0x9C101, 0x9C121,
+		0x9C141, 0x9C161, 0x9C181, 0x9C1A1, 0x9C1C1, 0x9C1E1, 0x9C201, 0x24101, 0x24121, 0x24141, 0x24161, 0x24181, 0x241A1, 0x241C1, 0, 0,
+		0, 0, 0x434C1, 0x461A1, 0x47DA1, 0x49141, 0x4A3C1, 0x4B2C1, 0x4C021, 0x4CE01, 0x4DB41, 0x241E1, 0x24201, 0x24221, 0x24241, 0x24261,
+		0x24281, 0x242A1, 0x242C1, 0x242E1, 0x24301, 0x24321, 0x427C1, 0x434E1, 0x461C1, 0x47DC1, 0x49161, 0x4A3E1, 0x4B2E1, 0x4C041, 0x4CE21, 0x4DB61,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x9C221,
+		0x40521, 0x40921, 0x40D61, 0x95641, 0x95661, 0x95681, 0x956A1, 0x956C1, 0x956E1, 0x95701, 0x95721, 0x95741, 0x95761, 0x95781, 0x957A1, 0x957C1,
+		0x957E1, 0x95801, 0x95821, 0x95841, 0x95861, 0x95881, 0x958A1, 0x958C1, 0x958E1, 0x95901, 0x95922, 0x95961, 0x95982, 0x959C1, 0x959E1, 0x95A01,
+		0x95A21, 0x95A41, 0x95A61, 0x95A81, 0x95AA1, 0x95AC1, 0x95B21, 0x95B41, 0x95B61, 0x95B81, 0x95BA1, 0x95AE2, 0x95BC1, 0x95BE1, 0x95C01, 0x95C21,
+		0x95C41, 0x95C61, 0x95C81, 0x95CA1, 0x95CC1, 0x95CE1, 0x95D01, 0x95D21, 0x95D41, 0x95D61, 0x40201, 0x24341, 0x24361, 0x24381, 0x243A1, 0x243C1,
+		0x243E1, 0x24401, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD9B01, 0xD9B21, 0xD9B41, 0xD9B61, 0xD9B81, 0xD9BA1, 0xD9BC1, 0xD9BE1, 0xD9C01, 0xD9C21, 0xD9C41, 0xD9C61, 0xD9C81, 0xD9CA1, 0xD9CC1, 0xD9CE1,
+		0xD9D01, 0xD9D21, 0xD9D41, 0xD9D61, 0xD9D81, 0xD9DA1, 0xD9DC1, 0xD9DE1, 0xD9E01, 0, 0, 0, 0, 0, 0, 0,
+		0x427E1, 0x43501, 0x461E1, 0x47DE1, 0x49181, 0x4A401, 0x4B301, 0x4C061, 0x4CE41, 0x4DB81, 0, 0, 0, 0, 0, 0,
+		0x40541, 0x40941, 0x40D81, 0xA4581, 0xA45A1, 0xA45C1, 0xA45E1, 0xA4601, 0xA4621, 0xA4641, 0xA4661, 0xA4681, 0xA46A1, 0xA46C1, 0xA46E1, 0xA4701,
+		0xA4721, 0xA4741, 0xA4761, 0xA4781, 0xA47A1, 0xA47C1, 0xA47E1, 0xA4801, 0xA4821, 0xA4841, 0xA4861, 0xA4881, 0xA48A1, 0xA48C1, 0xA48E1, 0xA4901,
+		0xA4921, 0xA4941, 0xA4961, 0xA4981, 0xA49A1, 0xA49C1, 0xA49E1, 0xA4A01, 0xA4A21, 0xA4A41, 0xA4A61, 0xA4A81, 0xA4AA1, 0xA4AC1, 0xA4AE1, 0xA4B01,
+		0xA4B21, 0xA4B41, 0xA4B61, 0xA4B81, 0xA4BA1, 0, 0x42801, 0x43521, 0x46201, 0x47E01, 0x491A1, 0x4A421, 0x4B321, 0x4C081, 0x4CE61, 0x4DBA1,
+		0x24421, 0x24441, 0x24461, 0x24481, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x95D81, 0x95DA1, 0x95DC1, 0x95DE1, 0x95E01, 0x95E21, 0x95E41, 0x95E61, 0x95E81, 0x95EA1, 0x95EC1, 0x95EE1, 0x95F01, 0x95F21, 0x95F41, 0x95F61,
+		0x95F81, 0x95FA1, 0x95FC1, 0x95FE1, 0x96001, 0x96021, 0x96041, 0x96061, 0x96081, 0x960A1, 0x960C1, 0x960E1, 0x96101, 0x96121, 0x96141, 0x96161,
+		0x961A1, 0x961C1, 0x961E1, 0x40221, 0x244A1, 0x244C1, 0x96181, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x40561, 0x40961, 0x40DA1, 0x96241, 0x96261, 0x96281, 0x962A1, 0x962C1, 0x962E1, 0x96301, 0x96321, 0x96341, 0x96361, 0x96381, 0x963A1, 0x963C1,
+		0x963E1, 0x96401, 0x96421, 0x96441, 0x96461, 0x96481, 0x964A1, 0x964C1, 0x964E1, 0x96501, 0x96521, 0x96541, 0x96561, 0x96581, 0x965A1, 0x965C1,
+		0x965E1, 0x96601, 0x96621, 0x96641, 0x96661, 0x96681, 0x966A1, 0x966C1, 0x966E1, 0x96701, 0x96721, 0x96741, 0x96761, 0x96781, 0x967A1, 0x967C1,
+		0x967E1, 0x96801, 0x96821, 0x968C1, 0x968E1, 0x96901, 0x96921, 0x96941, 0x96961, 0x96981, 0x969A1, 0x969C1, 0x969E1, 0x96A01, 0x96A21, 0x96A41,
+		0x96A61, 0x96841, 0x96861, 0x96881, 0x96201, 0x244E1, 0x24501, 0x24521, 0x24541, 0x40EA1, 0x40241, 0x40FE1, 0x41001, 0x24561, 0, 0,
+		0x42821, 0x43541, 0x46221, 0x47E21, 0x491C1, 0x4A441, 0x4B341, 0x4C0A1, 0x4CE81, 0x4DBC1, 0x96221, 0x24581, 0x968A1, 0x245A1, 0x245C1, 0x245E1,
+		0, 0x43561, 0x46241, 0x47E41, 0x491E1, 0x4A461, 0x4B361, 0x4C0C1, 0x4CEA1, 0x4DBE1, 0x24601, 0x24621, 0x24641, 0x24661, 0x24681, 0x246A1,
+		0x246C1, 0x246E1, 0x24701, 0x24721, 0x24741, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x96A81, 0x96AA1, 0x96AC1, 0x96AE1, 0x96B01, 0x96B21, 0x96B41, 0x96B61, 0x96B81, 0x96BA1, 0x96BC1, 0x96BE1, 0x96C01, 0x96C21, 0x96C41, 0x96C61,
+		0x96C81, 0x96CA1, 0, 0x96CC1, 0x96CE1, 0x96D01, 0x96D21, 0x96D41, 0x96D61, 0x96D81, 0x96DA1, 0x96DC1, 0x96DE1, 0x96E01, 0x96E21, 0x96E41,
+		0x96E61, 0x96E81, 0x96EA1, 0x96EC1, 0x96EE1, 0x96F01, 0x96F21, 0x96F41, 0x96F61, 0x96F81, 0x96FA1, 0x96FC1, 0x96FE1, 0x97001, 0x97021, 0x97041,
+		0x97061, 0x97081, 0x970A1, 0x970C1, 0x40981, 0x970E1, 0x40261, 0x3F761, 0x24761, 0x24781, 0x247A1, 0x247C1, 0x247E1, 0x24801, 0x3F821, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x97821, 0x97841, 0x97861, 0x97881, 0x978E1, 0x97901, 0x97921, 0, 0x97941, 0, 0x97961, 0x97981, 0x979A1, 0x979C1, 0, 0x979E1,
+		0x97A01, 0x97A21, 0x97A41, 0x97A61, 0x97A81, 0x97AA1, 0x97AC1, 0x97AE1, 0x97B01, 0x97B21, 0x97B41, 0x97B61, 0x97B81, 0x97BA1, 0, 0x97BC1,
+		0x97BE1, 0x97C01, 0x97C21, 0x97C41, 0x97C61, 0x978A1, 0x978C1, 0x97C81, 0x97CA1, 0x24821, 0, 0, 0, 0, 0, 0,
+		0x97101, 0x97121, 0x97141, 0x97161, 0x97181, 0x971A1, 0x971C1, 0x971E1, 0x97201, 0x97221, 0x97241, 0x97261, 0x97281, 0x972A1, 0x972C1, 0x972E1,
+		0x97301, 0x97321, 0x97341, 0x97361, 0x97381, 0x973A1, 0x973C1, 0x973E1, 0x97401, 0x97421, 0x97441, 0x97461, 0x97481, 0x974A1, 0x974C1, 0x974E1,
+		0x97501, 0x97521, 0x97541, 0x97561, 0x97581, 0x975A1, 0x975C1, 0x975E1, 0x97601, 0x97621, 0x97641, 0x97661, 0x97681, 0x976A1, 0x976C1, 0x409A1,
+		0x976E1, 0x97701, 0x97721, 0x97741, 0x97761, 0x97781, 0x977A1, 0x977C1, 0x977E1, 0x40281, 0x97801, 0, 0, 0, 0, 0,
+		0x42841, 0x43581, 0x46261, 0x47E61, 0x49201, 0x4A481, 0x4B381, 0x4C0E1, 0x4CEC1, 0x4DC01, 0, 0, 0, 0, 0, 0,
+		0x409C1, 0x40581, 0x409E1, 0x40DC1, 0, 0x97CE1, 0x97D01, 0x97D21, 0x97D41, 0x97D61, 0x97D81, 0x97DA1, 0x97DE1, 0, 0, 0x97E21,
+		0x97E41, 0, 0, 0x97E61, 0x97E81, 0x97EA1, 0x97EC1, 0x97EE1, 0x97F01, 0x97F21, 0x97F41, 0x97F61, 0x97F81, 0x97FA1, 0x97FC1, 0x97FE1,
+		0x98001, 0x98021, 0x98041, 0x98061, 0x98081, 0x980A1, 0x980C1, 0x980E1, 0x98101, 0, 0x98121, 0x98141, 0x98161, 0x98181, 0x981A1, 0x981C1,
+		0x981E1, 0, 0x98201, 0x98221, 0, 0x98241, 0x98261, 0x98281, 0x982A1, 0x982C1, 0, 0, 0x402A1, 0x982E1, 0x98341, 0x98361,
+		0x98381, 0x983A1, 0x983C1, 0x983E1, 0x98401, 0, 0, 0x98461, 0x98481, 0, 0, 0x984A1, 0x984C1, 0x984E1, 0, 0,
+		0x97CC1, 0, 0, 0, 0, 0, 0, 0x98501, 0, 0, 0, 0, 0, 0x98521, 0x98301, 0x98321,
+		0x97DC1, 0x97E01, 0x98421, 0x98441, 0, 0, 0x24841, 0x24861, 0x24881, 0x248A1, 0x248C1, 0x248E1, 0x24901, 0, 0, 0,
+		0x24921, 0x24941, 0x24961, 0x24981, 0x249A1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x98581, 0x985A1, 0x985C1, 0x985E1, 0x98601, 0x98621, 0x98641, 0x98661, 0x98681, 0x986A1, 0x986C1, 0x986E1, 0x98701, 0x98721, 0x98741, 0x98761,
+		0x98781, 0x987A1, 0x987C1, 0x987E1, 0x98801, 0x98821, 0x98841, 0x98861, 0x98881, 0x988A1, 0x988C1, 0x988E1, 0x98901, 0x98921, 0x98941, 0x98961,
+		0x98981, 0x989A1, 0x989C1, 0x989E1, 0x98A01, 0x98A21, 0x98A41, 0x98A61, 0x98A81, 0x98AA1, 0x98AC1, 0x98AE1, 0x98B01, 0x98B21, 0x98B41, 0x98B61,
+		0x98B81, 0x98BA1, 0x98BC1, 0x98BE1, 0x98C01, 0x98C61, 0x98C81, 0x98CA1, 0x98CC1, 0x98CE1, 0x98D01, 0x98D21, 0x98D41, 0x98D61, 0x98D81, 0x98DA1,
+		0x98DC1, 0x98DE1, 0x98E01, 0x405A1, 0x40A01, 0x40DE1, 0x402C1, 0x98C21, 0x98C41, 0x98541, 0x98561, 0x249C1, 0x249E1, 0x24A01, 0x24A21, 0x24A41,
+		0x42861, 0x435A1, 0x46281, 0x47E81, 0x49221, 0x4A4A1, 0x4B3A1, 0x4C101, 0x4CEE1, 0x4DC21, 0, 0x24A61, 0, 0x24A81, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x98E41, 0x98E61, 0x98E81, 0x98EA1, 0x98EC1, 0x98EE1, 0x98F01, 0x98F21, 0x98F41, 0x98F61, 0x98F81, 0x98FA1, 0x98FC1, 0x98FE1, 0x99001, 0x99021,
+		0x99041, 0x99061, 0x99081, 0x990A1, 0x990C1, 0x990E1, 0x99101, 0x99121, 0x99141, 0x99161, 0x99181, 0x991A1, 0x991C1, 0x991E1, 0x99201, 0x99221,
+		0x99241, 0x99261, 0x99281, 0x992A1, 0x992C1, 0x992E1, 0x99301, 0x99321, 0x99341, 0x99361, 0x99381, 0x993A1, 0x993C1, 0x993E1, 0x99401, 0x99421,
+		0x99481, 0x994A1, 0x994C1, 0x994E1, 0x99501, 0x99521, 0x99541, 0x99561, 0x99581, 0x995A1, 0x995C1, 0x995E1, 0x99601, 0x99621, 0x99641, 0x405C1,
+		0x40A21, 0x40E01, 0x99661, 0x402E1, 0x99441, 0x99461, 0x24AA1, 0x98E21, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x42881, 0x435C1, 0x462A1, 0x47EA1, 0x49241, 0x4A4C1, 0x4B3C1, 0x4C121, 0x4CF01, 0x4DC41, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x99681, 0x996A1, 0x996C1, 0x99761, 0x997C1, 0x99821, 0x99841, 0x99861, 0x99881, 0x998A1, 0x998C1, 0x998E1, 0x99901, 0x99921, 0x99941, 0x99961,
+		0x99981, 0x999A1, 0x999C1, 0x999E1, 0x99A01, 0x99A21, 0x99A41, 0x99A61, 0x99A81, 0x99AA1, 0x99AC1, 0x99AE1, 0x99B01, 0x99B21, 0x99B41, 0x99B61,
+		0x99B81, 0x99BA1, 0x99BC1, 0x99BE1, 0x99C01, 0x99C21, 0x99C41, 0x99C61, 0x99C81, 0x99CA1, 0x99CC1, 0x99CE1, 0x99D01, 0x99D21, 0x99D41, 0x99D61,
+		0x99D81, 0x99DA1, 0x99DC1, 0x99E21, 0x99E81, 0x99EA1, 0, 0, 0x99EC1, 0x99EE1, 0x99F01, 0x99F21, 0x405E1, 0x40A41, 0x40E21, 0x99F41,
+		0x40301, 0x24AC1, 0x24AE1, 0x24B01, 0x24B21, 0x24B41, 0x24B61, 0x24B81, 0x24BA1, 0x24BC1, 0x24BE1, 0x24C01, 0x24C21, 0x24C41, 0x24C61, 0x24C81,
+		0x24CA1, 0x24CC1, 0x24CE1, 0x24D01, 0x24D21, 0x24D41, 0x24D61, 0x24D81, 0x996E2, 0x99722, 0x99782, 0x997E2, 0x99DE2, 0x99E42, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x99F61, 0x99F81, 0x99FA1, 0x99FC1, 0x99FE1, 0x9A001, 0x9A021, 0x9A041, 0x9A061, 0x9A081, 0x9A0A1, 0x9A0C1, 0x9A0E1, 0x9A101, 0x9A121, 0x9A141,
+		0x9A161, 0x9A181, 0x9A1A1, 0x9A1C1, 0x9A1E1, 0x9A201, 0x9A221, 0x9A241, 0x9A261, 0x9A281, 0x9A2A1, 0x9A2C1, 0x9A2E1, 0x9A301, 0x9A321, 0x9A341,
+		0x9A361, 0x9A381, 0x9A3A1, 0x9A3C1, 0x9A3E1, 0x9A401, 0x9A421, 0x9A441, 0x9A461, 0x9A481, 0x9A4A1, 0x9A4C1, 0x9A4E1, 0x9A501, 0x9A521, 0x9A541,
+		0x9A561, 0x9A581, 0x9A5A1, 0x9A5C1, 0x9A5E1, 0x9A601, 0x9A621, 0x9A641, 0x9A661, 0x9A681, 0x9A6A1, 0x9A6C1, 0x9A6E1, 0x40A61, 0x40E41, 0x9A701,
+		0x40601, 0x24DA1, 0x24DC1, 0x24DE1, 0x9A721, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x428A1, 0x435E1, 0x462C1, 0x47EC1, 0x49261, 0x4A4E1, 0x4B3E1, 0x4C141, 0x4CF21, 0x4DC61, 0, 0, 0, 0, 0, 0,
+		0x24E01, 0x24E21, 0x24E41, 0x24E61, 0x24E81, 0x24EA1, 0x24EC1, 0x24EE1, 0x24F01, 0x24F21, 0x24F41, 0x24F61, 0x24F81, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x9A741, 0x9A761, 0x9A781, 0x9A7A1, 0x9A7C1, 0x9A7E1, 0x9A801, 0x9A821, 0x9A841, 0x9A861, 0x9A8E1, 0x9A901, 0x9A921, 0x9A941, 0x9A961, 0x9A981,
+		0x9A9A1, 0x9A9C1, 0x9A9E1, 0x9AA01, 0x9AA21, 0x9AA41, 0x9AA61, 0x9AA81, 0x9AAA1, 0x9AAC1, 0x9AAE1, 0x9AB01, 0x9AB21, 0x9AB41, 0x9AB61, 0x9AB81,
+		0x9ABA1, 0x9ABC1, 0x9ABE1, 0x9AC01, 0x9AC21, 0x9AC41, 0x9AC61, 0x9A8A1, 0x9A881, 0x9A8C1, 0x9AC81, 0x40A81, 0x40E61, 0x9ACA1, 0x9ACC1, 0x9ACE1,
+		0x9AD01, 0x9AD21, 0x9AD41, 0x9AD61, 0x9AD81, 0x9ADA1, 0x9ADC1, 0x40321, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x428C1, 0x43601, 0x462E1, 0x47EE1, 0x49281, 0x4A501, 0x4B401, 0x4C161, 0x4CF41, 0x4DC81, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x9ADE1, 0x9AE01, 0x9AE21, 0x9AE41, 0x9AE61, 0x9AE82, 0x9AEC1, 0x9AEE1, 0x9AF01, 0x9AF21, 0x9AF41, 0x9AF61, 0x9AF81, 0x9AFA1, 0x9AFC1, 0x9AFE1,
+		0x9B001, 0x9B021, 0x9B041, 0x9B061, 0x9B081, 0x9B0A1, 0x9B0C2, 0x9B101, 0x9B121, 0x9B141, 0, 0, 0, 0x9B2E1, 0x9B301, 0x9B321,
+		0x9B161, 0x9B181, 0x9B1A1, 0x9B1C1, 0x9B1E1, 0x9B201, 0x9B221, 0x9B241, 0x9B261, 0x9B281, 0x9B2A1, 0x9B2C1, 0, 0, 0, 0,
+		0x428E1, 0x43621, 0x46301, 0x47F01, 0x492A1, 0x4A521, 0x4B421, 0x4C181, 0x4CF61, 0x4DCA1, 0x24FA1, 0x24FC1, 0x24FE1, 0x25001, 0x25021, 0x25041,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD3BC1, 0xD3C01, 0xD3C41, 0xD3C81, 0xD3CC1, 0xD3D01, 0xD3D41, 0xD3D81, 0xD3DC1, 0xD3E01, 0xD3E41, 0xD3E81, 0xD3EC1, 0xD3F01, 0xD3F41, 0xD3F81,
+		0xD3FC1, 0xD4001, 0xD4041, 0xD4081, 0xD40C1, 0xD4101, 0xD4141, 0xD4181, 0xD41C1, 0xD4201, 0xD4241, 0xD4281, 0xD42C1, 0xD4301, 0xD4341, 0xD4381,
+		0xD3BA1, 0xD3BE1, 0xD3C21, 0xD3C61, 0xD3CA1, 0xD3CE1, 0xD3D21, 0xD3D61, 0xD3DA1, 0xD3DE1, 0xD3E21, 0xD3E61, 0xD3EA1, 0xD3EE1, 0xD3F21, 0xD3F61,
+		0xD3FA1, 0xD3FE1, 0xD4021, 0xD4061, 0xD40A1, 0xD40E1, 0xD4121, 0xD4161, 0xD41A1, 0xD41E1, 0xD4221, 0xD4261, 0xD42A1, 0xD42E1, 0xD4321, 0xD4361,
+		0x42901, 0x43641, 0x46321, 0x47F21, 0x492C1, 0x4A541, 0x4B441, 0x4C1A1, 0x4CF81, 0x4DCC1, 0x25061, 0x25081, 0x250A1, 0x250C1, 0x250E1, 0x25101,
+		0x25121, 0x25141, 0x25161, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xD3B81,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD4481, 0xD44A1, 0xD44C1, 0xD44E1, 0xD4501, 0xD4521, 0xD4541, 0xD4561, 0xD4581, 0xD45A1, 0xD45C1, 0xD45E1, 0xD4601, 0xD4621, 0xD4641, 0xD4661,
+		0xD4681, 0xD46A1, 0xD46C1, 0xD46E1, 0xD4701, 0xD43A1, 0xD43C1, 0xD43E1, 0xD4401, 0xD4421, 0xD4441, 0xD4461, 0xD4721, 0xD4741, 0xD4801, 0xD4761,
+		0xD4781, 0xD47A1, 0xD47C1, 0xD47E1, 0xD4821, 0xD48E1, 0xD48A1, 0xD4A61, 0xD4901, 0xD48C1, 0xD4A81, 0xD49E1, 0xD49A1, 0xD4A01, 0xD49C1, 0xD4841,
+		0xD4AA1, 0xD4881, 0xD4861, 0xD4961, 0xD4921, 0xD4A21, 0xD4981, 0xD4941, 0xD4A41, 0, 0, 0, 0, 0, 0, 0,
+		0x9C781, 0x9C7A1, 0x9C7C1, 0x9C7E1, 0x9C801, 0x9C821, 0x9C841, 0x9C861, 0x9C881, 0, 0x9C8A1, 0x9C8C1, 0x9C8E1, 0x9C901, 0x9C921, 0x9C941,
+		0x9C961, 0x9C981, 0x9C9A1, 0x9C9C1, 0x9C9E1, 0x9CA01, 0x9CA21, 0x9CA41, 0x9CA61, 0x9CA81, 0x9CAA1, 0x9CAC1, 0x9CAE1, 0x9CB01, 0x9CB21, 0x9CB41,
+		0x9CB61, 0x9CB81, 0x9CBA1, 0x9CBC1, 0x9CBE1, 0x9CC01, 0x9CC21, 0x9CC41, 0x9CC61, 0x9CC81, 0x9CCA1, 0x9CCC1, 0x9CCE1, 0x9CD01, 0x9CD21, 0x9CD61,
+		0x9CD81, 0x9CDA1, 0x9CDC1, 0x9CDE1, 0x9CE01, 0x9CE21, 0x9CE41, 0, 0x9CE61, 0x9CE81, 0x9CEA1, 0x9CEC1, 0x40621, 0x40AA1, 0x40E81, 0x9CEE1,
+		0x9CD41, 0x25181, 0x251A1, 0x251C1, 0x251E1, 0x25201, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x42921, 0x43661, 0x46341, 0x47F41, 0x492E1, 0x4A561, 0x4B461, 0x4C1C1, 0x4CFA1, 0x4DCE1, 0x43681, 0x46361, 0x47F61, 0x49301, 0x4A581, 0x4B481,
+		0x4C1E1, 0x4CFC1, 0x4DD01, 0x25221, 0x25241, 0x25261, 0x25281, 0x252A1, 0x252C1, 0x252E1, 0x25301, 0x25321, 0x25341, 0, 0, 0,
+		0x25361, 0x25381, 0x9F641, 0x9F681, 0x9F6C1, 0x9F701, 0x9F741, 0x9F781, 0x9F7C1, 0x9F801, 0x9F841, 0x9F881, 0x9F8C1, 0x9F901, 0x9F941, 0x9F981,
+		0x9F9C1, 0x9FA01, 0x9FA41, 0x9FA81, 0x9FAC1, 0x9FB01, 0x9FB41, 0x9FB81, 0x9FBC1, 0x9FBE1, 0x9FC21, 0x9FC61, 0x9FCA1, 0x9FCE1, 0x9FD21, 0x9FD61,
+		0, 0, 0x9F661, 0x9F6A1, 0x9F6E1, 0x9F721, 0x9F761, 0x9F7A1, 0x9F7E1, 0x9F821, 0x9F861, 0x9F8A1, 0x9F8E1, 0x9F921, 0x9F961, 0x9F9A1,
+		0x9F9E1, 0x9FA21, 0x9FA61, 0x9FAA1, 0x9FAE1, 0x9FB21, 0x9FB61, 0x9FBA1, 0, 0x9FC01, 0x9FC41, 0x9FC81, 0x9FCC1, 0x9FD01, 0x9FD41, 0x9FD81,
+		0x9FDA1, 0x9FDC1, 0x9FDE1, 0x9FE01, 0x9FE21, 0x40AC1, 0x40641, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xE1D81, 0xE1DA1, 0xE1DC1, 0xE1DE1, 0xE1E01, 0xE1E21, 0xE1E41, 0xE1E61, 0xE1E81, 0xE1EA1, 0xE1EC1, 0xE1EE1, 0xE1F01, 0xE1F21, 0xE1F41, 0xE1F61,
+		0xE1F81, 0xE1FA1, 0xE1FC1, 0xE1FE1, 0xE2001, 0xE2021, 0xE2041, 0xE2061, 0xE2081, 0xE20A1, 0xE20C1, 0xE20E1, 0xE2101, 0xE2121, 0xE2141, 0xE2161,
+		0xE2181, 0xE21A1, 0xE21C1, 0xE21E1, 0xE2201, 0xE2221, 0xE2241, 0xE2261, 0xE2281, 0xE22A1, 0xE22C1, 0xE22E1, 0xE2301, 0xE2321, 0xE2341, 0xE2361,
+		0xE2381, 0xE23A1, 0xE23C1, 0xE23E1, 0xE2401, 0xE2421, 0xE2441, 0xE2461, 0xE2481, 0xE24A1, 0xE24C1, 0xE24E1, 0xE2501, 0xE2521, 0xE2541, 0xE2561,
+		0xE2581, 0xE25A1, 0xE25C1, 0xE25E1, 0xE2601, 0xE2621, 0xE2641, 0xE2661, 0xE2681, 0xE26A1, 0xE26C1, 0xE26E1, 0xE2701, 0xE2721, 0xE2741, 0xE2761,
+		0xE2781, 0xE27A1, 0xE27C1, 0xE27E1, 0xE2801, 0xE2821, 0xE2841, 0xE2861, 0xE2881, 0xE28A1, 0xE28C1, 0xE28E1, 0xE2901, 0xE2921, 0xE2941, 0xE2961,
+		0xE2981, 0xE29A1, 0xE29C1, 0xE29E1, 0xE2A01, 0xE2A21, 0xE2A41, 0xE2A61, 0xE2A81, 0xE2AA1, 0xE2AC1, 0xE2AE1, 0xE2B01, 0xE2B21, 0xE2B41, 0xE2B61,
+		0xE2B81, 0xE2BA1, 0xE2BC1, 0xE2BE1, 0xE2C01, 0xE2C21, 0xE2C41, 0xE2C61, 0xE2C81, 0xE2CA1, 0xE2CC1, 0xE2CE1, 0xE2D01, 0xE2D21, 0xE2D41, 0xE2D61,
+		0xE2D81, 0xE2DA1, 0xE2DC1, 0xE2DE1, 0xE2E01, 0xE2E21, 0xE2E41, 0xE2E61, 0xE2E81, 0xE2EA1, 0xE2EC1, 0xE2EE1, 0xE2F01, 0xE2F21, 0xE2F41, 0xE2F61,
+		0xE2F81, 0xE2FA1, 0xE2FC1, 0xE2FE1, 0xE3001, 0xE3021, 0xE3041, 0xE3061, 0xE3081, 0xE30A1, 0xE30C1, 0xE30E1, 0xE3101, 0xE3121, 0xE3141, 0xE3161,
+		0xE3181, 0xE31A1, 0xE31C1, 0xE31E1, 0xE3201, 0xE3221, 0xE3241, 0xE3261, 0xE3281, 0xE32A1, 0xE32C1, 0xE32E1, 0xE3301, 0xE3321, 0xE3341, 0xE3361,
+		0xE3381, 0xE33A1, 0xE33C1, 0xE33E1, 0xE3401, 0xE3421, 0xE3441, 0xE3461, 0xE3481, 0xE34A1, 0xE34C1, 0xE34E1, 0xE3501, 0xE3521, 0xE3541, 0xE3561,
+		0xE3581, 0xE35A1, 0xE35C1, 0xE35E1, 0xE3601, 0xE3621, 0xE3641, 0xE3661, 0xE3681, 0xE36A1, 0xE36C1, 0xE36E1, 0xE3701, 0xE3721, 0xE3741, 0xE3761,
+		0xE3781, 0xE37A1, 0xE37C1, 0xE37E1, 0xE3801, 0xE3821, 0xE3841, 0xE3861, 0xE3881, 0xE38A1, 0xE38C1, 0xE38E1, 0xE3901, 0xE3921, 0xE3941, 0xE3961,
+		0xE3981, 0xE39A1, 0xE39C1, 0xE39E1, 0xE3A01, 0xE3A21, 0xE3A41, 0xE3A61, 0xE3A81, 0xE3AA1, 0xE3AC1, 0xE3AE1, 0xE3B01, 0xE3B21, 0xE3B41, 0xE3B61,
+		0xE3B81, 0xE3BA1, 0xE3BC1, 0xE3BE1, 0xE3C01, 0xE3C21, 0xE3C41, 0xE3C61, 0xE3C81, 0xE3CA1, 0xE3CC1, 0xE3CE1, 0xE3D01, 0xE3D21, 0xE3D41, 0xE3D61,
+		0xE3D81, 0xE3DA1, 0xE3DC1, 0xE3DE1, 0xE3E01, 0xE3E21, 0xE3E41, 0xE3E61, 0xE3E81, 0xE3EA1, 0xE3EC1, 0xE3EE1, 0xE3F01, 0xE3F21, 0xE3F41, 0xE3F61,
+		0xE3F81, 0xE3FA1, 0xE3FC1, 0xE3FE1, 0xE4001, 0xE4021, 0xE4041, 0xE4061, 0xE4081, 0xE40A1, 0xE40C1, 0xE40E1, 0xE4101, 0xE4121, 0xE4141, 0xE4161,
+		0xE4181, 0xE41A1, 0xE41C1, 0xE41E1, 0xE4201, 0xE4221, 0xE4241, 0xE4261, 0xE4281, 0xE42A1, 0xE42C1, 0xE42E1, 0xE4301, 0xE4321, 0xE4341, 0xE4361,
+		0xE4381, 0xE43A1, 0xE43C1, 0xE43E1, 0xE4401, 0xE4421, 0xE4441, 0xE4461, 0xE4481, 0xE44A1, 0xE44C1, 0xE44E1, 0xE4501, 0xE4521, 0xE4541, 0xE4561,
+		0xE4581, 0xE45A1, 0xE45C1, 0xE45E1, 0xE4601, 0xE4621, 0xE4641, 0xE4661, 0xE4681, 0xE46A1, 0xE46C1, 0xE46E1, 0xE4701, 0xE4721, 0xE4741, 0xE4761,
+		0xE4781, 0xE47A1, 0xE47C1, 0xE47E1, 0xE4801, 0xE4821, 0xE4841, 0xE4861, 0xE4881, 0xE48A1, 0xE48C1, 0xE48E1, 0xE4901, 0xE4921, 0xE4941, 0xE4961,
+		0xE4981, 0xE49A1, 0xE49C1, 0xE49E1, 0xE4A01, 0xE4A21, 0xE4A41, 0xE4A61, 0xE4A81, 0xE4AA1, 0xE4AC1, 0xE4AE1, 0xE4B01, 0xE4B21, 0xE4B41, 0xE4B61,
+		0xE4B81, 0xE4BA1, 0xE4BC1, 0xE4BE1, 0xE4C01, 0xE4C21, 0xE4C41, 0xE4C61, 0xE4C81, 0xE4CA1, 0xE4CC1, 0xE4CE1, 0xE4D01, 0xE4D21, 0xE4D41, 0xE4D61,
+		0xE4D81, 0xE4DA1, 0xE4DC1, 0xE4DE1, 0xE4E01, 0xE4E21, 0xE4E41, 0xE4E61, 0xE4E81, 0xE4EA1, 0xE4EC1, 0xE4EE1, 0xE4F01, 0xE4F21, 0xE4F41, 0xE4F61,
+		0xE4F81, 0xE4FA1, 0xE4FC1, 0xE4FE1, 0xE5001, 0xE5021, 0xE5041, 0xE5061, 0xE5081, 0xE50A1, 0xE50C1, 0xE50E1, 0xE5101, 0xE5121, 0xE5141, 0xE5161,
+		0xE5181, 0xE51A1, 0xE51C1, 0xE51E1, 0xE5201, 0xE5221, 0xE5241, 0xE5261, 0xE5281, 0xE52A1, 0xE52C1, 0xE52E1, 0xE5301, 0xE5321, 0xE5341, 0xE5361,
+		0xE5381, 0xE53A1, 0xE53C1, 0xE53E1, 0xE5401, 0xE5421, 0xE5441, 0xE5461, 0xE5481, 0xE54A1, 0xE54C1, 0xE54E1, 0xE5501, 0xE5521, 0xE5541, 0xE5561,
+		0xE5581, 0xE55A1, 0xE55C1, 0xE55E1, 0xE5601, 0xE5621, 0xE5641, 0xE5661, 0xE5681, 0xE56A1, 0xE56C1, 0xE56E1, 0xE5701, 0xE5721, 0xE5741, 0xE5761,
+		0xE5781, 0xE57A1, 0xE57C1, 0xE57E1, 0xE5801, 0xE5821, 0xE5841, 0xE5861, 0xE5881, 0xE58A1, 0xE58C1, 0xE58E1, 0xE5901, 0xE5921, 0xE5941, 0xE5961,
+		0xE5981, 0xE59A1, 0xE59C1, 0xE59E1, 0xE5A01, 0xE5A21, 0xE5A41, 0xE5A61, 0xE5A81, 0xE5AA1, 0xE5AC1, 0xE5AE1, 0xE5B01, 0xE5B21, 0xE5B41, 0xE5B61,
+		0xE5B81, 0xE5BA1, 0xE5BC1, 0xE5BE1, 0xE5C01, 0xE5C21, 0xE5C41, 0xE5C61, 0xE5C81, 0xE5CA1, 0xE5CC1, 0xE5CE1, 0xE5D01, 0xE5D21, 0xE5D41, 0xE5D61,
+		0xE5D81, 0xE5DA1, 0xE5DC1, 0xE5DE1, 0xE5E01, 0xE5E21, 0xE5E41, 0xE5E61, 0xE5E81, 0xE5EA1, 0xE5EC1, 0xE5EE1, 0xE5F01, 0xE5F21, 0xE5F41, 0xE5F61,
+		0xE5F81, 0xE5FA1, 0xE5FC1, 0xE5FE1, 0xE6001, 0xE6021, 0xE6041, 0xE6061, 0xE6081, 0xE60A1, 0xE60C1, 0xE60E1, 0xE6101, 0xE6121, 0xE6141, 0xE6161,
+		0xE6181, 0xE61A1, 0xE61C1, 0xE61E1, 0xE6201, 0xE6221, 0xE6241, 0xE6261, 0xE6281, 0xE62A1, 0xE62C1, 0xE62E1, 0xE6301, 0xE6321, 0xE6341, 0xE6361,
+		0xE6381, 0xE63A1, 0xE63C1, 0xE63E1, 0xE6401, 0xE6421, 0xE6441, 0xE6461, 0xE6481, 0xE64A1, 0xE64C1, 0xE64E1, 0xE6501, 0xE6521, 0xE6541, 0xE6561,
+		0xE6581, 0xE65A1, 0xE65C1, 0xE65E1, 0xE6601, 0xE6621, 0xE6641, 0xE6661, 0xE6681, 0xE66A1, 0xE66C1, 0xE66E1, 0xE6701, 0xE6721, 0xE6741, 0xE6761,
+		0xE6781, 0xE67A1, 0xE67C1, 0xE67E1, 0xE6801, 0xE6821, 0xE6841, 0xE6861, 0xE6881, 0xE68A1, 0xE68C1, 0xE68E1, 0xE6901, 0xE6921, 0xE6941, 0xE6961,
+		0xE6981, 0xE69A1, 0xE69C1, 0xE6A21, 0xE6A41, 0xE6A61, 0xE6A81, 0xE6AA1, 0xE6AC1, 0xE6AE1, 0xE6B01, 0xE6B21, 0xE6B41, 0xE6B61, 0xE6B81, 0xE6BA1,
+		0xE6BC1, 0xE6BE1, 0xE6C01, 0xE6C21, 0xE6C41, 0xE6C61, 0xE6C81, 0xE6CA1, 0xE6CC1, 0xE6CE1, 0xE6D01, 0xE6D21, 0xE6D41, 0xE6D61, 0xE6D81, 0xE6DA1,
+		0xE6DC1, 0xE6DE1, 0xE6E01, 0xE6E21, 0xE6E41, 0xE6E61, 0xE6E81, 0xE6EA1, 0xE6EC1, 0xE6EE1, 0xE6F01, 0xE6F21, 0xE6F41, 0xE6F61, 0xE6F81, 0xE6FA1,
+		0xE6FC1, 0xE6FE1, 0xE7001, 0xE7021, 0xE7041, 0xE7061, 0xE7081, 0xE70A1, 0xE70C1, 0xE70E1, 0xE7101, 0xE7121, 0xE7141, 0xE7161, 0xE7181, 0xE71A1,
+		0xE71C1, 0xE71E1, 0xE7201, 0xE7221, 0xE7241, 0xE7261, 0xE7281, 0xE72A1, 0xE72C1, 0xE72E1, 0xE7301, 0xE7321, 0xE7341, 0xE7361, 0xE7381, 0xE73A1,
+		0xE73C1, 0xE73E1, 0xE7401, 0xE7421, 0xE7441, 0xE7461, 0xE7481, 0xE74A1, 0xE74C1, 0xE74E1, 0xE7501, 0xE7521, 0xE7541, 0xE7561, 0xE7581, 0xE75A1,
+		0xE75C1, 0xE75E1, 0xE7601, 0xE7621, 0xE7641, 0xE7661, 0xE7681, 0xE76A1, 0xE76C1, 0xE76E1, 0xE7701, 0xE7721, 0xE7741, 0xE7761, 0xE7781, 0xE77A1,
+		0xE77C1, 0xE77E1, 0xE7801, 0xE7821, 0xE69E1, 0xE6A01, 0xE7841, 0xE7861, 0xE7881, 0xE78A1, 0xE78C1, 0xE78E1, 0xE7901, 0xE7921, 0xE7941, 0xE7961,
+		0xE7981, 0xE79A1, 0xE79C1, 0xE79E1, 0xE7A01, 0xE7A21, 0xE7A41, 0xE7A61, 0xE7A81, 0xE7AA1, 0xE7AC1, 0xE7AE1, 0xE7B01, 0xE7B21, 0xE7B41, 0xE7B61,
+		0xE7B81, 0xE7BA1, 0xE7BC1, 0xE7BE1, 0xE7C01, 0xE7C21, 0xE7C41, 0xE7C61, 0xE7C81, 0xE7CA1, 0xE7CC1, 0xE7CE1, 0xE7D01, 0xE7D21, 0xE7D41, 0xE7D61,
+		0xE7D81, 0xE7DA1, 0xE7DC1, 0xE7DE1, 0xE7E01, 0xE7E21, 0xE7E41, 0xE7E61, 0xE7E81, 0xE7EA1, 0xE7EC1, 0xE7EE1, 0xE7F01, 0xE7F21, 0xE7F41, 0xE7F61,
+		0xE7F81, 0xE7FA1, 0xE7FC1, 0xE7FE1, 0xE8001, 0xE8021, 0xE8041, 0xE8061, 0xE8081, 0xE80A1, 0xE80C1, 0xE80E1, 0xE8101, 0xE8121, 0xE8141, 0xE8161,
+		0xE8181, 0xE81A1, 0xE81C1, 0xE81E1, 0xE8201, 0xE8221, 0xE8241, 0xE8261, 0xE8281, 0xE82A1, 0xE82C1, 0xE82E1, 0xE8301, 0xE8321, 0xE8341, 0xE8361,
+		0xE8381, 0xE83A1, 0xE83C1, 0xE83E1, 0xE8401, 0xE8421, 0xE8441, 0xE8461, 0xE8481, 0xE84A1, 0xE84C1, 0xE84E1, 0xE8501, 0xE8521, 0xE8541, 0xE8561,
+		0xE8581, 0xE85A1, 0xE85C1, 0xE85E1, 0xE8601, 0xE8621, 0xE8641, 0xE8661, 0xE8681, 0xE86A1, 0xE86C1, 0xE86E1, 0xE8701, 0xE8721, 0xE8741, 0xE8761,
+		0xE8781, 0xE87A1, 0xE87C1, 0xE87E1, 0xE8801, 0xE8821, 0xE8841, 0xE8861, 0xE8881, 0xE88A1, 0xE88C1, 0xE88E1, 0xE8901, 0xE8921, 0xE8941, 0xE8961,
+		0xE8981, 0xE89A1, 0xE89C1, 0xE89E1, 0xE8A01, 0xE8A21, 0xE8A41, 0xE8A61, 0xE8A81, 0xE8AA1, 0xE8AC1, 0xE8AE1, 0xE8B01, 0xE8B21, 0xE8B41, 0xE8B61,
+		0xE8B81, 0xE8BA1, 0xE8BC1, 0xE8BE1, 0xE8C01, 0xE8C21, 0xE8C41, 0xE8C61, 0xE8C81, 0xE8CA1, 0xE8CC1, 0xE8CE1, 0xE8D01, 0xE8D21, 0xE8D41, 0xE8D61,
+		0xE8D81, 0xE8DA1, 0xE8DC1, 0xE8DE1, 0xE8E01, 0xE8E21, 0xE8E41, 0xE8E61, 0xE8E81, 0xE8EA1, 0xE8EC1, 0xE8EE1, 0xE8F01, 0xE8F21, 0xE8F41, 0xE8F61,
+		0xE8F81, 0xE8FA1, 0xE8FC1, 0xE8FE1, 0xE9001, 0xE9021, 0xE9041, 0xE9061, 0xE9081, 0xE90A1, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x46381, 0x47F81, 0x49321, 0x4A5A1, 0x4B4A1, 0x4C201, 0x4CFE1, 0x4DD21, 0x47FA1, 0x49341, 0x4A5C1, 0x4B4C1, 0x4C221, 0x4D001, 0x4DD41, 0x49361,
+		0x4A5E1, 0x4B4E1, 0x4C241, 0x4D021, 0x4DD61, 0x436A1, 0x463A1, 0x47FC1, 0x49381, 0x4A601, 0x4B501, 0x4C261, 0x4D041, 0x4DD81, 0x436C1, 0x463C1,
+		0x47FE1, 0x493A1, 0x4A621, 0x463E1, 0x48001, 0x48021, 0x493C1, 0x4A641, 0x4B521, 0x4C281, 0x4D061, 0x4DDA1, 0x436E1, 0x46401, 0x48041, 0x48061,
+		0x493E1, 0x4A661, 0x253A1, 0x253C1, 0x43701, 0x46421, 0x48081, 0x480A1, 0x49401, 0x4A681, 0x480C1, 0x480E1, 0x49421, 0x49441, 0x49461, 0x49481,
+		0x4B541, 0x4C2A1, 0x4C2C1, 0x4C2E1, 0x4D081, 0x4D0A1, 0x4DDC1, 0x4DDE1, 0x4DE01, 0x4DE21, 0x46441, 0x48101, 0x494A1, 0x4A6A1, 0x4B561, 0x43721,
+		0x46461, 0x48121, 0x494C1, 0x494E1, 0x4A6C1, 0x4A6E1, 0x46481, 0x48141, 0x43741, 0x464A1, 0x253E1, 0x25401, 0x25421, 0x25441, 0x25461, 0x25481,
+		0x254A1, 0x254C1, 0x254E1, 0x25501, 0x25521, 0x25541, 0x25561, 0x25581, 0x255A1, 0x49501, 0x4A701, 0x4B581, 0x4C301, 0x4D0C1, 0x4DE41, 0,
+		0x255C1, 0x255E1, 0x25601, 0x25621, 0x25641, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xE90C1, 0xE90E1, 0xE9101, 0xE9121, 0xE9141, 0xE9161, 0xE9181, 0xE91A1, 0xE91C1, 0xE91E1, 0xE9201, 0xE9221, 0xE9241, 0xE9261, 0xE9281, 0xE92A1,
+		0xE92C1, 0xE92E1, 0xE9301, 0xE9321, 0xE9341, 0xE9361, 0xE9381, 0xE93A1, 0xE93C1, 0xE93E1, 0xE9401, 0xE9421, 0xE9441, 0xE9461, 0xE9481, 0xE94A1,
+		0xE94C1, 0xE94E1, 0xE9501, 0xE9521, 0xE9541, 0xE9561, 0xE9581, 0xE95A1, 0xE95C1, 0xE95E1, 0xE9601, 0xE9621, 0xE9641, 0xE9661, 0xE9681, 0xE96A1,
+		0xE96C1, 0xE96E1, 0xE9701, 0xE9721, 0xE9741, 0xE9761, 0xE9781, 0xE97A1, 0xE97C1, 0xE97E1, 0xE9801, 0xE9821, 0xE9841, 0xE9861, 0xE9881, 0xE98A1,
+		0xE98C1, 0xE98E1, 0xE9901, 0xE9921, 0xE9941, 0xE9961, 0xE9981, 0xE99A1, 0xE99C1, 0xE99E1, 0xE9A01, 0xE9A21, 0xE9A41, 0xE9A61, 0xE9A81, 0xE9AA1,
+		0xE9AC1, 0xE9AE1, 0xE9B01, 0xE9B21, 0xE9B41, 0xE9B61, 0xE9B81, 0xE9BA1, 0xE9BC1, 0xE9BE1, 0xE9C01, 0xE9C21, 0xE9C41, 0xE9C61, 0xE9C81, 0xE9CA1,
+		0xE9CC1, 0xE9CE1, 0xE9D01, 0xE9D21, 0xE9D41, 0xE9D61, 0xE9D81, 0xE9DA1, 0xE9DC1, 0xE9DE1, 0xE9E01, 0xE9E21, 0xE9E41, 0xE9E61, 0xE9E81, 0xE9EA1,
+		0xE9EC1, 0xE9EE1, 0xE9F01, 0xE9F21, 0xE9F41, 0xE9F61, 0xE9F81, 0xE9FA1, 0xE9FC1, 0xE9FE1, 0xEA001, 0xEA021, 0xEA041, 0xEA061, 0xEA081, 0xEA0A1,
+		0xEA0C1, 0xEA0E1, 0xEA101, 0xEA121, 0xEA141, 0xEA161, 0xEA181, 0xEA1A1, 0xEA1C1, 0xEA1E1, 0xEA201, 0xEA221, 0xEA241, 0xEA261, 0xEA281, 0xEA2A1,
+		0xEA2C1, 0xEA2E1, 0xEA301, 0xEA321, 0xEA341, 0xEA361, 0xEA381, 0xEA3A1, 0xEA3C1, 0xEA3E1, 0xEA401, 0xEA421, 0xEA441, 0xEA461, 0xEA481, 0xEA4A1,
+		0xEA4C1, 0xEA4E1, 0xEA501, 0xEA521, 0xEA541, 0xEA561, 0xEA581, 0xEA5A1, 0xEA5C1, 0xEA5E1, 0xEA601, 0xEA621, 0xEA641, 0xEA661, 0xEA681, 0xEA6A1,
+		0xEA6C1, 0xEA6E1, 0xEA701, 0xEA721, 0xEA741, 0xEA761, 0xEA781, 0xEA7A1, 0xEA7C1, 0xEA7E1, 0xEA801, 0xEA821, 0xEA841, 0xEA861, 0xEA881, 0xEA8A1,
+		0xEA8C1, 0xEA8E1, 0xEA901, 0xEA921, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xEA941, 0xEA961, 0xEA981, 0xEA9A1, 0xEA9C1, 0xEA9E1, 0xEAA01, 0xEAA21, 0xEAA41, 0xEAA61, 0xEAA81, 0xEAAA1, 0xEAAC1, 0xEAAE1, 0xEAB01, 0xEAB21,
+		0xEAB41, 0xEAB61, 0xEAB81, 0xEABA1, 0xEABC1, 0xEABE1, 0xEAC01, 0xEAC21, 0xEAC41, 0xEAC61, 0xEAC81, 0xEACA1, 0xEACC1, 0xEACE1, 0xEAD01, 0xEAD21,
+		0xEAD41, 0xEAD61, 0xEAD81, 0xEADA1, 0xEADC1, 0xEADE1, 0xEAE01, 0xEAE21, 0xEAE41, 0xEAE61, 0xEAE81, 0xEAEA1, 0xEAEC1, 0xEAEE1, 0xEAF01, 0xEAF21,
+		0xEAF41, 0xEAF61, 0xEAF81, 0xEAFA1, 0xEAFC1, 0xEAFE1, 0xEB001, 0xEB021, 0xEB041, 0xEB061, 0xEB081, 0xEB0A1, 0xEB0C1, 0xEB0E1, 0xEB101, 0xEB121,
+		0xEB141, 0xEB161, 0xEB181, 0xEB1A1, 0xEB1C1, 0xEB1E1, 0xEB201, 0xEB221, 0xEB241, 0xEB261, 0xEB281, 0xEB2A1, 0xEB2C1, 0xEB2E1, 0xEB301, 0xEB321,
+		0xEB341, 0xEB361, 0xEB381, 0xEB3A1, 0xEB3C1, 0xEB3E1, 0xEB401, 0xEB421, 0xEB441, 0xEB461, 0xEB481, 0xEB4A1, 0xEB4C1, 0xEB4E1, 0xEB501, 0xEB521,
+		0xEB541, 0xEB561, 0xEB581, 0xEB5A1, 0xEB5C1, 0xEB5E1, 0xEB601, 0xEB621, 0xEB641, 0xEB661, 0xEB681, 0xEB6A1, 0xEB6C1, 0xEB6E1, 0xEB701, 0xEB721,
+		0xEB741, 0xEB761, 0xEB781, 0xEB7A1, 0xEB7C1, 0xEB7E1, 0xEB801, 0xEB821, 0xEB841, 0xEB861, 0xEB881, 0xEB8A1, 0xEB8C1, 0xEB8E1, 0xEB901, 0xEB921,
+		0xEB941, 0xEB961, 0xEB981, 0xEB9A1, 0xEB9C1, 0xEB9E1, 0xEBA01, 0xEBA21, 0xEBA41, 0xEBA61, 0xEBA81, 0xEBAA1, 0xEBAC1, 0xEBAE1, 0xEBB01, 0xEBB21,
+		0xEBB41, 0xEBB61, 0xEBB81, 0xEBBA1, 0xEBBC1, 0xEBBE1, 0xEBC01, 0xEBC21, 0xEBC41, 0xEBC61, 0xEBC81, 0xEBCA1, 0xEBCC1, 0xEBCE1, 0xEBD01, 0xEBD21,
+		0xEBD41, 0xEBD61, 0xEBD81, 0xEBDA1, 0xEBDC1, 0xEBDE1, 0xEBE01, 0xEBE21, 0xEBE41, 0xEBE61, 0xEBE81, 0xEBEA1, 0xEBEC1, 0xEBEE1, 0xEBF01, 0xEBF21,
+		0xEBF41, 0xEBF61, 0xEBF81, 0xEBFA1, 0xEBFC1, 0xEBFE1, 0xEC001, 0xEC021, 0xEC041, 0xEC061, 0xEC081, 0xEC0A1, 0xEC0C1, 0xEC0E1, 0xEC101, 0xEC121,
+		0xEC141, 0xEC161, 0xEC181, 0xEC1A1, 0xEC1C1, 0xEC1E1, 0xEC201, 0xEC221, 0xEC241, 0xEC261, 0xEC281, 0xEC2A1, 0xEC2C1, 0xEC2E1, 0xEC301, 0xEC321,
+		0xEC341, 0xEC361, 0xEC381, 0xEC3A1, 0xEC3C1, 0xEC3E1, 0xEC401, 0xEC421, 0xEC441, 0xEC461, 0xEC481, 0xEC4A1, 0xEC4C1, 0xEC4E1, 0xEC501, 0xEC521,
+		0xEC541, 0xEC561, 0xEC581, 0xEC5A1, 0xEC5C1, 0xEC5E1, 0xEC601, 0xEC621, 0xEC641, 0xEC661, 0xEC681, 0xEC6A1, 0xEC6C1, 0xEC6E1, 0xEC701, 0xEC721,
+		0xEC741, 0xEC761, 0xEC781, 0xEC7A1, 0xEC7C1, 0xEC7E1, 0xEC801, 0xEC821, 0xEC841, 0xEC861, 0xEC881, 0xEC8A1, 0xEC8C1, 0xEC8E1, 0xEC901, 0xEC921,
+		0xEC941, 0xEC961, 0xEC981, 0xEC9A1, 0xEC9C1, 0xEC9E1, 0xECA01, 0xECA21, 0xECA41, 0xECA61, 0xECA81, 0xECAA1, 0xECAC1, 0xECAE1, 0xECB01, 0xECB21,
+		0xECB41, 0xECB61, 0xECB81, 0xECBA1, 0xECBC1, 0xECBE1, 0xECC01, 0xECC21, 0xECC41, 0xECC61, 0xECC81, 0xECCA1, 0xECCC1, 0xECCE1, 0xECD01, 0xECD21,
+		0xECD41, 0xECD61, 0xECD81, 0xECDA1, 0xECDC1, 0xECDE1, 0xECE01, 0xECE21, 0xECE41, 0xECE61, 0xECE81, 0xECEA1, 0xECEC1, 0xECEE1, 0xECF01, 0xECF21,
+		0xECF41, 0xECF61, 0xECF81, 0xECFA1, 0xECFC1, 0xECFE1, 0xED001, 0xED021, 0xED041, 0xED061, 0xED081, 0xED0A1, 0xED0C1, 0xED0E1, 0xED101, 0xED121,
+		0xED141, 0xED161, 0xED181, 0xED1A1, 0xED1C1, 0xED1E1, 0xED201, 0xED221, 0xED241, 0xED261, 0xED281, 0xED2A1, 0xED2C1, 0xED2E1, 0xED301, 0xED321,
+		0xED341, 0xED361, 0xED381, 0xED3A1, 0xED3C1, 0xED3E1, 0xED401, 0xED421, 0xED441, 0xED461, 0xED481, 0xED4A1, 0xED4C1, 0xED4E1, 0xED501, 0xED521,
+		0xED541, 0xED561, 0xED581, 0xED5A1, 0xED5C1, 0xED5E1, 0xED601, 0xED621, 0xED641, 0xED661, 0xED681, 0xED6A1, 0xED6C1, 0xED6E1, 0xED701, 0xED721,
+		0xED741, 0xED761, 0xED781, 0xED7A1, 0xED7C1, 0xED7E1, 0xED801, 0xED821, 0xED841, 0xED861, 0xED881, 0xED8A1, 0xED8C1, 0xED8E1, 0xED901, 0xED921,
+		0xED941, 0xED961, 0xED981, 0xED9A1, 0xED9C1, 0xED9E1, 0xEDA01, 0xEDA21, 0xEDA41, 0xEDA61, 0xEDA81, 0xEDAA1, 0xEDAC1, 0xEDAE1, 0xEDB01, 0xEDB21,
+		0xEDB41, 0xEDB61, 0xEDB81, 0xEDBA1, 0xEDBC1, 0xEDBE1, 0xEDC01, 0xEDC21, 0xEDC41, 0xEDC61, 0xEDC81, 0xEDCA1, 0xEDCC1, 0xEDCE1, 0xEDD01, 0xEDD21,
+		0xEDD41, 0xEDD61, 0xEDD81, 0xEDDA1, 0xEDDC1, 0xEDDE1, 0xEDE01, 0xEDE21, 0xEDE41, 0xEDE61, 0xEDE81, 0xEDEA1, 0xEDEC1, 0xEDEE1, 0xEDF01, 0xEDF21,
+		0xEDF41, 0xEDF61, 0xEDF81, 0xEDFA1, 0xEDFC1, 0xEDFE1, 0xEE001, 0xEE021, 0xEE041, 0xEE061, 0xEE081, 0xEE0A1, 0xEE0C1, 0xEE0E1, 0xEE101, 0xEE121,
+		0xEE141, 0xEE161, 0xEE181, 0xEE1A1, 0xEE1C1, 0xEE1E1, 0xEE201, 0xEE221, 0xEE241, 0xEE261, 0xEE281, 0xEE2A1, 0xEE2C1, 0xEE2E1, 0xEE301, 0xEE321,
+		0xEE341, 0xEE361, 0xEE381, 0xEE3A1, 0xEE3C1, 0xEE3E1, 0xEE401, 0xEE421, 0xEE441, 0xEE461, 0xEE481, 0xEE4A1, 0xEE4C1, 0xEE4E1, 0xEE501, 0xEE521,
+		0xEE541, 0xEE561, 0xEE581, 0xEE5A1, 0xEE5C1, 0xEE5E1, 0xEE601, 0xEE621, 0xEE641, 0xEE661, 0xEE681, 0xEE6A1, 0xEE6C1, 0xEE6E1, 0xEE701, 0xEE721,
+		0xEE741, 0xEE761, 0xEE781, 0xEE7A1, 0xEE7C1, 0xEE7E1, 0xEE801, 0xEE821, 0xEE841, 0xEE861, 0xEE881, 0xEE8A1, 0xEE8C1, 0xEE8E1, 0xEE901, 0xEE921,
+		0xEE941, 0xEE961, 0xEE981, 0xEE9A1, 0xEE9C1, 0xEE9E1, 0xEEA01, 0xEEA21, 0xEEA41, 0xEEA61, 0xEEA81, 0xEEAA1, 0xEEAC1, 0xEEAE1, 0xEEB01, 0xEEB21,
+		0xEEB41, 0xEEB61, 0xEEB81, 0xEEBA1, 0xEEBC1, 0xEEBE1, 0xEEC01, 0xEEC21, 0xEEC41, 0xEEC61, 0xEEC81, 0xEECA1, 0xEECC1, 0xEECE1, 0xEED01, 0xEED21,
+		0xEED41, 0xEED61, 0xEED81, 0xEEDA1, 0xEEDC1, 0xEEDE1, 0xEEE01, 0xEEE21, 0xEEE41, 0xEEE61, 0xEEE81, 0xEEEA1, 0xEEEC1, 0xEEEE1, 0xEEF01, 0xEEF21,
+		0xEEF41, 0xEEF61, 0xEEF81, 0xEEFA1, 0xEEFC1, 0xEEFE1, 0xEF001, 0xEF021, 0xEF041, 0xEF061, 0xEF081, 0xEF0A1, 0xEF0C1, 0xEF0E1, 0xEF101, 0xEF121,
+		0xEF141, 0xEF161, 0xEF181, 0xEF1A1, 0xEF1C1, 0xEF1E1, 0xEF201, 0xEF221, 0xEF241, 0xEF261, 0xEF281, 0xEF2A1, 0xEF2C1, 0xEF2E1, 0xEF301, 0xEF321,
+		0xEF341, 0xEF361, 0xEF381, 0xEF3A1, 0xEF3C1, 0xEF3E1, 0xEF401, 0xEF421, 0xEF441, 0xEF461, 0xEF481, 0xEF4A1, 0xEF4C1, 0xEF4E1, 0xEF501, 0xEF521,
+		0xEF541, 0xEF561, 0xEF581, 0xEF5A1, 0xEF5C1, 0xEF5E1, 0xEF601, 0xEF621, 0xEF641, 0xEF661, 0xEF681, 0xEF6A1, 0xEF6C1, 0xEF6E1, 0xEF701, 0xEF721,
+		0xEF741, 0xEF761, 0xEF781, 0xEF7A1, 0xEF7C1, 0xEF7E1, 0xEF801, 0xEF821, 0xEF841, 0xEF861, 0xEF881, 0xEF8A1, 0xEF8C1, 0xEF8E1, 0xEF901, 0xEF921,
+		0xEF941, 0xEF961, 0xEF981, 0xEF9A1, 0xEF9C1, 0xEF9E1, 0xEFA01, 0xEFA21, 0xEFA41, 0xEFA61, 0xEFA81, 0xEFAA1, 0xEFAC1, 0xEFAE1, 0xEFB01, 0xEFB21,
+		0xEFB41, 0xEFB61, 0xEFB81, 0xEFBA1, 0xEFBC1, 0xEFBE1, 0xEFC01, 0xEFC21, 0xEFC41, 0xEFC61, 0xEFC81, 0xEFCA1, 0xEFCC1, 0xEFCE1, 0xEFD01, 0xEFD21,
+		0xEFD41, 0xEFD61, 0xEFD81, 0xEFDA1, 0xEFDC1, 0xEFDE1, 0xEFE01, 0xEFE21, 0xEFE41, 0xEFE61, 0xEFE81, 0xEFEA1, 0xEFEC1, 0xEFEE1, 0xEFF01, 0xEFF21,
+		0xEFF41, 0xEFF61, 0xEFF81, 0xEFFA1, 0xEFFC1, 0xEFFE1, 0xF0001, 0xF0021, 0xF0041, 0xF0061, 0xF0081, 0xF00A1, 0xF00C1, 0xF00E1, 0xF0101, 0xF0121,
+		0xF0141, 0xF0161, 0xF0181, 0xF01A1, 0xF01C1, 0xF01E1, 0xF0201, 0xF0221, 0xF0241, 0xF0261, 0xF0281, 0xF02A1, 0xF02C1, 0xF02E1, 0xF0301, 0xF0321,
+		0xF0341, 0xF0361, 0xF0381, 0xF03A1, 0xF03C1, 0xF03E1, 0xF0401, 0xF0421, 0xF0441, 0xF0461, 0xF0481, 0xF04A1, 0xF04C1, 0xF04E1, 0xF0501, 0xF0521,
+		0xF0541, 0xF0561, 0xF0581, 0xF05A1, 0xF05C1, 0xF05E1, 0xF0601, 0xF0621, 0xF0641, 0xF0661, 0xF0681, 0xF06A1, 0xF06C1, 0xF06E1, 0xF0701, 0xF0721,
+		0xF0741, 0xF0761, 0xF0781, 0xF07A1, 0xF07C1, 0xF07E1, 0xF0801, 0xF0821, 0xF0841, 0xF0861, 0xF0881, 0xF08A1, 0xF08C1, 0xF08E1, 0xF0901, 0xF0921,
+		0xF0941, 0xF0961, 0xF0981, 0xF09A1, 0xF09C1, 0xF09E1, 0xF0A01, 0xF0A21, 0xF0A41, 0xF0A61, 0xF0A81, 0xF0AA1, 0xF0AC1, 0xF0AE1, 0xF0B01, 0xF0B21,
+		0xF0B41, 0xF0B61, 0xF0B81, 0xF0BA1, 0xF0BC1, 0xF0BE1, 0xF0C01, 0xF0C21, 0xF0C41, 0xF0C61, 0xF0C81, 0xF0CA1, 0xF0CC1, 0xF0CE1, 0xF0D01, 0xF0D21,
+		0xF0D41, 0xF0D61, 0xF0D81, 0xF0DA1, 0xF0DC1, 0xF0DE1, 0xF0E01, 0xF0E21, 0xF0E41, 0xF0E61, 0xF0E81, 0xF0EA1, 0xF0EC1, 0xF0EE1, 0xF0F01, 0xF0F21,
+		0xF0F41, 0xF0F61, 0xF0F81, 0xF0FA1, 0xF0FC1, 0xF0FE1, 0xF1001, 0xF1021, 0xF1041, 0xF1061, 0xF1081, 0xF10A1, 0xF10C1, 0xF10E1, 0xF1101, 0xF1121,
+		0xF1141, 0xF1161, 0xF1181, 0xF11A1, 0xF11C1, 0xF11E1, 0xF1201, 0xF1221, 0xF1241, 0xF1261, 0xF1281, 0xF12A1, 0xF12C1, 0xF12E1, 0xF1301, 0xF1321,
+		0xF1341, 0xF1361, 0xF1381, 0xF13A1, 0xF13C1, 0xF13E1, 0xF1401, 0xF1421, 0xF1441, 0xF1461, 0xF1481, 0xF14A1, 0xF14C1, 0xF14E1, 0xF1501, 0xF1521,
+		0xF1541, 0xF1561, 0xF1581, 0xF15A1, 0xF15C1, 0xF15E1, 0xF1601, 0xF1621, 0xF1641, 0xF1661, 0xF1681, 0xF16A1, 0xF16C1, 0xF16E1, 0xF1701, 0xF1721,
+		0xF1741, 0xF1761, 0xF1781, 0xF17A1, 0xF17C1, 0xF17E1, 0xF1801, 0xF1821, 0xF1841, 0xF1861, 0xF1881, 0xF18A1, 0xF18C1, 0xF18E1, 0xF1901, 0xF1921,
+		0xF1941, 0xF1961, 0xF1981, 0xF19A1, 0xF19C1, 0xF19E1, 0xF1A01, 0xF1A21, 0xF1A41, 0xF1A61, 0xF1A81, 0xF1AA1, 0xF1AC1, 0xF1AE1, 0xF1B01, 0xF1B21,
+		0xF1B41, 0xF1B61, 0xF1B81, 0xF1BA1, 0xF1BC1, 0xF1BE1, 0xF1C01, 0xF1C21, 0xF1C41, 0xF1C61, 0xF1C81, 0xF1CA1, 0xF1CC1, 0xF1CE1, 0xF1D01, 0xF1D21,
+		0xF1D41, 0xF1D61, 0xF1D81, 0xF1DA1, 0xF1DC1, 0xF1DE1, 0xF1E01, 0xF1E21, 0xF1E41, 0xF1E61, 0xF1E81, 0xF1EA1, 0xF1EC1, 0xF1EE1, 0xF1F01, 0xF1F21,
+		0xF1F41, 0xF1F61, 0xF1F81, 0xF1FA1, 0xF1FC1, 0xF1FE1, 0xF2001, 0xF2021, 0xF2041, 0xF2061, 0xF2081, 0xF20A1, 0xF20C1, 0xF20E1, 0xF2101, 0xF2121,
+		0xF2141, 0xF2161, 0xF2181, 0xF21A1, 0xF21C1, 0xF21E1, 0xF2201, 0xF2221, 0xF2241, 0xF2261, 0xF2281, 0xF22A1, 0xF22C1, 0xF22E1, 0xF2301, 0xF2321,
+		0xF2341, 0xF2361, 0xF2381, 0xF23A1, 0xF23C1, 0xF23E1, 0xF2401, 0xF2421, 0xF2441, 0xF2461, 0xF2481, 0xF24A1, 0xF24C1, 0xF24E1, 0xF2501, 0xF2521,
+		0xF2541, 0xF2561, 0xF2581, 0xF25A1, 0xF25C1, 0xF25E1, 0xF2601, 0xF2621, 0xF2641, 0xF2661, 0xF2681, 0xF26A1, 0xF26C1, 0xF26E1, 0xF2701, 0xF2721,
+		0xF2741, 0xF2761, 0xF2781, 0xF27A1, 0xF27C1, 0xF27E1, 0xF2801, 0xF2821, 0xF2841, 0xF2861, 0xF2881, 0xF28A1, 0xF28C1, 0xF28E1, 0xF2901, 0xF2921,
+		0xF2941, 0xF2961, 0xF2981, 0xF29A1, 0xF29C1, 0xF29E1, 0xF2A01, 0xF2A21, 0xF2A41, 0xF2A61, 0xF2A81, 0xF2AA1, 0xF2AC1, 0xF2AE1, 0xF2B01, 0xF2B21,
+		0xF2B41, 0xF2B61, 0xF2B81, 0xF2BA1, 0xF2BC1, 0xF2BE1, 0xF2C01, 0xF2C21, 0xF2C41, 0xF2C61, 0xF2C81, 0xF2CA1, 0xF2CC1, 0xF2CE1, 0xF2D01, 0xF2D21,
+		0xF2D41, 0xF2D61, 0xF2D81, 0xF2DA1, 0xF2DC1, 0xF2DE1, 0xF2E01, 0xF2E21, 0xF2E41, 0xF2E61, 0xF2E81, 0xF2EA1, 0xF2EC1, 0xF2EE1, 0xF2F01, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xF3A41, 0xF3A61, 0xF3A81, 0xF3AA1, 0xF3AC1, 0xF3AE1, 0xF3B01, 0xF3B21, 0xF3B41, 0xF3B61, 0xF3B81, 0xF3BA1, 0xF3BC1, 0xF3BE1, 0xF3C01, 0xF3C21,
+		0xF3C41, 0xF3C61, 0xF3C81, 0xF3CA1, 0xF3CC1, 0xF3CE1, 0xF3D01, 0xF3D21, 0xF3D41, 0xF3D61, 0xF3D81, 0xF3DA1, 0xF3DC1, 0xF3DE1, 0xF3E01, 0xF3E21,
+		0xF3E41, 0xF3E61, 0xF3E81, 0xF3EA1, 0xF3EC1, 0xF3EE1, 0xF3F01, 0xF3F21, 0xF3F41, 0xF3F61, 0xF3F81, 0xF3FA1, 0xF3FC1, 0xF3FE1, 0xF4001, 0xF4021,
+		0xF4041, 0xF4061, 0xF4081, 0xF40A1, 0xF40C1, 0xF40E1, 0xF4101, 0xF4121, 0xF4141, 0xF4161, 0xF4181, 0xF41A1, 0xF41C1, 0xF41E1, 0xF4201, 0xF4221,
+		0xF4241, 0xF4261, 0xF4281, 0xF42A1, 0xF42C1, 0xF42E1, 0xF4301, 0xF4321, 0xF4341, 0xF4361, 0xF4381, 0xF43A1, 0xF43C1, 0xF43E1, 0xF4401, 0xF4421,
+		0xF4441, 0xF4461, 0xF4481, 0xF44A1, 0xF44C1, 0xF44E1, 0xF4501, 0xF4521, 0xF4541, 0xF4561, 0xF4581, 0xF45A1, 0xF45C1, 0xF45E1, 0xF4601, 0xF4621,
+		0xF4641, 0xF4661, 0xF4681, 0xF46A1, 0xF46C1, 0xF46E1, 0xF4701, 0xF4721, 0xF4741, 0xF4761, 0xF4781, 0xF47A1, 0xF47C1, 0xF47E1, 0xF4801, 0xF4821,
+		0xF4841, 0xF4861, 0xF4881, 0xF48A1, 0xF48C1, 0xF48E1, 0xF4901, 0xF4921, 0xF4941, 0xF4961, 0xF4981, 0xF49A1, 0xF49C1, 0xF49E1, 0xF4A01, 0xF4A21,
+		0xF4A41, 0xF4A61, 0xF4A81, 0xF4AA1, 0xF4AC1, 0xF4AE1, 0xF4B01, 0xF4B21, 0xF4B41, 0xF4B61, 0xF4B81, 0xF4BA1, 0xF4BC1, 0xF4BE1, 0xF4C01, 0xF4C21,
+		0xF4C41, 0xF4C61, 0xF4C81, 0xF4CA1, 0xF4CC1, 0xF4CE1, 0xF4D01, 0xF4D21, 0xF4D41, 0xF4D61, 0xF4D81, 0xF4DA1, 0xF4DC1, 0xF4DE1, 0xF4E01, 0xF4E21,
+		0xF4E41, 0xF4E61, 0xF4E81, 0xF4EA1, 0xF4EC1, 0xF4EE1, 0xF4F01, 0xF4F21, 0xF4F41, 0xF4F61, 0xF4F81, 0xF4FA1, 0xF4FC1, 0xF4FE1, 0xF5001, 0xF5021,
+		0xF5041, 0xF5061, 0xF5081, 0xF50A1, 0xF50C1, 0xF50E1, 0xF5101, 0xF5121, 0xF5141, 0xF5161, 0xF5181, 0xF51A1, 0xF51C1, 0xF51E1, 0xF5201, 0xF5221,
+		0xF5241, 0xF5261, 0xF5281, 0xF52A1, 0xF52C1, 0xF52E1, 0xF5301, 0xF5321, 0xF5341, 0xF5361, 0xF5381, 0xF53A1, 0xF53C1, 0xF53E1, 0xF5401, 0xF5421,
+		0xF5441, 0xF5461, 0xF5481, 0xF54A1, 0xF54C1, 0xF54E1, 0xF5501, 0xF5521, 0xF5541, 0xF5561, 0xF5581, 0xF55A1, 0xF55C1, 0xF55E1, 0xF5601, 0xF5621,
+		0xF5641, 0xF5661, 0xF5681, 0xF56A1, 0xF56C1, 0xF56E1, 0xF5701, 0xF5721, 0xF5741, 0xF5761, 0xF5781, 0xF57A1, 0xF57C1, 0xF57E1, 0xF5801, 0xF5821,
+		0xF5841, 0xF5861, 0xF5881, 0xF58A1, 0xF58C1, 0xF58E1, 0xF5901, 0xF5921, 0xF5941, 0xF5961, 0xF5981, 0xF59A1, 0xF59C1, 0xF59E1, 0xF5A01, 0xF5A21,
+		0xF5A41, 0xF5A61, 0xF5A81, 0xF5AA1, 0xF5AC1, 0xF5AE1, 0xF5B01, 0xF5B21, 0xF5B41, 0xF5B61, 0xF5B81, 0xF5BA1, 0xF5BC1, 0xF5BE1, 0xF5C01, 0xF5C21,
+		0xF5C41, 0xF5C61, 0xF5C81, 0xF5CA1, 0xF5CC1, 0xF5CE1, 0xF5D01, 0xF5D21, 0xF5D41, 0xF5D61, 0xF5D81, 0xF5DA1, 0xF5DC1, 0xF5DE1, 0xF5E01, 0xF5E21,
+		0xF5E41, 0xF5E61, 0xF5E81, 0xF5EA1, 0xF5EC1, 0xF5EE1, 0xF5F01, 0xF5F21, 0xF5F41, 0xF5F61, 0xF5F81, 0xF5FA1, 0xF5FC1, 0xF5FE1, 0xF6001, 0xF6021,
+		0xF6041, 0xF6061, 0xF6081, 0xF60A1, 0xF60C1, 0xF60E1, 0xF6101, 0xF6121, 0xF6141, 0xF6161, 0xF6181, 0xF61A1, 0xF61C1, 0xF61E1, 0xF6201, 0xF6221,
+		0xF6241, 0xF6261, 0xF6281, 0xF62A1, 0xF62C1, 0xF62E1, 0xF6301, 0xF6321, 0xF6341, 0xF6361, 0xF6381, 0xF63A1, 0xF63C1, 0xF63E1, 0xF6401, 0xF6421,
+		0xF6441, 0xF6461, 0xF6481, 0xF64A1, 0xF64C1, 0xF64E1, 0xF6501, 0xF6521, 0xF6541, 0xF6561, 0xF6581, 0xF65A1, 0xF65C1, 0xF65E1, 0xF6601, 0xF6621,
+		0xF6641, 0xF6661, 0xF6681, 0xF66A1, 0xF66C1, 0xF66E1, 0xF6701, 0xF6721, 0xF6741, 0xF6761, 0xF6781, 0xF67A1, 0xF67C1, 0xF67E1, 0xF6801, 0xF6821,
+		0xF6841, 0xF6861, 0xF6881, 0xF68A1, 0xF68C1, 0xF68E1, 0xF6901, 0xF6921, 0xF6941, 0xF6961, 0xF6981, 0xF69A1, 0xF69C1, 0xF69E1, 0xF6A01, 0xF6A21,
+		0xF6A41, 0xF6A61, 0xF6A81, 0xF6AA1, 0xF6AC1, 0xF6AE1, 0xF6B01, 0xF6B21, 0xF6B41, 0xF6B61, 0xF6B81, 0xF6BA1, 0xF6BC1, 0xF6BE1, 0xF6C01, 0xF6C21,
+		0xF6C41, 0xF6C61, 0xF6C81, 0xF6CA1, 0xF6CC1, 0xF6CE1, 0xF6D01, 0xF6D21, 0xF6D41, 0xF6D61, 0xF6D81, 0xF6DA1, 0xF6DC1, 0xF6DE1, 0xF6E01, 0xF6E21,
+		0xF6E41, 0xF6E61, 0xF6E81, 0xF6EA1, 0xF6EC1, 0xF6EE1, 0xF6F01, 0xF6F21, 0xF6F41, 0xF6F61, 0xF6F81, 0xF6FA1, 0xF6FC1, 0xF6FE1, 0xF7001, 0xF7021,
+		0xF7041, 0xF7061, 0xF7081, 0xF70A1, 0xF70C1, 0xF70E1, 0xF7101, 0xF7121, 0xF7141, 0xF7161, 0xF7181, 0xF71A1, 0xF71C1, 0xF71E1, 0xF7201, 0xF7221,
+		0xF7241, 0xF7261, 0xF7281, 0xF72A1, 0xF72C1, 0xF72E1, 0xF7301, 0xF7321, 0xF7341, 0xF7361, 0xF7381, 0xF73A1, 0xF73C1, 0xF73E1, 0xF7401, 0xF7421,
+		0xF7441, 0xF7461, 0xF7481, 0xF74A1, 0xF74C1, 0xF74E1, 0xF7501, 0xF7521, 0xF7541, 0xF7561, 0xF7581, 0xF75A1, 0xF75C1, 0xF75E1, 0xF7601, 0xF7621,
+		0xF7641, 0xF7661, 0xF7681, 0xF76A1, 0xF76C1, 0xF76E1, 0xF7701, 0xF7721, 0xF7741, 0xF7761, 0xF7781, 0xF77A1, 0xF77C1, 0xF77E1, 0xF7801, 0xF7821,
+		0xF7841, 0xF7861, 0xF7881, 0xF78A1, 0xF78C1, 0xF78E1, 0xF7901, 0xF7921, 0xF7941, 0xF7961, 0xF7981, 0xF79A1, 0xF79C1, 0xF79E1, 0xF7A01, 0xF7A21,
+		0xF7A41, 0xF7A61, 0xF7A81, 0xF7AA1, 0xF7AC1, 0xF7AE1, 0xF7B01, 0xF7B21, 0xF7B41, 0xF7B61, 0xF7B81, 0xF7BA1, 0xF7BC1, 0xF7BE1, 0xF7C01, 0xF7C21,
+		0xF7C41, 0xF7C61, 0xF7C81, 0xF7CA1, 0xF7CC1, 0xF7CE1, 0xF7D01, 0xF7D21, 0xF7D41, 0xF7D61, 0xF7D81, 0xF7DA1, 0xF7DC1, 0xF7DE1, 0xF7E01, 0xF7E21,
+		0xF7E41, 0xF7E61, 0xF7E81, 0xF7EA1, 0xF7EC1, 0xF7EE1, 0xF7F01, 0xF7F21, 0xF7F41, 0xF7F61, 0xF7F81, 0xF7FA1, 0xF7FC1, 0xF7FE1, 0xF8001, 0xF8021,
+		0xF8041, 0xF8061, 0xF8081, 0xF80A1, 0xF80C1, 0xF80E1, 0xF8101, 0xF8121, 0xF8141, 0xF8161, 0xF8181, 0xF81A1, 0xF81C1, 0xF81E1, 0xF8201, 0xF8221,
+		0xF8241, 0xF8261, 0xF8281, 0xF82A1, 0xF82C1, 0xF82E1, 0xF8301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xB7021, 0xB7041, 0xB7061, 0xB7081, 0xB70A1, 0xB70C1, 0xB70E1, 0xB7101, 0xB7121, 0xB7141, 0xB7161, 0xB7181, 0xB71A1, 0xB71C1, 0xB71E1, 0xB7201,
+		0xB7221, 0xB7241, 0xB7261, 0xB7281, 0xB72A1, 0xB72C1, 0xB72E1, 0xB7301, 0xB7321, 0xB7341, 0xB7361, 0xB7381, 0xB73A1, 0xB73C1, 0xB73E1, 0xB7401,
+		0xB7421, 0xB7441, 0xB7461, 0xB7481, 0xB74A1, 0xB74C1, 0xB74E1, 0xB7501, 0xB7521, 0xB7541, 0xB7561, 0xB7581, 0xB75A1, 0xB75C1, 0xB75E1, 0xB7601,
+		0xB7621, 0xB7641, 0xB7661, 0xB7681, 0xB76A1, 0xB76C1, 0xB76E1, 0xB7701, 0xB7721, 0xB7741, 0xB7761, 0xB7781, 0xB77A1, 0xB77C1, 0xB77E1, 0xB7801,
+		0xB7821, 0xB7841, 0xB7861, 0xB7881, 0xB78A1, 0xB78C1, 0xB78E1, 0xB7901, 0xB7921, 0xB7941, 0xB7961, 0xB7981, 0xB79A1, 0xB79C1, 0xB79E1, 0xB7A01,
+		0xB7A21, 0xB7A41, 0xB7A61, 0xB7A81, 0xB7AA1, 0xB7AC1, 0xB7AE1, 0xB7B01, 0xB7B21, 0xB7B41, 0xB7B61, 0xB7B81, 0xB7BA1, 0xB7BC1, 0xB7BE1, 0xB7C01,
+		0xB7C21, 0xB7C41, 0xB7C61, 0xB7C81, 0xB7CA1, 0xB7CC1, 0xB7CE1, 0xB7D01, 0xB7D21, 0xB7D41, 0xB7D61, 0xB7D81, 0xB7DA1, 0xB7DC1, 0xB7DE1, 0xB7E01,
+		0xB7E21, 0xB7E41, 0xB7E61, 0xB7E81, 0xB7EA1, 0xB7EC1, 0xB7EE1, 0xB7F01, 0xB7F21, 0xB7F41, 0xB7F61, 0xB7F81, 0xB7FA1, 0xB7FC1, 0xB7FE1, 0xB8001,
+		0xB8021, 0xB8041, 0xB8061, 0xB8081, 0xB80A1, 0xB80C1, 0xB80E1, 0xB8101, 0xB8121, 0xB8141, 0xB8161, 0xB8181, 0xB81A1, 0xB81C1, 0xB81E1, 0xB8201,
+		0xB8221, 0xB8241, 0xB8261, 0xB8281, 0xB82A1, 0xB82C1, 0xB82E1, 0xB8301, 0xB8321, 0xB8341, 0xB8361, 0xB8381, 0xB83A1, 0xB83C1, 0xB83E1, 0xB8401,
+		0xB8421, 0xB8441, 0xB8461, 0xB8481, 0xB84A1, 0xB84C1, 0xB84E1, 0xB8501, 0xB8521, 0xB8541, 0xB8561, 0xB8581, 0xB85A1, 0xB85C1, 0xB85E1, 0xB8601,
+		0xB8621, 0xB8641, 0xB8661, 0xB8681, 0xB86A1, 0xB86C1, 0xB86E1, 0xB8701, 0xB8721, 0xB8741, 0xB8761, 0xB8781, 0xB87A1, 0xB87C1, 0xB87E1, 0xB8801,
+		0xB8821, 0xB8841, 0xB8861, 0xB8881, 0xB88A1, 0xB88C1, 0xB88E1, 0xB8901, 0xB8921, 0xB8941, 0xB8961, 0xB8981, 0xB89A1, 0xB89C1, 0xB89E1, 0xB8A01,
+		0xB8A21, 0xB8A41, 0xB8A61, 0xB8A81, 0xB8AA1, 0xB8AC1, 0xB8AE1, 0xB8B01, 0xB8B21, 0xB8B41, 0xB8B61, 0xB8B81, 0xB8BA1, 0xB8BC1, 0xB8BE1, 0xB8C01,
+		0xB8C21, 0xB8C41, 0xB8C61, 0xB8C81, 0xB8CA1, 0xB8CC1, 0xB8CE1, 0xB8D01, 0xB8D21, 0xB8D41, 0xB8D61, 0xB8D81, 0xB8DA1, 0xB8DC1, 0xB8DE1, 0xB8E01,
+		0xB8E21, 0xB8E41, 0xB8E61, 0xB8E81, 0xB8EA1, 0xB8EC1, 0xB8EE1, 0xB8F01, 0xB8F21, 0xB8F41, 0xB8F61, 0xB8F81, 0xB8FA1, 0xB8FC1, 0xB8FE1, 0xB9001,
+		0xB9021, 0xB9041, 0xB9061, 0xB9081, 0xB90A1, 0xB90C1, 0xB90E1, 0xB9101, 0xB9121, 0xB9141, 0xB9161, 0xB9181, 0xB91A1, 0xB91C1, 0xB91E1, 0xB9201,
+		0xB9221, 0xB9241, 0xB9261, 0xB9281, 0xB92A1, 0xB92C1, 0xB92E1, 0xB9301, 0xB9321, 0xB9341, 0xB9361, 0xB9381, 0xB93A1, 0xB93C1, 0xB93E1, 0xB9401,
+		0xB9421, 0xB9441, 0xB9461, 0xB9481, 0xB94A1, 0xB94C1, 0xB94E1, 0xB9501, 0xB9521, 0xB9541, 0xB9561, 0xB9581, 0xB95A1, 0xB95C1, 0xB95E1, 0xB9601,
+		0xB9621, 0xB9641, 0xB9661, 0xB9681, 0xB96A1, 0xB96C1, 0xB96E1, 0xB9701, 0xB9721, 0xB9741, 0xB9761, 0xB9781, 0xB97A1, 0xB97C1, 0xB97E1, 0xB9801,
+		0xB9821, 0xB9841, 0xB9861, 0xB9881, 0xB98A1, 0xB98C1, 0xB98E1, 0xB9901, 0xB9921, 0xB9941, 0xB9961, 0xB9981, 0xB99A1, 0xB99C1, 0xB99E1, 0xB9A01,
+		0xB9A21, 0xB9A41, 0xB9A61, 0xB9A81, 0xB9AA1, 0xB9AC1, 0xB9AE1, 0xB9B01, 0xB9B21, 0xB9B41, 0xB9B61, 0xB9B81, 0xB9BA1, 0xB9BC1, 0xB9BE1, 0xB9C01,
+		0xB9C21, 0xB9C41, 0xB9C61, 0xB9C81, 0xB9CA1, 0xB9CC1, 0xB9CE1, 0xB9D01, 0xB9D21, 0xB9D41, 0xB9D61, 0xB9D81, 0xB9DA1, 0xB9DC1, 0xB9DE1, 0xB9E01,
+		0xB9E21, 0xB9E41, 0xB9E61, 0xB9E81, 0xB9EA1, 0xB9EC1, 0xB9EE1, 0xB9F01, 0xB9F21, 0xB9F41, 0xB9F61, 0xB9F81, 0xB9FA1, 0xB9FC1, 0xB9FE1, 0xBA001,
+		0xBA021, 0xBA041, 0xBA061, 0xBA081, 0xBA0A1, 0xBA0C1, 0xBA0E1, 0xBA101, 0xBA121, 0xBA141, 0xBA161, 0xBA181, 0xBA1A1, 0xBA1C1, 0xBA1E1, 0xBA201,
+		0xBA221, 0xBA241, 0xBA261, 0xBA281, 0xBA2A1, 0xBA2C1, 0xBA2E1, 0xBA301, 0xBA321, 0xBA341, 0xBA361, 0xBA381, 0xBA3A1, 0xBA3C1, 0xBA3E1, 0xBA401,
+		0xBA421, 0xBA441, 0xBA461, 0xBA481, 0xBA4A1, 0xBA4C1, 0xBA4E1, 0xBA501, 0xBA521, 0xBA541, 0xBA561, 0xBA581, 0xBA5A1, 0xBA5C1, 0xBA5E1, 0xBA601,
+		0xBA621, 0xBA641, 0xBA661, 0xBA681, 0xBA6A1, 0xBA6C1, 0xBA6E1, 0xBA701, 0xBA721, 0xBA741, 0xBA761, 0xBA781, 0xBA7A1, 0xBA7C1, 0xBA7E1, 0xBA801,
+		0xBA821, 0xBA841, 0xBA861, 0xBA881, 0xBA8A1, 0xBA8C1, 0xBA8E1, 0xBA901, 0xBA921, 0xBA941, 0xBA961, 0xBA981, 0xBA9A1, 0xBA9C1, 0xBA9E1, 0xBAA01,
+		0xBAA21, 0xBAA41, 0xBAA61, 0xBAA81, 0xBAAA1, 0xBAAC1, 0xBAAE1, 0xBAB01, 0xBAB21, 0xBAB41, 0xBAB61, 0xBAB81, 0xBABA1, 0xBABC1, 0xBABE1, 0xBAC01,
+		0xBAC21, 0xBAC41, 0xBAC61, 0xBAC81, 0xBACA1, 0xBACC1, 0xBACE1, 0xBAD01, 0xBAD21, 0xBAD41, 0xBAD61, 0xBAD81, 0xBADA1, 0xBADC1, 0xBADE1, 0xBAE01,
+		0xBAE21, 0xBAE41, 0xBAE61, 0xBAE81, 0xBAEA1, 0xBAEC1, 0xBAEE1, 0xBAF01, 0xBAF21, 0xBAF41, 0xBAF61, 0xBAF81, 0xBAFA1, 0xBAFC1, 0xBAFE1, 0xBB001,
+		0xBB021, 0xBB041, 0xBB061, 0xBB081, 0xBB0A1, 0xBB0C1, 0xBB0E1, 0xBB101, 0xBB121, 0xBB141, 0xBB161, 0xBB181, 0xBB1A1, 0xBB1C1, 0xBB1E1, 0xBB201,
+		0xBB221, 0xBB241, 0xBB261, 0xBB281, 0xBB2A1, 0xBB2C1, 0xBB2E1, 0xBB301, 0xBB321, 0xBB341, 0xBB361, 0xBB381, 0xBB3A1, 0xBB3C1, 0xBB3E1, 0xBB401,
+		0xBB421, 0xBB441, 0xBB461, 0xBB481, 0xBB4A1, 0xBB4C1, 0xBB4E1, 0xBB501, 0xBB521, 0xBB541, 0xBB561, 0xBB581, 0xBB5A1, 0xBB5C1, 0xBB5E1, 0xBB601,
+		0xBB621, 0xBB641, 0xBB661, 0xBB681, 0xBB6A1, 0xBB6C1, 0xBB6E1, 0xBB701, 0xBB721, 0, 0, 0, 0, 0, 0, 0,
+		0xD9E21, 0xD9E41, 0xD9E61, 0xD9E81, 0xD9EA1, 0xD9EC1, 0xD9EE1, 0xD9F01, 0xD9F21, 0xD9F41, 0xD9F61, 0xD9F81, 0xD9FA1, 0xD9FC1, 0xD9FE1, 0xDA001,
+		0xDA021, 0xDA041, 0xDA061, 0xDA081, 0xDA0A1, 0xDA0C1, 0xDA0E1, 0xDA101, 0xDA121, 0xDA141, 0xDA161, 0xDA181, 0xDA1A1, 0xDA1C1, 0xDA1E1, 0,
+		0x42941, 0x43761, 0x464C1, 0x48161, 0x49521, 0x4A721, 0x4B5A1, 0x4C321, 0x4D0E1, 0x4DE61, 0, 0, 0, 0, 0x25661, 0x25681,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xBB741, 0xBB761, 0xBB781, 0xBB7A1, 0xBB7C1, 0xBB7E1, 0xBB801, 0xBB821, 0xBB841, 0xBB861, 0xBB881, 0xBB8A1, 0xBB8C1, 0xBB8E1, 0xBB901, 0xBB921,
+		0xBB941, 0xBB961, 0xBB981, 0xBB9A1, 0xBB9C1, 0xBB9E1, 0xBBA01, 0xBBA21, 0xBBA41, 0xBBA61, 0xBBA81, 0xBBAA1, 0xBBAC1, 0xBBAE1, 0, 0,
+		0x3FF41, 0x3FF61, 0x3FF81, 0x3FFA1, 0x3FFC1, 0x256A1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD4AC1, 0xD4AE1, 0xD4B01, 0xD4B21, 0xD4B41, 0xD4B61, 0xD4B81, 0xD4BA1, 0xD4BC1, 0xD4BE1, 0xD4C01, 0xD4C21, 0xD4C41, 0xD4C61, 0xD4C81, 0xD4CA1,
+		0xD4CC1, 0xD4CE1, 0xD4D01, 0xD4D21, 0xD4D41, 0xD4D61, 0xD4D81, 0xD4DA1, 0xD4DC1, 0xD4DE1, 0xD4E01, 0xD4E21, 0xD4E41, 0xD4E61, 0xD4E81, 0xD4EA1,
+		0xD4EC1, 0xD4EE1, 0xD4F01, 0xD4F21, 0xD4F41, 0xD4F61, 0xD4F81, 0xD4FA1, 0xD4FC1, 0xD4FE1, 0xD5001, 0xD5021, 0xD5041, 0xD5061, 0xD5081, 0xD50A1,
+		0x41481, 0x414A1, 0x414C1, 0x414E1, 0x41501, 0x41521, 0x41541, 0x256C1, 0x256E1, 0x25701, 0x25721, 0x25741, 0x25761, 0x25781, 0x257A1, 0x257C1,
+		0xD50C1, 0xD50E1, 0x419C1, 0x419E1, 0x257E1, 0x25801, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x42961, 0x43781, 0x464E1, 0x48181, 0x49541, 0x4A741, 0x4B5C1, 0x4C341, 0x4D101, 0x4DE81, 0, 0x25821, 0x25841, 0x25861, 0x25881, 0x258A1,
+		0x258C1, 0x258E1, 0, 0xD5101, 0xD5121, 0xD5141, 0xD5161, 0xD5181, 0xD51A1, 0xD51C1, 0xD51E1, 0xD5201, 0xD5221, 0xD5241, 0xD5261, 0xD5281,
+		0xD52A1, 0xD52C1, 0xD52E1, 0xD5301, 0xD5321, 0xD5341, 0xD5361, 0xD5381, 0, 0, 0, 0, 0, 0xD53A1, 0xD53C1, 0xD53E1,
+		0xD5401, 0xD5421, 0xD5441, 0xD5461, 0xD5481, 0xD54A1, 0xD54C1, 0xD54E1, 0xD5501, 0xD5521, 0xD5541, 0xD5561, 0xD5581, 0xD55A1, 0xD55C1, 0xD55E1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD2AE1, 0xD2B01, 0xD2B21, 0xD2B41, 0xD2B61, 0xD2BA1, 0xD2B81, 0xD2BC1, 0xD2BE1, 0xD2C01, 0xD2C21, 0xD2C41, 0xD2C61, 0xD2C81, 0xD2CA1, 0xD2CC1,
+		0xD2CE1, 0xD2D21, 0xD2D41, 0xD2D01, 0xD2D61, 0xD2D81, 0xD2DA1, 0xD2DC1, 0xD2DE1, 0xD2E01, 0xD2E21, 0xD2E41, 0xD2E61, 0xD2E81, 0xD2EA1, 0xD2EC1,
+		0xD2EE1, 0xD2F01, 0xD2F21, 0xD2F41, 0xD2F81, 0xD2F61, 0xD2FA1, 0xD2FC1, 0xD2FE1, 0xD3001, 0xD3021, 0xD3041, 0xD3061, 0xD3081, 0xD30A1, 0xD30C1,
+		0xD30E1, 0xD3101, 0xD3121, 0xD3141, 0xD3161, 0xD3181, 0xD31A1, 0xD31C1, 0xD31E1, 0xD3201, 0xD3221, 0xD3241, 0xD3261, 0xD3281, 0xD32C1, 0xD32A1,
+		0xD32E1, 0xD3301, 0xD3321, 0xD3341, 0xD3361, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD3381, 0xD33A1, 0xD33C1, 0xD33E1, 0xD3401, 0xD3421, 0xD3441, 0xD3461, 0xD3481, 0xD34A1, 0xD34C1, 0xD34E1, 0xD3501, 0xD3521, 0xD3541, 0xD3561,
+		0xD3581, 0xD35A1, 0xD35C1, 0xD35E1, 0xD3601, 0xD3621, 0xD3641, 0xD3661, 0xD3681, 0xD36A1, 0xD36C1, 0xD36E1, 0xD3701, 0xD3721, 0xD3741, 0xD3761,
+		0xD3781, 0xD37A1, 0xD37C1, 0xD37E1, 0xD3801, 0xD3821, 0xD3841, 0xD3861, 0xD3881, 0xD38A1, 0xD38C1, 0xD38E1, 0xD3901, 0xD3921, 0xD3941, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xD3961,
+		0xD3981, 0xD39A1, 0xD39C1, 0xD39E1, 0xD3A01, 0xD3A21, 0xD3A41, 0xD3A61, 0xD3A81, 0xD3AA1, 0xD3AC1, 0xD3AE1, 0xD3B01, 0xD3B21, 0xD3B41, 0xD3B61,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x41A41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xC36A1, 0xC8941, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xD7A61, 0xD7A81, 0xD7AA1, 0xD7AC1, 0xD7AE1, 0xD7B01, 0xD7B21, 0xD7B41, 0xD7B61, 0xD7B81, 0xD7BA1, 0xD7BC1, 0xD7BE1, 0xD7C01, 0xD7C21, 0xD7C41,
+		0xD7C61, 0xD7C81, 0xD7CA1, 0xD7CC1, 0xD7CE1, 0xD7D01, 0xD7D21, 0xD7D41, 0xD7D61, 0xD7D81, 0xD7DA1, 0xD7DC1, 0xD7DE1, 0xD7E01, 0xD7E21, 0xD7E41,
+		0xD7E61, 0xD7E81, 0xD7EA1, 0xD7EC1, 0xD7EE1, 0xD7F01, 0xD7F21, 0xD7F41, 0xD7F61, 0xD7F81, 0xD7FA1, 0xD7FC1, 0xD7FE1, 0xD8001, 0xD8021, 0xD8041,
+		0xD8061, 0xD8081, 0xD80A1, 0xD80C1, 0xD80E1, 0xD8101, 0xD8121, 0xD8141, 0xD8161, 0xD8181, 0xD81A1, 0xD81C1, 0xD81E1, 0xD8201, 0xD8221, 0xD8241,
+		0xD8261, 0xD8281, 0xD82A1, 0xD82C1, 0xD82E1, 0xD8301, 0xD8321, 0xD8341, 0xD8361, 0xD8381, 0xD83A1, 0xD83C1, 0xD83E1, 0xD8401, 0xD8421, 0xD8441,
+		0xD8461, 0xD8481, 0xD84A1, 0xD84C1, 0xD84E1, 0xD8501, 0xD8521, 0xD8541, 0xD8561, 0xD8581, 0xD85A1, 0xD85C1, 0xD85E1, 0xD8601, 0xD8621, 0xD8641,
+		0xD8661, 0xD8681, 0xD86A1, 0xD86C1, 0xD86E1, 0xD8701, 0xD8721, 0xD8741, 0xD8761, 0xD8781, 0xD87A1, 0, 0, 0, 0, 0,
+		0xD87C1, 0xD87E1, 0xD8801, 0xD8821, 0xD8841, 0xD8861, 0xD8881, 0xD88A1, 0xD88C1, 0xD88E1, 0xD8901, 0xD8921, 0xD8941, 0, 0, 0,
+		0xD8961, 0xD8981, 0xD89A1, 0xD89C1, 0xD89E1, 0xD8A01, 0xD8A21, 0xD8A41, 0xD8A61, 0, 0, 0, 0, 0, 0, 0,
+		0xD8A81, 0xD8AA1, 0xD8AC1, 0xD8AE1, 0xD8B01, 0xD8B21, 0xD8B41, 0xD8B61, 0xD8B81, 0xD8BA1, 0, 0, 0x25901, 0x3DF61, 0x3E7A1, 0x25921,
+		0xD41, 0xD61, 0xD81, 0xDA1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x25941, 0x25961, 0x25981, 0x259A1, 0x259C1, 0x259E1, 0x25A01, 0x25A21, 0x25A41, 0x25A61, 0x25A81, 0x25AA1, 0x25AC1, 0x25AE1, 0x25B01, 0x25B21,
+		0x25B41, 0x25B61, 0x25B81, 0x25BA1, 0x25BC1, 0x25BE1, 0x25C01, 0x25C21, 0x25C41, 0x25C61, 0x25C81, 0x25CA1, 0x25CC1, 0x25CE1, 0x25D01, 0x25D21,
+		0x25D41, 0x25D61, 0x25D81, 0x25DA1, 0x25DC1, 0x25DE1, 0x25E01, 0x25E21, 0x25E41, 0x25E61, 0x25E81, 0x25EA1, 0x25EC1, 0x25EE1, 0x25F01, 0x25F21,
+		0x25F41, 0x25F61, 0x25F81, 0x25FA1, 0x25FC1, 0x25FE1, 0x26001, 0x26021, 0x26041, 0x26061, 0x26081, 0x260A1, 0x260C1, 0x260E1, 0x26101, 0x26121,
+		0x26141, 0x26161, 0x26181, 0x261A1, 0x261C1, 0x261E1, 0x26201, 0x26221, 0x26241, 0x26261, 0x26281, 0x262A1, 0x262C1, 0x262E1, 0x26301, 0x26321,
+		0x26341, 0x26361, 0x26381, 0x263A1, 0x263C1, 0x263E1, 0x26401, 0x26421, 0x26441, 0x26461, 0x26481, 0x264A1, 0x264C1, 0x264E1, 0x26501, 0x26521,
+		0x26541, 0x26561, 0x26581, 0x265A1, 0x265C1, 0x265E1, 0x26601, 0x26621, 0x26641, 0x26661, 0x26681, 0x266A1, 0x266C1, 0x266E1, 0x26701, 0x26721,
+		0x26741, 0x26761, 0x26781, 0x267A1, 0x267C1, 0x267E1, 0x26801, 0x26821, 0x26841, 0x26861, 0x26881, 0x268A1, 0x268C1, 0x268E1, 0x26901, 0x26921,
+		0x26941, 0x26961, 0x26981, 0x269A1, 0x269C1, 0x269E1, 0x26A01, 0x26A21, 0x26A41, 0x26A61, 0x26A81, 0x26AA1, 0x26AC1, 0x26AE1, 0x26B01, 0x26B21,
+		0x26B41, 0x26B61, 0x26B81, 0x26BA1, 0x26BC1, 0x26BE1, 0x26C01, 0x26C21, 0x26C41, 0x26C61, 0x26C81, 0x26CA1, 0x26CC1, 0x26CE1, 0x26D01, 0x26D21,
+		0x26D41, 0x26D61, 0x26D81, 0x26DA1, 0x26DC1, 0x26DE1, 0x26E01, 0x26E21, 0x26E41, 0x26E61, 0x26E81, 0x26EA1, 0x26EC1, 0x26EE1, 0x26F01, 0x26F21,
+		0x26F41, 0x26F61, 0x26F81, 0x26FA1, 0x26FC1, 0x26FE1, 0x27001, 0x27021, 0x27041, 0x27061, 0x27081, 0x270A1, 0x270C1, 0x270E1, 0x27101, 0x27121,
+		0x27141, 0x27161, 0x27181, 0x271A1, 0x271C1, 0x271E1, 0x27201, 0x27221, 0x27241, 0x27261, 0x27281, 0x272A1, 0x272C1, 0x272E1, 0x27301, 0x27321,
+		0x27341, 0x27361, 0x27381, 0x273A1, 0x273C1, 0x273E1, 0x27401, 0x27421, 0x27441, 0x27461, 0x27481, 0x274A1, 0x274C1, 0x274E1, 0x27501, 0x27521,
+		0x27541, 0x27561, 0x27581, 0x275A1, 0x275C1, 0x275E1, 0x27601, 0x27621, 0x27641, 0x27661, 0x27681, 0x276A1, 0x276C1, 0x276E1, 0x27701, 0x27721,
+		0x27741, 0x27761, 0x27781, 0x277A1, 0x277C1, 0x277E1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x27801, 0x27821, 0x27841, 0x27861, 0x27881, 0x278A1, 0x278C1, 0x278E1, 0x27901, 0x27921, 0x27941, 0x27961, 0x27981, 0x279A1, 0x279C1, 0x279E1,
+		0x27A01, 0x27A21, 0x27A41, 0x27A61, 0x27A81, 0x27AA1, 0x27AC1, 0x27AE1, 0x27B01, 0x27B21, 0x27B41, 0x27B61, 0x27B81, 0x27BA1, 0x27BC1, 0x27BE1,
+		0x27C01, 0x27C21, 0x27C41, 0x27C61, 0x27C81, 0x27CA1, 0x27CC1, 0, 0, 0x27CE1, 0x27D01, 0x27D21, 0x27D41, 0x27D61, 0x27D81, 0x27DA1,
+		0x27DC1, 0x27DE1, 0x27E01, 0x27E21, 0x27E41, 0x27E61, 0x27E81, 0x27EA1, 0x27EC1, 0x27EE1, 0x27F01, 0x27F21, 0x27F41, 0x27F61, 0x27F81, 0x27FA1,
+		0x27FC1, 0x27FE1, 0x28001, 0x28021, 0x28041, 0x28061, 0x28081, 0x280A1, 0x280C1, 0x280E1, 0x28101, 0x28121, 0x28141, 0x28161, 0x28181, 0x281A1,
+		0x281C1, 0x281E1, 0x28201, 0x28221, 0x28241, 0x28261, 0x28281, 0x282A1, 0x282C1, 0x282E1, 0x28301, 0x28321, 0x28341, 0x28361, 0x28382, 0x283C2,
+		0x28403, 0x28463, 0x284C3, 0x28523, 0x28583, 0x285E1, 0x28601, 0x28621, 0x28641, 0x28661, 0x28681, 0x286A1, 0x286C1, 0x286E1, 0x28701, 0x28721,
+		0x28741, 0x28761, 0x28781, 0xDC1, 0xDE1, 0xE01, 0xE21, 0xE41, 0xE61, 0xE81, 0xEA1, 0x287A1, 0x287C1, 0x287E1, 0x28801, 0x28821,
+		0x28841, 0x28861, 0x28881, 0x288A1, 0x288C1, 0x288E1, 0x28901, 0x28921, 0x28941, 0x28961, 0x28981, 0x289A1, 0x289C1, 0x289E1, 0x28A01, 0x28A21,
+		0x28A41, 0x28A61, 0x28A81, 0x28AA1, 0x28AC1, 0x28AE1, 0x28B01, 0x28B21, 0x28B41, 0x28B61, 0x28B81, 0x28BA1, 0x28BC1, 0x28BE1, 0x28C01, 0x28C21,
+		0x28C41, 0x28C61, 0x28C81, 0x28CA1, 0x28CC1, 0x28CE1, 0x28D01, 0x28D21, 0x28D41, 0x28D61, 0x28D81, 0x28DA1, 0x28DC1, 0x28DE1, 0x28E01, 0x28E21,
+		0x28E41, 0x28E61, 0x28E81, 0x28EA1, 0x28EC1, 0x28EE1, 0x28F01, 0x28F21, 0x28F41, 0x28F61, 0x28F81, 0x28FA2, 0x28FE2, 0x29023, 0x29083, 0x290E3,
+		0x29143, 0x291A1, 0x291C1, 0x291E1, 0x29201, 0x29221, 0x29241, 0x29261, 0x29281, 0x292A1, 0x292C1, 0x292E1, 0x29301, 0x29321, 0x29341, 0x29361,
+		0x29381, 0x293A1, 0x293C1, 0x293E1, 0x29401, 0x29421, 0x29441, 0x29461, 0x29481, 0x294A1, 0x294C1, 0x294E1, 0x29501, 0x29521, 0x29541, 0x29561,
+		0x29581, 0x295A1, 0x295C1, 0x295E1, 0x29601, 0x29621, 0x29641, 0x29661, 0x29681, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x296A1, 0x296C1, 0x296E1, 0x29701, 0x29721, 0x29741, 0x29761, 0x29781, 0x297A1, 0x297C1, 0x297E1, 0x29801, 0x29821, 0x29841, 0x29861, 0x29881,
+		0x298A1, 0x298C1, 0x298E1, 0x29901, 0x29921, 0x29941, 0x29961, 0x29981, 0x299A1, 0x299C1, 0x299E1, 0x29A01, 0x29A21, 0x29A41, 0x29A61, 0x29A81,
+		0x29AA1, 0x29AC1, 0x29AE1, 0x29B01, 0x29B21, 0x29B41, 0x29B61, 0x29B81, 0x29BA1, 0x29BC1, 0x29BE1, 0x29C01, 0x29C21, 0x29C41, 0x29C61, 0x29C81,
+		0x29CA1, 0x29CC1, 0x29CE1, 0x29D01, 0x29D21, 0x29D41, 0x29D61, 0x29D81, 0x29DA1, 0x29DC1, 0x29DE1, 0x29E01, 0x29E21, 0x29E41, 0x29E61, 0x29E81,
+		0x29EA1, 0x29EC1, 0x29EE1, 0x29F01, 0x29F21, 0x29F41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x29F61, 0x29F81, 0x29FA1, 0x29FC1, 0x29FE1, 0x2A001, 0x2A021, 0x2A041, 0x2A061, 0x2A081, 0x2A0A1, 0x2A0C1, 0x2A0E1, 0x2A101, 0x2A121, 0x2A141,
+		0x2A161, 0x2A181, 0x2A1A1, 0x2A1C1, 0x2A1E1, 0x2A201, 0x2A221, 0x2A241, 0x2A261, 0x2A281, 0x2A2A1, 0x2A2C1, 0x2A2E1, 0x2A301, 0x2A321, 0x2A341,
+		0x2A361, 0x2A381, 0x2A3A1, 0x2A3C1, 0x2A3E1, 0x2A401, 0x2A421, 0x2A441, 0x2A461, 0x2A481, 0x2A4A1, 0x2A4C1, 0x2A4E1, 0x2A501, 0x2A521, 0x2A541,
+		0x2A561, 0x2A581, 0x2A5A1, 0x2A5C1, 0x2A5E1, 0x2A601, 0x2A621, 0x2A641, 0x2A661, 0x2A681, 0x2A6A1, 0x2A6C1, 0x2A6E1, 0x2A701, 0x2A721, 0x2A741,
+		0x2A761, 0x2A781, 0x2A7A1, 0x2A7C1, 0x2A7E1, 0x2A801, 0x2A821, 0x2A841, 0x2A861, 0x2A881, 0x2A8A1, 0x2A8C1, 0x2A8E1, 0x2A901, 0x2A921, 0x2A941,
+		0x2A961, 0x2A981, 0x2A9A1, 0x2A9C1, 0x2A9E1, 0x2AA01, 0x2AA21, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x437A1, 0x46501, 0x481A1, 0x49561, 0x4A761, 0x4B5E1, 0x4C361, 0x4D121, 0x4DEA1, 0x2AA41, 0x2AA61, 0x2AA81, 0x2AAA1, 0x2AAC1, 0x2AAE1, 0x2AB01,
+		0x2AB21, 0x2AB41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x4E5E1, 0x50B61, 0x515A1, 0x52521, 0x536E1, 0x55041, 0x55AC1, 0x56941, 0x57921, 0x58CA1, 0x59481, 0x5A421, 0x5B741, 0x5C721, 0x5D7A1, 0x5FC41,
+		0x60921, 0x61001, 0x62241, 0x63561, 0x64421, 0x661A1, 0x66DC1, 0x67841, 0x68101, 0x68CA1, 0x4E381, 0x508E1, 0x512A1, 0x52281, 0x53481, 0x54D81,
+		0x55881, 0x566A1, 0x57661, 0x58A41, 0x59201, 0x5A181, 0x5B4A1, 0x5C4A1, 0x5D561, 0x5F9C1, 0x606A1, 0x60D41, 0x61F81, 0x63301, 0x641C1, 0x65F21,
+		0x66B61, 0x675C1, 0x67EA1, 0x68A01, 0x4E601, 0x50B81, 0x515C1, 0x52541, 0x53701, 0x55061, 0x55AE1, 0x56961, 0x57941, 0x58CC1, 0x594A1, 0x5A441,
+		0x5B761, 0x5C741, 0x5D7C1, 0x5FC61, 0x60941, 0x61021, 0x62261, 0x63581, 0x64441, 0x661C1, 0x66DE1, 0x67861, 0x68121, 0x68CC1, 0x4E3A1, 0x50901,
+		0x512C1, 0x522A1, 0x534A1, 0x54DA1, 0x558A1, 0, 0x57681, 0x58A61, 0x59221, 0x5A1A1, 0x5B4C1, 0x5C4C1, 0x5D581, 0x5F9E1, 0x606C1, 0x60D61,
+		0x61FA1, 0x63321, 0x641E1, 0x65F41, 0x66B81, 0x675E1, 0x67EC1, 0x68A21, 0x4E621, 0x50BA1, 0x515E1, 0x52561, 0x53721, 0x55081, 0x55B01, 0x56981,
+		0x57961, 0x58CE1, 0x594C1, 0x5A461, 0x5B781, 0x5C761, 0x5D7E1, 0x5FC81, 0x60961, 0x61041, 0x62281, 0x635A1, 0x64461, 0x661E1, 0x66E01, 0x67881,
+		0x68141, 0x68CE1, 0x4E3C1, 0x50921, 0x512E1, 0x522C1, 0x534C1, 0x54DC1, 0x558C1, 0x566C1, 0x576A1, 0x58A81, 0x59241, 0x5A1C1, 0x5B4E1, 0x5C4E1,
+		0x5D5A1, 0x5FA01, 0x606E1, 0x60D81, 0x61FC1, 0x63341, 0x64201, 0x65F61, 0x66BA1, 0x67601, 0x67EE1, 0x68A41, 0x4E641, 0, 0x51601, 0x52581,
+		0, 0, 0x55B21, 0, 0, 0x58D01, 0x594E1, 0, 0, 0x5C781, 0x5D801, 0x5FCA1, 0x60981, 0, 0x622A1, 0x635C1,
+		0x64481, 0x66201, 0x66E21, 0x678A1, 0x68161, 0x68D01, 0x4E3E1, 0x50941, 0x51301, 0x522E1, 0, 0x54DE1, 0, 0x566E1, 0x576C1, 0x58AA1,
+		0x59261, 0x5A1E1, 0x5B501, 0x5C501, 0, 0x5FA21, 0x60701, 0x60DA1, 0x61FE1, 0x63361, 0x64221, 0x65F81, 0x66BC1, 0x67621, 0x67F01, 0x68A61,
+		0x4E661, 0x50BC1, 0x51621, 0x525A1, 0x53741, 0x550A1, 0x55B41, 0x569A1, 0x57981, 0x58D21, 0x59501, 0x5A481, 0x5B7A1, 0x5C7A1, 0x5D821, 0x5FCC1,
+		0x609A1, 0x61061, 0x622C1, 0x635E1, 0x644A1, 0x66221, 0x66E41, 0x678C1, 0x68181, 0x68D21, 0x4E401, 0x50961, 0x51321, 0x52301, 0x534E1, 0x54E01,
+		0x558E1, 0x56701, 0x576E1, 0x58AC1, 0x59281, 0x5A201, 0x5B521, 0x5C521, 0x5D5C1, 0x5FA41, 0x60721, 0x60DC1, 0x62001, 0x63381, 0x64241, 0x65FA1,
+		0x66BE1, 0x67641, 0x67F21, 0x68A81, 0x4E681, 0x50BE1, 0, 0x525C1, 0x53761, 0x550C1, 0x55B61, 0, 0, 0x58D41, 0x59521, 0x5A4A1,
+		0x5B7C1, 0x5C7C1, 0x5D841, 0x5FCE1, 0x609C1, 0, 0x622E1, 0x63601, 0x644C1, 0x66241, 0x66E61, 0x678E1, 0x681A1, 0, 0x4E421, 0x50981,
+		0x51341, 0x52321, 0x53501, 0x54E21, 0x55901, 0x56721, 0x57701, 0x58AE1, 0x592A1, 0x5A221, 0x5B541, 0x5C541, 0x5D5E1, 0x5FA61, 0x60741, 0x60DE1,
+		0x62021, 0x633A1, 0x64261, 0x65FC1, 0x66C01, 0x67661, 0x67F41, 0x68AA1, 0x4E6A1, 0x50C01, 0, 0x525E1, 0x53781, 0x550E1, 0x55B81, 0,
+		0x579A1, 0x58D61, 0x59541, 0x5A4C1, 0x5B7E1, 0, 0x5D861, 0, 0, 0, 0x62301, 0x63621, 0x644E1, 0x66261, 0x66E81, 0x67901,
+		0x681C1, 0, 0x4E441, 0x509A1, 0x51361, 0x52341, 0x53521, 0x54E41, 0x55921, 0x56741, 0x57721, 0x58B01, 0x592C1, 0x5A241, 0x5B561, 0x5C561,
+		0x5D601, 0x5FA81, 0x60761, 0x60E01, 0x62041, 0x633C1, 0x64281, 0x65FE1, 0x66C21, 0x67681, 0x67F61, 0x68AC1, 0x4E6C1, 0x50C21, 0x51641, 0x52601,
+		0x537A1, 0x55101, 0x55BA1, 0x569C1, 0x579C1, 0x58D81, 0x59561, 0x5A4E1, 0x5B801, 0x5C7E1, 0x5D881, 0x5FD01, 0x609E1, 0x61081, 0x62321, 0x63641,
+		0x64501, 0x66281, 0x66EA1, 0x67921, 0x681E1, 0x68D41, 0x4E461, 0x509C1, 0x51381, 0x52361, 0x53541, 0x54E61, 0x55941, 0x56761, 0x57741, 0x58B21,
+		0x592E1, 0x5A261, 0x5B581, 0x5C581, 0x5D621, 0x5FAA1, 0x60781, 0x60E21, 0x62061, 0x633E1, 0x642A1, 0x66001, 0x66C41, 0x676A1, 0x67F81, 0x68AE1,
+		0x4E6E1, 0x50C41, 0x51661, 0x52621, 0x537C1, 0x55121, 0x55BC1, 0x569E1, 0x579E1, 0x58DA1, 0x59581, 0x5A501, 0x5B821, 0x5C801, 0x5D8A1, 0x5FD21,
+		0x60A01, 0x610A1, 0x62341, 0x63661, 0x64521, 0x662A1, 0x66EC1, 0x67941, 0x68201, 0x68D61, 0x4E481, 0x509E1, 0x513A1, 0x52381, 0x53561, 0x54E81,
+		0x55961, 0x56781, 0x57761, 0x58B41, 0x59301, 0x5A281, 0x5B5A1, 0x5C5A1, 0x5D641, 0x5FAC1, 0x607A1, 0x60E41, 0x62081, 0x63401, 0x642C1, 0x66021,
+		0x66C61, 0x676C1, 0x67FA1, 0x68B01, 0x4E701, 0x50C61, 0x51681, 0x52641, 0x537E1, 0x55141, 0x55BE1, 0x56A01, 0x57A01, 0x58DC1, 0x595A1, 0x5A521,
+		0x5B841, 0x5C821, 0x5D8C1, 0x5FD41, 0x60A21, 0x610C1, 0x62361, 0x63681, 0x64541, 0x662C1, 0x66EE1, 0x67961, 0x68221, 0x68D81, 0x4E4A1, 0x50A01,
+		0x513C1, 0x523A1, 0x53581, 0x54EA1, 0x55981, 0x567A1, 0x57781, 0x58B61, 0x59321, 0x5A2A1, 0x5B5C1, 0x5C5C1, 0x5D661, 0x5FAE1, 0x607C1, 0x60E61,
+		0x620A1, 0x63421, 0x642E1, 0x66041, 0x66C81, 0x676E1, 0x67FC1, 0x68B21, 0x4E721, 0x50C81, 0x516A1, 0x52661, 0x53801, 0x55161, 0x55C01, 0x56A21,
+		0x57A21, 0x58DE1, 0x595C1, 0x5A541, 0x5B861, 0x5C841, 0x5D8E1, 0x5FD61, 0x60A41, 0x610E1, 0x62381, 0x636A1, 0x64561, 0x662E1, 0x66F01, 0x67981,
+		0x68241, 0x68DA1, 0x4E4C1, 0x50A21, 0x513E1, 0x523C1, 0x535A1, 0x54EC1, 0x559A1, 0x567C1, 0x577A1, 0x58B81, 0x59341, 0x5A2C1, 0x5B5E1, 0x5C5E1,
+		0x5D681, 0x5FB01, 0x607E1, 0x60E81, 0x620C1, 0x63441, 0x64301, 0x66061, 0x66CA1, 0x67701, 0x67FE1, 0x68B41, 0x4E741, 0x50CA1, 0x516C1, 0x52681,
+		0x53821, 0x55181, 0x55C21, 0x56A41, 0x57A41, 0x58E01, 0x595E1, 0x5A561, 0x5B881, 0x5C861, 0x5D901, 0x5FD81, 0x60A61, 0x61101, 0x623A1, 0x636C1,
+		0x64581, 0x66301, 0x66F21, 0x679A1, 0x68261, 0x68DC1, 0x4E4E1, 0x50A41, 0x51401, 0x523E1, 0x535C1, 0x54EE1, 0x559C1, 0x567E1, 0x577C1, 0x58BA1,
+		0x59361, 0x5A2E1, 0x5B601, 0x5C601, 0x5D6A1, 0x5FB21, 0x60801, 0x60EA1, 0x620E1, 0x63461, 0x64321, 0x66081, 0x66CC1, 0x67721, 0x68001, 0x68B61,
+		0x4E761, 0x50CC1, 0x516E1, 0x526A1, 0x53841, 0x551A1, 0x55C41, 0x56A61, 0x57A61, 0x58E21, 0x59601, 0x5A581, 0x5B8A1, 0x5C881, 0x5D921, 0x5FDA1,
+		0x60A81, 0x61121, 0x623C1, 0x636E1, 0x645A1, 0x66321, 0x66F41, 0x679C1, 0x68281, 0x68DE1, 0x4E501, 0x50A61, 0x51421, 0x52401, 0x535E1, 0x54F01,
+		0x559E1, 0x56801, 0x577E1, 0x58BC1, 0x59381, 0x5A301, 0x5B621, 0x5C621, 0x5D6C1, 0x5FB41, 0x60821, 0x60EC1, 0x62101, 0x63481, 0x64341, 0x660A1,
+		0x66CE1, 0x67741, 0x68021, 0x68B81, 0x58741, 0x59001, 0, 0, 0x69F21, 0x6B221, 0x6B421, 0x6B601, 0x6B861, 0x6C061, 0x6C221, 0x6D4E1,
+		0x6D761, 0x6E421, 0x6E661, 0x6E821, 0x6EBA1, 0x6ED21, 0x6EEA1, 0x6F6A1, 0x6F9C1, 0x6D501, 0x6FD41, 0x6FFA1, 0x70141, 0x70CE1, 0x70EA1, 0x71061,
+		0x71221, 0x2AB61, 0x69E61, 0x6B161, 0x6B341, 0x6B541, 0x6B701, 0x6BFA1, 0x6C161, 0x6D361, 0x6D6A1, 0x6E2C1, 0x6E5A1, 0x6E761, 0x6EAE1, 0x6EC61,
+		0x6EDE1, 0x6F521, 0x6F861, 0x6FBC1, 0x6FBE1, 0x6FEE1, 0x70061, 0x70B81, 0x70DE1, 0x70FA1, 0x71141, 0x2AB81, 0x6B721, 0x6D381, 0x6E2E1, 0x70BA1,
+		0x6F881, 0x6F541, 0x69F41, 0x6B241, 0x6B441, 0x6B621, 0x6B881, 0x6C081, 0x6C241, 0x6D521, 0x6D781, 0x6E441, 0x6E681, 0x6E841, 0x6EBC1, 0x6ED41,
+		0x6EEC1, 0x6F6C1, 0x6F9E1, 0x6D541, 0x6FD61, 0x6FFC1, 0x70161, 0x70D01, 0x70EC1, 0x71081, 0x71241, 0x2ABA1, 0x69E81, 0x6B181, 0x6B361, 0x6B561,
+		0x6B741, 0x6BFC1, 0x6C181, 0x6D3A1, 0x6D6C1, 0x6E301, 0x6E5C1, 0x6E781, 0x6EB01, 0x6EC81, 0x6EE01, 0x6F561, 0x6F8A1, 0x6FC01, 0x6FC21, 0x6FF01,
+		0x70081, 0x70BC1, 0x70E01, 0x70FC1, 0x71161, 0x2ABC1, 0x6B761, 0x6D3C1, 0x6E321, 0x70BE1, 0x6F8C1, 0x6F581, 0x69F61, 0x6B261, 0x6B461, 0x6B641,
+		0x6B8A1, 0x6C0A1, 0x6C261, 0x6D561, 0x6D7A1, 0x6E461, 0x6E6A1, 0x6E861, 0x6EBE1, 0x6ED61, 0x6EEE1, 0x6F6E1, 0x6FA01, 0x6D581, 0x6FD81, 0x6FFE1,
+		0x70181, 0x70D21, 0x70EE1, 0x710A1, 0x71261, 0x2ABE1, 0x69EA1, 0x6B1A1, 0x6B381, 0x6B581, 0x6B781, 0x6BFE1, 0x6C1A1, 0x6D3E1, 0x6D6E1, 0x6E341,
+		0x6E5E1, 0x6E7A1, 0x6EB21, 0x6ECA1, 0x6EE21, 0x6F5A1, 0x6F8E1, 0x6FC41, 0x6FC61, 0x6FF21, 0x700A1, 0x70C01, 0x70E21, 0x70FE1, 0x71181, 0x2AC01,
+		0x6B7A1, 0x6D401, 0x6E361, 0x70C21, 0x6F901, 0x6F5C1, 0x69F81, 0x6B281, 0x6B481, 0x6B661, 0x6B8C1, 0x6C0C1, 0x6C281, 0x6D5A1, 0x6D7C1, 0x6E481,
+		0x6E6C1, 0x6E881, 0x6EC01, 0x6ED81, 0x6EF01, 0x6F701, 0x6FA21, 0x6D5C1, 0x6FDA1, 0x70001, 0x701A1, 0x70D41, 0x70F01, 0x710C1, 0x71281, 0x2AC21,
+		0x69EC1, 0x6B1C1, 0x6B3A1, 0x6B5A1, 0x6B7C1, 0x6C001, 0x6C1C1, 0x6D421, 0x6D701, 0x6E381, 0x6E601, 0x6E7C1, 0x6EB41, 0x6ECC1, 0x6EE41, 0x6F5E1,
+		0x6F921, 0x6FC81, 0x6FCA1, 0x6FF41, 0x700C1, 0x70C41, 0x70E41, 0x71001, 0x711A1, 0x2AC41, 0x6B7E1, 0x6D441, 0x6E3A1, 0x70C61, 0x6F941, 0x6F601,
+		0x69FA1, 0x6B2A1, 0x6B4A1, 0x6B681, 0x6B8E1, 0x6C0E1, 0x6C2A1, 0x6D5E1, 0x6D7E1, 0x6E4A1, 0x6E6E1, 0x6E8A1, 0x6EC21, 0x6EDA1, 0x6EF21, 0x6F721,
+		0x6FA41, 0x6D601, 0x6FDC1, 0x70021, 0x701C1, 0x70D61, 0x70F21, 0x710E1, 0x712A1, 0x2AC61, 0x69EE1, 0x6B1E1, 0x6B3C1, 0x6B5C1, 0x6B801, 0x6C021,
+		0x6C1E1, 0x6D461, 0x6D721, 0x6E3C1, 0x6E621, 0x6E7E1, 0x6EB61, 0x6ECE1, 0x6EE61, 0x6F621, 0x6F961, 0x6FCC1, 0x6FCE1, 0x6FF61, 0x700E1, 0x70C81,
+		0x70E61, 0x71021, 0x711C1, 0x2AC81, 0x6B821, 0x6D481, 0x6E3E1, 0x70CA1, 0x6F981, 0x6F641, 0x6BEE1, 0x6BEA1, 0, 0, 0x42A61, 0x43921,
+		0x46681, 0x48321, 0x496E1, 0x4A8E1, 0x4B761, 0x4C4E1, 0x4D2A1, 0x4E021, 0x42A81, 0x43941, 0x466A1, 0x48341, 0x49701, 0x4A901, 0x4B781, 0x4C501,
+		0x4D2C1, 0x4E041, 0x42AA1, 0x43961, 0x466C1, 0x48361, 0x49721, 0x4A921, 0x4B7A1, 0x4C521, 0x4D2E1, 0x4E061, 0x42AC1, 0x43981, 0x466E1, 0x48381,
+		0x49741, 0x4A941, 0x4B7C1, 0x4C541, 0x4D301, 0x4E081, 0x42AE1, 0x439A1, 0x46701, 0x483A1, 0x49761, 0x4A961, 0x4B7E1, 0x4C561, 0x4D321, 0x4E0A1,
+		0x2ACA1, 0x2ACC1, 0x2ACE1, 0x2AD01, 0x2AD21, 0x2AD41, 0x2AD61, 0x2AD81, 0x2ADA1, 0x2ADC1, 0x2ADE1, 0x2AE01, 0x2AE21, 0x2AE41, 0x2AE61, 0x2AE81,
+		0x2AEA1, 0x2AEC1, 0x2AEE1, 0x2AF01, 0x2AF21, 0x2AF41, 0x2AF61, 0x2AF81, 0x2AFA1, 0x2AFC1, 0x2AFE1, 0x2B001, 0x2B021, 0x2B041, 0x2B061, 0x2B081,
+		0x2B0A1, 0x2B0C1, 0x2B0E1, 0x2B101, 0x2B121, 0x2B141, 0x2B161, 0x2B181, 0x2B1A1, 0x2B1C1, 0x2B1E1, 0x2B201, 0x2B221, 0x2B241, 0x2B261, 0x2B281,
+		0x2B2A1, 0x2B2C1, 0x2B2E1, 0x2B301, 0x2B321, 0x2B341, 0x2B361, 0x2B381, 0x2B3A1, 0x2B3C1, 0x2B3E1, 0x2B401, 0x2B421, 0x2B441, 0x2B461, 0x2B481,
+		0x2B4A1, 0x2B4C1, 0x2B4E1, 0x2B501, 0x2B521, 0x2B541, 0x2B561, 0x2B581, 0x2B5A1, 0x2B5C1, 0x2B5E1, 0x2B601, 0x2B621, 0x2B641, 0x2B661, 0x2B681,
+		0x2B6A1, 0x2B6C1, 0x2B6E1, 0x2B701, 0x2B721, 0x2B741, 0x2B761, 0x2B781, 0x2B7A1, 0x2B7C1, 0x2B7E1, 0x2B801, 0x2B821, 0x2B841, 0x2B861, 0x2B881,
+		0x2B8A1, 0x2B8C1, 0x2B8E1, 0x2B901, 0x2B921, 0x2B941, 0x2B961, 0x2B981, 0x2B9A1, 0x2B9C1, 0x2B9E1, 0x2BA01, 0x2BA21, 0x2BA41, 0x2BA61, 0x2BA81,
+		0x2BAA1, 0x2BAC1, 0x2BAE1, 0x2BB01, 0x2BB21, 0x2BB41, 0x2BB61, 0x2BB81, 0x2BBA1, 0x2BBC1, 0x2BBE1, 0x2BC01, 0x2BC21, 0x2BC41, 0x2BC61, 0x2BC81,
+		0x2BCA1, 0x2BCC1, 0x2BCE1, 0x2BD01, 0x2BD21, 0x2BD41, 0x2BD61, 0x2BD81, 0x2BDA1, 0x2BDC1, 0x2BDE1, 0x2BE01, 0x2BE21, 0x2BE41, 0x2BE61, 0x2BE81,
+		0x2BEA1, 0x2BEC1, 0x2BEE1, 0x2BF01, 0x2BF21, 0x2BF41, 0x2BF61, 0x2BF81, 0x2BFA1, 0x2BFC1, 0x2BFE1, 0x2C001, 0x2C021, 0x2C041, 0x2C061, 0x2C081,
+		0x2C0A1, 0x2C0C1, 0x2C0E1, 0x2C101, 0x2C121, 0x2C141, 0x2C161, 0x2C181, 0x2C1A1, 0x2C1C1, 0x2C1E1, 0x2C201, 0x2C221, 0x2C241, 0x2C261, 0x2C281,
+		0x2C2A1, 0x2C2C1, 0x2C2E1, 0x2C301, 0x2C321, 0x2C341, 0x2C361, 0x2C381, 0x2C3A1, 0x2C3C1, 0x2C3E1, 0x2C401, 0x2C421, 0x2C441, 0x2C461, 0x2C481,
+		0x2C4A1, 0x2C4C1, 0x2C4E1, 0x2C501, 0x2C521, 0x2C541, 0x2C561, 0x2C581, 0x2C5A1, 0x2C5C1, 0x2C5E1, 0x2C601, 0x2C621, 0x2C641, 0x2C661, 0x2C681,
+		0x2C6A1, 0x2C6C1, 0x2C6E1, 0x2C701, 0x2C721, 0x2C741, 0x2C761, 0x2C781, 0x2C7A1, 0x2C7C1, 0x2C7E1, 0x2C801, 0x2C821, 0x2C841, 0x2C861, 0x2C881,
+		0x2C8A1, 0x2C8C1, 0x2C8E1, 0x2C901, 0x2C921, 0x2C941, 0x2C961, 0x2C981, 0x2C9A1, 0x2C9C1, 0x2C9E1, 0x2CA01, 0x2CA21, 0x2CA41, 0x2CA61, 0x2CA81,
+		0x2CAA1, 0x2CAC1, 0x2CAE1, 0x2CB01, 0x2CB21, 0x2CB41, 0x2CB61, 0x2CB81, 0x2CBA1, 0x2CBC1, 0x2CBE1, 0x2CC01, 0x2CC21, 0x2CC41, 0x2CC61, 0x2CC81,
+		0x2CCA1, 0x2CCC1, 0x2CCE1, 0x2CD01, 0x2CD21, 0x2CD41, 0x2CD61, 0x2CD81, 0x2CDA1, 0x2CDC1, 0x2CDE1, 0x2CE01, 0x2CE21, 0x2CE41, 0x2CE61, 0x2CE81,
+		0x2CEA1, 0x2CEC1, 0x2CEE1, 0x2CF01, 0x2CF21, 0x2CF41, 0x2CF61, 0x2CF81, 0x2CFA1, 0x2CFC1, 0x2CFE1, 0x2D001, 0x2D021, 0x2D041, 0x2D061, 0x2D081,
+		0x2D0A1, 0x2D0C1, 0x2D0E1, 0x2D101, 0x2D121, 0x2D141, 0x2D161, 0x2D181, 0x2D1A1, 0x2D1C1, 0x2D1E1, 0x2D201, 0x2D221, 0x2D241, 0x2D261, 0x2D281,
+		0x2D2A1, 0x2D2C1, 0x2D2E1, 0x2D301, 0x2D321, 0x2D341, 0x2D361, 0x2D381, 0x2D3A1, 0x2D3C1, 0x2D3E1, 0x2D401, 0x2D421, 0x2D441, 0x2D461, 0x2D481,
+		0x2D4A1, 0x2D4C1, 0x2D4E1, 0x2D501, 0x2D521, 0x2D541, 0x2D561, 0x2D581, 0x2D5A1, 0x2D5C1, 0x2D5E1, 0x2D601, 0x2D621, 0x2D641, 0x2D661, 0x2D681,
+		0x2D6A1, 0x2D6C1, 0x2D6E1, 0x2D701, 0x2D721, 0x2D741, 0x2D761, 0x2D781, 0x2D7A1, 0x2D7C1, 0x2D7E1, 0x2D801, 0x2D821, 0x2D841, 0x2D861, 0x2D881,
+		0x2D8A1, 0x2D8C1, 0x2D8E1, 0x2D901, 0x2D921, 0x2D941, 0x2D961, 0x2D981, 0x2D9A1, 0x2D9C1, 0x2D9E1, 0x2DA01, 0x2DA21, 0x2DA41, 0x2DA61, 0x2DA81,
+		0x2DAA1, 0x2DAC1, 0x2DAE1, 0x2DB01, 0x2DB21, 0x2DB41, 0x2DB61, 0x2DB81, 0x2DBA1, 0x2DBC1, 0x2DBE1, 0x2DC01, 0x2DC21, 0x2DC41, 0x2DC61, 0x2DC81,
+		0x2DCA1, 0x2DCC1, 0x2DCE1, 0x2DD01, 0x2DD21, 0x2DD41, 0x2DD61, 0x2DD81, 0x2DDA1, 0x2DDC1, 0x2DDE1, 0x2DE01, 0x2DE21, 0x2DE41, 0x2DE61, 0x2DE81,
+		0x2DEA1, 0x2DEC1, 0x2DEE1, 0x2DF01, 0x2DF21, 0x2DF41, 0x2DF61, 0x2DF81, 0x2DFA1, 0x2DFC1, 0x2DFE1, 0x2E001, 0x2E021, 0x2E041, 0x2E061, 0x2E081,
+		0x2E0A1, 0x2E0C1, 0x2E0E1, 0x2E101, 0x2E121, 0x2E141, 0x2E161, 0x2E181, 0x2E1A1, 0x2E1C1, 0x2E1E1, 0x2E201, 0x2E221, 0x2E241, 0x2E261, 0x2E281,
+		0x2E2A1, 0x2E2C1, 0x2E2E1, 0x2E301, 0x2E321, 0x2E341, 0x2E361, 0x2E381, 0x2E3A1, 0x2E3C1, 0x2E3E1, 0x2E401, 0x2E421, 0x2E441, 0x2E461, 0x2E481,
+		0x2E4A1, 0x2E4C1, 0x2E4E1, 0x2E501, 0x2E521, 0x2E541, 0x2E561, 0x2E581, 0x2E5A1, 0x2E5C1, 0x2E5E1, 0x2E601, 0x2E621, 0x2E641, 0x2E661, 0x2E681,
+		0x2E6A1, 0x2E6C1, 0x2E6E1, 0x2E701, 0x2E721, 0x2E741, 0x2E761, 0x2E781, 0x2E7A1, 0x2E7C1, 0x2E7E1, 0x2E801, 0x2E821, 0x2E841, 0x2E861, 0x2E881,
+		0x2E8A1, 0x2E8C1, 0x2E8E1, 0x2E901, 0x2E921, 0x2E941, 0x2E961, 0x2E981, 0x2E9A1, 0x2E9C1, 0x2E9E1, 0x2EA01, 0x2EA21, 0x2EA41, 0x2EA61, 0x2EA81,
+		0x2EAA1, 0x2EAC1, 0x2EAE1, 0x2EB01, 0x2EB21, 0x2EB41, 0x2EB61, 0x2EB81, 0x2EBA1, 0x2EBC1, 0x2EBE1, 0x2EC01, 0x2EC21, 0x2EC41, 0x2EC61, 0x2EC81,
+		0x2ECA1, 0x2ECC1, 0x2ECE1, 0x2ED01, 0x2ED21, 0x2ED41, 0x2ED61, 0x2ED81, 0x2EDA1, 0x2EDC1, 0x2EDE1, 0x2EE01, 0x2EE21, 0x2EE41, 0x2EE61, 0x2EE81,
+		0x2EEA1, 0x2EEC1, 0x2EEE1, 0x2EF01, 0x2EF21, 0x2EF41, 0x2EF61, 0x2EF81, 0x2EFA1, 0x2EFC1, 0x2EFE1, 0x2F001, 0x2F021, 0x2F041, 0x2F061, 0x2F081,
+		0x2F0A1, 0x2F0C1, 0x2F0E1, 0x2F101, 0x2F121, 0x2F141, 0x2F161, 0x2F181, 0x2F1A1, 0x2F1C1, 0x2F1E1, 0x2F201, 0x2F221, 0x2F241, 0x2F261, 0x2F281,
+		0x2F2A1, 0x2F2C1, 0x2F2E1, 0x2F301, 0x2F321, 0x2F341, 0x2F361, 0x2F381, 0x2F3A1, 0x2F3C1, 0x2F3E1, 0x2F401, 0x2F421, 0x2F441, 0x2F461, 0x2F481,
+		0x2F4A1, 0x2F4C1, 0x2F4E1, 0x2F501, 0x2F521, 0x2F541, 0x2F561, 0x2F581, 0x2F5A1, 0x2F5C1, 0x2F5E1, 0x2F601, 0x2F621, 0x2F641, 0x2F661, 0x2F681,
+		0x2F6A1, 0x2F6C1, 0x2F6E1, 0x2F701, 0x2F721, 0x2F741, 0x2F761, 0x2F781, 0x2F7A1, 0x2F7C1, 0x2F7E1, 0x2F801, 0x2F821, 0x2F841, 0x2F861, 0x2F881,
+		0x2F8A1, 0x2F8C1, 0x2F8E1, 0x2F901, 0x2F921, 0x2F941, 0x2F961, 0x2F981, 0x2F9A1, 0x2F9C1, 0x2F9E1, 0x2FA01, 0x2FA21, 0x2FA41, 0x2FA61, 0x2FA81,
+		0x2FAA1, 0x2FAC1, 0x2FAE1, 0x2FB01, 0x2FB21, 0x2FB41, 0x2FB61, 0x2FB81, 0x2FBA1, 0x2FBC1, 0x2FBE1, 0x2FC01, 0x2FC21, 0x2FC41, 0x2FC61, 0x2FC81,
+		0x2FCA1, 0x2FCC1, 0x2FCE1, 0x2FD01, 0x2FD21, 0x2FD41, 0x2FD61, 0x2FD81, 0x2FDA1, 0x2FDC1, 0x2FDE1, 0x2FE01, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x2FE21, 0x2FE41, 0x2FE61, 0x2FE81, 0x2FEA1,
+		0, 0x2FEC1, 0x2FEE1, 0x2FF01, 0x2FF21, 0x2FF41, 0x2FF61, 0x2FF81, 0x2FFA1, 0x2FFC1, 0x2FFE1, 0x30001, 0x30021, 0x30041, 0x30061, 0x30081,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x76FC1, 0x77021, 0x77081, 0x770E1, 0x77141, 0x771A1, 0x77201, 0, 0x772A1, 0x77301, 0x77361, 0x773C1, 0x77421, 0x77481, 0x774E1, 0x77541,
+		0x775A1, 0x77601, 0x77661, 0x776C1, 0x77721, 0x77781, 0x777E1, 0x77841, 0x778A1, 0, 0, 0x77981, 0x779E1, 0x77A41, 0x77AA1, 0x77B01,
+		0x77B61, 0x77BC1, 0, 0x77C61, 0x77CC1, 0, 0x77D61, 0x77DC1, 0x77E21, 0x77E81, 0x77EE1, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xBBB01, 0xBBB21, 0xBBB41, 0xBBB61, 0xBBB81, 0xBBBA1, 0xBBBC1, 0xBBBE1, 0xBBC01, 0xBBC21, 0xBBC41, 0xBBC61, 0xBBC81, 0xBBCA1, 0xBBCC1, 0xBBCE1,
+		0xBBD01, 0xBBD21, 0xBBD41, 0xBBD61, 0xBBD81, 0xBBDA1, 0xBBDC1, 0xBBDE1, 0xBBE01, 0xBBE21, 0xBBE41, 0xBBE61, 0xBBE81, 0xBBEA1, 0xBBEC1, 0xBBEE1,
+		0xBBF01, 0xBBF21, 0xBBF41, 0xBBF61, 0xBBF81, 0xBBFA1, 0xBBFC1, 0xBBFE1, 0xBC001, 0xBC021, 0xBC041, 0xBC061, 0xBC081, 0xBC0A1, 0xBC0C1, 0xBC0E1,
+		0xBC101, 0xBC121, 0xBC141, 0xBC161, 0xBC181, 0xBC1A1, 0xBC1C1, 0xBC1E1, 0xBC201, 0xBC221, 0xBC241, 0xBC261, 0xBC281, 0xBC2A1, 0xBC2C1, 0xBC2E1,
+		0xBC301, 0xBC321, 0xBC341, 0xBC361, 0xBC381, 0xBC3A1, 0xBC3C1, 0xBC3E1, 0xBC401, 0xBC421, 0xBC441, 0xBC461, 0xBC481, 0xBC4A1, 0xBC4C1, 0xBC4E1,
+		0xBC501, 0xBC521, 0xBC541, 0xBC561, 0xBC581, 0xBC5A1, 0xBC5C1, 0xBC5E1, 0xBC601, 0xBC621, 0xBC641, 0xBC661, 0xBC681, 0xBC6A1, 0xBC6C1, 0xBC6E1,
+		0xBC701, 0xBC721, 0xBC741, 0xBC761, 0xBC781, 0xBC7A1, 0xBC7C1, 0xBC7E1, 0xBC801, 0xBC821, 0xBC841, 0xBC861, 0xBC881, 0xBC8A1, 0xBC8C1, 0xBC8E1,
+		0xBC901, 0xBC921, 0xBC941, 0xBC961, 0xBC981, 0xBC9A1, 0xBC9C1, 0xBC9E1, 0xBCA01, 0xBCA21, 0xBCA41, 0xBCA61, 0xBCA81, 0xBCAA1, 0xBCAC1, 0xBCAE1,
+		0xBCB01, 0xBCB21, 0xBCB41, 0xBCB61, 0xBCB81, 0xBCBA1, 0xBCBC1, 0xBCBE1, 0xBCC01, 0xBCC21, 0xBCC41, 0xBCC61, 0xBCC81, 0xBCCA1, 0xBCCC1, 0xBCCE1,
+		0xBCD01, 0xBCD21, 0xBCD41, 0xBCD61, 0xBCD81, 0xBCDA1, 0xBCDC1, 0xBCDE1, 0xBCE01, 0xBCE21, 0xBCE41, 0xBCE61, 0xBCE81, 0xBCEA1, 0xBCEC1, 0xBCEE1,
+		0xBCF01, 0xBCF21, 0xBCF41, 0xBCF61, 0xBCF81, 0xBCFA1, 0xBCFC1, 0xBCFE1, 0xBD001, 0xBD021, 0xBD041, 0xBD061, 0xBD081, 0xBD0A1, 0xBD0C1, 0xBD0E1,
+		0xBD101, 0xBD121, 0xBD141, 0xBD161, 0xBD181, 0xBD1A1, 0xBD1C1, 0xBD1E1, 0xBD201, 0xBD221, 0xBD241, 0xBD261, 0xBD281, 0xBD2A1, 0xBD2C1, 0xBD2E1,
+		0xBD301, 0xBD321, 0xBD341, 0xBD361, 0xBD381, 0, 0, 0x437C1, 0x46521, 0x481C1, 0x49581, 0x4A781, 0x4B601, 0x4C381, 0x4D141, 0x4DEC1,
+		0x300A1, 0x300C1, 0x300E1, 0x30101, 0x30121, 0x30141, 0x30161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xBD3C1, 0xBD401, 0xBD441, 0xBD481, 0xBD4C1, 0xBD501, 0xBD541, 0xBD581, 0xBD5C1, 0xBD601, 0xBD641, 0xBD681, 0xBD6C1, 0xBD701, 0xBD741, 0xBD781,
+		0xBD7C1, 0xBD801, 0xBD841, 0xBD881, 0xBD8C1, 0xBD901, 0xBD941, 0xBD981, 0xBD9C1, 0xBDA01, 0xBDA41, 0xBDA81, 0xBDAC1, 0xBDB01, 0xBDB41, 0xBDB81,
+		0xBDBC1, 0xBDC01, 0xBD3A1, 0xBD3E1, 0xBD421, 0xBD461, 0xBD4A1, 0xBD4E1, 0xBD521, 0xBD561, 0xBD5A1, 0xBD5E1, 0xBD621, 0xBD661, 0xBD6A1, 0xBD6E1,
+		0xBD721, 0xBD761, 0xBD7A1, 0xBD7E1, 0xBD821, 0xBD861, 0xBD8A1, 0xBD8E1, 0xBD921, 0xBD961, 0xBD9A1, 0xBD9E1, 0xBDA21, 0xBDA61, 0xBDAA1, 0xBDAE1,
+		0xBDB21, 0xBDB61, 0xBDBA1, 0xBDBE1, 0x3FFE1, 0x40001, 0x40021, 0x40061, 0x40081, 0x400A1, 0x40041, 0, 0, 0, 0, 0,
+		0x42981, 0x437E1, 0x46541, 0x481E1, 0x495A1, 0x4A7A1, 0x4B621, 0x4C3A1, 0x4D161, 0x4DEE1, 0, 0, 0, 0, 0x30181, 0x301A1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x7C2A1, 0x7C561, 0x7E1C1, 0x7F461, 0, 0x879C1, 0x7FAC1, 0x7EB21, 0x82421, 0x88021, 0x84481, 0x850A1, 0x85DA1, 0x86721, 0x7FE21, 0x82B21,
+		0x83681, 0x813C1, 0x83EC1, 0x7F8C1, 0x80841, 0x7CFC1, 0x7DB01, 0x7EFA1, 0x7F521, 0x81D41, 0x82901, 0x83141, 0x7C501, 0x87161, 0x83BE1, 0x83E61,
+		0, 0x7C581, 0x7E1E1, 0, 0x87381, 0, 0, 0x7EB41, 0, 0x88041, 0x844A1, 0x850C1, 0x85DC1, 0x86741, 0x7FE41, 0x82B41,
+		0x836A1, 0x813E1, 0x83EE1, 0, 0x80861, 0x7CFE1, 0x7DB21, 0x7EFC1, 0, 0x81D61, 0, 0x83161, 0, 0, 0, 0,
+		0, 0, 0x7E201, 0, 0, 0, 0, 0x7EB61, 0, 0x88061, 0, 0x850E1, 0, 0x86761, 0x7FE61, 0x82B61,
+		0, 0x81401, 0x83F01, 0, 0x80881, 0, 0, 0x7EFE1, 0, 0x81D81, 0, 0x83181, 0, 0x87181, 0, 0x83E81,
+		0, 0x7C5A1, 0x7E221, 0, 0x873A1, 0, 0, 0x7EB81, 0x82441, 0x88081, 0x844C1, 0, 0x85DE1, 0x86781, 0x7FE81, 0x82B81,
+		0x836C1, 0x81421, 0x83F21, 0, 0x808A1, 0x7D001, 0x7DB41, 0x7F001, 0, 0x81DA1, 0x82921, 0x831A1, 0x7C521, 0, 0x83C01, 0,
+		0x7C2C1, 0x7C5C1, 0x7E241, 0x7F481, 0x873C1, 0x879E1, 0x7FAE1, 0x7EBA1, 0x82461, 0x880A1, 0, 0x85101, 0x85E01, 0x867A1, 0x7FEA1, 0x82BA1,
+		0x836E1, 0x81441, 0x83F41, 0x7F8E1, 0x808C1, 0x7D021, 0x7DB61, 0x7F021, 0x7F541, 0x81DC1, 0x82941, 0x831C1, 0, 0, 0, 0,
+		0, 0x7C5E1, 0x7E261, 0x7F4A1, 0, 0x87A01, 0x7FB01, 0x7EBC1, 0x82481, 0x880C1, 0, 0x85121, 0x85E21, 0x867C1, 0x7FEC1, 0x82BC1,
+		0x83701, 0x81461, 0x83F61, 0x7F901, 0x808E1, 0x7D041, 0x7DB81, 0x7F041, 0x7F561, 0x81DE1, 0x82961, 0x831E1, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x301C1, 0x301E1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x30201, 0x30221, 0x30241, 0x30261, 0x30281, 0x302A1, 0x302C1, 0x302E1, 0x30301, 0x30321, 0x30341, 0x30361, 0x30381, 0x303A1, 0x303C1, 0x303E1,
+		0x30401, 0x30421, 0x30441, 0x30461, 0x30481, 0x304A1, 0x304C1, 0x304E1, 0x30501, 0x30521, 0x30541, 0x30561, 0x30581, 0x305A1, 0x305C1, 0x305E1,
+		0x30601, 0x30621, 0x30641, 0x30661, 0x30681, 0x306A1, 0x306C1, 0x306E1, 0x30701, 0x30721, 0x30741, 0x30761, 0, 0, 0, 0,
+		0x30781, 0x307A1, 0x307C1, 0x307E1, 0x30801, 0x30821, 0x30841, 0x30861, 0x30881, 0x308A1, 0x308C1, 0x308E1, 0x30901, 0x30921, 0x30941, 0x30961,
+		0x30981, 0x309A1, 0x309C1, 0x309E1, 0x30A01, 0x30A21, 0x30A41, 0x30A61, 0x30A81, 0x30AA1, 0x30AC1, 0x30AE1, 0x30B01, 0x30B21, 0x30B41, 0x30B61,
+		0x30B81, 0x30BA1, 0x30BC1, 0x30BE1, 0x30C01, 0x30C21, 0x30C41, 0x30C61, 0x30C81, 0x30CA1, 0x30CC1, 0x30CE1, 0x30D01, 0x30D21, 0x30D41, 0x30D61,
+		0x30D81, 0x30DA1, 0x30DC1, 0x30DE1, 0x30E01, 0x30E21, 0x30E41, 0x30E61, 0x30E81, 0x30EA1, 0x30EC1, 0x30EE1, 0x30F01, 0x30F21, 0x30F41, 0x30F61,
+		0x30F81, 0x30FA1, 0x30FC1, 0x30FE1, 0x31001, 0x31021, 0x31041, 0x31061, 0x31081, 0x310A1, 0x310C1, 0x310E1, 0x31101, 0x31121, 0x31141, 0x31161,
+		0x31181, 0x311A1, 0x311C1, 0x311E1, 0x31201, 0x31221, 0x31241, 0x31261, 0x31281, 0x312A1, 0x312C1, 0x312E1, 0x31301, 0x31321, 0x31341, 0x31361,
+		0x31381, 0x313A1, 0x313C1, 0x313E1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x31401, 0x31421, 0x31441, 0x31461, 0x31481, 0x314A1, 0x314C1, 0x314E1, 0x31501, 0x31521, 0x31541, 0x31561, 0x31581, 0x315A1, 0x315C1, 0,
+		0, 0x315E1, 0x31601, 0x31621, 0x31641, 0x31661, 0x31681, 0x316A1, 0x316C1, 0x316E1, 0x31701, 0x31721, 0x31741, 0x31761, 0x31781, 0x317A1,
+		0, 0x317C1, 0x317E1, 0x31801, 0x31821, 0x31841, 0x31861, 0x31881, 0x318A1, 0x318C1, 0x318E1, 0x31901, 0x31921, 0x31941, 0x31961, 0x31981,
+		0, 0x319A1, 0x319C1, 0x319E1, 0x31A01, 0x31A21, 0x31A41, 0x31A61, 0x31A81, 0x31AA1, 0x31AC1, 0x31AE1, 0x31B01, 0x31B21, 0x31B41, 0x31B61,
+		0x31B81, 0x31BA1, 0x31BC1, 0x31BE1, 0x31C01, 0x31C21, 0x31C41, 0x31C61, 0x31C81, 0x31CA1, 0x31CC1, 0x31CE1, 0x31D01, 0x31D21, 0x31D41, 0x31D61,
+		0x31D81, 0x31DA1, 0x31DC1, 0x31DE1, 0x31E01, 0x31E21, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x429E2, 0x42A22, 0x438E2, 0x46642, 0x482E2, 0x496A2, 0x4A8A2, 0x4B722, 0x4C4A2, 0x4D262, 0x4DFE2, 0x42B41, 0x42B61, 0, 0, 0,
+		0x4E583, 0x50AE3, 0x51503, 0x524A3, 0x53663, 0x54FC3, 0x55A63, 0x56883, 0x57883, 0x58C43, 0x59423, 0x5A3A3, 0x5B6C3, 0x5C6A3, 0x5D743, 0x5FBC3,
+		0x608A3, 0x60F43, 0x62183, 0x63503, 0x643C3, 0x66143, 0x66D63, 0x677E3, 0x680A3, 0x68C03, 0x621E3, 0x51721, 0x61161, 0x51BE2, 0x67422, 0,
+		0x4E841, 0x50D61, 0x51781, 0x52761, 0x53901, 0x55221, 0x55CE1, 0x56B21, 0x57B21, 0x58EE1, 0x596C1, 0x5A641, 0x5B961, 0x5C941, 0x5DA01, 0x5FE61,
+		0x60AE1, 0x61201, 0x62461, 0x637A1, 0x64661, 0x663E1, 0x66FE1, 0x67A61, 0x68301, 0x68E61, 0x572A2, 0x5C182, 0x62C82, 0x62E02, 0x60223, 0x673A2,
+		0x4E7A1, 0x50D01, 0x51741, 0x526E1, 0x53881, 0x551E1, 0x55C81, 0x56AA1, 0x57AA1, 0x58E61, 0x59641, 0x5A5C1, 0x5B8E1, 0x5C8C1, 0x5D961, 0x5FDE1,
+		0x60AC1, 0x61181, 0x62401, 0x63721, 0x645E1, 0x66361, 0x66F81, 0x67A01, 0x682C1, 0x68E21, 0x5BC62, 0x5BCA2, 0, 0, 0, 0,
+		0x4E861, 0x50D81, 0x517A1, 0x52781, 0x53921, 0x55241, 0x55D01, 0x56B41, 0x57B41, 0x58F01, 0x596E1, 0x5A661, 0x5B981, 0x5C961, 0x5DA21, 0x5FE81,
+		0x60B01, 0x61221, 0x62481, 0x637C1, 0x64681, 0x66401, 0x67001, 0x67A81, 0x68321, 0x68E81, 0x5FEA1, 0x58362, 0x60042, 0x62C42, 0x4FC02, 0x673E2,
+		0x52DA2, 0x51CE2, 0x51EE4, 0x555C4, 0x583A2, 0x5CEC3, 0x5CF62, 0x5F322, 0x62D63, 0x659C3, 0x66822, 0x48982, 0x474E7, 0x474A2, 0x49B82, 0x4D462,
+		0x4AAE3, 0x4C6A3, 0x46C24, 0x4B923, 0x443E4, 0x52721, 0x57062, 0x570A3, 0x57146, 0x5AD68, 0x62CC3, 0x65963, 0x667C3, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x31E41, 0x31E61, 0x31E81, 0x31EA1, 0x31EC1, 0x31EE1, 0x31F01, 0x31F21, 0x31F41, 0x31F61,
+		0x31F81, 0x31FA1, 0x31FC1, 0x31FE1, 0x32001, 0x32021, 0x32041, 0x32061, 0x32081, 0x320A1, 0x320C1, 0x320E1, 0x32101, 0x32121, 0x32141, 0x32161,
+		0xC71A2, 0xC4A82, 0xC4BE1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xFFD22, 0xFD6E2, 0xFB9E2, 0xC5862, 0xF9122, 0xFCFE2, 0x109842, 0xFD1A2, 0xF9262, 0x100DC2, 0x103842, 0x100962, 0xFA902, 0xFF002, 0xFA0C2, 0x1009E2,
+		0xFA7C2, 0x106A62, 0x104922, 0x10A0C2, 0xFCDE2, 0xFBE22, 0x103182, 0xFFEA2, 0x100162, 0xF8462, 0xF8722, 0x10ACA2, 0xFE4A2, 0xF8A22, 0xFBBE2, 0x1000A2,
+		0x10A482, 0xFFE22, 0x105E42, 0x106282, 0xFBC62, 0x102F42, 0x101422, 0x1012C2, 0x104A62, 0xFA982, 0xFC422, 0x10B082, 0, 0, 0, 0,
+		0x1016E4, 0xF8624, 0xF9024, 0xFD864, 0x103744, 0xFFDA4, 0x105044, 0xFAD44, 0x100764, 0, 0, 0, 0, 0, 0, 0,
+		0xFF042, 0xFBB22, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x32181, 0x321A1, 0x321C1, 0x321E1, 0x32201, 0x32221, 0x32241, 0x32261, 0x32281, 0x322A1, 0x322C1, 0x322E1, 0x32301, 0x32321, 0x32341, 0x32361,
+		0x32381, 0x323A1, 0x323C1, 0x323E1, 0x32401, 0x32421, 0x32441, 0x32461, 0x32481, 0x324A1, 0x324C1, 0x324E1, 0x32501, 0x32521, 0x32541, 0x32561,
+		0x32581, 0x325A1, 0x325C1, 0x325E1, 0x32601, 0x32621, 0x32641, 0x32661, 0x32681, 0x326A1, 0x326C1, 0x326E1, 0x32701, 0x32721, 0x32741, 0x32761,
+		0x32781, 0x327A1, 0x327C1, 0x327E1, 0x32801, 0x32821, 0x32841, 0x32861, 0x32881, 0x328A1, 0x328C1, 0x328E1, 0x32901, 0x32921, 0x32941, 0x32961,
+		0x32981, 0x329A1, 0x329C1, 0x329E1, 0x32A01, 0x32A21, 0x32A41, 0x32A61, 0x32A81, 0x32AA1, 0x32AC1, 0x32AE1, 0x32B01, 0x32B21, 0x32B41, 0x32B61,
+		0x32B81, 0x32BA1, 0x32BC1, 0x32BE1, 0x32C01, 0x32C21, 0x32C41, 0x32C61, 0x32C81, 0x32CA1, 0x32CC1, 0x32CE1, 0x32D01, 0x32D21, 0x32D41, 0x32D61,
+		0x32D81, 0x32DA1, 0x32DC1, 0x32DE1, 0x32E01, 0x32E21, 0x32E41, 0x32E61, 0x32E81, 0x32EA1, 0x32EC1, 0x32EE1, 0x32F01, 0x32F21, 0x32F41, 0x32F61,
+		0x32F81, 0x32FA1, 0x32FC1, 0x32FE1, 0x33001, 0x33021, 0x33041, 0x33061, 0x33081, 0x330A1, 0x330C1, 0x330E1, 0x33101, 0x33121, 0x33141, 0x33161,
+		0x33181, 0x331A1, 0x331C1, 0x331E1, 0x33201, 0x33221, 0x33241, 0x33261, 0x33281, 0x332A1, 0x332C1, 0x332E1, 0x33301, 0x33321, 0x33341, 0x33361,
+		0x33381, 0x333A1, 0x333C1, 0x333E1, 0x33401, 0x33421, 0x33441, 0x33461, 0x33481, 0x334A1, 0x334C1, 0x334E1, 0x33501, 0x33521, 0x33541, 0x33561,
+		0x33581, 0x335A1, 0x335C1, 0x335E1, 0x33601, 0x33621, 0x33641, 0x33661, 0x33681, 0x336A1, 0x336C1, 0x336E1, 0x33701, 0x33721, 0x33741, 0x33761,
+		0x33781, 0x337A1, 0x337C1, 0x337E1, 0x33801, 0x33821, 0x33841, 0x33861, 0x33881, 0x338A1, 0x338C1, 0x338E1, 0x33901, 0x33921, 0x33941, 0x33961,
+		0x33981, 0x339A1, 0x339C1, 0x339E1, 0x33A01, 0x33A21, 0x33A41, 0x33A61, 0x33A81, 0x33AA1, 0x33AC1, 0x33AE1, 0x33B01, 0x33B21, 0x33B41, 0x33B61,
+		0x33B81, 0x33BA1, 0x33BC1, 0x33BE1, 0x33C01, 0x33C21, 0x33C41, 0x33C61, 0x33C81, 0x33CA1, 0x33CC1, 0x33CE1, 0x33D01, 0x33D21, 0x33D41, 0x33D61,
+		0x33D81, 0x33DA1, 0x33DC1, 0x33DE1, 0x33E01, 0x33E21, 0x33E41, 0x33E61, 0x33E81, 0x33EA1, 0x33EC1, 0x33EE1, 0x33F01, 0x33F21, 0x33F41, 0x33F61,
+		0x33F81, 0x33FA1, 0x33FC1, 0x33FE1, 0x34001, 0x34021, 0x34041, 0x34061, 0x34081, 0x340A1, 0x340C1, 0x340E1, 0x34101, 0x34121, 0x34141, 0x34161,
+		0x34181, 0x341A1, 0x341C1, 0x341E1, 0x34201, 0x34221, 0x34241, 0x34261, 0x34281, 0x342A1, 0x342C1, 0x342E1, 0x34301, 0x34321, 0x34341, 0x34361,
+		0x34381, 0x343A1, 0x343C1, 0x343E1, 0x34401, 0x34421, 0x34441, 0x34461, 0x34481, 0x344A1, 0x344C1, 0x344E1, 0x34501, 0x34521, 0x34541, 0x34561,
+		0x34581, 0x345A1, 0x345C1, 0x345E1, 0x34601, 0x34621, 0x34641, 0x34661, 0x34681, 0x346A1, 0x346C1, 0x346E1, 0x34701, 0x34721, 0x34741, 0x34761,
+		0x34781, 0x347A1, 0x347C1, 0x347E1, 0x34801, 0x34821, 0x34841, 0x34861, 0x34881, 0x348A1, 0x348C1, 0x348E1, 0x34901, 0x34921, 0x34941, 0x34961,
+		0x34981, 0x349A1, 0x349C1, 0x349E1, 0x34A01, 0x34A21, 0x34A41, 0x34A61, 0x34A81, 0x34AA1, 0x34AC1, 0x34AE1, 0x34B01, 0x34B21, 0x34B41, 0x34B61,
+		0x34B81, 0x34BA1, 0x34BC1, 0x34BE1, 0x34C01, 0x34C21, 0x34C41, 0x34C61, 0x34C81, 0x34CA1, 0x34CC1, 0x34CE1, 0x34D01, 0x34D21, 0x34D41, 0x34D61,
+		0x34D81, 0x34DA1, 0x34DC1, 0x34DE1, 0x34E01, 0x34E21, 0x34E41, 0x34E61, 0x34E81, 0x34EA1, 0x34EC1, 0x34EE1, 0x34F01, 0x34F21, 0x34F41, 0x34F61,
+		0x34F81, 0x34FA1, 0x34FC1, 0x34FE1, 0x35001, 0x35021, 0x35041, 0x35061, 0x35081, 0x350A1, 0x350C1, 0x350E1, 0x35101, 0x35121, 0x35141, 0x35161,
+		0x35181, 0x351A1, 0x351C1, 0x351E1, 0x35201, 0x35221, 0x35241, 0x35261, 0x35281, 0x352A1, 0x352C1, 0x352E1, 0x35301, 0x35321, 0x35341, 0x35361,
+		0x35381, 0x353A1, 0x353C1, 0x353E1, 0x35401, 0x35421, 0x35441, 0x35461, 0x35481, 0x354A1, 0x354C1, 0x354E1, 0x35501, 0x35521, 0x35541, 0x35561,
+		0x35581, 0x355A1, 0x355C1, 0x355E1, 0x35601, 0x35621, 0x35641, 0x35661, 0x35681, 0x356A1, 0x356C1, 0x356E1, 0x35701, 0x35721, 0x35741, 0x35761,
+		0x35781, 0x357A1, 0x357C1, 0x357E1, 0x35801, 0x35821, 0x35841, 0x35861, 0x35881, 0x358A1, 0x358C1, 0x358E1, 0x35901, 0x35921, 0x35941, 0x35961,
+		0x35981, 0x359A1, 0x359C1, 0x359E1, 0x35A01, 0x35A21, 0x35A41, 0x35A61, 0x35A81, 0x35AA1, 0x35AC1, 0x35AE1, 0x35B01, 0x35B21, 0x35B41, 0x35B61,
+		0x35B81, 0x35BA1, 0x35BC1, 0x35BE1, 0x35C01, 0x35C21, 0x35C41, 0x35C61, 0x35C81, 0x35CA1, 0x35CC1, 0x35CE1, 0x35D01, 0x35D21, 0x35D41, 0x35D61,
+		0x35D81, 0x35DA1, 0x35DC1, 0x35DE1, 0x35E01, 0x35E21, 0x35E41, 0x35E61, 0x35E81, 0x35EA1, 0x35EC1, 0x35EE1, 0x35F01, 0x35F21, 0x35F41, 0x35F61,
+		0x35F81, 0x35FA1, 0x35FC1, 0x35FE1, 0x36001, 0x36021, 0x36041, 0x36061, 0x36081, 0x360A1, 0x360C1, 0x360E1, 0x36101, 0x36121, 0x36141, 0x36161,
+		0x36181, 0x361A1, 0x361C1, 0x361E1, 0x36201, 0x36221, 0x36241, 0x36261, 0x36281, 0x362A1, 0x362C1, 0x362E1, 0x36301, 0x36321, 0x36341, 0x36361,
+		0x36381, 0x363A1, 0x363C1, 0x363E1, 0x36401, 0x36421, 0x36441, 0x36461, 0x36481, 0x364A1, 0x364C1, 0x364E1, 0x36501, 0x36521, 0x36541, 0x36561,
+		0x36581, 0x365A1, 0x365C1, 0x365E1, 0x36601, 0x36621, 0x36641, 0x36661, 0x36681, 0x366A1, 0x366C1, 0x366E1, 0x36701, 0x36721, 0x36741, 0x36761,
+		0x36781, 0x367A1, 0x367C1, 0x367E1, 0x36801, 0x36821, 0x36841, 0x36861, 0x36881, 0x368A1, 0x368C1, 0x368E1, 0x36901, 0x36921, 0x36941, 0x36961,
+		0x36981, 0x369A1, 0x369C1, 0x369E1, 0x36A01, 0x36A21, 0x36A41, 0x36A61, 0x36A81, 0x36AA1, 0x36AC1, 0x36AE1, 0x36B01, 0x36B21, 0x36B41, 0x36B61,
+		0x36B81, 0x36BA1, 0x36BC1, 0x36BE1, 0x36C01, 0x36C21, 0x36C41, 0x36C61, 0x36C81, 0x36CA1, 0x36CC1, 0x36CE1, 0x36D01, 0x36D21, 0x36D41, 0x36D61,
+		0x36D81, 0x36DA1, 0x36DC1, 0x36DE1, 0x36E01, 0x36E21, 0x36E41, 0x36E61, 0x36E81, 0x36EA1, 0x36EC1, 0x36EE1, 0x36F01, 0x36F21, 0x36F41, 0x36F61,
+		0x36F81, 0x36FA1, 0x36FC1, 0x36FE1, 0x37001, 0x37021, 0x37041, 0x37061, 0x37081, 0x370A1, 0x370C1, 0x370E1, 0x37101, 0x37121, 0x37141, 0x37161,
+		0x37181, 0x371A1, 0x371C1, 0x371E1, 0x37201, 0x37221, 0x37241, 0x37261, 0x37281, 0x372A1, 0x372C1, 0x372E1, 0x37301, 0x37321, 0x37341, 0x37361,
+		0x37381, 0x373A1, 0x373C1, 0x373E1, 0x37401, 0x37421, 0x37441, 0x37461, 0x37481, 0x374A1, 0x374C1, 0x374E1, 0x37501, 0x37521, 0x37541, 0x37561,
+		0x37581, 0x375A1, 0x375C1, 0x375E1, 0x37601, 0x37621, 0x37641, 0x37661, 0x37681, 0x376A1, 0x376C1, 0x376E1, 0x37701, 0x37721, 0x37741, 0x37761,
+		0x37781, 0x377A1, 0x377C1, 0x377E1, 0x37801, 0x37821, 0x37841, 0x37861, 0x37881, 0x378A1, 0x378C1, 0x378E1, 0x37901, 0x37921, 0x37941, 0x37961,
+		0x37981, 0x379A1, 0x379C1, 0x379E1, 0x37A01, 0x37A21, 0x37A41, 0x37A61, 0x37A81, 0x37AA1, 0x37AC1, 0x37AE1, 0x37B01, 0x37B21, 0x37B41, 0x37B61,
+		0x37B81, 0x37BA1, 0x37BC1, 0x37BE1, 0x37C01, 0x37C21, 0x37C41, 0x37C61, 0x37C81, 0x37CA1, 0x37CC1, 0x37CE1, 0x37D01, 0x37D21, 0x37D41, 0x37D61,
+		0x37D81, 0x37DA1, 0x37DC1, 0x37DE1, 0x37E01, 0x37E21, 0x37E41, 0x37E61, 0x37E81, 0x37EA1, 0x37EC1, 0x37EE1, 0x37F01, 0x37F21, 0x37F41, 0x37F61,
+		0x37F81, 0x37FA1, 0x37FC1, 0x37FE1, 0x38001, 0x38021, 0x38041, 0x38061, 0x38081, 0x380A1, 0x380C1, 0x380E1, 0x38101, 0x38121, 0x38141, 0x38161,
+		0x38181, 0x381A1, 0x381C1, 0x381E1, 0x38201, 0x38221, 0x38241, 0x38261, 0x38281, 0x382A1, 0x382C1, 0x382E1, 0x38301, 0x38321, 0x38341, 0x38361,
+		0x38381, 0x383A1, 0x383C1, 0x383E1, 0x38401, 0x38421, 0x38441, 0x38461, 0x38481, 0x384A1, 0x384C1, 0x384E1, 0x38501, 0x38521, 0x38541, 0x38561,
+		0x38581, 0x385A1, 0x385C1, 0x385E1, 0x38601, 0x38621, 0x38641, 0x38661, 0x38681, 0x386A1, 0x386C1, 0x386E1, 0x38701, 0x38721, 0x38741, 0x38761,
+		0x38781, 0x387A1, 0x387C1, 0x387E1, 0x38801, 0x38821, 0x38841, 0x38861, 0x38881, 0x388A1, 0x388C1, 0x388E1, 0x38901, 0x38921, 0x38941, 0x38961,
+		0x38981, 0x389A1, 0x389C1, 0x389E1, 0x38A01, 0x38A21, 0x38A41, 0x38A61, 0x38A81, 0x38AA1, 0x38AC1, 0x38AE1, 0x38B01, 0x38B21, 0x38B41, 0x38B61,
+		0x38B81, 0x38BA1, 0x38BC1, 0x38BE1, 0x38C01, 0x38C21, 0x38C41, 0x38C61, 0x38C81, 0x38CA1, 0x38CC1, 0x38CE1, 0x38D01, 0x38D21, 0x38D41, 0x38D61,
+		0x38D81, 0x38DA1, 0x38DC1, 0x38DE1, 0x38E01, 0x38E21, 0x38E41, 0x38E61, 0x38E81, 0x38EA1, 0x38EC1, 0x38EE1, 0x38F01, 0x38F21, 0x38F41, 0x38F61,
+		0x38F81, 0x38FA1, 0x38FC1, 0x38FE1, 0x39001, 0x39021, 0x39041, 0x39061, 0x39081, 0x390A1, 0x390C1, 0x390E1, 0x39101, 0x39121, 0x39141, 0x39161,
+		0x39181, 0x391A1, 0x391C1, 0x391E1, 0x39201, 0x39221, 0x39241, 0x39261, 0x39281, 0x392A1, 0x392C1, 0x392E1, 0x39301, 0x39321, 0x39341, 0x39361,
+		0x39381, 0x393A1, 0x393C1, 0x393E1, 0x39401, 0x39421, 0x39441, 0x39461, 0x39481, 0x394A1, 0x394C1, 0x394E1, 0x39501, 0x39521, 0x39541, 0x39561,
+		0x39581, 0x395A1, 0x395C1, 0x395E1, 0x39601, 0x39621, 0x39641, 0x39661, 0x39681, 0x396A1, 0x396C1, 0x396E1, 0x39701, 0x39721, 0x39741, 0x39761,
+		0x39781, 0x397A1, 0x397C1, 0x397E1, 0x39801, 0x39821, 0x39841, 0x39861, 0x39881, 0x398A1, 0x398C1, 0x398E1, 0x39901, 0x39921, 0x39941, 0x39961,
+		0x39981, 0x399A1, 0x399C1, 0x399E1, 0x39A01, 0x39A21, 0x39A41, 0x39A61, 0x39A81, 0x39AA1, 0x39AC1, 0x39AE1, 0x39B01, 0x39B21, 0x39B41, 0x39B61,
+		0x39B81, 0x39BA1, 0x39BC1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x39BE1, 0x39C01, 0x39C21, 0x39C41, 0x39C61, 0x39C81, 0x39CA1, 0x39CC1, 0x39CE1, 0x39D01, 0x39D21, 0x39D41, 0x39D61, 0, 0, 0,
+		0x39D81, 0x39DA1, 0x39DC1, 0x39DE1, 0x39E01, 0x39E21, 0x39E41, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x39E61, 0x39E81, 0x39EA1, 0x39EC1, 0x39EE1, 0x39F01, 0x39F21, 0x39F41, 0x39F61, 0x39F81, 0x39FA1, 0x39FC1, 0x39FE1, 0x3A001, 0x3A021, 0x3A041,
+		0x3A061, 0x3A081, 0x3A0A1, 0x3A0C1, 0x3A0E1, 0x3A101, 0x3A121, 0x3A141, 0x3A161, 0x3A181, 0x3A1A1, 0x3A1C1, 0x3A1E1, 0x3A201, 0x3A221, 0x3A241,
+		0x3A261, 0x3A281, 0x3A2A1, 0x3A2C1, 0x3A2E1, 0x3A301, 0x3A321, 0x3A341, 0x3A361, 0x3A381, 0x3A3A1, 0x3A3C1, 0x3A3E1, 0x3A401, 0x3A421, 0x3A441,
+		0x3A461, 0x3A481, 0x3A4A1, 0x3A4C1, 0x3A4E1, 0x3A501, 0x3A521, 0x3A541, 0x3A561, 0x3A581, 0x3A5A1, 0x3A5C1, 0x3A5E1, 0x3A601, 0x3A621, 0x3A641,
+		0x3A661, 0x3A681, 0x3A6A1, 0x3A6C1, 0x3A6E1, 0x3A701, 0x3A721, 0x3A741, 0x3A761, 0x3A781, 0x3A7A1, 0x3A7C1, 0x3A7E1, 0x3A801, 0x3A821, 0x3A841,
+		0x3A861, 0x3A881, 0x3A8A1, 0x3A8C1, 0x3A8E1, 0x3A901, 0x3A921, 0x3A941, 0x3A961, 0x3A981, 0x3A9A1, 0x3A9C1, 0x3A9E1, 0x3AA01, 0x3AA21, 0x3AA41,
+		0x3AA61, 0x3AA81, 0x3AAA1, 0x3AAC1, 0x3AAE1, 0x3AB01, 0x3AB21, 0x3AB41, 0x3AB61, 0x3AB81, 0x3ABA1, 0x3ABC1, 0x3ABE1, 0x3AC01, 0x3AC21, 0x3AC41,
+		0x3AC61, 0x3AC81, 0x3ACA1, 0x3ACC1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x3ACE1, 0x3AD01, 0x3AD21, 0x3AD41, 0x3AD61, 0x3AD81, 0x3ADA1, 0x3ADC1, 0x3ADE1, 0x3AE01, 0x3AE21, 0x3AE41, 0x3AE61, 0x3AE81, 0x3AEA1, 0x3AEC1,
+		0x3AEE1, 0x3AF01, 0x3AF21, 0x3AF41, 0x3AF61, 0x3AF81, 0x3AFA1, 0x3AFC1, 0x3AFE1, 0x3B001, 0x3B021, 0x3B041, 0x3B061, 0x3B081, 0x3B0A1, 0x3B0C1,
+		0x3B0E1, 0x3B101, 0x3B121, 0x3B141, 0x3B161, 0x3B181, 0x3B1A1, 0x3B1C1, 0x3B1E1, 0x3B201, 0x3B221, 0x3B241, 0x3B261, 0x3B281, 0x3B2A1, 0x3B2C1,
+		0x3B2E1, 0x3B301, 0x3B321, 0x3B341, 0x3B361, 0x3B381, 0x3B3A1, 0x3B3C1, 0x3B3E1, 0x3B401, 0x3B421, 0x3B441, 0x3B461, 0x3B481, 0x3B4A1, 0x3B4C1,
+		0x3B4E1, 0x3B501, 0x3B521, 0x3B541, 0x3B561, 0x3B581, 0x3B5A1, 0x3B5C1, 0x3B5E1, 0x3B601, 0x3B621, 0x3B641, 0x3B661, 0x3B681, 0x3B6A1, 0x3B6C1,
+		0x3B6E1, 0x3B701, 0x3B721, 0x3B741, 0x3B761, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x3B781, 0x3B7A1, 0x3B7C1, 0x3B7E1, 0x3B801, 0x3B821, 0x3B841, 0x3B861, 0x3B881, 0x3B8A1, 0x3B8C1, 0x3B8E1, 0, 0, 0, 0,
+		0x3B901, 0x3B921, 0x3B941, 0x3B961, 0x3B981, 0x3B9A1, 0x3B9C1, 0x3B9E1, 0x3BA01, 0x3BA21, 0x3BA41, 0x3BA61, 0x3BA81, 0x3BAA1, 0x3BAC1, 0x3BAE1,
+		0x3BB01, 0x3BB21, 0x3BB41, 0x3BB61, 0x3BB81, 0x3BBA1, 0x3BBC1, 0x3BBE1, 0x3BC01, 0x3BC21, 0x3BC41, 0x3BC61, 0x3BC81, 0x3BCA1, 0x3BCC1, 0x3BCE1,
+		0x3BD01, 0x3BD21, 0x3BD41, 0x3BD61, 0x3BD81, 0x3BDA1, 0x3BDC1, 0x3BDE1, 0x3BE01, 0x3BE21, 0x3BE41, 0x3BE61, 0x3BE81, 0x3BEA1, 0x3BEC1, 0x3BEE1,
+		0x3BF01, 0x3BF21, 0x3BF41, 0x3BF61, 0x3BF81, 0x3BFA1, 0x3BFC1, 0x3BFE1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x3C001, 0x3C021, 0x3C041, 0x3C061, 0x3C081, 0x3C0A1, 0x3C0C1, 0x3C0E1, 0x3C101, 0x3C121, 0, 0, 0, 0, 0, 0,
+		0x3C141, 0x3C161, 0x3C181, 0x3C1A1, 0x3C1C1, 0x3C1E1, 0x3C201, 0x3C221, 0x3C241, 0x3C261, 0x3C281, 0x3C2A1, 0x3C2C1, 0x3C2E1, 0x3C301, 0x3C321,
+		0x3C341, 0x3C361, 0x3C381, 0x3C3A1, 0x3C3C1, 0x3C3E1, 0x3C401, 0x3C421, 0x3C441, 0x3C461, 0x3C481, 0x3C4A1, 0x3C4C1, 0x3C4E1, 0x3C501, 0x3C521,
+		0x3C541, 0x3C561, 0x3C581, 0x3C5A1, 0x3C5C1, 0x3C5E1, 0x3C601, 0x3C621, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x3C641, 0x3C661, 0x3C681, 0x3C6A1, 0x3C6C1, 0x3C6E1, 0x3C701, 0x3C721, 0x3C741, 0x3C761, 0x3C781, 0x3C7A1, 0x3C7C1, 0x3C7E1, 0x3C801, 0x3C821,
+		0x3C841, 0x3C861, 0x3C881, 0x3C8A1, 0x3C8C1, 0x3C8E1, 0x3C901, 0x3C921, 0x3C941, 0x3C961, 0x3C981, 0x3C9A1, 0x3C9C1, 0x3C9E1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x3CA01, 0x3CA21, 0x3CA41, 0x3CA61, 0x3CA81, 0x3CAA1, 0x3CAC1, 0x3CAE1, 0x3CB01, 0x3CB21, 0x3CB41, 0x3CB61, 0x3CB81, 0x3CBA1, 0x3CBC1, 0,
+		0x3CBE1, 0x3CC01, 0x3CC21, 0x3CC41, 0x3CC61, 0x3CC81, 0x3CCA1, 0x3CCC1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x3CCE1, 0, 0, 0x3CD01, 0x3CD21, 0x3CD41, 0x3CD61, 0x3CD81, 0x3CDA1, 0x3CDC1, 0x3CDE1, 0x3CE01, 0x3CE21, 0x3CE41, 0x3CE61, 0,
+		0x3CE81, 0x3CEA1, 0x3CEC1, 0x3CEE1, 0x3CF01, 0x3CF21, 0x3CF41, 0x3CF61, 0x3CF81, 0x3CFA1, 0x3CFC1, 0x3CFE1, 0, 0, 0, 0,
+		0x3D001, 0x3D021, 0x3D041, 0x3D061, 0x3D081, 0x3D0A1, 0x3D0C1, 0x3D0E1, 0x3D101, 0x3D121, 0x3D141, 0x3D161, 0x3D181, 0x3D1A1, 0x3D1C1, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x3D1E1, 0x3D201, 0x3D221, 0x3D241, 0x3D261, 0x3D281, 0x3D2A1, 0x3D2C1, 0x3D2E1, 0x3D301, 0x3D321, 0x3D341, 0x3D361, 0x3D381, 0x3D3A1, 0x3D3C1,
+		0x3D3E1, 0x3D401, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x3D421, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xF8BC2, 0xF8B42, 0xF8C42, 0x10F382, 0xF9662, 0xF97A2, 0xF97E2, 0xF9862, 0xF98E2, 0xF9922, 0xF9A22, 0xF9962, 0x10E202, 0x10F482, 0xF9BA2, 0xF9BE2,
+		0xF9C22, 0xF9F62, 0x10F3C2, 0x10E242, 0xF9CA2, 0xFA082, 0x10F442, 0xFA1C2, 0xFA242, 0xF93E2, 0xFA2C2, 0xFA342, 0x110C82, 0xFA562, 0xFA6C2, 0x10E2C2,
+		0xFA882, 0xFA8C2, 0xFA942, 0xFA9C2, 0x10E302, 0xFAC42, 0xFACC2, 0xFAE42, 0xFAF42, 0xFAF82, 0xFAFC2, 0xFB082, 0xFB382, 0xFB402, 0xFB502, 0xFB6C2,
+		0xFB742, 0xFB782, 0xFB7C2, 0xFB802, 0x10F542, 0x103602, 0xFB9A2, 0xFBA22, 0x10F582, 0xFBAE2, 0xFBB62, 0xFBC22, 0xFBFA2, 0xFBDE2, 0xFBEA2, 0xFBEE2,
+		0xFBFE2, 0xFC062, 0xFC0A2, 0xFC122, 0xFC1A2, 0xFC1E2, 0xFC222, 0xFC2E2, 0xFC3A2, 0xFC3E2, 0xFC4A2, 0xFC7E2, 0xFC562, 0xFC822, 0xFC5A2, 0xFC622,
+		0xFA742, 0xFCDA2, 0xFC9E2, 0xFCA22, 0xFCA62, 0xFC9A2, 0xFCAE2, 0xFCAA2, 0xFCC62, 0x10F5C2, 0xFCE22, 0xFCE62, 0xFCEE2, 0xFCFA2, 0xFD062, 0xFD2E2,
+		0x10F602, 0x10F642, 0xFD422, 0xFD462, 0xFD4A2, 0xFD3E2, 0xFD522, 0x10E342, 0x10E382, 0xFD5A2, 0xFD622, 0xFD662, 0x10F682, 0xFD922, 0xFD962, 0xFDA22,
+		0xFDAA2, 0x10F6C2, 0xFDB22, 0xFDB62, 0xFEE42, 0xFDCA2, 0x10E3C2, 0xFDEE2, 0xFE022, 0xFE122, 0xFE0E2, 0x10F742, 0xFE1A2, 0x10F782, 0xFE262, 0xFE222,
+		0xFE2A2, 0xFE3A2, 0xFE3E2, 0x10E402, 0xFE562, 0xFE5E2, 0xFE622, 0xFE662, 0x10E442, 0x10F7C2, 0x10E482, 0xFE8E2, 0xFE922, 0xFE962, 0xFEA22, 0x110F02,
+		0xFEBA2, 0x10F842, 0x10F882, 0x107D62, 0xFECE2, 0xFED22, 0x10E4C2, 0x10FA42, 0x110502, 0xFEEC2, 0xFEF42, 0x10E502, 0xFF082, 0xFF222, 0xFF262, 0xFF2E2,
+		0xFF3E2, 0x10E582, 0x10E542, 0xFF462, 0x10F8C2, 0xFF4A2, 0xFF5E2, 0xFF622, 0xFF6E2, 0xFF662, 0xFF7A2, 0xFF862, 0xFF962, 0xFF8E2, 0xFF922, 0xFF9A2,
+		0xFFA62, 0xFFAE2, 0xFFBA2, 0xFFBE2, 0xFFE62, 0xFFEE2, 0xFFFE2, 0x100122, 0x10F982, 0x1000E2, 0x100022, 0x1001A2, 0x100222, 0x100362, 0x10F9C2, 0x1003E2,
+		0x100322, 0x1002A2, 0x10E5C2, 0x100462, 0x1004E2, 0x100562, 0x1004A2, 0x10E602, 0x1006E2, 0x1007E2, 0x10FA02, 0x100B62, 0x101142, 0x100E82, 0x10E6C2, 0x100FC2,
+		0x10E682, 0x10E642, 0xFA102, 0xFA142, 0x101182, 0x101002, 0x107942, 0x10EDC2, 0x1014E2, 0x101562, 0x1015A2, 0x101822, 0x1017A2, 0x10FB42, 0x10E702, 0x101962,
+		0x1018A2, 0x101BE2, 0x101CA2, 0x10FBC2, 0x101CE2, 0x1019E2, 0x101D62, 0x10E782, 0x101DA2, 0x101DE2, 0x101E22, 0x101F62, 0x10FC02, 0x101FE2, 0x10E7C2, 0x1020A2,
+		0x10FC42, 0x1020E2, 0x10E802, 0x1021A2, 0x102302, 0x102442, 0x102482, 0x10FC82, 0x10F702, 0x10FCC2, 0x102802, 0x10FD02, 0x1028C2, 0x102942, 0x102842, 0x102A02,
+		0x102B02, 0x102CC2, 0x102BC2, 0x102C02, 0x102D02, 0x102D42, 0x10FD42, 0x102AC2, 0x102EC2, 0x102F02, 0x10E842, 0x103082, 0x103002, 0x10FD82, 0x102E42, 0x103282,
+		0x10FDC2, 0x10FE02, 0x1032C2, 0x103442, 0x103402, 0x103382, 0x10E882, 0x103482, 0x103682, 0x103642, 0x103702, 0x10F402, 0x103882, 0x10FE42, 0x103982, 0x10FEC2,
+		0x103AC2, 0x103C62, 0x103DA2, 0x10FF02, 0x103FC2, 0x104002, 0x10FF42, 0x10FF82, 0x104242, 0x104382, 0x10E8C2, 0x104422, 0x10E902, 0x10E942, 0x1045A2, 0x1045E2,
+		0x1046A2, 0x1046E2, 0x1047A2, 0x10E982, 0x104962, 0x10FFC2, 0x104B22, 0x110002, 0x104C22, 0x10F802, 0x104D82, 0x110042, 0x110082, 0x1100C2, 0x10E9C2, 0x10EA02,
+		0x1052E2, 0x110142, 0x110102, 0x110182, 0x1101C2, 0x105362, 0x1053A2, 0x1053E2, 0x1054A2, 0x10EAC2, 0x1054E2, 0x10EB02, 0x10EB42, 0x110242, 0x105622, 0x1056E2,
+		0x1057E2, 0x10EB82, 0x110282, 0x105BC2, 0x1102C2, 0x110302, 0x105F42, 0x1060C2, 0x10EBC2, 0x106182, 0x1061C2, 0x106202, 0x110342, 0x110382, 0x1103C2, 0x1063C2,
+		0x10EC02, 0x110402, 0x1065A2, 0x1065E2, 0x10EC42, 0x110442, 0x1067A2, 0x10EC82, 0x1068A2, 0x106822, 0x106962, 0x1104C2, 0x106AE2, 0x10ECC2, 0x106BA2, 0x106CA2,
+		0x106DA2, 0x10ED02, 0x110542, 0x110582, 0x10ED42, 0x1105C2, 0x107102, 0x110602, 0x1072C2, 0x1073C2, 0x107542, 0x110642, 0x110682, 0x107682, 0x1106C2, 0x107702,
+		0x10FA82, 0x10ED82, 0x107982, 0x1079C2, 0x10EE02, 0x107A02, 0xFD562, 0x110702, 0x110742, 0x10FAC2, 0x10FB02, 0x107DA2, 0x107DE2, 0x10A9A2, 0x10EE42, 0x1081E2,
+		0x1081A2, 0x108222, 0xFAB02, 0x108262, 0x1082A2, 0x1082E2, 0x1083A2, 0x110782, 0x108362, 0x1083E2, 0x108522, 0x108562, 0x108422, 0x1085A2, 0x1086E2, 0x108862,
+		0x1084E2, 0x108622, 0x108662, 0x1086A2, 0x1107C2, 0x110842, 0x110802, 0x10EE82, 0x1088E2, 0x108922, 0x1089A2, 0x110942, 0x1089E2, 0x110882, 0x10EEC2, 0x10EF02,
+		0x1108C2, 0x110902, 0x10EF42, 0x108C22, 0x108CA2, 0x108CE2, 0x108D22, 0x108DE2, 0x108DA2, 0x108E62, 0x108E22, 0x108F62, 0x108EA2, 0x108EE2, 0x108FA2, 0x10EF82,
+		0x109022, 0x109062, 0x10EFC2, 0x1091A2, 0x1091E2, 0x110982, 0x109322, 0x109362, 0x10F002, 0x109422, 0x10E282, 0x1109C2, 0x110A02, 0x10F042, 0x10F082, 0x1098C2,
+		0x109AC2, 0x109DC2, 0x109F02, 0x110A42, 0x10A102, 0x10A142, 0x10A382, 0x10A4C2, 0x110AC2, 0x10F4C2, 0x10A5E2, 0x10A5A2, 0x10A662, 0x10F502, 0x10A762, 0x10A862,
+		0x110B02, 0x110B42, 0x10AE82, 0x10AF42, 0x10AFC2, 0x110B82, 0x10B002, 0x10B402, 0x10B4C2, 0x10B502, 0x10B482, 0x10B5C2, 0x10B602, 0x110BC2, 0x10B7C2, 0x10F0C2,
+		0x10B842, 0x110C02, 0x10F102, 0x10BCA2, 0xFE2E2, 0x10BEC2, 0x110C42, 0x110CC2, 0x10F142, 0x10F182, 0x10C1C2, 0x110D02, 0x10F1C2, 0x110D42, 0x10C402, 0x10C442,
+		0x10C4C2, 0x110D82, 0x10C822, 0x10F202, 0x10C922, 0x10CA82, 0x10CB02, 0x10CB82, 0x10F242, 0x110DC2, 0x10CDA2, 0x10CFC2, 0x10D0C2, 0x10F282, 0x10F2C2, 0x10D102,
+		0x110E02, 0x10F302, 0x110E42, 0x110E82, 0x110EC2, 0x10D462, 0x10F342, 0x10D622, 0x10D6E2, 0x10D762, 0x10D7E2, 0x10D862, 0x10D8E2, 0x110F42, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0xEC1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0xEE1, 0xF01, 0xF21, 0xF41, 0xF61, 0xF81, 0xFA1, 0xFC1, 0xFE1, 0x1001, 0x1021, 0x1041, 0x1061, 0x1081, 0x10A1, 0x10C1,
+		0x10E1, 0x1101, 0x1121, 0x1141, 0x1161, 0x1181, 0x11A1, 0x11C1, 0x11E1, 0x1201, 0x1221, 0x1241, 0x1261, 0x1281, 0x12A1, 0x12C1,
+		0x12E1, 0x1301, 0x1321, 0x1341, 0x1361, 0x1381, 0x13A1, 0x13C1, 0x13E1, 0x1401, 0x1421, 0x1441, 0x1461, 0x1481, 0x14A1, 0x14C1,
+		0x14E1, 0x1501, 0x1521, 0x1541, 0x1561, 0x1581, 0x15A1, 0x15C1, 0x15E1, 0x1601, 0x1621, 0x1641, 0x1661, 0x1681, 0x16A1, 0x16C1,
+		0x16E1, 0x1701, 0x1721, 0x1741, 0x1761, 0x1781, 0x17A1, 0x17C1, 0x17E1, 0x1801, 0x1821, 0x1841, 0x1861, 0x1881, 0x18A1, 0x18C1,
+		0x18E1, 0x1901, 0x1921, 0x1941, 0x1961, 0x1981, 0x19A1, 0x19C1, 0x19E1, 0x1A01, 0x1A21, 0x1A41, 0x1A61, 0x1A81, 0x1AA1, 0x1AC1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x1AE1, 0x1B01, 0x1B21, 0x1B41, 0x1B61, 0x1B81, 0x1BA1, 0x1BC1, 0x1BE1, 0x1C01, 0x1C21, 0x1C41, 0x1C61, 0x1C81, 0x1CA1, 0x1CC1,
+		0x1CE1, 0x1D01, 0x1D21, 0x1D41, 0x1D61, 0x1D81, 0x1DA1, 0x1DC1, 0x1DE1, 0x1E01, 0x1E21, 0x1E41, 0x1E61, 0x1E81, 0x1EA1, 0x1EC1,
+		0x1EE1, 0x1F01, 0x1F21, 0x1F41, 0x1F61, 0x1F81, 0x1FA1, 0x1FC1, 0x1FE1, 0x2001, 0x2021, 0x2041, 0x2061, 0x2081, 0x20A1, 0x20C1,
+		0x20E1, 0x2101, 0x2121, 0x2141, 0x2161, 0x2181, 0x21A1, 0x21C1, 0x21E1, 0x2201, 0x2221, 0x2241, 0x2261, 0x2281, 0x22A1, 0x22C1,
+		0x22E1, 0x2301, 0x2321, 0x2341, 0x2361, 0x2381, 0x23A1, 0x23C1, 0x23E1, 0x2401, 0x2421, 0x2441, 0x2461, 0x2481, 0x24A1, 0x24C1,
+		0x24E1, 0x2501, 0x2521, 0x2541, 0x2561, 0x2581, 0x25A1, 0x25C1, 0x25E1, 0x2601, 0x2621, 0x2641, 0x2661, 0x2681, 0x26A1, 0x26C1,
+		0x26E1, 0x2701, 0x2721, 0x2741, 0x2761, 0x2781, 0x27A1, 0x27C1, 0x27E1, 0x2801, 0x2821, 0x2841, 0x2861, 0x2881, 0x28A1, 0x28C1,
+		0x28E1, 0x2901, 0x2921, 0x2941, 0x2961, 0x2981, 0x29A1, 0x29C1, 0x29E1, 0x2A01, 0x2A21, 0x2A41, 0x2A61, 0x2A81, 0x2AA1, 0x2AC1,
+		0x2AE1, 0x2B01, 0x2B21, 0x2B41, 0x2B61, 0x2B81, 0x2BA1, 0x2BC1, 0x2BE1, 0x2C01, 0x2C21, 0x2C41, 0x2C61, 0x2C81, 0x2CA1, 0x2CC1,
+		0x2CE1, 0x2D01, 0x2D21, 0x2D41, 0x2D61, 0x2D81, 0x2DA1, 0x2DC1, 0x2DE1, 0x2E01, 0x2E21, 0x2E41, 0x2E61, 0x2E81, 0x2EA1, 0x2EC1,
+		0x2EE1, 0x2F01, 0x2F21, 0x2F41, 0x2F61, 0x2F81, 0x2FA1, 0x2FC1, 0x2FE1, 0x3001, 0x3021, 0x3041, 0x3061, 0x3081, 0x30A1, 0x30C1,
+		0x30E1, 0x3101, 0x3121, 0x3141, 0x3161, 0x3181, 0x31A1, 0x31C1, 0x31E1, 0x3201, 0x3221, 0x3241, 0x3261, 0x3281, 0x32A1, 0x32C1,
+		0x32E1, 0x3301, 0x3321, 0x3341, 0x3361, 0x3381, 0x33A1, 0x33C1, 0x33E1, 0x3401, 0x3421, 0x3441, 0x3461, 0x3481, 0x34A1, 0x34C1,
+		0x34E1, 0x3501, 0x3521, 0x3541, 0x3561, 0x3581, 0x35A1, 0x35C1, 0x35E1, 0x3601, 0x3621, 0x3641, 0x3661, 0x3681, 0x36A1, 0x36C1,
+		0x36E1, 0x3701, 0x3721, 0x3741, 0x3761, 0x3781, 0x37A1, 0x37C1, 0x37E1, 0x3801, 0x3821, 0x3841, 0x3861, 0x3881, 0x38A1, 0x38C1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	}
+	// uca0900CE is the collation elements, each one is primary << 16 | secondary << 5 | tertiary.
+	uca0900CE = []uint32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x2010402, 0x2020402, 0x2030402, 0x2040402, 0x2050402, 0x2090402, 0x2670402, 0x31D0402, 0x3AC0402,
+		0x3AD0402, 0x3A90402, 0x3160402, 0x3280402, 0x3290402, 0x3A10402, 0x6660402, 0x2230402, 0x20D0402, 0x27E0402, 0x3A60402, 0x2400402, 0x23A0402, 0x66A0402, 0x66B0402, 0x66C0402,
+		0x26D0402, 0x3A00402, 0x32A0402, 0x3A70402, 0x32B0402, 0x4B70402, 0x20B0402, 0x4B40402, 0x32C0402, 0x66E0402, 0x32D0402, 0x6700402, 0x2060402, 0x209041B, 0x2680402, 0x66F0402,
+		0x39A0402, 0x4BB0402, 0x5D20402, 0x3260402, 0x66D0402, 0x5D40402, 0x4B80402, 0x52A0402, 0x6670402, 0x4B50402, 0x39C0402, 0x2930402, 0x4BE0402, 0x3270402, 0x26E0402, 0x6690402,
+		0x6680402, 0x4C50402, 0x4C70402, 0x4C80402, 0x4C90402, 0x4CA0402, 0x4CB0402, 0x4CC0402, 0x4CD0402, 0x4CE0402, 0x4CF0402, 0x4D00402, 0x4D10402, 0x4D20402, 0x4D30402, 0x4D40402,
+		0x4D50402, 0x4D60402, 0x4D70402, 0x4D80402, 0x4D90402, 0x4DC0402, 0x4DD0402, 0x4B90402, 0x4BA0402, 0x4BC0402, 0x4BF0402, 0x4B60402, 0x4BD0402, 0x4DE0402, 0x4DF0402, 0x4E00402,
+		0x4E10402, 0x4E20402, 0x4E30402, 0x4E40402, 0x4E50402, 0x4E60402, 0x4E70402, 0x4E80402, 0x4E90402, 0x4EA0402, 0x4EB0402, 0x4EC0402, 0x4ED0402, 0x4EE0402, 0x4EF0402, 0x4F00402,
+		0x4F10402, 0x4F20402, 0x4F30402, 0x4F40402, 0x4F50402, 0x4F60402, 0x4F70402, 0x4F80402, 0x4F90402, 0, 0x4C50402, 0x4C60402, 0x23A0402, 0x4B50402, 0x4BB0402, 0x482,
+		0x2930402, 0x6610402, 0x52B0402, 0, 0, 0x3E40402, 0x3E50402, 0x2690402, 0x22A0402, 0x2700402, 0x3E60402, 0x2410402, 0x20E0402, 0x52C0402, 0x52D0402, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x3E70402,
+		0x3E80402, 0x3E90402, 0, 0, 0x3EA0402, 0x3EB0402, 0x3EC0402, 0, 0, 0, 0, 0, 0, 0x67D0402, 0x67F0402, 0x52E0402,
+		0x3B00402, 0x3B20402, 0x22B0402, 0x22C0402, 0x5310402, 0x5320402, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x23B0402, 0x2420402, 0x2710402, 0, 0x3AE0402, 0x22D0402, 0x22E0402, 0x3A40402, 0x2800402, 0, 0, 0, 0, 0, 0,
+		0, 0, 0x5330402, 0, 0, 0, 0, 0, 0, 0, 0, 0x5340402, 0, 0, 0, 0,
+		0x2CD0402, 0x2810402, 0x2820402, 0x2430402, 0x2440402, 0x2450402, 0x2460402, 0x2470402, 0x2480402, 0x2720402, 0x3ED0402, 0x3EE0402, 0x3EF0402, 0x3F00402, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x5460402, 0x2CE0402, 0x22F0402, 0x26A0402, 0, 0x2490402, 0x24A0402, 0x24B0402, 0x24C0402, 0x24D0402,
+		0x24E0402, 0x24F0402, 0x2500402, 0x2510402, 0x2520402, 0x2530402, 0x2540402, 0x2550402, 0x2560402, 0x2570402, 0x3F10402, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x2960402, 0x2970402, 0x4000402, 0x1D560402, 0x1D570402, 0x1D580402, 0x1D590402, 0x1D5A0402, 0x1D5B0402, 0x5470402, 0x4070402, 0x5480402, 0x1D5C0402,
+		0x1D5D0402, 0x1D5E0402, 0x1D5F0402, 0x1D600402, 0x1D610402, 0x1D680402, 0x1D690402, 0x1D6A0402, 0x5490402, 0x54A0402, 0x54B0402, 0x54C0402, 0x54D0402, 0x54E0402, 0x54F0402, 0x5690402,
+		0x56A0402, 0x1D800402, 0x1D810402, 0x1D820402, 0x1D830402, 0x1D840402, 0x1D850402, 0x1D860402, 0x1D870402, 0x1D880402, 0x1D890402, 0x1D8A0402, 0x1D8B0402, 0x1D8C0402, 0x1D8D0402, 0x1D8E0402,
+		0x1D8F0402, 0x56B0402, 0x40A0402, 0x40B0402, 0x40C0402, 0x40D0402, 0x5730402, 0x5740402, 0x5750402, 0x4100402, 0x4110402, 0x4120402, 0x4130402, 0x4140402, 0x4150402, 0x4160402,
+		0x4190402, 0x419041B, 0x41A0402, 0x41B0402, 0x41C0402, 0x41D0402, 0x41E0402, 0x41F0402, 0x5760402, 0x25F0402, 0x5770402, 0x5780402, 0x5790402, 0, 0, 0x57A0402,
+		0x57B0402, 0x57C0402, 0x57D0402, 0x57E0402, 0x57F0402, 0x5800402, 0, 0x5810402, 0, 0x5820402, 0x32E0402, 0x32F0402, 0x3300402, 0x3310402, 0, 0,
+		0, 0, 0x4200402, 0, 0, 0x5830402, 0x5840402, 0x5850402, 0x5860402, 0x5870402, 0x5880402, 0x5890402, 0x58A0402, 0, 0x58B0402, 0x58C0402,
+		0x58D0402, 0x58E0402, 0x58F0402, 0x5900402, 0x5910402, 0x5920402, 0x4170402, 0x4180402, 0x4210402, 0x4220402, 0x4230402, 0x5930402, 0x5940402, 0x5950402, 0x5960402, 0x4240402,
+		0x4250402, 0x2A10402, 0x2A20402, 0x43B0402, 0x43C0402, 0x43D0402, 0x43E0402, 0x5990402, 0x59A0402, 0x2CF0402, 0x2D00402, 0x2580402, 0x2830402, 0x2590402, 0x25A0402, 0x25B0402,
+		0x25C0402, 0x2730402, 0x2D10402, 0x1D900402, 0x1D910402, 0x1D920402, 0x1D930402, 0x1D940402, 0x1D950402, 0x1D960402, 0x1D970402, 0x1D980402, 0x1D990402, 0x1D9A0402, 0x4FA0402, 0x4FB0402,
+		0x4FC0402, 0x4FD0402, 0x4FE0402, 0x4FF0402, 0x5000402, 0x5010402, 0x5020402, 0x5030402, 0x20F0402, 0x5970402, 0x2860402, 0x2090404, 0x3320402, 0x3330402, 0x2630402, 0x2640402,
+		0x2650402, 0x29E0402, 0x29F0402, 0, 0, 0, 0x2A30402, 0x2A40402, 0x2600402, 0x43F0402, 0x4400402, 0x4410402, 0x3F20402, 0x27F0402, 0x2300402, 0x2840402,
+		0x25D0402, 0x25E0402, 0x2110402, 0x2120402, 0x2310402, 0x2850402, 0, 0x5980402, 0x26B0402, 0x2740402, 0x59F0402, 0x5A00402, 0x5A10402, 0x5A20402, 0x5A30402, 0x5A40402,
+		0x5A50402, 0x5A60402, 0x5A70402, 0x5A80402, 0x5A90402, 0x5AA0402, 0x5AB0402, 0x5AC0402, 0x5AD0402, 0x5AE0402, 0x5AF0402, 0x5B00402, 0x5B10402, 0x5B20402, 0x5B30402, 0x5B40402,
+		0x5B50402, 0x5B60402, 0x5B70402, 0x5B80402, 0x5B90402, 0x5BA0402, 0x5BB0402, 0x5BC0402, 0x5BD0402, 0x5BE0402, 0x2D20402, 0x2D30402, 0, 0x4420402, 0x4430402, 0x4440402,
+		0x4450402, 0x4460402, 0x4470402, 0x4480402, 0x2A50402, 0x2A60402, 0x2A70402, 0x2A80402, 0x4490402, 0x44A0402, 0x2D40402, 0x2D50402, 0x2870402, 0x2610402, 0x2A90402, 0x2AA0402,
+		0x2100402, 0x5BF0402, 0x5C00402, 0x5C10402, 0x5C20402, 0x5C30402, 0x5C40402, 0x5C50402, 0x5C60402, 0x5C70402, 0x5C80402, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0x5C90402, 0x5CA0402, 0x5CB0402, 0x5CC0402, 0x5CD0402, 0x5CE0402, 0x5CF0402, 0x5D00402, 0x5D10402, 0x4560402, 0x4570402, 0x4580402,
+		0x4590402, 0x29A0402, 0x29B0402, 0x4380402, 0x4390402, 0x43A0402, 0x2CB0402, 0x2CC0402, 0x44B0402, 0x44C0402, 0x44D0402, 0x44E0402, 0x44F0402, 0x4500402, 0x4510402, 0x4520402,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x4C00402, 0x4C00402, 0x4C20402, 0x4BB0402,
+		0x542, 0x4C00402, 0x4A2, 0x4C00402, 0x482, 0x4C00402, 0x542, 0x4C10402, 0x4A2, 0x4C10402, 0x482, 0x4C10402, 0x542, 0x4BB0402, 0x4A2, 0x4BB0402,
+		0x482, 0x4B40402, 0x4B50402, 0x4C10402, 0x2090404, 0x2090404, 0x2090404, 0x2090404, 0x2090404, 0x2090404, 0x2090404, 0x209041B, 0x2090404, 0x2090404, 0x2090404, 0x2130402,
+		0x213041B, 0x2140402, 0x2150402, 0x2160402, 0x2170402, 0x3940402, 0x20C0402, 0x3170402, 0x3180402, 0x3190402, 0x31A0402, 0x31E0402, 0x31F0402, 0x3200402, 0x3210402, 0x3B30402,
+		0x3B40402, 0x3B90402, 0x3BA0402, 0x27E0404, 0x27E0404, 0x27E0404, 0x27E0404, 0x27E0404, 0x27E0404, 0x3BB0402, 0x2070402, 0x2080402, 0x209041B, 0x3AF0402, 0x3B10402, 0x3BF0402,
+		0x3BF0404, 0x3BF0404, 0x3BF0404, 0x3BF0404, 0x3BF0404, 0x3C00402, 0x3C00404, 0x3C00404, 0x3C00404, 0x3C00404, 0x3C00404, 0x3C30402, 0x31B0402, 0x31C0402, 0x3C40402, 0x2670404,
+		0x2670404, 0x27C0402, 0x20A0402, 0x3C50402, 0x3C70402, 0x3C90402, 0x3CA0402, 0x3BC0402, 0x6760402, 0x3340402, 0x3350402, 0x26D0404, 0x26D0404, 0x26D0404, 0x2670404, 0x2670404,
+		0x26D0404, 0x3AA0402, 0x39D0402, 0x3BD0402, 0x3BE0402, 0x3A20402, 0x23C0402, 0x3C80402, 0x3A30402, 0x6720402, 0x21A0402, 0x3C60402, 0x2F90402, 0x2FA0402, 0x3BF0404, 0x3BF0404,
+		0x3BF0404, 0x3BF0404, 0x2FB0402, 0x2FC0402, 0x2FD0402, 0x2FE0402, 0x2FF0402, 0x3000402, 0x3010402, 0x2090404, 0, 0, 0, 0, 0x6660414, 0x6710414,
+		0x66B0414, 0x3280414, 0x3290414, 0x6660415, 0x6710415, 0x66B0415, 0x3280415, 0x3290415, 0x5D60402, 0x5D70402, 0x5D80402, 0x5D90402, 0x5DA0402, 0x5DB0402, 0x5DC0402, 0x5DD0402,
+		0x5DE0402, 0x5DF0402, 0x5E00402, 0x5E10402, 0x5E20402, 0x6650405, 0x5E30402, 0x5E40402, 0x5E50402, 0x5E60402, 0x5E70402, 0x7010402, 0x5E80402, 0x5E90402, 0x1D9B0402, 0x1D9C0402,
+		0x1D9D0402, 0x1D9E0402, 0x1D9F0402, 0x1DA00402, 0x5EA0402, 0x5EB0402, 0x5EC0402, 0x5EE0402, 0x5ED0402, 0x5EF0402, 0x5F00402, 0x5F10402, 0x5F20402, 0x5F30402, 0x5F40402, 0x5F50402,
+		0x5EC0402, 0x5E2, 0x5ED0402, 0x5E2, 0x5F60402, 0x5F70402, 0x5F80402, 0x5F90402, 0x5FA0402, 0x5FB0402, 0x5FC0402, 0x5FD0402, 0x5FE0402, 0x5FF0402, 0x6000402, 0x6010402,
+		0x6020402, 0x6030402, 0x6040402, 0x6050402, 0x6060402, 0x6070402, 0x5F00402, 0x5E2, 0x6080402, 0x6090402, 0x60A0402, 0x60B0402, 0x60C0402, 0x60D0402, 0x60E0402, 0x60F0402,
+		0x6100402, 0x6110402, 0x6120402, 0x6130402, 0x6140402, 0x6150402, 0x6160402, 0x6170402, 0x6180402, 0x6190402, 0x61A0402, 0x61B0402, 0x61C0402, 0x61D0402, 0x61E0402, 0x61F0402,
+		0x6200402, 0x6210402, 0x6220402, 0x6230402, 0x6240402, 0x6250402, 0x6260402, 0x5E2, 0x62A0402, 0x5E2, 0x6280402, 0x5E2, 0x6260402, 0x6270402, 0x6280402, 0x6290402,
+		0x62A0402, 0x62B0402, 0x62C0402, 0x62D0402, 0x62E0402, 0x62F0402, 0x6300402, 0x6310402, 0x6320402, 0x6330402, 0x6340402, 0x6350402, 0x6360402, 0x6370402, 0x6380402, 0x6390402,
+		0x63A0402, 0x63B0402, 0x63C0402, 0x63D0402, 0x63E0402, 0x63F0402, 0x6400402, 0x6410402, 0x6420402, 0x6430402, 0x6440402, 0x6450402, 0x6460402, 0x6470402, 0x6480402, 0x6490402,
+		0x64A0402, 0x64B0402, 0x64C0402, 0x64D0402, 0x64E0402, 0x64F0402, 0x6500402, 0x6510402, 0x6520402, 0x6530402, 0x6540402, 0x6550402, 0x6560402, 0x6570402, 0x6580402, 0x6590402,
+		0x6590402, 0x5E2, 0x65A0402, 0x65B0402, 0x65C0402, 0x65D0402, 0x65D0402, 0x5E2, 0x65E0402, 0x65F0402, 0x65F0402, 0x5E2, 0x6600402, 0x6620402, 0x6630402, 0x6640402,
+		0x6650402, 0x6710402, 0x6730402, 0x6740402, 0x6750402, 0x6770402, 0x6780402, 0x6790402, 0x67A0402, 0x67B0402, 0x67C0402, 0x67E0402, 0x6800402, 0x6810402, 0x6820402, 0x6830402,
+		0x6840402, 0x6850402, 0x6860402, 0x6860402, 0x5E2, 0x6870402, 0x6870402, 0x5E2, 0x6880402, 0x6890402, 0x68A0402, 0x68B0402, 0x68C0402, 0x68C0404, 0x68C0404, 0x68C0404,
+		0x68C0404, 0x68C0404, 0x68D0402, 0x68D0404, 0x68D0404, 0x68D0404, 0x68D0404, 0x68D0404, 0x68E0402, 0x68F0402, 0x6900402, 0x6910402, 0x6920402, 0x6930402, 0x6940402, 0x6950402,
+		0x6960402, 0x6970402, 0x6980402, 0x6990402, 0x69A0402, 0x69B0402, 0x69C0402, 0x69D0402, 0x6990402, 0x5E2, 0x69E0402, 0x69F0402, 0x69F0402, 0x5E2, 0x6A00402, 0x6A10402,
+		0x6A00402, 0x5E2, 0x6A20402, 0x6A20402, 0x5E2, 0x6A30402, 0x6A40402, 0x6A50402, 0x6A60402, 0x6A70402, 0x6A80402, 0x6A90402, 0x6AA0402, 0x6AB0402, 0x6AC0402, 0x6AD0402,
+		0x6AE0402, 0x6AF0402, 0x6B00402, 0x6B10402, 0x6B20402, 0x6B30402, 0x6B40402, 0x6B50402, 0x6B60402, 0x6B70402, 0x6B80402, 0x66B0402, 0x5E2, 0x6B90402, 0x6B90402, 0x5E2,
+		0x6BA0402, 0x6BB0402, 0x6BC0402, 0x6BD0402, 0x6BE0402, 0x6BF0402, 0x6C00402, 0x6C10402, 0x6C20402, 0x6C30402, 0x6A60402, 0x5E2, 0x66A0402, 0x5E2, 0x66C0402, 0x5E2,
+		0x6BB0402, 0x5E2, 0x6BC0402, 0x5E2, 0x6C40402, 0x6C50402, 0x6C40402, 0x5E2, 0x6C50402, 0x5E2, 0x6C60402, 0x6C70402, 0x6C60402, 0x5E2, 0x6C70402, 0x5E2,
+		0x6C80402, 0x6C90402, 0x6CA0402, 0x6CB0402, 0x6CC0402, 0x6CD0402, 0x6C80402, 0x5E2, 0x6C90402, 0x5E2, 0x6CE0402, 0x6CF0402, 0x6CE0402, 0x5E2, 0x6CF0402, 0x5E2,
+		0x6D00402, 0x6D10402, 0x6D00402, 0x5E2, 0x6D10402, 0x5E2, 0x6D20402, 0x6D30402, 0x6D40402, 0x6D50402, 0x6D60402, 0x6D70402, 0x6D80402, 0x6D90402, 0x6DA0402, 0x6DB0402,
+		0x6DC0402, 0x6DD0402, 0x6DE0402, 0x6DF0402, 0x6E00402, 0x6E10402, 0x6E20402, 0x6E30402, 0x6E40402, 0x6E50402, 0x6E60402, 0x6E70402, 0x6E80402, 0x6E90402, 0x6EA0402, 0x6EB0402,
+		0x6EC0402, 0x6ED0402, 0x6EE0402, 0x6EF0402, 0x6F00402, 0x6F10402, 0x6F20402, 0x6F30402, 0x6EA0402, 0x5E2, 0x6F00402, 0x5E2, 0x6F10402, 0x5E2, 0x6F30402, 0x5E2,
+		0x6F40402, 0x6F50402, 0x6F60402, 0x6F70402, 0x6F80402, 0x6F90402, 0x6FA0402, 0x6FB0402, 0x6FC0402, 0x6FD0402, 0x6FE0402, 0x6FF0402, 0x7000402, 0x7020402, 0x7030402, 0x7040402,
+		0x7050402, 0x7060402, 0x7070402, 0x7080402, 0x7090402, 0x70A0402, 0x70B0402, 0x70C0402, 0x70D0402, 0x70E0402, 0x70F0402, 0x7100402, 0x7110402, 0x7120402, 0x7130402, 0x7140402,
+		0x7150402, 0x7160402, 0x7170402, 0x7180402, 0x7190402, 0x71A0402, 0x71B0402, 0x71C0402, 0x71D0402, 0x71E0402, 0x71F0402, 0x7200402, 0x7210402, 0x7220402, 0x7230402, 0x7240402,
+		0x6CA0402, 0x5E2, 0x6CB0402, 0x5E2, 0x6D90402, 0x5E2, 0x6DA0402, 0x5E2, 0x7250402, 0x7260402, 0x7270402, 0x7280402, 0x7290402, 0x72A0402, 0x6F60402, 0x5E2,
+		0x6F70402, 0x5E2, 0x6F80402, 0x5E2, 0x6F90402, 0x5E2, 0x72B0402, 0x72C0402, 0x72D0402, 0x72E0402, 0x72F0402, 0x7300402, 0x7310402, 0x7320402, 0x7330402, 0x7340402,
+		0x7350402, 0x7360402, 0x7370402, 0x7380402, 0x7390402, 0x73A0402, 0x73B0402, 0x73C0402, 0x73D0402, 0x73E0402, 0x73F0402, 0x7400402, 0x7410402, 0x7420402, 0x7430402, 0x7440402,
+		0x3360402, 0x3370402, 0x3380402, 0x3390402, 0x7450402, 0x7460402, 0x7470402, 0x7480402, 0x7490402, 0x74A0402, 0x74B0402, 0x74C0402, 0x74D0402, 0x74E0402, 0x74F0402, 0x7500402,
+		0x7510402, 0x7520402, 0x7530402, 0x7540402, 0x7550402, 0x7560402, 0x7570402, 0x7580402, 0x7590402, 0x75A0402, 0x75B0402, 0x75C0402, 0x75D0402, 0x75E0402, 0x75F0402, 0x7600402,
+		0x7610402, 0x3800402, 0x3810402, 0x7620402, 0x7630402, 0x7640402, 0x7650402, 0x7660402, 0x7670402, 0x7680402, 0x7690402, 0x76A0402, 0x76B0402, 0x76C0402, 0x76D0402, 0x76E0402,
+		0x76F0402, 0x7700402, 0x7710402, 0x7720402, 0x7730402, 0x7740402, 0x7750402, 0x7760402, 0x7770402, 0x7780402, 0x7790402, 0x77A0402, 0x77B0402, 0x77C0402, 0x77D0402, 0x77E0402,
+		0x77F0402, 0x7800402, 0x7810402, 0x7820402, 0x7830402, 0x7840402, 0x7850402, 0x7860402, 0x7870402, 0x7880402, 0x7890402, 0x78A0402, 0x78B0402, 0x78C0402, 0x78D0402, 0x78E0402,
+		0x78F0402, 0x7900402, 0x7910402, 0x7920402, 0x7930402, 0x7940402, 0x7950402, 0x7960402, 0x7970402, 0x7980402, 0x7990402, 0x79A0402, 0x79B0402, 0x79C0402, 0x79D0402, 0x79E0402,
+		0x79F0402, 0x7A00402, 0x7A10402, 0x7A20402, 0x7A30402, 0x7A40402, 0x7A50402, 0x7A60402, 0x7A70402, 0x7A80402, 0x7A90402, 0x7AA0402, 0x7AB0402, 0x7AC0402, 0x7AD0402, 0x7AE0402,
+		0x7AF0402, 0x7B00402, 0x7B10402, 0x7B20402, 0x7B30402, 0x7B40402, 0x7B50402, 0x7B60402, 0x7B70402, 0x7B80402, 0x7B90402, 0x7BA0402, 0x7BB0402, 0x7BC0402, 0x7BD0402, 0x7BE0402,
+		0x7BF0402, 0x7C00402, 0x7C10402, 0x7C20402, 0x7C30402, 0x7C40402, 0x7C50402, 0x7C60402, 0x7C70402, 0x7C80402, 0x7C90402, 0x7CA0402, 0x7CB0402, 0x7CC0402, 0x7CD0402, 0x7CE0402,
+		0x7CF0402, 0x7D00402, 0x7D10402, 0x7D20402, 0x7D30402, 0x7D40402, 0x7D50402, 0x7D60402, 0x7D70402, 0x7D80402, 0x7D90402, 0x7DA0402, 0x7DB0402, 0x7DC0402, 0x7DD0402, 0x7DE0402,
+		0x7DF0402, 0x7E00402, 0x7E10402, 0x7E20402, 0x7E30402, 0x7E40402, 0x7E50402, 0x7E60402, 0x7E70402, 0x7E80402, 0x7E90402, 0x7EA0402, 0x7EB0402, 0x7EC0402, 0x7ED0402, 0x7EE0402,
+		0x7EF0402, 0x7F00402, 0x7F10402, 0x7F20402, 0x7F30402, 0x7F40402, 0x7F50402, 0x7F60402, 0x7F70402, 0x7F80402, 0x7F90402, 0x7FA0402, 0x7FB0402, 0x7FC0402, 0x7FD0402, 0x7FE0402,
+		0x7FF0402, 0x8000402, 0x8010402, 0x8020402, 0x8030402, 0x8040402, 0x8050402, 0x8060402, 0x8070402, 0x8080402, 0x8090402, 0x80A0402, 0x80B0402, 0x80C0402, 0x80D0402, 0x80E0402,
+		0x80F0402, 0x8100402, 0x8110402, 0x8120402, 0x8130402, 0x8140402, 0x8150402, 0x8160402, 0x8170402, 0x8180402, 0x8190402, 0x81A0402, 0x81B0402, 0x81C0402, 0x81D0402, 0x81E0402,
+		0x81F0402, 0x8200402, 0x8210402, 0x8220402, 0x8230402, 0x8240402, 0x8250402, 0x8260402, 0x8270402, 0x8280402, 0x8290402, 0x82A0402, 0x82B0402, 0x82C0402, 0x82D0402, 0x82E0402,
+		0x82F0402, 0x8300402, 0x8310402, 0x8320402, 0x8330402, 0x8340402, 0x8350402, 0x8370402, 0x8380402, 0x8390402, 0x83A0402, 0x83B0402, 0x83C0402, 0x83D0402, 0x83E0402, 0x83F0402,
+		0x8400402, 0x8410402, 0x8420402, 0x8430402, 0x8440402, 0x8450402, 0x8460402, 0x8470402, 0x8480402, 0x8490402, 0x84A0402, 0x84B0402, 0x84C0402, 0x84D0402, 0x84E0402, 0x84F0402,
+		0x8500402, 0x8510402, 0x8520402, 0x8530402, 0x8540402, 0x8550402, 0x8560402, 0x8570402, 0x8580402, 0x8590402, 0x85A0402, 0x85B0402, 0x85C0402, 0x85D0402, 0x85E0402, 0x85F0402,
+		0x8600402, 0x8610402, 0x8620402, 0x8630402, 0x8640402, 0x8650402, 0x8660402, 0x8670402, 0x8680402, 0x8690402, 0x86A0402, 0x86B0402, 0x86C0402, 0x86D0402, 0x86E0402, 0x86F0402,
+		0x8700402, 0x8710402, 0x8720402, 0x8730402, 0x8740402, 0x8750402, 0x8760402, 0x8770402, 0x8780402, 0x8790402, 0x87A0402, 0x87B0402, 0x87C0402, 0x87D0402, 0x87E0402, 0x87F0402,
+		0x8800402, 0x8810402, 0x8820402, 0x8830402, 0x8840402, 0x8850402, 0x8860402, 0x8870402, 0x8880402, 0x8890402, 0x88A0402, 0x88B0402, 0x88C0402, 0x88D0402, 0x88E0402, 0x88F0402,
+		0x8900402, 0x8910402, 0x8920402, 0x8930402, 0x8940402, 0x8950402, 0x8960402, 0x8970402, 0x8980402, 0x8990402, 0x89A0402, 0x89B0402, 0x89C0402, 0x89D0402, 0x89E0402, 0x89F0402,
+		0x8A00402, 0x8A10402, 0x8A20402, 0x8A30402, 0x8A40402, 0x8A50402, 0x8A60402, 0x8A70402, 0x8A80402, 0x8A90402, 0x8AA0402, 0x8AB0402, 0x8AC0402, 0x8AD0402, 0x8AE0402, 0x8AF0402,
+		0x8B00402, 0x8B10402, 0x8B20402, 0x8B30402, 0x8B40402, 0x8B50402, 0x8B60402, 0x8B70402, 0x8B80402, 0x8B90402, 0x8BA0402, 0x8BB0402, 0x8BC0402, 0x8BD0402, 0x8BE0402, 0x8BF0402,
+		0x8C00402, 0x8C10402, 0x8C20402, 0x8C30402, 0x8C40402, 0x8C50402, 0x8C60402, 0x8C70402, 0x8C80402, 0x8C90402, 0x8CA0402, 0x8CB0402, 0x8CC0402, 0x8CD0402, 0x8CE0402, 0x8CF0402,
+		0x8D00402, 0x8D10402, 0x8D20402, 0x8D30402, 0x8D40402, 0x8D50402, 0x8D60402, 0x8D70402, 0x8D80402, 0x8D90402, 0x8DA0402, 0x8DB0402, 0x8DC0402, 0x8DD0402, 0x8DE0402, 0x8DF0402,
+		0x8E00402, 0x8E10402, 0x8E20402, 0x8E30402, 0x8E40402, 0x8E50402, 0x8E60402, 0x8E70402, 0x8E80402, 0x8E90402, 0x8EA0402, 0x8EB0402, 0x8EC0402, 0x8ED0402, 0x8EE0402, 0x8EF0402,
+		0x8F00402, 0x8F10402, 0x8F20402, 0x8F30402, 0x8F40402, 0x8F50402, 0x8F60402, 0x8F70402, 0x8F80402, 0x8F90402, 0x8FA0402, 0x8FB0402, 0x8FC0402, 0x8FD0402, 0x8FE0402, 0x8FF0402,
+		0x9000402, 0x9010402, 0x9020402, 0x9030402, 0x9040402, 0x9050402, 0x9060402, 0x9070402, 0x9080402, 0x9090402, 0x90A0402, 0x90B0402, 0x90C0402, 0x90D0402, 0x90E0402, 0x90F0402,
+		0x9100402, 0x9110402, 0x9120402, 0x9130402, 0x9140402, 0x9150402, 0x9160402, 0x9170402, 0x9180402, 0x9190402, 0x91A0402, 0x91B0402, 0x91C0402, 0x91D0402, 0x91E0402, 0x91F0402,
+		0x9200402, 0x9210402, 0x9220402, 0x9230402, 0x9240402, 0x9250402, 0x9260402, 0x9270402, 0x9280402, 0x9290402, 0x92A0402, 0x92B0402, 0x92C0402, 0x92D0402, 0x92E0402, 0x92F0402,
+		0x9300402, 0x9310402, 0x9320402, 0x9330402, 0x9340402, 0x9350402, 0x9360402, 0x9370402, 0x9380402, 0x9390402, 0x93A0402, 0x93B0402, 0x93C0402, 0x93D0402, 0x93E0402, 0x93F0402,
+		0x9400402, 0x9410402, 0x9420402, 0x9430402, 0x9440402, 0x9450402, 0x9460402, 0x9470402, 0x9480402, 0x9490402, 0x94A0402, 0x94B0402, 0x94C0402, 0x94D0402, 0x94E0402, 0x94F0402,
+		0x9500402, 0x9510402, 0x9520402, 0x9530402, 0x9540402, 0x9550402, 0x9560402, 0x9570402, 0x9580402, 0x9590402, 0x95A0402, 0x95B0402, 0x95C0402, 0x95D0402, 0x95E0402, 0x95F0402,
+		0x9600402, 0x9610402, 0x9620402, 0x9630402, 0x9640402, 0x9650402, 0x9660402, 0x9670402, 0x9680402, 0xA330402, 0xA340402, 0xA350402, 0xA360402, 0xA370402, 0xA380402, 0xA390402,
+		0xA3A0402, 0xA3B0402, 0xA3C0402, 0xA3D0402, 0xA3E0402, 0xA3F0402, 0xA400402, 0xA410402, 0xA420402, 0xA430402, 0xA440402, 0xA450402, 0xA460402, 0xA470402, 0xA480402, 0xA490402,
+		0xA4A0402, 0xA4B0402, 0xA4C0402, 0xA4D0402, 0xA4E0402, 0xA4F0402, 0xA500402, 0xA510402, 0xA520402, 0xA530402, 0xA540402, 0xA550402, 0xA560402, 0xA570402, 0xA580402, 0xA590402,
+		0xA5A0402, 0xA5B0402, 0xA5C0402, 0xA5D0402, 0xA5E0402, 0xA5F0402, 0xA600402, 0xA610402, 0xA620402, 0xFEE0402, 0xFEF0402, 0xFF00402, 0xFF10402, 0xFF20402, 0xFF30402, 0xFF40402,
+		0xFF50402, 0xA630402, 0xA640402, 0xA650402, 0xA660402, 0xA670402, 0xA680402, 0xA690402, 0xA6A0402, 0xA6B0402, 0xA6C0402, 0xA6D0402, 0xA6E0402, 0xA6F0402, 0xA700402, 0xA710402,
+		0xA720402, 0xA730402, 0xA740402, 0xA750402, 0xA760402, 0xA770402, 0xA780402, 0xA790402, 0xA7A0402, 0xA7B0402, 0xA7C0402, 0xA7D0402, 0xA7E0402, 0xA7F0402, 0xA800402, 0xA810402,
+		0xA820402, 0xA830402, 0xA840402, 0xA850402, 0xA860402, 0xA870402, 0xA880402, 0xA890402, 0xA8A0402, 0xA8B0402, 0xA8C0402, 0xA8D0402, 0xA8E0402, 0xA8F0402, 0xA900402, 0xA910402,
+		0xA920402, 0xA930402, 0xA940402, 0xA950402, 0xA960402, 0xA970402, 0x12410402, 0x12420402, 0x12430402, 0xA980402, 0xA990402, 0xA9A0402, 0xA9B0402, 0xA9C0402, 0xA9D0402, 0xA9E0402,
+		0xA9F0402, 0xAA00402, 0xAA10402, 0xAA20402, 0xAA30402, 0xAA40402, 0xAA50402, 0xAA60402, 0xAA70402, 0xAA80402, 0xAA90402, 0xAAA0402, 0xAAB0402, 0xAAC0402, 0xAAD0402, 0xAAE0402,
+		0xAAF0402, 0xAB00402, 0xAB10402, 0xFE80402, 0xFE90402, 0xFEA0402, 0xFEB0402, 0xFEC0402, 0xFED0402, 0xAB20402, 0xAB30402, 0xAB40402, 0xAB50402, 0xAB60402, 0xAB70402, 0xAB80402,
+		0xAB90402, 0xABA0402, 0xABB0402, 0xABC0402, 0xABD0402, 0xABE0402, 0xABF0402, 0xAC00402, 0xAC10402, 0xAC20402, 0xAC30402, 0xAC40402, 0xAC50402, 0xAC60402, 0xAC70402, 0xAC80402,
+		0xAC90402, 0xACA0402, 0xACB0402, 0xACC0402, 0xACD0402, 0xACE0402, 0xACF0402, 0xAD00402, 0xAD10402, 0xAD20402, 0xAD30402, 0xAD40402, 0xAD50402, 0xAD60402, 0xAD70402, 0xAD80402,
+		0xAD90402, 0xADA0402, 0xADB0402, 0xADC0402, 0xADD0402, 0xADE0402, 0xADF0402, 0xAE00402, 0xAE10402, 0xAE20402, 0xAE30402, 0xAE40402, 0xAE50402, 0xAE60402, 0xAE70402, 0xAE80402,
+		0xAE90402, 0xAEA0402, 0xAEB0402, 0xAEC0402, 0xAED0402, 0xAEE0402, 0xAEF0402, 0xAF00402, 0xAF10402, 0xAF20402, 0xAF30402, 0xAF40402, 0xAF50402, 0xAF60402, 0xAF70402, 0xAF80402,
+		0xAF90402, 0xAFA0402, 0xAFB0402, 0xAFC0402, 0xAFD0402, 0xAFE0402, 0xAFF0402, 0xB000402, 0xB010402, 0xB020402, 0xB030402, 0xB040402, 0xB050402, 0xB060402, 0xB070402, 0xB080402,
+		0xB090402, 0xB0A0402, 0xB0B0402, 0xB0C0402, 0xB0D0402, 0xB0E0402, 0xB0F0402, 0xB100402, 0xB110402, 0xB120402, 0xB130402, 0xB140402, 0xB150402, 0xB160402, 0xB170402, 0xB180402,
+		0xB190402, 0xB1A0402, 0xB1B0402, 0xB1C0402, 0xB1D0402, 0xB1E0402, 0xB1F0402, 0xB200402, 0xB210402, 0xB3C0402, 0xB3D0402, 0xB3E0402, 0xB3F0402, 0xB400402, 0xB410402, 0xB420402,
+		0xB430402, 0xB440402, 0xB450402, 0xB460402, 0xB470402, 0xB480402, 0xB490402, 0xB4A0402, 0xB4B0402, 0xB4C0402, 0xB4D0402, 0xB4E0402, 0xB4F0402, 0xB500402, 0xB510402, 0xB520402,
+		0xB530402, 0xB540402, 0xB550402, 0xB560402, 0xB570402, 0xB580402, 0xB590402, 0xB5A0402, 0xB5B0402, 0xB5C0402, 0xB5F0402, 0xB600402, 0xB610402, 0xB620402, 0xB630402, 0xB640402,
+		0xB650402, 0xB660402, 0xB670402, 0xB680402, 0xB690402, 0xB6A0402, 0xB6B0402, 0xB6C0402, 0xB6D0402, 0xB6E0402, 0xB6F0402, 0xB700402, 0xB710402, 0xB720402, 0xB730402, 0xB740402,
+		0xB750402, 0xB760402, 0xB770402, 0xB780402, 0xB790402, 0xB7A0402, 0xB7B0402, 0xB7C0402, 0xB7D0402, 0xB7E0402, 0xB7F0402, 0xB800402, 0xB810402, 0xB820402, 0xB830402, 0xB840402,
+		0xB850402, 0xB860402, 0xB870402, 0xB880402, 0xB890402, 0xB8A0402, 0xB8B0402, 0xB8C0402, 0xB8D0402, 0xB8E0402, 0xB8F0402, 0xB900402, 0xB910402, 0xB920402, 0xB930402, 0xB940402,
+		0xB950402, 0xB960402, 0xB970402, 0xB980402, 0xB990402, 0xB9A0402, 0xB9B0402, 0xB9C0402, 0xB9D0402, 0xB9E0402, 0xB9F0402, 0xBA00402, 0xBA10402, 0xBA20402, 0xBA30402, 0xBA40402,
+		0xBA50402, 0x35E0402, 0x35F0402, 0x3600402, 0x3610402, 0x3620402, 0x3630402, 0x3640402, 0x3650402, 0x3660402, 0x3670402, 0x3680402, 0x3690402, 0x36A0402, 0x36B0402, 0xBA60402,
+		0xBA70402, 0xBA80402, 0xBA90402, 0xBAA0402, 0xBAB0402, 0xBAC0402, 0xBAD0402, 0xBAE0402, 0xBAF0402, 0xBB00402, 0xBB10402, 0xBB20402, 0xBB30402, 0xBB40402, 0xBB50402, 0xBB60402,
+		0xBB70402, 0xBB80402, 0xBB90402, 0xBBA0402, 0xBBB0402, 0xBBC0402, 0xBBD0402, 0xBBE0402, 0xBBF0402, 0xBC00402, 0xBC10402, 0xBC20402, 0xBC30402, 0xBC40402, 0xBC50402, 0xBC60402,
+		0xBC70402, 0xBC80402, 0xBC90402, 0xBCA0402, 0xBCB0402, 0xBCC0402, 0xBCD0402, 0xBCE0402, 0xBCF0402, 0xBD00402, 0xBD10402, 0xBD20402, 0xBD30402, 0xBD40402, 0xBD50402, 0xBD60402,
+		0x3520402, 0x3530402, 0xBD70402, 0xBD80402, 0xBD90402, 0xBDA0402, 0xBDB0402, 0xBDC0402, 0xBDD0402, 0xBDE0402, 0xBDF0402, 0xBE00402, 0xBE10402, 0xBE20402, 0xBE30402, 0xBE40402,
+		0xBE50402, 0xBE60402, 0xBE70402, 0xBE80402, 0xBE90402, 0xBEA0402, 0xBEB0402, 0xBEC0402, 0xBED0402, 0xBEE0402, 0xBEF0402, 0xBF00402, 0xBF10402, 0xBF20402, 0xBF30402, 0xBF40402,
+		0xBF50402, 0x3540402, 0x3550402, 0x3560402, 0x3570402, 0x3580402, 0x3590402, 0x35A0402, 0x35B0402, 0x35C0402, 0x35D0402, 0xBF60402, 0xBF70402, 0xBF80402, 0xBF90402, 0xBFA0402,
+		0xBFB0402, 0xBFC0402, 0xBFD0402, 0xBFE0402, 0xBFF0402, 0xC000402, 0xC010402, 0xC020402, 0xC030402, 0xC040402, 0xC050402, 0xEE80402, 0xEE90402, 0xEEA0402, 0xEEB0402, 0xEEC0402,
+		0xEED0402, 0xEEE0402, 0xEEF0402, 0xEF00402, 0xEF10402, 0xEF20402, 0xEF30402, 0xEF40402, 0xEF50402, 0xEF60402, 0xEF70402, 0xEF80402, 0xEF90402, 0xEFA0402, 0xEFB0402, 0xEFC0402,
+		0xEFD0402, 0xEFE0402, 0xEFF0402, 0xF000402, 0xF010402, 0xF020402, 0xF030402, 0xF040402, 0xF050402, 0xF060402, 0xF070402, 0xF080402, 0xF090402, 0xF0A0402, 0xF0B0402, 0xF0C0402,
+		0xF0D0402, 0xF0E0402, 0xF0F0402, 0xF100402, 0xF110402, 0xF120402, 0xF130402, 0xF140402, 0xF150402, 0xF160402, 0xF170402, 0xF180402, 0xF190402, 0xF1A0402, 0xF1B0402, 0xF1C0402,
+		0xF1D0402, 0xF1E0402, 0xF1F0402, 0xF200402, 0xF210402, 0xF220402, 0xF230402, 0xF240402, 0xF250402, 0xF260402, 0xF270402, 0xF280402, 0xF290402, 0xF2A0402, 0xF2B0402, 0xF2C0402,
+		0xF2D0402, 0xF2E0402, 0xF2F0402, 0xF300402, 0xF310402, 0xF320402, 0xF330402, 0xF340402, 0xF350402, 0xF360402, 0xF370402, 0xF380402, 0xF390402, 0xF3A0402, 0xF3B0402, 0xF3C0402,
+		0xF3D0402, 0xF3E0402, 0xF3F0402, 0xF400402, 0xF410402, 0xF420402, 0xF430402, 0xF440402, 0xF450402, 0xF460402, 0xF470402, 0xF480402, 0xF490402, 0xF4A0402, 0xF4B0402, 0xF4C0402,
+		0xF4D0402, 0xF4E0402, 0xF4F0402, 0xF500402, 0xF510402, 0xF520402, 0xF530402, 0xF540402, 0xF550402, 0xF560402, 0xF570402, 0xF580402, 0xF590402, 0xF5A0402, 0xF5B0402, 0xF5C0402,
+		0xF5D0402, 0xF5E0402, 0xF5F0402, 0xF600402, 0xF610402, 0xF620402, 0xF630402, 0xF640402, 0xF650402, 0xF660402, 0xF670402, 0xF680402, 0xF690402, 0xF6A0402, 0xF6B0402, 0xF6C0402,
+		0xF6D0402, 0xF6E0402, 0xF6F0402, 0xF700402, 0xF710402, 0xF720402, 0xF730402, 0xF740402, 0xF750402, 0xF760402, 0xF770402, 0xF780402, 0xF790402, 0xF7A0402, 0xF7B0402, 0xF7C0402,
+		0xF7D0402, 0xF7E0402, 0xF7F0402, 0xF800402, 0xF810402, 0xF820402, 0xF830402, 0xF840402, 0xF850402, 0xF860402, 0xF870402, 0xF880402, 0xF890402, 0xF8A0402, 0xF8B0402, 0xF8C0402,
+		0xF8D0402, 0xF8E0402, 0xF8F0402, 0xF900402, 0xF910402, 0xF920402, 0xF930402, 0xF940402, 0xF950402, 0xF960402, 0xF970402, 0xF980402, 0xF990402, 0xF9A0402, 0xF9B0402, 0xF9C0402,
+		0xF9D0402, 0xF9E0402, 0xF9F0402, 0xFA00402, 0xFA10402, 0xFA20402, 0xFA30402, 0xFA40402, 0xFA50402, 0xFA60402, 0xFA70402, 0xFA80402, 0xFA90402, 0xFAA0402, 0xFAB0402, 0xFAC0402,
+		0xFAD0402, 0xFAE0402, 0xFAF0402, 0xFB00402, 0xFB10402, 0xFB20402, 0xFB30402, 0xFB40402, 0xFB50402, 0xFB60402, 0xFB70402, 0xFB80402, 0xFB90402, 0xFBA0402, 0xFBB0402, 0xFBC0402,
+		0xFBD0402, 0xFBE0402, 0xFBF0402, 0xFC00402, 0xFC10402, 0xFC20402, 0xFC30402, 0xFC40402, 0xFC50402, 0xFC60402, 0xFC70402, 0xFC80402, 0xFC90402, 0xFCA0402, 0xFCB0402, 0xFCC0402,
+		0xFCD0402, 0xFCE0402, 0xFCF0402, 0xFD00402, 0xFD10402, 0xFD20402, 0xFD30402, 0xFD40402, 0xFD50402, 0xFD60402, 0xFD70402, 0xFD80402, 0xFD90402, 0xFDA0402, 0xFDB0402, 0xFDC0402,
+		0xFDD0402, 0xFDE0402, 0xFDF0402, 0xFE00402, 0xFE10402, 0xFE20402, 0xFE30402, 0xFE40402, 0xFE50402, 0xFE60402, 0xFE70402, 0xC060402, 0xC070402, 0xC080402, 0xC090402, 0xC0A0402,
+		0xC0B0402, 0xC0C0402, 0xC0D0402, 0xC0E0402, 0xC0F0402, 0xC100402, 0xC110402, 0xC120402, 0xC130402, 0xC140402, 0xC150402, 0xC160402, 0xC170402, 0xC180402, 0xC190402, 0xC1A0402,
+		0xC1B0402, 0xC1C0402, 0xC1D0402, 0xC1E0402, 0xC1F0402, 0xC200402, 0xC210402, 0xC220402, 0xC230402, 0xC240402, 0xC250402, 0xC260402, 0xC270402, 0xC280402, 0xC290402, 0xC2A0402,
+		0xC2B0402, 0xC2C0402, 0xC2D0402, 0xC2E0402, 0xC2F0402, 0xC300402, 0xC310402, 0xC320402, 0xC330402, 0xC340402, 0xC350402, 0xC360402, 0xC370402, 0xC380402, 0xC390402, 0xC3A0402,
+		0xC3B0402, 0xC3C0402, 0xC3D0402, 0xC3E0402, 0xC3F0402, 0xC400402, 0xC410402, 0xC420402, 0xC430402, 0xC440402, 0xC450402, 0xC460402, 0xC470402, 0xC480402, 0xC490402, 0xC4A0402,
+		0xC4B0402, 0xC4C0402, 0xC4D0402, 0xC4E0402, 0xC4F0402, 0xC500402, 0xC510402, 0xC520402, 0xC530402, 0xC540402, 0xC550402, 0xC560402, 0xC570402, 0xC580402, 0xC590402, 0xC5A0402,
+		0xC5B0402, 0xC5C0402, 0xC5D0402, 0xC5E0402, 0xC5F0402, 0xC600402, 0xC610402, 0xC620402, 0xC630402, 0xC640402, 0xC650402, 0xC660402, 0xC670402, 0xC680402, 0xC690402, 0xC6A0402,
+		0xC6B0402, 0xC6C0402, 0xC6D0402, 0xC6E0402, 0xC6F0402, 0xC700402, 0xC710402, 0xC720402, 0xC730402, 0xC740402, 0xC750402, 0xC760402, 0xC770402, 0xC780402, 0xC790402, 0xC7A0402,
+		0xC7B0402, 0xC7C0402, 0xC7D0402, 0xC7E0402, 0xC7F0402, 0xC800402, 0xC810402, 0xC820402, 0xC830402, 0xC840402, 0xC850402, 0xC860402, 0xC870402, 0xC880402, 0x33C0402, 0x33D0402,
+		0x33E0402, 0x33F0402, 0x3400402, 0x3410402, 0x3420402, 0x3430402, 0x3440402, 0x3450402, 0x3460402, 0x3470402, 0x3480402, 0x3490402, 0x34A0402, 0x34B0402, 0x34C0402, 0x34D0402,
+		0x34E0402, 0x34F0402, 0x3500402, 0x3510402, 0xC890402, 0xC8A0402, 0xC8B0402, 0xC8C0402, 0xC8D0402, 0xC8E0402, 0xC8F0402, 0xC900402, 0xC910402, 0xC920402, 0xC930402, 0xC940402,
+		0xC950402, 0xC960402, 0xC970402, 0xC980402, 0xC990402, 0xC9A0402, 0xC9B0402, 0xC9C0402, 0xC9D0402, 0xC9E0402, 0xC9F0402, 0xCA00402, 0xCA10402, 0xCA20402, 0xCA30402, 0xCA40402,
+		0xCA50402, 0xCA60402, 0xCA70402, 0xCA80402, 0xCA90402, 0xCAA0402, 0xCAB0402, 0xCAC0402, 0xCAD0402, 0xCAE0402, 0xCAF0402, 0xCB00402, 0xCB10402, 0xCB20402, 0xCB30402, 0xCB40402,
+		0xCB50402, 0xCB60402, 0xCB70402, 0xCB80402, 0xCB90402, 0xCBA0402, 0xCBB0402, 0xCBC0402, 0xCBD0402, 0xCBE0402, 0xCBF0402, 0xCC00402, 0xCC10402, 0xCC20402, 0xCC30402, 0xCC40402,
+		0xCC50402, 0xCC60402, 0xCC70402, 0x3960402, 0x3970402, 0x3980402, 0x3990402, 0xCC80402, 0xCC90402, 0xCCA0402, 0xCCB0402, 0xCCC0402, 0xCCD0402, 0xCCE0402, 0xCCF0402, 0xCD00402,
+		0xCD10402, 0xCD20402, 0xCD30402, 0xCD40402, 0xCD50402, 0xCD60402, 0xCD70402, 0xCD80402, 0xCD90402, 0xCDA0402, 0xCDB0402, 0xCDC0402, 0xCDD0402, 0xCDE0402, 0xCDF0402, 0xCE00402,
+		0xCE10402, 0xCE20402, 0xCE30402, 0xCE40402, 0xCE50402, 0xCE60402, 0xCE70402, 0x33A0402, 0x33B0402, 0xCE80402, 0xCE90402, 0xCEA0402, 0xCEB0402, 0xCEC0402, 0xCED0402, 0xCEE0402,
+		0xCEF0402, 0xCF00402, 0xCF10402, 0xCF20402, 0xCF30402, 0xCF40402, 0xCF50402, 0x68C0404, 0x68C0404, 0x68C0404, 0x68C0404, 0xCF60402, 0xCF70402, 0xCF80402, 0xCF90402, 0xCFA0402,
+		0xCFB0402, 0xCFC0402, 0xCFD0402, 0xCFE0402, 0xCFF0402, 0xD000402, 0xD010402, 0xD020402, 0xD030402, 0xD040402, 0xD050402, 0xD060402, 0xD070402, 0xD080402, 0xD090402, 0xD0A0402,
+		0xD0B0402, 0xD0C0402, 0xD0D0402, 0xD0E0402, 0xD0F0402, 0xD100402, 0xD110402, 0xD120402, 0xD130402, 0xD140402, 0xD150402, 0xD160402, 0xD170402, 0xD180402, 0xD190402, 0xD1A0402,
+		0xD1B0402, 0xD1C0402, 0xD1D0402, 0xD1E0402, 0xD1F0402, 0xD200402, 0xD210402, 0xD220402, 0xD230402, 0xD240402, 0xD250402, 0xD260402, 0xD270402, 0xD280402, 0xD290402, 0xD2A0402,
+		0xD2B0402, 0xD2C0402, 0xD2D0402, 0xD2E0402, 0xD2F0402, 0xD300402, 0xD310402, 0xD320402, 0xD330402, 0xD340402, 0xD350402, 0xD360402, 0xD370402, 0xD380402, 0xD390402, 0xD3A0402,
+		0xD3B0402, 0xD3C0402, 0xD3D0402, 0xD3E0402, 0xD3F0402, 0xD400402, 0xD410402, 0xD420402, 0xD430402, 0xD440402, 0xD450402, 0xD460402, 0xD470402, 0xD480402, 0xD490402, 0xD4A0402,
+		0xD4B0402, 0xD4C0402, 0xD4D0402, 0xD4E0402, 0xD4F0402, 0xD500402, 0xD510402, 0xD520402, 0xD530402, 0xD540402, 0xD550402, 0xD560402, 0xD570402, 0xD580402, 0xD590402, 0xD5A0402,
+		0xD5B0402, 0xD5C0402, 0x2400404, 0x2400404, 0x66B0404, 0x66B0404, 0x66B0404, 0x66B0404, 0x66B0404, 0x66B0404, 0xD5D0402, 0xD5E0402, 0xD5F0402, 0xD600402, 0xD610402, 0xD620402,
+		0xD630402, 0xD640402, 0xD650402, 0xD660402, 0xD670402, 0xD680402, 0xD690402, 0xD6A0402, 0xD6B0402, 0xD6C0402, 0xD6D0402, 0xD6E0402, 0xD6F0402, 0xD700402, 0xD710402, 0xD720402,
+		0xD730402, 0xD740402, 0xD750402, 0xD760402, 0xD770402, 0xD780402, 0xD790402, 0xD7A0402, 0xD7B0402, 0xD7C0402, 0xD7D0402, 0xD7E0402, 0xD7F0402, 0xD800402, 0xD810402, 0xD820402,
+		0xD830402, 0xD840402, 0xD850402, 0xD860402, 0xD870402, 0xD880402, 0xD890402, 0xD8A0402, 0xD8B0402, 0xD8C0402, 0xD8D0402, 0xD8E0402, 0xD8F0402, 0xD900402, 0xD910402, 0xD920402,
+		0xD930402, 0xD940402, 0xD950402, 0xD960402, 0xD970402, 0xD980402, 0xD990402, 0xD9A0402, 0xD9B0402, 0xD9C0402, 0xD9D0402, 0xD9E0402, 0xD9F0402, 0xDA00402, 0xDA10402, 0xDA20402,
+		0xDA30402, 0xDA40402, 0xDA50402, 0xDA60402, 0xDA70402, 0xDA80402, 0xDA90402, 0xDAA0402, 0xDAB0402, 0xDAC0402, 0xDAD0402, 0xDAE0402, 0xDAF0402, 0xDB00402, 0xDB10402, 0xDB20402,
+		0xDB30402, 0xDB40402, 0xDB50402, 0xDB60402, 0xDB70402, 0xDB80402, 0xDB90402, 0xDBA0402, 0xDBB0402, 0xDBC0402, 0xDBD0402, 0xDBE0402, 0xDBF0402, 0xDC00402, 0xDC10402, 0xDC20402,
+		0x5E2, 0xDC20402, 0xDC30402, 0xDC40402, 0xDC50402, 0xDC60402, 0xDC70402, 0xDC80402, 0xDC90402, 0xDCA0402, 0xDCB0402, 0xDCC0402, 0xDCD0402, 0xDCE0402, 0xDCF0402, 0xDD00402,
+		0xDD10402, 0xDD20402, 0xDD30402, 0xDD40402, 0xDD50402, 0xDD60402, 0xDD70402, 0xDD80402, 0xDD90402, 0xDDA0402, 0xDDB0402, 0xDDC0402, 0xDDD0402, 0xDDE0402, 0xDDF0402, 0xDE00402,
+		0xDE10402, 0xDE20402, 0xDE30402, 0xDE40402, 0xDE50402, 0xDE60402, 0xDE70402, 0xDE80402, 0xDE90402, 0xDEA0402, 0xDEB0402, 0xDEC0402, 0xDED0402, 0xDEE0402, 0xDEF0402, 0xDF00402,
+		0xDF10402, 0xDF20402, 0xDF30402, 0xDF40402, 0xDF50402, 0xDF60402, 0xDF70402, 0xDF80402, 0xDF90402, 0xDFA0402, 0xDFB0402, 0xDFC0402, 0xDFD0402, 0xDFE0402, 0xDFF0402, 0xE000402,
+		0xE010402, 0xE020402, 0xE030402, 0xE040402, 0xE050402, 0xE060402, 0xE070402, 0xE080402, 0xE090402, 0xE0A0402, 0xE0B0402, 0xE0C0402, 0xE0D0402, 0xE0E0402, 0xE0F0402, 0xE100402,
+		0xE110402, 0xE120402, 0xE130402, 0xE140402, 0xE150402, 0xE160402, 0xE170402, 0xE180402, 0xE190402, 0xE1A0402, 0xE1B0402, 0xE1C0402, 0xE1D0402, 0xE1E0402, 0xE1F0402, 0xE200402,
+		0xE210402, 0xE220402, 0xE230402, 0xE240402, 0xE250402, 0xE260402, 0xE270402, 0xE280402, 0xE290402, 0xE2A0402, 0xE2B0402, 0xE2C0402, 0xE2D0402, 0xE2E0402, 0xE2F0402, 0xE300402,
+		0xE310402, 0xE320402, 0xE330402, 0xE340402, 0xE350402, 0xE360402, 0xE370402, 0xE380402, 0xE390402, 0xE3A0402, 0xE3B0402, 0xE3C0402, 0xE3D0402, 0xE3E0402, 0xE3F0402, 0xE400402,
+		0xE410402, 0xE420402, 0xE430402, 0xE440402, 0xE450402, 0xE460402, 0xE470402, 0xE480402, 0xE490402, 0xE4A0402, 0xE4B0402, 0xE4C0402, 0xE4D0402, 0xE4E0402, 0xE4F0402, 0xE500402,
+		0xE510402, 0xE520402, 0xE530402, 0xE540402, 0xE550402, 0xE560402, 0xE570402, 0xE580402, 0xE590402, 0xE5A0402, 0xE5B0402, 0xE5C0402, 0xE5D0402, 0xE5E0402, 0xE5F0402, 0xE600402,
+		0xE610402, 0xE620402, 0xE630402, 0xE640402, 0xE650402, 0xE660402, 0xE670402, 0xE680402, 0xE690402, 0xE6A0402, 0xE6B0402, 0xE6C0402, 0xE6D0402, 0xE6E0402, 0xE6F0402, 0xE700402,
+		0xE710402, 0xE720402, 0xE730402, 0xE740402, 0xE750402, 0xE760402, 0xE770402, 0xE780402, 0xE7A0402, 0xE7B0402, 0xE7C0402, 0xE7D0402, 0xE7E0402, 0xE7F0402, 0xE800402, 0xE810402,
+		0xE820402, 0xE830402, 0xE840402, 0xE850402, 0xE860402, 0xE870402, 0xE880402, 0xE890402, 0xE8A0402, 0xE8B0402, 0xE8C0402, 0xE8D0402, 0xE8E0402, 0xE8F0402, 0xE900402, 0xE910402,
+		0xE920402, 0xE930402, 0xE940402, 0xE950402, 0xE960402, 0xE970402, 0xE980402, 0xE990402, 0xE9A0402, 0xE9B0402, 0xE9F0402, 0xEA00402, 0xEA10402, 0xEA20402, 0xEA30402, 0xEA40402,
+		0xEA50402, 0xEA60402, 0xEA70402, 0xEA80402, 0xEA90402, 0xEAA0402, 0xEAC0402, 0xEAD0402, 0xEAE0402, 0xEAF0402, 0xEB00402, 0xEB10402, 0xEB20402, 0xEB30402, 0xECE0402, 0xECF0402,
+		0xED00402, 0xED10402, 0xEE20402, 0xEE30402, 0xEE40402, 0xEE50402, 0xEE60402, 0xEE70402, 0x2880402, 0x2750402, 0x2760402, 0x3070402, 0x1DB70402, 0x2890402, 0x3080402, 0x4530402,
+		0, 0x3CB0402, 0x3CC0402, 0x36C0402, 0x36D0402, 0x36E0402, 0x36F0402, 0x3CD0402, 0x3CE0402, 0x3CF0402, 0x3700402, 0x3710402, 0x3D00402, 0x3720402, 0x3730402, 0x3D10402,
+		0x3D20402, 0x3D30402, 0x3D40402, 0x3D50402, 0x3D60402, 0x3D70402, 0x3D80402, 0x3D90402, 0x21C0402, 0x27D0402, 0x3090402, 0x3DA0402, 0x3DB0402, 0x3740402, 0x3750402, 0x3DC0402,
+		0x3DD0402, 0x3760402, 0x3770402, 0x3780402, 0x3790402, 0x37A0402, 0x37B0402, 0x37C0402, 0x37D0402, 0x37E0402, 0x37F0402, 0x3020402, 0x3030402, 0x3040402, 0x3050402, 0x26F0402,
+		0x28A0402, 0x2940402, 0x2250402, 0x2950402, 0x2240402, 0x23D0402, 0x3B50402, 0x3B60402, 0x3B70402, 0x39B0402, 0x2180402, 0x2190402, 0x28B0402, 0x3060402, 0x3950402, 0x39F0402,
+		0x21D0402, 0x2260402, 0x3220402, 0x21B0402, 0x3DE0402, 0x1D1B0402, 0x1D1C0402, 0x1D1D0402, 0x1D1E0402, 0x1D1F0402, 0x1D200402, 0x1D210402, 0x1D220402, 0x1D230402, 0x1D240402, 0x1D250402,
+		0x1D260402, 0x2090403, 0x2360402, 0x2920402, 0x3C10402, 0x1D4B0402, 0x3800402, 0x3810402, 0x3820402, 0x3830402, 0x3840402, 0x3850402, 0x3860402, 0x3870402, 0x3880402, 0x3890402,
+		0x1D4C0402, 0x1D4D0402, 0x38A0402, 0x38B0402, 0x38C0402, 0x38D0402, 0x38E0402, 0x38F0402, 0x3900402, 0x3910402, 0x21E0402, 0x3230402, 0x3240402, 0x3250402, 0x1D4E0402, 0x21F0402,
+		0x1D4C0404, 0x1D4F0402, 0x3C20402, 0x1D500402, 0x1D510402, 0x4C30402, 0x4C40402, 0x2200402, 0x2210402, 0x1D520402, 0x1D530402, 0x1D270402, 0x1D280402, 0x1D290402, 0x1D2A0402, 0x1D2B0402,
+		0x1D2C0402, 0x1D2D0402, 0x1D2E0402, 0x1D2F0402, 0x1D300402, 0x1D310402, 0x1D320402, 0x1D330402, 0x1D340402, 0x1D350402, 0x1D360402, 0x1D370402, 0x1D380402, 0x1D390402, 0x1D3A0402, 0x1D3B0402,
+		0x1D3C0402, 0x1D3D0402, 0x1D3E0402, 0x1D3F0402, 0x1D400402, 0x1D410402, 0x1D420402, 0x1D430402, 0x1D440402, 0x1D450402, 0x1D460402, 0x1D470402, 0x1D480402, 0x1D490402, 0x1D4A0402, 0x1D540402,
+		0xFF60402, 0xFF70402, 0xFF80402, 0xFF90402, 0xFFA0402, 0xFFB0402, 0xFFC0402, 0xFFD0402, 0xFFE0402, 0xFFF0402, 0x10000402, 0x10010402, 0x10020402, 0x10030402, 0x10040402, 0x10050402,
+		0x10060402, 0x10070402, 0x10080402, 0x10090402, 0x100A0402, 0x100B0402, 0x100C0402, 0x100D0402, 0x100E0402, 0x100F0402, 0x10100402, 0x10110402, 0x10120402, 0x10130402, 0x10140402, 0x10150402,
+		0x10160402, 0x10170402, 0x10180402, 0x10190402, 0x101A0402, 0x101B0402, 0x101C0402, 0x101D0402, 0x101E0402, 0x101F0402, 0x10200402, 0x10210402, 0x10220402, 0x10230402, 0x10240402, 0x10250402,
+		0x10260402, 0x10270402, 0x10280402, 0x10290402, 0x102A0402, 0x102B0402, 0x102C0402, 0x102D0402, 0x102E0402, 0x102F0402, 0x10300402, 0x10310402, 0x10320402, 0x10330402, 0x10340402, 0x10350402,
+		0x108D0402, 0x108E0402, 0x108F0402, 0x10900402, 0x10910402, 0x10920402, 0x10930402, 0x10940402, 0x10950402, 0x10960402, 0x10970402, 0x10980402, 0x10990402, 0x109A0402, 0x109B0402, 0x109C0402,
+		0x109D0402, 0x109E0402, 0x109F0402, 0x10A00402, 0x10A10402, 0x10A20402, 0x10A30402, 0x10A40402, 0x10A50402, 0x10A60402, 0x10A70402, 0x10A80402, 0x10A90402, 0x10AA0402, 0x10AB0402, 0x10AC0402,
+		0x10AD0402, 0x10AE0402, 0x10AF0402, 0x10B00402, 0x10B10402, 0x10B20402, 0x10B30402, 0x10B40402, 0x10B50402, 0x10B60402, 0x10B70402, 0x10B80402, 0x10B90402, 0x10BA0402, 0x10BB0402, 0x10BC0402,
+		0x10BD0402, 0x10BE0402, 0x10BF0402, 0x10C00402, 0x10C10402, 0x10C20402, 0x10C30402, 0x2320402, 0x28C0402, 0x2330402, 0x28D0402, 0x2770402, 0, 0, 0, 0x3A50402,
+		0x3E30402, 0x2E00402, 0x28E0402, 0x2660402, 0x2340402, 0x23E0402, 0x2780402, 0x5040402, 0x5050402, 0x5060402, 0x5070402, 0x5080402, 0x5090402, 0x50A0402, 0x50B0402, 0x50C0402,
+		0x50D0402, 0x50E0402, 0x50F0402, 0x5100402, 0x5110402, 0x5120402, 0x5130402, 0x5140402, 0x5150402, 0x5160402, 0x5170402, 0x5180402, 0x5190402, 0x51A0402, 0x51B0402, 0x51C0402,
+		0x51D0402, 0x51E0402, 0x51F0402, 0x5200402, 0x5210402, 0x5220402, 0x5230402, 0x5240402, 0x5250402, 0x5260402, 0x5270402, 0x5280402, 0x56C0402, 0x56D0402, 0x56E0402, 0x56F0402,
+		0x1D620402, 0x1D630402, 0x1D640402, 0x1D650402, 0x1D660402, 0x1D670402, 0x5700402, 0x5710402, 0x5720402, 0x4540402, 0x4550402, 0x29C0402, 0x29D0402, 0x2980402, 0x2990402, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x4010402, 0x4020402, 0x4030402, 0x4040402, 0x45A0402, 0x2A00402, 0x2E10402, 0x2D60402, 0x2D70402, 0x2D80402, 0x2D90402, 0x2DA0402, 0x2DB0402, 0x2620402, 0x2AB0402,
+		0x2AC0402, 0x2DC0402, 0x2DD0402, 0x2DE0402, 0x2DF0402, 0x45B0402, 0x45C0402, 0x45D0402, 0x2AD0402, 0x2AE0402, 0x2AF0402, 0x59B0402, 0x59C0402, 0x59D0402, 0x40E0402, 0x40F0402,
+		0x2B00402, 0x2790402, 0x5290402, 0x2B10402, 0x6660405, 0x5360402, 0x5370402, 0x5380402, 0x5390402, 0x53A0402, 0x53B0402, 0x53C0402, 0x53D0402, 0x53E0402, 0x53F0402, 0x5400402,
+		0x5410402, 0x5420402, 0x5430402, 0x5440402, 0x5450402, 0x3920402, 0x3930402, 0x5350402, 0x2230416, 0x2360416, 0x2920416, 0x2400416, 0x23A0416, 0x2670416, 0x26D0416, 0x38C0416,
+		0x38D0416, 0x27E0416, 0x27E0416, 0x27E0416, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x27E0416,
+		0x27E0416, 0x2160416, 0x2150416, 0x20B0416, 0x20B0416, 0x3280416, 0x3290416, 0x32C0416, 0x32D0416, 0x38A0416, 0x38B0416, 0x3880416, 0x3890416, 0x3820416, 0x3830416, 0x3800416,
+		0x3810416, 0x3840416, 0x3850416, 0x3860416, 0x3870416, 0x2370402, 0x2380402, 0x32A0416, 0x32B0416, 0x20A0404, 0x20A0404, 0x20A0404, 0x20A0404, 0x20B0404, 0x20B0404, 0x20B0404,
+		0x223040F, 0x236040F, 0x27E040F, 0x23A040F, 0x240040F, 0x26D040F, 0x267040F, 0x216040F, 0x328040F, 0x329040F, 0x32C040F, 0x32D040F, 0x38A040F, 0x38B040F, 0x3AC040F, 0x3A9040F,
+		0x3A1040F, 0x666040F, 0x20D040F, 0x66A040F, 0x66C040F, 0x66B040F, 0x3A7040F, 0x3AD040F, 0x3A0040F, 0, 0x2670403, 0x31D0403, 0x3AC0403, 0x3AD0403, 0x3A90403, 0x3160403,
+		0x3280403, 0x3290403, 0x3A10403, 0x6660403, 0x2230403, 0x20D0403, 0x27E0403, 0x3A60403, 0x2400403, 0x23A0403, 0x66A0403, 0x66B0403, 0x66C0403, 0x26D0403, 0x3A00403, 0x32A0403,
+		0x3A70403, 0x32B0403, 0x4B70403, 0x20B0403, 0x4B40403, 0x32C0403, 0x66E0403, 0x32D0403, 0x6700403, 0x33E0403, 0x33F0403, 0x2920412, 0x3840412, 0x3850412, 0x2360412, 0x2210412,
+		0x66D0403, 0x4B80403, 0x66F0403, 0x86B0412, 0x5EC0412, 0x5EE0412, 0x5ED0412, 0x5EF0412, 0x9090412, 0x9340412, 0x1D550402, 0x30B0402, 0x30C0402, 0x30D0402, 0x1DBE0402, 0x1DBF0402,
+		0x1DC00402, 0x1DC10402, 0x1DC20402, 0x1DC30402, 0x1DC40402, 0x1DC50402, 0x1DC60402, 0x1DC70402, 0x1DC80402, 0x1DC90402, 0x1DCA0402, 0x1DCB0402, 0x1DCC0402, 0x1DCD0402, 0x1DCE0402, 0x1DCF0402,
+		0x1DD00402, 0x1DD10402, 0x1DD20402, 0x1DD30402, 0x1DD40402, 0x1DD50402, 0x1DD60402, 0x1DD70402, 0x1DD80402, 0x1DD90402, 0x1DDA0402, 0x1DDB0402, 0x1DDC0402, 0x1DDD0402, 0x1DDE0402, 0x1DDF0402,
+		0x1DE00402, 0x1DE10402, 0x10C40402, 0x10C50402, 0x10C60402, 0x10C70402, 0x10C80402, 0x10C90402, 0x10CA0402, 0x10CB0402, 0x10CC0402, 0x1DE20402, 0x1DE30402, 0x1DE40402, 0x1DE50402, 0x1DE60402,
+		0x1DE70402, 0x1DE80402, 0x1DE90402, 0x1DEA0402, 0x1DEB0402, 0x1DEC0402, 0x1DED0402, 0x1DEE0402, 0x1DEF0402, 0x1DF00402, 0x1DF10402, 0x1DF20402, 0x1DF30402, 0x1DF40402, 0x1DF50402, 0x1DF60402,
+		0x1DF70402, 0x1DF80402, 0x1DF90402, 0x1DFA0402, 0x1DFB0402, 0x1DFC0402, 0x1DFD0402, 0x1DFE0402, 0x1DFF0402, 0x1E000402, 0x1E010402, 0x1E020402, 0x1E030402, 0x1E040402, 0x1E050402, 0x1E060402,
+		0x1E070402, 0x1E080402, 0x1E090402, 0x1E0A0402, 0x1E0B0402, 0x1E0C0402, 0x1E0D0402, 0x10CD0402, 0x10CE0402, 0x10CF0402, 0x10D00402, 0x10D10402, 0x10D20402, 0x10D30402, 0x10D40402, 0x10D50402,
+		0x10D60402, 0x10D70402, 0x10D80402, 0x10D90402, 0x10DA0402, 0x10DB0402, 0x10DC0402, 0x10DD0402, 0x1E0E0402, 0x10DE0402, 0x10DF0402, 0x10E00402, 0x10E10402, 0x10E20402, 0x10E30402, 0x10E40402,
+		0x10E50402, 0x10E60402, 0x10E70402, 0x10E80402, 0x10E90402, 0x10EA0402, 0x10EB0402, 0x10EC0402, 0x10EE0402, 0x10EF0402, 0x10F00402, 0x10F10402, 0x10F20402, 0x10F30402, 0x10F40402, 0x10F50402,
+		0x10F60402, 0x10F70402, 0x10F80402, 0x10F90402, 0x10FA0402, 0x10FB0402, 0x10FC0402, 0x10FD0402, 0x10FE0402, 0x10FF0402, 0x11000402, 0x11010402, 0x11020402, 0x11030402, 0x11040402, 0x11050402,
+		0x11060402, 0x11070402, 0x11080402, 0x11090402, 0x110A0402, 0x110B0402, 0x110C0402, 0x110D0402, 0x110E0402, 0x110F0402, 0x11100402, 0x11110402, 0x11120402, 0x11130402, 0x11140402, 0x11150402,
+		0x11160402, 0x11170402, 0x11180402, 0x11190402, 0x111A0402, 0x111B0402, 0, 0x1E0F0402, 0x1E100402, 0x1E110402, 0x1E120402, 0x1E130402, 0x1E140402, 0x1E150402, 0x1E160402, 0x1E170402,
+		0x1E180402, 0x1E190402, 0x1E1A0402, 0x1E1B0402, 0x1E1C0402, 0x1E1D0402, 0x1E1E0402, 0x1E1F0402, 0x1E200402, 0x1DB80402, 0x1DB90402, 0x30E0402, 0x30F0402, 0x1E210402, 0x1E220402, 0x1E230402,
+		0x45E0402, 0x2E20402, 0x1E330402, 0x1E340402, 0x1E350402, 0x1E360402, 0x1E370402, 0x111C0402, 0x111D0402, 0x1E240402, 0x1E250402, 0x1E260402, 0x1E270402, 0x1E280402, 0x1E290402, 0x1E2A0402,
+		0x1E2B0402, 0x1E300402, 0x1E310402, 0x1E320402, 0x3100402, 0x30A0402, 0x1F2B0402, 0x1F200402, 0x1EF50402, 0x1EF60402, 0x1EF70402, 0x1EF80402, 0x1EF90402, 0x1EFA0402, 0x1EFB0402, 0x1EFC0402,
+		0x1EFD0402, 0x1EFE0402, 0x1EFF0402, 0x1F000402, 0x1F010402, 0x1F020402, 0x1F030402, 0x1F040402, 0x1F050402, 0x1F060402, 0x1F070402, 0x1F080402, 0x1F090402, 0x1F0A0402, 0x1F0B0402, 0x1F0C0402,
+		0x1F0D0402, 0x1F0E0402, 0x1F0F0402, 0x1F100402, 0x1F110402, 0x1F120402, 0x1F130402, 0x1F140402, 0x1F150402, 0x1F160402, 0x1F170402, 0x1F180402, 0x1F190402, 0x1F1A0402, 0x1F1B0402, 0x1F1C0402,
+		0x1F1D0402, 0x1F1E0402, 0x1F1F0402, 0x1F210402, 0x1F220402, 0x1F230402, 0x1F240402, 0x1F250402, 0x1F260402, 0x1F270402, 0x1F280402, 0x1F290402, 0x1F2A0402, 0x1E5E0402, 0x1E5F0402, 0x1E600402,
+		0x1E610402, 0x4640402, 0x4650402, 0x4660402, 0x4670402, 0x4680402, 0x4690402, 0x2B20402, 0x2B30402, 0x46A0402, 0x1E2C0402, 0x1E2D0402, 0x1E2E0402, 0x1E2F0402, 0x1E380402, 0x1E390402,
+		0x1E3A0402, 0x46F0402, 0x4700402, 0x4710402, 0x4720402, 0x4730402, 0x4740402, 0x4750402, 0x46E0402, 0x2E30402, 0x2E40402, 0x2E50402, 0x2E60402, 0x2E70402, 0x2E80402, 0x1E3B0402,
+		0x1E3C0402, 0x1E3D0402, 0x1E3E0402, 0x1E3F0402, 0x1E400402, 0x1E410402, 0x1E420402, 0x4760402, 0x4770402, 0x4780402, 0x4790402, 0x1E430402, 0x1E440402, 0x1E450402, 0x1DBA0402, 0x1DBB0402,
+		0x1DBC0402, 0x1DBD0402, 0x1DA10402, 0x1DA20402, 0x1DA30402, 0x1DA40402, 0x1DA50402, 0x1DA60402, 0x1DA70402, 0x1DA80402, 0x1DA90402, 0x1DAA0402, 0x1DAB0402, 0x1DAC0402, 0x1DAD0402, 0x1DAE0402,
+		0x1DAF0402, 0x1DB00402, 0x1DB10402, 0x1DB20402, 0x1DB30402, 0x1DB40402, 0x1DB50402, 0x1DB60402, 0x2B40402, 0x2B50402, 0x45F0402, 0x4600402, 0x4610402, 0x4620402, 0x4630402, 0x1E530402,
+		0x1E540402, 0x1E550402, 0x1E560402, 0x1E570402, 0x1E580402, 0x1E590402, 0x1E5A0402, 0x1E5B0402, 0x1E5C0402, 0x1E5D0402, 0x47A0402, 0x47B0402, 0, 0x2EE0402, 0x2EF0402, 0x2B60402,
+		0x2B70402, 0x2F00402, 0x2B80402, 0x2B90402, 0x27A0402, 0x47C0402, 0x47D0402, 0x2BA0402, 0x2BB0402, 0x47F0402, 0x2F10402, 0x47E0402, 0x4800402, 0x4810402, 0x2F20402, 0x2F30402,
+		0x1E6D0402, 0x1E6E0402, 0x1E6F0402, 0x1E700402, 0x1E710402, 0x1E720402, 0x1E730402, 0x1E740402, 0x1E750402, 0x1E760402, 0x1E770402, 0x2BC0402, 0x2BD0402, 0x4820402, 0x4830402, 0x4840402,
+		0x4850402, 0x2F40402, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x2BE0402, 0x2BF0402,
+		0x4860402, 0x4880402, 0x4890402, 0x48A0402, 0x48B0402, 0x48C0402, 0x48D0402, 0x2C00402, 0x2C10402, 0x48E0402, 0x48F0402, 0x4900402, 0x4910402, 0x4920402, 0x4930402, 0x4940402,
+		0x4950402, 0x4960402, 0x4970402, 0x4980402, 0x4990402, 0x49A0402, 0x49B0402, 0x49C0402, 0x49D0402, 0x49E0402, 0x49F0402, 0x4A00402, 0x4A10402, 0x2C20402, 0x2C30402, 0x4A20402,
+		0x3F30402, 0x3F40402, 0x3F50402, 0x3F60402, 0x3F70402, 0x3F80402, 0x3F90402, 0x3FA0402, 0x3FB0402, 0x3FC0402, 0x3FD0402, 0x3FE0402, 0x3FF0402, 0x1E510402, 0x1E520402, 0x2C40402,
+		0x2C50402, 0x2F50402, 0x59E0402, 0x1E780402, 0x1E790402, 0x1E7A0402, 0x1E7B0402, 0x1E7C0402, 0x1E7D0402, 0x1E7E0402, 0x1E7F0402, 0x1E800402, 0x2C70402, 0x2C80402, 0x46B0402, 0x46C0402,
+		0x46D0402, 0x1E630402, 0x1E640402, 0x1E650402, 0x1E660402, 0x1E670402, 0x1E680402, 0x1E690402, 0x1E6A0402, 0x1E6B0402, 0x1E6C0402, 0x4360402, 0x4370402, 0x1F2C0402, 0x1F2D0402, 0x1F2E0402,
+		0x1F2F0402, 0x1F300402, 0x1F310402, 0x1F320402, 0x1F330402, 0x1F340402, 0x1F350402, 0x1F360402, 0x1F370402, 0x1F380402, 0x1F390402, 0x1F3A0402, 0x1F3B0402, 0x1F3C0402, 0x3110402, 0x3120402,
+		0x3130402, 0x3140402, 0x3150402, 0x2C90402, 0x2CA0402, 0x28F0402, 0x4A70402, 0x4A80402, 0x4A90402, 0x4AA0402, 0x4AB0402, 0x111E0402, 0x111F0402, 0x11200402, 0x11210402, 0x4AC0402,
+		0x11220402, 0x1E810402, 0x1E820402, 0x1E830402, 0x1E840402, 0x1E850402, 0x1E860402, 0x1E870402, 0x13140402, 0x2910402, 0x11240402, 0x11250402, 0x11260402, 0x11270402, 0x11280402, 0x11290402,
+		0x112A0402, 0x112B0402, 0x112C0402, 0x112D0402, 0x112E0402, 0x112F0402, 0x11300402, 0x11310402, 0x11320402, 0x11330402, 0x11340402, 0x11350402, 0x11360402, 0x11370402, 0x11380402, 0x11390402,
+		0x113A0402, 0x113B0402, 0x113C0402, 0x113D0402, 0x113E0402, 0x113F0402, 0x11400402, 0x11410402, 0x11420402, 0x11430402, 0x11440402, 0x11450402, 0x11460402, 0x11470402, 0x11480402, 0x11490402,
+		0x114A0402, 0x114B0402, 0x114C0402, 0x114D0402, 0x114E0402, 0x114F0402, 0x11500402, 0x11510402, 0x11520402, 0x11530402, 0x11540402, 0x11550402, 0x11560402, 0x11570402, 0x11580402, 0x11590402,
+		0x115A0402, 0x115B0402, 0x115C0402, 0x115D0402, 0x115E0402, 0x115F0402, 0x11600402, 0x11610402, 0x11620402, 0x11630402, 0x11640402, 0x11650402, 0x11660402, 0x11670402, 0x11680402, 0x11690402,
+		0x116A0402, 0x116B0402, 0x116C0402, 0x116D0402, 0x116E0402, 0x116F0402, 0x11700402, 0x11710402, 0x11720402, 0x11730402, 0x11740402, 0x11750402, 0x11760402, 0x11770402, 0x11780402, 0x11790402,
+		0x117A0402, 0x117B0402, 0x117C0402, 0x117D0402, 0x117E0402, 0x117F0402, 0x11800402, 0x11810402, 0x11820402, 0x11830402, 0x11840402, 0x11850402, 0x11860402, 0x11870402, 0x11880402, 0x11890402,
+		0x118A0402, 0x118B0402, 0x118C0402, 0x118D0402, 0x118E0402, 0x118F0402, 0x11900402, 0x11910402, 0x11920402, 0x11930402, 0x11940402, 0x11950402, 0x11960402, 0x11970402, 0x11980402, 0x11990402,
+		0x119A0402, 0x119B0402, 0x119C0402, 0x119D0402, 0x119E0402, 0x119F0402, 0x11A00402, 0x11A10402, 0x11A20402, 0x11A30402, 0x11A40402, 0x11A50402, 0x11A60402, 0x11A70402, 0x11A80402, 0x11A90402,
+		0x11AA0402, 0x11AB0402, 0x11AC0402, 0x11AD0402, 0x11AE0402, 0x11AF0402, 0x11B00402, 0x11B10402, 0x11B20402, 0x11B30402, 0x11B40402, 0x11B50402, 0x11B60402, 0x11B70402, 0x11B80402, 0x11B90402,
+		0x11BA0402, 0x11BB0402, 0x11BC0402, 0x11BD0402, 0x11BE0402, 0x11BF0402, 0x11C00402, 0x11C10402, 0x11C20402, 0x11C30402, 0x11C40402, 0x11C50402, 0x11C60402, 0x11C70402, 0x11C80402, 0x11C90402,
+		0x11CA0402, 0x11CB0402, 0x11CC0402, 0x11CD0402, 0x11CE0402, 0x11CF0402, 0x11D00402, 0x11D10402, 0x11D20402, 0x11D30402, 0x11D40402, 0x11D50402, 0x11D60402, 0x11D70402, 0x11D80402, 0x11D90402,
+		0x11DA0402, 0x11DB0402, 0x11DC0402, 0x11DD0402, 0x11DE0402, 0x11DF0402, 0x11E00402, 0x11E10402, 0x11E20402, 0x11E30402, 0x11E40402, 0x11E50402, 0x11E60402, 0x11E70402, 0x11E80402, 0x11E90402,
+		0x11EA0402, 0x11EB0402, 0x11EC0402, 0x11ED0402, 0x11EE0402, 0x11EF0402, 0x11F00402, 0x11F10402, 0x11F20402, 0x11F30402, 0x11F40402, 0x11F50402, 0x11F60402, 0x11F70402, 0x11F80402, 0x11F90402,
+		0x11FA0402, 0x11FB0402, 0x11FC0402, 0x11FD0402, 0x11FE0402, 0x11FF0402, 0x12000402, 0x12010402, 0x12020402, 0x12030402, 0x12040402, 0x12050402, 0x12060402, 0x12070402, 0x12080402, 0x12090402,
+		0x120A0402, 0x120B0402, 0x120C0402, 0x120D0402, 0x120E0402, 0x120F0402, 0x12100402, 0x12110402, 0x12120402, 0x12130402, 0x12140402, 0x12150402, 0x12160402, 0x12170402, 0x12180402, 0x12190402,
+		0x121A0402, 0x121B0402, 0x121C0402, 0x121D0402, 0x121E0402, 0x121F0402, 0x12200402, 0x12210402, 0x12220402, 0x12230402, 0x12240402, 0x12250402, 0x12260402, 0x12270402, 0x12280402, 0x12290402,
+		0x122A0402, 0x122B0402, 0x122C0402, 0x122D0402, 0x122E0402, 0x122F0402, 0x12300402, 0x12310402, 0x12320402, 0x12330402, 0x12340402, 0x12350402, 0x12360402, 0x12370402, 0x12380402, 0x12390402,
+		0x123A0402, 0x123B0402, 0x123C0402, 0x123D0402, 0x123E0402, 0x123F0402, 0x12400402, 0x12540402, 0x12440402, 0x12450402, 0x12460402, 0x12470402, 0x12480402, 0x12490402, 0x124A0402, 0x124B0402,
+		0x124C0402, 0x124D0402, 0x124E0402, 0x124F0402, 0x12500402, 0x12510402, 0x12520402, 0x12530402, 0x12550402, 0x12560402, 0x12570402, 0x12580402, 0x12590402, 0x125A0402, 0x125B0402, 0x125C0402,
+		0x125D0402, 0x125E0402, 0x125F0402, 0x12600402, 0x12610402, 0x12620402, 0x12630402, 0x12640402, 0x12650402, 0x12660402, 0x12670402, 0x12680402, 0x12690402, 0x126A0402, 0x126B0402, 0x126C0402,
+		0x126D0402, 0x126E0402, 0x126F0402, 0x12700402, 0x12710402, 0x12720402, 0x12730402, 0x12740402, 0x12750402, 0x12760402, 0x12770402, 0x12780402, 0x12720402, 0, 0x12730402, 0,
+		0x12730402, 0, 0, 0x12730402, 0, 0, 0x12730402, 0, 0, 0x12730402, 0, 0, 0x12730402, 0, 0, 0,
+		0, 0, 0, 0, 0x12790402, 0x127A0402, 0x127B0402, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0x127C0402, 0x127D0402, 0, 0, 0, 0, 0, 0, 0, 0x127E0402, 0x127F0402,
+		0x12800402, 0x12810402, 0x12820402, 0x12830402, 0x12840402, 0x12850402, 0x12860402, 0x12870402, 0x12880402, 0x12890402, 0x128A0402, 0x128B0402, 0x128C0402, 0x128D0402, 0x128E0402, 0x128F0402,
+		0x12900402, 0x12910402, 0x12920402, 0x12930402, 0x12940402, 0x12950402, 0x12960402, 0x12970402, 0x12980402, 0x12990402, 0x129A0402, 0x129B0402, 0, 0, 0, 0,
+		0x129C0402, 0x129D0402, 0x129E0402, 0x129F0402, 0x12A00402, 0x12A10402, 0x12A20402, 0x12A30402, 0x12A40402, 0x12A50402, 0x12A60402, 0x12A70402, 0x12A80402, 0x12A70402, 0, 0x12A80402,
+		0, 0x12A70402, 0, 0, 0x12A80402, 0, 0, 0x12A70402, 0, 0, 0x12A80402, 0, 0, 0x12A90402, 0x12AA0402, 0x12AB0402,
+		0x12AC0402, 0x12AD0402, 0x12AE0402, 0x12AF0402, 0x12B00402, 0x12B10402, 0x12B20402, 0x12B30402, 0x12B40402, 0x12B50402, 0x12B60402, 0x12B70402, 0x12B80402, 0x12B90402, 0x12BA0402, 0x12BB0402,
+		0x12BC0402, 0x12BD0402, 0x12BE0402, 0x12BF0402, 0x12C00402, 0x12C10402, 0x12C20402, 0x12C30402, 0x12C40402, 0x12C50402, 0x12C60402, 0x12C70402, 0x12C80402, 0x12C90402, 0x12CA0402, 0x12CB0402,
+		0x12CC0402, 0x12CD0402, 0x12CE0402, 0x12CF0402, 0x12D00402, 0x12D10402, 0x12D20402, 0x12D30402, 0x12D40402, 0x12D50402, 0x12D60402, 0x12D70402, 0x12D80402, 0x12D90402, 0x12DA0402, 0x12DB0402,
+		0x12DC0402, 0x12DD0402, 0x12DE0402, 0x12DF0402, 0x12E00402, 0x12E10402, 0x12E20402, 0x12E30402, 0x12E40402, 0x12E50402, 0x12E60402, 0x12E70402, 0x12E80402, 0x12E90402, 0x12EA0402, 0x12EB0402,
+		0x12EC0402, 0x12ED0402, 0x12EE0402, 0x12EF0402, 0x12F00402, 0x12F10402, 0x12F20402, 0x12F30402, 0x12F40402, 0x12F50402, 0x12F60402, 0x12F70402, 0x12F80402, 0x12F90402, 0x12FA0402, 0x12FB0402,
+		0x12FC0402, 0x12FD0402, 0x12FE0402, 0x12FF0402, 0x13000402, 0x13010402, 0x13020402, 0x13030402, 0x13040402, 0x13050402, 0x13060402, 0x13070402, 0x13080402, 0x13090402, 0x130A0402, 0x130B0402,
+		0x130C0402, 0x130D0402, 0x130E0402, 0x130F0402, 0x13100402, 0x13110402, 0x13120402, 0, 0, 0, 0x13130402, 0x10360402, 0x10370402, 0x10380402, 0x10390402, 0x103A0402,
+		0x103B0402, 0x103C0402, 0x103D0402, 0x103E0402, 0x103F0402, 0x10400402, 0x10410402, 0x10420402, 0x10430402, 0x10440402, 0x10450402, 0x10460402, 0x10470402, 0x10480402, 0x10490402, 0x104A0402,
+		0x104B0402, 0x104C0402, 0x104D0402, 0x104E0402, 0x104F0402, 0x10500402, 0x10510402, 0x10520402, 0x10530402, 0x10540402, 0x10550402, 0x10560402, 0x10570402, 0x10580402, 0x10590402, 0x105A0402,
+		0x105B0402, 0x105C0402, 0x105D0402, 0x105E0402, 0x105F0402, 0x10600402, 0x10610402, 0x10620402, 0x10630402, 0x10640402, 0x10650402, 0x10660402, 0x10670402, 0x10680402, 0x10690402, 0x106A0402,
+		0x106B0402, 0x106C0402, 0x106D0402, 0x106E0402, 0x106F0402, 0x10700402, 0x10710402, 0x10720402, 0x10730402, 0x10740402, 0x10750402, 0x10760402, 0x10770402, 0x10780402, 0x10790402, 0x107A0402,
+		0x107B0402, 0x107C0402, 0x107D0402, 0x107E0402, 0x107F0402, 0x10800402, 0x10810402, 0x10820402, 0x10830402, 0x10840402, 0x10850402, 0x10860402, 0x10870402, 0x10880402, 0x10890402, 0x108A0402,
+		0x108B0402, 0x108C0402, 0x1F3D0402, 0x1F3E0402, 0x1F3F0402, 0x1F400402, 0x1F410402, 0x1F420402, 0x1F430402, 0x1F440402, 0x1F450402, 0x65C0405, 0x6580405, 0x65C0405, 0x6580405, 0x65C0405,
+		0x6580405, 0x65C0405, 0x6580405, 0x65C0405, 0x6580405, 0x1AFF0402, 0x1B000402, 0x1B010402, 0x1B020402, 0x1B030402, 0x1B040402, 0x1B050402, 0x1B060402, 0x1B070402, 0x1B080402, 0x1B090402,
+		0x1B0A0402, 0x1B0B0402, 0x1B0C0402, 0x1B0D0402, 0x1B0E0402, 0x1B0F0402, 0x1B100402, 0x1B110402, 0x1B120402, 0x1B130402, 0x1B140402, 0x1B150402, 0x1B160402, 0x1B170402, 0x1B180402, 0x1B190402,
+		0x1B1A0402, 0x1B1B0402, 0x1B1C0402, 0x1B1D0402, 0x1B1E0402, 0x1B1F0402, 0x1B200402, 0x1B210402, 0x1B220402, 0x1B230402, 0x1B240402, 0x1B250402, 0x1B260402, 0x1B270402, 0x1B280402, 0x1B290402,
+		0x1B2A0402, 0x1B2B0402, 0x1B2C0402, 0x1B2D0402, 0x1B2E0402, 0x1B2F0402, 0x1B300402, 0x1B310402, 0x1B320402, 0x1B330402, 0x1B340402, 0x1B350402, 0x1B360402, 0x1B370402, 0x1B380402, 0x1B390402,
+		0x1B3A0402, 0x1B3B0402, 0x1B3C0402, 0x1B3D0402, 0x1B3E0402, 0x1B3F0402, 0x1B400402, 0x1B410402, 0x1B420402, 0x1B430402, 0x1B440402, 0x1B450402, 0x1B460402, 0x1B470402, 0x1B480402, 0x1B490402,
+		0x1B4A0402, 0x1B4B0402, 0x1B4C0402, 0x1B4D0402, 0x1B4E0402, 0x1B4F0402, 0x1B500402, 0x1B510402, 0x1B520402, 0x1B530402, 0x1B540402, 0x1B550402, 0x1B560402, 0x1B570402, 0x1B580402, 0x1B590402,
+		0x1B5A0402, 0x1B5B0402, 0x1B5C0402, 0x1B5D0402, 0x1B5E0402, 0x1B5F0402, 0x1B600402, 0x1B610402, 0x1B620402, 0x1B630402, 0x1B640402, 0x1B650402, 0x1B660402, 0x1B670402, 0x1B680402, 0x1B690402,
+		0x1B6A0402, 0x1B6B0402, 0x1B6C0402, 0x1B6D0402, 0x1B6E0402, 0x1B6F0402, 0x1B700402, 0x1B710402, 0x1B720402, 0x1B730402, 0x1B740402, 0x1B750402, 0x1B760402, 0x1B770402, 0x1B780402, 0x1B790402,
+		0x1B7A0402, 0x1B7B0402, 0x1B7C0402, 0x1B7D0402, 0x1B7E0402, 0x1B7F0402, 0x1B800402, 0x1B810402, 0x1B820402, 0x1B830402, 0x1B840402, 0x1B850402, 0x1B860402, 0x1B870402, 0x1B880402, 0x1B890402,
+		0x1B8A0402, 0x1B8B0402, 0x1B8C0402, 0x1B8D0402, 0x1B8E0402, 0x1B8F0402, 0x1B900402, 0x1B910402, 0x1B920402, 0x1B930402, 0x1B940402, 0x1B950402, 0x1B960402, 0x1B970402, 0x1B980402, 0x1B990402,
+		0x1B9A0402, 0x1B9B0402, 0x1B9C0402, 0x1B9D0402, 0x1B9E0402, 0x1B9F0402, 0x1BA00402, 0x1BA10402, 0x1BA20402, 0x1BA30402, 0x1BA40402, 0x1BA50402, 0x1BA60402, 0x1BA70402, 0x1BA80402, 0x1BA90402,
+		0x1BAA0402, 0x1BAB0402, 0x1BAC0402, 0x1BAD0402, 0x1BAE0402, 0x1BAF0402, 0x1BB00402, 0x1BB10402, 0x1BB20402, 0x1BB30402, 0x1BB40402, 0x1BB50402, 0x1BB60402, 0x1BB70402, 0x1BB80402, 0x1BB90402,
+		0x1BBA0402, 0x1BBB0402, 0x1BBC0402, 0x1BBD0402, 0x1BBE0402, 0x1BBF0402, 0x1BC00402, 0x1BC10402, 0x1BC20402, 0x1BC30402, 0x1BC40402, 0x1BC50402, 0x1BC60402, 0x1BC70402, 0x1BC80402, 0x1BC90402,
+		0x1BCA0402, 0x1BCB0402, 0x1BCC0402, 0x1BCD0402, 0x1BCE0402, 0x1BCF0402, 0x1BD00402, 0x1BD10402, 0x1BD20402, 0x1BD30402, 0x1BD40402, 0x1BD50402, 0x1BD60402, 0x1BD70402, 0x1BD80402, 0x1BD90402,
+		0x1BDA0402, 0x1BDB0402, 0x1BDC0402, 0x1BDD0402, 0x1BDE0402, 0x1BDF0402, 0x1BE00402, 0x1BE10402, 0x1BE20402, 0x1BE30402, 0x1BE40402, 0x1BE50402, 0x1BE60402, 0x1BE70402, 0x1BE80402, 0x1BE90402,
+		0x1BEA0402, 0x1BEB0402, 0x1BEC0402, 0x1BED0402, 0x1BEE0402, 0x1BEF0402, 0x1BF00402, 0x1BF10402, 0x1BF20402, 0x1BF30402, 0x1BF40402, 0x1BF50402, 0x1BF60402, 0x1BF70402, 0x1BF80402, 0x1BF90402,
+		0x1BFA0402, 0x1BFB0402, 0x1BFC0402, 0x1BFD0402, 0x1BFE0402, 0x1BFF0402, 0x1C000402, 0x1C010402, 0x1C020402, 0x1C030402, 0x1C040402, 0x1C050402, 0x1C060402, 0x1C070402, 0x1C080402, 0x1C090402,
+		0x1C0A0402, 0x1C0B0402, 0x1C0C0402, 0x1C0D0402, 0x1C0E0402, 0x1C0F0402, 0x1C100402, 0x1C110402, 0x1C120402, 0x1C130402, 0x1C140402, 0x1C150402, 0x1C160402, 0x1C170402, 0x1C180402, 0x1C190402,
+		0x1C1A0402, 0x1C1B0402, 0x1C1C0402, 0x1C1D0402, 0x1C1E0402, 0x1C1F0402, 0x1C200402, 0x1C210402, 0x1C220402, 0x1C230402, 0x1C240402, 0x1C250402, 0x1C260402, 0x1C270402, 0x1C280402, 0x1C290402,
+		0x1C2A0402, 0x1C2B0402, 0x1C2C0402, 0x1C2D0402, 0x1C2E0402, 0x1C2F0402, 0x1C300402, 0x1C310402, 0x1C320402, 0x1C330402, 0x1C340402, 0x1C350402, 0x1C360402, 0x1C370402, 0x1C380402, 0x1C390402,
+		0x1C3A0402, 0x1C3B0402, 0x1C3C0402, 0x1C3D0402, 0x1C3E0402, 0x1C3F0402, 0x1C400402, 0x1C410402, 0x1C420402, 0x1C430402, 0x1C440402, 0x1C450402, 0x1C460402, 0x1C470402, 0x1C480402, 0x1C490402,
+		0x1C4A0402, 0x1C4B0402, 0x1C4C0402, 0x1C4D0402, 0x1C4E0402, 0x1C4F0402, 0x1C500402, 0x1C510402, 0x1C520402, 0x1C530402, 0x1C540402, 0x1C550402, 0x1C560402, 0x1C570402, 0x1C580402, 0x1C590402,
+		0x1C5A0402, 0x1C5B0402, 0x1C5C0402, 0x1C5D0402, 0x1C5E0402, 0x1C5F0402, 0x1C600402, 0x1C610402, 0x1C620402, 0x1C630402, 0x1C640402, 0x1C650402, 0x1C660402, 0x1C670402, 0x1C680402, 0x1C690402,
+		0x1C6A0402, 0x1C6B0402, 0x1C6C0402, 0x1C6D0402, 0x1C6E0402, 0x1C6F0402, 0x1C700402, 0x1C710402, 0x1C720402, 0x1C730402, 0x1C740402, 0x1C750402, 0x1C760402, 0x1C770402, 0x1C780402, 0x1C790402,
+		0x1C7A0402, 0x1C7B0402, 0x1C7C0402, 0x1C7D0402, 0x1C7E0402, 0x1C7F0402, 0x1C800402, 0x1C810402, 0x1C820402, 0x1C830402, 0x1C840402, 0x1C850402, 0x1C860402, 0x1C870402, 0x1C880402, 0x1C890402,
+		0x1C8A0402, 0x1C8B0402, 0x1C8C0402, 0x1C8D0402, 0x1C8E0402, 0x1C8F0402, 0x1C900402, 0x1C910402, 0x1C920402, 0x1C930402, 0x1C940402, 0x1C950402, 0x1C960402, 0x1C970402, 0x1C980402, 0x1C990402,
+		0x1C9A0402, 0x1C9B0402, 0x1C9C0402, 0x1C9D0402, 0x1C9E0402, 0x1C9F0402, 0x1CA00402, 0x1CA10402, 0x1CA20402, 0x1CA30402, 0x1CA40402, 0x1CA50402, 0x1CA60402, 0x1CA70402, 0x1CA80402, 0x1CA90402,
+		0x1CAA0402, 0x1CAB0402, 0x1CAC0402, 0x1CAD0402, 0x1CAE0402, 0x1CAF0402, 0x1CB00402, 0x1CB10402, 0x1CB20402, 0x1CB30402, 0x1CB40402, 0x1CB50402, 0x1CB60402, 0x1CB70402, 0x1CB80402, 0x1CB90402,
+		0x1CBA0402, 0x1CBB0402, 0x1CBC0402, 0x1CBD0402, 0x1CBE0402, 0x1CBF0402, 0x1CC00402, 0x1CC10402, 0x1CC20402, 0x1CC30402, 0x1CC40402, 0x1CC50402, 0x1CC60402, 0x1CC70402, 0x1CC80402, 0x1CC90402,
+		0x1CCA0402, 0x1CCB0402, 0x1CCC0402, 0x1CCD0402, 0x1CCE0402, 0x1CCF0402, 0x1CD00402, 0x1CD10402, 0x1CD20402, 0x1CD30402, 0x1CD40402, 0x1CD50402, 0x1CD60402, 0x1CD70402, 0x1CD80402, 0x1CD90402,
+		0x1CDA0402, 0x1CDB0402, 0x1CDC0402, 0x1CDD0402, 0x1CDE0402, 0x1CDF0402, 0x1CE00402, 0x1CE10402, 0x1CE20402, 0x1CE30402, 0x1CE40402, 0x1CE50402, 0x1CE60402, 0x1CE70402, 0x1CE80402, 0x1CE90402,
+		0x1CEA0402, 0x1CEB0402, 0x1CEC0402, 0x1CED0402, 0x1CEE0402, 0x1CEF0402, 0x1CF00402, 0x1CF10402, 0x1CF20402, 0x1CF30402, 0x1CF40402, 0x1CF50402, 0x1CF60402, 0x1CF70402, 0x1CF80402, 0x1CF90402,
+		0x1CFA0402, 0x1CFB0402, 0x1CFC0402, 0x1CFD0402, 0x1CFE0402, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1CFF0402, 0x1D000402, 0x1D010402, 0x1D020402,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0x1D030402, 0x1D040402, 0x1D050402, 0x1D060402, 0x1D070402, 0x1D080402, 0x1D090402, 0x1D0A0402, 0, 0x1D0B0402, 0x1D0C0402, 0x1D0D0402, 0x1D0E0402, 0x1D0F0402,
+		0x1D100402, 0x1D110402, 0x1D120402, 0x1D130402, 0x1D140402, 0x1D150402, 0x1D160402, 0x1D170402, 0x1D180402, 0, 0x1D190402, 0x1D1A0402, 0x4AF0402, 0x4B00402, 0x4B10402, 0x4B20402,
+		0x4B30402, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x26C0402, 0x27B0402, 0x52F0402, 0x5300402,
+		0x13170402, 0x13180402, 0x13190402, 0x131A0402, 0x131B0402, 0x131C0402, 0x131D0402, 0x131E0402, 0x131F0402, 0x13200402, 0x13210402, 0x13220402, 0x13230402, 0x13240402, 0x13250402, 0x13260402,
+		0x13270402, 0x13280402, 0x13290402, 0x132A0402, 0x132B0402, 0x132C0402, 0x132D0402, 0x132E0402, 0x132F0402, 0x13300402, 0x13310402, 0x13320402, 0x13330402, 0x13340402, 0x13350402, 0x13360402,
+		0x13370402, 0x13380402, 0x13390402, 0x133A0402, 0x133B0402, 0x133C0402, 0x133D0402, 0x133E0402, 0x133F0402, 0x13400402, 0x13410402, 0x13420402, 0x13430402, 0x13440402, 0x13450402, 0x13460402,
+		0x13470402, 0x13480402, 0x13490402, 0x134A0402, 0x134B0402, 0x134C0402, 0x134D0402, 0x134E0402, 0x134F0402, 0x13500402, 0x13510402, 0x13520402, 0x13530402, 0x13540402, 0x13550402, 0x13560402,
+		0x13570402, 0x13580402, 0x13590402, 0x135A0402, 0x135B0402, 0x135C0402, 0x135D0402, 0x135E0402, 0x135F0402, 0x13600402, 0x13610402, 0x13620402, 0x13630402, 0x13640402, 0x13650402, 0x13660402,
+		0x13670402, 0x13680402, 0x13690402, 0x136A0402, 0x136B0402, 0x136C0402, 0x136D0402, 0x136E0402, 0x136F0402, 0x13700402, 0x13710402, 0x13720402, 0x13730402, 0x13740402, 0x13750402, 0x13760402,
+		0x13770402, 0x13780402, 0x13790402, 0x137A0402, 0x137B0402, 0x137C0402, 0x137D0402, 0x137E0402, 0x137F0402, 0x13800402, 0x13810402, 0x13820402, 0x13830402, 0x13840402, 0x13850402, 0x13860402,
+		0x13870402, 0x13880402, 0x13890402, 0x138A0402, 0x138B0402, 0x138C0402, 0x138D0402, 0x138E0402, 0x138F0402, 0x13900402, 0x13910402, 0x13920402, 0x13930402, 0x13940402, 0x13950402, 0x13960402,
+		0x13970402, 0x13980402, 0x13990402, 0x139A0402, 0x139B0402, 0x139C0402, 0x139D0402, 0x139E0402, 0x139F0402, 0x13A00402, 0x13A10402, 0x13A20402, 0x13A30402, 0x13A40402, 0x13A50402, 0x13A60402,
+		0x13A70402, 0x13A80402, 0x13A90402, 0x13AA0402, 0x13AB0402, 0x13AC0402, 0x13AD0402, 0x13AE0402, 0x13AF0402, 0x13B00402, 0x13B10402, 0x13B20402, 0x13B30402, 0x13B40402, 0x13B50402, 0x13B60402,
+		0x13B70402, 0x13B80402, 0x13B90402, 0x13BA0402, 0x13BB0402, 0x13BC0402, 0x13BD0402, 0x13BE0402, 0x13BF0402, 0x13C00402, 0x13C10402, 0x13C20402, 0x13C30402, 0x13C40402, 0x13C50402, 0x13C60402,
+		0x13C70402, 0x13C80402, 0x13C90402, 0x13CA0402, 0x13CB0402, 0x13CC0402, 0x13CD0402, 0x13CE0402, 0x13CF0402, 0x13D00402, 0x13D10402, 0x13D20402, 0x13D30402, 0x13D40402, 0x13D50402, 0x13D60402,
+		0x13D70402, 0x13D80402, 0x13D90402, 0x13DA0402, 0x13DB0402, 0x13DC0402, 0x13DD0402, 0x13DE0402, 0x13DF0402, 0x13E00402, 0x13E10402, 0x13E20402, 0x13E30402, 0x13E40402, 0x13E50402, 0x13E60402,
+		0x13E70402, 0x13E80402, 0x13E90402, 0x13EA0402, 0x13EB0402, 0x13EC0402, 0x13ED0402, 0x13EE0402, 0x13EF0402, 0x13F00402, 0x13F10402, 0x13F20402, 0x13F30402, 0x13F40402, 0x13F50402, 0x13F60402,
+		0x13F70402, 0x13F80402, 0xB220402, 0xB230402, 0xB240402, 0xB250402, 0xB260402, 0xB270402, 0xB280402, 0xB290402, 0xB2A0402, 0xB2B0402, 0xB2C0402, 0xB2D0402, 0xB2E0402, 0xB2F0402,
+		0xB300402, 0xB310402, 0xB320402, 0xB330402, 0xB340402, 0xB350402, 0xB360402, 0xB370402, 0xB380402, 0xB390402, 0xB3A0402, 0xB3B0402, 0x14670402, 0x14680402, 0x14690402, 0x146A0402,
+		0x146B0402, 0x146C0402, 0x146D0402, 0x146E0402, 0x146F0402, 0x14700402, 0x14710402, 0x14720402, 0x14730402, 0x14740402, 0x14750402, 0x14760402, 0x14770402, 0x14780402, 0x14790402, 0x147A0402,
+		0x147B0402, 0x147C0402, 0x147D0402, 0x147E0402, 0x147F0402, 0x14800402, 0x14810402, 0x14820402, 0x14830402, 0x14840402, 0x14850402, 0x14860402, 0x14870402, 0x14880402, 0x14890402, 0x148A0402,
+		0x148B0402, 0x148C0402, 0x148D0402, 0x148E0402, 0x148F0402, 0x14900402, 0x14910402, 0x14920402, 0x14930402, 0x14940402, 0x14950402, 0x14960402, 0x14970402, 0x14980402, 0x14990402, 0x149A0402,
+		0x149B0402, 0x149C0402, 0x149D0402, 0x149E0402, 0x149F0402, 0x14A00402, 0x14A10402, 0x14A20402, 0x14A30402, 0x14A40402, 0x14A50402, 0x14A60402, 0x14A70402, 0x14A80402, 0x14A90402, 0x14AA0402,
+		0x14AB0402, 0x14AC0402, 0x14AD0402, 0x14AE0402, 0x14AF0402, 0x14B00402, 0x14B10402, 0x14B20402, 0x14B30402, 0x14B40402, 0x14B50402, 0x14B60402, 0x14B70402, 0x14B80402, 0x14B90402, 0x14BA0402,
+		0x14BB0402, 0x14BC0402, 0x14BD0402, 0x14BE0402, 0x14BF0402, 0x14C00402, 0x14C10402, 0x14C20402, 0x14C30402, 0x14C40402, 0x14C50402, 0x14C60402, 0x14C70402, 0x14C80402, 0x14C90402, 0x14CA0402,
+		0x14CB0402, 0x14CC0402, 0x14CD0402, 0x14CE0402, 0x14CF0402, 0x14D00402, 0x14D10402, 0x14D20402, 0x14D30402, 0x14D40402, 0x14D50402, 0x14D60402, 0x14D70402, 0x14D80402, 0x14D90402, 0x14DA0402,
+		0x14DB0402, 0x14DC0402, 0x14DD0402, 0x14DE0402, 0x14DF0402, 0x14E00402, 0x14E10402, 0x14E20402, 0x14E30402, 0x14E40402, 0x14E50402, 0x14E60402, 0x14E70402, 0x14E80402, 0x14E90402, 0x14EA0402,
+		0x14EB0402, 0x14EC0402, 0x14ED0402, 0x14EE0402, 0x14EF0402, 0x14F00402, 0x14F10402, 0x14F20402, 0x14F30402, 0x14F40402, 0x14F50402, 0x14F60402, 0x14F70402, 0x14F80402, 0x14F90402, 0x14FA0402,
+		0x14FB0402, 0x14FC0402, 0x14FD0402, 0x14FE0402, 0x14FF0402, 0x15000402, 0x15010402, 0x15020402, 0x15030402, 0x15040402, 0x15050402, 0x15060402, 0x15070402, 0x15080402, 0x15090402, 0x150A0402,
+		0x150B0402, 0x150C0402, 0x150D0402, 0x150E0402, 0x150F0402, 0x15100402, 0x15110402, 0x15120402, 0x15130402, 0x15140402, 0x15150402, 0x15160402, 0x15170402, 0x15180402, 0x15190402, 0x151A0402,
+		0x151B0402, 0x151C0402, 0x151D0402, 0x151E0402, 0x151F0402, 0x15200402, 0x15210402, 0x15220402, 0x15230402, 0x15240402, 0x15250402, 0x15260402, 0x15270402, 0x15280402, 0x15290402, 0x152A0402,
+		0x152B0402, 0x152C0402, 0x152D0402, 0x152E0402, 0x152F0402, 0x15300402, 0x15310402, 0x15320402, 0x15330402, 0x15340402, 0x15350402, 0x15360402, 0x15370402, 0x15380402, 0x15390402, 0x153A0402,
+		0x153B0402, 0x153C0402, 0x153D0402, 0x153E0402, 0x153F0402, 0x15400402, 0x15410402, 0x15420402, 0x15430402, 0x15440402, 0x15450402, 0x15460402, 0x15470402, 0x15480402, 0x15490402, 0x154A0402,
+		0x154B0402, 0x154C0402, 0x154D0402, 0x154E0402, 0x154F0402, 0x15500402, 0x15510402, 0x15520402, 0x15530402, 0x15540402, 0x15550402, 0x15560402, 0x15570402, 0x15580402, 0x15590402, 0x155A0402,
+		0x155B0402, 0x155C0402, 0x155D0402, 0x155E0402, 0x155F0402, 0x15600402, 0x15610402, 0x15620402, 0x15630402, 0x15640402, 0x15650402, 0x15660402, 0x15670402, 0x15680402, 0x15690402, 0x156A0402,
+		0x156B0402, 0x156C0402, 0x156D0402, 0x156E0402, 0x156F0402, 0x15700402, 0x15710402, 0x15720402, 0x15730402, 0x15740402, 0x15750402, 0x15760402, 0x15770402, 0x15780402, 0x15790402, 0x157A0402,
+		0x157B0402, 0x157C0402, 0x157D0402, 0x157E0402, 0x157F0402, 0x15800402, 0x15810402, 0x15820402, 0x15830402, 0x15840402, 0x15850402, 0x15860402, 0x15870402, 0x15880402, 0x15890402, 0x158A0402,
+		0x158B0402, 0x158C0402, 0x158D0402, 0x158E0402, 0x158F0402, 0x15900402, 0x15910402, 0x15920402, 0x15930402, 0x15940402, 0x15950402, 0x15960402, 0x15970402, 0x15980402, 0x15990402, 0x159A0402,
+		0x159B0402, 0x159C0402, 0x159D0402, 0x159E0402, 0x159F0402, 0x15A00402, 0x15A10402, 0x15A20402, 0x15A30402, 0x15A40402, 0x15A50402, 0x15A60402, 0x15A70402, 0x15A80402, 0x15A90402, 0x15AA0402,
+		0x15AB0402, 0x15AC0402, 0x15AD0402, 0x15AE0402, 0x15AF0402, 0x15B00402, 0x15B10402, 0x15B20402, 0x15B30402, 0x15B40402, 0x15B50402, 0x15B60402, 0x15B70402, 0x15B80402, 0x15B90402, 0x15BA0402,
+		0x15BB0402, 0x15BC0402, 0x15BD0402, 0x15BE0402, 0x15BF0402, 0x15C00402, 0x15C10402, 0x15C20402, 0x15C30402, 0x15C40402, 0x15C50402, 0x15C60402, 0x15C70402, 0x15C80402, 0x15C90402, 0x15CA0402,
+		0x15CB0402, 0x15CC0402, 0x15CD0402, 0x15CE0402, 0x15CF0402, 0x15D00402, 0x15D10402, 0x15D20402, 0x15D30402, 0x15D40402, 0x15D50402, 0x15D60402, 0x15D70402, 0x15D80402, 0x15D90402, 0x15DA0402,
+		0x15DB0402, 0x15DC0402, 0x15DD0402, 0x15DE0402, 0x15DF0402, 0x15E00402, 0x15E10402, 0x15E20402, 0x15E30402, 0x15E40402, 0x15E50402, 0x15E60402, 0x15E70402, 0x15E80402, 0x15E90402, 0x15EA0402,
+		0x15EB0402, 0x15EC0402, 0x15ED0402, 0x15EE0402, 0x15EF0402, 0x15F00402, 0x15F10402, 0x15F20402, 0x15F30402, 0x15F40402, 0x15F50402, 0x15F60402, 0x15F70402, 0x15F80402, 0x15F90402, 0x15FA0402,
+		0x15FB0402, 0x15FC0402, 0x15FD0402, 0x15FE0402, 0x15FF0402, 0x16000402, 0x16010402, 0x16020402, 0x16030402, 0x16040402, 0x16050402, 0x16060402, 0x16070402, 0x16080402, 0x16090402, 0x160A0402,
+		0x160B0402, 0x160C0402, 0x160D0402, 0x160E0402, 0x160F0402, 0x16100402, 0x16110402, 0x16120402, 0x16130402, 0x16140402, 0x16150402, 0x16160402, 0x16170402, 0x16180402, 0x16190402, 0x161A0402,
+		0x161B0402, 0x161C0402, 0x161D0402, 0x161E0402, 0x161F0402, 0x16200402, 0x16210402, 0x16220402, 0x16230402, 0x16240402, 0x16250402, 0x16260402, 0x16270402, 0x16280402, 0x16290402, 0x162A0402,
+		0x162B0402, 0x162C0402, 0x162D0402, 0x162E0402, 0x162F0402, 0x16300402, 0x16310402, 0x16320402, 0x16330402, 0x16340402, 0x16350402, 0x16360402, 0x16370402, 0x16380402, 0x16390402, 0x163A0402,
+		0x163B0402, 0x163C0402, 0x163D0402, 0x163E0402, 0x163F0402, 0x16400402, 0x16410402, 0x16420402, 0x16430402, 0x16440402, 0x16450402, 0x16460402, 0x16470402, 0x16480402, 0x16490402, 0x164A0402,
+		0x164B0402, 0x164C0402, 0x164D0402, 0x164E0402, 0x164F0402, 0x16500402, 0x16510402, 0x16520402, 0x16530402, 0x16540402, 0x16550402, 0x16560402, 0x16570402, 0x16580402, 0x16590402, 0x165A0402,
+		0x165B0402, 0x165C0402, 0x165D0402, 0x165E0402, 0x165F0402, 0x16600402, 0x16610402, 0x16620402, 0x16630402, 0x16640402, 0x16650402, 0x16660402, 0x16670402, 0x16680402, 0x16690402, 0x166A0402,
+		0x166B0402, 0x166C0402, 0x166D0402, 0x166E0402, 0x166F0402, 0x16700402, 0x16710402, 0x16720402, 0x16730402, 0x16740402, 0x16750402, 0x16760402, 0x16770402, 0x16780402, 0x16790402, 0x167A0402,
+		0x167B0402, 0x167C0402, 0x167D0402, 0x167E0402, 0x167F0402, 0x16800402, 0x16810402, 0x16820402, 0x16830402, 0x16840402, 0x16850402, 0x16860402, 0x16870402, 0x16880402, 0x16890402, 0x168A0402,
+		0x168B0402, 0x168C0402, 0x168D0402, 0x168E0402, 0x168F0402, 0x16900402, 0x16910402, 0x16920402, 0x16930402, 0x16940402, 0x16950402, 0x16960402, 0x16970402, 0x16980402, 0x16990402, 0x169A0402,
+		0x169B0402, 0x169C0402, 0x169D0402, 0x169E0402, 0x169F0402, 0x16A00402, 0x16A10402, 0x16A20402, 0x16A30402, 0x16A40402, 0x16A50402, 0x16A60402, 0x16A70402, 0x16A80402, 0x16A90402, 0x16AA0402,
+		0x16AB0402, 0x16AC0402, 0x16AD0402, 0x16AE0402, 0x16AF0402, 0x16B00402, 0x16B10402, 0x16B20402, 0x16B30402, 0x16B40402, 0x16B50402, 0x16B60402, 0x16B70402, 0x16B80402, 0x16B90402, 0x16BA0402,
+		0x16BB0402, 0x16BC0402, 0x16BD0402, 0x16BE0402, 0x16BF0402, 0x16C00402, 0x16C10402, 0x16C20402, 0x16C30402, 0x16C40402, 0x16C50402, 0x16C60402, 0x16C70402, 0x16C80402, 0x16C90402, 0x16CA0402,
+		0x16CB0402, 0x16CC0402, 0x16CD0402, 0x16CE0402, 0x16CF0402, 0x16D00402, 0x16D10402, 0x16D20402, 0x16D30402, 0x16D40402, 0x16D50402, 0x16D60402, 0x16D70402, 0x16D80402, 0x16D90402, 0x16DA0402,
+		0x16DB0402, 0x16DC0402, 0x16DD0402, 0x16DE0402, 0x16DF0402, 0x16E00402, 0x16E10402, 0x16E20402, 0x16E30402, 0x16E40402, 0x16E50402, 0x16E60402, 0x16E70402, 0x16E80402, 0x16E90402, 0x16EA0402,
+		0x16EB0402, 0x16EC0402, 0x16ED0402, 0x16EE0402, 0x16EF0402, 0x16F00402, 0x16F10402, 0x16F20402, 0x16F30402, 0x16F40402, 0x16F50402, 0x16F60402, 0x16F70402, 0x16F80402, 0x16F90402, 0x16FA0402,
+		0x16FB0402, 0x16FC0402, 0x16FD0402, 0x16FE0402, 0x16FF0402, 0x17000402, 0x17010402, 0x17020402, 0x17030402, 0x17040402, 0x17050402, 0x17060402, 0x17070402, 0x17080402, 0x17090402, 0x170A0402,
+		0x170B0402, 0x170C0402, 0x170D0402, 0x170E0402, 0x170F0402, 0x17100402, 0x17110402, 0x17120402, 0x17130402, 0x17140402, 0x17150402, 0x17160402, 0x17170402, 0x17180402, 0x17190402, 0x171A0402,
+		0x171B0402, 0x171C0402, 0x171D0402, 0x171E0402, 0x171F0402, 0x17200402, 0x17210402, 0x17220402, 0x17230402, 0x17240402, 0x17250402, 0x17260402, 0x17270402, 0x17280402, 0x17290402, 0x172A0402,
+		0x172B0402, 0x172C0402, 0x172D0402, 0x172E0402, 0x172F0402, 0x17300402, 0x17310402, 0x17320402, 0x17330402, 0x17340402, 0x17350402, 0x17360402, 0x17370402, 0x17380402, 0x17390402, 0x173A0402,
+		0x173B0402, 0x173C0402, 0x173D0402, 0x173E0402, 0x173F0402, 0x17400402, 0x17410402, 0x17420402, 0x17430402, 0x17440402, 0x17450402, 0x17460402, 0x17470402, 0x17480402, 0x17490402, 0x174A0402,
+		0x174B0402, 0x174C0402, 0x174D0402, 0x174E0402, 0x174F0402, 0x17500402, 0x17510402, 0x17520402, 0x17530402, 0x17540402, 0x17550402, 0x17560402, 0x17570402, 0x17580402, 0x17590402, 0x175A0402,
+		0x175B0402, 0x175C0402, 0x175D0402, 0x175E0402, 0x175F0402, 0x17600402, 0x17610402, 0x17620402, 0x17630402, 0x17640402, 0x17650402, 0x17660402, 0x189E0402, 0x189F0402, 0x18A00402, 0x18A10402,
+		0x18A20402, 0x18A30402, 0x18A40402, 0x18A50402, 0x18A60402, 0x18A70402, 0x18A80402, 0x18A90402, 0x18AA0402, 0x18AB0402, 0x18AC0402, 0x18AD0402, 0x18AE0402, 0x18AF0402, 0x18B00402, 0x18B10402,
+		0x18B20402, 0x18B30402, 0x18B40402, 0x18B50402, 0x18B60402, 0x18B70402, 0x18B80402, 0x18B90402, 0x18BA0402, 0x18BB0402, 0x18BC0402, 0x18BD0402, 0x18BE0402, 0x18BF0402, 0x18C00402, 0x18C10402,
+		0x18C20402, 0x18C30402, 0x18C40402, 0x18C50402, 0x18C60402, 0x18C70402, 0x18C80402, 0x18C90402, 0x18CA0402, 0x18CB0402, 0x18CC0402, 0x18CD0402, 0x18CE0402, 0x18CF0402, 0x18D00402, 0x18D10402,
+		0x18D20402, 0x18D30402, 0x18D40402, 0x18D50402, 0x18D60402, 0x18D70402, 0x18D80402, 0x18D90402, 0x18DA0402, 0x18DB0402, 0x18DC0402, 0x18DD0402, 0x18DE0402, 0x18DF0402, 0x18E00402, 0x18E10402,
+		0x18E20402, 0x18E30402, 0x18E40402, 0x18E50402, 0x18E60402, 0x18E70402, 0x18E80402, 0x18E90402, 0x18EA0402, 0x18EB0402, 0x18EC0402, 0x18ED0402, 0x18EE0402, 0x18EF0402, 0x18F00402, 0x18F10402,
+		0x18F20402, 0x18F30402, 0x18F40402, 0x18F50402, 0x18F60402, 0x18F70402, 0x18F80402, 0x18F90402, 0x18FA0402, 0x18FB0402, 0x18FC0402, 0x18FD0402, 0x18FE0402, 0x18FF0402, 0x19000402, 0x19010402,
+		0x19020402, 0x19030402, 0x19040402, 0x19050402, 0x19060402, 0x19070402, 0x19080402, 0x19090402, 0x190A0402, 0x190B0402, 0x190C0402, 0x190D0402, 0x190E0402, 0x190F0402, 0x19100402, 0x19110402,
+		0x19120402, 0x19130402, 0x19140402, 0x19150402, 0x19160402, 0x19170402, 0x19180402, 0x19190402, 0x191A0402, 0x191B0402, 0x191C0402, 0x191D0402, 0x191E0402, 0x191F0402, 0x19200402, 0x19210402,
+		0x19220402, 0x19230402, 0x19240402, 0x19250402, 0x19260402, 0x19270402, 0x19280402, 0x19290402, 0x192A0402, 0x192B0402, 0x192C0402, 0x192D0402, 0x192E0402, 0x192F0402, 0x19300402, 0x19310402,
+		0x19320402, 0x19330402, 0x19340402, 0x19350402, 0x19360402, 0x19370402, 0x19380402, 0x19390402, 0x193A0402, 0x193B0402, 0x193C0402, 0x193D0402, 0x193E0402, 0x193F0402, 0x19400402, 0x19410402,
+		0x19420402, 0x19430402, 0x19440402, 0x19450402, 0x19460402, 0x19470402, 0x19480402, 0x19490402, 0x194A0402, 0x194B0402, 0x194C0402, 0x194D0402, 0x194E0402, 0x194F0402, 0x19500402, 0x19510402,
+		0x19520402, 0x19530402, 0x19540402, 0x19550402, 0x19560402, 0x19570402, 0x19580402, 0x19590402, 0x195A0402, 0x195B0402, 0x195C0402, 0x195D0402, 0x195E0402, 0x195F0402, 0x19600402, 0x19610402,
+		0x19620402, 0x19630402, 0x19640402, 0x19650402, 0x19660402, 0x19670402, 0x19680402, 0x19690402, 0x196A0402, 0x196B0402, 0x196C0402, 0x196D0402, 0x196E0402, 0x196F0402, 0x19700402, 0x19760402,
+		0x19770402, 0x19780402, 0x19790402, 0x197A0402, 0x197B0402, 0x197C0402, 0x197D0402, 0x197E0402, 0x197F0402, 0x19800402, 0x19810402, 0x19820402, 0x19830402, 0x19840402, 0x19850402, 0x19860402,
+		0x19870402, 0x19880402, 0x19890402, 0x19900402, 0x19910402, 0x19920402, 0x19930402, 0x19940402, 0x19950402, 0x19960402, 0x19970402, 0x19980402, 0x19990402, 0x199A0402, 0x199B0402, 0x199C0402,
+		0x199D0402, 0x199E0402, 0x199F0402, 0x19A00402, 0x19A10402, 0x19A20402, 0x19A30402, 0x19A40402, 0x19A50402, 0x19A60402, 0x19A70402, 0x19A80402, 0x19A90402, 0x19AA0402, 0x19AB0402, 0x19AC0402,
+		0x19AD0402, 0x19AE0402, 0x19AF0402, 0x19B00402, 0x19B10402, 0x19B20402, 0x19B30402, 0x19B40402, 0x19B50402, 0x19B60402, 0x19B70402, 0x19B80402, 0x19B90402, 0x19BA0402, 0x19BB0402, 0x19BC0402,
+		0x19BD0402, 0x19BE0402, 0x19BF0402, 0x19C00402, 0x19C10402, 0x19C20402, 0x19C30402, 0x19C40402, 0x19C50402, 0x19C60402, 0x19C70402, 0x19C80402, 0x19C90402, 0x19CA0402, 0x19CB0402, 0x19CC0402,
+		0x19CD0402, 0x19CE0402, 0x19CF0402, 0x19D00402, 0x19D10402, 0x19D20402, 0x19D30402, 0x19D40402, 0x19D50402, 0x19D60402, 0x19D70402, 0x19D80402, 0x19D90402, 0x19DA0402, 0x19DB0402, 0x19DC0402,
+		0x19DD0402, 0x19DE0402, 0x19DF0402, 0x19E00402, 0x19E10402, 0x19E20402, 0x19E30402, 0x19E40402, 0x19E50402, 0x19E60402, 0x19E70402, 0x19E80402, 0x19E90402, 0x19EA0402, 0x19EB0402, 0x19EC0402,
+		0x19ED0402, 0x19EE0402, 0x19EF0402, 0x19F00402, 0x19F10402, 0x19F20402, 0x19F30402, 0x19F40402, 0x19F50402, 0x19F60402, 0x19F70402, 0x19F80402, 0x19F90402, 0x19FA0402, 0x19FB0402, 0x19FC0402,
+		0x19FD0402, 0x19FE0402, 0x19FF0402, 0x1A000402, 0x1A010402, 0x1A020402, 0x1A030402, 0x1A040402, 0x1A050402, 0x1A060402, 0x1A070402, 0x1A080402, 0x1A090402, 0x1A0A0402, 0x1A0B0402, 0x1A0C0402,
+		0x1A0D0402, 0x1A0E0402, 0x1A0F0402, 0x1A100402, 0x1A110402, 0x1A120402, 0x1A130402, 0x1A140402, 0x1A150402, 0x1A160402, 0x1A170402, 0x1A180402, 0x1A190402, 0x1A1A0402, 0x1A1B0402, 0x1A1C0402,
+		0x1A1D0402, 0x1A1E0402, 0x1A1F0402, 0x1A200402, 0x1A210402, 0x1A220402, 0x1A230402, 0x1A240402, 0x1A250402, 0x1A260402, 0x1A270402, 0x1A280402, 0x1A290402, 0x1A2A0402, 0x1A2B0402, 0x1A2C0402,
+		0x1A2D0402, 0x1A2E0402, 0x1A2F0402, 0x1A300402, 0x1A310402, 0x1A320402, 0x1A330402, 0x1A340402, 0x1A350402, 0x1A360402, 0x1A370402, 0x1A380402, 0x1A390402, 0x1A3A0402, 0x1A3B0402, 0x1A3C0402,
+		0x1A3D0402, 0x1A3E0402, 0x1A3F0402, 0x1A400402, 0x1A410402, 0x1A420402, 0x1A430402, 0x1A440402, 0x1A450402, 0x1A460402, 0x1A470402, 0x1A480402, 0x1A490402, 0x1A4A0402, 0x1A4B0402, 0x1A4C0402,
+		0x1A4D0402, 0x1A4E0402, 0x1A4F0402, 0x1A500402, 0x1A510402, 0x1A520402, 0x1A530402, 0x1A540402, 0x1A550402, 0x1A560402, 0x1A570402, 0x1A580402, 0x1A690402, 0x1A6A0402, 0x1A6B0402, 0x1A6C0402,
+		0x1A6D0402, 0x1A6E0402, 0x1A6F0402, 0x1A700402, 0x1A710402, 0x1A720402, 0x1A730402, 0x1A740402, 0x1A750402, 0x1A760402, 0x1A770402, 0x1A780402, 0x1A790402, 0x1A7A0402, 0x1A7B0402, 0x1A7C0402,
+		0x1A7D0402, 0x1A7E0402, 0x1A7F0402, 0x1A800402, 0x1A810402, 0x1A820402, 0x1A830402, 0x1A840402, 0x1A850402, 0x1A860402, 0x1A870402, 0x1A880402, 0x1A890402, 0x1A8A0402, 0x1A8B0402, 0x1A8C0402,
+		0x1A8D0402, 0x1A8E0402, 0x1A8F0402, 0x1A900402, 0x1A910402, 0x1A920402, 0x1A930402, 0x1A940402, 0x1A950402, 0x1A960402, 0x1A970402, 0x1A980402, 0x1A990402, 0x1A9A0402, 0x1A9B0402, 0x1A9C0402,
+		0x1A9D0402, 0x1A9E0402, 0x1A9F0402, 0x1AA00402, 0x1AA10402, 0x1AA20402, 0x1AA30402, 0x1AA40402, 0x1AA50402, 0x1AA60402, 0x1AA70402, 0x1AA80402, 0x1AA90402, 0x1AAA0402, 0x1AAB0402, 0x1AAC0402,
+		0x1AAD0402, 0x1AAE0402, 0x1AAF0402, 0x1AB00402, 0x1AB10402, 0x1AB20402, 0x1AB30402, 0x1AB40402, 0x1AB50402, 0x1AB60402, 0x1AB70402, 0x1AB80402, 0x1AB90402, 0x1ABA0402, 0x1ABB0402, 0x1ABC0402,
+		0x1ABD0402, 0x1ABE0402, 0x1ABF0402, 0x1AC00402, 0x1AC10402, 0x1AC20402, 0x1AC30402, 0x1AC40402, 0x1AC50402, 0x1AC60402, 0x1AC70402, 0x1AC80402, 0x1AC90402, 0x1ACA0402, 0x1ACB0402, 0x1ACC0402,
+		0x1ACD0402, 0x1ACE0402, 0x1ACF0402, 0x1AD00402, 0x1AD10402, 0x1AD20402, 0x1AD30402, 0x1AD40402, 0x1AD50402, 0x1AD60402, 0x1AD70402, 0x1AD80402, 0x1AD90402, 0x1ADA0402, 0x1ADB0402, 0x1ADC0402,
+		0x1ADD0402, 0x1ADE0402, 0x1ADF0402, 0x1AE00402, 0x1AE10402, 0x1AE20402, 0x1AE30402, 0x1AE40402, 0x1AE50402, 0x1AE60402, 0x1AE70402, 0x1AE80402, 0x1AE90402, 0x1AEA0402, 0x1AEB0402, 0x1AEC0402,
+		0x1AED0402, 0x1AEE0402, 0x1AEF0402, 0x1AF00402, 0x1AF10402, 0x1AF20402, 0x1AF30402, 0x1AF40402, 0x1AF50402, 0x1AF60402, 0x1AF70402, 0x1AF80402, 0x1AF90402, 0x1AFA0402, 0x1AFB0402, 0x1AFC0402,
+		0x17770402, 0x17780402, 0x17790402, 0x177A0402, 0x177B0402, 0x177C0402, 0x177D0402, 0x177E0402, 0x177F0402, 0x17800402, 0x17810402, 0x17820402, 0x17830402, 0x17840402, 0x17850402, 0x17870402,
+		0x17880402, 0x17890402, 0x178A0402, 0x178B0402, 0x178C0402, 0x178D0402, 0x178E0402, 0x17970402, 0x179A0402, 0x179B0402, 0x179C0402, 0x179D0402, 0x179E0402, 0x179F0402, 0x17A00402, 0x17A10402,
+		0x17A20402, 0x17A30402, 0x17A40402, 0x17A50402, 0x17A70402, 0x17A80402, 0x17A90402, 0x17AA0402, 0x17AB0402, 0x17AC0402, 0x17AD0402, 0x17AE0402, 0x17AF0402, 0x17B00402, 0x17B10402, 0x17B20402,
+		0x17B70402, 0x17B80402, 0x17B90402, 0x17BA0402, 0x17BB0402, 0x17BC0402, 0x17BD0402, 0x17BE0402, 0x17BF0402, 0x17C00402, 0x17C10402, 0x17C20402, 0x17C30402, 0x17C40402, 0x17C50402, 0x17E60402,
+		0x17E70402, 0x17E80402, 0x17E90402, 0x17EA0402, 0x17EB0402, 0x17EC0402, 0x17ED0402, 0x17EE0402, 0x17EF0402, 0x17F00402, 0x17F10402, 0x17F20402, 0x17F30402, 0x17F40402, 0x17F50402, 0x17F60402,
+		0x17F70402, 0x18260402, 0x422, 0x442, 0x442, 0x442, 0x442, 0x462, 0x462, 0x462, 0x482, 0x482, 0x482, 0x4A2, 0x4A2, 0x4A2,
+		0x4C2, 0x4E2, 0x502, 0x522, 0x542, 0x562, 0x562, 0x482, 0x582, 0x5A2, 0x5C2, 0x5E2, 0x602, 0x622, 0x642, 0x662,
+		0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662,
+		0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662,
+		0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662,
+		0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x662, 0x682, 0x682, 0x682, 0x682,
+		0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682,
+		0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682,
+		0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682, 0x682,
+		0x682, 0x682, 0x682, 0x682, 0x682, 0x6A2, 0x6A2, 0x6A2, 0x6A2, 0x6A2, 0x6A2, 0x6A2, 0x6A2, 0x6A2, 0x6C2, 0x6C2,
+		0x6C2, 0x6C2, 0x6C2, 0x6C2, 0x6C2, 0x6C2, 0x6E2, 0x6F2, 0x702, 0x712, 0x722, 0x742, 0x762, 0x782, 0x7A2, 0x7C2,
+		0x7E2, 0x802, 0x822, 0x842, 0x862, 0x882, 0x8A2, 0x8C2, 0x8E2, 0x902, 0x922, 0x942, 0x962, 0x982, 0x9A2, 0x9C2,
+		0x9C2, 0x9C2, 0x9E2, 0x9E2, 0xA02, 0xA02, 0xA22, 0xA42, 0xA62, 0xA82, 0xAA2, 0xAC2, 0xAE2, 0xB02, 0xB22, 0xB42,
+		0xB42, 0xB62, 0xB62, 0xB82, 0xBA2, 0xBC2, 0xBE2, 0xC02, 0xC22, 0xC42, 0xC42, 0xC62, 0xC62, 0xC62, 0xC82, 0xC82,
+		0xC82, 0xCA2, 0xCA2, 0xCC2, 0xCC2, 0xCE2, 0xCE2, 0xCE2, 0xD02, 0xD22, 0xD42, 0xD62, 0xD82, 0xDA2, 0xDB8, 0xDBA,
+		0xDC2, 0xDE2, 0xE02, 0xE1A, 0xE1A, 0x101A, 0xE22, 0xE42, 0xE62, 0xE7A, 0xE7A, 0x101A, 0xE82, 0xEA2, 0xEC2, 0xED8,
+		0xEDA, 0xED8, 0x1018, 0xEDA, 0x101A, 0xEE2, 0xF02, 0xF22, 0xF42, 0xF58, 0xF5A, 0xF58, 0x1018, 0xF5A, 0x101A, 0xF62,
+		0xF82, 0xFA2, 0xFB8, 0xFBA, 0xFB8, 0x1018, 0xFBA, 0x101A, 0xFC2, 0xFE2, 0x1002, 0x1002, 0x1018, 0x101A, 0x101A, 0x131A,
+		0x1022, 0x1022, 0x1038, 0x103A, 0x1042, 0x1062, 0x1082, 0x10A2, 0x10C2, 0x10E2, 0x1102, 0x1122, 0x1142, 0x1162, 0x1182, 0x11A2,
+		0x11C2, 0x11E2, 0x1202, 0x1222, 0x1242, 0x1262, 0x1282, 0x12A2, 0x12C2, 0x12E2, 0x1302, 0x1322, 0x1342, 0x1362, 0x1382, 0x13A2,
+		0x13C2, 0x13E2, 0x1402, 0x1422, 0x1442, 0x1462, 0x1482, 0x14A2, 0x14C2, 0x14E2, 0x1502, 0x1522, 0x1542, 0x1562, 0x1582, 0x15A2,
+		0x15C2, 0x15E2, 0x1602, 0x1622, 0x1642, 0x1662, 0x1682, 0x16A2, 0x16C2, 0x16E2, 0x1702, 0x1722, 0x1742, 0x1762, 0x1782, 0x17A2,
+		0x17A2, 0x17A2, 0x17C2, 0x17E2, 0x1802, 0x1822, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842,
+		0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1842, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862,
+		0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862, 0x1862,
+		0x1862, 0x1862, 0x1862, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882,
+		0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882,
+		0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x1882, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2,
+		0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2,
+		0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18A2, 0x18C2, 0x18E2, 0x1902, 0x1922, 0x1942, 0x1962, 0x1982, 0x19A2, 0x19C2, 0x19E2, 0x1A02,
+		0x1A22, 0x1A62, 0x1A82, 0x1AA2, 0x1AC2, 0x1AE2, 0x1B02, 0x1B22, 0x1B42, 0x1B62, 0x1B82, 0x1BA2, 0x1BC2, 0x1BE2, 0x1C02, 0x1C22,
+		0x1C42, 0x1C62, 0x1C82, 0x1CA2, 0x1CC2, 0x1CE2, 0x1D02, 0x1D22, 0x1D42, 0x1D62, 0x1D82, 0x1DA2, 0x1DC2, 0x1DE2, 0x1E02, 0x1E22,
+		0x1E42, 0x1E62, 0x1E82, 0x1EA2, 0x1EC2, 0x1EE2, 0x1F02, 0x1F22, 0x1F42, 0x1F62, 0x1F82, 0x2022, 0x2042, 0x2062, 0x2082, 0x20A2,
+		0x20C2, 0x2122, 0x2142, 0x2162, 0x2162, 0x2182, 0x21A2, 0x21C2, 0x21E2, 0x2202, 0x2222, 0x2242, 0x2262, 0x2282, 0x22A2, 0x22C2,
+		0x22E2, 0x1F460402, 0x1F470402, 0x1F480402, 0x1F490402, 0x1F4A0402, 0x1F4B0402, 0x1F4C0402, 0x1F4D0402, 0x1F4E0402, 0x1F4F0402, 0x1F500402, 0x1F510402, 0x1F520402, 0x1F530402, 0x1F540402,
+		0x1F570402, 0x1F580402, 0x1F590402, 0x1F5C0402, 0x1F5C0402, 0x6E2, 0x1F5D0402, 0x1F5D0402, 0x6E2, 0x1F5E0402, 0x1F5F0402, 0x1F5F0402, 0x6E2, 0x1F600402, 0x1F600412, 0x1F610402,
+		0x1F610402, 0x6E2, 0x1F620402, 0x1F630402, 0x1F630403, 0x1F640402, 0x1F640403, 0x1F64040F, 0x1F650402, 0x1F650403, 0x1F660402, 0x1F660403, 0x1F670402, 0x1F680402, 0x1F6B0402, 0x1F6C0402,
+		0x1F6D0402, 0x1F6E0402, 0x1F6F0402, 0x1F700402, 0x1F750402, 0x1F760402, 0x1F780402, 0x1F790402, 0x1F7A0402, 0x1F7B0402, 0x1F7C0402, 0x1F7D0402, 0x1F7E0402, 0x1F7F0402, 0x1F800402, 0x1F800403,
+		0x1F810402, 0x1F820402, 0x1F830402, 0x1F840402, 0x1F850402, 0x1F860402, 0x1F870402, 0x1F880402, 0x1F890402, 0x1F8A0402, 0x1F8B0402, 0x1F8C0402, 0x1F8D0402, 0x1F8E0402, 0x1F8F0402, 0x1F900402,
+		0x1F920402, 0x1F930402, 0x1F940402, 0x1F950402, 0x1F960402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402,
+		0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402,
+		0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402,
+		0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980402, 0x1F980403, 0x1F980404, 0x1F980404,
+		0x27E0404, 0x1F980404, 0x2230404, 0x1F980405, 0x1F980405, 0x1F980405, 0x1F980405, 0x1F980405, 0x1F980406, 0x1F980406, 0x1F980406, 0x1F980406, 0x1F980414, 0x1F980415, 0x1F98041E, 0x676041E,
+		0x1F9B041E, 0x1F980404, 0xFB400404, 0xF0B90000, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402,
+		0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402,
+		0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402,
+		0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402,
+		0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402,
+		0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402, 0x1F990402,
+		0x1F990403, 0x1F990404, 0x3280404, 0x1F990404, 0x3290404, 0x1F990404, 0x27E0404, 0x1F990404, 0x2230404, 0x1F990405, 0x1F990405, 0x1F990405, 0x1F990405, 0x1F990405, 0x1F990406, 0x1F990406,
+		0x1F990406, 0x1F990406, 0x1F990406, 0x1F990414, 0x1F990415, 0x1F99041E, 0x676041E, 0x3280404, 0x1F990404, 0x1F980404, 0x3290404, 0x1F990404, 0x1F980404, 0x27E0404, 0x1F990406, 0x1F980406,
+		0x1F990406, 0x1F980406, 0x1F990406, 0x1F980406, 0x1F990406, 0x1F980406, 0x1F990406, 0x1F980406, 0x1F990406, 0x1F980406, 0x1F990404, 0x1F980404, 0xFB400404, 0xE5E50000, 0x1F990404, 0x1F980404,
+		0xFB400404, 0xE7080000, 0x1F990404, 0x1F980404, 0xFB400404, 0xF0B90000, 0x3280404, 0x1F990404, 0x1F990404, 0x3290404, 0x1F990404, 0x1F990404, 0x27E0404, 0x1F990406, 0x1F990406, 0x1F990406,
+		0x1F990406, 0x1F99041E, 0x676041E, 0x1F99041E, 0x1F98041E, 0x1F990404, 0x1F990404, 0xFB400404, 0xE5E50000, 0x1F990404, 0x1F990404, 0xFB400404, 0xE7080000, 0x1F990404, 0x1F990404, 0xFB400404,
+		0xF0B90000, 0x3280404, 0x1F990404, 0x1F9A0404, 0x3290404, 0x1F990404, 0x1F9A0404, 0x27E0404, 0x1F990406, 0x1F9A0406, 0x1F990406, 0x1F9A0406, 0x1F99041E, 0x676041E, 0x1F9A041E, 0x1F99041C,
+		0x1F9A041C, 0x1F98041C, 0x216B041D, 0x1F990404, 0x1F9A0404, 0xFB400404, 0xE5E50000, 0x1F990404, 0x1F9A0404, 0xFB400404, 0xE7080000, 0x1F990404, 0x1F9A0404, 0xFB400404, 0xF0B90000, 0x3280404,
+		0x1F990404, 0x1F9B0404, 0x3290404, 0x1F990404, 0x1F9B0404, 0x27E0404, 0x1F990406, 0x1F9B0406, 0x1F990406, 0x1F9B0406, 0x1F99041E, 0x676041E, 0x1F9B041E, 0x1F990404, 0x1F9B0404, 0xFB400404,
+		0xE5E50000, 0x1F990404, 0x1F9B0404, 0xFB400404, 0xF0B90000, 0x3280404, 0x1F990404, 0x1F9C0404, 0x3290404, 0x1F990404, 0x1F9C0404, 0x27E0404, 0x1F990406, 0x1F9C0406, 0x1F990406, 0x1F9C0406,
+		0x1F99041E, 0x676041E, 0x1F9C041E, 0x1F990404, 0x1F9C0404, 0xFB400404, 0xE5E50000, 0x1F990404, 0x1F9C0404, 0xFB400404, 0xF0B90000, 0x3280404, 0x1F990404, 0x1F9D0404, 0x3290404, 0x1F990404,
+		0x1F9D0404, 0x27E0404, 0x1F990406, 0x1F9D0406, 0x1F990406, 0x1F9D0406, 0x1F99041E, 0x676041E, 0x1F9D041E, 0x1F990404, 0x1F9D0404, 0xFB400404, 0xE5E50000, 0x1F990404, 0x1F9D0404, 0xFB400404,
+		0xF0B90000, 0x3280404, 0x1F990404, 0x1F9E0404, 0x3290404, 0x1F990404, 0x1F9E0404, 0x27E0404, 0x1F990406, 0x1F9E0406, 0x1F990406, 0x1F9E0406, 0x1F99041E, 0x676041E, 0x1F9E041E, 0x1F990404,
+		0x1F9E0404, 0xFB400404, 0xE5E50000, 0x1F990404, 0x1F9E0404, 0xFB400404, 0xF0B90000, 0x3280404, 0x1F990404, 0x1F9F0404, 0x3290404, 0x1F990404, 0x1F9F0404, 0x27E0404, 0x1F990406, 0x1F9F0406,
+		0x1F990406, 0x1F9F0406, 0x1F99041E, 0x676041E, 0x1F9F041E, 0x1F990404, 0x1F9F0404, 0xFB400404, 0xE5E50000, 0x1F990404, 0x1F9F0404, 0xFB400404, 0xF0B90000, 0x3280404, 0x1F990404, 0x1FA00404,
+		0x3290404, 0x1F990404, 0x1FA00404, 0x27E0404, 0x1F990406, 0x1FA00406, 0x1F990406, 0x1FA00406, 0x1F99041E, 0x676041E, 0x1FA0041E, 0x1F990404, 0x1FA00404, 0xFB400404, 0xE5E50000, 0x1F990404,
+		0x1FA00404, 0xFB400404, 0xF0B90000, 0x3280404, 0x1F990404, 0x1FA10404, 0x3290404, 0x1F990404, 0x1FA10404, 0x27E0404, 0x1F990406, 0x1FA10406, 0x1F990406, 0x1FA10406, 0x1F99041E, 0x676041E,
+		0x1FA1041E, 0x1F990404, 0x1FA10404, 0xFB400404, 0xE5E50000, 0x1F990404, 0x1FA10404, 0xFB400404, 0xF0B90000, 0x1F990404, 0xFB400404, 0xE5E50000, 0x1F990404, 0xFB400404, 0xE7080000, 0x1F990404,
+		0xFB400404, 0xF0B90000, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402,
+		0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402,
+		0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402,
+		0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402,
+		0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402,
+		0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0402, 0x1F9A0403, 0x1F9A0404, 0x3280404, 0x1F9A0404, 0x3290404,
+		0x1F9A0404, 0x27E0404, 0x1F9A0404, 0x2230404, 0x1F9A0405, 0x1F9A0405, 0x1F9A0405, 0x1F9A0405, 0x1F9A0405, 0x1F9A0406, 0x1F9A0406, 0x1F9A0406, 0x1F9A0406, 0x1F9A0406, 0x1F9A0414, 0x1F9A0415,
+		0x3280404, 0x1F9A0404, 0x1F980404, 0x3290404, 0x1F9A0404, 0x1F980404, 0x27E0404, 0x1F9A0406, 0x1F980406, 0x1F9A0406, 0x1F980406, 0x1F9A0406, 0x1F980406, 0x1F9A0404, 0x1F980404, 0xFB400404,
+		0xE5E50000, 0x1F9A0404, 0x1F980404, 0xFB400404, 0xF0B90000, 0x1F9A0406, 0x1F990406, 0x1F9A0404, 0x1F990404, 0xFB400404, 0xE5E50000, 0x1F9A0404, 0x1F990404, 0xFB400404, 0xF0B90000, 0x1F9A0406,
+		0x1F9A0406, 0x1F9A041C, 0x1F9A041C, 0x27E041C, 0x1F9A041C, 0x1F9A0404, 0x1F9A0404, 0xFB400404, 0xE5E50000, 0x1F9A0404, 0x1F9A0404, 0xFB400404, 0xF0B90000, 0x1F9A0406, 0x1F9B0406, 0x1F9A041E,
+		0x676041E, 0x1F9B041E, 0x1F9A0404, 0x1F9B0404, 0xFB400404, 0xE5E50000, 0x1F9A0404, 0x1F9B0404, 0xFB400404, 0xF0B90000, 0x1F9A0406, 0x1F9C0406, 0x1F9A0404, 0x1F9C0404, 0xFB400404, 0xE5E50000,
+		0x1F9A0404, 0x1F9C0404, 0xFB400404, 0xF0B90000, 0x1F9A0406, 0x1F9D0406, 0x1F9A041E, 0x676041E, 0x1F9D041E, 0x1F9A0404, 0x1F9D0404, 0xFB400404, 0xE5E50000, 0x1F9A0406, 0x1F9E0406, 0x1F9A0404,
+		0x1F9E0404, 0xFB400404, 0xE5E50000, 0x1F9A0406, 0x1F9F0406, 0x1F9A0404, 0x1F9F0404, 0xFB400404, 0xE5E50000, 0x1F9A0406, 0x1FA00406, 0x1F9A0404, 0x1FA00404, 0xFB400404, 0xE5E50000, 0x1F9A0406,
+		0x1FA10406, 0x1F9A0404, 0x1FA10404, 0xFB400404, 0xE5E50000, 0x1F9A041C, 0x20C4041D, 0x1F9A041C, 0x2118041C, 0x1FEB041C, 0x209041C, 0x21D2041D, 0x1FD6041C, 0x2193041C, 0x1F9A0404, 0xFB400404,
+		0xE5E50000, 0x1F9A0404, 0xFB400404, 0xE7080000, 0x1F9A0404, 0xFB400404, 0xF0B90000, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402,
+		0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402,
+		0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402,
+		0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402,
+		0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402,
+		0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402, 0x1F9B0402,
+		0x1F9B0403, 0x1F9B0404, 0x3280404, 0x1F9B0404, 0x3290404, 0x1F9B0404, 0x27E0404, 0x1F9B0404, 0x2230404, 0x1F9B0405, 0x1F9B0405, 0x1F9B0405, 0x1F9B0405, 0x1F9B0405, 0x1F9B0406, 0x1F9B0406,
+		0x1F9B0406, 0x1F9B0406, 0x1F9B0406, 0x1F9B0414, 0x1F9B0415, 0x1F9B0406, 0x1F980406, 0x1F9B0406, 0x1F980406, 0x1F9B0404, 0x1F980404, 0xFB400404, 0xE5E50000, 0x1F9B0406, 0x1F990406, 0x1F9B0404,
+		0x1F990404, 0xFB400404, 0xE5E50000, 0x1F9B0406, 0x1F9A0406, 0x1F9B0406, 0x1F9B0406, 0x1F9B0406, 0x1F9C0406, 0x1F9B041E, 0x676041E, 0x1F9C041E, 0x1F9B0406, 0x1F9D0406, 0x1F9B041E, 0x676041E,
+		0x1F9D041E, 0x1F9B0406, 0x1F9E0406, 0x1F9B0406, 0x1F9F0406, 0x1F9B0406, 0x1FA00406, 0x1F9B041E, 0x676041E, 0x1FA0041E, 0x1F9B0406, 0x1FA10406, 0x1F9B041C, 0x1FEB041D, 0x1F9B0404, 0xFB400404,
+		0xE5E50000, 0x1F9B0404, 0xFB400404, 0xE7080000, 0x1F9B0404, 0xFB400404, 0xF0B90000, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402,
+		0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402,
+		0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402,
+		0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402,
+		0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402,
+		0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0402, 0x1F9C0403, 0x1F9C0404,
+		0x3280404, 0x1F9C0404, 0x3290404, 0x1F9C0404, 0x27E0404, 0x1F9C0404, 0x2230404, 0x1F9C0405, 0x1F9C0405, 0x1F9C0405, 0x1F9C0405, 0x1F9C0405, 0x1F9C0406, 0x1F9C0406, 0x1F9C0406, 0x1F9C0406,
+		0x1F9C0406, 0x1F9C0414, 0x1F9C0415, 0x1F9C0406, 0x1F980406, 0x1F9C0406, 0x1F980406, 0x1F9C0406, 0x1F990406, 0x1F9C0406, 0x1F9A0406, 0x1F9C0406, 0x1F9B0406, 0x1F9C0406, 0x1F9C0406, 0x1F9C0406,
+		0x1F9D0406, 0x1F9C041E, 0x676041E, 0x1F9D041E, 0x1F9C0406, 0x1F9E0406, 0x1F9C0406, 0x1F9F0406, 0x1F9C0406, 0x1FA00406, 0x1F9C0406, 0x1FA10406, 0x1F9C041C, 0x20C4041D, 0x1F9C0404, 0xFB400404,
+		0xE5E50000, 0x1F9C0404, 0xFB400404, 0xE7080000, 0x1F9C0404, 0xFB400404, 0xF0B90000, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402,
+		0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402,
+		0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402,
+		0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402,
+		0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402,
+		0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0402, 0x1F9D0403, 0x1F9D0404,
+		0x3280404, 0x1F9D0404, 0x3290404, 0x1F9D0404, 0x27E0404, 0x1F9D0404, 0x2230404, 0x1F9D0405, 0x1F9D0405, 0x1F9D0405, 0x1F9D0405, 0x1F9D0405, 0x1F9D0406, 0x1F9D0406, 0x1F9D0406, 0x1F9D0406,
+		0x1F9D0406, 0x1F9D0414, 0x1F9D0415, 0x1F9D0406, 0x1F980406, 0x1F9D0406, 0x1F980406, 0x1F9D041C, 0x27E041C, 0x1F99041C, 0x1F9D041E, 0x676041E, 0x1F9E041E, 0x1F9D041E, 0x676041E, 0x1FA0041E,
+		0x1F9D0404, 0xFB400404, 0xE5E50000, 0x1F9D0404, 0xFB400404, 0xE7080000, 0x1F9D0404, 0xFB400404, 0xF0B90000, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402,
+		0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402,
+		0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402,
+		0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402,
+		0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402, 0x1F9E0402,
+		0x1F9E0402, 0x1F9E0402, 0x1F9E0403, 0x1F9E0404, 0x3280404, 0x1F9E0404, 0x3290404, 0x1F9E0404, 0x27E0404, 0x1F9E0404, 0x2230404, 0x1F9E0405, 0x1F9E0405, 0x1F9E0405, 0x1F9E0405, 0x1F9E0405,
+		0x1F9E0406, 0x1F9E0406, 0x1F9E0406, 0x1F9E0406, 0x1F9E0406, 0x1F9E0414, 0x1F9E0415, 0x1F9E0406, 0x1F980406, 0x1F9E041C, 0x1F98041C, 0x216B041D, 0x1F9E0404, 0xFB400404, 0xE5E50000, 0x1F9E0404,
+		0xFB400404, 0xE7080000, 0x1F9E0404, 0xFB400404, 0xF0B90000, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402,
+		0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402,
+		0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402,
+		0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402,
+		0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0402, 0x1F9F0403, 0x1F9F0404,
+		0x3280404, 0x1F9F0404, 0x3290404, 0x1F9F0404, 0x27E0404, 0x1F9F0404, 0x2230404, 0x1F9F0405, 0x1F9F0405, 0x1F9F0405, 0x1F9F0405, 0x1F9F0405, 0x1F9F0406, 0x1F9F0406, 0x1F9F0406, 0x1F9F0406,
+		0x1F9F0406, 0x1F9F0414, 0x1F9F0415, 0x1F9F0406, 0x1F980406, 0x1F9F041C, 0x27E041C, 0x1F99041C, 0x1F9F041E, 0x676041E, 0x1FA0041E, 0x1F9F0404, 0xFB400404, 0xE5E50000, 0x1F9F0404, 0xFB400404,
+		0xE7080000, 0x1F9F0404, 0xFB400404, 0xF0B90000, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402,
+		0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402,
+		0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402,
+		0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402,
+		0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00402, 0x1FA00403, 0x1FA00404, 0x3280404, 0x1FA00404,
+		0x3290404, 0x1FA00404, 0x27E0404, 0x1FA00404, 0x2230404, 0x1FA00405, 0x1FA00405, 0x1FA00405, 0x1FA00405, 0x1FA00405, 0x1FA00406, 0x1FA00406, 0x1FA00406, 0x1FA00406, 0x1FA00406, 0x1FA00414,
+		0x1FA00415, 0x1FA00406, 0x1F980406, 0x1FA0041C, 0x20C4041D, 0x1FA00404, 0xFB400404, 0xE5E50000, 0x1FA00404, 0xFB400404, 0xE7080000, 0x1FA00404, 0xFB400404, 0xF0B90000, 0x1FA10402, 0x1FA10402,
+		0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402,
+		0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402,
+		0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402,
+		0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402,
+		0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10402, 0x1FA10403, 0x1FA10404, 0x3280404, 0x1FA10404, 0x3290404, 0x1FA10404, 0x27E0404, 0x1FA10404,
+		0x2230404, 0x1FA10405, 0x1FA10405, 0x1FA10405, 0x1FA10405, 0x1FA10405, 0x1FA10406, 0x1FA10406, 0x1FA10406, 0x1FA10406, 0x1FA10406, 0x1FA10414, 0x1FA10415, 0x1FA10404, 0xFB400404, 0xE5E50000,
+		0x1FA10404, 0xFB400404, 0xE7080000, 0x1FA10404, 0xFB400404, 0xF0B90000, 0x1FA20402, 0x1FA20403, 0x1FA20404, 0x3280404, 0x1FA20404, 0x3290404, 0x1FA20405, 0x1FA20405, 0x1FA20405, 0x1FA20405,
+		0x1FA20405, 0x1FA20405, 0x1FA20405, 0x1FA20405, 0x1FA20405, 0x1FA20405, 0x1FA20405, 0x1FA20405, 0x1FA20405, 0x1FA20406, 0x1FA20408, 0x1FA20409, 0x3280404, 0x1FA2040A, 0x3290404, 0x1FA2040B,
+		0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040B, 0x1FA2040C, 0x1FA2040C, 0x1FA20414, 0x1FA20414,
+		0x1FA20415, 0x1FA2041D, 0x1FA2041D, 0x1FA2041D, 0x1FA20402, 0x482, 0x1FA20408, 0x482, 0x1FA20402, 0x4A2, 0x1FA20408, 0x4A2, 0x1FA20402, 0x4C2, 0x1FA20408, 0x4C2,
+		0x1FA20402, 0x4C2, 0x482, 0x1FA20408, 0x4C2, 0x482, 0x1FA20402, 0x4C2, 0x4A2, 0x1FA20408, 0x4C2, 0x4A2, 0x1FA20402, 0x4C2, 0x5A2, 0x1FA20408,
+		0x4C2, 0x5A2, 0x1FA20402, 0x4C2, 0x762, 0x1FA20408, 0x4C2, 0x762, 0x1FA20402, 0x4E2, 0x1FA20408, 0x4E2, 0x1FA20402, 0x4E2, 0x482, 0x1FA20408,
+		0x4E2, 0x482, 0x1FA20402, 0x4E2, 0x4A2, 0x1FA20408, 0x4E2, 0x4A2, 0x1FA20402, 0x4E2, 0x5A2, 0x1FA20408, 0x4E2, 0x5A2, 0x1FA20402, 0x4E2,
+		0x762, 0x1FA20408, 0x4E2, 0x762, 0x1FA20402, 0x502, 0x1FA20408, 0x502, 0x1FA20402, 0x522, 0x1FA20408, 0x522, 0x1FA20408, 0x522, 0x1FA20402, 0x522,
+		0x482, 0x1FA20408, 0x522, 0x482, 0x1FA20402, 0x562, 0x1FA20404, 0x564, 0x1FA20404, 0x564, 0x1FA20408, 0x562, 0x1FA2040A, 0x564, 0x1FA20402, 0x562,
+		0x642, 0x1FA20408, 0x562, 0x642, 0x1FA20402, 0x5A2, 0x1FA20408, 0x5A2, 0x1FA20402, 0x5C2, 0x1FA20408, 0x5C2, 0x1FA20402, 0x5C2, 0x642, 0x1FA20408,
+		0x5C2, 0x642, 0x1FA20402, 0x622, 0x1FA20408, 0x622, 0x1FA20402, 0x642, 0x1FA20408, 0x642, 0x1FA20402, 0x762, 0x1FA20408, 0x762, 0x1FA20402, 0x782,
+		0x1FA20408, 0x782, 0x1FA20402, 0x7C2, 0x1FA20408, 0x7C2, 0x1FA20402, 0x842, 0x1FA20408, 0x842, 0x1FA20402, 0x842, 0x4C2, 0x1FA20408, 0x842, 0x4C2,
+		0x1FA20402, 0x842, 0x4E2, 0x1FA20408, 0x842, 0x4E2, 0x1FA20402, 0x882, 0x1FA20408, 0x882, 0x1FA20404, 0x2304, 0x1FA20404, 0x1FA20404, 0x1FA2040A, 0x1FA2040A,
+		0x1FA2041D, 0x1FBC041D, 0x1FA20404, 0x3A60404, 0x1FD60404, 0x1FA20404, 0x2304, 0x20070404, 0x1FA20404, 0x2304, 0x20070404, 0x1FA2040A, 0x2304, 0x2007040A, 0x1FA20414, 0x2314,
+		0x20070414, 0x1FA20404, 0x2304, 0x20070404, 0x482, 0x1FA2040A, 0x2304, 0x2007040A, 0x482, 0x1FA20404, 0x2304, 0x20070404, 0x642, 0x1FA2040A, 0x2304, 0x2007040A,
+		0x642, 0x1FA2041C, 0x27E041C, 0x2109041C, 0x27E041C, 0x1FA2041D, 0x675041C, 0x2109041C, 0x1FA20404, 0x213C0404, 0x1FA20404, 0x213C0404, 0x1FA2040A, 0x213C040A, 0x1FA20404, 0x3A60404,
+		0x21D20404, 0x1FA2040A, 0x3A60404, 0x21D2040A, 0x1FA20404, 0x22170404, 0x1FA2040A, 0x2217040A, 0x1FA2041D, 0x2217041D, 0x1FA20404, 0x22470404, 0x1FA20404, 0x22470404, 0x1FA2040A, 0x2247040A,
+		0x1FA20404, 0x2304, 0x22470404, 0x1FA2040A, 0x2304, 0x2247040A, 0x1FA20404, 0x22700404, 0x1FA2040A, 0x2270040A, 0x1FA20404, 0x22E50404, 0x1FA60402, 0x1FA70402, 0x1FA70408, 0x1FA80402,
+		0x1FAA0402, 0x1FAB0402, 0x1FAB0414, 0x1FAC0402, 0x1FAD0402, 0x1FAD0408, 0x1FAD0414, 0x1FB10402, 0x1FB10404, 0x1FB10408, 0x1FB10414, 0x1FB50402, 0x1FB60402, 0x1FB70402, 0x1FB70408, 0x1FB70414,
+		0x1FBB0402, 0x1FBC0402, 0x1FBC0403, 0x1FBC0404, 0x3280404, 0x1FBC0404, 0x3290404, 0x1FBC0405, 0x1FBC0405, 0x1FBC0405, 0x1FBC0405, 0x1FBC0405, 0x1FBC0405, 0x1FBC0405, 0x1FBC0405, 0x1FBC0405,
+		0x1FBC0405, 0x1FBC0405, 0x1FBC0405, 0x1FBC0405, 0x1FBC0406, 0x1FBC0408, 0x1FBC0409, 0x3280404, 0x1FBC040A, 0x3290404, 0x1FBC040B, 0x1FBC040B, 0x1FBC040B, 0x1FBC040B, 0x1FBC040B, 0x1FBC040B,
+		0x1FBC040B, 0x1FBC040B, 0x1FBC040B, 0x1FBC040B, 0x1FBC040B, 0x1FBC040B, 0x1FBC040B, 0x1FBC040C, 0x1FBC040C, 0x1FBC0414, 0x1FBC041D, 0x1FBC041D, 0x1FBC041D, 0x1FBC0402, 0x5C2, 0x1FBC0408,
+		0x5C2, 0x1FBC0402, 0x842, 0x1FBC0408, 0x842, 0x1FBC0402, 0x922, 0x1FBC0408, 0x922, 0x1FBC041C, 0x1FA2041C, 0x2193041C, 0x1FBC041D, 0x2180041C, 0x1FC00402, 0x1FC40402,
+		0x1FC40408, 0x1FC80402, 0x1FC90402, 0x1FCA0402, 0x1FCB0402, 0x1FCB0408, 0x1FCC0402, 0x1FCD0402, 0x1FCD0408, 0x1FD10402, 0x1FD10408, 0x1FD50402, 0x1FD50404, 0x1FD50408, 0x1FD60402, 0x1FD60403,
+		0x1FD60404, 0x1FD60404, 0x3280404, 0x1FD60404, 0x3290404, 0x1FD60405, 0x1FD60405, 0x1FD60405, 0x1FD60405, 0x1FD60405, 0x1FD60405, 0x1FD60405, 0x1FD60405, 0x1FD60405, 0x1FD60405, 0x1FD60405,
+		0x1FD60405, 0x1FD60405, 0x1FD60406, 0x1FD60408, 0x1FD60409, 0x52A0404, 0x1FD6040A, 0x1FD6040A, 0x3280404, 0x1FD6040A, 0x3290404, 0x1FD6040B, 0x1FD6040B, 0x1FD6040B, 0x1FD6040B, 0x1FD6040B,
+		0x1FD6040B, 0x1FD6040B, 0x1FD6040B, 0x1FD6040B, 0x1FD6040B, 0x1FD6040B, 0x1FD6040B, 0x1FD6040B, 0x1FD6040C, 0x1FD6040C, 0x1FD6040C, 0x1FD60414, 0x1FD6041D, 0x1FD6041D, 0x1FD60402, 0x482,
+		0x1FD60408, 0x482, 0x1FD60402, 0x4E2, 0x1FD60408, 0x4E2, 0x1FD60402, 0x502, 0x1FD60408, 0x502, 0x1FD60402, 0x5C2, 0x1FD60408, 0x5C2, 0x1FD60402, 0x602,
+		0x1FD60404, 0x604, 0x1FD60408, 0x602, 0x1FD60402, 0x602, 0x482, 0x1FD60408, 0x602, 0x482, 0x1FD6041C, 0x1FA2041C, 0x20D6041C, 0x1FD6041C, 0x1FD6041C, 0x1FD6040C,
+		0x1FEB040C, 0x1FD6041C, 0x1FEB041C, 0x1FD6041D, 0x675041C, 0x20C4041C, 0x2051041C, 0x1FD6041D, 0x20D6041D, 0x1FD6041C, 0x2109041C, 0x1FD6041C, 0x2109041C, 0x1F9A041C, 0x1FD6041C, 0x2109041C,
+		0x1F9B041C, 0x1FD60404, 0x3A60404, 0x213C0404, 0x1FD6041D, 0x213C041C, 0x27E041C, 0x1FD6041D, 0x213C041D, 0x213C041D, 0x20D6041D, 0x1FD60404, 0x3A60404, 0x22170404, 0x1FDA0402, 0x1FDB0402,
+		0x1FDB0408, 0x1FDF0402, 0x1FDF0408, 0x1FE00402, 0x1FE10402, 0x1FE10408, 0x1FE50402, 0x1FE50414, 0x1FE90402, 0x1FE90408, 0x1FEA0402, 0x1FEA0408, 0x1FEB0402, 0x1FEB0403, 0x1FEB0404, 0x1FEB0404,
+		0x3280404, 0x1FEB0404, 0x3290404, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405, 0x1FEB0405,
+		0x1FEB0405, 0x1FEB0406, 0x1FEB0408, 0x1FEB0409, 0x1FEB040A, 0x3280404, 0x1FEB040A, 0x3290404, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B,
+		0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040B, 0x1FEB040C, 0x1FEB040C, 0x1FEB0414, 0x1FEB041C, 0x1FEB041D, 0x1FEB041D, 0x1FEB041D, 0x1FEB0402, 0x502, 0x1FEB0408,
+		0x502, 0x1FEB0402, 0x5C2, 0x1FEB0408, 0x5C2, 0x1FEB0402, 0x602, 0x1FEB0408, 0x602, 0x1FEB0402, 0x722, 0x1FEB0408, 0x722, 0x1FEB0402, 0x842, 0x1FEB0408,
+		0x842, 0x1FEB0402, 0x8C2, 0x1FEB0408, 0x8C2, 0x1FEB0402, 0x922, 0x1FEB0408, 0x922, 0x1FEB0404, 0x2304, 0x1FEB0404, 0x2304, 0x1FEB040A, 0x2304, 0x1FEB0414,
+		0x2314, 0x1FEB0404, 0x2324, 0x1FEB0404, 0x2324, 0x1FEB040A, 0x2324, 0x1FEB041C, 0x1FA2041C, 0x1FEB0404, 0x1FBC0404, 0x1FEB041C, 0x1FBC041D, 0x1FEB041D, 0x20AB041D, 0x1FEB041C,
+		0x20D6041C, 0x1FEB041C, 0x2109041C, 0x1FEB041C, 0x2109041C, 0x1F9A041C, 0x1FEB041C, 0x2109041C, 0x1F9B041C, 0x1FEB0404, 0x22860404, 0x1FEB0404, 0x22860404, 0x1FEB040A, 0x22860404, 0x1FEB040A,
+		0x2286040A, 0x1FEB0404, 0x22860404, 0x504, 0x1FEB040A, 0x22860404, 0x504, 0x1FEB040A, 0x2286040A, 0x504, 0x1FEB0404, 0x22990404, 0x1FEB0404, 0x22A30404, 0x1FEF0402, 0x1FF00402,
+		0x1FF20402, 0x1FF30402, 0x1FF40402, 0x1FF40408, 0x1FF80402, 0x1FF80408, 0x1FFC0402, 0x1FFD0402, 0x1FFD0408, 0x20010402, 0x20050402, 0x20060402, 0x20070402, 0x20070403, 0x20070404, 0x3280404,
+		0x20070404, 0x3290404, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405, 0x20070405,
+		0x20070406, 0x20070408, 0x20070409, 0x3280404, 0x2007040A, 0x3290404, 0x2007040B, 0x2007040B, 0x2007040B, 0x2007040B, 0x2007040B, 0x2007040B, 0x2007040B, 0x2007040B, 0x2007040B, 0x2007040B,
+		0x2007040B, 0x2007040B, 0x2007040B, 0x2007040C, 0x2007040C, 0x20070414, 0x20070415, 0x2007041D, 0x2007041D, 0x2007041D, 0x20070402, 0x482, 0x20070408, 0x482, 0x20070402, 0x4A2,
+		0x20070408, 0x4A2, 0x20070402, 0x4C2, 0x20070408, 0x4C2, 0x20070402, 0x4E2, 0x20070408, 0x4E2, 0x20070402, 0x4E2, 0x482, 0x20070408, 0x4E2, 0x482,
+		0x20070402, 0x4E2, 0x4A2, 0x20070408, 0x4E2, 0x4A2, 0x20070402, 0x4E2, 0x5A2, 0x20070408, 0x4E2, 0x5A2, 0x20070402, 0x4E2, 0x762, 0x20070408,
+		0x4E2, 0x762, 0x20070402, 0x502, 0x20070408, 0x502, 0x20070402, 0x562, 0x20070408, 0x562, 0x20070402, 0x5A2, 0x20070408, 0x5A2, 0x20070402, 0x5C2,
+		0x20070408, 0x5C2, 0x20070402, 0x602, 0x20070408, 0x602, 0x20070402, 0x602, 0x4C2, 0x20070408, 0x602, 0x4C2, 0x20070402, 0x622, 0x20070408, 0x622,
+		0x20070402, 0x642, 0x20070408, 0x642, 0x20070402, 0x642, 0x482, 0x20070408, 0x642, 0x482, 0x20070402, 0x642, 0x4A2, 0x20070408, 0x642, 0x4A2,
+		0x20070402, 0x762, 0x20070408, 0x762, 0x20070402, 0x782, 0x20070408, 0x782, 0x20070402, 0x7C2, 0x20070408, 0x7C2, 0x20070402, 0x842, 0x20070408, 0x842,
+		0x20070402, 0x842, 0x4E2, 0x20070408, 0x842, 0x4E2, 0x20070402, 0x8C2, 0x20070408, 0x8C2, 0x20070402, 0x902, 0x20070408, 0x902, 0x2007041C, 0x2193041C,
+		0x2051041C, 0x2007041C, 0x2247041D, 0x200B0402, 0x200C0402, 0x200D0402, 0x200E0402, 0x200E0408, 0x20120402, 0x20130402, 0x20140402, 0x20150402, 0x20150408, 0x2015041D, 0x20190402, 0x201A0402,
+		0x201A0404, 0x201A0408, 0x201A0414, 0x201A0415, 0x201E0402, 0x201F0402, 0x201F0408, 0x201F040A, 0x201F0414, 0x20230402, 0x20240402, 0x20280402, 0x202C0402, 0x202C0408, 0x202C0414, 0x20300402,
+		0x20310402, 0x20310414, 0x20320402, 0x20360402, 0x203A0402, 0x203E0402, 0x20420402, 0x20420403, 0x20420404, 0x3280404, 0x20420404, 0x3290404, 0x20420405, 0x20420405, 0x20420405, 0x20420405,
+		0x20420405, 0x20420405, 0x20420405, 0x20420405, 0x20420405, 0x20420405, 0x20420405, 0x20420405, 0x20420405, 0x20420406, 0x20420408, 0x20420409, 0x52A0404, 0x2042040A, 0x3280404, 0x2042040A,
+		0x3290404, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040B, 0x2042040C, 0x2042040C,
+		0x20420414, 0x2042041D, 0x2042041D, 0x20420402, 0x5C2, 0x20420408, 0x5C2, 0x20420404, 0x2324, 0x2042040A, 0x2324, 0x2042040A, 0x1FA2040A, 0x2264040A, 0x20420404, 0x20420404,
+		0x20420404, 0x20420404, 0x20900404, 0x20420404, 0x20420404, 0x20D60404, 0x20420404, 0x20900404, 0x20420404, 0x20D60404, 0x2042041C, 0x2109041C, 0x20420404, 0x21370404, 0x2042041D, 0x2193041D,
+		0x2007041D, 0x2007041D, 0x20460402, 0x20470402, 0x20480402, 0x20480408, 0x20490402, 0x204A0402, 0x204B0402, 0x204B0408, 0x204F0402, 0x204F0408, 0x20500402, 0x20510402, 0x20510403, 0x20510404,
+		0x3280404, 0x20510404, 0x3290404, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405, 0x20510405,
+		0x20510406, 0x20510408, 0x20510409, 0x3280404, 0x2051040A, 0x3290404, 0x2051040B, 0x2051040B, 0x2051040B, 0x2051040B, 0x2051040B, 0x2051040B, 0x2051040B, 0x2051040B, 0x2051040B, 0x2051040B,
+		0x2051040B, 0x2051040B, 0x2051040B, 0x2051040C, 0x2051040C, 0x20510414, 0x2051041D, 0x2051041D, 0x2051041D, 0x20510402, 0x482, 0x20510408, 0x482, 0x20510402, 0x4C2, 0x20510408,
+		0x4C2, 0x20510402, 0x4E2, 0x20510408, 0x4E2, 0x20510402, 0x502, 0x20510408, 0x502, 0x20510402, 0x5C2, 0x20510408, 0x5C2, 0x20510402, 0x602, 0x20510408,
+		0x602, 0x20510402, 0x642, 0x20510408, 0x642, 0x20510404, 0x6A4, 0x2051040A, 0x6A4, 0x20510404, 0x2324, 0x2051040A, 0x2324, 0x2051041C, 0x1FA2041C, 0x20D6041C,
+		0x2051041D, 0x1FBC041D, 0x2051041D, 0x2075041D, 0x2286041C, 0x2051041D, 0x216B041D, 0x1FA2041C, 0x2051041D, 0x2270041C, 0x20550402, 0x20550408, 0x20550414, 0x20590402, 0x205A0402, 0x205A0404,
+		0x205E0402, 0x205E0408, 0x20620402, 0x20630402, 0x20630408, 0x20670402, 0x206B0402, 0x206C0402, 0x206C0408, 0x206D0402, 0x206D0408, 0x206D0414, 0x20710402, 0x20710408, 0x20750402, 0x20750403,
+		0x20750404, 0x3280404, 0x20750404, 0x3290404, 0x20750405, 0x20750405, 0x20750405, 0x20750405, 0x20750405, 0x20750405, 0x20750405, 0x20750405, 0x20750405, 0x20750405, 0x20750405, 0x20750405,
+		0x20750405, 0x20750406, 0x20750408, 0x20750409, 0x3280404, 0x2075040A, 0x3290404, 0x2075040B, 0x2075040B, 0x2075040B, 0x2075040B, 0x2075040B, 0x2075040B, 0x2075040B, 0x2075040B, 0x2075040B,
+		0x2075040B, 0x2075040B, 0x2075040B, 0x2075040B, 0x2075040C, 0x2075040C, 0x20750414, 0x20750415, 0x2075041D, 0x2075041D, 0x2075041D, 0x20750402, 0x4E2, 0x20750408, 0x4E2, 0x20750402,
+		0x502, 0x20750408, 0x502, 0x20750402, 0x562, 0x20750408, 0x562, 0x20750402, 0x5C2, 0x20750408, 0x5C2, 0x20750402, 0x602, 0x20750408, 0x602, 0x20750402,
+		0x722, 0x20750402, 0x722, 0x20750408, 0x722, 0x20750414, 0x734, 0x20750402, 0x842, 0x20750408, 0x842, 0x20750402, 0x8E2, 0x20750408, 0x8E2, 0x20750402,
+		0x922, 0x2075041C, 0x1FA2041C, 0x2075041D, 0x1FD6041C, 0x2075041D, 0x1FEB041C, 0x2193041D, 0x2075041D, 0x2051041C, 0x2075041D, 0x2090041C, 0x209041C, 0x2193041D, 0x2007041C, 0x21D2041C,
+		0x2075041D, 0x216B041D, 0x2075041C, 0x216B041D, 0x1FA2041C, 0x2075041D, 0x2247041D, 0x2075041D, 0x2286041C, 0x20790402, 0x207D0402, 0x207D0408, 0x20810402, 0x20820402, 0x20820408, 0x20820414,
+		0x20860402, 0x20860408, 0x20870402, 0x20870408, 0x20890402, 0x20890408, 0x20890414, 0x208A0402, 0x208E0402, 0x208F0402, 0x20900402, 0x20900403, 0x20900404, 0x20900404, 0x3280404, 0x20900404,
+		0x3290404, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405, 0x20900405,
+		0x20900406, 0x20900408, 0x20900409, 0x2090040A, 0x3280404, 0x2090040A, 0x3290404, 0x2090040B, 0x2090040B, 0x2090040B, 0x2090040B, 0x2090040B, 0x2090040B, 0x2090040B, 0x2090040B, 0x2090040B,
+		0x2090040B, 0x2090040B, 0x2090040B, 0x2090040B, 0x2090040C, 0x2090040C, 0x20900414, 0x20900415, 0x2090041D, 0x2090041D, 0x2090041D, 0x20900402, 0x482, 0x20900408, 0x482, 0x20900402,
+		0x4A2, 0x20900408, 0x4A2, 0x20900402, 0x4C2, 0x20900408, 0x4C2, 0x20900402, 0x4E2, 0x20900408, 0x4E2, 0x20900402, 0x502, 0x20900408, 0x502, 0x20900402,
+		0x562, 0x20900408, 0x562, 0x20900402, 0x562, 0x482, 0x20900408, 0x562, 0x482, 0x20900402, 0x5A2, 0x20900408, 0x5A2, 0x20900408, 0x5C2, 0x20900402,
+		0x622, 0x20900408, 0x622, 0x20900402, 0x642, 0x20900408, 0x642, 0x20900402, 0x762, 0x20900408, 0x762, 0x20900402, 0x782, 0x20900408, 0x782, 0x20900402,
+		0x7C2, 0x20900408, 0x7C2, 0x20900402, 0x842, 0x20900408, 0x842, 0x20900402, 0x902, 0x20900408, 0x902, 0x2090041D, 0x1FD6041D, 0x2090041D, 0x1FEB041D, 0x20900404,
+		0x20900404, 0x2090040A, 0x2090040A, 0x20900404, 0x20900404, 0x20900404, 0x2090040A, 0x2090040A, 0x2090040A, 0x20900404, 0x20AB0404, 0x2090040A, 0x20AB040A, 0x2090041C, 0x2118041C, 0x2090041D,
+		0x2217041D, 0x20900404, 0x22470404, 0x2090040A, 0x2247040A, 0x20900404, 0x22640404, 0x2090040A, 0x2264040A, 0x20940402, 0x20940405, 0x20980402, 0x20980408, 0x20980414, 0x209C0402, 0x209D0402,
+		0x209E0402, 0x209E0414, 0x209F0402, 0x209F0408, 0x209F0414, 0x20A30402, 0x20A30414, 0x20A40402, 0x20A60402, 0x20A60408, 0x20A60414, 0x20AA0402, 0x20AB0402, 0x20AB0403, 0x3280404, 0x20AB0404,
+		0x3290404, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0405, 0x20AB0406,
+		0x20AB0408, 0x20AB0409, 0x3280404, 0x20AB040A, 0x3290404, 0x20AB040B, 0x20AB040B, 0x20AB040B, 0x20AB040B, 0x20AB040B, 0x20AB040B, 0x20AB040B, 0x20AB040B, 0x20AB040B, 0x20AB040B, 0x20AB040B,
+		0x20AB040B, 0x20AB040B, 0x20AB040C, 0x20AB040C, 0x20AB0414, 0x20AB0415, 0x20AB041D, 0x20AB041D, 0x20AB041D, 0x20AB0402, 0x4E2, 0x20AB0408, 0x4E2, 0x20AB0402, 0x502, 0x20AF0402,
+		0x20AF0405, 0x20B30402, 0x20B40402, 0x20B40408, 0x20B80402, 0x20B80408, 0x20B80414, 0x20BC0402, 0x20BC0414, 0x20C00402, 0x20C40402, 0x20C40403, 0x20C40404, 0x3280404, 0x20C40404, 0x3290404,
+		0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40405, 0x20C40406, 0x20C40408, 0x20C40408,
+		0x20C40409, 0x3280404, 0x20C4040A, 0x3290404, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B, 0x20C4040B,
+		0x20C4040B, 0x20C4040C, 0x20C4040C, 0x20C40414, 0x20C40415, 0x20C4041D, 0x20C4041D, 0x20C4041D, 0x20C40402, 0x482, 0x20C40408, 0x482, 0x20C40402, 0x502, 0x20C40408, 0x502,
+		0x20C40402, 0x602, 0x20C40408, 0x602, 0x20C40404, 0x6A4, 0x20C4040A, 0x6A4, 0x20C40402, 0x842, 0x20C40408, 0x842, 0x20C40402, 0x922, 0x20C40408, 0x922,
+		0x20C4041C, 0x1FA2041D, 0x20C4041D, 0x1FBC041D, 0x20C4041C, 0x1FD6041C, 0x1FA2041C, 0x20D6041C, 0x20C4041C, 0x2051041C, 0x20C4041C, 0x2075041D, 0x2286041C, 0x20C4041D, 0x20C4041D, 0x20C4041C,
+		0x20D6041C, 0x20C4041C, 0x2109041C, 0x20C4041D, 0x2109041D, 0x20C4041C, 0x2109041C, 0x1F9A041C, 0x20C4041C, 0x2109041C, 0x1F9B041C, 0x20C4041C, 0x216B041D, 0x1FA2041C, 0x20C4041C, 0x21F7041C,
+		0x20C4041C, 0x2247041D, 0x20C4041C, 0x2259041D, 0x20C4041C, 0x2346041D, 0x20C80402, 0x20C90402, 0x20CA0402, 0x20CA0408, 0x20CE0402, 0x20CE0408, 0x20CF0402, 0x20CF0408, 0x20D00402, 0x20D00408,
+		0x20D10402, 0x20D10408, 0x20D20402, 0x20D20408, 0x20D60402, 0x20D60403, 0x20D60404, 0x20D60404, 0x3280404, 0x20D60404, 0x3290404, 0x20D60405, 0x20D60405, 0x20D60405, 0x20D60405, 0x20D60405,
+		0x20D60405, 0x20D60405, 0x20D60405, 0x20D60405, 0x20D60405, 0x20D60405, 0x20D60405, 0x20D60405, 0x20D60405, 0x20D60406, 0x20D60408, 0x20D60409, 0x20D6040A, 0x3280404, 0x20D6040A, 0x3290404,
+		0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040B, 0x20D6040C, 0x20D6040C, 0x20D60414,
+		0x20D60415, 0x20D6041D, 0x20D6041D, 0x20D6041D, 0x20D60402, 0x482, 0x20D60408, 0x482, 0x20D60402, 0x502, 0x20D60408, 0x502, 0x20D60402, 0x602, 0x20D60408, 0x602,
+		0x20D60402, 0x722, 0x20D60408, 0x722, 0x20D60402, 0x842, 0x20D60408, 0x842, 0x20D60402, 0x842, 0x642, 0x20D60408, 0x842, 0x642, 0x20D60402, 0x8C2,
+		0x20D60408, 0x8C2, 0x20D60402, 0x922, 0x20D60408, 0x922, 0x20D60402, 0x2302, 0x20D60408, 0x2302, 0x20D60404, 0x20AB0404, 0x20D6040A, 0x20AB0404, 0x20D6040A, 0x20AB040A,
+		0x20D60404, 0x20D60404, 0x20D6040A, 0x20D6040A, 0x20D6041C, 0x2109041C, 0x20D6041C, 0x2118041C, 0x20D6041C, 0x213C041C, 0x2051041C, 0x20D6041D, 0x213C041C, 0x21D2041C, 0x21D2041C, 0x20D6041C,
+		0x2007041C, 0x21D2041C, 0x21D2041C, 0x20D60404, 0x21D20404, 0x20D6041D, 0x21F7041D, 0x1FEB041D, 0x20D6041C, 0x2264041C, 0x20D60404, 0x22860404, 0x20DA0402, 0x20DA0404, 0x20DA0414, 0x20DE0402,
+		0x20DE0408, 0x20DF0402, 0x20E00402, 0x20E00408, 0x20E10402, 0x20E10408, 0x20E50402, 0x20E50408, 0x20E60402, 0x20E60408, 0x20E60414, 0x20EA0402, 0x20EA0404, 0x20EB0402, 0x20EC0402, 0x20EC0408,
+		0x20F00402, 0x20F00414, 0x20F10402, 0x20F10414, 0x20F20402, 0x20F20414, 0x20F60402, 0x20F70402, 0x20FB0402, 0x20FC0402, 0x21000402, 0x21000408, 0x21010402, 0x21050402, 0x21090402, 0x21090403,
+		0x21090404, 0x21090404, 0x3280404, 0x21090404, 0x3290404, 0x21090405, 0x21090405, 0x21090405, 0x21090405, 0x21090405, 0x21090405, 0x21090405, 0x21090405, 0x21090405, 0x21090405, 0x21090405,
+		0x21090405, 0x21090405, 0x21090406, 0x21090408, 0x21090409, 0x2109040A, 0x3280404, 0x2109040A, 0x3290404, 0x2109040B, 0x2109040B, 0x2109040B, 0x2109040B, 0x2109040B, 0x2109040B, 0x2109040B,
+		0x2109040B, 0x2109040B, 0x2109040B, 0x2109040B, 0x2109040B, 0x2109040B, 0x2109040C, 0x2109040C, 0x21090414, 0x21090415, 0x2109041D, 0x2109041D, 0x2109041D, 0x21090402, 0x482, 0x21090408,
+		0x482, 0x21090402, 0x5C2, 0x21090408, 0x5C2, 0x21090402, 0x842, 0x21090408, 0x842, 0x2109041C, 0x1F9A041C, 0x2109041C, 0x1F9B041C, 0x2109041C, 0x1FA2041D, 0x2109041C,
+		0x1FBC041C, 0x2109041D, 0x1FBC041D, 0x21090414, 0x1FD60414, 0x21090414, 0x1FEB0414, 0x2109041C, 0x2051041C, 0x2109041D, 0x2075041D, 0x2286041C, 0x2109041C, 0x2090041C, 0x20D6041C, 0x2109041C,
+		0x20D6041C, 0x2109041C, 0x2109041C, 0x2109041C, 0x2109041C, 0x1F9A041C, 0x2109041C, 0x2109041C, 0x1F9B041C, 0x2109041C, 0x213C041C, 0x20D6041C, 0x2109041D, 0x216B041D, 0x1FA2041C, 0x2109041C,
+		0x675041C, 0x21D2041C, 0x2109041C, 0x21D2041C, 0x2109041C, 0x675041C, 0x21D2041C, 0x1F9A041C, 0x2109041C, 0x2247041D, 0x2109041D, 0x2247041D, 0x2109041D, 0x2247041D, 0x2109041C, 0x2259041D,
+		0x2109041D, 0x2259041D, 0x2109041D, 0x2346041D, 0x210D0402, 0x210D0404, 0x210E0402, 0x210F0402, 0x21100402, 0x21100408, 0x21100414, 0x21140402, 0x21150402, 0x21160402, 0x21170402, 0x21180402,
+		0x21180403, 0x21180404, 0x3280404, 0x21180404, 0x3290404, 0x21180405, 0x21180405, 0x21180405, 0x21180405, 0x21180405, 0x21180405, 0x21180405, 0x21180405, 0x21180405, 0x21180405, 0x21180405,
+		0x21180405, 0x21180405, 0x21180406, 0x21180408, 0x21180409, 0x3280404, 0x2118040A, 0x3290404, 0x2118040B, 0x2118040B, 0x2118040B, 0x2118040B, 0x2118040B, 0x2118040B, 0x2118040B, 0x2118040B,
+		0x2118040B, 0x2118040B, 0x2118040B, 0x2118040B, 0x2118040B, 0x2118040C, 0x2118040C, 0x21180414, 0x21180415, 0x2118041D, 0x2118041D, 0x2118041D, 0x21180402, 0x482, 0x21180408, 0x482,
+		0x21180402, 0x4A2, 0x21180408, 0x4A2, 0x21180402, 0x502, 0x21180408, 0x502, 0x21180402, 0x5A2, 0x21180408, 0x5A2, 0x21180402, 0x5C2, 0x21180408, 0x5C2,
+		0x21180402, 0x602, 0x21180408, 0x602, 0x21180404, 0x6A4, 0x2118040A, 0x6A4, 0x21180402, 0x842, 0x21180408, 0x842, 0x21180402, 0x8C2, 0x21180408, 0x8C2,
+		0x21180402, 0x922, 0x21180408, 0x922, 0x2118041C, 0x1FA2041D, 0x2118041D, 0x2007041D, 0x2259041D, 0x2118041C, 0x2042041D, 0x2118041D, 0x2051041D, 0x21180404, 0x20AB0404, 0x2118040A,
+		0x20AB0404, 0x2118040A, 0x20AB040A, 0x2118041C, 0x2109041C, 0x2118040A, 0x213C0404, 0x2118041C, 0x21D2041C, 0x2118041C, 0x2247041D, 0x2118041C, 0x2259041D, 0x211C0402, 0x211C0404, 0x211C0414,
+		0x21200402, 0x21210402, 0x21220402, 0x21230402, 0x21230408, 0x21230414, 0x21270402, 0x21270408, 0x212B0402, 0x212B0408, 0x212C0402, 0x212D0402, 0x212D0414, 0x21310402, 0x21350402, 0x21360402,
+		0x21370402, 0x21370408, 0x21370414, 0x213B0402, 0x213C0402, 0x213C0403, 0x213C0404, 0x3280404, 0x213C0404, 0x3290404, 0x213C0405, 0x213C0405, 0x213C0405, 0x213C0405, 0x213C0405, 0x213C0405,
+		0x213C0405, 0x213C0405, 0x213C0405, 0x213C0405, 0x213C0405, 0x213C0405, 0x213C0405, 0x213C0406, 0x213C0408, 0x213C0409, 0x3280404, 0x213C040A, 0x3290404, 0x213C040B, 0x213C040B, 0x213C040B,
+		0x213C040B, 0x213C040B, 0x213C040B, 0x213C040B, 0x213C040B, 0x213C040B, 0x213C040B, 0x213C040B, 0x213C040B, 0x213C040B, 0x213C040C, 0x213C040C, 0x213C0414, 0x213C0414, 0x213C0415, 0x213C041D,
+		0x213C041D, 0x213C041D, 0x213C0402, 0x482, 0x213C0408, 0x482, 0x213C0402, 0x4A2, 0x213C0408, 0x4A2, 0x213C0402, 0x4C2, 0x213C0408, 0x4C2, 0x213C0402, 0x4E2,
+		0x213C0408, 0x4E2, 0x213C0402, 0x4E2, 0x482, 0x213C0408, 0x4E2, 0x482, 0x213C0402, 0x4E2, 0x4A2, 0x213C0408, 0x4E2, 0x4A2, 0x213C0402, 0x4E2,
+		0x5A2, 0x213C0408, 0x4E2, 0x5A2, 0x213C0402, 0x4E2, 0x762, 0x213C0408, 0x4E2, 0x762, 0x213C0402, 0x502, 0x213C0408, 0x502, 0x213C0402, 0x562,
+		0x213C0404, 0x564, 0x213C0404, 0x564, 0x213C0408, 0x562, 0x213C040A, 0x564, 0x213C0402, 0x562, 0x642, 0x213C0408, 0x562, 0x642, 0x213C0402, 0x582,
+		0x213C0408, 0x582, 0x213C0402, 0x5A2, 0x213C0408, 0x5A2, 0x213C0402, 0x5A2, 0x482, 0x213C0408, 0x5A2, 0x482, 0x213C0402, 0x5A2, 0x562, 0x213C0408,
+		0x5A2, 0x562, 0x213C0402, 0x5A2, 0x642, 0x213C0408, 0x5A2, 0x642, 0x213C0402, 0x5C2, 0x213C0408, 0x5C2, 0x213C0402, 0x5C2, 0x642, 0x213C0408,
+		0x5C2, 0x642, 0x213C0402, 0x5E2, 0x213C0408, 0x5E2, 0x213C0402, 0x5E2, 0x482, 0x213C0408, 0x5E2, 0x482, 0x213C0402, 0x622, 0x213C0408, 0x622,
+		0x213C0402, 0x622, 0x642, 0x213C0408, 0x622, 0x642, 0x213C0402, 0x642, 0x213C0408, 0x642, 0x213C0402, 0x642, 0x482, 0x213C0408, 0x642, 0x482,
+		0x213C0402, 0x642, 0x4A2, 0x213C0408, 0x642, 0x4A2, 0x213C0404, 0x684, 0x213C0402, 0x762, 0x213C0408, 0x762, 0x213C0402, 0x782, 0x213C0408, 0x782,
+		0x213C0402, 0x7C2, 0x213C0408, 0x7C2, 0x213C0402, 0x7E2, 0x213C0408, 0x7E2, 0x213C0402, 0x7E2, 0x482, 0x213C0408, 0x7E2, 0x482, 0x213C0402, 0x7E2,
+		0x4A2, 0x213C0408, 0x7E2, 0x4A2, 0x213C0402, 0x7E2, 0x5A2, 0x213C0408, 0x7E2, 0x5A2, 0x213C0402, 0x7E2, 0x762, 0x213C0408, 0x7E2, 0x762,
+		0x213C0402, 0x7E2, 0x842, 0x213C0408, 0x7E2, 0x842, 0x213C0402, 0x842, 0x213C0408, 0x842, 0x213C0402, 0x842, 0x4E2, 0x213C0408, 0x842, 0x4E2,
+		0x213C0404, 0x2304, 0x20070404, 0x213C040A, 0x2304, 0x2007040A, 0x213C0414, 0x2314, 0x20070414, 0x213C041D, 0x20C4041D, 0x213C0404, 0x213C0404, 0x213C040A, 0x213C040A, 0x213C041C,
+		0x2247041D, 0x21400402, 0x21410402, 0x21420402, 0x21430402, 0x21470402, 0x21480402, 0x21490402, 0x214A0402, 0x214B0402, 0x214C0402, 0x214D0402, 0x214E0402, 0x214F0402, 0x214F0408, 0x214F0414,
+		0x21530402, 0x21540402, 0x21550402, 0x21560402, 0x21570402, 0x21580402, 0x21580408, 0x21590402, 0x21590414, 0x215A0402, 0x215A0414, 0x215B0402, 0x215C0402, 0x215C0408, 0x215C0414, 0x21600402,
+		0x21600408, 0x21610402, 0x21650402, 0x21650408, 0x21660402, 0x21660408, 0x2166041D, 0x216A0402, 0x216B0402, 0x216B0403, 0x216B0404, 0x3280404, 0x216B0404, 0x3290404, 0x216B0405, 0x216B0405,
+		0x216B0405, 0x216B0405, 0x216B0405, 0x216B0405, 0x216B0405, 0x216B0405, 0x216B0405, 0x216B0405, 0x216B0405, 0x216B0405, 0x216B0405, 0x216B0406, 0x216B0408, 0x216B0409, 0x3280404, 0x216B040A,
+		0x3290404, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040B, 0x216B040C, 0x216B040C,
+		0x216B0414, 0x216B0415, 0x216B041D, 0x216B041D, 0x216B041D, 0x216B041D, 0x216B0402, 0x482, 0x216B0408, 0x482, 0x216B0402, 0x5C2, 0x216B0408, 0x5C2, 0x216B041C, 0x1FA2041D,
+		0x216B041D, 0x1FA2041C, 0x216B041D, 0x1FA2041D, 0x216B041C, 0x1FD6041C, 0x216B041C, 0x2042041D, 0x216B041D, 0x2075041D, 0x216B041C, 0x27E041C, 0x2109041C, 0x27E041C, 0x216B041D, 0x216B041D,
+		0x2109041D, 0x216B041D, 0x216B041D, 0x2247041D, 0x216B041D, 0x2193041D, 0x216B041C, 0x21D2041C, 0x216B041D, 0x21F7041D, 0x2007041D, 0x216B041C, 0x2247041D, 0x216B041C, 0x2259041D, 0x216F0402,
+		0x21700402, 0x21700408, 0x21710402, 0x21710408, 0x21720402, 0x21730402, 0x21740402, 0x21740408, 0x21780402, 0x21780408, 0x21790402, 0x21790408, 0x217A0402, 0x217B0402, 0x217B0414, 0x217F0402,
+		0x21800402, 0x21800403, 0x3280404, 0x21800404, 0x3290404, 0x21800405, 0x21800405, 0x21800405, 0x21800405, 0x21800405, 0x21800405, 0x21800405, 0x21800405, 0x21800405, 0x21800405, 0x21800405,
+		0x21800405, 0x21800405, 0x21800406, 0x21800408, 0x21800409, 0x3280404, 0x2180040A, 0x3290404, 0x2180040B, 0x2180040B, 0x2180040B, 0x2180040B, 0x2180040B, 0x2180040B, 0x2180040B, 0x2180040B,
+		0x2180040B, 0x2180040B, 0x2180040B, 0x2180040B, 0x2180040B, 0x2180040C, 0x2180040C, 0x2180041D, 0x2180041D, 0x21800404, 0x216B0404, 0x21850402, 0x21850408, 0x21860402, 0x21860408, 0x21870402,
+		0x218B0402, 0x218B0408, 0x218F0402, 0x21930402, 0x21930403, 0x21930404, 0x21930404, 0x3280404, 0x21930404, 0x3290404, 0x21930405, 0x21930405, 0x21930405, 0x21930405, 0x21930405, 0x21930405,
+		0x21930405, 0x21930405, 0x21930405, 0x21930405, 0x21930405, 0x21930405, 0x21930405, 0x21930406, 0x21930408, 0x21930409, 0x3280404, 0x2193040A, 0x3290404, 0x2193040B, 0x2193040B, 0x2193040B,
+		0x2193040B, 0x2193040B, 0x2193040B, 0x2193040B, 0x2193040B, 0x2193040B, 0x2193040B, 0x2193040B, 0x2193040B, 0x2193040B, 0x2193040C, 0x2193040C, 0x2193040C, 0x21930414, 0x21930415, 0x2193041D,
+		0x2193041D, 0x2193041D, 0x21930402, 0x482, 0x21930408, 0x482, 0x21930402, 0x502, 0x21930408, 0x502, 0x21930402, 0x5C2, 0x21930408, 0x5C2, 0x21930402, 0x602,
+		0x21930408, 0x602, 0x21930404, 0x6A4, 0x2193040A, 0x6A4, 0x21930402, 0x782, 0x21930408, 0x782, 0x21930402, 0x7C2, 0x21930408, 0x7C2, 0x21930402, 0x842,
+		0x21930408, 0x842, 0x21930402, 0x842, 0x642, 0x21930408, 0x842, 0x642, 0x21930402, 0x922, 0x21930408, 0x922, 0x21930404, 0x2324, 0x2193040A, 0x2324,
+		0x2193041C, 0x1FA2041C, 0x1FEB041C, 0x2193041C, 0x1FA2041C, 0x1FEB041C, 0x675041C, 0x21D2041C, 0x2193041C, 0x1FA2041C, 0x1FEB041C, 0x675041C, 0x21D2041C, 0x1F9A041C, 0x2193040A, 0x21D20404,
+		0x21970402, 0x21980402, 0x21980404, 0x21980408, 0x219C0402, 0x219D0402, 0x219D0404, 0x219D0408, 0x219E0402, 0x219F0402, 0x219F0408, 0x21A30402, 0x21A40402, 0x21A40414, 0x21A80402, 0x21A90402,
+		0x21AD0402, 0x21AE0402, 0x21AE0414, 0x21B20402, 0x21B30402, 0x21B70402, 0x21B70408, 0x21BB0402, 0x21BC0402, 0x21C00402, 0x21C10402, 0x21C50402, 0x21C60402, 0x21C70402, 0x21C80402, 0x21C90402,
+		0x21CA0402, 0x21CA0414, 0x21CF0402, 0x21D00402, 0x21D10402, 0x21D10408, 0x21D20402, 0x21D20403, 0x21D20404, 0x3280404, 0x21D20404, 0x3290404, 0x21D20405, 0x21D20405, 0x21D20405, 0x21D20405,
+		0x21D20405, 0x21D20405, 0x21D20405, 0x21D20405, 0x21D20405, 0x21D20405, 0x21D20405, 0x21D20405, 0x21D20405, 0x21D20406, 0x21D20408, 0x21D20409, 0x3280404, 0x21D2040A, 0x3290404, 0x38A0404,
+		0x21D2040A, 0x38B0404, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040B, 0x21D2040C,
+		0x21D2040C, 0x21D20414, 0x21D20415, 0x21D2041D, 0x21D2041D, 0x21D20402, 0x482, 0x21D20408, 0x482, 0x21D20402, 0x482, 0x5C2, 0x21D20408, 0x482, 0x5C2, 0x21D20402,
+		0x4E2, 0x21D20408, 0x4E2, 0x21D20402, 0x502, 0x21D20408, 0x502, 0x21D20402, 0x502, 0x5C2, 0x21D20408, 0x502, 0x5C2, 0x21D20402, 0x5C2, 0x21D20408,
+		0x5C2, 0x21D20402, 0x602, 0x21D20408, 0x602, 0x21D20404, 0x6A4, 0x21D2040A, 0x6A4, 0x21D20402, 0x842, 0x21D20408, 0x842, 0x21D20402, 0x842, 0x5C2,
+		0x21D20408, 0x842, 0x5C2, 0x21D20402, 0x8A2, 0x21D20408, 0x8A2, 0x21D20404, 0x2324, 0x21D20404, 0x2324, 0x21D20404, 0x2324, 0x21D2040A, 0x2324, 0x21D20404,
+		0x2324, 0x5C2, 0x21D2041D, 0x1FA2041D, 0x21D2041D, 0x1FEB041D, 0x21D2041D, 0x2075041D, 0x2247041D, 0x21D20414, 0x21090414, 0x21D2041D, 0x213C041D, 0x21D2041D, 0x21D2041C, 0x2193041C,
+		0x21D2041D, 0x21D2041D, 0x21D20404, 0x2304, 0x21D20404, 0x21D2040A, 0x2304, 0x21D2040A, 0x21D20404, 0x21F70404, 0x21D20404, 0x2324, 0x21F70404, 0x21D2041D, 0x2247041C, 0x21D60402,
+		0x21D80402, 0x21D90402, 0x21DA0402, 0x21DA0414, 0x21DE0402, 0x21DE0408, 0x21E20402, 0x21E30402, 0x21E40402, 0x21E40404, 0x21E40408, 0x21E40414, 0x21E80402, 0x21E90402, 0x21EA0402, 0x21EE0402,
+		0x21F20402, 0x21F30402, 0x21F70402, 0x21F70403, 0x21F70404, 0x3280404, 0x21F70404, 0x3290404, 0x21F70405, 0x21F70405, 0x21F70405, 0x21F70405, 0x21F70405, 0x21F70405, 0x21F70405, 0x21F70405,
+		0x21F70405, 0x21F70405, 0x21F70405, 0x21F70405, 0x21F70405, 0x21F70406, 0x21F70408, 0x21F70409, 0x3280404, 0x21F7040A, 0x3290404, 0x21F7040B, 0x21F7040B, 0x21F7040B, 0x21F7040B, 0x21F7040B,
+		0x21F7040B, 0x21F7040B, 0x21F7040B, 0x21F7040B, 0x21F7040B, 0x21F7040B, 0x21F7040B, 0x21F7040B, 0x21F7040C, 0x21F7040C, 0x21F70414, 0x21F70415, 0x21F7041D, 0x21F7041D, 0x21F7041D, 0x21F70402,
+		0x502, 0x21F70408, 0x502, 0x21F70402, 0x562, 0x21F70402, 0x5C2, 0x21F70408, 0x5C2, 0x21F70402, 0x602, 0x21F70408, 0x602, 0x21F70402, 0x842, 0x21F70408,
+		0x842, 0x21F70402, 0x8A2, 0x21F70408, 0x8A2, 0x21F70402, 0x8C2, 0x21F70408, 0x8C2, 0x21F70402, 0x922, 0x21F70408, 0x922, 0x21F70404, 0x2324, 0x21F7040A,
+		0x2324, 0x21F70404, 0x1FE50404, 0x21F7040A, 0x2007040A, 0x20D6040A, 0x21F70404, 0x2304, 0x20750404, 0x21F7041D, 0x2075041D, 0x2286041C, 0x21F70414, 0x21090414, 0x21F70404, 0x21D20404,
+		0x21F70404, 0x21D20404, 0x21F70404, 0x21E40404, 0x21F70404, 0x22860404, 0x21F7040A, 0x22860404, 0x21FB0402, 0x21FC0402, 0x21FC0408, 0x22000402, 0x22000408, 0x22010402, 0x22020402, 0x22020414,
+		0x22060402, 0x22060408, 0x220A0402, 0x220A0408, 0x220E0402, 0x22120402, 0x22130402, 0x22130408, 0x22170402, 0x22170403, 0x22170404, 0x3280404, 0x22170404, 0x3290404, 0x22170405, 0x22170405,
+		0x22170405, 0x22170405, 0x22170405, 0x22170405, 0x22170405, 0x22170405, 0x22170405, 0x22170405, 0x22170405, 0x22170405, 0x22170405, 0x22170406, 0x22170408, 0x22170409, 0x3280404, 0x2217040A,
+		0x3290404, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040B, 0x2217040C, 0x2217040C,
+		0x22170414, 0x22170415, 0x2217041D, 0x2217041D, 0x2217041D, 0x22170402, 0x482, 0x22170408, 0x482, 0x22170402, 0x4A2, 0x22170408, 0x4A2, 0x22170402, 0x4C2, 0x22170408,
+		0x4C2, 0x22170402, 0x4E2, 0x22170408, 0x4E2, 0x22170402, 0x502, 0x22170408, 0x502, 0x22170402, 0x522, 0x22170408, 0x522, 0x22170402, 0x562, 0x22170404,
+		0x564, 0x22170404, 0x564, 0x22170408, 0x562, 0x2217040A, 0x564, 0x22170402, 0x562, 0x482, 0x22170408, 0x562, 0x482, 0x22170402, 0x562, 0x4A2,
+		0x22170408, 0x562, 0x4A2, 0x22170402, 0x562, 0x502, 0x22170408, 0x562, 0x502, 0x22170402, 0x562, 0x642, 0x22170408, 0x562, 0x642, 0x22170402,
+		0x582, 0x22170408, 0x582, 0x22170402, 0x5A2, 0x22170408, 0x5A2, 0x22170402, 0x5A2, 0x482, 0x22170408, 0x5A2, 0x482, 0x22170402, 0x622, 0x22170408,
+		0x622, 0x22170402, 0x642, 0x22170408, 0x642, 0x22170402, 0x642, 0x562, 0x22170408, 0x642, 0x562, 0x22170404, 0x684, 0x22170402, 0x762, 0x22170408,
+		0x762, 0x22170402, 0x782, 0x22170408, 0x782, 0x22170402, 0x7C2, 0x22170408, 0x7C2, 0x22170402, 0x7E2, 0x22170408, 0x7E2, 0x22170402, 0x7E2, 0x482,
+		0x22170408, 0x7E2, 0x482, 0x22170402, 0x7E2, 0x4A2, 0x22170408, 0x7E2, 0x4A2, 0x22170402, 0x7E2, 0x5A2, 0x22170408, 0x7E2, 0x5A2, 0x22170402,
+		0x7E2, 0x762, 0x22170408, 0x7E2, 0x762, 0x22170402, 0x7E2, 0x842, 0x22170408, 0x7E2, 0x842, 0x22170402, 0x842, 0x22170408, 0x842, 0x22170402,
+		0x862, 0x22170408, 0x862, 0x22170402, 0x8C2, 0x22170408, 0x8C2, 0x22170402, 0x902, 0x22170408, 0x902, 0x2217041D, 0x2075041D, 0x1FEB041D, 0x2217041D, 0x216B041D,
+		0x267041C, 0x221B0402, 0x221B0414, 0x221C0402, 0x221D0402, 0x221D0414, 0x221E0402, 0x221F0402, 0x22200402, 0x22210402, 0x22220402, 0x22220408, 0x22220414, 0x22260402, 0x22280402, 0x22290402,
+		0x222A0402, 0x222A0414, 0x222C0402, 0x222C0408, 0x222C0414, 0x22300402, 0x22340402, 0x22380402, 0x22380408, 0x22380414, 0x223C0402, 0x223D0402, 0x223E0402, 0x223E0414, 0x22420402, 0x22420408,
+		0x22420414, 0x22460402, 0x22470402, 0x22470403, 0x22470404, 0x22470404, 0x3280404, 0x22470404, 0x3290404, 0x22470405, 0x22470405, 0x22470405, 0x22470405, 0x22470405, 0x22470405, 0x22470405,
+		0x22470405, 0x22470405, 0x22470405, 0x22470405, 0x22470405, 0x22470405, 0x22470406, 0x22470408, 0x22470409, 0x2247040A, 0x3280404, 0x2247040A, 0x3290404, 0x2247040B, 0x2247040B, 0x2247040B,
+		0x2247040B, 0x2247040B, 0x2247040B, 0x2247040B, 0x2247040B, 0x2247040B, 0x2247040B, 0x2247040B, 0x2247040B, 0x2247040B, 0x2247040C, 0x2247040C, 0x22470414, 0x22470415, 0x2247041D, 0x2247041D,
+		0x2247041D, 0x22470402, 0x5A2, 0x22470408, 0x5A2, 0x22470402, 0x842, 0x22470408, 0x842, 0x22470404, 0x20900404, 0x2247040A, 0x2090040A, 0x22470404, 0x20900404, 0x20900404,
+		0x2247040A, 0x2090040A, 0x2090040A, 0x22470404, 0x20900404, 0x20900404, 0x20900404, 0x2247040A, 0x2090040A, 0x2090040A, 0x2090040A, 0x2247041D, 0x675041C, 0x2109041C, 0x2247041D, 0x213C041D,
+		0x1FEB041D, 0x2247041D, 0x21D2041D, 0x22470404, 0x22700404, 0x2247040A, 0x2270040A, 0x224B0402, 0x224C0402, 0x224C0408, 0x224D0402, 0x224E0402, 0x224E0408, 0x224E0414, 0x22520402, 0x22530402,
+		0x22540402, 0x22540408, 0x22550402, 0x22550408, 0x22550414, 0x22590402, 0x22590403, 0x22590404, 0x3280404, 0x22590404, 0x3290404, 0x22590405, 0x22590405, 0x22590405, 0x22590405, 0x22590405,
+		0x22590405, 0x22590405, 0x22590405, 0x22590405, 0x22590405, 0x22590405, 0x22590405, 0x22590405, 0x22590406, 0x22590408, 0x22590409, 0x3280404, 0x2259040A, 0x3290404, 0x2259040B, 0x2259040B,
+		0x2259040B, 0x2259040B, 0x2259040B, 0x2259040B, 0x2259040B, 0x2259040B, 0x2259040B, 0x2259040B, 0x2259040B, 0x2259040B, 0x2259040B, 0x2259040C, 0x2259040C, 0x22590414, 0x2259041D, 0x2259041D,
+		0x2259041D, 0x22590402, 0x482, 0x22590408, 0x482, 0x22590402, 0x4A2, 0x22590408, 0x4A2, 0x22590402, 0x4E2, 0x22590408, 0x4E2, 0x22590402, 0x522, 0x22590402,
+		0x562, 0x22590408, 0x562, 0x22590402, 0x5C2, 0x22590408, 0x5C2, 0x22590402, 0x842, 0x22590408, 0x842, 0x2259041D, 0x1FBC041C, 0x2259041D, 0x1FD6041D, 0x2259041D,
+		0x1FD6041D, 0x2259040C, 0x2286040C, 0x225D0402, 0x225F0402, 0x225F0408, 0x22600402, 0x22640402, 0x22640403, 0x22640404, 0x22640404, 0x3280404, 0x22640404, 0x3290404, 0x22640405, 0x22640405,
+		0x22640405, 0x22640405, 0x22640405, 0x22640405, 0x22640405, 0x22640405, 0x22640405, 0x22640405, 0x22640405, 0x22640405, 0x22640405, 0x22640406, 0x22640408, 0x22640409, 0x2264040A, 0x3280404,
+		0x2264040A, 0x3290404, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040B, 0x2264040C,
+		0x2264040C, 0x22640414, 0x22640415, 0x2264041D, 0x2264041D, 0x22640402, 0x562, 0x22640408, 0x562, 0x22640402, 0x5C2, 0x22640408, 0x5C2, 0x22640404, 0x20900404, 0x2264040A,
+		0x2090040A, 0x22640404, 0x20900404, 0x20900404, 0x2264040A, 0x2090040A, 0x2090040A, 0x22680402, 0x22690402, 0x226A0402, 0x226B0402, 0x226C0402, 0x226D0402, 0x226D0408, 0x226E0402, 0x226F0402,
+		0x22700402, 0x22700403, 0x3280404, 0x22700404, 0x3290404, 0x22700405, 0x22700405, 0x22700405, 0x22700405, 0x22700405, 0x22700405, 0x22700405, 0x22700405, 0x22700405, 0x22700405, 0x22700405,
+		0x22700405, 0x22700405, 0x22700406, 0x22700408, 0x22700409, 0x3280404, 0x2270040A, 0x3290404, 0x2270040B, 0x2270040B, 0x2270040B, 0x2270040B, 0x2270040B, 0x2270040B, 0x2270040B, 0x2270040B,
+		0x2270040B, 0x2270040B, 0x2270040B, 0x2270040B, 0x2270040B, 0x2270040C, 0x2270040C, 0x22700414, 0x2270041D, 0x2270041D, 0x22700402, 0x482, 0x22700408, 0x482, 0x22700402, 0x4A2,
+		0x22700408, 0x4A2, 0x22700402, 0x4E2, 0x22700408, 0x4E2, 0x22700402, 0x522, 0x22700402, 0x562, 0x22700408, 0x562, 0x22700402, 0x5A2, 0x22700408, 0x5A2,
+		0x22700402, 0x5C2, 0x22700408, 0x5C2, 0x22700402, 0x642, 0x22700408, 0x642, 0x22700402, 0x762, 0x22700408, 0x762, 0x22700402, 0x842, 0x22700408, 0x842,
+		0x22740402, 0x22780402, 0x22780408, 0x227C0402, 0x227C0408, 0x22800402, 0x22800408, 0x22810402, 0x22820402, 0x22820408, 0x22860402, 0x22860403, 0x22860404, 0x3280404, 0x22860404, 0x3290404,
+		0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860405, 0x22860406, 0x22860408, 0x22860409,
+		0x3280404, 0x2286040A, 0x3290404, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B, 0x2286040B,
+		0x2286040C, 0x2286040C, 0x22860414, 0x2286041D, 0x2286041D, 0x22860402, 0x482, 0x22860408, 0x482, 0x22860402, 0x4E2, 0x22860408, 0x4E2, 0x22860402, 0x502, 0x22860408,
+		0x502, 0x22860402, 0x5C2, 0x22860408, 0x5C2, 0x22860402, 0x842, 0x22860408, 0x842, 0x22860402, 0x922, 0x22860408, 0x922, 0x22860404, 0x22590404, 0x228A0402,
+		0x228B0402, 0x228B0408, 0x228F0402, 0x22900402, 0x22910402, 0x22910408, 0x22950402, 0x22950414, 0x22990402, 0x22990414, 0x229D0402, 0x229D0408, 0x22A10402, 0x22A10408, 0x22A20402, 0x22A20408,
+		0x22A30402, 0x22A30408, 0x22A30414, 0x22A30402, 0x502, 0x22A30408, 0x502, 0x22A70402, 0x22A80402, 0x22A80408, 0x22AC0402, 0x22AD0402, 0x22B10402, 0x22B50402, 0x22B50408, 0x22B90402,
+		0x22B90408, 0x22BA0402, 0x22BA0408, 0x22BB0402, 0x22BB0408, 0x22BF0402, 0x22BF0408, 0x22C00402, 0x22C10402, 0x22C20402, 0x22C30402, 0x22C30408, 0x22C40402, 0x22C40408, 0x22C50402, 0x22C50404,
+		0x22C50408, 0x22C50414, 0x22C60402, 0x22C70402, 0x22CB0402, 0x22CB0408, 0x22CC0402, 0x22CC0408, 0x22CD0402, 0x22CD0408, 0x22CE0402, 0x22CE0408, 0x22D20402, 0x22D20408, 0x22D60402, 0x22D60408,
+		0x22DA0402, 0x22DE0402, 0x22DE0408, 0x22E20402, 0x22E30402, 0x22E30404, 0x21180404, 0x22E40402, 0x22E50402, 0x22E60402, 0x22E60408, 0x22E70402, 0x22E70408, 0x22E80402, 0x22E90402, 0x22E90414,
+		0x22ED0402, 0x22EE0402, 0x22EF0402, 0x22F00402, 0x22F00414, 0x22F10402, 0x22F10408, 0x22F20402, 0x22F60402, 0x22FA0402, 0x22FE0402, 0x23020402, 0x23060402, 0x230A0402, 0x230E0402, 0x23120402,
+		0x23160402, 0x231A0402, 0x231E0402, 0x231E0405, 0x231E0405, 0x231E0405, 0x231E0405, 0x231E0405, 0x231E0408, 0x231E040B, 0x231E040B, 0x231E040B, 0x231E040B, 0x231E040B, 0x231E0402, 0x442,
+		0x231E0408, 0x442, 0x231E0402, 0x442, 0x482, 0x231E0408, 0x442, 0x482, 0x231E0402, 0x442, 0x482, 0x982, 0x231E0408, 0x442, 0x482, 0x982,
+		0x231E0402, 0x442, 0x4A2, 0x231E0408, 0x442, 0x4A2, 0x231E0402, 0x442, 0x4A2, 0x982, 0x231E0408, 0x442, 0x4A2, 0x982, 0x231E0402, 0x442,
+		0x542, 0x231E0408, 0x442, 0x542, 0x231E0402, 0x442, 0x542, 0x982, 0x231E0408, 0x442, 0x542, 0x982, 0x231E0402, 0x442, 0x982, 0x231E0408,
+		0x442, 0x982, 0x231E0402, 0x462, 0x231E0408, 0x462, 0x231E0402, 0x462, 0x482, 0x231E0408, 0x462, 0x482, 0x231E0402, 0x462, 0x482, 0x982,
+		0x231E0408, 0x462, 0x482, 0x982, 0x231E0402, 0x462, 0x4A2, 0x231E0408, 0x462, 0x4A2, 0x231E0402, 0x462, 0x4A2, 0x982, 0x231E0408, 0x462,
+		0x4A2, 0x982, 0x231E0402, 0x462, 0x542, 0x231E0408, 0x462, 0x542, 0x231E0402, 0x462, 0x542, 0x982, 0x231E0408, 0x462, 0x542, 0x982,
+		0x231E0402, 0x462, 0x982, 0x231E0408, 0x462, 0x982, 0x231E0402, 0x482, 0x231E0402, 0x482, 0x231E0408, 0x482, 0x231E0408, 0x482, 0x231E0402, 0x482,
+		0x982, 0x231E0402, 0x4A2, 0x231E0408, 0x4A2, 0x231E0402, 0x4A2, 0x982, 0x231E0402, 0x4C2, 0x231E0408, 0x4C2, 0x231E0402, 0x542, 0x231E0402, 0x542,
+		0x982, 0x231E0402, 0x642, 0x231E0408, 0x642, 0x231E0402, 0x982, 0x231E0408, 0x982, 0x231F0402, 0x231F0404, 0x231F0405, 0x231F0405, 0x231F0405, 0x231F0405, 0x231F0405,
+		0x231F0408, 0x231F040B, 0x231F040B, 0x231F040B, 0x231F040B, 0x231F040B, 0x231F0414, 0x231F0415, 0x23200402, 0x23200405, 0x23200405, 0x23200405, 0x23200405, 0x23200405, 0x23200405, 0x23200408,
+		0x2320040B, 0x2320040B, 0x2320040B, 0x2320040B, 0x2320040B, 0x2320040B, 0x23200414, 0x23200415, 0x23210402, 0x23220402, 0x23220405, 0x23220405, 0x23220405, 0x23220405, 0x23220405, 0x23220408,
+		0x2322040B, 0x2322040B, 0x2322040B, 0x2322040B, 0x2322040B, 0x23220414, 0x23230402, 0x23230404, 0x23230405, 0x23230405, 0x23230405, 0x23230405, 0x23230405, 0x23230405, 0x23230405, 0x23230405,
+		0x23230405, 0x23230405, 0x23230408, 0x2323040B, 0x2323040B, 0x2323040B, 0x2323040B, 0x2323040B, 0x23230402, 0x442, 0x23230408, 0x442, 0x23230402, 0x442, 0x482, 0x23230408,
+		0x442, 0x482, 0x23230402, 0x442, 0x4A2, 0x23230408, 0x442, 0x4A2, 0x23230402, 0x462, 0x23230408, 0x462, 0x23230402, 0x462, 0x482, 0x23230408,
+		0x462, 0x482, 0x23230402, 0x462, 0x4A2, 0x23230408, 0x462, 0x4A2, 0x23230402, 0x482, 0x23230402, 0x482, 0x23230408, 0x482, 0x23230408, 0x482,
+		0x23230402, 0x4A2, 0x23230408, 0x4A2, 0x23240402, 0x23240405, 0x23240408, 0x2324040B, 0x23250402, 0x23250408, 0x23260402, 0x23260408, 0x23270402, 0x23270405, 0x23270405, 0x23270405,
+		0x23270405, 0x23270405, 0x23270408, 0x2327040B, 0x2327040B, 0x2327040B, 0x2327040B, 0x2327040B, 0x23280402, 0x23280408, 0x23290402, 0x23290405, 0x23290405, 0x23290405, 0x23290405, 0x23290405,
+		0x23290408, 0x2329040B, 0x2329040B, 0x2329040B, 0x2329040B, 0x2329040B, 0x23290402, 0x442, 0x23290408, 0x442, 0x23290402, 0x442, 0x482, 0x23290408, 0x442, 0x482,
+		0x23290402, 0x442, 0x482, 0x982, 0x23290408, 0x442, 0x482, 0x982, 0x23290402, 0x442, 0x4A2, 0x23290408, 0x442, 0x4A2, 0x23290402, 0x442,
+		0x4A2, 0x982, 0x23290408, 0x442, 0x4A2, 0x982, 0x23290402, 0x442, 0x542, 0x23290408, 0x442, 0x542, 0x23290402, 0x442, 0x542, 0x982,
+		0x23290408, 0x442, 0x542, 0x982, 0x23290402, 0x442, 0x982, 0x23290408, 0x442, 0x982, 0x23290402, 0x462, 0x23290408, 0x462, 0x23290402, 0x462,
+		0x482, 0x23290408, 0x462, 0x482, 0x23290402, 0x462, 0x482, 0x982, 0x23290408, 0x462, 0x482, 0x982, 0x23290402, 0x462, 0x4A2, 0x23290408,
+		0x462, 0x4A2, 0x23290402, 0x462, 0x4A2, 0x982, 0x23290408, 0x462, 0x4A2, 0x982, 0x23290402, 0x462, 0x542, 0x23290408, 0x462, 0x542,
+		0x23290402, 0x462, 0x542, 0x982, 0x23290408, 0x462, 0x542, 0x982, 0x23290402, 0x462, 0x982, 0x23290408, 0x462, 0x982, 0x23290402, 0x482,
+		0x23290402, 0x482, 0x23290408, 0x482, 0x23290408, 0x482, 0x23290402, 0x482, 0x982, 0x23290402, 0x4A2, 0x23290408, 0x4A2, 0x23290402, 0x4A2, 0x982,
+		0x23290402, 0x542, 0x23290402, 0x542, 0x982, 0x23290402, 0x982, 0x23290408, 0x982, 0x232A0402, 0x232A0404, 0x232A0405, 0x232A0405, 0x232A0405, 0x232A0405, 0x232A0405,
+		0x232A0405, 0x232A0405, 0x232A0405, 0x232A0405, 0x232A0405, 0x232A0408, 0x232A040A, 0x232A040B, 0x232A040B, 0x232A040B, 0x232A040B, 0x232A040B, 0x232A040B, 0x232A040B, 0x232A040B, 0x232A040B,
+		0x232A040B, 0x232A0414, 0x232B0402, 0x232B0402, 0x232B0404, 0x232B0405, 0x232B0405, 0x232B0405, 0x232B0405, 0x232B0405, 0x232B0408, 0x232B040B, 0x232B040B, 0x232B040B, 0x232B040B, 0x232B040B,
+		0x232B0402, 0x442, 0x232B0408, 0x442, 0x232B0402, 0x442, 0x482, 0x232B0408, 0x442, 0x482, 0x232B0402, 0x442, 0x4A2, 0x232B0408, 0x442, 0x4A2,
+		0x232B0402, 0x442, 0x542, 0x232B0408, 0x442, 0x542, 0x232B0402, 0x462, 0x232B0408, 0x462, 0x232B0402, 0x462, 0x482, 0x232B0408, 0x462, 0x482,
+		0x232B0402, 0x462, 0x4A2, 0x232B0408, 0x462, 0x4A2, 0x232B0402, 0x462, 0x542, 0x232B0408, 0x462, 0x542, 0x232B0402, 0x482, 0x232B0402, 0x482,
+		0x232B0408, 0x482, 0x232B0408, 0x482, 0x232B0402, 0x4A2, 0x232B0408, 0x4A2, 0x232B0402, 0x4C2, 0x232B0408, 0x4C2, 0x232B0402, 0x542, 0x232B0402, 0x562,
+		0x232B0408, 0x562, 0x232B0402, 0x562, 0x482, 0x232B0402, 0x562, 0x482, 0x232B0402, 0x562, 0x4A2, 0x232B0402, 0x562, 0x542, 0x232B0402, 0x642,
+		0x232B0408, 0x642, 0x232C0402, 0x232C0408, 0x232D0402, 0x232D0404, 0x232D0405, 0x232D0405, 0x232D0405, 0x232D0405, 0x232D0405, 0x232D0405, 0x232D0405, 0x232D0405, 0x232D0405, 0x232D0405,
+		0x232D0408, 0x232D040B, 0x232D040B, 0x232D040B, 0x232D040B, 0x232D040B, 0x232D0404, 0x231E0404, 0x232B0404, 0x232D040A, 0x231E0404, 0x232B0404, 0x232E0402, 0x232E0405, 0x232E0405, 0x232E0405,
+		0x232E0405, 0x232E0405, 0x232E0408, 0x232E040B, 0x232E040B, 0x232E040B, 0x232E040B, 0x232E040B, 0x232F0402, 0x23300402, 0x23300404, 0x23300405, 0x23300405, 0x23300405, 0x23300405, 0x23300405,
+		0x23300408, 0x2330040B, 0x2330040B, 0x2330040B, 0x2330040B, 0x2330040B, 0x2330041C, 0x1FA2041D, 0x2330041C, 0x2042041D, 0x2330041C, 0x2051041C, 0x2330041C, 0x20D6041C, 0x2330041C, 0x2109041C,
+		0x2330041C, 0x21D2041C, 0x2330041C, 0x2247041D, 0x2330041C, 0x2259041D, 0x23310402, 0x23310405, 0x23310405, 0x23310405, 0x23310405, 0x23310405, 0x23310408, 0x2331040B, 0x2331040B, 0x2331040B,
+		0x2331040B, 0x2331040B, 0x23320402, 0x23320405, 0x23320405, 0x23320405, 0x23320405, 0x23320405, 0x23320408, 0x2332040B, 0x2332040B, 0x2332040B, 0x2332040B, 0x2332040B, 0x23330402, 0x23330405,
+		0x23330405, 0x23330405, 0x23330405, 0x23330405, 0x23330408, 0x2333040B, 0x2333040B, 0x2333040B, 0x2333040B, 0x2333040B, 0x23330402, 0x442, 0x23330408, 0x442, 0x23330402, 0x442,
+		0x482, 0x23330408, 0x442, 0x482, 0x23330402, 0x442, 0x4A2, 0x23330408, 0x442, 0x4A2, 0x23330402, 0x462, 0x23330408, 0x462, 0x23330402, 0x462,
+		0x482, 0x23330408, 0x462, 0x482, 0x23330402, 0x462, 0x4A2, 0x23330408, 0x462, 0x4A2, 0x23330402, 0x482, 0x23330402, 0x482, 0x23330408, 0x482,
+		0x23330408, 0x482, 0x23330402, 0x4A2, 0x23330408, 0x4A2, 0x23340402, 0x23340404, 0x23340405, 0x23340405, 0x23340405, 0x23340405, 0x23340405, 0x23340405, 0x23340405, 0x23340405,
+		0x23340405, 0x23340405, 0x23340405, 0x23340408, 0x2334040B, 0x2334040B, 0x2334040B, 0x2334040B, 0x2334040B, 0x2334040B, 0x23350402, 0x23360402, 0x23360408, 0x23370402, 0x23370408, 0x23380402,
+		0x23380408, 0x23390402, 0x23390404, 0x23390405, 0x23390405, 0x23390405, 0x23390405, 0x23390405, 0x23390405, 0x23390405, 0x23390405, 0x23390405, 0x23390405, 0x23390408, 0x2339040B, 0x2339040B,
+		0x2339040B, 0x2339040B, 0x2339040B, 0x23390415, 0x23390402, 0x442, 0x23390402, 0x462, 0x23390408, 0x462, 0x233A0402, 0x233B0402, 0x233C0402, 0x233C0404, 0x233C0405, 0x233C0405,
+		0x233C0405, 0x233C0405, 0x233C0405, 0x233C0405, 0x233C0405, 0x233C0405, 0x233C0405, 0x233C0405, 0x233C0408, 0x233C040A, 0x233C040B, 0x233C040B, 0x233C040B, 0x233C040B, 0x233C040B, 0x233C0419,
+		0x233D0402, 0x233D0408, 0x233E0402, 0x233E0408, 0x233F0402, 0x233F0408, 0x23400402, 0x23400405, 0x23400405, 0x23400405, 0x23400405, 0x23400405, 0x23400408, 0x2340040B, 0x2340040B, 0x2340040B,
+		0x2340040B, 0x2340040B, 0x23410402, 0x23410405, 0x23410405, 0x23410405, 0x23410405, 0x23410405, 0x23410408, 0x2341040A, 0x2341040B, 0x2341040B, 0x2341040B, 0x2341040B, 0x2341040B, 0x23410402,
+		0x442, 0x23410402, 0x442, 0x482, 0x23410402, 0x442, 0x4A2, 0x23410402, 0x442, 0x542, 0x23410402, 0x462, 0x23410408, 0x462, 0x23410402, 0x462,
+		0x482, 0x23410408, 0x462, 0x482, 0x23410402, 0x462, 0x4A2, 0x23410408, 0x462, 0x4A2, 0x23410402, 0x462, 0x542, 0x23410408, 0x462, 0x542,
+		0x23410402, 0x482, 0x23410402, 0x482, 0x23410408, 0x482, 0x23410408, 0x482, 0x2341040A, 0x482, 0x23410402, 0x4A2, 0x23410408, 0x4A2, 0x23410402, 0x4C2,
+		0x23410408, 0x4C2, 0x23410402, 0x542, 0x23410402, 0x562, 0x23410408, 0x562, 0x2341040A, 0x562, 0x23410402, 0x562, 0x482, 0x23410402, 0x562, 0x482,
+		0x23410402, 0x562, 0x4A2, 0x23410402, 0x562, 0x542, 0x23410402, 0x642, 0x23410408, 0x642, 0x23420402, 0x23420404, 0x23420405, 0x23420405, 0x23420405, 0x23420405,
+		0x23420405, 0x23420405, 0x23420405, 0x23420405, 0x23420405, 0x23420405, 0x23420408, 0x2342040B, 0x2342040B, 0x2342040B, 0x2342040B, 0x2342040B, 0x23420414, 0x23420415, 0x23430402, 0x23430405,
+		0x23430405, 0x23430405, 0x23430405, 0x23430405, 0x23430408, 0x2343040B, 0x2343040B, 0x2343040B, 0x2343040B, 0x2343040B, 0x23430414, 0x23430415, 0x23440402, 0x23440405, 0x23440405, 0x23440405,
+		0x23440405, 0x23440405, 0x23440408, 0x2344040B, 0x2344040B, 0x2344040B, 0x2344040B, 0x2344040B, 0x23450402, 0x23460402, 0x23460405, 0x23460405, 0x23460405, 0x23460405, 0x23460405, 0x23460408,
+		0x23460408, 0x2346040B, 0x2346040B, 0x2346040B, 0x2346040B, 0x2346040B, 0x23460402, 0x442, 0x23460408, 0x442, 0x23460402, 0x442, 0x482, 0x23460408, 0x442, 0x482,
+		0x23460402, 0x442, 0x482, 0x982, 0x23460408, 0x442, 0x482, 0x982, 0x23460402, 0x442, 0x4A2, 0x23460408, 0x442, 0x4A2, 0x23460402, 0x442,
+		0x4A2, 0x982, 0x23460408, 0x442, 0x4A2, 0x982, 0x23460402, 0x442, 0x542, 0x23460408, 0x442, 0x542, 0x23460402, 0x442, 0x542, 0x982,
+		0x23460408, 0x442, 0x542, 0x982, 0x23460402, 0x442, 0x982, 0x23460408, 0x442, 0x982, 0x23460402, 0x462, 0x23460408, 0x462, 0x23460402, 0x462,
+		0x482, 0x23460408, 0x462, 0x482, 0x23460402, 0x462, 0x482, 0x982, 0x23460408, 0x462, 0x482, 0x982, 0x23460402, 0x462, 0x4A2, 0x23460408,
+		0x462, 0x4A2, 0x23460402, 0x462, 0x4A2, 0x982, 0x23460408, 0x462, 0x4A2, 0x982, 0x23460402, 0x462, 0x542, 0x23460408, 0x462, 0x542,
+		0x23460402, 0x462, 0x542, 0x982, 0x23460408, 0x462, 0x542, 0x982, 0x23460402, 0x462, 0x982, 0x23460408, 0x462, 0x982, 0x23460402, 0x482,
+		0x23460402, 0x482, 0x23460408, 0x482, 0x23460408, 0x482, 0x23460402, 0x482, 0x982, 0x23460402, 0x4A2, 0x23460408, 0x4A2, 0x23460402, 0x4A2, 0x982,
+		0x23460402, 0x542, 0x23460402, 0x542, 0x982, 0x23460402, 0x982, 0x23460408, 0x982, 0x23470402, 0x23480402, 0x23480408, 0x23490402, 0x23490408, 0x234A0402, 0x234A0408,
+		0x234B0402, 0x234B0408, 0x234C0402, 0x234C0408, 0x234D0402, 0x234D0408, 0x234E0402, 0x234E0408, 0x234F0402, 0x234F0408, 0x23500402, 0x23500408, 0x23510402, 0x23510408, 0x23520402, 0x23520408,
+		0x23530402, 0x23530408, 0x23540402, 0x23540408, 0x23550402, 0x23550408, 0x23560402, 0x23560408, 0x23560404, 0x234B0404, 0x23550404, 0x23570402, 0x23570408, 0x23580402, 0x23580408, 0x23590402,
+		0x23590408, 0x235A0402, 0x235A0408, 0x235B0402, 0x235B0408, 0x235C0402, 0x235C0408, 0x235D0402, 0x235D0408, 0x235E0402, 0x235E0408, 0x235F0402, 0x235F0408, 0x23600402, 0x23600408, 0x23610402,
+		0x23610408, 0x23620402, 0x23620408, 0x23630402, 0x23630408, 0x23640402, 0x23640408, 0x23650402, 0x23650408, 0x23660402, 0x23660408, 0x23670402, 0x23670408, 0x23680402, 0x23680408, 0x23690402,
+		0x23690408, 0x236A0402, 0x236A0408, 0x236B0402, 0x236B0408, 0x236C0402, 0x236C0408, 0x236D0402, 0x236D0408, 0x236E0402, 0x236E0408, 0x236F0402, 0x236F0408, 0x23700402, 0x23700408, 0x23710402,
+		0x23710408, 0x23720402, 0x23720408, 0x23730402, 0x23730408, 0x23740402, 0x23740408, 0x23750402, 0x23750408, 0x23760402, 0x23760408, 0x23770402, 0x23770408, 0x23780402, 0x23780408, 0x23790402,
+		0x23790408, 0x237A0402, 0x237A0408, 0x237B0402, 0x237B0408, 0x237C0402, 0x237C0408, 0x237D0402, 0x237D0408, 0x237E0402, 0x237E0408, 0x237F0402, 0x237F0408, 0x23800402, 0x23800408, 0x23810402,
+		0x23810408, 0x23820402, 0x23820408, 0x23830402, 0x23830408, 0x23840402, 0x23840408, 0x23850402, 0x23850408, 0x23860402, 0x23860408, 0x23870402, 0x23870404, 0x23870408, 0x23870402, 0x4C2,
+		0x23870408, 0x4C2, 0x23870402, 0x562, 0x23870408, 0x562, 0x238B0402, 0x238B0408, 0x238B0402, 0x562, 0x238B0408, 0x562, 0x238F0402, 0x238F0408, 0x23930402, 0x23930404,
+		0x23930408, 0x23970402, 0x23970404, 0x23970404, 0x23970408, 0x239B0402, 0x239B0404, 0x239B0408, 0x239B0402, 0x482, 0x239B0408, 0x482, 0x239B0404, 0x2324, 0x239B040A, 0x2324,
+		0x239F0402, 0x239F0408, 0x23A30402, 0x23A30408, 0x23A70402, 0x23A70408, 0x23AB0402, 0x23AB0408, 0x23AF0402, 0x23AF0404, 0x23AF0404, 0x23AF0408, 0x23B30402, 0x23B30408, 0x23B40402, 0x23B40408,
+		0x23B50402, 0x23B50408, 0x23B90402, 0x23B90408, 0x23BA0402, 0x23BA0408, 0x23BB0402, 0x23BB0408, 0x23BF0402, 0x23BF0404, 0x23BF0408, 0x23BF0402, 0x4A2, 0x23BF0408, 0x4A2, 0x23BF0402,
+		0x4C2, 0x23BF0408, 0x4C2, 0x23BF0402, 0x562, 0x23BF0408, 0x562, 0x23C30402, 0x23C30404, 0x23C30408, 0x23C70402, 0x23C70404, 0x23C70408, 0x23C70402, 0x4C2, 0x23C70408,
+		0x4C2, 0x23C70402, 0x562, 0x23C70408, 0x562, 0x23CB0402, 0x23CB0408, 0x23CC0402, 0x23CC0408, 0x23CD0402, 0x23CD0408, 0x23D10402, 0x23D10404, 0x23D10408, 0x23D10402, 0x562,
+		0x23D10408, 0x562, 0x23D50402, 0x23D50408, 0x23D60402, 0x23D60408, 0x23D70402, 0x23D70408, 0x23D80402, 0x23D80408, 0x23D90402, 0x23D90408, 0x23DD0402, 0x23DD0408, 0x23DE0402, 0x23DE0408,
+		0x23E20402, 0x23E20408, 0x23E30402, 0x23E30408, 0x23E40402, 0x23E40408, 0x23E50402, 0x23E50404, 0x23E50408, 0x23E50402, 0x4A2, 0x23E50408, 0x4A2, 0x23E50402, 0x562, 0x23E50408,
+		0x562, 0x23E50402, 0x642, 0x23E50408, 0x642, 0x23E90402, 0x23E90408, 0x23ED0402, 0x23ED0408, 0x23ED0402, 0x562, 0x23ED0404, 0x564, 0x23ED0408, 0x562, 0x23F10402,
+		0x23F10408, 0x23F20402, 0x23F20408, 0x23F60402, 0x23F60408, 0x23FA0402, 0x23FA0404, 0x23FA0408, 0x23FB0402, 0x23FB0404, 0x23FB0408, 0x23FB0402, 0x482, 0x23FB0408, 0x482, 0x23FF0402,
+		0x23FF0408, 0x24030402, 0x24030408, 0x24070402, 0x24070408, 0x240B0402, 0x240B0408, 0x240F0402, 0x240F0408, 0x24130402, 0x24130408, 0x24140402, 0x24140408, 0x24150402, 0x24150404, 0x24150408,
+		0x24190402, 0x241A0402, 0x241A0408, 0x241E0402, 0x241E0408, 0x241F0402, 0x241F0408, 0x24200402, 0x24200408, 0x24210402, 0x24210408, 0x24250402, 0x24250408, 0x24260402, 0x24260408, 0x24270402,
+		0x24270408, 0x24280402, 0x24280404, 0x24280408, 0x242C0402, 0x242C0408, 0x24300402, 0x24300408, 0x24310402, 0x24310404, 0x24310408, 0x24310414, 0x24350402, 0x24350408, 0x24360402, 0x24360408,
+		0x243A0402, 0x243A0408, 0x243E0402, 0x243E0408, 0x24420402, 0x24420408, 0x24430402, 0x24430408, 0x24470402, 0x24470408, 0x244B0402, 0x244B0408, 0x244C0402, 0x244C0404, 0x244C0404, 0x244C0404,
+		0x244C0404, 0x244C0404, 0x244C0404, 0x244C0404, 0x244C0404, 0x244C0408, 0x244C040A, 0x244C040A, 0x244C040A, 0x244C040A, 0x244C040A, 0x244C0402, 0x562, 0x244C0408, 0x562, 0x24500402,
+		0x24500408, 0x24500402, 0x562, 0x24500408, 0x562, 0x24540402, 0x24540404, 0x24540408, 0x24580402, 0x24580408, 0x24590402, 0x24590408, 0x245D0402, 0x245D0408, 0x24610402, 0x24610404,
+		0x24610408, 0x24650402, 0x24650408, 0x24690402, 0x24690408, 0x246A0402, 0x246A0404, 0x246A0404, 0x246A0408, 0x246A0404, 0x24730404, 0x246E0402, 0x246E0408, 0x246F0402, 0x246F0408, 0x24730402,
+		0x24730404, 0x24730404, 0x24730404, 0x24730408, 0x24770402, 0x24770408, 0x24780402, 0x24780408, 0x24790402, 0x24790408, 0x247D0402, 0x247D0408, 0x247E0402, 0x247E0408, 0x24820402, 0x24820404,
+		0x24820408, 0x24820402, 0x4C2, 0x24820408, 0x4C2, 0x24820402, 0x562, 0x24820408, 0x562, 0x24820402, 0x582, 0x24820408, 0x582, 0x24820402, 0x642, 0x24820408,
+		0x642, 0x24860402, 0x24860408, 0x248A0402, 0x248A0408, 0x248E0402, 0x248E0404, 0x248E0404, 0x248E0408, 0x248F0402, 0x248F0408, 0x24930402, 0x24930404, 0x24930408, 0x24970402, 0x24970404,
+		0x24970408, 0x249B0402, 0x249B0408, 0x249F0402, 0x249F0408, 0x24A30402, 0x24A30408, 0x24A70402, 0x24A70408, 0x24AB0402, 0x24AB0408, 0x24AC0402, 0x24AC0408, 0x24AD0402, 0x24AD0404, 0x24AD0408,
+		0x24B10402, 0x24B10408, 0x24B50402, 0x24B50408, 0x24B60402, 0x24B60408, 0x24BA0402, 0x24BA0408, 0x24BE0402, 0x24BE0404, 0x24BE0408, 0x24C20402, 0x24C20408, 0x24C30402, 0x24C30408, 0x24C40402,
+		0x24C40408, 0x24C80402, 0x24C80408, 0x24C90402, 0x24C90404, 0x24C90408, 0x24C90402, 0x562, 0x24C90408, 0x562, 0x24CD0402, 0x24CD0408, 0x24CE0402, 0x24CE0408, 0x24CF0402, 0x24CF0408,
+		0x24D30402, 0x24D30408, 0x24D70402, 0x24D70408, 0x24DB0402, 0x24DB0408, 0x24DC0402, 0x24DC0408, 0x24E00402, 0x24E00408, 0x24E40402, 0x24E40408, 0x24E80402, 0x24E80404, 0x24E80408, 0x24EC0402,
+		0x24EC0408, 0x24ED0402, 0x24ED0404, 0x24ED0408, 0x24F10402, 0x24F10408, 0x24F20402, 0x24F30402, 0x24F40402, 0x24F40404, 0x24F40404, 0x24F40408, 0x24F40414, 0x24F80402, 0x24F80408, 0x24F90402,
+		0x24F90404, 0x24F90408, 0x24F90402, 0x562, 0x24F90408, 0x562, 0x24FD0402, 0x24FD0404, 0x24FD0408, 0x24FD0414, 0x25010402, 0x25010408, 0x25050402, 0x25050404, 0x25050404, 0x25050408,
+		0x25090402, 0x25090408, 0x250A0402, 0x250A0408, 0x250A0402, 0x562, 0x250A0408, 0x562, 0x250E0402, 0x250E0404, 0x250E0408, 0x25120402, 0x25120408, 0x25130402, 0x25130404, 0x25130408,
+		0x25140402, 0x25140408, 0x25180402, 0x25180408, 0x25190402, 0x25190404, 0x25190408, 0x251D0402, 0x251D0404, 0x251D0408, 0x25210402, 0x25210408, 0x25220402, 0x25220404, 0x25220408, 0x25260402,
+		0x25260408, 0x25270402, 0x25270408, 0x252B0402, 0x252B0408, 0x252C0402, 0x252C0404, 0x252C0408, 0x25300402, 0x25300408, 0x25340402, 0x25340408, 0x25380402, 0x25380404, 0x25380408, 0x253C0402,
+		0x253C0408, 0x253C0402, 0x782, 0x253C0408, 0x782, 0x25400402, 0x25400408, 0x25410402, 0x25410408, 0x25450402, 0x25450408, 0x25460402, 0x25460408, 0x254A0402, 0x254A0404, 0x254A0408,
+		0x254B0402, 0x254B0404, 0x254B0408, 0x254C0402, 0x254C0404, 0x254C0408, 0x254D0402, 0x254D0404, 0x254D0408, 0x254E0402, 0x254E0404, 0x254E0408, 0x254F0402, 0x254F0404, 0x254F0408, 0x25500402,
+		0x25500404, 0x25500408, 0x25510402, 0x25510408, 0x25520402, 0x25520404, 0x25520408, 0x25530402, 0x25530404, 0x25530408, 0x25540402, 0x25540404, 0x25540408, 0x25550402, 0x25550404, 0x25550408,
+		0x25560402, 0x25560404, 0x25560408, 0x25570402, 0x25570404, 0x25570408, 0x25580402, 0x25580404, 0x25580408, 0x25590402, 0x25590404, 0x25590408, 0x255A0402, 0x255A0404, 0x255A0408, 0x255B0402,
+		0x255B0404, 0x255B0408, 0x255C0402, 0x255C0404, 0x255C0408, 0x255D0402, 0x255D0404, 0x255D0408, 0x255E0402, 0x255E0404, 0x255E0408, 0x255F0402, 0x255F0404, 0x255F0408, 0x25600402, 0x25600404,
+		0x25600408, 0x25610402, 0x25610404, 0x25610408, 0x25620402, 0x25620404, 0x25620408, 0x25630402, 0x25630408, 0x25640402, 0x25640408, 0x25650402, 0x25650404, 0x25650408, 0x25660402, 0x25660404,
+		0x25660408, 0x25670402, 0x25670404, 0x25670408, 0x25680402, 0x25680404, 0x25680408, 0x25690402, 0x25690404, 0x25690408, 0x256A0402, 0x256A0404, 0x256A0408, 0x256B0402, 0x256B0404, 0x256B0408,
+		0x256C0402, 0x256C0408, 0x256D0402, 0x256D0404, 0x256D0408, 0x256E0402, 0x256E0404, 0x256E0408, 0x256F0402, 0x256F0408, 0x25700402, 0x25700404, 0x25700408, 0x25710402, 0x25710404, 0x25710408,
+		0x25720402, 0x25720404, 0x25720408, 0x25730402, 0x25730404, 0x25730408, 0x25740402, 0x25740404, 0x25740408, 0x25750402, 0x25750408, 0x25760402, 0x25760408, 0x25770402, 0x25770408, 0x25780402,
+		0x25780408, 0x25790402, 0x25790404, 0x257A0402, 0x257B0402, 0x257C0402, 0x257C0404, 0x257D0402, 0x257E0402, 0x257F0402, 0x25800402, 0x25800404, 0x25810402, 0x25820402, 0x25830402, 0x25840402,
+		0x25850402, 0x25860402, 0x25860404, 0x25870402, 0x25880402, 0x25890402, 0x258A0402, 0x258A0404, 0x258B0402, 0x258C0402, 0x258D0402, 0x258E0402, 0x258F0402, 0x25900402, 0x25910402, 0x25920402,
+		0x25930402, 0x25940402, 0x25950402, 0x25960402, 0x25970402, 0x25980402, 0x25990402, 0x259A0402, 0x259B0402, 0x259C0402, 0x259D0402, 0x259E0402, 0x259F0402, 0x25A00402, 0x25A00408, 0x25A10402,
+		0x25A20402, 0x25A20408, 0x25A30402, 0x25A40402, 0x25A40408, 0x25A50402, 0x25A60402, 0x25A60408, 0x25A70402, 0x25A80402, 0x25A80408, 0x25A90402, 0x25AA0402, 0x25AA0408, 0x25AB0402, 0x25AC0402,
+		0x25AC0408, 0x25AD0402, 0x25AE0402, 0x25AE0408, 0x25AF0402, 0x25B00402, 0x25B00408, 0x25B10402, 0x25B20402, 0x25B20408, 0x25B30402, 0x25B40402, 0x25B40408, 0x25B50402, 0x25B60402, 0x25B60408,
+		0x25B70402, 0x25B80402, 0x25B80408, 0x25B90402, 0x25B90414, 0x25BA0402, 0x25BA0408, 0x25BB0402, 0x25BC0402, 0x25BC0408, 0x25BD0402, 0x25BE0402, 0x25BE0408, 0x25BF0402, 0x25C00402, 0x25C00408,
+		0x25C10402, 0x25C20402, 0x25C20408, 0x25C30402, 0x25C40402, 0x25C40408, 0x25C50402, 0x25C60402, 0x25C60408, 0x25C70402, 0x25C80402, 0x25C80408, 0x25C90402, 0x25CA0402, 0x25CA0408, 0x25CB0402,
+		0x25CC0402, 0x25CC0408, 0x25CD0402, 0x25CE0402, 0x25CE0408, 0x25CF0402, 0x25D00402, 0x25D00408, 0x25D10402, 0x25D20402, 0x25D20408, 0x25D30402, 0x25D40402, 0x25D40408, 0x25D50402, 0x25D60402,
+		0x25D60408, 0x25D70402, 0x25D80402, 0x25D80408, 0x25D90402, 0x25DA0402, 0x25DA0408, 0x25DB0402, 0x25DC0402, 0x25DC0408, 0x25DD0402, 0x25DE0402, 0x25DE0408, 0x25DF0402, 0x25E00402, 0x25E00408,
+		0x25E10402, 0x25E20402, 0x25E20408, 0x25E30402, 0x25E40402, 0x25E40408, 0x25E50402, 0x25E60402, 0x25E60408, 0x25E70402, 0x25E80402, 0x25E80408, 0x25E90402, 0x25EA0402, 0x25EA0408, 0x25EB0402,
+		0x25EC0402, 0x25ED0402, 0x25ED0408, 0x25EE0402, 0x25EF0402, 0x25F00402, 0x25F10402, 0x25F20402, 0x25F20408, 0x25F30402, 0x25F40402, 0x25F50402, 0x25F50408, 0x25F70402, 0x25F70408, 0x25F80402,
+		0x25F80408, 0x25F90402, 0x25F90408, 0x25FA0402, 0x25FA0408, 0x25FA0404, 0x26180404, 0x25FB0402, 0x25FB0408, 0x25FC0402, 0x25FC0408, 0x25FD0402, 0x25FD0408, 0x25FE0402, 0x25FE0408, 0x25FF0402,
+		0x25FF0408, 0x26000402, 0x26000408, 0x26010402, 0x26010408, 0x26020402, 0x26020408, 0x26030402, 0x26030408, 0x26040402, 0x26040408, 0x26050402, 0x26050408, 0x26060402, 0x26060408, 0x26070402,
+		0x26070408, 0x26080402, 0x26080408, 0x26090402, 0x26090408, 0x26090404, 0x25FA0404, 0x26090404, 0x26000404, 0x26090404, 0x26020404, 0x26090404, 0x260C0404, 0x260A0402, 0x260A0408, 0x260C0402,
+		0x260C0408, 0x260D0402, 0x260D0408, 0x260E0402, 0x260E0408, 0x260F0402, 0x260F0408, 0x26100402, 0x26100408, 0x26110402, 0x26110408, 0x26120402, 0x26120408, 0x26130402, 0x26130408, 0x26140402,
+		0x26140408, 0x26140404, 0x260C0404, 0x26150402, 0x26150408, 0x26160402, 0x26160408, 0x26170402, 0x26170408, 0x26180402, 0x26180408, 0x26190402, 0x26190408, 0x261A0402, 0x261A0408, 0x261B0402,
+		0x261B0408, 0x261C0402, 0x261C0408, 0x261D0402, 0x261E0402, 0x261E0404, 0x261E0405, 0x261E0402, 0xB22, 0x261E0402, 0xB42, 0x261E0402, 0xBE2, 0x261E0404, 0x26290404, 0x261F0402,
+		0x261F0404, 0x261F0402, 0xBE2, 0x261F0402, 0xC02, 0x26200402, 0x26200404, 0x26200402, 0xBE2, 0x26210402, 0x26210404, 0x26210405, 0x26210402, 0xBE2, 0x26220402, 0x26220405,
+		0x26220402, 0xBE2, 0x26230402, 0x26230402, 0xB62, 0x26230402, 0xBE2, 0x26230404, 0x26230404, 0x26230404, 0x26270404, 0x26240402, 0x26240402, 0xBE2, 0x26250402, 0x26260402,
+		0x26260402, 0xBE2, 0x26270402, 0x26270402, 0xAC2, 0x26270402, 0xBE2, 0x26270404, 0x26270404, 0x26270404, 0x26270404, 0xB22, 0x26280402, 0x26280405, 0x26280419, 0x26280402,
+		0xBE2, 0x26280419, 0xBE2, 0x26280402, 0xC02, 0x26290402, 0x26290405, 0x26290402, 0xBE2, 0x262A0402, 0x262A0405, 0x262A0419, 0x262A0402, 0xBE2, 0x262B0402, 0x262B0419,
+		0x262B0402, 0xBE2, 0x262C0402, 0x262C0402, 0xBE2, 0x262D0402, 0x262D0405, 0x262E0402, 0x262E0419, 0x262E0402, 0xBE2, 0x262E0419, 0xBE2, 0x262E0402, 0xC02, 0x262F0402,
+		0x262F0419, 0x262F0402, 0xBE2, 0x26300402, 0x26300402, 0xBE2, 0x26310402, 0x26310405, 0x26310402, 0xBE2, 0x26320402, 0x26320402, 0xBA2, 0x26320402, 0xBC2, 0x26320402,
+		0xBE2, 0x26320402, 0xBE2, 0xBA2, 0x26320402, 0xBE2, 0xBC2, 0x26330402, 0x26330405, 0x26330402, 0xBE2, 0x26340402, 0x26350402, 0x26360402, 0x26370402, 0x26380402,
+		0x26390402, 0x263A0402, 0x263B0402, 0x263C0402, 0x263D0402, 0x263E0402, 0x263F0402, 0x26400402, 0x26410402, 0x26420402, 0x26430402, 0x26440402, 0x26450402, 0x26460402, 0x26470402, 0x26480402,
+		0x26490402, 0x264A0402, 0x264B0402, 0x264C0402, 0x264D0402, 0x264E0402, 0x264F0402, 0x26500402, 0x26510402, 0x26520402, 0x26530402, 0x26540402, 0x26550402, 0x26560402, 0x26570402, 0x26580402,
+		0x26590402, 0x265A0402, 0x265B0402, 0x265C0402, 0x265D0402, 0x265E0402, 0x265F0402, 0x26600402, 0x26610402, 0x26620402, 0x26630402, 0x26640402, 0x26640404, 0x2664041A, 0x26640404, 0x2324,
+		0x26650402, 0x26650419, 0x2665041A, 0x26660402, 0x26660419, 0x2666041A, 0x26670402, 0x26680402, 0x26680419, 0x2668041A, 0x26690402, 0x26690419, 0x2669041A, 0x266A0402, 0x266A0419, 0x266A041A,
+		0x266B0402, 0x266C0402, 0x266D0402, 0x266E0402, 0x266E0417, 0x266E0418, 0x266E0419, 0x266E041A, 0x266E0419, 0x26720419, 0x266E041A, 0x2672041A, 0x266E0417, 0x268F0417, 0x266E041A, 0x268F041A,
+		0x266E0417, 0x26990417, 0x266E041A, 0x2699041A, 0x266E0417, 0x269A0417, 0x266E0419, 0x26B30419, 0x266E0419, 0x26B40419, 0x266E0417, 0x27140417, 0x266E0418, 0x27140418, 0x266E0419, 0x27140419,
+		0x266E041A, 0x2714041A, 0x266E0419, 0x27180419, 0x266E0417, 0x27220417, 0x266E0418, 0x27220418, 0x266E0419, 0x27270419, 0x266E041A, 0x2727041A, 0x266E0419, 0x27280419, 0x266E041A, 0x2728041A,
+		0x266E0419, 0x272B0419, 0x266E041A, 0x272B041A, 0x266E0419, 0x272C0419, 0x266E041A, 0x272C041A, 0x266E0419, 0x272D0419, 0x266E041A, 0x272D041A, 0x266E0417, 0x27360417, 0x266E0419, 0x27360419,
+		0x266E0419, 0x27360419, 0x266E041A, 0x2736041A, 0x266E041A, 0x2736041A, 0x266E0419, 0x27370419, 0x266E041A, 0x2737041A, 0x266E0417, 0x273B0417, 0x266E0419, 0x273B0419, 0x266E041A, 0x273B041A,
+		0x266F0402, 0x26700402, 0x26710402, 0x26720402, 0x26720404, 0x26720405, 0x26720405, 0x26720419, 0x2672041A, 0x26720419, 0xDB9, 0x2672041A, 0xDBA, 0x26720404, 0x26640404, 0x2672041A,
+		0x26F6041A, 0x2674041A, 0x26B3041A, 0x2672041A, 0x270C041A, 0x270C041A, 0x2722041A, 0x26730402, 0x26730405, 0x26730405, 0x26740402, 0x26740405, 0x26740405, 0x26740405, 0x26740405, 0x26740405,
+		0x26740417, 0x26740418, 0x26740419, 0x2674041A, 0x26740417, 0x268F0417, 0x2674041A, 0x268F041A, 0x26740417, 0x26990417, 0x2674041A, 0x2699041A, 0x26740419, 0x26990419, 0x27370419, 0x26740417,
+		0x269A0417, 0x2674041A, 0x269A041A, 0x26740419, 0x269A0419, 0x27370419, 0x26740419, 0x26B30419, 0x26740419, 0x26B40419, 0x26740417, 0x27140417, 0x26740418, 0x27140418, 0x26740419, 0x27140419,
+		0x2674041A, 0x2714041A, 0x26740419, 0x27180419, 0x26740417, 0x27220417, 0x26740418, 0x27220418, 0x26740419, 0x27360419, 0x2674041A, 0x2736041A, 0x26740419, 0x27370419, 0x2674041A, 0x2737041A,
+		0x26750402, 0x26750417, 0x26750418, 0x26750419, 0x2675041A, 0x26760402, 0x26760417, 0x26760418, 0x26760419, 0x2676041A, 0x26770402, 0x26770417, 0x26770418, 0x26770419, 0x2677041A, 0x26780402,
+		0x26790402, 0x267A0402, 0x267B0402, 0x267C0402, 0x267D0402, 0x267E0402, 0x267F0402, 0x26810402, 0x26820402, 0x26830402, 0x26840402, 0x26840419, 0x2684041A, 0x26850402, 0x26850405, 0x26850405,
+		0x26850405, 0x26850405, 0x26850405, 0x26850417, 0x26850418, 0x26850419, 0x2685041A, 0x26850417, 0x268F0417, 0x2685041A, 0x268F041A, 0x26850417, 0x268F0417, 0x27140417, 0x26850419, 0x268F0419,
+		0x27360419, 0x26850419, 0x268F0419, 0x27370419, 0x26850417, 0x26990417, 0x2685041A, 0x2699041A, 0x26850417, 0x26990417, 0x268F0417, 0x26850419, 0x26990419, 0x268F0419, 0x26850417, 0x26990417,
+		0x27140417, 0x26850417, 0x269A0417, 0x2685041A, 0x269A041A, 0x26850417, 0x269A0417, 0x27140417, 0x26850419, 0x269A0419, 0x27360419, 0x26850419, 0x269A0419, 0x27370419, 0x26850419, 0x26B30419,
+		0x26850419, 0x26B40419, 0x26850417, 0x27140417, 0x26850418, 0x27140418, 0x26850419, 0x27140419, 0x2685041A, 0x2714041A, 0x26850417, 0x27140417, 0x268F0417, 0x26850417, 0x27140417, 0x26990417,
+		0x26850417, 0x27140417, 0x269A0417, 0x26850419, 0x27140419, 0x27360419, 0x26850419, 0x27140419, 0x27370419, 0x26850419, 0x27180419, 0x26850417, 0x27220417, 0x26850418, 0x27220418, 0x26850419,
+		0x27360419, 0x2685041A, 0x2736041A, 0x26850419, 0x27370419, 0x2685041A, 0x2737041A, 0x26860402, 0x26860405, 0x26860405, 0x26860405, 0x26860405, 0x26860405, 0x26860417, 0x26860418, 0x26860419,
+		0x2686041A, 0x2686041A, 0x268F041A, 0x26860419, 0x26B30419, 0x26860419, 0x26B40419, 0x26860417, 0x27140417, 0x26860418, 0x27140418, 0x26860419, 0x27140419, 0x2686041A, 0x2714041A, 0x26860419,
+		0x27180419, 0x26860418, 0x27220418, 0x26860419, 0x27360419, 0x2686041A, 0x2736041A, 0x26860419, 0x27370419, 0x2686041A, 0x2737041A, 0x26870402, 0x26870417, 0x26870418, 0x26870419, 0x2687041A,
+		0x26880402, 0x26880417, 0x26880418, 0x26880419, 0x2688041A, 0x26890402, 0x268A0402, 0x268B0402, 0x268B0417, 0x268B0418, 0x268B0419, 0x268B041A, 0x268C0402, 0x268F0402, 0x268F0405, 0x268F0405,
+		0x268F0405, 0x268F0405, 0x268F0405, 0x268F0405, 0x268F0417, 0x268F0418, 0x268F0419, 0x268F041A, 0x268F0417, 0x26990417, 0x268F041A, 0x2699041A, 0x268F0419, 0x26990419, 0x27360419, 0x268F0419,
+		0x26990419, 0x27370419, 0x268F041A, 0x270C041A, 0x209041A, 0x268F041A, 0x270C041A, 0x2672041A, 0x270C041A, 0x2722041A, 0x268F0417, 0x27140417, 0x268F041A, 0x2714041A, 0x268F0417, 0x27140417,
+		0x26990417, 0x268F0419, 0x27140419, 0x26990419, 0x268F0419, 0x27140419, 0x27360419, 0x268F0419, 0x27140419, 0x27370419, 0x268F0419, 0x27360419, 0x268F041A, 0x2736041A, 0x268F0419, 0x27370419,
+		0x268F041A, 0x2737041A, 0x26900402, 0x26900417, 0x26900418, 0x26900419, 0x2690041A, 0x26910402, 0x26910417, 0x26910418, 0x26910419, 0x2691041A, 0x26920402, 0x26920417, 0x26920418, 0x26920419,
+		0x2692041A, 0x26930402, 0x26940402, 0x26940417, 0x26940418, 0x26940419, 0x2694041A, 0x26950402, 0x26990402, 0x26990405, 0x26990405, 0x26990405, 0x26990405, 0x26990405, 0x26990405, 0x26990417,
+		0x26990418, 0x26990419, 0x2699041A, 0x26990417, 0x268F0417, 0x2699041A, 0x268F041A, 0x26990419, 0x268F0419, 0x27370419, 0x26990417, 0x27140417, 0x2699041A, 0x2714041A, 0x26990419, 0x27140419,
+		0x27360419, 0x26990419, 0x27140419, 0x27370419, 0x26990419, 0x27360419, 0x2699041A, 0x2736041A, 0x26990419, 0x27370419, 0x2699041A, 0x2737041A, 0x269A0402, 0x269A0405, 0x269A0405, 0x269A0405,
+		0x269A0405, 0x269A0405, 0x269A0405, 0x269A0417, 0x269A0418, 0x269A0419, 0x269A041A, 0x269A0417, 0x268F0417, 0x269A041A, 0x268F041A, 0x269A041A, 0x2699041A, 0x269A0417, 0x27140417, 0x269A041A,
+		0x2714041A, 0x269A0419, 0x27360419, 0x269A041A, 0x2736041A, 0x269A0419, 0x27370419, 0x269A041A, 0x2737041A, 0x269B0402, 0x269C0402, 0x269D0402, 0x269E0402, 0x269F0402, 0x26A00402, 0x26A10402,
+		0x26A20402, 0x26A30402, 0x26A40402, 0x26A40405, 0x26A40405, 0x26A40405, 0x26A40419, 0x26A4041A, 0x26A50402, 0x26A50405, 0x26A50405, 0x26A50405, 0x26A50419, 0x26A5041A, 0x26A5041A, 0x131A,
+		0x26A60402, 0x26A60419, 0x26A6041A, 0x26A70402, 0x26A80402, 0x26A90402, 0x26AA0402, 0x26AA0419, 0x26AA041A, 0x26AB0402, 0x26AB0419, 0x26AB041A, 0x26AC0402, 0x26AD0402, 0x26AD0419, 0x26AD041A,
+		0x26AE0402, 0x26AF0402, 0x26B00402, 0x26B10402, 0x26B20402, 0x26B30402, 0x26B30405, 0x26B30405, 0x26B30405, 0x26B30419, 0x26B3041A, 0x26B3041A, 0x131A, 0x26B3041A, 0x26C6041A, 0x2728041A,
+		0x270C041A, 0x26B3041A, 0x2738041A, 0x2672041A, 0x270C041A, 0x26B40402, 0x26B40405, 0x26B40405, 0x26B40405, 0x26B40419, 0x26B4041A, 0x26B50402, 0x26B50419, 0x26B5041A, 0x26B60402, 0x26B70402,
+		0x26B80402, 0x26B90402, 0x26BA0402, 0x26BB0402, 0x26BC0402, 0x26BC0419, 0x26BC041A, 0x26BD0402, 0x26BE0402, 0x26BF0402, 0x26C00402, 0x26C10402, 0x26C20402, 0x26C30402, 0x26C40402, 0x26C50402,
+		0x26C60402, 0x26C60405, 0x26C60405, 0x26C60405, 0x26C60405, 0x26C60405, 0x26C60405, 0x26C60417, 0x26C60418, 0x26C60419, 0x26C6041A, 0x26C60417, 0x268F0417, 0x26C60418, 0x268F0418, 0x26C6041A,
+		0x268F041A, 0x26C60417, 0x268F0417, 0x26990417, 0x26C60419, 0x268F0419, 0x27360419, 0x26C60417, 0x26990417, 0x26C60418, 0x26990418, 0x26C6041A, 0x2699041A, 0x26C60417, 0x26990417, 0x268F0417,
+		0x26C60417, 0x269A0417, 0x26C60418, 0x269A0418, 0x26C6041A, 0x269A041A, 0x26C60419, 0x269A0419, 0x27360419, 0x26C60419, 0x269A0419, 0x27370419, 0x26C60419, 0x26B30419, 0x26C6041A, 0x26B3041A,
+		0x26C60417, 0x27140417, 0x26C60418, 0x27140418, 0x26C6041A, 0x2714041A, 0x26C60417, 0x27140417, 0x268F0417, 0x26C60417, 0x27140417, 0x26990417, 0x26C60419, 0x27140419, 0x26990419, 0x26C60417,
+		0x27140417, 0x27140417, 0x26C60419, 0x27140419, 0x27140419, 0x26C60417, 0x27220417, 0x26C60418, 0x27220418, 0x26C60419, 0x27360419, 0x26C6041A, 0x2736041A, 0x26C60419, 0x27370419, 0x26C6041A,
+		0x2737041A, 0x26C70402, 0x26C70405, 0x26C70405, 0x26C70405, 0x26C70405, 0x26C70405, 0x26C70405, 0x26C70417, 0x26C70418, 0x26C70419, 0x26C7041A, 0x26C70417, 0x268F0417, 0x26C70418, 0x268F0418,
+		0x26C70419, 0x268F0419, 0x26C7041A, 0x268F041A, 0x26C70419, 0x268F0419, 0x27370419, 0x26C70417, 0x26990417, 0x26C70418, 0x26990418, 0x26C70419, 0x26990419, 0x26C7041A, 0x2699041A, 0x26C70417,
+		0x26990417, 0x27140417, 0x26C70419, 0x26990419, 0x27140419, 0x26C70419, 0x26990419, 0x27370419, 0x26C70417, 0x269A0417, 0x26C70418, 0x269A0418, 0x26C70419, 0x269A0419, 0x26C7041A, 0x269A041A,
+		0x26C70419, 0x26B30419, 0x26C7041A, 0x26B3041A, 0x26C70417, 0x27140417, 0x26C70418, 0x27140418, 0x26C70419, 0x27140419, 0x26C7041A, 0x2714041A, 0x26C70417, 0x27140417, 0x269A0417, 0x26C70419,
+		0x27140419, 0x269A0419, 0x26C70417, 0x27140417, 0x27140417, 0x26C70419, 0x27140419, 0x27140419, 0x26C70417, 0x27220417, 0x26C70418, 0x27220418, 0x26C70419, 0x27360419, 0x26C7041A, 0x2736041A,
+		0x26C70419, 0x27370419, 0x26C7041A, 0x2737041A, 0x26C80402, 0x26C90402, 0x26CA0402, 0x26CB0402, 0x26CC0402, 0x26CD0402, 0x26CE0402, 0x26CF0402, 0x26D00402, 0x26D10402, 0x26D10405, 0x26D10405,
+		0x26D10405, 0x26D10405, 0x26D10405, 0x26D10405, 0x26D10417, 0x26D10418, 0x26D10419, 0x26D1041A, 0x26D10417, 0x26990417, 0x26D1041A, 0x2699041A, 0x26D10417, 0x26990417, 0x26990417, 0x26D10419,
+		0x26990419, 0x26990419, 0x26D10419, 0x26990419, 0x27370419, 0x26D10417, 0x269A0417, 0x26D10419, 0x26B30419, 0x26D1041A, 0x26B3041A, 0x26D1041A, 0x270C041A, 0x26DB041A, 0x2714041A, 0x26D1041A,
+		0x270C041A, 0x2736041A, 0x26D1041A, 0x270C041A, 0x2736041A, 0x209041A, 0x2672041A, 0x270C041A, 0x270C041A, 0x2722041A, 0x209041A, 0x26DB041A, 0x270C041A, 0x2737041A, 0x2722041A, 0x209041A,
+		0x2728041A, 0x26C6041A, 0x270C041A, 0x2714041A, 0x26D1041A, 0x270C041A, 0x2745041A, 0x26D10417, 0x27140417, 0x26D1041A, 0x2714041A, 0x26D10417, 0x27140417, 0x27140417, 0x26D10419, 0x27140419,
+		0x27140419, 0x26D10419, 0x27360419, 0x26D1041A, 0x2736041A, 0x26D10419, 0x27370419, 0x26D1041A, 0x2737041A, 0x26D20402, 0x26D20405, 0x26D20405, 0x26D20405, 0x26D20405, 0x26D20405, 0x26D20405,
+		0x26D20417, 0x26D20418, 0x26D20419, 0x26D2041A, 0x26D20417, 0x268F0417, 0x26D2041A, 0x268F041A, 0x26D20417, 0x26990417, 0x26D2041A, 0x2699041A, 0x26D20419, 0x26990419, 0x27360419, 0x26D20419,
+		0x26990419, 0x27370419, 0x26D20417, 0x269A0417, 0x26D2041A, 0x269A041A, 0x26D20417, 0x269A0417, 0x27140417, 0x26D20419, 0x269A0419, 0x27140419, 0x26D20419, 0x26B30419, 0x26D2041A, 0x26B3041A,
+		0x26D20417, 0x27140417, 0x26D2041A, 0x2714041A, 0x26D20419, 0x27360419, 0x26D2041A, 0x2736041A, 0x26D20419, 0x27370419, 0x26D2041A, 0x2737041A, 0x26D30402, 0x26D40402, 0x26D50402, 0x26D60402,
+		0x26D70402, 0x26D70405, 0x26D70405, 0x26D70405, 0x26D70405, 0x26D70417, 0x26D70418, 0x26D70419, 0x26D7041A, 0x26D70417, 0x26990417, 0x26D7041A, 0x2699041A, 0x26D70417, 0x27140417, 0x26D70418,
+		0x27140418, 0x26D7041A, 0x2714041A, 0x26D70417, 0x27140417, 0x26990417, 0x26D70419, 0x27140419, 0x26990419, 0x26D70417, 0x27140417, 0x27140417, 0x26D70419, 0x27140419, 0x27370419, 0x26D70419,
+		0x27360419, 0x26D7041A, 0x2736041A, 0x26D70419, 0x27370419, 0x26D7041A, 0x2737041A, 0x26D80402, 0x26D80405, 0x26D80405, 0x26D80405, 0x26D80405, 0x26D80417, 0x26D80418, 0x26D80419, 0x26D8041A,
+		0x26D80417, 0x27140417, 0x26D80418, 0x27140418, 0x26D8041A, 0x2714041A, 0x26D90402, 0x26DA0402, 0x26DB0402, 0x26DB0405, 0x26DB0405, 0x26DB0405, 0x26DB0405, 0x26DB0405, 0x26DB0405, 0x26DB0417,
+		0x26DB0418, 0x26DB0419, 0x26DB041A, 0x26DB0417, 0x268F0417, 0x26DB041A, 0x268F041A, 0x26DB0417, 0x268F0417, 0x27140417, 0x26DB0419, 0x268F0419, 0x27140419, 0x26DB041A, 0x270C041A, 0x2737041A,
+		0x2722041A, 0x26DB0417, 0x27140417, 0x26DB041A, 0x2714041A, 0x26DB0417, 0x27140417, 0x27140417, 0x26DB0419, 0x27140419, 0x27140419, 0x26DB0419, 0x27140419, 0x27360419, 0x26DB0419, 0x27140419,
+		0x27370419, 0x26DB0419, 0x27360419, 0x26DB041A, 0x2736041A, 0x26DB0419, 0x27370419, 0x26DB041A, 0x2737041A, 0x26DC0402, 0x26DC0405, 0x26DC0405, 0x26DC0405, 0x26DC0405, 0x26DC0405, 0x26DC0405,
+		0x26DC0417, 0x26DC0418, 0x26DC0419, 0x26DC041A, 0x26DC0417, 0x268F0417, 0x26DC041A, 0x268F041A, 0x26DC0417, 0x27140417, 0x26DC041A, 0x2714041A, 0x26DC0419, 0x27140419, 0x27140419, 0x26DC0419,
+		0x27140419, 0x27360419, 0x26DC0419, 0x27140419, 0x27370419, 0x26DC0419, 0x27360419, 0x26DC041A, 0x2736041A, 0x26DC0419, 0x27370419, 0x26DC041A, 0x2737041A, 0x26DD0402, 0x26DF0402, 0x26E00402,
+		0x26E10402, 0x26E20402, 0x26E30402, 0x26E40402, 0x26E40405, 0x26E40405, 0x26E40405, 0x26E40405, 0x26E40405, 0x26E40417, 0x26E40418, 0x26E40419, 0x26E4041A, 0x26E40417, 0x268F0417, 0x26E4041A,
+		0x268F041A, 0x26E40417, 0x26990417, 0x26E4041A, 0x2699041A, 0x26E40417, 0x269A0417, 0x26E4041A, 0x269A041A, 0x26E40417, 0x269A0417, 0x27140417, 0x26E40419, 0x269A0419, 0x27140419, 0x26E40417,
+		0x27140417, 0x26E4041A, 0x2714041A, 0x26E40419, 0x27140419, 0x27370419, 0x26E40419, 0x27360419, 0x26E4041A, 0x2736041A, 0x26E40419, 0x27370419, 0x26E4041A, 0x2737041A, 0x26E50402, 0x26E50405,
+		0x26E50405, 0x26E60402, 0x26E70402, 0x26E80402, 0x26E90402, 0x26E90417, 0x26E90418, 0x26E90419, 0x26E9041A, 0x26EA0402, 0x26EB0402, 0x26EC0402, 0x26EC0417, 0x26EC0418, 0x26EC0419, 0x26EC041A,
+		0x26ED0402, 0x26EE0402, 0x26EF0402, 0x26EF0405, 0x26EF0405, 0x26F00402, 0x26F00405, 0x26F00405, 0x26F00405, 0x26F00405, 0x26F00405, 0x26F00405, 0x26F00417, 0x26F00418, 0x26F00419, 0x26F0041A,
+		0x26F00417, 0x26990417, 0x26F0041A, 0x2699041A, 0x26F0041A, 0x270C041A, 0x2745041A, 0x26F00417, 0x27140417, 0x26F0041A, 0x2714041A, 0x26F00417, 0x27140417, 0x26990417, 0x26F00419, 0x27140419,
+		0x26990419, 0x26F00419, 0x27140419, 0x27140419, 0x26F00419, 0x27140419, 0x27370419, 0x26F00419, 0x27360419, 0x26F0041A, 0x2736041A, 0x26F00419, 0x27370419, 0x26F0041A, 0x2737041A, 0x26F10402,
+		0x26F20402, 0x26F30402, 0x26F50402, 0x26F60402, 0x26F60405, 0x26F60405, 0x26F60405, 0x26F60417, 0x26F60418, 0x26F60419, 0x26F6041A, 0x26F60419, 0x26720419, 0x26F6041A, 0x2672041A, 0x26F60417,
+		0x268F0417, 0x26F6041A, 0x268F041A, 0x26F60417, 0x26990417, 0x26F6041A, 0x2699041A, 0x26F60417, 0x269A0417, 0x26F6041A, 0x269A041A, 0x26F60417, 0x270C0417, 0x26F60418, 0x270C0418, 0x26F60419,
+		0x270C0419, 0x26F6041A, 0x270C041A, 0x26F60417, 0x27140417, 0x26F60418, 0x27140418, 0x26F60419, 0x27140419, 0x26F6041A, 0x2714041A, 0x26F60417, 0x27140417, 0x27140417, 0x26F60419, 0x27140419,
+		0x27140419, 0x26F60419, 0x27140419, 0x27370419, 0x26F60419, 0x27360419, 0x26F6041A, 0x2736041A, 0x26F60419, 0x27370419, 0x26F6041A, 0x2737041A, 0x26F70402, 0x26F70417, 0x26F70418, 0x26F70419,
+		0x26F7041A, 0x26F80402, 0x26F90402, 0x26FA0402, 0x26FB0402, 0x26FC0402, 0x26FC0417, 0x26FC0418, 0x26FC0419, 0x26FC041A, 0x26FD0402, 0x26FE0402, 0x26FF0402, 0x26FF0417, 0x26FF0418, 0x26FF0419,
+		0x26FF041A, 0x27000402, 0x27010402, 0x27020402, 0x27020417, 0x27020418, 0x27020419, 0x2702041A, 0x27030402, 0x27040402, 0x27040417, 0x27040418, 0x27040419, 0x2704041A, 0x27050402, 0x27060402,
+		0x27070402, 0x27080402, 0x27090402, 0x270A0402, 0x270C0402, 0x270C0405, 0x270C0405, 0x270C0405, 0x270C0405, 0x270C0405, 0x270C0417, 0x270C0418, 0x270C0419, 0x270C041A, 0x270C0419, 0x26650419,
+		0x270C041A, 0x2665041A, 0x270C0419, 0x26660419, 0x270C041A, 0x2666041A, 0x270C0419, 0x266A0419, 0x270C041A, 0x266A041A, 0x270C0419, 0x26720419, 0x270C041A, 0x2672041A, 0x270C0417, 0x268F0417,
+		0x270C041A, 0x268F041A, 0x270C0417, 0x268F0417, 0x268F0417, 0x270C0419, 0x268F0419, 0x268F0419, 0x270C0417, 0x268F0417, 0x27140417, 0x270C0419, 0x268F0419, 0x27140419, 0x270C0419, 0x268F0419,
+		0x27370419, 0x270C0417, 0x26990417, 0x270C041A, 0x2699041A, 0x270C0417, 0x26990417, 0x27140417, 0x270C0419, 0x26990419, 0x27140419, 0x270C0419, 0x26990419, 0x27360419, 0x270C0419, 0x26990419,
+		0x27370419, 0x270C0417, 0x269A0417, 0x270C041A, 0x269A041A, 0x270C0417, 0x269A0417, 0x27140417, 0x270C0419, 0x269A0419, 0x27140419, 0x270C0417, 0x27140417, 0x270C0418, 0x27140418, 0x270C0419,
+		0x27140419, 0x270C041A, 0x2714041A, 0x270C0417, 0x27140417, 0x26990417, 0x270C0419, 0x27140419, 0x26990419, 0x270C0419, 0x27140419, 0x27370419, 0x270C0417, 0x27220417, 0x270C0419, 0x27360419,
+		0x270C041A, 0x2736041A, 0x270C0419, 0x27370419, 0x270C041A, 0x2737041A, 0x270D0402, 0x270E0402, 0x270F0402, 0x27100402, 0x27110402, 0x27120402, 0x27140402, 0x27140405, 0x27140405, 0x27140405,
+		0x27140405, 0x27140405, 0x27140417, 0x27140418, 0x27140419, 0x2714041A, 0x27140404, 0x2324, 0x27140419, 0x26720419, 0x27140417, 0x268F0417, 0x2714041A, 0x268F041A, 0x27140417, 0x268F0417,
+		0x26990417, 0x27140417, 0x268F0417, 0x269A0417, 0x27140417, 0x268F0417, 0x27140417, 0x27140419, 0x268F0419, 0x27370419, 0x27140417, 0x26990417, 0x2714041A, 0x2699041A, 0x27140417, 0x26990417,
+		0x268F0417, 0x27140417, 0x26990417, 0x27140417, 0x2714041A, 0x2699041A, 0x2714041A, 0x26A4041A, 0x27140419, 0x26990419, 0x27370419, 0x27140417, 0x269A0417, 0x2714041A, 0x269A041A, 0x27140417,
+		0x269A0417, 0x268F0417, 0x27140417, 0x269A0417, 0x27140417, 0x27140419, 0x269A0419, 0x27370419, 0x27140417, 0x27140417, 0x27140419, 0x27140419, 0x2714041A, 0x2714041A, 0x27140419, 0x27140419,
+		0x27370419, 0x2714041A, 0x2736041A, 0x2714041A, 0x2737041A, 0x27150402, 0x27160402, 0x27170402, 0x27180402, 0x27180405, 0x27180405, 0x27180405, 0x27180405, 0x27180405, 0x27180405, 0x27180417,
+		0x27180418, 0x27180419, 0x2718041A, 0x27180417, 0x268F0417, 0x2718041A, 0x268F041A, 0x27180417, 0x268F0417, 0x26990417, 0x27180419, 0x268F0419, 0x26990419, 0x27180417, 0x268F0417, 0x27140417,
+		0x27180419, 0x268F0419, 0x27140419, 0x27180419, 0x268F0419, 0x27360419, 0x27180419, 0x268F0419, 0x27370419, 0x27180417, 0x26990417, 0x2718041A, 0x2699041A, 0x27180417, 0x26990417, 0x27140417,
+		0x27180419, 0x26990419, 0x27360419, 0x27180419, 0x26990419, 0x27370419, 0x27180417, 0x269A0417, 0x2718041A, 0x269A041A, 0x27180419, 0x26B30419, 0x27180419, 0x26B40419, 0x27180417, 0x27140417,
+		0x27180418, 0x27140418, 0x27180419, 0x27140419, 0x2718041A, 0x2714041A, 0x27180419, 0x27140419, 0x27360419, 0x27180419, 0x27140419, 0x27370419, 0x27180419, 0x27180419, 0x27180417, 0x27220417,
+		0x27180418, 0x27220418, 0x27180419, 0x27360419, 0x2718041A, 0x2736041A, 0x27180419, 0x27370419, 0x2718041A, 0x2737041A, 0x27190402, 0x27190405, 0x27190405, 0x27190419, 0x2719041A, 0x271A0402,
+		0x271B0402, 0x271B0417, 0x271B0418, 0x271B0419, 0x271B041A, 0x271C0402, 0x271D0402, 0x271E0402, 0x271F0402, 0x27200402, 0x27210402, 0x27220402, 0x27220405, 0x27220405, 0x27220405, 0x27220417,
+		0x27220418, 0x27220419, 0x2722041A, 0x27220417, 0x1317, 0x27220417, 0x268F0417, 0x2722041A, 0x268F041A, 0x27220417, 0x27140417, 0x2722041A, 0x2714041A, 0x27220417, 0x27140417, 0x268F0417,
+		0x27220417, 0x27140417, 0x27140417, 0x2722041A, 0x2736041A, 0x2722041A, 0x2737041A, 0x27230402, 0x27230417, 0x27230418, 0x27230419, 0x2723041A, 0x27240402, 0x27240417, 0x27240418, 0x27240419,
+		0x2724041A, 0x27240402, 0x1062, 0x27250402, 0x27260402, 0x27270402, 0x27270402, 0x1062, 0x27270419, 0x1079, 0x2727041A, 0x107A, 0x27280402, 0x27280404, 0x27280405, 0x27280405,
+		0x27280405, 0x27280419, 0x2728041A, 0x27280404, 0x26640404, 0x2728041A, 0x26C6041A, 0x270C041A, 0x2714041A, 0x27290402, 0x272A0402, 0x272A0419, 0x272A041A, 0x272B0402, 0x272B0419, 0x272B041A,
+		0x272C0402, 0x272C0419, 0x272C041A, 0x272C0404, 0x26640404, 0x272C041A, 0x2664041A, 0x272D0402, 0x272D0419, 0x272D041A, 0x272E0402, 0x272E0419, 0x272E041A, 0x272F0402, 0x27300402, 0x27300419,
+		0x2730041A, 0x27310402, 0x27320402, 0x27330402, 0x27340402, 0x27350402, 0x27360402, 0x27360417, 0x27360418, 0x27360419, 0x2736041A, 0x27360419, 0x1319, 0x2736041A, 0x131A, 0x27370402,
+		0x27370404, 0x27370405, 0x27370405, 0x27370405, 0x27370405, 0x27370405, 0x27370405, 0x27370417, 0x27370418, 0x27370419, 0x2737041A, 0x27370404, 0x26640404, 0x27370417, 0x268F0417, 0x2737041A,
+		0x268F041A, 0x27370419, 0x268F0419, 0x27370419, 0x27370417, 0x26990417, 0x2737041A, 0x2699041A, 0x27370419, 0x26990419, 0x27370419, 0x27370417, 0x269A0417, 0x2737041A, 0x269A041A, 0x27370419,
+		0x26B30419, 0x27370419, 0x26B40419, 0x27370417, 0x27140417, 0x27370418, 0x27140418, 0x27370419, 0x27140419, 0x2737041A, 0x2714041A, 0x27370417, 0x27140417, 0x27140417, 0x27370419, 0x27140419,
+		0x27140419, 0x27370419, 0x27140419, 0x27370419, 0x27370419, 0x27180419, 0x27370417, 0x27220417, 0x27370418, 0x27220418, 0x27370419, 0x27360419, 0x2737041A, 0x2736041A, 0x27370419, 0x27370419,
+		0x2737041A, 0x2737041A, 0x27380402, 0x27380417, 0x27380418, 0x27380419, 0x2738041A, 0x27390402, 0x273A0402, 0x273B0402, 0x273B0417, 0x273B0418, 0x273B0419, 0x273B041A, 0x273C0402, 0x273D0402,
+		0x273E0402, 0x273F0402, 0x27400402, 0x27410402, 0x27420402, 0x27430402, 0x27440402, 0x27450402, 0x27450419, 0x2745041A, 0x27450402, 0x1062, 0x27450419, 0x1079, 0x2745041A, 0x107A,
+		0x27460402, 0x27470402, 0x27480402, 0x27490402, 0x27490404, 0x2344, 0x274A0402, 0x274A0404, 0x2324, 0x274A0404, 0x2344, 0x274B0402, 0x274C0402, 0x274C0404, 0x2344, 0x274D0402,
+		0x274E0402, 0x274F0402, 0x27500402, 0x27510402, 0x27520402, 0x27520404, 0x2324, 0x27530402, 0x27540402, 0x27550402, 0x27560402, 0x27570402, 0x27580402, 0x27590402, 0x275A0402, 0x275A0419,
+		0x275B0402, 0x275C0402, 0x275C0404, 0x2324, 0x275D0402, 0x275E0402, 0x275F0402, 0x27600402, 0x27610402, 0x27620402, 0x276E0402, 0x276F0402, 0x27700402, 0x27710402, 0x27720402, 0x27730402,
+		0x27740402, 0x27750402, 0x27760402, 0x27770402, 0x27780402, 0x27790402, 0x277A0402, 0x277B0402, 0x277C0402, 0x277D0402, 0x277E0402, 0x277F0402, 0x27800402, 0x27810402, 0x27820402, 0x27830402,
+		0x27840402, 0x27850402, 0x27860402, 0x27870402, 0x27880402, 0x27890402, 0x278A0402, 0x278B0402, 0x278C0402, 0x278D0402, 0x278E0402, 0x278F0402, 0x27900402, 0x27910402, 0x27920402, 0x27930402,
+		0x27940402, 0x27950402, 0x27960402, 0x27970402, 0x27980402, 0x27990402, 0x279A0402, 0x279B0402, 0x279C0402, 0x279D0402, 0x279E0402, 0x279F0402, 0x27A00402, 0x27A10402, 0x27A20402, 0x27A30402,
+		0x27A40402, 0x27A50402, 0x27A60402, 0x27A70402, 0x27A80402, 0x27A90402, 0x27AA0402, 0x27AB0402, 0x27AC0402, 0x27AD0402, 0x27AE0402, 0x27AF0402, 0x27B00402, 0x27B10402, 0x27B20402, 0x27B30402,
+		0x27B40402, 0x27B50402, 0x27B60402, 0x27B70402, 0x27B80402, 0x27B90402, 0x27BA0402, 0x27BB0402, 0x27BC0402, 0x27BD0402, 0x27BE0402, 0x27BF0402, 0x27C00402, 0x27C10402, 0x27C20402, 0x27C30402,
+		0x27C40402, 0x27C50402, 0x27C50404, 0x2304, 0x27C60402, 0x27C60404, 0x2304, 0x27C70402, 0x27C80402, 0x27C80404, 0x2304, 0x27C90402, 0x27CA0402, 0x27CB0402, 0x27CC0402, 0x27CD0402,
+		0x27CE0402, 0x27CF0402, 0x27D00402, 0x27D10402, 0x27D20402, 0x27D30402, 0x27D40402, 0x27D50402, 0x27D60402, 0x27D70402, 0x27D80402, 0x27D90402, 0x27DA0402, 0x27DB0402, 0x27DC0402, 0x27DD0402,
+		0x27DE0402, 0x27DF0402, 0x27E00402, 0x27E10402, 0x27E20402, 0x27E30402, 0x27E40402, 0x27E50402, 0x27E60402, 0x27E70402, 0x27E80402, 0x27E90402, 0x27EA0402, 0x27EB0402, 0x27EC0402, 0x27ED0402,
+		0x27EE0402, 0x27EF0402, 0x27F00402, 0x27F10402, 0x27F20402, 0x27F30402, 0x27F40402, 0x27F50402, 0x27F60402, 0x27F70402, 0x27F80402, 0x27F90402, 0x27FA0402, 0x27FB0402, 0x27FC0402, 0x27FD0402,
+		0x27FE0402, 0x27FF0402, 0x28000402, 0x28010402, 0x28020402, 0x28030402, 0x28040402, 0x28050402, 0x28060402, 0x28070402, 0x28080402, 0x28090402, 0x280A0402, 0x280B0402, 0x280C0402, 0x280D0402,
+		0x280E0402, 0x280F0402, 0x28100402, 0x28110402, 0x28120402, 0x28130402, 0x28140402, 0x28150402, 0x28160402, 0x28170402, 0x28180402, 0x28190402, 0x281A0402, 0x281B0402, 0x281C0402, 0x281D0402,
+		0x281E0402, 0x281F0402, 0x28200402, 0x28210402, 0x28220402, 0x28230402, 0x28240402, 0x28250402, 0x28260402, 0x28270402, 0x28280402, 0x28290402, 0x282A0402, 0x282B0402, 0x282C0402, 0x282D0402,
+		0x282E0402, 0x282F0402, 0x28300402, 0x28310402, 0x28320402, 0x28330402, 0x28340402, 0x28350402, 0x28360402, 0x28370402, 0x28380402, 0x28390402, 0x283A0402, 0x283B0402, 0x283C0402, 0x283D0402,
+		0x283E0402, 0x283F0402, 0x28400402, 0x28410402, 0x28420402, 0x28430402, 0x28440402, 0x28450402, 0x28460402, 0x28470402, 0x28480402, 0x28490402, 0x284A0402, 0x284B0402, 0x284C0402, 0x284D0402,
+		0x284E0402, 0x284F0402, 0x28500402, 0x28510402, 0x28520402, 0x28530402, 0x28540402, 0x28550402, 0x28560402, 0x28570402, 0x28580402, 0x28590402, 0x285A0402, 0x285B0402, 0x285C0402, 0x285D0402,
+		0x285E0402, 0x285F0402, 0x28600402, 0x28610402, 0x28620402, 0x28630402, 0x28640402, 0x28650402, 0x28660402, 0x28670402, 0x28680402, 0x28690402, 0x286A0402, 0x286B0402, 0x286C0402, 0x286D0402,
+		0x286E0402, 0x286F0402, 0x28700402, 0x28710402, 0x28720402, 0x28730402, 0x28740402, 0x28750402, 0x28760402, 0x28770402, 0x28780402, 0x28790402, 0x287A0402, 0x287B0402, 0x287C0402, 0x287D0402,
+		0x287E0402, 0x287F0402, 0x28800402, 0x28810402, 0x28820402, 0x28830402, 0x28840402, 0x28850402, 0x28860402, 0x28870402, 0x28880402, 0x28890402, 0x288A0402, 0x288B0402, 0x288C0402, 0x288D0402,
+		0x288E0402, 0x288F0402, 0x28900402, 0x28910402, 0x28920402, 0x28930402, 0x28940402, 0x28950402, 0x28960402, 0x28970402, 0x28980402, 0x28990402, 0x289A0402, 0x289B0402, 0x289C0402, 0x289D0402,
+		0x289E0402, 0x289F0402, 0x28A00402, 0x28A10402, 0x28A20402, 0x28A30402, 0x28A40402, 0x28A50402, 0x28A60402, 0x28A70402, 0x28A80402, 0x28A90402, 0x28AA0402, 0x28AB0402, 0x28AC0402, 0x28AD0402,
+		0x28AE0402, 0x28AF0402, 0x28B00402, 0x28B10402, 0x28B20402, 0x28B30402, 0x28B40402, 0x28B50402, 0x28B60402, 0x28B70402, 0x28B80402, 0x28B90402, 0x28BA0402, 0x28BB0402, 0x28BC0402, 0x28BD0402,
+		0x28BE0402, 0x28BF0402, 0x28C00402, 0x28C10402, 0x28C20402, 0x28C30402, 0x28C40402, 0x28C50402, 0x28C60402, 0x28C70402, 0x28C80402, 0x28C90402, 0x28CA0402, 0x28CB0402, 0x28CC0402, 0x28CD0402,
+		0x28CE0402, 0x28CF0402, 0x28D00402, 0x28D10402, 0x28D20402, 0x28D30402, 0x28D40402, 0x28D50402, 0x28D60402, 0x28D70402, 0x28D80402, 0x28D90402, 0x28DA0402, 0x28DB0402, 0x28DC0402, 0x28DD0402,
+		0x28DE0402, 0x28DF0402, 0x28E00402, 0x28E10402, 0x28E20402, 0x28E30402, 0x28E40402, 0x28E50402, 0x28E60402, 0x28E70402, 0x28E80402, 0x28E90402, 0x28EA0402, 0x28EB0402, 0x28EC0402, 0x28ED0402,
+		0x28EE0402, 0x28EF0402, 0x28F00402, 0x28F10402, 0x28F20402, 0x28F30402, 0x28F40402, 0x28F50402, 0x28F60402, 0x28F70402, 0x28F80402, 0x28F90402, 0x28FA0402, 0x28FB0402, 0x28FC0402, 0x28FD0402,
+		0x28FE0402, 0x28FF0402, 0x29000402, 0x29010402, 0x29020402, 0x29030402, 0x29040402, 0x29050402, 0x29060402, 0x29070402, 0x29080402, 0x29090402, 0x290A0402, 0x290B0402, 0x290C0402, 0x290D0402,
+		0x290E0402, 0x290F0402, 0x29100402, 0x29110402, 0x29120402, 0x29130402, 0x29140402, 0x29150402, 0x29160402, 0x29170402, 0x29180402, 0x29190402, 0x291A0402, 0x291B0402, 0x291C0402, 0x291D0402,
+		0x291E0402, 0x291F0402, 0x29200402, 0x29210402, 0x29220402, 0x29230402, 0x29240402, 0x29250402, 0x29260402, 0x29270402, 0x29280402, 0x29290402, 0x292A0402, 0x292B0402, 0x292C0402, 0x292D0402,
+		0x292E0402, 0x292F0402, 0x29300402, 0x29310402, 0x29320402, 0x29330402, 0x29340402, 0x29350402, 0x29360402, 0x29370402, 0x29380402, 0x29390402, 0x293A0402, 0x293B0402, 0x293C0402, 0x293D0402,
+		0x293E0402, 0x293F0402, 0x29400402, 0x29410402, 0x29420402, 0x29430402, 0x29440402, 0x29450402, 0x29460402, 0x29470402, 0x29480402, 0x29490402, 0x294A0402, 0x294B0402, 0x294C0402, 0x294D0402,
+		0x294E0402, 0x294F0402, 0x29500402, 0x29510402, 0x29520402, 0x29530402, 0x29540402, 0x29550402, 0x29560402, 0x29570402, 0x29580402, 0x29590402, 0x295A0402, 0x295B0402, 0x295C0402, 0x295D0402,
+		0x295E0402, 0x295F0402, 0x29600402, 0x29610402, 0x29620402, 0x29630402, 0x29640402, 0x29650402, 0x29660402, 0x29670402, 0x29680402, 0x29690402, 0x296A0402, 0x296B0402, 0x296C0402, 0x296D0402,
+		0x296E0402, 0x296F0402, 0x29700402, 0x29710402, 0x29720402, 0x29730402, 0x29740402, 0x29750402, 0x29760402, 0x29770402, 0x29780402, 0x29790402, 0x297A0402, 0x297B0402, 0x297C0402, 0x297D0402,
+		0x297E0402, 0x297F0402, 0x29800402, 0x29810402, 0x29820402, 0x29830402, 0x29840402, 0x29850402, 0x29860402, 0x29870402, 0x29880402, 0x29890402, 0x298A0402, 0x298B0402, 0x298C0402, 0x298D0402,
+		0x298E0402, 0x298F0402, 0x29900402, 0x29910402, 0x29920402, 0x29930402, 0x29940402, 0x29950402, 0x29960402, 0x29970402, 0x29980402, 0x29990402, 0x299A0402, 0x299B0402, 0x299C0402, 0x299D0402,
+		0x299E0402, 0x299F0402, 0x29A00402, 0x29A10402, 0x29A20402, 0x29A30402, 0x29A40402, 0x29A50402, 0x29A60402, 0x29A70402, 0x29A80402, 0x29A90402, 0x29AA0402, 0x29AB0402, 0x29AC0402, 0x29AD0402,
+		0x29AE0402, 0x29AF0402, 0x29B00402, 0x29B10402, 0x29B20402, 0x29B30402, 0x29B40402, 0x29B50402, 0x29B60402, 0x29B70402, 0x29B80402, 0x29B90402, 0x29BA0402, 0x29BB0402, 0x29BC0402, 0x29BD0402,
+		0x29BE0402, 0x29BF0402, 0x29C00402, 0x29C10402, 0x29C20402, 0x29C30402, 0x29C40402, 0x29C50402, 0x29C60402, 0x29C70402, 0x29C80402, 0x29C90402, 0x29CA0402, 0x29CB0402, 0x29CC0402, 0x29CD0402,
+		0x29CE0402, 0x29CF0402, 0x29D00402, 0x29D10402, 0x29D20402, 0x29D30402, 0x29D40402, 0x29D50402, 0x29D60402, 0x29D70402, 0x29D80402, 0x29D90402, 0x29DA0402, 0x29DB0402, 0x29DC0402, 0x29DD0402,
+		0x29DE0402, 0x29DF0402, 0x29E00402, 0x29E10402, 0x29E20402, 0x29E30402, 0x29E40402, 0x29E50402, 0x29E60402, 0x29E70402, 0x29E80402, 0x29E90402, 0x29EA0402, 0x29EB0402, 0x29EC0402, 0x29ED0402,
+		0x29EF0402, 0x29F00402, 0x29F10402, 0x29F20402, 0x29F30402, 0x29F30402, 0x1842, 0x29F40402, 0x29F40402, 0x1842, 0x29F50402, 0x29F50402, 0x1842, 0x29F60402, 0x29F70402, 0x29F80402,
+		0x29F90402, 0x29FA0402, 0x29FB0402, 0x29FB0402, 0x1842, 0x29FC0402, 0x29FD0402, 0x29FE0402, 0x29FF0402, 0x2A000402, 0x2A010402, 0x2A020402, 0x2A030402, 0x2A030402, 0x1842, 0x2A040402,
+		0x2A050402, 0x2A050402, 0x1842, 0x2A060402, 0x2A070402, 0x2A080402, 0x2A090402, 0x2A0A0402, 0x2A0B0402, 0x2A0B0402, 0x1842, 0x2A0C0402, 0x2A0D0402, 0x2A0D0402, 0x1842, 0x2A0E0402,
+		0x2A0F0402, 0x2A100402, 0x2A110402, 0x2A120402, 0x2A120402, 0x1842, 0x2A130402, 0x2A140402, 0x2A140402, 0x1842, 0x2A150402, 0x2A160402, 0x2A160402, 0x1842, 0x2A170402, 0x2A180402,
+		0x2A190402, 0x2A1A0402, 0x2A1B0402, 0x2A1C0402, 0x2A1D0402, 0x2A1E0402, 0x2A1E0404, 0x2A1E0404, 0x2A1E0404, 0x2A1E0404, 0x2A1E0404, 0x2A1E0404, 0x2A1E0404, 0x2A1F0402, 0x2A200402, 0x2A210402,
+		0x2A210404, 0x2A210404, 0x2A210404, 0x2A210404, 0x2A210404, 0x2A220402, 0x2A230402, 0x2A240402, 0x2A250402, 0x2A260402, 0x2A270402, 0x2A280402, 0x2A290402, 0x2A2A0402, 0x2A2B0402, 0x2A2C0402,
+		0x2A2D0402, 0x2A2E0402, 0x2A2F0402, 0x2A300402, 0x2A310402, 0x2A320402, 0x2A330402, 0x2A340402, 0x2A350402, 0x2A360402, 0x2A380402, 0x2A390402, 0x2A3A0402, 0x2A3B0402, 0x2A3C0402, 0x2A3D0402,
+		0x2A3E0402, 0x2A3F0402, 0x2A400402, 0x2A410402, 0x2A420402, 0x2A430402, 0x2A440402, 0x2A450402, 0x2A460402, 0x2A470402, 0x2A480402, 0x2A490402, 0x2A4A0402, 0x2A4B0402, 0x2A4C0402, 0x2A4D0402,
+		0x2A4E0402, 0x2A4F0402, 0x2A500402, 0x2A510402, 0x2A520402, 0x2A530402, 0x2A540402, 0x2A550402, 0x2A560402, 0x2A570402, 0x2A580402, 0x2A580402, 0x1842, 0x2A590402, 0x2A590402, 0x1842,
+		0x2A5A0402, 0x2A5B0402, 0x2A5B0404, 0x2A7C0404, 0x2A5C0402, 0x2A5D0402, 0x2A5E0402, 0x2A5F0402, 0x2A600402, 0x2A610402, 0x2A620402, 0x2A630402, 0x2A640402, 0x2A650402, 0x2A650402, 0x1842,
+		0x2A660402, 0x2A670402, 0x2A680402, 0x2A690402, 0x2A6A0402, 0x2A6B0402, 0x2A6C0402, 0x2A6D0402, 0x2A6E0402, 0x2A6F0402, 0x2A700402, 0x2A710402, 0x2A720402, 0x2A730402, 0x2A740402, 0x2A750402,
+		0x2A760402, 0x2A770402, 0x2A780402, 0x2A790402, 0x2A7A0402, 0x2A7B0402, 0x2A7C0402, 0x2A7D0402, 0x2A7F0402, 0x2A800402, 0x2A810402, 0x2A820402, 0x2A830402, 0x2A840402, 0x2A850402, 0x2A860402,
+		0x2A870402, 0x2A880402, 0x2A890402, 0x2A8A0402, 0x2A8B0402, 0x2A8C0402, 0x2A8C0402, 0x1842, 0x2A8D0402, 0x2A8E0402, 0x2A8F0402, 0x2A900402, 0x2A900402, 0x1842, 0x2A910402, 0x2A910402,
+		0x1842, 0x2A920402, 0x2A930402, 0x2A940402, 0x2A950402, 0x2A960402, 0x2A960402, 0x1842, 0x2A970402, 0x2A980402, 0x2A990402, 0x2A9A0402, 0x2A9B0402, 0x2A9C0402, 0x2A9D0402, 0x2A9E0402,
+		0x2A9F0402, 0x2AA00402, 0x2AA10402, 0x2AA20402, 0x2AA30402, 0x2AA40402, 0x2AA40402, 0x1842, 0x2AA50402, 0x2AA60402, 0x2AA70402, 0x2AA80402, 0x2AA90402, 0x2AAA0402, 0x2AAB0402, 0x2AAB0402,
+		0x1842, 0x2AAC0402, 0x2AAD0402, 0x2AAE0402, 0x2AAF0402, 0x2AB00402, 0x2AB10402, 0x2AB20402, 0x2AB30402, 0x2AB40402, 0x2AB50402, 0x2AB60402, 0x2AB70402, 0x2AB80402, 0x2AB90402, 0x2ABA0402,
+		0x2ABB0402, 0x2ABC0402, 0x2ABD0402, 0x2ABE0402, 0x2ABF0402, 0x2AC00402, 0x2AC10402, 0x2AC20402, 0x2AC30402, 0x2AC40402, 0x2AC50402, 0x2AC60402, 0x2AC70402, 0x2AC80402, 0x2AC90402, 0x2ACA0402,
+		0x2ACB0402, 0x2ACC0402, 0x2ACD0402, 0x2ACE0402, 0x2ACF0402, 0x2AD00402, 0x2AD10402, 0x2AD20402, 0x2AD30402, 0x2AD40402, 0x2AD50402, 0x2AD60402, 0x2AD70402, 0x2AD80402, 0x2AD90402, 0x2ADA0402,
+		0x2ADB0402, 0x2ADC0402, 0x2ADD0402, 0x2ADE0402, 0x2ADF0402, 0x2AE00402, 0x2AE10402, 0x2AE20402, 0x2AE30402, 0x2AE40402, 0x2AE50402, 0x2AE60402, 0x2AE70402, 0x2AE80402, 0x2AE90402, 0x2AEA0402,
+		0x2AEB0402, 0x2AEC0402, 0x2AED0402, 0x2AEE0402, 0x2AEF0402, 0x2AF00402, 0x2AF10402, 0x2AF20402, 0x2AF30402, 0x2AF40402, 0x2AF50402, 0x2AF60402, 0x2AF70402, 0x2AF80402, 0x2AF90402, 0x2AFA0402,
+		0x2AFB0402, 0x2AFC0402, 0x2AFD0402, 0x2AFE0402, 0x2AFF0402, 0x2B000402, 0x2B010402, 0x2B020402, 0x2B030402, 0x2B040402, 0x2B050402, 0x2B060402, 0x2B070402, 0x2B080402, 0x2B090402, 0x2B0A0402,
+		0x2B0B0402, 0x2B0C0402, 0x2B0D0402, 0x2B0E0402, 0x2B0F0402, 0x2B100402, 0x2B110402, 0x2B120402, 0x2B130402, 0x2B140402, 0x2B150402, 0x2B160402, 0x2B170402, 0x2B170402, 0x1842, 0x2B180402,
+		0x2B180402, 0x1842, 0x2B190402, 0x2B1A0402, 0x2B1B0402, 0x2B1C0402, 0x2B1D0402, 0x2B1E0402, 0x2B1F0402, 0x2B200402, 0x2B210402, 0x2B220402, 0x2B230402, 0x2B240402, 0x2B250402, 0x2B260402,
+		0x2B270402, 0x2B280402, 0x2B290402, 0x2B2A0402, 0x2B2B0402, 0x2B2C0402, 0x2B2D0402, 0x2B2E0402, 0x2B2F0402, 0x2B300402, 0x2B310402, 0x2B320402, 0x2B330402, 0x2B340402, 0x2B350402, 0x2B360402,
+		0x2B370402, 0x2B380402, 0x2B390402, 0x2B3A0402, 0x2B3B0402, 0x2B3C0402, 0x2B3D0402, 0x2B3E0402, 0x2B3F0402, 0x2B400402, 0x2B410402, 0x2B420402, 0x2B430402, 0x2B440402, 0x2B450402, 0x2B460402,
+		0x2B470402, 0x2B480402, 0x2B490402, 0x2B4A0402, 0x2B4B0402, 0x2B4C0402, 0x2B4D0402, 0x2B4E0402, 0x2B4F0402, 0x2B500402, 0x2B510402, 0x2B520402, 0x2B530402, 0x2B540402, 0x2B550402, 0x2B560402,
+		0x2B570402, 0x2B580402, 0x2B590402, 0x2B5A0402, 0x2B5B0402, 0x2B5C0402, 0x2B5D0402, 0x2B5E0402, 0x2B5F0402, 0x2B600402, 0x2B610402, 0x2B620402, 0x2B630402, 0x2B640402, 0x2B650402, 0x2B660402,
+		0x2B670402, 0x2B680402, 0x2B690402, 0x2B6A0402, 0x2B6B0402, 0x2B6C0402, 0x2B6D0402, 0x2B6E0402, 0x2B6F0402, 0x2B700402, 0x2B710402, 0x2B720402, 0x2B730402, 0x2B740402, 0x2B750402, 0x2B760402,
+		0x2B770402, 0x2B780402, 0x2B790402, 0x2B7A0402, 0x2B7B0402, 0x2B7C0402, 0x2B7D0402, 0x2B7E0402, 0x2B7F0402, 0x2B800402, 0x2B810402, 0x2B820402, 0x2B830402, 0x2B840402, 0x2B850402, 0x2B860402,
+		0x2B870402, 0x2B880402, 0x2B890402, 0x2B8A0402, 0x2B8B0402, 0x2B8C0402, 0x2B8D0402, 0x2B8E0402, 0x2B8F0402, 0x2B900402, 0x2B910402, 0x2B920402, 0x2B930402, 0x2B940402, 0x2B950402, 0x2B960402,
+		0x2B970402, 0x2B980402, 0x2B990402, 0x2B9A0402, 0x2B9B0402, 0x2B9C0402, 0x2B9D0402, 0x2B9E0402, 0x2B9F0402, 0x2BA00402, 0x2BA10402, 0x2BA20402, 0x2BA30402, 0x2BA40402, 0x2BA50402, 0x2BA60402,
+		0x2BA70402, 0x2BA80402, 0x2BA90402, 0x2BAA0402, 0x2BAB0402, 0x2BAC0402, 0x2BAD0402, 0x2BAE0402, 0x2BAF0402, 0x2BB00402, 0x2BB10402, 0x2BB20402, 0x2BB30402, 0x2BB40402, 0x2BB50402, 0x2BB60402,
+		0x2BB70402, 0x2BB80402, 0x2BB90402, 0x2BBA0402, 0x2BBB0402, 0x2BBC0402, 0x2BBD0402, 0x2BBE0402, 0x2BBF0402, 0x2BC00402, 0x2BC10402, 0x2BC20402, 0x2BC30402, 0x2BC40402, 0x2BC50402, 0x2BC60402,
+		0x2BC70402, 0x2BC80402, 0x2BC90402, 0x2BCA0402, 0x2BCB0402, 0x2BCC0402, 0x2BCD0402, 0x2BCE0402, 0x2BCF0402, 0x2BD00402, 0x2BD10402, 0x2BD20402, 0x2BD30402, 0x2BD40402, 0x2BD50402, 0x2BD60402,
+		0x2BD70402, 0x2BD80402, 0x2BD90402, 0x2BDA0402, 0x2BDB0402, 0x2BDC0402, 0x2BDD0402, 0x2BDE0402, 0x2BDF0402, 0x2BE00402, 0x2BE10402, 0x2BE20402, 0x2BE30402, 0x2BE40402, 0x2BE50402, 0x2BE60402,
+		0x2BE70402, 0x2BE80402, 0x2BE90402, 0x2BEA0402, 0x2BEB0402, 0x2BEC0402, 0x2BED0402, 0x2BEE0402, 0x2BEF0402, 0x2BF00402, 0x2BF10402, 0x2BF20402, 0x2BF30402, 0x2BF40402, 0x2BF50402, 0x2BF60402,
+		0x2BF70402, 0x2BF80402, 0x2BF90402, 0x2BFA0402, 0x2BFB0402, 0x2BFC0402, 0x2BFD0402, 0x2BFE0402, 0x2BFF0402, 0x2C000402, 0x2C010402, 0x2C020402, 0x2C030402, 0x2C040402, 0x2C050402, 0x2C060402,
+		0x2C070402, 0x2C080402, 0x2C090402, 0x2C0A0402, 0x2C0B0402, 0x2C0C0402, 0x2C0D0402, 0x2C0E0402, 0x2C0F0402, 0x2C100402, 0x2C110402, 0x2C120402, 0x2C130402, 0x2C140402, 0x2C150402, 0x2C160402,
+		0x2C170402, 0x2C170404, 0x2C4F0404, 0x2C180402, 0x2C190402, 0x2C1A0402, 0x2C1B0402, 0x2C1C0402, 0x2C1D0402, 0x2C1E0402, 0x2C1F0402, 0x2C200402, 0x2C210402, 0x2C220402, 0x2C230402, 0x2C240402,
+		0x2C250402, 0x2C250404, 0x2C4F0404, 0x2C260402, 0x2C270402, 0x2C280402, 0x2C290402, 0x2C2A0402, 0x2C2A0404, 0x2C4F0404, 0x2C2B0402, 0x2C2C0402, 0x2C2D0402, 0x2C2E0402, 0x2C2F0402, 0x2C300402,
+		0x2C300404, 0x2C4F0404, 0x2C310402, 0x2C310404, 0x2C4F0404, 0x2C320402, 0x2C320404, 0x2C4F0404, 0x2C320404, 0x2C4F0404, 0x2C330402, 0x2C330404, 0x2C4F0404, 0x2C340402, 0x2C350402, 0x2C360402,
+		0x2C370402, 0x2C380402, 0x2C390402, 0x2C390404, 0x2C4F0404, 0x2C3A0402, 0x2C3A0404, 0x2C4F0404, 0x2C3B0402, 0x2C3C0402, 0x2C3D0402, 0x2C3F0402, 0x2C400402, 0x2C410402, 0x2C420402, 0x2C430402,
+		0x2C440402, 0x2C450402, 0x2C460402, 0x2C470402, 0x2C480402, 0x2C490402, 0x2C4A0402, 0x2C4B0402, 0x2C4C0402, 0x2C4D0402, 0x2C4E0402, 0x2C4F0402, 0x2C500402, 0x2C510402, 0x2C520402, 0x2C530402,
+		0x2C540402, 0x2C550402, 0x2C560402, 0x2C570402, 0x2C580402, 0x2C590402, 0x2C5A0402, 0x2C5B0402, 0x2C5C0402, 0x2C5D0402, 0x2C5E0402, 0x2C5F0402, 0x2C600402, 0x2C610402, 0x2C620402, 0x2C630402,
+		0x2C640402, 0x2C650402, 0x2C660402, 0x2C670402, 0x2C680402, 0x2C690402, 0x2C6A0402, 0x2C6B0402, 0x2C6C0402, 0x2C6D0402, 0x2C6E0402, 0x2C6F0402, 0x2C700402, 0x2C710402, 0x2C720402, 0x2C730402,
+		0x2C740402, 0x2C750402, 0x2C760402, 0x2C770402, 0x2C780402, 0x2C790402, 0x2C7A0402, 0x2C7B0402, 0x2C7C0402, 0x2C7D0402, 0x2C7E0402, 0x2C7F0402, 0x2C800402, 0x2C810402, 0x2C820402, 0x2C830402,
+		0x2C840402, 0x2C850402, 0x2C860402, 0x2C870402, 0x2C880402, 0x2C890402, 0x2C8A0402, 0x2C8B0402, 0x2C8C0402, 0x2C8D0402, 0x2C8E0402, 0x2C8F0402, 0x2C900402, 0x2C910402, 0x2C920402, 0x2C930402,
+		0x2C940402, 0x2C950402, 0x2C960402, 0x2C970402, 0x2C980402, 0x2C990402, 0x2C9A0402, 0x2C9B0402, 0x2C9C0402, 0x2C9D0402, 0x2C9E0402, 0x2C9F0402, 0x2CA00402, 0x2CA10402, 0x2CA20402, 0x2CA30402,
+		0x2CA40402, 0x2CA50402, 0x2CA60402, 0x2CA70402, 0x2CA80402, 0x2CA90402, 0x2CAA0402, 0x2CAB0402, 0x2CAC0402, 0x2CAD0402, 0x2CAE0402, 0x2CAF0402, 0x2CB00402, 0x2CB10402, 0x2CB20402, 0x2CB30402,
+		0x2CB40402, 0x2CB50402, 0x2CB60402, 0x2CB70402, 0x2CB80402, 0x2CB90402, 0x2CBA0402, 0x2CBB0402, 0x2CBC0402, 0x2CBD0402, 0x2CBE0402, 0x2CBF0402, 0x2CC00402, 0x2CC10402, 0x2CC20402, 0x2CC30402,
+		0x2CC40402, 0x2CC50402, 0x2CC60402, 0x2CC70402, 0x2CC80402, 0x2CC90402, 0x2CCA0402, 0x2CCB0402, 0x2CCC0402, 0x2CCD0402, 0x2CCE0402, 0x2CCF0402, 0x2CD00402, 0x2CD10402, 0x2CD20402, 0x2CD30402,
+		0x2CD40402, 0x2CD50402, 0x2CD60402, 0x2CD70402, 0x2CD80402, 0x2CD90402, 0x2CDA0402, 0x2CDB0402, 0x2CDC0402, 0x2CDD0402, 0x2CDE0402, 0x2CDF0402, 0x2CE00402, 0x2CE10402, 0x2CE20402, 0x2CE40402,
+		0x2CE50402, 0x2CE60402, 0x2CE70402, 0x2CE80402, 0x2CE90402, 0x2CEA0402, 0x2CEB0402, 0x2CEC0402, 0x2CED0402, 0x2CEE0402, 0x2CEF0402, 0x2CF00402, 0x2CF10402, 0x2CF20402, 0x2CF30402, 0x2CF40402,
+		0x2CF50402, 0x2CF60402, 0x2CF70402, 0x2CF80402, 0x2CF90402, 0x2CFA0402, 0x2CFB0402, 0x2CFC0402, 0x2CFD0402, 0x2CFE0402, 0x2CFF0402, 0x2D000402, 0x2D010402, 0x2D020402, 0x2D030402, 0x2D040402,
+		0x2D050402, 0x2D060402, 0x2D070402, 0x2D080402, 0x2D090402, 0x2D0A0402, 0x2D0B0402, 0x2D0C0402, 0x2D0D0402, 0x2D0E0402, 0x2D0F0402, 0x2D100402, 0x2D110402, 0x2D120402, 0x2D130402, 0x2D140402,
+		0x2D150402, 0x2D160402, 0x2D170402, 0x2D180402, 0x2D190402, 0x2D1A0402, 0x2D1B0402, 0x2D1C0402, 0x2D1D0402, 0x2D1E0402, 0x2D1F0402, 0x2D200402, 0x2D210402, 0x2D220402, 0x2D230402, 0x2D240402,
+		0x2D250402, 0x2D260402, 0x2D270402, 0x2D280402, 0x2D290402, 0x2D2A0402, 0x2D2B0402, 0x2D2C0402, 0x2D2D0402, 0x2D2E0402, 0x2D2F0402, 0x2D300402, 0x2D310402, 0x2D320402, 0x2D330402, 0x2D340402,
+		0x2D350402, 0x2D360402, 0x2D370402, 0x2D380402, 0x2D390402, 0x2D3A0402, 0x2D3B0402, 0x2D3C0402, 0x2D3D0402, 0x2D3E0402, 0x2D3F0402, 0x2D400402, 0x2D410402, 0x2D420402, 0x2D430402, 0x2D440402,
+		0x2D450402, 0x2D460402, 0x2D470402, 0x2D480402, 0x2D490402, 0x2D4A0402, 0x2D4B0402, 0x2D4C0402, 0x2D4D0402, 0x2D4E0402, 0x2D4F0402, 0x2D500402, 0x2D510402, 0x2D520402, 0x2D530402, 0x2D540402,
+		0x2D550402, 0x2D560402, 0x2D570402, 0x2D580402, 0x2D590402, 0x2D5A0402, 0x2D5B0402, 0x2D5C0402, 0x2D5D0402, 0x2D5D0402, 0x1842, 0x2D5E0402, 0x2D5E0402, 0x1842, 0x2D5F0402, 0x2D600402,
+		0x2D610402, 0x2D620402, 0x2D630402, 0x2D640402, 0x2D650402, 0x2D660402, 0x2D670402, 0x2D670402, 0x1842, 0x2D680402, 0x2D690402, 0x2D6A0402, 0x2D6B0402, 0x2D6C0402, 0x2D6D0402, 0x2D6E0402,
+		0x2D6F0402, 0x2D700402, 0x2D710402, 0x2D720402, 0x2D730402, 0x2D740402, 0x2D750402, 0x2D760402, 0x2D770402, 0x2D780402, 0x2D790402, 0x2D7A0402, 0x2D7B0402, 0x2D7C0402, 0x2D7D0402, 0x2D7E0402,
+		0x2D7F0402, 0x2D800402, 0x2D810402, 0x2D820402, 0x2D830402, 0x2D840402, 0x2D850402, 0x2D860402, 0x2D870402, 0x2D880402, 0x2D890402, 0x2D8A0402, 0x2D8B0402, 0x2D8C0402, 0x2D8D0402, 0x2D8E0402,
+		0x2D8F0402, 0x2D900402, 0x2D910402, 0x2D920402, 0x2D930402, 0x2D940402, 0x2D950402, 0x2D960402, 0x2D970402, 0x2D980402, 0x2D990402, 0x2D9A0402, 0x2D9B0402, 0x2D9C0402, 0x2D9D0402, 0x2D9E0402,
+		0x2D9F0402, 0x2DA00402, 0x2DA10402, 0x2DA20402, 0x2DA30402, 0x2DA40402, 0x2DA50402, 0x2DA60402, 0x2DA70402, 0x2DA80402, 0x2DA90402, 0x2DAA0402, 0x2DAB0402, 0x2DAC0402, 0x2DAD0402, 0x2DAE0402,
+		0x2DAF0402, 0x2DB00402, 0x2DB10402, 0x2DB20402, 0x2DB30402, 0x2DB40402, 0x2DB50402, 0x2DB60402, 0x2DB70402, 0x2DB80402, 0x2DB90402, 0x2DBA0402, 0x2DBB0402, 0x2DBC0402, 0x2DBD0402, 0x2DBE0402,
+		0x2DBF0402, 0x2DC00402, 0x2DC10402, 0x2DC20402, 0x2DC30402, 0x2DC40402, 0x2DC50402, 0x2DC60402, 0x2DC70402, 0x2DC80402, 0x2DC90402, 0x2DCA0402, 0x2DCB0402, 0x2DCC0402, 0x2DCD0402, 0x2DCE0402,
+		0x2DCF0402, 0x2DD00402, 0x2DD10402, 0x2DD20402, 0x2DD30402, 0x2DD40402, 0x2DD50402, 0x2DD60402, 0x2DD70402, 0x2DD80402, 0x2DD90402, 0x2DDA0402, 0x2DDB0402, 0x2DDC0402, 0x2DDD0402, 0x2DDE0402,
+		0x2DE00402, 0x2DE10402, 0x2DE20402, 0x2DE30402, 0x2DE40402, 0x2DE50402, 0x2DE60402, 0x2DE70402, 0x2DE80402, 0x2DE90402, 0x2DEA0402, 0x2DEB0402, 0x2DEC0402, 0x2DED0402, 0x2DEE0402, 0x2DEF0402,
+		0x2DF00402, 0x2DF10402, 0x2DF20402, 0x2DF30402, 0x2DF40402, 0x2DF50402, 0x2DF60402, 0x2DF70402, 0x2DF80402, 0x2DF90402, 0x2DFA0402, 0x2DFB0402, 0x2DFC0402, 0x2DFD0402, 0x2DFE0402, 0x2DFF0402,
+		0x2E000402, 0x2E010402, 0x2E020402, 0x2E030402, 0x2E040402, 0x2E050402, 0x2E060402, 0x2E070402, 0x2E080402, 0x2E090402, 0x2E0A0402, 0x2E0B0402, 0x2E0C0402, 0x2E0D0402, 0x2E0E0402, 0x2E0F0402,
+		0x2E100402, 0x2E110402, 0x2E120402, 0x2E130402, 0x2E140402, 0x2E150402, 0x2E160402, 0x2E170402, 0x2E180402, 0x2E190402, 0x2E1A0402, 0x2E1B0402, 0x2E1C0402, 0x2E1D0402, 0x2E1E0402, 0x2E1F0402,
+		0x2E200402, 0x2E210402, 0x2E220402, 0x2E230402, 0x2E240402, 0x2E250402, 0x2E260402, 0x2E270402, 0x2E280402, 0x2E290402, 0x2E2A0402, 0x2E2B0402, 0x2E2C0402, 0x2E2D0402, 0x2E2E0402, 0x2E2F0402,
+		0x2E300402, 0x2E310402, 0x2E320402, 0x2E330402, 0x2E340402, 0x2E350402, 0x2E360402, 0x2E370402, 0x2E380402, 0x2E390402, 0x2E3A0402, 0x2E3B0402, 0x2E3C0402, 0x2E3D0402, 0x2E3E0402, 0x2E3F0402,
+		0x2E400402, 0x2E410402, 0x2E420402, 0x2E430402, 0x2E440402, 0x2E450402, 0x2E460402, 0x2E470402, 0x2E480402, 0x2E490402, 0x2E4A0402, 0x2E4B0402, 0x2E4C0402, 0x2E4D0402, 0x2E4E0402, 0x2E4F0402,
+		0x2E500402, 0x2E510402, 0x2E520402, 0x2E530402, 0x2E540402, 0x2E550402, 0x2E560402, 0x2E570402, 0x2E580402, 0x2E590402, 0x2E5A0402, 0x2E5B0402, 0x2E5C0402, 0x2E5D0402, 0x2E5E0402, 0x2E5F0402,
+		0x2E600402, 0x2E610402, 0x2E620402, 0x2E630402, 0x2E640402, 0x2E650402, 0x2E660402, 0x2E670402, 0x2E680402, 0x2E690402, 0x2E6A0402, 0x2E6B0402, 0x2E6C0402, 0x2E6D0402, 0x2E6E0402, 0x2E6F0402,
+		0x2E700402, 0x2E710402, 0x2E720402, 0x2E730402, 0x2E740402, 0x2E750402, 0x2E760402, 0x2E770402, 0x2E780402, 0x2E790402, 0x2E7A0402, 0x2E7B0402, 0x2E7C0402, 0x2E7D0402, 0x2E7E0402, 0x2E7F0402,
+		0x2E800402, 0x2E810402, 0x2E820402, 0x2E830402, 0x2E840402, 0x2E850402, 0x2E860402, 0x2E870402, 0x2E880402, 0x2E890402, 0x2E8A0402, 0x2E8B0402, 0x2E8C0402, 0x2E8D0402, 0x2E8E0402, 0x2E8F0402,
+		0x2E900402, 0x2E910402, 0x2E920402, 0x2E930402, 0x2E940402, 0x2E950402, 0x2E960402, 0x2E970402, 0x2E980402, 0x2E990402, 0x2E9A0402, 0x2E9B0402, 0x2E9C0402, 0x2E9D0402, 0x2E9E0402, 0x2E9F0402,
+		0x2EA00402, 0x2EA10402, 0x2EA20402, 0x2EA30402, 0x2EA40402, 0x2EA50402, 0x2EA60402, 0x2EA70402, 0x2EA80402, 0x2EA90402, 0x2EAA0402, 0x2EAB0402, 0x2EAC0402, 0x2EAD0402, 0x2EAE0402, 0x2EAF0402,
+		0x2EB00402, 0x2EB10402, 0x2EB20402, 0x2EB30402, 0x2EB40402, 0x2EB50402, 0x2EB60402, 0x2EB70402, 0x2EB80402, 0x2EB90402, 0x2EBA0402, 0x2EBB0402, 0x2EBC0402, 0x2EBD0402, 0x2EBE0402, 0x2EBF0402,
+		0x2EC00402, 0x2EC10402, 0x2EC20402, 0x2EC30402, 0x2EC40402, 0x2EC50402, 0x2EC60402, 0x2EC70402, 0x2EC80402, 0x2EC90402, 0x2ECA0402, 0x2ECB0402, 0x2ECC0402, 0x2ECD0402, 0x2ECE0402, 0x2ECF0402,
+		0x2ED00402, 0x2ED10402, 0x2ED20402, 0x2ED30402, 0x2ED40402, 0x2ED50402, 0x2ED60402, 0x2ED70402, 0x2ED80402, 0x2ED90402, 0x2EDA0402, 0x2EDB0402, 0x2EDC0402, 0x2EDD0402, 0x2EDE0402, 0x2EDF0402,
+		0x2EE00402, 0x2EE10402, 0x2EE20402, 0x2EE30402, 0x2EE40402, 0x2EE50402, 0x2EE60402, 0x2EE70402, 0x2EE80402, 0x2EE90402, 0x2EEA0402, 0x2EEB0402, 0x2EEC0402, 0x2EED0402, 0x2EEE0402, 0x2EEF0402,
+		0x2EF00402, 0x2EF10402, 0x2EF40402, 0x2EF60402, 0x2EF70402, 0x2EF80402, 0x2EF90402, 0x2EFA0402, 0x2EFB0402, 0x2EFC0402, 0x2EFD0402, 0x2EFE0402, 0x2EFF0402, 0x2F000402, 0x2F010402, 0x2F020402,
+		0x2F030402, 0x2F040402, 0x2F050402, 0x2F060402, 0x2F070402, 0x2F080402, 0x2F090402, 0x2F0A0402, 0x2F0B0402, 0x2F0C0402, 0x2F0D0402, 0x2F0E0402, 0x2F0F0402, 0x2F100402, 0x2F110402, 0x2F120402,
+		0x2F130402, 0x2F140402, 0x2F150402, 0x2F160402, 0x2F170402, 0x2F180402, 0x2F190402, 0x2F1A0402, 0x2F1B0402, 0x2F1C0402, 0x2F1D0402, 0x2F1E0402, 0x2F1F0402, 0x2F200402, 0x2F210402, 0x2F220402,
+		0x2F230402, 0x2F240402, 0x2F250402, 0x2F260402, 0x2F270402, 0x2F280402, 0x2F290402, 0x2F2A0402, 0x2F2B0402, 0x2F2C0402, 0x2F2D0402, 0x2F2E0402, 0x2F2F0402, 0x2F300402, 0x2F310402, 0x2F320402,
+		0x2F330402, 0x2F340402, 0x2F350402, 0x2F360402, 0x2F370402, 0x2F380402, 0x2F390402, 0x2F3A0402, 0x2F3B0402, 0x2F3C0402, 0x2F3D0402, 0x2F3E0402, 0x2F3F0402, 0x2F400402, 0x2F410402, 0x2F420402,
+		0x2F430402, 0x2F440402, 0x2F450402, 0x2F460402, 0x2F470402, 0x2F480402, 0x2F490402, 0x2F490404, 0x2304, 0x2F490404, 0x2324, 0x2F4A0402, 0x2F4A0404, 0x2304, 0x2F4B0402, 0x2F4B0404,
+		0x2304, 0x2F4C0402, 0x2F4D0402, 0x2F4E0402, 0x2F4F0402, 0x2F500402, 0x2F510402, 0x2F520402, 0x2F530402, 0x2F540402, 0x2F550402, 0x2F560402, 0x2F570402, 0x2F580402, 0x2F590402, 0x2F5A0402,
+		0x2F5B0402, 0x2F5C0402, 0x2F5D0402, 0x2F5E0402, 0x2F5F0402, 0x2F600402, 0x2F610402, 0x2F620402, 0x2F630402, 0x2F640402, 0x2F650402, 0x2F660402, 0x2F670402, 0x2F680402, 0x2F690402, 0x2F6A0402,
+		0x2F6B0402, 0x2F6C0402, 0x2F6D0402, 0x2F6E0402, 0x2F6F0402, 0x2F700402, 0x2F710402, 0x2F720402, 0x2F730402, 0x2F740402, 0x2F750402, 0x2F760402, 0x2F770402, 0x2F780402, 0x2F790402, 0x2F790404,
+		0x2304, 0x2F7A0402, 0x2F7A0404, 0x2304, 0x2F7B0402, 0x2F7C0402, 0x2F7D0402, 0x2F7E0402, 0x2F7F0402, 0x2F800402, 0x2F810402, 0x2F820402, 0x2F830402, 0x2F840402, 0x2F850402, 0x2F860402,
+		0x2F870402, 0x2F880402, 0x2F890402, 0x2F8A0402, 0x2F8B0402, 0x2F8C0402, 0x2F8D0402, 0x2F8E0402, 0x2F8F0402, 0x2F900402, 0x2F910402, 0x2F920402, 0x2F930402, 0x2F940402, 0x2F950402, 0x2F960402,
+		0x2F970402, 0x2F980402, 0x2F990402, 0x2F9A0402, 0x2F9B0402, 0x2F9C0402, 0x2F9D0402, 0x2F9E0402, 0x2F9F0402, 0x2FA00402, 0x2FA10402, 0x2FA20402, 0x2FA30402, 0x2FA40402, 0x2FA50402, 0x2FA60402,
+		0x2FA70402, 0x2FA80402, 0x2FA90402, 0x2FAA0402, 0x2FAB0402, 0x2FAC0402, 0x2FAD0402, 0x2FAE0402, 0x2FAF0402, 0x2FB00402, 0x2FB10402, 0x2FB20402, 0x2FB30402, 0x2FB40402, 0x2FB50402, 0x2FB60402,
+		0x2FB70402, 0x2FB80402, 0x2FB90402, 0x2FBA0402, 0x2FBB0402, 0x2FBC0402, 0x2FBD0402, 0x2FBE0402, 0x2FBF0402, 0x2FC00402, 0x2FC10402, 0x2FC20402, 0x2FC30402, 0x2FC40402, 0x2FC50402, 0x2FC60402,
+		0x2FC70402, 0x2FC80402, 0x2FC90402, 0x2FCA0402, 0x2FCB0402, 0x2FCC0402, 0x2FCD0402, 0x2FCE0402, 0x2FCF0402, 0x2FD10402, 0x2FD20402, 0x2FD30402, 0x2FD40402, 0x2FD50402, 0x2FD60402, 0x2FD70402,
+		0x2FD80402, 0x2FD90402, 0x2FDA0402, 0x2FDB0402, 0x2FDC0402, 0x2FDD0402, 0x2FDE0402, 0x2FDF0402, 0x2FE00402, 0x2FE10402, 0x2FE20402, 0x2FE30402, 0x2FE40402, 0x2FE50402, 0x2FE60402, 0x2FE70402,
+		0x2FE80402, 0x2FE90402, 0x2FEA0402, 0x2FEB0402, 0x2FEC0402, 0x2FED0402, 0x2FEE0402, 0x2FEF0402, 0x2FF00402, 0x2FF10402, 0x2FF20402, 0x2FF30402, 0x2FF40402, 0x2FF50402, 0x2FF60402, 0x30A50402,
+		0x30A60402, 0x30A70402, 0x30A80402, 0x30A90402, 0x30A90404, 0x2304, 0x30AA0402, 0x30AB0402, 0x30AC0402, 0x30AD0402, 0x30AE0402, 0x30AF0402, 0x30B00402, 0x30B10402, 0x30B20402, 0x30B30402,
+		0x30B40402, 0x30B50402, 0x30B60402, 0x30B70402, 0x30B80402, 0x30B90402, 0x30B90404, 0x2304, 0x30BA0402, 0x30BB0402, 0x30BC0402, 0x30BD0402, 0x30BE0402, 0x30BF0402, 0x30C00402, 0x30C10402,
+		0x30C20402, 0x30C30402, 0x30C40402, 0x30C50402, 0x30C60402, 0x30C70402, 0x30C80402, 0x30C90402, 0x30CA0402, 0x30CB0402, 0x313A0402, 0x313A0404, 0x313B0402, 0x313C0402, 0x313D0402, 0x313E0402,
+		0x313F0402, 0x31400402, 0x31410402, 0x31410419, 0x31420402, 0x31430402, 0x31440402, 0x31450402, 0x31460402, 0x31470402, 0x31480402, 0x31490402, 0x314A0402, 0x314B0402, 0x314C0402, 0x314D0402,
+		0x314E0402, 0x314F0402, 0x31500402, 0x31510402, 0x31520402, 0x31520419, 0x31530402, 0x31540402, 0x31550402, 0x31560402, 0x31570402, 0x31580402, 0x31590402, 0x315A0402, 0x315B0402, 0x315C0402,
+		0x315D0402, 0x315E0402, 0x315F0402, 0x31600402, 0x31610402, 0x31620402, 0x31630402, 0x31640402, 0x31650402, 0x31660402, 0x31670402, 0x31680402, 0x31690402, 0x316A0402, 0x316B0402, 0x316C0402,
+		0x316D0402, 0x316E0402, 0x316F0402, 0x31700402, 0x31710402, 0x31720402, 0x31730402, 0x31740402, 0x31750402, 0x31760402, 0x31770402, 0x31780402, 0x31790402, 0x317A0402, 0x317B0402, 0x317C0402,
+		0x317D0402, 0x317E0402, 0x317F0402, 0x31800402, 0x31810402, 0x31820402, 0x31830402, 0x31840402, 0x31850402, 0x31860402, 0x31870402, 0x31880402, 0x31890402, 0x318A0402, 0x318B0402, 0x318C0402,
+		0x318D0402, 0x318E0402, 0x318F0402, 0x31900402, 0x31910402, 0x31920402, 0x31930402, 0x31940402, 0x31950402, 0x31960402, 0x31970402, 0x31980402, 0x31990402, 0x319A0402, 0x319B0402, 0x319C0402,
+		0x319D0402, 0x319E0402, 0x319F0402, 0x31A00402, 0x31A10402, 0x31A20402, 0x31A30402, 0x31A40402, 0x31A50402, 0x31A60402, 0x31A70402, 0x31A80402, 0x31A90402, 0x31AA0402, 0x31AB0402, 0x31AC0402,
+		0x31AD0402, 0x31AE0402, 0x31AF0402, 0x31B00402, 0x31B10402, 0x31B20402, 0x31B30402, 0x31B40402, 0x31B50402, 0x31B60402, 0x31B70402, 0x31B80402, 0x31B90402, 0x31BA0402, 0x31BB0402, 0x31BC0402,
+		0x31BD0402, 0x31BE0402, 0x31BF0402, 0x31C10402, 0x31C20402, 0x31C30402, 0x31C40402, 0x31C50402, 0x31C60402, 0x31C70402, 0x31C80402, 0x31C90402, 0x31CA0402, 0x31CB0402, 0x31CC0402, 0x31CD0402,
+		0x31CE0402, 0x31CF0402, 0x31D00402, 0x31D10402, 0x31D20402, 0x31D30402, 0x31D50402, 0x31D60402, 0x31D70402, 0x31D80402, 0x31D90402, 0x31DA0402, 0x31DB0402, 0x31DC0402, 0x31DD0402, 0x31DE0402,
+		0x31DF0402, 0x31E00402, 0x31E10402, 0x31E20402, 0x31E30402, 0x31E40402, 0x31E50402, 0x31E60402, 0x31E70402, 0x31E80402, 0x31E90402, 0x31EA0402, 0x31EB0402, 0x31EC0402, 0x31ED0402, 0x31EE0402,
+		0x31EF0402, 0x31F00402, 0x31F10402, 0x31F20402, 0x31F30402, 0x31F40402, 0x31F50402, 0x31F60402, 0x31F70402, 0x31F80402, 0x31F90402, 0x31FA0402, 0x31FB0402, 0x31FC0402, 0x31FD0402, 0x31FE0402,
+		0x31FF0402, 0x32000402, 0x32010402, 0x32020402, 0x32030402, 0x32040402, 0x32050402, 0x32060402, 0x32070402, 0x32080402, 0x32090402, 0x320A0402, 0x320B0402, 0x320C0402, 0x320D0402, 0x320E0402,
+		0x320F0402, 0x32100402, 0x32110402, 0x32120402, 0x32130402, 0x32140402, 0x32150402, 0x32160402, 0x32170402, 0x32180402, 0x32190402, 0x321A0402, 0x321B0402, 0x321C0402, 0x321D0402, 0x321E0402,
+		0x321F0402, 0x32200402, 0x32210402, 0x32220402, 0x32230402, 0x32240402, 0x32250402, 0x32260402, 0x32270402, 0x32280402, 0x32290402, 0x322A0402, 0x322B0402, 0x322C0402, 0x322D0402, 0x322E0402,
+		0x322F0402, 0x32300402, 0x32310402, 0x32320402, 0x32330402, 0x32340402, 0x32350402, 0x32360402, 0x32370402, 0x32380402, 0x32390402, 0x323A0402, 0x323B0402, 0x323C0402, 0x323D0402, 0x323E0402,
+		0x323F0402, 0x32400402, 0x32410402, 0x32420402, 0x32430402, 0x32440402, 0x32450402, 0x32460402, 0x32470402, 0x32480402, 0x32490402, 0x324A0402, 0x324B0402, 0x324C0402, 0x324D0402, 0x324E0402,
+		0x324F0402, 0x32500402, 0x32510402, 0x32520402, 0x32530402, 0x32540402, 0x32550402, 0x32560402, 0x32570402, 0x32580402, 0x32590402, 0x325A0402, 0x325C0402, 0x325D0402, 0x325F0402, 0x32600402,
+		0x32630402, 0x32640402, 0x326A0402, 0x326B0402, 0x326C0402, 0x326D0402, 0x326F0402, 0x32700402, 0x32710402, 0x32720402, 0x32730402, 0x32740402, 0x32750402, 0x32770402, 0x32780402, 0x32790402,
+		0x327A0402, 0x327B0402, 0x327E0402, 0x327E0404, 0x326F0404, 0x327E0404, 0x32770404, 0x32800402, 0x32810402, 0x32820402, 0x32830402, 0x32840402, 0x32850402, 0x32860402, 0x32870402, 0x32880402,
+		0x32890402, 0x328A0402, 0x328B0402, 0x328C0402, 0x328E0402, 0x328F0402, 0x32900402, 0x32910402, 0x32920402, 0x32930402, 0x32940402, 0x32950402, 0x32960402, 0x32970402, 0x32980402, 0x32990402,
+		0x329A0402, 0x329B0402, 0x329C0402, 0x329D0402, 0x329E0402, 0x329F0402, 0x32A00402, 0x32A10402, 0x32A20402, 0x32A30402, 0x32A40402, 0x32A50402, 0x32A60402, 0x32A70402, 0x32A80402, 0x32A90402,
+		0x32AA0402, 0x32AB0402, 0x32AC0402, 0x32AD0402, 0x32AE0402, 0x32AF0402, 0x32B00402, 0x32B10402, 0x32B20402, 0x32B30402, 0x32B40402, 0x32B50402, 0x32B60402, 0x32B70402, 0x32B80402, 0x32B90402,
+		0x32BA0402, 0x32BB0402, 0x32BC0402, 0x32BD0402, 0x32BE0402, 0x32BF0402, 0x32C00402, 0x32C10402, 0x32C20402, 0x32C30402, 0x32C40402, 0x32C50402, 0x32C60402, 0x32C70402, 0x32C80402, 0x32C90402,
+		0x32CA0402, 0x32CB0402, 0x32CC0402, 0x32CD0402, 0x32CE0402, 0x32CF0402, 0x32D00402, 0x32D10402, 0x32D20402, 0x32D30402, 0x32D40402, 0x32D50402, 0x32D60402, 0x32D70402, 0x32D80402, 0x32D90402,
+		0x32D90402, 0x331A0402, 0x32DA0402, 0x32DA0402, 0x331A0402, 0x32DB0402, 0x32DC0402, 0x32DD0402, 0x32DE0402, 0x32DE0402, 0x331E0402, 0x32DF0402, 0x32DF0402, 0x331E0402, 0x32E00402, 0x32E10402,
+		0x32E20402, 0x32E30402, 0x32E40402, 0x32E50402, 0x32E60402, 0x32E70402, 0x32E80402, 0x32E90402, 0x32EA0402, 0x32EB0402, 0x32EC0402, 0x32ED0402, 0x32EE0402, 0x32EE0402, 0x331E0402, 0x32EF0402,
+		0x32EF0402, 0x331E0402, 0x32F00402, 0x32F10402, 0x32F20402, 0x32F30402, 0x32F40402, 0x32F50402, 0x32F60402, 0x32F60402, 0x331E0402, 0x32F70402, 0x32F70402, 0x331E0402, 0x32F80402, 0x32F90402,
+		0x32FA0402, 0x32FB0402, 0x32FC0402, 0x32FD0402, 0x32FE0402, 0x32FE0402, 0x331E0402, 0x32FF0402, 0x32FF0402, 0x331E0402, 0x33000402, 0x33010402, 0x33020402, 0x33030402, 0x33040402, 0x33050402,
+		0x33060402, 0x33060402, 0x331E0402, 0x33070402, 0x33070402, 0x331E0402, 0x33080402, 0x33090402, 0x33090404, 0x2324, 0x330A0402, 0x330B0402, 0x330C0402, 0x330D0402, 0x330E0402, 0x330F0402,
+		0x33100402, 0x33110402, 0x33110404, 0x2324, 0x33120402, 0x33120404, 0x2324, 0x33130402, 0x33130404, 0x2324, 0x33140402, 0x33150402, 0x33160402, 0x33170402, 0x33180402, 0x33190402,
+		0x331A0402, 0x331B0402, 0x331C0402, 0x331D0402, 0x331E0402, 0x331F0402, 0x331F0404, 0x33360404, 0x1884, 0x33200402, 0x33210402, 0x33220402, 0x33230402, 0x33240402, 0x33250402, 0x33260402,
+		0x33270402, 0x33280402, 0x33290402, 0x332A0402, 0x332B0402, 0x332C0402, 0x332D0402, 0x332E0402, 0x332F0402, 0x33300402, 0x33310402, 0x33320402, 0x33330402, 0x33340402, 0x33350402, 0x33360402,
+		0x33370402, 0x33380402, 0x33A60402, 0x33A70402, 0x33A80402, 0x33A90402, 0x33AA0402, 0x33AB0402, 0x33AC0402, 0x33AD0402, 0x33AE0402, 0x33AF0402, 0x33B00402, 0x33B10402, 0x33B20402, 0x33B30402,
+		0x33B40402, 0x33B50402, 0x33B60402, 0x33B70402, 0x33B80402, 0x33B90402, 0x33BA0402, 0x33BB0402, 0x33BC0402, 0x33BD0402, 0x33BE0402, 0x33BF0402, 0x33C00402, 0x33C10402, 0x33C20402, 0x33C30402,
+		0x33C40402, 0x33C50402, 0x33C60402, 0x33C70402, 0x33C80402, 0x33C90402, 0x33CA0402, 0x33CB0402, 0x33CC0402, 0x33CD0402, 0x33CE0402, 0x33CF0402, 0x33D00402, 0x33D10402, 0x33D20402, 0x33D30402,
+		0x33D40402, 0x33D50402, 0x33D60402, 0x33D70402, 0x33D80402, 0x33D90402, 0x33DA0402, 0x33DB0402, 0x33DC0402, 0x33DD0402, 0x33DE0402, 0x33DF0402, 0x33E00402, 0x33E10402, 0x33E20402, 0x33E30402,
+		0x33E40402, 0x33E50402, 0x33E60402, 0x33E70402, 0x33E80402, 0x33E90402, 0x33EA0402, 0x33EB0402, 0x33EC0402, 0x33ED0402, 0x33EE0402, 0x33EF0402, 0x33F00402, 0x33F10402, 0x33F20402, 0x33F30402,
+		0x33F40402, 0x33F50402, 0x33F60402, 0x33F70402, 0x33F80402, 0x33F90402, 0x33FA0402, 0x33FB0402, 0x33FC0402, 0x33FD0402, 0x33FE0402, 0x33FF0402, 0x34000402, 0x34010402, 0x34020402, 0x34030402,
+		0x34040402, 0x34050402, 0x34060402, 0x34070402, 0x34080402, 0x34090402, 0x340A0402, 0x340B0402, 0x340C0402, 0x340D0402, 0x340E0402, 0x340F0402, 0x34100402, 0x34110402, 0x34120402, 0x34130402,
+		0x34140402, 0x34150402, 0x34160402, 0x34170402, 0x34180402, 0x34190402, 0x341A0402, 0x341B0402, 0x341C0402, 0x341D0402, 0x341E0402, 0x341F0402, 0x34200402, 0x34210402, 0x34220402, 0x34230402,
+		0x34240402, 0x34250402, 0x34260402, 0x34270402, 0x34280402, 0x34290402, 0x342A0402, 0x342B0402, 0x342C0402, 0x342D0402, 0x342E0402, 0x342F0402, 0x34300402, 0x34310402, 0x34320402, 0x34330402,
+		0x34340402, 0x34350402, 0x34360402, 0x34370402, 0x34380402, 0x34390402, 0x343A0402, 0x343B0402, 0x343C0402, 0x343D0402, 0x343E0402, 0x343F0402, 0x34400402, 0x34410402, 0x34420402, 0x34430402,
+		0x34440402, 0x34450402, 0x34460402, 0x34470402, 0x34480402, 0x34490402, 0x344A0402, 0x344B0402, 0x344C0402, 0x344D0402, 0x344E0402, 0x344F0402, 0x34500402, 0x34510402, 0x34520402, 0x34530402,
+		0x34540402, 0x34550402, 0x34560402, 0x34570402, 0x34580402, 0x34590402, 0x345A0402, 0x345B0402, 0x345C0402, 0x345C0404, 0x347A0404, 0x345D0402, 0x345E0402, 0x345F0402, 0x345F0404, 0x347B0404,
+		0x34600402, 0x34610402, 0x34620402, 0x34630402, 0x34640402, 0x34650402, 0x34660402, 0x34670402, 0x34680402, 0x34690402, 0x346A0402, 0x346B0402, 0x346C0402, 0x346D0402, 0x346E0402, 0x346F0402,
+		0x34700402, 0x34710402, 0x34720402, 0x34730402, 0x34740402, 0x34750402, 0x34760402, 0x34770402, 0x34780402, 0x34790402, 0x347A0402, 0x347B0402, 0x347C0402, 0x347D0402, 0x347E0402, 0x347F0402,
+		0x34800402, 0x34810402, 0x34820402, 0x34830402, 0x34840402, 0x34850402, 0x34860402, 0x34870402, 0x34880402, 0x34890402, 0x348A0402, 0x348B0402, 0x348C0402, 0x348D0402, 0x348E0402, 0x348F0402,
+		0x34900402, 0x34910402, 0x34920402, 0x34930402, 0x34940402, 0x34950402, 0x34960402, 0x34970402, 0x34980402, 0x34990402, 0x349A0402, 0x349B0402, 0x349C0402, 0x349D0402, 0x349E0402, 0x349F0402,
+		0x34A00402, 0x34A10402, 0x34A20402, 0x34A30402, 0x34A40402, 0x34A50402, 0x34A60402, 0x34A70402, 0x34A80402, 0x34A90402, 0x34AA0402, 0x34AB0402, 0x34AC0402, 0x34AD0402, 0x34AE0402, 0x34AF0402,
+		0x34B00402, 0x34B10402, 0x34B20402, 0x34B30402, 0x34B40402, 0x34B50402, 0x34B60402, 0x34B70402, 0x34B80402, 0x34B90402, 0x34BA0402, 0x34BB0402, 0x34BC0402, 0x34BD0402, 0x34BE0402, 0x34BF0402,
+		0x34C00402, 0x34C10402, 0x34C20402, 0x34C30402, 0x34C40402, 0x34C50402, 0x34C60402, 0x34C70402, 0x34C80402, 0x34C90402, 0x34CA0402, 0x34CB0402, 0x34CC0402, 0x34CD0402, 0x34CE0402, 0x34CF0402,
+		0x34D00402, 0x34D10402, 0x34D20402, 0x34D30402, 0x34D40402, 0x34D50402, 0x34D60402, 0x34D70402, 0x34D80402, 0x34D90402, 0x34DA0402, 0x34DB0402, 0x34DC0402, 0x34DD0402, 0x34DE0402, 0x34DF0402,
+		0x34E00402, 0x34E10402, 0x34E20402, 0x34E30402, 0x34E40402, 0x34E50402, 0x34E60402, 0x34E70402, 0x34E80402, 0x34E90402, 0x34EA0402, 0x34EB0402, 0x34EC0402, 0x34ED0402, 0x34EE0402, 0x34EF0402,
+		0x34F00402, 0x35080402, 0x35080404, 0x35090402, 0x35090404, 0x35090404, 0x350A0402, 0x350A0404, 0x350B0402, 0x350B0404, 0x350C0402, 0x350C0404, 0x350D0402, 0x350D0404, 0x350D0404, 0x350E0402,
+		0x350E0404, 0x350F0402, 0x35100402, 0x35110402, 0x35110404, 0x35120402, 0x35120404, 0x35130402, 0x35130404, 0x35140402, 0x35140404, 0x35140404, 0x35150402, 0x35150404, 0x35160402, 0x35170402,
+		0x35170404, 0x35180402, 0x35190402, 0x351A0402, 0x351B0402, 0x351C0402, 0x351D0402, 0x351E0402, 0x351E0404, 0x351F0402, 0x35200402, 0x35200404, 0x35210402, 0x35210404, 0x35220402, 0x35220404,
+		0x35230402, 0x35240402, 0x35250402, 0x35260402, 0x35270402, 0x35280402, 0x35290402, 0x352A0402, 0x352B0402, 0x352C0402, 0x352D0402, 0x352E0402, 0x352F0402, 0x35300402, 0x35310402, 0x35320402,
+		0x35330402, 0x35340402, 0x35350402, 0x35360402, 0x35370402, 0x35380402, 0x35390402, 0x353A0402, 0x353B0402, 0x353C0402, 0x353D0402, 0x353E0402, 0x353F0402, 0x35400402, 0x35410402, 0x35420402,
+		0x35430402, 0x35440402, 0x35450402, 0x35460402, 0x35470402, 0x35480402, 0x35490402, 0x354A0402, 0x354B0402, 0x354C0402, 0x354D0402, 0x354E0402, 0x354F0402, 0x35500402, 0x35510402, 0x35520402,
+		0x35530402, 0x35540402, 0x35550402, 0x35560402, 0x35570402, 0x35580402, 0x35590402, 0x355A0402, 0x355B0402, 0x355C0402, 0x355D0402, 0x355E0402, 0x355F0402, 0x35600402, 0x35610402, 0x35620402,
+		0x35630402, 0x35640402, 0x35650402, 0x35660402, 0x35670402, 0x35680402, 0x35690402, 0x356A0402, 0x356B0402, 0x356C0402, 0x356D0402, 0x356E0402, 0x356F0402, 0x35700402, 0x35710402, 0x35720402,
+		0x35730402, 0x35740402, 0x35750402, 0x35760402, 0x35770402, 0x35780402, 0x35790402, 0x357A0402, 0x357B0402, 0x357C0402, 0x357D0402, 0x357E0402, 0x357F0402, 0x35800402, 0x35810402, 0x35820402,
+		0x35830402, 0x35840402, 0x35850402, 0x35860402, 0x35870402, 0x35880402, 0x35890402, 0x358A0402, 0x358B0402, 0x358C0402, 0x358D0402, 0x358E0402, 0x358F0402, 0x35900402, 0x35910402, 0x35920402,
+		0x35930402, 0x35940402, 0x35950402, 0x35960402, 0x35970402, 0x35980402, 0x35990402, 0x359A0402, 0x359B0402, 0x359C0402, 0x359D0402, 0x359E0402, 0x359F0402, 0x35A00402, 0x35A10402, 0x35A20402,
+		0x35A30402, 0x35A40402, 0x35A50402, 0x35A60402, 0x35A70402, 0x35A80402, 0x35A90402, 0x35AA0402, 0x35AB0402, 0x35AC0402, 0x35AD0402, 0x35AE0402, 0x35AF0402, 0x35B00402, 0x35B10402, 0x35B20402,
+		0x35B30402, 0x35B40402, 0x35B50402, 0x35B60402, 0x35B70402, 0x35B80402, 0x35B90402, 0x35BA0402, 0x35BB0402, 0x35BC0402, 0x35BD0402, 0x35BE0402, 0x35BF0402, 0x35C00402, 0x35C10402, 0x35C20402,
+		0x35C30402, 0x35C40402, 0x35C50402, 0x35C60402, 0x35C70402, 0x35C80402, 0x35C90402, 0x35C90404, 0x36020404, 0x35C90404, 0x35CA0402, 0x35CB0402, 0x35CC0402, 0x35CD0402, 0x35CE0402, 0x35CF0402,
+		0x35D00402, 0x35D10402, 0x35D20402, 0x35D30402, 0x35D40402, 0x35D50402, 0x35D60402, 0x35D70402, 0x35D80402, 0x35D90402, 0x35DA0402, 0x35DB0402, 0x35DC0402, 0x35DD0402, 0x35DE0402, 0x35DF0402,
+		0x35E00402, 0x35E10402, 0x35E20402, 0x35E30402, 0x35E40402, 0x35E50402, 0x35E60402, 0x35E60404, 0x35E70402, 0x35E80402, 0x35E90402, 0x35EA0402, 0x35EB0402, 0x35EC0402, 0x35ED0402, 0x35EE0402,
+		0x35EF0402, 0x35F00402, 0x35F10402, 0x35F20402, 0x35F30402, 0x35F40402, 0x35F50402, 0x35F60402, 0x35F70402, 0x35F80402, 0x35F90402, 0x35FA0402, 0x35FB0402, 0x35FC0402, 0x35FD0402, 0x35FE0402,
+		0x35FF0402, 0x36000402, 0x36010402, 0x36020402, 0x36030402, 0x36040402, 0x36050402, 0x36060402, 0x36070402, 0x36080402, 0x36090402, 0x360A0402, 0x360B0402, 0x360C0402, 0x360D0402, 0x360E0402,
+		0x360F0402, 0x36100402, 0x36110402, 0x36120402, 0x36130402, 0x36140402, 0x36150402, 0x36160402, 0x36170402, 0x36180402, 0x36190402, 0x361A0402, 0x363F0402, 0x36400402, 0x36410402, 0x36420402,
+		0x36430402, 0x36440402, 0x36450402, 0x36460402, 0x36470402, 0x36480402, 0x36490402, 0x364A0402, 0x364B0402, 0x364C0402, 0x364D0402, 0x364E0402, 0x364F0402, 0x36500402, 0x36510402, 0x36520402,
+		0x36530402, 0x36540402, 0x36550402, 0x36560402, 0x36570402, 0x36580402, 0x36590402, 0x365A0402, 0x365B0402, 0x365C0402, 0x365D0402, 0x365E0402, 0x365F0402, 0x36610402, 0x36620402, 0x36630402,
+		0x36650402, 0x36660402, 0x36670402, 0x36680402, 0x36690402, 0x366A0402, 0x366B0402, 0x366C0402, 0x366D0402, 0x366E0402, 0x366F0402, 0x36700402, 0x36730402, 0x36740402, 0x36750402, 0x36760402,
+		0x36770402, 0x36780402, 0x36790402, 0x367A0402, 0x367B0402, 0x367C0402, 0x367D0402, 0x367E0402, 0x367F0402, 0x36800402, 0x36810402, 0x36820402, 0x36830402, 0x36840402, 0x36850402, 0x36860402,
+		0x36870402, 0x36880402, 0x36890402, 0x368A0402, 0x368B0402, 0x368C0402, 0x368D0402, 0x368E0402, 0x368F0402, 0x36900402, 0x36910402, 0x36920402, 0x36930402, 0x36940402, 0x36950402, 0x36960402,
+		0x36970402, 0x36980402, 0x36990402, 0x369A0402, 0x369B0402, 0x369C0402, 0x369D0402, 0x369E0402, 0x369F0402, 0x36A00402, 0x36A10402, 0x36A20402, 0x36A30402, 0x36A40402, 0x36A50402, 0x36A60402,
+		0x36A70402, 0x36A80402, 0x36A90402, 0x36AA0402, 0x36AB0402, 0x36AC0402, 0x36AD0402, 0x36AE0402, 0x36AF0402, 0x36B00402, 0x36B10402, 0x36B20402, 0x36B30402, 0x36B40402, 0x36B50402, 0x36B60402,
+		0x36B70402, 0x36B80402, 0x36B90402, 0x36BA0402, 0x36BB0402, 0x36BC0402, 0x36BD0402, 0x36BE0402, 0x36BF0402, 0x36C00402, 0x36C10402, 0x36C20402, 0x36C30402, 0x36C40402, 0x36C50402, 0x36C60402,
+		0x36C70402, 0x36C80402, 0x36C90402, 0x36CA0402, 0x36CB0402, 0x36CC0402, 0x36CD0402, 0x36CE0402, 0x36CF0402, 0x36D00402, 0x36D10402, 0x36D20402, 0x36D30402, 0x36D40402, 0x36D50402, 0x36D60402,
+		0x36D70402, 0x36D80402, 0x36D90402, 0x36DA0402, 0x36DB0402, 0x36DC0402, 0x36DD0402, 0x36DE0402, 0x36DF0402, 0x36E00402, 0x36E10402, 0x36E20402, 0x36E30402, 0x36E40402, 0x36E50402, 0x36E60402,
+		0x36E70402, 0x36E80402, 0x36E90402, 0x36EA0402, 0x36EB0402, 0x36EC0402, 0x36ED0402, 0x36EE0402, 0x36EF0402, 0x36F00402, 0x36F10402, 0x36F20402, 0x36F30402, 0x36F40402, 0x36F50402, 0x36F60402,
+		0x36F70402, 0x36F80402, 0x36F90402, 0x36FA0402, 0x36FA0404, 0x37100404, 0x36FA0404, 0x37100404, 0x371B0404, 0x36FB0402, 0x36FC0402, 0x36FD0402, 0x36FE0402, 0x36FF0402, 0x37000402, 0x37010402,
+		0x37020402, 0x37030402, 0x37040402, 0x37050402, 0x37060402, 0x37070402, 0x37080402, 0x37090402, 0x370A0402, 0x370B0402, 0x370C0402, 0x370D0402, 0x370E0402, 0x370F0402, 0x37100402, 0x37110402,
+		0x37120402, 0x37130402, 0x37140402, 0x37150402, 0x37160402, 0x37170402, 0x37180402, 0x37190402, 0x371A0402, 0x371B0402, 0x371C0402, 0x371D0402, 0x371E0402, 0x371F0402, 0x37200402, 0x37210402,
+		0x37220402, 0x37230402, 0x37240402, 0x37250402, 0x37260402, 0x37270402, 0x37280402, 0x37290402, 0x372A0402, 0x372A0404, 0x372A0404, 0x372B0402, 0x372C0402, 0x372D0402, 0x372E0402, 0x372F0402,
+		0x37300402, 0x37310402, 0x37320402, 0x37330402, 0x37340402, 0x37350402, 0x37360402, 0x37370402, 0x37380402, 0x37390402, 0x373A0402, 0x373B0402, 0x373C0402, 0x373D0402, 0x373E0402, 0x373F0402,
+		0x373F0404, 0x373F0404, 0x37400402, 0x37410402, 0x37420402, 0x37430402, 0x37440402, 0x37450402, 0x37460402, 0x37470402, 0x37480402, 0x37490402, 0x374A0402, 0x374A0404, 0x37700404, 0x374A0404,
+		0x374B0402, 0x374C0402, 0x374D0402, 0x374E0402, 0x374F0402, 0x37500402, 0x37510402, 0x37520402, 0x37530402, 0x37540402, 0x37550402, 0x37560402, 0x37570402, 0x37580402, 0x37590402, 0x375A0402,
+		0x375B0402, 0x375C0402, 0x375D0402, 0x375E0402, 0x375F0402, 0x37600402, 0x37610402, 0x37620402, 0x37620404, 0x37630402, 0x37640402, 0x37650402, 0x37660402, 0x37670402, 0x37680402, 0x37690402,
+		0x376A0402, 0x376B0402, 0x376C0402, 0x376D0402, 0x376E0402, 0x376F0402, 0x37700402, 0x37710402, 0x37720402, 0x37730402, 0x37740402, 0x37750402, 0x37760402, 0x37770402, 0x37780402, 0x37790402,
+		0x377A0402, 0x377B0402, 0x377C0402, 0x377D0402, 0x377E0402, 0x377F0402, 0x37800402, 0x37810402, 0x37820402, 0x37830402, 0x37840402, 0x37850402, 0x37860402, 0x37870402, 0x37880402, 0x37890402,
+		0x378A0402, 0x378B0402, 0x378C0402, 0x378D0402, 0x378E0402, 0x378F0402, 0x37900402, 0x37910402, 0x37920402, 0x37930402, 0x37940402, 0x37950402, 0x37960402, 0x37970402, 0x37980402, 0x37990402,
+		0x379A0402, 0x379B0402, 0x379C0402, 0x379D0402, 0x379E0402, 0x379F0402, 0x37A00402, 0x37A10402, 0x37A20402, 0x37A30402, 0x37A40402, 0x37A50402, 0x37A60402, 0x37A70402, 0x37A80402, 0x37A90402,
+		0x37AA0402, 0x37AB0402, 0x37AC0402, 0x37AD0402, 0x37AE0402, 0x37AF0402, 0x37B00402, 0x37B10402, 0x37B20402, 0x37B30402, 0x37B40402, 0x37B50402, 0x37B60402, 0x37B70402, 0x37B80402, 0x37B90402,
+		0x37BA0402, 0x37BB0402, 0x37BC0402, 0x37BD0402, 0x37BE0402, 0x37BF0402, 0x37C00402, 0x37C10402, 0x37C20402, 0x37C30402, 0x37C40402, 0x37C50402, 0x37C60402, 0x37C70402, 0x37C80402, 0x37C90402,
+		0x37CA0402, 0x37CB0402, 0x37CC0402, 0x37CD0402, 0x37CE0402, 0x37CF0402, 0x37D00402, 0x37D10402, 0x37D20402, 0x37D30402, 0x37D40402, 0x37D50402, 0x37D60402, 0x37D70402, 0x37D80402, 0x37D90402,
+		0x37DA0402, 0x37DB0402, 0x37DC0402, 0x37DD0402, 0x37DE0402, 0x37DF0402, 0x37E00402, 0x37E10402, 0x37E20402, 0x37E30402, 0x37E40402, 0x37E50402, 0x37E60402, 0x37E70402, 0x37E80402, 0x37E90402,
+		0x37EA0402, 0x37EB0402, 0x37EC0402, 0x37ED0402, 0x37EE0402, 0x37EF0402, 0x37F00402, 0x37F10402, 0x37F20402, 0x37F30402, 0x37F40402, 0x37F50402, 0x37F60402, 0x37F70402, 0x37F80402, 0x37F90402,
+		0x37FA0402, 0x37FB0402, 0x37FC0402, 0x37FD0402, 0x37FE0402, 0x37FF0402, 0x38000402, 0x38010402, 0x38020402, 0x38030402, 0x38040402, 0x38050402, 0x38060402, 0x38070402, 0x38080402, 0x38090402,
+		0x380A0402, 0x380B0402, 0x380C0402, 0x380D0402, 0x380E0402, 0x380F0402, 0x38100402, 0x38110402, 0x38120402, 0x38130402, 0x38140402, 0x38150402, 0x38160402, 0x38170402, 0x38180402, 0x38190402,
+		0x381A0402, 0x381B0402, 0x381C0402, 0x381D0402, 0x381E0402, 0x381F0402, 0x38200402, 0x38210402, 0x38220402, 0x38230402, 0x38240402, 0x38240404, 0x38250402, 0x38260402, 0x38270402, 0x38280402,
+		0x38290402, 0x382A0402, 0x382B0402, 0x382C0402, 0x382C0404, 0x382D0402, 0x382E0402, 0x382F0402, 0x38300402, 0x38310402, 0x38320402, 0x38330402, 0x38340402, 0x38350402, 0x38360402, 0x38370402,
+		0x38380402, 0x38390402, 0x383A0402, 0x383B0402, 0x383C0402, 0x383D0402, 0x383E0402, 0x383F0402, 0x38400402, 0x38410402, 0x38420402, 0x38430402, 0x38440402, 0x38450402, 0x38460402, 0x38470402,
+		0x38480402, 0x38490402, 0x384A0402, 0x384B0402, 0x384C0402, 0x384D0402, 0x384E0402, 0x384F0402, 0x38500402, 0x38510402, 0x38520402, 0x38530402, 0x38540402, 0x38550402, 0x38560402, 0x38570402,
+		0x38580402, 0x38590402, 0x385A0402, 0x385B0402, 0x385C0402, 0x385D0402, 0x385E0402, 0x385F0402, 0x38600402, 0x38610402, 0x38620402, 0x38630402, 0x38640402, 0x38650402, 0x38660402, 0x38670402,
+		0x38680402, 0x38690402, 0x386A0402, 0x386B0402, 0x386C0402, 0x386D0402, 0x386E0402, 0x386F0402, 0x38700402, 0x38710402, 0x38720402, 0x38730402, 0x38740402, 0x38750402, 0x38760402, 0x38770402,
+		0x38780402, 0x38790402, 0x387B0402, 0x387C0402, 0x387D0402, 0x387E0402, 0x387F0402, 0x38800402, 0x38810402, 0x38820402, 0x38830402, 0x38840402, 0x38850402, 0x38860402, 0x38870402, 0x38880402,
+		0x38890402, 0x388A0402, 0x388B0402, 0x388C0402, 0x388D0402, 0x388E0402, 0x388F0402, 0x38900402, 0x38910402, 0x38920402, 0x38930402, 0x38940402, 0x38950402, 0x38960402, 0x38970402, 0x38980402,
+		0x38990402, 0x389A0402, 0x389B0402, 0x389C0402, 0x389D0402, 0x389E0402, 0x389F0402, 0x38A00402, 0x38A10402, 0x38A20402, 0x38A30402, 0x38A40402, 0x38A50402, 0x38A60402, 0x38A70402, 0x38A80402,
+		0x38A90402, 0x38AA0402, 0x38AB0402, 0x38AC0402, 0x38AD0402, 0x38AE0402, 0x38AF0402, 0x38B00402, 0x38B10402, 0x38B20402, 0x38B30402, 0x38B40402, 0x38B50402, 0x38B60402, 0x38B70402, 0x38B80402,
+		0x38B90402, 0x38BA0402, 0x38BB0402, 0x38BC0402, 0x38BD0402, 0x38BE0402, 0x38BF0402, 0x38C00402, 0x38C10402, 0x38C20402, 0x38C30402, 0x38C40402, 0x38C50402, 0x38C60402, 0x38C70402, 0x38C80402,
+		0x38C90402, 0x38CA0402, 0x38CB0402, 0x38CC0402, 0x38CD0402, 0x38CE0402, 0x38CF0402, 0x38D00402, 0x38D10402, 0x38D20402, 0x38D30402, 0x38D40402, 0x38D50402, 0x38D60402, 0x38D70402, 0x38D80402,
+		0x38D90402, 0x38DA0402, 0x38DB0402, 0x38DC0402, 0x38DD0402, 0x38DE0402, 0x38DE0408, 0x38DF0402, 0x38DF0408, 0x38E00402, 0x38E00408, 0x38E10402, 0x38E10408, 0x38E20402, 0x38E20408, 0x38E30402,
+		0x38E30408, 0x38E40402, 0x38E40408, 0x38E50402, 0x38E50408, 0x38E60402, 0x38E60408, 0x38E70402, 0x38E70408, 0x38E80402, 0x38E80408, 0x38E90402, 0x38E90408, 0x38EA0402, 0x38EA0408, 0x38EB0402,
+		0x38EB0408, 0x38EC0402, 0x38EC0408, 0x38ED0402, 0x38ED0408, 0x38EE0402, 0x38EE0408, 0x38EF0402, 0x38EF0408, 0x38F00402, 0x38F00408, 0x38F10402, 0x38F10408, 0x38F20402, 0x38F20408, 0x38F30402,
+		0x38F30408, 0x38F40402, 0x38F40408, 0x38F50402, 0x38F50408, 0x38F60402, 0x38F60408, 0x38F70402, 0x38F70408, 0x38F80402, 0x38F80408, 0x38F90402, 0x38F90408, 0x38FA0402, 0x38FA0408, 0x38FB0402,
+		0x38FB0408, 0x38FC0402, 0x38FC0408, 0x38FD0402, 0x38FD0408, 0x38FE0402, 0x38FE0408, 0x38FF0402, 0x38FF0408, 0x39000402, 0x39000408, 0x39010402, 0x39010408, 0x39020402, 0x39020408, 0x39030402,
+		0x39030408, 0x39040402, 0x39040408, 0x39050402, 0x39050408, 0x39060402, 0x39060408, 0x39070402, 0x39070408, 0x39080402, 0x39080408, 0x39090402, 0x39090408, 0x390A0402, 0x390A0408, 0x390B0402,
+		0x390B0408, 0x390C0402, 0x390C0408, 0x390D0402, 0x390D0408, 0x390E0402, 0x390E0408, 0x390F0402, 0x390F0408, 0x39100402, 0x39100408, 0x39110402, 0x39110408, 0x39120402, 0x39120408, 0x39130402,
+		0x39130408, 0x39140402, 0x39140408, 0x39150402, 0x39150408, 0x39160402, 0x39160408, 0x39170402, 0x39170408, 0x39180402, 0x39180408, 0x39190402, 0x39190408, 0x391A0402, 0x391A0408, 0x391B0402,
+		0x391B0408, 0x391C0402, 0x391C0408, 0x391D0402, 0x391D0408, 0x391E0402, 0x391E0408, 0x391F0402, 0x391F0408, 0x39200402, 0x39200408, 0x39210402, 0x39210408, 0x39220402, 0x39220408, 0x39230402,
+		0x39230408, 0x39240402, 0x39240408, 0x39250402, 0x39250408, 0x39260402, 0x39260408, 0x39270402, 0x39270408, 0x39280402, 0x39280408, 0x39290402, 0x39290408, 0x392A0402, 0x392A0408, 0x392B0402,
+		0x392B0408, 0x392C0402, 0x392C0408, 0x392D0402, 0x392D0408, 0x392E0402, 0x392E0408, 0x392F0402, 0x392F0408, 0x39300402, 0x39300408, 0x39310402, 0x39310408, 0x39320402, 0x39320408, 0x39330402,
+		0x39330408, 0x39340402, 0x39340408, 0x39350402, 0x39350408, 0x39360402, 0x39360408, 0x39370402, 0x39370408, 0x39380402, 0x39380408, 0x39390402, 0x39390408, 0x393A0402, 0x393A0408, 0x393B0402,
+		0x393B0408, 0x393C0402, 0x393C0408, 0x393D0402, 0x393D0408, 0x393E0402, 0x393E0408, 0x393F0402, 0x393F0408, 0x39400402, 0x39400408, 0x39410402, 0x39410408, 0x39420402, 0x39420408, 0x39430402,
+		0x39430408, 0x39440402, 0x39440408, 0x39450402, 0x39450408, 0x39460402, 0x39460408, 0x39470402, 0x39470408, 0x39480402, 0x39480408, 0x39490402, 0x39490408, 0x394A0402, 0x394A0408, 0x394B0402,
+		0x394B0408, 0x394C0402, 0x394C0408, 0x394D0402, 0x394D0408, 0x394E0402, 0x394E0408, 0x394F0402, 0x394F0408, 0x39500402, 0x39500408, 0x39510402, 0x39510408, 0x39520402, 0x39520408, 0x39530402,
+		0x39530408, 0x39540402, 0x39540408, 0x39550402, 0x39550408, 0x39560402, 0x39560408, 0x39570402, 0x39570408, 0x39580402, 0x39590402, 0x395A0402, 0x395B0402, 0x395C0402, 0x395D0402, 0x395E0402,
+		0x395F0402, 0x39600402, 0x39610402, 0x39620402, 0x39630402, 0x39640402, 0x39650402, 0x39660402, 0x39670402, 0x39680402, 0x39690402, 0x396A0402, 0x396B0402, 0x396C0402, 0x396D0402, 0x396E0402,
+		0x396F0402, 0x39700402, 0x39710402, 0x39720402, 0x39730402, 0x39740402, 0x39750402, 0x39760402, 0x39770402, 0x39780402, 0x39790402, 0x397A0402, 0x397B0402, 0x397C0402, 0x397D0402, 0x397E0402,
+		0x397F0402, 0x39800402, 0x39810402, 0x39820402, 0x39830402, 0x39840402, 0x39850402, 0x39860402, 0x39870402, 0x39880402, 0x39890402, 0x398A0402, 0x398B0402, 0x398C0402, 0x398D0402, 0x398E0402,
+		0x398F0402, 0x39900402, 0x39910402, 0x39920402, 0x39930402, 0x39940402, 0x39950402, 0x39960402, 0x39970402, 0x39980402, 0x39990402, 0x399A0402, 0x399B0402, 0x399C0402, 0x399D0402, 0x399E0402,
+		0x399F0402, 0x39A00402, 0x39A10402, 0x39A20402, 0x39A30402, 0x39A40402, 0x39A50402, 0x39A60402, 0x39A70402, 0x39A80402, 0x39A90402, 0x39AA0402, 0x39AB0402, 0x39AC0402, 0x39AD0402, 0x39AE0402,
+		0x39AF0402, 0x39B00402, 0x39B10402, 0x39B20402, 0x39B30402, 0x39B40402, 0x39B50402, 0x39B60402, 0x39B70402, 0x39B80402, 0x39B90402, 0x39BA0402, 0x39BB0402, 0x39BC0402, 0x39BD0402, 0x39BE0402,
+		0x39BF0402, 0x39C00402, 0x39C10402, 0x39C20402, 0x39C30402, 0x39C40402, 0x39C50402, 0x39C60402, 0x39C70402, 0x39C80402, 0x39C90402, 0x39CA0402, 0x39CB0402, 0x39CC0402, 0x39CD0402, 0x39CE0402,
+		0x39CF0402, 0x39D00402, 0x39D10402, 0x39D20402, 0x39D30402, 0x39D40402, 0x39D50402, 0x39D60402, 0x39D70402, 0x39D80402, 0x39D90402, 0x39DA0402, 0x39DB0402, 0x39DC0402, 0x39DD0402, 0x39DE0402,
+		0x39DF0402, 0x39E00402, 0x39E10402, 0x39E20402, 0x39E30402, 0x39E40402, 0x39E50402, 0x39E60402, 0x39E70402, 0x39E80402, 0x39E90402, 0x39EA0402, 0x39EB0402, 0x39EC0402, 0x39ED0402, 0x39EE0402,
+		0x39EF0402, 0x39F00402, 0x39F10402, 0x39F20402, 0x39F30402, 0x39F40402, 0x39F50402, 0x39F60402, 0x39F70402, 0x39F80402, 0x39F90402, 0x39FA0402, 0x39FB0402, 0x39FC0402, 0x39FD0402, 0x39FE0402,
+		0x39FF0402, 0x3A000402, 0x3A010402, 0x3A020402, 0x3A030402, 0x3A040402, 0x3A050402, 0x3A060402, 0x3A070402, 0x3A080402, 0x3A090402, 0x3A0A0402, 0x3A0B0402, 0x3A0C0402, 0x3A0D0402, 0x3A0E0402,
+		0x3A0F0402, 0x3A100402, 0x3A110402, 0x3A120402, 0x3A130402, 0x3A140402, 0x3A150402, 0x3A160402, 0x3A170402, 0x3A180402, 0x3A190402, 0x3A1A0402, 0x3A1B0402, 0x3A1C0402, 0x3A1D0402, 0x3A1E0402,
+		0x3A1F0402, 0x3A200402, 0x3A210402, 0x3A220402, 0x3A230402, 0x3A240402, 0x3A250402, 0x3A260402, 0x3A270402, 0x3A280402, 0x3A290402, 0x3A2A0402, 0x3A2B0402, 0x3A2C0402, 0x3A2D0402, 0x3A2E0402,
+		0x3A2F0402, 0x3A300402, 0x3A310402, 0x3A320402, 0x3A330402, 0x3A340402, 0x3A350402, 0x3A360402, 0x3A370402, 0x3A380402, 0x3A390402, 0x3A3A0402, 0x3A3B0402, 0x3A3C0402, 0x3A3D0402, 0x3A3E0402,
+		0x3A3F0402, 0x3A400402, 0x3A410402, 0x3A420402, 0x3A430402, 0x3A440402, 0x3A450402, 0x3A460402, 0x3A470402, 0x3A480402, 0x3A490402, 0x3A4A0402, 0x3A4B0402, 0x3A4C0402, 0x3A4D0402, 0x3A4E0402,
+		0x3A4F0402, 0x3A500402, 0x3A510402, 0x3A520402, 0x3A530402, 0x3A540402, 0x3A550402, 0x3A560402, 0x3A570402, 0x3A580402, 0x3A590402, 0x3A5A0402, 0x3A5B0402, 0x3A5C0402, 0x3A5D0402, 0x3A5E0402,
+		0x3A5F0402, 0x3A600402, 0x3A610402, 0x3A620402, 0x3A630402, 0x3A640402, 0x3A650402, 0x3A660402, 0x3A670402, 0x3A680402, 0x3A690402, 0x3A6A0402, 0x3A6B0402, 0x3A6C0402, 0x3A6D0402, 0x3A6E0402,
+		0x3A6F0402, 0x3A700402, 0x3A710402, 0x3A720402, 0x3A730402, 0x3A740402, 0x3A750402, 0x3A760402, 0x3A770402, 0x3A780402, 0x3A790402, 0x3A7A0402, 0x3A7B0402, 0x3A7C0402, 0x3A7D0402, 0x3A7E0402,
+		0x3A7F0402, 0x3A800402, 0x3A810402, 0x3A820402, 0x3A830402, 0x3A840402, 0x3A850402, 0x3A860402, 0x3A870402, 0x3A880402, 0x3A890402, 0x3A8A0402, 0x3A8B0402, 0x3A8C0402, 0x3A8D0402, 0x3A8E0402,
+		0x3A8F0402, 0x3A900402, 0x3A910402, 0x3A920402, 0x3A930402, 0x3A940402, 0x3A950402, 0x3A960402, 0x3A970402, 0x3A980402, 0x3A990402, 0x3A9A0402, 0x3A9B0402, 0x3A9C0402, 0x3A9D0402, 0x3A9E0402,
+		0x3A9F0402, 0x3AA00402, 0x3AA10402, 0x3AA20402, 0x3AA30402, 0x3AA40402, 0x3AA50402, 0x3AA60402, 0x3AA70402, 0x3AA80402, 0x3AA90402, 0x3AAA0402, 0x3AAB0402, 0x3AAC0402, 0x3AAD0402, 0x3AAE0402,
+		0x3AAF0402, 0x3AB00402, 0x3AB10402, 0x3AB20402, 0x3AB30402, 0x3AB40402, 0x3AB50402, 0x3AB60402, 0x3AB70402, 0x3AB80402, 0x3AB90402, 0x3ABA0402, 0x3ABB0402, 0x3ABC0402, 0x3ABD0402, 0x3ABE0402,
+		0x3ABF0402, 0x3AC00402, 0x3AC10402, 0x3AC20402, 0x3AC30402, 0x3AC40402, 0x3AC50402, 0x3AC60402, 0x3AC70402, 0x3AC80402, 0x3AC90402, 0x3ACA0402, 0x3ACB0402, 0x3ACC0402, 0x3ACD0402, 0x3ACE0402,
+		0x3ACF0402, 0x3AD00402, 0x3AD10402, 0x3AD20402, 0x3AD30402, 0x3AD40402, 0x3AD50402, 0x3AD60402, 0x3AD70402, 0x3AD80402, 0x3AD90402, 0x3ADA0402, 0x3ADB0402, 0x3ADC0402, 0x3ADD0402, 0x3ADE0402,
+		0x3ADF0402, 0x3AE00402, 0x3AE10402, 0x3AE20402, 0x3AE30402, 0x3AE40402, 0x3AE50402, 0x3AE60402, 0x3AE70402, 0x3AE80402, 0x3AE90402, 0x3AEA0402, 0x3AEB0402, 0x3AEC0402, 0x3AED0402, 0x3AEE0402,
+		0x3AEF0402, 0x3AF00402, 0x3AF10402, 0x3AF20402, 0x3AF30402, 0x3AF40402, 0x3AF50402, 0x3AF60402, 0x3AF70402, 0x3AF80402, 0x3AF90402, 0x3AFA0402, 0x3AFB0402, 0x3AFC0402, 0x3AFD0402, 0x3AFE0402,
+		0x3AFF0402, 0x3B000402, 0x3B010402, 0x3B020402, 0x3B030402, 0x3B040402, 0x3B050402, 0x3B060402, 0x3B070402, 0x3B080402, 0x3B090402, 0x3B0A0402, 0x3B0B0402, 0x3B0C0402, 0x3B0D0402, 0x3B0E0402,
+		0x3B0F0402, 0x3B100402, 0x3B110402, 0x3B120402, 0x3B130402, 0x3B140402, 0x3B150402, 0x3B160402, 0x3B170402, 0x3B180402, 0x3B190402, 0x3B1A0402, 0x3B1B0402, 0x3B1C0402, 0x3B1D0402, 0x3B1E0402,
+		0x3B1F0402, 0x3B200402, 0x3B210402, 0x3B220402, 0x3B230402, 0x3B240402, 0x3B250402, 0x3B260402, 0x3B270402, 0x3B280402, 0x3B290402, 0x3B2A0402, 0x3B2B0402, 0x3B2C0402, 0x3B2D0402, 0x3B2E0402,
+		0x3B2F0402, 0x3B300402, 0x3B310402, 0x3B320402, 0x3B330402, 0x3B340402, 0x3B350402, 0x3B360402, 0x3B370402, 0x3B380402, 0x3B390402, 0x3B3A0402, 0x3B3B0402, 0x3B3C0402, 0x3B3D0402, 0x3B3E0402,
+		0x3B3F0402, 0x3B400402, 0x3B410402, 0x3B420402, 0x3B430402, 0x3B440402, 0x3B450402, 0x3B460402, 0x3B470402, 0x3B480402, 0x3B490402, 0x3B4A0402, 0x3B4B0402, 0x3B4C0402, 0x3B4D0402, 0x3B4E0402,
+		0x3B4F0402, 0x3B500402, 0x3B510402, 0x3B520402, 0x3B530402, 0x3B540402, 0x3B550402, 0x3B560402, 0x3B570402, 0x3B580402, 0x3B590402, 0x3B5A0402, 0x3B5B0402, 0x3B5C0402, 0x3B5D0402, 0x3B5E0402,
+		0x3B5F0402, 0x3B600402, 0x3B610402, 0x3B620402, 0x3B630402, 0x3B640402, 0x3B650402, 0x3B660402, 0x3B670402, 0x3B680402, 0x3B690402, 0x3B6A0402, 0x3B6B0402, 0x3B6C0402, 0x3B6D0402, 0x3B6E0402,
+		0x3B6F0402, 0x3B700402, 0x3B710402, 0x3B720402, 0x3B730402, 0x3B740402, 0x3B750402, 0x3B760402, 0x3B770402, 0x3B780402, 0x3B790402, 0x3B7A0402, 0x3B7B0402, 0x3B7C0402, 0x3B7D0402, 0x3B7E0402,
+		0x3B7F0402, 0x3B800402, 0x3B810402, 0x3B820402, 0x3B830402, 0x3B840402, 0x3B850402, 0x3B860402, 0x3B870402, 0x3B880402, 0x3B890402, 0x3B8A0402, 0x3B8B0402, 0x3B8C0402, 0x3B8D0402, 0x3B8E0402,
+		0x3B8F0402, 0x3B900402, 0x3B910402, 0x3B920402, 0x3B930402, 0x3B940402, 0x3B950402, 0x3B960402, 0x3B970402, 0x3B980402, 0x3B990402, 0x3B9A0402, 0x3B9B0402, 0x3B9C0402, 0x3B9D0402, 0x3B9E0402,
+		0x3B9F0402, 0x3BA00402, 0x3BA10402, 0x3BA20402, 0x3BA30402, 0x3BA40402, 0x3BA50402, 0x3BA60402, 0x3BA70402, 0x3BA80402, 0x3BA90402, 0x3BAA0402, 0x3BAB0402, 0x3BAC0402, 0x3BAD0402, 0x3BAE0402,
+		0x3BAF0402, 0x3BB00402, 0x3BB10402, 0x3BB20402, 0x3BB30402, 0x3BB40402, 0x3BB50402, 0x3BB60402, 0x3BB70402, 0x3BB80402, 0x3BB90402, 0x3BBA0402, 0x3BBB0402, 0x3BBC0402, 0x3BBD0402, 0x3BBE0402,
+		0x3BBF0402, 0x3BC00402, 0x3BC10402, 0x3BC20402, 0x3BC30402, 0x3BC40402, 0x3BC50402, 0x3BC60402, 0x3BC70402, 0x3BC80402, 0x3BC90402, 0x3BCA0402, 0x3BCB0402, 0x3BCC0402, 0x3BCD0402, 0x3BCE0402,
+		0x3BCF0402, 0x3BD00402, 0x3BD10402, 0x3BD20402, 0x3BD30402, 0x3BD40402, 0x3BD50402, 0x3BD60402, 0x3BD70402, 0x3BD80402, 0x3BD90402, 0x3BDA0402, 0x3BDB0402, 0x3BDC0402, 0x3BDD0402, 0x3BDE0402,
+		0x3BDF0402, 0x3BE00402, 0x3BE10402, 0x3BE20402, 0x3BE30402, 0x3BE40402, 0x3BE50402, 0x3BE60402, 0x3BE70402, 0x3BE80402, 0x3BE90402, 0x3BEA0402, 0x3BEB0402, 0x3BEC0402, 0x3BED0402, 0x3BEE0402,
+		0x3BEF0402, 0x3BF00402, 0x3BF10402, 0x3BF20402, 0x3BF30402, 0x3BF40402, 0x3BF50402, 0x3BF60402, 0x3BF70402, 0x3BF80402, 0x3BF90402, 0x3BFA0402, 0x3BFB0402, 0x3BFC0402, 0x3BFD0402, 0x3BFE0402,
+		0x3BFF0402, 0x3C000402, 0x3C010402, 0x3C020402, 0x3C030402, 0x3C040402, 0x3C050402, 0x3C060402, 0x3C070402, 0x3C080402, 0x3C090402, 0x3C0A0402, 0x3C0B0402, 0x3C0C0402, 0x3C0D0402, 0x3C0E0402,
+		0x3C0F0402, 0x3C100402, 0x3C110402, 0x3C120402, 0x3C130402, 0x3C140402, 0x3C150402, 0x3C160402, 0x3C170402, 0x3C180402, 0x3C190402, 0x3C1A0402, 0x3C1B0402, 0x3C1C0402, 0x3C1D0402, 0x3C1E0402,
+		0x3C1F0402, 0x3C200402, 0x3C210402, 0x3C220402, 0x3C230402, 0x3C240402, 0x3C250402, 0x3C260402, 0x3C270402, 0x3C280402, 0x3C290402, 0x3C2A0402, 0x3C2B0402, 0x3C2C0402, 0x3C2D0402, 0x3C2E0402,
+		0x3C2F0402, 0x3C300402, 0x3C310402, 0x3C320402, 0x3C330402, 0x3C340402, 0x3C350402, 0x3C350404, 0x2304, 0x3C360402, 0x3C360404, 0x2304, 0x3C360404, 0x2324, 0x3C370402, 0x3C370404,
+		0x2304, 0x3C370404, 0x3C370404, 0x3C380402, 0x3C380404, 0x2304, 0x3C380404, 0x2324, 0x3C380404, 0x2344, 0x3C380404, 0x2364, 0x3C390402, 0x3C3A0402, 0x3C3B0402, 0x3C3C0402,
+		0x3C3D0402, 0x3C3D0404, 0x2304, 0x3C3D0404, 0x2324, 0x3C3D0404, 0x2344, 0x3C3D0404, 0x2364, 0x3C3E0402, 0x3C3F0402, 0x3C400402, 0x3C400404, 0x2304, 0x3C410402, 0x3C410404,
+		0x2304, 0x3C410404, 0x2324, 0x3C410404, 0x2344, 0x3C420402, 0x3C420404, 0x2304, 0x3C420404, 0x2324, 0x3C430402, 0x3C430404, 0x2304, 0x3C440402, 0x3C450402, 0x3C450404,
+		0x2304, 0x3C460402, 0x3C460404, 0x2304, 0x3C460404, 0x3C510404, 0x3C470402, 0x3C480402, 0x3C480404, 0x2304, 0x3C490402, 0x3C4A0402, 0x3C4A0404, 0x2304, 0x3C4A0404, 0x2324,
+		0x3C4A0404, 0x2344, 0x3C4A0404, 0x2364, 0x3C4A0404, 0x2384, 0x3C4B0402, 0x3C4C0402, 0x3C4C0404, 0x2304, 0x3C4C0404, 0x2324, 0x3C4D0402, 0x3C4D0404, 0x2304, 0x3C4D0404,
+		0x2324, 0x3C4E0402, 0x3C4F0402, 0x3C500402, 0x3C500404, 0x2304, 0x3C500404, 0x2324, 0x3C500404, 0x2304, 0x3C500404, 0x2304, 0x3C510402, 0x3C510404, 0x2304, 0x3C520402,
+		0x3C520404, 0x2304, 0x3C530402, 0x3C540402, 0x3C550402, 0x3C560402, 0x3C570402, 0x3C580402, 0x3C590402, 0x3C5A0402, 0x3C5B0402, 0x3C5C0402, 0x3C5D0402, 0x3C5E0402, 0x3C5F0402, 0x3C600402,
+		0x3C610402, 0x3C620402, 0x3C620404, 0x2304, 0x3C620404, 0x2324, 0x3C630402, 0x3C630408, 0x3C630404, 0x2304, 0x3C63040A, 0x2304, 0x3C640402, 0x3C640408, 0x3C650402, 0x3C650408,
+		0x3C660402, 0x3C660408, 0x3C670402, 0x3C670408, 0x3C680402, 0x3C680408, 0x3C690402, 0x3C690408, 0x3C6A0402, 0x3C6A0408, 0x3C6B0402, 0x3C6B0408, 0x3C6B0404, 0x2304, 0x3C6B040A, 0x2304,
+		0x3C6B0404, 0x2324, 0x3C6B040A, 0x2324, 0x3C6C0402, 0x3C6C0408, 0x3C6D0402, 0x3C6D0408, 0x3C6E0402, 0x3C6E0408, 0x3C6F0402, 0x3C6F0408, 0x3C700402, 0x3C700408, 0x3C700404, 0x2304,
+		0x3C70040A, 0x2304, 0x3C710402, 0x3C710408, 0x3C720402, 0x3C720408, 0x3C730402, 0x3C730408, 0x3C740402, 0x3C740408, 0x3C750402, 0x3C750408, 0x3C760402, 0x3C760408, 0x3C770402, 0x3C770408,
+		0x3C780402, 0x3C780408, 0x3C790402, 0x3C790408, 0x3C7A0402, 0x3C7A0408, 0x3C7A0404, 0x2304, 0x3C7A040A, 0x2304, 0x3C7B0402, 0x3C7B0408, 0x3C7B0404, 0x2304, 0x3C7B040A, 0x2304,
+		0x3C7B0404, 0x2324, 0x3C7B040A, 0x2324, 0x3C7C0402, 0x3C7C0408, 0x3C7D0402, 0x3C7D0408, 0x3C7E0402, 0x3C7E0408, 0x3C7E0404, 0x2304, 0x3C7E040A, 0x2304, 0x3C7F0402, 0x3C7F0408,
+		0x3C800402, 0x3C800408, 0x3C810402, 0x3C810408, 0x3C820402, 0x3C820408, 0x3C830402, 0x3C830408, 0x3C840402, 0x3C840408, 0x3C850402, 0x3C850408, 0x3C850404, 0x2304, 0x3C85040A, 0x2304,
+		0x3C860402, 0x3C860408, 0x3C860404, 0x2304, 0x3C86040A, 0x2304, 0x3C870402, 0x3C870408, 0x3C880402, 0x3C880408, 0x3C890402, 0x3C890408, 0x3C8A0402, 0x3C8A0408, 0x3C8B0402, 0x3C8B0408,
+		0x3C8C0402, 0x3C8C0404, 0x2304, 0x3C8D0402, 0x3C8E0402, 0x3C8E0404, 0x2304, 0x3C8F0402, 0x3C900402, 0x3C910402, 0x3C910404, 0x2304, 0x3C920402, 0x3C920404, 0x2304, 0x3C930402,
+		0x3C930404, 0x2304, 0x3C940402, 0x3C940404, 0x2304, 0x3C950402, 0x3C950404, 0x2304, 0x3C960402, 0x3C960404, 0x2304, 0x3C970402, 0x3C980402, 0x3C980404, 0x2304, 0x3C990402,
+		0x3C990404, 0x2304, 0x3C9A0402, 0x3C9A0404, 0x2304, 0x3C9B0402, 0x3C9B0404, 0x2304, 0x3C9C0402, 0x3C9C0404, 0x2304, 0x3C9D0402, 0x3C9D0404, 0x2304, 0x3C9E0402, 0x3C9F0402,
+		0x3CA00402, 0x3CA10402, 0x3CA20402, 0x3CA20404, 0x2304, 0x3CA30402, 0x3CA30404, 0x2304, 0x3CA40402, 0x3CA40404, 0x2304, 0x3CA50402, 0x3CA50404, 0x2304, 0x3CA60402, 0x3CA70402,
+		0x3CA70404, 0x2304, 0x3CA80402, 0x3CA90402, 0x3CAA0402, 0x3CAB0402, 0x3CAB0404, 0x2304, 0x3CAC0402, 0x3CAC0404, 0x2304, 0x3CAD0402, 0x3CAD0404, 0x2304, 0x3CAE0402, 0x3CAE0404,
+		0x2304, 0x3CAF0402, 0x3CAF0404, 0x2304, 0x3CB00402, 0x3CB10402, 0x3CB20402, 0x3CB30402, 0x3CB30404, 0x2304, 0x3CB40402, 0x3CB40404, 0x2304, 0x3CB50402, 0x3CB50404, 0x2304,
+		0x3CB60402, 0x3CB60404, 0x2304, 0x3CB70402, 0x3CB80402, 0x3CB90402, 0x3CBA0402, 0x3CBB0402, 0x3CBC0402, 0x3CBD0402, 0x3CBE0402, 0x3CBF0402, 0x3CC00402, 0x3CC10402, 0x3CC20402, 0x3CC30402,
+		0x3CC40402, 0x3CC50402, 0x3CC50404, 0x3DC40404, 0x3CC60402, 0x3CC70402, 0x3CC80402, 0x3CC90402, 0x3CCA0402, 0x3CCB0402, 0x3CCC0402, 0x3CCD0402, 0x3CCE0402, 0x3CCF0402, 0x3CD00402, 0x3CD10402,
+		0x3CD20402, 0x3CD30402, 0x3CD40402, 0x3CD50402, 0x3CD60402, 0x3CD70402, 0x3CD70404, 0x3DC40404, 0x3CD80402, 0x3CD90402, 0x3CDA0402, 0x3CDB0402, 0x3CDC0402, 0x3CDD0402, 0x3CDE0402, 0x3CDF0402,
+		0x3CE00402, 0x3CE10402, 0x3CE20402, 0x3CE30402, 0x3CE40402, 0x3CE50402, 0x3CE60402, 0x3CE70402, 0x3CE80402, 0x3CE90402, 0x3CEA0402, 0x3CEB0402, 0x3CEC0402, 0x3CEC0404, 0x3DC40404, 0x3CED0402,
+		0x3CEE0402, 0x3CEF0402, 0x3CF00402, 0x3CF10402, 0x3CF20402, 0x3CF30402, 0x3CF40402, 0x3CF50402, 0x3CF60402, 0x3CF70402, 0x3CF80402, 0x3CF90402, 0x3CFA0402, 0x3CFB0402, 0x3CFC0402, 0x3CFD0402,
+		0x3CFE0402, 0x3CFF0402, 0x3D000402, 0x3D000404, 0x3DC50404, 0x3D010402, 0x3D020402, 0x3D030402, 0x3D040402, 0x3D050402, 0x3D060402, 0x3D070402, 0x3D080402, 0x3D090402, 0x3D0A0402, 0x3D0B0402,
+		0x3D0B0404, 0x3DC40404, 0x3D0C0402, 0x3D0D0402, 0x3D0E0402, 0x3D0F0402, 0x3D100402, 0x3D110402, 0x3D110404, 0x3D110404, 0x3DC50404, 0x3D120402, 0x3D130402, 0x3D130404, 0x3DC50404, 0x3D140402,
+		0x3D150402, 0x3D160402, 0x3D170402, 0x3D180402, 0x3D190402, 0x3D190404, 0x3DC40404, 0x3D1A0402, 0x3D1B0402, 0x3D1C0402, 0x3D1D0402, 0x3D1E0402, 0x3D1F0402, 0x3D200402, 0x3D210402, 0x3D220402,
+		0x3D230402, 0x3D230404, 0x3D240402, 0x3D250402, 0x3D260402, 0x3D270402, 0x3D270404, 0x3D280402, 0x3D290402, 0x3D2A0402, 0x3D2B0402, 0x3D2C0402, 0x3D2D0402, 0x3D2E0402, 0x3D2F0402, 0x3D300402,
+		0x3D310402, 0x3D320402, 0x3D330402, 0x3D340402, 0x3D350402, 0x3D360402, 0x3D370402, 0x3D380402, 0x3D390402, 0x3D3A0402, 0x3D3B0402, 0x3D3C0402, 0x3D3D0402, 0x3D3E0402, 0x3D3E0404, 0x3DC40404,
+		0x3D3F0402, 0x3D400402, 0x3D400404, 0x3D410402, 0x3D420402, 0x3D430402, 0x3D440402, 0x3D450402, 0x3D460402, 0x3D470402, 0x3D480402, 0x3D490402, 0x3D4A0402, 0x3D4B0402, 0x3D4C0402, 0x3D4D0402,
+		0x3D4E0402, 0x3D4F0402, 0x3D500402, 0x3D510402, 0x3D520402, 0x3D530402, 0x3D540402, 0x3D550402, 0x3D560402, 0x3D570402, 0x3D580402, 0x3D590402, 0x3D5A0402, 0x3D5B0402, 0x3D5C0402, 0x3D5D0402,
+		0x3D5E0402, 0x3D5F0402, 0x3D600402, 0x3D610402, 0x3D620402, 0x3D630402, 0x3D640402, 0x3D650402, 0x3D660402, 0x3D670402, 0x3D680402, 0x3D690402, 0x3D6A0402, 0x3D6B0402, 0x3D6C0402, 0x3D6D0402,
+		0x3D6D0404, 0x3DC40404, 0x3D6E0402, 0x3D6F0402, 0x3D700402, 0x3D710402, 0x3D720402, 0x3D730402, 0x3D740402, 0x3D750402, 0x3D760402, 0x3D770402, 0x3D780402, 0x3D790402, 0x3D7A0402, 0x3D7B0402,
+		0x3D7C0402, 0x3D7D0402, 0x3D7E0402, 0x3D7F0402, 0x3D800402, 0x3D810402, 0x3D820402, 0x3D830402, 0x3D840402, 0x3D840404, 0x3DC40404, 0x3D850402, 0x3D860402, 0x3D870402, 0x3D880402, 0x3D890402,
+		0x3D8A0402, 0x3D8A0404, 0x3D8A0404, 0x3DC50404, 0x3D8B0402, 0x3D8C0402, 0x3D8D0402, 0x3D8E0402, 0x3D8F0402, 0x3D900402, 0x3D910402, 0x3D910404, 0x3DC40404, 0x3D920402, 0x3D930402, 0x3D940402,
+		0x3D950402, 0x3D960402, 0x3D970402, 0x3D980402, 0x3D990402, 0x3D9A0402, 0x3D9B0402, 0x3D9C0402, 0x3D9D0402, 0x3D9E0402, 0x3D9F0402, 0x3DA00402, 0x3DA10402, 0x3DA20402, 0x3DA30402, 0x3DA40402,
+		0x3DA50402, 0x3DA60402, 0x3DA70402, 0x3DA80402, 0x3DA90402, 0x3DAA0402, 0x3DAB0402, 0x3DAC0402, 0x3DAD0402, 0x3DAE0402, 0x3DAF0402, 0x3DB00402, 0x3DB10402, 0x3DB20402, 0x3DB30402, 0x3DB40402,
+		0x3DB50402, 0x3DB60402, 0x3DB70402, 0x3DB80402, 0x3DB90402, 0x3DBA0402, 0x3DBB0402, 0x3DBC0402, 0x3DBD0402, 0x3DBE0402, 0x3DBF0402, 0x3DC00402, 0x3DC10402, 0x3DC20402, 0x3DC30402, 0x3DC40402,
+		0x3DC50402, 0x3DC60402, 0x3DC70402, 0x3DC80402, 0x3DC90402, 0x3DCA0402, 0x3DCB0402, 0x3DCC0402, 0x3DCD0402, 0x3DCE0402, 0x3DCF0402, 0x3DD00402, 0x3DD10402, 0x3DD20402, 0x3DD30402, 0x3DD40402,
+		0x3DD50402, 0x3DD60402, 0x3DD70402, 0x3DD80402, 0x3DD90402, 0x3DDA0402, 0x3DDB0402, 0x3DDC0402, 0x3DDD0402, 0x3DDE0402, 0x3DDF0402, 0x3DE00402, 0x3DE10402, 0x3DE20402, 0x3DE30402, 0x3DE40402,
+		0x3DE50402, 0x3DE60402, 0x3DE70402, 0x3DE80402, 0x3DE90402, 0x3DEA0402, 0x3DEB0402, 0x3DEC0402, 0x3DED0402, 0x3DEE0402, 0x3DEF0402, 0x3DF00402, 0x3DF10402, 0x3DF20402, 0x3DF30402, 0x3DF40402,
+		0x3DF50402, 0x3DF60402, 0x3DF70402, 0x3DF80402, 0x3DF90402, 0x3DFA0402, 0x3DFB0402, 0x3DFC0402, 0x3DFD0402, 0x3DFE0402, 0x3DFF0402, 0x3E000402, 0x3E010402, 0x3E020402, 0x3E030402, 0x3E040402,
+		0x3E050402, 0x3E060402, 0x3E070402, 0x3E080402, 0x3E090402, 0x3E0A0402, 0x3E0B0402, 0x3E0C0402, 0x3E0D0402, 0x3E0E0402, 0x3E0F0402, 0x3E100402, 0x3E110402, 0x3E120402, 0x3E130402, 0x3E140402,
+		0x3E150402, 0x3E160402, 0x3E170402, 0x3E180402, 0x3E190402, 0x3E1A0402, 0x3E1B0402, 0x3E1C0402, 0x3E1D0402, 0x3E1E0402, 0x3E1F0402, 0x3E200402, 0x3E210402, 0x3E220402, 0x3E230402, 0x3E240402,
+		0x3E250402, 0x3E260402, 0x3E270402, 0x3E280402, 0x3E290402, 0x3E2A0402, 0x3E2B0402, 0x3E2C0402, 0x3E2D0402, 0x3E2E0402, 0x3E2F0402, 0x3E300402, 0x3E310402, 0x3E320402, 0x3E330402, 0x3E340402,
+		0x3E350402, 0x3E360402, 0x3E370402, 0x3E380402, 0x3E390402, 0x3E3A0402, 0x3E3B0402, 0x3E3C0402, 0x3E3D0402, 0x3E3E0402, 0x3E3F0402, 0x3E400402, 0x3E410402, 0x3E420402, 0x3E430402, 0x3E440402,
+		0x3E450402, 0x3E460402, 0x3E470402, 0x3E480402, 0x3E490402, 0x3E4A0402, 0x3E4B0402, 0x3E4C0402, 0x3E4D0402, 0x3E4E0402, 0x3E4F0402, 0x3E500402, 0x3E510402, 0x3E520402, 0x3E530402, 0x3E540402,
+		0x3E550402, 0x3E560402, 0x3E570402, 0x3E580402, 0x3E590402, 0x3E5A0402, 0x3E5B0402, 0x3E5C0402, 0x3E5D0402, 0x3E5E0402, 0x3E5F0402, 0x3E600402, 0x3E610402, 0x3E620402, 0x3E630402, 0x3E640402,
+		0x3E650402, 0x3E660402, 0x3E670402, 0x3E680402, 0x3E690402, 0x3E6A0402, 0x3E6B0402, 0x3E6C0402, 0x3E6D0402, 0x3E6E0402, 0x3E6F0402, 0x3E700402, 0x3E710402, 0x3E720402, 0x3E730402, 0x3E740402,
+		0x3E750402, 0x3E760402, 0x3E770402, 0x3E780402, 0x3E790402, 0x3E7A0402, 0x3E7B0402, 0x3E7C0402, 0x3E7D0402, 0x3E7E0402, 0x3E7F0402, 0x3E800402, 0x3E810402, 0x3E820402, 0x3E830402, 0x3E840402,
+		0x3E850402, 0x3E860402, 0x3E870402, 0x3E880402, 0x3E890402, 0x3E8A0402, 0x3E8B0402, 0x3E8C0402, 0x3E8D0402, 0x3E8E0402, 0x3E8F0402, 0x3E900402, 0x3E910402, 0x3E920402, 0x3E930402, 0x3E940402,
+		0x3E950402, 0x3E960402, 0x3E970402, 0x3E980402, 0x3E990402, 0x3E9A0402, 0x3E9B0402, 0x3E9C0402, 0x3E9D0402, 0x3E9E0402, 0x3E9F0402, 0x3EA00402, 0x3EA10402, 0x3EA20402, 0x3EA30402, 0x3EA40402,
+		0x3EA50402, 0x3EA60402, 0x3EA70402, 0x3EA80402, 0x3EA90402, 0x3EAA0402, 0x3EAB0402, 0x3EAC0402, 0x3EAD0402, 0x3EAE0402, 0x3EAF0402, 0x3EB00402, 0x3EB10402, 0x3EB20402, 0x3EB30402, 0x3EB40402,
+		0x3EB50402, 0x3EB60402, 0x3EB70402, 0x3EB80402, 0x3EB90402, 0x3EBA0402, 0x3EBB0402, 0x3EBC0402, 0x3EBD0402, 0x3EBE0402, 0x3EBF0402, 0x3EC00402, 0x3EC10402, 0x3EC20402, 0x3EC30402, 0x3EC40402,
+		0x3EC50402, 0x3EC60402, 0x3EC70402, 0x3EC80402, 0x3EC90402, 0x3ECA0402, 0x3ECB0402, 0x3ECC0402, 0x3ECD0402, 0x3ECE0402, 0x3ECF0402, 0x3ED00402, 0x3ED10402, 0x3ED20402, 0x3ED30402, 0x3ED40402,
+		0x3ED50402, 0x3ED60402, 0x3ED70402, 0x3ED80402, 0x3ED90402, 0x3EDA0402, 0x3EDB0402, 0x3EDC0402, 0x3EDD0402, 0x3EDE0402, 0x3EDF0402, 0x3EE00402, 0x3EE10402, 0x3EE20402, 0x3EE30402, 0x3EE40402,
+		0x3EE50402, 0x3EE60402, 0x3EE70402, 0x3EE80402, 0x3EE90402, 0x3EEA0402, 0x3EEB0402, 0x3EEC0402, 0x3EED0402, 0x3EEE0402, 0x3EEF0402, 0x3EF00402, 0x3EF10402, 0x3EF20402, 0x3EF30402, 0x3EF40402,
+		0x3EF50402, 0x3EF60402, 0x3EF70402, 0x3EF80402, 0x3EF90402, 0x3EFA0402, 0x3EFB0402, 0x3EFC0402, 0x3EFD0402, 0x3EFE0402, 0x3EFF0402, 0x3F000402, 0x3F010402, 0x3F020402, 0x3F030402, 0x3F040402,
+		0x3F050402, 0x3F060402, 0x3F070402, 0x3F080402, 0x3F090402, 0x3F0A0402, 0x3F0B0402, 0x3F0C0402, 0x3F0D0402, 0x3F0E0402, 0x3F0F0402, 0x3F100402, 0x3F110402, 0x3F120402, 0x3F130402, 0x3F140402,
+		0x3F150402, 0x3F160402, 0x3F170402, 0x3F180402, 0x3F190402, 0x3F1A0402, 0x3F1B0402, 0x3F1C0402, 0x3F1D0402, 0x3F1E0402, 0x3F1F0402, 0x3F200402, 0x3F210402, 0x3F220402, 0x3F230402, 0x3F240402,
+		0x3F250402, 0x3F260402, 0x3F270402, 0x3F280402, 0x3F290402, 0x3F2A0402, 0x3F2B0402, 0x3F2C0402, 0x3F2D0402, 0x3F2E0402, 0x3F2F0402, 0x3F300402, 0x3F310402, 0x3F320402, 0x3F330402, 0x3F340402,
+		0x3F350402, 0x3F360402, 0x3F370402, 0x3F380402, 0x3F390402, 0x3F3A0402, 0x3F3B0402, 0x3F3C0402, 0x3F3D0402, 0x3F3E0402, 0x3F3F0402, 0x3F400402, 0x3F410402, 0x3F420402, 0x3F430402, 0x3F440402,
+		0x3F450402, 0x3F460402, 0x3F470402, 0x3F480402, 0x3F490402, 0x3F4A0402, 0x3F4B0402, 0x3F4C0402, 0x3F4D0402, 0x3F4E0402, 0x3F4F0402, 0x3F500402, 0x3F510402, 0x3F520402, 0x3F530402, 0x3F540402,
+		0x3F550402, 0x3F560402, 0x3F570402, 0x3F580402, 0x3F590402, 0x3F5A0402, 0x3F5B0402, 0x3F5C0402, 0x3F5D0402, 0x3F5E0402, 0x3F5F0402, 0x3F600402, 0x3F610402, 0x3F620402, 0x3F630402, 0x3F640402,
+		0x3F650402, 0x3F660402, 0x3F670402, 0x3F680402, 0x3F690402, 0x3F6A0402, 0x3F6B0402, 0x3F6C0402, 0x3F6D0402, 0x3F6E0402, 0x3F6F0402, 0x3F700402, 0x3F710402, 0x3F720402, 0x3F730402, 0x3F740402,
+		0x3F750402, 0x3F760402, 0x3F770402, 0x3F780402, 0x3F790402, 0x3F7A0402, 0x3F7B0402, 0x3F7C0402, 0x3F7D0402, 0x3F7E0402, 0x3F7F0402, 0x3F800402, 0x3F810402, 0x3F820402, 0x3F830402, 0x3F840402,
+		0x3F850402, 0x3F860402, 0x3F870402, 0x3F880402, 0x3F890402, 0x3F8A0402, 0x3F8B0402, 0x3F8C0402, 0x3F8D0402, 0x3F8E0402, 0x3F8F0402, 0x3F900402, 0x3F910402, 0x3F920402, 0x3F930402, 0x3F940402,
+		0x3F950402, 0x3F960402, 0x3F970402, 0x3F980402, 0x3F990402, 0x3F9A0402, 0x3F9B0402, 0x3F9C0402, 0x3F9D0402, 0x3F9E0402, 0x3F9F0402, 0x3FA00402, 0x3FA10402, 0x3FA20402, 0x3FA30402, 0x3FA40402,
+		0x3FA50402, 0x3FA60402, 0x3FA70402, 0x3FA80402, 0x3FA90402, 0x3FAA0402, 0x3FAB0402, 0x3FAC0402, 0x3FAD0402, 0x3FAE0402, 0x3FAF0402, 0x3FB00402, 0x3FB10402, 0x3FB20402, 0x3FB30402, 0x3FB40402,
+		0x3FB50402, 0x3FB60402, 0x3FB70402, 0x3FB80402, 0x3FB90402, 0x3FBA0402, 0x3FBB0402, 0x3FBC0402, 0x3FBD0402, 0x3FBE0402, 0x3FBF0402, 0x3FC00402, 0x3FC10402, 0x3FC20402, 0x3FC30402, 0x3FC40402,
+		0x3FC50402, 0x3FC60402, 0x3FC70402, 0x3FC80402, 0x3FC90402, 0x3FCA0402, 0x3FCB0402, 0x3FCC0402, 0x3FCD0402, 0x3FCE0402, 0x3FCF0402, 0x3FD00402, 0x3FD10402, 0x3FD20402, 0x3FD30402, 0x3FD40402,
+		0x3FD50402, 0x3FD60402, 0x3FD70402, 0x3FD80402, 0x3FD90402, 0x3FDA0402, 0x3FDB0402, 0x3FDC0402, 0x3FDD0402, 0x3FDE0402, 0x3FDF0402, 0x3FE00402, 0x3FE10402, 0x3FE20402, 0x3FE30402, 0x3FE40402,
+		0x3FE50402, 0x3FE60402, 0x3FE70402, 0x3FE80402, 0x3FE90402, 0x3FEA0402, 0x3FEB0402, 0x3FEC0402, 0x3FED0402, 0x3FEE0402, 0x3FEF0402, 0x3FF00402, 0x3FF10402, 0x3FF20402, 0x3FF30402, 0x3FF40402,
+		0x3FF50402, 0x3FF60402, 0x3FF70402, 0x3FF80402, 0x3FF90402, 0x3FFA0402, 0x3FFB0402, 0x3FFC0402, 0x3FFD0402, 0x3FFE0402, 0x3FFF0402, 0x40000402, 0x40010402, 0x40020402, 0x40030402, 0x40040402,
+		0x40050402, 0x40060402, 0x40070402, 0x40080402, 0x40090402, 0x400A0402, 0x400B0402, 0x400C0402, 0x400D0402, 0x400E0402, 0x400F0402, 0x40100402, 0x40110402, 0x40120402, 0x40130402, 0x40140402,
+		0x40150402, 0x40160402, 0x40170402, 0x40180402, 0x40190402, 0x401A0402, 0x401B0402, 0x401C0402, 0x401D0402, 0x401E0402, 0x401F0402, 0x40200402, 0x40210402, 0x40220402, 0x40230402, 0x40240402,
+		0x40250402, 0x40260402, 0x40270402, 0x40280402, 0x40290402, 0x402A0402, 0x402B0402, 0x402C0402, 0x402D0402, 0x402E0402, 0x402F0402, 0x40300402, 0x40310402, 0x40320402, 0x40330402, 0x40340402,
+		0x40350402, 0x40360402, 0x40370402, 0x40380402, 0x40390402, 0x403A0402, 0x403B0402, 0x403C0402, 0x403D0402, 0x403E0402, 0x403F0402, 0x40400402, 0x40410402, 0x40420402, 0x40430402, 0x40440402,
+		0x40450402, 0x40460402, 0x40470402, 0x40480402, 0x40490402, 0x404A0402, 0x404B0402, 0x404C0402, 0x404D0402, 0x404E0402, 0x404F0402, 0x40500402, 0x40510402, 0x40520402, 0x40530402, 0x40540402,
+		0x40550402, 0x40560402, 0x40570402, 0x40580402, 0x40590402, 0x405A0402, 0x405B0402, 0x405C0402, 0x405D0402, 0x405E0402, 0x405F0402, 0x40600402, 0x40610402, 0x40620402, 0x40630402, 0x40640402,
+		0x40650402, 0x40660402, 0x40670402, 0x40680402, 0x40690402, 0x406A0402, 0x406B0402, 0x406C0402, 0x406D0402, 0x406E0402, 0x406F0402, 0x40700402, 0x40710402, 0x40720402, 0x40730402, 0x40740402,
+		0x40750402, 0x40760402, 0x40770402, 0x40780402, 0x40790402, 0x407A0402, 0x407B0402, 0x407C0402, 0x407D0402, 0x407E0402, 0x407F0402, 0x40800402, 0x40810402, 0x40820402, 0x40830402, 0x40840402,
+		0x40850402, 0x40860402, 0x40870402, 0x40880402, 0x40890402, 0x408A0402, 0x408B0402, 0x408C0402, 0x408D0402, 0x408E0402, 0x408F0402, 0x40900402, 0x40910402, 0x40920402, 0x40930402, 0x40940402,
+		0x40950402, 0x40960402, 0x40970402, 0x40980402, 0x40990402, 0x409A0402, 0x409B0402, 0x409C0402, 0x409D0402, 0x409E0402, 0x409F0402, 0x40A00402, 0x40A10402, 0x40A20402, 0x40A30402, 0x40A40402,
+		0x40A50402, 0x40A60402, 0x40A70402, 0x40A80402, 0x40A90402, 0x40AA0402, 0x40AB0402, 0x40AC0402, 0x40AD0402, 0x40AE0402, 0x40AF0402, 0x40B00402, 0x40B10402, 0x40B20402, 0x40B30402, 0x40B40402,
+		0x40B50402, 0x40B60402, 0x40B70402, 0x40B80402, 0x40B90402, 0x40BA0402, 0x40BB0402, 0x40BC0402, 0x40BD0402, 0x40BE0402, 0x40BF0402, 0x40C00402, 0x40C10402, 0x40C20402, 0x40C30402, 0x40C40402,
+		0x40C50402, 0x40C60402, 0x40C70402, 0x40C80402, 0x40C90402, 0x40CA0402, 0x40CB0402, 0x40CC0402, 0x40CD0402, 0x40CE0402, 0x40CF0402, 0x40D00402, 0x40D10402, 0x40D20402, 0x40D30402, 0x40D40402,
+		0x40D50402, 0x40D60402, 0x40D70402, 0x40D80402, 0x40D90402, 0x40DA0402, 0x40DB0402, 0x40DC0402, 0x40DD0402, 0x40DE0402, 0x40DF0402, 0x40E00402, 0x40E10402, 0x40E20402, 0x40E30402, 0x40E40402,
+		0x40E50402, 0x40E60402, 0x40E70402, 0x40E80402, 0x40E90402, 0x40EA0402, 0x40EB0402, 0x40EC0402, 0x40ED0402, 0x40EE0402, 0x40EF0402, 0x40F00402, 0x40F10402, 0x40F20402, 0x40F30402, 0x40F40402,
+		0x40F50402, 0x40F60402, 0x40F70402, 0x40F80402, 0x40F90402, 0x40FA0402, 0x40FB0402, 0x40FC0402, 0x40FD0402, 0x40FE0402, 0x40FF0402, 0x41000402, 0x41010402, 0x41020402, 0x41030402, 0x41040402,
+		0x41050402, 0x41060402, 0x41070402, 0x41080402, 0x41090402, 0x410A0402, 0x410B0402, 0x410C0402, 0x410D0402, 0x410E0402, 0x410F0402, 0x41100402, 0x41110402, 0x41120402, 0x41130402, 0x41140402,
+		0x41150402, 0x41160402, 0x41170402, 0x41180402, 0x41190402, 0x411A0402, 0x411B0402, 0x411C0402, 0x411D0402, 0x411E0402, 0x411F0402, 0x41200402, 0x41210402, 0x41220402, 0x41230402, 0x41240402,
+		0x41250402, 0x41260402, 0x41270402, 0x41280402, 0x41290402, 0x412A0402, 0x412B0402, 0x412C0402, 0x412D0402, 0x412E0402, 0x412F0402, 0x41300402, 0x41310402, 0x41520402, 0x41520408, 0x41530402,
+		0x41530408, 0x41540402, 0x41540408, 0x41550402, 0x41550408, 0x41560402, 0x41560408, 0x41570402, 0x41570408, 0x41580402, 0x41580408, 0x41590402, 0x41590408, 0x415A0402, 0x415A0408, 0x415B0402,
+		0x415B0408, 0x415C0402, 0x415C0408, 0x415D0402, 0x415D0408, 0x415E0402, 0x415E0408, 0x415F0402, 0x415F0408, 0x41600402, 0x41600408, 0x41610402, 0x41610408, 0x41620402, 0x41620408, 0x41630402,
+		0x41630408, 0x41640402, 0x41640408, 0x41650402, 0x41650408, 0x41660402, 0x41660408, 0x41670402, 0x41670408, 0x41680402, 0x41680408, 0x41690402, 0x41690408, 0x416A0402, 0x416A0408, 0x416B0402,
+		0x416B0408, 0x416C0402, 0x416C0408, 0x416D0402, 0x416D0408, 0x416E0402, 0x416E0408, 0x416F0402, 0x416F0408, 0x41700402, 0x41700408, 0x41710402, 0x41710408, 0x41720402, 0x41720408, 0x41730402,
+		0x41730408, 0x41750402, 0x41750404, 0x3280404, 0x41750404, 0x3290404, 0x41750406, 0x41750412, 0x3280404, 0x41750404, 0x41F30404, 0x3290404, 0x41750406, 0x41F30406, 0x41760402, 0x41760404,
+		0x41760412, 0x41770402, 0x41770404, 0x3280404, 0x41770404, 0x3290404, 0x41770406, 0x41770412, 0x3280404, 0x41770404, 0x41F30404, 0x3290404, 0x41770406, 0x41F30406, 0x41780402, 0x41780404,
+		0x3280404, 0x41780404, 0x3290404, 0x41780406, 0x41780412, 0x3280404, 0x41780404, 0x41F30404, 0x3290404, 0x41780406, 0x41F30406, 0x41790402, 0x41790404, 0x41790412, 0x417A0402, 0x417A0404,
+		0x3280404, 0x417A0404, 0x3290404, 0x417A0406, 0x417A0412, 0x3280404, 0x417A0404, 0x41F30404, 0x3290404, 0x417A0406, 0x41F30406, 0x417B0402, 0x417B0404, 0x3280404, 0x417B0404, 0x3290404,
+		0x417B0406, 0x417B0412, 0x3280404, 0x417B0404, 0x41F30404, 0x3290404, 0x417B0406, 0x41F30406, 0x417C0402, 0x417C0404, 0x3280404, 0x417C0404, 0x3290404, 0x417C0406, 0x417C0412, 0x3280404,
+		0x417C0404, 0x41F30404, 0x3290404, 0x417C0406, 0x41F30406, 0x417D0402, 0x417D0404, 0x417D0412, 0x417E0402, 0x417E0404, 0x3280404, 0x417E0404, 0x3290404, 0x417E0406, 0x417E0412, 0x3280404,
+		0x417E0404, 0x41F30404, 0x3290404, 0x417E0406, 0x41F30406, 0x417F0402, 0x417F0404, 0x417F0412, 0x41800402, 0x41800404, 0x3280404, 0x41800404, 0x3290404, 0x41800406, 0x41800412, 0x3280404,
+		0x41800404, 0x41F30404, 0x3290404, 0x41800406, 0x41F30406, 0x3280404, 0x41800404, 0x41FB0404, 0x41810404, 0x41F70404, 0x42540404, 0x3290404, 0x3280404, 0x41800404, 0x41FB0404, 0x41870404,
+		0x42000404, 0x3290404, 0x41800406, 0x42000406, 0x41810402, 0x41810404, 0x3280404, 0x41810404, 0x3290404, 0x41810406, 0x41810412, 0x3280404, 0x41810404, 0x41F30404, 0x3290404, 0x41810406,
+		0x41F30406, 0x3280404, 0x41810404, 0x42000404, 0x3290404, 0x41810406, 0x42000406, 0x41800406, 0x42060406, 0x41820402, 0x41820404, 0x41820412, 0x41830402, 0x41830404, 0x3280404, 0x41830404,
+		0x3290404, 0x41830406, 0x41830412, 0x3280404, 0x41830404, 0x41F30404, 0x3290404, 0x41830406, 0x41F30406, 0x41830406, 0x41F30406, 0x42600406, 0x41750406, 0x41FB0406, 0x41840402, 0x41840404,
+		0x3280404, 0x41840404, 0x3290404, 0x41840406, 0x41840412, 0x3280404, 0x41840404, 0x41F30404, 0x3290404, 0x41840406, 0x41F30406, 0x41850402, 0x41850404, 0x3280404, 0x41850404, 0x3290404,
+		0x41850406, 0x41850412, 0x3280404, 0x41850404, 0x41F30404, 0x3290404, 0x41850406, 0x41F30406, 0x41860402, 0x41860404, 0x3280404, 0x41860404, 0x3290404, 0x41860406, 0x41860412, 0x3280404,
+		0x41860404, 0x41F30404, 0x3290404, 0x41860406, 0x41F30406, 0x41870402, 0x41870404, 0x3280404, 0x41870404, 0x3290404, 0x41870406, 0x41870412, 0x3280404, 0x41870404, 0x41F30404, 0x3290404,
+		0x41870406, 0x41F30406, 0x41880402, 0x41890402, 0x41890404, 0x418A0402, 0x418A0404, 0x418B0402, 0x418C0402, 0x418D0402, 0x418E0402, 0x418F0402, 0x418F0404, 0x418F0412, 0x41900402, 0x41910402,
+		0x41910404, 0x41920402, 0x41920404, 0x41930402, 0x41930404, 0x41940402, 0x41950402, 0x41950404, 0x41960402, 0x41960404, 0x41960412, 0x41970402, 0x41970404, 0x41980402, 0x41980404, 0x41990402,
+		0x419A0402, 0x419B0402, 0x419C0402, 0x419C0404, 0x419D0402, 0x419E0402, 0x419E0404, 0x419F0402, 0x41A00402, 0x41A00404, 0x41A10402, 0x41A10404, 0x41A20402, 0x41A20404, 0x41A30402, 0x41A30404,
+		0x41A40402, 0x41A40404, 0x41A50402, 0x41A60402, 0x41A70402, 0x41A70404, 0x41A80402, 0x41A90402, 0x41AA0402, 0x41AB0402, 0x41AB0404, 0x41AC0402, 0x41AD0402, 0x41AE0402, 0x41AF0402, 0x41B00402,
+		0x41B10402, 0x41B20402, 0x41B30402, 0x41B40402, 0x41B50402, 0x41B50404, 0x41B60402, 0x41B70402, 0x41B80402, 0x41B90402, 0x41BA0402, 0x41BB0402, 0x41BC0402, 0x41BC0404, 0x41BD0402, 0x41BE0402,
+		0x41BF0402, 0x41C00402, 0x41C10402, 0x41C10404, 0x41C20402, 0x41C30402, 0x41C40402, 0x41C50402, 0x41C60402, 0x41C70402, 0x41C80402, 0x41C90402, 0x41CA0402, 0x41CB0402, 0x41CC0402, 0x41CC0404,
+		0x41CD0402, 0x41CD0404, 0x41CE0402, 0x41CE0404, 0x41CF0402, 0x41D00402, 0x41D10402, 0x41D20402, 0x41D30402, 0x41D40402, 0x41D50402, 0x41D60402, 0x41D70402, 0x41D80402, 0x41D90402, 0x41DA0402,
+		0x41DB0402, 0x41DC0402, 0x41DD0402, 0x41DE0402, 0x41DF0402, 0x41E00402, 0x41E10402, 0x41E20402, 0x41E30402, 0x41E40402, 0x41E50402, 0x41E60402, 0x41E70402, 0x41E80402, 0x41E90402, 0x41EA0402,
+		0x41EB0402, 0x41EC0402, 0x41ED0402, 0x41EE0402, 0x41EF0402, 0x41F00402, 0x41F10402, 0x41F20402, 0x41F20404, 0x41F20412, 0x41F30402, 0x41F30404, 0x41F30412, 0x41F40402, 0x41F40404, 0x41F40412,
+		0x41F50402, 0x41F50404, 0x41F50412, 0x41F60402, 0x41F60404, 0x41F60412, 0x41F70402, 0x41F70404, 0x41F70412, 0x41F80402, 0x41F80404, 0x41F80412, 0x41F90402, 0x41F90404, 0x41F90412, 0x41FA0402,
+		0x41FA0404, 0x41FA0412, 0x41FB0402, 0x41FB0404, 0x41FB0412, 0x41FC0402, 0x41FC0404, 0x41FC0412, 0x41FD0402, 0x41FD0404, 0x41FD0412, 0x41FE0402, 0x41FE0404, 0x41FE0412, 0x41FF0402, 0x41FF0404,
+		0x41FF0412, 0x42000402, 0x42000404, 0x42000412, 0x42010402, 0x42010404, 0x42010412, 0x42020402, 0x42020404, 0x42020412, 0x42030402, 0x42030404, 0x42030412, 0x42040402, 0x42040404, 0x42040412,
+		0x42050402, 0x42050404, 0x42050412, 0x42060402, 0x42060404, 0x42060412, 0x42070402, 0x42070404, 0x42070412, 0x42080402, 0x42090402, 0x420A0402, 0x420B0402, 0x420C0402, 0x420D0402, 0x420E0402,
+		0x420F0402, 0x42100402, 0x42110402, 0x42120402, 0x42130402, 0x42140402, 0x42150402, 0x42160402, 0x42160404, 0x42170402, 0x42170404, 0x42180402, 0x42190402, 0x421A0402, 0x421A0404, 0x421B0402,
+		0x421C0402, 0x421D0402, 0x421E0402, 0x421F0402, 0x42200402, 0x42210402, 0x42220402, 0x42230402, 0x42230404, 0x42240402, 0x42240404, 0x42250402, 0x42260402, 0x42260404, 0x42270402, 0x42280402,
+		0x42290402, 0x422A0402, 0x422B0402, 0x422C0402, 0x422D0402, 0x422E0402, 0x422F0402, 0x42300402, 0x42300404, 0x42310402, 0x42320402, 0x42330402, 0x42330404, 0x42340402, 0x42350402, 0x42360402,
+		0x42370402, 0x42380402, 0x42390402, 0x423A0402, 0x423B0402, 0x423C0402, 0x423D0402, 0x423E0402, 0x423F0402, 0x42400402, 0x42410402, 0x42420402, 0x42430402, 0x42440402, 0x42450402, 0x42460402,
+		0x42470402, 0x42480402, 0x42490402, 0x424A0402, 0x424B0402, 0x424C0402, 0x424D0402, 0x424E0402, 0x424F0402, 0x42500402, 0x42510402, 0x42520402, 0x42530402, 0x42530404, 0x42530412, 0x42540402,
+		0x42550402, 0x42550404, 0x42550412, 0x42560402, 0x42560404, 0x42560412, 0x42570402, 0x42580402, 0x42590402, 0x42590404, 0x42590412, 0x425A0402, 0x425A0404, 0x425A0412, 0x425B0402, 0x425B0404,
+		0x425B0412, 0x425C0402, 0x425C0404, 0x425C0412, 0x425D0402, 0x425D0404, 0x425D0412, 0x425E0402, 0x425E0404, 0x425E0412, 0x425F0402, 0x42600402, 0x42610402, 0x42620402, 0x42630402, 0x42640402,
+		0x42650402, 0x42660402, 0x42670402, 0x42680402, 0x42690402, 0x426A0402, 0x426B0402, 0x426C0402, 0x426D0402, 0x426E0402, 0x426F0402, 0x42700402, 0x42700404, 0x42710402, 0x42710404, 0x42720402,
+		0x42730402, 0x42740402, 0x42750402, 0x42750404, 0x42760402, 0x42770402, 0x42770404, 0x42780402, 0x42790402, 0x427A0402, 0x427B0402, 0x427C0402, 0x427C0404, 0x427D0402, 0x427E0402, 0x427F0402,
+		0x42800402, 0x42800404, 0x42810402, 0x42820402, 0x42820404, 0x42830402, 0x42840402, 0x42850402, 0x42860402, 0x42860404, 0x42870402, 0x42880402, 0x42880404, 0x42890402, 0x428A0402, 0x428B0402,
+		0x428C0402, 0x428D0402, 0x428E0402, 0x428F0402, 0x42900402, 0x42910402, 0x42920402, 0x42930402, 0x42940402, 0x42950402, 0x42960402, 0x42970402, 0x42980402, 0x42990402, 0x429A0402, 0x429A0404,
+		0x429B0402, 0x429B0404, 0x429C0402, 0x429D0402, 0x429E0402, 0x429F0402, 0x42A00402, 0x42A10402, 0x42A20402, 0x42A30402, 0x42A40402, 0x42A50402, 0x42A60402, 0x42A70402, 0x42A80402, 0x42A90402,
+		0x42AA0402, 0x42AB0402, 0x42AC0402, 0x42AD0402, 0x42AE0402, 0x42AF0402, 0x42B00402, 0x42B10402, 0x42B20402, 0x42B30402, 0x42B40402, 0x42B50402, 0x42B60402, 0x42B70402, 0x42B80402, 0x42B90402,
+		0x42BA0402, 0x42BB0402, 0x42BC0402, 0x42BD0402, 0x42BE0402, 0x42BF0402, 0x42C00402, 0x42C10402, 0x42C20402, 0x42C30402, 0x42C40402, 0x42C50402, 0x42C60402, 0x42C70402, 0x42C80402, 0x42C90402,
+		0x42CA0402, 0x42CB0402, 0x42CC0402, 0x42CD0402, 0x42CE0402, 0x42CF0402, 0x42D00402, 0x42D10402, 0x42D20402, 0x42D30402, 0x42D40402, 0x42D50402, 0x42D60402, 0x42D70402, 0x42D80402, 0x42D90402,
+		0x42DA040D, 0x42DA040E, 0x42DA040F, 0x42DA0410, 0x42DA0411, 0x42DA0412, 0x42DA0413, 0x42DA041C, 0x1F60041C, 0x4303041C, 0x42DA041C, 0x42F4041C, 0x71C, 0x1F60041C, 0x42EE041C, 0x42DA041C,
+		0x4303041C, 0x42F6041C, 0x42DA041C, 0x42DA041C, 0x430A041C, 0x42F7041C, 0x71C, 0x42DA041C, 0x42DB040D, 0x42DB040E, 0x42DB040F, 0x42DB0410, 0x42DB0411, 0x42DB0412, 0x42DB0413, 0x42DB041C,
+		0x42F0041C, 0x430A041C, 0x42E2041C, 0x6FC, 0x42DB041C, 0x430A041C, 0x42EB041C, 0x42DC040D, 0x42DC040E, 0x42DC040F, 0x42DC0410, 0x42DC0411, 0x42DC0412, 0x42DC0413, 0x42DC040E, 0x6E2,
+		0x42DC0411, 0x6E2, 0x42DC041C, 0x42DF041C, 0x430A041C, 0x42DD0411, 0x42DE040D, 0x42DE040E, 0x42DE040F, 0x42DE0410, 0x42DE0411, 0x42DE0412, 0x42DE0413, 0x42DE041C, 0x1F60041C, 0x42E0041C,
+		0x1F60041C, 0x42DE041C, 0x42E7041C, 0x42E2041C, 0x1F60041C, 0x42EE041C, 0x6FC, 0x42DF040D, 0x42DF040E, 0x42DF040F, 0x42DF0410, 0x42DF0411, 0x42DF0412, 0x42DF0413, 0x42DF041C, 0x1F60041C,
+		0x42FB041C, 0x42DF041C, 0x430A041C, 0x42E7041C, 0x42E0040D, 0x42E0040E, 0x42E0040F, 0x42E00411, 0x42E00412, 0x42E00413, 0x42E0040E, 0x6E2, 0x42E00411, 0x6E2, 0x42E0041C, 0x42DB041C,
+		0x4302041C, 0x42E0041C, 0x4301041C, 0x42EC041C, 0x42EE041C, 0x42E0041C, 0x4305041C, 0x4302041C, 0x1F60041C, 0x42E0041C, 0x6FC, 0x4305041C, 0x430A041C, 0x42E0041C, 0x6FC, 0x430A041C,
+		0x42F9041C, 0x42E1040E, 0x42E10411, 0x42E10412, 0x42E10413, 0x42E1040E, 0x6E2, 0x42E10411, 0x6E2, 0x42E1041C, 0x6FC, 0x42E0041C, 0x6FC, 0x42E1041C, 0x6FC, 0x42F0041C,
+		0x1F60041C, 0x42E1041C, 0x42FF041C, 0x4302041C, 0x1F60041C, 0x42E1041C, 0x6FC, 0x4303041C, 0x42EA041C, 0x6FC, 0x1F60041C, 0x42E1041C, 0x4305041C, 0x42E1041C, 0x4305041C, 0x42E2041C,
+		0x6FC, 0x4301041C, 0x42FB041C, 0x42E1041C, 0x4305041C, 0x42FC041C, 0x1F60041C, 0x42EE041C, 0x4303041C, 0x42E1041C, 0x4305041C, 0x4306041C, 0x42EC041C, 0x42EE041C, 0x42E2040E, 0x42E2040F,
+		0x42E20411, 0x42E20412, 0x42E20413, 0x42E2040E, 0x6E2, 0x42E20411, 0x6E2, 0x42E2041C, 0x6FC, 0x4301041C, 0x42FB041C, 0x42E2041C, 0x6FC, 0x4301041C, 0x42FB041C, 0x42EE041C,
+		0x430A041C, 0x42E2041C, 0x4303041C, 0x42E8041C, 0x6FC, 0x42DB041C, 0x4305041C, 0x42E2041C, 0x4305041C, 0x1F60041C, 0x42F2041C, 0x42E3040D, 0x42E3040E, 0x42E3040F, 0x42E30411, 0x42E30412,
+		0x42E30413, 0x42E3040E, 0x6E2, 0x42E30411, 0x6E2, 0x42E3041C, 0x1F60041C, 0x42E7041C, 0x42E4040E, 0x42E40411, 0x42E40412, 0x42E40413, 0x42E4040E, 0x6E2, 0x42E40411, 0x6E2,
+		0x42E4041C, 0x1F60041C, 0x42F8041C, 0x71C, 0x42E4041C, 0x42E4041C, 0x42E40416, 0x42EE0416, 0x42E4041C, 0x4303041C, 0x42EF041C, 0x42E5040E, 0x42E50411, 0x42E50412, 0x42E50413, 0x42E5041C,
+		0x42E5040E, 0x6E2, 0x42E50411, 0x6E2, 0x42E5041C, 0x42DB041C, 0x42E2041C, 0x4303041C, 0x42E5041C, 0x430A041C, 0x42EB041C, 0x1F60041C, 0x42FB041C, 0x42E6040E, 0x42E6040F, 0x42E60411,
+		0x42E60412, 0x42E60413, 0x42E6040E, 0x6E2, 0x42E60411, 0x6E2, 0x42E60404, 0x42FC0404, 0x42E6041C, 0x4302041C, 0x430A041C, 0x42E2041C, 0x6FC, 0x42E7040E, 0x42E7040F, 0x42E70411,
+		0x42E70412, 0x42E70413, 0x42E7040E, 0x6E2, 0x42E70411, 0x6E2, 0x42E8040E, 0x42E80411, 0x42E80412, 0x42E80413, 0x42E8040E, 0x6E2, 0x42E80411, 0x6E2, 0x42E8041C, 0x430A041C,
+		0x42EB041C, 0x42E8041C, 0x430A041C, 0x42EE041C, 0x42E9040E, 0x42E90411, 0x42E90412, 0x42E90413, 0x42E9040E, 0x6E2, 0x42E90411, 0x6E2, 0x42EA040E, 0x42EA0411, 0x42EA0412, 0x42EA0413,
+		0x42EA040E, 0x6E2, 0x42EA0411, 0x6E2, 0x42EA041C, 0x6FC, 0x1F60041C, 0x42E7041C, 0x42EB040E, 0x42EB0411, 0x42EB0412, 0x42EB0413, 0x42EB040E, 0x6E2, 0x42EB0411, 0x6E2,
+		0x42EC040D, 0x42EC040E, 0x42EC040F, 0x42EC0410, 0x42EC0411, 0x42EC0412, 0x42EC0413, 0x42EC040E, 0x6E2, 0x42EC0411, 0x6E2, 0x42ED040E, 0x42ED0411, 0x42ED0412, 0x42ED0413, 0x42ED040E,
+		0x6E2, 0x42ED0411, 0x6E2, 0x42ED041C, 0x6FC, 0x42ED041C, 0x6FC, 0x42E6041C, 0x42EE040E, 0x42EE040F, 0x42EE0411, 0x42EE0412, 0x42EE0413, 0x42EE040E, 0x6E2, 0x42EE0411,
+		0x6E2, 0x42EE041C, 0x6FC, 0x4303041C, 0x42EE041C, 0x430A041C, 0x42EF040E, 0x42EF0411, 0x42EF0412, 0x42EF0413, 0x42EF041C, 0x42F3041C, 0x42F0040E, 0x42F00411, 0x42F00412, 0x42F00413,
+		0x42F1040E, 0x42F1040F, 0x42F10411, 0x42F10412, 0x42F10413, 0x42F2040E, 0x42F20411, 0x42F20412, 0x42F20413, 0x42F3040E, 0x42F30411, 0x42F30412, 0x42F30413, 0x42F3041C, 0x42EC041C, 0x42EE041C,
+		0x42F4040E, 0x42F4040F, 0x42F40411, 0x42F40412, 0x42F40413, 0x42F4040E, 0x6E2, 0x42F40411, 0x6E2, 0x42F4040E, 0x702, 0x42F40411, 0x702, 0x42F4041C, 0x71C, 0x1F60041C,
+		0x42E8041C, 0x430A041C, 0x42EE041C, 0x42F4041C, 0x71C, 0x1F60041C, 0x42EC041C, 0x42F4041C, 0x6FC, 0x1F60041C, 0x4304041C, 0x4303041C, 0x42F4041C, 0x42DB041C, 0x42EC041C, 0x42F5040E,
+		0x42F5040F, 0x42F50411, 0x42F50412, 0x42F50413, 0x42F5040E, 0x6E2, 0x42F50411, 0x6E2, 0x42F5040E, 0x702, 0x42F50411, 0x702, 0x42F5041C, 0x71C, 0x42DA041C, 0x42E7041C,
+		0x42EE041C, 0x4303041C, 0x42F5041C, 0x71C, 0x42E2041C, 0x4303041C, 0x42F5041C, 0x71C, 0x42E4041C, 0x42F5041C, 0x6FC, 0x4303041C, 0x42F6040E, 0x42F6040F, 0x42F60411, 0x42F60412,
+		0x42F60413, 0x42F6040E, 0x6E2, 0x42F60411, 0x6E2, 0x42F6040E, 0x702, 0x42F60411, 0x702, 0x42F6041C, 0x42DA041C, 0x4301041C, 0x42EC041C, 0x42EE041C, 0x6FC, 0x42F6041C,
+		0x42DB041C, 0x1F60041C, 0x42EE041C, 0x42F6041C, 0x6FC, 0x42EC041C, 0x42E6041C, 0x42DE041C, 0x4303041C, 0x42F6041C, 0x4301041C, 0x430A041C, 0x42F7040E, 0x42F7040F, 0x42F70411, 0x42F70412,
+		0x42F70413, 0x42F7040E, 0x6E2, 0x42F70411, 0x6E2, 0x42F7040E, 0x702, 0x42F70411, 0x702, 0x42F7041C, 0x71C, 0x1F60041C, 0x42E6041C, 0x6FC, 0x42F7041C, 0x6FC,
+		0x1F60041C, 0x42EA041C, 0x42F7041C, 0x42E2041C, 0x42EA041C, 0x1F60041C, 0x4303041C, 0x42F7041C, 0x71C, 0x42E9041C, 0x42F7041C, 0x71C, 0x42F0041C, 0x42F5041C, 0x42F7041C, 0x4303041C,
+		0x42EC041C, 0x42F7041C, 0x71C, 0x430A041C, 0x42E7041C, 0x42F8040E, 0x42F8040F, 0x42F80411, 0x42F80412, 0x42F80413, 0x42F8040E, 0x6E2, 0x42F80411, 0x6E2, 0x42F8040E, 0x702,
+		0x42F80411, 0x702, 0x42F8041C, 0x1F60041C, 0x4303041C, 0x42F8041C, 0x1F60041C, 0x430A041C, 0x42F8041C, 0x71C, 0x42DB041C, 0x430A041C, 0x42EE041C, 0x42F8041C, 0x42E0041C, 0x42F8041C,
+		0x6FC, 0x4303041C, 0x42EE041C, 0x42F8041C, 0x430A041C, 0x42F8041C, 0x71C, 0x430A041C, 0x42EE041C, 0x6FC, 0x42F9040E, 0x42F90411, 0x42F90412, 0x42F90413, 0x42F9041C, 0x42DB041C,
+		0x42E2041C, 0x4305041C, 0x42F9041C, 0x42DB041C, 0x4303041C, 0x42F90404, 0x42E70404, 0x42F9041C, 0x42EC041C, 0x42F4041C, 0x42F9041C, 0x4303041C, 0x42E2041C, 0x42F9041C, 0x430A041C, 0x42E6041C,
+		0x4300041C, 0x430A041C, 0x42FA040E, 0x42FA0411, 0x42FA0412, 0x42FA0413, 0x42FA041C, 0x42E2041C, 0x4305041C, 0x430A041C, 0x42FA041C, 0x4302041C, 0x42FA041C, 0x4302041C, 0x42F4041C, 0x6FC,
+		0x1F60041C, 0x4303041C, 0x42FB040E, 0x42FB040F, 0x42FB0411, 0x42FB0412, 0x42FB0413, 0x42FC040E, 0x42FC0411, 0x42FC0412, 0x42FC0413, 0x42FC041C, 0x1F60041C, 0x42EE041C, 0x4303041C, 0x42FC041C,
+		0x42E0041C, 0x6FC, 0x42FC041C, 0x42E0041C, 0x6FC, 0x42EE041C, 0x430A041C, 0x42FD040E, 0x42FD0411, 0x42FD0412, 0x42FD0413, 0x42FE040D, 0x42FE040E, 0x42FE040F, 0x42FE0410, 0x42FE0411,
+		0x42FE0412, 0x42FE0413, 0x42FE041C, 0x1F60041C, 0x42EE041C, 0x6FC, 0x42FE041C, 0x1F60041C, 0x4303041C, 0x42FF040D, 0x42FF040E, 0x42FF040F, 0x42FF0410, 0x42FF0411, 0x42FF0412, 0x42FF0413,
+		0x42FF041C, 0x42DA041C, 0x430A041C, 0x4300040D, 0x4300040E, 0x4300040F, 0x43000410, 0x43000411, 0x43000412, 0x43000413, 0x43000416, 0x43020416, 0x4301040E, 0x4301040F, 0x43010411, 0x43010412,
+		0x43010413, 0x4302040E, 0x4302040F, 0x43020411, 0x43020412, 0x43020413, 0x4302041C, 0x42EC041C, 0x42EE041C, 0x4303041C, 0x4302041C, 0x4301041C, 0x4303040E, 0x4303040F, 0x43030411, 0x43030412,
+		0x43030413, 0x4303041C, 0x1F60041C, 0x42F6041C, 0x6FC, 0x4303041C, 0x4303041C, 0x42F5041C, 0x71C, 0x1F60041C, 0x4304040E, 0x4304040F, 0x43040411, 0x43040412, 0x43040413, 0x4304041C,
+		0x42FB041C, 0x4304041C, 0x430A041C, 0x42EE041C, 0x42E3041C, 0x6FC, 0x430A041C, 0x4305040E, 0x4305040F, 0x43050411, 0x43050412, 0x43050413, 0x4306040D, 0x4306040E, 0x4306040F, 0x43060411,
+		0x43060412, 0x43060413, 0x43060411, 0x6E2, 0x4306041C, 0x42EC041C, 0x42EE041C, 0x4307040E, 0x43070411, 0x43070413, 0x43070411, 0x6E2, 0x4308040E, 0x43080411, 0x43080413, 0x43080411,
+		0x6E2, 0x4309040E, 0x43090411, 0x43090412, 0x43090413, 0x43090411, 0x6E2, 0x430A040E, 0x430A0411, 0x430A0412, 0x43180402, 0x44290402, 0x44290404, 0x2324, 0x442A0402, 0x442A0419,
+		0x442B0402, 0x442C0402, 0x442D0402, 0x442E0402, 0x442F0402, 0x44300402, 0x44300419, 0x44310402, 0x44330402, 0x44340402, 0x44340404, 0x2324, 0x44350402, 0x44350419, 0x44360402, 0x44370402,
+		0x44380402, 0x44380419, 0x44390402, 0x44390404, 0x2324, 0x443A0402, 0x443B0402, 0x443C0402, 0x443F0402, 0x44400402, 0x44410402, 0x44420402, 0x44430402, 0x44430404, 0x2324, 0x44440402,
+		0x44450402, 0x44460402, 0x44470402, 0x44480402, 0x44490402, 0x44490404, 0x2324, 0x444A0402, 0x444A0404, 0x2324, 0x444B0402, 0x444C0402, 0x444D0402, 0x444E0402, 0x444E0404, 0x2324,
+		0x444F0402, 0x444F0404, 0x2324, 0x44500402, 0x44510402, 0x44510404, 0x2324, 0x44520402, 0x44530402, 0x44540402, 0x44550402, 0x44560402, 0x44570402, 0x44580402, 0x44590402, 0x445A0402,
+		0x445B0402, 0x445B0404, 0x2324, 0x445B0416, 0x2336, 0x445C0402, 0x445C0404, 0x2324, 0x445C0404, 0x2344, 0x445D0402, 0x445E0402, 0x44610402, 0x44620402, 0x44630402, 0x44640402,
+		0x44650402, 0x44660402, 0x44670402, 0x44680402, 0x44690402, 0x446A0402, 0x446B0402, 0x446C0402, 0x446D0402, 0x446E0402, 0x446F0402, 0x44700402, 0x44710402, 0x44720402, 0x44730402, 0x44740402,
+		0x44750402, 0x44760402, 0x44770402, 0x44780402, 0x44790402, 0x447A0402, 0x447B0402, 0x447C0402, 0x447D0402, 0x447E0402, 0x447F0402, 0x44800402, 0x44810402, 0x44820402, 0x44830402, 0x44840402,
+		0x44850402, 0x44860402, 0x44870402, 0x44880402, 0x44890402, 0x448A0402, 0x448B0402, 0x448C0402, 0x448D0402, 0x448E0402, 0x448F0402, 0x44900402, 0x44910402, 0x44920402, 0x44930402, 0x44940402,
+		0x44950402, 0x44960402, 0x44970402, 0x44980402, 0x44990402, 0x449A0402, 0x449B0402, 0x449C0402, 0x449D0402, 0x449E0402, 0x449F0402, 0x44A00402, 0x44A10402, 0x44A20402, 0x44A30402, 0x44A40402,
+		0x44A50402, 0x44A60402, 0x44A70402, 0x44A80402, 0x44A90402, 0x44AA0402, 0x44AB0402, 0x44AC0402, 0x44AD0402, 0x44AE0402, 0x44AF0402, 0x44B00402, 0x44B10402, 0x44B20402, 0x44B30402, 0x44B40402,
+		0x44B50402, 0x44B60402, 0x44B70402, 0x44B80402, 0x44B90402, 0x44BA0402, 0x44BB0402, 0x44BC0402, 0x44BD0402, 0x44BE0402, 0x44BF0402, 0x44C00402, 0x44C10402, 0x44C20402, 0x44C30402, 0x44C40402,
+		0x44C50402, 0x44C60402, 0x44C70402, 0x44C80402, 0x44C90402, 0x44CA0402, 0x44CB0402, 0x44CC0402, 0x44CD0402, 0x44CE0402, 0x44CF0402, 0x44D00402, 0x44D10402, 0x44D20402, 0x44D30402, 0x44D40402,
+		0x44D50402, 0x44D60402, 0x44D70402, 0x44D80402, 0x44D90402, 0x44DA0402, 0x44DB0402, 0x44DC0402, 0x44DD0402, 0x44DE0402, 0x44DF0402, 0x44E00402, 0x44E10402, 0x44E20402, 0x44E30402, 0x44E40402,
+		0x44E50402, 0x44E60402, 0x44E70402, 0x44E80402, 0x44E90402, 0x44EA0402, 0x44EB0402, 0x44EC0402, 0x44ED0402, 0x44EE0402, 0x44EF0402, 0x44F00402, 0x44F10402, 0x44F20402, 0x44F30402, 0x44F40402,
+		0x44F50402, 0x44F60402, 0x44F70402, 0x44F80402, 0x44F90402, 0x44FA0402, 0x44FB0402, 0x44FC0402, 0x44FD0402, 0x44FE0402, 0x44FF0402, 0x45000402, 0x45010402, 0x45020402, 0x45030402, 0x45040402,
+		0x45050402, 0x45060402, 0x45070402, 0x45080402, 0x45090402, 0x450A0402, 0x450B0402, 0x450C0402, 0x450D0402, 0x450E0402, 0x450F0402, 0x45100402, 0x45110402, 0x45120402, 0x45130402, 0x45140402,
+		0x45150402, 0x45160402, 0x45170402, 0x45180402, 0x45190402, 0x451A0402, 0x451B0402, 0x451C0402, 0x451D0402, 0x451E0402, 0x451F0402, 0x45200402, 0x45210402, 0x45220402, 0x45230402, 0x45240402,
+		0x45250402, 0x45260402, 0x45270402, 0x45280402, 0x45290402, 0x452A0402, 0x452B0402, 0x452C0402, 0x452D0402, 0x452E0402, 0x452F0402, 0x45300402, 0x45310402, 0x45320402, 0x45330402, 0x45340402,
+		0x45350402, 0x45360402, 0x45370402, 0x45380402, 0x45390402, 0x453A0402, 0x453B0402, 0x453C0402, 0x453D0402, 0x453E0402, 0x453F0402, 0x45400402, 0x45410402, 0x45420402, 0x45430402, 0x45440402,
+		0x45450402, 0x45460402, 0x45470402, 0x45480402, 0x45490402, 0x454A0402, 0x454B0402, 0x454C0402, 0x454D0402, 0x454E0402, 0x454F0402, 0x45500402, 0x45510402, 0x45520402, 0x45530402, 0x45540402,
+		0x45550402, 0x45560402, 0x45570402, 0x45580402, 0x45590402, 0x455A0402, 0x455B0402, 0x455C0402, 0x455D0402, 0x455E0402, 0x455F0402, 0x45600402, 0x45610402, 0x45620402, 0x45630402, 0x45640402,
+		0x45650402, 0x45660402, 0x45670402, 0x45680402, 0x45690402, 0x456A0402, 0x456B0402, 0x456C0402, 0x456D0402, 0x456E0402, 0x456F0402, 0x45700402, 0x45710402, 0x45720402, 0x45730402, 0x45740402,
+		0x45750402, 0x45760402, 0x45770402, 0x45780402, 0x45790402, 0x457A0402, 0x457B0402, 0x457C0402, 0x457D0402, 0x457E0402, 0x457F0402, 0x45800402, 0x45810402, 0x45820402, 0x45830402, 0x45840402,
+		0x45850402, 0x45860402, 0x45870402, 0x45880402, 0x45890402, 0x458A0402, 0x458B0402, 0x458C0402, 0x458D0402, 0x458E0402, 0x458F0402, 0x45900402, 0x45910402, 0x45920402, 0x45930402, 0x45940402,
+		0x45950402, 0x45960402, 0x45970402, 0x45980402, 0x45990402, 0x459A0402, 0x459B0402, 0x459C0402, 0x459D0402, 0x459E0402, 0x459F0402, 0x45A00402, 0x45A10402, 0x45A20402, 0x45A30402, 0x45A40402,
+		0x45A50402, 0x45A60402, 0x45A70402, 0x45A80402, 0x45A90402, 0x45AA0402, 0x45AB0402, 0x45AC0402, 0x45AD0402, 0x45AE0402, 0x45AF0402, 0x45B00402, 0x45B10402, 0x45B20402, 0x45B30402, 0x45B40402,
+		0x45B50402, 0x45B60402, 0x45B70402, 0x45B80402, 0x45B90402, 0x45BA0402, 0x45BB0402, 0x45BC0402, 0x45BD0402, 0x45BE0402, 0x45BF0402, 0x45C00402, 0x45C10402, 0x45C20402, 0x45C30402, 0x45C40402,
+		0x45C50402, 0x45C60402, 0x45C70402, 0x45C80402, 0x45C90402, 0x45CA0402, 0x45CB0402, 0x45CC0402, 0x45CD0402, 0x45CE0402, 0x45CF0402, 0x45D00402, 0x45D10402, 0x45D20402, 0x45D30402, 0x45D40402,
+		0x45D50402, 0x45D60402, 0x45D70402, 0x45D80402, 0x45D90402, 0x45DA0402, 0x45DB0402, 0x45DC0402, 0x45DD0402, 0x45DE0402, 0x45DF0402, 0x45E00402, 0x45E10402, 0x45E20402, 0x45E30402, 0x45E40402,
+		0x45E50402, 0x45E60402, 0x45E70402, 0x45E80402, 0x45E90402, 0x45EA0402, 0x45EB0402, 0x45EC0402, 0x45ED0402, 0x45EE0402, 0x45EF0402, 0x45F00402, 0x45F10402, 0x45F20402, 0x45F30402, 0x45F40402,
+		0x45F50402, 0x45F60402, 0x45F70402, 0x45F80402, 0x45F90402, 0x45FA0402, 0x45FB0402, 0x45FC0402, 0x45FD0402, 0x45FE0402, 0x45FF0402, 0x46000402, 0x46010402, 0x46020402, 0x46030402, 0x46040402,
+		0x46050402, 0x46060402, 0x46070402, 0x46080402, 0x46090402, 0x460A0402, 0x460B0402, 0x460C0402, 0x460D0402, 0x460E0402, 0x460F0402, 0x46100402, 0x46110402, 0x46120402, 0x46130402, 0x46140402,
+		0x46150402, 0x46160402, 0x46170402, 0x46180402, 0x46190402, 0x461A0402, 0x461B0402, 0x461C0402, 0x461D0402, 0x461E0402, 0x461F0402, 0x46200402, 0x46210402, 0x46220402, 0x46230402, 0x46240402,
+		0x46250402, 0x46260402, 0x46270402, 0x46280402, 0x46290402, 0x462A0402, 0x462B0402, 0x462C0402, 0x462D0402, 0x462E0402, 0x462F0402, 0x46300402, 0x46310402, 0x46320402, 0x46330402, 0x46340402,
+		0x46350402, 0x46360402, 0x46370402, 0x46380402, 0x46390402, 0x463A0402, 0x463B0402, 0x463C0402, 0x463D0402, 0x463E0402, 0x463F0402, 0x46400402, 0x46410402, 0x46420402, 0x46430402, 0x46440402,
+		0x46450402, 0x46460402, 0x46470402, 0x46480402, 0x46490402, 0x464A0402, 0x464B0402, 0x464C0402, 0x464D0402, 0x464E0402, 0x464F0402, 0x46500402, 0x46510402, 0x46520402, 0x46530402, 0x46540402,
+		0x46550402, 0x46560402, 0x46570402, 0x46580402, 0x46590402, 0x465A0402, 0x465B0402, 0x465C0402, 0x465D0402, 0x465E0402, 0x465F0402, 0x46600402, 0x46610402, 0x46620402, 0x46630402, 0x46640402,
+		0x46650402, 0x46660402, 0x46670402, 0x46680402, 0x46690402, 0x466A0402, 0x466B0402, 0x466C0402, 0x466D0402, 0x466E0402, 0x466F0402, 0x46700402, 0x46710402, 0x46720402, 0x46730402, 0x46740402,
+		0x46750402, 0x46760402, 0x46770402, 0x46780402, 0x46790402, 0x467A0402, 0x467B0402, 0x467C0402, 0x467D0402, 0x467E0402, 0x467F0402, 0x46800402, 0x46810402, 0x46820402, 0x46830402, 0x46840402,
+		0x46850402, 0x46860402, 0x46870402, 0x46880402, 0x46890402, 0x468A0402, 0x468B0402, 0x468C0402, 0x468D0402, 0x468E0402, 0x468F0402, 0x46900402, 0x46910402, 0x46920402, 0x46930402, 0x46940402,
+		0x46950402, 0x46960402, 0x46970402, 0x46980402, 0x46990402, 0x469A0402, 0x469B0402, 0x469C0402, 0x469D0402, 0x469E0402, 0x469F0402, 0x46A00402, 0x46A10402, 0x46A20402, 0x46A30402, 0x46A40402,
+		0x46A50402, 0x46A60402, 0x46A70402, 0x46A80402, 0x46A90402, 0x46AA0402, 0x46AB0402, 0x46AC0402, 0x46AD0402, 0x46AE0402, 0x46AF0402, 0x46B00402, 0x46B10402, 0x46B20402, 0x46B30402, 0x46B40402,
+		0x46B50402, 0x46B60402, 0x46B70402, 0x46B80402, 0x46B90402, 0x46BA0402, 0x46BB0402, 0x46BC0402, 0x46BD0402, 0x46BE0402, 0x46BF0402, 0x46C00402, 0x46C10402, 0x46C20402, 0x46C30402, 0x46C40402,
+		0x46C50402, 0x46C60402, 0x46C70402, 0x46C80402, 0x46C90402, 0x46CA0402, 0x46CB0402, 0x46CC0402, 0x46CD0402, 0x46CE0402, 0x46CF0402, 0x46D00402, 0x46D10402, 0x46D20402, 0x46D30402, 0x46D40402,
+		0x46D50402, 0x46D60402, 0x46D70402, 0x46D80402, 0x46D90402, 0x46DA0402, 0x46DB0402, 0x46DC0402, 0x46DD0402, 0x46DE0402, 0x46DF0402, 0x46E00402, 0x46E10402, 0x46E20402, 0x46E30402, 0x46E40402,
+		0x46E50402, 0x46E60402, 0x46E70402, 0x46E80402, 0x46E90402, 0x46EA0402, 0x46EB0402, 0x46EC0402, 0x46ED0402, 0x46EE0402, 0x46EF0402, 0x46F00402, 0x46F10402, 0x46F20402, 0x46F30402, 0x46F40402,
+		0x46F50402, 0x46F60402, 0x46F70402, 0x46F80402, 0x46F90402, 0x46FA0402, 0x46FB0402, 0x46FC0402, 0x46FD0402, 0x46FE0402, 0x46FF0402, 0x47000402, 0x47010402, 0x47020402, 0x47030402, 0x47040402,
+		0x47050402, 0x47060402, 0x47070402, 0x47080402, 0x47090402, 0x470A0402, 0x470B0402, 0x470C0402, 0x470D0402, 0x470E0402, 0x470F0402, 0x47100402, 0x47110402, 0x47120402, 0x47130402, 0x47140402,
+		0x47150402, 0x47160402, 0x47170402, 0x47180402, 0x47190402, 0x471A0402, 0x471B0402, 0x471C0402, 0x471D0402, 0x471E0402, 0x471F0402, 0x47200402, 0x47210402, 0x47220402, 0x47230402, 0x47240402,
+		0x47250402, 0x47260402, 0x47270402, 0x47280402, 0x47290402, 0x472A0402, 0x472B0402, 0x472C0402, 0x472D0402, 0x472E0402, 0x472F0402, 0x47300402, 0x47310402, 0x47320402, 0x47330402, 0x47340402,
+		0x47350402, 0x47360402, 0x47370402, 0x47380402, 0x47390402, 0x473A0402, 0x473B0402, 0x473C0402, 0x473D0402, 0x473E0402, 0x473F0402, 0x47400402, 0x47410402, 0x47420402, 0x47430402, 0x47440402,
+		0x47450402, 0x47460402, 0x47470402, 0x47480402, 0x47490402, 0x474A0402, 0x474B0402, 0x474C0402, 0x474D0402, 0x474E0402, 0x474F0402, 0x47500402, 0x47510402, 0x47520402, 0x47530402, 0x47540402,
+		0x47550402, 0x47560402, 0x47570402, 0x47580402, 0x47590402, 0x475A0402, 0x475B0402, 0x475C0402, 0x475D0402, 0x475E0402, 0x475F0402, 0x47600402, 0x47610402, 0x47620402, 0x47630402, 0x47640402,
+		0x47650402, 0x47660402, 0x47670402, 0x47680402, 0x47690402, 0x476A0402, 0x476B0402, 0x476C0402, 0x476D0402, 0x476E0402, 0x476F0402, 0x47700402, 0x47710402, 0x47720402, 0x47730402, 0x47740402,
+		0x47750402, 0x47760402, 0x47770402, 0x47780402, 0x47790402, 0x477A0402, 0x477B0402, 0x477C0402, 0x477D0402, 0x477E0402, 0x477F0402, 0x47800402, 0x47810402, 0x47820402, 0x47830402, 0x47840402,
+		0x47850402, 0x47860402, 0x47870402, 0x47880402, 0x47890402, 0x478A0402, 0x478B0402, 0x478C0402, 0x478D0402, 0x478E0402, 0x478F0402, 0x47900402, 0x47910402, 0x47920402, 0x47930402, 0x47940402,
+		0x47950402, 0x47960402, 0x47970402, 0x47980402, 0x47990402, 0x479A0402, 0x479B0402, 0x479C0402, 0x479D0402, 0x479E0402, 0x479F0402, 0x47A00402, 0x47A10402, 0x47A20402, 0x47A30402, 0x47A40402,
+		0x47A50402, 0x47A60402, 0x47A70402, 0x47A80402, 0x47A90402, 0x47AA0402, 0x47AB0402, 0x47AC0402, 0x47AD0402, 0x47AE0402, 0x47AF0402, 0x47B00402, 0x47B10402, 0x47B20402, 0x47B30402, 0x47B40402,
+		0x47B50402, 0x47B60402, 0x47B70402, 0x47B80402, 0x47B90402, 0x47BA0402, 0x47BB0402, 0x47BC0402, 0x47BD0402, 0x47BE0402, 0x47BF0402, 0x47C00402, 0x47C10402, 0x47C20402, 0x47C30402, 0x47C40402,
+		0x47C50402, 0x47C60402, 0x47C70402, 0x47C80402, 0x47C90402, 0x47CA0402, 0x47CB0402, 0x47CC0402, 0x47CD0402, 0x47CE0402, 0x47CF0402, 0x47D00402, 0x47D10402, 0x47D20402, 0x47D30402, 0x47D40402,
+		0x47D50402, 0x47D60402, 0x47D70402, 0x47D80402, 0x47D90402, 0x47DA0402, 0x47DB0402, 0x47DC0402, 0x47DD0402, 0x47DE0402, 0x47DF0402, 0x47E00402, 0x47E10402, 0x47E20402, 0x47E30402, 0x47E40402,
+		0x47E50402, 0x47E60402, 0x47E70402, 0x47E80402, 0x47E90402, 0x47EA0402, 0x47EB0402, 0x47EC0402, 0x47ED0402, 0x47EE0402, 0x47EF0402, 0x47F00402, 0x47F10402, 0x47F20402, 0x47F30402, 0x47F40402,
+		0x47F50402, 0x47F60402, 0x47F70402, 0x47F80402, 0x47F90402, 0x47FA0402, 0x47FB0402, 0x47FC0402, 0x47FD0402, 0x47FE0402, 0x47FF0402, 0x48000402, 0x48010402, 0x48020402, 0x48030402, 0x48040402,
+		0x48050402, 0x48060402, 0x48070402, 0x48080402, 0x48090402, 0x480A0402, 0x480B0402, 0x480C0402, 0x480D0402, 0x480E0402, 0x480F0402, 0x48100402, 0x48110402, 0x48120402, 0x48130402, 0x48140402,
+		0x48150402, 0x48160402, 0x48170402, 0x48180402, 0x48190402, 0x481A0402, 0x481B0402, 0x481C0402, 0x481D0402, 0x481E0402, 0x481F0402, 0x48200402, 0x48210402, 0x48220402, 0x48230402, 0x48240402,
+		0x48250402, 0x48260402, 0x48270402, 0x48280402, 0x48290402, 0x482A0402, 0x482B0402, 0x482C0402, 0x482D0402, 0x482E0402, 0x482F0402, 0x48300402, 0x48310402, 0x48320402, 0x48330402, 0x48340402,
+		0x48350402, 0x48360402, 0x48370402, 0x48380402, 0x48390402, 0x483A0402, 0x483B0402, 0x483C0402, 0x483D0402, 0x483E0402, 0x483F0402, 0x48400402, 0x48410402, 0x48420402, 0x48430402, 0x48440402,
+		0x48450402, 0x48460402, 0x48470402, 0x48480402, 0x48490402, 0x484A0402, 0x484B0402, 0x484C0402, 0x484D0402, 0x484E0402, 0x484F0402, 0x48500402, 0x48510402, 0x48520402, 0x48530402, 0x48540402,
+		0x48550402, 0x48560402, 0x48570402, 0x48580402, 0x48590402, 0x485A0402, 0x485B0402, 0x485C0402, 0x485D0402, 0x485E0402, 0x485F0402, 0x48600402, 0x48610402, 0x48620402, 0x48630402, 0x48640402,
+		0x48650402, 0x48660402, 0x48670402, 0x48680402, 0x48690402, 0x486A0402, 0x486B0402, 0x486C0402, 0x486D0402, 0x486E0402, 0x486F0402, 0x48700402, 0x48710402, 0x48720402, 0x48730402, 0x48740402,
+		0x48750402, 0x48760402, 0x48770402, 0x48780402, 0x48790402, 0x487A0402, 0x487B0402, 0x487C0402, 0x487D0402, 0x487E0402, 0x487F0402, 0x48800402, 0x48810402, 0x48820402, 0x48830402, 0x48840402,
+		0x48850402, 0x48860402, 0x48870402, 0x48880402, 0x48890402, 0x488A0402, 0x488B0402, 0x488C0402, 0x488D0402, 0x488E0402, 0x488F0402, 0x48900402, 0x48910402, 0x48920402, 0x48930402, 0x48940402,
+		0x48950402, 0x48960402, 0x48970402, 0x48980402, 0x48990402, 0x489A0402, 0x489B0402, 0x489C0402, 0x489D0402, 0x489E0402, 0x489F0402, 0x48A00402, 0x48A10402, 0x48A20402, 0x48A30402, 0x48A40402,
+		0x48A50402, 0x48A60402, 0x48A70402, 0x48A80402, 0x48A90402, 0x48AA0402, 0x48AB0402, 0x48AC0402, 0x48AD0402, 0x48AE0402, 0x48AF0402, 0x48B00402, 0x48B10402, 0x48B20402, 0x48B30402, 0x48B40402,
+		0x48B50402, 0x48B60402, 0x48B70402, 0x48B80402, 0x48B90402, 0x48BA0402, 0x48BB0402, 0x48BC0402, 0x48BD0402, 0x48BE0402, 0x48BF0402, 0x48C00402, 0x48C10402, 0x48C20402, 0x48C30402, 0x48C40402,
+		0x48C50402, 0x48C60402, 0x48C70402, 0x48C80402, 0x48C90402, 0x48CA0402, 0x48CB0402, 0x48CC0402, 0x48CD0402, 0x48CE0402, 0x48CF0402, 0x48D00402, 0x48D10402, 0x48D20402, 0x48D30402, 0x48D40402,
+		0x48D50402, 0x48D60402, 0x48D70402, 0x48D80402, 0x48D90402, 0x48DA0402, 0x48DB0402, 0x48DC0402, 0x48DD0402, 0x48DE0402, 0x48DF0402, 0x48E00402, 0x48E10402, 0x48E20402, 0x48E30402, 0x48E40402,
+		0x48E50402, 0x48E60402, 0x48E70402, 0x48E80402, 0x48E90402, 0x48EA0402, 0x48EB0402, 0x48EC0402, 0x48ED0402, 0x48EE0402, 0x48EF0402, 0x48F00402, 0x48F10402, 0x48F20402, 0x48F30402, 0x48F40402,
+		0x48F50402, 0x48F60402, 0x48F70402, 0x48F80402, 0x48F90402, 0x48FA0402, 0x48FB0402, 0x48FC0402, 0x48FD0402, 0x48FE0402, 0x48FF0402, 0x49000402, 0x49010402, 0x49020402, 0x49030402, 0x49040402,
+		0x49050402, 0x49060402, 0x49070402, 0x49080402, 0x49090402, 0x490A0402, 0x490B0402, 0x490C0402, 0x490D0402, 0x490E0402, 0x490F0402, 0x49100402, 0x49110402, 0x49130402, 0x49140402, 0x49150402,
+		0x49160402, 0x49170402, 0x49180402, 0x49190402, 0x491A0402, 0x491B0402, 0x491C0402, 0x491D0402, 0x491E0402, 0x491F0402, 0x49200402, 0x49220402, 0x49220404, 0x49230402, 0x49240402, 0x49250402,
+		0x49260402, 0x49270402, 0x49280402, 0x49290402, 0x492A0402, 0x492C0402, 0x492D0402, 0x492F0402, 0x492F0404, 0x49300402, 0x49310402, 0x49320402, 0x49330402, 0x49340402, 0x49350402, 0x49360402,
+		0x49370402, 0x49380402, 0x49390402, 0x493A0402, 0x493B0402, 0x493C0402, 0x493D0402, 0x493E0402, 0x493F0402, 0x49400402, 0x49410402, 0x49410404, 0x49420402, 0x49430402, 0x49440402, 0x49450402,
+		0x49460402, 0x49470402, 0x49480402, 0x49490402, 0x494A0402, 0x494B0402, 0x494C0402, 0x494D0402, 0x494E0402, 0x494F0402, 0x49510402, 0x49530402, 0x49540402, 0x49550402, 0x49560402, 0x49570402,
+		0x49580402, 0x495A0402, 0x495B0402, 0x495C0402, 0x495D0402, 0x495D0404, 0x495E0402, 0x495F0402, 0x49600402, 0x49610402, 0x49620402, 0x49630402, 0x49640402, 0x49650402, 0x49660402, 0x49670402,
+		0x49690402, 0x496A0402, 0x496B0402, 0x496C0402, 0x496D0402, 0x496F0402, 0x49700402, 0x49710402, 0x49720402, 0x49740402, 0x49750402, 0x49760402, 0x49770402, 0x49790402, 0x497A0402, 0x497B0402,
+		0x497C0402, 0x497D0402, 0x497F0402, 0x49800402, 0x49810402, 0x49820402, 0x49830402, 0x49840402, 0x49850402, 0x49860402, 0x49890402, 0x498A0402, 0x498B0402, 0x498C0402, 0x498D0402, 0x498F0402,
+		0x49900402, 0x49930402, 0x49940402, 0x49950402, 0x49960402, 0x49970402, 0x49980402, 0x49990402, 0x499A0402, 0x499B0402, 0x499C0402, 0x499D0402, 0x499E0402, 0x499F0402, 0x49A00402, 0x49A10402,
+		0x49A20402, 0x49A30402, 0x49A40402, 0x49A50402, 0x49A60402, 0x49A70402, 0x49A80402, 0x49A90402, 0x49AA0402, 0x49AB0402, 0x49AC0402, 0x49AD0402, 0x49AE0402, 0x49AF0402, 0x49AF0408, 0x49B00402,
+		0x49B00408, 0x49B10402, 0x49B10408, 0x49B20402, 0x49B20408, 0x49B30402, 0x49B30408, 0x49B40402, 0x49B40408, 0x49B50402, 0x49B50408, 0x49B60402, 0x49B60408, 0x49B70402, 0x49B70408, 0x49B80402,
+		0x49B80408, 0x49B90402, 0x49B90408, 0x49BA0402, 0x49BA0408, 0x49BB0402, 0x49BB0408, 0x49BC0402, 0x49BC0408, 0x49BD0402, 0x49BD0408, 0x49BE0402, 0x49BE0408, 0x49BF0402, 0x49BF0408, 0x49C00402,
+		0x49C00408, 0x49C10402, 0x49C10408, 0x49C20402, 0x49C20408, 0x49C30402, 0x49C30408, 0x49C40402, 0x49C40408, 0x49C50402, 0x49C50408, 0x49C60402, 0x49C60408, 0x49C70402, 0x49C70408, 0x49C80402,
+		0x49C80408, 0x49C90402, 0x49C90408, 0x49CA0402, 0x49CA0408, 0x49CB0402, 0x49CB0408, 0x49CC0402, 0x49CC0408, 0x49CD0402, 0x49CD0408, 0x49CE0402, 0x49CE0408, 0x49CF0402, 0x49D00402, 0x49D10402,
+		0x49D20402, 0x49D30402, 0x49D40402, 0x49D50402, 0x49D60402, 0x49D70402, 0x49D80402, 0x49D90402, 0x49DA0402, 0x49DB0402, 0x49DC0402, 0x49DD0402, 0x49DE0402, 0x49DF0402, 0x49E00402, 0x49E10402,
+		0x49E20402, 0x49E30402, 0x49E40402, 0x49E50402, 0x49E60402, 0x49E70402, 0x49E80402, 0x49E90402, 0x49EA0402, 0x49EB0402, 0x49EC0402, 0x49ED0402, 0x49EE0402, 0x49EF0402, 0x49F00402, 0x49F10402,
+		0x49F20402, 0x49F30402, 0x49F40402, 0x49F50402, 0x49F60402, 0x49F70402, 0x49F80402, 0x49F90402, 0x49FA0402, 0x49FB0402, 0x49FC0402, 0x49FD0402, 0x49FE0402, 0x49FF0402, 0x4A000402, 0x4A010402,
+		0x4A020402, 0x4A030402, 0x4A040402, 0x4A050402, 0x4A060402, 0x4A070402, 0x4A080402, 0x4A090402, 0x4A0A0402, 0x4A0B0402, 0x4A0C0402, 0x4A0D0402, 0x4A0E0402, 0x4A0F0402, 0x4A100402, 0x4A110402,
+		0x4A120402, 0x4A130402, 0x4A140402, 0x4A150402, 0x4A160402, 0x4A170402, 0x4A180402, 0x4A190402, 0x4A1A0402, 0x4A1B0402, 0x4A1C0402, 0x4A1D0402, 0x4A1E0402, 0x4A1F0402, 0x4A200402, 0x4A210402,
+		0x4A220402, 0x4A230402, 0x4A240402, 0x4A250402, 0x4A260402, 0x4A270402, 0x4A280402, 0x4A290402, 0x4A2A0402, 0x4A2B0402, 0x4A2C0402, 0x4A2D0402, 0x4A2E0402, 0x4A2F0402, 0x4A300402, 0x4A310402,
+		0x4A320402, 0x4A330402, 0x4A340402, 0x4A350402, 0x4A360402, 0x4A370402, 0x4A380402, 0x4A390402, 0x4A3A0402, 0x4A3B0402, 0x4A3C0402, 0x4A3D0402, 0x4A3E0402, 0x4A3F0402, 0x4A400402, 0x4A410402,
+		0x4A420402, 0x4A430402, 0x4A440402, 0x4A450402, 0x4A460402, 0x4A470402, 0x4A480402, 0x4A490402, 0x4A4A0402, 0x4A4B0402, 0x4A4C0402, 0x4A4D0402, 0x4A4E0402, 0x4A4F0402, 0x4A500402, 0x4A510402,
+		0x4A520402, 0x4A530402, 0x4A540402, 0x4A550402, 0x4A560402, 0x4A570402, 0x4A580402, 0x4A590402, 0x4A5A0402, 0x4A5B0402, 0x4A5C0402, 0x4A5D0402, 0x4A5E0402, 0x4A5F0402, 0x4A600402, 0x4A610402,
+		0x4AC10402, 0x4AC20402, 0x4AC30402, 0x4AC40402, 0x4AC50402, 0x4AC60402, 0x4AC70402, 0x4AC80402, 0x4AC90402, 0x4ACA0402, 0x4ACB0402, 0x4ACC0402, 0x4ACD0402, 0x4ACE0402, 0x4ACF0402, 0x4AD00402,
+		0x4AD10402, 0x4AD20402, 0x4AD30402, 0x4AD40402, 0x4AD50402, 0x4AD60402, 0x4AD70402, 0x4AD80402, 0x4AD90402, 0x4ADA0402, 0x4ADB0402, 0x4ADC0402, 0x4ADD0402, 0x4ADE0402, 0x4ADF0402, 0x4AE00402,
+		0x4AE10402, 0x4AE20402, 0x4AE30402, 0x4AE40402, 0x4AE50402, 0x4AE60402, 0x4AE70402, 0x4AE80402, 0x4AE90402, 0x4AEA0402, 0x4AEB0402, 0x4AEC0402, 0x4AED0402, 0x4AEE0402, 0x4AEF0402, 0x4AF00402,
+		0x4AF10402, 0x4AF20402, 0x4AF30402, 0x4AF40402, 0x4AF50402, 0x4AF60402, 0x4AF70402, 0x4AF80402, 0x4AF90402, 0x4AFA0402, 0x4AFB0402, 0x4AFC0402, 0x4AFD0402, 0x4AFE0402, 0x4AFF0402, 0x4B000402,
+		0x4B010402, 0x4B020402, 0x4B030402, 0x4B040402, 0x4B050402, 0x4B060402, 0x4B070402, 0x4B080402, 0x4B090402, 0x4B0A0402, 0x4B0B0402, 0x4B0C0402, 0x4B0D0402, 0x4B0E0402, 0x4B0F0402, 0x4B100402,
+		0x4B110402, 0x4B120402, 0x4B130402, 0x4B140402, 0x4B150402, 0x4B160402, 0x4B170402, 0x4B180402, 0x4B190402, 0x4B1A0402, 0x4B1B0402, 0x4B1C0402, 0x4B1D0402, 0x4B1E0402, 0x4B1F0402, 0x4B200402,
+		0x4B210402, 0x4B220402, 0x4B230402, 0x4B240402, 0x4B250402, 0x4B260402, 0x4B270402, 0x4B280402, 0x4B290402, 0x4B2A0402, 0x4B2B0402, 0x4B2C0402, 0x4B2D0402, 0x4B2E0402, 0x4B2F0402, 0x4B300402,
+		0x4B310402, 0x4B320402, 0x4B330402, 0x4B340402, 0x4B350402, 0x4B360402, 0x4B370402, 0x4B380402, 0x4B390402, 0x4B3A0402, 0x4B3B0402, 0x4B3C0402, 0x4B3D0402, 0x4B3E0402, 0x4B3F0402, 0x4B400402,
+		0x4B410402, 0x4B420402, 0x4B430402, 0x4B440402, 0x4B450402, 0x4B460402, 0x4B470402, 0x4B480402, 0x4B4C0402, 0x4B4D0402, 0x4B4E0402, 0x4B4F0402, 0x4B500402, 0x4B510402, 0x4B520402, 0x4B530402,
+		0x4B540402, 0x4B550402, 0x4B560402, 0x4B570402, 0x4B580402, 0x4B590402, 0x4B5A0402, 0x4B5B0402, 0x4B5C0402, 0x4B5D0402, 0x4B5E0402, 0x4B5F0402, 0x4B600402, 0x4B610402, 0x4B620402, 0x4B630402,
+		0x4B640402, 0x4B650402, 0x4B660402, 0x4B670402, 0x4B670408, 0x4B680402, 0x4B680408, 0x4B690402, 0x4B690408, 0x4B6A0402, 0x4B6A0408, 0x4B6B0402, 0x4B6B0408, 0x4B6C0402, 0x4B6C0408, 0x4B6D0402,
+		0x4B6D0408, 0x4B6E0402, 0x4B6E0408, 0x4B6F0402, 0x4B6F0408, 0x4B700402, 0x4B700408, 0x4B710402, 0x4B710408, 0x4B720402, 0x4B720408, 0x4B730402, 0x4B730408, 0x4B740402, 0x4B740408, 0x4B750402,
+		0x4B750408, 0x4B760402, 0x4B760408, 0x4B770402, 0x4B770408, 0x4B780402, 0x4B780408, 0x4B790402, 0x4B790408, 0x4B7A0402, 0x4B7A0408, 0x4B7B0402, 0x4B7B0408, 0x4B7C0402, 0x4B7C0408, 0x4B7D0402,
+		0x4B7D0408, 0x4B7E0402, 0x4B7E0408, 0x4B7F0402, 0x4B7F0408, 0x4B800402, 0x4B800408, 0x4B810402, 0x4B810408, 0x4B820402, 0x4B820408, 0x4B830402, 0x4B830408, 0x4B840402, 0x4B840408, 0x4B850402,
+		0x4B850408, 0x4B860402, 0x4B860408, 0x4B870402, 0x4B870408, 0x4B880402, 0x4B880408, 0x4B890402, 0x4B890408, 0x4B8A0402, 0x4B8A0408, 0x4B8B0402, 0x4B8B0408, 0x4B8C0402, 0x4B8C0408, 0x4B8D0402,
+		0x4B8D0408, 0x4B8E0402, 0x4B8E0408, 0x4B8F0402, 0x4B900402, 0x4B910402, 0x4B920402, 0x4B930402, 0x4B940402, 0x4B950402, 0x4B960402, 0x4B970402, 0x4B980402, 0x4B990402, 0x4B9A0402, 0x4B9B0402,
+		0x4B9C0402, 0x4B9D0402, 0x4B9E0402, 0x4B9F0402, 0x4BA00402, 0x4BA10402, 0x4BA20402, 0x4BA30402, 0x4BA40402, 0x4BA50402, 0x4BA60402, 0x4BA70402, 0x4BA80402, 0x4BA90402, 0x4BAA0402, 0x4BAB0402,
+		0x4BAC0402, 0x4BAD0402, 0x4BAE0402, 0x4BAF0402, 0x4BB00402, 0x4BB10402, 0x4BB20402, 0x4BB30402, 0x4BB40402, 0x4BB50402, 0x4BB60402, 0x4BB70402, 0x4BB80402, 0x4BB90402, 0x4BBA0402, 0x4BBB0402,
+		0x4BBC0402, 0x4BBD0402, 0x4BBE0402, 0x4BBF0402, 0x4BC00402, 0x4BC10402, 0x4BC20402, 0x4BC30402, 0x4BC40402, 0x4BC50402, 0x4BC60402, 0x4BC70402, 0x4BC80402, 0x4BC90402, 0x4BCA0402, 0x4BCB0402,
+		0x4BCC0402, 0x4BCD0402, 0x4BCE0402, 0x4BCF0402, 0x4BD00402, 0x4BD10402, 0x4BD20402, 0x4BD30402, 0x4BD40402, 0x4BD50402, 0x4BD60402, 0x4BD70402, 0x4BD80402, 0x4BD90402, 0x4BDA0402, 0x4BDB0402,
+		0x4BDC0402, 0x4BDD0402, 0x4BDE0402, 0x4BDF0402, 0x4BE00402, 0x4BE10402, 0x4BE20402, 0x4BE30402, 0x4BE40402, 0x4BE50402, 0x4BE60402, 0x4BE70402, 0x4BE80402, 0x4BE90402, 0x4BEA0402, 0x4BEB0402,
+		0x4BEC0402, 0x4BED0402, 0x4BEE0402, 0x4BEF0402, 0x4BF00402, 0x4BF10402, 0x4BF20402, 0x4BF30402, 0x4BF40402, 0x4BF50402, 0x4BF60402, 0x4BF70402, 0x4BF80402, 0x4BF90402, 0x4BFA0402, 0x4BFB0402,
+		0x4BFC0402, 0x4BFD0402, 0x4BFE0402, 0x4BFF0402, 0x4C000402, 0x4C010402, 0x4C020402, 0x4C030402, 0x4C040402, 0x4C050402, 0x4C060402, 0x4C070402, 0x4C080402, 0x4C090402, 0x4C0A0402, 0x4C0B0402,
+		0x4C0C0402, 0x4C0D0402, 0x4C0E0402, 0x4C0F0402, 0x4C100402, 0x4C110402, 0x4C120402, 0x4C130402, 0x4C140402, 0x4C150402, 0x4C160402, 0x4C170402, 0x4C180402, 0x4C190402, 0x4C1A0402, 0x4C1B0402,
+		0x4C1C0402, 0x4C1D0402, 0x4C1E0402, 0x4C1F0402, 0x4C200402, 0x4C210402, 0x4C220402, 0x4C230402, 0x4C240402, 0x4C250402, 0x4C260402, 0x4C270402, 0x4C280402, 0x4C290402, 0x4C2A0402, 0x4C2B0402,
+		0x4C2C0402, 0x4C2D0402, 0x4C2E0402, 0x4C2F0402, 0x4C300402, 0x4C310402, 0x4C320402, 0x4C330402, 0x4C340402, 0x4C350402, 0x4C360402, 0x4C370402, 0x4C380402, 0x4C390402, 0x4C3A0402, 0x4C3B0402,
+		0x4C3C0402, 0x4C3D0402, 0x4C3E0402, 0x4C3F0402, 0x4C400402, 0x4C410402, 0x4C420402, 0x4C430402, 0x4C440402, 0x4C450402, 0x4C460402, 0x4C470402, 0x4C480402, 0x4C490402, 0x4C4A0402, 0x4C4B0402,
+		0x4C4C0402, 0x4C4D0402, 0x4C4E0402, 0x4C4F0402, 0x4C500402, 0x4C510402, 0x4C520402, 0x4C530402, 0x4C540402, 0x4C550402, 0x4C560402, 0x4C570402, 0x4C580402, 0x4C590402, 0x4C5A0402, 0x4C5B0402,
+		0x4C5C0402, 0x4C5D0402, 0x4C5E0402, 0x4C5F0402, 0x4C600402, 0x4C610402, 0x4C620402, 0x4C630402, 0x4C640402, 0x4C650402, 0x4C660402, 0x4C670402, 0x4C680402, 0x4C690402, 0x4C6A0402, 0x4C6B0402,
+		0x4C6C0402, 0x4C6D0402, 0x4C6E0402, 0x4C6F0402, 0x4C700402, 0x4C710402, 0x4C720402, 0x4C730402, 0x4C740402, 0x4C750402, 0x4C760402, 0x4C770402, 0x4C780402, 0x4C790402, 0x4C7A0402, 0x4C7B0402,
+		0x4C7C0402, 0x4C7D0402, 0x4C7E0402, 0x4C7F0402, 0x4C800402, 0x4C810402, 0x4C820402, 0x4C830402, 0x4C840402, 0x4C850402, 0x4C860402, 0x4C870402, 0x4C880402, 0x4C890402, 0x4C8A0402, 0x4C8B0402,
+		0x4C8C0402, 0x4C8D0402, 0x4C8E0402, 0x4C8F0402, 0x4C900402, 0x4C910402, 0x4C920402, 0x4C930402, 0x4C940402, 0x4C950402, 0x4C960402, 0x4C970402, 0x4C980402, 0x4C990402, 0x4C9A0402, 0x4C9B0402,
+		0x4C9C0402, 0x4C9D0402, 0x4C9E0402, 0x4C9F0402, 0x4CA00402, 0x4CA10402, 0x4CA20402, 0x4CA30402, 0x4CA40402, 0x4CA50402, 0x4CA60402, 0x4CA70402, 0x4CA80402, 0x4CA90402, 0x4CAA0402, 0x4CAB0402,
+		0x4CAC0402, 0x4CAD0402, 0x4CAE0402, 0x4CAF0402, 0x4CB00402, 0x4CB10402, 0x4CB20402, 0x4CB30402, 0x4CB40402, 0x4CB50402, 0x4CB60402, 0x4CB70402, 0x4CB80402, 0x4CB90402, 0x4CBA0402, 0x4CBB0402,
+		0x4CBC0402, 0x4CBD0402, 0x4CBE0402, 0x4CBF0402, 0x4CC00402, 0x4CC10402, 0x4CC20402, 0x4CC30402, 0x4CC40402, 0x4CC50402, 0x4CC60402, 0x4CC70402, 0x4CC80402, 0x4CC90402, 0x4CCA0402, 0x4CCB0402,
+		0x4CCC0402, 0x4CCD0402, 0x4CCE0402, 0x4CCF0402, 0x4CD00402, 0x4CD10402, 0x4CD20402, 0x4CD30402, 0x4CD40402, 0x4CD50402, 0x4CD60402, 0x4CD70402, 0x4CD80402, 0x4CD90402, 0x4CDA0402, 0x4CDB0402,
+		0x4CDC0402, 0x4CDD0402, 0x4CDE0402, 0x4CDF0402, 0x4CE00402, 0x4CE10402, 0x4CE20402, 0x4CE30402, 0x4CE40402, 0x4CE50402, 0x4CE60402, 0x4CE70402, 0x4CE80402, 0x4CE90402, 0x4CEA0402, 0x4CEB0402,
+		0x4CEC0402, 0x4CED0402, 0x4CEE0402, 0x4CEF0402, 0x4CF00402, 0x4CF10402, 0x4CF20402, 0x4CF30402, 0x4CF40402, 0x4CF50402, 0x4CF60402, 0x4CF70402, 0x4CF80402, 0x4CF90402, 0x4CFA0402, 0x4CFB0402,
+		0x4CFC0402, 0x4CFD0402, 0x4CFE0402, 0x4CFF0402, 0x4D000402, 0x4D010402, 0x4D020402, 0x4D030402, 0x4D040402, 0x4D050402, 0x4D060402, 0x4D070402, 0x4D080402, 0x4D090402, 0x4D0A0402, 0x4D0B0402,
+		0x4D0C0402, 0x4D0D0402, 0x4D0E0402, 0x4D0F0402, 0x4D100402, 0x4D110402, 0x4D120402, 0x4D130402, 0x4D140402, 0x4D150402, 0x4D160402, 0x4D170402, 0x4D180402, 0x4D190402, 0x4D1A0402, 0x4D1B0402,
+		0x4D1C0402, 0x4D1D0402, 0x4D1E0402, 0x4D1F0402, 0x4D200402, 0x4D210402, 0x4D220402, 0x4D230402, 0x4D240402, 0x4D250402, 0x4D260402, 0x4D270402, 0x4D280402, 0x4D290402, 0x4D2A0402, 0x4D2B0402,
+		0x4D2C0402, 0x4D2D0402, 0x4D2E0402, 0x4D2F0402, 0x4D300402, 0x4D310402, 0x4D320402, 0x4D330402, 0x4D340402, 0x4D350402, 0x4D360402, 0x4D370402, 0x4D380402, 0x4D390402, 0x4D3A0402, 0x4D3B0402,
+		0x4D3C0402, 0x4D3D0402, 0x4D3E0402, 0x4D3F0402, 0x4D400402, 0x4D410402, 0x4D420402, 0x4D430402, 0x4D440402, 0x4D450402, 0x4D460402, 0x4D470402, 0x4D480402, 0x4D490402, 0x4D4A0402, 0x4D4B0402,
+		0x4D4C0402, 0x4D4D0402, 0x4D4E0402, 0x4D4F0402, 0x4D500402, 0x4D510402, 0x4D520402, 0x4D530402, 0x4D540402, 0x4D550402, 0x4D560402, 0x4D570402, 0x4D580402, 0x4D590402, 0x4D5A0402, 0x4D5B0402,
+		0x4D5C0402, 0x4D5D0402, 0x4D5E0402, 0x4D5F0402, 0x4D600402, 0x4D610402, 0x4D620402, 0x4D630402, 0x4D640402, 0x4D650402, 0x4D660402, 0x4D670402, 0x4D680402, 0x4D690402, 0x4D6A0402, 0x4D6B0402,
+		0x4D6C0402, 0x4D6D0402, 0x4D6E0402, 0x4D6F0402, 0x4D700402, 0x4D710402, 0x4D720402, 0x4D730402, 0x4D740402, 0x4D750402, 0x4D760402, 0x4D770402, 0x4D780402, 0x4D790402, 0x4D7A0402, 0x4D7B0402,
+		0x4D7C0402, 0x4D7D0402, 0x4D7E0402, 0x4D7F0402, 0x4D800402, 0x4D810402, 0x4D820402, 0x4D830402, 0x4D840402, 0x4D850402, 0x4D860402, 0x4D870402, 0x4D880402, 0x4D890402, 0x4D8A0402, 0x4D8B0402,
+		0x4D8C0402, 0x4D8D0402, 0x4D8E0402, 0x4D8F0402, 0x4D900402, 0x4D910402, 0x4D920402, 0x4D930402, 0x4D940402, 0x4D950402, 0x4D960402, 0x4D970402, 0x4D980402, 0x4D990402, 0x4D9A0402, 0x4D9B0402,
+		0x4D9C0402, 0x4D9D0402, 0x4D9E0402, 0x4D9F0402, 0x4DA00402, 0x4DA10402, 0x4DA20402, 0x4DA30402, 0x4DA40402, 0x4DA50402, 0x4DA60402, 0x4DA70402, 0x4DA80402, 0x4DA90402, 0x4DAA0402, 0x4DAB0402,
+		0x4DAC0402, 0x4DAD0402, 0x4DAE0402, 0x4DAF0402, 0x4DB00402, 0x4DB10402, 0x4DB20402, 0x4DB30402, 0x4DB40402, 0x4DB50402, 0x4DB60402, 0x4DB70402, 0x4DB80402, 0x4DB90402, 0x4DBA0402, 0x4DBB0402,
+		0x4DBC0402, 0x4DBD0402, 0x4DBE0402, 0x4DBF0402, 0x4DC00402, 0x4DC10402, 0x4DC20402, 0x4DC30402, 0x4DC40402, 0x4DC50402, 0x4DC60402, 0x4DC70402, 0x4DC80402, 0x4DC90402, 0x4DCA0402, 0x4DCB0402,
+		0x4DCC0402, 0x4DCD0402, 0x4DCE0402, 0x4DCF0402, 0x4DD00402, 0x4DD10402, 0x4DD20402, 0x4DD30402, 0x4DD40402, 0x4DD50402, 0x4DD60402, 0x4DD70402, 0x4DD80402, 0x4DD90402, 0x4DDA0402, 0x4DDB0402,
+		0x4DDC0402, 0x4DDD0402, 0x4DDE0402, 0x4DDF0402, 0x4DE00402, 0x4DE10402, 0x4DE20402, 0x4DE30402, 0x4DE40402, 0x4DE50402, 0x4DE60402, 0x4DE70402, 0x4DE80402, 0x4DE90402, 0x4DEA0402, 0x4DEB0402,
+		0x4DEC0402, 0x4DED0402, 0x4DEE0402, 0x4DEF0402, 0x4DF00402, 0x4DF10402, 0x4DF20402, 0x4DF30402, 0x4DF40402, 0x4DF50402, 0x4DF60402, 0x4DF70402, 0x4DF80402, 0x4DF90402, 0x4DFA0402, 0x4DFB0402,
+		0x4DFC0402, 0x4DFD0402, 0x4DFE0402, 0x4DFF0402, 0x4E000402, 0x4E010402, 0x4E020402, 0x4E030402, 0x4E040402, 0x4E050402, 0x4E060402, 0x4E070402, 0x4E080402, 0x4E090402, 0x4E0A0402, 0x4E0B0402,
+		0x4E0C0402, 0x4E0D0402, 0x4E0E0402, 0x4E0F0402, 0x4E100402, 0x4E110402, 0x4E120402, 0x4E130402, 0x4E140402, 0x4E150402, 0x4E160402, 0x4E170402, 0x4E180402, 0x4E190402, 0x4E1A0402, 0x4E1B0402,
+		0x4E1C0402, 0x4E1D0402, 0x4E1E0402, 0x4E1F0402, 0x4E200402, 0x4E210402, 0x4E220402, 0x4E230402, 0x4E240402, 0x4E250402, 0x4E260402, 0x4E270402, 0x4E280402, 0x4E290402, 0x4E2A0402, 0x4E2B0402,
+		0x4E2C0402, 0x4E2D0402, 0x4E2E0402, 0x4E2F0402, 0x4E300402, 0x4E310402, 0x4E320402, 0x4E330402, 0x4E340402, 0x4E350402, 0x4E360402, 0x4E370402, 0x4E380402, 0x4E390402, 0x4E3A0402, 0x4E3B0402,
+		0x4E3C0402, 0x4E3D0402, 0x4E3E0402, 0x4E3F0402, 0x4E400402, 0x4E410402, 0x4E420402, 0x4E430402, 0x4E440402, 0x4E450402, 0x4E460402, 0x4E470402, 0x4E480402, 0x4E490402, 0x4E4A0402, 0x4E4B0402,
+		0x4E4C0402, 0x4E4D0402, 0x4E4E0402, 0x4E4F0402, 0x4E500402, 0x4E510402, 0x4E520402, 0x4E530402, 0x4E540402, 0x4E550402, 0x4E560402, 0x4E570402, 0x4E580402, 0x4E590402, 0x4E5A0402, 0x4E5B0402,
+		0x4E5C0402, 0x4E5D0402, 0x4E5E0402, 0x4E5F0402, 0x4E600402, 0x4E610402, 0x4E620402, 0x4E630402, 0x4E640402, 0x4E650402, 0x4E660402, 0x4E670402, 0x4E680402, 0x4E690402, 0x4E6A0402, 0x4E6B0402,
+		0x4E6C0402, 0x4E6D0402, 0x4E6E0402, 0x4E6F0402, 0x4E700402, 0x4E710402, 0x4E720402, 0x4E730402, 0x4E740402, 0x4E750402, 0x4E760402, 0x4E770402, 0x4E780402, 0x4E790402, 0x4E7A0402, 0x4E7B0402,
+		0x4E7C0402, 0x4E7D0402, 0x4E7E0402, 0x4E7F0402, 0x4E800402, 0x4E810402, 0x4E820402, 0x4E830402, 0x4E840402, 0x4E850402, 0x4E860402, 0x4E870402, 0x4E880402, 0x4E890402, 0x4E8A0402, 0x4E8B0402,
+		0x4E8C0402, 0x4E8D0402, 0x4E8E0402, 0x4E8F0402, 0x4E900402, 0x4E910402, 0x4E920402, 0x4E930402, 0x4E940402, 0x4E950402, 0x4E960402, 0x4E970402, 0x4E980402, 0x4E990402, 0x4E9A0402, 0x4E9B0402,
+		0x4E9C0402, 0x4E9D0402, 0x4E9E0402, 0x4E9F0402, 0x4EA00402, 0x4EA10402, 0x4EA20402, 0x4EA30402, 0x4EA40402, 0x4EA50402, 0x4EA60402, 0x4EA70402, 0x4EA80402, 0x4EA90402, 0x4EAA0402, 0x4EAB0402,
+		0x4EAC0402, 0x4EAD0402, 0x4EAE0402, 0x4EAF0402, 0x4EB00402, 0x4EB10402, 0x4EB20402, 0x4EB30402, 0x4EB40402, 0x4EB50402, 0x4EB60402, 0x4EB70402, 0x4EB80402, 0x4EB90402, 0x4EBA0402, 0x4EBB0402,
+		0x4EBC0402, 0x4EBD0402, 0x4EBE0402, 0x4EBF0402, 0x4EC00402, 0x4EC10402, 0x4EC20402, 0x4EC30402, 0x4EC40402, 0x4EC50402, 0x4EC60402, 0x4EC70402, 0x4EC80402, 0x4EC90402, 0x4ECA0402, 0x4ECB0402,
+		0x4ECC0402, 0x4ECD0402, 0x4ECE0402, 0x4ECF0402, 0x4ED00402, 0x4ED10402, 0x4ED20402, 0x4ED30402, 0x4ED40402, 0x4ED50402, 0x4ED60402, 0x4ED70402, 0x4ED80402, 0x4ED90402, 0x4EDA0402, 0x4EDB0402,
+		0x4EDC0402, 0x4EDD0402, 0x4EDE0402, 0x4EDF0402, 0x4EE00402, 0x4EE10402, 0x4EE20402, 0x4EE30402, 0x4EE40402, 0x4EE50402, 0x4EE60402, 0x4EE70402, 0x4EE80402, 0x4EE90402, 0x4EEA0402, 0x4EEB0402,
+		0x4EEC0402, 0x4EED0402, 0x4EEE0402, 0x4EEF0402, 0x4EF00402, 0x4EF10402, 0x4EF20402, 0x4EF30402, 0x4EF40402, 0x4EF50402, 0x4EF60402, 0x4EF70402, 0x4EF80402, 0x4EF90402, 0x4EFA0402, 0x4EFB0402,
+		0x4EFC0402, 0x4EFD0402, 0x4EFE0402, 0x4EFF0402, 0x4F000402, 0x4F010402, 0x4F020402, 0x4F030402, 0x4F040402, 0x4F050402, 0x4F060402, 0x4F070402, 0x4F080402, 0x4F090402, 0x4F0A0402, 0x4F0B0402,
+		0x4F0C0402, 0x4F0D0402, 0x4F0E0402, 0x4F0F0402, 0x4F100402, 0x4F110402, 0x4F120402, 0x4F130402, 0x4F140402, 0x4F150402, 0x4F160402, 0x4F170402, 0x4F180402, 0x4F190402, 0x4F1A0402, 0x4F1B0402,
+		0x4F1C0402, 0x4F1D0402, 0x4F1E0402, 0x4F1F0402, 0x4F200402, 0x4F210402, 0x4F220402, 0x4F230402, 0x4F240402, 0x4F250402, 0x4F260402, 0x4F270402, 0x4F280402, 0x4F290402, 0x4F2A0402, 0x4F2B0402,
+		0x4F2C0402, 0x4F2D0402, 0x4F2E0402, 0x4F2F0402, 0x4F300402, 0x4F310402, 0x4F320402, 0x4F330402, 0x4F340402, 0x4F350402, 0x4F360402, 0x4F370402, 0x4F380402, 0x4F390402, 0x4F3A0402, 0x4F3B0402,
+		0x4F3C0402, 0x4F3D0402, 0x4F3E0402, 0x4F3F0402, 0x4F400402, 0x4F410402, 0x4F420402, 0x4F430402, 0x4F440402, 0x4F450402, 0x4F460402, 0x4F470402, 0x4F480402, 0x4F490402, 0x4F4A0402, 0x4F4B0402,
+		0x4F4C0402, 0x4F4D0402, 0x4F4E0402, 0x4F4F0402, 0x4F500402, 0x4F510402, 0x4F520402, 0x4F530402, 0x4F540402, 0x4F550402, 0x4F560402, 0x4F570402, 0x4F580402, 0x4F590402, 0x4F5A0402, 0x4F5B0402,
+		0x4F5C0402, 0x4F5D0402, 0x4F5E0402, 0x4F5F0402, 0x4F600402, 0x4F610402, 0x4F620402, 0x4F630402, 0x4F640402, 0x4F650402, 0x4F660402, 0x4F670402, 0x4F680402, 0x4F690402, 0x4F6A0402, 0x4F6B0402,
+		0x4F6C0402, 0x4F6D0402, 0x4F6E0402, 0x4F6F0402, 0x4F700402, 0x4F710402, 0x4F720402, 0x4F730402, 0x4F740402, 0x4F750402, 0x4F760402, 0x4F770402, 0x4F780402, 0x4F790402, 0x4F7A0402, 0x4F7B0402,
+		0x4F7C0402, 0x4F7D0402, 0x4F7E0402, 0x4F7F0402, 0x4F800402, 0x4F810402, 0x4F820402, 0x4F830402, 0x4F840402, 0x4F850402, 0x4F860402, 0x4F870402, 0x4F880402, 0x4F890402, 0x4F8A0402, 0x4F8B0402,
+		0x4F8C0402, 0x4F8D0402, 0x4F8E0402, 0x4F8F0402, 0x4F900402, 0x4F910402, 0x4F920402, 0x4F930402, 0x4F940402, 0x4F950402, 0x4F960402, 0x4F970402, 0x4F980402, 0x4F990402, 0x4F9A0402, 0x4F9B0402,
+		0x4F9C0402, 0x4F9D0402, 0x4F9E0402, 0x4F9F0402, 0x4FA00402, 0x4FA10402, 0x4FA20402, 0x4FA30402, 0x4FA40402, 0x4FA50402, 0x4FA60402, 0x4FA70402, 0x4FA80402, 0x4FA90402, 0x4FAA0402, 0x4FAB0402,
+		0x4FAC0402, 0x4FAD0402, 0x4FAE0402, 0x4FAF0402, 0x4FB00402, 0x4FB10402, 0x4FB20402, 0x4FB30402, 0x4FB40402, 0x4FB50402, 0x4FB60402, 0x4FB70402, 0x4FB80402, 0x4FB90402, 0x4FBA0402, 0x4FBB0402,
+		0x4FBC0402, 0x4FBD0402, 0x4FBE0402, 0x4FBF0402, 0x4FC00402, 0x4FC10402, 0x4FC20402, 0x4FC20404, 0x2304, 0x4FC30402, 0x4FC40402, 0x4FC50402, 0x4FC60402, 0x4FC70402, 0x4FC80402, 0x4FC90402,
+		0x4FCA0402, 0x4FCB0402, 0x4FCC0402, 0x4FCD0402, 0x4FCE0402, 0x4FCF0402, 0x4FD00402, 0x4FD10402, 0x4FD20402, 0x4FD30402, 0x4FD40402, 0x4FD50402, 0x4FD60402, 0x4FD70402, 0x4FD70419, 0x4FD80402,
+		0x4FD90402, 0x4FDA0402, 0x4FDB0402, 0x4FDC0402, 0x4FDD0402, 0x4FDE0402, 0x4FDF0402, 0x4FE00402, 0x4FE00419, 0x4FE10402, 0x4FE10419, 0x4FE20402, 0x4FE30402, 0x4FE40402, 0x4FE40419, 0x4FE50402,
+		0x4FE60402, 0x4FE70402, 0x4FE80402, 0x4FE90402, 0x4FE90419, 0x4FEA0402, 0x4FEA0419, 0x4FEB0402, 0x4FEB0419, 0x4FEC0402, 0x4FEC0419, 0x4FED0402, 0x4FED0419, 0x4FEE0402, 0x4FEF0402, 0x4FF00402,
+		0x4FF10402, 0x4FF20402, 0x4FF30402, 0x4FF40402, 0x4FF40419, 0x4FF50402, 0x4FF60402, 0x4FF70402, 0x4FF80402, 0x4FF90402, 0x4FFA0402, 0x4FFB0402, 0x4FFC0402, 0x4FFD0402, 0x4FFE0402, 0x4FFF0402,
+		0x50000402, 0x50010402, 0x50020402, 0x50030402, 0x50040402, 0x50050402, 0x50060402, 0x50070402, 0x50080402, 0x50090402, 0x500A0402, 0x500B0402, 0x500C0402, 0x500D0402, 0x500E0402, 0x500F0402,
+		0x50100402, 0x50110402, 0x50120402, 0x50130402, 0x50140402, 0x50150402, 0x50160402, 0x50170402, 0x50180402, 0x50190402, 0x501A0402, 0x501B0402, 0x501C0402, 0x501D0402, 0x501E0402, 0x501F0402,
+		0x50200402, 0x50210402, 0x50220402, 0x50230402, 0x50240402, 0x50250402, 0x50260402, 0x50270402, 0x50280402, 0x50290402, 0x502A0402, 0x502B0402, 0x502C0402, 0x502D0402, 0x502E0402, 0x502F0402,
+		0x50300402, 0x50310402, 0x50320402, 0x50330402, 0x50340402, 0x50350402, 0x50360402, 0x50370402, 0x50380402, 0x50390402, 0x503A0402, 0x503B0402, 0x503C0402, 0x503D0402, 0x503E0402, 0x503F0402,
+		0x50400402, 0x50410402, 0x50420402, 0x50430402, 0x50440402, 0x50450402, 0x50460402, 0x50470402, 0x50480402, 0x50490402, 0x504A0402, 0x504B0402, 0x504C0402, 0x504D0402, 0x504E0402, 0x504F0402,
+		0x50500402, 0x50510402, 0x50520402, 0x50530402, 0x50540402, 0x50550402, 0x50560402, 0x50570402, 0x50580402, 0x50590402, 0x505A0402, 0x505B0402, 0x50720402, 0x50730402, 0x50740402, 0x50750402,
+		0x50760402, 0x50770402, 0x50780402, 0x50790402, 0x50790404, 0x2324, 0x507A0402, 0x507B0402, 0x507C0402, 0x507D0402, 0x507E0402, 0x507F0402, 0x50800402, 0x50810402, 0x50820402, 0x50830402,
+		0x50840402, 0x50850402, 0x50860402, 0x50870402, 0x50880402, 0x50890402, 0x508A0402, 0x508B0402, 0x508C0402, 0x508D0402, 0x508E0402, 0x508F0402, 0x50900402, 0x50910402, 0x50920402, 0x50930402,
+		0x50940402, 0x50950402, 0x50FF0402, 0x51000402, 0x51010402, 0x51020402, 0x51030402, 0x51040402, 0x51050402, 0x51060402, 0x51070402, 0x51080402, 0x51090402, 0x510A0402, 0x510B0402, 0x510C0402,
+		0x510D0402, 0x510E0402, 0x510F0402, 0x51100402, 0x51110402, 0x51120402, 0x51130402, 0x51140402, 0x51150402, 0x51160402, 0x51170402, 0x51180402, 0x51190402, 0x511A0402, 0x511B0402, 0x511C0402,
+		0x511D0402, 0x511E0402, 0x511F0402, 0x51200402, 0x51210402, 0x51220402, 0x51230402, 0x51240402, 0x51250402, 0x51260402, 0x51270402, 0x51280402, 0x51290402, 0x512A0402, 0x512B0402, 0x512C0402,
+		0x512D0402, 0x512E0402, 0x512F0402, 0x51300402, 0x51310402, 0x51320402, 0x51330402, 0x51340402, 0x51350402, 0x51360402, 0x51370402, 0x51380402, 0x51390402, 0x513A0402, 0x513B0402, 0x513C0402,
+		0x513D0402, 0x513E0402, 0x513F0402, 0x51400402, 0x51410402, 0x51420402, 0x51430402, 0x51440402, 0x51450402, 0x51460402, 0x51470402, 0x51480402, 0x51490402, 0x514A0402, 0x514B0402, 0x514C0402,
+		0x514D0402, 0x514E0402, 0x514F0402, 0x51500402, 0x51510402, 0x51520402, 0x51530402, 0x51540402, 0x51550402, 0x51560402, 0x51570402, 0x51580402, 0x51590402, 0x515A0402, 0x515B0402, 0x515C0402,
+		0x515D0402, 0x515E0402, 0x515F0402, 0x51600402, 0x51610402, 0x51620402, 0x51630402, 0x51640402, 0x51650402, 0x51660402, 0x51670402, 0x51680402, 0x51690402, 0x516A0402, 0x516B0402, 0x516C0402,
+		0x516D0402, 0x516E0402, 0x516F0402, 0x51700402, 0x51710402, 0x51720402, 0x51730402, 0x51740402, 0x51750402, 0x51760402, 0x51770402, 0x51780402, 0x51790402, 0x517A0402, 0x517B0402, 0x517C0402,
+		0x517D0402, 0x517E0402, 0x517F0402, 0x51800402, 0x51810402, 0x51820402, 0x51830402, 0x51840402, 0x51850402, 0x51860402, 0x51870402, 0x51880402, 0x51890402, 0x518A0402, 0x518B0402, 0x518C0402,
+		0x518D0402, 0x518E0402, 0x518F0402, 0x51900402, 0x51910402, 0x51920402, 0x51930402, 0x51940402, 0x51950402, 0x51960402, 0x51970402, 0x51980402, 0x51990402, 0x519A0402, 0x519B0402, 0x519C0402,
+		0x519D0402, 0x519E0402, 0x519F0402, 0x51A00402, 0x51A10402, 0x51A20402, 0x51A30402, 0x51A40402, 0x51A50402, 0x51A60402, 0x51A70402, 0x51A80402, 0x51A90402, 0x51AA0402, 0x51AB0402, 0x51AC0402,
+		0x51AD0402, 0x51AE0402, 0x51AF0402, 0x51B00402, 0x51B10402, 0x51B20402, 0x51B30402, 0x51B40402, 0x51B50402, 0x51B60402, 0x51B70402, 0x51B80402, 0x51B90402, 0x51BA0402, 0x51BB0402, 0x51BC0402,
+		0x51BD0402, 0x51BE0402, 0x51BF0402, 0x51C00402, 0x51C10402, 0x51C20402, 0x51C30402, 0x51C40402, 0x51C50402, 0x51C60402, 0x51C70402, 0x51C80402, 0x51C90402, 0x51CA0402, 0x51CB0402, 0x51CC0402,
+		0x51CD0402, 0x51CE0402, 0x51CF0402, 0x51D00402, 0x51D10402, 0x51D20402, 0x51D30402, 0x51D40402, 0x51D50402, 0x51D60402, 0x51D70402, 0x51D80402, 0x51D90402, 0x51DA0402, 0x51DB0402, 0x51DC0402,
+		0x51DD0402, 0x51DE0402, 0x51DF0402, 0x51E00402, 0x51E10402, 0x51E20402, 0x51E30402, 0x51E40402, 0x51E50402, 0x51E60402, 0x51E70402, 0x51E80402, 0x51E90402, 0x51EA0402, 0x51EB0402, 0x51EC0402,
+		0x51ED0402, 0x51EE0402, 0x51EF0402, 0x51F00402, 0x51F10402, 0x51F20402, 0x51F30402, 0x51F40402, 0x51F50402, 0x51F60402, 0x51F70402, 0x51F80402, 0x51F90402, 0x51FA0402, 0x51FB0402, 0x51FC0402,
+		0x51FD0402, 0x51FE0402, 0x51FF0402, 0x52000402, 0x52010402, 0x52020402, 0x52030402, 0x52040402, 0x52050402, 0x52060402, 0x52070402, 0x52080402, 0x52090402, 0x520A0402, 0x520B0402, 0x520C0402,
+		0x520D0402, 0x520E0402, 0x520F0402, 0x52100402, 0x52110402, 0x52120402, 0x52130402, 0x52140402, 0x52150402, 0x52160402, 0x52170402, 0x52180402, 0x52190402, 0x521A0402, 0x521B0402, 0x521C0402,
+		0x521D0402, 0x521E0402, 0x521F0402, 0x52200402, 0x52210402, 0x52220402, 0x52230402, 0x52240402, 0x52250402, 0x52260402, 0x52270402, 0x52280402, 0x52290402, 0x522A0402, 0x522B0402, 0x522C0402,
+		0x522D0402, 0x522E0402, 0x522F0402, 0x52300402, 0x52310402, 0x52320402, 0x52330402, 0x52340402, 0x52350402, 0x52360402, 0x52370402, 0x52380402, 0x52390402, 0x523A0402, 0x523B0402, 0x523C0402,
+		0x523D0402, 0x523E0402, 0x523F0402, 0x52400402, 0x52410402, 0x52420402, 0x52430402, 0x52440402, 0x52450402, 0x52460402, 0x52470402, 0x52480402, 0x52490402, 0x524A0402, 0x524B0402, 0x524C0402,
+		0x524D0402, 0x524E0402, 0x524F0402, 0x52500402, 0x52510402, 0x52520402, 0x52530402, 0x52540402, 0x52550402, 0x52560402, 0x52570402, 0x52580402, 0x52590402, 0x525A0402, 0x525B0402, 0x525C0402,
+		0x525D0402, 0x525E0402, 0x525F0402, 0x52600402, 0x52610402, 0x52620402, 0x52630402, 0x52640402, 0x52650402, 0x52660402, 0x52670402, 0x52680402, 0x52690402, 0x526A0402, 0x526B0402, 0x526C0402,
+		0x526D0402, 0x526E0402, 0x526F0402, 0x52700402, 0x52710402, 0x52720402, 0x52730402, 0x52740402, 0x52750402, 0x52760402, 0x52770402, 0x52780402, 0x52790402, 0x527A0402, 0x527B0402, 0x527C0402,
+		0x527D0402, 0x527E0402, 0x527F0402, 0x52800402, 0x52810402, 0x52820402, 0x52830402, 0x52840402, 0x52850402, 0x52860402, 0x52870402, 0x52880402, 0x52890402, 0x528A0402, 0x528B0402, 0x528C0402,
+		0x528D0402, 0x528E0402, 0x528F0402, 0x52900402, 0x52910402, 0x52920402, 0x52930402, 0x52940402, 0x52950402, 0x52960402, 0x52970402, 0x52980402, 0x52990402, 0x529A0402, 0x529B0402, 0x529C0402,
+		0x529D0402, 0x529E0402, 0x529F0402, 0x52A00402, 0x52A10402, 0x52A20402, 0x52A30402, 0x52A40402, 0x52A50402, 0x52A60402, 0x52A70402, 0x52A80402, 0x52A90402, 0x52AA0402, 0x52AB0402, 0x52AC0402,
+		0x52AD0402, 0x52AE0402, 0x52AF0402, 0x52B00402, 0x52B10402, 0x52B20402, 0x52B30402, 0x52B40402, 0x52B50402, 0x52B60402, 0x52B70402, 0x52B80402, 0x52B90402, 0x52BA0402, 0x52BB0402, 0x52BC0402,
+		0x52BD0402, 0x52BE0402, 0x52BF0402, 0x52C00402, 0x52C10402, 0x52C20402, 0x52C30402, 0x52C40402, 0x52C50402, 0x52C60402, 0x52C70402, 0x52C80402, 0x52C90402, 0x52CA0402, 0x52CB0402, 0x52CC0402,
+		0x52CD0402, 0x52CE0402, 0x52CF0402, 0x52D00402, 0x52D10402, 0x52D20402, 0x52D30402, 0x52D40402, 0x52D50402, 0x52D60402, 0x52D70402, 0x52D80402, 0x52D90402, 0x52DA0402, 0x52DB0402, 0x52DC0402,
+		0x52DD0402, 0x52DE0402, 0x52DF0402, 0x52E00402, 0x52E10402, 0x52E20402, 0x52E30402, 0x52E40402, 0x52E50402, 0x52E60402, 0x52E70402, 0x52E80402, 0x52E90402, 0x52EA0402, 0x52EB0402, 0x52EC0402,
+		0x52ED0402, 0x52EE0402, 0x52EF0402, 0x52F00402, 0x52F10402, 0x52F20402, 0x52F30402, 0x52F40402, 0x52F50402, 0x52F60402, 0x52F70402, 0x52F80402, 0x52F90402, 0x52FA0402, 0x52FB0402, 0x52FC0402,
+		0x52FD0402, 0x52FE0402, 0x52FF0402, 0x53000402, 0x53010402, 0x53020402, 0x53030402, 0x53040402, 0x53050402, 0x53060402, 0x53070402, 0x53080402, 0x53090402, 0x530A0402, 0x530B0402, 0x530C0402,
+		0x530D0402, 0x530E0402, 0x530F0402, 0x53100402, 0x53110402, 0x53120402, 0x53130402, 0x53140402, 0x53150402, 0x53160402, 0x53170402, 0x53180402, 0x53190402, 0x531A0402, 0x531B0402, 0x531C0402,
+		0x531D0402, 0x531E0402, 0x531F0402, 0x53200402, 0x53210402, 0x53220402, 0x53230402, 0x53240402, 0x53250402, 0x53260402, 0x53270402, 0x53280402, 0x53290402, 0x532A0402, 0x532B0402, 0x532C0402,
+		0x532D0402, 0x532E0402, 0x532F0402, 0x53300402, 0x53310402, 0x53320402, 0x53330402, 0x53340402, 0x53350402, 0x53360402, 0x53370402, 0x53380402, 0x53390402, 0x533A0402, 0x533B0402, 0x533C0402,
+		0x533D0402, 0x533E0402, 0x533F0402, 0x53400402, 0x53410402, 0x53420402, 0x53430402, 0x53440402, 0x53450402, 0x53460402, 0x53470402, 0x53480402, 0x53490402, 0x534A0402, 0x534B0402, 0x534C0402,
+		0x534D0402, 0x534E0402, 0x534F0402, 0x53500402, 0x53510402, 0x53520402, 0x53530402, 0x53540402, 0x53550402, 0x53560402, 0x53570402, 0x53580402, 0x53590402, 0x535A0402, 0x535B0402, 0x535C0402,
+		0x535D0402, 0x535E0402, 0x535F0402, 0x53600402, 0x53610402, 0x53620402, 0x53630402, 0x53640402, 0x53650402, 0x53660402, 0x53670402, 0x53680402, 0x53690402, 0x536A0402, 0x536B0402, 0x536C0402,
+		0x536D0402, 0x536E0402, 0x536F0402, 0x53700402, 0x53710402, 0x53720402, 0x53730402, 0x53740402, 0x53750402, 0x53760402, 0x53770402, 0x53780402, 0x53790402, 0x537A0402, 0x537B0402, 0x537C0402,
+		0x537D0402, 0x537E0402, 0x537F0402, 0x53800402, 0x53810402, 0x53820402, 0x53830402, 0x53840402, 0x53850402, 0x53860402, 0x53870402, 0x53880402, 0x53890402, 0x538A0402, 0x538B0402, 0x538C0402,
+		0x538D0402, 0x538E0402, 0x538F0402, 0x53900402, 0x53910402, 0x53920402, 0x53930402, 0x53940402, 0x53950402, 0x53960402, 0x53970402, 0x53980402, 0x53990402, 0x539A0402, 0x539B0402, 0x539C0402,
+		0x539D0402, 0x539E0402, 0x539F0402, 0x53A00402, 0x53A10402, 0x53A20402, 0x53A30402, 0x53A40402, 0x53A50402, 0x53A60402, 0x53A70402, 0x53A80402, 0x53A90402, 0x53AA0402, 0x53AB0402, 0x53AC0402,
+		0x53AD0402, 0x53AE0402, 0x53AF0402, 0x53B00402, 0x53B10402, 0x53B20402, 0x53B30402, 0x53B40402, 0x53B50402, 0x53B60402, 0x53B70402, 0x53B80402, 0x53B90402, 0x53BA0402, 0x53BB0402, 0x53BC0402,
+		0x53BD0402, 0x53BE0402, 0x53BF0402, 0x53C00402, 0x53C10402, 0x53C20402, 0x53C30402, 0x53C40402, 0x53C50402, 0x53C60402, 0x53C70402, 0x53C80402, 0x53C90402, 0x53CA0402, 0x53CB0402, 0x53CC0402,
+		0x53CD0402, 0x53CE0402, 0x53CF0402, 0x53D00402, 0x53D10402, 0x53D20402, 0x53D30402, 0x53D40402, 0x53D50402, 0x53D60402, 0x53D70402, 0x53D80402, 0x53D90402, 0x53DA0402, 0x53DB0402, 0x53DC0402,
+		0x53DD0402, 0x53DE0402, 0x53DF0402, 0x53E00402, 0x53E10402, 0x53E20402, 0x53E30402, 0x53E40402, 0x53E50402, 0x53E60402, 0x53E70402, 0x53E80402, 0x53E90402, 0x53EA0402, 0x53EB0402, 0x53EC0402,
+		0x53ED0402, 0x53EE0402, 0x53EF0402, 0x53F00402, 0x53F10402, 0x53F20402, 0x53F30402, 0x53F40402, 0x53F50402, 0x53F60402, 0x53F70402, 0x53F80402, 0x53F90402, 0x53FA0402, 0x53FB0402, 0x53FC0402,
+		0x53FD0402, 0x53FE0402, 0x53FF0402, 0x54000402, 0x54010402, 0x54020402, 0x54030402, 0x54040402, 0x54050402, 0x54060402, 0x54070402, 0x54080402, 0x54090402, 0x540A0402, 0x540B0402, 0x540C0402,
+		0x540D0402, 0x540E0402, 0x540F0402, 0x54100402, 0x54110402, 0x54120402, 0x54130402, 0x54140402, 0x54150402, 0x54160402, 0x54170402, 0x54180402, 0x54190402, 0x541A0402, 0x541B0402, 0x541C0402,
+		0x541D0402, 0x541E0402, 0x541F0402, 0x54200402, 0x54210402, 0x54220402, 0x54230402, 0x54240402, 0x54250402, 0x54260402, 0x54270402, 0x54280402, 0x54290402, 0x542A0402, 0x542B0402, 0x542C0402,
+		0x542D0402, 0x542E0402, 0x542F0402, 0x54300402, 0x54310402, 0x54320402, 0x54330402, 0x54340402, 0x54350402, 0x54360402, 0x54370402, 0x54380402, 0x54390402, 0x543A0402, 0x543B0402, 0x543C0402,
+		0x543D0402, 0x543E0402, 0x543F0402, 0x54400402, 0x54410402, 0x54420402, 0x54430402, 0x54440402, 0x54450402, 0x54460402, 0x54470402, 0x54480402, 0x54490402, 0x544A0402, 0x544B0402, 0x544C0402,
+		0x544D0402, 0x544E0402, 0x544F0402, 0x54500402, 0x54510402, 0x54520402, 0x54530402, 0x54540402, 0x54550402, 0x54560402, 0x54570402, 0x54580402, 0x54590402, 0x545A0402, 0x545B0402, 0x545C0402,
+		0x545D0402, 0x545E0402, 0x545F0402, 0x54600402, 0x54610402, 0x54620402, 0x54630402, 0x54640402, 0x54650402, 0x54660402, 0x54670402, 0x54680402, 0x54690402, 0x546A0402, 0x546B0402, 0x546C0402,
+		0x546D0402, 0x546E0402, 0x546F0402, 0x54700402, 0x54710402, 0x54720402, 0x54730402, 0x54740402, 0x54750402, 0x54760402, 0x54770402, 0x54780402, 0x54790402, 0x547A0402, 0x547B0402, 0x547C0402,
+		0x547D0402, 0x547E0402, 0x547F0402, 0x54800402, 0x54810402, 0x54820402, 0x54830402, 0x54840402, 0x54850402, 0x54860402, 0x54870402, 0x54880402, 0x54890402, 0x548A0402, 0x548B0402, 0x548C0402,
+		0x548D0402, 0x548E0402, 0x548F0402, 0x54900402, 0x54910402, 0x54920402, 0x54930402, 0x54940402, 0x54950402, 0x54960402, 0x54970402, 0x54980402, 0x54990402, 0x549A0402, 0x549B0402, 0x549C0402,
+		0x549D0402, 0x549E0402, 0x549F0402, 0x54A00402, 0x54A10402, 0x54A20402, 0x54A30402, 0x54A40402, 0x54A50402, 0x54A60402, 0x54A70402, 0x54A80402, 0x54A90402, 0x54AA0402, 0x54AB0402, 0x54AC0402,
+		0x54AD0402, 0x54AE0402, 0x54AF0402, 0x54B00402, 0x54B10402, 0x54B20402, 0x54B30402, 0x54B40402, 0x54B50402, 0x54B60402, 0x54B70402, 0x54B80402, 0x54B90402, 0x54BA0402, 0x54BB0402, 0x54BC0402,
+		0x54BD0402, 0x54BE0402, 0x54BF0402, 0x54C00402, 0x54C10402, 0x54C20402, 0x54C30402, 0x54C40402, 0x54C50402, 0x54C60402, 0x54C70402, 0x54C80402, 0x54C90402, 0x54CA0402, 0x54CB0402, 0x54CC0402,
+		0x54CD0402, 0x54CE0402, 0x54CF0402, 0x54D00402, 0x54D10402, 0x54D20402, 0x54D30402, 0x54D40402, 0x54D50402, 0x54D60402, 0x54D70402, 0x54D80402, 0x54D90402, 0x54DA0402, 0x54DB0402, 0x54DC0402,
+		0x54DD0402, 0x54DE0402, 0x54DF0402, 0x54E00402, 0x54E10402, 0x54E20402, 0x54E30402, 0x54E40402, 0x54E50402, 0x54E60402, 0x54E70402, 0x54E80402, 0x54E90402, 0x54EA0402, 0x54EB0402, 0x54EC0402,
+		0x54ED0402, 0x54EE0402, 0x54EF0402, 0x54F00402, 0x54F10402, 0x54F20402, 0x54F30402, 0x54F40402, 0x54F50402, 0x54F60402, 0x54F70402, 0x54F80402, 0x54F90402, 0x54FA0402, 0x54FB0402, 0x54FC0402,
+		0x54FD0402, 0x54FE0402, 0x54FF0402, 0x55000402, 0x55010402, 0x55020402, 0x55030402, 0x55040402, 0x55050402, 0x55060402, 0x55070402, 0x55080402, 0x55090402, 0x550A0402, 0x550B0402, 0x550C0402,
+		0x550D0402, 0x550E0402, 0x550F0402, 0x55100402, 0x55110402, 0x55120402, 0x55130402, 0x55140402, 0x55150402, 0x55160402, 0x55170402, 0x55180402, 0x55190402, 0x551A0402, 0x551B0402, 0x551C0402,
+		0x551D0402, 0x551E0402, 0x551F0402, 0x55200402, 0x55210402, 0x55220402, 0x55230402, 0x55240402, 0x55250402, 0x55260402, 0x55270402, 0x55280402, 0x55290402, 0x552A0402, 0x552B0402, 0x552C0402,
+		0x552D0402, 0x552E0402, 0x552F0402, 0x55300402, 0x55310402, 0x55320402, 0x55330402, 0x55340402, 0x55350402, 0x55360402, 0x55370402, 0x55380402, 0x55390402, 0x553A0402, 0x553B0402, 0x553C0402,
+		0x553D0402, 0x553E0402, 0x553F0402, 0x55400402, 0x55410402, 0x55420402, 0x55430402, 0x55440402, 0x55450402, 0x55460402, 0x55470402, 0x55480402, 0x55490402, 0x554A0402, 0x554B0402, 0x554C0402,
+		0x554D0402, 0x554E0402, 0x554F0402, 0x55500402, 0x55510402, 0x55520402, 0x55530402, 0x55540402, 0x55550402, 0x55560402, 0x55570402, 0x55580402, 0x55590402, 0x555A0402, 0x555B0402, 0x555C0402,
+		0x555D0402, 0x555E0402, 0x555F0402, 0x55600402, 0x55610402, 0x55620402, 0x55630402, 0x55640402, 0x55650402, 0x55660402, 0x55670402, 0x55680402, 0x55690402, 0x556A0402, 0x556B0402, 0x556C0402,
+		0x556D0402, 0x556E0402, 0x556F0402, 0x55700402, 0x55710402, 0x55720402, 0x55730402, 0x55740402, 0x55750402, 0x55760402, 0x55770402, 0x55780402, 0x55790402, 0x557A0402, 0x557B0402, 0x557C0402,
+		0x557D0402, 0x557E0402, 0x557F0402, 0x55800402, 0x55810402, 0x55820402, 0x55830402, 0x55840402, 0x55850402, 0x55860402, 0x55870402, 0x55880402, 0x55890402, 0x558A0402, 0x558B0402, 0x558C0402,
+		0x558D0402, 0x558E0402, 0x558F0402, 0x55900402, 0x55910402, 0x55920402, 0x55930402, 0x55940402, 0x55950402, 0x55960402, 0x55970402, 0x55980402, 0x55990402, 0x559A0402, 0x559B0402, 0x559C0402,
+		0x559D0402, 0x559E0402, 0x559F0402, 0x55A00402, 0x55A10402, 0x55A20402, 0x55A30402, 0x55A40402, 0x55A50402, 0x55A60402, 0x55A70402, 0x55A80402, 0x55A90402, 0x55AA0402, 0x55AB0402, 0x55AC0402,
+		0x55AD0402, 0x55AE0402, 0x55AF0402, 0x55B00402, 0x55B10402, 0x55B20402, 0x55B30402, 0x55B40402, 0x55B50402, 0x55B60402, 0x55B70402, 0x55B80402, 0x55B90402, 0x55BA0402, 0x55BB0402, 0x55BC0402,
+		0x55BD0402, 0x55BE0402, 0x55BF0402, 0x55C00402, 0x55C10402, 0x55C20402, 0x55C30402, 0x55C40402, 0x55C50402, 0x55C60402, 0x55C70402, 0x55C80402, 0x55C90402, 0x55CA0402, 0x55CB0402, 0x55CC0402,
+		0x55CD0402, 0x55CE0402, 0x55CF0402, 0x55D00402, 0x55D10402, 0x55D20402, 0x55D30402, 0x55D40402, 0x55D50402, 0x55D60402, 0x55D70402, 0x55D80402, 0x55D90402, 0x55DA0402, 0x55DB0402, 0x55DC0402,
+		0x55DD0402, 0x55DE0402, 0x55DF0402, 0x55E00402, 0x55E10402, 0x55E20402, 0x55E30402, 0x55E40402, 0x55E50402, 0x55E60402, 0x55E70402, 0x55E80402, 0x55E90402, 0x55EA0402, 0x55EB0402, 0x55EC0402,
+		0x55ED0402, 0x55EE0402, 0x55EF0402, 0x55F00402, 0x55F10402, 0x55F20402, 0x55F30402, 0x55F40402, 0x55F50402, 0x55F60402, 0x55F70402, 0x55F80402, 0x55F90402, 0x55FA0402, 0x55FB0402, 0x55FC0402,
+		0x55FD0402, 0x55FE0402, 0x55FF0402, 0x56000402, 0x56010402, 0x56020402, 0x56030402, 0x56040402, 0x56050402, 0x56060402, 0x56070402, 0x56080402, 0x56090402, 0x560A0402, 0x560B0402, 0x560C0402,
+		0x560D0402, 0x560E0402, 0x560F0402, 0x56100402, 0x56110402, 0x56120402, 0x56130402, 0x56140402, 0x56150402, 0x56160402, 0x56170402, 0x56180402, 0x56190402, 0x561A0402, 0x561B0402, 0x561C0402,
+		0x561D0402, 0x561E0402, 0x561F0402, 0x56200402, 0x56210402, 0x56220402, 0x56230402, 0x56240402, 0x56250402, 0x56260402, 0x56270402, 0x56280402, 0x56290402, 0x562A0402, 0x562B0402, 0x562C0402,
+		0x562D0402, 0x562E0402, 0x562F0402, 0x56300402, 0x56310402, 0x56320402, 0x56330402, 0x56340402, 0x56350402, 0x56360402, 0x56370402, 0x56380402, 0x56390402, 0x563A0402, 0x563B0402, 0x563C0402,
+		0x563D0402, 0x563E0402, 0x563F0402, 0x56400402, 0x56410402, 0x56420402, 0x56430402, 0x56440402, 0x56450402, 0x56460402, 0x56470402, 0x56480402, 0x56490402, 0x564A0402, 0x564B0402, 0x564C0402,
+		0x564D0402, 0x564E0402, 0x564F0402, 0x56500402, 0x56510402, 0x56520402, 0x56530402, 0x56540402, 0x56550402, 0x56560402, 0x56570402, 0x56580402, 0x56590402, 0x565A0402, 0x565B0402, 0x565C0402,
+		0x565D0402, 0x565E0402, 0x565F0402, 0x56600402, 0x56610402, 0x56620402, 0x56630402, 0x56640402, 0x56650402, 0x56660402, 0x56670402, 0x56680402, 0x56690402, 0x566A0402, 0x566B0402, 0x566C0402,
+		0x566D0402, 0x566E0402, 0x566F0402, 0x56700402, 0x56710402, 0x56720402, 0x56730402, 0x56740402, 0x56750402, 0x56760402, 0x56770402, 0x56780402, 0x56790402, 0x567A0402, 0x567B0402, 0x567C0402,
+		0x567D0402, 0x567E0402, 0x567F0402, 0x56800402, 0x56810402, 0x56820402, 0x56830402, 0x56840402, 0x56850402, 0x56860402, 0x56870402, 0x56880402, 0x56890402, 0x568A0402, 0x568B0402, 0x568C0402,
+		0x568D0402, 0x568E0402, 0x568F0402, 0x56900402, 0x56910402, 0x56920402, 0x56930402, 0x56940402, 0x56950402, 0x56960402, 0x56970402, 0x56980402, 0x56990402, 0x569A0402, 0x569B0402, 0x569C0402,
+		0x569D0402, 0x569E0402, 0x569F0402, 0x56A00402, 0x56A10402, 0x56A20402, 0x56A30402, 0x56A40402, 0x56A50402, 0x56A60402, 0x56A70402, 0x56A80402, 0x56A90402, 0x56AA0402, 0x56AB0402, 0x56AC0402,
+		0x56AD0402, 0x56AE0402, 0x56AF0402, 0x56B00402, 0x56B10402, 0x56B20402, 0x56B30402, 0x56B40402, 0x56B50402, 0x56B60402, 0x56B70402, 0x56B80402, 0x56B90402, 0x56BA0402, 0x56BB0402, 0x56BC0402,
+		0x56BD0402, 0x56BE0402, 0x56BF0402, 0x56C00402, 0x56C10402, 0x56C20402, 0x56C30402, 0x56C40402, 0x56C50402, 0x56C60402, 0x56C70402, 0x56C80402, 0x56C90402, 0x56CA0402, 0x56CB0402, 0x56CC0402,
+		0x56CD0402, 0x56CE0402, 0x56CF0402, 0x56D00402, 0x56D10402, 0x56D20402, 0x56D30402, 0x56D40402, 0x56D50402, 0x56D60402, 0x56D70402, 0x56D80402, 0x56D90402, 0x56DA0402, 0x56DB0402, 0x56DC0402,
+		0x56DD0402, 0x56DE0402, 0x56DF0402, 0x56E00402, 0x56E10402, 0x56E20402, 0x56E30402, 0x56E40402, 0x56E50402, 0x56E60402, 0x56E70402, 0x56E80402, 0x56E90402, 0x56EA0402, 0x56EB0402, 0x56EC0402,
+		0x56ED0402, 0x56EE0402, 0x56EF0402, 0x56F00402, 0x56F10402, 0x56F20402, 0x56F30402, 0x56F40402, 0x56F50402, 0x56F60402, 0x56F70402, 0x56F80402, 0x56F90402, 0x56FA0402, 0x56FB0402, 0x56FC0402,
+		0x56FD0402, 0x56FE0402, 0x56FF0402, 0x57000402, 0x57010402, 0x57020402, 0x57030402, 0x57040402, 0x57050402, 0x57060402, 0x57070402, 0x57080402, 0x57090402, 0x570A0402, 0x570B0402, 0x570C0402,
+		0x570D0402, 0x570E0402, 0x570F0402, 0x57100402, 0x57110402, 0x57120402, 0x57130402, 0x57140402, 0x57150402, 0x57160402, 0x57170402, 0x57180402, 0x57190402, 0x571A0402, 0x571B0402, 0x571C0402,
+		0x571D0402, 0x571E0402, 0x571F0402, 0x57200402, 0x57210402, 0x57220402, 0x57230402, 0x57240402, 0x57250402, 0x57260402, 0x57270402, 0x57280402, 0x57290402, 0x572A0402, 0x572B0402, 0x572C0402,
+		0x572D0402, 0x572E0402, 0x572F0402, 0x57300402, 0x57310402, 0x57320402, 0x57330402, 0x57340402, 0x57350402, 0x57360402, 0x57370402, 0x57380402, 0x57390402, 0x573A0402, 0x573B0402, 0x573C0402,
+		0x573D0402, 0x573E0402, 0x573F0402, 0x57400402, 0x57410402, 0x57420402, 0x57430402, 0x57440402, 0x57450402, 0x57460402, 0x57470402, 0x57480402, 0x57490402, 0x574A0402, 0x574B0402, 0x574C0402,
+		0x574D0402, 0x574E0402, 0x574F0402, 0x57500402, 0x57510402, 0x57520402, 0x57530402, 0x57540402, 0x57550402, 0x57560402, 0x57570402, 0x57580402, 0x57590402, 0x575A0402, 0x575B0402, 0x575C0402,
+		0x575D0402, 0x575E0402, 0x575F0402, 0x57600402, 0x57610402, 0x57620402, 0x57630402, 0x57640402, 0x57650402, 0x57660402, 0x57670402, 0x57680402, 0x57690402, 0x576A0402, 0x576B0402, 0x576C0402,
+		0x576D0402, 0x576E0402, 0x576F0402, 0x57700402, 0x57710402, 0x57720402, 0x57730402, 0x57740402, 0x57750402, 0x57760402, 0x57770402, 0x57780402, 0x57790402, 0x577A0402, 0x577B0402, 0x577C0402,
+		0x577D0402, 0x577E0402, 0x577F0402, 0x57800402, 0x57810402, 0x57820402, 0x57830402, 0x57840402, 0x57850402, 0x57860402, 0x57870402, 0x57880402, 0x57890402, 0x578A0402, 0x578B0402, 0x578C0402,
+		0x578D0402, 0x578E0402, 0x578F0402, 0x57900402, 0x57910402, 0x57920402, 0x57930402, 0x57940402, 0x57950402, 0x57960402, 0x57970402, 0x57980402, 0x57990402, 0x579A0402, 0x579B0402, 0x579C0402,
+		0x579D0402, 0x579E0402, 0x579F0402, 0x57A00402, 0x57A10402, 0x57A20402, 0x57A30402, 0x57A40402, 0x57A50402, 0x57A60402, 0x57A70402, 0x57A80402, 0x57A90402, 0x57AA0402, 0x57AB0402, 0x57AC0402,
+		0x57AD0402, 0x57AE0402, 0x57AF0402, 0x57B00402, 0x57B10402, 0x57B20402, 0x57B30402, 0x57B40402, 0x57B50402, 0x57B60402, 0x57B70402, 0x57B80402, 0x57B90402, 0x57BA0402, 0x57BB0402, 0x57BC0402,
+		0x57BD0402, 0x57BE0402, 0x57BF0402, 0x57C00402, 0x57C10402, 0x57C20402, 0x57C30402, 0x57C40402, 0x57C50402, 0x57C60402, 0x57C70402, 0x57C80402, 0x57C90402, 0x57CA0402, 0x57CB0402, 0x57CC0402,
+		0x57CD0402, 0x57CE0402, 0x57CF0402, 0x57D00402, 0x57D10402, 0x57D20402, 0x57D30402, 0x57D40402, 0x57D50402, 0x57D60402, 0x57D70402, 0x57D80402, 0x57D90402, 0x57DA0402, 0x57DB0402, 0x57DC0402,
+		0x57DD0402, 0x57DE0402, 0x57DF0402, 0x57E00402, 0x57E10402, 0x57E20402, 0x57E30402, 0x57E40402, 0x57E50402, 0x57E60402, 0x57E70402, 0x57E80402, 0x57E90402, 0x57EA0402, 0x57EB0402, 0x57EC0402,
+		0x57ED0402, 0x57EE0402, 0x57EF0402, 0x57F00402, 0x57F10402, 0x57F20402, 0x57F30402, 0x57F40402, 0x57F50402, 0x57F60402, 0x57F70402, 0x57F80402, 0x57F90402, 0x57FA0402, 0x57FB0402, 0x57FC0402,
+		0x57FD0402, 0x57FE0402, 0x57FF0402, 0x58000402, 0x58010402, 0x58020402, 0x58030402, 0x58040402, 0x58050402, 0x58060402, 0x58070402, 0x58080402, 0x58090402, 0x580A0402, 0x580B0402, 0x580C0402,
+		0x580D0402, 0x580E0402, 0x580F0402, 0x58100402, 0x58110402, 0x58120402, 0x58130402, 0x58140402, 0x58150402, 0x58160402, 0x58170402, 0x58180402, 0x58190402, 0x581A0402, 0x581B0402, 0x581C0402,
+		0x581D0402, 0x581E0402, 0x581F0402, 0x58200402, 0x58210402, 0x58220402, 0x58230402, 0x58240402, 0x58250402, 0x58260402, 0x58270402, 0x58280402, 0x58290402, 0x582A0402, 0x582B0402, 0x582C0402,
+		0x582D0402, 0x582E0402, 0x582F0402, 0x58300402, 0x58310402, 0x58320402, 0x58330402, 0x58340402, 0x58350402, 0x58360402, 0x58370402, 0x58380402, 0x58390402, 0x583A0402, 0x583B0402, 0x583C0402,
+		0x583D0402, 0x583E0402, 0x583F0402, 0x58400402, 0x58410402, 0x58420402, 0x58430402, 0x58440402, 0x58450402, 0x58460402, 0x58470402, 0x58480402, 0x58490402, 0x584A0402, 0x584B0402, 0x584C0402,
+		0x584D0402, 0x584E0402, 0x584F0402, 0x58500402, 0x58510402, 0x58520402, 0x58530402, 0x58540402, 0x58550402, 0x58560402, 0x58570402, 0x58580402, 0x58590402, 0x585A0402, 0x585B0402, 0x585C0402,
+		0x585D0402, 0x585E0402, 0x585F0402, 0x58600402, 0x58610402, 0x58620402, 0x58630402, 0x58640402, 0x58650402, 0x58660402, 0x58670402, 0x58680402, 0x58690402, 0x586A0402, 0x586B0402, 0x586C0402,
+		0x586D0402, 0x586E0402, 0x586F0402, 0x58700402, 0x58710402, 0x58720402, 0x58730402, 0x58740402, 0x58750402, 0x58760402, 0x58770402, 0x58780402, 0x58790402, 0x587A0402, 0x587B0402, 0x587C0402,
+		0x587D0402, 0x587E0402, 0x587F0402, 0x58800402, 0x58810402, 0x58820402, 0x58830402, 0x58840402, 0x58850402, 0x58860402, 0x58870402, 0x58880402, 0x58890402, 0x588A0402, 0x588B0402, 0x588C0402,
+		0x588D0402, 0x588E0402, 0x588F0402, 0x58900402, 0x58910402, 0x58920402, 0x58930402, 0x58940402, 0x58950402, 0x58960402, 0x58970402, 0x58980402, 0x58990402, 0x589A0402, 0x589B0402, 0x589C0402,
+		0x589D0402, 0x589E0402, 0x589F0402, 0x58A00402, 0x58A10402, 0x58A20402, 0x58A30402, 0x58A40402, 0x58A50402, 0x58A60402, 0x58A70402, 0x58A80402, 0x58A90402, 0x58AA0402, 0x58AB0402, 0x58AC0402,
+		0x58AD0402, 0x58AE0402, 0x58AF0402, 0x58B00402, 0x58B10402, 0x58B20402, 0x58B30402, 0x58B40402, 0x58B50402, 0x58B60402, 0x58B70402, 0x58B80402, 0x58B90402, 0x58BA0402, 0x58BB0402, 0x58BC0402,
+		0x58BD0402, 0x58BE0402, 0x58BF0402, 0x58C00402, 0x58C10402, 0x58C20402, 0x58C30402, 0x58C40402, 0x58C50402, 0x58C60402, 0x58C70402, 0x58C80402, 0x58C90402, 0x58CA0402, 0x58CB0402, 0x58CC0402,
+		0x58CD0402, 0x58CE0402, 0x58CF0402, 0x58D00402, 0x58D10402, 0x58D20402, 0x58D30402, 0x58D40402, 0x58D50402, 0x58D60402, 0x58D70402, 0x58D80402, 0x58D90402, 0x58DA0402, 0x58DB0402, 0x58DC0402,
+		0x58DD0402, 0x58DE0402, 0x58DF0402, 0x58E00402, 0x58E10402, 0x58E20402, 0x58E30402, 0x58E40402, 0x58E50402, 0x58E60402, 0x58E70402, 0x58E80402, 0x58E90402, 0x58EA0402, 0x58EB0402, 0x58EC0402,
+		0x58ED0402, 0x58EE0402, 0x58EF0402, 0x58F00402, 0x58F10402, 0x58F20402, 0x58F30402, 0x58F40402, 0x58F50402, 0x58F60402, 0x58F70402, 0x58F80402, 0x58F90402, 0x58FA0402, 0x58FB0402, 0x58FC0402,
+		0x58FD0402, 0x58FE0402, 0x58FF0402, 0x59000402, 0x59010402, 0x59020402, 0x59030402, 0x59040402, 0x59050402, 0x59060402, 0x59070402, 0x59080402, 0x59090402, 0x590A0402, 0x590B0402, 0x590C0402,
+		0x590D0402, 0x590E0402, 0x590F0402, 0x59100402, 0x59110402, 0x59120402, 0x59130402, 0x59140402, 0x59150402, 0x59160402, 0x59170402, 0x59180402, 0x59190402, 0x591A0402, 0x591B0402, 0x591C0402,
+		0x591D0402, 0x591E0402, 0x591F0402, 0x59200402, 0x59210402, 0x59220402, 0x59230402, 0x59240402, 0x59250402, 0x59260402, 0x59270402, 0x59280402, 0x59290402, 0x592A0402, 0x592B0402, 0x592C0402,
+		0x592D0402, 0x592E0402, 0x592F0402, 0x59300402, 0x59310402, 0x59320402, 0x59330402, 0x59340402, 0x59350402, 0x59360402, 0x59370402, 0x59380402, 0x59390402, 0x593A0402, 0x593B0402, 0x593C0402,
+		0x593D0402, 0x593E0402, 0x593F0402, 0x59400402, 0x59410402, 0x59420402, 0x59430402, 0x59440402, 0x59450402, 0x59460402, 0x59470402, 0x59480402, 0x59490402, 0x594A0402, 0x594B0402, 0x594C0402,
+		0x594D0402, 0x594E0402, 0x594F0402, 0x59500402, 0x59510402, 0x59520402, 0x59530402, 0x59540402, 0x59550402, 0x59560402, 0x59570402, 0x59580402, 0x59590402, 0x595A0402, 0x595B0402, 0x595C0402,
+		0x595D0402, 0x595E0402, 0x595F0402, 0x59600402, 0x59610402, 0x59620402, 0x59630402, 0x59640402, 0x59650402, 0x59660402, 0x59670402, 0x59680402, 0x59690402, 0x596A0402, 0x596B0402, 0x596C0402,
+		0x596D0402, 0x596E0402, 0x596F0402, 0x59700402, 0x59710402, 0x59720402, 0x59730402, 0x59740402, 0x59750402, 0x59760402, 0x59770402, 0x59780402, 0x59790402, 0x597A0402, 0x597B0402, 0x597C0402,
+		0x597D0402, 0x597E0402, 0x597F0402, 0x59800402, 0x59810402, 0x59820402, 0x59830402, 0x59840402, 0x59850402, 0x59860402, 0x59870402, 0x59880402, 0x59890402, 0x598A0402, 0x598B0402, 0x598C0402,
+		0x598D0402, 0x598E0402, 0x598F0402, 0x59900402, 0x59910402, 0x59920402, 0x59930402, 0x59940402, 0x59950402, 0x59960402, 0x59970402, 0x59980402, 0x59990402, 0x599A0402, 0x599B0402, 0x599C0402,
+		0x599D0402, 0x599E0402, 0x599F0402, 0x59A00402, 0x59A10402, 0x59A20402, 0x59A30402, 0x59A40402, 0x59A50402, 0x59A60402, 0x59A70402, 0x59A80402, 0x59A90402, 0x59AA0402, 0x59AB0402, 0x59AC0402,
+		0x59AD0402, 0x59AE0402, 0x59AF0402, 0x59B00402, 0x59B10402, 0x59B20402, 0x59B30402, 0x59B40402, 0x59B50402, 0x59B60402, 0x59B70402, 0x59B80402, 0x59B90402, 0x59BA0402, 0x59BB0402, 0x59BC0402,
+		0x59BD0402, 0x59BE0402, 0x59BF0402, 0x59C00402, 0x59C10402, 0x59C20402, 0x59C30402, 0x59C40402, 0x59C50402, 0x59C60402, 0x59C70402, 0x59C80402, 0x59C90402, 0x59CA0402, 0x59CB0402, 0x59CC0402,
+		0x59CD0402, 0x59CE0402, 0x59CF0402, 0x59D00402, 0x59D10402, 0x59D20402, 0x59D30402, 0x59D40402, 0x59D50402, 0x59D60402, 0x59D60404, 0x2304, 0x59D70402, 0x59D70404, 0x2304, 0x59D80402,
+		0x59D80404, 0x2304, 0x59D90402, 0x59D90404, 0x2304, 0x59DA0402, 0x59DA0404, 0x2304, 0x59DB0402, 0x59DB0404, 0x2304, 0x59DC0402, 0x59DC0404, 0x2304, 0x59DC0404, 0x2324,
+		0x59DD0402, 0x59DD0404, 0x2304, 0x59DE0402, 0x59DE0404, 0x2304, 0x59DF0402, 0x59DF0404, 0x2304, 0x59DF0404, 0x2324, 0x59E00402, 0x59E00404, 0x2304, 0x59E00404, 0x2324,
+		0x59E10402, 0x59E10404, 0x2304, 0x59E10404, 0x2324, 0x59E20402, 0x59E20404, 0x2304, 0x59E30402, 0x59E30404, 0x2304, 0x59E40402, 0x59E40404, 0x2304, 0x59E50402, 0x59E50404,
+		0x2304, 0x59E50404, 0x2324, 0x59E50404, 0x2344, 0x59E60402, 0x59E60404, 0x2304, 0x59E70402, 0x59E70404, 0x2304, 0x59E80402, 0x59E80404, 0x2304, 0x59E90402, 0x59E90404,
+		0x2304, 0x59E90404, 0x2324, 0x59EA0402, 0x59EA0404, 0x2304, 0x59EA0404, 0x2324, 0x59EB0402, 0x59EB0404, 0x2304, 0x59EC0402, 0x59EC0404, 0x2304, 0x59ED0402, 0x59EE0402,
+		0x59EF0402, 0x59F00402, 0x59F10402, 0x59F20402, 0x59F30402, 0x59F40402, 0x59F50402, 0x59F60402, 0x59F70402, 0x59F80402, 0x59F90402, 0x59FA0402, 0x59FB0402, 0x59FC0402, 0x59FD0402, 0x59FE0402,
+		0x59FF0402, 0x5A000402, 0x5A010402, 0x5A020402, 0x5A030402, 0x5A040402, 0x5A050402, 0x5A060402, 0x5A070402, 0x5A080402, 0x5A090402, 0x5A0A0402, 0x5A0B0402, 0x5A0C0402, 0x5A0D0402, 0x5A0E0402,
+		0x5A0F0402, 0x5A100402, 0x5A110402, 0x5A120402, 0x5A130402, 0x5A140402, 0x5A150402, 0x5A160402, 0x5A170402, 0x5A180402, 0x5A190402, 0x5A1A0402, 0x5A1B0402, 0x5A1C0402, 0x5A1D0402, 0x5A1E0402,
+		0x5A1F0402, 0x5A200402, 0x5A210402, 0x5A220402, 0x5A230402, 0x5A240402, 0x5A250402, 0x5A260402, 0x5A270402, 0x5A280402, 0x5A290402, 0x5A2A0402, 0x5A2B0402, 0x5A2C0402, 0x5A2D0402, 0x5A2E0402,
+		0x5A2F0402, 0x5A300402, 0x5A310402, 0x5A320402, 0x5A330402, 0x5A340402, 0x5A350402, 0x5A360402, 0x5A370402, 0x5A380402, 0x5A390402, 0x5A3A0402, 0x5A3B0402, 0x5A3C0402, 0x5A3D0402, 0x5A3E0402,
+		0x5A3F0402, 0x5A400402, 0x5A410402, 0x5A420402, 0x5A430402, 0x5A440402, 0x5A450402, 0x5A460402, 0x5A470402, 0x5A480402, 0x5A490402, 0x5A4A0402, 0x5A4B0402, 0x5A4C0402, 0x5A4D0402, 0x5A4E0402,
+		0x5A4F0402, 0x5A500402, 0x5A510402, 0x5A520402, 0x5A530402, 0x5A540402, 0x5A550402, 0x5A560402, 0x5A570402, 0x5A580402, 0x5A590402, 0x5A5A0402, 0x5A5B0402, 0x5A5C0402, 0x5A5D0402, 0x5A5E0402,
+		0x5A5F0402, 0x5A600402, 0x5A610402, 0x5A620402, 0x5A630402, 0x5A640402, 0x5A650402, 0x5A660402, 0x5A670402, 0x5A680402, 0x5A690402, 0x5A6A0402, 0x5A6B0402, 0x5A6C0402, 0x5A6D0402, 0x5A6E0402,
+		0x5A6F0402, 0x5A700402, 0x5A710402, 0x5A720402, 0x5A730402, 0x5A740402, 0x5A750402, 0x5A760402, 0x5A770402, 0x5A780402, 0x5A790402, 0x5A7A0402, 0x5A7B0402, 0x5A7C0402, 0x5A7D0402, 0x5A7E0402,
+		0x5A7F0402, 0x5A800402, 0x5A810402, 0x5A820402, 0x5A830402, 0x5A840402, 0x5A850402, 0x5A860402, 0x5A870402, 0x5A880402, 0x5A890402, 0x5A8A0402, 0x5A8B0402, 0x5A8C0402, 0x5A8D0402, 0x5A8E0402,
+		0x5A8F0402, 0x5A900402, 0x5A910402, 0x5A920402, 0x5A930402, 0x5A940402, 0x5A950402, 0x5A960402, 0x5A970402, 0x5A980402, 0x5A990402, 0x5A9A0402, 0x5A9B0402, 0x5A9C0402, 0x5A9D0402, 0x5A9E0402,
+		0x5A9F0402, 0x5AA00402, 0x5AA10402, 0x5AA20402, 0x5AA30402, 0x5AA40402, 0x5AA50402, 0x5AA60402, 0x5AA70402, 0x5AA80402, 0x5AA90402, 0x5AAA0402, 0x5AAB0402, 0x5AAC0402, 0x5AAD0402, 0x5AAE0402,
+		0x5AAF0402, 0x5AB00402, 0x5AB10402, 0x5AB20402, 0x5AB30402, 0x5AB40402, 0x5AB50402, 0x5AB60402, 0x5AB70402, 0x5AB80402, 0x5AB90402, 0x5ABA0402, 0x5ABB0402, 0x5ABC0402, 0x5ABD0402, 0x5ABE0402,
+		0x5ABF0402, 0x5AC00402, 0x5AC10402, 0x5AC20402, 0x5AC30402, 0x5AC40402, 0x5AC50402, 0x5AC60402, 0x5AC70402, 0x5AC80402, 0x5AC90402, 0x5ACA0402, 0x5ACB0402, 0x5ACC0402, 0x5ACD0402, 0x5ACE0402,
+		0x5ACF0402, 0x5AD00402, 0x5AD10402, 0x5AD20402, 0x5AD30402, 0x5AD40402, 0x5AD50402, 0x5AD60402, 0x5AD70402, 0x5AD80402, 0x5AD90402, 0x5ADA0402, 0x5ADB0402, 0x5ADC0402, 0x5ADD0402, 0x5ADE0402,
+		0x5ADF0402, 0x5AE00402, 0x5AE10402, 0x5AE20402, 0x5AE30402, 0x5AE40402, 0x5AE50402, 0x5AE60402, 0x5AE70402, 0x5AE80402, 0x5AE90402, 0x5AEA0402, 0x5AEB0402, 0x5AEC0402, 0x5AED0402, 0x5AEE0402,
+		0x5AEF0402, 0x5AF00402, 0x5AF10402, 0x5AF20402, 0x5AF30402, 0x5AF40402, 0x5AF50402, 0x5AF60402, 0x5AF70402, 0x5AF80402, 0x5AF90402, 0x5AFA0402, 0x5AFB0402, 0x5AFC0402, 0x5AFD0402, 0x5AFE0402,
+		0x5AFF0402, 0x5B000402, 0x5B010402, 0x5B020402, 0x5B030402, 0x5B040402, 0x5B050402, 0x5B060402, 0x5B070402, 0x5B080402, 0x5B090402, 0x5B0A0402, 0x5B0B0402, 0x5B0C0402, 0x5B0D0402, 0x5B0E0402,
+		0x5B0F0402, 0x5B100402, 0x5B110402, 0x5B120402, 0x5B130402, 0x5B140402, 0x5B150402, 0x5B160402, 0x5B170402, 0x5B180402, 0x5B190402, 0x5B1A0402, 0x5B1B0402, 0x5B1C0402, 0x5B1D0402, 0x5B1E0402,
+		0x5B1F0402, 0x5B200402, 0x5B210402, 0x5B220402, 0x5B230402, 0x5B240402, 0x5B250402, 0x5B260402, 0x5B270402, 0x5B280402, 0x5B290402, 0x5B2A0402, 0x5B2B0402, 0x5B2C0402, 0x5B2D0402, 0x5B2E0402,
+		0x5B2F0402, 0x5B300402, 0x5B310402, 0x5B320402, 0x5B330402, 0x5B340402, 0x5B350402, 0x5B360402, 0x5B370402, 0x5B380402, 0x5B390402, 0x5B3A0402, 0x5B3B0402, 0x5B3C0402, 0x5B3D0402, 0x5B3E0402,
+		0x5B3F0402, 0x5B400402, 0x5B410402, 0x5B420402, 0x5B430402, 0x5B440402, 0x5B450402, 0x5B460402, 0x5B470402, 0x5B480402, 0x5B490402, 0x5B4A0402, 0x5B4B0402, 0x5B4C0402, 0x5B4D0402, 0x5B4E0402,
+		0x5B4F0402, 0x5B500402, 0x5B510402, 0x5B520402, 0x5B530402, 0x5B540402, 0x5B550402, 0x5B560402, 0x5B570402, 0x5B580402, 0x5B590402, 0x5B5A0402, 0x5B5B0402, 0x5B5C0402, 0x5B5D0402, 0x5B5E0402,
+		0x5B5F0402, 0x5B600402, 0x5B610402, 0x5B620402, 0x5B630402, 0x5B640402, 0x5B650402, 0x5B660402, 0x5B670402, 0x5B680402, 0x5B690402, 0x5B6A0402, 0x5B6B0402, 0x5B6C0402, 0x5B6D0402, 0x5B6E0402,
+		0x5B6F0402, 0x5B700402, 0x5B710402, 0x5B720402, 0x5B730402, 0x5B740402, 0x5B750402, 0x5B760402, 0x5B770402, 0x5B780402, 0x5B790402, 0x5B7A0402, 0x5B7B0402, 0x5B7C0402, 0x5B7D0402, 0x5B7E0402,
+		0x5B7F0402, 0x5B800402, 0x5B810402, 0x5B820402, 0x5B830402, 0x5B840402, 0x5B850402, 0x5B860402, 0x5B870402, 0x5B880402, 0x5B890402, 0x5B8A0402, 0x5B8B0402, 0x5B8C0402, 0x5B8D0402, 0x5B8E0402,
+		0x5B8F0402, 0x5B900402, 0x5B910402, 0x5B920402, 0x5B930402, 0x5B940402, 0x5B950402, 0x5B960402, 0x5B970402, 0x5B980402, 0x5B990402, 0x5B9A0402, 0x5B9B0402, 0x5B9C0402, 0x5B9D0402, 0x5B9E0402,
+		0x5B9F0402, 0x5BA00402, 0x5BA10402, 0x5BA20402, 0x5BA30402, 0x5BA40402, 0x5BA50402, 0x5BA60402, 0x5BA70402, 0x5BA80402, 0x5BA90402, 0x5BAA0402, 0x5BAB0402, 0x5BAC0402, 0x5BAD0402, 0x5BAE0402,
+		0x5BAF0402, 0x5BB00402, 0x5BB10402, 0x5BB20402, 0x5BB30402, 0x5BB40402, 0x5BB50402, 0x5BB60402, 0x5BB70402, 0x5BB80402, 0x5BB90402, 0x5BBA0402, 0x5BBB0402, 0x5BBC0402, 0x5BBD0402, 0x5BBE0402,
+		0x5BBF0402, 0x5BC00402, 0x5BC10402, 0x5BC20402, 0x5BC30402, 0x5BC40402, 0x5BC50402, 0x5BC60402, 0x5BC70402, 0x5BC80402, 0x5BC90402, 0x5BCA0402, 0x5BCB0402, 0x5BCC0402, 0x5BCD0402, 0x5BCE0402,
+		0x5BCF0402, 0x5BD00402, 0x5BD10402, 0x5BD20402, 0x5BD30402, 0x5BD40402, 0x5BD50402, 0x5BD60402, 0x5BD70402, 0x5BD80402, 0x5BD90402, 0x5BDA0402, 0x5BDB0402, 0x5BDC0402, 0x5BDD0402, 0x5BDE0402,
+		0x5BDF0402, 0x5BE00402, 0x5BE10402, 0x5BE20402, 0x5BE30402, 0x5BE40402, 0x5BE50402, 0x5BE60402, 0x5BE70402, 0x5BE80402, 0x5BE90402, 0x5BEA0402, 0x5BEB0402, 0x5BEC0402, 0x5BED0402, 0x5BEE0402,
+		0x5BEF0402, 0x5BF00402, 0x5BF10402, 0x5BF20402, 0x5BF30402, 0x5BF40402, 0x5BF50402, 0x5BF60402, 0x5BF70402, 0x5BF80402, 0x5BF90402, 0x5BFA0402, 0x5BFB0402, 0x5BFC0402, 0x5BFD0402, 0x5BFE0402,
+		0x5BFF0402, 0x5C000402, 0x5C010402, 0x5C020402, 0x5C030402, 0x5C040402, 0x5C050402, 0x5C060402, 0x5C070402, 0x5C080402, 0x5C090402, 0x5C0A0402, 0x5C0B0402, 0x5C0C0402, 0x5C0D0402, 0x5C0E0402,
+		0x5C0F0402, 0x5C100402, 0x5C110402, 0x5C120402, 0x5C130402, 0x5C140402, 0x5C150402, 0x5C160402, 0x5C170402, 0x5C180402, 0x5C190402, 0x5C1A0402, 0x5C1B0402, 0x5C1C0402, 0x5C1D0402, 0x5C1E0402,
+		0x5C1F0402, 0x5C200402, 0x5C210402, 0x5C220402, 0x5C230402, 0x5C240402, 0x5C250402, 0x5C260402, 0x5C270402, 0x5C280402, 0x5C290402, 0x5C2A0402, 0x5C2B0402, 0x5C2C0402, 0x5C2D0402, 0x5C2E0402,
+		0x5C2F0402, 0x5C300402, 0x5C310402, 0x5C320402, 0x5C330402, 0x5C340402, 0x5C350402, 0x5C360402, 0x5C370402, 0xFB400404, 0xCE000000, 0x3280404, 0xFB400404, 0xCE000000, 0x3290404, 0xFB400406,
+		0xCE000000, 0xFB400414, 0xCE000000, 0xFB40041C, 0xCE000000, 0xFB400414, 0xCE010000, 0x3280404, 0xFB400404, 0xCE030000, 0x3290404, 0xFB400406, 0xCE030000, 0x3280404, 0xFB400404, 0xCE090000,
+		0x3290404, 0x38A0404, 0xFB400404, 0xCE090000, 0x38B0404, 0xFB400406, 0xCE090000, 0xFB400414, 0xCE090000, 0xFB40041C, 0xCE090000, 0xFB400406, 0xCE0A0000, 0xFB400414, 0xCE0A0000, 0xFB400406,
+		0xCE0B0000, 0xFB400414, 0xCE0B0000, 0xFB400402, 0xCE0D0000, 0xFB400414, 0xCE190000, 0xFB400402, 0xCE260000, 0xFB400404, 0xCE280000, 0xFB400404, 0xCE2C0000, 0xFB400406, 0xCE2D0000, 0xFB400414,
+		0xCE2D0000, 0xFB40041C, 0xCE2D0000, 0xFB400402, 0xCE320000, 0xFB400404, 0xCE360000, 0xFB400404, 0xCE360000, 0x2304, 0xFB400402, 0xCE380000, 0xFB400402, 0xCE390000, 0xFB400402, 0xCE3D0000,
+		0xFB400404, 0xCE3F0000, 0xFB400402, 0xCE410000, 0xFB400404, 0xCE590000, 0xFB400414, 0xCE590000, 0xFB400404, 0xCE590000, 0x2304, 0xFB400404, 0xCE5A0000, 0xFB400404, 0xCE5B0000, 0x3280404,
+		0xFB400404, 0xCE5D0000, 0x3290404, 0xFB400406, 0xCE5D0000, 0xFB400402, 0xCE820000, 0xFB400404, 0xCE850000, 0xFB400402, 0xCE860000, 0xFB400404, 0xCE8C0000, 0x3280404, 0xFB400404, 0xCE8C0000,
+		0x3290404, 0x38A0404, 0xFB400404, 0xCE8C0000, 0x38B0404, 0xFB400406, 0xCE8C0000, 0xFB400414, 0xCE8C0000, 0xFB40041C, 0xCE8C0000, 0x3280404, 0xFB400404, 0xCE940000, 0x3290404, 0xFB400406,
+		0xCE940000, 0xFB400404, 0xCEA00000, 0xFB40041C, 0xCEA40000, 0xFB400402, 0xCEAE0000, 0xFB400404, 0xCEBA0000, 0xFB400414, 0xCEBA0000, 0xFB400404, 0xCEBB0000, 0xFB400402, 0xCEC00000, 0xFB400402,
+		0xCECC0000, 0x3280404, 0xFB400404, 0xCEE30000, 0x3290404, 0xFB400402, 0xCEE40000, 0x3280404, 0xFB400404, 0xCF010000, 0x3290404, 0xFB400406, 0xCF010000, 0x3280404, 0xFB400404, 0xCF110000,
+		0x3290404, 0xFB400406, 0xCF110000, 0xFB400402, 0xCF600000, 0xFB400402, 0xCF800000, 0xFB400402, 0xCF860000, 0xFB400402, 0xCF8B0000, 0xFB400402, 0xCFAE0000, 0xFB400402, 0xCFAE0000, 0xFB400402,
+		0xCFBB0000, 0xFB400402, 0xCFBF0000, 0xFB400402, 0xD0020000, 0xFB400402, 0xD02B0000, 0xFB400402, 0xD07A0000, 0xFB400402, 0xD0990000, 0xFB400402, 0xD0CF0000, 0xFB400402, 0xD0DA0000, 0xFB400402,
+		0xD0E70000, 0xFB400402, 0xD0E70000, 0xFB400406, 0xD12A0000, 0xFB400404, 0xD13F0000, 0xFB400402, 0xD1400000, 0xFB400402, 0xD1450000, 0xFB400402, 0xD14D0000, 0xFB400402, 0xD14D0000, 0xFB400402,
+		0xD1540000, 0xFB400402, 0xD1640000, 0xFB400404, 0xD1650000, 0xFB400402, 0xD1670000, 0xFB400402, 0xD1680000, 0xFB400402, 0xD1690000, 0xFB400404, 0xD16B0000, 0x3280404, 0xFB400404, 0xD16B0000,
+		0x3290404, 0xFB400406, 0xD16B0000, 0xFB400402, 0xD16D0000, 0x3280404, 0xFB400404, 0xD16D0000, 0x3290404, 0xFB400406, 0xD16D0000, 0xFB400402, 0xD1770000, 0xFB400402, 0xD1800000, 0xFB400404,
+		0xD1820000, 0xFB400404, 0xD1820000, 0x2304, 0xFB400402, 0xD18D0000, 0xFB40041C, 0xD18D0000, 0xFB400402, 0xD1920000, 0xFB400402, 0xD1950000, 0xFB400404, 0xD1960000, 0xFB400402, 0xD1970000,
+		0xFB400406, 0xD1990000, 0xFB400402, 0xD1A40000, 0xFB400404, 0xD1AB0000, 0xFB400402, 0xD1AC0000, 0xFB400402, 0xD1B50000, 0xFB400402, 0xD1B50000, 0xFB400402, 0xD1B70000, 0xFB400402, 0xD1C90000,
+		0xFB400402, 0xD1CC0000, 0xFB400402, 0xD1DC0000, 0xFB400402, 0xD1DE0000, 0xFB400404, 0xD1E00000, 0xFB400404, 0xD1E00000, 0x2304, 0xFB400402, 0xD1F50000, 0xFB400404, 0xD1F50000, 0xFB400404,
+		0xD2000000, 0xFB400404, 0xD2000000, 0x2304, 0xFB400404, 0xD2020000, 0xFB400402, 0xD2030000, 0xFB400402, 0xD2070000, 0xFB400402, 0xD2070000, 0xFB400402, 0xD2170000, 0xFB40041C, 0xD21D0000,
+		0xFB400402, 0xD2290000, 0xFB400402, 0xD23A0000, 0xFB400402, 0xD23B0000, 0xFB400402, 0xD2460000, 0xFB40041C, 0xD24D0000, 0xFB400402, 0xD2720000, 0xFB40041C, 0xD2720000, 0xFB400402, 0xD2770000,
+		0xFB400402, 0xD2890000, 0xFB400402, 0xD29B0000, 0xFB400404, 0xD29B0000, 0xFB400402, 0xD2A30000, 0xFB400402, 0xD2B30000, 0x3280404, 0xFB400404, 0xD2B40000, 0x3290404, 0xFB400406, 0xD2B40000,
+		0xFB400402, 0xD2C70000, 0xFB400402, 0xD2C70000, 0xFB400402, 0xD2C90000, 0xFB400402, 0xD2C90000, 0xFB400402, 0xD2D20000, 0x38A0404, 0xFB400404, 0xD2DD0000, 0x38B0404, 0xFB400402, 0xD2DE0000,
+		0xFB400402, 0xD2E40000, 0xFB400402, 0xD2E40000, 0xFB400402, 0xD2F50000, 0xFB400404, 0xD2F90000, 0xFB400402, 0xD2FA0000, 0xFB400402, 0xD2FA0000, 0xFB400402, 0xD3050000, 0xFB400402, 0xD3060000,
+		0xFB400404, 0xD3150000, 0xFB400402, 0xD3170000, 0xFB400402, 0xD3170000, 0xFB400404, 0xD31A0000, 0xFB400404, 0xD3380000, 0xFB400406, 0xD33B0000, 0xFB400402, 0xD33F0000, 0xFB400404, 0xD3410000,
+		0xFB400404, 0xD3410000, 0x3280404, 0xFB400404, 0xD3410000, 0x3290404, 0xFB400406, 0xD3410000, 0xFB400404, 0xD3440000, 0xFB400404, 0xD3450000, 0xFB400402, 0xD3490000, 0xFB400402, 0xD3510000,
+		0xFB400402, 0xD3510000, 0x3280404, 0xFB400404, 0xD3540000, 0x3290404, 0xFB400406, 0xD3540000, 0xFB400402, 0xD35A0000, 0xFB400404, 0xD35C0000, 0xFB400404, 0xD35C0000, 0x2304, 0xFB400404,
+		0xD3690000, 0xFB400404, 0xD3690000, 0x2304, 0xFB400406, 0xD3700000, 0xFB400402, 0xD3730000, 0xFB400402, 0xD3750000, 0xFB400402, 0xD37D0000, 0xFB400402, 0xD37F0000, 0xFB400402, 0xD37F0000,
+		0xFB400402, 0xD37F0000, 0xFB400404, 0xD3820000, 0xFB400404, 0xD3820000, 0x2304, 0xFB400404, 0xD3B60000, 0xFB400402, 0xD3C30000, 0xFB400404, 0xD3C80000, 0xFB400402, 0xD3CA0000, 0xFB40041C,
+		0xD3CC0000, 0xFB400402, 0xD3DF0000, 0xFB400404, 0xD3E30000, 0xFB400402, 0xD3E50000, 0xFB400402, 0xD3EB0000, 0xFB400406, 0xD3EF0000, 0xFB400402, 0xD3F10000, 0xFB400406, 0xD3F30000, 0xFB40041C,
+		0xD3F30000, 0xFB400402, 0xD4060000, 0xFB40041C, 0xD4080000, 0x3280404, 0xFB400404, 0xD40D0000, 0x3290404, 0xFB400406, 0xD40D0000, 0xFB400402, 0xD40F0000, 0xFB400402, 0xD41D0000, 0xFB400402,
+		0xD4380000, 0xFB40041C, 0xD4390000, 0xFB400402, 0xD4420000, 0xFB400402, 0xD4480000, 0xFB400402, 0xD4680000, 0x3280404, 0xFB400404, 0xD47C0000, 0x3290404, 0xFB400402, 0xD49E0000, 0xFB400402,
+		0xD4A20000, 0xFB400402, 0xD4BD0000, 0xFB400402, 0xD4F60000, 0xFB400402, 0xD5100000, 0xFB400406, 0xD54F0000, 0xFB400402, 0xD5530000, 0xFB400402, 0xD5550000, 0xFB400402, 0xD5630000, 0xFB400402,
+		0xD5840000, 0xFB400402, 0xD5840000, 0xFB400402, 0xD5870000, 0xFB400402, 0xD5990000, 0xFB400402, 0xD5990000, 0xFB400402, 0xD59D0000, 0xFB400402, 0xD59D0000, 0xFB400402, 0xD5AB0000, 0xFB400402,
+		0xD5B30000, 0xFB40041C, 0xD5B60000, 0xFB400402, 0xD5C00000, 0xFB400402, 0xD5C20000, 0xFB400402, 0xD5E20000, 0xFB400402, 0xD6060000, 0xFB400402, 0xD6060000, 0xFB400402, 0xD6510000, 0xFB400402,
+		0xD6680000, 0xFB400402, 0xD6740000, 0xFB400404, 0xD6D70000, 0x3280404, 0xFB400404, 0xD6DB0000, 0x3290404, 0xFB400406, 0xD6DB0000, 0xFB400414, 0xD6DB0000, 0xFB400402, 0xD6F90000, 0xFB400402,
+		0xD7160000, 0xFB400402, 0xD7170000, 0xFB400404, 0xD71F0000, 0x3280404, 0xFB400404, 0xD71F0000, 0x3290404, 0xFB400406, 0xD71F0000, 0xFB400414, 0xD7300000, 0xFB400402, 0xD78B0000, 0xFB400402,
+		0xD7CE0000, 0xFB400402, 0xD7F40000, 0xFB400402, 0xD80D0000, 0xFB400402, 0xD8310000, 0xFB400402, 0xD8320000, 0xFB400402, 0xD8400000, 0xFB400402, 0xD85A0000, 0xFB400402, 0xD85A0000, 0xFB400402,
+		0xD85E0000, 0xFB400402, 0xD8A80000, 0xFB400402, 0xD8AC0000, 0xFB400402, 0xD8B30000, 0xFB400402, 0xD8D80000, 0xFB400402, 0xD8DF0000, 0xFB400404, 0xD8EB0000, 0xFB400402, 0xD8EE0000, 0xFB40041C,
+		0xD8F00000, 0xFB400402, 0xD8F20000, 0xFB400402, 0xD8F70000, 0xFB400404, 0xD9020000, 0xFB400402, 0xD9060000, 0xFB400404, 0xD90A0000, 0xFB400404, 0xD9150000, 0xFB400402, 0xD91A0000, 0xFB40041C,
+		0xD91A0000, 0xFB400406, 0xD91C0000, 0xFB400402, 0xD9220000, 0xFB400404, 0xD9270000, 0xFB40041C, 0xD9270000, 0xFB40041C, 0xEB630000, 0xFB400414, 0xD9290000, 0xFB40041C, 0xD9290000, 0xFB400402,
+		0xD9440000, 0xFB400402, 0xD9480000, 0xFB400402, 0xD9510000, 0xFB400402, 0xD9540000, 0xFB400402, 0xD9620000, 0xFB400402, 0xD9730000, 0xFB400404, 0xD9730000, 0xFB400406, 0xD9730000, 0xFB400402,
+		0xD9D80000, 0xFB400402, 0xD9EC0000, 0xFB400402, 0xDA1B0000, 0xFB400402, 0xDA270000, 0xFB400402, 0xDA620000, 0xFB400402, 0xDA660000, 0xFB400402, 0xDAB50000, 0xFB400402, 0xDB080000, 0xFB400402,
+		0xDB280000, 0xFB400402, 0xDB3E0000, 0xFB400402, 0xDB3E0000, 0xFB400404, 0xDB500000, 0xFB40041C, 0xDB570000, 0x3280404, 0xFB400404, 0xDB660000, 0x3290404, 0xFB400406, 0xDB660000, 0xFB400404,
+		0xDB800000, 0xFB400402, 0xDB850000, 0x38A0404, 0xFB400404, 0xDB890000, 0x38B0404, 0xFB400406, 0xDB970000, 0xFB400402, 0xDBC30000, 0xFB400402, 0xDBD80000, 0xFB400402, 0xDBE70000, 0xFB400402,
+		0xDBE70000, 0xFB400402, 0xDBE70000, 0xFB400402, 0xDBEE0000, 0xFB400402, 0xDBF30000, 0xFB400404, 0xDBF80000, 0xFB400402, 0xDBFF0000, 0xFB400402, 0xDC060000, 0xFB400404, 0xDC0F0000, 0xFB400404,
+		0xDC0F0000, 0x2304, 0xFB400404, 0xDC0F0000, 0x2324, 0xFB400402, 0xDC220000, 0xFB400404, 0xDC220000, 0xFB400404, 0xDC220000, 0xFB400404, 0xDC220000, 0x2304, 0xFB400404, 0xDC230000,
+		0xFB400404, 0xDC230000, 0x2304, 0xFB400404, 0xDC380000, 0xFB400402, 0xDC3F0000, 0xFB400402, 0xDC600000, 0xFB400402, 0xDC620000, 0xFB400402, 0xDC640000, 0xFB400402, 0xDC650000, 0xFB400402,
+		0xDC6E0000, 0xFB400402, 0xDC6E0000, 0xFB400404, 0xDC6E0000, 0xFB400404, 0xDC710000, 0xFB400402, 0xDC8D0000, 0xFB400402, 0xDCC00000, 0xFB400402, 0xDD190000, 0xFB400402, 0xDD430000, 0xFB400402,
+		0xDD500000, 0xFB400402, 0xDD6B0000, 0xFB400402, 0xDD6E0000, 0xFB400402, 0xDD7C0000, 0xFB400402, 0xDDB20000, 0xFB400402, 0xDDBA0000, 0xFB400404, 0xDDDB0000, 0xFB400402, 0xDDE10000, 0xFB400402,
+		0xDDE20000, 0xFB400404, 0xDDE50000, 0xFB400406, 0xDDE60000, 0xFB40041C, 0xDDE60000, 0xFB400404, 0xDDF10000, 0xFB400404, 0xDDF30000, 0xFB400402, 0xDDFD0000, 0xFB400404, 0xDDFE0000, 0xFB400402,
+		0xDE280000, 0xFB400402, 0xDE3D0000, 0xFB400402, 0xDE690000, 0xFB400404, 0xDE720000, 0xFB40041C, 0xDE730000, 0xFB40041C, 0xE2100000, 0xFB400402, 0xDE740000, 0xFB400404, 0xDE7A0000, 0xFB400404,
+		0xDE7A0000, 0xFB400406, 0xDE7C0000, 0xFB400404, 0xDE7F0000, 0xFB400402, 0xDEA60000, 0xFB400402, 0xDEB00000, 0xFB400402, 0xDEB30000, 0xFB400402, 0xDEB60000, 0xFB400402, 0xDEC90000, 0xFB400402,
+		0xDECA0000, 0xFB400402, 0xDECA0000, 0xFB400402, 0xDED20000, 0xFB400402, 0xDED30000, 0xFB400402, 0xDED90000, 0xFB400402, 0xDEEC0000, 0xFB400404, 0xDEF40000, 0xFB400402, 0xDEFE0000, 0xFB400404,
+		0xDEFE0000, 0xFB400402, 0xDF040000, 0xFB400404, 0xDF0B0000, 0xFB400404, 0xDF130000, 0xFB400402, 0xDF220000, 0xFB400402, 0xDF220000, 0xFB400404, 0xDF500000, 0xFB400404, 0xDF500000, 0x2304,
+		0xFB400404, 0xDF510000, 0xFB400402, 0xDF530000, 0xFB400404, 0xDF610000, 0xFB400402, 0xDF620000, 0xFB400402, 0xDF690000, 0xFB400402, 0xDF6B0000, 0xFB400404, 0xDF730000, 0xFB400402, 0xDF8B0000,
+		0xFB40041C, 0xDF8C0000, 0xFB400406, 0xDF970000, 0xFB400402, 0xDF9A0000, 0xFB400402, 0xDFA90000, 0xFB400402, 0xDFAD0000, 0xFB400404, 0xDFC30000, 0xFB400404, 0xDFC30000, 0x2304, 0xFB400404,
+		0xDFC40000, 0xFB400402, 0xDFCD0000, 0xFB400402, 0xDFD70000, 0xFB400402, 0xDFF50000, 0xFB400402, 0xDFF90000, 0xFB400402, 0xE0120000, 0xFB400402, 0xE01C0000, 0xFB400402, 0xE0750000, 0xFB400402,
+		0xE0810000, 0xFB400402, 0xE0940000, 0xFB400402, 0xE0940000, 0xFB400402, 0xE0C70000, 0xFB400402, 0xE0D80000, 0xFB400402, 0xE0E10000, 0xFB400402, 0xE1080000, 0xFB400402, 0xE1440000, 0xFB400402,
+		0xE1480000, 0xFB400402, 0xE14C0000, 0xFB400402, 0xE14C0000, 0xFB400402, 0xE14E0000, 0xFB400402, 0xE14E0000, 0xFB400402, 0xE1600000, 0xFB400402, 0xE1680000, 0xFB400402, 0xE17A0000, 0xFB400402,
+		0xE18E0000, 0xFB400402, 0xE18E0000, 0xFB400402, 0xE18E0000, 0xFB400402, 0xE1900000, 0xFB400402, 0xE1A40000, 0xFB400402, 0xE1AF0000, 0xFB400402, 0xE1B20000, 0xFB400402, 0xE1DE0000, 0xFB400402,
+		0xE1F20000, 0xFB400402, 0xE1F20000, 0xFB400402, 0xE1F20000, 0xFB400402, 0xE1F60000, 0xFB400402, 0xE1F60000, 0xFB400402, 0xE2000000, 0xFB400404, 0xE2080000, 0xFB400402, 0xE2100000, 0xFB400402,
+		0xE21B0000, 0xFB400402, 0xE22E0000, 0xFB400402, 0xE2340000, 0xFB400404, 0xE2360000, 0xFB400404, 0xE24B0000, 0xFB40041C, 0xE24B0000, 0xFB400404, 0xE24C0000, 0x38A0404, 0xFB400404, 0xE2530000,
+		0x38B0404, 0xFB40041C, 0xE2530000, 0xFB400402, 0xE25D0000, 0xFB40041C, 0xE2950000, 0xFB400402, 0xE2B10000, 0xFB400402, 0xE2C90000, 0xFB400402, 0xE2CF0000, 0xFB400402, 0xE2D30000, 0xFB400402,
+		0xE2D40000, 0xFB400402, 0xE2FC0000, 0xFB400402, 0xE2FE0000, 0xFB40041C, 0xE3070000, 0xFB400402, 0xE33D0000, 0xFB400402, 0xE3500000, 0xFB40041C, 0xE3550000, 0xFB400402, 0xE3680000, 0xFB400402,
+		0xE37B0000, 0xFB400402, 0xE3830000, 0xFB400402, 0xE3A00000, 0xFB400402, 0xE3A90000, 0xFB400402, 0xE3C40000, 0xFB400402, 0xE3C50000, 0xFB400402, 0xE3E40000, 0xFB400402, 0xE41C0000, 0xFB400402,
+		0xE4220000, 0xFB400402, 0xE4520000, 0xFB400402, 0xE4690000, 0xFB400402, 0xE4770000, 0xFB400402, 0xE47E0000, 0xFB400402, 0xE49A0000, 0xFB400402, 0xE49D0000, 0xFB400402, 0xE4C40000, 0xFB400404,
+		0xE52F0000, 0xFB400404, 0xE5340000, 0xFB400404, 0xE5350000, 0xFB400402, 0xE54F0000, 0xFB400402, 0xE54F0000, 0xFB400402, 0xE5560000, 0x38A0404, 0xFB400404, 0xE5570000, 0x38B0404, 0xFB400402,
+		0xE56C0000, 0xFB400402, 0xE5780000, 0xFB400404, 0xE5870000, 0xFB400406, 0xE5870000, 0xFB400404, 0xE5970000, 0xFB400402, 0xE5990000, 0xFB40041C, 0xE5990000, 0xFB400404, 0xE5A40000, 0xFB40041C,
+		0xE5B00000, 0xFB400404, 0xE5B90000, 0xFB400402, 0xE5C50000, 0xFB400404, 0xE5E00000, 0xFB400404, 0xE5E10000, 0xFB400402, 0xE5E20000, 0xFB400402, 0xE5E30000, 0xFB400404, 0xE5E50000, 0x3280404,
+		0xFB400404, 0xE5E50000, 0x3290404, 0xFB400406, 0xE5E50000, 0xFB400404, 0xE5E50000, 0x2304, 0xFB40041C, 0xE60E0000, 0xFB40041C, 0xECBB0000, 0xFB400402, 0xE6130000, 0xFB40041C, 0xE6200000,
+		0xFB40041C, 0xE62D0000, 0xFB40041C, 0xD48C0000, 0xFB400402, 0xE6490000, 0xFB400402, 0xE6740000, 0xFB400402, 0xE6740000, 0xFB400402, 0xE6880000, 0xFB400402, 0xE6910000, 0xFB400402, 0xE6910000,
+		0xFB400402, 0xE69C0000, 0xFB400402, 0xE6B40000, 0xFB400402, 0xE6C60000, 0xFB400404, 0xE6F00000, 0xFB400402, 0xE6F40000, 0xFB400402, 0xE6F80000, 0xFB400402, 0xE7000000, 0xFB400404, 0xE7080000,
+		0x3280404, 0xFB400404, 0xE7080000, 0x3290404, 0xFB400406, 0xE7080000, 0xFB40041C, 0xE7080000, 0xFB400404, 0xE7080000, 0x2304, 0x3280404, 0xFB400404, 0xE7090000, 0x3290404, 0xFB400406,
+		0xE7090000, 0xFB40041C, 0xE7090000, 0xFB400402, 0xE7170000, 0xFB400402, 0xE7170000, 0xFB400402, 0xE7170000, 0xFB400402, 0xE71B0000, 0xFB400402, 0xE71B0000, 0xFB400402, 0xE7210000, 0xFB400404,
+		0xE7280000, 0x3280404, 0xFB400404, 0xE7280000, 0x3290404, 0xFB400406, 0xE7280000, 0x38A0404, 0xFB400404, 0xE72C0000, 0x38B0404, 0xFB400402, 0xE74E0000, 0xFB400402, 0xE7530000, 0xFB400402,
+		0xE7560000, 0xFB400402, 0xE75E0000, 0xFB400402, 0xE77B0000, 0xFB400402, 0xE7850000, 0xFB400402, 0xE7970000, 0xFB400402, 0xE7F30000, 0xFB400402, 0xE7FA0000, 0xFB400402, 0xE8170000, 0xFB400402,
+		0xE81F0000, 0x3280404, 0xFB400404, 0xE82A0000, 0x3290404, 0xFB400406, 0xE82A0000, 0xFB40041C, 0xE82A0000, 0xFB40041C, 0xDF0F0000, 0xFB40041C, 0xCF1A0000, 0xFB40041C, 0xF93E0000, 0xFB400402,
+		0xE8520000, 0xFB400402, 0xE8810000, 0xFB400402, 0xE8850000, 0xFB400402, 0xE8850000, 0xFB400402, 0xE88E0000, 0xFB400402, 0xE8A80000, 0xFB400402, 0xE9140000, 0xFB400402, 0xE9420000, 0xFB400402,
+		0xE9A30000, 0xFB400402, 0xE9EA0000, 0xFB400402, 0xEA020000, 0xFB400402, 0xEA020000, 0xFB400402, 0xEA020000, 0xFB400402, 0xEA130000, 0xFB400402, 0xEAA80000, 0xFB400402, 0xEAD30000, 0xFB400402,
+		0xEADB0000, 0xFB400402, 0xEB040000, 0xFB400404, 0xEB200000, 0xFB400402, 0xEB210000, 0xFB400402, 0xEB540000, 0xFB400404, 0xEB620000, 0xFB400406, 0xEB630000, 0xFB400402, 0xEB720000, 0xFB400402,
+		0xEB770000, 0xFB400402, 0xEB790000, 0xFB400404, 0xEB790000, 0xFB400404, 0xEB7A0000, 0x2304, 0xFB400402, 0xEB9F0000, 0xFB400402, 0xEBAE0000, 0xFB400404, 0xEBB30000, 0xFB400402, 0xEBBA0000,
+		0xFB400402, 0xEBBA0000, 0xFB400402, 0xEBBA0000, 0xFB400402, 0xEBBB0000, 0xFB400404, 0xEBCB0000, 0xFB400404, 0xEBCD0000, 0xFB400404, 0xEBD40000, 0xFB400404, 0xEBDB0000, 0xFB400404, 0xEC0F0000,
+		0xFB400404, 0xEC110000, 0xFB400404, 0xEC140000, 0xFB400404, 0xEC340000, 0x3280404, 0xFB400404, 0xEC340000, 0x3290404, 0xFB400406, 0xEC340000, 0xFB400404, 0xEC350000, 0xFB400404, 0xEC3A0000,
+		0xFB400402, 0xEC4E0000, 0xFB400402, 0xEC670000, 0xFB400402, 0xEC880000, 0xFB400402, 0xECBF0000, 0xFB400402, 0xECCC0000, 0xFB400402, 0xECCD0000, 0xFB400402, 0xECE50000, 0xFB400406, 0xECE80000,
+		0xFB400402, 0xED160000, 0xFB400402, 0xED1B0000, 0xFB400402, 0xED1E0000, 0xFB400402, 0xED340000, 0xFB400402, 0xED3E0000, 0xFB400402, 0xED410000, 0xFB400402, 0xED410000, 0xFB400402, 0xED410000,
+		0xFB400402, 0xED690000, 0xFB400402, 0xED6A0000, 0xFB400402, 0xED770000, 0xFB400402, 0xED770000, 0xFB400402, 0xED780000, 0xFB400402, 0xED850000, 0xFB400402, 0xEDCB0000, 0xFB400402, 0xEDDA0000,
+		0xFB400402, 0xEDEA0000, 0xFB400402, 0xEDF90000, 0xFB400402, 0xEE1A0000, 0xFB400402, 0xEE2F0000, 0xFB400402, 0xEE6E0000, 0xFB40041C, 0xEE800000, 0xFB400402, 0xEE9C0000, 0xFB400402, 0xEEBA0000,
+		0xFB400402, 0xEEC70000, 0xFB400402, 0xEECB0000, 0xFB400402, 0xEECB0000, 0xFB400402, 0xEED10000, 0xFB400402, 0xEEDB0000, 0xFB400402, 0xEF0F0000, 0xFB40041C, 0xEF140000, 0xFB400402, 0xEF220000,
+		0xFB400402, 0xEF220000, 0xFB400402, 0xEF230000, 0xFB400402, 0xEF6E0000, 0xFB400402, 0xEFC60000, 0xFB400402, 0xEFEB0000, 0xFB400402, 0xEFFE0000, 0xFB400402, 0xF01B0000, 0xFB400402, 0xF01E0000,
+		0xFB400402, 0xF01E0000, 0xFB400402, 0xF0390000, 0xFB400402, 0xF04A0000, 0xFB400404, 0xF06B0000, 0x3280404, 0xFB400404, 0xF06B0000, 0x3290404, 0xFB400406, 0xF06B0000, 0xFB400404, 0xF06C0000,
+		0xFB400402, 0xF0700000, 0xFB400402, 0xF0770000, 0xFB400402, 0xF07D0000, 0xFB400402, 0xF0990000, 0xFB400402, 0xF0AD0000, 0x38A0404, 0xFB400404, 0xF0B90000, 0x38B0404, 0xFB400402, 0xF0C80000,
+		0xFB400402, 0xF0D90000, 0xFB40041C, 0xF1210000, 0xFB400402, 0xF1450000, 0xFB400402, 0xF1490000, 0xFB400402, 0xF16E0000, 0xFB400402, 0xF16E0000, 0xFB400402, 0xF19C0000, 0xFB400402, 0xF1CE0000,
+		0xFB400402, 0xF1D00000, 0xFB400402, 0xF2100000, 0xFB400402, 0xF21B0000, 0xFB400402, 0xF2280000, 0xFB400404, 0xF22A0000, 0xFB400402, 0xF22B0000, 0xFB400404, 0xF22B0000, 0xFB400404, 0xF22B0000,
+		0x2304, 0xFB400402, 0xF2350000, 0xFB400402, 0xF2350000, 0xFB400404, 0xF2360000, 0xFB400404, 0xF23B0000, 0xFB400404, 0xF23F0000, 0xFB400404, 0xF2470000, 0xFB400402, 0xF2500000, 0xFB400404,
+		0xF2590000, 0xFB400404, 0xF25B0000, 0xFB400404, 0xF25B0000, 0x2304, 0xFB400402, 0xF2620000, 0x3280404, 0xFB400404, 0xF2790000, 0x3290404, 0xFB400406, 0xF2790000, 0xFB400402, 0xF2800000,
+		0xFB400402, 0xF2950000, 0xFB400404, 0xF2AC0000, 0xFB400404, 0xF2AD0000, 0xFB400402, 0xF2AF0000, 0xFB400402, 0xF2C00000, 0xFB400402, 0xF2FC0000, 0xFB400402, 0xF32A0000, 0xFB400402, 0xF32A0000,
+		0xFB400402, 0xF3750000, 0xFB400402, 0xF37A0000, 0xFB400404, 0xF3840000, 0xFB400402, 0xF3870000, 0xFB400402, 0xF3870000, 0xFB400404, 0xF3890000, 0xFB400402, 0xF38B0000, 0xFB400404, 0xF38B0000,
+		0x2304, 0xFB400402, 0xF3A50000, 0xFB400402, 0xF3B20000, 0xFB400402, 0xF3DE0000, 0xFB400402, 0xF4060000, 0xFB400402, 0xF4090000, 0xFB400402, 0xF4220000, 0xFB400402, 0xF4470000, 0xFB400402,
+		0xF45C0000, 0xFB400402, 0xF4690000, 0xFB400402, 0xF4710000, 0xFB400402, 0xF4710000, 0xFB400402, 0xF4850000, 0xFB400402, 0xF4890000, 0xFB400402, 0xF4980000, 0xFB400402, 0xF4CA0000, 0xFB400404,
+		0xF4DC0000, 0xFB400404, 0xF4E60000, 0xFB400402, 0xF5060000, 0xFB400404, 0xF5180000, 0xFB400404, 0xF51F0000, 0xFB40041C, 0xF51F0000, 0xFB400402, 0xF5240000, 0xFB400404, 0xF5280000, 0xFB400404,
+		0xF5300000, 0xFB400414, 0xF5320000, 0xFB40041C, 0xF5330000, 0xFB400406, 0xF5370000, 0xFB400402, 0xF53B0000, 0xFB400402, 0xF53E0000, 0xFB400402, 0xF5590000, 0xFB400402, 0xF5650000, 0xFB400402,
+		0xF5700000, 0xFB400402, 0xF5700000, 0xFB400404, 0xF58B0000, 0xFB400404, 0xF58B0000, 0x2304, 0xFB400404, 0xF5920000, 0xFB400402, 0xF5E20000, 0xFB400402, 0xF6100000, 0xFB400402, 0xF61D0000,
+		0xFB400402, 0xF61F0000, 0xFB400402, 0xF6420000, 0xFB400402, 0xF6690000, 0xFB400404, 0xF6760000, 0xFB400404, 0xF67D0000, 0xFB400404, 0xF6AE0000, 0xFB400404, 0xF6BF0000, 0xFB400402, 0xF6CA0000,
+		0xFB400402, 0xF6CA0000, 0x38A0404, 0xFB400404, 0xF6D70000, 0x38B0404, 0xFB400402, 0xF6DB0000, 0x3280404, 0xFB400404, 0xF6E30000, 0x3290404, 0xFB400406, 0xF6E30000, 0xFB400402, 0xF6E70000,
+		0xFB400404, 0xF6EE0000, 0xFB400404, 0xF6EE0000, 0x2304, 0xFB400402, 0xF6F40000, 0xFB400402, 0xF6F40000, 0xFB400402, 0xF7010000, 0xFB400402, 0xF71E0000, 0xFB400402, 0xF71F0000, 0xFB400402,
+		0xF71F0000, 0xFB400402, 0xF7400000, 0xFB400402, 0xF74A0000, 0xFB400402, 0xF74A0000, 0xFB400402, 0xF78B0000, 0xFB400402, 0xF7A70000, 0xFB400404, 0xF7DB0000, 0xFB400404, 0xF7E20000, 0xFB400404,
+		0xF7F30000, 0xFB400402, 0xF84E0000, 0xFB400402, 0xF86B0000, 0xFB400402, 0xF88C0000, 0xFB400402, 0xF88C0000, 0xFB400402, 0xF8910000, 0xFB400402, 0xF8CA0000, 0xFB400402, 0xF8CC0000, 0xFB400402,
+		0xF8CC0000, 0xFB400402, 0xF8FB0000, 0xFB400402, 0xF92A0000, 0xFB400404, 0xF93A0000, 0xFB400404, 0xF93A0000, 0x2304, 0xFB400404, 0xF93B0000, 0xFB400402, 0xF93C0000, 0xFB400402, 0xF93E0000,
+		0x3280404, 0xFB400404, 0xF93E0000, 0x3290404, 0xFB400406, 0xF93E0000, 0xFB400402, 0xF9480000, 0xFB400402, 0xF9490000, 0xFB400402, 0xF9500000, 0xFB400402, 0xF9560000, 0xFB400402, 0xF9560000,
+		0xFB400402, 0xF95D0000, 0x3280404, 0xFB400404, 0xF95D0000, 0x3290404, 0xFB400406, 0xF95D0000, 0xFB400402, 0xF95E0000, 0xFB400402, 0xF9650000, 0x3280404, 0xFB400404, 0xF96D0000, 0x3290404,
+		0xFB400402, 0xF97F0000, 0xFB40041C, 0xF9810000, 0xFB400402, 0xF98D0000, 0xFB400402, 0xF98E0000, 0xFB400402, 0xF98F0000, 0xFB400402, 0xF98F0000, 0xFB400402, 0xF9AE0000, 0xFB400404, 0xF9B80000,
+		0xFB400404, 0xF9BE0000, 0xFB400402, 0xF9CA0000, 0xFB400406, 0xF9D80000, 0xFB400402, 0xF9EB0000, 0xFB400402, 0xFA1C0000, 0xFB400402, 0xFA400000, 0xFB400402, 0xFA400000, 0xFB400402, 0xFA4A0000,
+		0xFB400402, 0xFA4F0000, 0xFB400404, 0xFA740000, 0xFB40041C, 0xFA7A0000, 0xFB400402, 0xFA810000, 0xFB400402, 0xFAB10000, 0xFB400402, 0xFACB0000, 0xFB400404, 0xFACB0000, 0xFB400402, 0xFAEE0000,
+		0xFB400404, 0xFAF90000, 0xFB400404, 0xFAF90000, 0x2304, 0xFB400402, 0xFB200000, 0xFB400406, 0xFB8F0000, 0xFB400402, 0xFBC00000, 0xFB400402, 0xFBC00000, 0xFB400402, 0xFBC60000, 0xFB400402,
+		0xFBC90000, 0xFB400402, 0xFC3E0000, 0xFB400402, 0xFC600000, 0xFB400404, 0xFC730000, 0xFB400402, 0xFC7B0000, 0xFB400402, 0xFC920000, 0xFB400402, 0xFCBE0000, 0xFB400402, 0xFCD20000, 0xFB400402,
+		0xFCD60000, 0xFB400402, 0xFCE30000, 0xFB400402, 0xFCE70000, 0xFB400402, 0xFCE80000, 0xFB400404, 0xFCF80000, 0xFB400404, 0xFCF90000, 0xFB400402, 0xFD000000, 0xFB400402, 0xFD100000, 0xFB400402,
+		0xFD220000, 0xFB400402, 0xFD2F0000, 0xFB40041C, 0xFD420000, 0xFB400402, 0xFD5B0000, 0xFB400402, 0xFD630000, 0xFB400402, 0xFDA00000, 0xFB400402, 0xFDBE0000, 0xFB400402, 0xFDC70000, 0xFB400402,
+		0xFDF40000, 0xFB400402, 0xFDF40000, 0xFB400402, 0xFDF40000, 0xFB400402, 0xFE020000, 0xFB400402, 0xFE090000, 0xFB400402, 0xFE370000, 0xFB400402, 0xFE410000, 0xFB400402, 0xFE450000, 0xFB400404,
+		0xFE9F0000, 0xFB400404, 0xFF360000, 0xFB400402, 0xFF3E0000, 0xFB400404, 0xFF510000, 0xFB400404, 0xFF520000, 0xFB400404, 0xFF520000, 0x2304, 0xFB400404, 0xFF530000, 0xFB400404, 0xFF530000,
+		0x2304, 0xFB400404, 0xFF530000, 0x2324, 0xFB400402, 0xFF720000, 0xFB400402, 0xFF790000, 0xFB400402, 0xFF7A0000, 0xFB400402, 0xFF850000, 0xFB400404, 0xFF8A0000, 0xFB400404, 0xFF8A0000,
+		0x2304, 0xFB400404, 0xFF8A0000, 0x2324, 0xFB400404, 0xFF8B0000, 0xFB400402, 0xFF950000, 0xFB400402, 0xFF9A0000, 0xFB400402, 0xFFBD0000, 0xFB400404, 0xFFBD0000, 0xFB400402, 0xFFFA0000,
+		0xFB410402, 0x80010000, 0xFB410404, 0x80010000, 0xFB410404, 0x80020000, 0xFB410402, 0x80050000, 0xFB410402, 0x80050000, 0xFB410402, 0x80050000, 0xFB410404, 0x800C0000, 0xFB410404, 0x80120000,
+		0xFB410404, 0x80330000, 0xFB410402, 0x80460000, 0xFB410402, 0x80600000, 0xFB410402, 0x806F0000, 0xFB410402, 0x80700000, 0xFB410402, 0x807E0000, 0xFB410404, 0x807F0000, 0xFB410404, 0x807F0000,
+		0x2304, 0xFB410404, 0x80800000, 0xFB410404, 0x80890000, 0xFB410404, 0x80890000, 0x2304, 0xFB410402, 0x808B0000, 0xFB410402, 0x80AD0000, 0xFB410402, 0x80B20000, 0xFB410402, 0x81030000,
+		0xFB410402, 0x813E0000, 0xFB410402, 0x81D80000, 0xFB410404, 0x81E30000, 0xFB410402, 0x81E80000, 0xFB410404, 0x81EA0000, 0x3280404, 0xFB410404, 0x81EA0000, 0x3290404, 0xFB410402, 0x81ED0000,
+		0xFB410404, 0x81F30000, 0x3280404, 0xFB410404, 0x81F30000, 0x3290404, 0xFB410404, 0x81FC0000, 0xFB410404, 0x81FC0000, 0x2304, 0xFB410402, 0x82010000, 0xFB410402, 0x82010000, 0xFB410402,
+		0x82040000, 0xFB410404, 0x820C0000, 0xFB410402, 0x82180000, 0xFB410404, 0x821B0000, 0xFB410404, 0x821F0000, 0xFB410404, 0x826E0000, 0xFB410402, 0x826F0000, 0xFB410404, 0x82720000, 0xFB410404,
+		0x82780000, 0xFB410402, 0x82790000, 0xFB410402, 0x82790000, 0xFB410404, 0x82790000, 0xFB410404, 0x82790000, 0x2304, 0xFB410404, 0x82790000, 0x2324, 0xFB410402, 0x828B0000, 0xFB410402,
+		0x82910000, 0xFB410402, 0x829D0000, 0xFB410402, 0x82B10000, 0xFB410402, 0x82B30000, 0xFB410402, 0x82BD0000, 0xFB410402, 0x82E50000, 0xFB410402, 0x82E50000, 0xFB410402, 0x82E60000, 0xFB410402,
+		0x831D0000, 0xFB410402, 0x83230000, 0xFB410402, 0x83360000, 0xFB410402, 0x83520000, 0xFB410402, 0x83530000, 0xFB410402, 0x83630000, 0xFB410402, 0x83AD0000, 0xFB410402, 0x83BD0000, 0xFB410402,
+		0x83C90000, 0xFB410402, 0x83CA0000, 0xFB410402, 0x83CC0000, 0xFB410402, 0x83DC0000, 0xFB410402, 0x83E70000, 0xFB410402, 0x83EF0000, 0xFB410402, 0x83F10000, 0xFB410402, 0x843D0000, 0xFB410402,
+		0x84490000, 0xFB410402, 0x84570000, 0xFB410402, 0x84570000, 0xFB410402, 0x84EE0000, 0xFB410402, 0x84F10000, 0xFB410402, 0x84F30000, 0xFB410402, 0x84FC0000, 0xFB410402, 0x85160000, 0xFB410402,
+		0x85640000, 0xFB410402, 0x85CD0000, 0xFB410402, 0x85FA0000, 0xFB410402, 0x86060000, 0xFB410402, 0x86120000, 0xFB410402, 0x862D0000, 0xFB410402, 0x863F0000, 0xFB410404, 0x864D0000, 0xFB410404,
+		0x864E0000, 0xFB410402, 0x86500000, 0xFB410402, 0x865C0000, 0xFB410402, 0x865C0000, 0xFB410402, 0x86670000, 0xFB410402, 0x86690000, 0xFB410404, 0x866B0000, 0xFB410402, 0x86880000, 0xFB410402,
+		0x86A90000, 0xFB410402, 0x86E20000, 0xFB410402, 0x870E0000, 0xFB410402, 0x87280000, 0xFB410402, 0x876B0000, 0xFB410402, 0x87790000, 0xFB410402, 0x87790000, 0xFB410402, 0x87860000, 0xFB410402,
+		0x87BA0000, 0xFB410402, 0x87E10000, 0xFB410402, 0x88010000, 0xFB410402, 0x881F0000, 0xFB410404, 0x88400000, 0xFB410402, 0x884C0000, 0xFB410404, 0x884C0000, 0xFB410402, 0x88600000, 0xFB410402,
+		0x88630000, 0xFB410404, 0x88630000, 0xFB410404, 0x88640000, 0xFB410402, 0x88C20000, 0xFB410402, 0x88CF0000, 0xFB410402, 0x88D70000, 0xFB410402, 0x88DE0000, 0xFB410402, 0x88E10000, 0xFB410402,
+		0x88F80000, 0xFB410402, 0x88FA0000, 0xFB410402, 0x89100000, 0xFB410402, 0x89410000, 0xFB410402, 0x89640000, 0xFB410404, 0x897E0000, 0xFB410404, 0x897F0000, 0xFB410404, 0x89800000, 0xFB410402,
+		0x89860000, 0xFB410402, 0x898B0000, 0xFB410404, 0x898B0000, 0xFB410402, 0x89960000, 0xFB410402, 0x89960000, 0xFB410404, 0x89C10000, 0xFB410404, 0x89D20000, 0xFB410404, 0x89D20000, 0xFB410404,
+		0x89D20000, 0x2304, 0xFB41041C, 0x89E30000, 0xFB410404, 0x8A000000, 0xFB410402, 0x8AA00000, 0xFB410402, 0x8AAA0000, 0xFB410402, 0x8AAA0000, 0xFB410402, 0x8ABF0000, 0xFB410402, 0x8ACB0000,
+		0xFB410402, 0x8AD20000, 0xFB410402, 0x8AD60000, 0xFB410402, 0x8AED0000, 0xFB410402, 0x8AED0000, 0xFB410402, 0x8AF80000, 0xFB410402, 0x8AF80000, 0xFB410402, 0x8AFE0000, 0xFB410402, 0x8AFE0000,
+		0xFB410402, 0x8B010000, 0xFB410402, 0x8B010000, 0xFB410402, 0x8B390000, 0xFB410402, 0x8B390000, 0xFB410402, 0x8B580000, 0xFB410402, 0x8B800000, 0xFB410402, 0x8B8A0000, 0xFB410402, 0x8B8A0000,
+		0xFB410404, 0x8BA00000, 0xFB410404, 0x8C370000, 0xFB410404, 0x8C460000, 0xFB410402, 0x8C480000, 0xFB410402, 0x8C550000, 0xFB410404, 0x8C550000, 0xFB410404, 0x8C780000, 0xFB410404, 0x8C9D0000,
+		0x3280404, 0xFB410404, 0x8CA10000, 0x3290404, 0xFB410406, 0x8CA10000, 0xFB41041C, 0x8CA90000, 0xFB410402, 0x8CAB0000, 0xFB410402, 0x8CC10000, 0xFB410402, 0x8CC20000, 0x3280404, 0xFB410404,
+		0x8CC70000, 0x3290404, 0xFB410406, 0x8CC70000, 0xFB410402, 0x8CC80000, 0xFB410402, 0x8CD30000, 0xFB410402, 0x8D080000, 0xFB410402, 0x8D080000, 0xFB410402, 0x8D1B0000, 0xFB410404, 0x8D1D0000,
+		0xFB410404, 0x8D640000, 0xFB410404, 0x8D700000, 0xFB41041C, 0x8D700000, 0xFB410402, 0x8D770000, 0xFB410404, 0x8DB30000, 0xFB410404, 0x8DB30000, 0x2304, 0xFB410402, 0x8DBC0000, 0xFB410402,
+		0x8DCB0000, 0xFB410402, 0x8DEF0000, 0xFB410402, 0x8DF00000, 0xFB410404, 0x8EAB0000, 0xFB410402, 0x8ECA0000, 0xFB410404, 0x8ECA0000, 0xFB410402, 0x8ED40000, 0xFB410402, 0x8F260000, 0xFB410402,
+		0x8F2A0000, 0xFB410402, 0x8F380000, 0xFB410402, 0x8F380000, 0xFB410402, 0x8F3B0000, 0xFB410402, 0x8F620000, 0xFB410404, 0x8F660000, 0xFB410404, 0x8F9B0000, 0xFB410402, 0x8F9E0000, 0xFB410402,
+		0x8FB00000, 0xFB410404, 0x8FB00000, 0xFB410404, 0x8FB50000, 0xFB410402, 0x8FB60000, 0xFB410404, 0x8FB60000, 0xFB410404, 0x8FB60000, 0x2304, 0xFB410404, 0x8FB60000, 0x2324, 0xFB410402,
+		0x90230000, 0xFB410402, 0x90380000, 0xFB410402, 0x90380000, 0xFB41041C, 0x904A0000, 0xFB410406, 0x90690000, 0xFB410402, 0x90720000, 0xFB410402, 0x907C0000, 0xFB410402, 0x908F0000, 0xFB410404,
+		0x90910000, 0xFB410404, 0x90910000, 0x2304, 0xFB410402, 0x90940000, 0xFB410402, 0x90CE0000, 0xFB410402, 0x90DE0000, 0xFB410402, 0x90F10000, 0xFB410402, 0x90FD0000, 0xFB410402, 0x91110000,
+		0xFB410402, 0x911B0000, 0xFB410404, 0x91490000, 0xFB41041C, 0x914D0000, 0xFB410402, 0x916A0000, 0xFB410402, 0x91990000, 0xFB410402, 0x91B40000, 0xFB410404, 0x91C60000, 0xFB410402, 0x91CC0000,
+		0xFB410404, 0x91CC0000, 0xFB410402, 0x91CF0000, 0xFB410402, 0x91D10000, 0xFB410404, 0x91D10000, 0x3280404, 0xFB410404, 0x91D10000, 0x3290404, 0xFB410406, 0x91D10000, 0xFB410402, 0x92340000,
+		0xFB410402, 0x92380000, 0xFB410402, 0x92760000, 0xFB410402, 0x927C0000, 0xFB410402, 0x92D70000, 0xFB410402, 0x92D80000, 0xFB410402, 0x93040000, 0xFB410402, 0x934A0000, 0xFB410402, 0x93F90000,
+		0xFB410402, 0x94150000, 0xFB410404, 0x94850000, 0xFB410404, 0x95770000, 0xFB410404, 0x95770000, 0xFB410404, 0x95780000, 0xFB410404, 0x957F0000, 0xFB410404, 0x95800000, 0xFB410402, 0x958B0000,
+		0xFB410402, 0x95AD0000, 0xFB410402, 0x95B70000, 0xFB410404, 0x95E80000, 0xFB410404, 0x961C0000, 0xFB410404, 0x961C0000, 0x2304, 0xFB410404, 0x961D0000, 0xFB410402, 0x962E0000, 0xFB410402,
+		0x964B0000, 0xFB410402, 0x964D0000, 0xFB410402, 0x96750000, 0xFB410402, 0x96780000, 0xFB410402, 0x967C0000, 0xFB410402, 0x96860000, 0xFB410402, 0x96A30000, 0xFB410404, 0x96B60000, 0xFB410402,
+		0x96B70000, 0xFB410402, 0x96B80000, 0xFB410404, 0x96B90000, 0xFB410402, 0x96C30000, 0xFB410402, 0x96E20000, 0xFB410402, 0x96E30000, 0xFB410402, 0x96E30000, 0xFB410404, 0x96E80000, 0xFB410404,
+		0x96E80000, 0x2304, 0xFB410402, 0x96F60000, 0xFB410402, 0x96F70000, 0xFB410402, 0x97230000, 0xFB410402, 0x97320000, 0xFB410402, 0x97480000, 0xFB410404, 0x97510000, 0xFB410404, 0x97520000,
+		0xFB410402, 0x97560000, 0xFB410402, 0x97560000, 0xFB410404, 0x975E0000, 0xFB410404, 0x97620000, 0xFB410404, 0x97690000, 0xFB410404, 0x97CB0000, 0xFB410402, 0x97DB0000, 0xFB410402, 0x97E00000,
+		0xFB410404, 0x97E60000, 0xFB410404, 0x97ED0000, 0xFB410404, 0x97F30000, 0xFB410402, 0x97FF0000, 0xFB410402, 0x97FF0000, 0xFB410404, 0x98010000, 0xFB410406, 0x98050000, 0xFB410402, 0x980B0000,
+		0xFB410402, 0x980B0000, 0xFB410402, 0x980B0000, 0xFB410402, 0x98180000, 0xFB410402, 0x98290000, 0xFB410402, 0x983B0000, 0xFB410402, 0x983B0000, 0xFB410402, 0x985E0000, 0xFB410404, 0x98750000,
+		0xFB410404, 0x98A80000, 0xFB410404, 0x98CE0000, 0xFB410404, 0x98DB0000, 0xFB410404, 0x98DE0000, 0xFB410404, 0x98DF0000, 0xFB410404, 0x98DF0000, 0xFB410404, 0x98E00000, 0xFB410404, 0x98E00000,
+		0x2304, 0xFB410402, 0x98E20000, 0xFB410402, 0x98EF0000, 0xFB410402, 0x98FC0000, 0xFB410402, 0x99280000, 0xFB410402, 0x99290000, 0xFB410404, 0x99630000, 0xFB410404, 0x99960000, 0xFB410404,
+		0x99960000, 0x2304, 0xFB410404, 0x99990000, 0xFB410402, 0x99A70000, 0xFB410404, 0x99AC0000, 0xFB410402, 0x99C20000, 0xFB410402, 0x99F10000, 0xFB410402, 0x99FE0000, 0xFB410402, 0x9A6A0000,
+		0xFB410404, 0x9A6C0000, 0xFB410404, 0x9AA80000, 0xFB410404, 0x9AA80000, 0x2304, 0xFB410404, 0x9AD80000, 0xFB410404, 0x9ADF0000, 0xFB410402, 0x9B120000, 0xFB410402, 0x9B120000, 0xFB410404,
+		0x9B250000, 0xFB410404, 0x9B2F0000, 0xFB410404, 0x9B320000, 0xFB410404, 0x9B3C0000, 0xFB410404, 0x9B3C0000, 0x2304, 0xFB410404, 0x9B5A0000, 0xFB410402, 0x9B6F0000, 0xFB410402, 0x9C400000,
+		0xFB410402, 0x9C570000, 0xFB410404, 0x9C7C0000, 0xFB410404, 0x9CE50000, 0xFB410402, 0x9CFD0000, 0xFB410402, 0x9D670000, 0xFB410402, 0x9DB40000, 0xFB410402, 0x9DFA0000, 0xFB410402, 0x9E1E0000,
+		0xFB410404, 0x9E1F0000, 0xFB410404, 0x9E750000, 0xFB410404, 0x9E750000, 0x2304, 0xFB410402, 0x9E7F0000, 0xFB410404, 0x9E7F0000, 0xFB410402, 0x9E970000, 0xFB410402, 0x9E9F0000, 0xFB410404,
+		0x9EA50000, 0xFB410404, 0x9EA60000, 0xFB410402, 0x9EBB0000, 0xFB410404, 0x9EBB0000, 0xFB410404, 0x9EC30000, 0xFB410404, 0x9EC40000, 0xFB410404, 0x9ECD0000, 0xFB410402, 0x9ECE0000, 0xFB410404,
+		0x9ED10000, 0xFB410402, 0x9EF90000, 0xFB410404, 0x9EF90000, 0xFB410404, 0x9EFD0000, 0xFB410402, 0x9EFE0000, 0xFB410404, 0x9EFE0000, 0xFB410402, 0x9F050000, 0xFB410404, 0x9F0E0000, 0xFB410402,
+		0x9F0F0000, 0xFB410404, 0x9F130000, 0xFB410402, 0x9F160000, 0xFB410404, 0x9F200000, 0xFB410402, 0x9F3B0000, 0xFB410404, 0x9F3B0000, 0xFB410402, 0x9F430000, 0xFB410404, 0x9F4A0000, 0xFB410404,
+		0x9F4A0000, 0x2304, 0xFB410404, 0x9F500000, 0xFB410404, 0x9F520000, 0xFB410404, 0x9F520000, 0x2304, 0xFB410404, 0x9F7F0000, 0xFB410402, 0x9F8D0000, 0xFB410404, 0x9F8D0000, 0xFB410404,
+		0x9F8D0000, 0x2304, 0xFB410402, 0x9F8E0000, 0xFB410404, 0x9F990000, 0xFB410402, 0x9F9C0000, 0xFB410402, 0x9F9C0000, 0xFB410402, 0x9F9C0000, 0xFB410404, 0x9F9C0000, 0xFB410404, 0x9F9C0000,
+		0x2304, 0xFB410404, 0x9F9C0000, 0x2324, 0xFB410404, 0x9F9F0000, 0xFB410404, 0x9FA00000, 0xFB410402, 0xFA0E0000, 0xFB410402, 0xFA0F0000, 0xFB410402, 0xFA110000, 0xFB410402, 0xFA130000,
+		0xFB410402, 0xFA140000, 0xFB410402, 0xFA1F0000, 0xFB410402, 0xFA210000, 0xFB410402, 0xFA230000, 0xFB410402, 0xFA240000, 0xFB410402, 0xFA270000, 0xFB410402, 0xFA280000, 0xFB410402, 0xFA290000,
+		0xFB800402, 0xB49E0000, 0xFB800402, 0xB4B90000, 0xFB800402, 0xB4BB0000, 0xFB800402, 0xB4DF0000, 0xFB800402, 0xB5150000, 0xFB800402, 0xB6EE0000, 0xFB800402, 0xB6FC0000, 0xFB800402, 0xB7810000,
+		0xFB800402, 0xB82F0000, 0xFB800402, 0xB8620000, 0xFB800402, 0xB87C0000, 0xFB800402, 0xB8C70000, 0xFB800402, 0xB8E30000, 0xFB800402, 0xB91C0000, 0xFB800402, 0xB93A0000, 0xFB800402, 0xBA2E0000,
+		0xFB800402, 0xBA6C0000, 0xFB800402, 0xBAE40000, 0xFB800402, 0xBB080000, 0xFB800402, 0xBB190000, 0xFB800402, 0xBB490000, 0xFB800402, 0xBB9D0000, 0xFB800402, 0xBB9D0000, 0xFB800402, 0xBC180000,
+		0xFB800402, 0xBC4E0000, 0xFB800402, 0xBD330000, 0xFB800402, 0xBD960000, 0xFB800402, 0xBEAC0000, 0xFB800402, 0xBEB80000, 0xFB800402, 0xBEB80000, 0xFB800402, 0xBF1B0000, 0xFB800402, 0xBFFC0000,
+		0xFB800402, 0xC0080000, 0xFB800402, 0xC0180000, 0xFB800402, 0xC0390000, 0xFB800402, 0xC0390000, 0xFB800402, 0xC0460000, 0xFB800402, 0xC0960000, 0xFB800402, 0xC0E30000, 0xFB800402, 0xC12F0000,
+		0xFB800402, 0xC2020000, 0xFB800402, 0xC2270000, 0xFB800402, 0xC2A00000, 0xFB800402, 0xC3010000, 0xFB800402, 0xC3340000, 0xFB800402, 0xC3590000, 0xFB800402, 0xC3D50000, 0xFB800402, 0xC3D90000,
+		0xFB800402, 0xC40B0000, 0xFB800402, 0xC46B0000, 0xFB800402, 0xC52B0000, 0xFB800402, 0xC55D0000, 0xFB800402, 0xC5610000, 0xFB800402, 0xC56B0000, 0xFB800402, 0xC5D70000, 0xFB800402, 0xC5F90000,
+		0xFB800402, 0xC6350000, 0xFB800402, 0xC6BE0000, 0xFB800402, 0xC6C70000, 0xFB800402, 0xC9950000, 0xFB800402, 0xC9E60000, 0xFB800402, 0xCA6E0000, 0xFB800402, 0xCA760000, 0xFB800402, 0xCAB20000,
+		0xFB800402, 0xCB330000, 0xFB800402, 0xCBCE0000, 0xFB800402, 0xCCCE0000, 0xFB800402, 0xCCED0000, 0xFB800402, 0xCCF80000, 0xFB800402, 0xCD560000, 0xFB840402, 0x81220000, 0xFB840402, 0x851C0000,
+		0xFB840402, 0x85250000, 0xFB840402, 0x854B0000, 0xFB840402, 0x863A0000, 0xFB840402, 0x88040000, 0xFB840402, 0x88DE0000, 0xFB840402, 0x8A2C0000, 0xFB840402, 0x8B630000, 0xFB840402, 0x94E40000,
+		0xFB840402, 0x96A80000, 0xFB840402, 0x96EA0000, 0xFB840402, 0x99C80000, 0xFB840402, 0x9B180000, 0xFB840402, 0x9D0B0000, 0xFB840402, 0x9DE40000, 0xFB840402, 0x9DE60000, 0xFB840402, 0xA1830000,
+		0xFB840402, 0xA19F0000, 0xFB840402, 0xA3310000, 0xFB840402, 0xA3310000, 0xFB840402, 0xA6D40000, 0xFB840402, 0xA8440000, 0xFB840402, 0xA84A0000, 0xFB840402, 0xAB0C0000, 0xFB840402, 0xABF10000,
+		0xFB840402, 0xB00A0000, 0xFB840402, 0xB2B80000, 0xFB840402, 0xB35F0000, 0xFB840402, 0xB3930000, 0xFB840402, 0xB39C0000, 0xFB840402, 0xB3C30000, 0xFB840402, 0xB3D50000, 0xFB840402, 0xB46D0000,
+		0xFB840402, 0xB6A30000, 0xFB840402, 0xB8A70000, 0xFB840402, 0xBA8D0000, 0xFB840402, 0xBAFA0000, 0xFB840402, 0xBCBC0000, 0xFB840402, 0xBD1E0000, 0xFB840402, 0xBED10000, 0xFB840402, 0xBF5E0000,
+		0xFB840402, 0xBF8E0000, 0xFB840402, 0xC2630000, 0xFB840402, 0xC2EE0000, 0xFB840402, 0xC3AB0000, 0xFB840402, 0xC6080000, 0xFB840402, 0xC7350000, 0xFB840402, 0xC8140000, 0xFB840402, 0xCC360000,
+		0xFB840402, 0xCC920000, 0xFB840402, 0xCFA10000, 0xFB840402, 0xCFB80000, 0xFB840402, 0xD0440000, 0xFB840402, 0xD0F20000, 0xFB840402, 0xD0F30000, 0xFB840402, 0xD1190000, 0xFB840402, 0xD1330000,
+		0xFB840402, 0xD2490000, 0xFB840402, 0xD41D0000, 0xFB840402, 0xD6260000, 0xFB840402, 0xD69A0000, 0xFB840402, 0xD6C50000, 0xFB840402, 0xD97C0000, 0xFB840402, 0xDAA70000, 0xFB840402, 0xDAA70000,
+		0xFB840402, 0xDBAB0000, 0xFB840402, 0xDC800000, 0xFB840402, 0xDCD00000, 0xFB840402, 0xDF860000, 0xFB840402, 0xE1DA0000, 0xFB840402, 0xE2280000, 0xFB840402, 0xE2470000, 0xFB840402, 0xE2D90000,
+		0xFB840402, 0xE33E0000, 0xFB840402, 0xE4DA0000, 0xFB840402, 0xE5230000, 0xFB840402, 0xE5A80000, 0xFB840402, 0xE7A70000, 0xFB840402, 0xE7B50000, 0xFB840402, 0xEB3C0000, 0xFB840402, 0xEC360000,
+		0xFB840402, 0xECD50000, 0xFB840402, 0xED6B0000, 0xFB840402, 0xEF2C0000, 0xFB840402, 0xEFB10000, 0xFB840402, 0xF0D20000, 0xFB840402, 0xF3CA0000, 0xFB840402, 0xF6670000, 0xFB840402, 0xF8AE0000,
+		0xFB840402, 0xF9660000, 0xFB840402, 0xFCA80000, 0xFB840402, 0xFED30000, 0xFB840402, 0xFF2F0000, 0xFB850402, 0x85D20000, 0xFB850402, 0x85ED0000, 0xFB850402, 0x872E0000, 0xFB850402, 0x8BFA0000,
+		0xFB850402, 0x8D770000, 0xFB850402, 0x91450000, 0xFB850402, 0x91DF0000, 0xFB850402, 0x921A0000, 0xFB850402, 0x940A0000, 0xFB850402, 0x94960000, 0xFB850402, 0x95B60000, 0xFB850402, 0x9B300000,
+		0xFB850402, 0xA0CE0000, 0xFB850402, 0xA1050000, 0xFB850402, 0xA20E0000, 0xFB850402, 0xA2910000, 0xFB850402, 0xA3920000, 0xFB850402, 0xA6000000, 0xFFFD0402,
+	}
+)
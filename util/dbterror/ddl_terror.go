@@ -269,6 +269,16 @@ var (
 	ErrWrongObject = ClassDDL.NewStd(mysql.ErrWrongObject)
 	// ErrTableCantHandleFt returns FULLTEXT keys are not supported by table type
 	ErrTableCantHandleFt = ClassDDL.NewStd(mysql.ErrTableCantHandleFt)
+	// ErrBadFtColumn returns column cannot be part of FULLTEXT index.
+	ErrBadFtColumn = ClassDDL.NewStd(mysql.ErrBadFtColumn)
+	// ErrFulltextNotSupportedWithPartitioning returns FULLTEXT index is not supported for partitioned tables.
+	ErrFulltextNotSupportedWithPartitioning = ClassDDL.NewStd(mysql.ErrFulltextNotSupportedWithPartitioning)
+	// ErrInnodbNoFtTempTable returns cannot create FULLTEXT index on temporary table.
+	ErrInnodbNoFtTempTable = ClassDDL.NewStd(mysql.ErrInnodbNoFtTempTable)
+	// ErrFulltextFunctionalIndex returns fulltext expression index is not supported.
+	ErrFulltextFunctionalIndex = ClassDDL.NewStd(mysql.ErrFulltextFunctionalIndex)
+	// ErrFtParserNotDefined returns the full-text parser is not defined.
+	ErrFtParserNotDefined = ClassDDL.NewStd(mysql.ErrFunctionNotDefined)
 	// ErrFieldNotFoundPart returns an error when 'partition by columns' are not found in table columns.
 	ErrFieldNotFoundPart = ClassDDL.NewStd(mysql.ErrFieldNotFoundPart)
 	// ErrWrongTypeColumnValue returns 'Partition column values of incorrect type'
@@ -469,6 +479,52 @@ var (
 	ErrCheckConstraintUsingFKReferActionColumn = ClassDDL.NewStd(mysql.ErrCheckConstraintClauseUsingFKReferActionColumn)
 	// ErrNonBooleanExprForCheckConstraint is returned for non bool expression.
 	ErrNonBooleanExprForCheckConstraint = ClassDDL.NewStd(mysql.ErrNonBooleanExprForCheckConstraint)
+
+	// ErrTrgAlreadyExists is returned when creating a trigger which already exists.
+	ErrTrgAlreadyExists = ClassDDL.NewStd(mysql.ErrTrgAlreadyExists)
+	// ErrTrgDoesNotExist is returned when dropping or referring to a trigger which doesn't exist.
+	ErrTrgDoesNotExist = ClassDDL.NewStd(mysql.ErrTrgDoesNotExist)
+	// ErrTrgOnViewOrTempTable is returned when creating a trigger on a view or temporary table.
+	ErrTrgOnViewOrTempTable = ClassDDL.NewStd(mysql.ErrTrgOnViewOrTempTable)
+	// ErrTrgCantChangeRow is returned when the trigger sets a row which can't be changed.
+	ErrTrgCantChangeRow = ClassDDL.NewStd(mysql.ErrTrgCantChangeRow)
+	// ErrTrgNoSuchRowInTrg is returned when the trigger refers to the NEW or OLD row which doesn't exist.
+	ErrTrgNoSuchRowInTrg = ClassDDL.NewStd(mysql.ErrTrgNoSuchRowInTrg)
+	// ErrTrgInWrongSchema is returned when the trigger isn't in the schema of its table.
+	ErrTrgInWrongSchema = ClassDDL.NewStd(mysql.ErrTrgInWrongSchema)
+	// ErrSpNoRetset is returned when a trigger or stored function returns a result set.
+	ErrSpNoRetset = ClassDDL.NewStd(mysql.ErrSpNoRetset)
+
+	// ErrSpAlreadyExists is returned when creating a stored routine which already exists.
+	ErrSpAlreadyExists = ClassDDL.NewStd(mysql.ErrSpAlreadyExists)
+	// ErrSpDoesNotExist is returned when dropping or calling a stored routine which doesn't exist.
+	ErrSpDoesNotExist = ClassDDL.NewStd(mysql.ErrSpDoesNotExist)
+	// ErrSpBadreturn is returned when RETURN is used in a stored procedure.
+	ErrSpBadreturn = ClassDDL.NewStd(mysql.ErrSpBadreturn)
+	// ErrSpNoreturn is returned when a stored function doesn't have RETURN.
+	ErrSpNoreturn = ClassDDL.NewStd(mysql.ErrSpNoreturn)
+	// ErrSpBadstatement is returned when the statement isn't allowed in stored procedures.
+	ErrSpBadstatement = ClassDDL.NewStd(mysql.ErrSpBadstatement)
+	// ErrSpDupParam is returned when the parameter names of a stored routine are duplicated.
+	ErrSpDupParam = ClassDDL.NewStd(mysql.ErrSpDupParam)
+	// ErrSpDupVar is returned when the variables declared in a block are duplicated.
+	ErrSpDupVar = ClassDDL.NewStd(mysql.ErrSpDupVar)
+	// ErrSpDupCurs is returned when the cursors declared in a block are duplicated.
+	ErrSpDupCurs = ClassDDL.NewStd(mysql.ErrSpDupCurs)
+	// ErrSpUndeclaredVar is returned when the variable isn't declared.
+	ErrSpUndeclaredVar = ClassDDL.NewStd(mysql.ErrSpUndeclaredVar)
+	// ErrSpCursorMismatch is returned when the cursor isn't declared.
+	ErrSpCursorMismatch = ClassDDL.NewStd(mysql.ErrSpCursorMismatch)
+	// ErrSpLilabelMismatch is returned when LEAVE or ITERATE refers to a label which doesn't exist.
+	ErrSpLilabelMismatch = ClassDDL.NewStd(mysql.ErrSpLilabelMismatch)
+	// ErrSpLabelMismatch is returned when the end label doesn't match the begin label.
+	ErrSpLabelMismatch = ClassDDL.NewStd(mysql.ErrSpLabelMismatch)
+	// ErrSpVarcondAfterCurshndlr is returned when a variable is declared after cursors or handlers.
+	ErrSpVarcondAfterCurshndlr = ClassDDL.NewStd(mysql.ErrSpVarcondAfterCurshndlr)
+	// ErrSpCursorAfterHandler is returned when a cursor is declared after handlers.
+	ErrSpCursorAfterHandler = ClassDDL.NewStd(mysql.ErrSpCursorAfterHandler)
+	// ErrSpNoRecursiveCreate is returned when creating a stored routine in another stored routine.
+	ErrSpNoRecursiveCreate = ClassDDL.NewStd(mysql.ErrSpNoRecursiveCreate)
 )
 
 // ReorgRetryableErrCodes is the error codes that are retryable for reorganization.
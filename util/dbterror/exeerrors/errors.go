@@ -73,9 +73,40 @@ var (
 	ErrFuncNotEnabled                 = dbterror.ClassExecutor.NewStdErr(mysql.ErrNotSupportedYet, parser_mysql.Message("%-.32s is not supported. To enable this experimental feature, set '%-.32s' in the configuration file.", nil))
 	ErrSavepointNotExists             = dbterror.ClassExecutor.NewStd(mysql.ErrSpDoesNotExist)
 	ErrForeignKeyCascadeDepthExceeded = dbterror.ClassExecutor.NewStd(mysql.ErrForeignKeyCascadeDepthExceeded)
+	ErrCantUpdateUsedTableInSfOrTrg   = dbterror.ClassExecutor.NewStd(mysql.ErrCantUpdateUsedTableInSfOrTrg)
+	ErrSpWrongNoOfArgs                = dbterror.ClassExecutor.NewStd(mysql.ErrSpWrongNoOfArgs)
+	ErrSpNoreturnend                  = dbterror.ClassExecutor.NewStd(mysql.ErrSpNoreturnend)
+	ErrSpCursorAlreadyOpen            = dbterror.ClassExecutor.NewStd(mysql.ErrSpCursorAlreadyOpen)
+	ErrSpCursorNotOpen                = dbterror.ClassExecutor.NewStd(mysql.ErrSpCursorNotOpen)
+	ErrSpWrongNoOfFetchArgs           = dbterror.ClassExecutor.NewStd(mysql.ErrSpWrongNoOfFetchArgs)
+	ErrSpFetchNoData                  = dbterror.ClassExecutor.NewStd(mysql.ErrSpFetchNoData)
+	ErrSpCaseNotFound                 = dbterror.ClassExecutor.NewStd(mysql.ErrSpCaseNotFound)
+	ErrSpNotVarArg                    = dbterror.ClassExecutor.NewStd(mysql.ErrSpNotVarArg)
+	ErrSpNoRecursion                  = dbterror.ClassExecutor.NewStd(mysql.ErrSpNoRecursion)
+	ErrSpRecursionLimit               = dbterror.ClassExecutor.NewStd(mysql.ErrSpRecursionLimit)
+	ErrTooManyRows                    = dbterror.ClassExecutor.NewStd(mysql.ErrTooManyRows)
+	ErrProcaccessDenied               = dbterror.ClassExecutor.NewStd(mysql.ErrProcaccessDenied)
 	ErrPasswordExpireAnonymousUser    = dbterror.ClassExecutor.NewStd(mysql.ErrPasswordExpireAnonymousUser)
 	ErrMustChangePassword             = dbterror.ClassExecutor.NewStd(mysql.ErrMustChangePassword)
 
+	ErrEventAlreadyExists               = dbterror.ClassExecutor.NewStd(mysql.ErrEventAlreadyExists)
+	ErrEventDoesNotExist                = dbterror.ClassExecutor.NewStd(mysql.ErrEventDoesNotExist)
+	ErrEventIntervalNotPositiveOrTooBig = dbterror.ClassExecutor.NewStd(mysql.ErrEventIntervalNotPositiveOrTooBig)
+	ErrEventEndsBeforeStarts            = dbterror.ClassExecutor.NewStd(mysql.ErrEventEndsBeforeStarts)
+	ErrEventExecTimeInThePast           = dbterror.ClassExecutor.NewStd(mysql.ErrEventExecTimeInThePast)
+	ErrEventSameName                    = dbterror.ClassExecutor.NewStd(mysql.ErrEventSameName)
+	ErrEventCannotCreateInThePast       = dbterror.ClassExecutor.NewStd(mysql.ErrEventCannotCreateInThePast)
+	ErrEventCannotAlterInThePast        = dbterror.ClassExecutor.NewStd(mysql.ErrEventCannotAlterInThePast)
+
+	ErrViewCheckFailed = dbterror.ClassExecutor.NewStd(mysql.ErrViewCheckFailed)
+
+	ErrXaerNota     = dbterror.ClassExecutor.NewStd(mysql.ErrXaerNota)
+	ErrXaerInval    = dbterror.ClassExecutor.NewStd(mysql.ErrXaerInval)
+	ErrXaerRmfail   = dbterror.ClassExecutor.NewStd(mysql.ErrXaerRmfail)
+	ErrXaerOutside  = dbterror.ClassExecutor.NewStd(mysql.ErrXaerOutside)
+	ErrXaRbrollback = dbterror.ClassExecutor.NewStd(mysql.ErrXaRbrollback)
+	ErrXaerDupid    = dbterror.ClassExecutor.NewStd(mysql.ErrXaerDupid)
+
 	ErrWrongStringLength            = dbterror.ClassDDL.NewStd(mysql.ErrWrongStringLength)
 	ErrUnsupportedFlashbackTmpTable = dbterror.ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message("Recover/flashback table is not supported on temporary tables", nil))
 	ErrTruncateWrongInsertValue     = dbterror.ClassTable.NewStdErr(mysql.ErrTruncatedWrongValue, parser_mysql.Message("Incorrect %-.32s value: '%-.128s' for column '%.192s' at row %d", nil))
@@ -99,4 +130,8 @@ var (
 	ErrLoadDataInvalidOperation       = dbterror.ClassExecutor.NewStd(mysql.ErrLoadDataInvalidOperation)
 	ErrLoadDataLocalUnsupportedOption = dbterror.ClassExecutor.NewStd(mysql.ErrLoadDataLocalUnsupportedOption)
 	ErrLoadDataPreCheckFailed         = dbterror.ClassExecutor.NewStd(mysql.ErrLoadDataPreCheckFailed)
+
+	ErrMissingJSONTableValue = dbterror.ClassExecutor.NewStd(mysql.ErrMissingJSONTableValue)
+	ErrWrongJSONTableValue   = dbterror.ClassExecutor.NewStd(mysql.ErrWrongJSONTableValue)
+	ErrJTValueOutOfRange     = dbterror.ClassExecutor.NewStd(mysql.ErrJTValueOutOfRange)
 )
@@ -0,0 +1,145 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fulltext implements the tokenizers and the search query language used by FULLTEXT indexes.
+package fulltext
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pingcap/tidb/util/collate"
+)
+
+const (
+	// NgramParser is the name of the built-in ngram full-text parser, which is suitable for CJK text.
+	NgramParser = "ngram"
+	// MinTokenSize is the minimum length in characters of a word stored by the built-in parser.
+	// It corresponds to the default value of innodb_ft_min_token_size.
+	MinTokenSize = 3
+	// MaxTokenSize is the maximum length in characters of a word stored by the built-in parser.
+	// It corresponds to the default value of innodb_ft_max_token_size.
+	MaxTokenSize = 84
+	// NgramTokenSize is the length in characters of the tokens produced by the ngram parser.
+	// It corresponds to the default value of ngram_token_size.
+	NgramTokenSize = 2
+)
+
+// defaultStopwords is the default stopword list of InnoDB, see INFORMATION_SCHEMA.INNODB_FT_DEFAULT_STOPWORD.
+var defaultStopwords = map[string]struct{}{
+	"a": {}, "about": {}, "an": {}, "are": {}, "as": {}, "at": {}, "be": {}, "by": {}, "com": {},
+	"de": {}, "en": {}, "for": {}, "from": {}, "how": {}, "i": {}, "in": {}, "is": {}, "it": {},
+	"la": {}, "of": {}, "on": {}, "or": {}, "that": {}, "the": {}, "this": {}, "to": {}, "was": {},
+	"what": {}, "when": {}, "where": {}, "who": {}, "will": {}, "with": {}, "und": {}, "www": {},
+}
+
+// IsSupportedParser checks whether the full-text parser is supported. The empty name means the built-in parser.
+func IsSupportedParser(name string) bool {
+	return name == "" || name == NgramParser
+}
+
+// IsCaseSensitive returns whether the tokens of a column in the collation are case-sensitive.
+func IsCaseSensitive(collation string) bool {
+	return collate.IsBinCollation(collation)
+}
+
+// IDF returns the inverse document frequency of a term which occurs in docFreq documents out of totalDocs.
+func IDF(totalDocs, docFreq int64) float64 {
+	if docFreq <= 0 {
+		return 0
+	}
+	if totalDocs < docFreq {
+		totalDocs = docFreq
+	}
+	return math.Log10(1 + float64(totalDocs)/float64(docFreq))
+}
+
+// Tokenizer splits text into the tokens stored in a FULLTEXT index.
+type Tokenizer struct {
+	ngram         bool
+	caseSensitive bool
+}
+
+// NewTokenizer creates a Tokenizer for the parser, see IsSupportedParser.
+func NewTokenizer(parser string, caseSensitive bool) *Tokenizer {
+	return &Tokenizer{ngram: parser == NgramParser, caseSensitive: caseSensitive}
+}
+
+// Tokenize splits the text into tokens in their order of appearance. Duplicated tokens are kept.
+func (t *Tokenizer) Tokenize(text string) []string {
+	var tokens []string
+	forEachWord(text, func(word string) {
+		tokens = t.appendTokens(tokens, word)
+	})
+	return tokens
+}
+
+// appendTokens appends the tokens of a single word.
+func (t *Tokenizer) appendTokens(tokens []string, word string) []string {
+	word = t.normalize(word)
+	if !t.ngram {
+		if t.isIndexable(word) {
+			tokens = append(tokens, word)
+		}
+		return tokens
+	}
+	runes := []rune(word)
+	for i := 0; i+NgramTokenSize <= len(runes); i++ {
+		tokens = append(tokens, string(runes[i:i+NgramTokenSize]))
+	}
+	return tokens
+}
+
+func (t *Tokenizer) normalize(word string) string {
+	if t.caseSensitive {
+		return word
+	}
+	return strings.ToLower(word)
+}
+
+// isIndexable checks whether a normalized word is stored by the built-in parser.
+func (*Tokenizer) isIndexable(word string) bool {
+	n := utf8.RuneCountInString(word)
+	if n < MinTokenSize || n > MaxTokenSize {
+		return false
+	}
+	_, isStopword := defaultStopwords[word]
+	return !isStopword
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
+
+// forEachWord calls fn with every maximal run of word characters in the text.
+func forEachWord(text string, fn func(word string)) {
+	start := -1
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			fn(text[start:i])
+			start = -1
+		}
+	}
+	if start >= 0 {
+		fn(text[start:])
+	}
+}
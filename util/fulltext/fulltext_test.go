@@ -0,0 +1,125 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	tk := NewTokenizer("", false)
+	require.Equal(t, []string{"quick", "brown", "fox", "jumps", "over", "lazy", "dog_2"},
+		tk.Tokenize("The QUICK brown fox, jumps over the lazy dog_2 at 10."))
+	require.Empty(t, tk.Tokenize(""))
+	require.Empty(t, tk.Tokenize("it is a to of"))
+
+	tk = NewTokenizer("", true)
+	require.Equal(t, []string{"The", "QUICK"}, tk.Tokenize("The QUICK"))
+
+	tk = NewTokenizer(NgramParser, false)
+	require.Equal(t, []string{"全文", "文索", "索引", "ab", "bc"}, tk.Tokenize("全文索引 ABC d"))
+
+	require.True(t, IsSupportedParser(""))
+	require.True(t, IsSupportedParser(NgramParser))
+	require.False(t, IsSupportedParser("mecab"))
+	require.True(t, IsCaseSensitive("utf8mb4_bin"))
+	require.False(t, IsCaseSensitive("utf8mb4_general_ci"))
+}
+
+func TestBooleanQuery(t *testing.T) {
+	tk := NewTokenizer("", false)
+	idf := func(Term) float64 { return 1 }
+	doc := func(texts ...string) Document {
+		d := make(Document, 0, len(texts))
+		for _, text := range texts {
+			d = append(d, tk.Tokenize(text))
+		}
+		return d
+	}
+
+	cases := []struct {
+		query string
+		doc   Document
+		score float64
+	}{
+		{"apple banana", doc("apple pie", "cherry"), 1},
+		{"apple banana", doc("apple banana apple"), 3},
+		{"apple banana", doc("cherry"), 0},
+		{"+apple -banana", doc("apple pie"), 1},
+		{"+apple -banana", doc("apple banana"), 0},
+		{"+apple +banana", doc("apple pie"), 0},
+		{"+apple banana", doc("apple banana"), 2},
+		{"app*", doc("apple application"), 2},
+		{`"apple pie"`, doc("apple pie and apple juice"), 2},
+		{`"apple pie"`, doc("pie apple"), 0},
+		{"+apple >pie <juice", doc("apple pie juice"), 1 + 1.5 + 1/1.5},
+		{"apple ~pie", doc("apple pie"), 0},
+		{"~pie", doc("pie"), -1},
+		{"+apple +(pie juice)", doc("apple juice"), 2},
+		{"+apple +(pie juice)", doc("apple"), 0},
+		{"+apple -(pie juice)", doc("apple juice"), 0},
+		{"-apple", doc("pie"), 0},
+		{"the +is", doc("the apple"), 0},
+		{"APPLE", doc("Apple"), 1},
+	}
+	for _, c := range cases {
+		q := ParseBooleanQuery(tk, c.query)
+		require.InDelta(t, c.score, q.Relevance(c.doc, idf), 1e-9, c.query)
+	}
+
+	terms, ok := ParseBooleanQuery(tk, `+apple -banana >cherry "pie juice" app*`).AccessTerms()
+	require.True(t, ok)
+	require.Equal(t, []Term{{Text: "apple"}}, terms)
+	terms, ok = ParseBooleanQuery(tk, `apple -banana (cherry "pie juice") app*`).AccessTerms()
+	require.True(t, ok)
+	require.Equal(t, []Term{{Text: "apple"}, {Text: "cherry"}, {Text: "pie"}, {Text: "app", Prefix: true}}, terms)
+	_, ok = ParseBooleanQuery(tk, "-apple").AccessTerms()
+	require.False(t, ok)
+	require.True(t, ParseBooleanQuery(tk, "the of").IsEmpty())
+	require.Equal(t, []Term{{Text: "pie"}, {Text: "juice"}, {Text: "apple"}},
+		ParseBooleanQuery(tk, `"pie juice" (apple pie)`).Terms())
+
+	// The ngram parser searches the words as phrases.
+	tk = NewTokenizer(NgramParser, false)
+	q := ParseBooleanQuery(tk, "+全文 -数据库")
+	require.Equal(t, 1.0, q.Relevance(Document{tk.Tokenize("全文索引")}, idf))
+	require.Equal(t, 0.0, q.Relevance(Document{tk.Tokenize("全文数据库")}, idf))
+	q = ParseBooleanQuery(tk, "索引*")
+	require.Equal(t, 1.0, q.Relevance(Document{tk.Tokenize("全文索引")}, idf))
+	require.Equal(t, 0.0, q.Relevance(Document{tk.Tokenize("索全引文")}, idf))
+}
+
+func TestNaturalLanguageQuery(t *testing.T) {
+	tk := NewTokenizer("", false)
+	idf := func(t Term) float64 {
+		if t.Text == "rare" {
+			return 2
+		}
+		return 1
+	}
+	q := ParseNaturalLanguageQuery(tk, "the rare common words")
+	require.Equal(t, []Term{{Text: "rare"}, {Text: "common"}, {Text: "words"}}, q.Terms())
+	require.Equal(t, 4.0, q.Relevance(Document{tk.Tokenize("a rare thing")}, idf))
+	require.Equal(t, 2.0, q.Relevance(Document{tk.Tokenize("common"), tk.Tokenize("common")}, idf))
+	require.Equal(t, 0.0, q.Relevance(Document{tk.Tokenize("nothing here")}, idf))
+	require.True(t, ParseNaturalLanguageQuery(tk, "it is").IsEmpty())
+
+	require.Equal(t, 0.0, IDF(10, 0))
+	require.InDelta(t, 1.0, IDF(9, 1), 1e-9)
+	require.InDelta(t, IDF(1, 1), IDF(0, 1), 1e-9)
+	require.Greater(t, IDF(100, 1), IDF(100, 50))
+}
@@ -0,0 +1,381 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Term is a word of a search query. A prefix term matches all the tokens starting with Text.
+type Term struct {
+	Text   string
+	Prefix bool
+}
+
+// Matches checks whether the token matches the term.
+func (t Term) Matches(token string) bool {
+	if t.Prefix {
+		return strings.HasPrefix(token, t.Text)
+	}
+	return token == t.Text
+}
+
+// The operators of the boolean full-text search.
+const (
+	opNone     byte = 0
+	opRequired byte = '+'
+	opExcluded byte = '-'
+	opIncrease byte = '>'
+	opDecrease byte = '<'
+	opNegate   byte = '~'
+)
+
+// boostFactor is the factor applied to the relevance contribution by the '>' and '<' operators.
+const boostFactor = 1.5
+
+// clause is a single operand of a search query, it is a word, a phrase or a parenthesized group.
+type clause struct {
+	op     byte
+	term   Term
+	phrase []string
+	group  []*clause
+}
+
+func (c *clause) isGroup() bool {
+	return c.group != nil
+}
+
+// Query is a parsed full-text search query.
+type Query struct {
+	clauses []*clause
+}
+
+// ParseNaturalLanguageQuery parses the search string of the natural language mode.
+func ParseNaturalLanguageQuery(t *Tokenizer, search string) *Query {
+	q := &Query{}
+	for _, token := range t.Tokenize(search) {
+		q.clauses = append(q.clauses, &clause{term: Term{Text: token}})
+	}
+	return q
+}
+
+// ParseBooleanQuery parses the search string of the boolean mode. It supports the
+// + - > < ~ operators, the trailing * of prefix terms, double-quoted phrases and parenthesized groups.
+// Stopwords and words the parser does not store are ignored.
+func ParseBooleanQuery(t *Tokenizer, search string) *Query {
+	p := &booleanParser{tokenizer: t, search: search}
+	return &Query{clauses: p.parseGroup(false)}
+}
+
+type booleanParser struct {
+	tokenizer *Tokenizer
+	search    string
+	pos       int
+}
+
+func (p *booleanParser) peek() (rune, int) {
+	if p.pos >= len(p.search) {
+		return utf8.RuneError, 0
+	}
+	return utf8.DecodeRuneInString(p.search[p.pos:])
+}
+
+func (p *booleanParser) parseGroup(nested bool) []*clause {
+	clauses := make([]*clause, 0, 4)
+	var op byte
+	for {
+		r, size := p.peek()
+		if size == 0 {
+			return clauses
+		}
+		switch {
+		case r == ')':
+			p.pos += size
+			if nested {
+				return clauses
+			}
+			op = opNone
+		case r == '+' || r == '-' || r == '>' || r == '<' || r == '~':
+			p.pos += size
+			op = byte(r)
+		case r == '(':
+			p.pos += size
+			group := p.parseGroup(true)
+			if len(group) > 0 {
+				clauses = append(clauses, &clause{op: op, group: group})
+			}
+			op = opNone
+		case r == '"':
+			p.pos += size
+			end := strings.IndexByte(p.search[p.pos:], '"')
+			if end < 0 {
+				end = len(p.search) - p.pos
+			}
+			phrase := p.tokenizer.Tokenize(p.search[p.pos : p.pos+end])
+			p.pos += end
+			if p.pos < len(p.search) {
+				p.pos++ // skip the closing quote
+			}
+			if c := newPhraseClause(op, phrase); c != nil {
+				clauses = append(clauses, c)
+			}
+			op = opNone
+		case isWordRune(r):
+			start := p.pos
+			for {
+				r, size = p.peek()
+				if size == 0 || !isWordRune(r) {
+					break
+				}
+				p.pos += size
+			}
+			word := p.search[start:p.pos]
+			prefix := false
+			if r == '*' {
+				p.pos += size
+				prefix = true
+			}
+			if c := p.newWordClause(op, word, prefix); c != nil {
+				clauses = append(clauses, c)
+			}
+			op = opNone
+		default:
+			p.pos += size
+			if r != '*' && r != '@' {
+				op = opNone
+			}
+		}
+	}
+}
+
+func (p *booleanParser) newWordClause(op byte, word string, prefix bool) *clause {
+	t := p.tokenizer
+	word = t.normalize(word)
+	if !t.ngram {
+		if _, isStopword := defaultStopwords[word]; isStopword {
+			return nil
+		}
+		n := utf8.RuneCountInString(word)
+		if n > MaxTokenSize || (!prefix && n < MinTokenSize) {
+			return nil
+		}
+		return &clause{op: op, term: Term{Text: word, Prefix: prefix}}
+	}
+	// The ngram parser searches a word as the phrase of its ngrams, and a word
+	// shorter than the token size can only be searched as a prefix.
+	if utf8.RuneCountInString(word) < NgramTokenSize {
+		if !prefix {
+			return nil
+		}
+		return &clause{op: op, term: Term{Text: word, Prefix: true}}
+	}
+	return newPhraseClause(op, t.appendTokens(nil, word))
+}
+
+func newPhraseClause(op byte, phrase []string) *clause {
+	switch len(phrase) {
+	case 0:
+		return nil
+	case 1:
+		return &clause{op: op, term: Term{Text: phrase[0]}}
+	default:
+		return &clause{op: op, phrase: phrase}
+	}
+}
+
+// IsEmpty returns whether the query has nothing to search, such a query matches no document.
+func (q *Query) IsEmpty() bool {
+	return len(q.clauses) == 0
+}
+
+// Terms returns all the distinct terms referenced by the query.
+func (q *Query) Terms() []Term {
+	var terms []Term
+	seen := make(map[Term]struct{})
+	var collect func(clauses []*clause)
+	collect = func(clauses []*clause) {
+		for _, c := range clauses {
+			switch {
+			case c.isGroup():
+				collect(c.group)
+			case c.phrase != nil:
+				for _, token := range c.phrase {
+					terms = appendTerm(terms, seen, Term{Text: token})
+				}
+			default:
+				terms = appendTerm(terms, seen, c.term)
+			}
+		}
+	}
+	collect(q.clauses)
+	return terms
+}
+
+func appendTerm(terms []Term, seen map[Term]struct{}, t Term) []Term {
+	if _, ok := seen[t]; ok {
+		return terms
+	}
+	seen[t] = struct{}{}
+	return append(terms, t)
+}
+
+// AccessTerms returns the terms which every matched document contains at least one of,
+// so the matched documents can be found by looking these terms up in the index.
+// It returns false if there are no such terms.
+func (q *Query) AccessTerms() ([]Term, bool) {
+	var terms []Term
+	seen := make(map[Term]struct{})
+	if !collectAccessTerms(q.clauses, &terms, seen) || len(terms) == 0 {
+		return nil, false
+	}
+	return terms, true
+}
+
+// collectAccessTerms collects the terms of the clauses which can make a group match,
+// it returns false if the group may match a document containing none of them.
+func collectAccessTerms(clauses []*clause, terms *[]Term, seen map[Term]struct{}) bool {
+	hasRequired := false
+	for _, c := range clauses {
+		if c.op == opRequired {
+			hasRequired = true
+			break
+		}
+	}
+	for _, c := range clauses {
+		if c.op == opExcluded || (hasRequired && c.op != opRequired) {
+			continue
+		}
+		switch {
+		case c.isGroup():
+			if !collectAccessTerms(c.group, terms, seen) {
+				return false
+			}
+		case c.phrase != nil:
+			// Every word of the phrase is contained by the matched documents, the first one is enough.
+			*terms = appendTerm(*terms, seen, Term{Text: c.phrase[0]})
+		default:
+			*terms = appendTerm(*terms, seen, c.term)
+		}
+		if hasRequired {
+			// One of the required clauses is contained by all the matched documents.
+			return true
+		}
+	}
+	return true
+}
+
+// Document is the tokens of the indexed columns of a row, one slice per column.
+type Document [][]string
+
+// Relevance evaluates the query upon the document. It returns 0 if the document does not match,
+// otherwise it returns the relevance of the document which is the sum of tf*idf^2 of the matched terms.
+func (q *Query) Relevance(doc Document, idf func(Term) float64) float64 {
+	matched, score := evalGroup(q.clauses, doc, idf)
+	if !matched {
+		return 0
+	}
+	return score
+}
+
+// evalGroup evaluates the clauses of a group. A group matches a document if the document contains all
+// the required clauses and none of the excluded clauses, and contains at least one of the optional clauses
+// when there is no required clause.
+func evalGroup(clauses []*clause, doc Document, idf func(Term) float64) (matched bool, score float64) {
+	hasRequired, hasOptional := false, false
+	for _, c := range clauses {
+		m, s := evalClause(c, doc, idf)
+		switch c.op {
+		case opRequired:
+			if !m {
+				return false, 0
+			}
+			hasRequired = true
+		case opExcluded:
+			if m {
+				return false, 0
+			}
+			continue
+		default:
+			hasOptional = hasOptional || m
+		}
+		if !m {
+			continue
+		}
+		switch c.op {
+		case opIncrease:
+			s *= boostFactor
+		case opDecrease:
+			s /= boostFactor
+		case opNegate:
+			s = -s
+		}
+		score += s
+	}
+	return hasRequired || hasOptional, score
+}
+
+func evalClause(c *clause, doc Document, idf func(Term) float64) (bool, float64) {
+	switch {
+	case c.isGroup():
+		return evalGroup(c.group, doc, idf)
+	case c.phrase != nil:
+		cnt := 0
+		for _, tokens := range doc {
+			cnt += countPhrase(tokens, c.phrase)
+		}
+		if cnt == 0 {
+			return false, 0
+		}
+		weight := 0.0
+		for _, token := range c.phrase {
+			w := idf(Term{Text: token})
+			weight += w * w
+		}
+		return true, float64(cnt) * weight
+	default:
+		cnt := 0
+		for _, tokens := range doc {
+			for _, token := range tokens {
+				if c.term.Matches(token) {
+					cnt++
+				}
+			}
+		}
+		if cnt == 0 {
+			return false, 0
+		}
+		w := idf(c.term)
+		return true, float64(cnt) * w * w
+	}
+}
+
+// countPhrase counts the occurrences of the phrase as consecutive tokens.
+func countPhrase(tokens []string, phrase []string) int {
+	cnt := 0
+	for i := 0; i+len(phrase) <= len(tokens); i++ {
+		found := true
+		for j, token := range phrase {
+			if tokens[i+j] != token {
+				found = false
+				break
+			}
+		}
+		if found {
+			cnt++
+		}
+	}
+	return cnt
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/charset"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/terror"
@@ -414,7 +415,7 @@ func ColumnsToProto(columns []*model.ColumnInfo, pkIsHandle bool, forIndex bool)
 func ColumnToProto(c *model.ColumnInfo, forIndex bool) *tipb.ColumnInfo {
 	pc := &tipb.ColumnInfo{
 		ColumnId:  c.ID,
-		Collation: collate.RewriteNewCollationIDIfNeeded(int32(mysql.CollationNames[c.GetCollate()])),
+		Collation: collate.RewriteNewCollationIDIfNeeded(collationID(c.GetCollate())),
 		ColumnLen: int32(c.GetFlen()),
 		Decimal:   int32(c.GetDecimal()),
 		Flag:      int32(c.GetFlag()),
@@ -429,6 +430,18 @@ func ColumnToProto(c *model.ColumnInfo, forIndex bool) *tipb.ColumnInfo {
 	return pc
 }
 
+// collationID returns the ID of the collation, 0 if it's unknown.
+// The IDs of some collations, e.g. utf8mb4_0900_as_cs(278), are not in mysql.CollationNames.
+func collationID(name string) int32 {
+	if id, ok := mysql.CollationNames[name]; ok {
+		return int32(id)
+	}
+	if coll, err := charset.GetCollationByName(name); err == nil {
+		return int32(coll.ID)
+	}
+	return 0
+}
+
 func init() {
 	for _, value := range tlsCipherString {
 		SupportCipher[value] = struct{}{}
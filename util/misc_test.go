@@ -176,6 +176,9 @@ func TestToPB(t *testing.T) {
 
 	assert.Equal(t, "column_id:1 collation:-45 columnLen:-1 decimal:-1 ", ColumnToProto(column, false).String())
 	assert.Equal(t, "column_id:1 collation:-45 columnLen:-1 decimal:-1 ", ColumnsToProto([]*model.ColumnInfo{column, column2}, false, false)[0].String())
+
+	column.SetCollate("utf8mb4_0900_as_cs")
+	assert.Equal(t, "column_id:1 collation:-278 columnLen:-1 decimal:-1 ", ColumnToProto(column, false).String())
 }
 
 func TestComposeURL(t *testing.T) {
@@ -425,6 +425,50 @@ func (ssMap *stmtSummaryByDigestMap) GetMoreThanCntBindableStmt(cnt int64) []*Bi
 	return stmts
 }
 
+// AdvisableStmt is a wrapper struct for a statement that is extracted from statements_summary and used as the
+// workload of the index advisor.
+type AdvisableStmt struct {
+	Schema     string
+	Digest     string
+	Query      string
+	ExecCount  int64
+	SumLatency time.Duration
+}
+
+// GetAdvisableStmt gets users' select/update/delete SQLs in the current interval.
+func (ssMap *stmtSummaryByDigestMap) GetAdvisableStmt() []*AdvisableStmt {
+	ssMap.Lock()
+	values := ssMap.summaryMap.Values()
+	ssMap.Unlock()
+
+	stmts := make([]*AdvisableStmt, 0, len(values))
+	for _, value := range values {
+		ssbd := value.(*stmtSummaryByDigest)
+		func() {
+			ssbd.Lock()
+			defer ssbd.Unlock()
+			if ssbd.initialized && !ssbd.isInternal && (ssbd.stmtType == "Select" || ssbd.stmtType == "Delete" || ssbd.stmtType == "Update") {
+				if ssbd.history.Len() > 0 {
+					ssElement := ssbd.history.Back().Value.(*stmtSummaryByDigestElement)
+					ssElement.Lock()
+					// The sample SQL of the prepared statements has no parameters, it can't be optimized.
+					if !ssElement.prepared {
+						stmts = append(stmts, &AdvisableStmt{
+							Schema:     ssbd.schemaName,
+							Digest:     ssbd.digest,
+							Query:      ssElement.sampleSQL,
+							ExecCount:  ssElement.execCount,
+							SumLatency: ssElement.sumLatency,
+						})
+					}
+					ssElement.Unlock()
+				}
+			}
+		}()
+	}
+	return stmts
+}
+
 // SetEnabled enables or disables statement summary
 func (ssMap *stmtSummaryByDigestMap) SetEnabled(value bool) error {
 	// `optEnabled` and `ssMap` don't need to be strictly atomically updated.
@@ -1174,6 +1174,35 @@ func TestGetMoreThanCntBindableStmt(t *testing.T) {
 	require.Equal(t, 1, len(stmts))
 }
 
+func TestGetAdvisableStmt(t *testing.T) {
+	ssMap := newStmtSummaryByDigestMap()
+
+	stmtExecInfo1 := generateAnyExecInfo()
+	stmtExecInfo1.OriginalSQL = "insert 1"
+	stmtExecInfo1.NormalizedSQL = "insert ?"
+	stmtExecInfo1.StmtCtx.StmtType = "Insert"
+	ssMap.AddStatement(stmtExecInfo1)
+	require.Empty(t, ssMap.GetAdvisableStmt())
+
+	stmtExecInfo1.OriginalSQL = "select 1"
+	stmtExecInfo1.NormalizedSQL = "select ?"
+	stmtExecInfo1.Digest = "digest1"
+	stmtExecInfo1.StmtCtx.StmtType = "Select"
+	ssMap.AddStatement(stmtExecInfo1)
+	ssMap.AddStatement(stmtExecInfo1)
+	stmts := ssMap.GetAdvisableStmt()
+	require.Len(t, stmts, 1)
+	require.Equal(t, "digest1", stmts[0].Digest)
+	require.Equal(t, "select 1", stmts[0].Query)
+	require.Equal(t, int64(2), stmts[0].ExecCount)
+	require.Equal(t, 2*stmtExecInfo1.TotalLatency, stmts[0].SumLatency)
+
+	stmtExecInfo1.Digest = "digest2"
+	stmtExecInfo1.Prepared = true
+	ssMap.AddStatement(stmtExecInfo1)
+	require.Len(t, ssMap.GetAdvisableStmt(), 1)
+}
+
 // Test `formatBackoffTypes`.
 func TestFormatBackoffTypes(t *testing.T) {
 	backoffMap := make(map[string]int)
@@ -377,6 +377,38 @@ func (s *StmtSummary) GetMoreThanCntBindableStmt(cnt int64) []*stmtsummary.Binda
 	return stmts
 }
 
+// GetAdvisableStmt is used to get the statements used as the workload of the
+// index advisor. Like GetMoreThanCntBindableStmt, only the statistics data of
+// the current window in memory is referred.
+func (s *StmtSummary) GetAdvisableStmt() []*stmtsummary.AdvisableStmt {
+	s.windowLock.Lock()
+	values := s.window.lru.Values()
+	s.windowLock.Unlock()
+	stmts := make([]*stmtsummary.AdvisableStmt, 0, len(values))
+	for _, value := range values {
+		record := value.(*lockedStmtRecord)
+		func() {
+			record.Lock()
+			defer record.Unlock()
+			if record.IsInternal || record.Prepared {
+				return
+			}
+			if record.StmtType == "Select" ||
+				record.StmtType == "Delete" ||
+				record.StmtType == "Update" {
+				stmts = append(stmts, &stmtsummary.AdvisableStmt{
+					Schema:     record.SchemaName,
+					Digest:     record.Digest,
+					Query:      record.SampleSQL,
+					ExecCount:  record.ExecCount,
+					SumLatency: record.SumLatency,
+				})
+			}
+		}()
+	}
+	return stmts
+}
+
 func (s *StmtSummary) rotateLoop() {
 	tick := time.NewTicker(defaultRotateCheckInterval * time.Second)
 	defer tick.Stop()
@@ -611,3 +643,12 @@ func GetMoreThanCntBindableStmt(frequency int64) []*stmtsummary.BindableStmt {
 	}
 	return stmtsummary.StmtSummaryByDigestMap.GetMoreThanCntBindableStmt(frequency)
 }
+
+// GetAdvisableStmt wraps GlobalStmtSummary.GetAdvisableStmt and
+// stmtsummary.StmtSummaryByDigestMap.GetAdvisableStmt.
+func GetAdvisableStmt() []*stmtsummary.AdvisableStmt {
+	if config.GetGlobalConfig().Instance.StmtSummaryEnablePersistent {
+		return GlobalStmtSummary.GetAdvisableStmt()
+	}
+	return stmtsummary.StmtSummaryByDigestMap.GetAdvisableStmt()
+}